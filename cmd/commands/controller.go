@@ -1,6 +1,10 @@
 package commands
 
 import (
+	"fmt"
+	"os"
+	"strconv"
+
 	"github.com/spf13/cobra"
 
 	"github.com/argoproj/argo-events/common"
@@ -11,12 +15,20 @@ import (
 
 func NewControllerCommand() *cobra.Command {
 	var (
-		leaderElection   bool
-		namespaced       bool
-		managedNamespace string
-		metricsPort      int32
-		healthPort       int32
-		klogLevel        int
+		leaderElection    bool
+		namespaced        bool
+		managedNamespace  string
+		metricsPort       int32
+		healthPort        int32
+		adminPort         int32
+		klogLevel         int
+		logOnlyNamespaces []string
+		clusterName       string
+		enableEventBus    bool
+		enableEventSource bool
+		enableSensor      bool
+		secureDefaults    bool
+		generateSAs       bool
 	)
 
 	command := &cobra.Command{
@@ -25,11 +37,20 @@ func NewControllerCommand() *cobra.Command {
 		Run: func(cmd *cobra.Command, args []string) {
 			logging.SetKlogLevel(klogLevel)
 			eventOpts := controllercmd.ArgoEventsControllerOpts{
-				LeaderElection:   leaderElection,
-				ManagedNamespace: managedNamespace,
-				Namespaced:       namespaced,
-				MetricsPort:      metricsPort,
-				HealthPort:       healthPort,
+				LeaderElection:    leaderElection,
+				ManagedNamespace:  managedNamespace,
+				Namespaced:        namespaced,
+				MetricsPort:       metricsPort,
+				HealthPort:        healthPort,
+				AdminPort:         adminPort,
+				LogOnlyNamespaces: logOnlyNamespaces,
+				ClusterName:       clusterName,
+
+				EnableEventBusController:    enableEventBus,
+				EnableEventSourceController: enableEventSource,
+				EnableSensorController:      enableSensor,
+				SecureDefaults:              secureDefaults,
+				GenerateServiceAccounts:     generateSAs,
 			}
 			controllercmd.Start(eventOpts)
 		},
@@ -39,6 +60,25 @@ func NewControllerCommand() *cobra.Command {
 	command.Flags().BoolVar(&leaderElection, "leader-election", true, "Enable leader election")
 	command.Flags().Int32Var(&metricsPort, "metrics-port", common.ControllerMetricsPort, "Metrics port")
 	command.Flags().Int32Var(&healthPort, "health-port", common.ControllerHealthPort, "Health port")
+	command.Flags().Int32Var(&adminPort, "admin-port", 0, fmt.Sprintf("Port of the admin endpoints, disabled if 0. The bearer token of the requests is read from the %s environment variable.", common.EnvVarAdminToken))
+	command.Flags().StringSliceVar(&logOnlyNamespaces, "log-only-namespaces", nil, "Namespaces where the Sensors consume the events and log the triggers without executing them, e.g. in DR or staging clusters mirroring production.")
+	command.Flags().StringVar(&clusterName, "cluster-name", "", "Name of the cluster, substituted for the {{cluster-name}} template variable of the EventSource and Sensor specs.")
+	command.Flags().BoolVar(&enableEventBus, "enable-eventbus-controller", lookupEnvBoolOr("ENABLE_EVENTBUS_CONTROLLER", true), "Run the EventBus controller, disable it when the EventBuses are managed by another deployment.")
+	command.Flags().BoolVar(&enableEventSource, "enable-eventsource-controller", lookupEnvBoolOr("ENABLE_EVENTSOURCE_CONTROLLER", true), "Run the EventSource controller.")
+	command.Flags().BoolVar(&enableSensor, "enable-sensor-controller", lookupEnvBoolOr("ENABLE_SENSOR_CONTROLLER", true), "Run the Sensor controller.")
+	command.Flags().BoolVar(&secureDefaults, "secure-defaults", lookupEnvBoolOr("SECURE_DEFAULTS", false), "Reject the EventBuses without TLS or client authentication, and generate the tokens of the webhook endpoints without authentication.")
+	command.Flags().BoolVar(&generateSAs, "generate-service-accounts", lookupEnvBoolOr("GENERATE_SERVICE_ACCOUNTS", false), "Generate the ServiceAccounts of the Sensors with generateServiceAccount, it requires the privileges of manifests/extensions/generated-service-accounts.")
 	command.Flags().IntVar(&klogLevel, "kloglevel", 0, "klog level")
 	return command
 }
+
+// lookupEnvBoolOr returns the boolean value of an environment variable, or the default value if it is not set or
+// not a boolean.
+func lookupEnvBoolOr(key string, o bool) bool {
+	if v, ok := os.LookupEnv(key); ok {
+		if b, err := strconv.ParseBool(v); err == nil {
+			return b
+		}
+	}
+	return o
+}
@@ -0,0 +1,18 @@
+package commands
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/argoproj/argo-events/eventbus/seed"
+)
+
+func NewEventBusSeedCommand() *cobra.Command {
+	command := &cobra.Command{
+		Use:   "eventbus-seed",
+		Short: "Publish the seed events of an EventBus",
+		Run: func(cmd *cobra.Command, args []string) {
+			seed.Start()
+		},
+	}
+	return command
+}
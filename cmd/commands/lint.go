@@ -0,0 +1,83 @@
+package commands
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"sigs.k8s.io/yaml"
+
+	"github.com/argoproj/argo-events/controllers/lint"
+	"github.com/argoproj/argo-events/sensors/fixtures"
+)
+
+func NewLintCommand() *cobra.Command {
+	var (
+		eventsFile   string
+		eventBusType string
+		render       bool
+	)
+
+	command := &cobra.Command{
+		Use:     "lint PATH...",
+		Aliases: []string{"validate"},
+		Short:   "Validate EventBus, EventSource and Sensor manifests, and render the triggers of the Sensors with sample events",
+		Args:    cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts := lint.Options{EventBusType: eventBusType}
+			if eventsFile != "" {
+				events, err := fixtures.LoadEvents(eventsFile)
+				if err != nil {
+					return err
+				}
+				opts.Events = events
+			}
+			results, err := lint.Lint(args, opts)
+			if err != nil {
+				return err
+			}
+			out := cmd.OutOrStdout()
+			failures := 0
+			for _, r := range results {
+				if r.Err != nil {
+					failures++
+					fmt.Fprintf(out, "FAIL %s %s/%s: %v\n", r.File, r.Kind, r.Name, r.Err)
+				} else {
+					fmt.Fprintf(out, "OK   %s %s/%s\n", r.File, r.Kind, r.Name)
+				}
+				if render && r.Evaluation != nil {
+					if err := printEvaluation(out, r.Evaluation); err != nil {
+						return err
+					}
+				}
+			}
+			if failures > 0 {
+				return fmt.Errorf("%d object(s) failed the validation", failures)
+			}
+			return nil
+		},
+		SilenceUsage: true,
+	}
+	command.Flags().StringVar(&eventsFile, "events", "", "Path of a sample events file (JSON), run through the Sensors to check their filters and trigger parameters")
+	command.Flags().StringVar(&eventBusType, "eventbus-type", lint.EventBusTypeJetStream, "Type of the EventBus the Sensors are validated against, when it is not part of the manifests: jetstream, kafka or nats")
+	command.Flags().BoolVar(&render, "render", true, "Print the dependency outcomes and the rendered triggers of the Sensors, when sample events are provided")
+	return command
+}
+
+// printEvaluation prints the outcome of the sample events, with the rendered triggers in YAML.
+func printEvaluation(out io.Writer, result *fixtures.Result) error {
+	b, err := json.Marshal(result)
+	if err != nil {
+		return err
+	}
+	y, err := yaml.JSONToYAML(b)
+	if err != nil {
+		return err
+	}
+	for _, line := range strings.Split(strings.TrimRight(string(y), "\n"), "\n") {
+		fmt.Fprintf(out, "    %s\n", line)
+	}
+	return nil
+}
@@ -0,0 +1,86 @@
+package commands
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	"github.com/argoproj/argo-events/controllers/preflight"
+	eventbusv1alpha1 "github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1"
+	eventsourcev1alpha1 "github.com/argoproj/argo-events/pkg/apis/eventsource/v1alpha1"
+	sensorv1alpha1 "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+)
+
+func NewPreflightCommand() *cobra.Command {
+	var (
+		namespace  string
+		configFile string
+		output     string
+	)
+
+	command := &cobra.Command{
+		Use:   "preflight",
+		Short: "Check the EventBus, EventSource and Sensor objects of the cluster before upgrading the controller",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if output != "json" && output != "yaml" {
+				return fmt.Errorf("unsupported output %q, it should be json or yaml", output)
+			}
+			opts := preflight.Options{Namespace: namespace}
+			if configFile != "" {
+				config, err := preflight.LoadConfig(configFile)
+				if err != nil {
+					return err
+				}
+				opts.Config = config
+			}
+			cl, err := newPreflightClient()
+			if err != nil {
+				return err
+			}
+			report, err := preflight.Check(context.Background(), cl, opts)
+			if err != nil {
+				return err
+			}
+			b, err := json.MarshalIndent(report, "", "  ")
+			if err != nil {
+				return err
+			}
+			if output == "yaml" {
+				if b, err = yaml.JSONToYAML(b); err != nil {
+					return err
+				}
+			}
+			fmt.Fprintln(cmd.OutOrStdout(), string(b))
+			if report.Errors > 0 {
+				return fmt.Errorf("%d error(s) must be fixed before the upgrade", report.Errors)
+			}
+			return nil
+		},
+		SilenceUsage: true,
+	}
+	command.Flags().StringVarP(&namespace, "namespace", "n", "", "Namespace of the objects to check, defaults to all the namespaces")
+	command.Flags().StringVar(&configFile, "config", "", "Path of the controller config of the upgrade, the controller-config.yaml file or its ConfigMap, to check the EventBus versions")
+	command.Flags().StringVarP(&output, "output", "o", "json", "Format of the report, json or yaml")
+	return command
+}
+
+func newPreflightClient() (client.Client, error) {
+	restConfig, err := ctrl.GetConfig()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the kubernetes config, %w", err)
+	}
+	scheme := runtime.NewScheme()
+	for _, addToScheme := range []func(*runtime.Scheme) error{corev1.AddToScheme, eventbusv1alpha1.AddToScheme, eventsourcev1alpha1.AddToScheme, sensorv1alpha1.AddToScheme} {
+		if err := addToScheme(scheme); err != nil {
+			return nil, err
+		}
+	}
+	return client.New(restConfig, client.Options{Scheme: scheme})
+}
@@ -24,7 +24,13 @@ func Execute() {
 
 func init() {
 	rootCmd.AddCommand(NewControllerCommand())
+	rootCmd.AddCommand(NewEventBusSeedCommand())
 	rootCmd.AddCommand(NewEventSourceCommand())
+	rootCmd.AddCommand(NewLintCommand())
+	rootCmd.AddCommand(NewPreflightCommand())
 	rootCmd.AddCommand(NewSensorCommand())
+	rootCmd.AddCommand(NewSensorReplayCommand())
+	rootCmd.AddCommand(NewSensorStateCommand())
+	rootCmd.AddCommand(NewSensorTestCommand())
 	rootCmd.AddCommand(NewWebhookCommand())
 }
@@ -0,0 +1,83 @@
+package commands
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/argoproj/argo-events/sensors"
+	"github.com/argoproj/argo-events/sensors/fixtures"
+)
+
+func NewSensorReplayCommand() *cobra.Command {
+	var (
+		sensorFile  string
+		capturePath string
+		since       string
+		until       string
+	)
+
+	command := &cobra.Command{
+		Use:   "sensor-replay",
+		Short: "Replay the events captured by a Sensor through a Sensor spec, and print the trigger executions",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			sensor, err := fixtures.LoadSensor(sensorFile)
+			if err != nil {
+				return err
+			}
+			events, err := fixtures.LoadCapture(capturePath)
+			if err != nil {
+				return err
+			}
+			if events, err = filterCapturedEvents(events, since, until); err != nil {
+				return err
+			}
+			decisions, err := fixtures.Replay(sensor, events)
+			if err != nil {
+				return err
+			}
+			encoder := json.NewEncoder(cmd.OutOrStdout())
+			for _, decision := range decisions {
+				if err := encoder.Encode(decision); err != nil {
+					return err
+				}
+			}
+			fmt.Fprintf(cmd.ErrOrStderr(), "replayed %d event(s), %d trigger execution(s)\n", len(events), len(decisions))
+			return nil
+		},
+		SilenceUsage: true,
+	}
+	command.Flags().StringVar(&sensorFile, "sensor", "", "Path of the Sensor manifest")
+	command.Flags().StringVar(&capturePath, "capture", "", "Path of a capture file, or of a directory of capture files (*.jsonl)")
+	command.Flags().StringVar(&since, "since", "", "Only replay the events received from this time, in RFC 3339")
+	command.Flags().StringVar(&until, "until", "", "Only replay the events received before this time, in RFC 3339")
+	_ = command.MarkFlagRequired("sensor")
+	_ = command.MarkFlagRequired("capture")
+	return command
+}
+
+// filterCapturedEvents returns the events received in the time range, the bounds are optional.
+func filterCapturedEvents(events []sensors.CapturedEvent, since, until string) ([]sensors.CapturedEvent, error) {
+	var from, to time.Time
+	var err error
+	if since != "" {
+		if from, err = time.Parse(time.RFC3339, since); err != nil {
+			return nil, fmt.Errorf("invalid --since, %w", err)
+		}
+	}
+	if until != "" {
+		if to, err = time.Parse(time.RFC3339, until); err != nil {
+			return nil, fmt.Errorf("invalid --until, %w", err)
+		}
+	}
+	result := events[:0]
+	for _, event := range events {
+		if (!from.IsZero() && event.ReceivedAt.Before(from)) || (!to.IsZero() && !event.ReceivedAt.Before(to)) {
+			continue
+		}
+		result = append(result, event)
+	}
+	return result, nil
+}
@@ -0,0 +1,133 @@
+package commands
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/argoproj/argo-events/common/logging"
+	eventbuscommon "github.com/argoproj/argo-events/eventbus/common"
+	jetstreambase "github.com/argoproj/argo-events/eventbus/jetstream/base"
+	jetstreamsensor "github.com/argoproj/argo-events/eventbus/jetstream/sensor"
+	eventbusv1alpha1 "github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1"
+)
+
+type jetStreamFlags struct {
+	url      string
+	username string
+	password string
+	token    string
+}
+
+func (f *jetStreamFlags) register(command *cobra.Command) {
+	command.Flags().StringVar(&f.url, "url", "", "URL of the JetStream EventBus, e.g. nats://localhost:4222")
+	command.Flags().StringVar(&f.username, "username", "", "Username of the JetStream EventBus")
+	command.Flags().StringVar(&f.password, "password", "", "Password of the JetStream EventBus")
+	command.Flags().StringVar(&f.token, "token", "", "Token of the JetStream EventBus, instead of a username and password")
+	_ = command.MarkFlagRequired("url")
+}
+
+func (f *jetStreamFlags) connect() (*jetstreambase.JetstreamConnection, error) {
+	auth := &eventbuscommon.Auth{Strategy: eventbusv1alpha1.AuthStrategyNone}
+	switch {
+	case f.token != "":
+		auth = &eventbuscommon.Auth{Strategy: eventbusv1alpha1.AuthStrategyToken, Credential: &eventbuscommon.AuthCredential{Token: f.token}}
+	case f.username != "":
+		auth = &eventbuscommon.Auth{Strategy: eventbusv1alpha1.AuthStrategyBasic, Credential: &eventbuscommon.AuthCredential{Username: f.username, Password: f.password}}
+	}
+	js, err := jetstreambase.NewJetstream(f.url, "", auth, logging.NewArgoEventsLogger().Named("sensor-state"))
+	if err != nil {
+		return nil, err
+	}
+	return js.MakeConnection()
+}
+
+func NewSensorStateCommand() *cobra.Command {
+	command := &cobra.Command{
+		Use:   "sensor-state",
+		Short: "Export and import the state of a Sensor on a JetStream EventBus, to migrate it to another cluster",
+	}
+	command.AddCommand(newSensorStateExportCommand(), newSensorStateImportCommand())
+	return command
+}
+
+func newSensorStateExportCommand() *cobra.Command {
+	var (
+		flags      jetStreamFlags
+		sensorName string
+		output     string
+	)
+
+	command := &cobra.Command{
+		Use:   "export",
+		Short: "Export the durable consumer positions and the partially resolved dependencies of a Sensor",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			conn, err := flags.connect()
+			if err != nil {
+				return err
+			}
+			defer func() { _ = conn.Close() }()
+			state, err := jetstreamsensor.ExportState(conn.JSContext, sensorName)
+			if err != nil {
+				return err
+			}
+			b, err := json.MarshalIndent(state, "", "  ")
+			if err != nil {
+				return err
+			}
+			if output == "" {
+				fmt.Fprintln(cmd.OutOrStdout(), string(b))
+				return nil
+			}
+			return os.WriteFile(output, b, 0600)
+		},
+		SilenceUsage: true,
+	}
+	flags.register(command)
+	command.Flags().StringVar(&sensorName, "sensor", "", "Name of the Sensor")
+	command.Flags().StringVarP(&output, "output", "o", "", "Path of the state file, defaults to the standard output")
+	_ = command.MarkFlagRequired("sensor")
+	return command
+}
+
+func newSensorStateImportCommand() *cobra.Command {
+	var (
+		flags      jetStreamFlags
+		file       string
+		bySequence bool
+	)
+
+	command := &cobra.Command{
+		Use:   "import",
+		Short: "Import the state of a Sensor before deploying it to the target EventBus",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			b, err := os.ReadFile(file)
+			if err != nil {
+				return err
+			}
+			state := &jetstreamsensor.SensorState{}
+			if err := json.Unmarshal(b, state); err != nil {
+				return fmt.Errorf("failed to parse the state file %s, %w", file, err)
+			}
+			conn, err := flags.connect()
+			if err != nil {
+				return err
+			}
+			defer func() { _ = conn.Close() }()
+			if err := jetstreamsensor.ImportState(conn.JSContext, state, jetstreamsensor.ImportOptions{BySequence: bySequence}); err != nil {
+				return err
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "imported the state of sensor %s: %d keys, %d batches, %d consumers\n",
+				state.Sensor, len(state.KeyValues), len(state.Batches), len(state.Consumers))
+			return nil
+		},
+		SilenceUsage: true,
+	}
+	flags.register(command)
+	command.Flags().StringVarP(&file, "file", "f", "", "Path of the state file")
+	command.Flags().BoolVar(&bySequence, "by-sequence", false, "Resume the consumers from their stream sequence, only if the target stream mirrors the source one")
+	_ = command.MarkFlagRequired("file")
+	return command
+}
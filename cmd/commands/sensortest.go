@@ -0,0 +1,51 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/argoproj/argo-events/sensors/fixtures"
+)
+
+func NewSensorTestCommand() *cobra.Command {
+	var (
+		sensorFile string
+		eventsDir  string
+		goldenDir  string
+		update     bool
+	)
+
+	command := &cobra.Command{
+		Use:   "sensor-test",
+		Short: "Run sample events through a Sensor and compare the results with golden files",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			sensor, err := fixtures.LoadSensor(sensorFile)
+			if err != nil {
+				return err
+			}
+			if goldenDir == "" {
+				goldenDir = eventsDir
+			}
+			mismatches, err := fixtures.Run(sensor, eventsDir, goldenDir, update)
+			if err != nil {
+				return err
+			}
+			for _, m := range mismatches {
+				fmt.Fprintf(cmd.OutOrStdout(), "--- FAIL: %s\nexpected:\n%s\nactual:\n%s\n", m.EventsFile, m.Expected, m.Actual)
+			}
+			if len(mismatches) > 0 {
+				return fmt.Errorf("%d events file(s) do not match the golden files", len(mismatches))
+			}
+			return nil
+		},
+		SilenceUsage: true,
+	}
+	command.Flags().StringVar(&sensorFile, "sensor", "", "Path of the Sensor manifest")
+	command.Flags().StringVar(&eventsDir, "events", "", "Directory of the sample events files (*.json)")
+	command.Flags().StringVar(&goldenDir, "golden", "", "Directory of the golden files, defaults to the events directory")
+	command.Flags().BoolVar(&update, "update", false, "Write the golden files instead of comparing them")
+	_ = command.MarkFlagRequired("sensor")
+	_ = command.MarkFlagRequired("events")
+	return command
+}
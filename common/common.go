@@ -35,16 +35,36 @@ const (
 	EnvVarLeaderElection = "LEADER_ELECTION"
 	// EnvImagePullPolicy is the env var to set container's ImagePullPolicy
 	EnvImagePullPolicy = "IMAGE_PULL_POLICY"
+	// EnvVarAdminToken is the bearer token required by the admin endpoints of the controller
+	EnvVarAdminToken = "ARGO_EVENTS_ADMIN_TOKEN"
+	// EnvVarAdminReadToken is the optional bearer token only granting the read-only admin endpoints, e.g. to dashboards
+	EnvVarAdminReadToken = "ARGO_EVENTS_ADMIN_READ_TOKEN"
+	// EnvVarDebugToken is the bearer token required by the debug endpoint of the EventSource and Sensor pods, the
+	// endpoint is disabled if it is not set
+	EnvVarDebugToken = "ARGO_EVENTS_DEBUG_TOKEN"
 )
 
 // EventBus related
 const (
 	// EnvVarEventBusConfig refers to the eventbus config env
 	EnvVarEventBusConfig = "EVENTBUS_CONFIG"
+	// EnvVarAdditionalEventBusConfigs refers to the env of the base64 encoded configs of the additional EventBuses
+	// the dependencies of a Sensor consume from, keyed by EventBus name
+	EnvVarAdditionalEventBusConfigs = "EVENTBUS_ADDITIONAL_CONFIGS"
 	// EnvVarEventBusSubject refers to the eventbus subject env
 	EnvVarEventBusSubject = "EVENTBUS_SUBJECT"
+	// EnvVarEventBusSeed refers to the env of based64 encoded eventbus seed, used by the seed Jobs
+	EnvVarEventBusSeed = "EVENTBUS_SEED"
 	// volumeMount path for eventbus auth file
 	EventBusAuthFileMountPath = "/etc/eventbus/auth"
+	// volumeMount path of the directories of the auth files of the additional EventBuses, one per EventBus name
+	AdditionalEventBusAuthFileMountPath = "/etc/eventbus/additional"
+	// volumeMount path for the SPIFFE X.509 SVID of the eventbus clients and servers
+	EventBusSPIFFEMountPath = "/etc/eventbus/spiffe"
+	// Files of the SPIFFE X.509 SVID, mounted by the SPIFFE CSI driver
+	SPIFFECertFile   = "tls.crt"
+	SPIFFEKeyFile    = "tls.key"
+	SPIFFECACertFile = "ca.crt"
 	// Default NATS Streaming messages max age
 	STANMaxAge = "72h"
 	// Default NATS Streaming max messages per channel
@@ -85,6 +105,8 @@ const (
 	JetStreamClusterCertKey = "cluster-cert"
 	// key for server CA certificate
 	JetStreamClusterCACertKey = "cluster-ca-cert"
+	// key of the accounts of the tenant EventBuses in the tenants secret
+	JetStreamTenantsSecretKey = "tenants"
 	// key of nats-js.conf in the configmap
 	JetStreamConfigMapKey = "nats-js"
 	// Jetstream Stream name
@@ -97,6 +119,9 @@ const (
 const (
 	// EnvVarSensorObject refers to the env of based64 encoded sensor spec
 	EnvVarSensorObject = "SENSOR_OBJECT"
+	// EnvVarSensorDryRun is set to "true" to resolve the triggers without executing them, it is used by the canary rollouts
+	// and the log-only namespaces
+	EnvVarSensorDryRun = "SENSOR_DRY_RUN"
 	// SensorNamespace is used to get namespace where sensors are deployed
 	SensorNamespace = "SENSOR_NAMESPACE"
 	// LabelSensorName is label for sensor name
@@ -111,6 +136,9 @@ const (
 	EnvVarEventSource = "EVENT_SOURCE"
 	// LabelEventSourceName is the label for a event source
 	LabelEventSourceName = "eventsource-name"
+	// LabelWebhookServiceGroup is the label of the Services exposing a group of webhook endpoints of an EventSource,
+	// holding the name of the group
+	LabelWebhookServiceGroup = "events.argoproj.io/webhook-service-group"
 )
 
 var (
@@ -125,6 +153,21 @@ const (
 	AnnotationResourceSpecHash = "resource-spec-hash"
 	// AnnotationLeaderElection is the annotation for leader election
 	AnnotationLeaderElection = "events.argoproj.io/leader-election"
+	// AnnotationWebhookResponses is the annotation of an EventSource holding the static responses returned by
+	// its webhook endpoints instead of processing the requests, e.g. during a planned downtime
+	AnnotationWebhookResponses = "events.argoproj.io/webhook-responses"
+	// AnnotationReferencesHash is the annotation of the adapter pods holding the hash of the versions of the
+	// Secrets and ConfigMaps referenced by the spec, so that the pods are rolled when they change
+	AnnotationReferencesHash = "events.argoproj.io/references-hash"
+	// AnnotationApprovedPlan is the annotation of an EventBus holding the ID of the approved plan of destructive
+	// actions, when the EventBus requires the approval of the plans
+	AnnotationApprovedPlan = "events.argoproj.io/approved-plan"
+	// LabelClusterOwnerName is the label of the EventSources and Sensors instantiated from a ClusterEventSource
+	// or a ClusterSensor, holding its name
+	LabelClusterOwnerName = "events.argoproj.io/cluster-owner-name"
+	// LabelSharedInclude is the label of the ConfigMaps publishing partial EventSource specs, set to "true" to let the
+	// EventSources of the other namespaces include them
+	LabelSharedInclude = "events.argoproj.io/shared-include"
 )
 
 // various supported media types
@@ -140,9 +183,17 @@ const (
 	ControllerMetricsPort  = 7777
 	EventBusMetricsPort    = 7777
 	ControllerHealthPort   = 8081
+	// EventSourceReadinessPath is the readiness endpoint of the EventSource pods with dependency probes,
+	// served on the metrics port.
+	EventSourceReadinessPath = "/ready"
+	// DebugConfigPath is the debug endpoint of the EventSource and Sensor pods, serving their configuration, on the
+	// metrics port.
+	DebugConfigPath = "/debug/config"
 )
 
 var (
-	SecretKeySelectorType    = reflect.TypeOf(&corev1.SecretKeySelector{})
-	ConfigMapKeySelectorType = reflect.TypeOf(&corev1.ConfigMapKeySelector{})
+	SecretKeySelectorType     = reflect.TypeOf(&corev1.SecretKeySelector{})
+	ConfigMapKeySelectorType  = reflect.TypeOf(&corev1.ConfigMapKeySelector{})
+	SecretVolumeSourceType    = reflect.TypeOf(&corev1.SecretVolumeSource{})
+	ConfigMapVolumeSourceType = reflect.TypeOf(&corev1.ConfigMapVolumeSource{})
 )
@@ -21,6 +21,7 @@ import (
 	"github.com/argoproj/argo-events/common"
 	"github.com/argoproj/argo-events/common/logging"
 	eventbuscommon "github.com/argoproj/argo-events/eventbus/common"
+	apicommon "github.com/argoproj/argo-events/pkg/apis/common"
 	eventbusv1alpha1 "github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1"
 )
 
@@ -42,23 +43,24 @@ func NewElector(ctx context.Context, eventBusConfig eventbusv1alpha1.BusConfig,
 	case eventBusConfig.Kafka != nil || strings.ToLower(os.Getenv(common.EnvVarLeaderElection)) == "k8s":
 		return newKubernetesElector(namespace, leasename, hostname)
 	case eventBusConfig.NATS != nil:
-		return newEventBusElector(ctx, eventBusConfig.NATS.Auth, clusterName, clusterSize, eventBusConfig.NATS.URL)
+		return newEventBusElector(ctx, eventBusConfig.NATS.Auth, nil, clusterName, clusterSize, eventBusConfig.NATS.URL)
 	case eventBusConfig.JetStream != nil:
 		if eventBusConfig.JetStream.AccessSecret != nil {
-			return newEventBusElector(ctx, &eventbusv1alpha1.AuthStrategyBasic, clusterName, clusterSize, eventBusConfig.JetStream.URL)
+			return newEventBusElector(ctx, &eventbusv1alpha1.AuthStrategyBasic, eventBusConfig.JetStream.TLS, clusterName, clusterSize, eventBusConfig.JetStream.URL)
 		} else {
-			return newEventBusElector(ctx, &eventbusv1alpha1.AuthStrategyNone, clusterName, clusterSize, eventBusConfig.JetStream.URL)
+			return newEventBusElector(ctx, &eventbusv1alpha1.AuthStrategyNone, eventBusConfig.JetStream.TLS, clusterName, clusterSize, eventBusConfig.JetStream.URL)
 		}
 	default:
 		return nil, fmt.Errorf("invalid event bus")
 	}
 }
 
-func newEventBusElector(ctx context.Context, authStrategy *eventbusv1alpha1.AuthStrategy, clusterName string, clusterSize int, url string) (Elector, error) {
+func newEventBusElector(ctx context.Context, authStrategy *eventbusv1alpha1.AuthStrategy, tlsConfig *apicommon.TLSConfig, clusterName string, clusterSize int, url string) (Elector, error) {
 	auth, err := getEventBusAuth(ctx, authStrategy)
 	if err != nil {
 		return nil, err
 	}
+	auth.TLS = tlsConfig
 
 	return &natsEventBusElector{
 		clusterName: clusterName,
@@ -99,8 +101,13 @@ func getEventBusAuth(ctx context.Context, authStrategy *eventbusv1alpha1.AuthStr
 			logger.Fatal("Eventbus auth config file changed, exiting..")
 		})
 
+		strategy := *authStrategy
+		if strategy == eventbusv1alpha1.AuthStrategyBasic && cred.Username == "" && cred.Token != "" {
+			// Credentials of a remote JetStream EventBus may come with a token instead of username and password.
+			strategy = eventbusv1alpha1.AuthStrategyToken
+		}
 		auth = &eventbuscommon.Auth{
-			Strategy:   *authStrategy,
+			Strategy:   strategy,
 			Credential: cred,
 		}
 	}
@@ -129,8 +136,16 @@ func (e *natsEventBusElector) RunOrDie(ctx context.Context, callbacks LeaderCall
 		opts.Password = e.auth.Credential.Password
 	}
 
-	opts.TLSConfig = &tls.Config{ // seems fine to pass this in even when we're not using TLS
-		InsecureSkipVerify: true,
+	if e.auth.TLS != nil {
+		tlsConfig, err := common.GetTLSConfig(e.auth.TLS)
+		if err != nil {
+			log.Fatalw("failed to get the tls configuration", zap.Error(err))
+		}
+		opts.TLSConfig = tlsConfig
+	} else {
+		opts.TLSConfig = &tls.Config{ // seems fine to pass this in even when we're not using TLS
+			InsecureSkipVerify: true,
+		}
 	}
 
 	rpc, err := graft.NewNatsRpc(opts)
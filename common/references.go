@@ -0,0 +1,38 @@
+package common
+
+import (
+	"sort"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+// ReferencedObjects returns the sorted names of the Secrets and ConfigMaps referenced by the obj and its children,
+// with secretKeySelectors, configMapKeySelectors, or secret and configMap volumes.
+func ReferencedObjects(obj interface{}) ([]string, []string) {
+	secrets := map[string]bool{}
+	for _, v := range findTypeValues(obj, SecretKeySelectorType) {
+		secrets[v.(*corev1.SecretKeySelector).Name] = true
+	}
+	for _, v := range findTypeValues(obj, SecretVolumeSourceType) {
+		secrets[v.(*corev1.SecretVolumeSource).SecretName] = true
+	}
+	configMaps := map[string]bool{}
+	for _, v := range findTypeValues(obj, ConfigMapKeySelectorType) {
+		configMaps[v.(*corev1.ConfigMapKeySelector).Name] = true
+	}
+	for _, v := range findTypeValues(obj, ConfigMapVolumeSourceType) {
+		configMaps[v.(*corev1.ConfigMapVolumeSource).Name] = true
+	}
+	return sortedNames(secrets), sortedNames(configMaps)
+}
+
+func sortedNames(names map[string]bool) []string {
+	result := []string{}
+	for name := range names {
+		if name != "" {
+			result = append(result, name)
+		}
+	}
+	sort.Strings(result)
+	return result
+}
@@ -0,0 +1,30 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestReferencedObjects(t *testing.T) {
+	obj := struct {
+		Secret    *corev1.SecretKeySelector
+		ConfigMap *corev1.ConfigMapKeySelector
+		Volumes   []corev1.Volume
+		Env       []corev1.EnvVar
+	}{
+		Secret:    &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "b"}},
+		ConfigMap: &corev1.ConfigMapKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "c"}},
+		Volumes: []corev1.Volume{
+			{VolumeSource: corev1.VolumeSource{Secret: &corev1.SecretVolumeSource{SecretName: "a"}}},
+			{VolumeSource: corev1.VolumeSource{ConfigMap: &corev1.ConfigMapVolumeSource{LocalObjectReference: corev1.LocalObjectReference{Name: "c"}}}},
+		},
+		Env: []corev1.EnvVar{
+			{ValueFrom: &corev1.EnvVarSource{SecretKeyRef: &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "b"}}}},
+		},
+	}
+	secrets, configMaps := ReferencedObjects(&obj)
+	assert.Equal(t, []string{"a", "b"}, secrets)
+	assert.Equal(t, []string{"c"}, configMaps)
+}
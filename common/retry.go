@@ -17,6 +17,7 @@ limitations under the License.
 package common
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -56,15 +57,11 @@ func Convert2WaitBackoff(backoff *apicommon.Backoff) (*wait.Backoff, error) {
 	if d == nil {
 		d = &defaultDuration
 	}
-	if d.Type == apicommon.Int64 {
-		result.Duration = time.Duration(d.Int64Value())
-	} else {
-		parsedDuration, err := time.ParseDuration(d.StrVal)
-		if err != nil {
-			return nil, err
-		}
-		result.Duration = parsedDuration
+	duration, err := toDuration(d)
+	if err != nil {
+		return nil, err
 	}
+	result.Duration = duration
 
 	factor := backoff.Factor
 	if factor == nil {
@@ -94,7 +91,50 @@ func Convert2WaitBackoff(backoff *apicommon.Backoff) (*wait.Backoff, error) {
 	return &result, nil
 }
 
+// DoWithRetry calls f until it succeeds or the steps of the backoff are exhausted.
 func DoWithRetry(backoff *apicommon.Backoff, f func() error) error {
+	return doWithRetry(backoff, nil, f)
+}
+
+// DoWithTrackedRetry works like DoWithRetry, the state of the retries is tracked under the operation and the target,
+// and exposed by RetryStates and RetryCounts.
+func DoWithTrackedRetry(operation, target string, backoff *apicommon.Backoff, f func() error) error {
+	run := retries.track(operation, target)
+	err := doWithRetry(backoff, run, f)
+	run.Done(err)
+	return err
+}
+
+// PollWithTrackedRetry calls f every interval until the context is done. The consecutive failed calls are tracked
+// as the failed attempts of the operation on the target until a call succeeds, like the retries of
+// DoWithTrackedRetry.
+func PollWithTrackedRetry(ctx context.Context, operation, target string, interval time.Duration, f func() error) {
+	run := retries.track(operation, target)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			run.Done(ctx.Err())
+			return
+		case <-ticker.C:
+			if err := f(); err != nil {
+				run.AttemptFailed(err)
+			} else {
+				run.Done(nil)
+			}
+		}
+	}
+}
+
+func doWithRetry(backoff *apicommon.Backoff, run *RetryRun, f func() error) error {
+	attempt := func() error {
+		err := f()
+		if err != nil && run != nil {
+			run.AttemptFailed(err)
+		}
+		return err
+	}
 	if backoff == nil {
 		backoff = &DefaultBackoff
 	}
@@ -102,14 +142,55 @@ func DoWithRetry(backoff *apicommon.Backoff, f func() error) error {
 	if err != nil {
 		return fmt.Errorf("invalid backoff configuration, %w", err)
 	}
-	_ = wait.ExponentialBackoff(*b, func() (bool, error) {
-		if err = f(); err != nil {
-			return false, nil
+	if backoff.Cap != nil {
+		cap, capErr := toDuration(backoff.Cap)
+		if capErr != nil {
+			return fmt.Errorf("invalid backoff configuration, invalid cap, %w", capErr)
 		}
-		return true, nil
-	})
+		exponentialBackoffWithCap(*b, cap, func() bool {
+			err = attempt()
+			return err == nil
+		})
+	} else {
+		_ = wait.ExponentialBackoff(*b, func() (bool, error) {
+			if err = attempt(); err != nil {
+				return false, nil
+			}
+			return true, nil
+		})
+	}
 	if err != nil {
 		return fmt.Errorf("failed after retries: %w", err)
 	}
 	return nil
 }
+
+// exponentialBackoffWithCap works like wait.ExponentialBackoff, except that once the cap is reached,
+// the retries go on at the cap until the steps are exhausted instead of stopping.
+func exponentialBackoffWithCap(backoff wait.Backoff, cap time.Duration, condition func() bool) {
+	duration := backoff.Duration
+	for steps := backoff.Steps; steps > 0; steps-- {
+		if condition() || steps == 1 {
+			return
+		}
+		d := duration
+		if backoff.Jitter > 0 {
+			d = wait.Jitter(d, backoff.Jitter)
+		}
+		if cap > 0 && d > cap {
+			d = cap
+		}
+		time.Sleep(d)
+		duration = time.Duration(float64(duration) * backoff.Factor)
+		if cap > 0 && duration > cap {
+			duration = cap
+		}
+	}
+}
+
+func toDuration(d *apicommon.Int64OrString) (time.Duration, error) {
+	if d.Type == apicommon.Int64 {
+		return time.Duration(d.Int64Value()), nil
+	}
+	return time.ParseDuration(d.StrVal)
+}
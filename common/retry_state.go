@@ -0,0 +1,278 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"sort"
+	"sync"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	apicommon "github.com/argoproj/argo-events/pkg/apis/common"
+)
+
+// RetryState is the state of an operation which is being retried, e.g. the connection of an event source to its
+// server, from its first failed attempt until it succeeds or its retries are exhausted.
+type RetryState struct {
+	// Operation is the kind of the operation, e.g. "eventsource.connect".
+	Operation string `json:"operation"`
+	// Target is what the operation is retried for, e.g. "<event source name>/<event name>".
+	Target string `json:"target"`
+	// Attempts is the number of failed attempts.
+	Attempts int `json:"attempts"`
+	// LastError is the error of the last failed attempt.
+	LastError string `json:"lastError"`
+	// Since is the time of the first failed attempt.
+	Since time.Time `json:"since"`
+	// LastAttempt is the time of the last failed attempt.
+	LastAttempt time.Time `json:"lastAttempt"`
+}
+
+// RetryCount is the number of failed attempts and exhausted retries of an operation, since the process started.
+type RetryCount struct {
+	Operation      string
+	Target         string
+	FailedAttempts int64
+	Exhausted      int64
+}
+
+type retryKey struct {
+	operation string
+	target    string
+}
+
+// retryTracker holds the states of the operations being retried, and the counts of their retries.
+type retryTracker struct {
+	lock sync.Mutex
+	// states are the states of the operations being retried, an operation can be retried by several concurrent
+	// calls, e.g. the executions of a trigger, in which case the state is the one of the latest failed attempt.
+	states map[retryKey]*RetryState
+	// runs are the numbers of the calls retrying the operations.
+	runs   map[retryKey]int
+	counts map[retryKey]*RetryCount
+}
+
+var retries = newRetryTracker()
+
+func newRetryTracker() *retryTracker {
+	return &retryTracker{
+		states: map[retryKey]*RetryState{},
+		runs:   map[retryKey]int{},
+		counts: map[retryKey]*RetryCount{},
+	}
+}
+
+// RetryRun tracks the attempts of a call retrying an operation.
+type RetryRun struct {
+	tracker *retryTracker
+	key     retryKey
+	failed  bool
+}
+
+// TrackRetries returns the tracker of a call retrying an operation, for the retry loops which do not use
+// DoWithTrackedRetry, e.g. the loops retrying until their context is done.
+func TrackRetries(operation, target string) *RetryRun {
+	return retries.track(operation, target)
+}
+
+func (t *retryTracker) track(operation, target string) *RetryRun {
+	return &RetryRun{tracker: t, key: retryKey{operation: operation, target: target}}
+}
+
+// AttemptFailed records a failed attempt of the operation.
+func (r *RetryRun) AttemptFailed(err error) {
+	t := r.tracker
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	now := time.Now()
+	state, ok := t.states[r.key]
+	if !ok {
+		state = &RetryState{Operation: r.key.operation, Target: r.key.target, Since: now}
+		t.states[r.key] = state
+	}
+	if !r.failed {
+		r.failed = true
+		t.runs[r.key]++
+	}
+	state.Attempts++
+	state.LastAttempt = now
+	if err != nil {
+		state.LastError = err.Error()
+	}
+	t.count(r.key).FailedAttempts++
+}
+
+// Done records the end of the retries of the operation, err is the error of the last attempt if the retries are
+// exhausted. The retries stopped because their context is done are not counted as exhausted.
+func (r *RetryRun) Done(err error) {
+	t := r.tracker
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	if err != nil && !errors.Is(err, context.Canceled) && !errors.Is(err, context.DeadlineExceeded) {
+		t.count(r.key).Exhausted++
+	}
+	if !r.failed {
+		return
+	}
+	r.failed = false
+	if t.runs[r.key]--; t.runs[r.key] <= 0 {
+		delete(t.runs, r.key)
+		delete(t.states, r.key)
+	}
+}
+
+func (t *retryTracker) count(key retryKey) *RetryCount {
+	count, ok := t.counts[key]
+	if !ok {
+		count = &RetryCount{Operation: key.operation, Target: key.target}
+		t.counts[key] = count
+	}
+	return count
+}
+
+func (t *retryTracker) snapshot() ([]RetryState, []RetryCount) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	states := make([]RetryState, 0, len(t.states))
+	for _, state := range t.states {
+		states = append(states, *state)
+	}
+	sort.Slice(states, func(i, j int) bool {
+		if states[i].Operation != states[j].Operation {
+			return states[i].Operation < states[j].Operation
+		}
+		return states[i].Target < states[j].Target
+	})
+	counts := make([]RetryCount, 0, len(t.counts))
+	for _, count := range t.counts {
+		counts = append(counts, *count)
+	}
+	sort.Slice(counts, func(i, j int) bool {
+		if counts[i].Operation != counts[j].Operation {
+			return counts[i].Operation < counts[j].Operation
+		}
+		return counts[i].Target < counts[j].Target
+	})
+	return states, counts
+}
+
+// RetryStates returns the states of the operations being retried, sorted by operation and target.
+func RetryStates() []RetryState {
+	states, _ := retries.snapshot()
+	return states
+}
+
+// RetryCounts returns the counts of the retries of the operations, sorted by operation and target.
+func RetryCounts() []RetryCount {
+	_, counts := retries.snapshot()
+	return counts
+}
+
+const (
+	// maxRetryStatuses is the maximum number of operations being retried reported by a pod in the status.
+	maxRetryStatuses = 20
+	// maxRetryErrorLength is the maximum length of the errors reported in the status.
+	maxRetryErrorLength = 512
+	// staleRetryStatus is how long the statuses of the other pods are kept without a new attempt, so that the
+	// ones of the killed pods, which did not remove their statuses, are eventually removed.
+	staleRetryStatus = time.Hour
+	// maxRetryStatusBackoff is the maximum delay of the next report after failed ones, e.g. because the pod is not
+	// allowed to update the status.
+	maxRetryStatusBackoff = 10 * time.Minute
+)
+
+// RetryStatuses returns the states of the operations being retried, as reported by the pod in the status of its
+// resource, the ones failing for the longest time first.
+func RetryStatuses(pod string) []apicommon.RetryStatus {
+	states := RetryStates()
+	sort.SliceStable(states, func(i, j int) bool { return states[i].Since.Before(states[j].Since) })
+	if len(states) > maxRetryStatuses {
+		states = states[:maxRetryStatuses]
+	}
+	statuses := make([]apicommon.RetryStatus, 0, len(states))
+	for _, state := range states {
+		lastError := state.LastError
+		if len(lastError) > maxRetryErrorLength {
+			lastError = lastError[:maxRetryErrorLength] + "..."
+		}
+		statuses = append(statuses, apicommon.RetryStatus{
+			Pod:             pod,
+			Operation:       state.Operation,
+			Target:          state.Target,
+			Attempts:        int32(state.Attempts),
+			LastError:       lastError,
+			Since:           metav1.NewTime(state.Since),
+			LastAttemptTime: metav1.NewTime(state.LastAttempt),
+		})
+	}
+	return statuses
+}
+
+// MergeRetryStatuses returns the reported statuses, with the ones of the pod replaced by statuses, and without the
+// stale ones of the other pods.
+func MergeRetryStatuses(reported []apicommon.RetryStatus, pod string, statuses []apicommon.RetryStatus) []apicommon.RetryStatus {
+	var merged []apicommon.RetryStatus
+	for _, status := range reported {
+		if status.Pod != pod && time.Since(status.LastAttemptTime.Time) < staleRetryStatus {
+			merged = append(merged, status)
+		}
+	}
+	merged = append(merged, statuses...)
+	sort.SliceStable(merged, func(i, j int) bool {
+		if merged[i].Pod != merged[j].Pod {
+			return merged[i].Pod < merged[j].Pod
+		}
+		return merged[i].Since.Before(&merged[j].Since)
+	})
+	return merged
+}
+
+// ReportRetryStatuses reports the operations retried by the pod with update, every interval when they changed since
+// the previous report, until the context is done. They are then removed from the status, so that the status only
+// lists the operations retried by the running pods. update merges the statuses of the pod in the status of its
+// resource, with MergeRetryStatuses. The reports are delayed exponentially after failed ones, up to 10 minutes, and
+// warn is called with the errors which differ from the previous one.
+func ReportRetryStatuses(ctx context.Context, pod string, interval time.Duration, update func(ctx context.Context, statuses []apicommon.RetryStatus) error, warn func(err error)) {
+	var reported []apicommon.RetryStatus
+	var lastError string
+	var backoff time.Duration
+	var next time.Time
+	report := func(ctx context.Context, statuses []apicommon.RetryStatus) {
+		if len(statuses) == 0 && len(reported) == 0 || reflect.DeepEqual(statuses, reported) {
+			return
+		}
+		err := update(ctx, statuses)
+		if err == nil {
+			reported, lastError, backoff = statuses, "", 0
+			return
+		}
+		if err.Error() != lastError {
+			lastError = err.Error()
+			warn(err)
+		}
+		if backoff = 2 * backoff; backoff < interval {
+			backoff = interval
+		} else if backoff > maxRetryStatusBackoff {
+			backoff = maxRetryStatusBackoff
+		}
+		next = time.Now().Add(backoff)
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			clearCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), 5*time.Second)
+			report(clearCtx, nil)
+			cancel()
+			return
+		case now := <-ticker.C:
+			if now.Before(next) {
+				continue
+			}
+			report(ctx, RetryStatuses(pod))
+		}
+	}
+}
@@ -0,0 +1,139 @@
+package common
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	apicommon "github.com/argoproj/argo-events/pkg/apis/common"
+)
+
+func TestDoWithTrackedRetry(t *testing.T) {
+	duration := apicommon.FromString("1ms")
+	backoff := apicommon.Backoff{Duration: &duration, Steps: 3}
+
+	attempts := 0
+	err := DoWithTrackedRetry("test.succeed", "es/event", &backoff, func() error {
+		if attempts++; attempts < 2 {
+			return fmt.Errorf("connection refused")
+		}
+		return nil
+	})
+	assert.NoError(t, err)
+	err = DoWithTrackedRetry("test.exhaust", "es/event", &backoff, func() error {
+		return fmt.Errorf("connection refused")
+	})
+	assert.Error(t, err)
+
+	// The operations aren't retried anymore
+	for _, state := range RetryStates() {
+		assert.NotContains(t, []string{"test.succeed", "test.exhaust"}, state.Operation)
+	}
+	counts := map[string]RetryCount{}
+	for _, count := range RetryCounts() {
+		counts[count.Operation] = count
+	}
+	assert.Equal(t, RetryCount{Operation: "test.succeed", Target: "es/event", FailedAttempts: 1}, counts["test.succeed"])
+	assert.Equal(t, RetryCount{Operation: "test.exhaust", Target: "es/event", FailedAttempts: 3, Exhausted: 1}, counts["test.exhaust"])
+}
+
+func TestRetryTracker(t *testing.T) {
+	tracker := newRetryTracker()
+	first := tracker.track("trigger.execute", "sensor/trigger")
+	second := tracker.track("trigger.execute", "sensor/trigger")
+	first.AttemptFailed(fmt.Errorf("first"))
+	second.AttemptFailed(fmt.Errorf("second"))
+	second.AttemptFailed(fmt.Errorf("second again"))
+
+	states, _ := tracker.snapshot()
+	assert.Len(t, states, 1)
+	assert.Equal(t, 3, states[0].Attempts)
+	assert.Equal(t, "second again", states[0].LastError)
+	assert.False(t, states[0].Since.After(states[0].LastAttempt))
+
+	// The state is kept until all the calls retrying the operation are done
+	first.Done(nil)
+	states, _ = tracker.snapshot()
+	assert.Len(t, states, 1)
+	second.Done(fmt.Errorf("second again"))
+	states, counts := tracker.snapshot()
+	assert.Empty(t, states)
+	assert.Equal(t, []RetryCount{{Operation: "trigger.execute", Target: "sensor/trigger", FailedAttempts: 3, Exhausted: 1}}, counts)
+
+	// A call which succeeds at the first attempt isn't tracked
+	third := tracker.track("trigger.execute", "sensor/trigger")
+	third.Done(nil)
+	states, _ = tracker.snapshot()
+	assert.Empty(t, states)
+}
+
+func TestRetryRunDoneCancelled(t *testing.T) {
+	tracker := newRetryTracker()
+	run := tracker.track("eventsource.register", "es/event")
+	run.AttemptFailed(fmt.Errorf("connection refused"))
+	// The retries stopped with their context are not exhausted
+	run.Done(fmt.Errorf("failed to register, %w", context.Canceled))
+	run.AttemptFailed(fmt.Errorf("connection refused"))
+	run.Done(context.DeadlineExceeded)
+	states, counts := tracker.snapshot()
+	assert.Empty(t, states)
+	assert.Equal(t, []RetryCount{{Operation: "eventsource.register", Target: "es/event", FailedAttempts: 2}}, counts)
+}
+
+func TestPollWithTrackedRetry(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	calls := 0
+	PollWithTrackedRetry(ctx, "test.poll", "es/event", time.Millisecond, func() error {
+		if calls++; calls == 3 {
+			cancel()
+		}
+		return fmt.Errorf("connection refused")
+	})
+	for _, count := range RetryCounts() {
+		if count.Operation == "test.poll" {
+			assert.Equal(t, RetryCount{Operation: "test.poll", Target: "es/event", FailedAttempts: 3}, count)
+		}
+	}
+	for _, state := range RetryStates() {
+		assert.NotEqual(t, "test.poll", state.Operation)
+	}
+}
+
+func TestMergeRetryStatuses(t *testing.T) {
+	now := time.Now()
+	reported := []apicommon.RetryStatus{
+		{Pod: "a", Operation: "eventsource.connect", LastAttemptTime: metav1.NewTime(now)},
+		{Pod: "b", Operation: "eventsource.connect", LastAttemptTime: metav1.NewTime(now)},
+		{Pod: "c", Operation: "eventsource.connect", LastAttemptTime: metav1.NewTime(now.Add(-2 * time.Hour))},
+	}
+	// The statuses of the pod are replaced, and the stale ones of the other pods removed
+	merged := MergeRetryStatuses(reported, "b", []apicommon.RetryStatus{{Pod: "b", Operation: "eventsource.poll", LastAttemptTime: metav1.NewTime(now)}})
+	assert.Equal(t, []apicommon.RetryStatus{
+		{Pod: "a", Operation: "eventsource.connect", LastAttemptTime: metav1.NewTime(now)},
+		{Pod: "b", Operation: "eventsource.poll", LastAttemptTime: metav1.NewTime(now)},
+	}, merged)
+	assert.Len(t, MergeRetryStatuses(merged, "a", nil), 1)
+}
+
+func TestReportRetryStatusesBackoff(t *testing.T) {
+	run := retries.track("test.report", "es/event")
+	run.AttemptFailed(fmt.Errorf("connection refused"))
+	defer run.Done(nil)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	updates, warnings := 0, 0
+	ReportRetryStatuses(ctx, "pod", time.Millisecond, func(ctx context.Context, statuses []apicommon.RetryStatus) error {
+		updates++
+		return fmt.Errorf("forbidden")
+	}, func(err error) {
+		warnings++
+	})
+	// The failed reports are delayed exponentially, and the same error is only warned about once
+	assert.Less(t, updates, 20)
+	assert.Equal(t, 1, warnings)
+}
@@ -119,6 +119,38 @@ func TestRetryFailure(t *testing.T) {
 	assert.Contains(t, err.Error(), "this is an error")
 }
 
+func TestRetryWithCap(t *testing.T) {
+	factor := apicommon.NewAmount("10")
+	jitter := apicommon.NewAmount("0")
+	duration := apicommon.FromString("10ms")
+	capDuration := apicommon.FromString("50ms")
+	backoff := apicommon.Backoff{
+		Duration: &duration,
+		Factor:   &factor,
+		Jitter:   &jitter,
+		Steps:    5,
+		Cap:      &capDuration,
+	}
+	attempts := 0
+	start := time.Now()
+	err := DoWithRetry(&backoff, func() error {
+		attempts++
+		return fmt.Errorf("this is an error")
+	})
+	elapsed := time.Since(start)
+	assert.Error(t, err)
+	// The retries go on at the cap: 10ms + 50ms + 50ms + 50ms
+	assert.Equal(t, 5, attempts)
+	assert.True(t, elapsed >= 160*time.Millisecond)
+	assert.True(t, elapsed < time.Second)
+
+	invalidCap := apicommon.FromString("abc")
+	backoff.Cap = &invalidCap
+	err = DoWithRetry(&backoff, func() error { return nil })
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid cap")
+}
+
 func TestConvert2WaitBackoff(t *testing.T) {
 	factor := apicommon.NewAmount("1.0")
 	jitter := apicommon.NewAmount("1")
@@ -33,3 +33,15 @@ func (sm *StringKeyedMap[T]) Delete(key string) {
 	defer sm.lock.Unlock()
 	delete(sm.items, key)
 }
+
+// LoadOrStore returns the existing item of the key if present, otherwise it stores and returns the given item.
+// The loaded result is true if the item was loaded.
+func (sm *StringKeyedMap[T]) LoadOrStore(key string, item T) (T, bool) {
+	sm.lock.Lock()
+	defer sm.lock.Unlock()
+	if existing, ok := sm.items[key]; ok {
+		return existing, true
+	}
+	sm.items[key] = item
+	return item, false
+}
@@ -0,0 +1,56 @@
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/propagation"
+	sdkresource "go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+const (
+	// EnvVarOTLPEndpoint is the standard OpenTelemetry environment variable of the OTLP endpoint
+	EnvVarOTLPEndpoint = "OTEL_EXPORTER_OTLP_ENDPOINT"
+	// EnvVarOTLPTracesEndpoint is the standard OpenTelemetry environment variable of the OTLP traces endpoint
+	EnvVarOTLPTracesEndpoint = "OTEL_EXPORTER_OTLP_TRACES_ENDPOINT"
+)
+
+// Enabled returns whether the traces are exported, i.e. an OTLP endpoint is configured.
+func Enabled() bool {
+	return os.Getenv(EnvVarOTLPEndpoint) != "" || os.Getenv(EnvVarOTLPTracesEndpoint) != ""
+}
+
+// Init registers the global tracer provider, exporting the spans with OTLP over gRPC. It is configured with the
+// standard OpenTelemetry environment variables, e.g. OTEL_EXPORTER_OTLP_ENDPOINT and OTEL_TRACES_SAMPLER.
+// If no endpoint is configured, the global tracer provider is left as a no-op one.
+// The returned function flushes and stops the tracer provider.
+func Init(ctx context.Context, serviceName string, attrs ...attribute.KeyValue) (func(context.Context) error, error) {
+	if !Enabled() {
+		return func(context.Context) error { return nil }, nil
+	}
+	exporter, err := otlptracegrpc.New(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create the OTLP trace exporter, %w", err)
+	}
+	// The attributes from OTEL_SERVICE_NAME and OTEL_RESOURCE_ATTRIBUTES take precedence
+	resource, err := sdkresource.New(ctx,
+		sdkresource.WithTelemetrySDK(),
+		sdkresource.WithAttributes(append([]attribute.KeyValue{attribute.String("service.name", serviceName)}, attrs...)...),
+		sdkresource.WithFromEnv(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create the trace resource, %w", err)
+	}
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(resource),
+	)
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+	return provider.Shutdown, nil
+}
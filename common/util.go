@@ -157,6 +157,26 @@ func GetSecretFromVolume(selector *v1.SecretKeySelector) (string, error) {
 	return strings.TrimSuffix(string(data), "\n"), nil
 }
 
+// GetBasicAuthFromVolume retrieves the username and the password of a basic auth from the mounted secret volumes,
+// the missing ones are empty
+func GetBasicAuthFromVolume(auth *apicommon.BasicAuth) (string, string, error) {
+	var username, password string
+	var err error
+	if auth.Username != nil {
+		username, err = GetSecretFromVolume(auth.Username)
+		if err != nil {
+			return "", "", fmt.Errorf("failed to retrieve the username, %w", err)
+		}
+	}
+	if auth.Password != nil {
+		password, err = GetSecretFromVolume(auth.Password)
+		if err != nil {
+			return "", "", fmt.Errorf("failed to retrieve the password, %w", err)
+		}
+	}
+	return username, password, nil
+}
+
 // GetSecretVolumePath returns the path of the mounted secret
 func GetSecretVolumePath(selector *v1.SecretKeySelector) (string, error) {
 	if selector == nil {
@@ -0,0 +1,375 @@
+package admin
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+	appv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	controllerscommon "github.com/argoproj/argo-events/controllers/common"
+	apicommon "github.com/argoproj/argo-events/pkg/apis/common"
+	eventbusv1alpha1 "github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1"
+	eventsourcev1alpha1 "github.com/argoproj/argo-events/pkg/apis/eventsource/v1alpha1"
+	sensorv1alpha1 "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+)
+
+var (
+	// Kinds are the kinds of resources managed by the controller.
+	Kinds = []string{
+		eventbusv1alpha1.SchemaGroupVersionKind.Kind,
+		eventsourcev1alpha1.SchemaGroupVersionKind.Kind,
+		sensorv1alpha1.SchemaGroupVersionKind.Kind,
+	}
+)
+
+// Orphan describes a child resource whose owner is gone.
+type Orphan struct {
+	Kind      string `json:"kind"`
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+	Owner     string `json:"owner,omitempty"`
+	Reason    string `json:"reason"`
+}
+
+// Server serves the admin endpoints of the controller:
+//
+//	GET  /api/v1/orphans                                         lists the child resources whose owner is gone
+//	GET  /api/v1/summary?namespace=<ns>                          summarizes the resources of each namespace, optionally filtered
+//	GET  /api/v1/catalog?namespace=<ns>&eventSource=<name>&event=<name>&type=<type>  lists the events of the EventSources and their consumers, optionally filtered
+//	GET  /api/v1/insecure-settings?namespace=<ns>                lists the resources rejected or changed by the secure defaults, optionally filtered
+//	POST /api/v1/resync?namespace=<ns>&kind=<kind>              reconciles all the resources, optionally filtered
+//	POST /api/v1/recompute-status?kind=<kind>&namespace=<ns>&name=<name>  resets the status conditions of a resource and reconciles it
+//
+// All the requests require the bearer token, the GET requests also accept the read-only token if it is set.
+type Server struct {
+	client    client.Client
+	port      int32
+	token     string
+	readToken string
+	logger    *zap.SugaredLogger
+
+	// kinds are the kinds of resources reconciled by the enabled controllers.
+	kinds  []string
+	events map[string]chan event.GenericEvent
+}
+
+// NewServer returns a new admin server, reconciling the resources of the given kinds. The readToken only grants the
+// GET requests, it is disabled if empty.
+func NewServer(client client.Client, port int32, token, readToken string, kinds []string, logger *zap.SugaredLogger) *Server {
+	events := make(map[string]chan event.GenericEvent, len(kinds))
+	for _, kind := range kinds {
+		events[kind] = make(chan event.GenericEvent, 1024)
+	}
+	return &Server{
+		client:    client,
+		port:      port,
+		token:     token,
+		readToken: readToken,
+		logger:    logger.Named("admin"),
+		kinds:     kinds,
+		events:    events,
+	}
+}
+
+// Source returns the source of the reconciliation requests of a kind, to be watched by its controller.
+func (s *Server) Source(kind string) source.Source {
+	return &source.Channel{Source: s.events[kind]}
+}
+
+// Start runs the admin server until the context is done, it implements manager.Runnable.
+// It only runs on the leader, where the controllers process the reconciliation requests.
+func (s *Server) Start(ctx context.Context) error {
+	srv := &http.Server{
+		Addr:              fmt.Sprintf(":%d", s.port),
+		Handler:           s.Handler(),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		_ = srv.Shutdown(shutdownCtx)
+	}()
+	s.logger.Infow("starting admin server", "port", s.port)
+	if err := srv.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+		return fmt.Errorf("admin server failed, %w", err)
+	}
+	return nil
+}
+
+// Handler returns the HTTP handler of the admin endpoints.
+func (s *Server) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v1/orphans", s.method(http.MethodGet, s.handleOrphans))
+	mux.HandleFunc("/api/v1/summary", s.method(http.MethodGet, s.handleSummary))
+	mux.HandleFunc("/api/v1/catalog", s.method(http.MethodGet, s.handleCatalog))
+	mux.HandleFunc("/api/v1/insecure-settings", s.method(http.MethodGet, s.handleInsecureSettings))
+	mux.HandleFunc("/api/v1/resync", s.method(http.MethodPost, s.handleResync))
+	mux.HandleFunc("/api/v1/recompute-status", s.method(http.MethodPost, s.handleRecomputeStatus))
+	return s.authenticate(mux)
+}
+
+func (s *Server) authenticate(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if s.token != "" && subtle.ConstantTimeCompare([]byte(token), []byte(s.token)) == 1 {
+			next.ServeHTTP(w, r)
+			return
+		}
+		if s.readToken != "" && subtle.ConstantTimeCompare([]byte(token), []byte(s.readToken)) == 1 {
+			if r.Method != http.MethodGet {
+				writeError(w, http.StatusForbidden, "the read-only token only grants GET requests")
+				return
+			}
+			next.ServeHTTP(w, r)
+			return
+		}
+		writeError(w, http.StatusUnauthorized, "unauthorized")
+	})
+}
+
+func (s *Server) method(method string, f http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != method {
+			writeError(w, http.StatusMethodNotAllowed, fmt.Sprintf("method %s is not allowed", r.Method))
+			return
+		}
+		f(w, r)
+	}
+}
+
+func (s *Server) handleOrphans(w http.ResponseWriter, r *http.Request) {
+	orphans, err := s.ListOrphans(r.Context(), r.URL.Query().Get("namespace"))
+	if err != nil {
+		s.logger.Errorw("failed to list orphans", zap.Error(err))
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{"orphans": orphans})
+}
+
+func (s *Server) handleResync(w http.ResponseWriter, r *http.Request) {
+	namespace := r.URL.Query().Get("namespace")
+	kinds := s.kinds
+	if kind := r.URL.Query().Get("kind"); kind != "" {
+		if _, ok := s.events[kind]; !ok {
+			writeError(w, http.StatusBadRequest, fmt.Sprintf("unknown kind %q", kind))
+			return
+		}
+		kinds = []string{kind}
+	}
+	enqueued := 0
+	for _, kind := range kinds {
+		n, err := s.resync(r.Context(), kind, namespace)
+		enqueued += n
+		if err != nil {
+			s.logger.Errorw("failed to resync", "kind", kind, "namespace", namespace, zap.Error(err))
+			writeError(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+	}
+	s.logger.Infow("resync requested", "kinds", kinds, "namespace", namespace, "enqueued", enqueued)
+	writeJSON(w, http.StatusOK, map[string]interface{}{"enqueued": enqueued})
+}
+
+func (s *Server) handleRecomputeStatus(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	kind, namespace, name := query.Get("kind"), query.Get("namespace"), query.Get("name")
+	if _, ok := s.events[kind]; !ok || namespace == "" || name == "" {
+		writeError(w, http.StatusBadRequest, "a known kind, a namespace and a name are required")
+		return
+	}
+	obj, status := newObject(kind)
+	if err := s.client.Get(r.Context(), client.ObjectKey{Namespace: namespace, Name: name}, obj); err != nil {
+		if apierrors.IsNotFound(err) {
+			writeError(w, http.StatusNotFound, err.Error())
+			return
+		}
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	status.Conditions = nil
+	if err := s.client.Status().Update(r.Context(), obj); err != nil {
+		writeError(w, http.StatusInternalServerError, fmt.Sprintf("failed to reset the status, %v", err))
+		return
+	}
+	if err := s.enqueue(r.Context(), kind, obj); err != nil {
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	s.logger.Infow("status recompute requested", "kind", kind, "namespace", namespace, "name", name)
+	writeJSON(w, http.StatusOK, map[string]interface{}{"enqueued": 1})
+}
+
+func (s *Server) resync(ctx context.Context, kind, namespace string) (int, error) {
+	list := newObjectList(kind)
+	if err := s.client.List(ctx, list, client.InNamespace(namespace)); err != nil {
+		return 0, fmt.Errorf("failed to list %s objects, %w", kind, err)
+	}
+	var objs []client.Object
+	switch l := list.(type) {
+	case *eventbusv1alpha1.EventBusList:
+		for i := range l.Items {
+			objs = append(objs, &l.Items[i])
+		}
+	case *eventsourcev1alpha1.EventSourceList:
+		for i := range l.Items {
+			objs = append(objs, &l.Items[i])
+		}
+	case *sensorv1alpha1.SensorList:
+		for i := range l.Items {
+			objs = append(objs, &l.Items[i])
+		}
+	}
+	for i, obj := range objs {
+		if err := s.enqueue(ctx, kind, obj); err != nil {
+			return i, err
+		}
+	}
+	return len(objs), nil
+}
+
+func (s *Server) enqueue(ctx context.Context, kind string, obj client.Object) error {
+	select {
+	case s.events[kind] <- event.GenericEvent{Object: obj}:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// ListOrphans lists the child resources created by the controller whose owner is gone.
+func (s *Server) ListOrphans(ctx context.Context, namespace string) ([]Orphan, error) {
+	opts := []client.ListOption{
+		client.InNamespace(namespace),
+		client.MatchingLabelsSelector{Selector: controllerscommon.GeneratedObjectsSelector()},
+	}
+	children := []struct {
+		kind string
+		list client.ObjectList
+	}{
+		{"Deployment", &appv1.DeploymentList{}},
+		{"StatefulSet", &appv1.StatefulSetList{}},
+		{"Service", &corev1.ServiceList{}},
+		{"ConfigMap", &corev1.ConfigMapList{}},
+		{"Secret", &corev1.SecretList{}},
+	}
+	orphans := []Orphan{}
+	for _, child := range children {
+		if err := s.client.List(ctx, child.list, opts...); err != nil {
+			return nil, fmt.Errorf("failed to list %s objects, %w", child.kind, err)
+		}
+		var metas []metav1.Object
+		switch l := child.list.(type) {
+		case *appv1.DeploymentList:
+			for i := range l.Items {
+				metas = append(metas, &l.Items[i])
+			}
+		case *appv1.StatefulSetList:
+			for i := range l.Items {
+				metas = append(metas, &l.Items[i])
+			}
+		case *corev1.ServiceList:
+			for i := range l.Items {
+				metas = append(metas, &l.Items[i])
+			}
+		case *corev1.ConfigMapList:
+			for i := range l.Items {
+				metas = append(metas, &l.Items[i])
+			}
+		case *corev1.SecretList:
+			for i := range l.Items {
+				metas = append(metas, &l.Items[i])
+			}
+		}
+		for _, m := range metas {
+			orphan, err := s.checkOwner(ctx, child.kind, m)
+			if err != nil {
+				return nil, err
+			}
+			if orphan != nil {
+				orphans = append(orphans, *orphan)
+			}
+		}
+	}
+	return orphans, nil
+}
+
+func (s *Server) checkOwner(ctx context.Context, kind string, m metav1.Object) (*Orphan, error) {
+	orphan := &Orphan{Kind: kind, Namespace: m.GetNamespace(), Name: m.GetName()}
+	ref := metav1.GetControllerOf(m)
+	if ref == nil {
+		orphan.Reason = "no controller owner reference"
+		return orphan, nil
+	}
+	gv, err := schema.ParseGroupVersion(ref.APIVersion)
+	if err != nil || gv.Group != eventbusv1alpha1.SchemeGroupVersion.Group {
+		// Not created by this controller
+		return nil, nil
+	}
+	if _, ok := s.events[ref.Kind]; !ok {
+		return nil, nil
+	}
+	orphan.Owner = fmt.Sprintf("%s/%s", ref.Kind, ref.Name)
+	owner, _ := newObject(ref.Kind)
+	if err := s.client.Get(ctx, client.ObjectKey{Namespace: m.GetNamespace(), Name: ref.Name}, owner); err != nil {
+		if apierrors.IsNotFound(err) {
+			orphan.Reason = "owner not found"
+			return orphan, nil
+		}
+		return nil, fmt.Errorf("failed to get %s, %w", orphan.Owner, err)
+	}
+	if owner.GetUID() != ref.UID {
+		orphan.Reason = "owner UID mismatch"
+		return orphan, nil
+	}
+	return nil, nil
+}
+
+func newObject(kind string) (client.Object, *apicommon.Status) {
+	switch kind {
+	case eventbusv1alpha1.SchemaGroupVersionKind.Kind:
+		obj := &eventbusv1alpha1.EventBus{}
+		return obj, &obj.Status.Status
+	case eventsourcev1alpha1.SchemaGroupVersionKind.Kind:
+		obj := &eventsourcev1alpha1.EventSource{}
+		return obj, &obj.Status.Status
+	default:
+		obj := &sensorv1alpha1.Sensor{}
+		return obj, &obj.Status.Status
+	}
+}
+
+func newObjectList(kind string) client.ObjectList {
+	switch kind {
+	case eventbusv1alpha1.SchemaGroupVersionKind.Kind:
+		return &eventbusv1alpha1.EventBusList{}
+	case eventsourcev1alpha1.SchemaGroupVersionKind.Kind:
+		return &eventsourcev1alpha1.EventSourceList{}
+	default:
+		return &sensorv1alpha1.SensorList{}
+	}
+}
+
+func writeJSON(w http.ResponseWriter, code int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, code int, message string) {
+	writeJSON(w, code, map[string]string{"error": message})
+}
@@ -0,0 +1,162 @@
+package admin
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	appv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/argoproj/argo-events/common/logging"
+	apicommon "github.com/argoproj/argo-events/pkg/apis/common"
+	eventbusv1alpha1 "github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1"
+	eventsourcev1alpha1 "github.com/argoproj/argo-events/pkg/apis/eventsource/v1alpha1"
+	sensorv1alpha1 "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+)
+
+const (
+	testNamespace = "test-ns"
+	testToken     = "test-token"
+	testReadToken = "test-read-token"
+)
+
+func fakeServer(t *testing.T, objs ...client.Object) (*Server, client.Client) {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	assert.NoError(t, corev1.AddToScheme(scheme))
+	assert.NoError(t, appv1.AddToScheme(scheme))
+	assert.NoError(t, eventbusv1alpha1.AddToScheme(scheme))
+	assert.NoError(t, eventsourcev1alpha1.AddToScheme(scheme))
+	assert.NoError(t, sensorv1alpha1.AddToScheme(scheme))
+	cl := fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).WithStatusSubresource(&sensorv1alpha1.Sensor{}).Build()
+	return NewServer(cl, 0, testToken, testReadToken, Kinds, logging.NewArgoEventsLogger()), cl
+}
+
+func doRequest(s *Server, method, url, token string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, url, nil)
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	w := httptest.NewRecorder()
+	s.Handler().ServeHTTP(w, req)
+	return w
+}
+
+func childDeployment(name string, owner metav1.Object, kind string) *appv1.Deployment {
+	deploy := &appv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: testNamespace,
+			Name:      name,
+			Labels:    map[string]string{"controller": "sensor-controller"},
+		},
+	}
+	if owner != nil {
+		deploy.OwnerReferences = []metav1.OwnerReference{*metav1.NewControllerRef(owner, sensorv1alpha1.SchemeGroupVersion.WithKind(kind))}
+	}
+	return deploy
+}
+
+func TestAuthentication(t *testing.T) {
+	s, _ := fakeServer(t)
+	assert.Equal(t, http.StatusUnauthorized, doRequest(s, http.MethodGet, "/api/v1/orphans", "").Code)
+	assert.Equal(t, http.StatusUnauthorized, doRequest(s, http.MethodGet, "/api/v1/orphans", "wrong").Code)
+	assert.Equal(t, http.StatusOK, doRequest(s, http.MethodGet, "/api/v1/orphans", testToken).Code)
+	assert.Equal(t, http.StatusMethodNotAllowed, doRequest(s, http.MethodPost, "/api/v1/orphans", testToken).Code)
+	// The read-only token only grants the GET requests
+	assert.Equal(t, http.StatusOK, doRequest(s, http.MethodGet, "/api/v1/summary", testReadToken).Code)
+	assert.Equal(t, http.StatusForbidden, doRequest(s, http.MethodPost, "/api/v1/resync", testReadToken).Code)
+}
+
+func TestListOrphans(t *testing.T) {
+	sensor := &sensorv1alpha1.Sensor{ObjectMeta: metav1.ObjectMeta{Namespace: testNamespace, Name: "sensor", UID: "uid-1"}}
+	gone := &sensorv1alpha1.Sensor{ObjectMeta: metav1.ObjectMeta{Namespace: testNamespace, Name: "gone", UID: "uid-2"}}
+	recreated := &sensorv1alpha1.Sensor{ObjectMeta: metav1.ObjectMeta{Namespace: testNamespace, Name: "recreated", UID: "uid-3"}}
+	s, cl := fakeServer(t, sensor,
+		childDeployment("owned", sensor, "Sensor"),
+		childDeployment("gone", gone, "Sensor"),
+		childDeployment("recreated", recreated, "Sensor"),
+		childDeployment("no-owner", nil, ""),
+	)
+	recreated.UID = "uid-4"
+	assert.NoError(t, cl.Create(context.Background(), &sensorv1alpha1.Sensor{ObjectMeta: metav1.ObjectMeta{Namespace: testNamespace, Name: "recreated", UID: "uid-4"}}))
+
+	w := doRequest(s, http.MethodGet, "/api/v1/orphans", testToken)
+	assert.Equal(t, http.StatusOK, w.Code)
+	var resp struct {
+		Orphans []Orphan `json:"orphans"`
+	}
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	reasons := map[string]string{}
+	for _, o := range resp.Orphans {
+		assert.Equal(t, "Deployment", o.Kind)
+		reasons[o.Name] = o.Reason
+	}
+	assert.Equal(t, map[string]string{
+		"gone":      "owner not found",
+		"recreated": "owner UID mismatch",
+		"no-owner":  "no controller owner reference",
+	}, reasons)
+}
+
+func TestResync(t *testing.T) {
+	s, _ := fakeServer(t,
+		&sensorv1alpha1.Sensor{ObjectMeta: metav1.ObjectMeta{Namespace: testNamespace, Name: "sensor-1"}},
+		&sensorv1alpha1.Sensor{ObjectMeta: metav1.ObjectMeta{Namespace: "other-ns", Name: "sensor-2"}},
+		&eventsourcev1alpha1.EventSource{ObjectMeta: metav1.ObjectMeta{Namespace: testNamespace, Name: "eventsource"}},
+	)
+	sensors := s.events[sensorv1alpha1.SchemaGroupVersionKind.Kind]
+	eventSources := s.events[eventsourcev1alpha1.SchemaGroupVersionKind.Kind]
+
+	w := doRequest(s, http.MethodPost, "/api/v1/resync?kind=Sensor&namespace="+testNamespace, testToken)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Len(t, sensors, 1)
+	assert.Equal(t, "sensor-1", (<-sensors).Object.GetName())
+	assert.Len(t, eventSources, 0)
+
+	w = doRequest(s, http.MethodPost, "/api/v1/resync", testToken)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `{"enqueued": 3}`, w.Body.String())
+	assert.Len(t, sensors, 2)
+	assert.Len(t, eventSources, 1)
+
+	w = doRequest(s, http.MethodPost, "/api/v1/resync?kind=Unknown", testToken)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestRecomputeStatus(t *testing.T) {
+	sensor := &sensorv1alpha1.Sensor{ObjectMeta: metav1.ObjectMeta{Namespace: testNamespace, Name: "sensor"}}
+	sensor.Status.InitConditions()
+	sensor.Status.MarkCircuitBreakersOpen("CircuitOpen", "stale")
+	s, cl := fakeServer(t, sensor)
+
+	w := doRequest(s, http.MethodPost, "/api/v1/recompute-status?kind=Sensor&namespace="+testNamespace+"&name=sensor", testToken)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Len(t, s.events[sensorv1alpha1.SchemaGroupVersionKind.Kind], 1)
+	updated := &sensorv1alpha1.Sensor{}
+	assert.NoError(t, cl.Get(context.Background(), client.ObjectKeyFromObject(sensor), updated))
+	assert.Equal(t, []apicommon.Condition(nil), updated.Status.Conditions)
+
+	w = doRequest(s, http.MethodPost, "/api/v1/recompute-status?kind=Sensor&namespace="+testNamespace+"&name=missing", testToken)
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	w = doRequest(s, http.MethodPost, "/api/v1/recompute-status?kind=Sensor", testToken)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestResyncDisabledKinds(t *testing.T) {
+	s, cl := fakeServer(t, &eventsourcev1alpha1.EventSource{ObjectMeta: metav1.ObjectMeta{Namespace: testNamespace, Name: "eventsource"}})
+	// Only the Sensor controller is enabled
+	s = NewServer(cl, 0, testToken, testReadToken, []string{sensorv1alpha1.SchemaGroupVersionKind.Kind}, logging.NewArgoEventsLogger())
+	w := doRequest(s, http.MethodPost, "/api/v1/resync?kind=EventSource", testToken)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	w = doRequest(s, http.MethodPost, "/api/v1/resync", testToken)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `{"enqueued": 0}`, w.Body.String())
+}
@@ -0,0 +1,213 @@
+package admin
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/http"
+	"sort"
+
+	"go.uber.org/zap"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/argoproj/argo-events/common"
+	"github.com/argoproj/argo-events/eventsources"
+	eventsourcev1alpha1 "github.com/argoproj/argo-events/pkg/apis/eventsource/v1alpha1"
+	sensorv1alpha1 "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+)
+
+// CatalogEvent describes an event declared by an EventSource, and the Sensors consuming it.
+type CatalogEvent struct {
+	Namespace       string `json:"namespace"`
+	EventSourceName string `json:"eventSourceName"`
+	EventName       string `json:"eventName"`
+	// Type is the type of the event source, e.g. "webhook".
+	Type        string `json:"type"`
+	EventBus    string `json:"eventBus"`
+	Description string `json:"description,omitempty"`
+	// Schema is the JSON Schema of the data of the event, declared in the catalog of the EventSource or inferred
+	// from its sample.
+	Schema         json.RawMessage `json:"schema,omitempty"`
+	SchemaInferred bool            `json:"schemaInferred,omitempty"`
+	Sample         json.RawMessage `json:"sample,omitempty"`
+	Consumers      []EventConsumer `json:"consumers"`
+}
+
+// EventConsumer is a Sensor consuming an event, with the names of its dependencies on the event.
+type EventConsumer struct {
+	Sensor       string   `json:"sensor"`
+	Dependencies []string `json:"dependencies"`
+}
+
+// CatalogFilter restricts the events of the catalog, the empty fields match all the events.
+type CatalogFilter struct {
+	Namespace       string
+	EventSourceName string
+	EventName       string
+	Type            string
+}
+
+func (s *Server) handleCatalog(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	events, err := s.Catalog(r.Context(), CatalogFilter{
+		Namespace:       query.Get("namespace"),
+		EventSourceName: query.Get("eventSource"),
+		EventName:       query.Get("event"),
+		Type:            query.Get("type"),
+	})
+	if err != nil {
+		s.logger.Errorw("failed to build the event catalog", zap.Error(err))
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{"events": events})
+}
+
+// Catalog returns the events declared by the EventSources matching the filter, with the Sensors consuming them,
+// sorted by namespace, EventSource name and event name.
+func (s *Server) Catalog(ctx context.Context, filter CatalogFilter) ([]CatalogEvent, error) {
+	eventSources := &eventsourcev1alpha1.EventSourceList{}
+	if err := s.client.List(ctx, eventSources, client.InNamespace(filter.Namespace)); err != nil {
+		return nil, fmt.Errorf("failed to list EventSource objects, %w", err)
+	}
+	sensors := &sensorv1alpha1.SensorList{}
+	if err := s.client.List(ctx, sensors, client.InNamespace(filter.Namespace)); err != nil {
+		return nil, fmt.Errorf("failed to list Sensor objects, %w", err)
+	}
+
+	type eventKey struct {
+		namespace, eventBus, eventSource, event string
+	}
+	consumers := map[eventKey][]EventConsumer{}
+	for _, sensor := range sensors.Items {
+		deps := map[eventKey][]string{}
+		var keys []eventKey
+		for _, dep := range sensor.Spec.Dependencies {
+			if dep.IsMetric() {
+				continue
+			}
+			eventBus := dep.EventBusName
+			if eventBus == "" {
+				eventBus = sensor.Spec.EventBusName
+			}
+			key := eventKey{sensor.Namespace, eventBusOrDefault(eventBus), dep.EventSourceName, dep.EventName}
+			if _, ok := deps[key]; !ok {
+				keys = append(keys, key)
+			}
+			deps[key] = append(deps[key], dep.Name)
+		}
+		for _, key := range keys {
+			consumers[key] = append(consumers[key], EventConsumer{Sensor: sensor.Name, Dependencies: deps[key]})
+		}
+	}
+
+	result := []CatalogEvent{}
+	for i := range eventSources.Items {
+		es := &eventSources.Items[i]
+		if filter.EventSourceName != "" && es.Name != filter.EventSourceName {
+			continue
+		}
+		eventBus := eventBusOrDefault(es.Spec.EventBusName)
+		servers, _, _ := eventsources.GetEventingServers(es, nil)
+		for eventType, ss := range servers {
+			if filter.Type != "" && string(eventType) != filter.Type {
+				continue
+			}
+			for _, server := range ss {
+				eventName := server.GetEventName()
+				if filter.EventName != "" && eventName != filter.EventName {
+					continue
+				}
+				event := CatalogEvent{
+					Namespace:       es.Namespace,
+					EventSourceName: es.Name,
+					EventName:       eventName,
+					Type:            string(eventType),
+					EventBus:        eventBus,
+					Consumers:       consumers[eventKey{es.Namespace, eventBus, es.Name, eventName}],
+				}
+				if event.Consumers == nil {
+					event.Consumers = []EventConsumer{}
+				}
+				sort.Slice(event.Consumers, func(i, j int) bool {
+					return event.Consumers[i].Sensor < event.Consumers[j].Sensor
+				})
+				if entry, ok := es.Spec.Catalog[eventName]; ok {
+					describeEvent(&event, entry)
+				}
+				result = append(result, event)
+			}
+		}
+	}
+	sort.Slice(result, func(i, j int) bool {
+		a, b := result[i], result[j]
+		if a.Namespace != b.Namespace {
+			return a.Namespace < b.Namespace
+		}
+		if a.EventSourceName != b.EventSourceName {
+			return a.EventSourceName < b.EventSourceName
+		}
+		return a.EventName < b.EventName
+	})
+	return result, nil
+}
+
+func eventBusOrDefault(name string) string {
+	if name == "" {
+		return common.DefaultEventBusName
+	}
+	return name
+}
+
+// describeEvent adds the description, the schema and the sample of the catalog entry of the event.
+func describeEvent(event *CatalogEvent, entry eventsourcev1alpha1.EventCatalogEntry) {
+	event.Description = entry.Description
+	if entry.Sample != "" && json.Valid([]byte(entry.Sample)) {
+		event.Sample = json.RawMessage(entry.Sample)
+	}
+	if entry.Schema != "" && json.Valid([]byte(entry.Schema)) {
+		event.Schema = json.RawMessage(entry.Schema)
+		return
+	}
+	if event.Sample == nil {
+		return
+	}
+	var sample interface{}
+	if err := json.Unmarshal(event.Sample, &sample); err != nil {
+		return
+	}
+	if schema, err := json.Marshal(inferSchema(sample)); err == nil {
+		event.Schema = schema
+		event.SchemaInferred = true
+	}
+}
+
+// inferSchema returns a JSON Schema describing the types of a sample JSON value.
+func inferSchema(value interface{}) map[string]interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		properties := make(map[string]interface{}, len(v))
+		for name, field := range v {
+			properties[name] = inferSchema(field)
+		}
+		return map[string]interface{}{"type": "object", "properties": properties}
+	case []interface{}:
+		schema := map[string]interface{}{"type": "array"}
+		if len(v) > 0 {
+			schema["items"] = inferSchema(v[0])
+		}
+		return schema
+	case string:
+		return map[string]interface{}{"type": "string"}
+	case float64:
+		if v == math.Trunc(v) {
+			return map[string]interface{}{"type": "integer"}
+		}
+		return map[string]interface{}{"type": "number"}
+	case bool:
+		return map[string]interface{}{"type": "boolean"}
+	default:
+		return map[string]interface{}{"type": "null"}
+	}
+}
@@ -0,0 +1,97 @@
+package admin
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	eventsourcev1alpha1 "github.com/argoproj/argo-events/pkg/apis/eventsource/v1alpha1"
+	sensorv1alpha1 "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+)
+
+func TestCatalog(t *testing.T) {
+	webhook := &eventsourcev1alpha1.EventSource{
+		ObjectMeta: metav1.ObjectMeta{Namespace: testNamespace, Name: "webhook"},
+		Spec: eventsourcev1alpha1.EventSourceSpec{
+			Webhook: map[string]eventsourcev1alpha1.WebhookEventSource{
+				"push":   {WebhookContext: eventsourcev1alpha1.WebhookContext{Endpoint: "/push", Method: "POST", Port: "12000"}},
+				"deploy": {WebhookContext: eventsourcev1alpha1.WebhookContext{Endpoint: "/deploy", Method: "POST", Port: "12000"}},
+			},
+			Catalog: map[string]eventsourcev1alpha1.EventCatalogEntry{
+				"push":   {Description: "A push to the repository", Sample: `{"ref":"main","commits":[{"id":"abc"}],"size":1.5,"forced":false}`},
+				"deploy": {Schema: `{"type":"object"}`},
+			},
+		},
+	}
+	calendar := &eventsourcev1alpha1.EventSource{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "other-ns", Name: "calendar"},
+		Spec: eventsourcev1alpha1.EventSourceSpec{
+			EventBusName: "analytics",
+			Calendar:     map[string]eventsourcev1alpha1.CalendarEventSource{"hourly": {Schedule: "0 * * * *"}},
+		},
+	}
+	ci := &sensorv1alpha1.Sensor{
+		ObjectMeta: metav1.ObjectMeta{Namespace: testNamespace, Name: "ci"},
+		Spec: sensorv1alpha1.SensorSpec{
+			Dependencies: []sensorv1alpha1.EventDependency{
+				{Name: "push-main", EventSourceName: "webhook", EventName: "push"},
+				{Name: "push-any", EventSourceName: "webhook", EventName: "push"},
+				// Another EventBus
+				{Name: "push-other-bus", EventSourceName: "webhook", EventName: "push", EventBusName: "other"},
+			},
+		},
+	}
+	audit := &sensorv1alpha1.Sensor{
+		ObjectMeta: metav1.ObjectMeta{Namespace: testNamespace, Name: "audit"},
+		Spec: sensorv1alpha1.SensorSpec{
+			Dependencies: []sensorv1alpha1.EventDependency{{Name: "push", EventSourceName: "webhook", EventName: "push"}},
+		},
+	}
+	s, _ := fakeServer(t, webhook, calendar, ci, audit)
+
+	var resp struct {
+		Events []CatalogEvent `json:"events"`
+	}
+	w := doRequest(s, http.MethodGet, "/api/v1/catalog", testReadToken)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	assert.Len(t, resp.Events, 3)
+	assert.Equal(t, "calendar", resp.Events[0].EventSourceName)
+	assert.Equal(t, "analytics", resp.Events[0].EventBus)
+	assert.Equal(t, "calendar", resp.Events[0].Type)
+	assert.Empty(t, resp.Events[0].Consumers)
+
+	deploy := resp.Events[1]
+	assert.Equal(t, "deploy", deploy.EventName)
+	assert.JSONEq(t, `{"type":"object"}`, string(deploy.Schema))
+	assert.False(t, deploy.SchemaInferred)
+
+	push := resp.Events[2]
+	assert.Equal(t, "push", push.EventName)
+	assert.Equal(t, "webhook", push.Type)
+	assert.Equal(t, "default", push.EventBus)
+	assert.Equal(t, "A push to the repository", push.Description)
+	assert.True(t, push.SchemaInferred)
+	assert.JSONEq(t, `{"type":"object","properties":{
+		"ref":{"type":"string"},
+		"commits":{"type":"array","items":{"type":"object","properties":{"id":{"type":"string"}}}},
+		"size":{"type":"number"},
+		"forced":{"type":"boolean"}}}`, string(push.Schema))
+	assert.Equal(t, []EventConsumer{
+		{Sensor: "audit", Dependencies: []string{"push"}},
+		{Sensor: "ci", Dependencies: []string{"push-main", "push-any"}},
+	}, push.Consumers)
+
+	w = doRequest(s, http.MethodGet, "/api/v1/catalog?namespace=test-ns&type=webhook&event=push", testReadToken)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	assert.Len(t, resp.Events, 1)
+	assert.Equal(t, "push", resp.Events[0].EventName)
+
+	w = doRequest(s, http.MethodGet, "/api/v1/catalog?eventSource=unknown", testToken)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `{"events":[]}`, w.Body.String())
+}
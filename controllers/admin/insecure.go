@@ -0,0 +1,71 @@
+package admin
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+
+	"go.uber.org/zap"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	controllerscommon "github.com/argoproj/argo-events/controllers/common"
+	eventbusv1alpha1 "github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1"
+	eventsourcev1alpha1 "github.com/argoproj/argo-events/pkg/apis/eventsource/v1alpha1"
+)
+
+// InsecureResource is a resource with settings which are rejected, or replaced, when the secure defaults are
+// enforced.
+type InsecureResource struct {
+	Kind      string                              `json:"kind"`
+	Namespace string                              `json:"namespace"`
+	Name      string                              `json:"name"`
+	Settings  []controllerscommon.InsecureSetting `json:"settings"`
+}
+
+func (s *Server) handleInsecureSettings(w http.ResponseWriter, r *http.Request) {
+	resources, err := s.InsecureResources(r.Context(), r.URL.Query().Get("namespace"))
+	if err != nil {
+		s.logger.Errorw("failed to list the insecure settings", zap.Error(err))
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{"resources": resources})
+}
+
+// InsecureResources returns the EventBuses and EventSources with settings which are rejected, or replaced, when
+// the secure defaults are enforced, sorted by namespace, kind and name, optionally restricted to a namespace.
+func (s *Server) InsecureResources(ctx context.Context, namespace string) ([]InsecureResource, error) {
+	result := []InsecureResource{}
+	eventBuses := &eventbusv1alpha1.EventBusList{}
+	if err := s.client.List(ctx, eventBuses, client.InNamespace(namespace)); err != nil {
+		return nil, fmt.Errorf("failed to list EventBus objects, %w", err)
+	}
+	for i := range eventBuses.Items {
+		eb := &eventBuses.Items[i]
+		if settings := controllerscommon.EventBusInsecureSettings(eb); len(settings) > 0 {
+			result = append(result, InsecureResource{Kind: eventbusv1alpha1.SchemaGroupVersionKind.Kind, Namespace: eb.Namespace, Name: eb.Name, Settings: settings})
+		}
+	}
+	eventSources := &eventsourcev1alpha1.EventSourceList{}
+	if err := s.client.List(ctx, eventSources, client.InNamespace(namespace)); err != nil {
+		return nil, fmt.Errorf("failed to list EventSource objects, %w", err)
+	}
+	for i := range eventSources.Items {
+		es := &eventSources.Items[i]
+		if settings := controllerscommon.EventSourceInsecureSettings(es); len(settings) > 0 {
+			result = append(result, InsecureResource{Kind: eventsourcev1alpha1.SchemaGroupVersionKind.Kind, Namespace: es.Namespace, Name: es.Name, Settings: settings})
+		}
+	}
+	sort.Slice(result, func(i, j int) bool {
+		a, b := result[i], result[j]
+		if a.Namespace != b.Namespace {
+			return a.Namespace < b.Namespace
+		}
+		if a.Kind != b.Kind {
+			return a.Kind < b.Kind
+		}
+		return a.Name < b.Name
+	})
+	return result, nil
+}
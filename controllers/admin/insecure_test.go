@@ -0,0 +1,53 @@
+package admin
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	eventbusv1alpha1 "github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1"
+	eventsourcev1alpha1 "github.com/argoproj/argo-events/pkg/apis/eventsource/v1alpha1"
+)
+
+func TestInsecureSettings(t *testing.T) {
+	kafka := &eventbusv1alpha1.EventBus{
+		ObjectMeta: metav1.ObjectMeta{Namespace: testNamespace, Name: "kafka"},
+		Spec:       eventbusv1alpha1.EventBusSpec{Kafka: &eventbusv1alpha1.KafkaBus{URL: "kafka:9092"}},
+	}
+	jetstream := &eventbusv1alpha1.EventBus{
+		ObjectMeta: metav1.ObjectMeta{Namespace: testNamespace, Name: "default"},
+		Spec:       eventbusv1alpha1.EventBusSpec{JetStream: &eventbusv1alpha1.JetStreamBus{Version: "latest"}},
+	}
+	webhook := &eventsourcev1alpha1.EventSource{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "other-ns", Name: "webhook"},
+		Spec: eventsourcev1alpha1.EventSourceSpec{
+			Webhook: map[string]eventsourcev1alpha1.WebhookEventSource{
+				"push": {WebhookContext: eventsourcev1alpha1.WebhookContext{Endpoint: "/push", Method: "POST", Port: "12000"}},
+			},
+		},
+	}
+	s, _ := fakeServer(t, kafka, jetstream, webhook)
+
+	var resp struct {
+		Resources []InsecureResource `json:"resources"`
+	}
+	w := doRequest(s, http.MethodGet, "/api/v1/insecure-settings", testReadToken)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	assert.Len(t, resp.Resources, 2)
+	assert.Equal(t, "EventSource", resp.Resources[0].Kind)
+	assert.Equal(t, "webhook", resp.Resources[0].Name)
+	assert.Len(t, resp.Resources[0].Settings, 1)
+	assert.False(t, resp.Resources[0].Settings[0].Rejected)
+	assert.Equal(t, "EventBus", resp.Resources[1].Kind)
+	assert.Equal(t, "kafka", resp.Resources[1].Name)
+	assert.Len(t, resp.Resources[1].Settings, 2)
+	assert.True(t, resp.Resources[1].Settings[0].Rejected)
+
+	w = doRequest(s, http.MethodGet, "/api/v1/insecure-settings?namespace=unknown", testToken)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `{"resources":[]}`, w.Body.String())
+}
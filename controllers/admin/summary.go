@@ -0,0 +1,128 @@
+package admin
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+
+	"go.uber.org/zap"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	eventbusv1alpha1 "github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1"
+	eventsourcev1alpha1 "github.com/argoproj/argo-events/pkg/apis/eventsource/v1alpha1"
+	sensorv1alpha1 "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+)
+
+// NamespaceSummary summarizes the resources of a namespace, for the dashboards.
+type NamespaceSummary struct {
+	Namespace    string          `json:"namespace"`
+	EventBuses   ResourceCounts  `json:"eventBuses"`
+	EventSources ResourceCounts  `json:"eventSources"`
+	Sensors      ResourceCounts  `json:"sensors"`
+	SensorList   []SensorSummary `json:"sensorList"`
+}
+
+// ResourceCounts counts the resources of a kind, and lists the ones which are not ready.
+type ResourceCounts struct {
+	Total    int      `json:"total"`
+	Ready    int      `json:"ready"`
+	NotReady []string `json:"notReady,omitempty"`
+}
+
+// SensorSummary summarizes the status of a Sensor, and of its triggers if they are reported.
+type SensorSummary struct {
+	Name              string       `json:"name"`
+	Ready             bool         `json:"ready"`
+	Executions        int64        `json:"executions,omitempty"`
+	Failures          int64        `json:"failures,omitempty"`
+	LastExecutionTime *metav1.Time `json:"lastExecutionTime,omitempty"`
+	FailingTriggers   []string     `json:"failingTriggers,omitempty"`
+}
+
+func (s *Server) handleSummary(w http.ResponseWriter, r *http.Request) {
+	summaries, err := s.Summarize(r.Context(), r.URL.Query().Get("namespace"))
+	if err != nil {
+		s.logger.Errorw("failed to summarize", zap.Error(err))
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{"namespaces": summaries})
+}
+
+// Summarize returns the summaries of the namespaces holding resources, sorted by namespace, optionally
+// restricted to a namespace.
+func (s *Server) Summarize(ctx context.Context, namespace string) ([]NamespaceSummary, error) {
+	summaries := map[string]*NamespaceSummary{}
+	get := func(ns string) *NamespaceSummary {
+		if summaries[ns] == nil {
+			summaries[ns] = &NamespaceSummary{Namespace: ns, SensorList: []SensorSummary{}}
+		}
+		return summaries[ns]
+	}
+
+	eventBuses := &eventbusv1alpha1.EventBusList{}
+	if err := s.client.List(ctx, eventBuses, client.InNamespace(namespace)); err != nil {
+		return nil, fmt.Errorf("failed to list EventBus objects, %w", err)
+	}
+	for _, eb := range eventBuses.Items {
+		get(eb.Namespace).EventBuses.count(eb.Name, eb.Status.IsReady())
+	}
+
+	eventSources := &eventsourcev1alpha1.EventSourceList{}
+	if err := s.client.List(ctx, eventSources, client.InNamespace(namespace)); err != nil {
+		return nil, fmt.Errorf("failed to list EventSource objects, %w", err)
+	}
+	for _, es := range eventSources.Items {
+		get(es.Namespace).EventSources.count(es.Name, es.Status.IsReady())
+	}
+
+	sensors := &sensorv1alpha1.SensorList{}
+	if err := s.client.List(ctx, sensors, client.InNamespace(namespace)); err != nil {
+		return nil, fmt.Errorf("failed to list Sensor objects, %w", err)
+	}
+	for _, sensor := range sensors.Items {
+		summary := get(sensor.Namespace)
+		summary.Sensors.count(sensor.Name, sensor.Status.IsReady())
+		summary.SensorList = append(summary.SensorList, summarizeSensor(&sensor))
+	}
+
+	result := make([]NamespaceSummary, 0, len(summaries))
+	for _, summary := range summaries {
+		sort.Strings(summary.EventBuses.NotReady)
+		sort.Strings(summary.EventSources.NotReady)
+		sort.Strings(summary.Sensors.NotReady)
+		sort.Slice(summary.SensorList, func(i, j int) bool {
+			return summary.SensorList[i].Name < summary.SensorList[j].Name
+		})
+		result = append(result, *summary)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Namespace < result[j].Namespace
+	})
+	return result, nil
+}
+
+func (c *ResourceCounts) count(name string, ready bool) {
+	c.Total++
+	if ready {
+		c.Ready++
+	} else {
+		c.NotReady = append(c.NotReady, name)
+	}
+}
+
+func summarizeSensor(sensor *sensorv1alpha1.Sensor) SensorSummary {
+	summary := SensorSummary{Name: sensor.Name, Ready: sensor.Status.IsReady()}
+	if triggers := sensor.Status.Triggers; triggers != nil {
+		summary.Executions = triggers.Executions
+		summary.Failures = triggers.Failures
+		summary.LastExecutionTime = triggers.LastExecutionTime
+		for _, failing := range triggers.Failing {
+			summary.FailingTriggers = append(summary.FailingTriggers, failing.Name)
+		}
+	}
+	return summary
+}
@@ -0,0 +1,59 @@
+package admin
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	eventbusv1alpha1 "github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1"
+	eventsourcev1alpha1 "github.com/argoproj/argo-events/pkg/apis/eventsource/v1alpha1"
+	sensorv1alpha1 "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+)
+
+func TestSummary(t *testing.T) {
+	bus := &eventbusv1alpha1.EventBus{ObjectMeta: metav1.ObjectMeta{Namespace: testNamespace, Name: "default"}}
+	bus.Status.InitConditions()
+	bus.Status.MarkDeployed("Deployed", "deployed")
+	bus.Status.MarkConfigured()
+	eventSource := &eventsourcev1alpha1.EventSource{ObjectMeta: metav1.ObjectMeta{Namespace: testNamespace, Name: "webhook"}}
+	healthy := &sensorv1alpha1.Sensor{ObjectMeta: metav1.ObjectMeta{Namespace: testNamespace, Name: "healthy"}}
+	lastExecution := metav1.NewTime(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	failing := &sensorv1alpha1.Sensor{ObjectMeta: metav1.ObjectMeta{Namespace: testNamespace, Name: "failing"}}
+	failing.Status.Triggers = &sensorv1alpha1.TriggersStatus{
+		Executions:        10,
+		Failures:          3,
+		LastExecutionTime: &lastExecution,
+		Failing:           []sensorv1alpha1.FailingTriggerStatus{{Name: "http-trigger"}},
+	}
+	other := &sensorv1alpha1.Sensor{ObjectMeta: metav1.ObjectMeta{Namespace: "other-ns", Name: "other"}}
+	s, _ := fakeServer(t, bus, eventSource, healthy, failing, other)
+
+	w := doRequest(s, http.MethodGet, "/api/v1/summary", testToken)
+	assert.Equal(t, http.StatusOK, w.Code)
+	var resp struct {
+		Namespaces []NamespaceSummary `json:"namespaces"`
+	}
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	assert.Len(t, resp.Namespaces, 2)
+	assert.Equal(t, "other-ns", resp.Namespaces[0].Namespace)
+	summary := resp.Namespaces[1]
+	assert.Equal(t, testNamespace, summary.Namespace)
+	assert.Equal(t, ResourceCounts{Total: 1, Ready: 1}, summary.EventBuses)
+	assert.Equal(t, ResourceCounts{Total: 1, NotReady: []string{"webhook"}}, summary.EventSources)
+	assert.Equal(t, 2, summary.Sensors.Total)
+	assert.Len(t, summary.SensorList, 2)
+	assert.Equal(t, "failing", summary.SensorList[0].Name)
+	assert.Equal(t, int64(3), summary.SensorList[0].Failures)
+	assert.Equal(t, []string{"http-trigger"}, summary.SensorList[0].FailingTriggers)
+	assert.True(t, lastExecution.Equal(summary.SensorList[0].LastExecutionTime))
+
+	w = doRequest(s, http.MethodGet, "/api/v1/summary?namespace=other-ns", testReadToken)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	assert.Len(t, resp.Namespaces, 1)
+	assert.Equal(t, []SensorSummary{{Name: "other"}}, resp.Namespaces[0].SensorList)
+}
@@ -0,0 +1,210 @@
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/argoproj/argo-events/common"
+	"github.com/argoproj/argo-events/common/logging"
+	apicommon "github.com/argoproj/argo-events/pkg/apis/common"
+)
+
+// kind adapts a cluster-scoped kind, and the namespaced kind instantiated from it.
+type kind struct {
+	// name of the namespaced kind
+	name string
+	// newObject returns an empty cluster-scoped object.
+	newObject func() client.Object
+	// selectorAndStatus returns the namespace selector and the status of the cluster-scoped object.
+	selectorAndStatus func(client.Object) (*metav1.LabelSelector, *apicommon.ClusterResourceStatus)
+	// newInstance returns an empty namespaced object.
+	newInstance func() client.Object
+	// newInstanceList returns an empty list of namespaced objects.
+	newInstanceList func() client.ObjectList
+	// instances returns the items of a list of namespaced objects.
+	instances func(client.ObjectList) []client.Object
+	// setSpec sets the spec of the cluster-scoped object on the namespaced object, it returns true if it changed.
+	setSpec func(cluster client.Object, instance client.Object) bool
+}
+
+type reconciler struct {
+	client client.Client
+	scheme *runtime.Scheme
+	kind   kind
+
+	logger *zap.SugaredLogger
+}
+
+func (r *reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	obj := r.kind.newObject()
+	if err := r.client.Get(ctx, req.NamespacedName, obj); err != nil {
+		if apierrors.IsNotFound(err) {
+			r.logger.Warnw("WARNING: object not found", "request", req)
+			return reconcile.Result{}, nil
+		}
+		r.logger.Errorw("unable to get object", "request", req, zap.Error(err))
+		return ctrl.Result{}, err
+	}
+	if !obj.GetDeletionTimestamp().IsZero() {
+		// The instantiated objects are garbage collected with their owner
+		return reconcile.Result{}, nil
+	}
+	log := r.logger.With("name", obj.GetName())
+	ctx = logging.WithLogger(ctx, log)
+	reconcileErr := r.reconcile(ctx, obj)
+	if reconcileErr != nil {
+		log.Errorw("reconcile error", zap.Error(reconcileErr))
+	}
+	if err := r.client.Status().Update(ctx, obj); err != nil {
+		return reconcile.Result{}, err
+	}
+	return ctrl.Result{}, reconcileErr
+}
+
+// reconcile instantiates the cluster-scoped object into the selected namespaces, and deletes the instances of
+// the namespaces not selected anymore.
+func (r *reconciler) reconcile(ctx context.Context, obj client.Object) error {
+	log := logging.FromContext(ctx)
+	namespaceSelector, status := r.kind.selectorAndStatus(obj)
+	status.InitConditions()
+	if namespaceSelector == nil {
+		status.MarkNotInstantiated("InvalidNamespaceSelector", "namespaceSelector is required")
+		return fmt.Errorf("namespaceSelector is required")
+	}
+	selector, err := metav1.LabelSelectorAsSelector(namespaceSelector)
+	if err != nil {
+		status.MarkNotInstantiated("InvalidNamespaceSelector", err.Error())
+		return fmt.Errorf("invalid namespaceSelector, %w", err)
+	}
+	namespaceList := &corev1.NamespaceList{}
+	if err := r.client.List(ctx, namespaceList, client.MatchingLabelsSelector{Selector: selector}); err != nil {
+		status.MarkNotInstantiated("ListNamespacesFailed", err.Error())
+		return fmt.Errorf("failed to list the namespaces, %w", err)
+	}
+
+	selected := make(map[string]bool)
+	var namespaces, failures []string
+	for _, ns := range namespaceList.Items {
+		if !ns.DeletionTimestamp.IsZero() {
+			continue
+		}
+		selected[ns.Name] = true
+		if err := r.instantiate(ctx, obj, ns.Name); err != nil {
+			log.Errorw("failed to instantiate", "namespace", ns.Name, zap.Error(err))
+			failures = append(failures, fmt.Sprintf("%s: %s", ns.Name, err.Error()))
+			continue
+		}
+		namespaces = append(namespaces, ns.Name)
+	}
+
+	list := r.kind.newInstanceList()
+	if err := r.client.List(ctx, list, client.MatchingLabels{common.LabelClusterOwnerName: obj.GetName()}); err != nil {
+		status.MarkNotInstantiated("ListInstancesFailed", err.Error())
+		return fmt.Errorf("failed to list the instances, %w", err)
+	}
+	for _, instance := range r.kind.instances(list) {
+		if selected[instance.GetNamespace()] || !metav1.IsControlledBy(instance, obj) {
+			continue
+		}
+		if err := r.client.Delete(ctx, instance); err != nil && !apierrors.IsNotFound(err) {
+			failures = append(failures, fmt.Sprintf("%s: failed to delete, %s", instance.GetNamespace(), err.Error()))
+			continue
+		}
+		log.Infow("deleted the instance of a namespace not selected anymore", "namespace", instance.GetNamespace())
+	}
+
+	sort.Strings(namespaces)
+	status.Namespaces = namespaces
+	if len(failures) > 0 {
+		sort.Strings(failures)
+		message := strings.Join(failures, "; ")
+		status.MarkNotInstantiated("InstantiationFailed", message)
+		return fmt.Errorf("failed to instantiate into some namespaces, %s", message)
+	}
+	status.MarkInstantiated()
+	return nil
+}
+
+// instantiate creates or updates the instance of the cluster-scoped object in the namespace.
+func (r *reconciler) instantiate(ctx context.Context, obj client.Object, namespace string) error {
+	existing := r.kind.newInstance()
+	err := r.client.Get(ctx, types.NamespacedName{Namespace: namespace, Name: obj.GetName()}, existing)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+	if apierrors.IsNotFound(err) {
+		instance := r.kind.newInstance()
+		instance.SetNamespace(namespace)
+		instance.SetName(obj.GetName())
+		instance.SetLabels(instanceLabels(obj, nil))
+		r.kind.setSpec(obj, instance)
+		if err := controllerutil.SetControllerReference(obj, instance, r.scheme); err != nil {
+			return err
+		}
+		return r.client.Create(ctx, instance)
+	}
+	if !metav1.IsControlledBy(existing, obj) {
+		return fmt.Errorf("%s %q already exists and is not instantiated from it", r.kind.name, obj.GetName())
+	}
+	labels := instanceLabels(obj, existing.GetLabels())
+	changed := r.kind.setSpec(obj, existing)
+	if !changed && equalLabels(labels, existing.GetLabels()) {
+		return nil
+	}
+	existing.SetLabels(labels)
+	return r.client.Update(ctx, existing)
+}
+
+// instanceLabels returns the labels of an instance, the labels of the cluster-scoped object are propagated.
+func instanceLabels(obj client.Object, existing map[string]string) map[string]string {
+	labels := make(map[string]string)
+	for k, v := range existing {
+		labels[k] = v
+	}
+	for k, v := range obj.GetLabels() {
+		labels[k] = v
+	}
+	labels[common.LabelClusterOwnerName] = obj.GetName()
+	return labels
+}
+
+func equalLabels(a, b map[string]string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for k, v := range a {
+		if w, ok := b[k]; !ok || w != v {
+			return false
+		}
+	}
+	return true
+}
+
+// allObjects returns a function enqueuing all the cluster-scoped objects of a kind, used to instantiate them
+// into the namespaces when the namespaces change.
+func allObjects(cl client.Client, newList func() client.ObjectList, items func(client.ObjectList) []client.Object) func(context.Context, client.Object) []reconcile.Request {
+	return func(ctx context.Context, _ client.Object) []reconcile.Request {
+		list := newList()
+		if err := cl.List(ctx, list); err != nil {
+			return nil
+		}
+		var requests []reconcile.Request
+		for _, obj := range items(list) {
+			requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Name: obj.GetName()}})
+		}
+		return requests
+	}
+}
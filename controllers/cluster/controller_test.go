@@ -0,0 +1,141 @@
+package cluster
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/argoproj/argo-events/common"
+	"github.com/argoproj/argo-events/common/logging"
+	eventsourcev1alpha1 "github.com/argoproj/argo-events/pkg/apis/eventsource/v1alpha1"
+	sensorv1alpha1 "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+)
+
+func init() {
+	_ = eventsourcev1alpha1.AddToScheme(scheme.Scheme)
+	_ = sensorv1alpha1.AddToScheme(scheme.Scheme)
+	_ = corev1.AddToScheme(scheme.Scheme)
+}
+
+func fakeNamespace(name string, labels map[string]string) *corev1.Namespace {
+	return &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: name, Labels: labels}}
+}
+
+func fakeClusterEventSource() *eventsourcev1alpha1.ClusterEventSource {
+	return &eventsourcev1alpha1.ClusterEventSource{
+		ObjectMeta: metav1.ObjectMeta{Name: "standard", UID: "ces-uid", Labels: map[string]string{"team": "platform"}},
+		Spec: eventsourcev1alpha1.ClusterEventSourceSpec{
+			NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"pipeline": "standard"}},
+			EventSource: eventsourcev1alpha1.EventSourceSpec{
+				Calendar: map[string]eventsourcev1alpha1.CalendarEventSource{
+					"example": {Schedule: "*/5 * * * *"},
+				},
+			},
+		},
+	}
+}
+
+func TestReconcileClusterEventSource(t *testing.T) {
+	ctx := context.Background()
+	ces := fakeClusterEventSource()
+	cl := fake.NewClientBuilder().
+		WithObjects(
+			ces,
+			fakeNamespace("team-a", map[string]string{"pipeline": "standard"}),
+			fakeNamespace("team-b", map[string]string{"pipeline": "standard"}),
+			fakeNamespace("other", nil),
+		).
+		WithStatusSubresource(&eventsourcev1alpha1.ClusterEventSource{}).
+		Build()
+	r := NewEventSourceReconciler(cl, scheme.Scheme, logging.NewArgoEventsLogger())
+	req := ctrl.Request{NamespacedName: types.NamespacedName{Name: ces.Name}}
+
+	t.Run("instantiate into the selected namespaces", func(t *testing.T) {
+		_, err := r.Reconcile(ctx, req)
+		assert.NoError(t, err)
+		list := &eventsourcev1alpha1.EventSourceList{}
+		assert.NoError(t, cl.List(ctx, list))
+		assert.Len(t, list.Items, 2)
+		for _, es := range list.Items {
+			assert.Equal(t, ces.Name, es.Name)
+			assert.Equal(t, ces.Name, es.Labels[common.LabelClusterOwnerName])
+			assert.Equal(t, "platform", es.Labels["team"])
+			assert.Equal(t, ces.Spec.EventSource, es.Spec)
+			assert.True(t, metav1.IsControlledBy(&es, ces))
+		}
+		got := &eventsourcev1alpha1.ClusterEventSource{}
+		assert.NoError(t, cl.Get(ctx, req.NamespacedName, got))
+		assert.Equal(t, []string{"team-a", "team-b"}, got.Status.Namespaces)
+		assert.True(t, got.Status.IsReady())
+	})
+
+	t.Run("update the spec and remove unselected namespaces", func(t *testing.T) {
+		got := &eventsourcev1alpha1.ClusterEventSource{}
+		assert.NoError(t, cl.Get(ctx, req.NamespacedName, got))
+		got.Spec.EventSource.Calendar["example"] = eventsourcev1alpha1.CalendarEventSource{Schedule: "*/10 * * * *"}
+		assert.NoError(t, cl.Update(ctx, got))
+		ns := &corev1.Namespace{}
+		assert.NoError(t, cl.Get(ctx, client.ObjectKey{Name: "team-b"}, ns))
+		ns.Labels = nil
+		assert.NoError(t, cl.Update(ctx, ns))
+
+		_, err := r.Reconcile(ctx, req)
+		assert.NoError(t, err)
+		list := &eventsourcev1alpha1.EventSourceList{}
+		assert.NoError(t, cl.List(ctx, list))
+		assert.Len(t, list.Items, 1)
+		assert.Equal(t, "team-a", list.Items[0].Namespace)
+		assert.Equal(t, "*/10 * * * *", list.Items[0].Spec.Calendar["example"].Schedule)
+	})
+
+	t.Run("existing eventsource not instantiated from it", func(t *testing.T) {
+		assert.NoError(t, cl.Create(ctx, fakeNamespace("team-c", map[string]string{"pipeline": "standard"})))
+		assert.NoError(t, cl.Create(ctx, &eventsourcev1alpha1.EventSource{ObjectMeta: metav1.ObjectMeta{Namespace: "team-c", Name: ces.Name}}))
+
+		_, err := r.Reconcile(ctx, req)
+		assert.Error(t, err)
+		got := &eventsourcev1alpha1.ClusterEventSource{}
+		assert.NoError(t, cl.Get(ctx, req.NamespacedName, got))
+		assert.False(t, got.Status.IsReady())
+		assert.Equal(t, []string{"team-a"}, got.Status.Namespaces)
+		existing := &eventsourcev1alpha1.EventSource{}
+		assert.NoError(t, cl.Get(ctx, client.ObjectKey{Namespace: "team-c", Name: ces.Name}, existing))
+		assert.Nil(t, existing.Spec.Calendar)
+	})
+}
+
+func TestReconcileClusterSensor(t *testing.T) {
+	ctx := context.Background()
+	cs := &sensorv1alpha1.ClusterSensor{
+		ObjectMeta: metav1.ObjectMeta{Name: "standard", UID: "cs-uid"},
+		Spec: sensorv1alpha1.ClusterSensorSpec{
+			NamespaceSelector: &metav1.LabelSelector{},
+			Sensor: sensorv1alpha1.SensorSpec{
+				Dependencies: []sensorv1alpha1.EventDependency{{Name: "dep", EventSourceName: "standard", EventName: "example"}},
+			},
+		},
+	}
+	cl := fake.NewClientBuilder().
+		WithObjects(cs, fakeNamespace("team-a", nil), fakeNamespace("team-b", nil)).
+		WithStatusSubresource(&sensorv1alpha1.ClusterSensor{}).
+		Build()
+	r := NewSensorReconciler(cl, scheme.Scheme, logging.NewArgoEventsLogger())
+	_, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: types.NamespacedName{Name: cs.Name}})
+	assert.NoError(t, err)
+	list := &sensorv1alpha1.SensorList{}
+	assert.NoError(t, cl.List(ctx, list))
+	assert.Len(t, list.Items, 2)
+	assert.Equal(t, cs.Spec.Sensor, list.Items[0].Spec)
+
+	requests := AllClusterSensors(cl)(ctx, fakeNamespace("team-c", nil))
+	assert.Len(t, requests, 1)
+	assert.Equal(t, cs.Name, requests[0].Name)
+}
@@ -0,0 +1,109 @@
+package cluster
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	apicommon "github.com/argoproj/argo-events/pkg/apis/common"
+	eventsourcev1alpha1 "github.com/argoproj/argo-events/pkg/apis/eventsource/v1alpha1"
+	sensorv1alpha1 "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+)
+
+const (
+	// EventSourceControllerName is name of the ClusterEventSource controller
+	EventSourceControllerName = "cluster-eventsource-controller"
+	// SensorControllerName is name of the ClusterSensor controller
+	SensorControllerName = "cluster-sensor-controller"
+)
+
+var eventSourceKind = kind{
+	name:      eventsourcev1alpha1.SchemaGroupVersionKind.Kind,
+	newObject: func() client.Object { return &eventsourcev1alpha1.ClusterEventSource{} },
+	selectorAndStatus: func(obj client.Object) (*metav1.LabelSelector, *apicommon.ClusterResourceStatus) {
+		ces := obj.(*eventsourcev1alpha1.ClusterEventSource)
+		return ces.Spec.NamespaceSelector, &ces.Status
+	},
+	newInstance:     func() client.Object { return &eventsourcev1alpha1.EventSource{} },
+	newInstanceList: func() client.ObjectList { return &eventsourcev1alpha1.EventSourceList{} },
+	instances: func(list client.ObjectList) []client.Object {
+		var objs []client.Object
+		for i := range list.(*eventsourcev1alpha1.EventSourceList).Items {
+			objs = append(objs, &list.(*eventsourcev1alpha1.EventSourceList).Items[i])
+		}
+		return objs
+	},
+	setSpec: func(cluster client.Object, instance client.Object) bool {
+		spec := cluster.(*eventsourcev1alpha1.ClusterEventSource).Spec.EventSource
+		es := instance.(*eventsourcev1alpha1.EventSource)
+		if equality.Semantic.DeepEqual(es.Spec, spec) {
+			return false
+		}
+		es.Spec = *spec.DeepCopy()
+		return true
+	},
+}
+
+var sensorKind = kind{
+	name:      sensorv1alpha1.SchemaGroupVersionKind.Kind,
+	newObject: func() client.Object { return &sensorv1alpha1.ClusterSensor{} },
+	selectorAndStatus: func(obj client.Object) (*metav1.LabelSelector, *apicommon.ClusterResourceStatus) {
+		cs := obj.(*sensorv1alpha1.ClusterSensor)
+		return cs.Spec.NamespaceSelector, &cs.Status
+	},
+	newInstance:     func() client.Object { return &sensorv1alpha1.Sensor{} },
+	newInstanceList: func() client.ObjectList { return &sensorv1alpha1.SensorList{} },
+	instances: func(list client.ObjectList) []client.Object {
+		var objs []client.Object
+		for i := range list.(*sensorv1alpha1.SensorList).Items {
+			objs = append(objs, &list.(*sensorv1alpha1.SensorList).Items[i])
+		}
+		return objs
+	},
+	setSpec: func(cluster client.Object, instance client.Object) bool {
+		spec := cluster.(*sensorv1alpha1.ClusterSensor).Spec.Sensor
+		s := instance.(*sensorv1alpha1.Sensor)
+		if equality.Semantic.DeepEqual(s.Spec, spec) {
+			return false
+		}
+		s.Spec = *spec.DeepCopy()
+		return true
+	},
+}
+
+// NewEventSourceReconciler returns a reconciler instantiating the ClusterEventSources into the selected namespaces
+func NewEventSourceReconciler(client client.Client, scheme *runtime.Scheme, logger *zap.SugaredLogger) reconcile.Reconciler {
+	return &reconciler{client: client, scheme: scheme, kind: eventSourceKind, logger: logger}
+}
+
+// NewSensorReconciler returns a reconciler instantiating the ClusterSensors into the selected namespaces
+func NewSensorReconciler(client client.Client, scheme *runtime.Scheme, logger *zap.SugaredLogger) reconcile.Reconciler {
+	return &reconciler{client: client, scheme: scheme, kind: sensorKind, logger: logger}
+}
+
+// AllClusterEventSources returns a function enqueuing all the ClusterEventSources
+func AllClusterEventSources(cl client.Client) func(context.Context, client.Object) []reconcile.Request {
+	return allObjects(cl, func() client.ObjectList { return &eventsourcev1alpha1.ClusterEventSourceList{} }, func(list client.ObjectList) []client.Object {
+		var objs []client.Object
+		for i := range list.(*eventsourcev1alpha1.ClusterEventSourceList).Items {
+			objs = append(objs, &list.(*eventsourcev1alpha1.ClusterEventSourceList).Items[i])
+		}
+		return objs
+	})
+}
+
+// AllClusterSensors returns a function enqueuing all the ClusterSensors
+func AllClusterSensors(cl client.Client) func(context.Context, client.Object) []reconcile.Request {
+	return allObjects(cl, func() client.ObjectList { return &sensorv1alpha1.ClusterSensorList{} }, func(list client.ObjectList) []client.Object {
+		var objs []client.Object
+		for i := range list.(*sensorv1alpha1.ClusterSensorList).Items {
+			objs = append(objs, &list.(*sensorv1alpha1.ClusterSensorList).Items[i])
+		}
+		return objs
+	})
+}
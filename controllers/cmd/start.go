@@ -1,25 +1,33 @@
 package cmd
 
 import (
+	"context"
 	"fmt"
 	"os"
 
 	"go.uber.org/zap"
 	appv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
 	"k8s.io/client-go/kubernetes"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/healthz"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
 	"sigs.k8s.io/controller-runtime/pkg/manager/signals"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/source"
 
 	argoevents "github.com/argoproj/argo-events"
+	"github.com/argoproj/argo-events/common"
 	"github.com/argoproj/argo-events/common/logging"
 	"github.com/argoproj/argo-events/controllers"
+	"github.com/argoproj/argo-events/controllers/admin"
+	"github.com/argoproj/argo-events/controllers/cluster"
+	controllerscommon "github.com/argoproj/argo-events/controllers/common"
 	"github.com/argoproj/argo-events/controllers/eventbus"
 	"github.com/argoproj/argo-events/controllers/eventsource"
 	"github.com/argoproj/argo-events/controllers/sensor"
@@ -39,12 +47,73 @@ type ArgoEventsControllerOpts struct {
 	LeaderElection   bool
 	MetricsPort      int32
 	HealthPort       int32
+	// AdminPort is the port of the admin endpoints, 0 to disable them
+	AdminPort int32
+	// LogOnlyNamespaces are the namespaces where the Sensors consume the events and log the triggers without executing them
+	LogOnlyNamespaces []string
+	// ClusterName is substituted for the {{cluster-name}} template variable of the EventSource and Sensor specs
+	ClusterName string
+	// EnableEventBusController, EnableEventSourceController and EnableSensorController enable the controllers, so
+	// that they can be split across deployments, or the EventBus objects be managed externally
+	EnableEventBusController    bool
+	EnableEventSourceController bool
+	EnableSensorController      bool
+	// SecureDefaults rejects the EventBuses without TLS or client authentication, and makes the webhook endpoints
+	// without authentication generate their token
+	SecureDefaults bool
+	// GenerateServiceAccounts makes the Sensor controller generate the ServiceAccounts, Roles and RoleBindings of the
+	// Sensors with generateServiceAccount, the controller must be granted the privileges to manage them
+	GenerateServiceAccounts bool
+}
+
+// leaderElectionID returns the ID of the leader election lease, distinct for each combination of the enabled
+// controllers, so that the deployments running different controllers do not compete for the same lease.
+func (o ArgoEventsControllerOpts) leaderElectionID() string {
+	id := "argo-events-controller"
+	if o.EnableEventBusController && o.EnableEventSourceController && o.EnableSensorController {
+		return id
+	}
+	if o.EnableEventBusController {
+		id += "-eventbus"
+	}
+	if o.EnableEventSourceController {
+		id += "-eventsource"
+	}
+	if o.EnableSensorController {
+		id += "-sensor"
+	}
+	return id
+}
+
+// enabledKinds returns the kinds of resources reconciled by the enabled controllers.
+func (o ArgoEventsControllerOpts) enabledKinds() []string {
+	var kinds []string
+	if o.EnableEventBusController {
+		kinds = append(kinds, eventbusv1alpha1.SchemaGroupVersionKind.Kind)
+	}
+	if o.EnableEventSourceController {
+		kinds = append(kinds, eventsourcev1alpha1.SchemaGroupVersionKind.Kind)
+	}
+	if o.EnableSensorController {
+		kinds = append(kinds, sensorv1alpha1.SchemaGroupVersionKind.Kind)
+	}
+	return kinds
 }
 
 func Start(eventsOpts ArgoEventsControllerOpts) {
 	logger := logging.NewArgoEventsLogger().Named(eventbus.ControllerName)
+	if !eventsOpts.EnableEventBusController && !eventsOpts.EnableEventSourceController && !eventsOpts.EnableSensorController {
+		logger.Fatal("At least one of the EventBus, EventSource and Sensor controllers must be enabled")
+	}
+	configChanges := eventbus.NewConfigChanges()
 	config, err := controllers.LoadConfig(func(err error) {
 		logger.Errorw("Failed to reload global configuration file", zap.Error(err))
+	}, func(old, new *controllers.GlobalConfig) {
+		logger.Info("Reloaded global configuration file")
+		if !eventsOpts.EnableEventBusController {
+			return
+		}
+		configChanges.Add(eventbus.ConfigChange{Old: old, New: new})
 	})
 	if err != nil {
 		logger.Fatalw("Failed to load global configuration file", zap.Error(err))
@@ -63,17 +132,29 @@ func Start(eventsOpts ArgoEventsControllerOpts) {
 			BindAddress: fmt.Sprintf(":%d", eventsOpts.MetricsPort),
 		},
 		HealthProbeBindAddress: fmt.Sprintf(":%d", eventsOpts.HealthPort),
+		Client: client.Options{
+			Cache: &client.CacheOptions{
+				// Pods are only listed to evaluate the Sensor canaries, there is no need to cache them.
+				DisableFor: []client.Object{&corev1.Pod{}},
+			},
+		},
+		// Only the Secrets generated by the controllers are cached, the other Secrets are read directly from the
+		// API server.
+		NewClient: controllerscommon.NewClient,
+		Cache: cache.Options{
+			ByObject: map[client.Object]cache.ByObject{
+				&corev1.Secret{}: {Label: controllerscommon.GeneratedObjectsSelector()},
+			},
+		},
 	}
 	if eventsOpts.Namespaced {
-		opts.Cache = cache.Options{
-			DefaultNamespaces: map[string]cache.Config{
-				eventsOpts.ManagedNamespace: {},
-			},
+		opts.Cache.DefaultNamespaces = map[string]cache.Config{
+			eventsOpts.ManagedNamespace: {},
 		}
 	}
 	if eventsOpts.LeaderElection {
 		opts.LeaderElection = true
-		opts.LeaderElectionID = "argo-events-controller"
+		opts.LeaderElectionID = eventsOpts.leaderElectionID()
 	}
 	restConfig := ctrl.GetConfigOrDie()
 	mgr, err := ctrl.NewManager(restConfig, opts)
@@ -81,6 +162,20 @@ func Start(eventsOpts ArgoEventsControllerOpts) {
 		logger.Fatalw("Unable to get a controller-runtime manager", zap.Error(err))
 	}
 	kubeClient := kubernetes.NewForConfigOrDie(restConfig)
+	// The metadata of all the Secrets is watched to roll the adapters referencing them, it has its own cache as the
+	// cache of the manager only holds the generated Secrets.
+	secretsCache, err := cache.New(restConfig, cache.Options{
+		HTTPClient:        mgr.GetHTTPClient(),
+		Scheme:            mgr.GetScheme(),
+		Mapper:            mgr.GetRESTMapper(),
+		DefaultNamespaces: opts.Cache.DefaultNamespaces,
+	})
+	if err != nil {
+		logger.Fatalw("Unable to create the Secrets cache", zap.Error(err))
+	}
+	if err := mgr.Add(secretsCache); err != nil {
+		logger.Fatalw("Unable to add the Secrets cache", zap.Error(err))
+	}
 
 	// Readyness probe
 	if err := mgr.AddReadyzCheck("readiness", healthz.Ping); err != nil {
@@ -104,22 +199,156 @@ func Start(eventsOpts ArgoEventsControllerOpts) {
 		logger.Fatalw("Unable to add Sensor scheme", zap.Error(err))
 	}
 
+	// Admin endpoints
+	var adminServer *admin.Server
+	if eventsOpts.AdminPort > 0 {
+		token, defined := os.LookupEnv(common.EnvVarAdminToken)
+		if !defined || token == "" {
+			logger.Fatalf("required environment variable '%s' not defined, it is needed by the admin endpoints", common.EnvVarAdminToken)
+		}
+		adminServer = admin.NewServer(mgr.GetClient(), eventsOpts.AdminPort, token, os.Getenv(common.EnvVarAdminReadToken), eventsOpts.enabledKinds(), logger)
+		if err := mgr.Add(adminServer); err != nil {
+			logger.Fatalw("Unable to add the admin server", zap.Error(err))
+		}
+	}
+	// watchAdminRequests makes a controller process the reconciliation requests of the admin endpoints
+	watchAdminRequests := func(c controller.Controller, kind string) {
+		if adminServer == nil {
+			return
+		}
+		if err := c.Watch(adminServer.Source(kind), &handler.EnqueueRequestForObject{}); err != nil {
+			logger.Fatalw("Unable to watch the admin requests", "kind", kind, zap.Error(err))
+		}
+	}
+
+	if eventsOpts.EnableEventBusController {
+		setupEventBusController(mgr, kubeClient, config, imageName, configChanges, eventsOpts.SecureDefaults, watchAdminRequests, logger)
+	}
+	if eventsOpts.EnableEventSourceController {
+		setupEventSourceController(mgr, secretsCache, imageName, eventsOpts.ClusterName, eventsOpts.SecureDefaults, watchAdminRequests, logger)
+	}
+	if eventsOpts.EnableSensorController {
+		setupSensorController(mgr, secretsCache, kubeClient, imageName, eventsOpts, watchAdminRequests, logger)
+	}
+
+	// The cluster-scoped ClusterEventSources and ClusterSensors are only instantiated by a cluster-wide controller
+	if !eventsOpts.Namespaced {
+		if eventsOpts.EnableEventSourceController {
+			setupClusterEventSourceController(mgr, logger)
+		}
+		if eventsOpts.EnableSensorController {
+			setupClusterSensorController(mgr, logger)
+		}
+	}
+
+	logger.Infow("Starting controller manager", "version", argoevents.GetVersion())
+	if err := mgr.Start(signals.SetupSignalHandler()); err != nil {
+		logger.Fatalw("Unable to start controller manager", zap.Error(err))
+	}
+}
+
+// setupClusterEventSourceController sets up the controller instantiating the ClusterEventSources into the selected
+// namespaces.
+func setupClusterEventSourceController(mgr manager.Manager, logger *zap.SugaredLogger) {
+	clusterEventSourceController, err := controller.New(cluster.EventSourceControllerName, mgr, controller.Options{
+		Reconciler: cluster.NewEventSourceReconciler(mgr.GetClient(), mgr.GetScheme(), logger),
+	})
+	if err != nil {
+		logger.Fatalw("Unable to set up ClusterEventSource controller", zap.Error(err))
+	}
+
+	// Watch ClusterEventSource and enqueue ClusterEventSource object key
+	if err := clusterEventSourceController.Watch(source.Kind(mgr.GetCache(), &eventsourcev1alpha1.ClusterEventSource{}), &handler.EnqueueRequestForObject{},
+		predicate.Or(
+			predicate.GenerationChangedPredicate{},
+			predicate.LabelChangedPredicate{},
+		)); err != nil {
+		logger.Fatalw("Unable to watch ClusterEventSources", zap.Error(err))
+	}
+
+	// Watch EventSources and enqueue owning ClusterEventSource key
+	if err := clusterEventSourceController.Watch(source.Kind(mgr.GetCache(), &eventsourcev1alpha1.EventSource{}),
+		handler.EnqueueRequestForOwner(mgr.GetScheme(), mgr.GetRESTMapper(), &eventsourcev1alpha1.ClusterEventSource{}, handler.OnlyControllerOwner()),
+		predicate.GenerationChangedPredicate{}); err != nil {
+		logger.Fatalw("Unable to watch EventSources", zap.Error(err))
+	}
+
+	// Watch Namespaces and enqueue all the ClusterEventSources
+	if err := clusterEventSourceController.Watch(source.Kind(mgr.GetCache(), &corev1.Namespace{}),
+		handler.EnqueueRequestsFromMapFunc(cluster.AllClusterEventSources(mgr.GetClient())),
+		predicate.LabelChangedPredicate{}); err != nil {
+		logger.Fatalw("Unable to watch Namespaces", zap.Error(err))
+	}
+}
+
+// setupClusterSensorController sets up the controller instantiating the ClusterSensors into the selected namespaces.
+func setupClusterSensorController(mgr manager.Manager, logger *zap.SugaredLogger) {
+	clusterSensorController, err := controller.New(cluster.SensorControllerName, mgr, controller.Options{
+		Reconciler: cluster.NewSensorReconciler(mgr.GetClient(), mgr.GetScheme(), logger),
+	})
+	if err != nil {
+		logger.Fatalw("Unable to set up ClusterSensor controller", zap.Error(err))
+	}
+
+	// Watch ClusterSensor and enqueue ClusterSensor object key
+	if err := clusterSensorController.Watch(source.Kind(mgr.GetCache(), &sensorv1alpha1.ClusterSensor{}), &handler.EnqueueRequestForObject{},
+		predicate.Or(
+			predicate.GenerationChangedPredicate{},
+			predicate.LabelChangedPredicate{},
+		)); err != nil {
+		logger.Fatalw("Unable to watch ClusterSensors", zap.Error(err))
+	}
+
+	// Watch Sensors and enqueue owning ClusterSensor key
+	if err := clusterSensorController.Watch(source.Kind(mgr.GetCache(), &sensorv1alpha1.Sensor{}),
+		handler.EnqueueRequestForOwner(mgr.GetScheme(), mgr.GetRESTMapper(), &sensorv1alpha1.ClusterSensor{}, handler.OnlyControllerOwner()),
+		predicate.GenerationChangedPredicate{}); err != nil {
+		logger.Fatalw("Unable to watch Sensors", zap.Error(err))
+	}
+
+	// Watch Namespaces and enqueue all the ClusterSensors
+	if err := clusterSensorController.Watch(source.Kind(mgr.GetCache(), &corev1.Namespace{}),
+		handler.EnqueueRequestsFromMapFunc(cluster.AllClusterSensors(mgr.GetClient())),
+		predicate.LabelChangedPredicate{}); err != nil {
+		logger.Fatalw("Unable to watch Namespaces", zap.Error(err))
+	}
+}
+
+// setupEventBusController sets up the controller of the EventBus objects.
+func setupEventBusController(mgr manager.Manager, kubeClient kubernetes.Interface, config *controllers.GlobalConfig, imageName string, configChanges *eventbus.ConfigChanges, secureDefaults bool, watchAdminRequests func(controller.Controller, string), logger *zap.SugaredLogger) {
 	// EventBus controller
 	eventBusController, err := controller.New(eventbus.ControllerName, mgr, controller.Options{
-		Reconciler: eventbus.NewReconciler(mgr.GetClient(), kubeClient, mgr.GetScheme(), config, logger),
+		Reconciler: eventbus.NewReconciler(mgr.GetClient(), kubeClient, mgr.GetScheme(), config, imageName, mgr.GetEventRecorderFor(eventbus.ControllerName), secureDefaults, logger),
 	})
 	if err != nil {
 		logger.Fatalw("Unable to set up EventBus controller", zap.Error(err))
 	}
 
-	// Watch EventBus and enqueue EventBus object key
+	// Watch EventBus and enqueue EventBus object key, the annotations approve the plans of destructive actions
 	if err := eventBusController.Watch(source.Kind(mgr.GetCache(), &eventbusv1alpha1.EventBus{}), &handler.EnqueueRequestForObject{},
 		predicate.Or(
 			predicate.GenerationChangedPredicate{},
 			predicate.LabelChangedPredicate{},
+			predicate.AnnotationChangedPredicate{},
 		)); err != nil {
 		logger.Fatalw("Unable to watch EventBus", zap.Error(err))
 	}
+	watchAdminRequests(eventBusController, eventbusv1alpha1.SchemaGroupVersionKind.Kind)
+
+	// Watch shared EventBus and enqueue its tenant EventBus keys
+	if err := eventBusController.Watch(source.Kind(mgr.GetCache(), &eventbusv1alpha1.EventBus{}),
+		handler.EnqueueRequestsFromMapFunc(eventbus.TenantEventBuses(mgr.GetClient()))); err != nil {
+		logger.Fatalw("Unable to watch shared EventBus", zap.Error(err))
+	}
+
+	// Reconcile the EventBus objects affected by the reloads of the global configuration
+	configReloader := eventbus.NewConfigReloader(mgr.GetClient(), configChanges, logger)
+	if err := mgr.Add(configReloader); err != nil {
+		logger.Fatalw("Unable to add the configuration reloader", zap.Error(err))
+	}
+	if err := eventBusController.Watch(configReloader.Source(), &handler.EnqueueRequestForObject{}); err != nil {
+		logger.Fatalw("Unable to watch the configuration reloads", zap.Error(err))
+	}
 
 	// Watch ConfigMaps and enqueue owning EventBus key
 	if err := eventBusController.Watch(source.Kind(mgr.GetCache(), &corev1.ConfigMap{}),
@@ -141,10 +370,13 @@ func Start(eventsOpts ArgoEventsControllerOpts) {
 		predicate.GenerationChangedPredicate{}); err != nil {
 		logger.Fatalw("Unable to watch Services", zap.Error(err))
 	}
+}
 
+// setupEventSourceController sets up the controller of the EventSource objects.
+func setupEventSourceController(mgr manager.Manager, secretsCache cache.Cache, imageName, clusterName string, secureDefaults bool, watchAdminRequests func(controller.Controller, string), logger *zap.SugaredLogger) {
 	// EventSource controller
 	eventSourceController, err := controller.New(eventsource.ControllerName, mgr, controller.Options{
-		Reconciler: eventsource.NewReconciler(mgr.GetClient(), mgr.GetScheme(), imageName, logger),
+		Reconciler: eventsource.NewReconciler(mgr.GetClient(), mgr.GetScheme(), imageName, clusterName, secureDefaults, logger),
 	})
 	if err != nil {
 		logger.Fatalw("Unable to set up EventSource controller", zap.Error(err))
@@ -158,6 +390,7 @@ func Start(eventsOpts ArgoEventsControllerOpts) {
 		)); err != nil {
 		logger.Fatalw("Unable to watch EventSources", zap.Error(err))
 	}
+	watchAdminRequests(eventSourceController, eventsourcev1alpha1.SchemaGroupVersionKind.Kind)
 
 	// Watch Deployments and enqueue owning EventSource key
 	if err := eventSourceController.Watch(source.Kind(mgr.GetCache(), &appv1.Deployment{}),
@@ -173,9 +406,29 @@ func Start(eventsOpts ArgoEventsControllerOpts) {
 		logger.Fatalw("Unable to watch Services", zap.Error(err))
 	}
 
+	// Watch ConfigMaps and enqueue EventSources including them
+	if err := eventSourceController.Watch(source.Kind(mgr.GetCache(), &corev1.ConfigMap{}),
+		handler.EnqueueRequestsFromMapFunc(eventsource.IncludingEventSources(mgr.GetClient()))); err != nil {
+		logger.Fatalw("Unable to watch ConfigMaps", zap.Error(err))
+	}
+
+	// Watch the Secrets and ConfigMaps referenced by EventSources, and enqueue them
+	if err := controllerscommon.IndexReferences(context.Background(), mgr.GetFieldIndexer(), &eventsourcev1alpha1.EventSource{}); err != nil {
+		logger.Fatalw("Unable to index the references of EventSources", zap.Error(err))
+	}
+	for _, src := range []source.Source{source.Kind(secretsCache, controllerscommon.SecretMetadata()), source.Kind(mgr.GetCache(), &corev1.ConfigMap{})} {
+		if err := eventSourceController.Watch(src,
+			handler.EnqueueRequestsFromMapFunc(controllerscommon.ReferencingObjects(mgr.GetClient(), func() client.ObjectList { return &eventsourcev1alpha1.EventSourceList{} }))); err != nil {
+			logger.Fatalw("Unable to watch the objects referenced by EventSources", zap.Error(err))
+		}
+	}
+}
+
+// setupSensorController sets up the controller of the Sensor objects.
+func setupSensorController(mgr manager.Manager, secretsCache cache.Cache, kubeClient kubernetes.Interface, imageName string, eventsOpts ArgoEventsControllerOpts, watchAdminRequests func(controller.Controller, string), logger *zap.SugaredLogger) {
 	// Sensor controller
 	sensorController, err := controller.New(sensor.ControllerName, mgr, controller.Options{
-		Reconciler: sensor.NewReconciler(mgr.GetClient(), mgr.GetScheme(), imageName, logger),
+		Reconciler: sensor.NewReconciler(mgr.GetClient(), mgr.GetScheme(), imageName, eventsOpts.ClusterName, eventsOpts.LogOnlyNamespaces, eventsOpts.GenerateServiceAccounts, sensor.NewDiscoveryResourceSchemas(kubeClient.Discovery(), logger), logger),
 	})
 	if err != nil {
 		logger.Fatalw("Unable to set up Sensor controller", zap.Error(err))
@@ -189,6 +442,30 @@ func Start(eventsOpts ArgoEventsControllerOpts) {
 		)); err != nil {
 		logger.Fatalw("Unable to watch Sensors", zap.Error(err))
 	}
+	watchAdminRequests(sensorController, sensorv1alpha1.SchemaGroupVersionKind.Kind)
+
+	// Watch EventSources and enqueue the Sensors depending on them
+	if err := sensorController.Watch(source.Kind(mgr.GetCache(), &eventsourcev1alpha1.EventSource{}),
+		handler.EnqueueRequestsFromMapFunc(sensor.DependentSensors(mgr.GetClient()))); err != nil {
+		logger.Fatalw("Unable to watch EventSources", zap.Error(err))
+	}
+
+	// Watch EventBuses and enqueue the Sensors using them
+	if err := sensorController.Watch(source.Kind(mgr.GetCache(), &eventbusv1alpha1.EventBus{}),
+		handler.EnqueueRequestsFromMapFunc(sensor.DependentSensors(mgr.GetClient()))); err != nil {
+		logger.Fatalw("Unable to watch EventBuses", zap.Error(err))
+	}
+
+	// Watch the Secrets and ConfigMaps referenced by Sensors, and enqueue them
+	if err := controllerscommon.IndexReferences(context.Background(), mgr.GetFieldIndexer(), &sensorv1alpha1.Sensor{}); err != nil {
+		logger.Fatalw("Unable to index the references of Sensors", zap.Error(err))
+	}
+	for _, src := range []source.Source{source.Kind(secretsCache, controllerscommon.SecretMetadata()), source.Kind(mgr.GetCache(), &corev1.ConfigMap{})} {
+		if err := sensorController.Watch(src,
+			handler.EnqueueRequestsFromMapFunc(controllerscommon.ReferencingObjects(mgr.GetClient(), func() client.ObjectList { return &sensorv1alpha1.SensorList{} }))); err != nil {
+			logger.Fatalw("Unable to watch the objects referenced by Sensors", zap.Error(err))
+		}
+	}
 
 	// Watch Deployments and enqueue owning Sensor key
 	if err := sensorController.Watch(source.Kind(mgr.GetCache(), &appv1.Deployment{}),
@@ -197,8 +474,14 @@ func Start(eventsOpts ArgoEventsControllerOpts) {
 		logger.Fatalw("Unable to watch Deployments", zap.Error(err))
 	}
 
-	logger.Infow("Starting controller manager", "version", argoevents.GetVersion())
-	if err := mgr.Start(signals.SetupSignalHandler()); err != nil {
-		logger.Fatalw("Unable to start controller manager", zap.Error(err))
+	if !eventsOpts.GenerateServiceAccounts {
+		return
+	}
+	// Watch the generated ServiceAccounts, Roles and RoleBindings, and enqueue owning Sensor key to restore them
+	for _, obj := range []client.Object{&corev1.ServiceAccount{}, &rbacv1.Role{}, &rbacv1.RoleBinding{}} {
+		if err := sensorController.Watch(source.Kind(mgr.GetCache(), obj),
+			handler.EnqueueRequestForOwner(mgr.GetScheme(), mgr.GetRESTMapper(), &sensorv1alpha1.Sensor{}, handler.OnlyControllerOwner())); err != nil {
+			logger.Fatalw("Unable to watch the generated RBAC objects", zap.Error(err))
+		}
 	}
 }
@@ -0,0 +1,52 @@
+package common
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/selection"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// ControllerLabelValues are the values of the "controller" label of the resources generated by the controllers.
+var ControllerLabelValues = []string{"eventbus-controller", "eventsource-controller", "sensor-controller"}
+
+// GeneratedObjectsSelector selects the resources generated by the controllers.
+func GeneratedObjectsSelector() labels.Selector {
+	requirement, err := labels.NewRequirement("controller", selection.In, ControllerLabelValues)
+	if err != nil {
+		panic(err)
+	}
+	return labels.NewSelector().Add(*requirement)
+}
+
+// NewClient returns the client of the manager, only the Secrets generated by the controllers are cached, the other
+// Secrets are read from the API server when they are missing from the cache.
+func NewClient(config *rest.Config, options client.Options) (client.Client, error) {
+	apiReader, err := client.New(config, client.Options{HTTPClient: options.HTTPClient, Scheme: options.Scheme, Mapper: options.Mapper})
+	if err != nil {
+		return nil, err
+	}
+	cl, err := client.New(config, options)
+	if err != nil {
+		return nil, err
+	}
+	return &secretsClient{Client: cl, apiReader: apiReader}, nil
+}
+
+// secretsClient reads the Secrets missing from the cache from the API server.
+type secretsClient struct {
+	client.Client
+	apiReader client.Reader
+}
+
+func (c *secretsClient) Get(ctx context.Context, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+	err := c.Client.Get(ctx, key, obj, opts...)
+	if _, ok := obj.(*corev1.Secret); ok && apierrors.IsNotFound(err) {
+		return c.apiReader.Get(ctx, key, obj, opts...)
+	}
+	return err
+}
@@ -0,0 +1,33 @@
+package common
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestSecretsClient(t *testing.T) {
+	secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: "test-ns", Name: "user-secret"}}
+	configMap := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: "test-ns", Name: "user-config"}}
+	apiReader := fake.NewClientBuilder().WithObjects(secret, configMap).Build()
+	cl := &secretsClient{Client: fake.NewClientBuilder().Build(), apiReader: apiReader}
+
+	assert.NoError(t, cl.Get(context.TODO(), client.ObjectKeyFromObject(secret), &corev1.Secret{}))
+	// Only the Secrets are read from the API server
+	err := cl.Get(context.TODO(), client.ObjectKeyFromObject(configMap), &corev1.ConfigMap{})
+	assert.True(t, apierrors.IsNotFound(err))
+}
+
+func TestGeneratedObjectsSelector(t *testing.T) {
+	selector := GeneratedObjectsSelector()
+	assert.True(t, selector.Matches(labels.Set{"controller": "eventbus-controller"}))
+	assert.False(t, selector.Matches(labels.Set{"controller": "other"}))
+	assert.False(t, selector.Matches(labels.Set{}))
+}
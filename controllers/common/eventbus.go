@@ -0,0 +1,97 @@
+package common
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	"github.com/argoproj/argo-events/common"
+	apicommon "github.com/argoproj/argo-events/pkg/apis/common"
+	eventbusv1alpha1 "github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1"
+)
+
+// remoteEventBusConnection is the content of a remote EventBus connection secret.
+type remoteEventBusConnection struct {
+	URL          string `json:"url"`
+	StreamConfig string `json:"streamConfig,omitempty"`
+	Username     string `json:"username,omitempty"`
+	Password     string `json:"password,omitempty"`
+	Token        string `json:"token,omitempty"`
+}
+
+// GetRemoteEventBus builds an EventBus object from the connection secret of a remote EventBus,
+// the returned object is not persisted, it only carries the resolved bus config.
+func GetRemoteEventBus(ctx context.Context, cl client.Client, namespace string, remote *apicommon.RemoteEventBus) (*eventbusv1alpha1.EventBus, error) {
+	if remote == nil || remote.ConnectionSecret == nil {
+		return nil, fmt.Errorf("remote eventbus connection secret is not specified")
+	}
+	secret := &corev1.Secret{}
+	if err := cl.Get(ctx, types.NamespacedName{Namespace: namespace, Name: remote.ConnectionSecret.Name}, secret); err != nil {
+		return nil, fmt.Errorf("failed to get remote eventbus connection secret %q, %w", remote.ConnectionSecret.Name, err)
+	}
+	data, ok := secret.Data[remote.ConnectionSecret.Key]
+	if !ok {
+		return nil, fmt.Errorf("key %q not found in remote eventbus connection secret %q", remote.ConnectionSecret.Key, remote.ConnectionSecret.Name)
+	}
+	conn := &remoteEventBusConnection{}
+	if err := yaml.Unmarshal(data, conn); err != nil {
+		return nil, fmt.Errorf("failed to parse remote eventbus connection secret %q, %w", remote.ConnectionSecret.Name, err)
+	}
+	if conn.URL == "" {
+		return nil, fmt.Errorf("url is missing in remote eventbus connection secret %q", remote.ConnectionSecret.Name)
+	}
+	jsConfig := &eventbusv1alpha1.JetStreamConfig{
+		URL:          conn.URL,
+		StreamConfig: conn.StreamConfig,
+		TLS:          remote.TLS.DeepCopy(),
+	}
+	if conn.Token != "" || conn.Username != "" {
+		// The whole secret key is mounted as the auth file, the dialer picks up the credentials from it.
+		jsConfig.AccessSecret = remote.ConnectionSecret.DeepCopy()
+	}
+	eventBus := &eventbusv1alpha1.EventBus{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: namespace,
+			Name:      remote.ConnectionSecret.Name,
+		},
+		Status: eventbusv1alpha1.EventBusStatus{
+			Config: eventbusv1alpha1.BusConfig{
+				JetStream: jsConfig,
+			},
+		},
+	}
+	eventBus.Status.Ordering = eventBus.Status.Config.GetOrdering()
+	eventBus.Status.InitConditions()
+	eventBus.Status.MarkDeployed("Remote", "Remote EventBus")
+	eventBus.Status.MarkConfigured()
+	return eventBus, nil
+}
+
+// SPIFFEVolume returns the CSI volume mounting the SPIFFE X.509 SVID of the pods, and its mount, if the clients
+// of the EventBus authenticate with their workload identity.
+func SPIFFEVolume(busConfig eventbusv1alpha1.BusConfig) (*corev1.Volume, *corev1.VolumeMount) {
+	if busConfig.JetStream == nil || busConfig.JetStream.SPIFFE == nil {
+		return nil, nil
+	}
+	readOnly := true
+	volume := &corev1.Volume{
+		Name: "spiffe",
+		VolumeSource: corev1.VolumeSource{
+			CSI: &corev1.CSIVolumeSource{
+				Driver:   busConfig.JetStream.SPIFFE.GetCSIDriver(),
+				ReadOnly: &readOnly,
+			},
+		},
+	}
+	mount := &corev1.VolumeMount{
+		Name:      "spiffe",
+		MountPath: common.EventBusSPIFFEMountPath,
+		ReadOnly:  true,
+	}
+	return volume, mount
+}
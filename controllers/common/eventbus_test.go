@@ -0,0 +1,69 @@
+package common
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	apicommon "github.com/argoproj/argo-events/pkg/apis/common"
+)
+
+func TestGetRemoteEventBus(t *testing.T) {
+	remote := &apicommon.RemoteEventBus{
+		ConnectionSecret: &corev1.SecretKeySelector{
+			LocalObjectReference: corev1.LocalObjectReference{Name: "remote-bus"},
+			Key:                  "config",
+		},
+	}
+	newSecret := func(config string) *corev1.Secret {
+		return &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "test-ns", Name: "remote-bus"},
+			Data:       map[string][]byte{"config": []byte(config)},
+		}
+	}
+
+	t.Run("secret not found", func(t *testing.T) {
+		cl := fake.NewClientBuilder().Build()
+		_, err := GetRemoteEventBus(context.TODO(), cl, "test-ns", remote)
+		assert.Error(t, err)
+	})
+
+	t.Run("url missing", func(t *testing.T) {
+		cl := fake.NewClientBuilder().WithObjects(newSecret("token: abc")).Build()
+		_, err := GetRemoteEventBus(context.TODO(), cl, "test-ns", remote)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "url is missing")
+	})
+
+	t.Run("without credentials", func(t *testing.T) {
+		cl := fake.NewClientBuilder().WithObjects(newSecret("url: nats://central:4222")).Build()
+		b, err := GetRemoteEventBus(context.TODO(), cl, "test-ns", remote)
+		assert.NoError(t, err)
+		assert.True(t, b.Status.IsReady())
+		assert.NotNil(t, b.Status.Config.JetStream)
+		assert.Equal(t, "nats://central:4222", b.Status.Config.JetStream.URL)
+		assert.Nil(t, b.Status.Config.JetStream.AccessSecret)
+	})
+
+	t.Run("with credentials", func(t *testing.T) {
+		cl := fake.NewClientBuilder().WithObjects(newSecret("url: nats://central:4222\nusername: u\npassword: p\nstreamConfig: |\n  maxAge: 24h")).Build()
+		b, err := GetRemoteEventBus(context.TODO(), cl, "test-ns", remote)
+		assert.NoError(t, err)
+		assert.Equal(t, "maxAge: 24h", b.Status.Config.JetStream.StreamConfig)
+		assert.Equal(t, remote.ConnectionSecret, b.Status.Config.JetStream.AccessSecret)
+	})
+	t.Run("with tls", func(t *testing.T) {
+		cl := fake.NewClientBuilder().WithObjects(newSecret("url: tls://central:4222")).Build()
+		withTLS := remote.DeepCopy()
+		withTLS.TLS = &apicommon.TLSConfig{
+			CACertSecret: &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "central-ca"}, Key: "ca.crt"},
+		}
+		b, err := GetRemoteEventBus(context.TODO(), cl, "test-ns", withTLS)
+		assert.NoError(t, err)
+		assert.Equal(t, withTLS.TLS, b.Status.Config.JetStream.TLS)
+	})
+}
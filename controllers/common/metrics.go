@@ -0,0 +1,196 @@
+package common
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/argoproj/argo-events/common"
+	"github.com/argoproj/argo-events/metrics"
+	apicommon "github.com/argoproj/argo-events/pkg/apis/common"
+)
+
+// MetricsPortName is the name of the container port serving the metrics endpoint.
+const MetricsPortName = "metrics"
+
+// ServiceMonitorGVK is the GroupVersionKind of the Prometheus Operator ServiceMonitor.
+var ServiceMonitorGVK = schema.GroupVersionKind{Group: "monitoring.coreos.com", Version: "v1", Kind: "ServiceMonitor"}
+
+// MetricsMonitoringArgs are the args needed to monitor the metrics endpoint of the pods of an EventSource or a Sensor.
+type MetricsMonitoringArgs struct {
+	// Owner is the EventSource or the Sensor.
+	Owner    metav1.Object
+	OwnerGVK schema.GroupVersionKind
+	// Name is the name of both the metrics Service and the ServiceMonitor.
+	Name string
+	// Labels are the labels of the pods.
+	Labels map[string]string
+	Port   int32
+	Config *apicommon.MetricsConfig
+}
+
+// ValidateMetricsConfig validates the metrics configuration of an EventSource or a Sensor.
+func ValidateMetricsConfig(config *apicommon.MetricsConfig) error {
+	if config == nil || config.LabelLimits == nil {
+		return nil
+	}
+	limits := config.LabelLimits
+	if limits.MaxValues <= 0 {
+		return fmt.Errorf("metrics labelLimits maxValues must be greater than 0")
+	}
+	switch limits.GetPolicy() {
+	case apicommon.MetricsLabelDrop, apicommon.MetricsLabelHash:
+	default:
+		return fmt.Errorf("invalid metrics labelLimits policy %q", limits.Policy)
+	}
+	if limits.HashBuckets < 0 {
+		return fmt.Errorf("metrics labelLimits hashBuckets must not be negative")
+	}
+	for _, label := range limits.Labels {
+		if !metrics.IsLimitedLabel(label) {
+			return fmt.Errorf("metrics label %q can not be limited", label)
+		}
+	}
+	return nil
+}
+
+// ReconcileMetricsMonitoring makes sure a Service exposing the metrics port and a ServiceMonitor scraping it
+// exist when a ServiceMonitor is configured, and deletes them otherwise.
+func ReconcileMetricsMonitoring(ctx context.Context, cl client.Client, args *MetricsMonitoringArgs) error {
+	existingSvc := &corev1.Service{}
+	if err := cl.Get(ctx, types.NamespacedName{Namespace: args.Owner.GetNamespace(), Name: args.Name}, existingSvc); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return fmt.Errorf("failed to get metrics service, %w", err)
+		}
+		existingSvc = nil
+	} else if !metav1.IsControlledBy(existingSvc, args.Owner) {
+		return fmt.Errorf("service %s already exists and is not controlled by %s", args.Name, args.Owner.GetName())
+	}
+
+	if args.Config == nil || args.Config.ServiceMonitor == nil {
+		// The metrics Service only exists if a ServiceMonitor has been configured before.
+		if existingSvc == nil {
+			return nil
+		}
+		sm := &unstructured.Unstructured{}
+		sm.SetGroupVersionKind(ServiceMonitorGVK)
+		sm.SetNamespace(args.Owner.GetNamespace())
+		sm.SetName(args.Name)
+		if err := cl.Delete(ctx, sm); err != nil && !apierrors.IsNotFound(err) && !meta.IsNoMatchError(err) {
+			return fmt.Errorf("failed to delete servicemonitor, %w", err)
+		}
+		if err := cl.Delete(ctx, existingSvc); err != nil && !apierrors.IsNotFound(err) {
+			return fmt.Errorf("failed to delete metrics service, %w", err)
+		}
+		return nil
+	}
+
+	svc, err := buildMetricsService(args)
+	if err != nil {
+		return err
+	}
+	if existingSvc == nil {
+		if err := cl.Create(ctx, svc); err != nil {
+			return fmt.Errorf("failed to create metrics service, %w", err)
+		}
+	} else if existingSvc.Annotations[common.AnnotationResourceSpecHash] != svc.Annotations[common.AnnotationResourceSpecHash] {
+		existingSvc.Spec.Ports = svc.Spec.Ports
+		existingSvc.Spec.Selector = svc.Spec.Selector
+		existingSvc.SetLabels(svc.Labels)
+		existingSvc.SetAnnotations(svc.Annotations)
+		if err := cl.Update(ctx, existingSvc); err != nil {
+			return fmt.Errorf("failed to update metrics service, %w", err)
+		}
+	}
+
+	sm, err := buildServiceMonitor(args)
+	if err != nil {
+		return err
+	}
+	existingSM := &unstructured.Unstructured{}
+	existingSM.SetGroupVersionKind(ServiceMonitorGVK)
+	if err := cl.Get(ctx, types.NamespacedName{Namespace: sm.GetNamespace(), Name: sm.GetName()}, existingSM); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return fmt.Errorf("failed to get servicemonitor, %w", err)
+		}
+		if err := cl.Create(ctx, sm); err != nil {
+			return fmt.Errorf("failed to create servicemonitor, %w", err)
+		}
+		return nil
+	}
+	if !metav1.IsControlledBy(existingSM, args.Owner) {
+		return fmt.Errorf("servicemonitor %s already exists and is not controlled by %s", args.Name, args.Owner.GetName())
+	}
+	if existingSM.GetAnnotations()[common.AnnotationResourceSpecHash] != sm.GetAnnotations()[common.AnnotationResourceSpecHash] {
+		existingSM.Object["spec"] = sm.Object["spec"]
+		existingSM.SetLabels(sm.GetLabels())
+		existingSM.SetAnnotations(sm.GetAnnotations())
+		if err := cl.Update(ctx, existingSM); err != nil {
+			return fmt.Errorf("failed to update servicemonitor, %w", err)
+		}
+	}
+	return nil
+}
+
+func buildMetricsService(args *MetricsMonitoringArgs) (*corev1.Service, error) {
+	svc := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      args.Name,
+			Namespace: args.Owner.GetNamespace(),
+			Labels:    args.Labels,
+		},
+		Spec: corev1.ServiceSpec{
+			Ports: []corev1.ServicePort{
+				{Name: MetricsPortName, Port: args.Port, TargetPort: intstr.FromString(MetricsPortName)},
+			},
+			Type:     corev1.ServiceTypeClusterIP,
+			Selector: args.Labels,
+		},
+	}
+	if err := SetObjectMeta(args.Owner, svc, args.OwnerGVK); err != nil {
+		return nil, err
+	}
+	return svc, nil
+}
+
+func buildServiceMonitor(args *MetricsMonitoringArgs) (*unstructured.Unstructured, error) {
+	endpoint := map[string]interface{}{
+		"port": MetricsPortName,
+		"path": "/metrics",
+	}
+	if args.Config.ServiceMonitor.Interval != "" {
+		endpoint["interval"] = args.Config.ServiceMonitor.Interval
+	}
+	matchLabels := map[string]interface{}{}
+	for k, v := range args.Labels {
+		matchLabels[k] = v
+	}
+	sm := &unstructured.Unstructured{Object: map[string]interface{}{
+		"spec": map[string]interface{}{
+			"selector": map[string]interface{}{
+				"matchLabels": matchLabels,
+			},
+			"namespaceSelector": map[string]interface{}{
+				"matchNames": []interface{}{args.Owner.GetNamespace()},
+			},
+			"endpoints": []interface{}{endpoint},
+		},
+	}}
+	sm.SetGroupVersionKind(ServiceMonitorGVK)
+	sm.SetName(args.Name)
+	sm.SetNamespace(args.Owner.GetNamespace())
+	sm.SetLabels(args.Config.ServiceMonitor.Labels)
+	if err := SetObjectMeta(args.Owner, sm, args.OwnerGVK); err != nil {
+		return nil, err
+	}
+	return sm, nil
+}
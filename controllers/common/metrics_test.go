@@ -0,0 +1,108 @@
+package common
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	appv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	apicommon "github.com/argoproj/argo-events/pkg/apis/common"
+)
+
+func TestReconcileMetricsMonitoring(t *testing.T) {
+	ctx := context.TODO()
+	owner := &appv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "fake-deployment",
+			Namespace: "fake-namespace",
+			UID:       "fake-uid",
+		},
+	}
+	args := &MetricsMonitoringArgs{
+		Owner:    owner,
+		OwnerGVK: appv1.SchemeGroupVersion.WithKind("Deployment"),
+		Name:     "fake-metrics",
+		Labels:   map[string]string{"app": "fake"},
+		Port:     7777,
+		Config: &apicommon.MetricsConfig{
+			ServiceMonitor: &apicommon.ServiceMonitorConfig{
+				Labels:   map[string]string{"release": "prometheus"},
+				Interval: "30s",
+			},
+		},
+	}
+	key := types.NamespacedName{Namespace: "fake-namespace", Name: "fake-metrics"}
+
+	t.Run("test not configured", func(t *testing.T) {
+		cl := fake.NewClientBuilder().Build()
+		err := ReconcileMetricsMonitoring(ctx, cl, &MetricsMonitoringArgs{Owner: owner, Name: "fake-metrics"})
+		assert.NoError(t, err)
+		err = cl.Get(ctx, key, &corev1.Service{})
+		assert.True(t, apierrors.IsNotFound(err))
+	})
+
+	t.Run("test create, update and delete", func(t *testing.T) {
+		cl := fake.NewClientBuilder().Build()
+		err := ReconcileMetricsMonitoring(ctx, cl, args)
+		assert.NoError(t, err)
+		svc := &corev1.Service{}
+		err = cl.Get(ctx, key, svc)
+		assert.NoError(t, err)
+		assert.Equal(t, MetricsPortName, svc.Spec.Ports[0].Name)
+		assert.Equal(t, int32(7777), svc.Spec.Ports[0].Port)
+		assert.Equal(t, args.Labels, svc.Spec.Selector)
+		assert.True(t, metav1.IsControlledBy(svc, owner))
+
+		sm := &unstructured.Unstructured{}
+		sm.SetGroupVersionKind(ServiceMonitorGVK)
+		err = cl.Get(ctx, key, sm)
+		assert.NoError(t, err)
+		assert.Equal(t, "prometheus", sm.GetLabels()["release"])
+		endpoints, _, _ := unstructured.NestedSlice(sm.Object, "spec", "endpoints")
+		assert.Len(t, endpoints, 1)
+		assert.Equal(t, "30s", endpoints[0].(map[string]interface{})["interval"])
+		matchLabels, _, _ := unstructured.NestedStringMap(sm.Object, "spec", "selector", "matchLabels")
+		assert.Equal(t, args.Labels, matchLabels)
+
+		args.Config.ServiceMonitor.Interval = "1m"
+		err = ReconcileMetricsMonitoring(ctx, cl, args)
+		assert.NoError(t, err)
+		err = cl.Get(ctx, key, sm)
+		assert.NoError(t, err)
+		endpoints, _, _ = unstructured.NestedSlice(sm.Object, "spec", "endpoints")
+		assert.Equal(t, "1m", endpoints[0].(map[string]interface{})["interval"])
+
+		err = ReconcileMetricsMonitoring(ctx, cl, &MetricsMonitoringArgs{Owner: owner, Name: "fake-metrics"})
+		assert.NoError(t, err)
+		err = cl.Get(ctx, key, &corev1.Service{})
+		assert.True(t, apierrors.IsNotFound(err))
+		err = cl.Get(ctx, key, sm)
+		assert.True(t, apierrors.IsNotFound(err))
+	})
+
+	t.Run("test service not controlled by the owner", func(t *testing.T) {
+		cl := fake.NewClientBuilder().WithObjects(&corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "fake-namespace", Name: "fake-metrics"},
+		}).Build()
+		err := ReconcileMetricsMonitoring(ctx, cl, args)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "not controlled by")
+	})
+}
+
+func TestValidateMetricsConfig(t *testing.T) {
+	assert.NoError(t, ValidateMetricsConfig(nil))
+	assert.NoError(t, ValidateMetricsConfig(&apicommon.MetricsConfig{}))
+	assert.NoError(t, ValidateMetricsConfig(&apicommon.MetricsConfig{LabelLimits: &apicommon.MetricsLabelLimits{MaxValues: 10, Policy: apicommon.MetricsLabelHash, Labels: []string{"trigger_name"}}}))
+	assert.Error(t, ValidateMetricsConfig(&apicommon.MetricsConfig{LabelLimits: &apicommon.MetricsLabelLimits{}}))
+	assert.Error(t, ValidateMetricsConfig(&apicommon.MetricsConfig{LabelLimits: &apicommon.MetricsLabelLimits{MaxValues: 10, Policy: "Sample"}}))
+	assert.Error(t, ValidateMetricsConfig(&apicommon.MetricsConfig{LabelLimits: &apicommon.MetricsLabelLimits{MaxValues: 10, HashBuckets: -1}}))
+	assert.Error(t, ValidateMetricsConfig(&apicommon.MetricsConfig{LabelLimits: &apicommon.MetricsLabelLimits{MaxValues: 10, Labels: []string{"sensor_name"}}}))
+}
@@ -0,0 +1,120 @@
+package common
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/argoproj/argo-events/common"
+)
+
+const (
+	// IndexReferencedSecrets is the field index of the objects by the Secrets referenced by their spec
+	IndexReferencedSecrets = "spec.referencedSecrets"
+	// IndexReferencedConfigMaps is the field index of the objects by the ConfigMaps referenced by their spec
+	IndexReferencedConfigMaps = "spec.referencedConfigMaps"
+)
+
+// SecretMetadata returns an object to watch the metadata of the Secrets, without caching their data.
+func SecretMetadata() *metav1.PartialObjectMetadata {
+	obj := &metav1.PartialObjectMetadata{}
+	obj.SetGroupVersionKind(corev1.SchemeGroupVersion.WithKind("Secret"))
+	return obj
+}
+
+// IndexReferences indexes the objects of the type of obj by the Secrets and ConfigMaps referenced by their spec.
+func IndexReferences(ctx context.Context, indexer client.FieldIndexer, obj client.Object) error {
+	if err := indexer.IndexField(ctx, obj, IndexReferencedSecrets, func(o client.Object) []string {
+		secrets, _ := common.ReferencedObjects(o)
+		return secrets
+	}); err != nil {
+		return err
+	}
+	return indexer.IndexField(ctx, obj, IndexReferencedConfigMaps, func(o client.Object) []string {
+		_, configMaps := common.ReferencedObjects(o)
+		return configMaps
+	})
+}
+
+// ReferencingObjects returns a function mapping a Secret or a ConfigMap to the reconcile requests of the objects
+// of the list type referencing it, using the indexes of IndexReferences.
+func ReferencingObjects(cl client.Client, newList func() client.ObjectList) func(context.Context, client.Object) []reconcile.Request {
+	return func(ctx context.Context, obj client.Object) []reconcile.Request {
+		index := IndexReferencedSecrets
+		if _, ok := obj.(*corev1.ConfigMap); ok {
+			index = IndexReferencedConfigMaps
+		}
+		list := newList()
+		if err := cl.List(ctx, list, client.InNamespace(obj.GetNamespace()), client.MatchingFields{index: obj.GetName()}); err != nil {
+			return nil
+		}
+		items, err := meta.ExtractList(list)
+		if err != nil {
+			return nil
+		}
+		var requests []reconcile.Request
+		for _, item := range items {
+			if o, ok := item.(client.Object); ok {
+				requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Namespace: o.GetNamespace(), Name: o.GetName()}})
+			}
+		}
+		return requests
+	}
+}
+
+// ReferencesHash returns the hash of the versions of the Secrets and ConfigMaps referenced by obj in the namespace,
+// empty if there is none. The missing objects are hashed too, so that their creation changes the hash.
+func ReferencesHash(ctx context.Context, cl client.Client, namespace string, obj interface{}) (string, error) {
+	secrets, configMaps := common.ReferencedObjects(obj)
+	if len(secrets) == 0 && len(configMaps) == 0 {
+		return "", nil
+	}
+	versions := []string{}
+	for _, name := range secrets {
+		secret := SecretMetadata()
+		version, err := resourceVersion(ctx, cl, namespace, name, secret)
+		if err != nil {
+			return "", fmt.Errorf("failed to get secret %s, %w", name, err)
+		}
+		versions = append(versions, "secret/"+name+"="+version)
+	}
+	for _, name := range configMaps {
+		version, err := resourceVersion(ctx, cl, namespace, name, &corev1.ConfigMap{})
+		if err != nil {
+			return "", fmt.Errorf("failed to get configmap %s, %w", name, err)
+		}
+		versions = append(versions, "configmap/"+name+"="+version)
+	}
+	return common.MustHash(versions), nil
+}
+
+func resourceVersion(ctx context.Context, cl client.Client, namespace, name string, obj client.Object) (string, error) {
+	if err := cl.Get(ctx, types.NamespacedName{Namespace: namespace, Name: name}, obj); err != nil {
+		if apierrors.IsNotFound(err) {
+			return "", nil
+		}
+		return "", err
+	}
+	return obj.GetResourceVersion(), nil
+}
+
+// SetReferencesHash sets the references hash annotation of the pod template, so that the pods are rolled when the
+// referenced objects change.
+func SetReferencesHash(template *corev1.PodTemplateSpec, hash string) {
+	if hash == "" {
+		return
+	}
+	annotations := map[string]string{}
+	for k, v := range template.Annotations {
+		annotations[k] = v
+	}
+	annotations[common.AnnotationReferencesHash] = hash
+	template.Annotations = annotations
+}
@@ -0,0 +1,116 @@
+package common
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/argoproj/argo-events/common"
+	apicommon "github.com/argoproj/argo-events/pkg/apis/common"
+	sensorv1alpha1 "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+)
+
+var testBasicAuth = apicommon.BasicAuth{
+	Password: &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "auth"}, Key: "password"},
+}
+
+func TestReferencesHash(t *testing.T) {
+	ctx := context.TODO()
+	cl := fake.NewClientBuilder().Build()
+	spec := &sensorv1alpha1.SensorSpec{
+		Triggers: []sensorv1alpha1.Trigger{{
+			Template: &sensorv1alpha1.TriggerTemplate{
+				Name: "http",
+				HTTP: &sensorv1alpha1.HTTPTrigger{
+					BasicAuth: &testBasicAuth,
+				},
+			},
+		}},
+	}
+	hash, err := ReferencesHash(ctx, cl, "test-ns", &sensorv1alpha1.SensorSpec{})
+	assert.NoError(t, err)
+	assert.Empty(t, hash)
+
+	missing, err := ReferencesHash(ctx, cl, "test-ns", spec)
+	assert.NoError(t, err)
+	assert.NotEmpty(t, missing)
+
+	secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: "test-ns", Name: "auth"}, Data: map[string][]byte{"password": []byte("a")}}
+	assert.NoError(t, cl.Create(ctx, secret))
+	created, err := ReferencesHash(ctx, cl, "test-ns", spec)
+	assert.NoError(t, err)
+	assert.NotEqual(t, missing, created)
+
+	secret.Data["password"] = []byte("b")
+	assert.NoError(t, cl.Update(ctx, secret))
+	updated, err := ReferencesHash(ctx, cl, "test-ns", spec)
+	assert.NoError(t, err)
+	assert.NotEqual(t, created, updated)
+}
+
+func TestReferencingObjects(t *testing.T) {
+	scheme := runtime.NewScheme()
+	assert.NoError(t, corev1.AddToScheme(scheme))
+	assert.NoError(t, sensorv1alpha1.AddToScheme(scheme))
+	sensor := &sensorv1alpha1.Sensor{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "test-ns", Name: "sensor"},
+		Spec: sensorv1alpha1.SensorSpec{
+			Template: &sensorv1alpha1.Template{
+				Volumes: []corev1.Volume{{
+					Name:         "config",
+					VolumeSource: corev1.VolumeSource{ConfigMap: &corev1.ConfigMapVolumeSource{LocalObjectReference: corev1.LocalObjectReference{Name: "config"}}},
+				}},
+			},
+			Triggers: []sensorv1alpha1.Trigger{{
+				Template: &sensorv1alpha1.TriggerTemplate{
+					Name: "http",
+					HTTP: &sensorv1alpha1.HTTPTrigger{BasicAuth: &testBasicAuth},
+				},
+			}},
+		},
+	}
+	indexFunc := func(index string) client.IndexerFunc {
+		return func(o client.Object) []string {
+			secrets, configMaps := common.ReferencedObjects(o)
+			if index == IndexReferencedSecrets {
+				return secrets
+			}
+			return configMaps
+		}
+	}
+	cl := fake.NewClientBuilder().WithScheme(scheme).WithObjects(sensor).
+		WithIndex(&sensorv1alpha1.Sensor{}, IndexReferencedSecrets, indexFunc(IndexReferencedSecrets)).
+		WithIndex(&sensorv1alpha1.Sensor{}, IndexReferencedConfigMaps, indexFunc(IndexReferencedConfigMaps)).
+		Build()
+	mapFunc := ReferencingObjects(cl, func() client.ObjectList { return &sensorv1alpha1.SensorList{} })
+
+	secret := SecretMetadata()
+	secret.Namespace = "test-ns"
+	secret.Name = "auth"
+	requests := mapFunc(context.TODO(), secret)
+	assert.Len(t, requests, 1)
+	assert.Equal(t, "sensor", requests[0].Name)
+
+	requests = mapFunc(context.TODO(), &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: "test-ns", Name: "config"}})
+	assert.Len(t, requests, 1)
+
+	requests = mapFunc(context.TODO(), &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: "test-ns", Name: "auth"}})
+	assert.Empty(t, requests)
+}
+
+func TestSetReferencesHash(t *testing.T) {
+	annotations := map[string]string{"a": "b"}
+	template := &corev1.PodTemplateSpec{ObjectMeta: metav1.ObjectMeta{Annotations: annotations}}
+	SetReferencesHash(template, "")
+	assert.Len(t, template.Annotations, 1)
+	SetReferencesHash(template, "hash")
+	assert.Equal(t, "hash", template.Annotations[common.AnnotationReferencesHash])
+	// The annotations of the spec are not modified
+	assert.Len(t, annotations, 1)
+}
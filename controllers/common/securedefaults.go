@@ -0,0 +1,196 @@
+package common
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"go.uber.org/zap"
+	ctrlmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	eventbusv1alpha1 "github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1"
+	eventsourcev1alpha1 "github.com/argoproj/argo-events/pkg/apis/eventsource/v1alpha1"
+)
+
+// The rules enforced by the secure defaults.
+const (
+	// SecureDefaultBusTLS requires the connections to the EventBus to use TLS.
+	SecureDefaultBusTLS = "BusTLS"
+	// SecureDefaultBusAuth requires the clients of the EventBus to authenticate.
+	SecureDefaultBusAuth = "BusAuth"
+	// SecureDefaultWebhookToken requires the webhook endpoints to authenticate their requests with a bearer token.
+	SecureDefaultWebhookToken = "WebhookToken"
+)
+
+// InsecureSetting is a setting of a resource which is rejected, or replaced by a secure default, when the secure
+// defaults are enforced.
+type InsecureSetting struct {
+	Rule  string `json:"rule"`
+	Field string `json:"field"`
+	// Rejected is true if the resource is rejected with the secure defaults, false if the setting is replaced.
+	Rejected bool   `json:"rejected"`
+	Message  string `json:"message"`
+}
+
+func (s InsecureSetting) String() string {
+	return fmt.Sprintf("%q: %s", s.Field, s.Message)
+}
+
+var insecureSettings = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+	Namespace: "argo_events",
+	Name:      "insecure_settings",
+	Help:      "Number of settings of a resource which are rejected, or replaced, when the secure defaults are enforced. https://argoproj.github.io/argo-events/metrics/#argo_events_insecure_settings",
+}, []string{"kind", "namespace", "name", "rule"})
+
+func init() {
+	ctrlmetrics.Registry.MustRegister(insecureSettings)
+}
+
+// EventBusInsecureSettings returns the settings of an EventBus which do not comply with the secure defaults: the
+// connections without TLS and the clients without authentication.
+func EventBusInsecureSettings(eventBus *eventbusv1alpha1.EventBus) []InsecureSetting {
+	var settings []InsecureSetting
+	if x := eventBus.Spec.NATS; x != nil {
+		if x.Native != nil {
+			settings = append(settings, InsecureSetting{
+				Rule:     SecureDefaultBusTLS,
+				Field:    "spec.nats.native",
+				Rejected: true,
+				Message:  "NATS streaming does not support TLS, migrate to a JetStream EventBus",
+			})
+			if x.Native.Auth == nil || *x.Native.Auth == eventbusv1alpha1.AuthStrategyNone {
+				settings = append(settings, InsecureSetting{
+					Rule:     SecureDefaultBusAuth,
+					Field:    "spec.nats.native.auth",
+					Rejected: true,
+					Message:  "the clients do not authenticate, set the auth strategy to \"token\"",
+				})
+			}
+		}
+		if x.Exotic != nil {
+			if !strings.HasPrefix(x.Exotic.URL, "tls://") {
+				settings = append(settings, InsecureSetting{
+					Rule:     SecureDefaultBusTLS,
+					Field:    "spec.nats.exotic.url",
+					Rejected: true,
+					Message:  "the clients do not require TLS, use a \"tls://\" URL",
+				})
+			}
+			if x.Exotic.Auth == nil || *x.Exotic.Auth == eventbusv1alpha1.AuthStrategyNone {
+				settings = append(settings, InsecureSetting{
+					Rule:     SecureDefaultBusAuth,
+					Field:    "spec.nats.exotic.auth",
+					Rejected: true,
+					Message:  "the clients do not authenticate, set the auth strategy to \"token\"",
+				})
+			}
+		}
+	}
+	if x := eventBus.Spec.JetStreamExotic; x != nil && x.SPIFFE == nil {
+		// The SPIFFE authentication is mutual TLS
+		if !strings.HasPrefix(x.URL, "tls://") {
+			settings = append(settings, InsecureSetting{
+				Rule:     SecureDefaultBusTLS,
+				Field:    "spec.jetstreamExotic.url",
+				Rejected: true,
+				Message:  "the clients do not require TLS, use a \"tls://\" URL",
+			})
+		}
+		if x.AccessSecret == nil {
+			settings = append(settings, InsecureSetting{
+				Rule:     SecureDefaultBusAuth,
+				Field:    "spec.jetstreamExotic.accessSecret",
+				Rejected: true,
+				Message:  "the clients do not authenticate, set the access secret or SPIFFE",
+			})
+		}
+	}
+	if x := eventBus.Spec.Kafka; x != nil {
+		switch {
+		case x.TLS == nil:
+			settings = append(settings, InsecureSetting{
+				Rule:     SecureDefaultBusTLS,
+				Field:    "spec.kafka.tls",
+				Rejected: true,
+				Message:  "the connections to the brokers do not use TLS",
+			})
+		case x.TLS.InsecureSkipVerify:
+			settings = append(settings, InsecureSetting{
+				Rule:     SecureDefaultBusTLS,
+				Field:    "spec.kafka.tls.insecureSkipVerify",
+				Rejected: true,
+				Message:  "the certificates of the brokers are not verified",
+			})
+		}
+		if x.SASL == nil && (x.TLS == nil || x.TLS.ClientCertSecret == nil) {
+			settings = append(settings, InsecureSetting{
+				Rule:     SecureDefaultBusAuth,
+				Field:    "spec.kafka.sasl",
+				Rejected: true,
+				Message:  "the clients do not authenticate, set SASL or a TLS client certificate",
+			})
+		}
+	}
+	return settings
+}
+
+// EventSourceInsecureSettings returns the settings of an EventSource which do not comply with the secure defaults:
+// the webhook endpoints without authentication, which generate their token with the secure defaults.
+func EventSourceInsecureSettings(eventSource *eventsourcev1alpha1.EventSource) []InsecureSetting {
+	names := make([]string, 0, len(eventSource.Spec.Webhook))
+	for name := range eventSource.Spec.Webhook {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var settings []InsecureSetting
+	for _, name := range names {
+		wc := eventSource.Spec.Webhook[name].WebhookContext
+		if wc.AuthSecret != nil || wc.GenerateToken {
+			continue
+		}
+		settings = append(settings, InsecureSetting{
+			Rule:    SecureDefaultWebhookToken,
+			Field:   fmt.Sprintf("spec.webhook.%s.authSecret", name),
+			Message: "the requests are not authenticated, a token is generated and the clients must send it",
+		})
+	}
+	return settings
+}
+
+// RequireWebhookTokens makes the webhook endpoints without authentication generate their token.
+func RequireWebhookTokens(spec *eventsourcev1alpha1.EventSourceSpec) {
+	for name, webhook := range spec.Webhook {
+		if webhook.AuthSecret == nil && !webhook.GenerateToken {
+			webhook.GenerateToken = true
+			spec.Webhook[name] = webhook
+		}
+	}
+}
+
+// RejectedSettings returns an error listing the rejected settings, nil if there are none.
+func RejectedSettings(settings []InsecureSetting) error {
+	var rejected []string
+	for _, s := range settings {
+		if s.Rejected {
+			rejected = append(rejected, s.String())
+		}
+	}
+	if len(rejected) == 0 {
+		return nil
+	}
+	return fmt.Errorf("insecure settings are rejected by the secure defaults: %s", strings.Join(rejected, "; "))
+}
+
+// ReportInsecureSettings exposes the insecure settings of a resource as the insecure_settings metric, replacing
+// the previous ones, and logs them when the secure defaults are not enforced. The settings are nil to clear them
+// once the resource is deleted.
+func ReportInsecureSettings(kind, namespace, name string, settings []InsecureSetting, enforced bool, logger *zap.SugaredLogger) {
+	insecureSettings.DeletePartialMatch(prometheus.Labels{"kind": kind, "namespace": namespace, "name": name})
+	for _, s := range settings {
+		insecureSettings.WithLabelValues(kind, namespace, name, s.Rule).Inc()
+		if !enforced {
+			logger.Warnw("insecure setting, it will be rejected or replaced with the secure defaults", "rule", s.Rule, "field", s.Field, "message", s.Message)
+		}
+	}
+}
@@ -0,0 +1,105 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap/zaptest"
+	corev1 "k8s.io/api/core/v1"
+
+	apicommon "github.com/argoproj/argo-events/pkg/apis/common"
+	eventbusv1alpha1 "github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1"
+	eventsourcev1alpha1 "github.com/argoproj/argo-events/pkg/apis/eventsource/v1alpha1"
+)
+
+func rules(settings []InsecureSetting) []string {
+	var result []string
+	for _, s := range settings {
+		result = append(result, s.Rule+" "+s.Field)
+	}
+	return result
+}
+
+func TestEventBusInsecureSettings(t *testing.T) {
+	secret := &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "bus"}, Key: "token"}
+
+	t.Run("test native nats", func(t *testing.T) {
+		eb := &eventbusv1alpha1.EventBus{Spec: eventbusv1alpha1.EventBusSpec{NATS: &eventbusv1alpha1.NATSBus{Native: &eventbusv1alpha1.NativeStrategy{}}}}
+		assert.Equal(t, []string{"BusTLS spec.nats.native", "BusAuth spec.nats.native.auth"}, rules(EventBusInsecureSettings(eb)))
+		eb.Spec.NATS.Native.Auth = &eventbusv1alpha1.AuthStrategyToken
+		assert.Equal(t, []string{"BusTLS spec.nats.native"}, rules(EventBusInsecureSettings(eb)))
+	})
+
+	t.Run("test jetstream", func(t *testing.T) {
+		eb := &eventbusv1alpha1.EventBus{Spec: eventbusv1alpha1.EventBusSpec{JetStream: &eventbusv1alpha1.JetStreamBus{Version: "latest"}}}
+		assert.Empty(t, EventBusInsecureSettings(eb))
+	})
+
+	t.Run("test exotic jetstream", func(t *testing.T) {
+		eb := &eventbusv1alpha1.EventBus{Spec: eventbusv1alpha1.EventBusSpec{JetStreamExotic: &eventbusv1alpha1.JetStreamConfig{URL: "nats://js:4222"}}}
+		assert.Equal(t, []string{"BusTLS spec.jetstreamExotic.url", "BusAuth spec.jetstreamExotic.accessSecret"}, rules(EventBusInsecureSettings(eb)))
+		eb.Spec.JetStreamExotic.URL = "tls://js:4222"
+		eb.Spec.JetStreamExotic.AccessSecret = secret
+		assert.Empty(t, EventBusInsecureSettings(eb))
+		eb.Spec.JetStreamExotic = &eventbusv1alpha1.JetStreamConfig{URL: "nats://js:4222", SPIFFE: &eventbusv1alpha1.SPIFFEConfig{}}
+		assert.Empty(t, EventBusInsecureSettings(eb))
+	})
+
+	t.Run("test kafka", func(t *testing.T) {
+		eb := &eventbusv1alpha1.EventBus{Spec: eventbusv1alpha1.EventBusSpec{Kafka: &eventbusv1alpha1.KafkaBus{URL: "kafka:9092"}}}
+		assert.Equal(t, []string{"BusTLS spec.kafka.tls", "BusAuth spec.kafka.sasl"}, rules(EventBusInsecureSettings(eb)))
+		eb.Spec.Kafka.TLS = &apicommon.TLSConfig{InsecureSkipVerify: true, ClientCertSecret: secret}
+		assert.Equal(t, []string{"BusTLS spec.kafka.tls.insecureSkipVerify"}, rules(EventBusInsecureSettings(eb)))
+		eb.Spec.Kafka.TLS = &apicommon.TLSConfig{}
+		eb.Spec.Kafka.SASL = &apicommon.SASLConfig{UserSecret: secret, PasswordSecret: secret}
+		assert.Empty(t, EventBusInsecureSettings(eb))
+	})
+
+	t.Run("test rejected", func(t *testing.T) {
+		eb := &eventbusv1alpha1.EventBus{Spec: eventbusv1alpha1.EventBusSpec{Kafka: &eventbusv1alpha1.KafkaBus{URL: "kafka:9092"}}}
+		err := RejectedSettings(EventBusInsecureSettings(eb))
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `"spec.kafka.tls"`)
+		assert.Contains(t, err.Error(), `"spec.kafka.sasl"`)
+		assert.NoError(t, RejectedSettings(nil))
+	})
+}
+
+func TestEventSourceInsecureSettings(t *testing.T) {
+	es := &eventsourcev1alpha1.EventSource{Spec: eventsourcev1alpha1.EventSourceSpec{
+		Webhook: map[string]eventsourcev1alpha1.WebhookEventSource{
+			"open":      {WebhookContext: eventsourcev1alpha1.WebhookContext{Endpoint: "/open"}},
+			"secret":    {WebhookContext: eventsourcev1alpha1.WebhookContext{Endpoint: "/secret", AuthSecret: &corev1.SecretKeySelector{Key: "token"}}},
+			"generated": {WebhookContext: eventsourcev1alpha1.WebhookContext{Endpoint: "/generated", GenerateToken: true}},
+		},
+	}}
+	settings := EventSourceInsecureSettings(es)
+	assert.Equal(t, []string{"WebhookToken spec.webhook.open.authSecret"}, rules(settings))
+	assert.NoError(t, RejectedSettings(settings))
+
+	RequireWebhookTokens(&es.Spec)
+	assert.True(t, es.Spec.Webhook["open"].GenerateToken)
+	assert.False(t, es.Spec.Webhook["secret"].GenerateToken)
+	assert.Empty(t, EventSourceInsecureSettings(es))
+}
+
+func TestReportInsecureSettings(t *testing.T) {
+	logger := zaptest.NewLogger(t).Sugar()
+	settings := []InsecureSetting{
+		{Rule: SecureDefaultBusTLS, Field: "spec.kafka.tls"},
+		{Rule: SecureDefaultBusTLS, Field: "spec.kafka.tls.insecureSkipVerify"},
+		{Rule: SecureDefaultBusAuth, Field: "spec.kafka.sasl"},
+	}
+	ReportInsecureSettings("EventBus", "test-ns", "report", settings, false, logger)
+	assert.Equal(t, float64(2), testutil.ToFloat64(insecureSettings.WithLabelValues("EventBus", "test-ns", "report", SecureDefaultBusTLS)))
+	assert.Equal(t, float64(1), testutil.ToFloat64(insecureSettings.WithLabelValues("EventBus", "test-ns", "report", SecureDefaultBusAuth)))
+
+	// The previous settings are replaced
+	ReportInsecureSettings("EventBus", "test-ns", "report", settings[2:], true, logger)
+	assert.Equal(t, 1, testutil.CollectAndCount(insecureSettings))
+	assert.Equal(t, float64(1), testutil.ToFloat64(insecureSettings.WithLabelValues("EventBus", "test-ns", "report", SecureDefaultBusAuth)))
+
+	ReportInsecureSettings("EventBus", "test-ns", "report", nil, true, logger)
+	assert.Equal(t, 0, testutil.CollectAndCount(insecureSettings))
+}
@@ -0,0 +1,42 @@
+package common
+
+import (
+	corev1 "k8s.io/api/core/v1"
+)
+
+// DefaultRunAsUser is the user and group of the generated pods, when no pod security context is specified.
+const DefaultRunAsUser int64 = 65532
+
+// PodSecurityContext returns the pod security context of a generated pod. If none is specified, the default one
+// is compliant with the restricted PodSecurity standard. An empty security context can be specified to opt out.
+func PodSecurityContext(sc *corev1.PodSecurityContext) *corev1.PodSecurityContext {
+	if sc != nil {
+		return sc
+	}
+	runAsNonRoot := true
+	runAsUser := DefaultRunAsUser
+	return &corev1.PodSecurityContext{
+		RunAsNonRoot: &runAsNonRoot,
+		RunAsUser:    &runAsUser,
+		RunAsGroup:   &runAsUser,
+		FSGroup:      &runAsUser,
+		SeccompProfile: &corev1.SeccompProfile{
+			Type: corev1.SeccompProfileTypeRuntimeDefault,
+		},
+	}
+}
+
+// ContainerSecurityContext returns the security context of a generated container. If none is specified, the
+// default one is compliant with the restricted PodSecurity standard.
+func ContainerSecurityContext(sc *corev1.SecurityContext) *corev1.SecurityContext {
+	if sc != nil {
+		return sc
+	}
+	allowPrivilegeEscalation := false
+	return &corev1.SecurityContext{
+		AllowPrivilegeEscalation: &allowPrivilegeEscalation,
+		Capabilities: &corev1.Capabilities{
+			Drop: []corev1.Capability{"ALL"},
+		},
+	}
+}
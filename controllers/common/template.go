@@ -0,0 +1,78 @@
+package common
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// templateVariable matches the template variables of the specs, {{namespace}}, {{cluster-name}} and
+// {{namespace.labels.<key>}}.
+var templateVariable = regexp.MustCompile(`\{\{\s*(namespace|cluster-name|namespace\.labels\.([^\s{}]+))\s*\}\}`)
+
+// SubstituteTemplateVariables substitutes the template variables in the string values of a spec, with the
+// namespace of the resource, the name of the cluster and the labels of the namespace. The spec is a pointer
+// to the spec struct, which is left untouched if it has no template variables.
+func SubstituteTemplateVariables(ctx context.Context, cl client.Client, clusterName, namespace string, spec interface{}) error {
+	data, err := json.Marshal(spec)
+	if err != nil {
+		return fmt.Errorf("failed to marshal the spec, %w", err)
+	}
+	if !templateVariable.Match(data) {
+		return nil
+	}
+	var labels map[string]string
+	var substituteErr error
+	substituted := templateVariable.ReplaceAllFunc(data, func(match []byte) []byte {
+		groups := templateVariable.FindSubmatch(match)
+		var value string
+		switch name := string(groups[1]); {
+		case name == "namespace":
+			value = namespace
+		case name == "cluster-name":
+			if clusterName == "" {
+				substituteErr = fmt.Errorf("{{cluster-name}} is used but the cluster name is not configured")
+				return match
+			}
+			value = clusterName
+		default:
+			if labels == nil {
+				ns := &corev1.Namespace{}
+				if err := cl.Get(ctx, types.NamespacedName{Name: namespace}, ns); err != nil {
+					substituteErr = fmt.Errorf("failed to get the namespace %q, %w", namespace, err)
+					return match
+				}
+				labels = ns.Labels
+				if labels == nil {
+					labels = map[string]string{}
+				}
+			}
+			key := string(groups[2])
+			v, ok := labels[key]
+			if !ok {
+				substituteErr = fmt.Errorf("label %q referenced by %s is not set on the namespace %q", key, name, namespace)
+				return match
+			}
+			value = v
+		}
+		// The value is substituted inside a JSON string
+		quoted, _ := json.Marshal(value)
+		return []byte(strings.TrimSuffix(strings.TrimPrefix(string(quoted), `"`), `"`))
+	})
+	if substituteErr != nil {
+		return substituteErr
+	}
+	v := reflect.ValueOf(spec).Elem()
+	v.Set(reflect.Zero(v.Type()))
+	if err := json.Unmarshal(substituted, spec); err != nil {
+		return fmt.Errorf("failed to unmarshal the substituted spec, %w", err)
+	}
+	return nil
+}
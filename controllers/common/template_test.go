@@ -0,0 +1,58 @@
+package common
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	sensorv1alpha1 "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+)
+
+func TestSubstituteTemplateVariables(t *testing.T) {
+	ctx := context.TODO()
+	cl := fake.NewClientBuilder().WithObjects(&corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{Name: "team-a", Labels: map[string]string{"env": "prod", "quote": `a"b`}},
+	}).Build()
+	newSpec := func(url string) *sensorv1alpha1.SensorSpec {
+		return &sensorv1alpha1.SensorSpec{
+			Dependencies: []sensorv1alpha1.EventDependency{{Name: "dep", EventSourceName: "webhook", EventName: "example"}},
+			Triggers: []sensorv1alpha1.Trigger{{
+				Template: &sensorv1alpha1.TriggerTemplate{
+					Name: "http",
+					HTTP: &sensorv1alpha1.HTTPTrigger{URL: url},
+				},
+			}},
+		}
+	}
+
+	t.Run("no template variables", func(t *testing.T) {
+		spec := newSpec("http://{{ .Input.host }}/events")
+		assert.NoError(t, SubstituteTemplateVariables(ctx, cl, "", "team-a", spec))
+		assert.Equal(t, "http://{{ .Input.host }}/events", spec.Triggers[0].Template.HTTP.URL)
+	})
+
+	t.Run("substitute", func(t *testing.T) {
+		spec := newSpec("http://api.{{namespace}}.{{ namespace.labels.env }}/{{cluster-name}}?q={{namespace.labels.quote}}")
+		assert.NoError(t, SubstituteTemplateVariables(ctx, cl, "east", "team-a", spec))
+		assert.Equal(t, `http://api.team-a.prod/east?q=a"b`, spec.Triggers[0].Template.HTTP.URL)
+		assert.Equal(t, "webhook", spec.Dependencies[0].EventSourceName)
+	})
+
+	t.Run("cluster name not configured", func(t *testing.T) {
+		spec := newSpec("http://{{cluster-name}}")
+		err := SubstituteTemplateVariables(ctx, cl, "", "team-a", spec)
+		assert.Error(t, err)
+		assert.Equal(t, "http://{{cluster-name}}", spec.Triggers[0].Template.HTTP.URL)
+	})
+
+	t.Run("missing label", func(t *testing.T) {
+		spec := newSpec("http://{{namespace.labels.region}}")
+		err := SubstituteTemplateVariables(ctx, cl, "", "team-a", spec)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `label "region"`)
+	})
+}
@@ -2,14 +2,20 @@ package controllers
 
 import (
 	"fmt"
+	"reflect"
 	"strings"
+	"sync"
 
 	"github.com/argoproj/argo-events/common"
+	"github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1"
 	"github.com/fsnotify/fsnotify"
 )
 
 type GlobalConfig struct {
 	EventBus *EventBusConfig `json:"eventBus"`
+
+	// lock guards the reloads of the configuration
+	lock sync.RWMutex
 }
 
 type EventBusConfig struct {
@@ -93,7 +99,54 @@ func (g *GlobalConfig) GetJetStreamVersion(version string) (*JetStreamVersion, e
 	return nil, fmt.Errorf("unsupported version %q, supported versions: %q", version, strings.Join(g.supportedJetStreamVersions(), ","))
 }
 
-func LoadConfig(onErrorReloading func(error)) (*GlobalConfig, error) {
+// Snapshot returns a copy of the configuration, which is not affected by the later reloads.
+func (g *GlobalConfig) Snapshot() *GlobalConfig {
+	g.lock.RLock()
+	defer g.lock.RUnlock()
+	return &GlobalConfig{EventBus: g.EventBus}
+}
+
+func (g *GlobalConfig) update(config *GlobalConfig) {
+	g.lock.Lock()
+	defer g.lock.Unlock()
+	g.EventBus = config.EventBus
+}
+
+// AffectsEventBus returns whether the changes from the old to the new configuration affect the installation
+// of an EventBus, i.e. the settings, the stream configuration or the images of its version.
+func AffectsEventBus(old, new *GlobalConfig, eventBus *v1alpha1.EventBus) bool {
+	switch {
+	case eventBus.Spec.JetStream != nil:
+		oldJS, newJS := old.jetStream(), new.jetStream()
+		if oldJS.Settings != newJS.Settings || oldJS.StreamConfig != newJS.StreamConfig {
+			return true
+		}
+		oldVersion, _ := old.GetJetStreamVersion(eventBus.Spec.JetStream.Version)
+		newVersion, _ := new.GetJetStreamVersion(eventBus.Spec.JetStream.Version)
+		return !reflect.DeepEqual(oldVersion, newVersion)
+	case eventBus.Spec.NATS != nil && eventBus.Spec.NATS.Native != nil:
+		return !reflect.DeepEqual(old.nats(), new.nats())
+	}
+	return false
+}
+
+func (g *GlobalConfig) jetStream() JetStreamConfig {
+	if g.EventBus == nil || g.EventBus.JetStream == nil {
+		return JetStreamConfig{}
+	}
+	return *g.EventBus.JetStream
+}
+
+func (g *GlobalConfig) nats() StanConfig {
+	if g.EventBus == nil || g.EventBus.NATS == nil {
+		return StanConfig{}
+	}
+	return *g.EventBus.NATS
+}
+
+// LoadConfig loads the global configuration, and reloads it when the file changes. A valid reloaded configuration
+// which differs from the current one replaces it, and onReload is invoked with both, if set.
+func LoadConfig(onErrorReloading func(error), onReload func(old, new *GlobalConfig)) (*GlobalConfig, error) {
 	v := common.ViperWithLogging()
 	v.SetConfigName("controller-config")
 	v.SetConfigType("yaml")
@@ -109,9 +162,22 @@ func LoadConfig(onErrorReloading func(error)) (*GlobalConfig, error) {
 	}
 	v.WatchConfig()
 	v.OnConfigChange(func(e fsnotify.Event) {
-		err = v.Unmarshal(r)
-		if err != nil {
+		reloaded := &GlobalConfig{}
+		if err := v.Unmarshal(reloaded); err != nil {
+			onErrorReloading(err)
+			return
+		}
+		if err := ValidateConfig(reloaded); err != nil {
 			onErrorReloading(err)
+			return
+		}
+		current := r.Snapshot()
+		if reflect.DeepEqual(current.EventBus, reloaded.EventBus) {
+			return
+		}
+		r.update(reloaded)
+		if onReload != nil {
+			onReload(current, reloaded)
 		}
 	})
 	return r, nil
@@ -0,0 +1,54 @@
+package controllers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1"
+)
+
+func TestAffectsEventBus(t *testing.T) {
+	config := func(natsImage, settings string) *GlobalConfig {
+		return &GlobalConfig{
+			EventBus: &EventBusConfig{
+				NATS: &StanConfig{Versions: []StanVersion{{Version: "0.22.1", NATSStreamingImage: "nats-streaming:0.22.1"}}},
+				JetStream: &JetStreamConfig{
+					Settings: settings,
+					Versions: []JetStreamVersion{
+						{Version: "2.9.0", NatsImage: "nats:2.9.0"},
+						{Version: "latest", NatsImage: natsImage},
+					},
+				},
+			},
+		}
+	}
+	jsBus := func(version string) *v1alpha1.EventBus {
+		return &v1alpha1.EventBus{Spec: v1alpha1.EventBusSpec{JetStream: &v1alpha1.JetStreamBus{Version: version}}}
+	}
+	nativeBus := &v1alpha1.EventBus{Spec: v1alpha1.EventBusSpec{NATS: &v1alpha1.NATSBus{Native: &v1alpha1.NativeStrategy{}}}}
+	exoticBus := &v1alpha1.EventBus{Spec: v1alpha1.EventBusSpec{NATS: &v1alpha1.NATSBus{Exotic: &v1alpha1.NATSConfig{}}}}
+
+	old := config("nats:2.10.0", "max_payload: 1MB")
+	newImage := config("nats:2.10.1", "max_payload: 1MB")
+	assert.True(t, AffectsEventBus(old, newImage, jsBus("latest")))
+	assert.False(t, AffectsEventBus(old, newImage, jsBus("2.9.0")))
+	assert.False(t, AffectsEventBus(old, newImage, nativeBus))
+	assert.False(t, AffectsEventBus(old, newImage, exoticBus))
+
+	newSettings := config("nats:2.10.0", "max_payload: 2MB")
+	assert.True(t, AffectsEventBus(old, newSettings, jsBus("2.9.0")))
+
+	newNATS := config("nats:2.10.0", "max_payload: 1MB")
+	newNATS.EventBus.NATS.Versions[0].NATSStreamingImage = "nats-streaming:0.22.2"
+	assert.True(t, AffectsEventBus(old, newNATS, nativeBus))
+	assert.False(t, AffectsEventBus(old, newNATS, jsBus("latest")))
+}
+
+func TestSnapshot(t *testing.T) {
+	config := &GlobalConfig{EventBus: &EventBusConfig{JetStream: &JetStreamConfig{Settings: "a"}}}
+	snapshot := config.Snapshot()
+	config.update(&GlobalConfig{EventBus: &EventBusConfig{JetStream: &JetStreamConfig{Settings: "b"}}})
+	assert.Equal(t, "a", snapshot.EventBus.JetStream.Settings)
+	assert.Equal(t, "b", config.Snapshot().EventBus.JetStream.Settings)
+}
@@ -0,0 +1,116 @@
+package eventbus
+
+import (
+	"context"
+	"sync"
+
+	"go.uber.org/zap"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	"github.com/argoproj/argo-events/controllers"
+	"github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1"
+)
+
+// ConfigChange is a reload of the global configuration.
+type ConfigChange struct {
+	Old *controllers.GlobalConfig
+	New *controllers.GlobalConfig
+}
+
+// ConfigChanges holds the reloads of the global configuration which are not processed yet. The reloads are coalesced,
+// so that none of them is dropped while the previous ones are processed.
+type ConfigChanges struct {
+	lock    sync.Mutex
+	pending *ConfigChange
+	// all is set if several reloads are coalesced, all the EventBus objects are then reconciled.
+	all    bool
+	notify chan struct{}
+}
+
+// NewConfigChanges returns an empty ConfigChanges.
+func NewConfigChanges() *ConfigChanges {
+	return &ConfigChanges{notify: make(chan struct{}, 1)}
+}
+
+// Add adds a reload of the global configuration, it never blocks.
+func (c *ConfigChanges) Add(change ConfigChange) {
+	c.lock.Lock()
+	if c.pending != nil {
+		c.all = true
+	}
+	c.pending = &change
+	c.lock.Unlock()
+	select {
+	case c.notify <- struct{}{}:
+	default:
+	}
+}
+
+// take returns the pending reload, and whether all the EventBus objects need to be reconciled.
+func (c *ConfigChanges) take() (*ConfigChange, bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	change, all := c.pending, c.all
+	c.pending, c.all = nil, false
+	return change, all
+}
+
+// ConfigReloader enqueues the EventBus objects affected by the reloads of the global configuration, to
+// reconcile them without restarting the controller.
+type ConfigReloader struct {
+	client  client.Client
+	changes *ConfigChanges
+	events  chan event.GenericEvent
+	logger  *zap.SugaredLogger
+}
+
+// NewConfigReloader returns a ConfigReloader processing the given configuration changes.
+func NewConfigReloader(client client.Client, changes *ConfigChanges, logger *zap.SugaredLogger) *ConfigReloader {
+	return &ConfigReloader{client: client, changes: changes, events: make(chan event.GenericEvent, 1024), logger: logger}
+}
+
+// Source returns the source of the EventBus objects to reconcile.
+func (c *ConfigReloader) Source() source.Source {
+	return &source.Channel{Source: c.events}
+}
+
+// Start processes the configuration changes until the context is done.
+func (c *ConfigReloader) Start(ctx context.Context) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-c.changes.notify:
+			change, all := c.changes.take()
+			if change == nil {
+				continue
+			}
+			if err := c.enqueueAffected(ctx, *change, all); err != nil {
+				c.logger.Errorw("failed to enqueue the EventBus objects affected by the configuration reload", zap.Error(err))
+			}
+		}
+	}
+}
+
+// enqueueAffected enqueues the EventBus objects affected by the change, or all of them if all is set.
+func (c *ConfigReloader) enqueueAffected(ctx context.Context, change ConfigChange, all bool) error {
+	list := &v1alpha1.EventBusList{}
+	if err := c.client.List(ctx, list); err != nil {
+		return err
+	}
+	for i := range list.Items {
+		eventBus := &list.Items[i]
+		if !all && !controllers.AffectsEventBus(change.Old, change.New, eventBus) {
+			continue
+		}
+		c.logger.Infow("reconciling the EventBus affected by the configuration reload", "namespace", eventBus.Namespace, "eventbus", eventBus.Name)
+		select {
+		case c.events <- event.GenericEvent{Object: eventBus}:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	return nil
+}
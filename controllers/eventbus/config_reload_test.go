@@ -0,0 +1,58 @@
+package eventbus
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap/zaptest"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/argoproj/argo-events/controllers"
+)
+
+func TestConfigReloader(t *testing.T) {
+	ctx := context.TODO()
+	exotic := exoticBus.DeepCopy()
+	exotic.Name = "exotic-bus"
+	cl := fake.NewClientBuilder().WithObjects(nativeBus.DeepCopy(), exotic).Build()
+	reloader := NewConfigReloader(cl, NewConfigChanges(), zaptest.NewLogger(t).Sugar())
+
+	reloaded := fakeConfig.Snapshot()
+	reloaded.EventBus = &controllers.EventBusConfig{
+		NATS: &controllers.StanConfig{
+			Versions: []controllers.StanVersion{
+				{Version: "0.22.1", NATSStreamingImage: "test-n-s-image-2", MetricsExporterImage: "test-n-s-m-image"},
+			},
+		},
+		JetStream: fakeConfig.EventBus.JetStream,
+	}
+	err := reloader.enqueueAffected(ctx, ConfigChange{Old: fakeConfig, New: reloaded}, false)
+	assert.NoError(t, err)
+	assert.Len(t, reloader.events, 1)
+	e := <-reloader.events
+	assert.Equal(t, nativeBus.Name, e.Object.GetName())
+}
+
+func TestConfigChangesCoalesced(t *testing.T) {
+	ctx := context.TODO()
+	exotic := exoticBus.DeepCopy()
+	exotic.Name = "exotic-bus"
+	cl := fake.NewClientBuilder().WithObjects(nativeBus.DeepCopy(), exotic).Build()
+	changes := NewConfigChanges()
+	reloader := NewConfigReloader(cl, changes, zaptest.NewLogger(t).Sugar())
+
+	// None of the reloads is dropped, all the EventBus objects are reconciled once they are coalesced
+	for i := 0; i < 20; i++ {
+		changes.Add(ConfigChange{Old: fakeConfig, New: fakeConfig.Snapshot()})
+	}
+	assert.Len(t, changes.notify, 1)
+	change, all := changes.take()
+	assert.NotNil(t, change)
+	assert.True(t, all)
+	err := reloader.enqueueAffected(ctx, *change, all)
+	assert.NoError(t, err)
+	assert.Len(t, reloader.events, 2)
+	change, _ = changes.take()
+	assert.Nil(t, change)
+}
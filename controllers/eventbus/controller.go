@@ -2,12 +2,14 @@ package eventbus
 
 import (
 	"context"
+	"errors"
 
 	"go.uber.org/zap"
 	"k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
@@ -15,6 +17,7 @@ import (
 
 	"github.com/argoproj/argo-events/common/logging"
 	"github.com/argoproj/argo-events/controllers"
+	controllerscommon "github.com/argoproj/argo-events/controllers/common"
 	"github.com/argoproj/argo-events/controllers/eventbus/installer"
 	"github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1"
 )
@@ -32,12 +35,17 @@ type reconciler struct {
 	scheme     *runtime.Scheme
 
 	config *controllers.GlobalConfig
-	logger *zap.SugaredLogger
+	// image is the image of the seed Jobs
+	image    string
+	recorder record.EventRecorder
+	// secureDefaults rejects the EventBuses without TLS or client authentication
+	secureDefaults bool
+	logger         *zap.SugaredLogger
 }
 
 // NewReconciler returns a new reconciler
-func NewReconciler(client client.Client, kubeClient kubernetes.Interface, scheme *runtime.Scheme, config *controllers.GlobalConfig, logger *zap.SugaredLogger) reconcile.Reconciler {
-	return &reconciler{client: client, scheme: scheme, config: config, kubeClient: kubeClient, logger: logger}
+func NewReconciler(client client.Client, kubeClient kubernetes.Interface, scheme *runtime.Scheme, config *controllers.GlobalConfig, image string, recorder record.EventRecorder, secureDefaults bool, logger *zap.SugaredLogger) reconcile.Reconciler {
+	return &reconciler{client: client, scheme: scheme, config: config, kubeClient: kubeClient, image: image, recorder: recorder, secureDefaults: secureDefaults, logger: logger}
 }
 
 func (r *reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
@@ -53,10 +61,24 @@ func (r *reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 	log := r.logger.With("namespace", eventBus.Namespace).With("eventbus", eventBus.Name)
 	ctx = logging.WithLogger(ctx, log)
 	busCopy := eventBus.DeepCopy()
+	result := ctrl.Result{}
 	reconcileErr := r.reconcile(ctx, busCopy)
-	if reconcileErr != nil {
+	var requeueErr *installer.RequeueError
+	if errors.As(reconcileErr, &requeueErr) {
+		log.Infow("installation in progress", "message", requeueErr.Message, "requeueAfter", requeueErr.After)
+		result.RequeueAfter = requeueErr.After
+		reconcileErr = nil
+	} else if reconcileErr != nil {
 		log.Errorw("reconcile error", zap.Error(reconcileErr))
 	}
+	if result.RequeueAfter == 0 {
+		// Check the storage usage again
+		result.RequeueAfter = installer.StorageBudgetRequeueAfter(busCopy)
+	}
+	if after := installer.MirrorsRequeueAfter(busCopy); after > 0 && (result.RequeueAfter == 0 || after < result.RequeueAfter) {
+		// Create the mirror streams once the stream of the events exists
+		result.RequeueAfter = after
+	}
 	if r.needsUpdate(eventBus, busCopy) {
 		// Use a DeepCopy to update, because it will be mutated afterwards, with empty Status.
 		if err := r.client.Update(ctx, busCopy.DeepCopy()); err != nil {
@@ -66,22 +88,25 @@ func (r *reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 	if err := r.client.Status().Update(ctx, busCopy); err != nil {
 		return reconcile.Result{}, err
 	}
-	return ctrl.Result{}, reconcileErr
+	return result, reconcileErr
 }
 
 // reconcile does the real logic
 func (r *reconciler) reconcile(ctx context.Context, eventBus *v1alpha1.EventBus) error {
 	log := logging.FromContext(ctx)
+	// The global configuration can be reloaded while reconciling
+	config := r.config.Snapshot()
 	if !eventBus.DeletionTimestamp.IsZero() {
 		log.Info("deleting eventbus")
 		if controllerutil.ContainsFinalizer(eventBus, finalizerName) {
 			// Finalizer logic should be added here.
-			if err := installer.Uninstall(ctx, eventBus, r.client, r.kubeClient, r.config, log); err != nil {
+			if err := installer.Uninstall(ctx, eventBus, r.client, r.kubeClient, config, log); err != nil {
 				log.Errorw("failed to uninstall", zap.Error(err))
 				return err
 			}
 			controllerutil.RemoveFinalizer(eventBus, finalizerName)
 		}
+		controllerscommon.ReportInsecureSettings(v1alpha1.SchemaGroupVersionKind.Kind, eventBus.Namespace, eventBus.Name, nil, r.secureDefaults, log)
 		return nil
 	}
 	controllerutil.AddFinalizer(eventBus, finalizerName)
@@ -91,10 +116,30 @@ func (r *reconciler) reconcile(ctx context.Context, eventBus *v1alpha1.EventBus)
 		log.Errorw("validation failed", zap.Error(err))
 		eventBus.Status.MarkNotConfigured("InvalidSpec", err.Error())
 		return err
-	} else {
-		eventBus.Status.MarkConfigured()
 	}
-	return installer.Install(ctx, eventBus, r.client, r.kubeClient, r.config, log)
+	insecureSettings := controllerscommon.EventBusInsecureSettings(eventBus)
+	controllerscommon.ReportInsecureSettings(v1alpha1.SchemaGroupVersionKind.Kind, eventBus.Namespace, eventBus.Name, insecureSettings, r.secureDefaults, log)
+	if r.secureDefaults {
+		if err := controllerscommon.RejectedSettings(insecureSettings); err != nil {
+			log.Errorw("insecure settings", zap.Error(err))
+			eventBus.Status.MarkNotConfigured("InsecureSpec", err.Error())
+			return err
+		}
+	}
+	eventBus.Status.MarkConfigured()
+	if err := installer.Install(ctx, eventBus, r.client, r.kubeClient, config, log); err != nil {
+		return err
+	}
+	installer.ReconcileStorageBudget(ctx, eventBus, r.client, r.recorder, log)
+	planErr := installer.ReconcileMirrors(ctx, eventBus, r.client, r.recorder, log)
+	if planErr == nil {
+		// The planned actions, if any, are done
+		eventBus.Status.PendingActions = nil
+	}
+	if err := r.reconcileSeed(ctx, eventBus); err != nil {
+		return err
+	}
+	return planErr
 }
 
 func (r *reconciler) needsUpdate(old, new *v1alpha1.EventBus) bool {
@@ -142,6 +142,28 @@ func TestReconcileExotic(t *testing.T) {
 	})
 }
 
+func TestReconcileSecureDefaults(t *testing.T) {
+	testBus := exoticBus.DeepCopy()
+	r := &reconciler{
+		client:         fake.NewClientBuilder().Build(),
+		kubeClient:     k8sfake.NewSimpleClientset(),
+		scheme:         scheme.Scheme,
+		config:         fakeConfig,
+		secureDefaults: true,
+		logger:         zaptest.NewLogger(t).Sugar(),
+	}
+	err := r.reconcile(context.TODO(), testBus)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "spec.nats.exotic.url")
+	assert.False(t, testBus.Status.IsReady())
+
+	testBus.Spec.NATS.Exotic.URL = "tls://test"
+	testBus.Spec.NATS.Exotic.Auth = &v1alpha1.AuthStrategyToken
+	testBus.Spec.NATS.Exotic.AccessSecret = &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "nats"}, Key: "token"}
+	assert.NoError(t, r.reconcile(context.TODO(), testBus))
+	assert.Equal(t, "tls://test", testBus.Status.Config.NATS.URL)
+}
+
 func TestNeedsUpdate(t *testing.T) {
 	t.Run("needs update", func(t *testing.T) {
 		testBus := nativeBus.DeepCopy()
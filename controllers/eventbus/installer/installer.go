@@ -2,7 +2,9 @@ package installer
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
 
 	"go.uber.org/zap"
 	"k8s.io/client-go/kubernetes"
@@ -23,6 +25,17 @@ type Installer interface {
 	Uninstall(ctx context.Context) error
 }
 
+// RequeueError is returned when an installation is in progress, and the EventBus needs to be reconciled again later.
+type RequeueError struct {
+	Reason  string
+	Message string
+	After   time.Duration
+}
+
+func (e *RequeueError) Error() string {
+	return e.Message
+}
+
 // Install function installs the event bus
 func Install(ctx context.Context, eventBus *v1alpha1.EventBus, client client.Client, kubeClient kubernetes.Interface, config *controllers.GlobalConfig, logger *zap.SugaredLogger) error {
 	installer, err := getInstaller(eventBus, client, kubeClient, config, logger)
@@ -32,10 +45,15 @@ func Install(ctx context.Context, eventBus *v1alpha1.EventBus, client client.Cli
 	}
 	busConfig, err := installer.Install(ctx)
 	if err != nil {
-		logger.Errorw("installation error", zap.Error(err))
+		var requeueErr *RequeueError
+		if !errors.As(err, &requeueErr) {
+			logger.Errorw("installation error", zap.Error(err))
+		}
 		return err
 	}
 	eventBus.Status.Config = *busConfig
+	eventBus.Status.Config.Format = eventBus.Spec.Format
+	eventBus.Status.Ordering = busConfig.GetOrdering()
 	return nil
 }
 
@@ -53,6 +71,8 @@ func getInstaller(eventBus *v1alpha1.EventBus, client client.Client, kubeClient
 		return NewExoticKafkaInstaller(eventBus, logger), nil
 	} else if js := eventBus.Spec.JetStreamExotic; js != nil {
 		return NewExoticJetStreamInstaller(eventBus, logger), nil
+	} else if shared := eventBus.Spec.Shared; shared != nil {
+		return NewSharedJetStreamInstaller(client, eventBus, getLabels(eventBus), logger), nil
 	}
 	return nil, fmt.Errorf("invalid eventbus spec")
 }
@@ -97,6 +117,7 @@ func Uninstall(ctx context.Context, eventBus *v1alpha1.EventBus, client client.C
 		logger.Errorw("failed to get an installer", zap.Error(err))
 		return err
 	}
+	storageUsagePercent.DeleteLabelValues(eventBus.Namespace, eventBus.Name)
 	return installer.Uninstall(ctx)
 }
 
@@ -4,7 +4,9 @@ import (
 	"bytes"
 	"context"
 	"embed"
+	"errors"
 	"fmt"
+	"path"
 	"strconv"
 	"strings"
 	"text/template"
@@ -27,6 +29,7 @@ import (
 	"github.com/argoproj/argo-events/common"
 	"github.com/argoproj/argo-events/common/tls"
 	"github.com/argoproj/argo-events/controllers"
+	controllerscommon "github.com/argoproj/argo-events/controllers/common"
 	"github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1"
 )
 
@@ -35,6 +38,9 @@ const (
 	jsClusterPort = int32(6222)
 	jsMonitorPort = int32(8222)
 	jsMetricsPort = int32(7777)
+
+	// jsStreamMaxBytesVolumePercent is the max percentage of the persistence volume used by the stream
+	jsStreamMaxBytesVolumePercent = 80
 )
 
 var (
@@ -89,6 +95,14 @@ func (r *jetStreamInstaller) Install(ctx context.Context) (*v1alpha1.BusConfig,
 			return nil, fmt.Errorf("failed to merge customized stream config, %w", err)
 		}
 	}
+	if r.eventBus.Spec.JetStream.Persistence != nil {
+		// Keep the stream within the volume, leaving room for the other JetStream data
+		volSize := r.volumeSize()
+		limit := volSize.Value() / 100 * jsStreamMaxBytesVolumePercent
+		if maxBytes := v.GetInt64("maxBytes"); maxBytes <= 0 || maxBytes > limit {
+			v.Set("maxBytes", limit)
+		}
+	}
 	b, err := yaml.Marshal(v.AllSettings())
 	if err != nil {
 		return nil, fmt.Errorf("failed to marshal merged buffer config, %w", err)
@@ -99,6 +113,11 @@ func (r *jetStreamInstaller) Install(ctx context.Context) (*v1alpha1.BusConfig,
 		r.eventBus.Status.MarkDeployFailed("JetStreamAuthSecretsFailed", err.Error())
 		return nil, err
 	}
+	if err := r.reconcileTenancy(ctx); err != nil {
+		r.logger.Errorw("failed to reconcile jetstream tenancy", zap.Error(err))
+		r.eventBus.Status.MarkDeployFailed("JetStreamTenancyFailed", err.Error())
+		return nil, err
+	}
 	if err := r.createConfigMap(ctx); err != nil {
 		r.logger.Errorw("failed to create jetstream ConfigMap", zap.Error(err))
 		r.eventBus.Status.MarkDeployFailed("JetStreamConfigMapFailed", err.Error())
@@ -110,23 +129,47 @@ func (r *jetStreamInstaller) Install(ctx context.Context) (*v1alpha1.BusConfig,
 		return nil, err
 	}
 	if err := r.createStatefulSet(ctx); err != nil {
-		r.logger.Errorw("failed to create jetstream StatefulSet", zap.Error(err))
-		r.eventBus.Status.MarkDeployFailed("JetStreamStatefulSetFailed", err.Error())
-		return nil, err
+		return nil, r.markStatefulSetFailed(err)
+	}
+	if err := r.resizeVolumes(ctx); err != nil {
+		return nil, r.markStatefulSetFailed(err)
 	}
 	r.eventBus.Status.MarkDeployed("Succeeded", "JetStream is deployed")
-	return &v1alpha1.BusConfig{
-		JetStream: &v1alpha1.JetStreamConfig{
-			URL: fmt.Sprintf("nats://%s.%s.svc:%s", generateJetStreamServiceName(r.eventBus), r.eventBus.Namespace, strconv.Itoa(int(jsClientPort))),
-			AccessSecret: &corev1.SecretKeySelector{
-				LocalObjectReference: corev1.LocalObjectReference{
-					Name: generateJetStreamClientAuthSecretName(r.eventBus),
-				},
-				Key: common.JetStreamClientAuthSecretKey,
+	busConfig := &v1alpha1.JetStreamConfig{
+		URL:          fmt.Sprintf("nats://%s.%s.svc:%s", generateJetStreamServiceName(r.eventBus), r.eventBus.Namespace, strconv.Itoa(int(jsClientPort))),
+		StreamConfig: string(b),
+	}
+	if x := r.eventBus.Spec.JetStream.SPIFFE; x != nil {
+		busConfig.SPIFFE = &v1alpha1.SPIFFEConfig{
+			CSIDriver: x.GetCSIDriver(),
+			ServerID:  spiffeID(x.TrustDomain, r.eventBus.Namespace, r.eventBus.Spec.JetStream.ServiceAccountName),
+		}
+	} else {
+		busConfig.AccessSecret = &corev1.SecretKeySelector{
+			LocalObjectReference: corev1.LocalObjectReference{
+				Name: generateJetStreamClientAuthSecretName(r.eventBus),
 			},
-			StreamConfig: string(b),
-		},
-	}, nil
+			Key: common.JetStreamClientAuthSecretKey,
+		}
+	}
+	return &v1alpha1.BusConfig{JetStream: busConfig}, nil
+}
+
+func (r *jetStreamInstaller) markStatefulSetFailed(err error) error {
+	var requeueErr *RequeueError
+	if errors.As(err, &requeueErr) {
+		r.eventBus.Status.MarkDeploying(requeueErr.Reason, requeueErr.Message)
+		return err
+	}
+	var storageErr *StorageError
+	if errors.As(err, &storageErr) {
+		r.logger.Errorw("the volumes of the jetstream StatefulSet can not be provisioned", zap.Error(err))
+		r.eventBus.Status.MarkDeployFailed(storageErr.Reason, storageErr.Message)
+		return err
+	}
+	r.logger.Errorw("failed to create jetstream StatefulSet", zap.Error(err))
+	r.eventBus.Status.MarkDeployFailed("JetStreamStatefulSetFailed", err.Error())
+	return err
 }
 
 // buildJetStreamService builds a Service for Jet Stream
@@ -209,6 +252,9 @@ func (r *jetStreamInstaller) createStatefulSet(ctx context.Context) error {
 	old := &appv1.StatefulSet{}
 	if err := r.client.Get(ctx, client.ObjectKeyFromObject(obj), old); err != nil {
 		if apierrors.IsNotFound(err) {
+			if err := validateStorage(ctx, r.kubeClient, r.eventBus.Spec.JetStream.Persistence, r.logger); err != nil {
+				return err
+			}
 			if err := r.client.Create(ctx, obj); err != nil {
 				return fmt.Errorf("failed to create jetstream statefulset, err: %w", err)
 			}
@@ -219,6 +265,38 @@ func (r *jetStreamInstaller) createStatefulSet(ctx context.Context) error {
 		}
 	}
 	if old.GetAnnotations()[common.AnnotationResourceSpecHash] != hash {
+		target := "StatefulSet/" + old.Name
+		if oldSize, newSize, changed := volumeSizeChanged(old.Spec, spec); changed {
+			if newSize.Cmp(oldSize) < 0 {
+				return fmt.Errorf("shrinking the persistence volume from %s to %s is not supported", oldSize.String(), newSize.String())
+			}
+			if err := planActions(r.eventBus, v1alpha1.PendingAction{
+				Type:        v1alpha1.PendingActionRecreateStatefulSet,
+				Target:      target,
+				Description: fmt.Sprintf("Recreate the StatefulSet to resize the volumes from %s to %s, the pods are kept", oldSize.String(), newSize.String()),
+			}); err != nil {
+				return err
+			}
+			return r.recreateStatefulSet(ctx, old, "VolumeResizing", fmt.Sprintf("Recreating the StatefulSet to resize the volumes to %s", newSize.String()))
+		}
+		if persistenceChanged(old.Spec, spec) {
+			if err := validateStorage(ctx, r.kubeClient, r.eventBus.Spec.JetStream.Persistence, r.logger); err != nil {
+				return err
+			}
+			description := "Recreate the StatefulSet to persist the streams on volumes, the streams stored in the pods are lost when they are rolled"
+			if len(spec.VolumeClaimTemplates) == 0 {
+				description = "Recreate the StatefulSet without volumes, the streams stored on the volumes are lost when the pods are rolled"
+			}
+			if err := planActions(r.eventBus, v1alpha1.PendingAction{
+				Type:        v1alpha1.PendingActionRecreateStatefulSet,
+				Target:      target,
+				Description: description,
+				DataLoss:    true,
+			}); err != nil {
+				return err
+			}
+			return r.recreateStatefulSet(ctx, old, "PersistenceChanging", "Recreating the StatefulSet to change the persistence")
+		}
 		old.Annotations[common.AnnotationResourceSpecHash] = hash
 		old.Spec = spec
 		if err := r.client.Update(ctx, old); err != nil {
@@ -229,6 +307,147 @@ func (r *jetStreamInstaller) createStatefulSet(ctx context.Context) error {
 	return nil
 }
 
+// recreateStatefulSet deletes a StatefulSet to create it again with immutable fields changed, e.g. its volume
+// claim templates. Its pods are not deleted, they are adopted by the new one.
+func (r *jetStreamInstaller) recreateStatefulSet(ctx context.Context, old *appv1.StatefulSet, reason, message string) error {
+	if old.DeletionTimestamp.IsZero() {
+		if err := r.client.Delete(ctx, old, client.PropagationPolicy(metav1.DeletePropagationOrphan)); err != nil && !apierrors.IsNotFound(err) {
+			return fmt.Errorf("failed to delete jetstream statefulset to recreate it, err: %w", err)
+		}
+		r.logger.Infow("deleted jetstream statefulset to recreate it", "reason", reason)
+	}
+	return &RequeueError{
+		Reason:  reason,
+		Message: message,
+		After:   5 * time.Second,
+	}
+}
+
+// persistenceChanged returns whether the persistence is added to or removed from a StatefulSet spec.
+func persistenceChanged(old, new appv1.StatefulSetSpec) bool {
+	return (len(old.VolumeClaimTemplates) > 0) != (len(new.VolumeClaimTemplates) > 0)
+}
+
+// volumeSizeChanged returns the volume sizes of two StatefulSet specs, and whether they are different.
+func volumeSizeChanged(old, new appv1.StatefulSetSpec) (apiresource.Quantity, apiresource.Quantity, bool) {
+	var oldSize, newSize apiresource.Quantity
+	if len(old.VolumeClaimTemplates) > 0 {
+		oldSize = old.VolumeClaimTemplates[0].Spec.Resources.Requests[corev1.ResourceStorage]
+	}
+	if len(new.VolumeClaimTemplates) > 0 {
+		newSize = new.VolumeClaimTemplates[0].Spec.Resources.Requests[corev1.ResourceStorage]
+	}
+	if oldSize.IsZero() || newSize.IsZero() {
+		// Adding or removing the persistence is not a resize
+		return oldSize, newSize, false
+	}
+	return oldSize, newSize, oldSize.Cmp(newSize) != 0
+}
+
+// resizeVolumes resizes the existing PVCs to the persistence volume size. A PVC is expanded if its storage class
+// allows it, otherwise it is recreated, one replica at a time, relying on the stream replication to restore the data.
+func (r *jetStreamInstaller) resizeVolumes(ctx context.Context) error {
+	js := r.eventBus.Spec.JetStream
+	if js.Persistence == nil {
+		return nil
+	}
+	size := r.volumeSize()
+	ssName := generateJetStreamStatefulSetName(r.eventBus)
+	pvcPrefix := fmt.Sprintf("%s-%s-", generateJetStreamPVCName(r.eventBus), ssName)
+	pvcs, err := r.getPVCs(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get jetstream pvcs, err: %w", err)
+	}
+	toRecreate := map[int]*corev1.PersistentVolumeClaim{}
+	for i := range pvcs {
+		pvc := &pvcs[i]
+		if !strings.HasPrefix(pvc.Name, pvcPrefix) {
+			continue
+		}
+		ordinal, err := strconv.Atoi(strings.TrimPrefix(pvc.Name, pvcPrefix))
+		if err != nil {
+			continue
+		}
+		if !pvc.DeletionTimestamp.IsZero() {
+			return &RequeueError{
+				Reason:  "VolumeResizing",
+				Message: fmt.Sprintf("Waiting for the PVC %s to be recreated", pvc.Name),
+				After:   10 * time.Second,
+			}
+		}
+		current := pvc.Spec.Resources.Requests[corev1.ResourceStorage]
+		if current.Cmp(size) >= 0 {
+			continue
+		}
+		pvc.Spec.Resources.Requests[corev1.ResourceStorage] = size
+		if err := r.client.Update(ctx, pvc); err != nil {
+			if apierrors.IsInvalid(err) || apierrors.IsForbidden(err) {
+				r.logger.Infow("jetstream pvc can not be expanded, it will be recreated", "pvcName", pvc.Name, zap.Error(err))
+				toRecreate[ordinal] = pvc
+				continue
+			}
+			return fmt.Errorf("failed to expand jetstream pvc %s, err: %w", pvc.Name, err)
+		}
+		r.logger.Infow("expanded jetstream pvc", "pvcName", pvc.Name, "size", size.String())
+	}
+	if len(toRecreate) == 0 {
+		return nil
+	}
+	replicas := js.GetReplicas()
+	if replicas < 3 {
+		return fmt.Errorf("the storage class does not allow volume expansion, recreating the volumes requires at least 3 replicas")
+	}
+	if err := planActions(r.eventBus, v1alpha1.PendingAction{
+		Type:        v1alpha1.PendingActionRecreateVolumes,
+		Target:      "StatefulSet/" + ssName,
+		Description: fmt.Sprintf("Recreate the volumes one replica at a time to resize them to %s, the storage class does not allow expanding them", size.String()),
+		DataLoss:    true,
+	}); err != nil {
+		return err
+	}
+	sts := &appv1.StatefulSet{}
+	if err := r.client.Get(ctx, client.ObjectKey{Namespace: r.eventBus.Namespace, Name: ssName}, sts); err != nil {
+		return fmt.Errorf("failed to get jetstream statefulset, err: %w", err)
+	}
+	if sts.Status.ReadyReplicas < int32(replicas) {
+		return &RequeueError{
+			Reason:  "VolumeResizing",
+			Message: fmt.Sprintf("Waiting for all the replicas to be ready to recreate the next PVC, %d PVCs left", len(toRecreate)),
+			After:   10 * time.Second,
+		}
+	}
+	// Recreate the PVC of the highest ordinal first, like a rolling update
+	ordinal := -1
+	for o := range toRecreate {
+		if o > ordinal {
+			ordinal = o
+		}
+	}
+	pvc := toRecreate[ordinal]
+	if err := r.client.Delete(ctx, pvc); err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("failed to delete jetstream pvc %s, err: %w", pvc.Name, err)
+	}
+	pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: r.eventBus.Namespace, Name: fmt.Sprintf("%s-%d", ssName, ordinal)}}
+	if err := r.client.Delete(ctx, pod); err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("failed to delete jetstream pod %s, err: %w", pod.Name, err)
+	}
+	r.logger.Infow("deleted jetstream pvc and pod to recreate the volume", "pvcName", pvc.Name, "podName", pod.Name, "size", size.String())
+	return &RequeueError{
+		Reason:  "VolumeResizing",
+		Message: fmt.Sprintf("Recreating the PVC %s with size %s", pvc.Name, size.String()),
+		After:   10 * time.Second,
+	}
+}
+
+// volumeSize returns the size of the persistence volume.
+func (r *jetStreamInstaller) volumeSize() apiresource.Quantity {
+	if p := r.eventBus.Spec.JetStream.Persistence; p != nil && p.VolumeSize != nil {
+		return *p.VolumeSize
+	}
+	// Default volume size
+	return apiresource.MustParse("20Gi")
+}
+
 func (r *jetStreamInstaller) buildStatefulSetSpec(jsVersion *controllers.JetStreamVersion) appv1.StatefulSetSpec {
 	js := r.eventBus.Spec.JetStream
 	replicas := int32(js.GetReplicas())
@@ -272,7 +491,8 @@ func (r *jetStreamInstaller) buildStatefulSetSpec(jsVersion *controllers.JetStre
 			Spec: corev1.PodSpec{
 				NodeSelector:                  js.NodeSelector,
 				Tolerations:                   js.Tolerations,
-				SecurityContext:               js.SecurityContext,
+				SecurityContext:               controllerscommon.PodSecurityContext(js.SecurityContext),
+				RuntimeClassName:              js.RuntimeClassName,
 				ImagePullSecrets:              js.ImagePullSecrets,
 				PriorityClassName:             js.PriorityClassName,
 				Priority:                      js.Priority,
@@ -365,7 +585,7 @@ func (r *jetStreamInstaller) buildStatefulSetSpec(jsVersion *controllers.JetStre
 							{Name: "config-volume", MountPath: "/etc/nats-config"},
 							{Name: "pid", MountPath: "/var/run/nats"},
 						},
-						SecurityContext: jsContainerSecurityContext,
+						SecurityContext: controllerscommon.ContainerSecurityContext(jsContainerSecurityContext),
 						StartupProbe: &corev1.Probe{
 							ProbeHandler: corev1.ProbeHandler{
 								HTTPGet: &corev1.HTTPGetAction{
@@ -400,7 +620,7 @@ func (r *jetStreamInstaller) buildStatefulSetSpec(jsVersion *controllers.JetStre
 						Name:            "reloader",
 						Image:           jsVersion.ConfigReloaderImage,
 						ImagePullPolicy: reloaderContainerPullPolicy,
-						SecurityContext: reloaderContainerSecurityContext,
+						SecurityContext: controllerscommon.ContainerSecurityContext(reloaderContainerSecurityContext),
 						Args:            []string{"-pid", "/var/run/nats/nats.pid", "-config", "/etc/nats-config/nats-js.conf"},
 						VolumeMounts: []corev1.VolumeMount{
 							{Name: "config-volume", MountPath: "/etc/nats-config"},
@@ -415,7 +635,7 @@ func (r *jetStreamInstaller) buildStatefulSetSpec(jsVersion *controllers.JetStre
 							{Name: "metrics", ContainerPort: jsMetricsPort},
 						},
 						Args:            []string{"-connz", "-routez", "-subz", "-varz", "-prefix=nats", "-use_internal_server_id", "-jsz=all", fmt.Sprintf("http://localhost:%s", strconv.Itoa(int(jsMonitorPort)))},
-						SecurityContext: metricsContainerSecurityContext,
+						SecurityContext: controllerscommon.ContainerSecurityContext(metricsContainerSecurityContext),
 					},
 				},
 			},
@@ -443,13 +663,52 @@ func (r *jetStreamInstaller) buildStatefulSetSpec(jsVersion *controllers.JetStre
 		containers["reloader"].Resources = js.ReloaderContainerTemplate.Resources
 	}
 
+	if js.Tenancy != nil {
+		// The accounts of the tenant EventBuses are included in the auth config
+		var projection *corev1.ProjectedVolumeSource
+		for _, v := range spec.Template.Spec.Volumes {
+			if v.Name == "config-volume" {
+				projection = v.VolumeSource.Projected
+			}
+		}
+		projection.Sources = append(projection.Sources, corev1.VolumeProjection{
+			Secret: &corev1.SecretProjection{
+				LocalObjectReference: corev1.LocalObjectReference{
+					Name: generateJetStreamTenantsSecretName(r.eventBus),
+				},
+				Items: []corev1.KeyToPath{
+					{
+						Key:  common.JetStreamTenantsSecretKey,
+						Path: "tenants.conf",
+					},
+				},
+			},
+		})
+		// Reload the servers when a tenant EventBus is added or removed
+		containers["reloader"].Args = append(containers["reloader"].Args, "-config", "/etc/nats-config/auth.conf", "-config", "/etc/nats-config/tenants.conf")
+	}
+
+	if js.SPIFFE != nil {
+		// The servers authenticate the clients with their SPIFFE X.509 SVID, and present their own
+		readOnly := true
+		spec.Template.Spec.Volumes = append(spec.Template.Spec.Volumes, corev1.Volume{
+			Name: "spiffe",
+			VolumeSource: corev1.VolumeSource{
+				CSI: &corev1.CSIVolumeSource{Driver: js.SPIFFE.GetCSIDriver(), ReadOnly: &readOnly},
+			},
+		})
+		spiffeMount := corev1.VolumeMount{Name: "spiffe", MountPath: common.EventBusSPIFFEMountPath, ReadOnly: true}
+		containers["main"].VolumeMounts = append(containers["main"].VolumeMounts, spiffeMount)
+		// Reload the servers when the CSI driver rotates the SVID
+		containers["reloader"].VolumeMounts = append(containers["reloader"].VolumeMounts, spiffeMount)
+		for _, f := range []string{common.SPIFFECertFile, common.SPIFFEKeyFile, common.SPIFFECACertFile} {
+			containers["reloader"].Args = append(containers["reloader"].Args, "-config", path.Join(common.EventBusSPIFFEMountPath, f))
+		}
+	}
+
 	if js.Persistence != nil {
 		volMode := corev1.PersistentVolumeFilesystem
-		// Default volume size
-		volSize := apiresource.MustParse("20Gi")
-		if js.Persistence.VolumeSize != nil {
-			volSize = *js.Persistence.VolumeSize
-		}
+		volSize := r.volumeSize()
 		// Default to ReadWriteOnce
 		accessMode := corev1.ReadWriteOnce
 		if js.Persistence.AccessMode != nil {
@@ -532,18 +791,9 @@ func (r *jetStreamInstaller) createSecrets(ctx context.Context) error {
 		jsUser := common.RandomString(8)
 		jsPass := common.RandomString(16)
 		sysPassword := common.RandomString(24)
-		authTpl := template.Must(template.ParseFS(jetStremAssets, "assets/jetstream/server-auth.conf"))
-		var authTplOutput bytes.Buffer
-		if err := authTpl.Execute(&authTplOutput, struct {
-			JetStreamUser     string
-			JetStreamPassword string
-			SysPassword       string
-		}{
-			JetStreamUser:     jsUser,
-			JetStreamPassword: jsPass,
-			SysPassword:       sysPassword,
-		}); err != nil {
-			return fmt.Errorf("failed to parse nats auth template, error: %w", err)
+		auth, err := renderServerAuth(jsUser, jsPass, sysPassword, r.eventBus.Spec.JetStream.Tenancy != nil)
+		if err != nil {
+			return err
 		}
 
 		// Generate TLS self signed certificate for Jetstream bus: includes TLS private key, certificate, and CA certificate
@@ -578,7 +828,7 @@ func (r *jetStreamInstaller) createSecrets(ctx context.Context) error {
 			},
 			Type: corev1.SecretTypeOpaque,
 			Data: map[string][]byte{
-				common.JetStreamServerSecretAuthKey:       authTplOutput.Bytes(),
+				common.JetStreamServerSecretAuthKey:       auth,
 				common.JetStreamServerSecretEncryptionKey: []byte(encryptionKey),
 				common.JetStreamServerPrivateKeyKey:       serverKeyPEM,
 				common.JetStreamServerCertKey:             serverCertPEM,
@@ -655,6 +905,10 @@ func (r *jetStreamInstaller) createConfigMap(ctx context.Context) error {
 	} else {
 		confTpl = template.Must(template.ParseFS(jetStremAssets, "assets/jetstream/nats.conf"))
 	}
+	authorization, err := r.buildAuthorization()
+	if err != nil {
+		return err
+	}
 	var confTplOutput bytes.Buffer
 	if err := confTpl.Execute(&confTplOutput, struct {
 		MaxPayloadSize string
@@ -664,6 +918,7 @@ func (r *jetStreamInstaller) createConfigMap(ctx context.Context) error {
 		ClientPort     string
 		Routes         string
 		Settings       string
+		Authorization  string
 	}{
 		MaxPayloadSize: maxPayload,
 		ClusterName:    r.eventBus.Name,
@@ -672,6 +927,7 @@ func (r *jetStreamInstaller) createConfigMap(ctx context.Context) error {
 		ClientPort:     strconv.Itoa(int(jsClientPort)),
 		Routes:         strings.Join(routes, ","),
 		Settings:       settings,
+		Authorization:  authorization,
 	}); err != nil {
 		return fmt.Errorf("failed to parse nats config template, error: %w", err)
 	}
@@ -715,7 +971,59 @@ func (r *jetStreamInstaller) createConfigMap(ctx context.Context) error {
 	return nil
 }
 
+// buildAuthorization returns the authorization section of the server config, which includes the generated
+// credentials of the server secret, or authenticates the clients with their SPIFFE IDs.
+func (r *jetStreamInstaller) buildAuthorization() (string, error) {
+	x := r.eventBus.Spec.JetStream.SPIFFE
+	if x == nil {
+		return "include ./auth.conf", nil
+	}
+	serviceAccounts := x.ServiceAccounts
+	if len(serviceAccounts) == 0 {
+		serviceAccounts = []string{"default"}
+	}
+	ids := []string{}
+	for _, sa := range serviceAccounts {
+		namespace, name := r.eventBus.Namespace, sa
+		if parts := strings.SplitN(sa, "/", 2); len(parts) == 2 {
+			namespace, name = parts[0], parts[1]
+		}
+		ids = append(ids, spiffeID(x.TrustDomain, namespace, name))
+	}
+	authTpl := template.Must(template.ParseFS(jetStremAssets, "assets/jetstream/server-spiffe-auth.conf"))
+	var authTplOutput bytes.Buffer
+	if err := authTpl.Execute(&authTplOutput, struct {
+		SPIFFEIDs []string
+		CertFile  string
+		KeyFile   string
+		CAFile    string
+	}{
+		SPIFFEIDs: ids,
+		CertFile:  path.Join(common.EventBusSPIFFEMountPath, common.SPIFFECertFile),
+		KeyFile:   path.Join(common.EventBusSPIFFEMountPath, common.SPIFFEKeyFile),
+		CAFile:    path.Join(common.EventBusSPIFFEMountPath, common.SPIFFECACertFile),
+	}); err != nil {
+		return "", fmt.Errorf("failed to parse nats spiffe auth template, error: %w", err)
+	}
+	return authTplOutput.String(), nil
+}
+
+// spiffeID returns the SPIFFE ID of a service account.
+func spiffeID(trustDomain, namespace, serviceAccount string) string {
+	if serviceAccount == "" {
+		serviceAccount = "default"
+	}
+	return fmt.Sprintf("spiffe://%s/ns/%s/sa/%s", trustDomain, namespace, serviceAccount)
+}
+
 func (r *jetStreamInstaller) Uninstall(ctx context.Context) error {
+	tenants, err := r.tenantCount(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to check if there is any tenant EventBus, %w", err)
+	}
+	if tenants > 0 {
+		return fmt.Errorf("can not delete an EventBus serving %v tenant EventBuses", tenants)
+	}
 	return r.uninstallPVCs(ctx)
 }
 
@@ -752,6 +1060,27 @@ func (r *jetStreamInstaller) getPVCs(ctx context.Context) ([]corev1.PersistentVo
 	return pvcl.Items, nil
 }
 
+// renderServerAuth returns the auth config of the JetStream servers, including the accounts of the tenant EventBuses
+// if tenancy is enabled.
+func renderServerAuth(jsUser, jsPass, sysPassword string, tenancy bool) ([]byte, error) {
+	authTpl := template.Must(template.ParseFS(jetStremAssets, "assets/jetstream/server-auth.conf"))
+	var authTplOutput bytes.Buffer
+	if err := authTpl.Execute(&authTplOutput, struct {
+		JetStreamUser     string
+		JetStreamPassword string
+		SysPassword       string
+		Tenancy           bool
+	}{
+		JetStreamUser:     jsUser,
+		JetStreamPassword: jsPass,
+		SysPassword:       sysPassword,
+		Tenancy:           tenancy,
+	}); err != nil {
+		return nil, fmt.Errorf("failed to parse nats auth template, error: %w", err)
+	}
+	return authTplOutput.Bytes(), nil
+}
+
 func generateJetStreamServerSecretName(eventBus *v1alpha1.EventBus) string {
 	return fmt.Sprintf("eventbus-%s-js-server", eventBus.Name)
 }
@@ -0,0 +1,394 @@
+package installer
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"time"
+
+	nats "github.com/nats-io/nats.go"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	ctrlmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+	"sigs.k8s.io/yaml"
+
+	"github.com/argoproj/argo-events/common"
+	jetstreambase "github.com/argoproj/argo-events/eventbus/jetstream/base"
+	"github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1"
+)
+
+var (
+	storageUsagePercent = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "argo_events",
+		Name:      "eventbus_storage_usage_percent",
+		Help:      "Storage usage of the most used JetStream server of an EventBus, in percent of its storage limit. https://argoproj.github.io/argo-events/metrics/#argo_events_eventbus_storage_usage_percent",
+	}, []string{"namespace", "eventbus"})
+	retentionTightened = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "argo_events",
+		Name:      "eventbus_retention_tightened_total",
+		Help:      "How many times the retention of a low priority stream has been tightened because of the storage budget of its EventBus. https://argoproj.github.io/argo-events/metrics/#argo_events_eventbus_retention_tightened_total",
+	}, []string{"namespace", "eventbus", "stream"})
+)
+
+func init() {
+	ctrlmetrics.Registry.MustRegister(storageUsagePercent, retentionTightened)
+}
+
+// fetchJetStreamStorage returns the storage usage and limit in bytes of a JetStream server, from its monitoring
+// endpoint, it's a variable so that it can be replaced in the tests.
+var fetchJetStreamStorage = func(ctx context.Context, url string) (int64, int64, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return 0, 0, err
+	}
+	resp, err := (&http.Client{Timeout: 10 * time.Second}).Do(req)
+	if err != nil {
+		return 0, 0, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return 0, 0, fmt.Errorf("unexpected status %s", resp.Status)
+	}
+	jsz := struct {
+		Config struct {
+			MaxStorage int64 `json:"max_storage"`
+		} `json:"config"`
+		Storage int64 `json:"storage"`
+	}{}
+	if err := json.NewDecoder(resp.Body).Decode(&jsz); err != nil {
+		return 0, 0, fmt.Errorf("failed to decode the jetstream info, %w", err)
+	}
+	return jsz.Storage, jsz.Config.MaxStorage, nil
+}
+
+// jetStreamStreams manages the streams of a JetStream EventBus.
+type jetStreamStreams interface {
+	StreamInfo(stream string, opts ...nats.JSOpt) (*nats.StreamInfo, error)
+	AddStream(cfg *nats.StreamConfig, opts ...nats.JSOpt) (*nats.StreamInfo, error)
+	UpdateStream(cfg *nats.StreamConfig, opts ...nats.JSOpt) (*nats.StreamInfo, error)
+	DeleteStream(name string, opts ...nats.JSOpt) error
+}
+
+// connectJetStream connects to a JetStream EventBus as its clients do, and returns its streams and a function closing
+// the connection, it's a variable so that it can be replaced in the tests.
+var connectJetStream = func(ctx context.Context, cl client.Client, eventBus *v1alpha1.EventBus) (jetStreamStreams, func(), error) {
+	js, closeConn, err := ConnectJetStream(ctx, cl, eventBus)
+	if err != nil {
+		return nil, nil, err
+	}
+	return js, closeConn, nil
+}
+
+// ConnectJetStream connects the controller to a JetStream EventBus as its clients do, and returns the JetStream
+// context and a function closing the connection.
+func ConnectJetStream(ctx context.Context, cl client.Client, eventBus *v1alpha1.EventBus) (nats.JetStreamContext, func(), error) {
+	opts, err := jetStreamConnectOptions(ctx, cl, eventBus)
+	if err != nil {
+		return nil, nil, err
+	}
+	nc, err := nats.Connect(eventBus.Status.Config.JetStream.URL, opts...)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to connect to jetstream, %w", err)
+	}
+	js, err := nc.JetStream(nats.Context(ctx))
+	if err != nil {
+		nc.Close()
+		return nil, nil, fmt.Errorf("failed to get jetstream context, %w", err)
+	}
+	return js, nc.Close, nil
+}
+
+// jetStreamConnectOptions returns the options of the connections of the controller to a JetStream EventBus. With the
+// SPIFFE authentication, the controller authenticates with the SVID mounted in its pod. Otherwise, it authenticates
+// with the client credentials, and verifies the servers with the CA of the TLS configuration if set, or else with the
+// CA generated along with their certificate.
+func jetStreamConnectOptions(ctx context.Context, cl client.Client, eventBus *v1alpha1.EventBus) ([]nats.Option, error) {
+	config := eventBus.Status.Config.JetStream
+	opts := []nats.Option{nats.NoReconnect()}
+	if config.SPIFFE != nil {
+		return append(opts, jetstreambase.SPIFFEOptions(config.SPIFFE)...), nil
+	}
+	secret := &corev1.Secret{}
+	if err := cl.Get(ctx, client.ObjectKey{Namespace: eventBus.Namespace, Name: generateJetStreamClientAuthSecretName(eventBus)}, secret); err != nil {
+		return nil, fmt.Errorf("failed to get jetstream client auth secret, %w", err)
+	}
+	creds := struct {
+		Username string `json:"username"`
+		Password string `json:"password"`
+	}{}
+	if err := yaml.Unmarshal(secret.Data[common.JetStreamClientAuthSecretKey], &creds); err != nil {
+		return nil, fmt.Errorf("failed to parse jetstream client auth secret, %w", err)
+	}
+	tlsConfig, err := jetStreamTLSConfig(ctx, cl, eventBus)
+	if err != nil {
+		return nil, err
+	}
+	return append(opts, nats.Secure(tlsConfig), nats.UserInfo(creds.Username, creds.Password)), nil
+}
+
+// jetStreamTLSConfig returns the TLS configuration of the connections of the controller to a JetStream EventBus
+// authenticating with the client credentials.
+func jetStreamTLSConfig(ctx context.Context, cl client.Client, eventBus *v1alpha1.EventBus) (*tls.Config, error) {
+	x := eventBus.Status.Config.JetStream.TLS
+	if x == nil {
+		// The certificate of the servers is signed by the CA generated along with it
+		secret := &corev1.Secret{}
+		if err := cl.Get(ctx, client.ObjectKey{Namespace: eventBus.Namespace, Name: generateJetStreamServerSecretName(eventBus)}, secret); err != nil {
+			return nil, fmt.Errorf("failed to get jetstream server secret, %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(secret.Data[common.JetStreamServerCACertKey]) {
+			return nil, fmt.Errorf("no CA certificate found in the jetstream server secret")
+		}
+		return &tls.Config{MinVersion: tls.VersionTLS12, RootCAs: pool}, nil
+	}
+	if x.InsecureSkipVerify {
+		return &tls.Config{InsecureSkipVerify: true}, nil
+	}
+	c := &tls.Config{MinVersion: tls.VersionTLS12}
+	if x.CACertSecret != nil {
+		caCert, err := getSecretKey(ctx, cl, eventBus.Namespace, x.CACertSecret)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get the CA certificate, %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(caCert) {
+			return nil, fmt.Errorf("no CA certificate found in secret %s", x.CACertSecret.Name)
+		}
+		c.RootCAs = pool
+	}
+	if x.ClientCertSecret != nil && x.ClientKeySecret != nil {
+		certPEM, err := getSecretKey(ctx, cl, eventBus.Namespace, x.ClientCertSecret)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get the client certificate, %w", err)
+		}
+		keyPEM, err := getSecretKey(ctx, cl, eventBus.Namespace, x.ClientKeySecret)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get the client key, %w", err)
+		}
+		cert, err := tls.X509KeyPair(certPEM, keyPEM)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load the client certificate, %w", err)
+		}
+		c.Certificates = []tls.Certificate{cert}
+	}
+	return c, nil
+}
+
+// getSecretKey returns the value of a key of a Secret.
+func getSecretKey(ctx context.Context, cl client.Client, namespace string, selector *corev1.SecretKeySelector) ([]byte, error) {
+	secret := &corev1.Secret{}
+	if err := cl.Get(ctx, client.ObjectKey{Namespace: namespace, Name: selector.Name}, secret); err != nil {
+		return nil, err
+	}
+	value, ok := secret.Data[selector.Key]
+	if !ok {
+		return nil, fmt.Errorf("secret %s does not have the key %s", selector.Name, selector.Key)
+	}
+	return value, nil
+}
+
+// ReconcileStorageBudget checks the storage usage of a JetStream EventBus against its storage budget. It sets the
+// StorageWithinBudget condition, tightens the retention of the low priority streams above the critical watermark,
+// and restores it once the usage is back under the warning watermark.
+func ReconcileStorageBudget(ctx context.Context, eventBus *v1alpha1.EventBus, cl client.Client, recorder record.EventRecorder, logger *zap.SugaredLogger) {
+	var budget *v1alpha1.JetStreamStorageBudget
+	if eventBus.Spec.JetStream != nil {
+		budget = eventBus.Spec.JetStream.StorageBudget
+	}
+	if budget == nil {
+		if eventBus.Status.GetCondition(v1alpha1.EventBusConditionStorageWithinBudget) != nil {
+			eventBus.Status.ClearStorageBudget()
+			storageUsagePercent.DeleteLabelValues(eventBus.Namespace, eventBus.Name)
+		}
+		if len(eventBus.Status.TightenedStreams) > 0 {
+			if err := restoreRetention(ctx, eventBus, cl, recorder, logger); err != nil {
+				logger.Warnw("failed to restore the retention of the low priority streams", zap.Error(err))
+			}
+		}
+		return
+	}
+	if !eventBus.Status.IsReady() {
+		return
+	}
+	usage, err := jetStreamStorageUsage(ctx, eventBus)
+	if err != nil {
+		logger.Warnw("failed to get the storage usage of the jetstream servers", zap.Error(err))
+		eventBus.Status.MarkStorageBudgetUnknown("StorageUsageUnavailable", err.Error())
+		return
+	}
+	storageUsagePercent.WithLabelValues(eventBus.Namespace, eventBus.Name).Set(usage)
+
+	previous := eventBus.Status.GetCondition(v1alpha1.EventBusConditionStorageWithinBudget)
+	warning, critical := budget.GetWarningWatermark(), budget.GetCriticalWatermark()
+	var reason, message string
+	switch {
+	case usage >= float64(critical):
+		reason = "CriticalWatermark"
+		message = fmt.Sprintf("The storage usage %.0f%% is over the critical watermark %d%%.", usage, critical)
+		eventBus.Status.MarkStorageOverBudget(reason, message)
+	case usage >= float64(warning):
+		reason = "WarningWatermark"
+		message = fmt.Sprintf("The storage usage %.0f%% is over the warning watermark %d%%.", usage, warning)
+		eventBus.Status.MarkStorageOverBudget(reason, message)
+	default:
+		reason = "WithinBudget"
+		message = fmt.Sprintf("The storage usage %.0f%% is under the warning watermark %d%%.", usage, warning)
+		eventBus.Status.MarkStorageWithinBudget(reason, message)
+	}
+	if previous == nil || previous.Reason != reason {
+		if reason != "WithinBudget" {
+			logger.Warnw("storage usage is over a watermark", "usage", usage, "reason", reason)
+			recorder.Event(eventBus, corev1.EventTypeWarning, reason, message)
+		} else if previous != nil && previous.IsFalse() {
+			logger.Infow("storage usage is back under the warning watermark", "usage", usage)
+			recorder.Event(eventBus, corev1.EventTypeNormal, reason, message)
+		}
+	}
+
+	switch reason {
+	case "CriticalWatermark":
+		if err := tightenRetention(ctx, eventBus, budget, cl, recorder, logger); err != nil {
+			logger.Warnw("failed to tighten the retention of the low priority streams", zap.Error(err))
+			recorder.Event(eventBus, corev1.EventTypeWarning, "RetentionTighteningFailed", err.Error())
+		}
+	case "WithinBudget":
+		if err := restoreRetention(ctx, eventBus, cl, recorder, logger); err != nil {
+			logger.Warnw("failed to restore the retention of the low priority streams", zap.Error(err))
+		}
+	}
+}
+
+// StorageBudgetRequeueAfter returns when to check the storage usage of the EventBus again, 0 if it doesn't have a
+// storage budget.
+func StorageBudgetRequeueAfter(eventBus *v1alpha1.EventBus) time.Duration {
+	if eventBus.Spec.JetStream == nil || eventBus.Spec.JetStream.StorageBudget == nil || !eventBus.DeletionTimestamp.IsZero() {
+		return 0
+	}
+	return eventBus.Spec.JetStream.StorageBudget.GetCheckInterval()
+}
+
+// jetStreamStorageUsage returns the storage usage of the most used JetStream server, in percent of its limit.
+func jetStreamStorageUsage(ctx context.Context, eventBus *v1alpha1.EventBus) (float64, error) {
+	var usage float64
+	var lastErr error
+	found := false
+	for i := 0; i < eventBus.Spec.JetStream.GetReplicas(); i++ {
+		url := fmt.Sprintf("http://%s-%d.%s.%s.svc:%d/jsz", generateJetStreamStatefulSetName(eventBus), i, generateJetStreamServiceName(eventBus), eventBus.Namespace, jsMonitorPort)
+		used, limit, err := fetchJetStreamStorage(ctx, url)
+		if err != nil {
+			lastErr = fmt.Errorf("failed to get the storage usage of jetstream server %d, %w", i, err)
+			continue
+		}
+		if limit <= 0 {
+			continue
+		}
+		found = true
+		if u := float64(used) * 100 / float64(limit); u > usage {
+			usage = u
+		}
+	}
+	if !found {
+		if lastErr != nil {
+			return 0, lastErr
+		}
+		return 0, fmt.Errorf("the jetstream servers don't have a storage limit")
+	}
+	return usage, nil
+}
+
+// tightenRetention sets the tightened max age on the low priority streams, and records their original max age.
+func tightenRetention(ctx context.Context, eventBus *v1alpha1.EventBus, budget *v1alpha1.JetStreamStorageBudget, cl client.Client, recorder record.EventRecorder, logger *zap.SugaredLogger) error {
+	var pending []string
+	for _, name := range budget.LowPriorityStreams {
+		if _, ok := eventBus.Status.TightenedStreams[name]; !ok {
+			pending = append(pending, name)
+		}
+	}
+	if len(pending) == 0 {
+		return nil
+	}
+	streams, closeConn, err := connectJetStream(ctx, cl, eventBus)
+	if err != nil {
+		return err
+	}
+	defer closeConn()
+	maxAge := budget.GetTightenedMaxAge()
+	for _, name := range pending {
+		info, err := streams.StreamInfo(name)
+		if errors.Is(err, nats.ErrStreamNotFound) {
+			logger.Warnw("low priority stream not found", "stream", name)
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("failed to get the info of stream %s, %w", name, err)
+		}
+		original := info.Config.MaxAge
+		if original > 0 && original <= maxAge {
+			continue
+		}
+		config := info.Config
+		config.MaxAge = maxAge
+		if _, err := streams.UpdateStream(&config); err != nil {
+			return fmt.Errorf("failed to update the max age of stream %s, %w", name, err)
+		}
+		if eventBus.Status.TightenedStreams == nil {
+			eventBus.Status.TightenedStreams = map[string]string{}
+		}
+		eventBus.Status.TightenedStreams[name] = original.String()
+		retentionTightened.WithLabelValues(eventBus.Namespace, eventBus.Name, name).Inc()
+		logger.Infow("tightened the retention of the low priority stream", "stream", name, "maxAge", maxAge.String(), "originalMaxAge", original.String())
+		recorder.Eventf(eventBus, corev1.EventTypeWarning, "RetentionTightened", "The max age of stream %s has been tightened from %s to %s.", name, original, maxAge)
+	}
+	return nil
+}
+
+// restoreRetention restores the original max age of the tightened streams.
+func restoreRetention(ctx context.Context, eventBus *v1alpha1.EventBus, cl client.Client, recorder record.EventRecorder, logger *zap.SugaredLogger) error {
+	if len(eventBus.Status.TightenedStreams) == 0 {
+		return nil
+	}
+	streams, closeConn, err := connectJetStream(ctx, cl, eventBus)
+	if err != nil {
+		return err
+	}
+	defer closeConn()
+	names := make([]string, 0, len(eventBus.Status.TightenedStreams))
+	for name := range eventBus.Status.TightenedStreams {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		original, err := time.ParseDuration(eventBus.Status.TightenedStreams[name])
+		if err != nil {
+			logger.Warnw("invalid original max age of the tightened stream", "stream", name, zap.Error(err))
+			delete(eventBus.Status.TightenedStreams, name)
+			continue
+		}
+		info, err := streams.StreamInfo(name)
+		if errors.Is(err, nats.ErrStreamNotFound) {
+			delete(eventBus.Status.TightenedStreams, name)
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("failed to get the info of stream %s, %w", name, err)
+		}
+		config := info.Config
+		config.MaxAge = original
+		if _, err := streams.UpdateStream(&config); err != nil {
+			return fmt.Errorf("failed to update the max age of stream %s, %w", name, err)
+		}
+		delete(eventBus.Status.TightenedStreams, name)
+		logger.Infow("restored the retention of the low priority stream", "stream", name, "maxAge", original.String())
+		recorder.Eventf(eventBus, corev1.EventTypeNormal, "RetentionRestored", "The max age of stream %s has been restored to %s.", name, original)
+	}
+	return nil
+}
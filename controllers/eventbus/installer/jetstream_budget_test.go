@@ -0,0 +1,200 @@
+package installer
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	nats "github.com/nats-io/nats.go"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap/zaptest"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/argoproj/argo-events/common"
+	"github.com/argoproj/argo-events/common/tls"
+	apicommon "github.com/argoproj/argo-events/pkg/apis/common"
+	"github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1"
+)
+
+type fakeStreams map[string]*nats.StreamConfig
+
+func (f fakeStreams) StreamInfo(stream string, opts ...nats.JSOpt) (*nats.StreamInfo, error) {
+	config, ok := f[stream]
+	if !ok {
+		return nil, nats.ErrStreamNotFound
+	}
+	return &nats.StreamInfo{Config: *config}, nil
+}
+
+func (f fakeStreams) AddStream(cfg *nats.StreamConfig, opts ...nats.JSOpt) (*nats.StreamInfo, error) {
+	config := *cfg
+	f[cfg.Name] = &config
+	return &nats.StreamInfo{Config: config}, nil
+}
+
+func (f fakeStreams) DeleteStream(name string, opts ...nats.JSOpt) error {
+	if _, ok := f[name]; !ok {
+		return nats.ErrStreamNotFound
+	}
+	delete(f, name)
+	return nil
+}
+
+func (f fakeStreams) UpdateStream(cfg *nats.StreamConfig, opts ...nats.JSOpt) (*nats.StreamInfo, error) {
+	config := *cfg
+	f[cfg.Name] = &config
+	return &nats.StreamInfo{Config: config}, nil
+}
+
+func TestReconcileStorageBudget(t *testing.T) {
+	usage := map[string]int64{}
+	origFetch, origConnect := fetchJetStreamStorage, connectJetStream
+	defer func() { fetchJetStreamStorage, connectJetStream = origFetch, origConnect }()
+	fetchJetStreamStorage = func(ctx context.Context, url string) (int64, int64, error) {
+		for host, used := range usage {
+			if strings.Contains(url, host) {
+				return used, 100, nil
+			}
+		}
+		return 0, 100, nil
+	}
+	streams := fakeStreams{
+		"KV_my-sensor": {Name: "KV_my-sensor"},
+		"default":      {Name: "default", MaxAge: 72 * time.Hour},
+	}
+	connectJetStream = func(ctx context.Context, cl client.Client, eventBus *v1alpha1.EventBus) (jetStreamStreams, func(), error) {
+		return streams, func() {}, nil
+	}
+
+	eventBus := testJetStreamEventBus.DeepCopy()
+	eventBus.Spec.JetStream.StorageBudget = &v1alpha1.JetStreamStorageBudget{
+		WarningWatermark:   ptr.To[int32](70),
+		LowPriorityStreams: []string{"KV_my-sensor", "KV_missing"},
+		TightenedMaxAge:    "30m",
+	}
+	eventBus.Status.InitConditions()
+	eventBus.Status.MarkDeployed("test", "test")
+	eventBus.Status.MarkConfigured()
+	recorder := record.NewFakeRecorder(10)
+	logger := zaptest.NewLogger(t).Sugar()
+
+	ReconcileStorageBudget(context.Background(), eventBus, nil, recorder, logger)
+	c := eventBus.Status.GetCondition(v1alpha1.EventBusConditionStorageWithinBudget)
+	assert.True(t, c.IsTrue())
+	assert.Empty(t, recorder.Events)
+
+	// The usage of the most used server is compared to the watermarks
+	usage["-js-1."] = 75
+	ReconcileStorageBudget(context.Background(), eventBus, nil, recorder, logger)
+	c = eventBus.Status.GetCondition(v1alpha1.EventBusConditionStorageWithinBudget)
+	assert.True(t, c.IsFalse())
+	assert.Equal(t, "WarningWatermark", c.Reason)
+	assert.True(t, eventBus.Status.IsReady())
+	assert.Contains(t, <-recorder.Events, "Warning WarningWatermark")
+	assert.Empty(t, eventBus.Status.TightenedStreams)
+
+	usage["-js-2."] = 95
+	ReconcileStorageBudget(context.Background(), eventBus, nil, recorder, logger)
+	c = eventBus.Status.GetCondition(v1alpha1.EventBusConditionStorageWithinBudget)
+	assert.Equal(t, "CriticalWatermark", c.Reason)
+	assert.Contains(t, <-recorder.Events, "Warning CriticalWatermark")
+	assert.Contains(t, <-recorder.Events, "Warning RetentionTightened")
+	assert.Equal(t, map[string]string{"KV_my-sensor": "0s"}, eventBus.Status.TightenedStreams)
+	assert.Equal(t, 30*time.Minute, streams["KV_my-sensor"].MaxAge)
+	assert.Equal(t, 72*time.Hour, streams["default"].MaxAge)
+
+	// The retention is kept tightened until the usage is back under the warning watermark
+	usage["-js-2."] = 80
+	ReconcileStorageBudget(context.Background(), eventBus, nil, recorder, logger)
+	assert.Contains(t, <-recorder.Events, "Warning WarningWatermark")
+	assert.Equal(t, 30*time.Minute, streams["KV_my-sensor"].MaxAge)
+
+	usage = map[string]int64{}
+	ReconcileStorageBudget(context.Background(), eventBus, nil, recorder, logger)
+	assert.True(t, eventBus.Status.GetCondition(v1alpha1.EventBusConditionStorageWithinBudget).IsTrue())
+	assert.Contains(t, <-recorder.Events, "Normal WithinBudget")
+	assert.Contains(t, <-recorder.Events, "Normal RetentionRestored")
+	assert.Empty(t, eventBus.Status.TightenedStreams)
+	assert.Equal(t, time.Duration(0), streams["KV_my-sensor"].MaxAge)
+
+	eventBus.Spec.JetStream.StorageBudget = nil
+	ReconcileStorageBudget(context.Background(), eventBus, nil, recorder, logger)
+	assert.Nil(t, eventBus.Status.GetCondition(v1alpha1.EventBusConditionStorageWithinBudget))
+	assert.Equal(t, time.Duration(0), StorageBudgetRequeueAfter(eventBus))
+}
+
+func TestJetStreamConnectOptions(t *testing.T) {
+	eventBus := testJetStreamEventBus.DeepCopy()
+	eventBus.Status.Config.JetStream = &v1alpha1.JetStreamConfig{URL: "nats://eventbus-test-js-svc.test-ns.svc:4222"}
+	_, _, caCert, err := tls.CreateCerts("io.argoproj", []string{"eventbus-test-js-svc.test-ns.svc"}, time.Now().Add(time.Hour), true, false)
+	assert.NoError(t, err)
+	cl := fake.NewClientBuilder().WithObjects(
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Namespace: eventBus.Namespace, Name: generateJetStreamClientAuthSecretName(eventBus)},
+			Data:       map[string][]byte{common.JetStreamClientAuthSecretKey: []byte("username: user\npassword: pass")},
+		},
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Namespace: eventBus.Namespace, Name: generateJetStreamServerSecretName(eventBus)},
+			Data:       map[string][]byte{common.JetStreamServerCACertKey: caCert},
+		},
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Namespace: eventBus.Namespace, Name: "remote-ca"},
+			Data:       map[string][]byte{"ca.crt": caCert},
+		},
+	).Build()
+	apply := func(opts []nats.Option) nats.Options {
+		o := nats.GetDefaultOptions()
+		for _, opt := range opts {
+			assert.NoError(t, opt(&o))
+		}
+		return o
+	}
+
+	t.Run("test generated ca", func(t *testing.T) {
+		opts, err := jetStreamConnectOptions(context.Background(), cl, eventBus)
+		assert.NoError(t, err)
+		o := apply(opts)
+		assert.Equal(t, "user", o.User)
+		assert.Equal(t, "pass", o.Password)
+		assert.False(t, o.TLSConfig.InsecureSkipVerify)
+		assert.NotNil(t, o.TLSConfig.RootCAs)
+	})
+
+	t.Run("test tls config", func(t *testing.T) {
+		eb := eventBus.DeepCopy()
+		eb.Status.Config.JetStream.TLS = &apicommon.TLSConfig{
+			CACertSecret: &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "remote-ca"}, Key: "ca.crt"},
+		}
+		opts, err := jetStreamConnectOptions(context.Background(), cl, eb)
+		assert.NoError(t, err)
+		o := apply(opts)
+		assert.False(t, o.TLSConfig.InsecureSkipVerify)
+		assert.NotNil(t, o.TLSConfig.RootCAs)
+
+		eb.Status.Config.JetStream.TLS.CACertSecret.Key = "missing"
+		_, err = jetStreamConnectOptions(context.Background(), cl, eb)
+		assert.ErrorContains(t, err, "does not have the key")
+	})
+
+	t.Run("test spiffe", func(t *testing.T) {
+		eb := eventBus.DeepCopy()
+		eb.Status.Config.JetStream.SPIFFE = &v1alpha1.SPIFFEConfig{ServerID: "spiffe://cluster.local/ns/test-ns/sa/nats"}
+		opts, err := jetStreamConnectOptions(context.Background(), fake.NewClientBuilder().Build(), eb)
+		assert.NoError(t, err)
+		o := nats.GetDefaultOptions()
+		for _, opt := range opts {
+			if err := opt(&o); err != nil {
+				// The SVID is read from the files mounted in the controller pod
+				assert.ErrorContains(t, err, "error loading client certificate")
+			}
+		}
+		assert.Empty(t, o.User)
+		assert.NotNil(t, o.TLSConfig.VerifyPeerCertificate)
+	})
+}
@@ -0,0 +1,232 @@
+package installer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	nats "github.com/nats-io/nats.go"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/argoproj/argo-events/common"
+	"github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1"
+)
+
+// mirrorsRetryInterval is how often the creation of the mirrors is retried while the stream of the events doesn't
+// exist yet.
+const mirrorsRetryInterval = time.Minute
+
+// ReconcileMirrors creates, updates and deletes the mirror streams of a JetStream EventBus, so that they match its
+// spec. The mirror streams are created once the stream of the events has been created by an EventSource or a Sensor.
+// The deletions and the recreations of the mirror streams are planned like the other destructive actions, a
+// RequeueError is returned while they wait for their plan, the other failures are only reported.
+func ReconcileMirrors(ctx context.Context, eventBus *v1alpha1.EventBus, cl client.Client, recorder record.EventRecorder, logger *zap.SugaredLogger) error {
+	var mirrors []v1alpha1.JetStreamMirror
+	if eventBus.Spec.JetStream != nil {
+		mirrors = eventBus.Spec.JetStream.Mirrors
+	}
+	if len(mirrors) == 0 && len(eventBus.Status.Mirrors) == 0 {
+		return nil
+	}
+	if eventBus.Spec.JetStream == nil {
+		// The streams are gone with the JetStream servers
+		eventBus.Status.Mirrors = nil
+		return nil
+	}
+	if !eventBus.Status.IsReady() {
+		return nil
+	}
+	planErr, err := reconcileMirrors(ctx, eventBus, mirrors, cl, recorder, logger)
+	if err != nil {
+		logger.Warnw("failed to reconcile the mirror streams", zap.Error(err))
+		recorder.Event(eventBus, corev1.EventTypeWarning, "MirrorsReconcileFailed", err.Error())
+	}
+	return planErr
+}
+
+// MirrorsRequeueAfter returns when to reconcile the mirror streams of the EventBus again, 0 if all of them are
+// created.
+func MirrorsRequeueAfter(eventBus *v1alpha1.EventBus) time.Duration {
+	if eventBus.Spec.JetStream == nil || !eventBus.DeletionTimestamp.IsZero() {
+		return 0
+	}
+	created := make(map[string]bool, len(eventBus.Status.Mirrors))
+	for _, name := range eventBus.Status.Mirrors {
+		created[name] = true
+	}
+	for _, m := range eventBus.Spec.JetStream.Mirrors {
+		if !created[m.Name] {
+			return mirrorsRetryInterval
+		}
+	}
+	return 0
+}
+
+// reconcileMirrors returns the error of the plan of the destructive actions, if they can't be executed yet, and the
+// failures to reconcile the mirror streams.
+func reconcileMirrors(ctx context.Context, eventBus *v1alpha1.EventBus, mirrors []v1alpha1.JetStreamMirror, cl client.Client, recorder record.EventRecorder, logger *zap.SugaredLogger) (error, error) {
+	streams, closeConn, err := connectJetStream(ctx, cl, eventBus)
+	if err != nil {
+		return nil, err
+	}
+	defer closeConn()
+
+	wanted := make(map[string]bool, len(mirrors))
+	for _, m := range mirrors {
+		wanted[m.Name] = true
+	}
+	var errs []error
+	var actions []v1alpha1.PendingAction
+	var removed []string
+	for _, name := range eventBus.Status.Mirrors {
+		if wanted[name] {
+			continue
+		}
+		removed = append(removed, name)
+		actions = append(actions, v1alpha1.PendingAction{
+			Type:        v1alpha1.PendingActionDeleteMirror,
+			Target:      "Stream/" + name,
+			Description: fmt.Sprintf("Delete the mirror stream %s removed from the spec", name),
+			DataLoss:    true,
+		})
+	}
+
+	var source *nats.StreamInfo
+	infos := make(map[string]*nats.StreamInfo, len(mirrors))
+	if len(mirrors) > 0 {
+		source, err = streams.StreamInfo(common.JetStreamStreamName)
+		if errors.Is(err, nats.ErrStreamNotFound) {
+			logger.Infow("the stream of the events doesn't exist yet, the mirror streams will be created later", "stream", common.JetStreamStreamName)
+			source, err = nil, nil
+		}
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to get the info of stream %s, %w", common.JetStreamStreamName, err))
+			source = nil
+		}
+		for _, m := range mirrors {
+			info, err := streams.StreamInfo(m.Name)
+			if err != nil && !errors.Is(err, nats.ErrStreamNotFound) {
+				errs = append(errs, fmt.Errorf("failed to get the info of mirror stream %s, %w", m.Name, err))
+				continue
+			}
+			infos[m.Name] = info
+			if err != nil || source == nil || info.Config.Mirror == nil || info.Config.Mirror.Name != common.JetStreamStreamName {
+				continue
+			}
+			if filter := m.FilterSubject(common.JetStreamStreamName); info.Config.Mirror.FilterSubject != filter {
+				actions = append(actions, v1alpha1.PendingAction{
+					Type:        v1alpha1.PendingActionRecreateMirror,
+					Target:      "Stream/" + m.Name,
+					Description: fmt.Sprintf("Recreate the mirror stream %s to mirror %s instead of %s, the events still in the stream of the events are mirrored again", m.Name, filter, info.Config.Mirror.FilterSubject),
+					DataLoss:    true,
+				})
+			}
+		}
+	}
+	// The deletions and the recreations wait for their plan, the mirror streams are created and updated meanwhile
+	var planErr error
+	if len(actions) > 0 {
+		planErr = planActions(eventBus, actions...)
+	}
+
+	created := []string{}
+	for _, name := range removed {
+		if planErr != nil {
+			created = append(created, name)
+			continue
+		}
+		if err := streams.DeleteStream(name); err != nil && !errors.Is(err, nats.ErrStreamNotFound) {
+			errs = append(errs, fmt.Errorf("failed to delete mirror stream %s, %w", name, err))
+			created = append(created, name)
+			continue
+		}
+		logger.Infow("deleted the mirror stream", "stream", name)
+		recorder.Eventf(eventBus, corev1.EventTypeNormal, "MirrorDeleted", "The mirror stream %s has been deleted.", name)
+	}
+
+	for _, m := range mirrors {
+		info, found := infos[m.Name]
+		if !found {
+			continue
+		}
+		exists := info != nil
+		if exists && (info.Config.Mirror == nil || info.Config.Mirror.Name != common.JetStreamStreamName) {
+			errs = append(errs, fmt.Errorf("stream %s already exists and isn't a mirror of stream %s", m.Name, common.JetStreamStreamName))
+			continue
+		}
+		if source == nil {
+			if exists {
+				created = append(created, m.Name)
+			}
+			continue
+		}
+		config := mirrorStreamConfig(m, source.Config)
+		switch {
+		case !exists:
+			if _, err := streams.AddStream(config); err != nil {
+				errs = append(errs, fmt.Errorf("failed to create mirror stream %s, %w", m.Name, err))
+				continue
+			}
+			logger.Infow("created the mirror stream", "stream", m.Name, "filterSubject", config.Mirror.FilterSubject)
+			recorder.Eventf(eventBus, corev1.EventTypeNormal, "MirrorCreated", "The mirror stream %s has been created.", m.Name)
+		case info.Config.Mirror.FilterSubject != config.Mirror.FilterSubject:
+			if planErr != nil {
+				break
+			}
+			// The source of a mirror can't be updated
+			if err := streams.DeleteStream(m.Name); err != nil {
+				errs = append(errs, fmt.Errorf("failed to delete mirror stream %s, %w", m.Name, err))
+				created = append(created, m.Name)
+				continue
+			}
+			if _, err := streams.AddStream(config); err != nil {
+				errs = append(errs, fmt.Errorf("failed to recreate mirror stream %s, %w", m.Name, err))
+				continue
+			}
+			logger.Infow("recreated the mirror stream with a new filter subject", "stream", m.Name, "filterSubject", config.Mirror.FilterSubject)
+			recorder.Eventf(eventBus, corev1.EventTypeNormal, "MirrorRecreated", "The mirror stream %s has been recreated to mirror %s.", m.Name, config.Mirror.FilterSubject)
+		case info.Config.MaxAge != config.MaxAge || info.Config.Replicas != config.Replicas:
+			update := info.Config
+			update.MaxAge, update.Replicas = config.MaxAge, config.Replicas
+			if _, err := streams.UpdateStream(&update); err != nil {
+				errs = append(errs, fmt.Errorf("failed to update mirror stream %s, %w", m.Name, err))
+				created = append(created, m.Name)
+				continue
+			}
+			logger.Infow("updated the mirror stream", "stream", m.Name, "maxAge", config.MaxAge.String(), "replicas", config.Replicas)
+		}
+		created = append(created, m.Name)
+	}
+	sort.Strings(created)
+	eventBus.Status.Mirrors = created
+	if len(created) == 0 {
+		eventBus.Status.Mirrors = nil
+	}
+	return planErr, errors.Join(errs...)
+}
+
+// mirrorStreamConfig returns the config of a mirror stream, its max age and replicas default to the ones of the
+// stream of the events.
+func mirrorStreamConfig(mirror v1alpha1.JetStreamMirror, source nats.StreamConfig) *nats.StreamConfig {
+	config := &nats.StreamConfig{
+		Name:      mirror.Name,
+		Mirror:    &nats.StreamSource{Name: common.JetStreamStreamName, FilterSubject: mirror.FilterSubject(common.JetStreamStreamName)},
+		Retention: nats.LimitsPolicy,
+		Discard:   nats.DiscardOld,
+		Storage:   nats.FileStorage,
+		MaxAge:    source.MaxAge,
+		Replicas:  source.Replicas,
+	}
+	if d, err := time.ParseDuration(mirror.MaxAge); err == nil && d > 0 {
+		config.MaxAge = d
+	}
+	if mirror.Replicas != nil {
+		config.Replicas = int(*mirror.Replicas)
+	}
+	return config
+}
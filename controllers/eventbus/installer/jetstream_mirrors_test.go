@@ -0,0 +1,118 @@
+package installer
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	nats "github.com/nats-io/nats.go"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap/zaptest"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/argoproj/argo-events/common"
+	"github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1"
+)
+
+func TestReconcileMirrors(t *testing.T) {
+	origConnect := connectJetStream
+	defer func() { connectJetStream = origConnect }()
+	streams := fakeStreams{
+		"other": {Name: "other"},
+	}
+	connectJetStream = func(ctx context.Context, cl client.Client, eventBus *v1alpha1.EventBus) (jetStreamStreams, func(), error) {
+		return streams, func() {}, nil
+	}
+
+	eventBus := testJetStreamEventBus.DeepCopy()
+	eventBus.Spec.JetStream.Mirrors = []v1alpha1.JetStreamMirror{
+		{Name: "analytics"},
+		{Name: "analytics-webhook", EventSourceName: "webhook", MaxAge: "168h", Replicas: ptr.To[int32](1)},
+	}
+	eventBus.Status.InitConditions()
+	eventBus.Status.MarkDeployed("test", "test")
+	eventBus.Status.MarkConfigured()
+	recorder := record.NewFakeRecorder(10)
+	logger := zaptest.NewLogger(t).Sugar()
+	ctx := context.Background()
+
+	// The stream of the events doesn't exist yet
+	assert.NoError(t, ReconcileMirrors(ctx, eventBus, nil, recorder, logger))
+	assert.Empty(t, eventBus.Status.Mirrors)
+	assert.Equal(t, mirrorsRetryInterval, MirrorsRequeueAfter(eventBus))
+
+	streams["default"] = &nats.StreamConfig{Name: "default", MaxAge: 72 * time.Hour, Replicas: 3}
+	assert.NoError(t, ReconcileMirrors(ctx, eventBus, nil, recorder, logger))
+	assert.Equal(t, []string{"analytics", "analytics-webhook"}, eventBus.Status.Mirrors)
+	assert.Equal(t, time.Duration(0), MirrorsRequeueAfter(eventBus))
+	assert.Contains(t, <-recorder.Events, "Normal MirrorCreated")
+	assert.Contains(t, <-recorder.Events, "Normal MirrorCreated")
+	assert.Equal(t, "default.*.*", streams["analytics"].Mirror.FilterSubject)
+	assert.Equal(t, 72*time.Hour, streams["analytics"].MaxAge)
+	assert.Equal(t, 3, streams["analytics"].Replicas)
+	assert.Empty(t, streams["analytics"].Subjects)
+	assert.Equal(t, "default.webhook.*", streams["analytics-webhook"].Mirror.FilterSubject)
+	assert.Equal(t, 168*time.Hour, streams["analytics-webhook"].MaxAge)
+	assert.Equal(t, 1, streams["analytics-webhook"].Replicas)
+
+	t.Run("test update", func(t *testing.T) {
+		eventBus.Spec.JetStream.Mirrors[0].MaxAge = "24h"
+		eventBus.Spec.JetStream.Mirrors[1].EventName = "push"
+		eventBus.Generation++
+		var requeueErr *RequeueError
+		assert.True(t, errors.As(ReconcileMirrors(ctx, eventBus, nil, recorder, logger), &requeueErr))
+		assert.Equal(t, "ActionsPlanned", requeueErr.Reason)
+		assert.Equal(t, 24*time.Hour, streams["analytics"].MaxAge)
+		assert.Equal(t, "default.webhook.*", streams["analytics-webhook"].Mirror.FilterSubject)
+		plan := eventBus.Status.PendingActions
+		assert.Len(t, plan.Actions, 1)
+		assert.Equal(t, v1alpha1.PendingActionRecreateMirror, plan.Actions[0].Type)
+		assert.Equal(t, "Stream/analytics-webhook", plan.Actions[0].Target)
+		assert.True(t, plan.Actions[0].DataLoss)
+
+		assert.NoError(t, ReconcileMirrors(ctx, eventBus, nil, recorder, logger))
+		assert.Contains(t, <-recorder.Events, "Normal MirrorRecreated")
+		assert.Equal(t, "default.webhook.push", streams["analytics-webhook"].Mirror.FilterSubject)
+		assert.Equal(t, []string{"analytics", "analytics-webhook"}, eventBus.Status.Mirrors)
+	})
+
+	t.Run("test not a mirror", func(t *testing.T) {
+		eventBus.Spec.JetStream.Mirrors = append(eventBus.Spec.JetStream.Mirrors, v1alpha1.JetStreamMirror{Name: "other"})
+		assert.NoError(t, ReconcileMirrors(ctx, eventBus, nil, recorder, logger))
+		assert.Contains(t, <-recorder.Events, "Warning MirrorsReconcileFailed")
+		assert.Equal(t, []string{"analytics", "analytics-webhook"}, eventBus.Status.Mirrors)
+		assert.Nil(t, streams["other"].Mirror)
+	})
+
+	t.Run("test delete", func(t *testing.T) {
+		eventBus.Spec.JetStream.Mirrors = eventBus.Spec.JetStream.Mirrors[1:2]
+		eventBus.Generation++
+		eventBus.Spec.RequirePlanApproval = true
+		var requeueErr *RequeueError
+		assert.True(t, errors.As(ReconcileMirrors(ctx, eventBus, nil, recorder, logger), &requeueErr))
+		assert.Equal(t, "PlanApprovalRequired", requeueErr.Reason)
+		assert.Contains(t, streams, "analytics")
+		assert.Equal(t, []string{"analytics", "analytics-webhook"}, eventBus.Status.Mirrors)
+		plan := eventBus.Status.PendingActions
+		assert.Equal(t, v1alpha1.PendingActionDeleteMirror, plan.Actions[0].Type)
+		assert.False(t, plan.Approved)
+
+		eventBus.Annotations = map[string]string{common.AnnotationApprovedPlan: plan.ID}
+		assert.NoError(t, ReconcileMirrors(ctx, eventBus, nil, recorder, logger))
+		assert.Contains(t, <-recorder.Events, "Normal MirrorDeleted")
+		assert.Equal(t, []string{"analytics-webhook"}, eventBus.Status.Mirrors)
+		assert.NotContains(t, streams, "analytics")
+		assert.Contains(t, streams, "other")
+
+		eventBus.Spec.JetStream.Mirrors = nil
+		eventBus.Spec.RequirePlanApproval = false
+		assert.Error(t, ReconcileMirrors(ctx, eventBus, nil, recorder, logger))
+		assert.NoError(t, ReconcileMirrors(ctx, eventBus, nil, recorder, logger))
+		assert.Contains(t, <-recorder.Events, "Normal MirrorDeleted")
+		assert.Empty(t, eventBus.Status.Mirrors)
+		assert.Len(t, streams, 2)
+	})
+}
@@ -0,0 +1,223 @@
+package installer
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	"github.com/argoproj/argo-events/common"
+	"github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1"
+)
+
+const (
+	// tenantsInclude includes the accounts of the tenant EventBuses in the accounts of the server.
+	tenantsInclude = "include ./tenants.conf"
+	// tenantsHeader is the beginning of the accounts of the tenant EventBuses, which can not be empty.
+	tenantsHeader = "# Accounts of the tenant EventBuses\n"
+)
+
+// reconcileTenancy makes the JetStream server serve the accounts of the tenant EventBuses if the tenancy is enabled.
+func (r *jetStreamInstaller) reconcileTenancy(ctx context.Context) error {
+	tenancy := r.eventBus.Spec.JetStream.Tenancy
+	if tenancy != nil {
+		if err := r.createTenantsSecret(ctx, tenancy); err != nil {
+			return err
+		}
+	}
+	return r.reconcileTenantsInclude(ctx, tenancy != nil)
+}
+
+// createTenantsSecret creates the secret holding the accounts of the tenant EventBuses, and removes the accounts
+// of the namespaces which are not allowed anymore.
+func (r *jetStreamInstaller) createTenantsSecret(ctx context.Context, tenancy *v1alpha1.JetStreamTenancy) error {
+	secret := &corev1.Secret{}
+	if err := r.client.Get(ctx, client.ObjectKey{Namespace: r.eventBus.Namespace, Name: generateJetStreamTenantsSecretName(r.eventBus)}, secret); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return fmt.Errorf("failed to check if jetstream tenants secret is existing, err: %w", err)
+		}
+		secret = &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: r.eventBus.Namespace,
+				Name:      generateJetStreamTenantsSecretName(r.eventBus),
+				Labels:    r.labels,
+				OwnerReferences: []metav1.OwnerReference{
+					*metav1.NewControllerRef(r.eventBus.GetObjectMeta(), v1alpha1.SchemaGroupVersionKind),
+				},
+			},
+			Type: corev1.SecretTypeOpaque,
+			Data: map[string][]byte{
+				common.JetStreamTenantsSecretKey: []byte(tenantsHeader),
+			},
+		}
+		if err := r.client.Create(ctx, secret); err != nil {
+			return fmt.Errorf("failed to create jetstream tenants secret, err: %w", err)
+		}
+		r.logger.Info("created jetstream tenants secret successfully")
+		return nil
+	}
+	changed := false
+	for key := range secret.Data {
+		if namespace, _, ok := parseTenantAccountKey(key); ok && !tenancy.IsAllowed(namespace) {
+			delete(secret.Data, key)
+			changed = true
+		}
+	}
+	if !changed {
+		return nil
+	}
+	secret.Data[common.JetStreamTenantsSecretKey] = renderTenantAccounts(secret.Data)
+	if err := r.client.Update(ctx, secret); err != nil {
+		return fmt.Errorf("failed to update jetstream tenants secret, err: %w", err)
+	}
+	r.logger.Info("removed the accounts of the tenants not allowed anymore")
+	return nil
+}
+
+// reconcileTenantsInclude renders the server auth config again if the include of the tenant accounts is added or removed.
+func (r *jetStreamInstaller) reconcileTenantsInclude(ctx context.Context, enabled bool) error {
+	secret := &corev1.Secret{}
+	if err := r.client.Get(ctx, client.ObjectKey{Namespace: r.eventBus.Namespace, Name: generateJetStreamServerSecretName(r.eventBus)}, secret); err != nil {
+		return fmt.Errorf("failed to get nats server auth secret, err: %w", err)
+	}
+	if strings.Contains(string(secret.Data[common.JetStreamServerSecretAuthKey]), tenantsInclude) == enabled {
+		return nil
+	}
+	clientSecret := &corev1.Secret{}
+	if err := r.client.Get(ctx, client.ObjectKey{Namespace: r.eventBus.Namespace, Name: generateJetStreamClientAuthSecretName(r.eventBus)}, clientSecret); err != nil {
+		return fmt.Errorf("failed to get nats client auth secret, err: %w", err)
+	}
+	creds := struct {
+		Username string `json:"username"`
+		Password string `json:"password"`
+	}{}
+	if err := yaml.Unmarshal(clientSecret.Data[common.JetStreamClientAuthSecretKey], &creds); err != nil {
+		return fmt.Errorf("failed to parse nats client auth secret, err: %w", err)
+	}
+	// The system account is only used by the servers, its password can be regenerated
+	auth, err := renderServerAuth(creds.Username, creds.Password, common.RandomString(24), enabled)
+	if err != nil {
+		return err
+	}
+	secret.Data[common.JetStreamServerSecretAuthKey] = auth
+	if err := r.client.Update(ctx, secret); err != nil {
+		return fmt.Errorf("failed to update nats server auth secret, err: %w", err)
+	}
+	r.logger.Infow("updated the tenant accounts of nats server auth secret successfully", "tenancy", enabled)
+	return nil
+}
+
+// tenantCount returns the number of tenant EventBuses with an account.
+func (r *jetStreamInstaller) tenantCount(ctx context.Context) (int, error) {
+	secret := &corev1.Secret{}
+	if err := r.client.Get(ctx, client.ObjectKey{Namespace: r.eventBus.Namespace, Name: generateJetStreamTenantsSecretName(r.eventBus)}, secret); err != nil {
+		if apierrors.IsNotFound(err) {
+			return 0, nil
+		}
+		return 0, err
+	}
+	count := 0
+	for key := range secret.Data {
+		if _, _, ok := parseTenantAccountKey(key); ok {
+			count++
+		}
+	}
+	return count, nil
+}
+
+// tenantAccountKey returns the key of the account of a tenant EventBus in the tenants secret. Namespaces can not
+// contain dots, unlike EventBus names.
+func tenantAccountKey(namespace, name string) string {
+	return namespace + "." + name
+}
+
+func parseTenantAccountKey(key string) (string, string, bool) {
+	if key == common.JetStreamTenantsSecretKey {
+		return "", "", false
+	}
+	namespace, name, ok := strings.Cut(key, ".")
+	return namespace, name, ok
+}
+
+// natsAccount is an account of the server config, which accepts JSON.
+type natsAccount struct {
+	// JetStream is true, or the limits of the account
+	JetStream interface{} `json:"jetstream"`
+	Users     []natsUser  `json:"users"`
+}
+
+type natsUser struct {
+	User string `json:"user"`
+	Pass string `json:"pass"`
+}
+
+type natsJetStreamLimits struct {
+	MaxMemory    *int64 `json:"max_mem,omitempty"`
+	MaxFile      *int64 `json:"max_file,omitempty"`
+	MaxStreams   *int32 `json:"max_streams,omitempty"`
+	MaxConsumers *int32 `json:"max_consumers,omitempty"`
+}
+
+// tenantAccount returns the account of a tenant EventBus in the server config, with its own JetStream limited by
+// the limits of the shared EventBus.
+func tenantAccount(namespace, name, username, password string, limits *v1alpha1.JetStreamAccountLimits) ([]byte, error) {
+	account := natsAccount{
+		JetStream: true,
+		Users:     []natsUser{{User: username, Pass: password}},
+	}
+	if limits != nil {
+		jsLimits := natsJetStreamLimits{MaxStreams: limits.MaxStreams, MaxConsumers: limits.MaxConsumers}
+		if limits.MaxMemory != nil {
+			v := limits.MaxMemory.Value()
+			jsLimits.MaxMemory = &v
+		}
+		if limits.MaxStorage != nil {
+			v := limits.MaxStorage.Value()
+			jsLimits.MaxFile = &v
+		}
+		account.JetStream = jsLimits
+	}
+	key, err := json.Marshal(namespace + "/" + name)
+	if err != nil {
+		return nil, err
+	}
+	value, err := json.MarshalIndent(account, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	buf.Write(key)
+	buf.WriteString(": ")
+	buf.Write(value)
+	buf.WriteString("\n")
+	return buf.Bytes(), nil
+}
+
+// renderTenantAccounts returns the accounts of the tenant EventBuses included in the server config.
+func renderTenantAccounts(data map[string][]byte) []byte {
+	keys := []string{}
+	for key := range data {
+		if _, _, ok := parseTenantAccountKey(key); ok {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	var buf bytes.Buffer
+	buf.WriteString(tenantsHeader)
+	for _, key := range keys {
+		buf.Write(data[key])
+	}
+	return buf.Bytes()
+}
+
+func generateJetStreamTenantsSecretName(eventBus *v1alpha1.EventBus) string {
+	return fmt.Sprintf("eventbus-%s-js-tenants", eventBus.Name)
+}
@@ -2,7 +2,8 @@ package installer
 
 import (
 	"context"
-
+	"errors"
+	"fmt"
 	"testing"
 
 	"github.com/argoproj/argo-events/common"
@@ -11,11 +12,16 @@ import (
 	"go.uber.org/zap/zaptest"
 	appv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	apiresource "k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	k8sfake "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
 )
 
 var (
@@ -168,6 +174,213 @@ func TestJetStreamCreateObjects(t *testing.T) {
 	})
 }
 
+func TestJetStreamResizeVolumes(t *testing.T) {
+	ctx := context.TODO()
+	newInstaller := func(cl client.Client, size string) *jetStreamInstaller {
+		obj := testJetStreamEventBus.DeepCopy()
+		volSize := apiresource.MustParse(size)
+		obj.Spec.JetStream.Persistence = &v1alpha1.PersistenceStrategy{VolumeSize: &volSize}
+		return &jetStreamInstaller{
+			client:     cl,
+			kubeClient: k8sfake.NewSimpleClientset(),
+			eventBus:   obj,
+			config:     fakeConfig,
+			labels:     testLabels,
+			logger:     zaptest.NewLogger(t).Sugar(),
+		}
+	}
+	newPVC := func(ordinal int, size string) *corev1.PersistentVolumeClaim {
+		return &corev1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: testNamespace,
+				Name:      fmt.Sprintf("%s-%s-%d", generateJetStreamPVCName(testJetStreamEventBus), generateJetStreamStatefulSetName(testJetStreamEventBus), ordinal),
+				Labels:    testLabels,
+			},
+			Spec: corev1.PersistentVolumeClaimSpec{
+				Resources: corev1.VolumeResourceRequirements{
+					Requests: corev1.ResourceList{corev1.ResourceStorage: apiresource.MustParse(size)},
+				},
+			},
+		}
+	}
+	getPVCSize := func(cl client.Client, ordinal int) string {
+		pvc := &corev1.PersistentVolumeClaim{}
+		err := cl.Get(ctx, client.ObjectKeyFromObject(newPVC(ordinal, "1Gi")), pvc)
+		if apierrors.IsNotFound(err) {
+			return ""
+		}
+		assert.NoError(t, err)
+		q := pvc.Spec.Resources.Requests[corev1.ResourceStorage]
+		return q.String()
+	}
+
+	t.Run("test recreate statefulset", func(t *testing.T) {
+		cl := fake.NewClientBuilder().Build()
+		assert.NoError(t, newInstaller(cl, "10Gi").createStatefulSet(ctx))
+		err := newInstaller(cl, "5Gi").createStatefulSet(ctx)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "shrinking")
+
+		i := newInstaller(cl, "20Gi")
+		err = i.createStatefulSet(ctx)
+		var requeueErr *RequeueError
+		assert.True(t, errors.As(err, &requeueErr))
+		assert.Equal(t, "ActionsPlanned", requeueErr.Reason)
+		assert.Equal(t, v1alpha1.PendingActionRecreateStatefulSet, i.eventBus.Status.PendingActions.Actions[0].Type)
+		sts := &appv1.StatefulSet{}
+		key := types.NamespacedName{Namespace: testNamespace, Name: generateJetStreamStatefulSetName(testJetStreamEventBus)}
+		assert.NoError(t, cl.Get(ctx, key, sts))
+		err = i.createStatefulSet(ctx)
+		assert.True(t, errors.As(err, &requeueErr))
+		assert.Equal(t, "VolumeResizing", requeueErr.Reason)
+		assert.True(t, apierrors.IsNotFound(cl.Get(ctx, key, sts)))
+		assert.NoError(t, newInstaller(cl, "20Gi").createStatefulSet(ctx))
+		assert.NoError(t, cl.Get(ctx, key, sts))
+		assert.Equal(t, "20Gi", sts.Spec.VolumeClaimTemplates[0].Spec.Resources.Requests.Storage().String())
+	})
+
+	t.Run("test change persistence", func(t *testing.T) {
+		cl := fake.NewClientBuilder().Build()
+		assert.NoError(t, newInstaller(cl, "10Gi").createStatefulSet(ctx))
+		i := newInstaller(cl, "10Gi")
+		i.eventBus.Spec.JetStream.Persistence = nil
+		i.eventBus.Spec.RequirePlanApproval = true
+		err := i.createStatefulSet(ctx)
+		var requeueErr *RequeueError
+		assert.True(t, errors.As(err, &requeueErr))
+		assert.Equal(t, "PlanApprovalRequired", requeueErr.Reason)
+		plan := i.eventBus.Status.PendingActions
+		assert.True(t, plan.Actions[0].DataLoss)
+		assert.False(t, plan.Approved)
+
+		i.eventBus.Annotations = map[string]string{common.AnnotationApprovedPlan: plan.ID}
+		err = i.createStatefulSet(ctx)
+		assert.True(t, errors.As(err, &requeueErr))
+		assert.Equal(t, "PersistenceChanging", requeueErr.Reason)
+		assert.True(t, i.eventBus.Status.PendingActions.Approved)
+		key := types.NamespacedName{Namespace: testNamespace, Name: generateJetStreamStatefulSetName(testJetStreamEventBus)}
+		assert.True(t, apierrors.IsNotFound(cl.Get(ctx, key, &appv1.StatefulSet{})))
+		assert.NoError(t, i.createStatefulSet(ctx))
+	})
+
+	t.Run("test expand pvcs", func(t *testing.T) {
+		cl := fake.NewClientBuilder().WithObjects(newPVC(0, "10Gi"), newPVC(1, "10Gi"), newPVC(2, "30Gi")).Build()
+		assert.NoError(t, newInstaller(cl, "20Gi").resizeVolumes(ctx))
+		assert.Equal(t, "20Gi", getPVCSize(cl, 0))
+		assert.Equal(t, "20Gi", getPVCSize(cl, 1))
+		assert.Equal(t, "30Gi", getPVCSize(cl, 2))
+	})
+
+	t.Run("test recreate pvcs", func(t *testing.T) {
+		pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: testNamespace, Name: generateJetStreamStatefulSetName(testJetStreamEventBus) + "-2"}}
+		sts := &appv1.StatefulSet{ObjectMeta: metav1.ObjectMeta{Namespace: testNamespace, Name: generateJetStreamStatefulSetName(testJetStreamEventBus)}}
+		cl := fake.NewClientBuilder().
+			WithObjects(newPVC(0, "10Gi"), newPVC(1, "10Gi"), newPVC(2, "10Gi"), pod, sts).
+			WithInterceptorFuncs(interceptor.Funcs{
+				Update: func(ctx context.Context, client client.WithWatch, obj client.Object, opts ...client.UpdateOption) error {
+					if _, ok := obj.(*corev1.PersistentVolumeClaim); ok {
+						return apierrors.NewForbidden(schema.GroupResource{Resource: "persistentvolumeclaims"}, obj.GetName(), fmt.Errorf("only dynamically provisioned pvc can be resized"))
+					}
+					return client.Update(ctx, obj, opts...)
+				},
+			}).Build()
+
+		// The recreation of the volumes is planned first
+		i := newInstaller(cl, "20Gi")
+		err := i.resizeVolumes(ctx)
+		var requeueErr *RequeueError
+		assert.True(t, errors.As(err, &requeueErr))
+		assert.Equal(t, "ActionsPlanned", requeueErr.Reason)
+		assert.True(t, i.eventBus.Status.PendingActions.Actions[0].DataLoss)
+
+		// Not all the replicas are ready
+		err = i.resizeVolumes(ctx)
+		assert.True(t, errors.As(err, &requeueErr))
+		assert.Equal(t, "10Gi", getPVCSize(cl, 2))
+
+		sts.Status.ReadyReplicas = 3
+		assert.NoError(t, cl.Status().Update(ctx, sts))
+		err = i.resizeVolumes(ctx)
+		assert.True(t, errors.As(err, &requeueErr))
+		assert.Equal(t, "", getPVCSize(cl, 2))
+		assert.Equal(t, "10Gi", getPVCSize(cl, 1))
+		assert.True(t, apierrors.IsNotFound(cl.Get(ctx, client.ObjectKeyFromObject(pod), &corev1.Pod{})))
+
+		i = newInstaller(cl, "20Gi")
+		i.eventBus.Spec.JetStream.Replicas = ptr.To[int32](1)
+		err = i.resizeVolumes(ctx)
+		assert.Error(t, err)
+		assert.False(t, errors.As(err, &requeueErr))
+	})
+}
+
+func TestJetStreamStreamMaxBytes(t *testing.T) {
+	i := &jetStreamInstaller{
+		client:     fake.NewClientBuilder().Build(),
+		kubeClient: k8sfake.NewSimpleClientset(),
+		eventBus:   testJetStreamEventBus.DeepCopy(),
+		config:     fakeConfig,
+		labels:     testLabels,
+		logger:     zaptest.NewLogger(t).Sugar(),
+	}
+	volSize := apiresource.MustParse("10Gi")
+	i.eventBus.Spec.JetStream.Persistence = &v1alpha1.PersistenceStrategy{VolumeSize: &volSize}
+	busConfig, err := i.Install(context.TODO())
+	assert.NoError(t, err)
+	assert.Contains(t, busConfig.JetStream.StreamConfig, fmt.Sprintf("maxbytes: %d", volSize.Value()/100*80))
+
+	i.client = fake.NewClientBuilder().Build()
+	i.kubeClient = k8sfake.NewSimpleClientset()
+	i.eventBus.Spec.JetStream.StreamConfig = ptr.To("maxBytes: 1024")
+	busConfig, err = i.Install(context.TODO())
+	assert.NoError(t, err)
+	assert.Contains(t, busConfig.JetStream.StreamConfig, "maxbytes: 1024")
+}
+
+func TestJetStreamSPIFFE(t *testing.T) {
+	cl := fake.NewClientBuilder().Build()
+	i := &jetStreamInstaller{
+		client:     cl,
+		kubeClient: k8sfake.NewSimpleClientset(),
+		eventBus:   testJetStreamEventBus.DeepCopy(),
+		config:     fakeConfig,
+		labels:     testLabels,
+		logger:     zaptest.NewLogger(t).Sugar(),
+	}
+	i.eventBus.Spec.JetStream.ServiceAccountName = "nats"
+	i.eventBus.Spec.JetStream.SPIFFE = &v1alpha1.SPIFFEAuth{
+		TrustDomain:     "cluster.local",
+		ServiceAccounts: []string{"sensors", "other-ns/eventsources"},
+	}
+	busConfig, err := i.Install(context.TODO())
+	assert.NoError(t, err)
+	assert.Nil(t, busConfig.JetStream.AccessSecret)
+	assert.Equal(t, &v1alpha1.SPIFFEConfig{
+		CSIDriver: v1alpha1.DefaultSPIFFECSIDriver,
+		ServerID:  fmt.Sprintf("spiffe://cluster.local/ns/%s/sa/nats", testNamespace),
+	}, busConfig.JetStream.SPIFFE)
+
+	c := &corev1.ConfigMap{}
+	assert.NoError(t, cl.Get(context.TODO(), types.NamespacedName{Namespace: testNamespace, Name: generateJetStreamConfigMapName(i.eventBus)}, c))
+	conf := c.Data[common.JetStreamConfigMapKey]
+	assert.NotContains(t, conf, "include ./auth.conf")
+	assert.Contains(t, conf, "verify_and_map: true")
+	assert.Contains(t, conf, fmt.Sprintf(`{"user": "spiffe://cluster.local/ns/%s/sa/sensors"}`, testNamespace))
+	assert.Contains(t, conf, `{"user": "spiffe://cluster.local/ns/other-ns/sa/eventsources"}`)
+
+	sts := &appv1.StatefulSet{}
+	assert.NoError(t, cl.Get(context.TODO(), types.NamespacedName{Namespace: testNamespace, Name: generateJetStreamStatefulSetName(i.eventBus)}, sts))
+	found := false
+	for _, v := range sts.Spec.Template.Spec.Volumes {
+		if v.CSI != nil {
+			found = true
+			assert.Equal(t, v1alpha1.DefaultSPIFFECSIDriver, v.CSI.Driver)
+		}
+	}
+	assert.True(t, found)
+	assert.Contains(t, sts.Spec.Template.Spec.Containers[1].Args, common.EventBusSPIFFEMountPath+"/"+common.SPIFFECertFile)
+}
+
 func TestBuildJetStreamStatefulSetSpec(t *testing.T) {
 	cl := fake.NewClientBuilder().Build()
 	i := &jetStreamInstaller{
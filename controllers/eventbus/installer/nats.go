@@ -38,11 +38,11 @@ const (
 	serverAuthSecretKey = "auth"
 	// key of stan.conf in the configmap
 	configMapKey = "stan-config"
-
-	// default nats streaming version to be installed
-	defaultSTANVersion = "0.22.1"
 )
 
+// DefaultSTANVersion is the nats streaming version to be installed.
+const DefaultSTANVersion = "0.22.1"
+
 // natsInstaller is used create a NATS installation.
 type natsInstaller struct {
 	client     client.Client
@@ -615,7 +615,7 @@ func (i *natsInstaller) buildStatefulSet(serviceName, configmapName, authSecretN
 }
 
 func (i *natsInstaller) buildStatefulSetSpec(serviceName, configmapName, authSecretName string) (*appv1.StatefulSetSpec, error) {
-	stanVersion, err := i.config.GetSTANVersion(defaultSTANVersion)
+	stanVersion, err := i.config.GetSTANVersion(DefaultSTANVersion)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get nats streaming version, err: %w", err)
 	}
@@ -668,7 +668,8 @@ func (i *natsInstaller) buildStatefulSetSpec(serviceName, configmapName, authSec
 			Spec: corev1.PodSpec{
 				NodeSelector:       i.eventBus.Spec.NATS.Native.NodeSelector,
 				Tolerations:        i.eventBus.Spec.NATS.Native.Tolerations,
-				SecurityContext:    i.eventBus.Spec.NATS.Native.SecurityContext,
+				SecurityContext:    controllerscommon.PodSecurityContext(i.eventBus.Spec.NATS.Native.SecurityContext),
+				RuntimeClassName:   i.eventBus.Spec.NATS.Native.RuntimeClassName,
 				ImagePullSecrets:   i.eventBus.Spec.NATS.Native.ImagePullSecrets,
 				ServiceAccountName: i.eventBus.Spec.NATS.Native.ServiceAccountName,
 				PriorityClassName:  i.eventBus.Spec.NATS.Native.PriorityClassName,
@@ -731,7 +732,7 @@ func (i *natsInstaller) buildStatefulSetSpec(serviceName, configmapName, authSec
 							{Name: "config-volume", MountPath: "/etc/stan-config"},
 						},
 						Resources:       stanContainerResources,
-						SecurityContext: stanContainerSecurityContext,
+						SecurityContext: controllerscommon.ContainerSecurityContext(stanContainerSecurityContext),
 						LivenessProbe: &corev1.Probe{
 							ProbeHandler: corev1.ProbeHandler{
 								HTTPGet: &corev1.HTTPGetAction{
@@ -752,7 +753,7 @@ func (i *natsInstaller) buildStatefulSetSpec(serviceName, configmapName, authSec
 						},
 						Args:            []string{"-connz", "-routez", "-subz", "-varz", "-channelz", "-serverz", fmt.Sprintf("http://localhost:%s", strconv.Itoa(int(monitorPort)))},
 						Resources:       metricsContainerResources,
-						SecurityContext: metricsContainerSecurityContext,
+						SecurityContext: controllerscommon.ContainerSecurityContext(metricsContainerSecurityContext),
 					},
 				},
 			},
@@ -0,0 +1,60 @@
+package installer
+
+import (
+	"fmt"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-events/common"
+	"github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1"
+)
+
+// planApprovalRequeueAfter is how often a plan waiting for its approval is checked again, the approval annotation
+// also triggers a reconciliation.
+const planApprovalRequeueAfter = time.Minute
+
+// planActions writes the plan of the destructive actions to the status of the EventBus, and returns nil once the
+// actions can be executed. A new plan is only executed after it is written to the status, and after it is approved
+// if the EventBus requires the approval of the plans.
+func planActions(eventBus *v1alpha1.EventBus, actions ...v1alpha1.PendingAction) error {
+	plan := &v1alpha1.ActionPlan{
+		Version:    v1alpha1.ActionPlanVersion,
+		Generation: eventBus.Generation,
+		Actions:    actions,
+	}
+	plan.ID = planID(plan)
+	plan.Approved = !eventBus.Spec.RequirePlanApproval || eventBus.Annotations[common.AnnotationApprovedPlan] == plan.ID
+	current := eventBus.Status.PendingActions
+	if current != nil && current.ID == plan.ID {
+		plan.CreatedAt = current.CreatedAt
+		eventBus.Status.PendingActions = plan
+		if plan.Approved {
+			return nil
+		}
+	} else {
+		plan.CreatedAt = metav1.Now()
+		eventBus.Status.PendingActions = plan
+		if plan.Approved {
+			return &RequeueError{
+				Reason:  "ActionsPlanned",
+				Message: fmt.Sprintf("Planned %d destructive action(s), plan %s", len(actions), plan.ID),
+				After:   time.Second,
+			}
+		}
+	}
+	return &RequeueError{
+		Reason:  "PlanApprovalRequired",
+		Message: fmt.Sprintf("Waiting for the approval of the plan %s, annotate the EventBus with %s=%s to approve it", plan.ID, common.AnnotationApprovedPlan, plan.ID),
+		After:   planApprovalRequeueAfter,
+	}
+}
+
+// planID returns the ID of a plan, computed from its version, generation and actions.
+func planID(plan *v1alpha1.ActionPlan) string {
+	return common.MustHash(struct {
+		Version    string
+		Generation int64
+		Actions    []v1alpha1.PendingAction
+	}{plan.Version, plan.Generation, plan.Actions})[:12]
+}
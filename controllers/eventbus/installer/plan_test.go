@@ -0,0 +1,53 @@
+package installer
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/argoproj/argo-events/common"
+	"github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1"
+)
+
+func TestPlanActions(t *testing.T) {
+	action := v1alpha1.PendingAction{Type: v1alpha1.PendingActionRecreateStatefulSet, Target: "StatefulSet/eventbus-default-js"}
+	var requeueErr *RequeueError
+
+	t.Run("test plan written before execution", func(t *testing.T) {
+		obj := testJetStreamEventBus.DeepCopy()
+		obj.Generation = 2
+		err := planActions(obj, action)
+		assert.True(t, errors.As(err, &requeueErr))
+		assert.Equal(t, "ActionsPlanned", requeueErr.Reason)
+		plan := obj.Status.PendingActions
+		assert.Equal(t, v1alpha1.ActionPlanVersion, plan.Version)
+		assert.Equal(t, int64(2), plan.Generation)
+		assert.True(t, plan.Approved)
+		assert.Len(t, plan.ID, 12)
+		createdAt := plan.CreatedAt
+		assert.NoError(t, planActions(obj, action))
+		assert.Equal(t, createdAt, obj.Status.PendingActions.CreatedAt)
+
+		// A new generation is a new plan
+		obj.Generation = 3
+		assert.True(t, errors.As(planActions(obj, action), &requeueErr))
+		assert.NotEqual(t, plan.ID, obj.Status.PendingActions.ID)
+	})
+
+	t.Run("test plan approval", func(t *testing.T) {
+		obj := testJetStreamEventBus.DeepCopy()
+		obj.Spec.RequirePlanApproval = true
+		err := planActions(obj, action)
+		assert.True(t, errors.As(err, &requeueErr))
+		assert.Equal(t, "PlanApprovalRequired", requeueErr.Reason)
+		assert.False(t, obj.Status.PendingActions.Approved)
+		assert.True(t, errors.As(planActions(obj, action), &requeueErr))
+
+		obj.Annotations = map[string]string{common.AnnotationApprovedPlan: "stale"}
+		assert.True(t, errors.As(planActions(obj, action), &requeueErr))
+		obj.Annotations[common.AnnotationApprovedPlan] = obj.Status.PendingActions.ID
+		assert.NoError(t, planActions(obj, action))
+		assert.True(t, obj.Status.PendingActions.Approved)
+	})
+}
@@ -0,0 +1,195 @@
+package installer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	"github.com/argoproj/argo-events/common"
+	"github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1"
+)
+
+// sharedJetStreamInstaller installs a tenant EventBus, using its own account on a shared JetStream EventBus.
+type sharedJetStreamInstaller struct {
+	client   client.Client
+	eventBus *v1alpha1.EventBus
+	labels   map[string]string
+
+	logger *zap.SugaredLogger
+}
+
+// NewSharedJetStreamInstaller returns a new sharedJetStreamInstaller
+func NewSharedJetStreamInstaller(client client.Client, eventBus *v1alpha1.EventBus, labels map[string]string, logger *zap.SugaredLogger) Installer {
+	return &sharedJetStreamInstaller{
+		client:   client,
+		eventBus: eventBus,
+		labels:   labels,
+		logger:   logger.Named("shared-jetstream"),
+	}
+}
+
+func (i *sharedJetStreamInstaller) Install(ctx context.Context) (*v1alpha1.BusConfig, error) {
+	shared := i.eventBus.Spec.Shared
+	if shared == nil {
+		return nil, fmt.Errorf("invalid request")
+	}
+	host := &v1alpha1.EventBus{}
+	if err := i.client.Get(ctx, client.ObjectKey{Namespace: shared.Namespace, Name: shared.GetName()}, host); err != nil {
+		i.eventBus.Status.MarkDeployFailed("SharedEventBusNotFound", err.Error())
+		return nil, fmt.Errorf("failed to get the shared eventbus %s/%s, %w", shared.Namespace, shared.GetName(), err)
+	}
+	if host.Spec.JetStream == nil || host.Spec.JetStream.Tenancy == nil {
+		err := fmt.Errorf("eventbus %s/%s does not serve tenant eventbuses", host.Namespace, host.Name)
+		i.eventBus.Status.MarkDeployFailed("SharedEventBusNotServing", err.Error())
+		return nil, err
+	}
+	if !host.Spec.JetStream.Tenancy.IsAllowed(i.eventBus.Namespace) {
+		err := fmt.Errorf("eventbus %s/%s does not serve the namespace %s", host.Namespace, host.Name, i.eventBus.Namespace)
+		i.eventBus.Status.MarkDeployFailed("SharedEventBusNotAllowed", err.Error())
+		return nil, err
+	}
+	if host.Status.Config.JetStream == nil {
+		i.eventBus.Status.MarkDeploying("SharedEventBusNotReady", "Waiting for the shared EventBus to be deployed")
+		return nil, &RequeueError{
+			Reason:  "SharedEventBusNotReady",
+			Message: fmt.Sprintf("Waiting for the shared eventbus %s/%s to be deployed", host.Namespace, host.Name),
+			After:   10 * time.Second,
+		}
+	}
+	username, password, err := i.createClientAuthSecret(ctx)
+	if err != nil {
+		i.logger.Errorw("failed to create the tenant client auth secret", zap.Error(err))
+		i.eventBus.Status.MarkDeployFailed("JetStreamAuthSecretsFailed", err.Error())
+		return nil, err
+	}
+	account, err := tenantAccount(i.eventBus.Namespace, i.eventBus.Name, username, password, host.Spec.JetStream.Tenancy.AccountLimits)
+	if err != nil {
+		i.eventBus.Status.MarkDeployFailed("JetStreamTenantAccountFailed", err.Error())
+		return nil, err
+	}
+	if err := i.updateAccount(ctx, host, account); err != nil {
+		var requeueErr *RequeueError
+		if errors.As(err, &requeueErr) {
+			i.eventBus.Status.MarkDeploying(requeueErr.Reason, requeueErr.Message)
+			return nil, err
+		}
+		i.logger.Errorw("failed to add the tenant account", zap.Error(err))
+		i.eventBus.Status.MarkDeployFailed("JetStreamTenantAccountFailed", err.Error())
+		return nil, err
+	}
+	i.eventBus.Status.MarkDeployed("Shared", fmt.Sprintf("Using the shared EventBus %s/%s", host.Namespace, host.Name))
+	return &v1alpha1.BusConfig{
+		JetStream: &v1alpha1.JetStreamConfig{
+			URL:          host.Status.Config.JetStream.URL,
+			StreamConfig: host.Status.Config.JetStream.StreamConfig,
+			AccessSecret: &corev1.SecretKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{
+					Name: generateJetStreamClientAuthSecretName(i.eventBus),
+				},
+				Key: common.JetStreamClientAuthSecretKey,
+			},
+		},
+	}, nil
+}
+
+// createClientAuthSecret creates the secret holding the credentials of the tenant account, and returns them.
+func (i *sharedJetStreamInstaller) createClientAuthSecret(ctx context.Context) (string, string, error) {
+	secret := &corev1.Secret{}
+	err := i.client.Get(ctx, client.ObjectKey{Namespace: i.eventBus.Namespace, Name: generateJetStreamClientAuthSecretName(i.eventBus)}, secret)
+	if err == nil && metav1.IsControlledBy(secret, i.eventBus) {
+		creds := struct {
+			Username string `json:"username"`
+			Password string `json:"password"`
+		}{}
+		if err := yaml.Unmarshal(secret.Data[common.JetStreamClientAuthSecretKey], &creds); err == nil && creds.Username != "" && creds.Password != "" {
+			return creds.Username, creds.Password, nil
+		}
+	}
+	if err != nil && !apierrors.IsNotFound(err) {
+		return "", "", fmt.Errorf("failed to check if nats client auth secret is existing, err: %w", err)
+	}
+	username := common.RandomString(8)
+	password := common.RandomString(16)
+	obj := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: i.eventBus.Namespace,
+			Name:      generateJetStreamClientAuthSecretName(i.eventBus),
+			Labels:    i.labels,
+			OwnerReferences: []metav1.OwnerReference{
+				*metav1.NewControllerRef(i.eventBus.GetObjectMeta(), v1alpha1.SchemaGroupVersionKind),
+			},
+		},
+		Type: corev1.SecretTypeOpaque,
+		Data: map[string][]byte{
+			common.JetStreamClientAuthSecretKey: []byte(fmt.Sprintf("username: %s\npassword: %s", username, password)),
+		},
+	}
+	if err == nil {
+		// The existing secret is malformed or not owned by the EventBus
+		if err := i.client.Delete(ctx, secret); err != nil {
+			return "", "", fmt.Errorf("failed to delete malformed nats client auth secret, err: %w", err)
+		}
+	}
+	if err := i.client.Create(ctx, obj); err != nil {
+		return "", "", fmt.Errorf("failed to create nats client auth secret, err: %w", err)
+	}
+	i.logger.Info("created nats client auth secret successfully")
+	return username, password, nil
+}
+
+// updateAccount sets the account of the tenant in the tenants secret of the shared EventBus, it is removed if
+// account is nil.
+func (i *sharedJetStreamInstaller) updateAccount(ctx context.Context, host *v1alpha1.EventBus, account []byte) error {
+	secret := &corev1.Secret{}
+	if err := i.client.Get(ctx, client.ObjectKey{Namespace: host.Namespace, Name: generateJetStreamTenantsSecretName(host)}, secret); err != nil {
+		if apierrors.IsNotFound(err) {
+			if account == nil {
+				return nil
+			}
+			return &RequeueError{
+				Reason:  "SharedEventBusNotReady",
+				Message: fmt.Sprintf("Waiting for the shared eventbus %s/%s to serve the tenants", host.Namespace, host.Name),
+				After:   10 * time.Second,
+			}
+		}
+		return fmt.Errorf("failed to get the tenants secret of the shared eventbus, err: %w", err)
+	}
+	key := tenantAccountKey(i.eventBus.Namespace, i.eventBus.Name)
+	if existing, ok := secret.Data[key]; (account == nil && !ok) || (account != nil && string(existing) == string(account)) {
+		return nil
+	}
+	if secret.Data == nil {
+		secret.Data = make(map[string][]byte)
+	}
+	if account == nil {
+		delete(secret.Data, key)
+	} else {
+		secret.Data[key] = account
+	}
+	secret.Data[common.JetStreamTenantsSecretKey] = renderTenantAccounts(secret.Data)
+	if err := i.client.Update(ctx, secret); err != nil {
+		return fmt.Errorf("failed to update the tenants secret of the shared eventbus, err: %w", err)
+	}
+	i.logger.Infow("updated the tenant account of the shared eventbus", "removed", account == nil)
+	return nil
+}
+
+func (i *sharedJetStreamInstaller) Uninstall(ctx context.Context) error {
+	shared := i.eventBus.Spec.Shared
+	host := &v1alpha1.EventBus{}
+	if err := i.client.Get(ctx, client.ObjectKey{Namespace: shared.Namespace, Name: shared.GetName()}, host); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to get the shared eventbus %s/%s, %w", shared.Namespace, shared.GetName(), err)
+	}
+	return i.updateAccount(ctx, host, nil)
+}
@@ -0,0 +1,153 @@
+package installer
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap/zaptest"
+	corev1 "k8s.io/api/core/v1"
+	apiresource "k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/argoproj/argo-events/common"
+	"github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1"
+)
+
+func TestSharedJetStreamInstall(t *testing.T) {
+	_ = v1alpha1.AddToScheme(scheme.Scheme)
+	ctx := context.TODO()
+	host := testJetStreamEventBus.DeepCopy()
+	host.Spec.JetStream.Version = "2.7.3"
+	host.Spec.JetStream.Tenancy = &v1alpha1.JetStreamTenancy{AllowedNamespaces: []string{"tenant"}}
+	tenant := &v1alpha1.EventBus{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: v1alpha1.SchemeGroupVersion.String(),
+			Kind:       "EventBus",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "tenant",
+			Name:      common.DefaultEventBusName,
+		},
+		Spec: v1alpha1.EventBusSpec{
+			Shared: &v1alpha1.SharedEventBus{Namespace: host.Namespace, Name: host.Name},
+		},
+	}
+	cl := fake.NewClientBuilder().WithObjects(host).Build()
+	hostInstaller := &jetStreamInstaller{
+		client:     cl,
+		kubeClient: k8sfake.NewSimpleClientset(),
+		eventBus:   host,
+		config:     fakeConfig,
+		labels:     testLabels,
+		logger:     zaptest.NewLogger(t).Sugar(),
+	}
+	newTenantInstaller := func(eb *v1alpha1.EventBus) *sharedJetStreamInstaller {
+		return &sharedJetStreamInstaller{
+			client:   cl,
+			eventBus: eb,
+			labels:   testLabels,
+			logger:   zaptest.NewLogger(t).Sugar(),
+		}
+	}
+	getSecret := func(namespace, name string) *corev1.Secret {
+		secret := &corev1.Secret{}
+		assert.NoError(t, cl.Get(ctx, client.ObjectKey{Namespace: namespace, Name: name}, secret))
+		return secret
+	}
+
+	t.Run("test shared eventbus not deployed", func(t *testing.T) {
+		_, err := newTenantInstaller(tenant.DeepCopy()).Install(ctx)
+		var requeueErr *RequeueError
+		assert.True(t, errors.As(err, &requeueErr))
+	})
+
+	t.Run("test tenant eventbus", func(t *testing.T) {
+		busConfig, err := hostInstaller.Install(ctx)
+		assert.NoError(t, err)
+		host.Status.Config = *busConfig
+		assert.NoError(t, cl.Update(ctx, host))
+		auth := getSecret(host.Namespace, generateJetStreamServerSecretName(host)).Data[common.JetStreamServerSecretAuthKey]
+		assert.Contains(t, string(auth), tenantsInclude)
+
+		tenantConfig, err := newTenantInstaller(tenant.DeepCopy()).Install(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, busConfig.JetStream.URL, tenantConfig.JetStream.URL)
+		assert.Equal(t, generateJetStreamClientAuthSecretName(tenant), tenantConfig.JetStream.AccessSecret.Name)
+		tenants := getSecret(host.Namespace, generateJetStreamTenantsSecretName(host))
+		assert.Contains(t, string(tenants.Data[common.JetStreamTenantsSecretKey]), `"tenant/default"`)
+		count, err := hostInstaller.tenantCount(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, count)
+		assert.ErrorContains(t, hostInstaller.Uninstall(ctx), "serving 1 tenant EventBuses")
+
+		// The credentials are kept
+		_, err = newTenantInstaller(tenant.DeepCopy()).Install(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, tenants.Data, getSecret(host.Namespace, generateJetStreamTenantsSecretName(host)).Data)
+
+		assert.NoError(t, newTenantInstaller(tenant.DeepCopy()).Uninstall(ctx))
+		count, err = hostInstaller.tenantCount(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, 0, count)
+	})
+
+	t.Run("test namespace not allowed", func(t *testing.T) {
+		other := tenant.DeepCopy()
+		other.Namespace = "other"
+		_, err := newTenantInstaller(other).Install(ctx)
+		assert.ErrorContains(t, err, "does not serve the namespace other")
+	})
+
+	t.Run("test tenancy disabled", func(t *testing.T) {
+		hostInstaller.eventBus = host.DeepCopy()
+		hostInstaller.eventBus.Spec.JetStream.Tenancy = nil
+		assert.NoError(t, hostInstaller.reconcileTenancy(ctx))
+		auth := getSecret(host.Namespace, generateJetStreamServerSecretName(host)).Data[common.JetStreamServerSecretAuthKey]
+		assert.NotContains(t, string(auth), tenantsInclude)
+		assert.NoError(t, cl.Update(ctx, hostInstaller.eventBus))
+		_, err := newTenantInstaller(tenant.DeepCopy()).Install(ctx)
+		assert.ErrorContains(t, err, "does not serve tenant eventbuses")
+	})
+}
+
+func TestTenantAccount(t *testing.T) {
+	account, err := tenantAccount("a", "default", "user", `pa"ss`, nil)
+	assert.NoError(t, err)
+	assert.Contains(t, string(account), `"a/default": {`)
+	assert.Contains(t, string(account), `"jetstream": true`)
+	assert.Contains(t, string(account), `"pass": "pa\"ss"`)
+
+	memory := apiresource.MustParse("1Gi")
+	streams := int32(10)
+	account, err = tenantAccount("a", "default", "user", "pass", &v1alpha1.JetStreamAccountLimits{MaxMemory: &memory, MaxStreams: &streams})
+	assert.NoError(t, err)
+	assert.Contains(t, string(account), `"max_mem": 1073741824`)
+	assert.Contains(t, string(account), `"max_streams": 10`)
+	assert.NotContains(t, string(account), "max_file")
+}
+
+func TestRenderTenantAccounts(t *testing.T) {
+	b, err := tenantAccount("b", "default", "user", "pass", nil)
+	assert.NoError(t, err)
+	a, err := tenantAccount("a", "x.y", "user", "pass", nil)
+	assert.NoError(t, err)
+	data := map[string][]byte{
+		common.JetStreamTenantsSecretKey: []byte("stale"),
+		tenantAccountKey("b", "default"): b,
+		tenantAccountKey("a", "x.y"):     a,
+	}
+	rendered := string(renderTenantAccounts(data))
+	assert.NotContains(t, rendered, "stale")
+	assert.Less(t, len(tenantsHeader), len(rendered))
+	namespace, name, ok := parseTenantAccountKey(tenantAccountKey("a", "x.y"))
+	assert.True(t, ok)
+	assert.Equal(t, "a", namespace)
+	assert.Equal(t, "x.y", name)
+	assert.Regexp(t, `(?s)"a/x\.y".*"b/default"`, rendered)
+}
@@ -0,0 +1,103 @@
+package installer
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1"
+)
+
+const (
+	annotationDefaultStorageClass     = "storageclass.kubernetes.io/is-default-class"
+	annotationBetaDefaultStorageClass = "storageclass.beta.kubernetes.io/is-default-class"
+)
+
+// singleNodeProvisioners are the provisioners of block storage, whose volumes can only be mounted by a single node.
+var singleNodeProvisioners = map[string]bool{
+	"ebs.csi.aws.com":              true,
+	"kubernetes.io/aws-ebs":        true,
+	"pd.csi.storage.gke.io":        true,
+	"kubernetes.io/gce-pd":         true,
+	"disk.csi.azure.com":           true,
+	"kubernetes.io/azure-disk":     true,
+	"cinder.csi.openstack.org":     true,
+	"kubernetes.io/cinder":         true,
+	"dobs.csi.digitalocean.com":    true,
+	"rancher.io/local-path":        true,
+	"kubernetes.io/no-provisioner": true,
+}
+
+// StorageError is returned when the volumes of an EventBus can not be provisioned, the pods would stay Pending.
+type StorageError struct {
+	Reason  string
+	Message string
+}
+
+func (e *StorageError) Error() string {
+	return e.Message
+}
+
+// validateStorage checks that the StorageClass of the volumes exists, and supports their access mode, so that the
+// StatefulSet is not created with pods stuck Pending. The default StorageClass is checked if none is specified. The
+// volumes provisioned without class are not checked, nor are the volumes when the StorageClasses can not be read.
+func validateStorage(ctx context.Context, kubeClient kubernetes.Interface, persistence *v1alpha1.PersistenceStrategy, logger *zap.SugaredLogger) error {
+	if persistence == nil {
+		return nil
+	}
+	accessMode := corev1.ReadWriteOnce
+	if persistence.AccessMode != nil {
+		accessMode = *persistence.AccessMode
+	}
+	if accessMode == corev1.ReadOnlyMany {
+		return &StorageError{Reason: "AccessModeNotSupported", Message: fmt.Sprintf("the %s access mode does not allow the servers to write their volumes", accessMode)}
+	}
+	if kubeClient == nil || (persistence.StorageClassName != nil && *persistence.StorageClassName == "") {
+		return nil
+	}
+
+	var storageClass *storagev1.StorageClass
+	if name := persistence.StorageClassName; name != nil {
+		sc, err := kubeClient.StorageV1().StorageClasses().Get(ctx, *name, metav1.GetOptions{})
+		switch {
+		case apierrors.IsNotFound(err):
+			return &StorageError{Reason: "StorageClassNotFound", Message: fmt.Sprintf("StorageClass %q not found", *name)}
+		case err != nil:
+			logger.Warnw("failed to get the StorageClass, the volumes are not validated", "storageClass", *name, zap.Error(err))
+			return nil
+		}
+		storageClass = sc
+	} else {
+		list, err := kubeClient.StorageV1().StorageClasses().List(ctx, metav1.ListOptions{})
+		if err != nil {
+			logger.Warnw("failed to list the StorageClasses, the volumes are not validated", zap.Error(err))
+			return nil
+		}
+		for i := range list.Items {
+			sc := &list.Items[i]
+			if sc.Annotations[annotationDefaultStorageClass] == "true" || sc.Annotations[annotationBetaDefaultStorageClass] == "true" {
+				storageClass = sc
+				break
+			}
+		}
+		if storageClass == nil {
+			// The claims are bound to the volumes without class
+			logger.Warn("no StorageClass is specified and there is no default StorageClass, the volumes must be provisioned without class")
+			return nil
+		}
+	}
+
+	// The ReadWriteOncePod access mode is only supported by the CSI drivers, not by the in-tree provisioners
+	if (accessMode == corev1.ReadWriteMany && singleNodeProvisioners[storageClass.Provisioner]) ||
+		(accessMode == corev1.ReadWriteOncePod && strings.HasPrefix(storageClass.Provisioner, "kubernetes.io/")) {
+		return &StorageError{Reason: "AccessModeNotSupported", Message: fmt.Sprintf("the volumes of StorageClass %q, provisioned by %s, do not support the %s access mode", storageClass.Name, storageClass.Provisioner, accessMode)}
+	}
+	return nil
+}
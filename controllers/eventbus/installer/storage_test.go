@@ -0,0 +1,101 @@
+package installer
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap/zaptest"
+	appv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	apiresource "k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1"
+)
+
+func TestValidateStorage(t *testing.T) {
+	ctx := context.Background()
+	logger := zaptest.NewLogger(t).Sugar()
+	kubeClient := k8sfake.NewSimpleClientset(
+		&storagev1.StorageClass{ObjectMeta: metav1.ObjectMeta{Name: "gp3"}, Provisioner: "ebs.csi.aws.com"},
+		&storagev1.StorageClass{ObjectMeta: metav1.ObjectMeta{Name: "standard"}, Provisioner: "kubernetes.io/gce-pd"},
+		&storagev1.StorageClass{ObjectMeta: metav1.ObjectMeta{Name: "efs"}, Provisioner: "efs.csi.aws.com"},
+	)
+	reason := func(err error) string {
+		if storageErr, ok := err.(*StorageError); ok {
+			return storageErr.Reason
+		}
+		return fmt.Sprint(err)
+	}
+
+	assert.NoError(t, validateStorage(ctx, kubeClient, nil, logger))
+	assert.NoError(t, validateStorage(ctx, kubeClient, &v1alpha1.PersistenceStrategy{StorageClassName: ptr.To("gp3")}, logger))
+	assert.NoError(t, validateStorage(ctx, kubeClient, &v1alpha1.PersistenceStrategy{StorageClassName: ptr.To("efs"), AccessMode: ptr.To(corev1.ReadWriteMany)}, logger))
+	assert.NoError(t, validateStorage(ctx, kubeClient, &v1alpha1.PersistenceStrategy{StorageClassName: ptr.To("gp3"), AccessMode: ptr.To(corev1.ReadWriteOncePod)}, logger))
+	// Provisioned without class
+	assert.NoError(t, validateStorage(ctx, kubeClient, &v1alpha1.PersistenceStrategy{StorageClassName: ptr.To("")}, logger))
+	// No default StorageClass
+	assert.NoError(t, validateStorage(ctx, kubeClient, &v1alpha1.PersistenceStrategy{AccessMode: ptr.To(corev1.ReadWriteMany)}, logger))
+
+	assert.Equal(t, "StorageClassNotFound", reason(validateStorage(ctx, kubeClient, &v1alpha1.PersistenceStrategy{StorageClassName: ptr.To("fast")}, logger)))
+	assert.Equal(t, "AccessModeNotSupported", reason(validateStorage(ctx, kubeClient, &v1alpha1.PersistenceStrategy{StorageClassName: ptr.To("gp3"), AccessMode: ptr.To(corev1.ReadWriteMany)}, logger)))
+	assert.Equal(t, "AccessModeNotSupported", reason(validateStorage(ctx, kubeClient, &v1alpha1.PersistenceStrategy{StorageClassName: ptr.To("standard"), AccessMode: ptr.To(corev1.ReadWriteOncePod)}, logger)))
+	assert.Equal(t, "AccessModeNotSupported", reason(validateStorage(ctx, kubeClient, &v1alpha1.PersistenceStrategy{StorageClassName: ptr.To("efs"), AccessMode: ptr.To(corev1.ReadOnlyMany)}, logger)))
+
+	t.Run("test default storage class", func(t *testing.T) {
+		sc, _ := kubeClient.StorageV1().StorageClasses().Get(ctx, "gp3", metav1.GetOptions{})
+		sc.Annotations = map[string]string{annotationDefaultStorageClass: "true"}
+		_, err := kubeClient.StorageV1().StorageClasses().Update(ctx, sc, metav1.UpdateOptions{})
+		assert.NoError(t, err)
+		assert.NoError(t, validateStorage(ctx, kubeClient, &v1alpha1.PersistenceStrategy{}, logger))
+		assert.Equal(t, "AccessModeNotSupported", reason(validateStorage(ctx, kubeClient, &v1alpha1.PersistenceStrategy{AccessMode: ptr.To(corev1.ReadWriteMany)}, logger)))
+	})
+
+	t.Run("test forbidden", func(t *testing.T) {
+		forbidden := k8sfake.NewSimpleClientset()
+		forbidden.PrependReactor("*", "storageclasses", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			return true, nil, apierrors.NewForbidden(schema.GroupResource{Group: "storage.k8s.io", Resource: "storageclasses"}, "", fmt.Errorf("namespaced"))
+		})
+		assert.NoError(t, validateStorage(ctx, forbidden, &v1alpha1.PersistenceStrategy{StorageClassName: ptr.To("fast")}, logger))
+		assert.NoError(t, validateStorage(ctx, forbidden, &v1alpha1.PersistenceStrategy{}, logger))
+	})
+}
+
+func TestJetStreamStorageNotProvisionable(t *testing.T) {
+	cl := fake.NewClientBuilder().Build()
+	ctx := context.Background()
+	volumeSize := apiresource.MustParse("10Gi")
+	eventBus := testJetStreamEventBus.DeepCopy()
+	eventBus.Spec.JetStream.Version = "2.7.3"
+	eventBus.Spec.JetStream.Persistence = &v1alpha1.PersistenceStrategy{StorageClassName: ptr.To("fast"), VolumeSize: &volumeSize}
+	eventBus.Status.InitConditions()
+	i := &jetStreamInstaller{
+		client:     cl,
+		kubeClient: k8sfake.NewSimpleClientset(),
+		eventBus:   eventBus,
+		config:     fakeConfig,
+		labels:     testLabels,
+		logger:     zaptest.NewLogger(t).Sugar(),
+	}
+	_, err := i.Install(ctx)
+	assert.Error(t, err)
+	cond := eventBus.Status.GetCondition(v1alpha1.EventBusConditionDeployed)
+	assert.NotNil(t, cond)
+	assert.False(t, cond.IsTrue())
+	assert.Equal(t, "StorageClassNotFound", cond.Reason)
+	assert.Equal(t, `StorageClass "fast" not found`, cond.Message)
+	sts := &appv1.StatefulSet{}
+	err = cl.Get(ctx, client.ObjectKey{Namespace: eventBus.Namespace, Name: generateJetStreamStatefulSetName(eventBus)}, sts)
+	assert.True(t, apierrors.IsNotFound(err))
+}
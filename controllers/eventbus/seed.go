@@ -0,0 +1,191 @@
+package eventbus
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"go.uber.org/zap"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/argoproj/argo-events/common"
+	"github.com/argoproj/argo-events/common/logging"
+	controllerscommon "github.com/argoproj/argo-events/controllers/common"
+	"github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1"
+)
+
+const (
+	// seedLabel labels the seed Jobs of the EventBus objects
+	seedLabel = "eventbus-seed"
+	// seedBackoffLimit is the number of retries of a seed Job
+	seedBackoffLimit = int32(6)
+)
+
+// reconcileSeed makes sure a seed Job has been created for the current seed of a deployed EventBus. The Job
+// is named after the hash of the seed, so the events are published once per seed, and again when it changes.
+func (r *reconciler) reconcileSeed(ctx context.Context, eventBus *v1alpha1.EventBus) error {
+	log := logging.FromContext(ctx)
+	name := ""
+	if eventBus.Spec.Seed != nil && eventBus.Status.IsReady() {
+		name = seedJobName(eventBus)
+	}
+	jobs := &batchv1.JobList{}
+	if err := r.client.List(ctx, jobs, client.InNamespace(eventBus.Namespace), client.MatchingLabels(seedLabels(eventBus))); err != nil {
+		return fmt.Errorf("failed to list the seed jobs, %w", err)
+	}
+	found := false
+	for i := range jobs.Items {
+		job := &jobs.Items[i]
+		if job.Name == name {
+			found = true
+			continue
+		}
+		if name == "" && eventBus.Spec.Seed != nil {
+			// The EventBus is not ready yet, keep the existing Jobs
+			continue
+		}
+		// The seed has changed or been removed
+		if err := r.client.Delete(ctx, job, client.PropagationPolicy(metav1.DeletePropagationBackground)); client.IgnoreNotFound(err) != nil {
+			return fmt.Errorf("failed to delete the stale seed job %s, %w", job.Name, err)
+		}
+		log.Infow("deleted the stale seed job", "job", job.Name)
+	}
+	if found || name == "" {
+		return nil
+	}
+	job, err := buildSeedJob(eventBus, name, r.image)
+	if err != nil {
+		return fmt.Errorf("failed to build the seed job, %w", err)
+	}
+	if err := r.client.Create(ctx, job); client.IgnoreAlreadyExists(err) != nil {
+		return fmt.Errorf("failed to create the seed job, %w", err)
+	}
+	log.Infow("created the seed job", "job", name, zap.Int("events", len(eventBus.Spec.Seed.Events)))
+	return nil
+}
+
+func seedLabels(eventBus *v1alpha1.EventBus) map[string]string {
+	return map[string]string{
+		"controller":          "eventbus-controller",
+		"eventbus-name":       eventBus.Name,
+		common.LabelOwnerName: eventBus.Name,
+		seedLabel:             "true",
+	}
+}
+
+func seedJobName(eventBus *v1alpha1.EventBus) string {
+	return fmt.Sprintf("%s-seed-%s", eventBus.Name, common.MustHash(eventBus.Spec.Seed)[:8])
+}
+
+func buildSeedJob(eventBus *v1alpha1.EventBus, name, image string) (*batchv1.Job, error) {
+	busConfigBytes, err := json.Marshal(eventBus.Status.Config)
+	if err != nil {
+		return nil, fmt.Errorf("failed marshal event bus config: %v", err)
+	}
+	seedBytes, err := json.Marshal(eventBus.Spec.Seed)
+	if err != nil {
+		return nil, fmt.Errorf("failed marshal event bus seed: %v", err)
+	}
+	env := []corev1.EnvVar{
+		{
+			Name:  common.EnvVarEventBusConfig,
+			Value: base64.StdEncoding.EncodeToString(busConfigBytes),
+		},
+		{
+			Name:  common.EnvVarEventBusSubject,
+			Value: fmt.Sprintf("eventbus-%s", eventBus.Namespace),
+		},
+		{
+			Name:  common.EnvVarEventBusSeed,
+			Value: base64.StdEncoding.EncodeToString(seedBytes),
+		},
+	}
+
+	var volumes []corev1.Volume
+	var volumeMounts []corev1.VolumeMount
+	var accessSecret *corev1.SecretKeySelector
+	switch {
+	case eventBus.Status.Config.NATS != nil:
+		accessSecret = eventBus.Status.Config.NATS.AccessSecret
+	case eventBus.Status.Config.JetStream != nil:
+		accessSecret = eventBus.Status.Config.JetStream.AccessSecret
+	case eventBus.Status.Config.Kafka != nil:
+		// kafka requires secrets for sasl and tls
+		volumes, volumeMounts = common.VolumesFromSecretsOrConfigMaps(common.SecretKeySelectorType, eventBus)
+	default:
+		return nil, fmt.Errorf("unsupported event bus")
+	}
+	if accessSecret != nil {
+		volumes = append(volumes, corev1.Volume{
+			Name: "auth-volume",
+			VolumeSource: corev1.VolumeSource{
+				Secret: &corev1.SecretVolumeSource{
+					SecretName: accessSecret.Name,
+					Items: []corev1.KeyToPath{
+						{
+							Key:  accessSecret.Key,
+							Path: "auth.yaml",
+						},
+					},
+				},
+			},
+		})
+		volumeMounts = append(volumeMounts, corev1.VolumeMount{
+			Name:      "auth-volume",
+			MountPath: common.EventBusAuthFileMountPath,
+		})
+	}
+
+	if volume, mount := controllerscommon.SPIFFEVolume(eventBus.Status.Config); volume != nil {
+		// The pods authenticate with their SPIFFE workload identity
+		volumes = append(volumes, *volume)
+		volumeMounts = append(volumeMounts, *mount)
+	}
+
+	sort.Slice(volumes, func(i, j int) bool {
+		return volumes[i].Name < volumes[j].Name
+	})
+	sort.Slice(volumeMounts, func(i, j int) bool {
+		return volumeMounts[i].Name < volumeMounts[j].Name
+	})
+
+	backoffLimit := seedBackoffLimit
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: eventBus.Namespace,
+			Name:      name,
+			Labels:    seedLabels(eventBus),
+		},
+		Spec: batchv1.JobSpec{
+			BackoffLimit: &backoffLimit,
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: seedLabels(eventBus),
+				},
+				Spec: corev1.PodSpec{
+					RestartPolicy: corev1.RestartPolicyOnFailure,
+					Containers: []corev1.Container{
+						{
+							Name:            "seed",
+							Image:           image,
+							ImagePullPolicy: common.GetImagePullPolicy(),
+							Args:            []string{"eventbus-seed"},
+							Env:             env,
+							VolumeMounts:    volumeMounts,
+						},
+					},
+					Volumes: volumes,
+				},
+			},
+		},
+	}
+	if err := controllerscommon.SetObjectMeta(eventBus, job, v1alpha1.SchemaGroupVersionKind); err != nil {
+		return nil, err
+	}
+	return job, nil
+}
@@ -0,0 +1,68 @@
+package eventbus
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap/zaptest"
+	batchv1 "k8s.io/api/batch/v1"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/argoproj/argo-events/common"
+	"github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1"
+)
+
+func TestReconcileSeed(t *testing.T) {
+	ctx := context.TODO()
+	cl := fake.NewClientBuilder().Build()
+	r := &reconciler{
+		client:     cl,
+		kubeClient: k8sfake.NewSimpleClientset(),
+		scheme:     scheme.Scheme,
+		config:     fakeConfig,
+		image:      "test-image",
+		logger:     zaptest.NewLogger(t).Sugar(),
+	}
+	testBus := exoticBus.DeepCopy()
+	testBus.Spec.Seed = &v1alpha1.EventBusSeed{
+		Events: []v1alpha1.SeedEvent{{EventSourceName: "webhook", EventName: "example", Data: `{"a":1}`}},
+	}
+	assert.NoError(t, r.reconcile(ctx, testBus))
+
+	jobs := &batchv1.JobList{}
+	assert.NoError(t, cl.List(ctx, jobs))
+	assert.Len(t, jobs.Items, 1)
+	job := jobs.Items[0]
+	assert.Equal(t, seedJobName(testBus), job.Name)
+	assert.Equal(t, testBus.Name, job.OwnerReferences[0].Name)
+	container := job.Spec.Template.Spec.Containers[0]
+	assert.Equal(t, "test-image", container.Image)
+	assert.Equal(t, []string{"eventbus-seed"}, container.Args)
+	envNames := []string{}
+	for _, e := range container.Env {
+		envNames = append(envNames, e.Name)
+	}
+	assert.ElementsMatch(t, []string{common.EnvVarEventBusConfig, common.EnvVarEventBusSubject, common.EnvVarEventBusSeed}, envNames)
+
+	// Reconciling the same seed again does not create another Job
+	assert.NoError(t, r.reconcile(ctx, testBus))
+	assert.NoError(t, cl.List(ctx, jobs))
+	assert.Len(t, jobs.Items, 1)
+
+	// A changed seed replaces the Job
+	testBus.Spec.Seed.Events[0].Data = `{"a":2}`
+	assert.NoError(t, r.reconcile(ctx, testBus))
+	assert.NoError(t, cl.List(ctx, jobs))
+	assert.Len(t, jobs.Items, 1)
+	assert.Equal(t, seedJobName(testBus), jobs.Items[0].Name)
+	assert.NotEqual(t, job.Name, jobs.Items[0].Name)
+
+	// A removed seed deletes the Job
+	testBus.Spec.Seed = nil
+	assert.NoError(t, r.reconcile(ctx, testBus))
+	assert.NoError(t, cl.List(ctx, jobs))
+	assert.Empty(t, jobs.Items)
+}
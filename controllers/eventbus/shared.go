@@ -0,0 +1,33 @@
+package eventbus
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1"
+)
+
+// TenantEventBuses returns a function mapping a shared EventBus to the reconcile requests of its tenant EventBuses,
+// so that they follow its changes, e.g. get their config once it is deployed.
+func TenantEventBuses(cl client.Client) func(context.Context, client.Object) []reconcile.Request {
+	return func(ctx context.Context, obj client.Object) []reconcile.Request {
+		host, ok := obj.(*v1alpha1.EventBus)
+		if !ok || host.Spec.JetStream == nil || host.Spec.JetStream.Tenancy == nil {
+			return nil
+		}
+		list := &v1alpha1.EventBusList{}
+		if err := cl.List(ctx, list); err != nil {
+			return nil
+		}
+		var requests []reconcile.Request
+		for _, eb := range list.Items {
+			if shared := eb.Spec.Shared; shared != nil && shared.Namespace == host.Namespace && shared.GetName() == host.Name {
+				requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Namespace: eb.Namespace, Name: eb.Name}})
+			}
+		}
+		return requests
+	}
+}
@@ -1,15 +1,27 @@
 package eventbus
 
 import (
+	"encoding/json"
 	"fmt"
+	"strings"
+	"time"
 
+	"k8s.io/apimachinery/pkg/util/validation"
+
+	"github.com/argoproj/argo-events/common"
+	kafkabase "github.com/argoproj/argo-events/eventbus/kafka/base"
 	"github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1"
 )
 
 // ValidateEventBus accepts an EventBus and performs validation against it
 func ValidateEventBus(eb *v1alpha1.EventBus) error {
-	if eb.Spec.NATS == nil && eb.Spec.JetStream == nil && eb.Spec.Kafka == nil && eb.Spec.JetStreamExotic == nil {
-		return fmt.Errorf("invalid spec: either \"nats\", \"jetstream\", \"jetstreamExotic\", or \"kafka\" needs to be specified")
+	if eb.Spec.NATS == nil && eb.Spec.JetStream == nil && eb.Spec.Kafka == nil && eb.Spec.JetStreamExotic == nil && eb.Spec.Shared == nil {
+		return fmt.Errorf("invalid spec: either \"nats\", \"jetstream\", \"jetstreamExotic\", \"kafka\", or \"shared\" needs to be specified")
+	}
+	switch eb.Spec.Format {
+	case "", v1alpha1.EventFormatJSON, v1alpha1.EventFormatProtobuf:
+	default:
+		return fmt.Errorf("invalid \"spec.format\" %q, it must be %q or %q", eb.Spec.Format, v1alpha1.EventFormatJSON, v1alpha1.EventFormatProtobuf)
 	}
 	if x := eb.Spec.NATS; x != nil {
 		if x.Native != nil && x.Exotic != nil {
@@ -35,16 +47,184 @@ func ValidateEventBus(eb *v1alpha1.EventBus) error {
 		if x.Replicas != nil && (*x.Replicas == 2 || *x.Replicas <= 0) {
 			return fmt.Errorf("invalid spec: a jetstream eventbus requires 1 replica or >= 3 replicas")
 		}
+		if x.SPIFFE != nil {
+			if err := validateSPIFFE(x.SPIFFE); err != nil {
+				return fmt.Errorf("invalid \"spec.jetstream.spiffe\", %w", err)
+			}
+		}
+		if x.Tenancy != nil {
+			if x.SPIFFE != nil {
+				return fmt.Errorf("\"spec.jetstream.tenancy\" and \"spec.jetstream.spiffe\" can not be defined together")
+			}
+			for _, ns := range x.Tenancy.AllowedNamespaces {
+				if ns == "*" {
+					continue
+				}
+				if errs := validation.IsDNS1123Label(ns); len(errs) > 0 {
+					return fmt.Errorf("invalid namespace %q in \"spec.jetstream.tenancy.allowedNamespaces\", %s", ns, strings.Join(errs, ", "))
+				}
+			}
+			if l := x.Tenancy.AccountLimits; l != nil {
+				if (l.MaxMemory != nil && l.MaxMemory.Sign() < 0) || (l.MaxStorage != nil && l.MaxStorage.Sign() < 0) ||
+					(l.MaxStreams != nil && *l.MaxStreams < 0) || (l.MaxConsumers != nil && *l.MaxConsumers < 0) {
+					return fmt.Errorf("\"spec.jetstream.tenancy.accountLimits\" can not be negative")
+				}
+			}
+		}
+		if x.StorageBudget != nil {
+			if err := validateStorageBudget(x.StorageBudget); err != nil {
+				return fmt.Errorf("invalid \"spec.jetstream.storageBudget\", %w", err)
+			}
+		}
+		if len(x.Mirrors) > 0 {
+			if err := validateJetStreamMirrors(x.Mirrors); err != nil {
+				return fmt.Errorf("invalid \"spec.jetstream.mirrors\", %w", err)
+			}
+		}
 	}
 	if x := eb.Spec.Kafka; x != nil {
 		if x.URL == "" {
 			return fmt.Errorf("\"spec.kafka.url\" is missing")
 		}
+		if _, _, err := kafkabase.SensorTopics(x, eb.Namespace, "sensor"); err != nil {
+			return fmt.Errorf("invalid \"spec.kafka.topics\", %w", err)
+		}
+		if err := validateKafkaMirrors(x, eb.Namespace, eb.Name); err != nil {
+			return fmt.Errorf("invalid \"spec.kafka.mirrors\", %w", err)
+		}
 	}
 	if x := eb.Spec.JetStreamExotic; x != nil {
 		if x.URL == "" {
 			return fmt.Errorf("\"spec.jetstreamExotic.url\" is missing")
 		}
+		if x.SPIFFE != nil && x.AccessSecret != nil {
+			return fmt.Errorf("\"spec.jetstreamExotic.spiffe\" and \"spec.jetstreamExotic.accessSecret\" can not be defined together")
+		}
+	}
+	if x := eb.Spec.Shared; x != nil {
+		if eb.Spec.NATS != nil || eb.Spec.JetStream != nil || eb.Spec.Kafka != nil || eb.Spec.JetStreamExotic != nil {
+			return fmt.Errorf("\"spec.shared\" can not be defined with another eventbus")
+		}
+		if x.Namespace == "" {
+			return fmt.Errorf("\"spec.shared.namespace\" is missing")
+		}
+		if x.Namespace == eb.Namespace && x.GetName() == eb.Name {
+			return fmt.Errorf("\"spec.shared\" can not refer to the eventbus itself")
+		}
+	}
+	if x := eb.Spec.Seed; x != nil {
+		if err := validateSeed(x); err != nil {
+			return fmt.Errorf("invalid \"spec.seed\", %w", err)
+		}
+	}
+	return nil
+}
+
+func validateSPIFFE(spiffe *v1alpha1.SPIFFEAuth) error {
+	if spiffe.TrustDomain == "" || strings.ContainsAny(spiffe.TrustDomain, "/:") {
+		return fmt.Errorf("invalid trust domain %q", spiffe.TrustDomain)
+	}
+	for _, sa := range spiffe.ServiceAccounts {
+		for _, part := range strings.SplitN(sa, "/", 2) {
+			if errs := validation.IsDNS1123Subdomain(part); len(errs) > 0 {
+				return fmt.Errorf("invalid service account %q, %s", sa, strings.Join(errs, ", "))
+			}
+		}
+	}
+	return nil
+}
+
+func validateStorageBudget(budget *v1alpha1.JetStreamStorageBudget) error {
+	warning, critical := budget.GetWarningWatermark(), budget.GetCriticalWatermark()
+	if warning <= 0 || warning > 100 || critical <= 0 || critical > 100 {
+		return fmt.Errorf("the watermarks should be percentages between 1 and 100")
+	}
+	if warning > critical {
+		return fmt.Errorf("the warning watermark %d can not be greater than the critical watermark %d", warning, critical)
+	}
+	if budget.CheckInterval != "" {
+		if d, err := time.ParseDuration(budget.CheckInterval); err != nil || d <= 0 {
+			return fmt.Errorf("invalid checkInterval %q, it should be a positive duration, e.g. 1m", budget.CheckInterval)
+		}
+	}
+	if budget.TightenedMaxAge != "" {
+		if d, err := time.ParseDuration(budget.TightenedMaxAge); err != nil || d <= 0 {
+			return fmt.Errorf("invalid tightenedMaxAge %q, it should be a positive duration, e.g. 1h", budget.TightenedMaxAge)
+		}
+	}
+	for _, stream := range budget.LowPriorityStreams {
+		if stream == "" || strings.ContainsAny(stream, " .*>") {
+			return fmt.Errorf("invalid stream name %q in lowPriorityStreams", stream)
+		}
+	}
+	return nil
+}
+
+func validateJetStreamMirrors(mirrors []v1alpha1.JetStreamMirror) error {
+	names := make(map[string]bool)
+	for _, m := range mirrors {
+		if m.Name == "" || strings.ContainsAny(m.Name, " .*>") {
+			return fmt.Errorf("invalid stream name %q", m.Name)
+		}
+		if m.Name == common.JetStreamStreamName || strings.HasPrefix(m.Name, "KV_") {
+			return fmt.Errorf("stream name %q is reserved", m.Name)
+		}
+		if names[m.Name] {
+			return fmt.Errorf("duplicate stream name %q", m.Name)
+		}
+		names[m.Name] = true
+		if strings.ContainsAny(m.EventSourceName+m.EventName, " .*>") {
+			return fmt.Errorf("mirror %q: invalid eventSourceName or eventName", m.Name)
+		}
+		if m.MaxAge != "" {
+			if d, err := time.ParseDuration(m.MaxAge); err != nil || d <= 0 {
+				return fmt.Errorf("mirror %q: invalid maxAge %q, it should be a positive duration, e.g. 168h", m.Name, m.MaxAge)
+			}
+		}
+		if m.Replicas != nil && *m.Replicas <= 0 {
+			return fmt.Errorf("mirror %q: replicas should be positive", m.Name)
+		}
+	}
+	return nil
+}
+
+func validateKafkaMirrors(kafka *v1alpha1.KafkaBus, namespace, name string) error {
+	topic := kafka.Topic
+	if topic == "" {
+		topic = fmt.Sprintf("%s-%s", namespace, name)
+	}
+	topics := make(map[string]bool)
+	for _, m := range kafka.Mirrors {
+		if m.Topic == "" {
+			return fmt.Errorf("topic is missing")
+		}
+		if m.Topic == topic {
+			return fmt.Errorf("topic %q is the topic of the events", m.Topic)
+		}
+		if topics[m.Topic] {
+			return fmt.Errorf("duplicate topic %q", m.Topic)
+		}
+		topics[m.Topic] = true
+	}
+	return nil
+}
+
+func validateSeed(seed *v1alpha1.EventBusSeed) error {
+	if len(seed.Events) == 0 {
+		return fmt.Errorf("no events specified")
+	}
+	if seed.Delay != "" {
+		if d, err := time.ParseDuration(seed.Delay); err != nil || d < 0 {
+			return fmt.Errorf("invalid delay %q, it should be a duration, e.g. 1m", seed.Delay)
+		}
+	}
+	for i, e := range seed.Events {
+		if e.EventSourceName == "" || e.EventName == "" {
+			return fmt.Errorf("event %d: eventSourceName and eventName are required", i)
+		}
+		if e.Data != "" && !json.Valid([]byte(e.Data)) {
+			return fmt.Errorf("event %d: data is not valid JSON", i)
+		}
 	}
 	return nil
 }
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
 
@@ -140,6 +141,33 @@ func TestValidate(t *testing.T) {
 		assert.NoError(t, err)
 	})
 
+	t.Run("test kafka eventbus topics", func(t *testing.T) {
+		eb := testKafkaEventBus.DeepCopy()
+		eb.Spec.Kafka.Topics = &v1alpha1.KafkaTopics{Trigger: "{{.Namespace}}.{{.SensorName}}.trigger"}
+		err := ValidateEventBus(eb)
+		assert.NoError(t, err)
+		eb.Spec.Kafka.Topics.Action = "{{.Unknown}}"
+		err = ValidateEventBus(eb)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid \"spec.kafka.topics\"")
+	})
+
+	t.Run("test kafka eventbus mirrors", func(t *testing.T) {
+		eb := testKafkaEventBus.DeepCopy()
+		eb.Spec.Kafka.Mirrors = []v1alpha1.KafkaMirror{{Topic: "analytics"}, {Topic: "analytics-webhook", EventSourceName: "webhook"}}
+		assert.NoError(t, ValidateEventBus(eb))
+
+		eb.Spec.Kafka.Mirrors[1].Topic = "test-ns-default"
+		err := ValidateEventBus(eb)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "is the topic of the events")
+
+		eb.Spec.Kafka.Mirrors[1].Topic = "analytics"
+		err = ValidateEventBus(eb)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "duplicate topic")
+	})
+
 	t.Run("test kafka eventbus no URL", func(t *testing.T) {
 		eb := testKafkaEventBus.DeepCopy()
 		eb.Spec.Kafka.URL = ""
@@ -155,4 +183,189 @@ func TestValidate(t *testing.T) {
 		assert.Error(t, err)
 		assert.True(t, strings.Contains(err.Error(), "\"spec.jetstreamExotic.url\" is missing"))
 	})
+
+	t.Run("test eventbus seed", func(t *testing.T) {
+		eb := testJetStreamEventBus.DeepCopy()
+		eb.Spec.Seed = &v1alpha1.EventBusSeed{
+			Events: []v1alpha1.SeedEvent{{EventSourceName: "webhook", EventName: "example", Data: `{"a":1}`}},
+			Delay:  "30s",
+		}
+		assert.NoError(t, ValidateEventBus(eb))
+
+		eb.Spec.Seed.Delay = "soon"
+		err := ValidateEventBus(eb)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid delay")
+
+		eb.Spec.Seed.Delay = ""
+		eb.Spec.Seed.Events[0].Data = "{"
+		err = ValidateEventBus(eb)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "not valid JSON")
+
+		eb.Spec.Seed.Events = nil
+		err = ValidateEventBus(eb)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "no events specified")
+	})
+
+	t.Run("test js eventbus spiffe", func(t *testing.T) {
+		eb := testJetStreamEventBus.DeepCopy()
+		eb.Spec.JetStream.SPIFFE = &v1alpha1.SPIFFEAuth{TrustDomain: "cluster.local", ServiceAccounts: []string{"default", "other/sensor"}}
+		assert.NoError(t, ValidateEventBus(eb))
+
+		eb.Spec.JetStream.SPIFFE.ServiceAccounts = []string{"Not_Valid"}
+		err := ValidateEventBus(eb)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid service account")
+
+		eb.Spec.JetStream.SPIFFE = &v1alpha1.SPIFFEAuth{}
+		err = ValidateEventBus(eb)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid trust domain")
+	})
+
+	t.Run("test exotic js eventbus spiffe and access secret", func(t *testing.T) {
+		eb := testJetStreamExoticBus.DeepCopy()
+		eb.Spec.JetStreamExotic.SPIFFE = &v1alpha1.SPIFFEConfig{}
+		assert.NoError(t, ValidateEventBus(eb))
+		eb.Spec.JetStreamExotic.AccessSecret = &corev1.SecretKeySelector{Key: "auth"}
+		err := ValidateEventBus(eb)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "can not be defined together")
+	})
+
+	t.Run("test js eventbus tenancy", func(t *testing.T) {
+		eb := testJetStreamEventBus.DeepCopy()
+		eb.Spec.JetStream.Tenancy = &v1alpha1.JetStreamTenancy{AllowedNamespaces: []string{"team-a", "team-b"}}
+		assert.NoError(t, ValidateEventBus(eb))
+
+		eb.Spec.JetStream.Tenancy.AllowedNamespaces = []string{"Not_Valid"}
+		err := ValidateEventBus(eb)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid namespace")
+
+		eb.Spec.JetStream.Tenancy.AllowedNamespaces = []string{"*"}
+		assert.NoError(t, ValidateEventBus(eb))
+
+		streams := int32(-1)
+		eb.Spec.JetStream.Tenancy.AccountLimits = &v1alpha1.JetStreamAccountLimits{MaxStreams: &streams}
+		err = ValidateEventBus(eb)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "can not be negative")
+
+		eb.Spec.JetStream.Tenancy.AccountLimits = nil
+		eb.Spec.JetStream.Tenancy.AllowedNamespaces = nil
+		eb.Spec.JetStream.SPIFFE = &v1alpha1.SPIFFEAuth{TrustDomain: "cluster.local"}
+		err = ValidateEventBus(eb)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "can not be defined together")
+	})
+
+	t.Run("test js eventbus storage budget", func(t *testing.T) {
+		eb := testJetStreamEventBus.DeepCopy()
+		eb.Spec.JetStream.StorageBudget = &v1alpha1.JetStreamStorageBudget{
+			WarningWatermark:   ptr.To[int32](70),
+			CheckInterval:      "30s",
+			LowPriorityStreams: []string{"KV_my-sensor"},
+			TightenedMaxAge:    "30m",
+		}
+		assert.NoError(t, ValidateEventBus(eb))
+
+		eb.Spec.JetStream.StorageBudget.CriticalWatermark = ptr.To[int32](60)
+		err := ValidateEventBus(eb)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "can not be greater than the critical watermark")
+
+		eb.Spec.JetStream.StorageBudget.CriticalWatermark = ptr.To[int32](120)
+		err = ValidateEventBus(eb)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "percentages between 1 and 100")
+
+		eb.Spec.JetStream.StorageBudget.CriticalWatermark = nil
+		eb.Spec.JetStream.StorageBudget.TightenedMaxAge = "1d"
+		err = ValidateEventBus(eb)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid tightenedMaxAge")
+
+		eb.Spec.JetStream.StorageBudget.TightenedMaxAge = ""
+		eb.Spec.JetStream.StorageBudget.LowPriorityStreams = []string{"events.*"}
+		err = ValidateEventBus(eb)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid stream name")
+
+		// The controller connects with its own SVID
+		eb.Spec.JetStream.StorageBudget.LowPriorityStreams = []string{"KV_my-sensor"}
+		eb.Spec.JetStream.SPIFFE = &v1alpha1.SPIFFEAuth{TrustDomain: "cluster.local"}
+		assert.NoError(t, ValidateEventBus(eb))
+	})
+
+	t.Run("test js eventbus mirrors", func(t *testing.T) {
+		eb := testJetStreamEventBus.DeepCopy()
+		eb.Spec.JetStream.Mirrors = []v1alpha1.JetStreamMirror{
+			{Name: "analytics", MaxAge: "168h"},
+			{Name: "analytics-webhook", EventSourceName: "webhook", Replicas: ptr.To[int32](1)},
+		}
+		assert.NoError(t, ValidateEventBus(eb))
+
+		eb.Spec.JetStream.Mirrors[1].Name = "default"
+		err := ValidateEventBus(eb)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "is reserved")
+
+		eb.Spec.JetStream.Mirrors[1].Name = "analytics"
+		err = ValidateEventBus(eb)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "duplicate stream name")
+
+		eb.Spec.JetStream.Mirrors[1].Name = "analytics-webhook"
+		eb.Spec.JetStream.Mirrors[0].MaxAge = "7d"
+		err = ValidateEventBus(eb)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid maxAge")
+
+		eb.Spec.JetStream.Mirrors[0].MaxAge = ""
+		eb.Spec.JetStream.SPIFFE = &v1alpha1.SPIFFEAuth{TrustDomain: "cluster.local"}
+		assert.NoError(t, ValidateEventBus(eb))
+	})
+
+	t.Run("test shared eventbus", func(t *testing.T) {
+		eb := &v1alpha1.EventBus{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "team-a",
+				Name:      common.DefaultEventBusName,
+			},
+			Spec: v1alpha1.EventBusSpec{
+				Shared: &v1alpha1.SharedEventBus{Namespace: "test-ns"},
+			},
+		}
+		assert.NoError(t, ValidateEventBus(eb))
+
+		eb.Spec.JetStream = &v1alpha1.JetStreamBus{Version: "2.7.3"}
+		err := ValidateEventBus(eb)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "can not be defined with another eventbus")
+
+		eb.Spec.JetStream = nil
+		eb.Spec.Shared.Namespace = ""
+		err = ValidateEventBus(eb)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "\"spec.shared.namespace\" is missing")
+
+		eb.Spec.Shared.Namespace = "team-a"
+		err = ValidateEventBus(eb)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "can not refer to the eventbus itself")
+	})
+
+	t.Run("test eventbus format", func(t *testing.T) {
+		eb := testJetStreamEventBus.DeepCopy()
+		eb.Spec.Format = v1alpha1.EventFormatProtobuf
+		assert.NoError(t, ValidateEventBus(eb))
+
+		eb.Spec.Format = "Avro"
+		err := ValidateEventBus(eb)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid \"spec.format\"")
+	})
 }
@@ -2,10 +2,13 @@ package eventsource
 
 import (
 	"context"
+	"sort"
+	"time"
 
 	"go.uber.org/zap"
 	"k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -14,6 +17,8 @@ import (
 
 	"github.com/argoproj/argo-events/common"
 	"github.com/argoproj/argo-events/common/logging"
+	controllerscommon "github.com/argoproj/argo-events/controllers/common"
+	"github.com/argoproj/argo-events/eventsources/sources/calendar"
 	"github.com/argoproj/argo-events/pkg/apis/eventsource/v1alpha1"
 )
 
@@ -22,6 +27,11 @@ const (
 	ControllerName = "eventsource-controller"
 
 	finalizerName = ControllerName
+
+	// calendarFireTimes is the number of next fire times of the calendar schedules in the status
+	calendarFireTimes = 5
+	// minCalendarRequeue is the minimum delay before the next fire times of the calendar schedules are refreshed
+	minCalendarRequeue = 5 * time.Minute
 )
 
 type reconciler struct {
@@ -29,12 +39,16 @@ type reconciler struct {
 	scheme *runtime.Scheme
 
 	eventSourceImage string
-	logger           *zap.SugaredLogger
+	// clusterName is substituted for the {{cluster-name}} template variable
+	clusterName string
+	// secureDefaults makes the webhook endpoints without authentication generate their token
+	secureDefaults bool
+	logger         *zap.SugaredLogger
 }
 
 // NewReconciler returns a new reconciler
-func NewReconciler(client client.Client, scheme *runtime.Scheme, eventSourceImage string, logger *zap.SugaredLogger) reconcile.Reconciler {
-	return &reconciler{client: client, scheme: scheme, eventSourceImage: eventSourceImage, logger: logger}
+func NewReconciler(client client.Client, scheme *runtime.Scheme, eventSourceImage, clusterName string, secureDefaults bool, logger *zap.SugaredLogger) reconcile.Reconciler {
+	return &reconciler{client: client, scheme: scheme, eventSourceImage: eventSourceImage, clusterName: clusterName, secureDefaults: secureDefaults, logger: logger}
 }
 
 func (r *reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
@@ -56,14 +70,19 @@ func (r *reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 	}
 	if r.needsUpdate(eventSource, esCopy) {
 		// Use a DeepCopy to update, because it will be mutated afterwards, with empty Status.
-		if err := r.client.Update(ctx, esCopy.DeepCopy()); err != nil {
+		if err := r.client.Update(ctx, esCopy.DeepCopy(), client.FieldOwner(fieldManager)); err != nil {
 			return reconcile.Result{}, err
 		}
 	}
 	if err := r.client.Status().Update(ctx, esCopy); err != nil {
 		return reconcile.Result{}, err
 	}
-	return ctrl.Result{}, reconcileErr
+	now := time.Now()
+	requeueAfter := calendarRequeueAfter(esCopy.Status.Calendars, now)
+	if d := tokenRotationRequeueAfter(esCopy.Status.WebhookTokenRotations, now); d > 0 && (requeueAfter == 0 || d < requeueAfter) {
+		requeueAfter = d
+	}
+	return ctrl.Result{RequeueAfter: requeueAfter}, reconcileErr
 }
 
 // reconcile does the real logic
@@ -75,27 +94,112 @@ func (r *reconciler) reconcile(ctx context.Context, eventSource *v1alpha1.EventS
 			// Finalizer logic should be added here.
 			controllerutil.RemoveFinalizer(eventSource, finalizerName)
 		}
+		controllerscommon.ReportInsecureSettings(v1alpha1.SchemaGroupVersionKind.Kind, eventSource.Namespace, eventSource.Name, nil, r.secureDefaults, log)
 		return nil
 	}
 	controllerutil.AddFinalizer(eventSource, finalizerName)
 
 	eventSource.Status.InitConditions()
-	if err := ValidateEventSource(eventSource); err != nil {
+	resolved, err := ResolveIncludes(ctx, r.client, eventSource)
+	if err != nil {
+		log.Errorw("failed to resolve includes", zap.Error(err))
+		eventSource.Status.MarkSourcesNotProvided("IncludesNotResolved", err.Error())
+		return err
+	}
+	// Only the status of the resolved copy is written back, the included specs are not persisted.
+	defer func() {
+		eventSource.Status = resolved.Status
+	}()
+	resolved.Status.Calendars = nil
+	if err := controllerscommon.SubstituteTemplateVariables(ctx, r.client, r.clusterName, eventSource.Namespace, &resolved.Spec); err != nil {
+		log.Errorw("failed to substitute the template variables", zap.Error(err))
+		resolved.Status.MarkSourcesNotProvided("InvalidTemplateVariables", err.Error())
+		return err
+	}
+	if err := ValidateEventSource(resolved); err != nil {
 		log.Errorw("validation error", zap.Error(err))
 		return err
 	}
+	insecureSettings := controllerscommon.EventSourceInsecureSettings(resolved)
+	controllerscommon.ReportInsecureSettings(v1alpha1.SchemaGroupVersionKind.Kind, eventSource.Namespace, eventSource.Name, insecureSettings, r.secureDefaults, log)
+	if r.secureDefaults && len(insecureSettings) > 0 {
+		// The tokens are required on a copy, so that they are not written to the spec
+		resolved = resolved.DeepCopy()
+		controllerscommon.RequireWebhookTokens(&resolved.Spec)
+	}
+	resolved.Status.Calendars = calendarStatuses(resolved, time.Now())
+	if len(resolved.Spec.DependencyProbes) == 0 {
+		// The condition is reported by the EventSource pods, it is stale once the probes are removed
+		resolved.Status.RemoveDependenciesReachable()
+	}
+	labels := map[string]string{
+		"controller":                "eventsource-controller",
+		common.LabelEventSourceName: eventSource.Name,
+		common.LabelOwnerName:       eventSource.Name,
+	}
+	tokenSecret, err := r.reconcileWebhookTokens(ctx, resolved, labels, time.Now())
+	if err != nil {
+		log.Errorw("failed to reconcile the webhook tokens", zap.Error(err))
+		resolved.Status.MarkDeployFailed("WebhookTokensFailed", err.Error())
+		return err
+	}
+	referencesHash, err := controllerscommon.ReferencesHash(ctx, r.client, eventSource.Namespace, &resolved.Spec)
+	if err != nil {
+		log.Errorw("failed to get the referenced objects", zap.Error(err))
+		resolved.Status.MarkDeployFailed("GetReferencesFailed", err.Error())
+		return err
+	}
 	args := &AdaptorArgs{
-		Image:       r.eventSourceImage,
-		EventSource: eventSource,
-		Labels: map[string]string{
-			"controller":                "eventsource-controller",
-			common.LabelEventSourceName: eventSource.Name,
-			common.LabelOwnerName:       eventSource.Name,
-		},
+		Image:              r.eventSourceImage,
+		EventSource:        resolved,
+		Labels:             labels,
+		WebhookTokenSecret: tokenSecret,
+		ReferencesHash:     referencesHash,
 	}
 	return Reconcile(r.client, args, log)
 }
 
+// calendarStatuses returns the next fire times of the calendar schedules of the event source.
+func calendarStatuses(eventSource *v1alpha1.EventSource, now time.Time) []v1alpha1.CalendarStatus {
+	names := make([]string, 0, len(eventSource.Spec.Calendar))
+	for name := range eventSource.Spec.Calendar {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var statuses []v1alpha1.CalendarStatus
+	for _, name := range names {
+		cal := eventSource.Spec.Calendar[name]
+		times, err := calendar.NextFireTimes(&cal, now, calendarFireTimes)
+		if err != nil || len(times) == 0 {
+			continue
+		}
+		status := v1alpha1.CalendarStatus{EventName: name}
+		for _, t := range times {
+			status.NextFireTimes = append(status.NextFireTimes, metav1.NewTime(t))
+		}
+		statuses = append(statuses, status)
+	}
+	return statuses
+}
+
+// calendarRequeueAfter returns the delay before the next fire times of the calendar schedules are refreshed,
+// 0 if there are none.
+func calendarRequeueAfter(statuses []v1alpha1.CalendarStatus, now time.Time) time.Duration {
+	var next time.Time
+	for _, status := range statuses {
+		if t := status.NextFireTimes[0].Time; next.IsZero() || t.Before(next) {
+			next = t
+		}
+	}
+	if next.IsZero() {
+		return 0
+	}
+	if d := next.Sub(now); d > minCalendarRequeue {
+		return d
+	}
+	return minCalendarRequeue
+}
+
 func (r *reconciler) needsUpdate(old, new *v1alpha1.EventSource) bool {
 	if old == nil {
 		return true
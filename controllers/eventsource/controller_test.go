@@ -3,11 +3,13 @@ package eventsource
 import (
 	"context"
 	"testing"
+	"time"
 
 	appv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	"github.com/argoproj/argo-events/common"
@@ -226,5 +228,53 @@ func TestReconcile(t *testing.T) {
 		err = r.reconcile(ctx, testEventSource)
 		assert.NoError(t, err)
 		assert.True(t, testEventSource.Status.IsReady())
+		assert.Len(t, testEventSource.Status.Calendars, 1)
+		assert.Equal(t, "test", testEventSource.Status.Calendars[0].EventName)
+		assert.Len(t, testEventSource.Status.Calendars[0].NextFireTimes, calendarFireTimes)
 	})
 }
+
+func TestReconcileSecureDefaults(t *testing.T) {
+	testEventSource := fakeEmptyEventSource()
+	testEventSource.Spec.Webhook = map[string]v1alpha1.WebhookEventSource{
+		"open":   {WebhookContext: v1alpha1.WebhookContext{Endpoint: "/open", Method: "POST", Port: "12000"}},
+		"secret": {WebhookContext: v1alpha1.WebhookContext{Endpoint: "/secret", Method: "POST", Port: "12000", AuthSecret: &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "tokens"}, Key: "secret"}}},
+	}
+	ctx := context.TODO()
+	cl := fake.NewClientBuilder().Build()
+	testBus := fakeEventBus.DeepCopy()
+	testBus.Status.MarkDeployed("test", "test")
+	testBus.Status.MarkConfigured()
+	assert.NoError(t, cl.Create(ctx, testBus))
+	r := &reconciler{
+		client:           cl,
+		scheme:           scheme.Scheme,
+		eventSourceImage: "test-image",
+		secureDefaults:   true,
+		logger:           logging.NewArgoEventsLogger(),
+	}
+	assert.NoError(t, r.reconcile(ctx, testEventSource))
+	assert.Equal(t, webhookTokenSecretName(testEventSource), testEventSource.Status.WebhookTokenSecret)
+	// The spec is not changed
+	assert.False(t, testEventSource.Spec.Webhook["open"].GenerateToken)
+	secret := &corev1.Secret{}
+	assert.NoError(t, cl.Get(ctx, client.ObjectKey{Namespace: testEventSource.Namespace, Name: testEventSource.Status.WebhookTokenSecret}, secret))
+	assert.NotEmpty(t, secret.Data["open"])
+	assert.NotContains(t, secret.Data, "secret")
+}
+
+func TestCalendarStatuses(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 1, 0, 0, time.UTC)
+	eventSource := fakeEmptyEventSource()
+	eventSource.Spec.Calendar = map[string]v1alpha1.CalendarEventSource{
+		"hourly":   {Schedule: "0 * * * *"},
+		"interval": {Interval: "10s"},
+	}
+	statuses := calendarStatuses(eventSource, now)
+	assert.Len(t, statuses, 1)
+	assert.Equal(t, "hourly", statuses[0].EventName)
+	assert.Equal(t, time.Date(2024, 1, 1, 1, 0, 0, 0, time.UTC), statuses[0].NextFireTimes[0].UTC())
+	assert.Equal(t, 59*time.Minute, calendarRequeueAfter(statuses, now))
+	assert.Equal(t, minCalendarRequeue, calendarRequeueAfter(statuses, now.Add(58*time.Minute)))
+	assert.Equal(t, time.Duration(0), calendarRequeueAfter(nil, now))
+}
@@ -0,0 +1,243 @@
+package eventsource
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/yaml"
+
+	"github.com/argoproj/argo-events/common"
+	"github.com/argoproj/argo-events/pkg/apis/eventsource/v1alpha1"
+)
+
+// fieldManager is the field manager of the updates of the EventSources by the controller, its fields are not
+// explicitly set by the users.
+const fieldManager = "argo-events-eventsource-controller"
+
+// getConfigMapFunc gets a ConfigMap by namespace and name.
+type getConfigMapFunc func(ctx context.Context, namespace, name string) (*corev1.ConfigMap, error)
+
+// ResolveIncludes returns a copy of the EventSource with the included partial specs merged into its spec.
+// The included specs are merged in order, the spec of the EventSource itself is merged last. Maps are
+// merged recursively, lists are replaced, and zero values in the EventSource spec only override the
+// included values if they are explicitly set, according to the managed fields of the EventSource.
+func ResolveIncludes(ctx context.Context, cl client.Client, eventSource *v1alpha1.EventSource) (*v1alpha1.EventSource, error) {
+	return resolveIncludes(ctx, func(ctx context.Context, namespace, name string) (*corev1.ConfigMap, error) {
+		cm := &corev1.ConfigMap{}
+		if err := cl.Get(ctx, types.NamespacedName{Namespace: namespace, Name: name}, cm); err != nil {
+			return nil, err
+		}
+		return cm, nil
+	}, eventSource)
+}
+
+// ResolveIncludesWithClientset resolves the includes of the EventSource like ResolveIncludes, with a clientset.
+func ResolveIncludesWithClientset(ctx context.Context, kubeClient kubernetes.Interface, eventSource *v1alpha1.EventSource) (*v1alpha1.EventSource, error) {
+	return resolveIncludes(ctx, func(ctx context.Context, namespace, name string) (*corev1.ConfigMap, error) {
+		return kubeClient.CoreV1().ConfigMaps(namespace).Get(ctx, name, metav1.GetOptions{})
+	}, eventSource)
+}
+
+func resolveIncludes(ctx context.Context, getConfigMap getConfigMapFunc, eventSource *v1alpha1.EventSource) (*v1alpha1.EventSource, error) {
+	if len(eventSource.Spec.Includes) == 0 {
+		return eventSource, nil
+	}
+	merged := map[string]interface{}{}
+	for _, include := range eventSource.Spec.Includes {
+		partial, err := getIncludedSpec(ctx, getConfigMap, eventSource.Namespace, include)
+		if err != nil {
+			return nil, err
+		}
+		mergeSpec(merged, partial)
+	}
+	b, err := json.Marshal(eventSource.Spec)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal eventsource spec, %w", err)
+	}
+	own := map[string]interface{}{}
+	if err := json.Unmarshal(b, &own); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal eventsource spec, %w", err)
+	}
+	explicit, err := explicitSpecFields(eventSource.ManagedFields)
+	if err != nil {
+		return nil, err
+	}
+	pruneZeroValues(own, explicit)
+	mergeSpec(merged, own)
+	mergeExplicitZeroValues(merged, own, explicit)
+	if b, err = json.Marshal(merged); err != nil {
+		return nil, fmt.Errorf("failed to marshal merged eventsource spec, %w", err)
+	}
+	spec := v1alpha1.EventSourceSpec{}
+	if err := json.Unmarshal(b, &spec); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal merged eventsource spec, %w", err)
+	}
+	resolved := eventSource.DeepCopy()
+	resolved.Spec = spec
+	return resolved, nil
+}
+
+// explicitSpecFields returns the tree of the spec fields set by the users, merged from the managed fields of the
+// EventSource, e.g. {"f:webhook": {"f:example": {"f:insecure": {}}}}.
+func explicitSpecFields(managedFields []metav1.ManagedFieldsEntry) (map[string]interface{}, error) {
+	explicit := map[string]interface{}{}
+	for _, entry := range managedFields {
+		if entry.Manager == fieldManager || entry.Subresource != "" || entry.FieldsV1 == nil {
+			continue
+		}
+		fields := map[string]interface{}{}
+		if err := json.Unmarshal(entry.FieldsV1.Raw, &fields); err != nil {
+			return nil, fmt.Errorf("failed to parse the managed fields of manager %s, %w", entry.Manager, err)
+		}
+		if spec, ok := fields["f:spec"].(map[string]interface{}); ok {
+			mergeSpec(explicit, spec)
+		}
+	}
+	return explicit, nil
+}
+
+// getIncludedSpec returns the partial spec included by an EventSource of the namespace. The ConfigMaps of the other
+// namespaces are only included if they are shared with the LabelSharedInclude label, so that the privileges of the
+// controller can't be used to read any ConfigMap of the cluster.
+func getIncludedSpec(ctx context.Context, getConfigMap getConfigMapFunc, namespace string, include v1alpha1.EventSourceInclude) (map[string]interface{}, error) {
+	if include.ConfigMap == nil {
+		return nil, fmt.Errorf("configMap is not specified in the include")
+	}
+	shared := false
+	if include.Namespace != "" && include.Namespace != namespace {
+		namespace = include.Namespace
+		shared = true
+	}
+	cm, err := getConfigMap(ctx, namespace, include.ConfigMap.Name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get included configmap %s/%s, %w", namespace, include.ConfigMap.Name, err)
+	}
+	if shared && cm.Labels[common.LabelSharedInclude] != "true" {
+		return nil, fmt.Errorf("included configmap %s/%s is not shared with the other namespaces, it requires the label %s=true", namespace, include.ConfigMap.Name, common.LabelSharedInclude)
+	}
+	data, ok := cm.Data[include.ConfigMap.Key]
+	if !ok {
+		return nil, fmt.Errorf("key %q not found in included configmap %s/%s", include.ConfigMap.Key, namespace, include.ConfigMap.Name)
+	}
+	partial := map[string]interface{}{}
+	if err := yaml.Unmarshal([]byte(data), &partial); err != nil {
+		return nil, fmt.Errorf("failed to parse included configmap %s/%s, %w", namespace, include.ConfigMap.Name, err)
+	}
+	if _, ok := partial["includes"]; ok {
+		return nil, fmt.Errorf("nested includes are not allowed, found in configmap %s/%s", namespace, include.ConfigMap.Name)
+	}
+	return partial, nil
+}
+
+// mergeSpec merges src into dst, nested maps are merged and any other value is replaced.
+func mergeSpec(dst, src map[string]interface{}) {
+	for k, v := range src {
+		srcMap, srcIsMap := v.(map[string]interface{})
+		dstMap, dstIsMap := dst[k].(map[string]interface{})
+		if srcIsMap && dstIsMap {
+			mergeSpec(dstMap, srcMap)
+			continue
+		}
+		dst[k] = v
+	}
+}
+
+// pruneZeroValues removes the null, empty and zero values from the map, so that they don't override included values,
+// unless they are in the tree of the explicitly set fields.
+func pruneZeroValues(m map[string]interface{}, explicit map[string]interface{}) {
+	for k, v := range m {
+		explicitField, isExplicit := explicit["f:"+k].(map[string]interface{})
+		switch val := v.(type) {
+		case nil:
+			delete(m, k)
+		case map[string]interface{}:
+			pruneZeroValues(val, explicitField)
+			if len(val) == 0 && !isExplicit {
+				delete(m, k)
+			}
+		case []interface{}:
+			if len(val) == 0 && !isExplicit {
+				delete(m, k)
+			}
+		case string:
+			if val == "" && !isExplicit {
+				delete(m, k)
+			}
+		case bool:
+			if !val && !isExplicit {
+				delete(m, k)
+			}
+		case float64:
+			if val == 0 && !isExplicit {
+				delete(m, k)
+			}
+		}
+	}
+}
+
+// mergeExplicitZeroValues overrides the included values with the zero values explicitly set in the spec of the
+// EventSource, which are omitted from its serialized spec, e.g. a bool switched off.
+func mergeExplicitZeroValues(merged, own, explicit map[string]interface{}) {
+	for field, fields := range explicit {
+		k := strings.TrimPrefix(field, "f:")
+		if k == field {
+			// Not a field, e.g. "." for the map itself or the "k:" keys of the list items
+			continue
+		}
+		ownValue, inOwn := own[k]
+		switch val := merged[k].(type) {
+		case map[string]interface{}:
+			ownMap, _ := ownValue.(map[string]interface{})
+			explicitFields, _ := fields.(map[string]interface{})
+			mergeExplicitZeroValues(val, ownMap, explicitFields)
+		case []interface{}:
+			if !inOwn {
+				merged[k] = []interface{}{}
+			}
+		case string:
+			if !inOwn {
+				merged[k] = ""
+			}
+		case bool:
+			if !inOwn {
+				merged[k] = false
+			}
+		case float64:
+			if !inOwn {
+				merged[k] = float64(0)
+			}
+		}
+	}
+}
+
+// IncludingEventSources returns a function mapping a ConfigMap to the EventSources including it.
+func IncludingEventSources(cl client.Client) func(context.Context, client.Object) []reconcile.Request {
+	return func(ctx context.Context, obj client.Object) []reconcile.Request {
+		list := &v1alpha1.EventSourceList{}
+		if err := cl.List(ctx, list); err != nil {
+			return nil
+		}
+		var requests []reconcile.Request
+		for _, es := range list.Items {
+			for _, include := range es.Spec.Includes {
+				namespace := include.Namespace
+				if namespace == "" {
+					namespace = es.Namespace
+				}
+				if include.ConfigMap != nil && include.ConfigMap.Name == obj.GetName() && namespace == obj.GetNamespace() {
+					requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Namespace: es.Namespace, Name: es.Name}})
+					break
+				}
+			}
+		}
+		return requests
+	}
+}
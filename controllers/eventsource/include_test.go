@@ -0,0 +1,145 @@
+package eventsource
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/argoproj/argo-events/common"
+	"github.com/argoproj/argo-events/pkg/apis/eventsource/v1alpha1"
+)
+
+const testIncludedSpec = `
+webhook:
+  example:
+    endpoint: /example
+    port: "12000"
+    method: POST
+    maxPayloadSize: 1048576
+replicas: 2
+`
+
+func fakeIncludeConfigMap(namespace, data string) *corev1.ConfigMap {
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: namespace,
+			Name:      testConfigMapName,
+		},
+		Data: map[string]string{testConfigMapKey: data},
+	}
+}
+
+func TestResolveIncludes(t *testing.T) {
+	ctx := context.TODO()
+
+	t.Run("test without includes", func(t *testing.T) {
+		testEventSource := fakeEmptyEventSource()
+		resolved, err := ResolveIncludes(ctx, fake.NewClientBuilder().Build(), testEventSource)
+		assert.NoError(t, err)
+		assert.Equal(t, testEventSource, resolved)
+	})
+
+	t.Run("test merge included spec", func(t *testing.T) {
+		cm := fakeIncludeConfigMap("platform", testIncludedSpec)
+		cm.Labels = map[string]string{common.LabelSharedInclude: "true"}
+		cl := fake.NewClientBuilder().WithObjects(cm).Build()
+		testEventSource := fakeEmptyEventSource()
+		testEventSource.Spec.Includes = []v1alpha1.EventSourceInclude{{ConfigMap: testConfigMapSelector, Namespace: "platform"}}
+		testEventSource.Spec.Webhook = map[string]v1alpha1.WebhookEventSource{
+			"example": {WebhookContext: v1alpha1.WebhookContext{Endpoint: "/override"}},
+		}
+		resolved, err := ResolveIncludes(ctx, cl, testEventSource)
+		assert.NoError(t, err)
+		assert.Equal(t, int32(2), resolved.Spec.GetReplicas())
+		wh := resolved.Spec.Webhook["example"]
+		assert.Equal(t, "/override", wh.Endpoint)
+		assert.Equal(t, "12000", wh.Port)
+		assert.Equal(t, "POST", wh.Method)
+		assert.Equal(t, int64(1048576), *wh.MaxPayloadSize)
+		assert.Len(t, resolved.Spec.Includes, 1)
+		assert.Equal(t, "/override", testEventSource.Spec.Webhook["example"].Endpoint)
+		assert.Empty(t, testEventSource.Spec.Webhook["example"].Port)
+	})
+
+	t.Run("test explicit zero values override included values", func(t *testing.T) {
+		cl := fake.NewClientBuilder().WithObjects(fakeIncludeConfigMap(testNamespace, `
+file:
+  example:
+    eventType: WRITE
+    polling: true
+    watchPathConfig:
+      directory: /bin/
+      path: x.txt
+`)).Build()
+		testEventSource := fakeEmptyEventSource()
+		testEventSource.Spec.Includes = []v1alpha1.EventSourceInclude{{ConfigMap: testConfigMapSelector}}
+		resolved, err := ResolveIncludes(ctx, cl, testEventSource)
+		assert.NoError(t, err)
+		assert.True(t, resolved.Spec.File["example"].Polling)
+
+		// polling: false is omitted from the spec, it is only known from the managed fields
+		testEventSource.ManagedFields = []metav1.ManagedFieldsEntry{
+			{Manager: "kubectl", Operation: metav1.ManagedFieldsOperationUpdate, FieldsType: "FieldsV1", FieldsV1: &metav1.FieldsV1{
+				Raw: []byte(`{"f:spec":{".":{},"f:file":{".":{},"f:example":{".":{},"f:polling":{}}},"f:includes":{}}}`),
+			}},
+			{Manager: fieldManager, Operation: metav1.ManagedFieldsOperationUpdate, FieldsType: "FieldsV1", FieldsV1: &metav1.FieldsV1{
+				Raw: []byte(`{"f:spec":{"f:file":{"f:example":{"f:eventType":{}}}}}`),
+			}},
+		}
+		testEventSource.Spec.File = map[string]v1alpha1.FileEventSource{"example": {}}
+		resolved, err = ResolveIncludes(ctx, cl, testEventSource)
+		assert.NoError(t, err)
+		assert.False(t, resolved.Spec.File["example"].Polling)
+		// The fields of the controller are not explicitly set by the users
+		assert.Equal(t, "WRITE", resolved.Spec.File["example"].EventType)
+		assert.Equal(t, "/bin/", resolved.Spec.File["example"].WatchPathConfig.Directory)
+	})
+
+	t.Run("test configmap of another namespace not shared", func(t *testing.T) {
+		cl := fake.NewClientBuilder().WithObjects(fakeIncludeConfigMap("platform", testIncludedSpec)).Build()
+		testEventSource := fakeEmptyEventSource()
+		testEventSource.Spec.Includes = []v1alpha1.EventSourceInclude{{ConfigMap: testConfigMapSelector, Namespace: "platform"}}
+		_, err := ResolveIncludes(ctx, cl, testEventSource)
+		assert.ErrorContains(t, err, "is not shared with the other namespaces")
+	})
+
+	t.Run("test configmap of the same namespace", func(t *testing.T) {
+		cl := fake.NewClientBuilder().WithObjects(fakeIncludeConfigMap(testNamespace, testIncludedSpec)).Build()
+		testEventSource := fakeEmptyEventSource()
+		testEventSource.Spec.Includes = []v1alpha1.EventSourceInclude{{ConfigMap: testConfigMapSelector, Namespace: testNamespace}}
+		resolved, err := ResolveIncludes(ctx, cl, testEventSource)
+		assert.NoError(t, err)
+		assert.Equal(t, int32(2), resolved.Spec.GetReplicas())
+	})
+
+	t.Run("test configmap not found", func(t *testing.T) {
+		testEventSource := fakeEmptyEventSource()
+		testEventSource.Spec.Includes = []v1alpha1.EventSourceInclude{{ConfigMap: testConfigMapSelector}}
+		_, err := ResolveIncludes(ctx, fake.NewClientBuilder().Build(), testEventSource)
+		assert.Error(t, err)
+	})
+
+	t.Run("test nested includes", func(t *testing.T) {
+		cl := fake.NewClientBuilder().WithObjects(fakeIncludeConfigMap(testNamespace, "includes: []")).Build()
+		testEventSource := fakeEmptyEventSource()
+		testEventSource.Spec.Includes = []v1alpha1.EventSourceInclude{{ConfigMap: testConfigMapSelector}}
+		_, err := ResolveIncludes(ctx, cl, testEventSource)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "nested includes")
+	})
+
+	t.Run("test including eventsources", func(t *testing.T) {
+		testEventSource := fakeEmptyEventSource()
+		testEventSource.Spec.Includes = []v1alpha1.EventSourceInclude{{ConfigMap: testConfigMapSelector, Namespace: "platform"}}
+		cl := fake.NewClientBuilder().WithObjects(testEventSource).Build()
+		requests := IncludingEventSources(cl)(ctx, fakeIncludeConfigMap("platform", ""))
+		assert.Len(t, requests, 1)
+		assert.Equal(t, testEventSourceName, requests[0].Name)
+		requests = IncludingEventSources(cl)(ctx, fakeIncludeConfigMap(testNamespace, ""))
+		assert.Empty(t, requests)
+	})
+}
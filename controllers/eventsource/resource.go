@@ -16,6 +16,7 @@ import (
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/argoproj/argo-events/common"
@@ -29,6 +30,10 @@ type AdaptorArgs struct {
 	Image       string
 	EventSource *v1alpha1.EventSource
 	Labels      map[string]string
+	// WebhookTokenSecret is the name of the Secret holding the generated webhook tokens, if any
+	WebhookTokenSecret string
+	// ReferencesHash is the hash of the versions of the Secrets and ConfigMaps referenced by the spec, if any
+	ReferencesHash string
 }
 
 // Reconcile does the real logic
@@ -36,25 +41,39 @@ func Reconcile(client client.Client, args *AdaptorArgs, logger *zap.SugaredLogge
 	ctx := context.Background()
 	eventSource := args.EventSource
 	eventBus := &eventbusv1alpha1.EventBus{}
-	eventBusName := common.DefaultEventBusName
-	if len(eventSource.Spec.EventBusName) > 0 {
-		eventBusName = eventSource.Spec.EventBusName
-	}
-	err := client.Get(ctx, types.NamespacedName{Namespace: eventSource.Namespace, Name: eventBusName}, eventBus)
-	if err != nil {
-		if apierrors.IsNotFound(err) {
-			eventSource.Status.MarkDeployFailed("EventBusNotFound", "EventBus not found.")
-			logger.Errorw("EventBus not found", "eventBusName", eventBusName, "error", err)
-			return fmt.Errorf("eventbus %s not found", eventBusName)
+	if eventSource.Spec.RemoteEventBus != nil {
+		if len(eventSource.Spec.EventBusName) > 0 {
+			eventSource.Status.MarkDeployFailed("InvalidEventBus", "EventBusName and RemoteEventBus can not be used together.")
+			return fmt.Errorf("eventBusName and remoteEventBus can not be used together")
+		}
+		remoteEventBus, err := controllerscommon.GetRemoteEventBus(ctx, client, eventSource.Namespace, eventSource.Spec.RemoteEventBus)
+		if err != nil {
+			eventSource.Status.MarkDeployFailed("GetRemoteEventBusFailed", "Failed to get remote EventBus.")
+			logger.Errorw("failed to get remote EventBus", "error", err)
+			return err
+		}
+		eventBus = remoteEventBus
+	} else {
+		eventBusName := common.DefaultEventBusName
+		if len(eventSource.Spec.EventBusName) > 0 {
+			eventBusName = eventSource.Spec.EventBusName
+		}
+		err := client.Get(ctx, types.NamespacedName{Namespace: eventSource.Namespace, Name: eventBusName}, eventBus)
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				eventSource.Status.MarkDeployFailed("EventBusNotFound", "EventBus not found.")
+				logger.Errorw("EventBus not found", "eventBusName", eventBusName, "error", err)
+				return fmt.Errorf("eventbus %s not found", eventBusName)
+			}
+			eventSource.Status.MarkDeployFailed("GetEventBusFailed", "Failed to get EventBus.")
+			logger.Errorw("failed to get EventBus", "eventBusName", eventBusName, "error", err)
+			return err
+		}
+		if !eventBus.Status.IsReady() {
+			eventSource.Status.MarkDeployFailed("EventBusNotReady", "EventBus not ready.")
+			logger.Errorw("event bus is not in ready status", "eventBusName", eventBusName)
+			return fmt.Errorf("eventbus not ready")
 		}
-		eventSource.Status.MarkDeployFailed("GetEventBusFailed", "Failed to get EventBus.")
-		logger.Errorw("failed to get EventBus", "eventBusName", eventBusName, "error", err)
-		return err
-	}
-	if !eventBus.Status.IsReady() {
-		eventSource.Status.MarkDeployFailed("EventBusNotReady", "EventBus not ready.")
-		logger.Errorw("event bus is not in ready status", "eventBusName", eventBusName, "error", err)
-		return fmt.Errorf("eventbus not ready")
 	}
 
 	expectedDeploy, err := buildDeployment(args, eventBus)
@@ -141,6 +160,21 @@ func Reconcile(client client.Client, args *AdaptorArgs, logger *zap.SugaredLogge
 			logger.Infow("service is re-created", "serviceName", existingSvc.Name)
 		}
 	}
+	if err := reconcileServiceGroups(ctx, client, args, logger); err != nil {
+		return err
+	}
+	if err := controllerscommon.ReconcileMetricsMonitoring(ctx, client, &controllerscommon.MetricsMonitoringArgs{
+		Owner:    eventSource,
+		OwnerGVK: v1alpha1.SchemaGroupVersionKind,
+		Name:     metricsServiceName(eventSource),
+		Labels:   args.Labels,
+		Port:     common.EventSourceMetricsPort,
+		Config:   eventSource.Spec.Metrics,
+	}); err != nil {
+		eventSource.Status.MarkDeployFailed("ReconcileMetricsMonitoringFailed", "Failed to reconcile the metrics monitoring")
+		logger.Errorw("error reconciling the metrics monitoring", "error", err)
+		return err
+	}
 	eventSource.Status.MarkDeployed()
 	return nil
 }
@@ -172,7 +206,13 @@ func buildDeployment(args *AdaptorArgs, eventBus *eventbusv1alpha1.EventBus) (*a
 			Namespace: args.EventSource.Namespace,
 			Name:      args.EventSource.Name,
 		},
-		Spec: args.EventSource.Spec,
+		Spec: *args.EventSource.Spec.DeepCopy(),
+	}
+	if value, ok := args.EventSource.Annotations[common.AnnotationWebhookResponses]; ok {
+		eventSourceCopy.Annotations = map[string]string{common.AnnotationWebhookResponses: value}
+	}
+	if args.WebhookTokenSecret != "" {
+		useGeneratedWebhookTokens(&eventSourceCopy.Spec, args.WebhookTokenSecret)
 	}
 	eventSourceBytes, err := json.Marshal(eventSourceCopy)
 	if err != nil {
@@ -259,6 +299,12 @@ func buildDeployment(args *AdaptorArgs, eventBus *eventbusv1alpha1.EventBus) (*a
 		})
 	}
 
+	if volume, mount := controllerscommon.SPIFFEVolume(eventBus.Status.Config); volume != nil {
+		// The pods authenticate with their SPIFFE workload identity
+		volumes = append(volumes, *volume)
+		volumeMounts = append(volumeMounts, *mount)
+	}
+
 	// secrets
 	volSecrets, volSecretMounts := common.VolumesFromSecretsOrConfigMaps(common.SecretKeySelectorType, secretObjs...)
 	volumes = append(volumes, volSecrets...)
@@ -305,12 +351,24 @@ func buildDeploymentSpec(args *AdaptorArgs) (*appv1.DeploymentSpec, error) {
 			{Name: "metrics", ContainerPort: common.EventSourceMetricsPort},
 		},
 	}
+	if len(args.EventSource.Spec.DependencyProbes) > 0 {
+		eventSourceContainer.ReadinessProbe = &corev1.Probe{
+			ProbeHandler: corev1.ProbeHandler{
+				HTTPGet: &corev1.HTTPGetAction{
+					Path: common.EventSourceReadinessPath,
+					Port: intstr.FromInt(common.EventSourceMetricsPort),
+				},
+			},
+			PeriodSeconds: 10,
+		}
+	}
 	if args.EventSource.Spec.Template != nil && args.EventSource.Spec.Template.Container != nil {
 		if err := mergo.Merge(&eventSourceContainer, args.EventSource.Spec.Template.Container, mergo.WithOverride); err != nil {
 			return nil, err
 		}
 	}
 	eventSourceContainer.Name = "main"
+	eventSourceContainer.SecurityContext = controllerscommon.ContainerSecurityContext(eventSourceContainer.SecurityContext)
 	podTemplateLabels := make(map[string]string)
 	if args.EventSource.Spec.Template != nil && args.EventSource.Spec.Template.Metadata != nil &&
 		len(args.EventSource.Spec.Template.Metadata.Labels) > 0 {
@@ -352,7 +410,10 @@ func buildDeploymentSpec(args *AdaptorArgs) (*appv1.DeploymentSpec, error) {
 		spec.Template.Spec.ImagePullSecrets = args.EventSource.Spec.Template.ImagePullSecrets
 		spec.Template.Spec.PriorityClassName = args.EventSource.Spec.Template.PriorityClassName
 		spec.Template.Spec.Priority = args.EventSource.Spec.Template.Priority
+		spec.Template.Spec.RuntimeClassName = args.EventSource.Spec.Template.RuntimeClassName
 	}
+	spec.Template.Spec.SecurityContext = controllerscommon.PodSecurityContext(spec.Template.Spec.SecurityContext)
+	controllerscommon.SetReferencesHash(&spec.Template, args.ReferencesHash)
 	return spec, nil
 }
 
@@ -366,6 +427,12 @@ func getService(ctx context.Context, cl client.Client, args *AdaptorArgs) (*core
 		return nil, err
 	}
 	for _, svc := range sl.Items {
+		if svc.Name == metricsServiceName(args.EventSource) {
+			continue
+		}
+		if _, ok := svc.Labels[common.LabelWebhookServiceGroup]; ok {
+			continue
+		}
 		if metav1.IsControlledBy(&svc, args.EventSource) {
 			return &svc, nil
 		}
@@ -403,6 +470,11 @@ func buildService(args *AdaptorArgs) (*corev1.Service, error) {
 	return svc, nil
 }
 
+// metricsServiceName returns the name of the Service exposing the metrics port, which is also the name of the ServiceMonitor.
+func metricsServiceName(eventSource *v1alpha1.EventSource) string {
+	return fmt.Sprintf("%s-eventsource-metrics", eventSource.Name)
+}
+
 func mergeLabels(eventBusLabels, given map[string]string) map[string]string {
 	result := map[string]string{}
 	for k, v := range eventBusLabels {
@@ -11,6 +11,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
+	"github.com/argoproj/argo-events/common"
 	"github.com/argoproj/argo-events/common/logging"
 	apicommon "github.com/argoproj/argo-events/pkg/apis/common"
 	eventbusv1alpha1 "github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1"
@@ -205,6 +206,52 @@ func Test_BuildDeployment(t *testing.T) {
 			assert.Equal(t, gotVolumeMountNames[i], wantVolumeMountNames[i])
 		}
 	})
+
+	t.Run("test generated webhook token secret attached", func(t *testing.T) {
+		eventSource := fakeEmptyEventSource()
+		eventSource.Spec.Webhook = map[string]v1alpha1.WebhookEventSource{
+			"hook": {WebhookContext: v1alpha1.WebhookContext{Endpoint: "/hook", Port: "1234", GenerateToken: true}},
+		}
+		args := &AdaptorArgs{
+			Image:              testImage,
+			EventSource:        eventSource,
+			Labels:             testLabels,
+			WebhookTokenSecret: "tokens",
+		}
+		deployment, err := buildDeployment(args, fakeEventBus)
+		assert.Nil(t, err)
+		hasTokenVolume := false
+		for _, vol := range deployment.Spec.Template.Spec.Volumes {
+			if vol.Name == "secret-tokens" {
+				hasTokenVolume = true
+			}
+		}
+		assert.True(t, hasTokenVolume)
+		// The spec of the EventSource is not modified
+		assert.Nil(t, eventSource.Spec.Webhook["hook"].AuthSecret)
+	})
+
+	t.Run("test readiness probe with dependency probes", func(t *testing.T) {
+		eventSource := fakeEmptyEventSource()
+		eventSource.Spec.HDFS = fakeHDFSEventSourceMap("test")
+		args := &AdaptorArgs{
+			Image:       testImage,
+			EventSource: eventSource,
+			Labels:      testLabels,
+		}
+		deployment, err := buildDeployment(args, fakeEventBus)
+		assert.Nil(t, err)
+		assert.Nil(t, deployment.Spec.Template.Spec.Containers[0].ReadinessProbe)
+
+		eventSource.Spec.DependencyProbes = []v1alpha1.DependencyProbe{
+			{Name: "kafka", TCP: &v1alpha1.TCPDependencyProbe{Addresses: []string{"kafka:9092"}}},
+		}
+		deployment, err = buildDeployment(args, fakeEventBus)
+		assert.Nil(t, err)
+		probe := deployment.Spec.Template.Spec.Containers[0].ReadinessProbe
+		assert.NotNil(t, probe)
+		assert.Equal(t, "/ready", probe.HTTPGet.Path)
+	})
 }
 
 func TestResourceReconcile(t *testing.T) {
@@ -257,3 +304,63 @@ func TestResourceReconcile(t *testing.T) {
 		})
 	}
 }
+
+func TestReconcileServiceGroups(t *testing.T) {
+	ctx := context.TODO()
+	testEventSource := fakeEmptyEventSource()
+	testEventSource.Spec.Webhook = map[string]v1alpha1.WebhookEventSource{
+		"a": {WebhookContext: v1alpha1.WebhookContext{Endpoint: "/a", Port: "12000", Host: "a.example.com", ServiceGroup: "partners"}},
+		"b": {WebhookContext: v1alpha1.WebhookContext{Endpoint: "/b", Port: "12001", ServiceGroup: "partners"}},
+		"c": {WebhookContext: v1alpha1.WebhookContext{Endpoint: "/c", Port: "12000"}},
+	}
+	testEventSource.Spec.Github = map[string]v1alpha1.GithubEventSource{
+		"d": {Webhook: &v1alpha1.WebhookContext{Endpoint: "/d", Port: "12000", ServiceGroup: "github"}},
+	}
+	testEventSource.Spec.Service = &v1alpha1.Service{Ports: []corev1.ServicePort{{Port: 12000}}}
+	testBus := fakeEventBus.DeepCopy()
+	testBus.Status.MarkDeployed("test", "test")
+	testBus.Status.MarkConfigured()
+	cl := fake.NewClientBuilder().WithObjects(testBus).Build()
+	args := &AdaptorArgs{
+		Image:       testImage,
+		EventSource: testEventSource,
+		Labels:      testLabels,
+	}
+	assert.NoError(t, Reconcile(cl, args, logging.NewArgoEventsLogger()))
+
+	services := func() map[string]corev1.Service {
+		svcList := &corev1.ServiceList{}
+		assert.NoError(t, cl.List(ctx, svcList, &client.ListOptions{Namespace: testNamespace}))
+		result := map[string]corev1.Service{}
+		for _, svc := range svcList.Items {
+			result[svc.Name] = svc
+		}
+		return result
+	}
+	svcs := services()
+	assert.Len(t, svcs, 3)
+	assert.Contains(t, svcs, testEventSourceName+"-eventsource-svc")
+	partners := svcs[testEventSourceName+"-eventsource-partners-svc"]
+	assert.Equal(t, "partners", partners.Labels[common.LabelWebhookServiceGroup])
+	assert.Len(t, partners.Spec.Ports, 2)
+	assert.Equal(t, int32(12000), partners.Spec.Ports[0].Port)
+	assert.Equal(t, int32(12001), partners.Spec.Ports[1].Port)
+	assert.Equal(t, testLabels, partners.Spec.Selector)
+	assert.Len(t, svcs[testEventSourceName+"-eventsource-github-svc"].Spec.Ports, 1)
+
+	t.Run("remove a service group", func(t *testing.T) {
+		testEventSource.Spec.Github = nil
+		assert.NoError(t, Reconcile(cl, args, logging.NewArgoEventsLogger()))
+		svcs := services()
+		assert.Len(t, svcs, 2)
+		assert.NotContains(t, svcs, testEventSourceName+"-eventsource-github-svc")
+		assert.Contains(t, svcs, testEventSourceName+"-eventsource-svc")
+	})
+
+	t.Run("invalid service name", func(t *testing.T) {
+		es := testEventSource.DeepCopy()
+		es.Name = strings.Repeat("a", 60)
+		_, err := buildServiceGroups(&AdaptorArgs{EventSource: es, Labels: testLabels})
+		assert.Error(t, err)
+	})
+}
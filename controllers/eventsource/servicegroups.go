@@ -0,0 +1,149 @@
+package eventsource
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/argoproj/argo-events/common"
+	controllerscommon "github.com/argoproj/argo-events/controllers/common"
+	"github.com/argoproj/argo-events/pkg/apis/eventsource/v1alpha1"
+)
+
+// serviceGroupName returns the name of the Service exposing a group of webhook endpoints of an EventSource
+func serviceGroupName(eventSource *v1alpha1.EventSource, group string) string {
+	return fmt.Sprintf("%s-eventsource-%s-svc", eventSource.Name, group)
+}
+
+// webhookServiceGroups returns the ports of the webhook endpoints of the spec, keyed by service group.
+func webhookServiceGroups(spec *v1alpha1.EventSourceSpec) (map[string][]int32, error) {
+	groups := map[string]map[int32]bool{}
+	var err error
+	forEachWebhookContext(spec.DeepCopy(), func(eventName string, wc *v1alpha1.WebhookContext) {
+		if wc.ServiceGroup == "" || err != nil {
+			return
+		}
+		port, e := strconv.ParseInt(wc.Port, 10, 32)
+		if e != nil {
+			err = fmt.Errorf("failed to parse the port %s of the event %s, %w", wc.Port, eventName, e)
+			return
+		}
+		if groups[wc.ServiceGroup] == nil {
+			groups[wc.ServiceGroup] = map[int32]bool{}
+		}
+		groups[wc.ServiceGroup][int32(port)] = true
+	})
+	if err != nil {
+		return nil, err
+	}
+	result := make(map[string][]int32, len(groups))
+	for group, ports := range groups {
+		for port := range ports {
+			result[group] = append(result[group], port)
+		}
+		sort.Slice(result[group], func(i, j int) bool { return result[group][i] < result[group][j] })
+	}
+	return result, nil
+}
+
+// buildServiceGroups builds the Services of the service groups of the webhook endpoints, keyed by name.
+func buildServiceGroups(args *AdaptorArgs) (map[string]*corev1.Service, error) {
+	eventSource := args.EventSource
+	groups, err := webhookServiceGroups(&eventSource.Spec)
+	if err != nil {
+		return nil, err
+	}
+	result := make(map[string]*corev1.Service, len(groups))
+	for group, ports := range groups {
+		name := serviceGroupName(eventSource, group)
+		if errs := validation.IsDNS1035Label(name); len(errs) > 0 {
+			return nil, fmt.Errorf("invalid name %s of the Service of the service group %s, %v", name, group, errs)
+		}
+		svcLabels := mergeLabels(eventSource.Labels, args.Labels)
+		svcLabels[common.LabelWebhookServiceGroup] = group
+		svc := &corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: eventSource.Namespace,
+				Labels:    svcLabels,
+			},
+			Spec: corev1.ServiceSpec{
+				Type:     corev1.ServiceTypeClusterIP,
+				Selector: args.Labels,
+			},
+		}
+		for _, port := range ports {
+			svc.Spec.Ports = append(svc.Spec.Ports, corev1.ServicePort{
+				Name:       fmt.Sprintf("port-%d", port),
+				Port:       port,
+				TargetPort: intstr.FromInt(int(port)),
+				Protocol:   corev1.ProtocolTCP,
+			})
+		}
+		if err := controllerscommon.SetObjectMeta(eventSource, svc, v1alpha1.SchemaGroupVersionKind); err != nil {
+			return nil, err
+		}
+		result[name] = svc
+	}
+	return result, nil
+}
+
+// reconcileServiceGroups creates the Services of the service groups of the webhook endpoints, re-creates the ones
+// whose spec changed and deletes the ones of the groups which no longer exist.
+func reconcileServiceGroups(ctx context.Context, cl client.Client, args *AdaptorArgs, logger *zap.SugaredLogger) error {
+	eventSource := args.EventSource
+	expected, err := buildServiceGroups(args)
+	if err != nil {
+		eventSource.Status.MarkDeployFailed("BuildServiceFailed", "Failed to build the services of the service groups")
+		logger.Errorw("error building the services of the service groups", zap.Error(err))
+		return err
+	}
+	sl := &corev1.ServiceList{}
+	if err := cl.List(ctx, sl, &client.ListOptions{
+		Namespace:     eventSource.Namespace,
+		LabelSelector: labelSelector(args.Labels),
+	}); err != nil {
+		eventSource.Status.MarkDeployFailed("GetServiceFailed", "Failed to get existing services")
+		logger.Errorw("error getting existing services", zap.Error(err))
+		return err
+	}
+	existing := map[string]*corev1.Service{}
+	for i := range sl.Items {
+		svc := &sl.Items[i]
+		if _, ok := svc.Labels[common.LabelWebhookServiceGroup]; !ok || !metav1.IsControlledBy(svc, eventSource) {
+			continue
+		}
+		existing[svc.Name] = svc
+		if expectedSvc, ok := expected[svc.Name]; ok && svc.Annotations[common.AnnotationResourceSpecHash] == expectedSvc.Annotations[common.AnnotationResourceSpecHash] {
+			continue
+		}
+		// To avoid service updating issues such as port name change, re-create it.
+		if err := cl.Delete(ctx, svc); err != nil {
+			eventSource.Status.MarkDeployFailed("DeleteServiceFailed", "Failed to delete existing service")
+			logger.Errorw("error deleting existing service", "serviceName", svc.Name, zap.Error(err))
+			return err
+		}
+		delete(existing, svc.Name)
+		logger.Infow("deleted existing service", "serviceName", svc.Name)
+	}
+	for name, svc := range expected {
+		if _, ok := existing[name]; ok {
+			continue
+		}
+		if err := cl.Create(ctx, svc); err != nil {
+			eventSource.Status.MarkDeployFailed("CreateServiceFailed", "Failed to create a service")
+			logger.Errorw("error creating a service", "serviceName", name, zap.Error(err))
+			return err
+		}
+		logger.Infow("service is created", "serviceName", name)
+	}
+	return nil
+}
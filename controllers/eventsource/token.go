@@ -0,0 +1,241 @@
+package eventsource
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/argoproj/argo-events/common"
+	"github.com/argoproj/argo-events/common/logging"
+	"github.com/argoproj/argo-events/pkg/apis/eventsource/v1alpha1"
+)
+
+const (
+	// webhookTokenLength is the length of the generated webhook tokens
+	webhookTokenLength = 32
+	// annotationTokenRotationTimes is the annotation of the token Secret holding the last rotation time of
+	// the tokens, keyed by event name
+	annotationTokenRotationTimes = "eventsource.argoproj.io/token-rotation-times"
+)
+
+// webhookTokenSecretName returns the name of the Secret holding the generated webhook tokens of an EventSource
+func webhookTokenSecretName(eventSource *v1alpha1.EventSource) string {
+	return fmt.Sprintf("%s-eventsource-webhook-tokens", eventSource.Name)
+}
+
+// forEachWebhookContext calls fn with the webhook context of each event of the spec which has one. The
+// changes fn makes to the contexts are kept in the spec.
+func forEachWebhookContext(spec *v1alpha1.EventSourceSpec, fn func(eventName string, wc *v1alpha1.WebhookContext)) {
+	specValue := reflect.ValueOf(spec).Elem()
+	for i := 0; i < specValue.NumField(); i++ {
+		field := specValue.Field(i)
+		if field.Kind() != reflect.Map || field.Type().Key().Kind() != reflect.String {
+			continue
+		}
+		iter := field.MapRange()
+		for iter.Next() {
+			// The values of the maps are not addressable, they are copied and stored back
+			value := reflect.New(iter.Value().Type()).Elem()
+			value.Set(iter.Value())
+			if wc := webhookContextOf(value); wc != nil {
+				fn(iter.Key().String(), wc)
+				field.SetMapIndex(iter.Key(), value)
+			}
+		}
+	}
+}
+
+var webhookContextType = reflect.TypeOf(v1alpha1.WebhookContext{})
+
+// webhookContextOf returns the webhook context of an event source, embedded or in a field
+func webhookContextOf(value reflect.Value) *v1alpha1.WebhookContext {
+	if value.Kind() == reflect.Ptr {
+		if value.IsNil() {
+			return nil
+		}
+		value = value.Elem()
+	}
+	if value.Kind() != reflect.Struct {
+		return nil
+	}
+	for i := 0; i < value.NumField(); i++ {
+		field := value.Field(i)
+		switch {
+		case field.Type() == webhookContextType:
+			return field.Addr().Interface().(*v1alpha1.WebhookContext)
+		case field.Type() == reflect.PtrTo(webhookContextType) && !field.IsNil():
+			return field.Interface().(*v1alpha1.WebhookContext)
+		}
+	}
+	return nil
+}
+
+// useGeneratedWebhookTokens sets the generated tokens of the Secret as the auth secrets of the webhook
+// endpoints which generate their token.
+func useGeneratedWebhookTokens(spec *v1alpha1.EventSourceSpec, secretName string) {
+	forEachWebhookContext(spec, func(eventName string, wc *v1alpha1.WebhookContext) {
+		if wc.GenerateToken {
+			wc.AuthSecret = &corev1.SecretKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: secretName},
+				Key:                  eventName,
+			}
+		}
+	})
+}
+
+// reconcileWebhookTokens makes sure the tokens of the webhook endpoints which generate their token are in
+// the token Secret, rotates the ones which are due, and returns the name of the Secret, empty if there are
+// none. The next rotation times are set in the status.
+func (r *reconciler) reconcileWebhookTokens(ctx context.Context, eventSource *v1alpha1.EventSource, labels map[string]string, now time.Time) (string, error) {
+	log := logging.FromContext(ctx)
+	periods := map[string]time.Duration{}
+	forEachWebhookContext(eventSource.Spec.DeepCopy(), func(eventName string, wc *v1alpha1.WebhookContext) {
+		if !wc.GenerateToken {
+			return
+		}
+		// The period has been validated
+		period, _ := time.ParseDuration(wc.TokenRotationPeriod)
+		periods[eventName] = period
+	})
+	eventSource.Status.WebhookTokenSecret = ""
+	eventSource.Status.WebhookTokenRotations = nil
+
+	name := webhookTokenSecretName(eventSource)
+	secret := &corev1.Secret{}
+	err := r.client.Get(ctx, types.NamespacedName{Namespace: eventSource.Namespace, Name: name}, secret)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return "", fmt.Errorf("failed to get the webhook token secret, %w", err)
+	}
+	exists := err == nil
+	if len(periods) == 0 {
+		if exists {
+			if err := r.client.Delete(ctx, secret); err != nil && !apierrors.IsNotFound(err) {
+				return "", fmt.Errorf("failed to delete the webhook token secret, %w", err)
+			}
+			log.Infow("deleted the webhook token secret", "secret", name)
+		}
+		return "", nil
+	}
+
+	if !exists {
+		secret = &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: eventSource.Namespace,
+				Name:      name,
+				Labels:    labels,
+				OwnerReferences: []metav1.OwnerReference{
+					*metav1.NewControllerRef(eventSource.GetObjectMeta(), v1alpha1.SchemaGroupVersionKind),
+				},
+			},
+			Type: corev1.SecretTypeOpaque,
+		}
+	}
+	if secret.Data == nil {
+		secret.Data = map[string][]byte{}
+	}
+	rotationTimes := map[string]time.Time{}
+	if value := secret.Annotations[annotationTokenRotationTimes]; value != "" {
+		if err := json.Unmarshal([]byte(value), &rotationTimes); err != nil {
+			log.Warnw("failed to parse the token rotation times, the tokens are rotated", "secret", name, "error", err)
+			rotationTimes = map[string]time.Time{}
+		}
+	}
+
+	changed := false
+	for key := range secret.Data {
+		eventName := key
+		if base := strings.TrimSuffix(key, v1alpha1.PreviousWebhookTokenKeySuffix); base != key {
+			if _, ok := periods[key]; !ok {
+				eventName = base
+			}
+		}
+		if _, ok := periods[eventName]; !ok {
+			// The endpoint has been removed, or does not generate its token anymore
+			delete(secret.Data, key)
+			delete(rotationTimes, eventName)
+			changed = true
+		}
+	}
+	eventNames := make([]string, 0, len(periods))
+	for eventName := range periods {
+		eventNames = append(eventNames, eventName)
+	}
+	sort.Strings(eventNames)
+	for _, eventName := range eventNames {
+		period := periods[eventName]
+		rotatedAt, ok := rotationTimes[eventName]
+		switch {
+		case len(secret.Data[eventName]) == 0 || !ok:
+			secret.Data[eventName] = []byte(common.RandomString(webhookTokenLength))
+			delete(secret.Data, eventName+v1alpha1.PreviousWebhookTokenKeySuffix)
+			rotatedAt = now
+			changed = true
+			log.Infow("generated a webhook token", "eventName", eventName, "secret", name)
+		case period > 0 && !now.Before(rotatedAt.Add(period)):
+			secret.Data[eventName+v1alpha1.PreviousWebhookTokenKeySuffix] = secret.Data[eventName]
+			secret.Data[eventName] = []byte(common.RandomString(webhookTokenLength))
+			rotatedAt = now
+			changed = true
+			log.Infow("rotated a webhook token", "eventName", eventName, "secret", name)
+		case period == 0 && len(secret.Data[eventName+v1alpha1.PreviousWebhookTokenKeySuffix]) > 0:
+			// The rotation has been disabled
+			delete(secret.Data, eventName+v1alpha1.PreviousWebhookTokenKeySuffix)
+			changed = true
+		}
+		rotationTimes[eventName] = rotatedAt.UTC().Truncate(time.Second)
+		if period > 0 {
+			eventSource.Status.WebhookTokenRotations = append(eventSource.Status.WebhookTokenRotations, v1alpha1.WebhookTokenRotation{
+				EventName:        eventName,
+				NextRotationTime: metav1.NewTime(rotationTimes[eventName].Add(period)),
+			})
+		}
+	}
+
+	if !exists || changed {
+		b, err := json.Marshal(rotationTimes)
+		if err != nil {
+			return "", fmt.Errorf("failed to marshal the token rotation times, %w", err)
+		}
+		if secret.Annotations == nil {
+			secret.Annotations = map[string]string{}
+		}
+		secret.Annotations[annotationTokenRotationTimes] = string(b)
+		if exists {
+			err = r.client.Update(ctx, secret)
+		} else {
+			err = r.client.Create(ctx, secret)
+		}
+		if err != nil {
+			return "", fmt.Errorf("failed to save the webhook token secret, %w", err)
+		}
+	}
+	eventSource.Status.WebhookTokenSecret = name
+	return name, nil
+}
+
+// tokenRotationRequeueAfter returns the delay before the next rotation of the generated webhook tokens,
+// 0 if there are none.
+func tokenRotationRequeueAfter(rotations []v1alpha1.WebhookTokenRotation, now time.Time) time.Duration {
+	var next time.Time
+	for _, rotation := range rotations {
+		if t := rotation.NextRotationTime.Time; next.IsZero() || t.Before(next) {
+			next = t
+		}
+	}
+	if next.IsZero() {
+		return 0
+	}
+	if d := next.Sub(now); d > time.Second {
+		return d
+	}
+	return time.Second
+}
@@ -0,0 +1,111 @@
+package eventsource
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/argoproj/argo-events/common/logging"
+	"github.com/argoproj/argo-events/pkg/apis/eventsource/v1alpha1"
+)
+
+func fakeTokenEventSource() *v1alpha1.EventSource {
+	eventSource := fakeEmptyEventSource()
+	eventSource.Spec.Webhook = fakeWebhookEventSourceMap("hook")
+	hook := eventSource.Spec.Webhook["hook"]
+	hook.GenerateToken = true
+	hook.TokenRotationPeriod = "24h"
+	eventSource.Spec.Webhook["hook"] = hook
+	eventSource.Spec.Github = map[string]v1alpha1.GithubEventSource{
+		"github": {Webhook: &v1alpha1.WebhookContext{Endpoint: "/github", Port: "12000", GenerateToken: true}},
+	}
+	return eventSource
+}
+
+func TestUseGeneratedWebhookTokens(t *testing.T) {
+	eventSource := fakeTokenEventSource()
+	eventSource.Spec.Webhook["other"] = fakeWebhookEventSourceMap("other")["other"]
+	useGeneratedWebhookTokens(&eventSource.Spec, "tokens")
+	assert.Equal(t, &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "tokens"}, Key: "hook"}, eventSource.Spec.Webhook["hook"].AuthSecret)
+	assert.Equal(t, &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "tokens"}, Key: "github"}, eventSource.Spec.Github["github"].Webhook.AuthSecret)
+	assert.Nil(t, eventSource.Spec.Webhook["other"].AuthSecret)
+}
+
+func TestReconcileWebhookTokens(t *testing.T) {
+	ctx := context.TODO()
+	cl := fake.NewClientBuilder().Build()
+	r := &reconciler{
+		client:           cl,
+		scheme:           scheme.Scheme,
+		eventSourceImage: "test-image",
+		logger:           logging.NewArgoEventsLogger(),
+	}
+	eventSource := fakeTokenEventSource()
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	getSecret := func() *corev1.Secret {
+		secret := &corev1.Secret{}
+		require.NoError(t, cl.Get(ctx, types.NamespacedName{Namespace: testNamespace, Name: webhookTokenSecretName(eventSource)}, secret))
+		return secret
+	}
+
+	name, err := r.reconcileWebhookTokens(ctx, eventSource, nil, now)
+	require.NoError(t, err)
+	assert.Equal(t, "test-name-eventsource-webhook-tokens", name)
+	assert.Equal(t, name, eventSource.Status.WebhookTokenSecret)
+	assert.Equal(t, []v1alpha1.WebhookTokenRotation{{EventName: "hook", NextRotationTime: metav1.NewTime(now.Add(24 * time.Hour))}}, eventSource.Status.WebhookTokenRotations)
+	secret := getSecret()
+	assert.Len(t, secret.Data, 2)
+	hookToken, githubToken := secret.Data["hook"], secret.Data["github"]
+	assert.Len(t, hookToken, webhookTokenLength)
+	assert.NotEqual(t, hookToken, githubToken)
+
+	// The tokens are kept until the rotation
+	_, err = r.reconcileWebhookTokens(ctx, eventSource, nil, now.Add(time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, hookToken, getSecret().Data["hook"])
+
+	// The previous token remains valid until the next rotation
+	_, err = r.reconcileWebhookTokens(ctx, eventSource, nil, now.Add(25*time.Hour))
+	require.NoError(t, err)
+	secret = getSecret()
+	assert.NotEqual(t, hookToken, secret.Data["hook"])
+	assert.Equal(t, hookToken, secret.Data["hook-previous"])
+	assert.Equal(t, githubToken, secret.Data["github"])
+	assert.Equal(t, now.Add(49*time.Hour), eventSource.Status.WebhookTokenRotations[0].NextRotationTime.Time)
+
+	// The tokens of the endpoints which don't generate their token anymore are removed
+	delete(eventSource.Spec.Webhook, "hook")
+	_, err = r.reconcileWebhookTokens(ctx, eventSource, nil, now.Add(26*time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]byte{"github": githubToken}, getSecret().Data)
+	assert.Empty(t, eventSource.Status.WebhookTokenRotations)
+
+	// The secret is deleted with the last endpoint generating its token
+	eventSource.Spec.Github = nil
+	name, err = r.reconcileWebhookTokens(ctx, eventSource, nil, now.Add(27*time.Hour))
+	require.NoError(t, err)
+	assert.Empty(t, name)
+	assert.Empty(t, eventSource.Status.WebhookTokenSecret)
+	err = cl.Get(ctx, types.NamespacedName{Namespace: testNamespace, Name: webhookTokenSecretName(eventSource)}, &corev1.Secret{})
+	assert.True(t, apierrors.IsNotFound(err))
+}
+
+func TestTokenRotationRequeueAfter(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	assert.Equal(t, time.Duration(0), tokenRotationRequeueAfter(nil, now))
+	rotations := []v1alpha1.WebhookTokenRotation{
+		{EventName: "a", NextRotationTime: metav1.NewTime(now.Add(2 * time.Hour))},
+		{EventName: "b", NextRotationTime: metav1.NewTime(now.Add(time.Hour))},
+	}
+	assert.Equal(t, time.Hour, tokenRotationRequeueAfter(rotations, now))
+	assert.Equal(t, time.Second, tokenRotationRequeueAfter(rotations, now.Add(3*time.Hour)))
+}
@@ -2,9 +2,14 @@ package eventsource
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"time"
 
+	"github.com/argoproj/argo-events/common"
+	controllerscommon "github.com/argoproj/argo-events/controllers/common"
 	"github.com/argoproj/argo-events/eventsources"
+	"github.com/argoproj/argo-events/eventsources/common/webhook"
 	apicommon "github.com/argoproj/argo-events/pkg/apis/common"
 	"github.com/argoproj/argo-events/pkg/apis/eventsource/v1alpha1"
 )
@@ -16,7 +21,7 @@ func ValidateEventSource(eventSource *v1alpha1.EventSource) error {
 		recreateTypes[esType] = true
 	}
 
-	servers, _ := eventsources.GetEventingServers(eventSource, nil)
+	servers, _, transforms := eventsources.GetEventingServers(eventSource, nil)
 
 	eventNames := make(map[string]bool)
 	rollingUpdates, recreates := 0, 0
@@ -46,6 +51,38 @@ func ValidateEventSource(eventSource *v1alpha1.EventSource) error {
 		}
 	}
 
+	for eventName, transform := range transforms {
+		if err := eventsources.ValidateTransform(transform); err != nil {
+			eventSource.Status.MarkSourcesNotProvided("InvalidEventSource", fmt.Sprintf("Invalid transform: %s - %s", eventName, err.Error()))
+			return fmt.Errorf("invalid transform of %q, %w", eventName, err)
+		}
+	}
+
+	if err := validateMaxEventSize(eventSource.Spec.MaxEventSize); err != nil {
+		eventSource.Status.MarkSourcesNotProvided("InvalidMaxEventSize", err.Error())
+		return err
+	}
+
+	if err := validateDependencyProbes(eventSource.Spec.DependencyProbes); err != nil {
+		eventSource.Status.MarkSourcesNotProvided("InvalidDependencyProbes", err.Error())
+		return err
+	}
+
+	if err := validateCatalog(eventSource.Spec.Catalog, eventNames); err != nil {
+		eventSource.Status.MarkSourcesNotProvided("InvalidCatalog", err.Error())
+		return err
+	}
+
+	if _, err := webhook.ParseStaticResponses(eventSource.Annotations[common.AnnotationWebhookResponses]); err != nil {
+		eventSource.Status.MarkSourcesNotProvided("InvalidWebhookResponses", err.Error())
+		return err
+	}
+
+	if err := controllerscommon.ValidateMetricsConfig(eventSource.Spec.Metrics); err != nil {
+		eventSource.Status.MarkSourcesNotProvided("InvalidMetrics", err.Error())
+		return err
+	}
+
 	if rollingUpdates > 0 && recreates > 0 {
 		// We don't allow this as if we use recreate strategy for the deployment it will have downtime
 		eventSource.Status.MarkSourcesNotProvided("InvalidEventSource", "Some types of event sources can not be put in one spec")
@@ -55,3 +92,84 @@ func ValidateEventSource(eventSource *v1alpha1.EventSource) error {
 	eventSource.Status.MarkSourcesProvided()
 	return nil
 }
+
+func validateMaxEventSize(limit *v1alpha1.EventSizeLimit) error {
+	if limit == nil {
+		return nil
+	}
+	if limit.Bytes <= 0 {
+		return fmt.Errorf("maxEventSize bytes must be greater than 0")
+	}
+	switch limit.GetPolicy() {
+	case v1alpha1.OversizePolicyReject, v1alpha1.OversizePolicyTruncate:
+	case v1alpha1.OversizePolicyClaimCheck:
+		if limit.ClaimCheck == nil || limit.ClaimCheck.Bucket == nil || limit.ClaimCheck.Bucket.Name == "" {
+			return fmt.Errorf("maxEventSize claimCheck bucket is required by the ClaimCheck policy")
+		}
+		if limit.ClaimCheck.Endpoint == "" {
+			return fmt.Errorf("maxEventSize claimCheck endpoint is required by the ClaimCheck policy")
+		}
+	default:
+		return fmt.Errorf("invalid maxEventSize policy %q", limit.Policy)
+	}
+	return nil
+}
+
+func validateCatalog(catalog map[string]v1alpha1.EventCatalogEntry, eventNames map[string]bool) error {
+	for eventName, entry := range catalog {
+		if !eventNames[eventName] {
+			return fmt.Errorf("catalog entry %q doesn't match any event of the EventSource", eventName)
+		}
+		if entry.Schema != "" && !json.Valid([]byte(entry.Schema)) {
+			return fmt.Errorf("catalog entry %q schema is not valid JSON", eventName)
+		}
+		if entry.Sample != "" && !json.Valid([]byte(entry.Sample)) {
+			return fmt.Errorf("catalog entry %q sample is not valid JSON", eventName)
+		}
+	}
+	return nil
+}
+
+func validateDependencyProbes(probes []v1alpha1.DependencyProbe) error {
+	names := make(map[string]bool)
+	for _, probe := range probes {
+		if probe.Name == "" {
+			return fmt.Errorf("dependency probe name is required")
+		}
+		if names[probe.Name] {
+			return fmt.Errorf("more than one dependency probe named %q", probe.Name)
+		}
+		names[probe.Name] = true
+		specified := 0
+		if probe.TCP != nil {
+			specified++
+			if len(probe.TCP.Addresses) == 0 {
+				return fmt.Errorf("dependency probe %q tcp addresses are required", probe.Name)
+			}
+		}
+		if probe.HTTP != nil {
+			specified++
+			if probe.HTTP.URL == "" {
+				return fmt.Errorf("dependency probe %q http url is required", probe.Name)
+			}
+		}
+		if probe.S3 != nil {
+			specified++
+			if probe.S3.Endpoint == "" || probe.S3.Bucket == nil || probe.S3.Bucket.Name == "" {
+				return fmt.Errorf("dependency probe %q s3 endpoint and bucket are required", probe.Name)
+			}
+		}
+		if specified != 1 {
+			return fmt.Errorf("dependency probe %q must specify exactly one of tcp, http and s3", probe.Name)
+		}
+		for field, value := range map[string]string{"interval": probe.Interval, "timeout": probe.Timeout} {
+			if value == "" {
+				continue
+			}
+			if d, err := time.ParseDuration(value); err != nil || d <= 0 {
+				return fmt.Errorf("dependency probe %q has an invalid %s %q", probe.Name, field, value)
+			}
+		}
+	}
+	return nil
+}
@@ -4,6 +4,9 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+
+	"github.com/argoproj/argo-events/common"
+	"github.com/argoproj/argo-events/pkg/apis/eventsource/v1alpha1"
 )
 
 func TestValidate(t *testing.T) {
@@ -39,4 +42,103 @@ func TestValidate(t *testing.T) {
 		assert.Error(t, err)
 		assert.Equal(t, "more than one \"test\" found in the spec", err.Error())
 	})
+
+	t.Run("validate max event size", func(t *testing.T) {
+		testEventSource := fakeEmptyEventSource()
+		testEventSource.Spec.Calendar = fakeCalendarEventSourceMap("test")
+		testEventSource.Spec.MaxEventSize = &v1alpha1.EventSizeLimit{Bytes: 1024, Policy: v1alpha1.OversizePolicyTruncate}
+		assert.NoError(t, ValidateEventSource(testEventSource))
+
+		testEventSource.Spec.MaxEventSize = &v1alpha1.EventSizeLimit{Bytes: 0}
+		assert.Error(t, ValidateEventSource(testEventSource))
+
+		testEventSource.Spec.MaxEventSize = &v1alpha1.EventSizeLimit{Bytes: 1024, Policy: "Drop"}
+		assert.Error(t, ValidateEventSource(testEventSource))
+
+		testEventSource.Spec.MaxEventSize = &v1alpha1.EventSizeLimit{Bytes: 1024, Policy: v1alpha1.OversizePolicyClaimCheck}
+		err := ValidateEventSource(testEventSource)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "claimCheck bucket is required")
+		assert.False(t, testEventSource.Status.IsReady())
+	})
+
+	t.Run("validate webhook responses", func(t *testing.T) {
+		testEventSource := fakeEmptyEventSource()
+		testEventSource.Spec.Webhook = fakeWebhookEventSourceMap("test")
+		testEventSource.Annotations = map[string]string{common.AnnotationWebhookResponses: `{"test": {"statusCode": 503, "retryAfter": "10m"}}`}
+		assert.NoError(t, ValidateEventSource(testEventSource))
+
+		testEventSource.Annotations[common.AnnotationWebhookResponses] = `{"test": {"statusCode": 503, "retryAfter": "later"}}`
+		err := ValidateEventSource(testEventSource)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid retryAfter")
+		assert.False(t, testEventSource.Status.IsReady())
+	})
+
+	t.Run("validate dependency probes", func(t *testing.T) {
+		testEventSource := fakeEmptyEventSource()
+		testEventSource.Spec.Calendar = fakeCalendarEventSourceMap("test")
+		testEventSource.Spec.DependencyProbes = []v1alpha1.DependencyProbe{
+			{Name: "kafka", TCP: &v1alpha1.TCPDependencyProbe{Addresses: []string{"kafka:9092"}}, Interval: "1m"},
+			{Name: "github", HTTP: &v1alpha1.HTTPDependencyProbe{URL: "https://api.github.com"}},
+		}
+		assert.NoError(t, ValidateEventSource(testEventSource))
+
+		testEventSource.Spec.DependencyProbes[1].Name = "kafka"
+		err := ValidateEventSource(testEventSource)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "more than one dependency probe")
+
+		testEventSource.Spec.DependencyProbes[1].Name = "github"
+		testEventSource.Spec.DependencyProbes[1].TCP = &v1alpha1.TCPDependencyProbe{Addresses: []string{"github:443"}}
+		err = ValidateEventSource(testEventSource)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "exactly one of tcp, http and s3")
+
+		testEventSource.Spec.DependencyProbes[1].TCP = nil
+		testEventSource.Spec.DependencyProbes[0].Timeout = "soon"
+		err = ValidateEventSource(testEventSource)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid timeout")
+		assert.False(t, testEventSource.Status.IsReady())
+	})
+
+	t.Run("validate catalog", func(t *testing.T) {
+		testEventSource := fakeEmptyEventSource()
+		testEventSource.Spec.Calendar = fakeCalendarEventSourceMap("test")
+		testEventSource.Spec.Catalog = map[string]v1alpha1.EventCatalogEntry{
+			"test": {Description: "Every 5 minutes", Sample: `{"eventTime":"2024-01-01T00:00:00Z"}`},
+		}
+		assert.NoError(t, ValidateEventSource(testEventSource))
+
+		testEventSource.Spec.Catalog["test"] = v1alpha1.EventCatalogEntry{Schema: `{"type":`}
+		err := ValidateEventSource(testEventSource)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "schema is not valid JSON")
+
+		testEventSource.Spec.Catalog = map[string]v1alpha1.EventCatalogEntry{"unknown": {}}
+		err = ValidateEventSource(testEventSource)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "doesn't match any event")
+		assert.False(t, testEventSource.Status.IsReady())
+	})
+
+	t.Run("validate transform", func(t *testing.T) {
+		testEventSource := fakeEmptyEventSource()
+		testEventSource.Spec.Calendar = map[string]v1alpha1.CalendarEventSource{
+			"test": {Schedule: "*/5 * * * *", Transform: &v1alpha1.EventSourceTransform{JQ: "{eventTime: .eventTime}"}},
+		}
+		assert.NoError(t, ValidateEventSource(testEventSource))
+
+		testEventSource.Spec.Calendar["test"].Transform.JQ = "{eventTime: "
+		err := ValidateEventSource(testEventSource)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid jq command")
+
+		testEventSource.Spec.Calendar["test"].Transform.Script = "return event"
+		err = ValidateEventSource(testEventSource)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "only one of jq and script")
+		assert.False(t, testEventSource.Status.IsReady())
+	})
 }
@@ -0,0 +1,279 @@
+// Package lint validates EventBus, EventSource and Sensor manifests offline, with the same validation the
+// controller runs before reconciling them, so that they can be checked in a CI pipeline before being applied.
+package lint
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/yaml"
+
+	"github.com/argoproj/argo-events/common"
+	eventbuscontroller "github.com/argoproj/argo-events/controllers/eventbus"
+	eventsourcecontroller "github.com/argoproj/argo-events/controllers/eventsource"
+	sensorcontroller "github.com/argoproj/argo-events/controllers/sensor"
+	eventbusv1alpha1 "github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1"
+	eventsourcev1alpha1 "github.com/argoproj/argo-events/pkg/apis/eventsource/v1alpha1"
+	sensorv1alpha1 "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+	"github.com/argoproj/argo-events/sensors/fixtures"
+)
+
+// Supported EventBus types, for the Sensors whose EventBus is not part of the manifests.
+const (
+	EventBusTypeJetStream = "jetstream"
+	EventBusTypeKafka     = "kafka"
+	EventBusTypeNATS      = "nats"
+)
+
+// Options are the options of the linting.
+type Options struct {
+	// Events are the sample events run through the Sensors, the triggers are rendered if any.
+	Events []fixtures.Event
+	// EventBusType is the type of the EventBus the Sensors are validated against, when their EventBus
+	// is not part of the manifests. Defaults to jetstream.
+	EventBusType string
+}
+
+// Result is the outcome of linting one object.
+type Result struct {
+	// File is the path of the manifest.
+	File string
+	// Kind is the kind of the object.
+	Kind string
+	// Name is the name of the object.
+	Name string
+	// Err is the validation error, nil if the object is valid.
+	Err error
+	// Evaluation is the outcome of running the sample events through a Sensor.
+	Evaluation *fixtures.Result
+}
+
+type object struct {
+	file string
+	kind string
+	name string
+	data []byte
+}
+
+// Lint validates the EventBus, EventSource and Sensor objects of the manifests. A path can be a file or a
+// directory, in which case the *.yaml, *.yml and *.json files are read. The other kinds of object are ignored,
+// except the ConfigMaps which can be included by the EventSources.
+func Lint(paths []string, opts Options) ([]Result, error) {
+	files, err := listFiles(paths)
+	if err != nil {
+		return nil, err
+	}
+	var objects []object
+	for _, file := range files {
+		objs, err := readObjects(file)
+		if err != nil {
+			return nil, err
+		}
+		objects = append(objects, objs...)
+	}
+
+	// The EventBuses and ConfigMaps are collected first, as they can be referenced by the Sensors and EventSources
+	var (
+		eventBuses []*eventbusv1alpha1.EventBus
+		configMaps []client.Object
+	)
+	for _, obj := range objects {
+		switch obj.kind {
+		case "EventBus":
+			eb := &eventbusv1alpha1.EventBus{}
+			if err := yaml.Unmarshal(obj.data, eb); err == nil {
+				eventBuses = append(eventBuses, eb)
+			}
+		case "ConfigMap":
+			cm := &corev1.ConfigMap{}
+			if err := yaml.Unmarshal(obj.data, cm); err != nil {
+				return nil, fmt.Errorf("failed to parse configmap in %s, %w", obj.file, err)
+			}
+			configMaps = append(configMaps, cm)
+		}
+	}
+
+	cl := fake.NewClientBuilder().WithObjects(configMaps...).Build()
+	var results []Result
+	for _, obj := range objects {
+		switch obj.kind {
+		case "EventBus":
+			results = append(results, lintEventBus(obj))
+		case "EventSource":
+			results = append(results, lintEventSource(cl, obj))
+		case "Sensor":
+			results = append(results, lintSensor(obj, eventBuses, opts))
+		}
+	}
+	return results, nil
+}
+
+func lintEventBus(obj object) Result {
+	result := Result{File: obj.file, Kind: obj.kind, Name: obj.name}
+	eb := &eventbusv1alpha1.EventBus{}
+	if result.Err = yaml.UnmarshalStrict(obj.data, eb); result.Err != nil {
+		return result
+	}
+	result.Err = eventbuscontroller.ValidateEventBus(eb)
+	return result
+}
+
+func lintEventSource(cl client.Client, obj object) Result {
+	result := Result{File: obj.file, Kind: obj.kind, Name: obj.name}
+	es := &eventsourcev1alpha1.EventSource{}
+	if result.Err = yaml.UnmarshalStrict(obj.data, es); result.Err != nil {
+		return result
+	}
+	resolved, err := eventsourcecontroller.ResolveIncludes(context.Background(), cl, es)
+	if err != nil {
+		result.Err = err
+		return result
+	}
+	result.Err = eventsourcecontroller.ValidateEventSource(resolved)
+	return result
+}
+
+func lintSensor(obj object, eventBuses []*eventbusv1alpha1.EventBus, opts Options) Result {
+	result := Result{File: obj.file, Kind: obj.kind, Name: obj.name}
+	sensor := &sensorv1alpha1.Sensor{}
+	if result.Err = yaml.UnmarshalStrict(obj.data, sensor); result.Err != nil {
+		return result
+	}
+	eb, err := sensorEventBus(sensor, eventBuses, opts.EventBusType)
+	if err != nil {
+		result.Err = err
+		return result
+	}
+	if result.Err = sensorcontroller.ValidateSensor(sensor, eb); result.Err != nil {
+		return result
+	}
+	if len(opts.Events) == 0 {
+		return result
+	}
+	evaluation, err := fixtures.Evaluate(sensor, opts.Events)
+	if err != nil {
+		result.Err = err
+		return result
+	}
+	result.Evaluation = evaluation
+	var errs []error
+	for _, name := range sortedKeys(evaluation.Dependencies) {
+		if e := evaluation.Dependencies[name].Error; e != "" {
+			errs = append(errs, fmt.Errorf("dependency %s: %s", name, e))
+		}
+	}
+	for _, name := range sortedKeys(evaluation.Triggers) {
+		if e := evaluation.Triggers[name].Error; e != "" {
+			errs = append(errs, fmt.Errorf("trigger %s: %s", name, e))
+		}
+	}
+	result.Err = errors.Join(errs...)
+	return result
+}
+
+// sensorEventBus returns the EventBus of the Sensor from the manifests, or a placeholder of the given type.
+func sensorEventBus(sensor *sensorv1alpha1.Sensor, eventBuses []*eventbusv1alpha1.EventBus, eventBusType string) (*eventbusv1alpha1.EventBus, error) {
+	name := sensor.Spec.EventBusName
+	if name == "" {
+		name = common.DefaultEventBusName
+	}
+	for _, eb := range eventBuses {
+		if eb.Name == name && (eb.Namespace == "" || sensor.Namespace == "" || eb.Namespace == sensor.Namespace) {
+			return eb, nil
+		}
+	}
+	eb := &eventbusv1alpha1.EventBus{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: sensor.Namespace}}
+	switch eventBusType {
+	case "", EventBusTypeJetStream:
+		eb.Spec.JetStream = &eventbusv1alpha1.JetStreamBus{}
+	case EventBusTypeKafka:
+		eb.Spec.Kafka = &eventbusv1alpha1.KafkaBus{}
+	case EventBusTypeNATS:
+		eb.Spec.NATS = &eventbusv1alpha1.NATSBus{}
+	default:
+		return nil, fmt.Errorf("unsupported eventbus type %q, it should be one of %s, %s or %s", eventBusType, EventBusTypeJetStream, EventBusTypeKafka, EventBusTypeNATS)
+	}
+	return eb, nil
+}
+
+func listFiles(paths []string) ([]string, error) {
+	var files []string
+	for _, path := range paths {
+		info, err := os.Stat(path)
+		if err != nil {
+			return nil, err
+		}
+		if !info.IsDir() {
+			files = append(files, path)
+			continue
+		}
+		err = filepath.WalkDir(path, func(p string, d os.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			switch strings.ToLower(filepath.Ext(p)) {
+			case ".yaml", ".yml", ".json":
+				if !d.IsDir() {
+					files = append(files, p)
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return files, nil
+}
+
+// readObjects reads the objects of a manifest, which can contain several YAML documents.
+func readObjects(file string) ([]object, error) {
+	b, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	var objects []object
+	reader := utilyaml.NewYAMLReader(bufio.NewReader(bytes.NewReader(b)))
+	for {
+		doc, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s, %w", file, err)
+		}
+		if len(bytes.TrimSpace(doc)) == 0 {
+			continue
+		}
+		meta := metav1.PartialObjectMetadata{}
+		if err := yaml.Unmarshal(doc, &meta); err != nil {
+			return nil, fmt.Errorf("failed to parse %s, %w", file, err)
+		}
+		if meta.Kind == "ConfigMap" || strings.HasPrefix(meta.APIVersion, "argoproj.io/") {
+			objects = append(objects, object{file: file, kind: meta.Kind, name: meta.Name, data: doc})
+		}
+	}
+	return objects, nil
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
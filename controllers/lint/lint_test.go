@@ -0,0 +1,148 @@
+package lint
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+	"github.com/argoproj/argo-events/sensors/fixtures"
+)
+
+const testEventBus = `apiVersion: argoproj.io/v1alpha1
+kind: EventBus
+metadata:
+  name: default
+spec:
+  nats:
+    native: {}
+`
+
+const testEventSource = `apiVersion: argoproj.io/v1alpha1
+kind: EventSource
+metadata:
+  name: webhook
+spec:
+  includes:
+    - configMap:
+        name: webhook-defaults
+        key: spec
+  webhook:
+    example:
+      endpoint: /example
+      method: POST
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: webhook-defaults
+data:
+  spec: |
+    webhook:
+      example:
+        port: "12000"
+`
+
+const testSensor = `apiVersion: argoproj.io/v1alpha1
+kind: Sensor
+metadata:
+  name: webhook
+spec:
+  dependencies:
+    - name: push
+      eventSourceName: webhook
+      eventName: example
+  triggers:
+    - template:
+        name: http-trigger
+        http:
+          url: http://example.com
+          payload:
+            - src:
+                dependencyName: push
+                dataKey: body.repository
+              dest: repository
+`
+
+func writeManifests(t *testing.T, files map[string]string) string {
+	t.Helper()
+	dir := t.TempDir()
+	for name, content := range files {
+		assert.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(content), 0o600))
+	}
+	return dir
+}
+
+func TestLint(t *testing.T) {
+	t.Run("valid manifests", func(t *testing.T) {
+		dir := writeManifests(t, map[string]string{
+			"eventbus.yaml":    testEventBus,
+			"eventsource.yaml": testEventSource,
+			"sensor.yaml":      testSensor,
+			"README.md":        "not a manifest",
+		})
+		results, err := Lint([]string{dir}, Options{})
+		assert.NoError(t, err)
+		assert.Len(t, results, 3)
+		for _, r := range results {
+			assert.NoError(t, r.Err, "%s/%s", r.Kind, r.Name)
+		}
+	})
+
+	t.Run("unknown field", func(t *testing.T) {
+		dir := writeManifests(t, map[string]string{
+			"sensor.yaml": testSensor + "  unknown: true\n",
+		})
+		results, err := Lint([]string{dir}, Options{})
+		assert.NoError(t, err)
+		assert.Len(t, results, 1)
+		assert.Equal(t, "webhook", results[0].Name)
+		assert.ErrorContains(t, results[0].Err, "unknown field")
+	})
+
+	t.Run("missing include", func(t *testing.T) {
+		dir := writeManifests(t, map[string]string{
+			"eventsource.yaml": strings.Split(testEventSource, "---\n")[0],
+		})
+		results, err := Lint([]string{filepath.Join(dir, "eventsource.yaml")}, Options{})
+		assert.NoError(t, err)
+		assert.Len(t, results, 1)
+		assert.ErrorContains(t, results[0].Err, "failed to get included configmap")
+	})
+
+	t.Run("eventbus type", func(t *testing.T) {
+		sensor := strings.Replace(testSensor, "eventName: example\n", "eventName: example\n      startPosition:\n        deliverPolicy: Earliest\n", 1)
+		dir := writeManifests(t, map[string]string{"sensor.yaml": sensor})
+		results, err := Lint([]string{dir}, Options{})
+		assert.NoError(t, err)
+		assert.NoError(t, results[0].Err)
+		results, err = Lint([]string{dir}, Options{EventBusType: EventBusTypeKafka})
+		assert.NoError(t, err)
+		assert.ErrorContains(t, results[0].Err, "startPosition is only supported with JetStream EventBus")
+		results, err = Lint([]string{dir}, Options{EventBusType: "unknown"})
+		assert.NoError(t, err)
+		assert.ErrorContains(t, results[0].Err, "unsupported eventbus type")
+	})
+
+	t.Run("sample events", func(t *testing.T) {
+		dir := writeManifests(t, map[string]string{"sensor.yaml": testSensor})
+		events := []fixtures.Event{{
+			Context: v1alpha1.EventContext{Source: "webhook", Subject: "example"},
+			Data:    json.RawMessage(`{"body": {"repository": "argo-events"}}`),
+		}}
+		results, err := Lint([]string{dir}, Options{Events: events})
+		assert.NoError(t, err)
+		assert.NoError(t, results[0].Err)
+		assert.True(t, results[0].Evaluation.Triggers["http-trigger"].Fired)
+		assert.JSONEq(t, `{"repository": "argo-events"}`, string(results[0].Evaluation.Triggers["http-trigger"].Payload))
+
+		events[0].Data = json.RawMessage(`{"body": {}}`)
+		results, err = Lint([]string{dir}, Options{Events: events})
+		assert.NoError(t, err)
+		assert.ErrorContains(t, results[0].Err, "trigger http-trigger")
+	})
+}
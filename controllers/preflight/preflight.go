@@ -0,0 +1,314 @@
+// Package preflight inspects the EventBus, EventSource and Sensor objects of a cluster before a controller upgrade,
+// and reports the deprecated fields, the EventBus versions the new controller does not support, and the
+// configurations it would reject.
+package preflight
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	"github.com/argoproj/argo-events/common"
+	"github.com/argoproj/argo-events/controllers"
+	controllerscommon "github.com/argoproj/argo-events/controllers/common"
+	eventbuscontroller "github.com/argoproj/argo-events/controllers/eventbus"
+	"github.com/argoproj/argo-events/controllers/eventbus/installer"
+	eventsourcecontroller "github.com/argoproj/argo-events/controllers/eventsource"
+	sensorcontroller "github.com/argoproj/argo-events/controllers/sensor"
+	eventbusv1alpha1 "github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1"
+	eventsourcev1alpha1 "github.com/argoproj/argo-events/pkg/apis/eventsource/v1alpha1"
+	sensorv1alpha1 "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+)
+
+// Severity is the severity of a finding.
+type Severity string
+
+const (
+	// SeverityError is a finding the new controller fails on, it must be fixed before the upgrade.
+	SeverityError Severity = "Error"
+	// SeverityWarning is a finding which does not block the upgrade, e.g. a deprecated field.
+	SeverityWarning Severity = "Warning"
+)
+
+// The codes of the findings.
+const (
+	CodeInvalidSpec        = "InvalidSpec"
+	CodeUnsupportedVersion = "UnsupportedVersion"
+	CodeDeprecatedField    = "DeprecatedField"
+	CodeDeprecatedEventBus = "DeprecatedEventBus"
+	CodeEventBusNotReady   = "EventBusNotReady"
+	CodeEventBusNotFound   = "EventBusNotFound"
+)
+
+// Finding is an issue found on an object.
+type Finding struct {
+	Severity  Severity `json:"severity"`
+	Code      string   `json:"code"`
+	Kind      string   `json:"kind"`
+	Namespace string   `json:"namespace"`
+	Name      string   `json:"name"`
+	// Field is the path of the field the finding is about, if any.
+	Field   string `json:"field,omitempty"`
+	Message string `json:"message"`
+}
+
+// Report is the outcome of the pre-flight checks.
+type Report struct {
+	// Checked is the number of objects checked, by kind.
+	Checked  map[string]int `json:"checked"`
+	Errors   int            `json:"errors"`
+	Warnings int            `json:"warnings"`
+	// VersionsChecked tells whether the EventBus versions were checked against the controller config of the upgrade.
+	VersionsChecked bool      `json:"versionsChecked"`
+	Findings        []Finding `json:"findings"`
+}
+
+// Options are the options of the pre-flight checks.
+type Options struct {
+	// Namespace restricts the checks to a namespace, all the namespaces if empty.
+	Namespace string
+	// Config is the controller config of the upgrade, the EventBus versions are not checked if nil.
+	Config *controllers.GlobalConfig
+}
+
+// Check runs the pre-flight checks against the objects of the cluster.
+func Check(ctx context.Context, cl client.Client, opts Options) (*Report, error) {
+	report := &Report{Checked: map[string]int{}, VersionsChecked: opts.Config != nil, Findings: []Finding{}}
+
+	eventBuses := &eventbusv1alpha1.EventBusList{}
+	if err := cl.List(ctx, eventBuses, client.InNamespace(opts.Namespace)); err != nil {
+		return nil, fmt.Errorf("failed to list EventBus objects, %w", err)
+	}
+	for i := range eventBuses.Items {
+		report.add(checkEventBus(&eventBuses.Items[i], opts.Config)...)
+	}
+	report.Checked[eventbusv1alpha1.SchemaGroupVersionKind.Kind] = len(eventBuses.Items)
+
+	eventSources := &eventsourcev1alpha1.EventSourceList{}
+	if err := cl.List(ctx, eventSources, client.InNamespace(opts.Namespace)); err != nil {
+		return nil, fmt.Errorf("failed to list EventSource objects, %w", err)
+	}
+	for i := range eventSources.Items {
+		report.add(checkEventSource(ctx, cl, &eventSources.Items[i])...)
+	}
+	report.Checked[eventsourcev1alpha1.SchemaGroupVersionKind.Kind] = len(eventSources.Items)
+
+	sensors := &sensorv1alpha1.SensorList{}
+	if err := cl.List(ctx, sensors, client.InNamespace(opts.Namespace)); err != nil {
+		return nil, fmt.Errorf("failed to list Sensor objects, %w", err)
+	}
+	for i := range sensors.Items {
+		report.add(checkSensor(ctx, cl, &sensors.Items[i], eventBuses.Items)...)
+	}
+	report.Checked[sensorv1alpha1.SchemaGroupVersionKind.Kind] = len(sensors.Items)
+
+	sort.SliceStable(report.Findings, func(i, j int) bool {
+		a, b := report.Findings[i], report.Findings[j]
+		if a.Severity != b.Severity {
+			return a.Severity == SeverityError
+		}
+		if a.Namespace != b.Namespace {
+			return a.Namespace < b.Namespace
+		}
+		return a.Kind < b.Kind
+	})
+	return report, nil
+}
+
+func (r *Report) add(findings ...Finding) {
+	for _, f := range findings {
+		if f.Severity == SeverityError {
+			r.Errors++
+		} else {
+			r.Warnings++
+		}
+		r.Findings = append(r.Findings, f)
+	}
+}
+
+func newFinding(obj metav1.Object, kind string, severity Severity, code, field, message string) Finding {
+	return Finding{Severity: severity, Code: code, Kind: kind, Namespace: obj.GetNamespace(), Name: obj.GetName(), Field: field, Message: message}
+}
+
+func checkEventBus(eb *eventbusv1alpha1.EventBus, config *controllers.GlobalConfig) []Finding {
+	kind := eventbusv1alpha1.SchemaGroupVersionKind.Kind
+	var findings []Finding
+	if err := eventbuscontroller.ValidateEventBus(eb); err != nil {
+		findings = append(findings, newFinding(eb, kind, SeverityError, CodeInvalidSpec, "spec", err.Error()))
+	}
+	if eb.Spec.NATS != nil {
+		findings = append(findings, newFinding(eb, kind, SeverityWarning, CodeDeprecatedEventBus, "spec.nats",
+			"NATS Streaming is end of life, migrate to a JetStream EventBus"))
+	}
+	if config != nil {
+		if eb.Spec.JetStream != nil {
+			if _, err := config.GetJetStreamVersion(eb.Spec.JetStream.Version); err != nil {
+				findings = append(findings, newFinding(eb, kind, SeverityError, CodeUnsupportedVersion, "spec.jetstream.version", err.Error()))
+			}
+		}
+		if eb.Spec.NATS != nil && eb.Spec.NATS.Native != nil {
+			if _, err := config.GetSTANVersion(installer.DefaultSTANVersion); err != nil {
+				findings = append(findings, newFinding(eb, kind, SeverityError, CodeUnsupportedVersion, "spec.nats.native", err.Error()))
+			}
+		}
+	}
+	if !eb.Status.IsReady() {
+		findings = append(findings, newFinding(eb, kind, SeverityWarning, CodeEventBusNotReady, "status",
+			"the EventBus is not ready, the upgrade restarts its EventSources and Sensors which might not reconnect"))
+	}
+	return findings
+}
+
+func checkEventSource(ctx context.Context, cl client.Client, es *eventsourcev1alpha1.EventSource) []Finding {
+	kind := eventsourcev1alpha1.SchemaGroupVersionKind.Kind
+	var findings []Finding
+	if resolved, err := eventsourcecontroller.ResolveIncludes(ctx, cl, es); err != nil {
+		findings = append(findings, newFinding(es, kind, SeverityError, CodeInvalidSpec, "spec", err.Error()))
+	} else if err := eventsourcecontroller.ValidateEventSource(resolved); err != nil {
+		findings = append(findings, newFinding(es, kind, SeverityError, CodeInvalidSpec, "spec", err.Error()))
+	}
+	deprecated := func(field, replacement string) {
+		findings = append(findings, newFinding(es, kind, SeverityWarning, CodeDeprecatedField, field,
+			fmt.Sprintf("the field is deprecated, use %q instead", replacement)))
+	}
+	for _, name := range sortedKeys(es.Spec.Github) {
+		g := es.Spec.Github[name]
+		if g.ID != 0 {
+			findings = append(findings, newFinding(es, kind, SeverityWarning, CodeDeprecatedField, fmt.Sprintf("spec.github.%s.id", name),
+				"the field is deprecated and ignored, remove it"))
+		}
+		if g.DeprecatedOwner != "" || g.DeprecatedRepository != "" {
+			deprecated(fmt.Sprintf("spec.github.%s.owner", name), "repositories")
+		}
+	}
+	for _, name := range sortedKeys(es.Spec.Gitlab) {
+		if es.Spec.Gitlab[name].DeprecatedProjectID != "" {
+			deprecated(fmt.Sprintf("spec.gitlab.%s.projectID", name), "projects")
+		}
+	}
+	for _, name := range sortedKeys(es.Spec.Bitbucket) {
+		b := es.Spec.Bitbucket[name]
+		if b.DeprecatedOwner != "" || b.DeprecatedProjectKey != "" || b.DeprecatedRepositorySlug != "" {
+			deprecated(fmt.Sprintf("spec.bitbucket.%s.owner", name), "repositories")
+		}
+	}
+	for _, name := range sortedKeys(es.Spec.BitbucketServer) {
+		b := es.Spec.BitbucketServer[name]
+		if b.DeprecatedProjectKey != "" || b.DeprecatedRepositorySlug != "" {
+			deprecated(fmt.Sprintf("spec.bitbucketserver.%s.projectKey", name), "repositories")
+		}
+	}
+	return findings
+}
+
+// checkSensor validates a Sensor against the EventBuses it consumes from, resolved like the Sensor controller does.
+func checkSensor(ctx context.Context, cl client.Client, sensor *sensorv1alpha1.Sensor, eventBuses []eventbusv1alpha1.EventBus) []Finding {
+	kind := sensorv1alpha1.SchemaGroupVersionKind.Kind
+	var findings []Finding
+	find := func(name string) *eventbusv1alpha1.EventBus {
+		for i := range eventBuses {
+			if eventBuses[i].Namespace == sensor.Namespace && eventBuses[i].Name == name {
+				return &eventBuses[i]
+			}
+		}
+		return nil
+	}
+	var eb *eventbusv1alpha1.EventBus
+	switch {
+	case sensor.Spec.RemoteEventBus != nil && sensor.Spec.EventBusName != "":
+		findings = append(findings, newFinding(sensor, kind, SeverityError, CodeInvalidSpec, "spec.remoteEventBus",
+			"eventBusName and remoteEventBus can not be used together"))
+	case sensor.Spec.RemoteEventBus != nil:
+		remote, err := controllerscommon.GetRemoteEventBus(ctx, cl, sensor.Namespace, sensor.Spec.RemoteEventBus)
+		if err != nil {
+			findings = append(findings, newFinding(sensor, kind, SeverityError, CodeEventBusNotFound, "spec.remoteEventBus", err.Error()))
+		}
+		eb = remote
+	default:
+		name := sensor.Spec.EventBusName
+		if name == "" {
+			name = common.DefaultEventBusName
+		}
+		if eb = find(name); eb == nil {
+			findings = append(findings, newFinding(sensor, kind, SeverityError, CodeEventBusNotFound, "spec.eventBusName",
+				fmt.Sprintf("EventBus %q not found", name)))
+		}
+	}
+	// The additional EventBuses the dependencies consume from
+	additionalEventBuses := make(map[string]*eventbusv1alpha1.EventBus)
+	for i, dep := range sensor.Spec.Dependencies {
+		name := dep.EventBusName
+		if name == "" || (eb != nil && sensor.Spec.RemoteEventBus == nil && name == eb.Name) {
+			continue
+		}
+		if _, ok := additionalEventBuses[name]; ok {
+			continue
+		}
+		additional := find(name)
+		if additional == nil {
+			findings = append(findings, newFinding(sensor, kind, SeverityError, CodeEventBusNotFound, fmt.Sprintf("spec.dependencies[%d].eventBusName", i),
+				fmt.Sprintf("EventBus %q of the dependency %s not found", name, dep.Name)))
+			continue
+		}
+		additionalEventBuses[name] = additional
+	}
+	if eb != nil {
+		if err := sensorcontroller.ValidateSensorWithEventBuses(sensor, eb, additionalEventBuses); err != nil {
+			findings = append(findings, newFinding(sensor, kind, SeverityError, CodeInvalidSpec, "spec", err.Error()))
+		}
+	}
+	for i, trigger := range sensor.Spec.Triggers {
+		if trigger.Template != nil && trigger.Template.Kafka != nil && trigger.Template.Kafka.Partition != 0 {
+			findings = append(findings, newFinding(sensor, kind, SeverityWarning, CodeDeprecatedField,
+				fmt.Sprintf("spec.triggers[%d].template.kafka.partition", i), "the field is deprecated and ignored, use \"partitioningKey\" instead"))
+		}
+	}
+	return findings
+}
+
+// LoadConfig loads the controller config of the upgrade, from either the controller-config.yaml file or the
+// ConfigMap manifest holding it.
+func LoadConfig(path string) (*controllers.GlobalConfig, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	meta := metav1.TypeMeta{}
+	if err := yaml.Unmarshal(b, &meta); err != nil {
+		return nil, fmt.Errorf("failed to parse %s, %w", path, err)
+	}
+	if meta.Kind == "ConfigMap" {
+		cm := &corev1.ConfigMap{}
+		if err := yaml.Unmarshal(b, cm); err != nil {
+			return nil, fmt.Errorf("failed to parse %s, %w", path, err)
+		}
+		data, ok := cm.Data["controller-config.yaml"]
+		if !ok {
+			return nil, fmt.Errorf("%q not found in the ConfigMap %s", "controller-config.yaml", cm.Name)
+		}
+		b = []byte(data)
+	}
+	config := &controllers.GlobalConfig{}
+	if err := yaml.Unmarshal(b, config); err != nil {
+		return nil, fmt.Errorf("failed to parse the controller config, %w", err)
+	}
+	if err := controllers.ValidateConfig(config); err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,176 @@
+package preflight
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/argoproj/argo-events/controllers"
+	apicommon "github.com/argoproj/argo-events/pkg/apis/common"
+	eventbusv1alpha1 "github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1"
+	eventsourcev1alpha1 "github.com/argoproj/argo-events/pkg/apis/eventsource/v1alpha1"
+	sensorv1alpha1 "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+)
+
+const testConfig = `apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: argo-events-controller-config
+data:
+  controller-config.yaml: |
+    eventBus:
+      nats:
+        versions:
+          - version: 0.22.1
+            natsStreamingImage: nats-streaming:0.22.1
+            metricsExporterImage: natsio/prometheus-nats-exporter:0.8.0
+      jetstream:
+        versions:
+          - version: 2.9.1
+            natsImage: nats:2.9.1
+            metricsExporterImage: natsio/prometheus-nats-exporter:0.9.1
+            configReloaderImage: natsio/nats-server-config-reloader:0.7.0
+            startCommand: /nats-server
+`
+
+func fakeClient(t *testing.T, objs ...client.Object) client.Client {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	assert.NoError(t, corev1.AddToScheme(scheme))
+	assert.NoError(t, eventbusv1alpha1.AddToScheme(scheme))
+	assert.NoError(t, eventsourcev1alpha1.AddToScheme(scheme))
+	assert.NoError(t, sensorv1alpha1.AddToScheme(scheme))
+	return fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).Build()
+}
+
+func loadTestConfig(t *testing.T) *controllers.GlobalConfig {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	assert.NoError(t, os.WriteFile(path, []byte(testConfig), 0600))
+	config, err := LoadConfig(path)
+	assert.NoError(t, err)
+	return config
+}
+
+// codes returns the codes of the findings of an object.
+func codes(report *Report, kind, namespace, name string) []string {
+	var result []string
+	for _, f := range report.Findings {
+		if f.Kind == kind && f.Namespace == namespace && f.Name == name {
+			result = append(result, f.Code)
+		}
+	}
+	return result
+}
+
+func TestLoadConfig(t *testing.T) {
+	config := loadTestConfig(t)
+	_, err := config.GetJetStreamVersion("2.9.1")
+	assert.NoError(t, err)
+
+	path := filepath.Join(t.TempDir(), "controller-config.yaml")
+	assert.NoError(t, os.WriteFile(path, []byte("eventBus:\n  jetstream:\n    versions:\n      - version: 2.9.1\n"), 0600))
+	_, err = LoadConfig(path)
+	assert.ErrorContains(t, err, "no stan versions")
+}
+
+func TestCheck(t *testing.T) {
+	ready := &eventbusv1alpha1.EventBus{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns-a", Name: "default"},
+		Spec:       eventbusv1alpha1.EventBusSpec{JetStream: &eventbusv1alpha1.JetStreamBus{Version: "2.9.1"}},
+	}
+	ready.Status.InitConditions()
+	ready.Status.MarkDeployed("Deployed", "deployed")
+	ready.Status.MarkConfigured()
+	outdated := &eventbusv1alpha1.EventBus{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns-a", Name: "old"},
+		Spec:       eventbusv1alpha1.EventBusSpec{JetStream: &eventbusv1alpha1.JetStreamBus{Version: "2.7.3"}},
+	}
+	stan := &eventbusv1alpha1.EventBus{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns-b", Name: "default"},
+		Spec:       eventbusv1alpha1.EventBusSpec{NATS: &eventbusv1alpha1.NATSBus{Native: &eventbusv1alpha1.NativeStrategy{}}},
+	}
+	eventSource := &eventsourcev1alpha1.EventSource{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns-a", Name: "gitlab"},
+		Spec: eventsourcev1alpha1.EventSourceSpec{
+			Gitlab: map[string]eventsourcev1alpha1.GitlabEventSource{
+				"example": {DeprecatedProjectID: "1"},
+			},
+		},
+	}
+	kafkaSensor := &sensorv1alpha1.Sensor{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns-a", Name: "kafka"},
+		Spec: sensorv1alpha1.SensorSpec{
+			Dependencies: []sensorv1alpha1.EventDependency{{Name: "dep", EventSourceName: "gitlab", EventName: "example"}},
+			Triggers: []sensorv1alpha1.Trigger{{
+				Template: &sensorv1alpha1.TriggerTemplate{
+					Name:  "kafka-trigger",
+					Kafka: &sensorv1alpha1.KafkaTrigger{URL: "kafka:9092", Topic: "topic", Partition: 1},
+				},
+			}},
+		},
+	}
+	orphanSensor := kafkaSensor.DeepCopy()
+	orphanSensor.Namespace = "ns-c"
+	remoteSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns-a", Name: "remote"},
+		Data:       map[string][]byte{"connection": []byte("url: nats://remote:4222")},
+	}
+	validSensor := kafkaSensor.DeepCopy()
+	validSensor.Spec.Triggers[0].Template.Kafka.Partition = 0
+	validSensor.Spec.Triggers[0].Template.Kafka.Payload = []sensorv1alpha1.TriggerParameter{
+		{Src: &sensorv1alpha1.TriggerParameterSource{DependencyName: "dep"}, Dest: "body"},
+	}
+	remoteSensor := validSensor.DeepCopy()
+	remoteSensor.Name = "remote"
+	remoteSensor.Spec.RemoteEventBus = &apicommon.RemoteEventBus{
+		ConnectionSecret: &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "remote"}, Key: "connection"},
+	}
+	missingRemoteSensor := remoteSensor.DeepCopy()
+	missingRemoteSensor.Name = "missing-remote"
+	missingRemoteSensor.Spec.RemoteEventBus.ConnectionSecret.Name = "missing"
+	// The start position of the dependency is checked against its own EventBus
+	multiBusSensor := validSensor.DeepCopy()
+	multiBusSensor.Name = "multi"
+	multiBusSensor.Spec.EventBusName = "kafka"
+	multiBusSensor.Spec.Dependencies = append(multiBusSensor.Spec.Dependencies, sensorv1alpha1.EventDependency{
+		Name: "other", EventSourceName: "gitlab", EventName: "other", EventBusName: "default",
+		StartPosition: &sensorv1alpha1.DependencyStartPosition{DeliverPolicy: sensorv1alpha1.DeliverPolicyEarliest},
+	}, sensorv1alpha1.EventDependency{Name: "missing", EventSourceName: "gitlab", EventName: "missing", EventBusName: "missing"})
+	kafkaBus := &eventbusv1alpha1.EventBus{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns-a", Name: "kafka"},
+		Spec:       eventbusv1alpha1.EventBusSpec{Kafka: &eventbusv1alpha1.KafkaBus{URL: "kafka:9092"}},
+	}
+	cl := fakeClient(t, ready, outdated, stan, kafkaBus, eventSource, kafkaSensor, orphanSensor, remoteSecret, remoteSensor, missingRemoteSensor, multiBusSensor)
+
+	report, err := Check(context.Background(), cl, Options{Config: loadTestConfig(t)})
+	assert.NoError(t, err)
+	assert.True(t, report.VersionsChecked)
+	assert.Equal(t, map[string]int{"EventBus": 4, "EventSource": 1, "Sensor": 5}, report.Checked)
+	assert.Empty(t, codes(report, "EventBus", "ns-a", "default"))
+	assert.Equal(t, []string{CodeUnsupportedVersion, CodeEventBusNotReady}, codes(report, "EventBus", "ns-a", "old"))
+	assert.Equal(t, []string{CodeDeprecatedEventBus, CodeEventBusNotReady}, codes(report, "EventBus", "ns-b", "default"))
+	assert.Contains(t, codes(report, "EventSource", "ns-a", "gitlab"), CodeDeprecatedField)
+	assert.Contains(t, codes(report, "Sensor", "ns-a", "kafka"), CodeDeprecatedField)
+	assert.Contains(t, codes(report, "Sensor", "ns-c", "kafka"), CodeEventBusNotFound)
+	assert.Empty(t, codes(report, "Sensor", "ns-a", "remote"))
+	assert.Equal(t, []string{CodeEventBusNotFound}, codes(report, "Sensor", "ns-a", "missing-remote"))
+	assert.Equal(t, []string{CodeEventBusNotFound}, codes(report, "Sensor", "ns-a", "multi"))
+	assert.Equal(t, SeverityError, report.Findings[0].Severity)
+	assert.Equal(t, len(report.Findings), report.Errors+report.Warnings)
+
+	// Without the controller config, the versions are not checked
+	report, err = Check(context.Background(), cl, Options{Namespace: "ns-a"})
+	assert.NoError(t, err)
+	assert.False(t, report.VersionsChecked)
+	assert.Equal(t, []string{CodeEventBusNotReady}, codes(report, "EventBus", "ns-a", "old"))
+	assert.Empty(t, codes(report, "Sensor", "ns-c", "kafka"))
+}
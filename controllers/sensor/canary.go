@@ -0,0 +1,378 @@
+package sensor
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/prometheus/common/expfmt"
+	"go.uber.org/zap"
+	appv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	"github.com/argoproj/argo-events/common"
+	controllerscommon "github.com/argoproj/argo-events/controllers/common"
+	"github.com/argoproj/argo-events/controllers/eventbus/installer"
+	jetstreamsensor "github.com/argoproj/argo-events/eventbus/jetstream/sensor"
+	eventbusv1alpha1 "github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1"
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+)
+
+const (
+	metricActionTriggered = "argo_events_action_triggered_total"
+	metricActionFailed    = "argo_events_action_failed_total"
+
+	// canaryStateFinalizer keeps the canary Deployment on a JetStream EventBus until the EventBus state of the shadow
+	// Sensor is deleted, once the canary pods are gone.
+	canaryStateFinalizer = "sensor.argoproj.io/canary-state"
+)
+
+// fetchCanaryExecutions returns the numbers of dry-run trigger executions and failures of a canary pod,
+// it's a variable so that it can be replaced in the tests.
+var fetchCanaryExecutions = func(ctx context.Context, pod *corev1.Pod) (int64, int64, error) {
+	url := fmt.Sprintf("http://%s:%d/metrics", pod.Status.PodIP, common.SensorMetricsPort)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return 0, 0, err
+	}
+	resp, err := (&http.Client{Timeout: 10 * time.Second}).Do(req)
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to get the metrics of canary pod %s, %w", pod.Name, err)
+	}
+	defer resp.Body.Close()
+	families, err := (&expfmt.TextParser{}).TextToMetricFamilies(resp.Body)
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to parse the metrics of canary pod %s, %w", pod.Name, err)
+	}
+	sum := func(name string) int64 {
+		var total float64
+		if family, ok := families[name]; ok {
+			for _, m := range family.GetMetric() {
+				total += m.GetCounter().GetValue()
+			}
+		}
+		return int64(total)
+	}
+	failures := sum(metricActionFailed)
+	return sum(metricActionTriggered) + failures, failures, nil
+}
+
+// canaryArgs returns the args of the canary Deployment. The canary runs as a shadow Sensor with a distinct name,
+// so that it gets its own EventBus consumers, leader election and pod labels.
+func canaryArgs(args *AdaptorArgs) *AdaptorArgs {
+	shadow := args.Sensor.DeepCopy()
+	shadow.Name = fmt.Sprintf("%s-canary", args.Sensor.Name)
+	shadow.Spec.Replicas = nil
+	if generatesServiceAccount(args.Sensor) {
+		// The canary runs with the ServiceAccount generated for the Sensor
+		shadow.Spec.Template.GenerateServiceAccount = false
+		shadow.Spec.Template.ServiceAccountName = generatedServiceAccountName(args.Sensor)
+	}
+	labels := map[string]string{}
+	for k, v := range args.Labels {
+		labels[k] = v
+	}
+	labels[common.LabelSensorName] = shadow.Name
+	return &AdaptorArgs{
+		Image:          args.Image,
+		Sensor:         shadow,
+		Labels:         labels,
+		ReferencesHash: args.ReferencesHash,
+		LogOnly:        true,
+	}
+}
+
+// withReferencesHash returns a copy of the args with the given references hash.
+func withReferencesHash(args *AdaptorArgs, hash string) *AdaptorArgs {
+	result := *args
+	result.ReferencesHash = hash
+	return &result
+}
+
+// canaryRevision returns the revision gated by the canary, the spec hash of the expected Deployment regardless of
+// the references hash, so that a revision rolled back is not retried once a Secret or a ConfigMap is rotated.
+func canaryRevision(args *AdaptorArgs, eventBus *eventbusv1alpha1.EventBus) (string, error) {
+	deploy, err := buildDeployment(withReferencesHash(args, ""), eventBus)
+	if err != nil {
+		return "", err
+	}
+	return deploy.Annotations[common.AnnotationResourceSpecHash], nil
+}
+
+// referencesOnlyChange returns true if the expected Deployment differs from the current one by the references hash
+// only, i.e. a referenced Secret or ConfigMap was rotated. Such a change is rolled out without the canary, whose
+// dry-run triggers would not exercise the new credentials, and which would keep them from the current pods once
+// rolled back.
+func referencesOnlyChange(args *AdaptorArgs, eventBus *eventbusv1alpha1.EventBus, deploy *appv1.Deployment) (bool, error) {
+	currentHash := deploy.Spec.Template.Annotations[common.AnnotationReferencesHash]
+	if currentHash == args.ReferencesHash {
+		return false, nil
+	}
+	current, err := buildDeployment(withReferencesHash(args, currentHash), eventBus)
+	if err != nil {
+		return false, err
+	}
+	return current.Annotations[common.AnnotationResourceSpecHash] == deploy.Annotations[common.AnnotationResourceSpecHash], nil
+}
+
+func buildCanaryDeployment(args *AdaptorArgs, eventBus *eventbusv1alpha1.EventBus) (*appv1.Deployment, error) {
+	if kafka := eventBus.Status.Config.Kafka; kafka != nil && kafka.ConsumerGroup != nil && kafka.ConsumerGroup.GroupName != "" {
+		// A consumer group shared with the current Deployment would split the events between them.
+		eventBus = eventBus.DeepCopy()
+		eventBus.Status.Config.Kafka.ConsumerGroup.GroupName += "-canary"
+	}
+	deploy, err := buildDeployment(canaryArgs(args), eventBus)
+	if err != nil {
+		return nil, err
+	}
+	// The shadow Sensor is not the owner, set the owner reference and the hash again.
+	deploy.SetOwnerReferences(nil)
+	delete(deploy.Annotations, common.AnnotationResourceSpecHash)
+	if err := controllerscommon.SetObjectMeta(args.Sensor, deploy, v1alpha1.SchemaGroupVersionKind); err != nil {
+		return nil, err
+	}
+	if len(jetStreamEventBuses(args, eventBus)) > 0 {
+		controllerutil.AddFinalizer(deploy, canaryStateFinalizer)
+	}
+	return deploy, nil
+}
+
+// jetStreamEventBuses returns the JetStream EventBuses the Sensor consumes from, where the shadow Sensor of the canary
+// has its Key/Value stores and durable consumers.
+func jetStreamEventBuses(args *AdaptorArgs, eventBus *eventbusv1alpha1.EventBus) []*eventbusv1alpha1.EventBus {
+	var result []*eventbusv1alpha1.EventBus
+	if eventBus.Status.Config.JetStream != nil {
+		result = append(result, eventBus)
+	}
+	names := make([]string, 0, len(args.AdditionalEventBuses))
+	for name := range args.AdditionalEventBuses {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if b := args.AdditionalEventBuses[name]; b.Status.Config.JetStream != nil {
+			result = append(result, b)
+		}
+	}
+	return result
+}
+
+// deleteCanaryState deletes the EventBus state of the shadow Sensor of the canary on the JetStream EventBuses, it's a
+// variable so that it can be replaced in the tests.
+var deleteCanaryState = func(ctx context.Context, cl client.Client, sensorName string, eventBuses []*eventbusv1alpha1.EventBus) error {
+	for _, eventBus := range eventBuses {
+		js, closeConn, err := installer.ConnectJetStream(ctx, cl, eventBus)
+		if err != nil {
+			return err
+		}
+		err = jetstreamsensor.DeleteState(js, sensorName)
+		closeConn()
+		if err != nil {
+			return fmt.Errorf("failed to delete the state on eventbus %s, %w", eventBus.Name, err)
+		}
+	}
+	return nil
+}
+
+// reconcileCanary rolls out the expected Deployment with a canary. The canary is started alongside the current
+// Deployment, and is evaluated once the bake duration elapsed: the current Deployment is either updated with the
+// expected spec, or kept as is until the spec changes again.
+func reconcileCanary(ctx context.Context, cl client.Client, args *AdaptorArgs, eventBus *eventbusv1alpha1.EventBus, deploy, expectedDeploy *appv1.Deployment, logger *zap.SugaredLogger) error {
+	sensor := args.Sensor
+	canary := sensor.Spec.Rollout.GetCanary()
+	revision, err := canaryRevision(args, eventBus)
+	if err != nil {
+		return fmt.Errorf("failed to build deployment spec, %w", err)
+	}
+	status := sensor.Status.Canary
+	if status != nil && status.Revision == revision && status.Phase == v1alpha1.CanaryPhaseRolledBack {
+		return deleteCanary(ctx, cl, args, eventBus, logger)
+	}
+
+	expectedCanary, err := buildCanaryDeployment(args, eventBus)
+	if err != nil {
+		return fmt.Errorf("failed to build canary deployment spec, %w", err)
+	}
+	existingCanary, err := getDeployment(ctx, cl, canaryArgs(args))
+	if err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("failed to get canary deployment, %w", err)
+	}
+	if existingCanary != nil && !existingCanary.DeletionTimestamp.IsZero() {
+		// The canary of the previous rollout is still being deleted
+		return deleteCanary(ctx, cl, args, eventBus, logger)
+	}
+	if existingCanary == nil {
+		if err := cl.Create(ctx, expectedCanary); err != nil {
+			return fmt.Errorf("failed to create canary deployment, %w", err)
+		}
+		sensor.Status.Canary = newCanaryStatus(revision)
+		logger.Infow("canary deployment is created", "deploymentName", expectedCanary.Name, "revision", revision)
+		return nil
+	}
+	if existingCanary.Annotations[common.AnnotationResourceSpecHash] != expectedCanary.Annotations[common.AnnotationResourceSpecHash] {
+		existingCanary.Spec = expectedCanary.Spec
+		existingCanary.SetLabels(expectedCanary.Labels)
+		existingCanary.Annotations[common.AnnotationResourceSpecHash] = expectedCanary.Annotations[common.AnnotationResourceSpecHash]
+		if err := cl.Update(ctx, existingCanary); err != nil {
+			return fmt.Errorf("failed to update canary deployment, %w", err)
+		}
+		sensor.Status.Canary = newCanaryStatus(revision)
+		logger.Infow("canary deployment is updated", "deploymentName", existingCanary.Name, "revision", revision)
+		return nil
+	}
+	if status == nil || status.Revision != revision || status.Phase != v1alpha1.CanaryPhaseBaking {
+		sensor.Status.Canary = newCanaryStatus(revision)
+		return nil
+	}
+	if time.Since(status.StartedAt.Time) < canary.GetBakeDuration() {
+		return nil
+	}
+
+	running, executions, failures, err := getCanaryExecutions(ctx, cl, canaryArgs(args))
+	if err != nil {
+		return err
+	}
+	status.Executions = executions
+	status.Failures = failures
+	errorRate := float64(0)
+	if executions > 0 {
+		errorRate = float64(failures) / float64(executions)
+	}
+	switch {
+	case running == 0:
+		status.Phase = v1alpha1.CanaryPhaseRolledBack
+		status.Message = "no canary pod is running"
+	case executions < int64(canary.MinExecutions):
+		status.Phase = v1alpha1.CanaryPhaseRolledBack
+		status.Message = fmt.Sprintf("%d dry-run trigger executions, less than the minimum of %d", executions, canary.MinExecutions)
+	case errorRate > canary.GetMaxErrorRate():
+		status.Phase = v1alpha1.CanaryPhaseRolledBack
+		status.Message = fmt.Sprintf("error rate %.4f of the dry-run trigger executions exceeds the maximum of %v", errorRate, canary.GetMaxErrorRate())
+	default:
+		deploy.Spec = expectedDeploy.Spec
+		deploy.SetLabels(expectedDeploy.Labels)
+		deploy.Annotations[common.AnnotationResourceSpecHash] = expectedDeploy.Annotations[common.AnnotationResourceSpecHash]
+		if err := cl.Update(ctx, deploy); err != nil {
+			return fmt.Errorf("failed to promote canary, %w", err)
+		}
+		status.Phase = v1alpha1.CanaryPhasePromoted
+		status.Message = fmt.Sprintf("error rate %.4f of %d dry-run trigger executions", errorRate, executions)
+		logger.Infow("canary is promoted", "deploymentName", deploy.Name, "revision", revision)
+	}
+	if status.Phase == v1alpha1.CanaryPhaseRolledBack {
+		logger.Warnw("canary is rolled back", "revision", revision, "reason", status.Message)
+	}
+	return deleteCanary(ctx, cl, args, eventBus, logger)
+}
+
+func newCanaryStatus(revision string) *v1alpha1.CanaryStatus {
+	return &v1alpha1.CanaryStatus{
+		Revision:  revision,
+		Phase:     v1alpha1.CanaryPhaseBaking,
+		StartedAt: metav1.Now(),
+	}
+}
+
+// getCanaryExecutions returns the number of running canary pods, and the sum of their dry-run trigger executions and failures.
+func getCanaryExecutions(ctx context.Context, cl client.Client, canaryArgs *AdaptorArgs) (int, int64, int64, error) {
+	pods := &corev1.PodList{}
+	if err := cl.List(ctx, pods, &client.ListOptions{
+		Namespace:     canaryArgs.Sensor.Namespace,
+		LabelSelector: labelSelector(canaryArgs.Labels),
+	}); err != nil {
+		return 0, 0, 0, fmt.Errorf("failed to list canary pods, %w", err)
+	}
+	var running int
+	var executions, failures int64
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		if pod.Status.Phase != corev1.PodRunning || pod.Status.PodIP == "" {
+			continue
+		}
+		e, f, err := fetchCanaryExecutions(ctx, pod)
+		if err != nil {
+			return 0, 0, 0, err
+		}
+		running++
+		executions += e
+		failures += f
+	}
+	return running, executions, failures, nil
+}
+
+// deleteCanary deletes the canary Deployment, if any. On a JetStream EventBus, the Deployment is deleted once its
+// pods are, so that they don't create the EventBus state of the shadow Sensor again, and the state is deleted before
+// the finalizer of the Deployment is removed.
+func deleteCanary(ctx context.Context, cl client.Client, args *AdaptorArgs, eventBus *eventbusv1alpha1.EventBus, logger *zap.SugaredLogger) error {
+	existingCanary, err := getDeployment(ctx, cl, canaryArgs(args))
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to get canary deployment, %w", err)
+	}
+	if existingCanary.DeletionTimestamp.IsZero() {
+		if err := cl.Delete(ctx, existingCanary, client.PropagationPolicy(metav1.DeletePropagationForeground)); err != nil && !apierrors.IsNotFound(err) {
+			return fmt.Errorf("failed to delete canary deployment, %w", err)
+		}
+		logger.Infow("canary deployment is deleted", "deploymentName", existingCanary.Name)
+		return nil
+	}
+	if !controllerutil.ContainsFinalizer(existingCanary, canaryStateFinalizer) || controllerutil.ContainsFinalizer(existingCanary, metav1.FinalizerDeleteDependents) {
+		// The canary pods are not gone yet
+		return nil
+	}
+	shadowName := canaryArgs(args).Sensor.Name
+	if err := deleteCanaryState(ctx, cl, shadowName, jetStreamEventBuses(args, eventBus)); err != nil {
+		return fmt.Errorf("failed to delete the eventbus state of the canary, %w", err)
+	}
+	controllerutil.RemoveFinalizer(existingCanary, canaryStateFinalizer)
+	if err := cl.Update(ctx, existingCanary); err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("failed to remove the finalizer of canary deployment, %w", err)
+	}
+	logger.Infow("eventbus state of the canary is deleted", "sensorName", shadowName)
+	return nil
+}
+
+// releaseCanary removes the finalizer of the canary Deployment of a deleted Sensor, whose EventBus state is left
+// behind like the one of the Sensor.
+func releaseCanary(ctx context.Context, cl client.Client, sensor *v1alpha1.Sensor) error {
+	deployments := &appv1.DeploymentList{}
+	if err := cl.List(ctx, deployments, &client.ListOptions{
+		Namespace:     sensor.Namespace,
+		LabelSelector: labelSelector(map[string]string{common.LabelSensorName: fmt.Sprintf("%s-canary", sensor.Name)}),
+	}); err != nil {
+		return fmt.Errorf("failed to list canary deployments, %w", err)
+	}
+	for i := range deployments.Items {
+		deploy := &deployments.Items[i]
+		if !metav1.IsControlledBy(deploy, sensor) || !controllerutil.ContainsFinalizer(deploy, canaryStateFinalizer) {
+			continue
+		}
+		controllerutil.RemoveFinalizer(deploy, canaryStateFinalizer)
+		if err := cl.Update(ctx, deploy); err != nil && !apierrors.IsNotFound(err) {
+			return fmt.Errorf("failed to remove the finalizer of canary deployment, %w", err)
+		}
+	}
+	return nil
+}
+
+// CanaryRequeueAfter returns how long to wait before evaluating the canary of the Sensor, 0 if it is not baking.
+func CanaryRequeueAfter(sensor *v1alpha1.Sensor) time.Duration {
+	canary := sensor.Spec.Rollout.GetCanary()
+	status := sensor.Status.Canary
+	if canary == nil || status == nil || status.Phase != v1alpha1.CanaryPhaseBaking {
+		return 0
+	}
+	remaining := time.Until(status.StartedAt.Add(canary.GetBakeDuration()))
+	if remaining < time.Second {
+		return time.Second
+	}
+	return remaining
+}
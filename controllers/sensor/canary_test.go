@@ -0,0 +1,235 @@
+package sensor
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	appv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	"github.com/argoproj/argo-events/common"
+	"github.com/argoproj/argo-events/common/logging"
+	apicommon "github.com/argoproj/argo-events/pkg/apis/common"
+	eventbusv1alpha1 "github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1"
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+)
+
+func TestReconcileCanary(t *testing.T) {
+	ctx := context.TODO()
+	testBus := fakeEventBusJetstream.DeepCopy()
+	testBus.Status.MarkDeployed("test", "test")
+	testBus.Status.MarkConfigured()
+	maxErrorRate := apicommon.NewAmount("0.1")
+	testSensor := sensorObj.DeepCopy()
+	testSensor.Spec.Rollout = &v1alpha1.SensorRollout{
+		Canary: &v1alpha1.CanaryRollout{BakeDuration: "1m", MaxErrorRate: &maxErrorRate},
+	}
+	cl := fake.NewClientBuilder().WithInterceptorFuncs(interceptor.Funcs{
+		Delete: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.DeleteOption) error {
+			// The objects deleted in the foreground are kept until their dependents are deleted
+			deleteOpts := &client.DeleteOptions{}
+			deleteOpts.ApplyOptions(opts)
+			if deleteOpts.PropagationPolicy != nil && *deleteOpts.PropagationPolicy == metav1.DeletePropagationForeground {
+				controllerutil.AddFinalizer(obj, metav1.FinalizerDeleteDependents)
+				if err := c.Update(ctx, obj); err != nil {
+					return err
+				}
+			}
+			return c.Delete(ctx, obj, opts...)
+		},
+	}).Build()
+	labels := map[string]string{"controller": "test-controller", common.LabelSensorName: testSensor.Name}
+	args := &AdaptorArgs{Image: testImage, Sensor: testSensor, Labels: labels}
+	logger := logging.NewArgoEventsLogger()
+
+	var executions, failures int64
+	defer func(f func(context.Context, *corev1.Pod) (int64, int64, error)) { fetchCanaryExecutions = f }(fetchCanaryExecutions)
+	fetchCanaryExecutions = func(ctx context.Context, pod *corev1.Pod) (int64, int64, error) {
+		return executions, failures, nil
+	}
+	var deletedStates []string
+	defer func(f func(context.Context, client.Client, string, []*eventbusv1alpha1.EventBus) error) {
+		deleteCanaryState = f
+	}(deleteCanaryState)
+	deleteCanaryState = func(ctx context.Context, cl client.Client, sensorName string, eventBuses []*eventbusv1alpha1.EventBus) error {
+		assert.Len(t, eventBuses, 1)
+		deletedStates = append(deletedStates, sensorName)
+		return nil
+	}
+	listDeployments := func() []appv1.Deployment {
+		deployList := &appv1.DeploymentList{}
+		err := cl.List(ctx, deployList, &client.ListOptions{Namespace: testNamespace})
+		assert.NoError(t, err)
+		return deployList.Items
+	}
+	stableDeployment := func() *appv1.Deployment {
+		deploy, err := getDeployment(ctx, cl, args)
+		assert.NoError(t, err)
+		return deploy
+	}
+	startCanary := func(replicas int32) string {
+		testSensor.Spec.Replicas = &replicas
+		err := Reconcile(cl, testBus, args, logger)
+		assert.NoError(t, err)
+		assert.Len(t, listDeployments(), 2)
+		assert.Equal(t, v1alpha1.CanaryPhaseBaking, testSensor.Status.Canary.Phase)
+		canary, err := getDeployment(ctx, cl, canaryArgs(args))
+		assert.NoError(t, err)
+		assert.Equal(t, int32(1), *canary.Spec.Replicas)
+		assert.Contains(t, canary.Spec.Template.Spec.Containers[0].Env, corev1.EnvVar{Name: common.EnvVarSensorDryRun, Value: "true"})
+		assert.True(t, CanaryRequeueAfter(testSensor) > 0)
+		// Not baked yet
+		err = Reconcile(cl, testBus, args, logger)
+		assert.NoError(t, err)
+		assert.Len(t, listDeployments(), 2)
+		testSensor.Status.Canary.StartedAt = metav1.NewTime(time.Now().Add(-2 * time.Minute))
+		return testSensor.Status.Canary.Revision
+	}
+	// finishCanary checks the canary is being deleted, and that its state is deleted along with it once its pods are
+	finishCanary := func() {
+		deletedStates = nil
+		err := Reconcile(cl, testBus, args, logger)
+		assert.NoError(t, err)
+		assert.Empty(t, deletedStates)
+		assert.Len(t, listDeployments(), 2)
+		canary, err := getDeployment(ctx, cl, canaryArgs(args))
+		assert.NoError(t, err)
+		assert.False(t, canary.DeletionTimestamp.IsZero())
+
+		// The canary pods are deleted
+		controllerutil.RemoveFinalizer(canary, metav1.FinalizerDeleteDependents)
+		assert.NoError(t, cl.Update(ctx, canary))
+		err = Reconcile(cl, testBus, args, logger)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{canaryArgs(args).Sensor.Name}, deletedStates)
+		assert.Len(t, listDeployments(), 1)
+	}
+
+	err := Reconcile(cl, testBus, args, logger)
+	assert.NoError(t, err)
+	assert.Len(t, listDeployments(), 1)
+	assert.Nil(t, testSensor.Status.Canary)
+
+	err = cl.Create(ctx, &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Namespace: testNamespace, Name: "canary-pod", Labels: canaryArgs(args).Labels},
+		Status:     corev1.PodStatus{Phase: corev1.PodRunning, PodIP: "10.0.0.1"},
+	})
+	assert.NoError(t, err)
+
+	t.Run("test promote canary", func(t *testing.T) {
+		revision := startCanary(2)
+		executions, failures = 10, 1
+		err := Reconcile(cl, testBus, args, logger)
+		assert.NoError(t, err)
+		assert.Equal(t, v1alpha1.CanaryPhasePromoted, testSensor.Status.Canary.Phase)
+		assert.Equal(t, int64(10), testSensor.Status.Canary.Executions)
+		finishCanary()
+		assert.Equal(t, revision, stableDeployment().Annotations[common.AnnotationResourceSpecHash])
+		assert.Equal(t, int32(2), *stableDeployment().Spec.Replicas)
+		assert.Equal(t, time.Duration(0), CanaryRequeueAfter(testSensor))
+	})
+
+	t.Run("test roll back canary", func(t *testing.T) {
+		revision := startCanary(3)
+		executions, failures = 10, 5
+		err := Reconcile(cl, testBus, args, logger)
+		assert.NoError(t, err)
+		assert.Equal(t, v1alpha1.CanaryPhaseRolledBack, testSensor.Status.Canary.Phase)
+		assert.Contains(t, testSensor.Status.Canary.Message, "exceeds the maximum")
+		finishCanary()
+		assert.NotEqual(t, revision, stableDeployment().Annotations[common.AnnotationResourceSpecHash])
+		assert.Equal(t, int32(2), *stableDeployment().Spec.Replicas)
+
+		// The rolled back revision is not retried
+		err = Reconcile(cl, testBus, args, logger)
+		assert.NoError(t, err)
+		assert.Len(t, listDeployments(), 1)
+		assert.Equal(t, v1alpha1.CanaryPhaseRolledBack, testSensor.Status.Canary.Phase)
+	})
+
+	t.Run("test roll back canary without enough executions", func(t *testing.T) {
+		testSensor.Spec.Rollout.Canary.MinExecutions = 20
+		startCanary(4)
+		executions, failures = 10, 0
+		err := Reconcile(cl, testBus, args, logger)
+		assert.NoError(t, err)
+		assert.Equal(t, v1alpha1.CanaryPhaseRolledBack, testSensor.Status.Canary.Phase)
+		assert.Contains(t, testSensor.Status.Canary.Message, "less than the minimum")
+		finishCanary()
+	})
+
+	t.Run("test roll out rotated references without canary", func(t *testing.T) {
+		testSensor.Spec.Replicas = stableDeployment().Spec.Replicas
+		testSensor.Spec.Rollout.Canary.MinExecutions = 0
+		testSensor.Status.Canary = nil
+		args.ReferencesHash = "rotated"
+		err := Reconcile(cl, testBus, args, logger)
+		assert.NoError(t, err)
+		assert.Nil(t, testSensor.Status.Canary)
+		assert.Len(t, listDeployments(), 1)
+		assert.Equal(t, "rotated", stableDeployment().Spec.Template.Annotations[common.AnnotationReferencesHash])
+	})
+
+	t.Run("test rotated references during canary", func(t *testing.T) {
+		revision := startCanary(5)
+		args.ReferencesHash = "rotated-again"
+		err := Reconcile(cl, testBus, args, logger)
+		assert.NoError(t, err)
+		assert.Equal(t, v1alpha1.CanaryPhaseBaking, testSensor.Status.Canary.Phase)
+		assert.Equal(t, revision, testSensor.Status.Canary.Revision)
+		canary, err := getDeployment(ctx, cl, canaryArgs(args))
+		assert.NoError(t, err)
+		assert.Equal(t, "rotated-again", canary.Spec.Template.Annotations[common.AnnotationReferencesHash])
+
+		testSensor.Status.Canary.StartedAt = metav1.NewTime(time.Now().Add(-2 * time.Minute))
+		executions, failures = 10, 0
+		err = Reconcile(cl, testBus, args, logger)
+		assert.NoError(t, err)
+		assert.Equal(t, v1alpha1.CanaryPhasePromoted, testSensor.Status.Canary.Phase)
+		finishCanary()
+		assert.Equal(t, int32(5), *stableDeployment().Spec.Replicas)
+		assert.Equal(t, "rotated-again", stableDeployment().Spec.Template.Annotations[common.AnnotationReferencesHash])
+	})
+}
+
+func TestReleaseCanary(t *testing.T) {
+	ctx := context.TODO()
+	testSensor := sensorObj.DeepCopy()
+	testSensor.UID = "sensor-uid"
+	canary := &appv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:  testNamespace,
+			Name:       "fake-sensor-canary-sensor-abcde",
+			Labels:     map[string]string{common.LabelSensorName: testSensor.Name + "-canary"},
+			Finalizers: []string{canaryStateFinalizer},
+			OwnerReferences: []metav1.OwnerReference{
+				*metav1.NewControllerRef(testSensor.GetObjectMeta(), v1alpha1.SchemaGroupVersionKind),
+			},
+		},
+	}
+	cl := fake.NewClientBuilder().WithObjects(canary).Build()
+
+	assert.NoError(t, releaseCanary(ctx, cl, testSensor))
+	deploy := &appv1.Deployment{}
+	assert.NoError(t, cl.Get(ctx, client.ObjectKeyFromObject(canary), deploy))
+	assert.Empty(t, deploy.Finalizers)
+}
+
+func TestValidateCanaryRollout(t *testing.T) {
+	rate := func(s string) *apicommon.Amount {
+		a := apicommon.NewAmount(s)
+		return &a
+	}
+	assert.NoError(t, validateCanaryRollout(nil))
+	assert.NoError(t, validateCanaryRollout(&v1alpha1.CanaryRollout{BakeDuration: "10m", MaxErrorRate: rate("0.05"), MinExecutions: 5}))
+	assert.Error(t, validateCanaryRollout(&v1alpha1.CanaryRollout{BakeDuration: "10"}))
+	assert.Error(t, validateCanaryRollout(&v1alpha1.CanaryRollout{MaxErrorRate: rate("1.5")}))
+	assert.Error(t, validateCanaryRollout(&v1alpha1.CanaryRollout{MinExecutions: -1}))
+}
@@ -32,6 +32,7 @@ import (
 
 	"github.com/argoproj/argo-events/common"
 	"github.com/argoproj/argo-events/common/logging"
+	controllerscommon "github.com/argoproj/argo-events/controllers/common"
 	eventbusv1alpha1 "github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1"
 	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
 )
@@ -48,12 +49,28 @@ type reconciler struct {
 	scheme *runtime.Scheme
 
 	sensorImage string
-	logger      *zap.SugaredLogger
+	// clusterName is substituted for the {{cluster-name}} template variable
+	clusterName string
+	// logOnlyNamespaces are the namespaces where the Sensors never execute their triggers
+	logOnlyNamespaces map[string]bool
+	// generateServiceAccounts enables the generation of the ServiceAccounts of the Sensors, which requires the
+	// controller to be granted the privileges to manage the ServiceAccounts, Roles and RoleBindings
+	generateServiceAccounts bool
+	// schemas are the schemas the destinations of the trigger parameters are validated against, nil to not validate them
+	schemas ResourceSchemas
+	logger  *zap.SugaredLogger
 }
 
-// NewReconciler returns a new reconciler
-func NewReconciler(client client.Client, scheme *runtime.Scheme, sensorImage string, logger *zap.SugaredLogger) reconcile.Reconciler {
-	return &reconciler{client: client, scheme: scheme, sensorImage: sensorImage, logger: logger}
+// NewReconciler returns a new reconciler. The Sensors in the logOnlyNamespaces are deployed in log-only mode, they
+// consume the events and log the triggers they resolve without executing them. The Sensors with generateServiceAccount
+// are rejected unless generateServiceAccounts is set. The destinations of the trigger parameters are validated against
+// the schemas, if not nil.
+func NewReconciler(client client.Client, scheme *runtime.Scheme, sensorImage, clusterName string, logOnlyNamespaces []string, generateServiceAccounts bool, schemas ResourceSchemas, logger *zap.SugaredLogger) reconcile.Reconciler {
+	logOnly := map[string]bool{}
+	for _, ns := range logOnlyNamespaces {
+		logOnly[ns] = true
+	}
+	return &reconciler{client: client, scheme: scheme, sensorImage: sensorImage, clusterName: clusterName, logOnlyNamespaces: logOnly, generateServiceAccounts: generateServiceAccounts, schemas: schemas, logger: logger}
 }
 
 func (r *reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
@@ -82,7 +99,7 @@ func (r *reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 	if err := r.client.Status().Update(ctx, sensorCopy); err != nil {
 		return reconcile.Result{}, err
 	}
-	return ctrl.Result{}, reconcileErr
+	return ctrl.Result{RequeueAfter: CanaryRequeueAfter(sensorCopy)}, reconcileErr
 }
 
 // reconcile does the real logic
@@ -91,7 +108,10 @@ func (r *reconciler) reconcile(ctx context.Context, sensor *v1alpha1.Sensor) err
 	if !sensor.DeletionTimestamp.IsZero() {
 		log.Info("deleting sensor")
 		if controllerutil.ContainsFinalizer(sensor, finalizerName) {
-			// Finalizer logic should be added here.
+			if err := releaseCanary(ctx, r.client, sensor); err != nil {
+				log.Errorw("failed to release the canary deployment", zap.Error(err))
+				return err
+			}
 			controllerutil.RemoveFinalizer(sensor, finalizerName)
 		}
 		return nil
@@ -100,27 +120,80 @@ func (r *reconciler) reconcile(ctx context.Context, sensor *v1alpha1.Sensor) err
 
 	sensor.Status.InitConditions()
 
+	// The template variables are only substituted in a copy, the Sensor keeps them
+	original := sensor
+	sensor = sensor.DeepCopy()
+	defer func() {
+		original.Status = sensor.Status
+	}()
+	if err := controllerscommon.SubstituteTemplateVariables(ctx, r.client, r.clusterName, sensor.Namespace, &sensor.Spec); err != nil {
+		sensor.Status.MarkDeployFailed("InvalidTemplateVariables", err.Error())
+		log.Errorw("failed to substitute the template variables", zap.Error(err))
+		return err
+	}
+
 	eventBus := &eventbusv1alpha1.EventBus{}
-	eventBusName := common.DefaultEventBusName
-	if len(sensor.Spec.EventBusName) > 0 {
-		eventBusName = sensor.Spec.EventBusName
+	if sensor.Spec.RemoteEventBus != nil {
+		if len(sensor.Spec.EventBusName) > 0 {
+			sensor.Status.MarkDeployFailed("InvalidEventBus", "EventBusName and RemoteEventBus can not be used together.")
+			return fmt.Errorf("eventBusName and remoteEventBus can not be used together")
+		}
+		remoteEventBus, err := controllerscommon.GetRemoteEventBus(ctx, r.client, sensor.Namespace, sensor.Spec.RemoteEventBus)
+		if err != nil {
+			sensor.Status.MarkDeployFailed("GetRemoteEventBusFailed", "Failed to get remote EventBus.")
+			log.Errorw("failed to get remote EventBus", "error", err)
+			return err
+		}
+		eventBus = remoteEventBus
+	} else {
+		eventBusName := common.DefaultEventBusName
+		if len(sensor.Spec.EventBusName) > 0 {
+			eventBusName = sensor.Spec.EventBusName
+		}
+		err := r.client.Get(ctx, types.NamespacedName{Namespace: sensor.Namespace, Name: eventBusName}, eventBus)
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				sensor.Status.MarkDeployFailed("EventBusNotFound", "EventBus not found.")
+				log.Errorw("EventBus not found", "eventBusName", eventBusName, "error", err)
+				return fmt.Errorf("eventbus %s not found", eventBusName)
+			}
+			sensor.Status.MarkDeployFailed("GetEventBusFailed", "Failed to get EventBus.")
+			log.Errorw("failed to get EventBus", "eventBusName", eventBusName, "error", err)
+			return err
+		}
 	}
-	err := r.client.Get(ctx, types.NamespacedName{Namespace: sensor.Namespace, Name: eventBusName}, eventBus)
+
+	additionalEventBuses, err := r.additionalEventBuses(ctx, sensor, eventBus)
 	if err != nil {
-		if apierrors.IsNotFound(err) {
-			sensor.Status.MarkDeployFailed("EventBusNotFound", "EventBus not found.")
-			log.Errorw("EventBus not found", "eventBusName", eventBusName, "error", err)
-			return fmt.Errorf("eventbus %s not found", eventBusName)
-		}
-		sensor.Status.MarkDeployFailed("GetEventBusFailed", "Failed to get EventBus.")
-		log.Errorw("failed to get EventBus", "eventBusName", eventBusName, "error", err)
+		log.Errorw("failed to get the eventbuses of the dependencies", zap.Error(err))
 		return err
 	}
-
-	if err := ValidateSensor(sensor, eventBus); err != nil {
+	if err := ValidateSensorWithEventBuses(sensor, eventBus, additionalEventBuses); err != nil {
 		log.Errorw("validation error", "error", err)
 		return err
 	}
+	if generatesServiceAccount(sensor) && !r.generateServiceAccounts {
+		sensor.Status.MarkDeployFailed("ServiceAccountGenerationDisabled", "generateServiceAccount requires the controller to run with --generate-service-accounts.")
+		log.Error("generateServiceAccount is set, but the generation of the service accounts is disabled")
+		return fmt.Errorf("generateServiceAccount requires the controller to run with --generate-service-accounts")
+	}
+	if r.schemas != nil {
+		if err := validateParameterDestinations(sensor.Spec.Triggers, r.schemas); err != nil {
+			sensor.Status.MarkTriggersNotProvided("InvalidTriggerParameters", err.Error())
+			log.Errorw("invalid trigger parameters", zap.Error(err))
+			return err
+		}
+	}
+	if err := r.markDependenciesReady(ctx, sensor, eventBus, additionalEventBuses); err != nil {
+		log.Errorw("failed to check the dependencies", "error", err)
+		return err
+	}
+	referencesHash, err := controllerscommon.ReferencesHash(ctx, r.client, sensor.Namespace, &sensor.Spec)
+	if err != nil {
+		sensor.Status.MarkDeployFailed("GetReferencesFailed", err.Error())
+		log.Errorw("failed to get the referenced objects", "error", err)
+		return err
+	}
 	args := &AdaptorArgs{
 		Image:  r.sensorImage,
 		Sensor: sensor,
@@ -129,6 +202,13 @@ func (r *reconciler) reconcile(ctx context.Context, sensor *v1alpha1.Sensor) err
 			common.LabelSensorName: sensor.Name,
 			common.LabelOwnerName:  sensor.Name,
 		},
+		ReferencesHash:          referencesHash,
+		LogOnly:                 r.logOnlyNamespaces[sensor.Namespace],
+		AdditionalEventBuses:    additionalEventBuses,
+		GenerateServiceAccounts: r.generateServiceAccounts,
+	}
+	if args.LogOnly {
+		log.Info("the namespace is log-only, the triggers will not be executed")
 	}
 	return Reconcile(r.client, eventBus, args, log)
 }
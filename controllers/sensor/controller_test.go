@@ -22,11 +22,15 @@ import (
 	"github.com/stretchr/testify/assert"
 	appv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/kubernetes/scheme"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	"github.com/argoproj/argo-events/common/logging"
+	apicommon "github.com/argoproj/argo-events/pkg/apis/common"
 	eventbusv1alpha1 "github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1"
+	eventsourcev1alpha1 "github.com/argoproj/argo-events/pkg/apis/eventsource/v1alpha1"
 	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
 )
 
@@ -65,13 +69,98 @@ func TestReconcile(t *testing.T) {
 		}
 		err = r.reconcile(ctx, sensorObj)
 		assert.NoError(t, err)
+		assert.False(t, sensorObj.Status.IsReady())
+		c := sensorObj.Status.GetCondition(v1alpha1.SensorConditionDependenciesReady)
+		assert.NotNil(t, c)
+		assert.Equal(t, "WaitingForEventSources", c.Reason)
+		assert.Contains(t, c.Message, "not found: fake-source")
+
+		es := &eventsourcev1alpha1.EventSource{
+			ObjectMeta: metav1.ObjectMeta{Namespace: testNamespace, Name: "fake-source"},
+		}
+		err = cl.Create(ctx, es)
+		assert.Nil(t, err)
+		err = r.reconcile(ctx, sensorObj)
+		assert.NoError(t, err)
+		assert.Contains(t, sensorObj.Status.GetCondition(v1alpha1.SensorConditionDependenciesReady).Message, "not ready on the EventBus default: fake-source")
+
+		es.Status.InitConditions()
+		es.Status.MarkSourcesProvided()
+		es.Status.MarkDeployed()
+		err = cl.Update(ctx, es)
+		assert.Nil(t, err)
+		err = r.reconcile(ctx, sensorObj)
+		assert.NoError(t, err)
 		assert.True(t, sensorObj.Status.IsReady())
 	})
+
+	t.Run("test reconcile with generateServiceAccount", func(t *testing.T) {
+		ctx := context.TODO()
+		cl := fake.NewClientBuilder().Build()
+		testBus := fakeEventBus.DeepCopy()
+		testBus.Status.MarkDeployed("test", "test")
+		testBus.Status.MarkConfigured()
+		err := cl.Create(ctx, testBus)
+		assert.Nil(t, err)
+		testSensor := sensorObj.DeepCopy()
+		testSensor.Spec.Template = &v1alpha1.Template{GenerateServiceAccount: true}
+		r := &reconciler{
+			client:      cl,
+			scheme:      scheme.Scheme,
+			sensorImage: testImage,
+			logger:      logging.NewArgoEventsLogger(),
+		}
+		err = r.reconcile(ctx, testSensor)
+		assert.ErrorContains(t, err, "--generate-service-accounts")
+		assert.Equal(t, "ServiceAccountGenerationDisabled", testSensor.Status.GetCondition(v1alpha1.SensorConditionDeployed).Reason)
+
+		r.generateServiceAccounts = true
+		err = r.reconcile(ctx, testSensor)
+		assert.NoError(t, err)
+		sa := &corev1.ServiceAccount{}
+		err = cl.Get(ctx, types.NamespacedName{Namespace: testNamespace, Name: generatedServiceAccountName(testSensor)}, sa)
+		assert.NoError(t, err)
+	})
+
+	t.Run("test reconcile with remote eventbus", func(t *testing.T) {
+		ctx := context.TODO()
+		cl := fake.NewClientBuilder().Build()
+		secret := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Namespace: testNamespace, Name: "remote-bus"},
+			Data: map[string][]byte{
+				"config": []byte("url: nats://central:4222\ntoken: abc"),
+			},
+		}
+		err := cl.Create(ctx, secret)
+		assert.Nil(t, err)
+		r := &reconciler{
+			client:      cl,
+			scheme:      scheme.Scheme,
+			sensorImage: testImage,
+			logger:      logging.NewArgoEventsLogger(),
+		}
+		sensor := sensorObj.DeepCopy()
+		sensor.Spec.RemoteEventBus = &apicommon.RemoteEventBus{
+			ConnectionSecret: &corev1.SecretKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: "remote-bus"},
+				Key:                  "config",
+			},
+		}
+		err = r.reconcile(ctx, sensor)
+		assert.NoError(t, err)
+		assert.True(t, sensor.Status.IsReady())
+
+		sensor.Spec.EventBusName = "default"
+		err = r.reconcile(ctx, sensor)
+		assert.Error(t, err)
+		assert.False(t, sensor.Status.IsReady())
+	})
 }
 
 func init() {
 	_ = eventbusv1alpha1.AddToScheme(scheme.Scheme)
 	_ = v1alpha1.AddToScheme(scheme.Scheme)
+	_ = eventsourcev1alpha1.AddToScheme(scheme.Scheme)
 	_ = appv1.AddToScheme(scheme.Scheme)
 	_ = corev1.AddToScheme(scheme.Scheme)
 }
@@ -0,0 +1,188 @@
+package sensor
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/argoproj/argo-events/common"
+	eventbusv1alpha1 "github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1"
+	eventsourcev1alpha1 "github.com/argoproj/argo-events/pkg/apis/eventsource/v1alpha1"
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+)
+
+// markDependenciesReady sets the DependenciesReady condition of the Sensor, which waits for its EventBus to be
+// deployed, and for the EventSources of its dependencies to be ready on the EventBuses the dependencies consume from.
+// The Sensor is deployed anyway, its pods retry to subscribe until the dependencies are available.
+func (r *reconciler) markDependenciesReady(ctx context.Context, sensor *v1alpha1.Sensor, eventBus *eventbusv1alpha1.EventBus, additionalEventBuses map[string]*eventbusv1alpha1.EventBus) error {
+	if !eventBus.Status.IsReady() {
+		sensor.Status.MarkWaitingForDependencies("WaitingForEventBus", fmt.Sprintf("Waiting for the EventBus %s to be deployed.", eventBus.Name))
+		return nil
+	}
+	// The EventSources publishing to a remote EventBus are not known
+	buses := map[string]*eventbusv1alpha1.EventBus{}
+	if sensor.Spec.RemoteEventBus == nil {
+		buses[eventBus.Name] = eventBus
+	}
+	for name, b := range additionalEventBuses {
+		buses[name] = b
+	}
+	if len(buses) == 0 {
+		sensor.Status.MarkDependenciesReady()
+		return nil
+	}
+	// The EventSources providing the events on each EventBus, the events seeded by the EventBus impersonate their
+	// EventSources
+	provided := map[string]map[string]bool{}
+	for name, b := range buses {
+		provided[name] = map[string]bool{}
+		if b.Spec.Seed != nil {
+			for _, e := range b.Spec.Seed.Events {
+				provided[name][e.EventSourceName] = true
+			}
+		}
+	}
+	list := &eventsourcev1alpha1.EventSourceList{}
+	if err := r.client.List(ctx, list, client.InNamespace(sensor.Namespace)); err != nil {
+		return fmt.Errorf("failed to list the eventsources, %w", err)
+	}
+	found := map[string]bool{}
+	for _, es := range list.Items {
+		found[es.Name] = true
+		busName := es.Spec.EventBusName
+		if busName == "" {
+			busName = common.DefaultEventBusName
+		}
+		if es.Status.IsReady() && es.Spec.RemoteEventBus == nil && provided[busName] != nil {
+			provided[busName][es.Name] = true
+		}
+	}
+	var missing []string
+	notReady := map[string][]string{}
+	for _, name := range dependencyEventSources(sensor) {
+		for _, busName := range eventSourceBuses(sensor, name, eventBus) {
+			if _, ok := buses[busName]; !ok {
+				continue
+			}
+			switch {
+			case provided[busName][name]:
+			case found[name]:
+				notReady[busName] = append(notReady[busName], name)
+			default:
+				missing = append(missing, name)
+			}
+		}
+	}
+	if len(missing) == 0 && len(notReady) == 0 {
+		sensor.Status.MarkDependenciesReady()
+		return nil
+	}
+	var msgs []string
+	if len(missing) > 0 {
+		msgs = append(msgs, fmt.Sprintf("EventSources not found: %s.", strings.Join(unique(missing), ", ")))
+	}
+	busNames := make([]string, 0, len(notReady))
+	for busName := range notReady {
+		busNames = append(busNames, busName)
+	}
+	sort.Strings(busNames)
+	for _, busName := range busNames {
+		msgs = append(msgs, fmt.Sprintf("EventSources not ready on the EventBus %s: %s.", busName, strings.Join(notReady[busName], ", ")))
+	}
+	sensor.Status.MarkWaitingForDependencies("WaitingForEventSources", strings.Join(msgs, " "))
+	return nil
+}
+
+// eventSourceBuses returns the sorted names of the EventBuses the dependencies of the Sensor consume the events of
+// an EventSource from.
+func eventSourceBuses(sensor *v1alpha1.Sensor, eventSourceName string, eventBus *eventbusv1alpha1.EventBus) []string {
+	var names []string
+	for _, dep := range sensor.Spec.Dependencies {
+		if dep.EventSourceName == eventSourceName {
+			names = append(names, dependencyEventBusName(dep, eventBus))
+		}
+	}
+	names = unique(names)
+	sort.Strings(names)
+	return names
+}
+
+// unique returns the strings without the duplicates, in their order.
+func unique(values []string) []string {
+	seen := map[string]bool{}
+	var result []string
+	for _, v := range values {
+		if !seen[v] {
+			seen[v] = true
+			result = append(result, v)
+		}
+	}
+	return result
+}
+
+// dependencyEventSources returns the sorted names of the EventSources of the dependencies of the Sensor.
+func dependencyEventSources(sensor *v1alpha1.Sensor) []string {
+	names := map[string]bool{}
+	for _, dep := range sensor.Spec.Dependencies {
+		if dep.EventSourceName != "" {
+			names[dep.EventSourceName] = true
+		}
+	}
+	result := make([]string, 0, len(names))
+	for name := range names {
+		result = append(result, name)
+	}
+	sort.Strings(result)
+	return result
+}
+
+// DependentSensors returns a function mapping an EventSource or an EventBus to the reconcile requests of the
+// Sensors depending on it, including the Sensors whose dependencies consume from the EventBus, so that they follow
+// its readiness.
+func DependentSensors(cl client.Client) func(context.Context, client.Object) []reconcile.Request {
+	return func(ctx context.Context, obj client.Object) []reconcile.Request {
+		var depends func(*v1alpha1.Sensor) bool
+		switch o := obj.(type) {
+		case *eventsourcev1alpha1.EventSource:
+			depends = func(s *v1alpha1.Sensor) bool {
+				for _, dep := range s.Spec.Dependencies {
+					if dep.EventSourceName == o.Name {
+						return true
+					}
+				}
+				return false
+			}
+		case *eventbusv1alpha1.EventBus:
+			depends = func(s *v1alpha1.Sensor) bool {
+				for _, dep := range s.Spec.Dependencies {
+					if dep.EventBusName == o.Name {
+						return true
+					}
+				}
+				name := s.Spec.EventBusName
+				if name == "" {
+					name = common.DefaultEventBusName
+				}
+				return s.Spec.RemoteEventBus == nil && name == o.Name
+			}
+		default:
+			return nil
+		}
+		list := &v1alpha1.SensorList{}
+		if err := cl.List(ctx, list, client.InNamespace(obj.GetNamespace())); err != nil {
+			return nil
+		}
+		var requests []reconcile.Request
+		for i := range list.Items {
+			if s := &list.Items[i]; depends(s) {
+				requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Namespace: s.Namespace, Name: s.Name}})
+			}
+		}
+		return requests
+	}
+}
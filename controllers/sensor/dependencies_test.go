@@ -0,0 +1,43 @@
+package sensor
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	eventsourcev1alpha1 "github.com/argoproj/argo-events/pkg/apis/eventsource/v1alpha1"
+)
+
+func TestDependentSensors(t *testing.T) {
+	other := sensorObj.DeepCopy()
+	other.Name = "other-sensor"
+	other.Spec.EventBusName = "other"
+	other.Spec.Dependencies[0].EventSourceName = "other-source"
+	cl := fake.NewClientBuilder().WithObjects(sensorObj.DeepCopy(), other).Build()
+	mapFunc := DependentSensors(cl)
+
+	requests := mapFunc(context.TODO(), &eventsourcev1alpha1.EventSource{
+		ObjectMeta: metav1.ObjectMeta{Namespace: testNamespace, Name: "fake-source"},
+	})
+	assert.Len(t, requests, 1)
+	assert.Equal(t, sensorObj.Name, requests[0].Name)
+
+	requests = mapFunc(context.TODO(), fakeEventBus.DeepCopy())
+	assert.Len(t, requests, 1)
+	assert.Equal(t, sensorObj.Name, requests[0].Name)
+
+	requests = mapFunc(context.TODO(), &eventsourcev1alpha1.EventSource{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "other-ns", Name: "fake-source"},
+	})
+	assert.Empty(t, requests)
+}
+
+func TestDependencyEventSources(t *testing.T) {
+	s := sensorObj.DeepCopy()
+	s.Spec.Dependencies = append(s.Spec.Dependencies, s.Spec.Dependencies[0], s.Spec.Dependencies[0])
+	s.Spec.Dependencies[1].EventSourceName = "a-source"
+	assert.Equal(t, []string{"a-source", "fake-source"}, dependencyEventSources(s))
+}
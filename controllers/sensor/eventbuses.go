@@ -0,0 +1,127 @@
+package sensor
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"sort"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/argoproj/argo-events/common"
+	controllerscommon "github.com/argoproj/argo-events/controllers/common"
+	eventbusv1alpha1 "github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1"
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+)
+
+// additionalEventBuses returns the EventBuses the dependencies of the Sensor consume from, other than the EventBus
+// of the Sensor, keyed by name. They have to be deployed before the Sensor.
+func (r *reconciler) additionalEventBuses(ctx context.Context, sensor *v1alpha1.Sensor, eventBus *eventbusv1alpha1.EventBus) (map[string]*eventbusv1alpha1.EventBus, error) {
+	result := make(map[string]*eventbusv1alpha1.EventBus)
+	for _, dep := range sensor.Spec.Dependencies {
+		name := dep.EventBusName
+		if name == "" || (sensor.Spec.RemoteEventBus == nil && name == eventBus.Name) {
+			continue
+		}
+		if _, ok := result[name]; ok {
+			continue
+		}
+		b := &eventbusv1alpha1.EventBus{}
+		if err := r.client.Get(ctx, types.NamespacedName{Namespace: sensor.Namespace, Name: name}, b); err != nil {
+			if apierrors.IsNotFound(err) {
+				sensor.Status.MarkDeployFailed("EventBusNotFound", fmt.Sprintf("EventBus %s of the dependency %s not found.", name, dep.Name))
+				return nil, fmt.Errorf("eventbus %s of the dependency %s not found", name, dep.Name)
+			}
+			sensor.Status.MarkDeployFailed("GetEventBusFailed", "Failed to get EventBus.")
+			return nil, err
+		}
+		if !b.Status.IsReady() {
+			sensor.Status.MarkDeployFailed("EventBusNotReady", fmt.Sprintf("EventBus %s not ready.", name))
+			return nil, fmt.Errorf("eventbus %s of the dependency %s not ready", name, dep.Name)
+		}
+		result[name] = b
+	}
+	if len(result) == 0 {
+		return nil, nil
+	}
+	if sensor.Spec.RequiresOrdering {
+		err := fmt.Errorf("the sensor requires ordering, which can't be guaranteed with dependencies consuming from several eventbuses")
+		sensor.Status.MarkDeployFailed("EventBusOrderingNotSupported", err.Error())
+		return nil, err
+	}
+	if sensor.Spec.IsSharedDistribution() {
+		err := fmt.Errorf("shared distribution is not supported with dependencies consuming from several eventbuses")
+		sensor.Status.MarkDeployFailed("InvalidDistribution", err.Error())
+		return nil, err
+	}
+	return result, nil
+}
+
+// dependencyEventBusName returns the name of the EventBus a dependency consumes from.
+func dependencyEventBusName(dep v1alpha1.EventDependency, eventBus *eventbusv1alpha1.EventBus) string {
+	if dep.EventBusName != "" {
+		return dep.EventBusName
+	}
+	return eventBus.Name
+}
+
+// additionalEventBusVolumes returns the volumes of the auth files of the additional EventBuses, mounted in the
+// directories named after them, and the SPIFFE volume if not in the existing volumes yet. It also returns the
+// Kafka EventBuses, whose SASL and TLS secrets are mounted as the ones of the Sensor.
+func additionalEventBusVolumes(eventBuses map[string]*eventbusv1alpha1.EventBus, existing []corev1.Volume) ([]corev1.Volume, []corev1.VolumeMount, []interface{}) {
+	names := make([]string, 0, len(eventBuses))
+	for name := range eventBuses {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	spiffe := false
+	for _, v := range existing {
+		if v.Name == "spiffe" {
+			spiffe = true
+		}
+	}
+	var volumes []corev1.Volume
+	var volumeMounts []corev1.VolumeMount
+	var secretObjs []interface{}
+	for _, name := range names {
+		config := eventBuses[name].Status.Config
+		var accessSecret *corev1.SecretKeySelector
+		switch {
+		case config.NATS != nil:
+			accessSecret = config.NATS.AccessSecret
+		case config.JetStream != nil:
+			accessSecret = config.JetStream.AccessSecret
+		case config.Kafka != nil:
+			secretObjs = append(secretObjs, eventBuses[name])
+		}
+		if accessSecret != nil {
+			volumeName := fmt.Sprintf("auth-volume-%s", name)
+			volumes = append(volumes, corev1.Volume{
+				Name: volumeName,
+				VolumeSource: corev1.VolumeSource{
+					Secret: &corev1.SecretVolumeSource{
+						SecretName: accessSecret.Name,
+						Items: []corev1.KeyToPath{
+							{
+								Key:  accessSecret.Key,
+								Path: "auth.yaml",
+							},
+						},
+					},
+				},
+			})
+			volumeMounts = append(volumeMounts, corev1.VolumeMount{
+				Name:      volumeName,
+				MountPath: path.Join(common.AdditionalEventBusAuthFileMountPath, name),
+			})
+		}
+		if volume, mount := controllerscommon.SPIFFEVolume(config); volume != nil && !spiffe {
+			spiffe = true
+			volumes = append(volumes, *volume)
+			volumeMounts = append(volumeMounts, *mount)
+		}
+	}
+	return volumes, volumeMounts, secretObjs
+}
@@ -0,0 +1,127 @@
+package sensor
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/argoproj/argo-events/common"
+	"github.com/argoproj/argo-events/common/logging"
+	eventbusv1alpha1 "github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1"
+	eventsourcev1alpha1 "github.com/argoproj/argo-events/pkg/apis/eventsource/v1alpha1"
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+)
+
+func TestAdditionalEventBuses(t *testing.T) {
+	ctx := context.TODO()
+	mainBus := fakeEventBus.DeepCopy()
+	mainBus.Status.MarkDeployed("test", "test")
+	mainBus.Status.MarkConfigured()
+	kafkaBus := fakeEventBusKafka.DeepCopy()
+	kafkaBus.Name = "kafka"
+	cl := fake.NewClientBuilder().WithObjects(mainBus, kafkaBus).Build()
+	r := &reconciler{client: cl, scheme: scheme.Scheme, sensorImage: testImage, logger: logging.NewArgoEventsLogger()}
+
+	sensor := sensorObj.DeepCopy()
+	sensor.Spec.Dependencies = append(sensor.Spec.Dependencies, v1alpha1.EventDependency{
+		Name: "kafka-dep", EventSourceName: "kafka-source", EventName: "example", EventBusName: "kafka",
+	})
+
+	t.Run("eventbus of the sensor", func(t *testing.T) {
+		s := sensorObj.DeepCopy()
+		s.Spec.Dependencies[0].EventBusName = common.DefaultEventBusName
+		buses, err := r.additionalEventBuses(ctx, s, mainBus)
+		assert.NoError(t, err)
+		assert.Empty(t, buses)
+	})
+
+	t.Run("eventbus not ready", func(t *testing.T) {
+		_, err := r.additionalEventBuses(ctx, sensor, mainBus)
+		assert.Error(t, err)
+		assert.Equal(t, "EventBusNotReady", sensor.Status.GetCondition(v1alpha1.SensorConditionDeployed).Reason)
+	})
+
+	kafkaBus.Status.MarkDeployed("test", "test")
+	kafkaBus.Status.MarkConfigured()
+	assert.NoError(t, cl.Update(ctx, kafkaBus))
+
+	t.Run("eventbus not found", func(t *testing.T) {
+		s := sensor.DeepCopy()
+		s.Spec.Dependencies[1].EventBusName = "missing"
+		_, err := r.additionalEventBuses(ctx, s, mainBus)
+		assert.Error(t, err)
+		assert.Equal(t, "EventBusNotFound", s.Status.GetCondition(v1alpha1.SensorConditionDeployed).Reason)
+	})
+
+	t.Run("shared distribution", func(t *testing.T) {
+		s := sensor.DeepCopy()
+		s.Spec.Distribution = &v1alpha1.SensorDistribution{Mode: v1alpha1.SensorDistributionShared}
+		_, err := r.additionalEventBuses(ctx, s, mainBus)
+		assert.Error(t, err)
+	})
+
+	buses, err := r.additionalEventBuses(ctx, sensor, mainBus)
+	assert.NoError(t, err)
+	assert.Len(t, buses, 1)
+	assert.Equal(t, "kafka", buses["kafka"].Name)
+
+	t.Run("dependencies ready on their eventbuses", func(t *testing.T) {
+		for _, es := range []*eventsourcev1alpha1.EventSource{
+			{ObjectMeta: metav1.ObjectMeta{Namespace: testNamespace, Name: "fake-source"}},
+			{ObjectMeta: metav1.ObjectMeta{Namespace: testNamespace, Name: "kafka-source"}, Spec: eventsourcev1alpha1.EventSourceSpec{EventBusName: "kafka"}},
+		} {
+			es.Status.InitConditions()
+			es.Status.MarkSourcesProvided()
+			es.Status.MarkDeployed()
+			assert.NoError(t, cl.Create(ctx, es))
+		}
+		s := sensor.DeepCopy()
+		s.Spec.Dependencies[1].EventSourceName = "fake-source"
+		assert.NoError(t, r.markDependenciesReady(ctx, s, mainBus, buses))
+		assert.Equal(t, "EventSources not ready on the EventBus kafka: fake-source.", s.Status.GetCondition(v1alpha1.SensorConditionDependenciesReady).Message)
+
+		s = sensor.DeepCopy()
+		assert.NoError(t, r.markDependenciesReady(ctx, s, mainBus, buses))
+		assert.True(t, s.Status.GetCondition(v1alpha1.SensorConditionDependenciesReady).IsTrue())
+	})
+
+	t.Run("build deployment", func(t *testing.T) {
+		natsBus := fakeEventBus.DeepCopy()
+		natsBus.Name = "nats"
+		args := &AdaptorArgs{
+			Image:                testImage,
+			Sensor:               sensor,
+			Labels:               testLabels,
+			AdditionalEventBuses: map[string]*eventbusv1alpha1.EventBus{"kafka": buses["kafka"], "nats": natsBus},
+		}
+		deployment, err := buildDeployment(args, mainBus)
+		assert.NoError(t, err)
+		var encoded string
+		for _, env := range deployment.Spec.Template.Spec.Containers[0].Env {
+			if env.Name == common.EnvVarAdditionalEventBusConfigs {
+				encoded = env.Value
+			}
+		}
+		data, err := base64.StdEncoding.DecodeString(encoded)
+		assert.NoError(t, err)
+		configs := map[string]eventbusv1alpha1.BusConfig{}
+		assert.NoError(t, json.Unmarshal(data, &configs))
+		assert.Len(t, configs, 2)
+		assert.NotNil(t, configs["kafka"].Kafka)
+
+		mounted := false
+		for _, m := range deployment.Spec.Template.Spec.Containers[0].VolumeMounts {
+			if m.Name == "auth-volume-nats" {
+				mounted = true
+				assert.Equal(t, "/etc/eventbus/additional/nats", m.MountPath)
+			}
+		}
+		assert.True(t, mounted)
+	})
+}
@@ -0,0 +1,190 @@
+package sensor
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	controllerscommon "github.com/argoproj/argo-events/controllers/common"
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+)
+
+// generatedServiceAccountName returns the name of the ServiceAccount, Role and RoleBinding generated for the Sensor.
+func generatedServiceAccountName(sensor *v1alpha1.Sensor) string {
+	return fmt.Sprintf("%s-sensor", sensor.Name)
+}
+
+// generatesServiceAccount tells whether the controller generates the ServiceAccount of the Sensor pods.
+func generatesServiceAccount(sensor *v1alpha1.Sensor) bool {
+	return sensor.Spec.Template != nil && sensor.Spec.Template.GenerateServiceAccount
+}
+
+// serviceAccountName returns the ServiceAccount of the Sensor pods.
+func serviceAccountName(sensor *v1alpha1.Sensor) string {
+	if generatesServiceAccount(sensor) {
+		return generatedServiceAccountName(sensor)
+	}
+	if sensor.Spec.Template != nil {
+		return sensor.Spec.Template.ServiceAccountName
+	}
+	return ""
+}
+
+// generatedRoleRules returns the rules of the Role generated for the Sensor: the leases of the leader election, the
+// status of the Sensor reporting the operations being retried, and the Workflows operated by the Argo Workflow
+// triggers, if any.
+func generatedRoleRules(sensor *v1alpha1.Sensor) []rbacv1.PolicyRule {
+	rules := []rbacv1.PolicyRule{
+		{
+			APIGroups: []string{"coordination.k8s.io"},
+			Resources: []string{"leases"},
+			Verbs:     []string{"get", "create", "update"},
+		},
+		{
+			APIGroups:     []string{"argoproj.io"},
+			Resources:     []string{"sensors"},
+			ResourceNames: []string{sensor.Name},
+			Verbs:         []string{"get"},
+		},
+		{
+			APIGroups:     []string{"argoproj.io"},
+			Resources:     []string{"sensors/status"},
+			ResourceNames: []string{sensor.Name},
+			Verbs:         []string{"update"},
+		},
+	}
+	for _, trigger := range sensor.Spec.Triggers {
+		if trigger.Template != nil && trigger.Template.ArgoWorkflow != nil {
+			return append(rules,
+				rbacv1.PolicyRule{
+					APIGroups: []string{"argoproj.io"},
+					Resources: []string{"workflows"},
+					Verbs:     []string{"get", "list", "create", "update", "patch"},
+				},
+				rbacv1.PolicyRule{
+					APIGroups: []string{"argoproj.io"},
+					Resources: []string{"workflowtemplates", "cronworkflows"},
+					Verbs:     []string{"get"},
+				},
+			)
+		}
+	}
+	return rules
+}
+
+// reconcileServiceAccount makes the generated ServiceAccount, Role and RoleBinding of the Sensor match the expected
+// ones, recreating them if they are deleted and restoring them if they are modified. They are deleted if the
+// ServiceAccount is no longer generated.
+func reconcileServiceAccount(ctx context.Context, cl client.Client, args *AdaptorArgs, logger *zap.SugaredLogger) error {
+	sensor := args.Sensor
+	name := generatedServiceAccountName(sensor)
+	key := types.NamespacedName{Namespace: sensor.Namespace, Name: name}
+	objs := []client.Object{&rbacv1.RoleBinding{}, &rbacv1.Role{}, &corev1.ServiceAccount{}}
+	if !generatesServiceAccount(sensor) {
+		for _, obj := range objs {
+			if err := cl.Get(ctx, key, obj); err != nil {
+				if apierrors.IsNotFound(err) {
+					continue
+				}
+				return fmt.Errorf("failed to get %T %s, %w", obj, name, err)
+			}
+			if !metav1.IsControlledBy(obj, sensor) {
+				continue
+			}
+			if err := cl.Delete(ctx, obj); err != nil && !apierrors.IsNotFound(err) {
+				return fmt.Errorf("failed to delete %T %s, %w", obj, name, err)
+			}
+			logger.Infow("generated rbac object is deleted", "kind", fmt.Sprintf("%T", obj), "name", name)
+		}
+		return nil
+	}
+
+	meta := func() metav1.ObjectMeta {
+		return metav1.ObjectMeta{Namespace: sensor.Namespace, Name: name, Labels: mergeLabels(sensor.Labels, args.Labels)}
+	}
+	sa := &corev1.ServiceAccount{ObjectMeta: meta()}
+	role := &rbacv1.Role{ObjectMeta: meta(), Rules: generatedRoleRules(sensor)}
+	binding := &rbacv1.RoleBinding{
+		ObjectMeta: meta(),
+		RoleRef:    rbacv1.RoleRef{APIGroup: rbacv1.GroupName, Kind: "Role", Name: name},
+		Subjects:   []rbacv1.Subject{{Kind: rbacv1.ServiceAccountKind, Namespace: sensor.Namespace, Name: name}},
+	}
+	for _, obj := range []client.Object{sa, role, binding} {
+		if err := controllerscommon.SetObjectMeta(sensor, obj, v1alpha1.SchemaGroupVersionKind); err != nil {
+			return err
+		}
+	}
+
+	if err := reconcileGeneratedObject(ctx, cl, sensor, sa, &corev1.ServiceAccount{}, func(existing client.Object) (bool, bool) {
+		return false, false
+	}, logger); err != nil {
+		return err
+	}
+	if err := reconcileGeneratedObject(ctx, cl, sensor, role, &rbacv1.Role{}, func(existing client.Object) (bool, bool) {
+		r := existing.(*rbacv1.Role)
+		if equality.Semantic.DeepEqual(r.Rules, role.Rules) {
+			return false, false
+		}
+		r.Rules = role.Rules
+		return true, false
+	}, logger); err != nil {
+		return err
+	}
+	return reconcileGeneratedObject(ctx, cl, sensor, binding, &rbacv1.RoleBinding{}, func(existing client.Object) (bool, bool) {
+		b := existing.(*rbacv1.RoleBinding)
+		if b.RoleRef != binding.RoleRef {
+			// The role of a binding is immutable
+			return false, true
+		}
+		if equality.Semantic.DeepEqual(b.Subjects, binding.Subjects) {
+			return false, false
+		}
+		b.Subjects = binding.Subjects
+		return true, false
+	}, logger)
+}
+
+// reconcileGeneratedObject creates the expected object if it does not exist. Otherwise, drift restores the expected
+// content into the existing object, telling whether it has to be updated, or recreated if the drift is immutable.
+func reconcileGeneratedObject(ctx context.Context, cl client.Client, sensor *v1alpha1.Sensor, expected, existing client.Object, drift func(existing client.Object) (update, recreate bool), logger *zap.SugaredLogger) error {
+	kind := fmt.Sprintf("%T", expected)
+	log := logger.With("kind", kind, "name", expected.GetName())
+	if err := cl.Get(ctx, client.ObjectKeyFromObject(expected), existing); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return fmt.Errorf("failed to get %s %s, %w", kind, expected.GetName(), err)
+		}
+		if err := cl.Create(ctx, expected); err != nil {
+			return fmt.Errorf("failed to create %s %s, %w", kind, expected.GetName(), err)
+		}
+		log.Info("generated rbac object is created")
+		return nil
+	}
+	if !metav1.IsControlledBy(existing, sensor) {
+		return fmt.Errorf("%s %s already exists and is not controlled by %s", kind, expected.GetName(), sensor.Name)
+	}
+	update, recreate := drift(existing)
+	switch {
+	case recreate:
+		if err := cl.Delete(ctx, existing); err != nil && !apierrors.IsNotFound(err) {
+			return fmt.Errorf("failed to delete %s %s, %w", kind, expected.GetName(), err)
+		}
+		if err := cl.Create(ctx, expected); err != nil {
+			return fmt.Errorf("failed to create %s %s, %w", kind, expected.GetName(), err)
+		}
+		log.Info("drifted generated rbac object is recreated")
+	case update:
+		if err := cl.Update(ctx, existing); err != nil {
+			return fmt.Errorf("failed to update %s %s, %w", kind, expected.GetName(), err)
+		}
+		log.Info("drifted generated rbac object is restored")
+	}
+	return nil
+}
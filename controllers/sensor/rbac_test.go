@@ -0,0 +1,93 @@
+package sensor
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/argoproj/argo-events/common"
+	"github.com/argoproj/argo-events/common/logging"
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+)
+
+func TestReconcileServiceAccount(t *testing.T) {
+	ctx := context.TODO()
+	testBus := fakeEventBus.DeepCopy()
+	testBus.Status.MarkDeployed("test", "test")
+	testBus.Status.MarkConfigured()
+	testSensor := sensorObj.DeepCopy()
+	testSensor.UID = "fake-uid"
+	testSensor.Spec.Template.ServiceAccountName = ""
+	testSensor.Spec.Template.GenerateServiceAccount = true
+	testSensor.Spec.Triggers = append(testSensor.Spec.Triggers, v1alpha1.Trigger{
+		Template: &v1alpha1.TriggerTemplate{
+			Name:         "fake-workflow-trigger",
+			ArgoWorkflow: &v1alpha1.ArgoWorkflowTrigger{Source: &v1alpha1.ArtifactLocation{}},
+		},
+	})
+	cl := fake.NewClientBuilder().Build()
+	labels := map[string]string{"controller": "test-controller", common.LabelSensorName: testSensor.Name}
+	args := &AdaptorArgs{Image: testImage, Sensor: testSensor, Labels: labels, GenerateServiceAccounts: true}
+	logger := logging.NewArgoEventsLogger()
+	key := types.NamespacedName{Namespace: testNamespace, Name: "fake-sensor-sensor"}
+
+	err := Reconcile(cl, testBus, args, logger)
+	assert.NoError(t, err)
+	sa := &corev1.ServiceAccount{}
+	assert.NoError(t, cl.Get(ctx, key, sa))
+	role := &rbacv1.Role{}
+	assert.NoError(t, cl.Get(ctx, key, role))
+	assert.Equal(t, generatedRoleRules(testSensor), role.Rules)
+	assert.Len(t, role.Rules, 5)
+	assert.Equal(t, []string{"sensors/status"}, role.Rules[2].Resources)
+	assert.Equal(t, []string{"fake-sensor"}, role.Rules[2].ResourceNames)
+	binding := &rbacv1.RoleBinding{}
+	assert.NoError(t, cl.Get(ctx, key, binding))
+	assert.Equal(t, "fake-sensor-sensor", binding.Subjects[0].Name)
+	deploy, err := getDeployment(ctx, cl, args)
+	assert.NoError(t, err)
+	assert.Equal(t, "fake-sensor-sensor", deploy.Spec.Template.Spec.ServiceAccountName)
+
+	t.Run("test recreate deleted objects", func(t *testing.T) {
+		assert.NoError(t, cl.Delete(ctx, sa))
+		assert.NoError(t, cl.Delete(ctx, binding))
+		err := reconcileServiceAccount(ctx, cl, args, logger)
+		assert.NoError(t, err)
+		assert.NoError(t, cl.Get(ctx, key, &corev1.ServiceAccount{}))
+		assert.NoError(t, cl.Get(ctx, key, &rbacv1.RoleBinding{}))
+	})
+
+	t.Run("test restore drifted objects", func(t *testing.T) {
+		role := &rbacv1.Role{}
+		assert.NoError(t, cl.Get(ctx, key, role))
+		role.Rules = role.Rules[:1]
+		assert.NoError(t, cl.Update(ctx, role))
+		binding := &rbacv1.RoleBinding{}
+		assert.NoError(t, cl.Get(ctx, key, binding))
+		binding.RoleRef.Name = "other-role"
+		binding.Subjects = nil
+		assert.NoError(t, cl.Update(ctx, binding))
+
+		err := reconcileServiceAccount(ctx, cl, args, logger)
+		assert.NoError(t, err)
+		assert.NoError(t, cl.Get(ctx, key, role))
+		assert.Len(t, role.Rules, 5)
+		assert.NoError(t, cl.Get(ctx, key, binding))
+		assert.Equal(t, "fake-sensor-sensor", binding.RoleRef.Name)
+		assert.Len(t, binding.Subjects, 1)
+	})
+
+	t.Run("test delete objects no longer generated", func(t *testing.T) {
+		testSensor.Spec.Template.GenerateServiceAccount = false
+		err := reconcileServiceAccount(ctx, cl, args, logger)
+		assert.NoError(t, err)
+		assert.Error(t, cl.Get(ctx, key, &corev1.ServiceAccount{}))
+		assert.Error(t, cl.Get(ctx, key, &rbacv1.Role{}))
+		assert.Error(t, cl.Get(ctx, key, &rbacv1.RoleBinding{}))
+	})
+}
@@ -31,6 +31,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/argoproj/argo-events/common"
@@ -39,11 +40,23 @@ import (
 	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
 )
 
+// defaultTerminationGracePeriodSeconds is the Kubernetes default grace period for a pod to exit
+const defaultTerminationGracePeriodSeconds = 30
+
 // AdaptorArgs are the args needed to create a sensor deployment
 type AdaptorArgs struct {
 	Image  string
 	Sensor *v1alpha1.Sensor
 	Labels map[string]string
+	// ReferencesHash is the hash of the versions of the Secrets and ConfigMaps referenced by the spec, if any
+	ReferencesHash string
+	// LogOnly makes the pods consume the events and log the triggers they resolve, without executing them
+	LogOnly bool
+	// AdditionalEventBuses are the EventBuses the dependencies consume from, other than the EventBus of the Sensor,
+	// keyed by name
+	AdditionalEventBuses map[string]*eventbusv1alpha1.EventBus
+	// GenerateServiceAccounts tells whether the controller manages the ServiceAccounts generated for the Sensors
+	GenerateServiceAccounts bool
 }
 
 // Reconcile does the real logic
@@ -67,6 +80,14 @@ func Reconcile(client client.Client, eventBus *eventbusv1alpha1.EventBus, args *
 		return fmt.Errorf("eventbus not ready")
 	}
 
+	if args.GenerateServiceAccounts {
+		if err := reconcileServiceAccount(ctx, client, args, logger); err != nil {
+			sensor.Status.MarkDeployFailed("ReconcileServiceAccountFailed", "Failed to reconcile the generated ServiceAccount")
+			logger.Errorw("error reconciling the generated service account", "error", err)
+			return err
+		}
+	}
+
 	expectedDeploy, err := buildDeployment(args, eventBus)
 	if err != nil {
 		sensor.Status.MarkDeployFailed("BuildDeploymentSpecFailed", "Failed to build Deployment spec.")
@@ -79,7 +100,24 @@ func Reconcile(client client.Client, eventBus *eventbusv1alpha1.EventBus, args *
 		logger.Errorw("error getting existing deployment", "error", err)
 		return err
 	}
-	if deploy != nil {
+	rollCanary := false
+	if deploy != nil && sensor.Spec.Rollout.GetCanary() != nil && deploy.Annotations != nil &&
+		deploy.Annotations[common.AnnotationResourceSpecHash] != expectedDeploy.Annotations[common.AnnotationResourceSpecHash] {
+		referencesOnly, err := referencesOnlyChange(args, eventBus, deploy)
+		if err != nil {
+			sensor.Status.MarkDeployFailed("BuildDeploymentSpecFailed", "Failed to build Deployment spec.")
+			logger.Errorw("failed to build deployment spec", "error", err)
+			return err
+		}
+		rollCanary = !referencesOnly
+	}
+	if rollCanary {
+		if err := reconcileCanary(ctx, client, args, eventBus, deploy, expectedDeploy, logger); err != nil {
+			sensor.Status.MarkDeployFailed("CanaryRolloutFailed", "Failed to roll out the canary")
+			logger.Errorw("error rolling out the canary", "error", err)
+			return err
+		}
+	} else if deploy != nil {
 		if deploy.Annotations != nil && deploy.Annotations[common.AnnotationResourceSpecHash] != expectedDeploy.Annotations[common.AnnotationResourceSpecHash] {
 			deploy.Spec = expectedDeploy.Spec
 			deploy.SetLabels(expectedDeploy.Labels)
@@ -101,6 +139,26 @@ func Reconcile(client client.Client, eventBus *eventbusv1alpha1.EventBus, args *
 		}
 		logger.Infow("deployment is created", "deploymentName", expectedDeploy.Name)
 	}
+	if sensor.Status.Canary == nil || sensor.Status.Canary.Phase != v1alpha1.CanaryPhaseBaking {
+		// Clean up the canary left over by an aborted rollout, e.g. the canary has been disabled or the spec reverted.
+		if err := deleteCanary(ctx, client, args, eventBus, logger); err != nil {
+			sensor.Status.MarkDeployFailed("DeleteCanaryFailed", "Failed to delete the canary deployment")
+			logger.Errorw("error deleting the canary deployment", "error", err)
+			return err
+		}
+	}
+	if err := controllerscommon.ReconcileMetricsMonitoring(ctx, client, &controllerscommon.MetricsMonitoringArgs{
+		Owner:    sensor,
+		OwnerGVK: v1alpha1.SchemaGroupVersionKind,
+		Name:     fmt.Sprintf("%s-sensor-metrics", sensor.Name),
+		Labels:   args.Labels,
+		Port:     common.SensorMetricsPort,
+		Config:   sensor.Spec.Metrics,
+	}); err != nil {
+		sensor.Status.MarkDeployFailed("ReconcileMetricsMonitoringFailed", "Failed to reconcile the metrics monitoring")
+		logger.Errorw("error reconciling the metrics monitoring", "error", err)
+		return err
+	}
 	sensor.Status.MarkDeployed()
 	return nil
 }
@@ -165,6 +223,23 @@ func buildDeployment(args *AdaptorArgs, eventBus *eventbusv1alpha1.EventBus) (*a
 			Value: base64.StdEncoding.EncodeToString(busConfigBytes),
 		},
 	}
+	if args.LogOnly {
+		env = append(env, corev1.EnvVar{Name: common.EnvVarSensorDryRun, Value: "true"})
+	}
+	if len(args.AdditionalEventBuses) > 0 {
+		configs := make(map[string]eventbusv1alpha1.BusConfig, len(args.AdditionalEventBuses))
+		for name, b := range args.AdditionalEventBuses {
+			configs[name] = b.Status.Config
+		}
+		configsBytes, err := json.Marshal(configs)
+		if err != nil {
+			return nil, fmt.Errorf("failed marshal additional event bus configs: %v", err)
+		}
+		env = append(env, corev1.EnvVar{
+			Name:  common.EnvVarAdditionalEventBusConfigs,
+			Value: base64.StdEncoding.EncodeToString(configsBytes),
+		})
+	}
 
 	volumes := []corev1.Volume{
 		{
@@ -219,6 +294,17 @@ func buildDeployment(args *AdaptorArgs, eventBus *eventbusv1alpha1.EventBus) (*a
 		})
 	}
 
+	if volume, mount := controllerscommon.SPIFFEVolume(eventBus.Status.Config); volume != nil {
+		// The pods authenticate with their SPIFFE workload identity
+		volumes = append(volumes, *volume)
+		volumeMounts = append(volumeMounts, *mount)
+	}
+
+	busVolumes, busVolumeMounts, busSecretObjs := additionalEventBusVolumes(args.AdditionalEventBuses, volumes)
+	volumes = append(volumes, busVolumes...)
+	volumeMounts = append(volumeMounts, busVolumeMounts...)
+	secretObjs = append(secretObjs, busSecretObjs...)
+
 	// secrets
 	volSecrets, volSecretMounts := common.VolumesFromSecretsOrConfigMaps(common.SecretKeySelectorType, secretObjs...)
 	volumes = append(volumes, volSecrets...)
@@ -272,6 +358,7 @@ func buildDeploymentSpec(args *AdaptorArgs) (*appv1.DeploymentSpec, error) {
 		}
 	}
 	sensorContainer.Name = "main"
+	sensorContainer.SecurityContext = controllerscommon.ContainerSecurityContext(sensorContainer.SecurityContext)
 	podTemplateLabels := make(map[string]string)
 	if args.Sensor.Spec.Template != nil && args.Sensor.Spec.Template.Metadata != nil &&
 		len(args.Sensor.Spec.Template.Metadata.Labels) > 0 {
@@ -303,7 +390,7 @@ func buildDeploymentSpec(args *AdaptorArgs) (*appv1.DeploymentSpec, error) {
 		if args.Sensor.Spec.Template.Metadata != nil {
 			spec.Template.SetAnnotations(args.Sensor.Spec.Template.Metadata.Annotations)
 		}
-		spec.Template.Spec.ServiceAccountName = args.Sensor.Spec.Template.ServiceAccountName
+		spec.Template.Spec.ServiceAccountName = serviceAccountName(args.Sensor)
 		spec.Template.Spec.Volumes = args.Sensor.Spec.Template.Volumes
 		spec.Template.Spec.SecurityContext = args.Sensor.Spec.Template.SecurityContext
 		spec.Template.Spec.NodeSelector = args.Sensor.Spec.Template.NodeSelector
@@ -312,6 +399,23 @@ func buildDeploymentSpec(args *AdaptorArgs) (*appv1.DeploymentSpec, error) {
 		spec.Template.Spec.ImagePullSecrets = args.Sensor.Spec.Template.ImagePullSecrets
 		spec.Template.Spec.PriorityClassName = args.Sensor.Spec.Template.PriorityClassName
 		spec.Template.Spec.Priority = args.Sensor.Spec.Template.Priority
+		spec.Template.Spec.RuntimeClassName = args.Sensor.Spec.Template.RuntimeClassName
+	}
+	spec.Template.Spec.SecurityContext = controllerscommon.PodSecurityContext(spec.Template.Spec.SecurityContext)
+	controllerscommon.SetReferencesHash(&spec.Template, args.ReferencesHash)
+	if drainTimeout := args.Sensor.Spec.GetDrainTimeout(); drainTimeout > 0 {
+		// Bring up the new pod before the old one starts draining, and give the old one enough time to finish.
+		maxSurge := intstr.FromInt(1)
+		maxUnavailable := intstr.FromInt(0)
+		spec.Strategy = appv1.DeploymentStrategy{
+			Type: appv1.RollingUpdateDeploymentStrategyType,
+			RollingUpdate: &appv1.RollingUpdateDeployment{
+				MaxSurge:       &maxSurge,
+				MaxUnavailable: &maxUnavailable,
+			},
+		}
+		gracePeriod := int64(drainTimeout.Seconds()) + defaultTerminationGracePeriodSeconds
+		spec.Template.Spec.TerminationGracePeriodSeconds = &gracePeriod
 	}
 	return spec, nil
 }
@@ -213,6 +213,64 @@ func Test_BuildDeployment(t *testing.T) {
 		assert.NotNil(t, deployment)
 		assert.Equal(t, int32(3), *deployment.Spec.RevisionHistoryLimit)
 	})
+	t.Run("test log-only", func(t *testing.T) {
+		args := &AdaptorArgs{
+			Image:  testImage,
+			Sensor: sensorObj,
+			Labels: testLabels,
+		}
+		deployment, err := buildDeployment(args, fakeEventBus)
+		assert.Nil(t, err)
+		assert.NotContains(t, deployment.Spec.Template.Spec.Containers[0].Env, corev1.EnvVar{Name: common.EnvVarSensorDryRun, Value: "true"})
+		args.LogOnly = true
+		deployment, err = buildDeployment(args, fakeEventBus)
+		assert.Nil(t, err)
+		assert.Contains(t, deployment.Spec.Template.Spec.Containers[0].Env, corev1.EnvVar{Name: common.EnvVarSensorDryRun, Value: "true"})
+	})
+	t.Run("test security context", func(t *testing.T) {
+		args := &AdaptorArgs{
+			Image:  testImage,
+			Sensor: sensorObj.DeepCopy(),
+			Labels: testLabels,
+		}
+		deployment, err := buildDeployment(args, fakeEventBus)
+		assert.Nil(t, err)
+		podSpec := deployment.Spec.Template.Spec
+		assert.True(t, *podSpec.SecurityContext.RunAsNonRoot)
+		assert.Equal(t, corev1.SeccompProfileTypeRuntimeDefault, podSpec.SecurityContext.SeccompProfile.Type)
+		assert.False(t, *podSpec.Containers[0].SecurityContext.AllowPrivilegeEscalation)
+		assert.Equal(t, []corev1.Capability{"ALL"}, podSpec.Containers[0].SecurityContext.Capabilities.Drop)
+		assert.Nil(t, podSpec.RuntimeClassName)
+
+		runtimeClassName := "gvisor"
+		privileged := true
+		args.Sensor.Spec.Template.RuntimeClassName = &runtimeClassName
+		args.Sensor.Spec.Template.SecurityContext = &corev1.PodSecurityContext{}
+		args.Sensor.Spec.Template.Container = &corev1.Container{SecurityContext: &corev1.SecurityContext{Privileged: &privileged}}
+		deployment, err = buildDeployment(args, fakeEventBus)
+		assert.Nil(t, err)
+		podSpec = deployment.Spec.Template.Spec
+		assert.Equal(t, "gvisor", *podSpec.RuntimeClassName)
+		assert.Equal(t, &corev1.PodSecurityContext{}, podSpec.SecurityContext)
+		assert.True(t, *podSpec.Containers[0].SecurityContext.Privileged)
+		assert.Nil(t, podSpec.Containers[0].SecurityContext.Capabilities)
+	})
+	t.Run("test drainTimeout", func(t *testing.T) {
+		sensorWithDrainTimeout := sensorObj.DeepCopy()
+		sensorWithDrainTimeout.Spec.DrainTimeout = "60s"
+		args := &AdaptorArgs{
+			Image:  testImage,
+			Sensor: sensorWithDrainTimeout,
+			Labels: testLabels,
+		}
+		deployment, err := buildDeployment(args, fakeEventBus)
+		assert.Nil(t, err)
+		assert.NotNil(t, deployment)
+		assert.Equal(t, appv1.RollingUpdateDeploymentStrategyType, deployment.Spec.Strategy.Type)
+		assert.Equal(t, 1, deployment.Spec.Strategy.RollingUpdate.MaxSurge.IntValue())
+		assert.Equal(t, 0, deployment.Spec.Strategy.RollingUpdate.MaxUnavailable.IntValue())
+		assert.Equal(t, int64(90), *deployment.Spec.Template.Spec.TerminationGracePeriodSeconds)
+	})
 
 	t.Run("test kafka eventbus secrets attached", func(t *testing.T) {
 		args := &AdaptorArgs{
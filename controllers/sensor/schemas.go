@@ -0,0 +1,219 @@
+package sensor
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/discovery"
+	"k8s.io/kube-openapi/pkg/util/proto"
+	"sigs.k8s.io/yaml"
+
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+)
+
+const (
+	groupVersionKindExtension      = "x-kubernetes-group-version-kind"
+	preserveUnknownFieldsExtension = "x-kubernetes-preserve-unknown-fields"
+
+	// schemasRefreshInterval is the interval the OpenAPI schemas are refreshed at, to take the new CRDs into account
+	schemasRefreshInterval = 5 * time.Minute
+)
+
+// ResourceSchemas looks up the OpenAPI schemas of the Kubernetes resources.
+type ResourceSchemas interface {
+	// LookupResource returns the schema of a resource, nil if it is unknown.
+	LookupResource(gvk schema.GroupVersionKind) proto.Schema
+}
+
+type discoveryResourceSchemas struct {
+	client discovery.OpenAPISchemaInterface
+	logger *zap.SugaredLogger
+
+	lock      sync.Mutex
+	fetchedAt time.Time
+	schemas   map[schema.GroupVersionKind]proto.Schema
+}
+
+// NewDiscoveryResourceSchemas returns the schemas published by the API server. The resources are not validated while
+// the schemas can not be fetched, the failures are logged.
+func NewDiscoveryResourceSchemas(client discovery.OpenAPISchemaInterface, logger *zap.SugaredLogger) ResourceSchemas {
+	return &discoveryResourceSchemas{client: client, logger: logger}
+}
+
+func (d *discoveryResourceSchemas) LookupResource(gvk schema.GroupVersionKind) proto.Schema {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	if d.schemas == nil || time.Since(d.fetchedAt) > schemasRefreshInterval {
+		doc, err := d.client.OpenAPISchema()
+		if err != nil {
+			d.logger.Warnw("failed to get the OpenAPI schema, the trigger parameters are not validated", zap.Error(err))
+			return nil
+		}
+		models, err := proto.NewOpenAPIData(doc)
+		if err != nil {
+			d.logger.Warnw("failed to parse the OpenAPI schema, the trigger parameters are not validated", zap.Error(err))
+			return nil
+		}
+		schemas := make(map[schema.GroupVersionKind]proto.Schema)
+		for _, name := range models.ListModels() {
+			model := models.LookupModel(name)
+			for _, k := range groupVersionKinds(model) {
+				schemas[k] = model
+			}
+		}
+		d.schemas = schemas
+		d.fetchedAt = time.Now()
+	}
+	return d.schemas[gvk]
+}
+
+// groupVersionKinds returns the kinds a model is the schema of.
+func groupVersionKinds(model proto.Schema) []schema.GroupVersionKind {
+	if model == nil {
+		return nil
+	}
+	list, ok := model.GetExtensions()[groupVersionKindExtension].([]interface{})
+	if !ok {
+		return nil
+	}
+	var gvks []schema.GroupVersionKind
+	for _, item := range list {
+		m, ok := item.(map[interface{}]interface{})
+		if !ok {
+			continue
+		}
+		group, _ := m["group"].(string)
+		version, _ := m["version"].(string)
+		kind, _ := m["kind"].(string)
+		gvks = append(gvks, schema.GroupVersionKind{Group: group, Version: version, Kind: kind})
+	}
+	return gvks
+}
+
+// validateParameterDestinations validates that the destinations of the parameters of the Kubernetes and Argo Workflow
+// triggers resolve against the schemas of their resources. The resources not embedded in the Sensor, and the ones
+// without a published schema are not validated.
+func validateParameterDestinations(triggers []v1alpha1.Trigger, schemas ResourceSchemas) error {
+	for _, trigger := range triggers {
+		if trigger.Template == nil {
+			continue
+		}
+		var source *v1alpha1.ArtifactLocation
+		var parameters []v1alpha1.TriggerParameter
+		switch {
+		case trigger.Template.K8s != nil:
+			source, parameters = trigger.Template.K8s.Source, trigger.Template.K8s.Parameters
+		case trigger.Template.ArgoWorkflow != nil:
+			source, parameters = trigger.Template.ArgoWorkflow.Source, trigger.Template.ArgoWorkflow.Parameters
+		default:
+			continue
+		}
+		if len(parameters) == 0 {
+			continue
+		}
+		obj, err := embeddedResource(source)
+		if err != nil {
+			return fmt.Errorf("trigger %s: %w", trigger.Template.Name, err)
+		}
+		if obj == nil {
+			continue
+		}
+		gvk := obj.GroupVersionKind()
+		s := schemas.LookupResource(gvk)
+		if s == nil {
+			continue
+		}
+		for i, parameter := range parameters {
+			if parameter.Dest == "" {
+				// A patch of the whole resource
+				continue
+			}
+			if err := validateDestination(s, parameter.Dest); err != nil {
+				return fmt.Errorf("trigger %s: resource parameter index: %d: destination %q does not resolve against the schema of %s, %w", trigger.Template.Name, i, parameter.Dest, gvk.Kind, err)
+			}
+		}
+	}
+	return nil
+}
+
+// embeddedResource returns the resource embedded in the Sensor, nil if it is fetched from elsewhere.
+func embeddedResource(source *v1alpha1.ArtifactLocation) (*unstructured.Unstructured, error) {
+	if source == nil {
+		return nil, nil
+	}
+	var data []byte
+	switch {
+	case source.Resource != nil:
+		data = source.Resource.Value
+	case source.Inline != nil:
+		data = []byte(*source.Inline)
+	default:
+		return nil, nil
+	}
+	obj := &unstructured.Unstructured{}
+	if err := yaml.Unmarshal(data, &obj.Object); err != nil {
+		return nil, fmt.Errorf("failed to parse the resource, %w", err)
+	}
+	if obj.GetKind() == "" {
+		return nil, nil
+	}
+	return obj, nil
+}
+
+// validateDestination validates that a destination, a path in the sjson syntax, resolves against a schema.
+func validateDestination(s proto.Schema, dest string) error {
+	for _, key := range splitPath(dest) {
+		for r, ok := s.(proto.Reference); ok; r, ok = s.(proto.Reference) {
+			s = r.SubSchema()
+		}
+		switch t := s.(type) {
+		case *proto.Kind:
+			field, ok := t.Fields[key]
+			if !ok {
+				if preserved, _ := t.GetExtensions()[preserveUnknownFieldsExtension].(bool); preserved {
+					return nil
+				}
+				return fmt.Errorf("unknown field %q", key)
+			}
+			s = field
+		case *proto.Map:
+			s = t.SubType
+		case *proto.Array:
+			if _, err := strconv.Atoi(key); err != nil {
+				return fmt.Errorf("%q is not an index of the array", key)
+			}
+			s = t.SubType
+		case *proto.Primitive:
+			return fmt.Errorf("%s is a %s, it has no field %q", t.GetPath().String(), t.Type, key)
+		default:
+			// Arbitrary values accept any field
+			return nil
+		}
+	}
+	return nil
+}
+
+// splitPath splits a path in the sjson syntax into its keys, the dots can be escaped with a backslash.
+func splitPath(path string) []string {
+	var keys []string
+	var key strings.Builder
+	for i := 0; i < len(path); i++ {
+		switch {
+		case path[i] == '\\' && i+1 < len(path):
+			i++
+			key.WriteByte(path[i])
+		case path[i] == '.':
+			keys = append(keys, key.String())
+			key.Reset()
+		default:
+			key.WriteByte(path[i])
+		}
+	}
+	return append(keys, key.String())
+}
@@ -0,0 +1,130 @@
+package sensor
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/kube-openapi/pkg/util/proto"
+
+	apicommon "github.com/argoproj/argo-events/pkg/apis/common"
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+)
+
+type fakeResourceSchemas map[schema.GroupVersionKind]proto.Schema
+
+func (f fakeResourceSchemas) LookupResource(gvk schema.GroupVersionKind) proto.Schema {
+	return f[gvk]
+}
+
+var fakePodSchema = &proto.Kind{
+	BaseSchema: proto.BaseSchema{Path: proto.NewPath("io.k8s.api.core.v1.Pod")},
+	Fields: map[string]proto.Schema{
+		"metadata": &proto.Kind{
+			Fields: map[string]proto.Schema{
+				"generateName": &proto.Primitive{BaseSchema: proto.BaseSchema{Path: proto.NewPath("io.k8s.apimachinery.pkg.apis.meta.v1.ObjectMeta.generateName")}, Type: "string"},
+				"labels":       &proto.Map{SubType: &proto.Primitive{Type: "string"}},
+			},
+		},
+		"spec": &proto.Kind{
+			Fields: map[string]proto.Schema{
+				"containers": &proto.Array{SubType: &proto.Kind{
+					Fields: map[string]proto.Schema{
+						"args": &proto.Array{SubType: &proto.Primitive{Type: "string"}},
+					},
+				}},
+				"overhead": &proto.Arbitrary{},
+			},
+		},
+	},
+}
+
+func TestValidateDestination(t *testing.T) {
+	for _, dest := range []string{
+		"metadata.generateName",
+		"metadata.labels.app",
+		`metadata.labels.app\.kubernetes\.io/name`,
+		"spec.containers.0.args.-1",
+		"spec.overhead.cpu.anything",
+	} {
+		assert.NoError(t, validateDestination(fakePodSchema, dest), dest)
+	}
+
+	err := validateDestination(fakePodSchema, "spec.container.0.args")
+	assert.Error(t, err)
+	assert.Equal(t, `unknown field "container"`, err.Error())
+	err = validateDestination(fakePodSchema, "spec.containers.args")
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "not an index of the array")
+	err = validateDestination(fakePodSchema, "metadata.generateName.value")
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "it has no field")
+}
+
+func TestSplitPath(t *testing.T) {
+	assert.Equal(t, []string{"metadata", "labels", "app.kubernetes.io/name"}, splitPath(`metadata.labels.app\.kubernetes\.io/name`))
+	assert.Equal(t, []string{"spec"}, splitPath("spec"))
+}
+
+func TestGroupVersionKinds(t *testing.T) {
+	model := &proto.Kind{BaseSchema: proto.BaseSchema{Extensions: map[string]interface{}{
+		groupVersionKindExtension: []interface{}{
+			map[interface{}]interface{}{"group": "", "version": "v1", "kind": "Pod"},
+		},
+	}}}
+	assert.Equal(t, []schema.GroupVersionKind{{Version: "v1", Kind: "Pod"}}, groupVersionKinds(model))
+	assert.Nil(t, groupVersionKinds(&proto.Kind{}))
+}
+
+func TestValidateParameterDestinations(t *testing.T) {
+	schemas := fakeResourceSchemas{{Version: "v1", Kind: "Pod"}: fakePodSchema}
+	pod := `
+apiVersion: v1
+kind: Pod
+metadata:
+  generateName: hello-world-
+`
+	newTrigger := func(source *v1alpha1.ArtifactLocation, dest string) v1alpha1.Trigger {
+		return v1alpha1.Trigger{
+			Template: &v1alpha1.TriggerTemplate{
+				Name: "pod-trigger",
+				K8s: &v1alpha1.StandardK8STrigger{
+					Source: source,
+					Parameters: []v1alpha1.TriggerParameter{
+						{Src: &v1alpha1.TriggerParameterSource{DependencyName: "dep"}, Dest: dest},
+					},
+				},
+			},
+		}
+	}
+
+	t.Run("valid destination", func(t *testing.T) {
+		trigger := newTrigger(&v1alpha1.ArtifactLocation{Inline: &pod}, "metadata.labels.app")
+		assert.NoError(t, validateParameterDestinations([]v1alpha1.Trigger{trigger}, schemas))
+	})
+
+	t.Run("invalid destination", func(t *testing.T) {
+		resource := apicommon.NewResource(map[string]interface{}{"apiVersion": "v1", "kind": "Pod"})
+		trigger := newTrigger(&v1alpha1.ArtifactLocation{Resource: &resource}, "metadata.label.app")
+		err := validateParameterDestinations([]v1alpha1.Trigger{trigger}, schemas)
+		assert.Error(t, err)
+		assert.Equal(t, `trigger pod-trigger: resource parameter index: 0: destination "metadata.label.app" does not resolve against the schema of Pod, unknown field "label"`, err.Error())
+	})
+
+	t.Run("patch of the whole resource", func(t *testing.T) {
+		trigger := newTrigger(&v1alpha1.ArtifactLocation{Inline: &pod}, "")
+		trigger.Template.K8s.Parameters[0].Operation = v1alpha1.TriggerParameterOpJSONPatch
+		assert.NoError(t, validateParameterDestinations([]v1alpha1.Trigger{trigger}, schemas))
+	})
+
+	t.Run("unknown kind", func(t *testing.T) {
+		job := "apiVersion: batch/v1\nkind: Job\n"
+		trigger := newTrigger(&v1alpha1.ArtifactLocation{Inline: &job}, "metadata.label.app")
+		assert.NoError(t, validateParameterDestinations([]v1alpha1.Trigger{trigger}, schemas))
+	})
+
+	t.Run("resource not embedded", func(t *testing.T) {
+		trigger := newTrigger(&v1alpha1.ArtifactLocation{URL: &v1alpha1.URLArtifact{Path: "https://example.com/pod.yaml"}}, "metadata.label.app")
+		assert.NoError(t, validateParameterDestinations([]v1alpha1.Trigger{trigger}, schemas))
+	})
+}
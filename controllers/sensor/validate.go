@@ -17,13 +17,26 @@ limitations under the License.
 package sensor
 
 import (
+	"encoding/json"
 	"fmt"
+	"mime"
 	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"text/template"
 	"time"
 
+	"github.com/Knetic/govaluate"
+	sprig "github.com/Masterminds/sprig/v3"
+	jsonpatch "github.com/evanphx/json-patch/v5"
 	cronlib "github.com/robfig/cron/v3"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/validation"
 
 	"github.com/argoproj/argo-events/common"
+	controllerscommon "github.com/argoproj/argo-events/controllers/common"
+	apicommon "github.com/argoproj/argo-events/pkg/apis/common"
 	eventbusv1alpha1 "github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1"
 	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
 )
@@ -33,6 +46,12 @@ import (
 // the error is ignored by the operation context as subsequent re-queues would produce the same error.
 // Exporting this function so that external APIs can use this to validate sensor resource.
 func ValidateSensor(s *v1alpha1.Sensor, b *eventbusv1alpha1.EventBus) error {
+	return ValidateSensorWithEventBuses(s, b, nil)
+}
+
+// ValidateSensorWithEventBuses validates a sensor whose dependencies may consume from additional EventBuses, keyed
+// by name, each dependency is validated against the EventBus it consumes from.
+func ValidateSensorWithEventBuses(s *v1alpha1.Sensor, b *eventbusv1alpha1.EventBus, additionalEventBuses map[string]*eventbusv1alpha1.EventBus) error {
 	if s == nil {
 		s.Status.MarkDependenciesNotProvided("InvalidSensor", "nil sensor")
 		return fmt.Errorf("nil sensor")
@@ -41,7 +60,49 @@ func ValidateSensor(s *v1alpha1.Sensor, b *eventbusv1alpha1.EventBus) error {
 		s.Status.MarkDependenciesNotProvided("InvalidEventBus", "nil eventbus")
 		return fmt.Errorf("nil eventbus")
 	}
-	if err := validateDependencies(s.Spec.Dependencies, b); err != nil {
+	if s.Spec.DrainTimeout != "" {
+		if d, err := time.ParseDuration(s.Spec.DrainTimeout); err != nil || d < 0 {
+			s.Status.MarkDeployFailed("InvalidDrainTimeout", "Invalid drainTimeout.")
+			return fmt.Errorf("invalid drainTimeout %q, it should be a positive duration, e.g. 30s", s.Spec.DrainTimeout)
+		}
+	}
+	if err := validateCanaryRollout(s.Spec.Rollout.GetCanary()); err != nil {
+		s.Status.MarkDeployFailed("InvalidRollout", err.Error())
+		return err
+	}
+	if t := s.Spec.Template; t != nil && t.GenerateServiceAccount && t.ServiceAccountName != "" {
+		s.Status.MarkDeployFailed("InvalidTemplate", "generateServiceAccount and serviceAccountName can't be both specified.")
+		return fmt.Errorf("generateServiceAccount and serviceAccountName can't be both specified")
+	}
+	if err := validateTriggerStatus(s.Spec.TriggerStatus); err != nil {
+		s.Status.MarkDeployFailed("InvalidTriggerStatus", err.Error())
+		return err
+	}
+	if err := validateQuota(s.Spec.Quota); err != nil {
+		s.Status.MarkDeployFailed("InvalidQuota", err.Error())
+		return err
+	}
+	if err := validateTracingMetadata(s.Spec.TracingMetadata); err != nil {
+		s.Status.MarkDeployFailed("InvalidTracingMetadata", err.Error())
+		return err
+	}
+	if err := validateTriggerPools(s.Spec.TriggerPools); err != nil {
+		s.Status.MarkDeployFailed("InvalidTriggerPools", err.Error())
+		return err
+	}
+	if err := validateEventCapture(s.Spec.Capture); err != nil {
+		s.Status.MarkDeployFailed("InvalidCapture", err.Error())
+		return err
+	}
+	if err := controllerscommon.ValidateMetricsConfig(s.Spec.Metrics); err != nil {
+		s.Status.MarkDeployFailed("InvalidMetrics", err.Error())
+		return err
+	}
+	if err := validateDataSchemaValidation(s.Spec.DataSchemaValidation); err != nil {
+		s.Status.MarkDependenciesNotProvided("InvalidDataSchemaValidation", err.Error())
+		return err
+	}
+	if err := validateDependencies(s.Spec.Dependencies, b, additionalEventBuses); err != nil {
 		s.Status.MarkDependenciesNotProvided("InvalidDependencies", err.Error())
 		return err
 	}
@@ -51,10 +112,212 @@ func ValidateSensor(s *v1alpha1.Sensor, b *eventbusv1alpha1.EventBus) error {
 		s.Status.MarkTriggersNotProvided("InvalidTriggers", err.Error())
 		return err
 	}
+	if err := validateMetricDependencyConditions(s); err != nil {
+		s.Status.MarkTriggersNotProvided("InvalidTriggers", err.Error())
+		return err
+	}
+	if b.Spec.JetStream == nil && b.Spec.JetStreamExotic == nil && b.Status.Config.JetStream == nil {
+		for _, trigger := range s.Spec.Triggers {
+			if trigger.Deduplication != nil {
+				err := fmt.Errorf("trigger %s: deduplication is only supported with JetStream EventBus", trigger.Template.Name)
+				s.Status.MarkTriggersNotProvided("InvalidTriggers", err.Error())
+				return err
+			}
+			// The batches are persisted in the Key/Value store, so that they survive the restarts of the Sensor
+			if trigger.Batch != nil {
+				err := fmt.Errorf("trigger %s: batch is only supported with JetStream EventBus", trigger.Template.Name)
+				s.Status.MarkTriggersNotProvided("InvalidTriggers", err.Error())
+				return err
+			}
+		}
+	}
+	if err := validateDistribution(s, b); err != nil {
+		s.Status.MarkDeployFailed("InvalidDistribution", err.Error())
+		return err
+	}
+	if err := validateOrdering(s, b); err != nil {
+		s.Status.MarkDeployFailed("EventBusOrderingNotSupported", err.Error())
+		return err
+	}
 	s.Status.MarkTriggersProvided()
 	return nil
 }
 
+// validateTriggerStatus validates the report of the trigger executions
+func validateTriggerStatus(r *v1alpha1.TriggerStatusReporting) error {
+	if r == nil {
+		return nil
+	}
+	switch r.Verbosity {
+	case "", v1alpha1.TriggerStatusSummary, v1alpha1.TriggerStatusDetailed:
+	default:
+		return fmt.Errorf("invalid triggerStatus verbosity %q, it should be either %s or %s", r.Verbosity, v1alpha1.TriggerStatusSummary, v1alpha1.TriggerStatusDetailed)
+	}
+	if r.MaxFailingTriggers < 0 {
+		return fmt.Errorf("triggerStatus maxFailingTriggers can't be negative")
+	}
+	if r.ExecutionTTL != "" {
+		if d, err := time.ParseDuration(r.ExecutionTTL); err != nil || d <= 0 {
+			return fmt.Errorf("invalid triggerStatus executionTTL %q, it should be a positive duration, e.g. 6h", r.ExecutionTTL)
+		}
+	}
+	return nil
+}
+
+// validateQuota validates the execution quota of the Sensor
+func validateQuota(q *v1alpha1.SensorExecutionQuota) error {
+	if q == nil {
+		return nil
+	}
+	if q.MaxTriggersPerHour < 0 || q.MaxTriggersPerDay < 0 {
+		return fmt.Errorf("quota maxTriggersPerHour and maxTriggersPerDay can't be negative")
+	}
+	if q.MaxTriggersPerHour == 0 && q.MaxTriggersPerDay == 0 {
+		return fmt.Errorf("quota requires either maxTriggersPerHour or maxTriggersPerDay")
+	}
+	if q.MaxTriggersPerHour > 0 && q.MaxTriggersPerDay > 0 && q.MaxTriggersPerHour > q.MaxTriggersPerDay {
+		return fmt.Errorf("quota maxTriggersPerHour can't be greater than maxTriggersPerDay")
+	}
+	switch q.Overflow {
+	case "", v1alpha1.QuotaOverflowDrop, v1alpha1.QuotaOverflowQueue, v1alpha1.QuotaOverflowAlert:
+	default:
+		return fmt.Errorf("invalid quota overflow %q, it should be either %s, %s or %s", q.Overflow, v1alpha1.QuotaOverflowDrop, v1alpha1.QuotaOverflowQueue, v1alpha1.QuotaOverflowAlert)
+	}
+	return nil
+}
+
+// validateTracingMetadata validates the templates of the labels and annotations added to the created resources
+func validateTracingMetadata(m *v1alpha1.TracingMetadata) error {
+	if m == nil {
+		return nil
+	}
+	for kind, templates := range map[string]map[string]string{"label": m.Labels, "annotation": m.Annotations} {
+		for k, templString := range templates {
+			if errs := validation.IsQualifiedName(k); len(errs) > 0 {
+				return fmt.Errorf("invalid tracing %s name %q, %s", kind, k, strings.Join(errs, ", "))
+			}
+			if _, err := template.New(k).Funcs(sprig.FuncMap()).Parse(templString); err != nil {
+				return fmt.Errorf("invalid template of the tracing %s %s, %w", kind, k, err)
+			}
+		}
+	}
+	return nil
+}
+
+// validateTriggerPools validates the sizes of the trigger pools
+func validateTriggerPools(p *v1alpha1.TriggerPools) error {
+	if p == nil {
+		return nil
+	}
+	if p.Workers < 0 || p.QueueSize < 0 || p.MaxConcurrency < 0 {
+		return fmt.Errorf("triggerPools workers, queueSize and maxConcurrency can't be negative")
+	}
+	return nil
+}
+
+// validateEventCapture validates the bucket and the durations of the capture of the received events
+func validateEventCapture(c *v1alpha1.EventCapture) error {
+	if c == nil {
+		return nil
+	}
+	if c.S3 == nil || c.S3.Bucket == nil || c.S3.Bucket.Name == "" {
+		return fmt.Errorf("capture s3 bucket is required")
+	}
+	if c.S3.Endpoint == "" {
+		return fmt.Errorf("capture s3 endpoint is required")
+	}
+	if c.S3.AccessKey == nil || c.S3.SecretKey == nil {
+		return fmt.Errorf("capture s3 accessKey and secretKey are required")
+	}
+	if c.FlushInterval != "" {
+		if d, err := time.ParseDuration(c.FlushInterval); err != nil || d <= 0 {
+			return fmt.Errorf("invalid capture flushInterval %q", c.FlushInterval)
+		}
+	}
+	if c.Retention != "" {
+		if d, err := time.ParseDuration(c.Retention); err != nil || d < c.GetFlushInterval() {
+			return fmt.Errorf("invalid capture retention %q, it must be a duration longer than the flushInterval", c.Retention)
+		}
+	}
+	return nil
+}
+
+// validateOrdering validates that the EventBus delivers the events in order when the Sensor requires it
+func validateOrdering(s *v1alpha1.Sensor, b *eventbusv1alpha1.EventBus) error {
+	if !s.Spec.RequiresOrdering {
+		return nil
+	}
+	if ordering := b.GetOrdering(); !ordering.IsOrdered() {
+		return fmt.Errorf("the sensor requires ordering, but the ordering of eventbus %s is %q", b.Name, ordering)
+	}
+	return nil
+}
+
+// validateDistribution validates the distribution of the trigger load across the replicas
+func validateDistribution(s *v1alpha1.Sensor, b *eventbusv1alpha1.EventBus) error {
+	if s.Spec.Distribution == nil {
+		return nil
+	}
+	switch s.Spec.Distribution.Mode {
+	case "", v1alpha1.SensorDistributionLeaderElection:
+		return nil
+	case v1alpha1.SensorDistributionShared:
+	default:
+		return fmt.Errorf("invalid distribution mode %q, it should be either %s or %s", s.Spec.Distribution.Mode, v1alpha1.SensorDistributionLeaderElection, v1alpha1.SensorDistributionShared)
+	}
+	if b.Spec.Kafka != nil || b.Status.Config.Kafka != nil {
+		return nil
+	}
+	if b.Spec.JetStream == nil && b.Spec.JetStreamExotic == nil && b.Status.Config.JetStream == nil {
+		return fmt.Errorf("shared distribution is only supported with JetStream or Kafka EventBus")
+	}
+	return nil
+}
+
+// validateCanaryRollout validates the canary rollout configuration
+func validateCanaryRollout(canary *v1alpha1.CanaryRollout) error {
+	if canary == nil {
+		return nil
+	}
+	if canary.BakeDuration != "" {
+		if d, err := time.ParseDuration(canary.BakeDuration); err != nil || d <= 0 {
+			return fmt.Errorf("invalid canary bakeDuration %q, it should be a positive duration, e.g. 10m", canary.BakeDuration)
+		}
+	}
+	if canary.MaxErrorRate != nil {
+		rate, err := canary.MaxErrorRate.Float64()
+		if err != nil || rate < 0 || rate > 1 {
+			return fmt.Errorf("invalid canary maxErrorRate %q, it should be between 0 and 1", string(canary.MaxErrorRate.Value))
+		}
+	}
+	if canary.MinExecutions < 0 {
+		return fmt.Errorf("invalid canary minExecutions %d, it should not be negative", canary.MinExecutions)
+	}
+	return nil
+}
+
+// validateDataSchemaValidation validates the data schema validation configuration
+func validateDataSchemaValidation(v *v1alpha1.DataSchemaValidation) error {
+	if v == nil {
+		return nil
+	}
+	if len(v.AllowedURLs) == 0 {
+		return fmt.Errorf("allowedURLs are required by the data schema validation")
+	}
+	for _, u := range v.AllowedURLs {
+		parsed, err := url.Parse(u)
+		if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+			return fmt.Errorf("invalid allowed url %q, it should be an http or https url", u)
+		}
+	}
+	if v.CacheTTL != "" {
+		if d, err := time.ParseDuration(v.CacheTTL); err != nil || d <= 0 {
+			return fmt.Errorf("invalid data schema cacheTTL %q, it should be a positive duration, e.g. 10m", v.CacheTTL)
+		}
+	}
+	return nil
+}
+
 // validateTriggers validates triggers
 func validateTriggers(triggers []v1alpha1.Trigger) error {
 	if len(triggers) < 1 {
@@ -72,6 +335,17 @@ func validateTriggers(triggers []v1alpha1.Trigger) error {
 		}
 		trigNames[trigger.Template.Name] = true
 	}
+	// The alternate triggers have their own state keyed by name, e.g. circuit breaker, cache and rate limiter
+	for _, trigger := range triggers {
+		if trigger.OversizeRoute == nil {
+			continue
+		}
+		name := trigger.OversizeRoute.Trigger.Template.Name
+		if _, ok := trigNames[name]; ok {
+			return fmt.Errorf("duplicate trigger name: %s, the name of the oversize route trigger of %s must differ from the names of the other triggers", name, trigger.Template.Name)
+		}
+		trigNames[name] = true
+	}
 	return nil
 }
 
@@ -88,10 +362,203 @@ func validateTrigger(trigger v1alpha1.Trigger) error {
 	if err := validateDlqTrigger(&trigger); err != nil {
 		return err
 	}
+	if err := validateTriggerDeduplication(trigger.Deduplication); err != nil {
+		return err
+	}
+	if err := validateTriggerRetryStrategy(trigger.RetryStrategy); err != nil {
+		return err
+	}
+	if err := validateTriggerCircuitBreaker(trigger.CircuitBreaker); err != nil {
+		return err
+	}
+	if err := validateTriggerActiveWindows(trigger.ActiveWindows); err != nil {
+		return err
+	}
+	if err := validateTriggerBatch(trigger.Batch); err != nil {
+		return err
+	}
+	if err := validateTriggerCache(trigger.Cache); err != nil {
+		return err
+	}
+	if err := validateTriggerFeatureFlag(trigger.FeatureFlag); err != nil {
+		return err
+	}
+	if err := validateTriggerOversizeRoute(&trigger); err != nil {
+		return err
+	}
+	if trigger.Pool != nil && (trigger.Pool.Workers < 0 || trigger.Pool.QueueSize < 0) {
+		return fmt.Errorf("pool workers and queueSize can't be negative")
+	}
 
 	return nil
 }
 
+// validateTriggerRetryStrategy validates the retry strategy of a trigger
+func validateTriggerRetryStrategy(backoff *apicommon.Backoff) error {
+	if backoff == nil {
+		return nil
+	}
+	if _, err := common.Convert2WaitBackoff(backoff); err != nil {
+		return fmt.Errorf("invalid retryStrategy, %w", err)
+	}
+	if backoff.Cap != nil {
+		if backoff.Cap.Type == apicommon.String {
+			if _, err := time.ParseDuration(backoff.Cap.StrVal); err != nil {
+				return fmt.Errorf("invalid retryStrategy cap %q, %w", backoff.Cap.StrVal, err)
+			}
+		} else if backoff.Cap.Int64Value() < 0 {
+			return fmt.Errorf("invalid retryStrategy cap, it should not be negative")
+		}
+	}
+	return nil
+}
+
+// validateTriggerCircuitBreaker validates the circuit breaker of a trigger
+func validateTriggerCircuitBreaker(cb *v1alpha1.TriggerCircuitBreaker) error {
+	if cb == nil {
+		return nil
+	}
+	if cb.ConsecutiveFailures <= 0 {
+		return fmt.Errorf("circuitBreaker consecutiveFailures should be greater than 0")
+	}
+	if cb.OpenDuration != "" {
+		if d, err := time.ParseDuration(cb.OpenDuration); err != nil || d <= 0 {
+			return fmt.Errorf("invalid circuitBreaker openDuration %q, it should be a positive duration, e.g. 1m", cb.OpenDuration)
+		}
+	}
+	return nil
+}
+
+// validateTriggerActiveWindows validates the active windows of a trigger
+func validateTriggerActiveWindows(aw *v1alpha1.TriggerActiveWindows) error {
+	if aw == nil {
+		return nil
+	}
+	if len(aw.Windows) == 0 {
+		return fmt.Errorf("activeWindows should have at least one window")
+	}
+	parser := cronlib.NewParser(cronlib.Minute | cronlib.Hour | cronlib.Dom | cronlib.Month | cronlib.Dow)
+	for _, w := range aw.Windows {
+		if _, err := parser.Parse(w.Start); err != nil {
+			return fmt.Errorf("invalid activeWindows start %q, it should be a cron expression", w.Start)
+		}
+		if d, err := time.ParseDuration(w.Duration); err != nil || d <= 0 {
+			return fmt.Errorf("invalid activeWindows duration %q, it should be a positive duration, e.g. 8h", w.Duration)
+		}
+	}
+	if _, err := time.LoadLocation(aw.Timezone); err != nil {
+		return fmt.Errorf("invalid activeWindows timezone %q", aw.Timezone)
+	}
+	switch aw.OutsideWindows {
+	case "", v1alpha1.TriggerOutsideWindowsDrop, v1alpha1.TriggerOutsideWindowsDefer:
+	default:
+		return fmt.Errorf("invalid activeWindows outsideWindows %q, it should be %s or %s", aw.OutsideWindows, v1alpha1.TriggerOutsideWindowsDrop, v1alpha1.TriggerOutsideWindowsDefer)
+	}
+	return nil
+}
+
+// validateTriggerBatch validates the flush settings of a trigger batch
+func validateTriggerBatch(batch *v1alpha1.TriggerBatch) error {
+	if batch == nil {
+		return nil
+	}
+	if batch.MaxEvents < 0 {
+		return fmt.Errorf("batch maxEvents should not be negative")
+	}
+	if batch.Window != "" {
+		if w, err := time.ParseDuration(batch.Window); err != nil || w <= 0 {
+			return fmt.Errorf("invalid batch window %q, it should be a positive duration, e.g. 5m", batch.Window)
+		}
+	}
+	if batch.MaxEvents == 0 && batch.Window == "" {
+		return fmt.Errorf("batch requires either maxEvents or window")
+	}
+	return nil
+}
+
+// validateTriggerDeduplication validates the key template and the window of trigger deduplication
+func validateTriggerDeduplication(dedup *v1alpha1.TriggerDeduplication) error {
+	if dedup == nil {
+		return nil
+	}
+	if dedup.KeyTemplate != "" {
+		if _, err := template.New("keyTemplate").Funcs(sprig.FuncMap()).Parse(dedup.KeyTemplate); err != nil {
+			return fmt.Errorf("invalid deduplication keyTemplate, %w", err)
+		}
+	}
+	if dedup.Window != "" {
+		if w, err := time.ParseDuration(dedup.Window); err != nil || w <= 0 {
+			return fmt.Errorf("invalid deduplication window %q, it should be a positive duration, e.g. 10m", dedup.Window)
+		}
+	}
+	return nil
+}
+
+// validateTriggerCache validates the key template and the TTL of the trigger cache
+func validateTriggerCache(cache *v1alpha1.TriggerCache) error {
+	if cache == nil {
+		return nil
+	}
+	if cache.KeyTemplate != "" {
+		if _, err := template.New("keyTemplate").Funcs(sprig.FuncMap()).Parse(cache.KeyTemplate); err != nil {
+			return fmt.Errorf("invalid cache keyTemplate, %w", err)
+		}
+	}
+	if ttl, err := time.ParseDuration(cache.TTL); err != nil || ttl <= 0 {
+		return fmt.Errorf("invalid cache ttl %q, it should be a positive duration, e.g. 10m", cache.TTL)
+	}
+	return nil
+}
+
+// validateTriggerFeatureFlag validates the feature flag gating the trigger
+func validateTriggerFeatureFlag(flag *v1alpha1.TriggerFeatureFlag) error {
+	if flag == nil {
+		return nil
+	}
+	if flag.Key == "" {
+		return fmt.Errorf("feature flag key can't be empty")
+	}
+	if flag.URL == "" {
+		return fmt.Errorf("feature flag url can't be empty")
+	}
+	if flag.Timeout < 0 {
+		return fmt.Errorf("feature flag timeout can't be negative")
+	}
+	if flag.TargetingKey != nil && flag.TargetingKey.DependencyName == "" {
+		return fmt.Errorf("feature flag targeting key dependency name can't be empty")
+	}
+	for i, parameter := range flag.Context {
+		if err := validateTriggerParameter(&parameter); err != nil {
+			return fmt.Errorf("feature flag context index: %d. err: %w", i, err)
+		}
+	}
+	return nil
+}
+
+// validateTriggerOversizeRoute validates the routing of the oversized executions to an alternate trigger
+func validateTriggerOversizeRoute(trigger *v1alpha1.Trigger) error {
+	route := trigger.OversizeRoute
+	if route == nil {
+		return nil
+	}
+	if route.MaxSize <= 0 {
+		return fmt.Errorf("oversize route max size must be greater than 0")
+	}
+	if route.Trigger == nil {
+		return fmt.Errorf("oversize route trigger can't be nil")
+	}
+	if route.Trigger.OversizeRoute != nil {
+		return fmt.Errorf("the trigger of an oversize route can't have an oversize route")
+	}
+	if err := validateTrigger(*route.Trigger); err != nil {
+		return fmt.Errorf("invalid oversize route trigger, %w", err)
+	}
+	if route.Trigger.Template.Name == trigger.Template.Name {
+		return fmt.Errorf("the name of the oversize route trigger must differ from the name of the trigger")
+	}
+	return nil
+}
+
 // validateDlqTrigger validates trigger.atLeastOnce==true and the trigger.dlqTrigger
 func validateDlqTrigger(trigger *v1alpha1.Trigger) error {
 	if trigger == nil {
@@ -152,6 +619,16 @@ func validateTriggerTemplate(template *v1alpha1.TriggerTemplate) error {
 			return fmt.Errorf("template %s is invalid, %w", template.Name, err)
 		}
 	}
+	if template.AWSSQS != nil {
+		if err := validateAWSSQSTrigger(template.AWSSQS); err != nil {
+			return fmt.Errorf("template %s is invalid, %w", template.Name, err)
+		}
+	}
+	if template.AWSSNS != nil {
+		if err := validateAWSSNSTrigger(template.AWSSNS); err != nil {
+			return fmt.Errorf("template %s is invalid, %w", template.Name, err)
+		}
+	}
 	if template.Kafka != nil {
 		if err := validateKafkaTrigger(template.Kafka); err != nil {
 			return fmt.Errorf("template %s is invalid, %w", template.Name, err)
@@ -182,6 +659,46 @@ func validateTriggerTemplate(template *v1alpha1.TriggerTemplate) error {
 			return fmt.Errorf("template %s is invalid, %w", template.Name, err)
 		}
 	}
+	if template.Prometheus != nil {
+		if err := validatePrometheusTrigger(template.Prometheus); err != nil {
+			return fmt.Errorf("template %s is invalid, %w", template.Name, err)
+		}
+	}
+	if template.Loki != nil {
+		if err := validateLokiTrigger(template.Loki); err != nil {
+			return fmt.Errorf("template %s is invalid, %w", template.Name, err)
+		}
+	}
+	if template.Elasticsearch != nil {
+		if err := validateElasticsearchTrigger(template.Elasticsearch); err != nil {
+			return fmt.Errorf("template %s is invalid, %w", template.Name, err)
+		}
+	}
+	if template.Jenkins != nil {
+		if err := validateJenkinsTrigger(template.Jenkins); err != nil {
+			return fmt.Errorf("template %s is invalid, %w", template.Name, err)
+		}
+	}
+	if template.GithubWorkflow != nil {
+		if err := validateGithubWorkflowTrigger(template.GithubWorkflow); err != nil {
+			return fmt.Errorf("template %s is invalid, %w", template.Name, err)
+		}
+	}
+	if template.AzureEventHubs != nil {
+		if err := validateAzureEventHubsTrigger(template.AzureEventHubs); err != nil {
+			return fmt.Errorf("template %s is invalid, %w", template.Name, err)
+		}
+	}
+	if template.AzureQueueStorage != nil {
+		if err := validateAzureQueueStorageTrigger(template.AzureQueueStorage); err != nil {
+			return fmt.Errorf("template %s is invalid, %w", template.Name, err)
+		}
+	}
+	if template.AzureServiceBus != nil {
+		if err := validateAzureServiceBusTrigger(template.AzureServiceBus); err != nil {
+			return fmt.Errorf("template %s is invalid, %w", template.Name, err)
+		}
+	}
 	return nil
 }
 
@@ -215,12 +732,21 @@ func validateArgoWorkflowTrigger(trigger *v1alpha1.ArgoWorkflowTrigger) error {
 	if trigger == nil {
 		return fmt.Errorf("argoWorkflow trigger can't be nil")
 	}
-	if trigger.Source == nil {
+	if trigger.Source == nil && trigger.LabelSelector == "" {
 		return fmt.Errorf("argoWorkflow trigger does not contain an absolute action")
 	}
 
 	switch trigger.Operation {
-	case v1alpha1.Submit, v1alpha1.SubmitFrom, v1alpha1.Suspend, v1alpha1.Retry, v1alpha1.Resume, v1alpha1.Resubmit, v1alpha1.Terminate, v1alpha1.Stop:
+	case v1alpha1.Submit, v1alpha1.SubmitFrom:
+		if trigger.LabelSelector != "" {
+			return fmt.Errorf("labelSelector is not applicable to the %s operation", trigger.Operation)
+		}
+	case v1alpha1.Suspend, v1alpha1.Retry, v1alpha1.Resume, v1alpha1.Resubmit, v1alpha1.Terminate, v1alpha1.Stop:
+		if trigger.LabelSelector != "" {
+			if _, err := labels.Parse(trigger.LabelSelector); err != nil {
+				return fmt.Errorf("invalid labelSelector %q, %w", trigger.LabelSelector, err)
+			}
+		}
 	default:
 		return fmt.Errorf("unknown operation type %s", string(trigger.Operation))
 	}
@@ -231,6 +757,38 @@ func validateArgoWorkflowTrigger(trigger *v1alpha1.ArgoWorkflowTrigger) error {
 			}
 		}
 	}
+	if len(trigger.Artifacts) > 0 {
+		if trigger.Operation != v1alpha1.Submit {
+			return fmt.Errorf("artifacts are only applicable to the %s operation", v1alpha1.Submit)
+		}
+		names := map[string]bool{}
+		for i, artifact := range trigger.Artifacts {
+			if err := validateArgoWorkflowArtifact(artifact); err != nil {
+				return fmt.Errorf("artifact index: %d. err: %w", i, err)
+			}
+			if names[artifact.Name] {
+				return fmt.Errorf("artifact %s is specified more than once", artifact.Name)
+			}
+			names[artifact.Name] = true
+		}
+	}
+	return nil
+}
+
+// validateArgoWorkflowArtifact validates an input artifact of an Argo workflow trigger
+func validateArgoWorkflowArtifact(artifact v1alpha1.ArgoWorkflowArtifact) error {
+	if artifact.Name == "" {
+		return fmt.Errorf("artifact name can't be empty")
+	}
+	if (artifact.Raw == nil) == (artifact.S3 == nil) {
+		return fmt.Errorf("either raw or s3 must be specified")
+	}
+	if artifact.Raw != nil && artifact.Raw.DependencyName == "" {
+		return fmt.Errorf("raw artifact dependency name can't be empty")
+	}
+	if artifact.S3 != nil && artifact.S3.DependencyName == "" {
+		return fmt.Errorf("s3 artifact dependency name can't be empty")
+	}
 	return nil
 }
 
@@ -249,6 +807,14 @@ func validateHTTPTrigger(trigger *v1alpha1.HTTPTrigger) error {
 			return fmt.Errorf("only GET, DELETE, PATCH, POST and PUT methods are supported")
 		}
 	}
+	switch trigger.ContentMode {
+	case "", v1alpha1.HTTPContentModeRaw, v1alpha1.HTTPContentModeStructured, v1alpha1.HTTPContentModeBinary, v1alpha1.HTTPContentModeWrapped:
+	default:
+		return fmt.Errorf("invalid content mode %q, it should be either %s, %s, %s or %s", trigger.ContentMode, v1alpha1.HTTPContentModeRaw, v1alpha1.HTTPContentModeStructured, v1alpha1.HTTPContentModeBinary, v1alpha1.HTTPContentModeWrapped)
+	}
+	if trigger.Wrapper != nil && trigger.ContentMode != v1alpha1.HTTPContentModeWrapped {
+		return fmt.Errorf("wrapper is only supported with the %s content mode", v1alpha1.HTTPContentModeWrapped)
+	}
 	if trigger.Parameters != nil {
 		for i, parameter := range trigger.Parameters {
 			if err := validateTriggerParameter(&parameter); err != nil {
@@ -354,6 +920,14 @@ func validateKafkaTrigger(trigger *v1alpha1.KafkaTrigger) error {
 			}
 		}
 	}
+	if sr := trigger.SchemaRegistry; sr != nil {
+		if sr.URL == "" {
+			return fmt.Errorf("schema registry url must not be empty")
+		}
+		if sr.SchemaID == 0 && sr.Subject == "" {
+			return fmt.Errorf("either schema id or subject of the schema registry must be specified")
+		}
+	}
 	return nil
 }
 
@@ -420,6 +994,226 @@ func validateEmailTrigger(trigger *v1alpha1.EmailTrigger) error {
 	return nil
 }
 
+var (
+	// prometheusMetricName is the format of the Prometheus metric names
+	prometheusMetricName = regexp.MustCompile(`^[a-zA-Z_:][a-zA-Z0-9_:]*$`)
+	// prometheusLabelName is the format of the Prometheus label names
+	prometheusLabelName = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+)
+
+// validatePrometheusTrigger validates the Prometheus trigger
+func validatePrometheusTrigger(trigger *v1alpha1.PrometheusTrigger) error {
+	if (trigger.RemoteWrite == nil) == (trigger.Pushgateway == nil) {
+		return fmt.Errorf("exactly one of remoteWrite or pushgateway must be specified")
+	}
+	if rw := trigger.RemoteWrite; rw != nil {
+		if rw.URL == "" {
+			return fmt.Errorf("remoteWrite url can't be empty")
+		}
+		if rw.BasicAuth != nil && rw.BearerToken != nil {
+			return fmt.Errorf("remoteWrite basicAuth and bearerToken can't be both specified")
+		}
+	}
+	if pg := trigger.Pushgateway; pg != nil {
+		if pg.URL == "" {
+			return fmt.Errorf("pushgateway url can't be empty")
+		}
+		if pg.Job == "" {
+			return fmt.Errorf("pushgateway job can't be empty")
+		}
+	}
+	if trigger.Timeout < 0 {
+		return fmt.Errorf("timeout can't be negative")
+	}
+	if len(trigger.Metrics) == 0 {
+		return fmt.Errorf("at least one metric must be specified")
+	}
+	for i, metric := range trigger.Metrics {
+		if !prometheusMetricName.MatchString(metric.Name) {
+			return fmt.Errorf("metric index: %d, invalid metric name %q", i, metric.Name)
+		}
+		for name := range metric.Labels {
+			if name == "__name__" || !prometheusLabelName.MatchString(name) {
+				return fmt.Errorf("metric %s, invalid label name %q", metric.Name, name)
+			}
+		}
+	}
+	for i, parameter := range trigger.Parameters {
+		if err := validateTriggerParameter(&parameter); err != nil {
+			return fmt.Errorf("resource parameter index: %d. err: %w", i, err)
+		}
+	}
+	return nil
+}
+
+// validateLokiTrigger validates the Loki trigger
+func validateLokiTrigger(trigger *v1alpha1.LokiTrigger) error {
+	if trigger.URL == "" {
+		return fmt.Errorf("url can't be empty")
+	}
+	if len(trigger.Labels) == 0 {
+		return fmt.Errorf("at least one stream label must be specified")
+	}
+	for name := range trigger.Labels {
+		if !prometheusLabelName.MatchString(name) {
+			return fmt.Errorf("invalid stream label name %q", name)
+		}
+	}
+	if len(trigger.Payload) == 0 {
+		return fmt.Errorf("payload can't be empty")
+	}
+	if trigger.BasicAuth != nil && trigger.BearerToken != nil {
+		return fmt.Errorf("basicAuth and bearerToken can't be both specified")
+	}
+	if trigger.Timeout < 0 {
+		return fmt.Errorf("timeout can't be negative")
+	}
+	for i, parameter := range trigger.Payload {
+		if err := validateTriggerParameter(&parameter); err != nil {
+			return fmt.Errorf("payload index: %d. err: %w", i, err)
+		}
+	}
+	for i, parameter := range trigger.Parameters {
+		if err := validateTriggerParameter(&parameter); err != nil {
+			return fmt.Errorf("resource parameter index: %d. err: %w", i, err)
+		}
+	}
+	return nil
+}
+
+// validateElasticsearchTrigger validates the Elasticsearch trigger
+func validateElasticsearchTrigger(trigger *v1alpha1.ElasticsearchTrigger) error {
+	if trigger.URL == "" {
+		return fmt.Errorf("url can't be empty")
+	}
+	if trigger.Index == "" {
+		return fmt.Errorf("index can't be empty")
+	}
+	if len(trigger.Payload) == 0 {
+		return fmt.Errorf("payload can't be empty")
+	}
+	if trigger.BasicAuth != nil && trigger.APIKey != nil {
+		return fmt.Errorf("basicAuth and apiKey can't be both specified")
+	}
+	if trigger.Timeout < 0 {
+		return fmt.Errorf("timeout can't be negative")
+	}
+	for i, parameter := range trigger.Payload {
+		if err := validateTriggerParameter(&parameter); err != nil {
+			return fmt.Errorf("payload index: %d. err: %w", i, err)
+		}
+	}
+	for i, parameter := range trigger.Parameters {
+		if err := validateTriggerParameter(&parameter); err != nil {
+			return fmt.Errorf("resource parameter index: %d. err: %w", i, err)
+		}
+	}
+	return nil
+}
+
+// validateJenkinsTrigger validates the Jenkins trigger
+func validateJenkinsTrigger(trigger *v1alpha1.JenkinsTrigger) error {
+	if trigger.URL == "" {
+		return fmt.Errorf("url can't be empty")
+	}
+	if strings.Trim(trigger.Job, "/") == "" {
+		return fmt.Errorf("job can't be empty")
+	}
+	if trigger.Timeout < 0 {
+		return fmt.Errorf("timeout can't be negative")
+	}
+	for i, parameter := range trigger.Parameters {
+		if err := validateTriggerParameter(&parameter); err != nil {
+			return fmt.Errorf("resource parameter index: %d. err: %w", i, err)
+		}
+	}
+	return nil
+}
+
+// validateGithubWorkflowTrigger validates the GitHub workflow trigger
+func validateGithubWorkflowTrigger(trigger *v1alpha1.GithubWorkflowTrigger) error {
+	if trigger.Owner == "" {
+		return fmt.Errorf("owner can't be empty")
+	}
+	if trigger.Repository == "" {
+		return fmt.Errorf("repository can't be empty")
+	}
+	if trigger.Workflow == "" {
+		return fmt.Errorf("workflow can't be empty")
+	}
+	if trigger.Ref == "" {
+		return fmt.Errorf("ref can't be empty")
+	}
+	// GitHub accepts at most 10 inputs
+	if len(trigger.Inputs) > 10 {
+		return fmt.Errorf("inputs can't have more than 10 entries")
+	}
+	switch {
+	case trigger.APIToken != nil && trigger.GithubApp != nil:
+		return fmt.Errorf("only one of apiToken and githubApp can be specified")
+	case trigger.APIToken == nil && trigger.GithubApp == nil:
+		return fmt.Errorf("either apiToken or githubApp must be specified")
+	case trigger.GithubApp != nil && trigger.GithubApp.PrivateKey == nil:
+		return fmt.Errorf("githubApp.privateKey can't be empty")
+	}
+	for i, parameter := range trigger.Parameters {
+		if err := validateTriggerParameter(&parameter); err != nil {
+			return fmt.Errorf("resource parameter index: %d. err: %w", i, err)
+		}
+	}
+	return nil
+}
+
+// validateAzureEventHubsTrigger validates the Azure Event Hubs trigger
+func validateAzureEventHubsTrigger(trigger *v1alpha1.AzureEventHubsTrigger) error {
+	if trigger.FQDN == "" {
+		return fmt.Errorf("fqdn can't be empty")
+	}
+	if trigger.HubName == "" {
+		return fmt.Errorf("hubName can't be empty")
+	}
+	if (trigger.SharedAccessKeyName == nil) != (trigger.SharedAccessKey == nil) {
+		return fmt.Errorf("sharedAccessKeyName and sharedAccessKey must be either both specified, or both omitted to use Azure AD credentials")
+	}
+	return nil
+}
+
+// validateAzureServiceBusTrigger validates the Azure Service Bus trigger
+func validateAzureServiceBusTrigger(trigger *v1alpha1.AzureServiceBusTrigger) error {
+	if trigger.ConnectionString == nil && trigger.FullyQualifiedNamespace == "" {
+		return fmt.Errorf("either connectionString or fullyQualifiedNamespace must be specified")
+	}
+	if trigger.QueueName == "" && trigger.TopicName == "" {
+		return fmt.Errorf("either queueName or topicName must be specified")
+	}
+	return nil
+}
+
+// validateAzureQueueStorageTrigger validates the Azure Queue Storage trigger
+func validateAzureQueueStorageTrigger(trigger *v1alpha1.AzureQueueStorageTrigger) error {
+	if trigger.ConnectionString == nil && trigger.StorageAccountName == "" {
+		return fmt.Errorf("either connectionString or storageAccountName must be specified")
+	}
+	if trigger.QueueName == "" {
+		return fmt.Errorf("queueName must be specified")
+	}
+	if len(trigger.Payload) == 0 {
+		return fmt.Errorf("payload parameters are not specified")
+	}
+	if ttl := trigger.TimeToLiveInSeconds; ttl != nil && (*ttl == 0 || *ttl < -1) {
+		return fmt.Errorf("timeToLiveInSeconds must be positive, or -1 to never expire the messages")
+	}
+	if vt := trigger.VisibilityTimeoutInSeconds; vt != nil {
+		if *vt < 0 || *vt > 604800 {
+			return fmt.Errorf("visibilityTimeoutInSeconds must be between 0 and 604800")
+		}
+		if ttl := trigger.TimeToLiveInSeconds; ttl != nil && *ttl != -1 && *vt >= *ttl {
+			return fmt.Errorf("visibilityTimeoutInSeconds must be smaller than timeToLiveInSeconds")
+		}
+	}
+	return validateMessageTriggerParameters(trigger.Parameters, trigger.Payload)
+}
+
 // validateCustomTrigger validates the custom trigger.
 func validateCustomTrigger(trigger *v1alpha1.CustomTrigger) error {
 	if trigger == nil {
@@ -466,7 +1260,7 @@ func validateTriggerParameter(parameter *v1alpha1.TriggerParameter) error {
 	if parameter.Src.DependencyName == "" {
 		return fmt.Errorf("parameter dependency name can't be empty")
 	}
-	if parameter.Dest == "" {
+	if parameter.Dest == "" && !parameter.Operation.IsPatch() {
 		return fmt.Errorf("parameter destination can't be empty")
 	}
 
@@ -475,6 +1269,12 @@ func validateTriggerParameter(parameter *v1alpha1.TriggerParameter) error {
 	case v1alpha1.TriggerParameterOpOverwrite:
 	case v1alpha1.TriggerParameterOpPrepend:
 	case v1alpha1.TriggerParameterOpNone:
+	case v1alpha1.TriggerParameterOpJSONPatch, v1alpha1.TriggerParameterOpMergePatch:
+		if src := parameter.Src; src.Value != nil && src.DataKey == "" && src.DataTemplate == "" && src.ContextKey == "" && src.ContextTemplate == "" {
+			if err := validatePatch(op, *src.Value); err != nil {
+				return err
+			}
+		}
 	default:
 		return fmt.Errorf("parameter operation %+v is invalid", op)
 	}
@@ -482,17 +1282,40 @@ func validateTriggerParameter(parameter *v1alpha1.TriggerParameter) error {
 	return nil
 }
 
-// perform a check to see that each event dependency is in correct format and has valid filters set if any
-func validateDependencies(eventDependencies []v1alpha1.EventDependency, b *eventbusv1alpha1.EventBus) error {
+// validatePatch validates a static patch document of a parameter
+func validatePatch(op v1alpha1.TriggerParameterOperation, patch string) error {
+	if op == v1alpha1.TriggerParameterOpJSONPatch {
+		if _, err := jsonpatch.DecodePatch([]byte(patch)); err != nil {
+			return fmt.Errorf("parameter value is not a valid JSON patch, %w", err)
+		}
+		return nil
+	}
+	if !json.Valid([]byte(patch)) {
+		return fmt.Errorf("parameter value is not a valid JSON merge patch")
+	}
+	return nil
+}
+
+// perform a check to see that each event dependency is in correct format and has valid filters set if any, the
+// settings depending on the EventBus are checked against the EventBus the dependency consumes from
+func validateDependencies(eventDependencies []v1alpha1.EventDependency, b *eventbusv1alpha1.EventBus, additionalEventBuses map[string]*eventbusv1alpha1.EventBus) error {
 	if len(eventDependencies) < 1 {
 		return fmt.Errorf("no event dependencies found")
 	}
 
 	comboKeys := make(map[string]bool)
+	hasEventDependency := false
 	for _, dep := range eventDependencies {
 		if dep.Name == "" {
 			return fmt.Errorf("event dependency must define a name")
 		}
+		if dep.IsMetric() {
+			if err := validateMetricDependency(dep); err != nil {
+				return fmt.Errorf("dependency %s: %w", dep.Name, err)
+			}
+			continue
+		}
+		hasEventDependency = true
 		if dep.EventSourceName == "" {
 			return fmt.Errorf("event dependency must define the EventSourceName")
 		}
@@ -500,9 +1323,10 @@ func validateDependencies(eventDependencies []v1alpha1.EventDependency, b *event
 		if dep.EventName == "" {
 			return fmt.Errorf("event dependency must define the EventName")
 		}
-		if b.Spec.NATS != nil {
+		depBus := dependencyEventBus(dep, b, additionalEventBuses)
+		if depBus != nil && depBus.Spec.NATS != nil {
 			// For STAN, EventSourceName + EventName can not be referenced more than once in one Sensor object.
-			comboKey := fmt.Sprintf("%s-$$$-%s", dep.EventSourceName, dep.EventName)
+			comboKey := fmt.Sprintf("%s-$$$-%s-$$$-%s", depBus.Name, dep.EventSourceName, dep.EventName)
 			if _, existing := comboKeys[comboKey]; existing {
 				return fmt.Errorf("event '%s' from EventSource '%s' is referenced for more than one dependency in this Sensor object", dep.EventName, dep.EventSourceName)
 			}
@@ -516,6 +1340,147 @@ func validateDependencies(eventDependencies []v1alpha1.EventDependency, b *event
 		if err := validateLogicalOperator(dep.FiltersLogicalOperator); err != nil {
 			return err
 		}
+
+		if dep.StartPosition != nil {
+			if depBus != nil && depBus.Spec.JetStream == nil && depBus.Spec.JetStreamExotic == nil && depBus.Status.Config.JetStream == nil {
+				return fmt.Errorf("dependency %s: startPosition is only supported with JetStream EventBus", dep.Name)
+			}
+			if err := validateStartPosition(dep.StartPosition); err != nil {
+				return fmt.Errorf("dependency %s: %w", dep.Name, err)
+			}
+		}
+
+		if dep.Guards != nil {
+			if err := validatePayloadGuards(dep.Guards); err != nil {
+				return fmt.Errorf("dependency %s: %w", dep.Name, err)
+			}
+		}
+	}
+	if !hasEventDependency {
+		return fmt.Errorf("no event dependencies found, the metric dependencies are only evaluated with event dependencies")
+	}
+	return nil
+}
+
+// dependencyEventBus returns the EventBus a dependency consumes from, nil if it is an additional EventBus which is
+// not known, whose settings are then checked once it is.
+func dependencyEventBus(dep v1alpha1.EventDependency, b *eventbusv1alpha1.EventBus, additionalEventBuses map[string]*eventbusv1alpha1.EventBus) *eventbusv1alpha1.EventBus {
+	if dep.EventBusName == "" {
+		return b
+	}
+	if additional, ok := additionalEventBuses[dep.EventBusName]; ok {
+		return additional
+	}
+	if dep.EventBusName == b.Name {
+		return b
+	}
+	return nil
+}
+
+// validateMetricDependency validates a dependency resolved by a PromQL query
+func validateMetricDependency(dep v1alpha1.EventDependency) error {
+	if dep.EventSourceName != "" || dep.EventName != "" || dep.EventBusName != "" || dep.Filters != nil || dep.Transform != nil || dep.StartPosition != nil || dep.Guards != nil {
+		return fmt.Errorf("a metric dependency can't define eventSourceName, eventName, eventBusName, filters, transform, startPosition or guards")
+	}
+	p := dep.Prometheus
+	if p.URL == "" {
+		return fmt.Errorf("prometheus url can't be empty")
+	}
+	if _, err := url.Parse(p.URL); err != nil {
+		return fmt.Errorf("invalid prometheus url %q, %w", p.URL, err)
+	}
+	if p.Query == "" {
+		return fmt.Errorf("prometheus query can't be empty")
+	}
+	if p.Interval != "" && p.GetInterval() == 0 {
+		return fmt.Errorf("invalid prometheus interval %q, it should be a positive duration, e.g. 30s", p.Interval)
+	}
+	if p.Timeout < 0 {
+		return fmt.Errorf("prometheus timeout can't be negative")
+	}
+	return nil
+}
+
+// validateMetricDependencyConditions validates that the trigger conditions combine the metric dependencies with the
+// event dependencies with "&&", and that they require an event dependency
+func validateMetricDependencyConditions(s *v1alpha1.Sensor) error {
+	metricDeps := make(map[string]bool)
+	for _, dep := range s.Spec.Dependencies {
+		if dep.IsMetric() {
+			metricDeps[dep.Name] = true
+		}
+	}
+	if len(metricDeps) == 0 {
+		return nil
+	}
+	for _, trigger := range s.Spec.Triggers {
+		if trigger.Template == nil || trigger.Template.Conditions == "" {
+			continue
+		}
+		expr, err := govaluate.NewEvaluableExpression(strings.ReplaceAll(trigger.Template.Conditions, "-", "\\-"))
+		if err != nil {
+			return fmt.Errorf("trigger %s: failed to parse the conditions, %w", trigger.Template.Name, err)
+		}
+		depth := 0
+		hasMetricDependency, hasEventDependency, hasTopLevelOr := false, false, false
+		for _, token := range expr.Tokens() {
+			switch token.Kind {
+			case govaluate.CLAUSE:
+				depth++
+			case govaluate.CLAUSE_CLOSE:
+				depth--
+			case govaluate.LOGICALOP:
+				if token.Value == "||" && depth == 0 {
+					hasTopLevelOr = true
+				}
+			case govaluate.VARIABLE:
+				name, _ := token.Value.(string)
+				if !metricDeps[name] {
+					hasEventDependency = true
+					continue
+				}
+				if depth > 0 {
+					return fmt.Errorf("trigger %s: the metric dependency %s can't be in parentheses in the conditions, it's combined with \"&&\"", trigger.Template.Name, name)
+				}
+				hasMetricDependency = true
+			}
+		}
+		if hasMetricDependency && hasTopLevelOr {
+			return fmt.Errorf("trigger %s: the metric dependencies can only be combined with \"&&\" in the conditions", trigger.Template.Name)
+		}
+		if !hasEventDependency {
+			return fmt.Errorf("trigger %s: the conditions require at least one event dependency", trigger.Template.Name)
+		}
+	}
+	return nil
+}
+
+// validatePayloadGuards validates the payload guards of a dependency
+func validatePayloadGuards(g *v1alpha1.PayloadGuards) error {
+	if g.MaxBytes < 0 || g.MaxDepth < 0 || g.MaxArrayLength < 0 {
+		return fmt.Errorf("guards maxBytes, maxDepth and maxArrayLength can not be negative")
+	}
+	for _, ct := range g.ContentTypes {
+		if _, _, err := mime.ParseMediaType(ct); err != nil {
+			return fmt.Errorf("invalid guards content type %q, %w", ct, err)
+		}
+	}
+	return nil
+}
+
+// validateStartPosition validates the start position of a dependency
+func validateStartPosition(p *v1alpha1.DependencyStartPosition) error {
+	switch p.GetDeliverPolicy() {
+	case v1alpha1.DeliverPolicyLatest, v1alpha1.DeliverPolicyEarliest:
+		if p.Time != nil {
+			return fmt.Errorf("startPosition time is only supported with the %q deliver policy", v1alpha1.DeliverPolicyTime)
+		}
+	case v1alpha1.DeliverPolicyTime:
+		if p.Time == nil || p.Time.IsZero() {
+			return fmt.Errorf("startPosition time is required with the %q deliver policy", v1alpha1.DeliverPolicyTime)
+		}
+	default:
+		return fmt.Errorf("invalid startPosition deliverPolicy %q, it should be one of %q, %q and %q", p.DeliverPolicy, v1alpha1.DeliverPolicyLatest, v1alpha1.DeliverPolicyEarliest, v1alpha1.DeliverPolicyTime)
 	}
 	return nil
 }
@@ -659,6 +1624,75 @@ func validateEventTimeFilter(timeFilter *v1alpha1.TimeFilter) error {
 	return nil
 }
 
+// validateAWSSQSTrigger validates the AWS SQS trigger
+func validateAWSSQSTrigger(trigger *v1alpha1.AWSSQSTrigger) error {
+	if trigger.Queue == "" {
+		return fmt.Errorf("queue is not specified")
+	}
+	if trigger.Region == "" {
+		return fmt.Errorf("region is not specified")
+	}
+	if len(trigger.Payload) == 0 {
+		return fmt.Errorf("payload parameters are not specified")
+	}
+	if trigger.DelaySeconds < 0 || trigger.DelaySeconds > 900 {
+		return fmt.Errorf("delaySeconds must be between 0 and 900")
+	}
+	if trigger.DelaySeconds > 0 && trigger.MessageGroupID != "" {
+		return fmt.Errorf("delaySeconds can't be used with messageGroupId, FIFO queues don't support per-message delays")
+	}
+	if trigger.MessageDeduplicationID != "" && trigger.MessageGroupID == "" {
+		return fmt.Errorf("messageDeduplicationId requires messageGroupId")
+	}
+	if err := validateAWSMessageAttributes(trigger.MessageAttributes); err != nil {
+		return err
+	}
+	return validateMessageTriggerParameters(trigger.Parameters, trigger.Payload)
+}
+
+// validateAWSSNSTrigger validates the AWS SNS trigger
+func validateAWSSNSTrigger(trigger *v1alpha1.AWSSNSTrigger) error {
+	if trigger.TopicArn == "" {
+		return fmt.Errorf("topicArn is not specified")
+	}
+	if trigger.Region == "" {
+		return fmt.Errorf("region is not specified")
+	}
+	if len(trigger.Payload) == 0 {
+		return fmt.Errorf("payload parameters are not specified")
+	}
+	if trigger.MessageDeduplicationID != "" && trigger.MessageGroupID == "" {
+		return fmt.Errorf("messageDeduplicationId requires messageGroupId")
+	}
+	if err := validateAWSMessageAttributes(trigger.MessageAttributes); err != nil {
+		return err
+	}
+	return validateMessageTriggerParameters(trigger.Parameters, trigger.Payload)
+}
+
+// validateAWSMessageAttributes validates the message attributes of the AWS messaging triggers, one attribute is
+// reserved for the idempotency key out of the 10 supported by SQS and SNS.
+func validateAWSMessageAttributes(attributes map[string]string) error {
+	if len(attributes) > 9 {
+		return fmt.Errorf("at most 9 messageAttributes are supported")
+	}
+	return nil
+}
+
+func validateMessageTriggerParameters(parameters, payload []v1alpha1.TriggerParameter) error {
+	for i, parameter := range parameters {
+		if err := validateTriggerParameter(&parameter); err != nil {
+			return fmt.Errorf("resource parameter index: %d. err: %w", i, err)
+		}
+	}
+	for i, p := range payload {
+		if err := validateTriggerParameter(&p); err != nil {
+			return fmt.Errorf("payload index: %d. err: %w", i, err)
+		}
+	}
+	return nil
+}
+
 // validateTriggerPolicy validates a trigger policy
 func validateTriggerPolicy(trigger *v1alpha1.Trigger) error {
 	if trigger.Policy == nil {
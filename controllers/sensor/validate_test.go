@@ -22,11 +22,15 @@ import (
 	"strings"
 	"testing"
 
+	apicommon "github.com/argoproj/argo-events/pkg/apis/common"
 	eventbusv1alpha1 "github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1"
 	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
 	"github.com/ghodss/yaml"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 )
 
 func TestValidateSensor(t *testing.T) {
@@ -55,6 +59,549 @@ func TestValidateSensor(t *testing.T) {
 	}
 }
 
+func TestValidateDrainTimeout(t *testing.T) {
+	jetstreamBus := &eventbusv1alpha1.EventBus{Spec: eventbusv1alpha1.EventBusSpec{JetStream: &eventbusv1alpha1.JetStreamBus{}}}
+
+	t.Run("test valid drainTimeout", func(t *testing.T) {
+		sObj := sensorObj.DeepCopy()
+		sObj.Spec.DrainTimeout = "30s"
+		err := ValidateSensor(sObj, jetstreamBus)
+		assert.NoError(t, err)
+	})
+
+	t.Run("test invalid drainTimeout", func(t *testing.T) {
+		sObj := sensorObj.DeepCopy()
+		sObj.Spec.DrainTimeout = "30"
+		err := ValidateSensor(sObj, jetstreamBus)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid drainTimeout")
+	})
+}
+
+func TestValidateOrdering(t *testing.T) {
+	jetstreamBus := &eventbusv1alpha1.EventBus{Spec: eventbusv1alpha1.EventBusSpec{JetStream: &eventbusv1alpha1.JetStreamBus{}}}
+	stanBus := &eventbusv1alpha1.EventBus{Spec: eventbusv1alpha1.EventBusSpec{NATS: &eventbusv1alpha1.NATSBus{}}}
+
+	t.Run("test ordering not required", func(t *testing.T) {
+		sObj := sensorObj.DeepCopy()
+		assert.NoError(t, ValidateSensor(sObj, stanBus))
+	})
+
+	t.Run("test ordering required", func(t *testing.T) {
+		sObj := sensorObj.DeepCopy()
+		sObj.Spec.RequiresOrdering = true
+		assert.NoError(t, ValidateSensor(sObj, jetstreamBus))
+		err := ValidateSensor(sObj, stanBus)
+		assert.ErrorContains(t, err, "the sensor requires ordering")
+		assert.False(t, sObj.Status.IsReady())
+	})
+
+	t.Run("test ordering required with shared distribution", func(t *testing.T) {
+		sObj := sensorObj.DeepCopy()
+		sObj.Spec.RequiresOrdering = true
+		sObj.Spec.Distribution = &v1alpha1.SensorDistribution{Mode: v1alpha1.SensorDistributionShared}
+		// The events of a dependency are consumed by the replica of its partition
+		assert.NoError(t, ValidateSensor(sObj, jetstreamBus))
+		kafkaBus := &eventbusv1alpha1.EventBus{Spec: eventbusv1alpha1.EventBusSpec{Kafka: &eventbusv1alpha1.KafkaBus{}}}
+		assert.NoError(t, ValidateSensor(sObj, kafkaBus))
+	})
+}
+
+func TestValidateTriggerStatus(t *testing.T) {
+	jetstreamBus := &eventbusv1alpha1.EventBus{Spec: eventbusv1alpha1.EventBusSpec{JetStream: &eventbusv1alpha1.JetStreamBus{}}}
+
+	t.Run("test valid trigger status", func(t *testing.T) {
+		sObj := sensorObj.DeepCopy()
+		sObj.Spec.TriggerStatus = &v1alpha1.TriggerStatusReporting{Verbosity: v1alpha1.TriggerStatusDetailed, MaxFailingTriggers: 5, ExecutionTTL: "6h"}
+		assert.NoError(t, ValidateSensor(sObj, jetstreamBus))
+	})
+
+	t.Run("test invalid verbosity", func(t *testing.T) {
+		sObj := sensorObj.DeepCopy()
+		sObj.Spec.TriggerStatus = &v1alpha1.TriggerStatusReporting{Verbosity: "Verbose"}
+		assert.ErrorContains(t, ValidateSensor(sObj, jetstreamBus), "invalid triggerStatus verbosity")
+	})
+
+	t.Run("test negative max failing triggers", func(t *testing.T) {
+		sObj := sensorObj.DeepCopy()
+		sObj.Spec.TriggerStatus = &v1alpha1.TriggerStatusReporting{MaxFailingTriggers: -1}
+		assert.ErrorContains(t, ValidateSensor(sObj, jetstreamBus), "can't be negative")
+	})
+
+	t.Run("test invalid execution ttl", func(t *testing.T) {
+		sObj := sensorObj.DeepCopy()
+		sObj.Spec.TriggerStatus = &v1alpha1.TriggerStatusReporting{ExecutionTTL: "0s"}
+		assert.ErrorContains(t, ValidateSensor(sObj, jetstreamBus), "invalid triggerStatus executionTTL")
+	})
+}
+
+func TestValidateTracingMetadata(t *testing.T) {
+	jetstreamBus := &eventbusv1alpha1.EventBus{Spec: eventbusv1alpha1.EventBusSpec{JetStream: &eventbusv1alpha1.JetStreamBus{}}}
+
+	t.Run("test valid tracing metadata", func(t *testing.T) {
+		sObj := sensorObj.DeepCopy()
+		sObj.Spec.TracingMetadata = &v1alpha1.TracingMetadata{
+			Labels:      map[string]string{"example.com/order": "{{ .Input.order.data.id }}"},
+			Annotations: map[string]string{"example.com/trace": "{{ .TraceID }}"},
+		}
+		assert.NoError(t, ValidateSensor(sObj, jetstreamBus))
+	})
+
+	t.Run("test invalid label name", func(t *testing.T) {
+		sObj := sensorObj.DeepCopy()
+		sObj.Spec.TracingMetadata = &v1alpha1.TracingMetadata{Labels: map[string]string{"order id": "{{ .EventIDs }}"}}
+		assert.ErrorContains(t, ValidateSensor(sObj, jetstreamBus), "invalid tracing label name")
+	})
+
+	t.Run("test invalid template", func(t *testing.T) {
+		sObj := sensorObj.DeepCopy()
+		sObj.Spec.TracingMetadata = &v1alpha1.TracingMetadata{Annotations: map[string]string{"example.com/trace": "{{ .TraceID"}}
+		assert.ErrorContains(t, ValidateSensor(sObj, jetstreamBus), "invalid template of the tracing annotation")
+	})
+}
+
+func TestValidateEventCapture(t *testing.T) {
+	jetstreamBus := &eventbusv1alpha1.EventBus{Spec: eventbusv1alpha1.EventBusSpec{JetStream: &eventbusv1alpha1.JetStreamBus{}}}
+	s3 := &apicommon.S3Artifact{
+		Endpoint:  "s3.amazonaws.com",
+		Bucket:    &apicommon.S3Bucket{Name: "captures", Key: "sensors"},
+		AccessKey: &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "s3"}, Key: "accesskey"},
+		SecretKey: &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "s3"}, Key: "secretkey"},
+	}
+
+	t.Run("test valid capture", func(t *testing.T) {
+		sObj := sensorObj.DeepCopy()
+		sObj.Spec.Capture = &v1alpha1.EventCapture{S3: s3, FlushInterval: "30s", Retention: "72h"}
+		assert.NoError(t, ValidateSensor(sObj, jetstreamBus))
+	})
+
+	t.Run("test no bucket", func(t *testing.T) {
+		sObj := sensorObj.DeepCopy()
+		sObj.Spec.Capture = &v1alpha1.EventCapture{S3: &apicommon.S3Artifact{Endpoint: "s3.amazonaws.com"}}
+		assert.ErrorContains(t, ValidateSensor(sObj, jetstreamBus), "capture s3 bucket is required")
+	})
+
+	t.Run("test invalid flush interval", func(t *testing.T) {
+		sObj := sensorObj.DeepCopy()
+		sObj.Spec.Capture = &v1alpha1.EventCapture{S3: s3, FlushInterval: "often"}
+		assert.ErrorContains(t, ValidateSensor(sObj, jetstreamBus), "invalid capture flushInterval")
+	})
+
+	t.Run("test retention shorter than the flush interval", func(t *testing.T) {
+		sObj := sensorObj.DeepCopy()
+		sObj.Spec.Capture = &v1alpha1.EventCapture{S3: s3, FlushInterval: "10m", Retention: "5m"}
+		assert.ErrorContains(t, ValidateSensor(sObj, jetstreamBus), "invalid capture retention")
+	})
+}
+
+func TestValidateTriggerPools(t *testing.T) {
+	jetstreamBus := &eventbusv1alpha1.EventBus{Spec: eventbusv1alpha1.EventBusSpec{JetStream: &eventbusv1alpha1.JetStreamBus{}}}
+
+	t.Run("test valid pools", func(t *testing.T) {
+		sObj := sensorObj.DeepCopy()
+		sObj.Spec.TriggerPools = &v1alpha1.TriggerPools{Workers: 2, QueueSize: 10, MaxConcurrency: 4}
+		sObj.Spec.Triggers[0].Pool = &v1alpha1.TriggerPool{Workers: 1}
+		assert.NoError(t, ValidateSensor(sObj, jetstreamBus))
+	})
+
+	t.Run("test negative pool sizes", func(t *testing.T) {
+		sObj := sensorObj.DeepCopy()
+		sObj.Spec.TriggerPools = &v1alpha1.TriggerPools{MaxConcurrency: -1}
+		assert.ErrorContains(t, ValidateSensor(sObj, jetstreamBus), "can't be negative")
+
+		sObj = sensorObj.DeepCopy()
+		sObj.Spec.Triggers[0].Pool = &v1alpha1.TriggerPool{QueueSize: -1}
+		assert.ErrorContains(t, ValidateSensor(sObj, jetstreamBus), "pool workers and queueSize can't be negative")
+	})
+}
+
+func TestValidateQuota(t *testing.T) {
+	jetstreamBus := &eventbusv1alpha1.EventBus{Spec: eventbusv1alpha1.EventBusSpec{JetStream: &eventbusv1alpha1.JetStreamBus{}}}
+
+	t.Run("test valid quota", func(t *testing.T) {
+		sObj := sensorObj.DeepCopy()
+		sObj.Spec.Quota = &v1alpha1.SensorExecutionQuota{MaxTriggersPerHour: 100, MaxTriggersPerDay: 1000, Overflow: v1alpha1.QuotaOverflowQueue}
+		assert.NoError(t, ValidateSensor(sObj, jetstreamBus))
+	})
+
+	t.Run("test quota without limits", func(t *testing.T) {
+		sObj := sensorObj.DeepCopy()
+		sObj.Spec.Quota = &v1alpha1.SensorExecutionQuota{}
+		assert.ErrorContains(t, ValidateSensor(sObj, jetstreamBus), "requires either maxTriggersPerHour or maxTriggersPerDay")
+	})
+
+	t.Run("test negative limit", func(t *testing.T) {
+		sObj := sensorObj.DeepCopy()
+		sObj.Spec.Quota = &v1alpha1.SensorExecutionQuota{MaxTriggersPerHour: -1}
+		assert.ErrorContains(t, ValidateSensor(sObj, jetstreamBus), "can't be negative")
+	})
+
+	t.Run("test hourly limit greater than daily limit", func(t *testing.T) {
+		sObj := sensorObj.DeepCopy()
+		sObj.Spec.Quota = &v1alpha1.SensorExecutionQuota{MaxTriggersPerHour: 100, MaxTriggersPerDay: 10}
+		assert.ErrorContains(t, ValidateSensor(sObj, jetstreamBus), "can't be greater than maxTriggersPerDay")
+	})
+
+	t.Run("test invalid overflow", func(t *testing.T) {
+		sObj := sensorObj.DeepCopy()
+		sObj.Spec.Quota = &v1alpha1.SensorExecutionQuota{MaxTriggersPerDay: 10, Overflow: "Block"}
+		assert.ErrorContains(t, ValidateSensor(sObj, jetstreamBus), "invalid quota overflow")
+	})
+}
+
+func TestValidateDataSchemaValidation(t *testing.T) {
+	jetstreamBus := &eventbusv1alpha1.EventBus{Spec: eventbusv1alpha1.EventBusSpec{JetStream: &eventbusv1alpha1.JetStreamBus{}}}
+
+	t.Run("test valid data schema validation", func(t *testing.T) {
+		sObj := sensorObj.DeepCopy()
+		sObj.Spec.DataSchemaValidation = &v1alpha1.DataSchemaValidation{AllowedURLs: []string{"https://schemas.example.com/"}, CacheTTL: "10m"}
+		err := ValidateSensor(sObj, jetstreamBus)
+		assert.NoError(t, err)
+	})
+
+	t.Run("test without allowed urls", func(t *testing.T) {
+		sObj := sensorObj.DeepCopy()
+		sObj.Spec.DataSchemaValidation = &v1alpha1.DataSchemaValidation{}
+		err := ValidateSensor(sObj, jetstreamBus)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "allowedURLs are required")
+	})
+
+	t.Run("test invalid allowed url", func(t *testing.T) {
+		sObj := sensorObj.DeepCopy()
+		sObj.Spec.DataSchemaValidation = &v1alpha1.DataSchemaValidation{AllowedURLs: []string{"file:///etc/"}}
+		err := ValidateSensor(sObj, jetstreamBus)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid allowed url")
+	})
+}
+
+func TestValidateTriggerRetryAndCircuitBreaker(t *testing.T) {
+	capDuration := apicommon.FromString("30s")
+	assert.NoError(t, validateTriggerRetryStrategy(&apicommon.Backoff{Steps: 5, Cap: &capDuration}))
+	invalidCap := apicommon.FromString("30")
+	assert.Error(t, validateTriggerRetryStrategy(&apicommon.Backoff{Steps: 5, Cap: &invalidCap}))
+	invalidFactor := apicommon.NewAmount("abc")
+	assert.Error(t, validateTriggerRetryStrategy(&apicommon.Backoff{Factor: &invalidFactor}))
+
+	assert.NoError(t, validateTriggerCircuitBreaker(nil))
+	assert.NoError(t, validateTriggerCircuitBreaker(&v1alpha1.TriggerCircuitBreaker{ConsecutiveFailures: 3, OpenDuration: "30s"}))
+	assert.Error(t, validateTriggerCircuitBreaker(&v1alpha1.TriggerCircuitBreaker{}))
+	assert.Error(t, validateTriggerCircuitBreaker(&v1alpha1.TriggerCircuitBreaker{ConsecutiveFailures: 3, OpenDuration: "-1s"}))
+}
+
+func TestValidateDependencyStartPosition(t *testing.T) {
+	jetstreamBus := &eventbusv1alpha1.EventBus{Spec: eventbusv1alpha1.EventBusSpec{JetStream: &eventbusv1alpha1.JetStreamBus{}}}
+	kafkaBus := &eventbusv1alpha1.EventBus{Spec: eventbusv1alpha1.EventBusSpec{Kafka: &eventbusv1alpha1.KafkaBus{}}}
+	startTime := metav1.Now()
+
+	t.Run("test valid start positions", func(t *testing.T) {
+		for _, p := range []*v1alpha1.DependencyStartPosition{
+			{},
+			{DeliverPolicy: v1alpha1.DeliverPolicyEarliest},
+			{DeliverPolicy: v1alpha1.DeliverPolicyTime, Time: &startTime},
+		} {
+			sObj := sensorObj.DeepCopy()
+			sObj.Spec.Dependencies[0].StartPosition = p
+			err := ValidateSensor(sObj, jetstreamBus)
+			assert.NoError(t, err)
+		}
+	})
+
+	t.Run("test invalid start positions", func(t *testing.T) {
+		for _, p := range []*v1alpha1.DependencyStartPosition{
+			{DeliverPolicy: "Oldest"},
+			{DeliverPolicy: v1alpha1.DeliverPolicyTime},
+			{DeliverPolicy: v1alpha1.DeliverPolicyLatest, Time: &startTime},
+		} {
+			sObj := sensorObj.DeepCopy()
+			sObj.Spec.Dependencies[0].StartPosition = p
+			err := ValidateSensor(sObj, jetstreamBus)
+			assert.Error(t, err)
+		}
+	})
+
+	t.Run("test start position with kafka eventbus", func(t *testing.T) {
+		sObj := sensorObj.DeepCopy()
+		sObj.Spec.Dependencies[0].StartPosition = &v1alpha1.DependencyStartPosition{DeliverPolicy: v1alpha1.DeliverPolicyEarliest}
+		err := ValidateSensor(sObj, kafkaBus)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "only supported with JetStream EventBus")
+	})
+
+	t.Run("test start position on the eventbus of the dependency", func(t *testing.T) {
+		sObj := sensorObj.DeepCopy()
+		sObj.Spec.Dependencies[0].EventBusName = "js"
+		sObj.Spec.Dependencies[0].StartPosition = &v1alpha1.DependencyStartPosition{DeliverPolicy: v1alpha1.DeliverPolicyEarliest}
+		assert.NoError(t, ValidateSensorWithEventBuses(sObj, kafkaBus, map[string]*eventbusv1alpha1.EventBus{"js": jetstreamBus}))
+		err := ValidateSensorWithEventBuses(sObj.DeepCopy(), jetstreamBus, map[string]*eventbusv1alpha1.EventBus{"js": kafkaBus})
+		assert.ErrorContains(t, err, "only supported with JetStream EventBus")
+	})
+}
+
+func TestValidatePayloadGuards(t *testing.T) {
+	assert.NoError(t, validatePayloadGuards(&v1alpha1.PayloadGuards{MaxBytes: 1024, MaxDepth: 5, MaxArrayLength: 100, ContentTypes: []string{"application/json"}}))
+	assert.Error(t, validatePayloadGuards(&v1alpha1.PayloadGuards{MaxDepth: -1}))
+	assert.Error(t, validatePayloadGuards(&v1alpha1.PayloadGuards{ContentTypes: []string{"application/"}}))
+}
+
+func TestValidateMetricDependencies(t *testing.T) {
+	metricDep := v1alpha1.EventDependency{
+		Name:       "queue-high",
+		Prometheus: &v1alpha1.PrometheusDependency{URL: "http://prometheus:9090", Query: "sum(queue_depth) > 100", Interval: "30s"},
+	}
+	newSensor := func(conditions string) *v1alpha1.Sensor {
+		sObj := sensorObj.DeepCopy()
+		sObj.Spec.Dependencies = append(sObj.Spec.Dependencies, metricDep)
+		sObj.Spec.Triggers[0].Template.Conditions = conditions
+		return sObj
+	}
+
+	t.Run("test valid metric dependencies", func(t *testing.T) {
+		assert.NoError(t, ValidateSensor(newSensor(""), fakeEventBus))
+		assert.NoError(t, ValidateSensor(newSensor("fake-dep && queue-high"), fakeEventBus))
+		assert.NoError(t, ValidateSensor(newSensor("fake-dep"), fakeEventBus))
+	})
+
+	t.Run("test invalid metric dependency", func(t *testing.T) {
+		dep := *metricDep.DeepCopy()
+		dep.EventSourceName = "fake-source"
+		assert.ErrorContains(t, validateMetricDependency(dep), "can't define eventSourceName")
+		dep = *metricDep.DeepCopy()
+		dep.Prometheus.Query = ""
+		assert.ErrorContains(t, validateMetricDependency(dep), "query can't be empty")
+		dep = *metricDep.DeepCopy()
+		dep.Prometheus.Interval = "-1m"
+		assert.ErrorContains(t, validateMetricDependency(dep), "invalid prometheus interval")
+		assert.ErrorContains(t, validateDependencies([]v1alpha1.EventDependency{metricDep}, fakeEventBus, nil), "no event dependencies found")
+	})
+
+	t.Run("test invalid metric dependency conditions", func(t *testing.T) {
+		assert.ErrorContains(t, ValidateSensor(newSensor("fake-dep || queue-high"), fakeEventBus), "only be combined with \"&&\"")
+		assert.ErrorContains(t, ValidateSensor(newSensor("fake-dep && (queue-high)"), fakeEventBus), "can't be in parentheses")
+		assert.ErrorContains(t, ValidateSensor(newSensor("queue-high"), fakeEventBus), "require at least one event dependency")
+	})
+}
+
+func TestValidateTriggerDeduplication(t *testing.T) {
+	jetstreamBus := &eventbusv1alpha1.EventBus{Spec: eventbusv1alpha1.EventBusSpec{JetStream: &eventbusv1alpha1.JetStreamBus{}}}
+	stanBus := &eventbusv1alpha1.EventBus{Spec: eventbusv1alpha1.EventBusSpec{NATS: &eventbusv1alpha1.NATSBus{}}}
+
+	t.Run("test valid deduplication", func(t *testing.T) {
+		sObj := sensorObj.DeepCopy()
+		sObj.Spec.Triggers[0].Deduplication = &v1alpha1.TriggerDeduplication{KeyTemplate: "{{ .Input.dep.data.id }}", Window: "10m"}
+		err := ValidateSensor(sObj, jetstreamBus)
+		assert.NoError(t, err)
+	})
+
+	t.Run("test invalid deduplication", func(t *testing.T) {
+		sObj := sensorObj.DeepCopy()
+		sObj.Spec.Triggers[0].Deduplication = &v1alpha1.TriggerDeduplication{KeyTemplate: "{{ .Input.dep.data.id "}
+		err := ValidateSensor(sObj, jetstreamBus)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid deduplication keyTemplate")
+
+		sObj.Spec.Triggers[0].Deduplication = &v1alpha1.TriggerDeduplication{Window: "-1m"}
+		err = ValidateSensor(sObj, jetstreamBus)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid deduplication window")
+	})
+
+	t.Run("test deduplication not supported", func(t *testing.T) {
+		sObj := sensorObj.DeepCopy()
+		sObj.Spec.Triggers[0].Deduplication = &v1alpha1.TriggerDeduplication{}
+		err := ValidateSensor(sObj, stanBus)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "only supported with JetStream EventBus")
+	})
+}
+
+func TestValidateTriggerCache(t *testing.T) {
+	assert.NoError(t, validateTriggerCache(nil))
+	assert.NoError(t, validateTriggerCache(&v1alpha1.TriggerCache{TTL: "10m"}))
+	assert.NoError(t, validateTriggerCache(&v1alpha1.TriggerCache{KeyTemplate: "{{ .Input.dep.data.state }}", TTL: "1h"}))
+	assert.ErrorContains(t, validateTriggerCache(&v1alpha1.TriggerCache{KeyTemplate: "{{ .Input.dep.data.state ", TTL: "1h"}), "invalid cache keyTemplate")
+	assert.ErrorContains(t, validateTriggerCache(&v1alpha1.TriggerCache{}), "invalid cache ttl")
+	assert.ErrorContains(t, validateTriggerCache(&v1alpha1.TriggerCache{TTL: "-1m"}), "invalid cache ttl")
+}
+
+func TestValidateTriggerFeatureFlag(t *testing.T) {
+	flag := &v1alpha1.TriggerFeatureFlag{
+		Key:          "new-automation",
+		URL:          "http://flagd:8016",
+		TargetingKey: &v1alpha1.TriggerParameterSource{DependencyName: "dep", DataKey: "body.tenant"},
+		Context: []v1alpha1.TriggerParameter{
+			{Src: &v1alpha1.TriggerParameterSource{DependencyName: "dep", DataKey: "body.region"}, Dest: "region"},
+		},
+	}
+	assert.NoError(t, validateTriggerFeatureFlag(nil))
+	assert.NoError(t, validateTriggerFeatureFlag(flag))
+
+	invalid := flag.DeepCopy()
+	invalid.Key = ""
+	assert.ErrorContains(t, validateTriggerFeatureFlag(invalid), "key can't be empty")
+	invalid = flag.DeepCopy()
+	invalid.URL = ""
+	assert.ErrorContains(t, validateTriggerFeatureFlag(invalid), "url can't be empty")
+	invalid = flag.DeepCopy()
+	invalid.TargetingKey.DependencyName = ""
+	assert.ErrorContains(t, validateTriggerFeatureFlag(invalid), "targeting key dependency name")
+	invalid = flag.DeepCopy()
+	invalid.Context[0].Src = nil
+	assert.ErrorContains(t, validateTriggerFeatureFlag(invalid), "feature flag context index: 0")
+}
+
+func TestValidateTriggerOversizeRoute(t *testing.T) {
+	sObj := sensorObj.DeepCopy()
+	alternate := sObj.Spec.Triggers[0].DeepCopy()
+	alternate.Template.Name = "fake-trigger-from-s3"
+	sObj.Spec.Triggers[0].OversizeRoute = &v1alpha1.TriggerOversizeRoute{MaxSize: 1024 * 1024, Trigger: alternate}
+	assert.NoError(t, ValidateSensor(sObj, fakeEventBus))
+
+	invalid := sObj.DeepCopy()
+	invalid.Spec.Triggers[0].OversizeRoute.MaxSize = 0
+	assert.ErrorContains(t, ValidateSensor(invalid, fakeEventBus), "max size must be greater than 0")
+	invalid = sObj.DeepCopy()
+	invalid.Spec.Triggers[0].OversizeRoute.Trigger = nil
+	assert.ErrorContains(t, ValidateSensor(invalid, fakeEventBus), "oversize route trigger can't be nil")
+	invalid = sObj.DeepCopy()
+	invalid.Spec.Triggers[0].OversizeRoute.Trigger.Template.Name = "fake-trigger"
+	assert.ErrorContains(t, ValidateSensor(invalid, fakeEventBus), "must differ from the name of the trigger")
+	invalid = sObj.DeepCopy()
+	invalid.Spec.Triggers[0].OversizeRoute.Trigger.OversizeRoute = &v1alpha1.TriggerOversizeRoute{MaxSize: 1, Trigger: alternate}
+	assert.ErrorContains(t, ValidateSensor(invalid, fakeEventBus), "can't have an oversize route")
+	invalid = sObj.DeepCopy()
+	invalid.Spec.Triggers[0].OversizeRoute.Trigger.Template = nil
+	assert.ErrorContains(t, ValidateSensor(invalid, fakeEventBus), "invalid oversize route trigger")
+	// The alternate trigger can't collide with another trigger
+	invalid = sObj.DeepCopy()
+	other := sObj.Spec.Triggers[0].DeepCopy()
+	other.OversizeRoute = nil
+	other.Template.Name = "fake-trigger-from-s3"
+	invalid.Spec.Triggers = append(invalid.Spec.Triggers, *other)
+	assert.ErrorContains(t, ValidateSensor(invalid, fakeEventBus), "duplicate trigger name: fake-trigger-from-s3")
+}
+
+func TestValidateTriggerActiveWindows(t *testing.T) {
+	t.Run("test valid active windows", func(t *testing.T) {
+		sObj := sensorObj.DeepCopy()
+		sObj.Spec.Triggers[0].ActiveWindows = &v1alpha1.TriggerActiveWindows{
+			Windows:        []v1alpha1.TriggerActiveWindow{{Start: "0 9 * * 1-5", Duration: "8h"}},
+			Timezone:       "America/New_York",
+			OutsideWindows: v1alpha1.TriggerOutsideWindowsDefer,
+		}
+		err := ValidateSensor(sObj, fakeEventBus)
+		assert.NoError(t, err)
+	})
+
+	t.Run("test invalid active windows", func(t *testing.T) {
+		sObj := sensorObj.DeepCopy()
+		sObj.Spec.Triggers[0].ActiveWindows = &v1alpha1.TriggerActiveWindows{}
+		err := ValidateSensor(sObj, fakeEventBus)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "at least one window")
+
+		sObj.Spec.Triggers[0].ActiveWindows = &v1alpha1.TriggerActiveWindows{Windows: []v1alpha1.TriggerActiveWindow{{Start: "every day", Duration: "8h"}}}
+		err = ValidateSensor(sObj, fakeEventBus)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid activeWindows start")
+
+		sObj.Spec.Triggers[0].ActiveWindows = &v1alpha1.TriggerActiveWindows{Windows: []v1alpha1.TriggerActiveWindow{{Start: "0 9 * * *", Duration: "0s"}}}
+		err = ValidateSensor(sObj, fakeEventBus)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid activeWindows duration")
+
+		sObj.Spec.Triggers[0].ActiveWindows = &v1alpha1.TriggerActiveWindows{Windows: []v1alpha1.TriggerActiveWindow{{Start: "0 9 * * *", Duration: "8h"}}, Timezone: "Mars/Olympus"}
+		err = ValidateSensor(sObj, fakeEventBus)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid activeWindows timezone")
+
+		sObj.Spec.Triggers[0].ActiveWindows = &v1alpha1.TriggerActiveWindows{Windows: []v1alpha1.TriggerActiveWindow{{Start: "0 9 * * *", Duration: "8h"}}, OutsideWindows: "Queue"}
+		err = ValidateSensor(sObj, fakeEventBus)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid activeWindows outsideWindows")
+	})
+}
+
+func TestValidateTriggerBatch(t *testing.T) {
+	jetstreamBus := &eventbusv1alpha1.EventBus{Spec: eventbusv1alpha1.EventBusSpec{JetStream: &eventbusv1alpha1.JetStreamBus{}}}
+
+	t.Run("test valid batch", func(t *testing.T) {
+		sObj := sensorObj.DeepCopy()
+		sObj.Spec.Triggers[0].Batch = &v1alpha1.TriggerBatch{MaxEvents: 100, Window: "5m"}
+		err := ValidateSensor(sObj, jetstreamBus)
+		assert.NoError(t, err)
+	})
+
+	t.Run("test invalid batch", func(t *testing.T) {
+		sObj := sensorObj.DeepCopy()
+		sObj.Spec.Triggers[0].Batch = &v1alpha1.TriggerBatch{}
+		err := ValidateSensor(sObj, jetstreamBus)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "batch requires either maxEvents or window")
+
+		sObj.Spec.Triggers[0].Batch = &v1alpha1.TriggerBatch{MaxEvents: -1}
+		err = ValidateSensor(sObj, jetstreamBus)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "batch maxEvents should not be negative")
+
+		sObj.Spec.Triggers[0].Batch = &v1alpha1.TriggerBatch{Window: "soon"}
+		err = ValidateSensor(sObj, jetstreamBus)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid batch window")
+	})
+	t.Run("test batch without JetStream", func(t *testing.T) {
+		sObj := sensorObj.DeepCopy()
+		sObj.Spec.Triggers[0].Batch = &v1alpha1.TriggerBatch{MaxEvents: 100}
+		err := ValidateSensor(sObj, fakeEventBus)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "batch is only supported with JetStream EventBus")
+	})
+}
+
+func TestValidateDistribution(t *testing.T) {
+	jetstreamBus := &eventbusv1alpha1.EventBus{Spec: eventbusv1alpha1.EventBusSpec{JetStream: &eventbusv1alpha1.JetStreamBus{}}}
+	kafkaBus := &eventbusv1alpha1.EventBus{Spec: eventbusv1alpha1.EventBusSpec{Kafka: &eventbusv1alpha1.KafkaBus{}}}
+	stanBus := &eventbusv1alpha1.EventBus{Spec: eventbusv1alpha1.EventBusSpec{NATS: &eventbusv1alpha1.NATSBus{}}}
+	shared := &v1alpha1.SensorDistribution{Mode: v1alpha1.SensorDistributionShared}
+
+	t.Run("test valid distribution", func(t *testing.T) {
+		sObj := sensorObj.DeepCopy()
+		sObj.Spec.Distribution = shared
+		assert.NoError(t, ValidateSensor(sObj, jetstreamBus))
+		assert.NoError(t, ValidateSensor(sObj, kafkaBus))
+		sObj.Spec.Distribution = &v1alpha1.SensorDistribution{Mode: v1alpha1.SensorDistributionLeaderElection}
+		assert.NoError(t, ValidateSensor(sObj, stanBus))
+	})
+
+	t.Run("test invalid distribution mode", func(t *testing.T) {
+		sObj := sensorObj.DeepCopy()
+		sObj.Spec.Distribution = &v1alpha1.SensorDistribution{Mode: "RoundRobin"}
+		err := ValidateSensor(sObj, jetstreamBus)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid distribution mode")
+	})
+
+	t.Run("test shared distribution not supported", func(t *testing.T) {
+		sObj := sensorObj.DeepCopy()
+		sObj.Spec.Distribution = shared
+		err := ValidateSensor(sObj, stanBus)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "only supported with JetStream or Kafka EventBus")
+	})
+
+	t.Run("test shared distribution with several dependencies", func(t *testing.T) {
+		sObj := sensorObj.DeepCopy()
+		sObj.Spec.Distribution = shared
+		sObj.Spec.Dependencies = append(sObj.Spec.Dependencies, v1alpha1.EventDependency{Name: "fake-dep-2", EventSourceName: "fake-source", EventName: "fake-two"})
+		assert.NoError(t, ValidateSensor(sObj, jetstreamBus))
+		sObj.Spec.Triggers[0].Template.Conditions = "fake-dep && fake-dep-2"
+		assert.NoError(t, ValidateSensor(sObj, jetstreamBus))
+		sObj.Spec.Triggers[0].Batch = &v1alpha1.TriggerBatch{MaxEvents: 10}
+		assert.NoError(t, ValidateSensor(sObj, jetstreamBus))
+	})
+}
+
 func TestValidDependencies(t *testing.T) {
 	jetstreamBus := &eventbusv1alpha1.EventBus{Spec: eventbusv1alpha1.EventBusSpec{JetStream: &eventbusv1alpha1.JetStreamBus{}}}
 	stanBus := &eventbusv1alpha1.EventBus{Spec: eventbusv1alpha1.EventBusSpec{NATS: &eventbusv1alpha1.NATSBus{}}}
@@ -116,6 +663,26 @@ func TestValidDependencies(t *testing.T) {
 	})
 }
 
+func TestValidateKafkaTriggerSchemaRegistry(t *testing.T) {
+	trigger := &v1alpha1.KafkaTrigger{
+		URL:     "kafka:9092",
+		Topic:   "topic",
+		Payload: []v1alpha1.TriggerParameter{},
+	}
+	assert.NoError(t, validateKafkaTrigger(trigger))
+
+	trigger.SchemaRegistry = &apicommon.SchemaRegistryConfig{URL: "http://registry:8081"}
+	err := validateKafkaTrigger(trigger)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "either schema id or subject")
+
+	trigger.SchemaRegistry.Subject = "topic-value"
+	assert.NoError(t, validateKafkaTrigger(trigger))
+
+	trigger.SchemaRegistry.URL = ""
+	assert.Error(t, validateKafkaTrigger(trigger))
+}
+
 func TestValidateLogicalOperator(t *testing.T) {
 	t.Run("test valid", func(t *testing.T) {
 		logOp := v1alpha1.OrLogicalOperator
@@ -445,6 +1012,196 @@ func TestValidateEventTimeFilter(t *testing.T) {
 	})
 }
 
+func TestValidateAzureTriggers(t *testing.T) {
+	secret := &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "azure"}, Key: "key"}
+
+	eventHubs := &v1alpha1.AzureEventHubsTrigger{FQDN: "ns.servicebus.windows.net", HubName: "hub"}
+	assert.NoError(t, validateAzureEventHubsTrigger(eventHubs))
+	eventHubs.SharedAccessKeyName = secret
+	assert.ErrorContains(t, validateAzureEventHubsTrigger(eventHubs), "must be either both specified")
+	eventHubs.SharedAccessKey = secret
+	assert.NoError(t, validateAzureEventHubsTrigger(eventHubs))
+
+	serviceBus := &v1alpha1.AzureServiceBusTrigger{QueueName: "queue"}
+	assert.ErrorContains(t, validateAzureServiceBusTrigger(serviceBus), "either connectionString or fullyQualifiedNamespace")
+	serviceBus.FullyQualifiedNamespace = "ns.servicebus.windows.net"
+	assert.NoError(t, validateAzureServiceBusTrigger(serviceBus))
+	serviceBus.QueueName = ""
+	assert.ErrorContains(t, validateAzureServiceBusTrigger(serviceBus), "either queueName or topicName")
+}
+
+func TestValidatePrometheusTrigger(t *testing.T) {
+	trigger := &v1alpha1.PrometheusTrigger{
+		RemoteWrite: &v1alpha1.PrometheusRemoteWrite{URL: "http://prometheus:9090/api/v1/write"},
+		Metrics:     []v1alpha1.PrometheusMetric{{Name: "build_duration_seconds", Value: "1", Labels: map[string]string{"repo": "argo-events"}}},
+	}
+	assert.NoError(t, validatePrometheusTrigger(trigger))
+	trigger.Pushgateway = &v1alpha1.PrometheusPushgateway{URL: "http://pushgateway:9091", Job: "builds"}
+	assert.ErrorContains(t, validatePrometheusTrigger(trigger), "exactly one of remoteWrite or pushgateway")
+	trigger.RemoteWrite = nil
+	assert.NoError(t, validatePrometheusTrigger(trigger))
+	trigger.Pushgateway.Job = ""
+	assert.ErrorContains(t, validatePrometheusTrigger(trigger), "job can't be empty")
+	trigger.Pushgateway.Job = "builds"
+	trigger.Metrics[0].Labels["__name__"] = "other"
+	assert.ErrorContains(t, validatePrometheusTrigger(trigger), "invalid label name")
+	delete(trigger.Metrics[0].Labels, "__name__")
+	trigger.Metrics[0].Name = "build-duration"
+	assert.ErrorContains(t, validatePrometheusTrigger(trigger), "invalid metric name")
+	trigger.Metrics = nil
+	assert.ErrorContains(t, validatePrometheusTrigger(trigger), "at least one metric")
+}
+
+func TestValidateLogTriggers(t *testing.T) {
+	payload := []v1alpha1.TriggerParameter{{Src: &v1alpha1.TriggerParameterSource{DependencyName: "dep", DataKey: "body"}, Dest: "event"}}
+	secret := &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "secret"}, Key: "key"}
+
+	loki := &v1alpha1.LokiTrigger{URL: "http://loki:3100", Labels: map[string]string{"app": "audit"}, Payload: payload}
+	assert.NoError(t, validateLokiTrigger(loki))
+	loki.Labels = map[string]string{"audit-log": "true"}
+	assert.ErrorContains(t, validateLokiTrigger(loki), "invalid stream label name")
+	loki.Labels = nil
+	assert.ErrorContains(t, validateLokiTrigger(loki), "at least one stream label")
+
+	es := &v1alpha1.ElasticsearchTrigger{URL: "https://elasticsearch:9200", Index: "audit", Payload: payload}
+	assert.NoError(t, validateElasticsearchTrigger(es))
+	es.BasicAuth = &apicommon.BasicAuth{Username: secret, Password: secret}
+	es.APIKey = secret
+	assert.ErrorContains(t, validateElasticsearchTrigger(es), "can't be both specified")
+	es.APIKey = nil
+	es.Payload = nil
+	assert.ErrorContains(t, validateElasticsearchTrigger(es), "payload can't be empty")
+}
+
+func TestValidateAWSMessagingTriggers(t *testing.T) {
+	payload := []v1alpha1.TriggerParameter{{Src: &v1alpha1.TriggerParameterSource{DependencyName: "dep", DataKey: "body"}, Dest: "body"}}
+	sqsTrigger := &v1alpha1.AWSSQSTrigger{Queue: "orders", Region: "us-east-1", Payload: payload}
+	assert.NoError(t, validateAWSSQSTrigger(sqsTrigger))
+	sqsTrigger.DelaySeconds = 901
+	assert.ErrorContains(t, validateAWSSQSTrigger(sqsTrigger), "delaySeconds must be between 0 and 900")
+	sqsTrigger.DelaySeconds = 10
+	sqsTrigger.MessageGroupID = "orders"
+	assert.ErrorContains(t, validateAWSSQSTrigger(sqsTrigger), "FIFO queues don't support per-message delays")
+	sqsTrigger.DelaySeconds = 0
+	sqsTrigger.MessageGroupID = ""
+	sqsTrigger.MessageDeduplicationID = "id"
+	assert.ErrorContains(t, validateAWSSQSTrigger(sqsTrigger), "messageDeduplicationId requires messageGroupId")
+	sqsTrigger.Queue = ""
+	assert.ErrorContains(t, validateAWSSQSTrigger(sqsTrigger), "queue is not specified")
+
+	snsTrigger := &v1alpha1.AWSSNSTrigger{TopicArn: "arn:aws:sns:us-east-1:123456789012:orders", Region: "us-east-1", Payload: payload}
+	assert.NoError(t, validateAWSSNSTrigger(snsTrigger))
+	snsTrigger.MessageAttributes = map[string]string{}
+	for _, name := range []string{"a", "b", "c", "d", "e", "f", "g", "h", "i", "j"} {
+		snsTrigger.MessageAttributes[name] = name
+	}
+	assert.ErrorContains(t, validateAWSSNSTrigger(snsTrigger), "at most 9 messageAttributes are supported")
+	snsTrigger.MessageAttributes = nil
+	snsTrigger.Payload = nil
+	assert.ErrorContains(t, validateAWSSNSTrigger(snsTrigger), "payload parameters are not specified")
+}
+
+func TestValidateAzureQueueStorageTrigger(t *testing.T) {
+	payload := []v1alpha1.TriggerParameter{{Src: &v1alpha1.TriggerParameterSource{DependencyName: "dep", DataKey: "body"}, Dest: "body"}}
+	trigger := &v1alpha1.AzureQueueStorageTrigger{StorageAccountName: "account", QueueName: "orders", Payload: payload}
+	assert.NoError(t, validateAzureQueueStorageTrigger(trigger))
+	ttl, visibilityTimeout := int32(60), int32(60)
+	trigger.TimeToLiveInSeconds = &ttl
+	trigger.VisibilityTimeoutInSeconds = &visibilityTimeout
+	assert.ErrorContains(t, validateAzureQueueStorageTrigger(trigger), "visibilityTimeoutInSeconds must be smaller than timeToLiveInSeconds")
+	ttl = -1
+	assert.NoError(t, validateAzureQueueStorageTrigger(trigger))
+	ttl = -2
+	assert.ErrorContains(t, validateAzureQueueStorageTrigger(trigger), "timeToLiveInSeconds must be positive")
+	trigger.TimeToLiveInSeconds = nil
+	trigger.StorageAccountName = ""
+	assert.ErrorContains(t, validateAzureQueueStorageTrigger(trigger), "either connectionString or storageAccountName must be specified")
+}
+
+func TestValidateJenkinsTrigger(t *testing.T) {
+	trigger := &v1alpha1.JenkinsTrigger{URL: "https://jenkins.example.com", Job: "release/argo-events"}
+	assert.NoError(t, validateJenkinsTrigger(trigger))
+	trigger.Job = "/"
+	assert.ErrorContains(t, validateJenkinsTrigger(trigger), "job can't be empty")
+	trigger.URL = ""
+	assert.ErrorContains(t, validateJenkinsTrigger(trigger), "url can't be empty")
+}
+
+func TestValidateGithubWorkflowTrigger(t *testing.T) {
+	trigger := &v1alpha1.GithubWorkflowTrigger{
+		Owner:      "argoproj",
+		Repository: "argo-events",
+		Workflow:   "release.yaml",
+		Ref:        "main",
+		APIToken:   &corev1.SecretKeySelector{Key: "token"},
+	}
+	assert.NoError(t, validateGithubWorkflowTrigger(trigger))
+	trigger.GithubApp = &v1alpha1.GithubAppCreds{AppID: 1, InstallationID: 2}
+	assert.ErrorContains(t, validateGithubWorkflowTrigger(trigger), "only one of apiToken and githubApp")
+	trigger.APIToken = nil
+	assert.ErrorContains(t, validateGithubWorkflowTrigger(trigger), "githubApp.privateKey can't be empty")
+	trigger.GithubApp = nil
+	assert.ErrorContains(t, validateGithubWorkflowTrigger(trigger), "either apiToken or githubApp")
+	trigger.Ref = ""
+	assert.ErrorContains(t, validateGithubWorkflowTrigger(trigger), "ref can't be empty")
+}
+
+func TestValidateArgoWorkflowArtifacts(t *testing.T) {
+	artifact := apicommon.NewResource(map[string]interface{}{"kind": "Workflow"})
+	trigger := &v1alpha1.ArgoWorkflowTrigger{
+		Source:    &v1alpha1.ArtifactLocation{Resource: &artifact},
+		Operation: v1alpha1.Submit,
+		Artifacts: []v1alpha1.ArgoWorkflowArtifact{
+			{Name: "order", Raw: &v1alpha1.TriggerParameterSource{DependencyName: "dep", DataKey: "body.order"}},
+			{Name: "payload", S3: &v1alpha1.ArgoWorkflowS3Artifact{DependencyName: "dep"}},
+		},
+	}
+	assert.NoError(t, validateArgoWorkflowTrigger(trigger))
+	trigger.Artifacts[1].Name = "order"
+	assert.ErrorContains(t, validateArgoWorkflowTrigger(trigger), "artifact order is specified more than once")
+	trigger.Artifacts[1].Raw = trigger.Artifacts[0].Raw
+	assert.ErrorContains(t, validateArgoWorkflowTrigger(trigger), "either raw or s3 must be specified")
+	trigger.Artifacts[1].Raw = nil
+	trigger.Artifacts[1].S3.DependencyName = ""
+	assert.ErrorContains(t, validateArgoWorkflowTrigger(trigger), "s3 artifact dependency name can't be empty")
+	trigger.Artifacts = trigger.Artifacts[:1]
+	trigger.Operation = v1alpha1.SubmitFrom
+	assert.ErrorContains(t, validateArgoWorkflowTrigger(trigger), "artifacts are only applicable to the submit operation")
+}
+
+func TestValidateHTTPTriggerContentMode(t *testing.T) {
+	trigger := &v1alpha1.HTTPTrigger{URL: "https://example.com", ContentMode: v1alpha1.HTTPContentModeBinary}
+	assert.NoError(t, validateHTTPTrigger(trigger))
+	trigger.Wrapper = &v1alpha1.HTTPPayloadWrapper{DataKey: "event"}
+	assert.ErrorContains(t, validateHTTPTrigger(trigger), "wrapper is only supported")
+	trigger.ContentMode = v1alpha1.HTTPContentModeWrapped
+	assert.NoError(t, validateHTTPTrigger(trigger))
+	trigger.ContentMode = "JSON"
+	assert.ErrorContains(t, validateHTTPTrigger(trigger), "invalid content mode")
+}
+
+func TestValidatePatchParameters(t *testing.T) {
+	parameter := &v1alpha1.TriggerParameter{
+		Src:       &v1alpha1.TriggerParameterSource{DependencyName: "dep", DataTemplate: `[{"op": "add", "path": "/spec/args/-", "value": "{{ .Input.ref }}"}]`},
+		Operation: v1alpha1.TriggerParameterOpJSONPatch,
+	}
+	assert.NoError(t, validateTriggerParameter(parameter))
+	parameter.Operation = v1alpha1.TriggerParameterOpOverwrite
+	assert.ErrorContains(t, validateTriggerParameter(parameter), "destination can't be empty")
+
+	parameter.Operation = v1alpha1.TriggerParameterOpJSONPatch
+	parameter.Src = &v1alpha1.TriggerParameterSource{DependencyName: "dep", Value: ptr.To(`{"op": "add"}`)}
+	assert.ErrorContains(t, validateTriggerParameter(parameter), "not a valid JSON patch")
+	parameter.Src.Value = ptr.To(`[{"op": "remove", "path": "/spec/env"}]`)
+	assert.NoError(t, validateTriggerParameter(parameter))
+
+	parameter.Operation = v1alpha1.TriggerParameterOpMergePatch
+	parameter.Src.Value = ptr.To(`{"metadata":`)
+	assert.ErrorContains(t, validateTriggerParameter(parameter), "not a valid JSON merge patch")
+	parameter.Src.Value = ptr.To(`{"metadata": {"labels": {"team": null}}}`)
+	assert.NoError(t, validateTriggerParameter(parameter))
+}
+
 func TestValidTriggers(t *testing.T) {
 	t.Run("duplicate trigger names", func(t *testing.T) {
 		triggers := []v1alpha1.Trigger{
@@ -0,0 +1,62 @@
+package common
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+
+	eventbusv1alpha1 "github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1"
+)
+
+// EncodeEvent encodes a CloudEvent to be published to the EventBus, in the format of the EventBus.
+func EncodeEvent(format eventbusv1alpha1.EventFormat, event *cloudevents.Event) ([]byte, error) {
+	if format == eventbusv1alpha1.EventFormatProtobuf {
+		return marshalProtobufEvent(event)
+	}
+	return json.Marshal(event)
+}
+
+// DecodeEvent decodes a CloudEvent received from the EventBus, in either format, so that the format of an
+// EventBus can be changed while events are still in flight.
+func DecodeEvent(data []byte) (*cloudevents.Event, error) {
+	if isJSON(data) {
+		event := &cloudevents.Event{}
+		if err := json.Unmarshal(data, event); err != nil {
+			return nil, err
+		}
+		return event, nil
+	}
+	return unmarshalProtobufEvent(data)
+}
+
+// EncodeEvents encodes a batch of CloudEvents, a JSON array in the JSON format.
+func EncodeEvents(format eventbusv1alpha1.EventFormat, events []*cloudevents.Event) ([]byte, error) {
+	if format == eventbusv1alpha1.EventFormatProtobuf {
+		return marshalProtobufBatch(events)
+	}
+	return json.Marshal(events)
+}
+
+// DecodeEvents decodes a batch of CloudEvents, in either format.
+func DecodeEvents(data []byte) ([]*cloudevents.Event, error) {
+	if isJSON(data) {
+		var events []*cloudevents.Event
+		if err := json.Unmarshal(data, &events); err != nil {
+			return nil, err
+		}
+		return events, nil
+	}
+	return unmarshalProtobufBatch(data)
+}
+
+// isJSON tells the JSON format from the protobuf one, whose messages start with a field tag.
+func isJSON(data []byte) bool {
+	trimmed := bytes.TrimLeft(data, " \t\r\n")
+	return len(trimmed) > 0 && (trimmed[0] == '{' || trimmed[0] == '[')
+}
+
+func errInvalidProtobuf(what string) error {
+	return fmt.Errorf("invalid protobuf CloudEvent, %s", what)
+}
@@ -0,0 +1,74 @@
+package common
+
+import (
+	"testing"
+	"time"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/stretchr/testify/assert"
+
+	eventbusv1alpha1 "github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1"
+)
+
+func newTestEvent(t *testing.T, id string) *cloudevents.Event {
+	t.Helper()
+	event := cloudevents.NewEvent()
+	event.SetID(id)
+	event.SetSource("webhook")
+	event.SetType("webhook")
+	event.SetSubject("example")
+	event.SetTime(time.Date(2024, 1, 1, 10, 0, 0, 500, time.UTC))
+	event.SetExtension("priority", int32(3))
+	assert.NoError(t, event.SetData(cloudevents.ApplicationJSON, []byte(`{"a":"b"}`)))
+	return &event
+}
+
+func TestEncodeDecodeEvent(t *testing.T) {
+	for _, format := range []eventbusv1alpha1.EventFormat{"", eventbusv1alpha1.EventFormatJSON, eventbusv1alpha1.EventFormatProtobuf} {
+		event := newTestEvent(t, "1")
+		data, err := EncodeEvent(format, event)
+		assert.NoError(t, err)
+		assert.Equal(t, format != eventbusv1alpha1.EventFormatProtobuf, isJSON(data))
+		decoded, err := DecodeEvent(data)
+		assert.NoError(t, err)
+		assert.Equal(t, event.ID(), decoded.ID())
+		assert.Equal(t, event.Source(), decoded.Source())
+		assert.Equal(t, event.Subject(), decoded.Subject())
+		assert.True(t, event.Time().Equal(decoded.Time()))
+		assert.Equal(t, event.DataContentType(), decoded.DataContentType())
+		assert.JSONEq(t, string(event.Data()), string(decoded.Data()))
+		priority, err := decoded.Context.GetExtension("priority")
+		assert.NoError(t, err)
+		assert.EqualValues(t, 3, priority)
+	}
+}
+
+func TestEncodeDecodeBinaryData(t *testing.T) {
+	event := newTestEvent(t, "1")
+	assert.NoError(t, event.SetData("application/octet-stream", []byte{0x00, 0xff}))
+	data, err := EncodeEvent(eventbusv1alpha1.EventFormatProtobuf, event)
+	assert.NoError(t, err)
+	decoded, err := DecodeEvent(data)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{0x00, 0xff}, decoded.Data())
+}
+
+func TestEncodeDecodeEvents(t *testing.T) {
+	for _, format := range []eventbusv1alpha1.EventFormat{eventbusv1alpha1.EventFormatJSON, eventbusv1alpha1.EventFormatProtobuf} {
+		events := []*cloudevents.Event{newTestEvent(t, "1"), newTestEvent(t, "2")}
+		data, err := EncodeEvents(format, events)
+		assert.NoError(t, err)
+		decoded, err := DecodeEvents(data)
+		assert.NoError(t, err)
+		assert.Len(t, decoded, 2)
+		assert.Equal(t, "1", decoded[0].ID())
+		assert.Equal(t, "2", decoded[1].ID())
+	}
+}
+
+func TestDecodeInvalidEvent(t *testing.T) {
+	_, err := DecodeEvent([]byte{0xff, 0xff})
+	assert.Error(t, err)
+	_, err = DecodeEvent([]byte(`{"id":`))
+	assert.Error(t, err)
+}
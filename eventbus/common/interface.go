@@ -48,3 +48,59 @@ type SensorDriver interface {
 		deps []Dependency,
 		atLeastOnce bool) (TriggerConnection, error)
 }
+
+// Deduplicator records the idempotency keys of trigger executions,
+// it is optionally implemented by a SensorDriver which has a Key/Value store.
+type Deduplicator interface {
+	// ClaimKey atomically records the key of the trigger for the window, it returns false if the key is already
+	// recorded, i.e. the execution is a duplicate.
+	ClaimKey(triggerName, key string, window time.Duration) (bool, error)
+	// ReleaseKey deletes the key of the trigger, once the execution which claimed it has failed.
+	ReleaseKey(triggerName, key string, window time.Duration) error
+}
+
+// BatchStore persists the batches of trigger executions, one key per execution,
+// it is optionally implemented by a SensorDriver which has a Key/Value store.
+type BatchStore interface {
+	// LoadBatch returns the persisted executions of the batch of the trigger by key, nil if there is none.
+	LoadBatch(triggerName string) (map[string][]byte, error)
+	// SaveBatchExecution persists an execution added to the batch of the trigger.
+	SaveBatchExecution(triggerName, key string, execution []byte) error
+	// DeleteBatchExecutions deletes the executions of the batch of the trigger, once they are flushed.
+	DeleteBatchExecutions(triggerName string, keys []string) error
+}
+
+// QuotaStore persists the trigger executions counted by the execution quota of a Sensor,
+// it is optionally implemented by a SensorDriver which has a Key/Value store.
+type QuotaStore interface {
+	// LoadExecutions returns the times of the persisted executions within the window, oldest first.
+	LoadExecutions(window time.Duration) ([]time.Time, error)
+	// SaveExecution persists the time of an execution, it expires after the window.
+	SaveExecution(at time.Time, window time.Duration) error
+}
+
+// Partitioner assigns the replicas of a Sensor to the partitions of its dependencies,
+// it is optionally implemented by a SensorDriver which has a Key/Value store.
+type Partitioner interface {
+	// ClaimPartition blocks until the holder claims one of the partitions, which it keeps until the context is
+	// done. The returned channel is closed if the partition is lost before, i.e. the holder failed to keep it.
+	ClaimPartition(ctx context.Context, holder string, partitions int) (int, <-chan struct{}, error)
+}
+
+// SubjectsChecker checks the subjects the dependencies subscribe to,
+// it is optionally implemented by a SensorDriver.
+type SubjectsChecker interface {
+	// MissingSubjects returns the subjects of the dependencies which do not exist on the EventBus yet.
+	MissingSubjects(deps []Dependency) ([]string, error)
+}
+
+// ConditionsObserveFunc is called after the trigger conditions are evaluated on the event of a dependency, with the
+// dependencies received so far.
+type ConditionsObserveFunc func(depName string, event cloudevents.Event, received map[string]bool, satisfied bool, err error)
+
+// ConditionsObserver reports the evaluations of the trigger conditions,
+// it is optionally implemented by a TriggerConnection.
+type ConditionsObserver interface {
+	// ObserveConditions registers the function called after each evaluation of the trigger conditions.
+	ObserveConditions(observe ConditionsObserveFunc)
+}
@@ -0,0 +1,304 @@
+package common
+
+import (
+	"strings"
+	"time"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/cloudevents/sdk-go/v2/types"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// The field numbers of the messages of the protobuf format of CloudEvents, see
+// https://github.com/cloudevents/spec/blob/main/cloudevents/formats/cloudevents.proto
+const (
+	ceID          protowire.Number = 1
+	ceSource      protowire.Number = 2
+	ceSpecVersion protowire.Number = 3
+	ceType        protowire.Number = 4
+	ceAttributes  protowire.Number = 5
+	ceBinaryData  protowire.Number = 6
+	ceTextData    protowire.Number = 7
+	ceProtoData   protowire.Number = 8
+
+	attrBoolean   protowire.Number = 1
+	attrInteger   protowire.Number = 2
+	attrString    protowire.Number = 3
+	attrBytes     protowire.Number = 4
+	attrURI       protowire.Number = 5
+	attrURIRef    protowire.Number = 6
+	attrTimestamp protowire.Number = 7
+
+	batchEvents protowire.Number = 1
+)
+
+func marshalProtobufEvent(event *cloudevents.Event) ([]byte, error) {
+	if err := event.Validate(); err != nil {
+		return nil, err
+	}
+	var b []byte
+	b = appendString(b, ceID, event.ID())
+	b = appendString(b, ceSource, event.Source())
+	b = appendString(b, ceSpecVersion, event.SpecVersion())
+	b = appendString(b, ceType, event.Type())
+
+	attributes := map[string]interface{}{}
+	if v := event.DataContentType(); v != "" {
+		attributes["datacontenttype"] = v
+	}
+	if u := types.ParseURI(event.DataSchema()); u != nil && event.DataSchema() != "" {
+		attributes["dataschema"] = *u
+	}
+	if v := event.Subject(); v != "" {
+		attributes["subject"] = v
+	}
+	if v := event.Time(); !v.IsZero() {
+		attributes["time"] = types.Timestamp{Time: v}
+	}
+	for name, value := range event.Extensions() {
+		attributes[name] = value
+	}
+	for name, value := range attributes {
+		attr, err := marshalAttribute(value)
+		if err != nil {
+			return nil, err
+		}
+		var entry []byte
+		entry = appendString(entry, 1, name)
+		entry = protowire.AppendTag(entry, 2, protowire.BytesType)
+		entry = protowire.AppendBytes(entry, attr)
+		b = protowire.AppendTag(b, ceAttributes, protowire.BytesType)
+		b = protowire.AppendBytes(b, entry)
+	}
+
+	if data := event.Data(); data != nil {
+		if isTextContentType(event.DataContentType()) {
+			b = protowire.AppendTag(b, ceTextData, protowire.BytesType)
+		} else {
+			b = protowire.AppendTag(b, ceBinaryData, protowire.BytesType)
+		}
+		b = protowire.AppendBytes(b, data)
+	}
+	return b, nil
+}
+
+func marshalAttribute(value interface{}) ([]byte, error) {
+	value, err := types.Validate(value)
+	if err != nil {
+		return nil, err
+	}
+	var b []byte
+	switch v := value.(type) {
+	case bool:
+		b = protowire.AppendTag(b, attrBoolean, protowire.VarintType)
+		b = protowire.AppendVarint(b, protowire.EncodeBool(v))
+	case int32:
+		b = protowire.AppendTag(b, attrInteger, protowire.VarintType)
+		b = protowire.AppendVarint(b, uint64(int64(v)))
+	case string:
+		b = appendString(b, attrString, v)
+	case []byte:
+		b = protowire.AppendTag(b, attrBytes, protowire.BytesType)
+		b = protowire.AppendBytes(b, v)
+	case types.URI:
+		b = appendString(b, attrURI, v.String())
+	case types.URIRef:
+		b = appendString(b, attrURIRef, v.String())
+	case types.Timestamp:
+		var ts []byte
+		ts = protowire.AppendTag(ts, 1, protowire.VarintType)
+		ts = protowire.AppendVarint(ts, uint64(v.Time.Unix()))
+		ts = protowire.AppendTag(ts, 2, protowire.VarintType)
+		ts = protowire.AppendVarint(ts, uint64(v.Time.Nanosecond()))
+		b = protowire.AppendTag(b, attrTimestamp, protowire.BytesType)
+		b = protowire.AppendBytes(b, ts)
+	default:
+		return nil, errInvalidProtobuf("unsupported attribute type")
+	}
+	return b, nil
+}
+
+func unmarshalProtobufEvent(data []byte) (*cloudevents.Event, error) {
+	event := cloudevents.NewEvent()
+	var contentType string
+	var payload []byte
+	attributes := map[string]interface{}{}
+	err := forEachField(data, func(num protowire.Number, typ protowire.Type, value []byte, varint uint64) error {
+		switch num {
+		case ceID:
+			event.SetID(string(value))
+		case ceSource:
+			event.SetSource(string(value))
+		case ceSpecVersion:
+			event.SetSpecVersion(string(value))
+		case ceType:
+			event.SetType(string(value))
+		case ceAttributes:
+			name, attr, err := unmarshalAttributeEntry(value)
+			if err != nil {
+				return err
+			}
+			attributes[name] = attr
+		case ceBinaryData, ceTextData:
+			payload = value
+		case ceProtoData:
+			return errInvalidProtobuf("proto_data is not supported")
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	for name, value := range attributes {
+		switch name {
+		case "datacontenttype":
+			contentType, _ = value.(string)
+		case "dataschema":
+			if u, err := types.ToURL(value); err == nil {
+				event.SetDataSchema(u.String())
+			}
+		case "subject":
+			s, _ := value.(string)
+			event.SetSubject(s)
+		case "time":
+			if ts, ok := value.(types.Timestamp); ok {
+				event.SetTime(ts.Time)
+			}
+		default:
+			event.SetExtension(name, value)
+		}
+	}
+	if contentType != "" {
+		event.SetDataContentType(contentType)
+	}
+	if payload != nil {
+		if err := event.SetData(contentType, payload); err != nil {
+			return nil, err
+		}
+	}
+	if err := event.Validate(); err != nil {
+		return nil, err
+	}
+	return &event, nil
+}
+
+func unmarshalAttributeEntry(data []byte) (string, interface{}, error) {
+	var name string
+	var value interface{}
+	err := forEachField(data, func(num protowire.Number, typ protowire.Type, b []byte, varint uint64) error {
+		switch num {
+		case 1:
+			name = string(b)
+		case 2:
+			return forEachField(b, func(num protowire.Number, typ protowire.Type, b []byte, varint uint64) error {
+				switch num {
+				case attrBoolean:
+					value = protowire.DecodeBool(varint)
+				case attrInteger:
+					value = int32(varint)
+				case attrString:
+					value = string(b)
+				case attrBytes:
+					value = append([]byte{}, b...)
+				case attrURI, attrURIRef:
+					// Kept as a string, the URIs which are not absolute do not validate as URI
+					value = string(b)
+				case attrTimestamp:
+					var seconds, nanos uint64
+					if err := forEachField(b, func(num protowire.Number, typ protowire.Type, b []byte, varint uint64) error {
+						switch num {
+						case 1:
+							seconds = varint
+						case 2:
+							nanos = varint
+						}
+						return nil
+					}); err != nil {
+						return err
+					}
+					value = types.Timestamp{Time: time.Unix(int64(seconds), int64(nanos)).UTC()}
+				}
+				return nil
+			})
+		}
+		return nil
+	})
+	if err != nil {
+		return "", nil, err
+	}
+	if name == "" || value == nil {
+		return "", nil, errInvalidProtobuf("attribute without name or value")
+	}
+	return name, value, nil
+}
+
+func marshalProtobufBatch(events []*cloudevents.Event) ([]byte, error) {
+	var b []byte
+	for _, event := range events {
+		e, err := marshalProtobufEvent(event)
+		if err != nil {
+			return nil, err
+		}
+		b = protowire.AppendTag(b, batchEvents, protowire.BytesType)
+		b = protowire.AppendBytes(b, e)
+	}
+	return b, nil
+}
+
+func unmarshalProtobufBatch(data []byte) ([]*cloudevents.Event, error) {
+	var events []*cloudevents.Event
+	err := forEachField(data, func(num protowire.Number, typ protowire.Type, value []byte, varint uint64) error {
+		if num != batchEvents {
+			return nil
+		}
+		event, err := unmarshalProtobufEvent(value)
+		if err != nil {
+			return err
+		}
+		events = append(events, event)
+		return nil
+	})
+	return events, err
+}
+
+// forEachField calls fn with each field of a protobuf message, with the value of the length delimited fields,
+// or the varint.
+func forEachField(data []byte, fn func(num protowire.Number, typ protowire.Type, value []byte, varint uint64) error) error {
+	for len(data) > 0 {
+		num, typ, n := protowire.ConsumeTag(data)
+		if n < 0 {
+			return errInvalidProtobuf(protowire.ParseError(n).Error())
+		}
+		data = data[n:]
+		var value []byte
+		var varint uint64
+		switch typ {
+		case protowire.BytesType:
+			value, n = protowire.ConsumeBytes(data)
+		case protowire.VarintType:
+			varint, n = protowire.ConsumeVarint(data)
+		default:
+			n = protowire.ConsumeFieldValue(num, typ, data)
+		}
+		if n < 0 {
+			return errInvalidProtobuf(protowire.ParseError(n).Error())
+		}
+		data = data[n:]
+		if err := fn(num, typ, value, varint); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func appendString(b []byte, num protowire.Number, s string) []byte {
+	b = protowire.AppendTag(b, num, protowire.BytesType)
+	return protowire.AppendString(b, s)
+}
+
+// isTextContentType returns whether the data is sent as text_data rather than binary_data.
+func isTextContentType(contentType string) bool {
+	contentType = strings.ToLower(contentType)
+	return contentType == "" || strings.HasPrefix(contentType, "text/") || strings.Contains(contentType, "json") ||
+		strings.Contains(contentType, "xml")
+}
@@ -1,13 +1,19 @@
 package common
 
 import (
+	apicommon "github.com/argoproj/argo-events/pkg/apis/common"
 	eventbusv1alpha1 "github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1"
+	sensorv1alpha1 "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
 )
 
 // Auth contains the auth infor for event bus
 type Auth struct {
 	Strategy   eventbusv1alpha1.AuthStrategy
 	Credential *AuthCredential
+	// SPIFFE authenticates with the SPIFFE X.509 SVID of the pod, instead of the credential
+	SPIFFE *eventbusv1alpha1.SPIFFEConfig
+	// TLS verifies the certificate of the servers, it is skipped if nil
+	TLS *apicommon.TLSConfig
 }
 
 // AuthCredential host the credential info
@@ -33,4 +39,5 @@ type Dependency struct {
 	Name            string
 	EventSourceName string
 	EventName       string
+	StartPosition   *sensorv1alpha1.DependencyStartPosition
 }
@@ -3,6 +3,7 @@ package eventbus
 import (
 	"context"
 	"fmt"
+	"path"
 
 	"github.com/argoproj/argo-events/common"
 	apicommon "github.com/argoproj/argo-events/pkg/apis/common"
@@ -11,6 +12,7 @@ import (
 
 	"github.com/argoproj/argo-events/common/logging"
 	eventbuscommon "github.com/argoproj/argo-events/eventbus/common"
+	"github.com/argoproj/argo-events/eventbus/faults"
 	jetstreamsource "github.com/argoproj/argo-events/eventbus/jetstream/eventsource"
 	jetstreamsensor "github.com/argoproj/argo-events/eventbus/jetstream/sensor"
 	kafkasource "github.com/argoproj/argo-events/eventbus/kafka/eventsource"
@@ -63,11 +65,28 @@ func GetEventSourceDriver(ctx context.Context, eventBusConfig eventbusv1alpha1.B
 	default:
 		return nil, fmt.Errorf("invalid eventbus type")
 	}
+	faultConfig, err := faults.ConfigFromEnv()
+	if err != nil {
+		return nil, err
+	}
+	if faultConfig != nil {
+		dvr = faults.WrapEventSourceDriver(dvr, faultConfig, logger)
+	}
 	return dvr, nil
 }
 
 func GetSensorDriver(ctx context.Context, eventBusConfig eventbusv1alpha1.BusConfig, sensorSpec *v1alpha1.Sensor, hostname string) (eventbuscommon.SensorDriver, error) {
-	auth, err := GetAuth(ctx, eventBusConfig)
+	return getSensorDriver(ctx, eventBusConfig, sensorSpec, hostname, common.EventBusAuthFileMountPath)
+}
+
+// GetAdditionalSensorDriver returns the driver of an additional EventBus the dependencies of the Sensor consume from,
+// its auth file is mounted in the directory named after it.
+func GetAdditionalSensorDriver(ctx context.Context, eventBusName string, eventBusConfig eventbusv1alpha1.BusConfig, sensorSpec *v1alpha1.Sensor, hostname string) (eventbuscommon.SensorDriver, error) {
+	return getSensorDriver(ctx, eventBusConfig, sensorSpec, hostname, path.Join(common.AdditionalEventBusAuthFileMountPath, eventBusName))
+}
+
+func getSensorDriver(ctx context.Context, eventBusConfig eventbusv1alpha1.BusConfig, sensorSpec *v1alpha1.Sensor, hostname, authPath string) (eventbuscommon.SensorDriver, error) {
+	auth, err := getAuth(ctx, eventBusConfig, authPath)
 	if err != nil {
 		return nil, err
 	}
@@ -96,19 +115,31 @@ func GetSensorDriver(ctx context.Context, eventBusConfig eventbusv1alpha1.BusCon
 	switch eventBusType {
 	case apicommon.EventBusNATS:
 		dvr = stansensor.NewSensorSTAN(eventBusConfig.NATS.URL, *eventBusConfig.NATS.ClusterID, sensorSpec.Name, auth, logger)
-		return dvr, nil
 	case apicommon.EventBusJetStream:
 		dvr, err = jetstreamsensor.NewSensorJetstream(eventBusConfig.JetStream.URL, sensorSpec, eventBusConfig.JetStream.StreamConfig, auth, logger) // don't need to pass in subject because subjects will be derived from dependencies
-		return dvr, err
 	case apicommon.EventBusKafka:
-		dvr = kafkasensor.NewKafkaSensor(eventBusConfig.Kafka, sensorSpec, hostname, logger)
-		return dvr, nil
+		dvr, err = kafkasensor.NewKafkaSensor(eventBusConfig.Kafka, sensorSpec, hostname, eventBusConfig.Format, logger)
 	default:
 		return nil, fmt.Errorf("invalid eventbus type")
 	}
+	if err != nil {
+		return nil, err
+	}
+	faultConfig, err := faults.ConfigFromEnv()
+	if err != nil {
+		return nil, err
+	}
+	if faultConfig != nil {
+		dvr = faults.WrapSensorDriver(dvr, faultConfig, logger)
+	}
+	return dvr, nil
 }
 
 func GetAuth(ctx context.Context, eventBusConfig eventbusv1alpha1.BusConfig) (*eventbuscommon.Auth, error) {
+	return getAuth(ctx, eventBusConfig, common.EventBusAuthFileMountPath)
+}
+
+func getAuth(ctx context.Context, eventBusConfig eventbusv1alpha1.BusConfig, authPath string) (*eventbuscommon.Auth, error) {
 	logger := logging.FromContext(ctx)
 
 	var eventBusAuth *eventbusv1alpha1.AuthStrategy
@@ -116,6 +147,13 @@ func GetAuth(ctx context.Context, eventBusConfig eventbusv1alpha1.BusConfig) (*e
 	case eventBusConfig.NATS != nil:
 		eventBusAuth = eventBusConfig.NATS.Auth
 	case eventBusConfig.JetStream != nil:
+		if eventBusConfig.JetStream.SPIFFE != nil {
+			logger.Info("authenticating to the eventbus with the SPIFFE workload identity")
+			return &eventbuscommon.Auth{
+				Strategy: eventbusv1alpha1.AuthStrategyNone,
+				SPIFFE:   eventBusConfig.JetStream.SPIFFE,
+			}, nil
+		}
 		if eventBusConfig.JetStream.AccessSecret != nil {
 			eventBusAuth = &eventbusv1alpha1.AuthStrategyBasic
 		} else {
@@ -136,7 +174,7 @@ func GetAuth(ctx context.Context, eventBusConfig eventbusv1alpha1.BusConfig) (*e
 		v := common.ViperWithLogging()
 		v.SetConfigName("auth")
 		v.SetConfigType("yaml")
-		v.AddConfigPath(common.EventBusAuthFileMountPath)
+		v.AddConfigPath(authPath)
 		err := v.ReadInConfig()
 		if err != nil {
 			return nil, fmt.Errorf("failed to load auth.yaml. err: %w", err)
@@ -146,6 +184,10 @@ func GetAuth(ctx context.Context, eventBusConfig eventbusv1alpha1.BusConfig) (*e
 			logger.Errorw("failed to unmarshal auth.yaml", zap.Error(err))
 			return nil, err
 		}
+		if eventBusConfig.JetStream != nil && cred.Username == "" && cred.Token != "" {
+			// Credentials of a remote JetStream EventBus may come with a token instead of username and password.
+			eventBusAuth = &eventbusv1alpha1.AuthStrategyToken
+		}
 		v.WatchConfig()
 		v.OnConfigChange(func(e fsnotify.Event) {
 			// Auth file changed, let it restart
@@ -156,6 +198,9 @@ func GetAuth(ctx context.Context, eventBusConfig eventbusv1alpha1.BusConfig) (*e
 			Credential: cred,
 		}
 	}
+	if eventBusConfig.JetStream != nil {
+		auth.TLS = eventBusConfig.JetStream.TLS
+	}
 
 	return auth, nil
 }
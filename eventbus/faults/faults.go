@@ -0,0 +1,255 @@
+// Package faults injects faults in the EventBus drivers, to run resilience tests of the EventSources and Sensors
+// against a healthy EventBus. It is enabled with the EVENTBUS_FAULT_INJECTION environment variable.
+package faults
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"go.uber.org/zap"
+
+	eventbuscommon "github.com/argoproj/argo-events/eventbus/common"
+)
+
+// EnvVarFaultInjection is the environment variable enabling the fault injection, with a comma separated
+// list of faults, e.g. "publishDropRate=0.1,ackDelay=2s,reconnectInterval=10m".
+const EnvVarFaultInjection = "EVENTBUS_FAULT_INJECTION"
+
+// Config is the configuration of the injected faults.
+type Config struct {
+	// PublishDropRate is the ratio of the published messages silently dropped, between 0 and 1.
+	PublishDropRate float64
+	// PublishErrorRate is the ratio of the publishes failing with an error, between 0 and 1.
+	PublishErrorRate float64
+	// AckDelay delays the processing of each received message, and thus its acknowledgement.
+	AckDelay time.Duration
+	// ReconnectInterval is the interval the connections are closed at, to force reconnections.
+	ReconnectInterval time.Duration
+}
+
+// ConfigFromEnv returns the configuration of the injected faults from EnvVarFaultInjection, nil if it is not set.
+func ConfigFromEnv() (*Config, error) {
+	value, ok := os.LookupEnv(EnvVarFaultInjection)
+	if !ok || value == "" {
+		return nil, nil
+	}
+	return ParseConfig(value)
+}
+
+// ParseConfig parses the value of EnvVarFaultInjection.
+func ParseConfig(value string) (*Config, error) {
+	c := &Config{}
+	for _, fault := range strings.Split(value, ",") {
+		fault = strings.TrimSpace(fault)
+		if fault == "" {
+			continue
+		}
+		key, val, ok := strings.Cut(fault, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid fault %q, it should be key=value", fault)
+		}
+		var err error
+		switch key {
+		case "publishDropRate":
+			c.PublishDropRate, err = parseRate(val)
+		case "publishErrorRate":
+			c.PublishErrorRate, err = parseRate(val)
+		case "ackDelay":
+			c.AckDelay, err = time.ParseDuration(val)
+		case "reconnectInterval":
+			c.ReconnectInterval, err = time.ParseDuration(val)
+		default:
+			return nil, fmt.Errorf("unknown fault %q", key)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid fault %q, %w", fault, err)
+		}
+	}
+	return c, nil
+}
+
+func parseRate(val string) (float64, error) {
+	rate, err := strconv.ParseFloat(val, 64)
+	if err != nil {
+		return 0, err
+	}
+	if rate < 0 || rate > 1 {
+		return 0, fmt.Errorf("rate should be between 0 and 1")
+	}
+	return rate, nil
+}
+
+type injector struct {
+	config *Config
+	logger *zap.SugaredLogger
+
+	mu   sync.Mutex
+	rand *rand.Rand
+}
+
+// hit returns true with the probability of the rate.
+func (i *injector) hit(rate float64) bool {
+	if rate <= 0 {
+		return false
+	}
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	return i.rand.Float64() < rate
+}
+
+// WrapEventSourceDriver returns the driver with the configured faults injected in its connections.
+func WrapEventSourceDriver(driver eventbuscommon.EventSourceDriver, config *Config, logger *zap.SugaredLogger) eventbuscommon.EventSourceDriver {
+	logger.Warnw("EventBus fault injection is enabled", zap.Any("faults", config))
+	return &eventSourceDriver{EventSourceDriver: driver, injector: newInjector(config, logger)}
+}
+
+// WrapSensorDriver returns the driver with the configured faults injected in its connections.
+func WrapSensorDriver(driver eventbuscommon.SensorDriver, config *Config, logger *zap.SugaredLogger) eventbuscommon.SensorDriver {
+	logger.Warnw("EventBus fault injection is enabled", zap.Any("faults", config))
+	d := &sensorDriver{SensorDriver: driver, injector: newInjector(config, logger)}
+	deduplicator, isDeduplicator := driver.(eventbuscommon.Deduplicator)
+	batchStore, isBatchStore := driver.(eventbuscommon.BatchStore)
+	switch {
+	case isDeduplicator && isBatchStore:
+		return &keyValueSensorDriver{sensorDriver: d, Deduplicator: deduplicator, BatchStore: batchStore}
+	case isDeduplicator:
+		return &deduplicatingSensorDriver{sensorDriver: d, Deduplicator: deduplicator}
+	}
+	return d
+}
+
+func newInjector(config *Config, logger *zap.SugaredLogger) *injector {
+	return &injector{config: config, logger: logger, rand: rand.New(rand.NewSource(time.Now().UnixNano()))}
+}
+
+type eventSourceDriver struct {
+	eventbuscommon.EventSourceDriver
+	injector *injector
+}
+
+func (d *eventSourceDriver) Connect(clientID string) (eventbuscommon.EventSourceConnection, error) {
+	conn, err := d.EventSourceDriver.Connect(clientID)
+	if err != nil {
+		return nil, err
+	}
+	return &eventSourceConnection{EventSourceConnection: conn, injector: d.injector, closeAt: d.injector.closeAt()}, nil
+}
+
+type sensorDriver struct {
+	eventbuscommon.SensorDriver
+	injector *injector
+}
+
+func (d *sensorDriver) Connect(ctx context.Context, triggerName string, dependencyExpression string, deps []eventbuscommon.Dependency, atLeastOnce bool) (eventbuscommon.TriggerConnection, error) {
+	conn, err := d.SensorDriver.Connect(ctx, triggerName, dependencyExpression, deps, atLeastOnce)
+	if err != nil {
+		return nil, err
+	}
+	return &triggerConnection{TriggerConnection: conn, injector: d.injector, closeAt: d.injector.closeAt()}, nil
+}
+
+// MissingSubjects keeps the subjects check of the wrapped driver.
+func (d *sensorDriver) MissingSubjects(deps []eventbuscommon.Dependency) ([]string, error) {
+	if checker, ok := d.SensorDriver.(eventbuscommon.SubjectsChecker); ok {
+		return checker.MissingSubjects(deps)
+	}
+	return nil, nil
+}
+
+type deduplicatingSensorDriver struct {
+	*sensorDriver
+	eventbuscommon.Deduplicator
+}
+
+// keyValueSensorDriver keeps the optional interfaces of the drivers having a Key/Value store.
+type keyValueSensorDriver struct {
+	*sensorDriver
+	eventbuscommon.Deduplicator
+	eventbuscommon.BatchStore
+}
+
+// closeAt returns the time a new connection is closed at, zero if the reconnections are not forced.
+func (i *injector) closeAt() time.Time {
+	if i.config.ReconnectInterval <= 0 {
+		return time.Time{}
+	}
+	return time.Now().Add(i.config.ReconnectInterval)
+}
+
+// expired closes the connection if its forced reconnection is due.
+func (i *injector) expired(conn eventbuscommon.Connection, closeAt time.Time) bool {
+	if closeAt.IsZero() || time.Now().Before(closeAt) {
+		return false
+	}
+	if !conn.IsClosed() {
+		i.logger.Warn("fault injection: closing the EventBus connection to force a reconnection")
+		if err := conn.Close(); err != nil {
+			i.logger.Errorw("fault injection: failed to close the EventBus connection", zap.Error(err))
+		}
+	}
+	return true
+}
+
+type eventSourceConnection struct {
+	eventbuscommon.EventSourceConnection
+	injector *injector
+	closeAt  time.Time
+}
+
+func (c *eventSourceConnection) IsClosed() bool {
+	return c.injector.expired(c.EventSourceConnection, c.closeAt) || c.EventSourceConnection.IsClosed()
+}
+
+func (c *eventSourceConnection) Publish(ctx context.Context, msg eventbuscommon.Message) error {
+	if c.injector.hit(c.injector.config.PublishErrorRate) {
+		c.injector.logger.Warnw("fault injection: failing a publish", "eventName", msg.EventName)
+		return fmt.Errorf("fault injection: publish failed")
+	}
+	if c.injector.hit(c.injector.config.PublishDropRate) {
+		c.injector.logger.Warnw("fault injection: dropping a publish", "eventName", msg.EventName)
+		return nil
+	}
+	return c.EventSourceConnection.Publish(ctx, msg)
+}
+
+type triggerConnection struct {
+	eventbuscommon.TriggerConnection
+	injector *injector
+	closeAt  time.Time
+}
+
+func (c *triggerConnection) IsClosed() bool {
+	return c.injector.expired(c.TriggerConnection, c.closeAt) || c.TriggerConnection.IsClosed()
+}
+
+func (c *triggerConnection) Subscribe(ctx context.Context,
+	closeCh <-chan struct{},
+	resetConditionsCh <-chan struct{},
+	lastResetTime time.Time,
+	transform func(depName string, event cloudevents.Event) (*cloudevents.Event, error),
+	filter func(string, cloudevents.Event) bool,
+	action func(map[string]cloudevents.Event),
+	defaultSubject *string) error {
+	if delay := c.injector.config.AckDelay; delay > 0 {
+		// The messages are acknowledged after they are processed
+		next := transform
+		transform = func(depName string, event cloudevents.Event) (*cloudevents.Event, error) {
+			time.Sleep(delay)
+			return next(depName, event)
+		}
+	}
+	return c.TriggerConnection.Subscribe(ctx, closeCh, resetConditionsCh, lastResetTime, transform, filter, action, defaultSubject)
+}
+
+func (c *triggerConnection) ObserveConditions(observe eventbuscommon.ConditionsObserveFunc) {
+	if observer, ok := c.TriggerConnection.(eventbuscommon.ConditionsObserver); ok {
+		observer.ObserveConditions(observe)
+	}
+}
@@ -0,0 +1,177 @@
+package faults
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/argoproj/argo-events/common/logging"
+	eventbuscommon "github.com/argoproj/argo-events/eventbus/common"
+)
+
+type fakeConnection struct {
+	closed    bool
+	published int
+}
+
+func (c *fakeConnection) Close() error {
+	c.closed = true
+	return nil
+}
+
+func (c *fakeConnection) IsClosed() bool {
+	return c.closed
+}
+
+func (c *fakeConnection) Publish(ctx context.Context, msg eventbuscommon.Message) error {
+	c.published++
+	return nil
+}
+
+type fakeEventSourceDriver struct {
+	conn *fakeConnection
+}
+
+func (d *fakeEventSourceDriver) Initialize() error {
+	return nil
+}
+
+func (d *fakeEventSourceDriver) Connect(clientID string) (eventbuscommon.EventSourceConnection, error) {
+	return d.conn, nil
+}
+
+type fakeSensorDriver struct{}
+
+func (d *fakeSensorDriver) Initialize() error {
+	return nil
+}
+
+func (d *fakeSensorDriver) Connect(ctx context.Context, triggerName string, dependencyExpression string, deps []eventbuscommon.Dependency, atLeastOnce bool) (eventbuscommon.TriggerConnection, error) {
+	return nil, nil
+}
+
+type fakeDeduplicatingSensorDriver struct {
+	fakeSensorDriver
+}
+
+func (d *fakeDeduplicatingSensorDriver) ClaimKey(triggerName, key string, window time.Duration) (bool, error) {
+	return true, nil
+}
+
+func (d *fakeDeduplicatingSensorDriver) ReleaseKey(triggerName, key string, window time.Duration) error {
+	return nil
+}
+
+type fakeKeyValueSensorDriver struct {
+	fakeDeduplicatingSensorDriver
+}
+
+func (d *fakeKeyValueSensorDriver) LoadBatch(triggerName string) (map[string][]byte, error) {
+	return nil, nil
+}
+
+func (d *fakeKeyValueSensorDriver) SaveBatchExecution(triggerName, key string, execution []byte) error {
+	return nil
+}
+
+func (d *fakeKeyValueSensorDriver) DeleteBatchExecutions(triggerName string, keys []string) error {
+	return nil
+}
+
+type fakeTriggerConnection struct {
+	fakeConnection
+}
+
+func (c *fakeTriggerConnection) String() string {
+	return "fake"
+}
+
+func (c *fakeTriggerConnection) Subscribe(ctx context.Context, closeCh <-chan struct{}, resetConditionsCh <-chan struct{}, lastResetTime time.Time,
+	transform func(depName string, event cloudevents.Event) (*cloudevents.Event, error), filter func(string, cloudevents.Event) bool,
+	action func(map[string]cloudevents.Event), defaultSubject *string) error {
+	_, err := transform("dep", cloudevents.NewEvent())
+	return err
+}
+
+func TestParseConfig(t *testing.T) {
+	c, err := ParseConfig("publishDropRate=0.1, publishErrorRate=1,ackDelay=2s,reconnectInterval=10m")
+	assert.NoError(t, err)
+	assert.Equal(t, &Config{PublishDropRate: 0.1, PublishErrorRate: 1, AckDelay: 2 * time.Second, ReconnectInterval: 10 * time.Minute}, c)
+
+	_, err = ParseConfig("publishDropRate=2")
+	assert.ErrorContains(t, err, "rate should be between 0 and 1")
+	_, err = ParseConfig("dropEverything=true")
+	assert.ErrorContains(t, err, "unknown fault")
+	_, err = ParseConfig("ackDelay")
+	assert.ErrorContains(t, err, "it should be key=value")
+
+	t.Setenv(EnvVarFaultInjection, "")
+	c, err = ConfigFromEnv()
+	assert.NoError(t, err)
+	assert.Nil(t, c)
+}
+
+func TestEventSourceConnection(t *testing.T) {
+	logger := logging.NewArgoEventsLogger()
+	ctx := context.Background()
+
+	t.Run("publish errors", func(t *testing.T) {
+		inner := &fakeConnection{}
+		conn, err := WrapEventSourceDriver(&fakeEventSourceDriver{conn: inner}, &Config{PublishErrorRate: 1}, logger).Connect("test")
+		assert.NoError(t, err)
+		assert.ErrorContains(t, conn.Publish(ctx, eventbuscommon.Message{}), "fault injection")
+		assert.Equal(t, 0, inner.published)
+	})
+
+	t.Run("publish drops", func(t *testing.T) {
+		inner := &fakeConnection{}
+		conn, err := WrapEventSourceDriver(&fakeEventSourceDriver{conn: inner}, &Config{PublishDropRate: 1}, logger).Connect("test")
+		assert.NoError(t, err)
+		assert.NoError(t, conn.Publish(ctx, eventbuscommon.Message{}))
+		assert.Equal(t, 0, inner.published)
+	})
+
+	t.Run("no faults", func(t *testing.T) {
+		inner := &fakeConnection{}
+		conn, err := WrapEventSourceDriver(&fakeEventSourceDriver{conn: inner}, &Config{}, logger).Connect("test")
+		assert.NoError(t, err)
+		assert.NoError(t, conn.Publish(ctx, eventbuscommon.Message{}))
+		assert.Equal(t, 1, inner.published)
+		assert.False(t, conn.IsClosed())
+	})
+
+	t.Run("forced reconnections", func(t *testing.T) {
+		inner := &fakeConnection{}
+		conn, err := WrapEventSourceDriver(&fakeEventSourceDriver{conn: inner}, &Config{ReconnectInterval: time.Nanosecond}, logger).Connect("test")
+		assert.NoError(t, err)
+		time.Sleep(time.Millisecond)
+		assert.True(t, conn.IsClosed())
+		assert.True(t, inner.closed)
+	})
+}
+
+func TestSensorDriver(t *testing.T) {
+	logger := logging.NewArgoEventsLogger()
+
+	_, ok := WrapSensorDriver(&fakeSensorDriver{}, &Config{}, logger).(eventbuscommon.Deduplicator)
+	assert.False(t, ok)
+	_, ok = WrapSensorDriver(&fakeDeduplicatingSensorDriver{}, &Config{}, logger).(eventbuscommon.Deduplicator)
+	assert.True(t, ok)
+	_, ok = WrapSensorDriver(&fakeDeduplicatingSensorDriver{}, &Config{}, logger).(eventbuscommon.BatchStore)
+	assert.False(t, ok)
+	driver := WrapSensorDriver(&fakeKeyValueSensorDriver{}, &Config{}, logger)
+	_, ok = driver.(eventbuscommon.Deduplicator)
+	assert.True(t, ok)
+	_, ok = driver.(eventbuscommon.BatchStore)
+	assert.True(t, ok)
+
+	conn := &triggerConnection{TriggerConnection: &fakeTriggerConnection{}, injector: newInjector(&Config{AckDelay: 50 * time.Millisecond}, logger)}
+	start := time.Now()
+	assert.NoError(t, conn.Subscribe(context.Background(), nil, nil, time.Now(), func(depName string, event cloudevents.Event) (*cloudevents.Event, error) {
+		return &event, nil
+	}, nil, nil, nil))
+	assert.GreaterOrEqual(t, time.Since(start), 50*time.Millisecond)
+}
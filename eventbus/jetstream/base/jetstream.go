@@ -67,9 +67,21 @@ func (stream *Jetstream) MakeConnection() (*JetstreamConnection, error) {
 			conn.NATSConnected = true
 			log.Info("Reconnected to NATS server")
 		}),
-		nats.Secure(&tls.Config{
+	}
+
+	if stream.auth.SPIFFE != nil {
+		log.Info("NATS auth strategy: SPIFFE")
+		opts = append(opts, SPIFFEOptions(stream.auth.SPIFFE)...)
+	} else if stream.auth.TLS != nil {
+		tlsConfig, err := common.GetTLSConfig(stream.auth.TLS)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get the tls configuration, %w", err)
+		}
+		opts = append(opts, nats.Secure(tlsConfig))
+	} else {
+		opts = append(opts, nats.Secure(&tls.Config{
 			InsecureSkipVerify: true,
-		}),
+		}))
 	}
 
 	switch stream.auth.Strategy {
@@ -80,7 +92,9 @@ func (stream *Jetstream) MakeConnection() (*JetstreamConnection, error) {
 		log.Info("NATS auth strategy: Basic")
 		opts = append(opts, nats.UserInfo(stream.auth.Credential.Username, stream.auth.Credential.Password))
 	case eventbusv1alpha1.AuthStrategyNone:
-		log.Info("NATS auth strategy: None")
+		if stream.auth.SPIFFE == nil {
+			log.Info("NATS auth strategy: None")
+		}
 	default:
 		return nil, fmt.Errorf("unsupported auth strategy")
 	}
@@ -113,7 +127,7 @@ func (stream *Jetstream) CreateStream(conn *JetstreamConnection) error {
 	streamInfo, err := conn.JSContext.StreamInfo(common.JetStreamStreamName)
 	if streamInfo != nil && err == nil {
 		stream.Logger.Infof("No need to create Stream '%s' as it already exists", common.JetStreamStreamName)
-		return nil
+		return stream.updateStreamMaxBytes(conn, streamInfo)
 	}
 	if err != nil && err != nats.ErrStreamNotFound {
 		stream.Logger.Warnf(`Error calling StreamInfo for Stream '%s' (this can happen if another Jetstream client "
@@ -155,7 +169,7 @@ func (stream *Jetstream) CreateStream(conn *JetstreamConnection) error {
 	}
 	stream.Logger.Infof("Will use this stream config:\n '%v'", streamConfig)
 
-	connectErr := common.DoWithRetry(nil, func() error { // exponential backoff if it fails the first time
+	connectErr := common.DoWithTrackedRetry("eventbus.stream", streamConfig.Name, nil, func() error { // exponential backoff if it fails the first time
 		_, err = conn.JSContext.AddStream(&streamConfig)
 		if err != nil {
 			errStr := fmt.Sprintf(`Failed to add Jetstream stream '%s'for connection %+v: err=%v`,
@@ -173,6 +187,26 @@ func (stream *Jetstream) CreateStream(conn *JetstreamConnection) error {
 	return nil
 }
 
+// updateStreamMaxBytes updates the max bytes of an existing Stream, which follows the size of the EventBus volumes.
+func (stream *Jetstream) updateStreamMaxBytes(conn *JetstreamConnection, streamInfo *nats.StreamInfo) error {
+	v := viper.New()
+	v.SetConfigType("yaml")
+	if err := v.ReadConfig(bytes.NewBufferString(stream.streamSettings)); err != nil {
+		return err
+	}
+	maxBytes := v.GetInt64("maxBytes")
+	if maxBytes == 0 || streamInfo.Config.MaxBytes == maxBytes {
+		return nil
+	}
+	streamConfig := streamInfo.Config
+	streamConfig.MaxBytes = maxBytes
+	if _, err := conn.JSContext.UpdateStream(&streamConfig); err != nil {
+		return fmt.Errorf("failed to update the max bytes of Stream '%s' from %d to %d, %w", common.JetStreamStreamName, streamInfo.Config.MaxBytes, maxBytes, err)
+	}
+	stream.Logger.Infof("Updated the max bytes of Stream '%s' from %d to %d", common.JetStreamStreamName, streamInfo.Config.MaxBytes, maxBytes)
+	return nil
+}
+
 func intToRetentionPolicy(i int) (nats.RetentionPolicy, error) {
 	if i < 0 || i > int(nats.WorkQueuePolicy) {
 		// Handle invalid value, return a default value or panic
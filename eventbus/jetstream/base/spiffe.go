@@ -0,0 +1,73 @@
+package base
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+	"path"
+
+	nats "github.com/nats-io/nats.go"
+
+	"github.com/argoproj/argo-events/common"
+	eventbusv1alpha1 "github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1"
+)
+
+// SPIFFEOptions returns the options of the connections authenticating with the SPIFFE X.509 SVID of the pod.
+// The files of the SVID are read on each connection, as the CSI driver rotates them.
+func SPIFFEOptions(config *eventbusv1alpha1.SPIFFEConfig) []nats.Option {
+	caFile := path.Join(common.EventBusSPIFFEMountPath, common.SPIFFECACertFile)
+	return []nats.Option{
+		nats.Secure(&tls.Config{
+			MinVersion: tls.VersionTLS12,
+			// The server certificate holds a SPIFFE ID instead of a host name, it is verified below
+			InsecureSkipVerify: true,
+			VerifyPeerCertificate: func(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+				return verifySPIFFEPeer(rawCerts, caFile, config.ServerID)
+			},
+		}),
+		nats.ClientCert(path.Join(common.EventBusSPIFFEMountPath, common.SPIFFECertFile), path.Join(common.EventBusSPIFFEMountPath, common.SPIFFEKeyFile)),
+	}
+}
+
+// verifySPIFFEPeer verifies the certificate chain of the server against the trust bundle in caFile, and its
+// SPIFFE ID if serverID is not empty.
+func verifySPIFFEPeer(rawCerts [][]byte, caFile, serverID string) error {
+	if len(rawCerts) == 0 {
+		return fmt.Errorf("no server certificate")
+	}
+	caPEM, err := os.ReadFile(caFile)
+	if err != nil {
+		return fmt.Errorf("failed to read the SPIFFE trust bundle, %w", err)
+	}
+	roots := x509.NewCertPool()
+	if !roots.AppendCertsFromPEM(caPEM) {
+		return fmt.Errorf("no certificate found in the SPIFFE trust bundle")
+	}
+	certs := make([]*x509.Certificate, len(rawCerts))
+	intermediates := x509.NewCertPool()
+	for i, raw := range rawCerts {
+		if certs[i], err = x509.ParseCertificate(raw); err != nil {
+			return fmt.Errorf("failed to parse the server certificate, %w", err)
+		}
+		if i > 0 {
+			intermediates.AddCert(certs[i])
+		}
+	}
+	if _, err := certs[0].Verify(x509.VerifyOptions{
+		Roots:         roots,
+		Intermediates: intermediates,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageAny},
+	}); err != nil {
+		return fmt.Errorf("failed to verify the server certificate, %w", err)
+	}
+	if serverID == "" {
+		return nil
+	}
+	for _, uri := range certs[0].URIs {
+		if uri.String() == serverID {
+			return nil
+		}
+	}
+	return fmt.Errorf("the server certificate does not hold the SPIFFE ID %s", serverID)
+}
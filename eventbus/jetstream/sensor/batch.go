@@ -0,0 +1,100 @@
+package sensor
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	nats "github.com/nats-io/nats.go"
+)
+
+// batchStore holds the Key/Value store for the batches of trigger executions, created on first use.
+type batchStore struct {
+	sync.Mutex
+	kv nats.KeyValue
+}
+
+// LoadBatch returns the persisted executions of the batch of the trigger by key, nil if there is none.
+func (stream *SensorJetstream) LoadBatch(triggerName string) (map[string][]byte, error) {
+	kv, err := stream.getBatchStore()
+	if err != nil {
+		return nil, err
+	}
+	keys, err := kv.Keys()
+	if err != nil {
+		if errors.Is(err, nats.ErrNoKeysFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to list the batch executions of trigger %s, %w", triggerName, err)
+	}
+	prefix := getBatchKey(triggerName, "")
+	var executions map[string][]byte
+	for _, key := range keys {
+		if !strings.HasPrefix(key, prefix) {
+			continue
+		}
+		entry, err := kv.Get(key)
+		if err != nil {
+			if errors.Is(err, nats.ErrKeyNotFound) {
+				continue
+			}
+			return nil, fmt.Errorf("failed to get the batch execution %s of trigger %s, %w", key, triggerName, err)
+		}
+		if executions == nil {
+			executions = make(map[string][]byte)
+		}
+		executions[strings.TrimPrefix(key, prefix)] = entry.Value()
+	}
+	return executions, nil
+}
+
+// SaveBatchExecution persists an execution added to the batch of the trigger.
+func (stream *SensorJetstream) SaveBatchExecution(triggerName, key string, execution []byte) error {
+	kv, err := stream.getBatchStore()
+	if err != nil {
+		return err
+	}
+	if _, err := kv.Put(getBatchKey(triggerName, key), execution); err != nil {
+		return fmt.Errorf("failed to store the batch execution %s of trigger %s, %w", key, triggerName, err)
+	}
+	return nil
+}
+
+// DeleteBatchExecutions deletes the executions of the batch of the trigger, once they are flushed.
+func (stream *SensorJetstream) DeleteBatchExecutions(triggerName string, keys []string) error {
+	kv, err := stream.getBatchStore()
+	if err != nil {
+		return err
+	}
+	for _, key := range keys {
+		if err := kv.Delete(getBatchKey(triggerName, key)); err != nil && !errors.Is(err, nats.ErrKeyNotFound) {
+			return fmt.Errorf("failed to delete the batch execution %s of trigger %s, %w", key, triggerName, err)
+		}
+	}
+	return nil
+}
+
+func (stream *SensorJetstream) getBatchStore() (nats.KeyValue, error) {
+	stream.batches.Lock()
+	defer stream.batches.Unlock()
+	if stream.batches.kv != nil {
+		return stream.batches.kv, nil
+	}
+	bucket := fmt.Sprintf("%s-batches", stream.sensorName)
+	kv, _ := stream.MgmtConnection.JSContext.KeyValue(bucket)
+	if kv == nil {
+		var err error
+		kv, err = stream.MgmtConnection.JSContext.CreateKeyValue(&nats.KeyValueConfig{Bucket: bucket})
+		if err != nil {
+			return nil, fmt.Errorf("failed to create batch Key/Value store %s, %w", bucket, err)
+		}
+		stream.Logger.Infof("created batch K/V store %s", bucket)
+	}
+	stream.batches.kv = kv
+	return kv, nil
+}
+
+func getBatchKey(triggerName, key string) string {
+	return fmt.Sprintf("%s/%s", triggerName, key)
+}
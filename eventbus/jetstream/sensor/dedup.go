@@ -0,0 +1,74 @@
+package sensor
+
+import (
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	nats "github.com/nats-io/nats.go"
+)
+
+// dedupStores holds the Key/Value stores for the deduplication keys, one per window,
+// because the TTL of the keys is configured on the Key/Value store.
+type dedupStores struct {
+	sync.Mutex
+	stores map[time.Duration]nats.KeyValue
+}
+
+// ClaimKey records the idempotency key of the trigger unless it is already recorded within the window, it expires
+// after the window. The key is created atomically, so that only one of the concurrent executions claims it.
+func (stream *SensorJetstream) ClaimKey(triggerName, key string, window time.Duration) (bool, error) {
+	kv, err := stream.getDedupStore(window)
+	if err != nil {
+		return false, err
+	}
+	if _, err := kv.Create(getDedupKey(triggerName, key), []byte(time.Now().UTC().Format(time.RFC3339))); err != nil {
+		if errors.Is(err, nats.ErrKeyExists) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to store deduplication key for trigger %s, %w", triggerName, err)
+	}
+	return true, nil
+}
+
+// ReleaseKey deletes the idempotency key of the trigger, so that the redeliveries of a failed execution are not
+// dropped as duplicates.
+func (stream *SensorJetstream) ReleaseKey(triggerName, key string, window time.Duration) error {
+	kv, err := stream.getDedupStore(window)
+	if err != nil {
+		return err
+	}
+	if err := kv.Delete(getDedupKey(triggerName, key)); err != nil {
+		return fmt.Errorf("failed to delete deduplication key for trigger %s, %w", triggerName, err)
+	}
+	return nil
+}
+
+func (stream *SensorJetstream) getDedupStore(window time.Duration) (nats.KeyValue, error) {
+	stream.dedup.Lock()
+	defer stream.dedup.Unlock()
+	if kv, ok := stream.dedup.stores[window]; ok {
+		return kv, nil
+	}
+	bucket := fmt.Sprintf("%s-dedup-%d", stream.sensorName, int64(window.Seconds()))
+	kv, _ := stream.MgmtConnection.JSContext.KeyValue(bucket)
+	if kv == nil {
+		var err error
+		kv, err = stream.MgmtConnection.JSContext.CreateKeyValue(&nats.KeyValueConfig{Bucket: bucket, TTL: window})
+		if err != nil {
+			return nil, fmt.Errorf("failed to create deduplication Key/Value store %s, %w", bucket, err)
+		}
+		stream.Logger.Infof("created deduplication K/V store %s", bucket)
+	}
+	if stream.dedup.stores == nil {
+		stream.dedup.stores = make(map[time.Duration]nats.KeyValue)
+	}
+	stream.dedup.stores[window] = kv
+	return kv, nil
+}
+
+func getDedupKey(triggerName, key string) string {
+	return fmt.Sprintf("%s/%x", triggerName, sha256.Sum256([]byte(key)))
+}
@@ -0,0 +1,92 @@
+package sensor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	nats "github.com/nats-io/nats.go"
+	"go.uber.org/zap"
+)
+
+// partitionTTL is how long a partition stays claimed once its holder stops refreshing it, e.g. the pod is gone.
+const partitionTTL = 15 * time.Second
+
+// ClaimPartition claims one of the partitions of the dependencies for the holder, it waits until one is free. The
+// partitions are keys of a Key/Value store expiring after partitionTTL, the holder refreshes its key until the
+// context is done, and deletes it then to hand the partition over right away.
+func (stream *SensorJetstream) ClaimPartition(ctx context.Context, holder string, partitions int) (int, <-chan struct{}, error) {
+	bucket := fmt.Sprintf("%s-partitions", stream.sensorName)
+	kv, _ := stream.MgmtConnection.JSContext.KeyValue(bucket)
+	if kv == nil {
+		var err error
+		kv, err = stream.MgmtConnection.JSContext.CreateKeyValue(&nats.KeyValueConfig{Bucket: bucket, TTL: partitionTTL})
+		if err != nil {
+			return 0, nil, fmt.Errorf("failed to create partition Key/Value store %s, %w", bucket, err)
+		}
+		stream.Logger.Infof("created partition K/V store %s", bucket)
+	}
+	return claimPartition(ctx, kv, holder, partitions, partitionTTL, stream.Logger)
+}
+
+func claimPartition(ctx context.Context, kv nats.KeyValue, holder string, partitions int, ttl time.Duration, logger *zap.SugaredLogger) (int, <-chan struct{}, error) {
+	ticker := time.NewTicker(ttl / 3)
+	defer ticker.Stop()
+	for {
+		for partition := 0; partition < partitions; partition++ {
+			key := getPartitionKey(partitions, partition)
+			revision, err := kv.Create(key, []byte(holder))
+			if err == nil {
+				lost := make(chan struct{})
+				go holdPartition(ctx, kv, key, holder, revision, ttl, lost, logger)
+				return partition, lost, nil
+			}
+			if !errors.Is(err, nats.ErrKeyExists) {
+				return 0, nil, fmt.Errorf("failed to claim partition %s, %w", key, err)
+			}
+		}
+		logger.Debugf("all the %d partitions are claimed, waiting for one to be released", partitions)
+		select {
+		case <-ctx.Done():
+			return 0, nil, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// holdPartition refreshes the key of the partition, the refreshes may fail as long as the key has not expired yet,
+// the lost channel is closed once it might have.
+func holdPartition(ctx context.Context, kv nats.KeyValue, key, holder string, revision uint64, ttl time.Duration, lost chan<- struct{}, logger *zap.SugaredLogger) {
+	refresh := ttl / 3
+	ticker := time.NewTicker(refresh)
+	defer ticker.Stop()
+	refreshed := time.Now()
+	for {
+		select {
+		case <-ctx.Done():
+			if err := kv.Delete(key, nats.LastRevision(revision)); err != nil {
+				logger.Warnw("failed to release the partition, it is released once it expires", "partition", key, zap.Error(err))
+			}
+			return
+		case <-ticker.C:
+			rev, err := kv.Update(key, []byte(holder), revision)
+			if err == nil {
+				revision = rev
+				refreshed = time.Now()
+				continue
+			}
+			logger.Errorw("failed to refresh the partition", "partition", key, zap.Error(err))
+			if time.Since(refreshed)+refresh >= ttl {
+				close(lost)
+				return
+			}
+		}
+	}
+}
+
+// getPartitionKey returns the key of the partition, the number of partitions is part of it, so that the replicas of a
+// Sensor scaled up or down don't wait for the partitions of the previous replicas.
+func getPartitionKey(partitions, partition int) string {
+	return fmt.Sprintf("%d/%d", partitions, partition)
+}
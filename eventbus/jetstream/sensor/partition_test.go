@@ -0,0 +1,127 @@
+package sensor
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	nats "github.com/nats-io/nats.go"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/argoproj/argo-events/common/logging"
+)
+
+// fakePartitionKV keeps the revisions of the keys, the keys don't expire
+type fakePartitionKV struct {
+	nats.KeyValue
+	sync.Mutex
+	revisions map[string]uint64
+	revision  uint64
+	failing   bool
+}
+
+func (kv *fakePartitionKV) Create(key string, value []byte) (uint64, error) {
+	kv.Lock()
+	defer kv.Unlock()
+	if _, ok := kv.revisions[key]; ok {
+		return 0, nats.ErrKeyExists
+	}
+	kv.revision++
+	kv.revisions[key] = kv.revision
+	return kv.revision, nil
+}
+
+func (kv *fakePartitionKV) Update(key string, value []byte, last uint64) (uint64, error) {
+	kv.Lock()
+	defer kv.Unlock()
+	if kv.failing {
+		return 0, errors.New("unavailable")
+	}
+	if kv.revisions[key] != last {
+		return 0, errors.New("wrong last sequence")
+	}
+	kv.revision++
+	kv.revisions[key] = kv.revision
+	return kv.revision, nil
+}
+
+func (kv *fakePartitionKV) Delete(key string, opts ...nats.DeleteOpt) error {
+	kv.Lock()
+	defer kv.Unlock()
+	delete(kv.revisions, key)
+	return nil
+}
+
+func (kv *fakePartitionKV) claimed() int {
+	kv.Lock()
+	defer kv.Unlock()
+	return len(kv.revisions)
+}
+
+func TestClaimPartition(t *testing.T) {
+	logger := logging.NewArgoEventsLogger()
+	kv := &fakePartitionKV{revisions: map[string]uint64{}}
+	ttl := 300 * time.Millisecond
+
+	t.Run("test claim the free partitions", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		first, _, err := claimPartition(ctx, kv, "pod-a", 2, ttl, logger)
+		assert.NoError(t, err)
+		second, _, err := claimPartition(ctx, kv, "pod-b", 2, ttl, logger)
+		assert.NoError(t, err)
+		assert.ElementsMatch(t, []int{0, 1}, []int{first, second})
+
+		// The previous replicas don't hold the partitions of another number of replicas
+		_, _, err = claimPartition(ctx, kv, "pod-c", 3, ttl, logger)
+		assert.NoError(t, err)
+	})
+
+	assert.Eventually(t, func() bool { return kv.claimed() == 0 }, time.Second, 10*time.Millisecond)
+
+	t.Run("test wait for a partition to be released", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		holderCtx, release := context.WithCancel(ctx)
+		_, _, err := claimPartition(holderCtx, kv, "pod-a", 1, ttl, logger)
+		assert.NoError(t, err)
+
+		claimed := make(chan int)
+		go func() {
+			partition, _, err := claimPartition(ctx, kv, "pod-b", 1, ttl, logger)
+			assert.NoError(t, err)
+			claimed <- partition
+		}()
+		select {
+		case <-claimed:
+			t.Fatal("the partition is claimed twice")
+		case <-time.After(2 * ttl):
+		}
+		release()
+		select {
+		case partition := <-claimed:
+			assert.Equal(t, 0, partition)
+		case <-time.After(time.Second):
+			t.Fatal("the released partition is not claimed")
+		}
+	})
+
+	assert.Eventually(t, func() bool { return kv.claimed() == 0 }, time.Second, 10*time.Millisecond)
+
+	t.Run("test lose the partition", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		_, lost, err := claimPartition(ctx, kv, "pod-a", 1, ttl, logger)
+		assert.NoError(t, err)
+		kv.Lock()
+		kv.failing = true
+		kv.Unlock()
+		select {
+		case <-lost:
+		case <-time.After(2 * ttl):
+			t.Fatal("the partition is not lost")
+		}
+	})
+}
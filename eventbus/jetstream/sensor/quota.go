@@ -0,0 +1,97 @@
+package sensor
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	nats "github.com/nats-io/nats.go"
+
+	"github.com/argoproj/argo-events/common"
+)
+
+// quotaStores holds the Key/Value stores for the executions counted by the quota of the Sensor, one per window,
+// because the TTL of the keys is configured on the Key/Value store.
+type quotaStores struct {
+	sync.Mutex
+	stores map[time.Duration]nats.KeyValue
+}
+
+// LoadExecutions returns the times of the persisted executions within the window, oldest first.
+func (stream *SensorJetstream) LoadExecutions(window time.Duration) ([]time.Time, error) {
+	kv, err := stream.getQuotaStore(window)
+	if err != nil {
+		return nil, err
+	}
+	keys, err := kv.Keys()
+	if err != nil {
+		if errors.Is(err, nats.ErrNoKeysFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to list the executions of the quota, %w", err)
+	}
+	since := time.Now().Add(-window)
+	executions := make([]time.Time, 0, len(keys))
+	for _, key := range keys {
+		at, err := parseQuotaKey(key)
+		if err != nil {
+			stream.Logger.Warnw("ignoring invalid quota execution key", "key", key, "error", err)
+			continue
+		}
+		if at.After(since) {
+			executions = append(executions, at)
+		}
+	}
+	sort.Slice(executions, func(i, j int) bool { return executions[i].Before(executions[j]) })
+	return executions, nil
+}
+
+// SaveExecution persists the time of an execution, it expires after the window.
+func (stream *SensorJetstream) SaveExecution(at time.Time, window time.Duration) error {
+	kv, err := stream.getQuotaStore(window)
+	if err != nil {
+		return err
+	}
+	// The executions of the replicas are told apart by a random suffix
+	if _, err := kv.Put(fmt.Sprintf("%d-%s", at.UnixNano(), common.RandomString(8)), nil); err != nil {
+		return fmt.Errorf("failed to store the execution of the quota, %w", err)
+	}
+	return nil
+}
+
+func (stream *SensorJetstream) getQuotaStore(window time.Duration) (nats.KeyValue, error) {
+	stream.quota.Lock()
+	defer stream.quota.Unlock()
+	if kv, ok := stream.quota.stores[window]; ok {
+		return kv, nil
+	}
+	bucket := fmt.Sprintf("%s-quota-%d", stream.sensorName, int64(window.Seconds()))
+	kv, _ := stream.MgmtConnection.JSContext.KeyValue(bucket)
+	if kv == nil {
+		var err error
+		kv, err = stream.MgmtConnection.JSContext.CreateKeyValue(&nats.KeyValueConfig{Bucket: bucket, TTL: window})
+		if err != nil {
+			return nil, fmt.Errorf("failed to create quota Key/Value store %s, %w", bucket, err)
+		}
+		stream.Logger.Infof("created quota K/V store %s", bucket)
+	}
+	if stream.quota.stores == nil {
+		stream.quota.stores = make(map[time.Duration]nats.KeyValue)
+	}
+	stream.quota.stores[window] = kv
+	return kv, nil
+}
+
+// parseQuotaKey returns the time of the execution of a quota key, "<unix nanoseconds>-<suffix>".
+func parseQuotaKey(key string) (time.Time, error) {
+	nanos, _, _ := strings.Cut(key, "-")
+	n, err := strconv.ParseInt(nanos, 10, 64)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return time.Unix(0, n), nil
+}
@@ -29,6 +29,9 @@ type SensorJetstream struct {
 	sensorName    string
 	sensorSpec    *v1alpha1.Sensor
 	keyValueStore nats.KeyValue
+	dedup         dedupStores
+	batches       batchStore
+	quota         quotaStores
 }
 
 func NewSensorJetstream(url string, sensorSpec *v1alpha1.Sensor, streamConfig string, auth *eventbuscommon.Auth, logger *zap.SugaredLogger) (*SensorJetstream, error) {
@@ -43,10 +46,10 @@ func NewSensorJetstream(url string, sensorSpec *v1alpha1.Sensor, streamConfig st
 		return nil, err
 	}
 	return &SensorJetstream{
-		baseJetstream,
-		sensorSpec.Name,
-		sensorSpec,
-		nil}, nil
+		Jetstream:  baseJetstream,
+		sensorName: sensorSpec.Name,
+		sensorSpec: sensorSpec,
+	}, nil
 }
 
 func (stream *SensorJetstream) Initialize() error {
@@ -82,7 +85,12 @@ func (stream *SensorJetstream) Connect(ctx context.Context, triggerName string,
 		return nil, err
 	}
 
-	return NewJetstreamTriggerConn(conn, stream.sensorName, triggerName, dependencyExpression, deps)
+	triggerConn, err := NewJetstreamTriggerConn(conn, stream.sensorName, triggerName, dependencyExpression, deps)
+	if err != nil {
+		return nil, err
+	}
+	triggerConn.ordered = stream.sensorSpec.Spec.RequiresOrdering
+	return triggerConn, nil
 }
 
 // Update the K/V store to reflect the current Spec:
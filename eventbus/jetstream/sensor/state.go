@@ -0,0 +1,273 @@
+package sensor
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	nats "github.com/nats-io/nats.go"
+
+	"github.com/argoproj/argo-events/common"
+)
+
+// SensorState is the durable state of a Sensor on a JetStream EventBus, exported to migrate the Sensor to another
+// cluster without losing the dependencies received so far.
+type SensorState struct {
+	// Sensor is the name of the Sensor.
+	Sensor string `json:"sensor"`
+	// ExportedAt is when the state was exported.
+	ExportedAt time.Time `json:"exportedAt"`
+	// KeyValues are the entries of the Key/Value store of the Sensor: the trigger list, the dependency
+	// definitions, the trigger expressions and the partially resolved dependencies.
+	KeyValues map[string][]byte `json:"keyValues,omitempty"`
+	// Batches are the entries of the batch Key/Value store of the Sensor, the pending batches of the triggers.
+	Batches map[string][]byte `json:"batches,omitempty"`
+	// Consumers are the positions of the durable consumers of the trigger dependencies.
+	Consumers []ConsumerPosition `json:"consumers,omitempty"`
+}
+
+// ConsumerPosition is the position of the durable consumer of a trigger dependency.
+type ConsumerPosition struct {
+	Trigger       string `json:"trigger"`
+	Dependency    string `json:"dependency"`
+	FilterSubject string `json:"filterSubject"`
+	// AckFloor is the stream sequence of the last message acknowledged in order.
+	AckFloor uint64 `json:"ackFloor"`
+	// ResumeTime is the time of the first message after the ack floor, or the export time if there is none,
+	// to resume from on a stream whose sequences differ.
+	ResumeTime time.Time `json:"resumeTime"`
+}
+
+// StateJetStream is the part of the JetStream context used to export and import the state of a Sensor.
+type StateJetStream interface {
+	KeyValue(bucket string) (nats.KeyValue, error)
+	CreateKeyValue(cfg *nats.KeyValueConfig) (nats.KeyValue, error)
+	ConsumerInfo(stream, name string, opts ...nats.JSOpt) (*nats.ConsumerInfo, error)
+	AddConsumer(stream string, cfg *nats.ConsumerConfig, opts ...nats.JSOpt) (*nats.ConsumerInfo, error)
+	GetMsg(name string, seq uint64, opts ...nats.JSOpt) (*nats.RawStreamMsg, error)
+}
+
+// ExportState returns the durable state of the Sensor. The Sensor should be scaled down first, so that the state
+// does not change during the export.
+func ExportState(js StateJetStream, sensorName string) (*SensorState, error) {
+	state := &SensorState{Sensor: sensorName, ExportedAt: time.Now().UTC()}
+	kv, err := js.KeyValue(sensorName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the Key/Value store of sensor %s, %w", sensorName, err)
+	}
+	if state.KeyValues, err = readBucket(kv); err != nil {
+		return nil, err
+	}
+	if batches, err := js.KeyValue(fmt.Sprintf("%s-batches", sensorName)); err == nil {
+		if state.Batches, err = readBucket(batches); err != nil {
+			return nil, err
+		}
+	} else if !errors.Is(err, nats.ErrBucketNotFound) {
+		return nil, fmt.Errorf("failed to get the batch Key/Value store of sensor %s, %w", sensorName, err)
+	}
+
+	triggers := TriggerValue{}
+	if err := unmarshalValue(state.KeyValues, TriggersKey, &triggers); err != nil {
+		return nil, err
+	}
+	deps := DependencyDefinitionValue{}
+	if err := unmarshalValue(state.KeyValues, DependencyDefsKey, &deps); err != nil {
+		return nil, err
+	}
+	// The durable names are hashed, so every trigger and dependency pair is looked up
+	for _, triggerName := range triggers {
+		for depName := range deps {
+			info, err := js.ConsumerInfo(common.JetStreamStreamName, getDurableName(sensorName, triggerName, depName))
+			if err != nil {
+				if errors.Is(err, nats.ErrConsumerNotFound) {
+					continue
+				}
+				return nil, fmt.Errorf("failed to get the consumer of trigger %s dependency %s, %w", triggerName, depName, err)
+			}
+			position := ConsumerPosition{
+				Trigger:       triggerName,
+				Dependency:    depName,
+				FilterSubject: info.Config.FilterSubject,
+				AckFloor:      info.AckFloor.Stream,
+				ResumeTime:    state.ExportedAt,
+			}
+			next, err := js.GetMsg(common.JetStreamStreamName, info.AckFloor.Stream+1)
+			if err == nil {
+				position.ResumeTime = next.Time.UTC()
+			} else if !errors.Is(err, nats.ErrMsgNotFound) {
+				return nil, fmt.Errorf("failed to get the message following the ack floor of trigger %s dependency %s, %w", triggerName, depName, err)
+			}
+			state.Consumers = append(state.Consumers, position)
+		}
+	}
+	return state, nil
+}
+
+// ImportOptions are the options to import the state of a Sensor.
+type ImportOptions struct {
+	// BySequence resumes the consumers from their ack floor sequence, which is only correct when the stream of
+	// the target EventBus has the same sequences, e.g. a mirror. By default, they resume from the resume time.
+	BySequence bool
+}
+
+// ImportState restores the durable state of a Sensor, before the Sensor is deployed to the target EventBus. The
+// consumers must not exist yet, since an existing consumer resumes from its own position.
+func ImportState(js StateJetStream, state *SensorState, opts ImportOptions) error {
+	for _, position := range state.Consumers {
+		durableName := getDurableName(state.Sensor, position.Trigger, position.Dependency)
+		if _, err := js.ConsumerInfo(common.JetStreamStreamName, durableName); err == nil {
+			return fmt.Errorf("the consumer of trigger %s dependency %s already exists", position.Trigger, position.Dependency)
+		} else if !errors.Is(err, nats.ErrConsumerNotFound) {
+			return fmt.Errorf("failed to get the consumer of trigger %s dependency %s, %w", position.Trigger, position.Dependency, err)
+		}
+	}
+
+	if err := writeBucket(js, state.Sensor, state.KeyValues); err != nil {
+		return err
+	}
+	if len(state.Batches) > 0 {
+		if err := writeBucket(js, fmt.Sprintf("%s-batches", state.Sensor), state.Batches); err != nil {
+			return err
+		}
+	}
+	for _, position := range state.Consumers {
+		config := &nats.ConsumerConfig{
+			Durable:       getDurableName(state.Sensor, position.Trigger, position.Dependency),
+			AckPolicy:     nats.AckExplicitPolicy,
+			FilterSubject: position.FilterSubject,
+		}
+		if opts.BySequence {
+			config.DeliverPolicy = nats.DeliverByStartSequencePolicy
+			config.OptStartSeq = position.AckFloor + 1
+		} else {
+			resumeTime := position.ResumeTime
+			config.DeliverPolicy = nats.DeliverByStartTimePolicy
+			config.OptStartTime = &resumeTime
+		}
+		if _, err := js.AddConsumer(common.JetStreamStreamName, config); err != nil {
+			return fmt.Errorf("failed to create the consumer of trigger %s dependency %s, %w", position.Trigger, position.Dependency, err)
+		}
+	}
+	return nil
+}
+
+// StateDeleteJetStream is the part of the JetStream context used to delete the state of a Sensor.
+type StateDeleteJetStream interface {
+	KeyValue(bucket string) (nats.KeyValue, error)
+	KeyValueStoreNames() <-chan string
+	DeleteKeyValue(bucket string) error
+	StreamNames(opts ...nats.JSOpt) <-chan string
+	DeleteConsumer(stream, consumer string, opts ...nats.JSOpt) error
+}
+
+// DeleteState deletes the durable state of a Sensor: the durable consumers of its trigger dependencies, and its
+// Key/Value stores. The Sensor pods should be gone first, so that they don't create it again.
+func DeleteState(js StateDeleteJetStream, sensorName string) error {
+	kv, err := js.KeyValue(sensorName)
+	if err != nil {
+		if errors.Is(err, nats.ErrBucketNotFound) {
+			// The consumers are only created once the Key/Value store of the Sensor is
+			return deleteBuckets(js, sensorName)
+		}
+		return fmt.Errorf("failed to get the Key/Value store of sensor %s, %w", sensorName, err)
+	}
+	values, err := readBucket(kv)
+	if err != nil {
+		return err
+	}
+	triggers := TriggerValue{}
+	if err := unmarshalValue(values, TriggersKey, &triggers); err != nil {
+		return err
+	}
+	deps := DependencyDefinitionValue{}
+	if err := unmarshalValue(values, DependencyDefsKey, &deps); err != nil {
+		return err
+	}
+	// The dependencies may consume from any stream, the consumers are looked up in all of them
+	var streams []string
+	for name := range js.StreamNames() {
+		streams = append(streams, name)
+	}
+	for _, triggerName := range triggers {
+		for depName := range deps {
+			durableName := getDurableName(sensorName, triggerName, depName)
+			for _, stream := range streams {
+				if err := js.DeleteConsumer(stream, durableName); err != nil && !errors.Is(err, nats.ErrConsumerNotFound) {
+					return fmt.Errorf("failed to delete the consumer of trigger %s dependency %s, %w", triggerName, depName, err)
+				}
+			}
+		}
+	}
+	return deleteBuckets(js, sensorName)
+}
+
+// deleteBuckets deletes the Key/Value stores of a Sensor: the main one, and the ones of the batches, the
+// partitions, the deduplication keys and the quota.
+func deleteBuckets(js StateDeleteJetStream, sensorName string) error {
+	var buckets []string
+	for name := range js.KeyValueStoreNames() {
+		if name == sensorName || name == fmt.Sprintf("%s-batches", sensorName) || name == fmt.Sprintf("%s-partitions", sensorName) ||
+			strings.HasPrefix(name, fmt.Sprintf("%s-dedup-", sensorName)) || strings.HasPrefix(name, fmt.Sprintf("%s-quota-", sensorName)) {
+			buckets = append(buckets, name)
+		}
+	}
+	for _, bucket := range buckets {
+		if err := js.DeleteKeyValue(bucket); err != nil && !errors.Is(err, nats.ErrBucketNotFound) && !errors.Is(err, nats.ErrStreamNotFound) {
+			return fmt.Errorf("failed to delete Key/Value store %s, %w", bucket, err)
+		}
+	}
+	return nil
+}
+
+func readBucket(kv nats.KeyValue) (map[string][]byte, error) {
+	keys, err := kv.Keys()
+	if err != nil {
+		if errors.Is(err, nats.ErrNoKeysFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to list the keys of Key/Value store %s, %w", kv.Bucket(), err)
+	}
+	values := make(map[string][]byte, len(keys))
+	for _, key := range keys {
+		entry, err := kv.Get(key)
+		if err != nil {
+			if errors.Is(err, nats.ErrKeyNotFound) {
+				continue
+			}
+			return nil, fmt.Errorf("failed to get key %s of Key/Value store %s, %w", key, kv.Bucket(), err)
+		}
+		values[key] = entry.Value()
+	}
+	return values, nil
+}
+
+func writeBucket(js StateJetStream, bucket string, values map[string][]byte) error {
+	kv, err := js.KeyValue(bucket)
+	if err != nil {
+		if !errors.Is(err, nats.ErrBucketNotFound) {
+			return fmt.Errorf("failed to get Key/Value store %s, %w", bucket, err)
+		}
+		if kv, err = js.CreateKeyValue(&nats.KeyValueConfig{Bucket: bucket}); err != nil {
+			return fmt.Errorf("failed to create Key/Value store %s, %w", bucket, err)
+		}
+	}
+	for key, value := range values {
+		if _, err := kv.Put(key, value); err != nil {
+			return fmt.Errorf("failed to store key %s in Key/Value store %s, %w", key, bucket, err)
+		}
+	}
+	return nil
+}
+
+func unmarshalValue(values map[string][]byte, key string, v interface{}) error {
+	value, ok := values[key]
+	if !ok {
+		return nil
+	}
+	if err := json.Unmarshal(value, v); err != nil {
+		return fmt.Errorf("failed to unmarshal key %s, %w", key, err)
+	}
+	return nil
+}
@@ -0,0 +1,205 @@
+package sensor
+
+import (
+	"testing"
+	"time"
+
+	nats "github.com/nats-io/nats.go"
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeEntry struct {
+	nats.KeyValueEntry
+	value []byte
+}
+
+func (e fakeEntry) Value() []byte { return e.value }
+
+type fakeKV struct {
+	nats.KeyValue
+	values map[string][]byte
+}
+
+func (kv *fakeKV) Bucket() string { return "fake" }
+
+func (kv *fakeKV) Keys(opts ...nats.WatchOpt) ([]string, error) {
+	if len(kv.values) == 0 {
+		return nil, nats.ErrNoKeysFound
+	}
+	keys := []string{}
+	for k := range kv.values {
+		keys = append(keys, k)
+	}
+	return keys, nil
+}
+
+func (kv *fakeKV) Get(key string) (nats.KeyValueEntry, error) {
+	v, ok := kv.values[key]
+	if !ok {
+		return nil, nats.ErrKeyNotFound
+	}
+	return fakeEntry{value: v}, nil
+}
+
+func (kv *fakeKV) Put(key string, value []byte) (uint64, error) {
+	kv.values[key] = value
+	return uint64(len(kv.values)), nil
+}
+
+type fakeStateJetStream struct {
+	buckets   map[string]*fakeKV
+	consumers map[string]*nats.ConsumerInfo
+	msgs      map[uint64]time.Time
+}
+
+func (js *fakeStateJetStream) KeyValue(bucket string) (nats.KeyValue, error) {
+	kv, ok := js.buckets[bucket]
+	if !ok {
+		return nil, nats.ErrBucketNotFound
+	}
+	return kv, nil
+}
+
+func (js *fakeStateJetStream) CreateKeyValue(cfg *nats.KeyValueConfig) (nats.KeyValue, error) {
+	kv := &fakeKV{values: map[string][]byte{}}
+	js.buckets[cfg.Bucket] = kv
+	return kv, nil
+}
+
+func (js *fakeStateJetStream) ConsumerInfo(stream, name string, opts ...nats.JSOpt) (*nats.ConsumerInfo, error) {
+	info, ok := js.consumers[name]
+	if !ok {
+		return nil, nats.ErrConsumerNotFound
+	}
+	return info, nil
+}
+
+func (js *fakeStateJetStream) AddConsumer(stream string, cfg *nats.ConsumerConfig, opts ...nats.JSOpt) (*nats.ConsumerInfo, error) {
+	info := &nats.ConsumerInfo{Name: cfg.Durable, Config: *cfg}
+	js.consumers[cfg.Durable] = info
+	return info, nil
+}
+
+func (js *fakeStateJetStream) GetMsg(name string, seq uint64, opts ...nats.JSOpt) (*nats.RawStreamMsg, error) {
+	t, ok := js.msgs[seq]
+	if !ok {
+		return nil, nats.ErrMsgNotFound
+	}
+	return &nats.RawStreamMsg{Sequence: seq, Time: t}, nil
+}
+
+func (js *fakeStateJetStream) KeyValueStoreNames() <-chan string {
+	ch := make(chan string, len(js.buckets))
+	for name := range js.buckets {
+		ch <- name
+	}
+	close(ch)
+	return ch
+}
+
+func (js *fakeStateJetStream) DeleteKeyValue(bucket string) error {
+	if _, ok := js.buckets[bucket]; !ok {
+		return nats.ErrBucketNotFound
+	}
+	delete(js.buckets, bucket)
+	return nil
+}
+
+func (js *fakeStateJetStream) StreamNames(opts ...nats.JSOpt) <-chan string {
+	ch := make(chan string, 1)
+	ch <- "default"
+	close(ch)
+	return ch
+}
+
+func (js *fakeStateJetStream) DeleteConsumer(stream, consumer string, opts ...nats.JSOpt) error {
+	if _, ok := js.consumers[consumer]; !ok {
+		return nats.ErrConsumerNotFound
+	}
+	delete(js.consumers, consumer)
+	return nil
+}
+
+func TestDeleteState(t *testing.T) {
+	other := getDurableName("my-sensor", "trigger", "dep-a")
+	js := &fakeStateJetStream{
+		buckets: map[string]*fakeKV{
+			"my-sensor-canary": {values: map[string][]byte{
+				TriggersKey:       []byte(`["trigger"]`),
+				DependencyDefsKey: []byte(`{"dep-a":1,"dep-b":2}`),
+			}},
+			"my-sensor-canary-batches":     {values: map[string][]byte{}},
+			"my-sensor-canary-partitions":  {values: map[string][]byte{}},
+			"my-sensor-canary-dedup-3600":  {values: map[string][]byte{}},
+			"my-sensor-canary-quota-86400": {values: map[string][]byte{}},
+			"my-sensor":                    {values: map[string][]byte{}},
+			"my-sensor-dedup-3600":         {values: map[string][]byte{}},
+		},
+		consumers: map[string]*nats.ConsumerInfo{
+			getDurableName("my-sensor-canary", "trigger", "dep-a"): {},
+			other: {},
+		},
+	}
+	assert.NoError(t, DeleteState(js, "my-sensor-canary"))
+	assert.Len(t, js.buckets, 2)
+	assert.Contains(t, js.buckets, "my-sensor")
+	assert.Contains(t, js.buckets, "my-sensor-dedup-3600")
+	assert.Len(t, js.consumers, 1)
+	assert.Contains(t, js.consumers, other)
+
+	// Nothing is left to delete
+	assert.NoError(t, DeleteState(js, "my-sensor-canary"))
+}
+
+func TestExportImportState(t *testing.T) {
+	published := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	source := &fakeStateJetStream{
+		buckets: map[string]*fakeKV{
+			"my-sensor": {values: map[string][]byte{
+				TriggersKey:                          []byte(`["trigger"]`),
+				DependencyDefsKey:                    []byte(`{"dep-a":1,"dep-b":2}`),
+				"trigger/Expression":                 []byte("dep-a && dep-b"),
+				getDependencyKey("trigger", "dep-a"): []byte(`{"StreamSeq":4}`),
+			}},
+		},
+		consumers: map[string]*nats.ConsumerInfo{
+			getDurableName("my-sensor", "trigger", "dep-a"): {Config: nats.ConsumerConfig{FilterSubject: "default.es.a"}, AckFloor: nats.SequenceInfo{Stream: 4}},
+			getDurableName("my-sensor", "trigger", "dep-b"): {Config: nats.ConsumerConfig{FilterSubject: "default.es.b"}, AckFloor: nats.SequenceInfo{Stream: 9}},
+		},
+		msgs: map[uint64]time.Time{5: published},
+	}
+
+	state, err := ExportState(source, "my-sensor")
+	assert.NoError(t, err)
+	assert.Len(t, state.KeyValues, 4)
+	assert.Empty(t, state.Batches)
+	assert.Len(t, state.Consumers, 2)
+	for _, c := range state.Consumers {
+		switch c.Dependency {
+		case "dep-a":
+			assert.Equal(t, uint64(4), c.AckFloor)
+			assert.Equal(t, published, c.ResumeTime)
+		case "dep-b":
+			// Nothing after the ack floor, the consumer resumes from the export
+			assert.Equal(t, state.ExportedAt, c.ResumeTime)
+		}
+	}
+
+	target := &fakeStateJetStream{buckets: map[string]*fakeKV{}, consumers: map[string]*nats.ConsumerInfo{}}
+	assert.NoError(t, ImportState(target, state, ImportOptions{}))
+	assert.Equal(t, source.buckets["my-sensor"].values, target.buckets["my-sensor"].values)
+	a := target.consumers[getDurableName("my-sensor", "trigger", "dep-a")]
+	assert.Equal(t, nats.DeliverByStartTimePolicy, a.Config.DeliverPolicy)
+	assert.Equal(t, published, *a.Config.OptStartTime)
+	assert.Equal(t, "default.es.a", a.Config.FilterSubject)
+	assert.Equal(t, nats.AckExplicitPolicy, a.Config.AckPolicy)
+
+	// The consumers resume from their own position once they exist
+	assert.Error(t, ImportState(target, state, ImportOptions{}))
+
+	target = &fakeStateJetStream{buckets: map[string]*fakeKV{}, consumers: map[string]*nats.ConsumerInfo{}}
+	assert.NoError(t, ImportState(target, state, ImportOptions{BySequence: true}))
+	b := target.consumers[getDurableName("my-sensor", "trigger", "dep-b")]
+	assert.Equal(t, nats.DeliverByStartSequencePolicy, b.Config.DeliverPolicy)
+	assert.Equal(t, uint64(10), b.Config.OptStartSeq)
+}
@@ -0,0 +1,47 @@
+package sensor
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/argoproj/argo-events/common"
+	eventbuscommon "github.com/argoproj/argo-events/eventbus/common"
+)
+
+// MissingSubjects returns the subjects of the dependencies which are not captured by the Stream.
+func (stream *SensorJetstream) MissingSubjects(deps []eventbuscommon.Dependency) ([]string, error) {
+	info, err := stream.MgmtConnection.JSContext.StreamInfo(common.JetStreamStreamName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the info of the stream %s, %w", common.JetStreamStreamName, err)
+	}
+	var missing []string
+	for _, dep := range deps {
+		subject := fmt.Sprintf("%s.%s.%s", common.JetStreamStreamName, dep.EventSourceName, dep.EventName)
+		captured := false
+		for _, filter := range info.Config.Subjects {
+			if subjectMatches(filter, subject) {
+				captured = true
+				break
+			}
+		}
+		if !captured {
+			missing = append(missing, subject)
+		}
+	}
+	return missing, nil
+}
+
+// subjectMatches checks if the subject matches the filter, which can contain the "*" and ">" wildcards.
+func subjectMatches(filter, subject string) bool {
+	filterTokens := strings.Split(filter, ".")
+	subjectTokens := strings.Split(subject, ".")
+	for i, token := range filterTokens {
+		if token == ">" {
+			return len(subjectTokens) > i
+		}
+		if i >= len(subjectTokens) || (token != "*" && token != subjectTokens[i]) {
+			return false
+		}
+	}
+	return len(filterTokens) == len(subjectTokens)
+}
@@ -0,0 +1,17 @@
+package sensor
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSubjectMatches(t *testing.T) {
+	assert.True(t, subjectMatches("default.*.*", "default.webhook.example"))
+	assert.True(t, subjectMatches("default.>", "default.webhook.example"))
+	assert.True(t, subjectMatches("default.webhook.example", "default.webhook.example"))
+	assert.False(t, subjectMatches("default.*.*", "default.webhook.example.v1"))
+	assert.False(t, subjectMatches("default.*.*.*", "default.webhook.example"))
+	assert.False(t, subjectMatches("default.>", "default"))
+	assert.False(t, subjectMatches("other.*.*", "default.webhook.example"))
+}
@@ -15,6 +15,7 @@ import (
 
 	eventbuscommon "github.com/argoproj/argo-events/eventbus/common"
 	jetstreambase "github.com/argoproj/argo-events/eventbus/jetstream/base"
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
 )
 
 type JetstreamTriggerConn struct {
@@ -29,6 +30,10 @@ type JetstreamTriggerConn struct {
 	sourceDepMap         map[string][]string // maps EventSource and EventName to dependency name
 	recentMsgsByID       map[string]*msg     // prevent re-processing the same message as before (map of msg ID to time)
 	recentMsgsByTime     []*msg
+	observeConditions    eventbuscommon.ConditionsObserveFunc
+	// ordered limits the consumers to one unacknowledged event, so that a redelivery can't overtake the later
+	// events of its dependency
+	ordered bool
 }
 
 type msg struct {
@@ -83,6 +88,22 @@ func NewJetstreamTriggerConn(conn *jetstreambase.JetstreamConnection,
 	return connection, nil
 }
 
+// ObserveConditions implements eventbuscommon.ConditionsObserver.
+func (conn *JetstreamTriggerConn) ObserveConditions(observe eventbuscommon.ConditionsObserveFunc) {
+	conn.observeConditions = observe
+}
+
+func (conn *JetstreamTriggerConn) observe(depName string, event *cloudevents.Event, received map[string]interface{}, satisfied bool, err error) {
+	if conn.observeConditions == nil {
+		return
+	}
+	state := make(map[string]bool, len(received))
+	for k, v := range received {
+		state[k] = v == true
+	}
+	conn.observeConditions(depName, *event, state, satisfied, err)
+}
+
 func (conn *JetstreamTriggerConn) IsClosed() bool {
 	return conn == nil || conn.JetstreamConnection.IsClosed()
 }
@@ -144,7 +165,11 @@ func (conn *JetstreamTriggerConn) Subscribe(ctx context.Context,
 
 		conn.Logger.Debugf("durable name for sensor='%s', trigger='%s', dep='%s': '%s'", conn.sensorName, conn.triggerName, dependency.Name, durableName)
 		log.Infof("Subscribing to subject %s with durable name %s", subject, durableName)
-		subscriptions[subscriptionIndex], err = conn.JSContext.PullSubscribe(subject, durableName, nats.AckExplicit(), nats.DeliverNew())
+		opts, err := conn.subscribeOptions(durableName, dependency.StartPosition)
+		if err != nil {
+			return err
+		}
+		subscriptions[subscriptionIndex], err = conn.JSContext.PullSubscribe(subject, durableName, opts...)
 		if err != nil {
 			errorStr := fmt.Sprintf("Failed to subscribe to subject %s using group %s: %v", subject, durableName, err)
 			log.Error(errorStr)
@@ -299,8 +324,8 @@ func (conn *JetstreamTriggerConn) processMsg(
 
 	log := conn.Logger
 
-	var event *cloudevents.Event
-	if err := json.Unmarshal(m.Data, &event); err != nil {
+	event, err := eventbuscommon.DecodeEvent(m.Data)
+	if err != nil {
 		log.Errorf("Failed to convert to a cloudevent, discarding it... err: %v", err)
 		return
 	}
@@ -356,6 +381,7 @@ func (conn *JetstreamTriggerConn) processDependency(
 		// this is the simple case: we can just perform the trigger
 		messages := make(map[string]cloudevents.Event)
 		messages[depName] = *event
+		conn.observe(depName, event, map[string]interface{}{depName: true}, true, nil)
 		log.Infof("Triggering actions after receiving dependency %s", depName)
 
 		action(messages)
@@ -364,6 +390,7 @@ func (conn *JetstreamTriggerConn) processDependency(
 
 		prevMsgs, err := conn.getSavedDependencies()
 		if err != nil {
+			conn.observe(depName, event, nil, false, err)
 			return
 		}
 
@@ -380,6 +407,7 @@ func (conn *JetstreamTriggerConn) processDependency(
 
 		// evaluate the filter expression
 		result, err := conn.evaluableExpression.Evaluate(parameters)
+		conn.observe(depName, event, parameters, result == true, err)
 		if err != nil {
 			errStr := fmt.Sprintf("failed to evaluate dependency expression: %v", err)
 			log.Error(errStr)
@@ -564,3 +592,37 @@ func (conn *JetstreamTriggerConn) purgeOldMsgs() {
 		}
 	}
 }
+
+// subscribeOptions returns the options to subscribe to a dependency. The start position only applies when the
+// consumer is created, an existing consumer resumes from where it was.
+func (conn *JetstreamTriggerConn) subscribeOptions(durableName string, startPosition *v1alpha1.DependencyStartPosition) ([]nats.SubOpt, error) {
+	opts := []nats.SubOpt{nats.AckExplicit()}
+	if info, err := conn.JSContext.ConsumerInfo("default", durableName); err == nil {
+		if ordered := info.Config.MaxAckPending == 1; ordered != conn.ordered {
+			// The ordering requirement of the Sensor changed, 0 restores the default of the server
+			config := info.Config
+			config.MaxAckPending = 0
+			if conn.ordered {
+				config.MaxAckPending = 1
+			}
+			if _, err := conn.JSContext.UpdateConsumer("default", &config); err != nil {
+				return nil, fmt.Errorf("failed to update the max ack pending of consumer %s, %w", durableName, err)
+			}
+		}
+		return opts, nil
+	} else if !errors.Is(err, nats.ErrConsumerNotFound) {
+		return nil, fmt.Errorf("failed to get consumer %s, %w", durableName, err)
+	}
+	if conn.ordered {
+		opts = append(opts, nats.MaxAckPending(1))
+	}
+	switch startPosition.GetDeliverPolicy() {
+	case v1alpha1.DeliverPolicyEarliest:
+		opts = append(opts, nats.DeliverAll())
+	case v1alpha1.DeliverPolicyTime:
+		opts = append(opts, nats.StartTime(startPosition.Time.Time))
+	default:
+		opts = append(opts, nats.DeliverNew())
+	}
+	return opts, nil
+}
@@ -0,0 +1,52 @@
+package sensor
+
+import (
+	"testing"
+
+	nats "github.com/nats-io/nats.go"
+	"github.com/stretchr/testify/assert"
+
+	jetstreambase "github.com/argoproj/argo-events/eventbus/jetstream/base"
+)
+
+type fakeConsumerJetStream struct {
+	nats.JetStreamContext
+	consumers map[string]*nats.ConsumerInfo
+}
+
+func (js *fakeConsumerJetStream) ConsumerInfo(stream, name string, opts ...nats.JSOpt) (*nats.ConsumerInfo, error) {
+	if info, ok := js.consumers[name]; ok {
+		return info, nil
+	}
+	return nil, nats.ErrConsumerNotFound
+}
+
+func (js *fakeConsumerJetStream) UpdateConsumer(stream string, cfg *nats.ConsumerConfig, opts ...nats.JSOpt) (*nats.ConsumerInfo, error) {
+	info := &nats.ConsumerInfo{Config: *cfg}
+	js.consumers[cfg.Durable] = info
+	return info, nil
+}
+
+func TestSubscribeOptionsOrdered(t *testing.T) {
+	js := &fakeConsumerJetStream{consumers: map[string]*nats.ConsumerInfo{}}
+	conn := &JetstreamTriggerConn{JetstreamConnection: &jetstreambase.JetstreamConnection{JSContext: js}, ordered: true}
+
+	// A new consumer is created with one unacknowledged event at most
+	opts, err := conn.subscribeOptions("new", nil)
+	assert.NoError(t, err)
+	assert.Len(t, opts, 3)
+
+	// An existing consumer is updated when the ordering requirement changes
+	js.consumers["existing"] = &nats.ConsumerInfo{Config: nats.ConsumerConfig{Durable: "existing", MaxAckPending: 1000}}
+	_, err = conn.subscribeOptions("existing", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, js.consumers["existing"].Config.MaxAckPending)
+
+	conn.ordered = false
+	_, err = conn.subscribeOptions("existing", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, js.consumers["existing"].Config.MaxAckPending)
+	opts, err = conn.subscribeOptions("new", nil)
+	assert.NoError(t, err)
+	assert.Len(t, opts, 2)
+}
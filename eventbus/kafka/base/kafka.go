@@ -1,7 +1,10 @@
 package base
 
 import (
+	"bytes"
+	"fmt"
 	"strings"
+	"text/template"
 
 	"github.com/IBM/sarama"
 	"github.com/argoproj/argo-events/common"
@@ -59,6 +62,8 @@ func (k *Kafka) Config() (*sarama.Config, error) {
 	config.Net.MaxOpenRequests = 1
 
 	// common config
+	config.Metadata.AllowAutoTopicCreation = k.config.Topics.GetAutoCreate()
+
 	if k.config.Version != "" {
 		version, err := sarama.ParseKafkaVersion(k.config.Version)
 		if err != nil {
@@ -110,3 +115,40 @@ func (k *Kafka) Config() (*sarama.Config, error) {
 
 	return config, nil
 }
+
+// SensorTopics returns the names of the trigger and action topics of a Sensor.
+func (k *Kafka) SensorTopics(namespace, sensorName string) (string, string, error) {
+	return SensorTopics(k.config, namespace, sensorName)
+}
+
+// SensorTopics renders the names of the trigger and action topics of a Sensor.
+func SensorTopics(config *eventbusv1alpha1.KafkaBus, namespace, sensorName string) (string, string, error) {
+	data := map[string]string{
+		"Topic":      config.Topic,
+		"Namespace":  namespace,
+		"SensorName": sensorName,
+	}
+	render := func(name, text string) (string, error) {
+		tpl, err := template.New(name).Option("missingkey=error").Parse(text)
+		if err != nil {
+			return "", fmt.Errorf("failed to parse the %s topic template, %w", name, err)
+		}
+		var b bytes.Buffer
+		if err := tpl.Execute(&b, data); err != nil {
+			return "", fmt.Errorf("failed to render the %s topic template, %w", name, err)
+		}
+		if b.Len() == 0 {
+			return "", fmt.Errorf("the %s topic template renders an empty name", name)
+		}
+		return b.String(), nil
+	}
+	trigger, err := render("trigger", config.Topics.GetTrigger())
+	if err != nil {
+		return "", "", err
+	}
+	action, err := render("action", config.Topics.GetAction())
+	if err != nil {
+		return "", "", err
+	}
+	return trigger, action, nil
+}
@@ -103,3 +103,49 @@ func TestNewKafka_EmptyURL(t *testing.T) {
 	assert.NotNil(t, kafka.Logger)
 	assert.NotNil(t, kafka.config)
 }
+
+func TestSensorTopics(t *testing.T) {
+	config := &eventbusv1alpha1.KafkaBus{
+		URL:   "localhost:9092",
+		Topic: "events",
+	}
+
+	trigger, action, err := SensorTopics(config, "ns", "sensor")
+	assert.NoError(t, err)
+	assert.Equal(t, "events-sensor-trigger", trigger)
+	assert.Equal(t, "events-sensor-action", action)
+
+	config.Topics = &eventbusv1alpha1.KafkaTopics{
+		Trigger: "team.{{.Namespace}}.{{.SensorName}}.trigger",
+		Action:  "team.{{.Namespace}}.{{.SensorName}}.action",
+	}
+	trigger, action, err = SensorTopics(config, "ns", "sensor")
+	assert.NoError(t, err)
+	assert.Equal(t, "team.ns.sensor.trigger", trigger)
+	assert.Equal(t, "team.ns.sensor.action", action)
+
+	config.Topics.Trigger = "{{.Missing}}"
+	_, _, err = SensorTopics(config, "ns", "sensor")
+	assert.Error(t, err)
+
+	config.Topics.Trigger = "{{if false}}x{{end}}"
+	_, _, err = SensorTopics(config, "ns", "sensor")
+	assert.Error(t, err)
+}
+
+func TestConfigAutoCreate(t *testing.T) {
+	autoCreate := false
+	config := &eventbusv1alpha1.KafkaBus{
+		URL: "localhost:9092",
+	}
+	kafka := NewKafka(config, zap.NewNop().Sugar())
+
+	saramaConfig, err := kafka.Config()
+	assert.NoError(t, err)
+	assert.True(t, saramaConfig.Metadata.AllowAutoTopicCreation)
+
+	config.Topics = &eventbusv1alpha1.KafkaTopics{AutoCreate: &autoCreate}
+	saramaConfig, err = kafka.Config()
+	assert.NoError(t, err)
+	assert.False(t, saramaConfig.Metadata.AllowAutoTopicCreation)
+}
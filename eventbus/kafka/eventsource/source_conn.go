@@ -6,6 +6,7 @@ import (
 	"github.com/IBM/sarama"
 	"github.com/argoproj/argo-events/eventbus/common"
 	"github.com/argoproj/argo-events/eventbus/kafka/base"
+	eventbusv1alpha1 "github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1"
 	"go.uber.org/zap"
 )
 
@@ -14,6 +15,10 @@ type KafkaSourceConnection struct {
 	Topic    string
 	Client   sarama.Client
 	Producer sarama.SyncProducer
+	// Mirrors are the topics a copy of the events is published to
+	Mirrors []eventbusv1alpha1.KafkaMirror
+	// MirrorProducer publishes the copies of the events to the mirror topics, in the background
+	MirrorProducer sarama.AsyncProducer
 }
 
 func (c *KafkaSourceConnection) Publish(ctx context.Context, msg common.Message) error {
@@ -39,10 +44,60 @@ func (c *KafkaSourceConnection) Publish(ctx context.Context, msg common.Message)
 
 	c.Logger.Infow("Published message to kafka", zap.String("topic", c.Topic), zap.String("key", key), zap.Int32("partition", partition), zap.Int64("offset", offset))
 
+	c.publishMirrors(msg, key)
 	return nil
 }
 
+// publishMirrors hands a copy of the message to the producer of the mirror topics, without waiting for it to be
+// published, so that the mirrors never delay the publish of the events to the Sensors. The copies are delivered at
+// most once: a copy is dropped when the buffer of the producer is full, and a failure to publish it is only logged,
+// so that the message isn't published again to the topic of the Sensors.
+func (c *KafkaSourceConnection) publishMirrors(msg common.Message, key string) {
+	if c.MirrorProducer == nil {
+		return
+	}
+	for _, mirror := range c.Mirrors {
+		if !mirror.Matches(msg.EventSourceName, msg.EventName) {
+			continue
+		}
+		select {
+		case c.MirrorProducer.Input() <- &sarama.ProducerMessage{
+			Topic: mirror.Topic,
+			Key:   sarama.StringEncoder(key),
+			Value: sarama.ByteEncoder(msg.Body),
+		}:
+		default:
+			c.Logger.Warnw("Dropped message for mirror topic, the producer is busy", zap.String("topic", mirror.Topic), zap.String("key", key))
+		}
+	}
+}
+
+// drainMirrors consumes the results of the producer of the mirror topics until it is closed, logging the failures.
+func (c *KafkaSourceConnection) drainMirrors() {
+	successes, errs := c.MirrorProducer.Successes(), c.MirrorProducer.Errors()
+	for successes != nil || errs != nil {
+		select {
+		case _, ok := <-successes:
+			if !ok {
+				successes = nil
+			}
+		case err, ok := <-errs:
+			if !ok {
+				errs = nil
+				continue
+			}
+			c.Logger.Warnw("Failed to publish message to mirror topic", zap.String("topic", err.Msg.Topic), zap.Error(err.Err))
+		}
+	}
+}
+
 func (c *KafkaSourceConnection) Close() error {
+	if c.MirrorProducer != nil {
+		// Flushes the copies of the events still buffered
+		if err := c.MirrorProducer.Close(); err != nil {
+			c.Logger.Warnw("Failed to publish messages to mirror topics", zap.Error(err))
+		}
+	}
 	if err := c.Producer.Close(); err != nil {
 		return err
 	}
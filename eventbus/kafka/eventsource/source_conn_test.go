@@ -0,0 +1,62 @@
+package eventsource
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/IBM/sarama"
+	"github.com/IBM/sarama/mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap/zaptest"
+
+	"github.com/argoproj/argo-events/eventbus/common"
+	"github.com/argoproj/argo-events/eventbus/kafka/base"
+	eventbusv1alpha1 "github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1"
+)
+
+func TestPublishMirrors(t *testing.T) {
+	producer := mocks.NewSyncProducer(t, nil)
+	config := mocks.NewTestConfig()
+	config.Producer.Return.Successes = true
+	mirrorProducer := mocks.NewAsyncProducer(t, config)
+	var topics []string
+	record := func(msg *sarama.ProducerMessage) error {
+		topics = append(topics, msg.Topic)
+		return nil
+	}
+	conn := &KafkaSourceConnection{
+		KafkaConnection: base.NewKafkaConnection(zaptest.NewLogger(t).Sugar()),
+		Topic:           "events",
+		Producer:        producer,
+		Mirrors: []eventbusv1alpha1.KafkaMirror{
+			{Topic: "analytics"},
+			{Topic: "analytics-webhook", EventSourceName: "webhook"},
+			{Topic: "analytics-calendar", EventSourceName: "calendar"},
+		},
+		MirrorProducer: mirrorProducer,
+	}
+	drained := make(chan struct{})
+	go func() {
+		conn.drainMirrors()
+		close(drained)
+	}()
+	msg := common.Message{MsgHeader: common.MsgHeader{EventSourceName: "webhook", EventName: "push"}, Body: []byte("{}")}
+
+	producer.ExpectSendMessageWithMessageCheckerFunctionAndSucceed(record)
+	mirrorProducer.ExpectInputWithMessageCheckerFunctionAndSucceed(record)
+	mirrorProducer.ExpectInputWithMessageCheckerFunctionAndSucceed(record)
+	assert.NoError(t, conn.Publish(context.Background(), msg))
+	assert.Eventually(t, func() bool { return len(mirrorProducer.Successes()) == 0 && len(mirrorProducer.Input()) == 0 }, time.Second, 10*time.Millisecond)
+
+	// A failed mirror doesn't fail the publish
+	producer.ExpectSendMessageAndSucceed()
+	mirrorProducer.ExpectInputAndFail(fmt.Errorf("unavailable"))
+	mirrorProducer.ExpectInputAndSucceed()
+	assert.NoError(t, conn.Publish(context.Background(), msg))
+	assert.NoError(t, producer.Close())
+	mirrorProducer.AsyncClose()
+	<-drained
+	assert.ElementsMatch(t, []string{"events", "analytics", "analytics-webhook"}, topics)
+}
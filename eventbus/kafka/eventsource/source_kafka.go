@@ -10,13 +10,15 @@ import (
 
 type KafkaSource struct {
 	*base.Kafka
-	topic string
+	topic   string
+	mirrors []eventbusv1alpha1.KafkaMirror
 }
 
 func NewKafkaSource(config *eventbusv1alpha1.KafkaBus, logger *zap.SugaredLogger) *KafkaSource {
 	return &KafkaSource{
-		Kafka: base.NewKafka(config, logger),
-		topic: config.Topic,
+		Kafka:   base.NewKafka(config, logger),
+		topic:   config.Topic,
+		mirrors: config.Mirrors,
 	}
 }
 
@@ -49,6 +51,16 @@ func (s *KafkaSource) Connect(string) (eventbuscommon.EventSourceConnection, err
 		Topic:           s.topic,
 		Client:          client,
 		Producer:        producer,
+		Mirrors:         s.mirrors,
+	}
+	if len(s.mirrors) > 0 {
+		mirrorProducer, err := sarama.NewAsyncProducerFromClient(client)
+		if err != nil {
+			_ = producer.Close()
+			return nil, err
+		}
+		conn.MirrorProducer = mirrorProducer
+		go conn.drainMirrors()
 	}
 
 	return conn, nil
@@ -2,7 +2,7 @@ package kafka
 
 import (
 	"context"
-	"encoding/json"
+	"errors"
 	"fmt"
 	"strings"
 	"sync"
@@ -29,6 +29,8 @@ type KafkaSensor struct {
 	consumer  sarama.ConsumerGroup
 	hostname  string
 	groupName string
+	// format is the format of the events produced to the trigger and action topics
+	format eventbusv1alpha1.EventFormat
 
 	// triggers handlers
 	// holds the state of all sensor triggers
@@ -40,11 +42,15 @@ type KafkaSensor struct {
 	connected    bool
 }
 
-func NewKafkaSensor(kafkaConfig *eventbusv1alpha1.KafkaBus, sensor *sensorv1alpha1.Sensor, hostname string, logger *zap.SugaredLogger) *KafkaSensor {
+func NewKafkaSensor(kafkaConfig *eventbusv1alpha1.KafkaBus, sensor *sensorv1alpha1.Sensor, hostname string, format eventbusv1alpha1.EventFormat, logger *zap.SugaredLogger) (*KafkaSensor, error) {
+	triggerTopic, actionTopic, err := base.SensorTopics(kafkaConfig, sensor.Namespace, sensor.Name)
+	if err != nil {
+		return nil, err
+	}
 	topics := &Topics{
 		event:   kafkaConfig.Topic,
-		trigger: fmt.Sprintf("%s-%s-%s", kafkaConfig.Topic, sensor.Name, "trigger"),
-		action:  fmt.Sprintf("%s-%s-%s", kafkaConfig.Topic, sensor.Name, "action"),
+		trigger: triggerTopic,
+		action:  actionTopic,
 	}
 
 	var groupName string
@@ -61,8 +67,9 @@ func NewKafkaSensor(kafkaConfig *eventbusv1alpha1.KafkaBus, sensor *sensorv1alph
 		topics:    topics,
 		hostname:  hostname,
 		groupName: groupName,
+		format:    format,
 		triggers:  Triggers{},
-	}
+	}, nil
 }
 
 type Topics struct {
@@ -197,6 +204,29 @@ func (s *KafkaSensor) Connect(ctx context.Context, triggerName string, depExpres
 	return s.triggers[triggerName], nil
 }
 
+// MissingSubjects returns the topics of the sensor which do not exist, the metadata request creates them if the
+// brokers auto create the topics.
+func (s *KafkaSensor) MissingSubjects(deps []eventbuscommon.Dependency) ([]string, error) {
+	if err := s.client.RefreshMetadata(s.topics.List()...); err != nil && !errors.Is(err, sarama.ErrUnknownTopicOrPartition) {
+		return nil, err
+	}
+	topics, err := s.client.Topics()
+	if err != nil {
+		return nil, err
+	}
+	existing := map[string]bool{}
+	for _, topic := range topics {
+		existing[topic] = true
+	}
+	var missing []string
+	for _, topic := range s.topics.List() {
+		if !existing[topic] {
+			missing = append(missing, topic)
+		}
+	}
+	return missing, nil
+}
+
 func (s *KafkaSensor) Listen(ctx context.Context) {
 	defer s.Disconnect()
 
@@ -262,8 +292,8 @@ func (s *KafkaSensor) IsClosed() bool {
 }
 
 func (s *KafkaSensor) Event(msg *sarama.ConsumerMessage) ([]*sarama.ProducerMessage, int64, func()) {
-	var event *cloudevents.Event
-	if err := json.Unmarshal(msg.Value, &event); err != nil {
+	event, err := eventbuscommon.DecodeEvent(msg.Value)
+	if err != nil {
 		s.Logger.Errorw("Failed to deserialize cloudevent, skipping", zap.Error(err))
 		return nil, msg.Offset + 1, nil
 	}
@@ -285,17 +315,15 @@ func (s *KafkaSensor) Event(msg *sarama.ConsumerMessage) ([]*sarama.ProducerMess
 		// can skip ahead to the action topic, otherwise produce to
 		// the trigger topic
 
-		var data any
+		var value []byte
 		var topic string
 		if trigger.OneAndDone() {
-			data = []*cloudevents.Event{event}
+			value, err = eventbuscommon.EncodeEvents(s.format, []*cloudevents.Event{event})
 			topic = s.topics.action
 		} else {
-			data = event
+			value, err = eventbuscommon.EncodeEvent(s.format, event)
 			topic = s.topics.trigger
 		}
-
-		value, err := json.Marshal(data)
 		if err != nil {
 			s.Logger.Errorw("Failed to serialize cloudevent, skipping", zap.Error(err))
 			continue
@@ -312,8 +340,8 @@ func (s *KafkaSensor) Event(msg *sarama.ConsumerMessage) ([]*sarama.ProducerMess
 }
 
 func (s *KafkaSensor) Trigger(msg *sarama.ConsumerMessage) ([]*sarama.ProducerMessage, int64, func()) {
-	var event *cloudevents.Event
-	if err := json.Unmarshal(msg.Value, &event); err != nil {
+	event, err := eventbuscommon.DecodeEvent(msg.Value)
+	if err != nil {
 		// do not return here as we still need to call trigger.Offset
 		// below to determine current offset
 		s.Logger.Errorw("Failed to deserialize cloudevent, skipping", zap.Error(err))
@@ -337,7 +365,7 @@ func (s *KafkaSensor) Trigger(msg *sarama.ConsumerMessage) ([]*sarama.ProducerMe
 				return
 			}
 
-			value, err := json.Marshal(events)
+			value, err := eventbuscommon.EncodeEvents(s.format, events)
 			if err != nil {
 				s.Logger.Errorw("Failed to serialize cloudevent, skipping", zap.Error(err))
 				return
@@ -362,8 +390,8 @@ func (s *KafkaSensor) Trigger(msg *sarama.ConsumerMessage) ([]*sarama.ProducerMe
 }
 
 func (s *KafkaSensor) Action(msg *sarama.ConsumerMessage) ([]*sarama.ProducerMessage, int64, func()) {
-	var events []*cloudevents.Event
-	if err := json.Unmarshal(msg.Value, &events); err != nil {
+	events, err := eventbuscommon.DecodeEvents(msg.Value)
+	if err != nil {
 		s.Logger.Errorw("Failed to deserialize cloudevents, skipping", zap.Error(err))
 		return nil, msg.Offset + 1, nil
 	}
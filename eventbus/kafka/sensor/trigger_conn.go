@@ -28,6 +28,8 @@ type KafkaTriggerConnection struct {
 	filter    func(string, cloudevents.Event) bool
 	action    func(map[string]cloudevents.Event)
 
+	observeConditions common.ConditionsObserveFunc
+
 	// state
 	events        []*eventWithMetadata
 	lastResetTime time.Time
@@ -59,6 +61,11 @@ func (c *KafkaTriggerConnection) Close() error {
 	return c.close()
 }
 
+// ObserveConditions implements common.ConditionsObserver.
+func (c *KafkaTriggerConnection) ObserveConditions(observe common.ConditionsObserveFunc) {
+	c.observeConditions = observe
+}
+
 func (c *KafkaTriggerConnection) IsClosed() bool {
 	return c.isClosed == nil || c.isClosed()
 }
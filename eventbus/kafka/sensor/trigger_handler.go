@@ -83,6 +83,11 @@ func (c *KafkaTriggerConnection) Update(event *cloudevents.Event, partition int3
 	c.events = append(c.events[:i], eventWithMetadata)
 
 	satisfied, err := c.satisfied()
+	if c.observeConditions != nil {
+		if depName, ok := c.DependsOn(event); ok {
+			c.observeConditions(depName, *event, c.parameters(), satisfied == true, err)
+		}
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -132,13 +137,18 @@ func (c *KafkaTriggerConnection) Action(events []*cloudevents.Event) func() {
 	return f
 }
 
-func (c *KafkaTriggerConnection) satisfied() (interface{}, error) {
+func (c *KafkaTriggerConnection) parameters() Parameters {
 	parameters := Parameters{}
 	for _, event := range c.events {
 		if depName, ok := c.DependsOn(event.Event); ok {
 			parameters[depName] = true
 		}
 	}
+	return parameters
+}
+
+func (c *KafkaTriggerConnection) satisfied() (interface{}, error) {
+	parameters := c.parameters()
 
 	c.Logger.Infow("Evaluating", zap.String("expr", c.depExpression.String()), zap.Any("parameters", parameters))
 
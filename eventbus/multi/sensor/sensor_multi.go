@@ -0,0 +1,135 @@
+package sensor
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"go.uber.org/zap"
+
+	eventbuscommon "github.com/argoproj/argo-events/eventbus/common"
+)
+
+// SensorMulti is the driver of a Sensor whose dependencies consume from several EventBuses. The triggers whose
+// dependencies consume from a single EventBus are connected by the driver of that EventBus, the dependencies of the
+// triggers spanning several EventBuses are joined in memory.
+type SensorMulti struct {
+	// drivers are the drivers of the EventBuses, keyed by EventBus name
+	drivers map[string]eventbuscommon.SensorDriver
+	// dependencyBuses are the names of the EventBuses of the dependencies, keyed by dependency name
+	dependencyBuses map[string]string
+	// sharedConsumers are the names of the EventBuses whose trigger connections share a single consumer loop, e.g.
+	// Kafka, their events can't be held until the trigger fires without blocking the other connections
+	sharedConsumers map[string]bool
+	logger          *zap.SugaredLogger
+}
+
+func NewSensorMulti(drivers map[string]eventbuscommon.SensorDriver, dependencyBuses map[string]string, sharedConsumers map[string]bool, logger *zap.SugaredLogger) *SensorMulti {
+	return &SensorMulti{drivers: drivers, dependencyBuses: dependencyBuses, sharedConsumers: sharedConsumers, logger: logger}
+}
+
+func (s *SensorMulti) Initialize() error {
+	for _, name := range s.busNames() {
+		if err := s.drivers[name].Initialize(); err != nil {
+			return fmt.Errorf("failed to initialize the eventbus %s, %w", name, err)
+		}
+	}
+	return nil
+}
+
+func (s *SensorMulti) Connect(ctx context.Context, triggerName string, dependencyExpression string, deps []eventbuscommon.Dependency, atLeastOnce bool) (eventbuscommon.TriggerConnection, error) {
+	busDeps, err := s.groupByBus(deps)
+	if err != nil {
+		return nil, err
+	}
+	if len(busDeps) == 1 {
+		for name, deps := range busDeps {
+			return s.drivers[name].Connect(ctx, triggerName, dependencyExpression, deps, atLeastOnce)
+		}
+	}
+	conns := make(map[string]eventbuscommon.TriggerConnection)
+	holds := make(map[string]bool)
+	closeAll := func() {
+		for _, c := range conns {
+			_ = c.Close()
+		}
+	}
+	for name, deps := range busDeps {
+		if s.sharedConsumers[name] {
+			// Any event of the dependencies of the EventBus is passed on, the conditions are evaluated on the joined events
+			depNames := make([]string, 0, len(deps))
+			for _, dep := range deps {
+				depNames = append(depNames, dep.Name)
+			}
+			sort.Strings(depNames)
+			conn, err := s.drivers[name].Connect(ctx, triggerName, strings.Join(depNames, " || "), deps, atLeastOnce)
+			if err != nil {
+				closeAll()
+				return nil, fmt.Errorf("failed to connect to the eventbus %s, %w", name, err)
+			}
+			conns[name] = conn
+			continue
+		}
+		// A connection per dependency, so that its event is held, unacknowledged, until the trigger fires, without
+		// blocking the events of the other dependencies
+		for _, dep := range deps {
+			conn, err := s.drivers[name].Connect(ctx, triggerName, dep.Name, []eventbuscommon.Dependency{dep}, atLeastOnce)
+			if err != nil {
+				closeAll()
+				return nil, fmt.Errorf("failed to connect to the eventbus %s, %w", name, err)
+			}
+			key := name + "/" + dep.Name
+			conns[key] = conn
+			holds[key] = true
+		}
+	}
+	return NewMultiTriggerConn(triggerName, dependencyExpression, conns, holds, s.logger)
+}
+
+// MissingSubjects implements eventbuscommon.SubjectsChecker.
+func (s *SensorMulti) MissingSubjects(deps []eventbuscommon.Dependency) ([]string, error) {
+	busDeps, err := s.groupByBus(deps)
+	if err != nil {
+		return nil, err
+	}
+	var missing []string
+	for name, deps := range busDeps {
+		checker, ok := s.drivers[name].(eventbuscommon.SubjectsChecker)
+		if !ok {
+			continue
+		}
+		m, err := checker.MissingSubjects(deps)
+		if err != nil {
+			return nil, fmt.Errorf("eventbus %s: %w", name, err)
+		}
+		missing = append(missing, m...)
+	}
+	sort.Strings(missing)
+	return missing, nil
+}
+
+// groupByBus groups the dependencies by the names of their EventBuses.
+func (s *SensorMulti) groupByBus(deps []eventbuscommon.Dependency) (map[string][]eventbuscommon.Dependency, error) {
+	result := make(map[string][]eventbuscommon.Dependency)
+	for _, dep := range deps {
+		name, ok := s.dependencyBuses[dep.Name]
+		if !ok {
+			return nil, fmt.Errorf("the eventbus of the dependency %s is unknown", dep.Name)
+		}
+		if _, ok := s.drivers[name]; !ok {
+			return nil, fmt.Errorf("eventbus %s of the dependency %s is not configured", name, dep.Name)
+		}
+		result[name] = append(result[name], dep)
+	}
+	return result, nil
+}
+
+func (s *SensorMulti) busNames() []string {
+	names := make([]string, 0, len(s.drivers))
+	for name := range s.drivers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
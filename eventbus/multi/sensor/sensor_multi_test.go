@@ -0,0 +1,162 @@
+package sensor
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+
+	eventbuscommon "github.com/argoproj/argo-events/eventbus/common"
+)
+
+type fakeTriggerConn struct {
+	expression string
+	deps       []eventbuscommon.Dependency
+	closed     bool
+	actionCh   chan func(map[string]cloudevents.Event)
+}
+
+func (f *fakeTriggerConn) Close() error {
+	f.closed = true
+	return nil
+}
+
+func (f *fakeTriggerConn) IsClosed() bool { return f.closed }
+
+func (f *fakeTriggerConn) String() string { return f.expression }
+
+func (f *fakeTriggerConn) Subscribe(ctx context.Context, closeCh <-chan struct{}, resetConditionsCh <-chan struct{}, lastResetTime time.Time,
+	transform func(depName string, event cloudevents.Event) (*cloudevents.Event, error), filter func(string, cloudevents.Event) bool,
+	action func(map[string]cloudevents.Event), defaultSubject *string) error {
+	f.actionCh <- action
+	select {
+	case <-ctx.Done():
+	case <-closeCh:
+	}
+	return nil
+}
+
+type fakeSensorDriver struct {
+	conns []*fakeTriggerConn
+}
+
+func (f *fakeSensorDriver) Initialize() error { return nil }
+
+func (f *fakeSensorDriver) Connect(ctx context.Context, triggerName string, dependencyExpression string, deps []eventbuscommon.Dependency, atLeastOnce bool) (eventbuscommon.TriggerConnection, error) {
+	conn := &fakeTriggerConn{expression: dependencyExpression, deps: deps, actionCh: make(chan func(map[string]cloudevents.Event), 1)}
+	f.conns = append(f.conns, conn)
+	return conn, nil
+}
+
+func newEvent(id string) cloudevents.Event {
+	e := cloudevents.NewEvent()
+	e.SetID(id)
+	return e
+}
+
+func TestSensorMulti(t *testing.T) {
+	main, kafka := &fakeSensorDriver{}, &fakeSensorDriver{}
+	driver := NewSensorMulti(
+		map[string]eventbuscommon.SensorDriver{"default": main, "kafka": kafka},
+		map[string]string{"dep-a": "default", "dep-b": "default", "dep-c": "kafka"},
+		map[string]bool{"kafka": true},
+		zap.NewNop().Sugar())
+	ctx := context.Background()
+	depA := eventbuscommon.Dependency{Name: "dep-a"}
+	depB := eventbuscommon.Dependency{Name: "dep-b"}
+	depC := eventbuscommon.Dependency{Name: "dep-c"}
+
+	t.Run("single eventbus", func(t *testing.T) {
+		conn, err := driver.Connect(ctx, "trigger", "dep-a && dep-b", []eventbuscommon.Dependency{depA, depB}, false)
+		assert.NoError(t, err)
+		assert.Equal(t, main.conns[0], conn)
+		assert.Equal(t, "dep-a && dep-b", main.conns[0].expression)
+	})
+
+	t.Run("unknown dependency", func(t *testing.T) {
+		_, err := driver.Connect(ctx, "trigger", "dep-d", []eventbuscommon.Dependency{{Name: "dep-d"}}, false)
+		assert.Error(t, err)
+	})
+
+	t.Run("several eventbuses", func(t *testing.T) {
+		conn, err := driver.Connect(ctx, "trigger", "(dep-a || dep-b) && dep-c", []eventbuscommon.Dependency{depA, depB, depC}, false)
+		assert.NoError(t, err)
+		multiConn, ok := conn.(*MultiTriggerConn)
+		assert.True(t, ok)
+		// A connection per dependency, except for the shared consumer
+		connA, connB, kafkaConn := main.conns[len(main.conns)-2], main.conns[len(main.conns)-1], kafka.conns[0]
+		if connA.expression != "dep-a" {
+			connA, connB = connB, connA
+		}
+		assert.Equal(t, "dep-a", connA.expression)
+		assert.Equal(t, "dep-b", connB.expression)
+		assert.Equal(t, "dep-c", kafkaConn.expression)
+
+		triggered := make(chan map[string]cloudevents.Event, 1)
+		closeCh := make(chan struct{}, 1)
+		resetCh := make(chan struct{}, 1)
+		subscribed := make(chan error, 1)
+		go func() {
+			subscribed <- multiConn.Subscribe(ctx, closeCh, resetCh, time.Time{}, nil, nil, func(events map[string]cloudevents.Event) {
+				triggered <- events
+			}, nil)
+		}()
+		actionA, actionB, kafkaAction := <-connA.actionCh, <-connB.actionCh, <-kafkaConn.actionCh
+		hold := func(action func(map[string]cloudevents.Event), depName, id string) chan struct{} {
+			released := make(chan struct{})
+			go func() {
+				action(map[string]cloudevents.Event{depName: newEvent(id)})
+				close(released)
+			}()
+			return released
+		}
+
+		// The held events are acknowledged after the trigger fires
+		releasedA := hold(actionA, "dep-a", "1")
+		releasedB := hold(actionB, "dep-b", "2")
+		assert.Never(t, func() bool { return len(triggered) > 0 || isClosed(releasedA) || isClosed(releasedB) }, 100*time.Millisecond, 10*time.Millisecond)
+		kafkaAction(map[string]cloudevents.Event{"dep-c": newEvent("3")})
+		events := <-triggered
+		assert.Len(t, events, 3)
+		assert.Equal(t, "3", events["dep-c"].ID())
+		<-releasedA
+		<-releasedB
+
+		// The events of the shared consumer are acknowledged when they are passed on
+		kafkaAction(map[string]cloudevents.Event{"dep-c": newEvent("4")})
+		assert.Empty(t, triggered)
+		// The reset releases the held events
+		resetCh <- struct{}{}
+		time.Sleep(100 * time.Millisecond)
+		releasedA = hold(actionA, "dep-a", "5")
+		assert.Never(t, func() bool { return isClosed(releasedA) }, 100*time.Millisecond, 10*time.Millisecond)
+		resetCh <- struct{}{}
+		<-releasedA
+		assert.Empty(t, triggered)
+
+		// The holding connections are closed before their events are released, so that they are delivered again
+		releasedB = hold(actionB, "dep-b", "6")
+		time.Sleep(100 * time.Millisecond)
+		closeCh <- struct{}{}
+		assert.NoError(t, <-subscribed)
+		<-releasedB
+		assert.Empty(t, triggered)
+		assert.True(t, connB.closed)
+		assert.False(t, kafkaConn.closed)
+		assert.True(t, multiConn.IsClosed())
+		assert.NoError(t, multiConn.Close())
+		assert.True(t, kafkaConn.closed)
+	})
+}
+
+func isClosed(ch chan struct{}) bool {
+	select {
+	case <-ch:
+		return true
+	default:
+		return false
+	}
+}
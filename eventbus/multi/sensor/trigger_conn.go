@@ -0,0 +1,282 @@
+package sensor
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Knetic/govaluate"
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"go.uber.org/zap"
+
+	eventbuscommon "github.com/argoproj/argo-events/eventbus/common"
+)
+
+// MultiTriggerConn joins the events of the dependencies of a trigger spanning several EventBuses. Each connection
+// passes on any event of its dependencies, the events are held in memory until the dependency expression is
+// satisfied. The events of the holding connections are only acknowledged once the trigger fires, or the conditions
+// are reset: their action blocks until then. The events of the other connections are acknowledged when they are
+// passed on.
+type MultiTriggerConn struct {
+	triggerName string
+	// conns are the connections to the EventBuses, keyed by EventBus name, or by EventBus and dependency names
+	conns map[string]eventbuscommon.TriggerConnection
+	// holds are the names of the connections whose events are held until the trigger fires
+	holds             map[string]bool
+	expr              *govaluate.EvaluableExpression
+	depNames          []string
+	observeConditions eventbuscommon.ConditionsObserveFunc
+
+	lock   sync.Mutex
+	events map[string]cloudevents.Event
+	// waiters are the actions of the holding connections blocked until the trigger fires
+	waiters []chan struct{}
+	// stopped is closed when the subscriptions end, to release the blocked actions
+	stopped chan struct{}
+
+	logger *zap.SugaredLogger
+}
+
+func NewMultiTriggerConn(triggerName, dependencyExpression string, conns map[string]eventbuscommon.TriggerConnection, holds map[string]bool, logger *zap.SugaredLogger) (*MultiTriggerConn, error) {
+	expr, err := govaluate.NewEvaluableExpression(strings.ReplaceAll(dependencyExpression, "-", "\\-"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse the dependency expression %q, %w", dependencyExpression, err)
+	}
+	return &MultiTriggerConn{
+		triggerName: triggerName,
+		conns:       conns,
+		holds:       holds,
+		expr:        expr,
+		depNames:    expr.Vars(),
+		events:      make(map[string]cloudevents.Event),
+		logger:      logger.With("triggerName", triggerName),
+	}, nil
+}
+
+func (c *MultiTriggerConn) String() string {
+	if c == nil {
+		return ""
+	}
+	conns := make([]string, 0, len(c.conns))
+	for name, conn := range c.conns {
+		conns = append(conns, fmt.Sprintf("%s:%s", name, conn))
+	}
+	sort.Strings(conns)
+	return fmt.Sprintf("MultiTriggerConn{Trigger:%s,Connections:[%s]}", c.triggerName, strings.Join(conns, ","))
+}
+
+// ObserveConditions implements eventbuscommon.ConditionsObserver.
+func (c *MultiTriggerConn) ObserveConditions(observe eventbuscommon.ConditionsObserveFunc) {
+	c.observeConditions = observe
+}
+
+// IsClosed returns true if any of the connections is closed, so that they are all reconnected.
+func (c *MultiTriggerConn) IsClosed() bool {
+	if c == nil {
+		return true
+	}
+	for _, conn := range c.conns {
+		if conn == nil || conn.IsClosed() {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *MultiTriggerConn) Close() error {
+	if c == nil {
+		return fmt.Errorf("can't close the multi trigger connection, MultiTriggerConn is nil")
+	}
+	var errs []string
+	for name, conn := range c.conns {
+		if conn == nil || conn.IsClosed() {
+			continue
+		}
+		if err := conn.Close(); err != nil {
+			errs = append(errs, fmt.Sprintf("%s: %s", name, err.Error()))
+		}
+	}
+	if len(errs) > 0 {
+		sort.Strings(errs)
+		return fmt.Errorf("failed to close the connections, %s", strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+// Subscribe subscribes to the dependencies on all the EventBuses, it returns when all the subscriptions end.
+func (c *MultiTriggerConn) Subscribe(
+	ctx context.Context,
+	closeCh <-chan struct{},
+	resetConditionsCh <-chan struct{},
+	lastResetTime time.Time,
+	transform func(depName string, event cloudevents.Event) (*cloudevents.Event, error),
+	filter func(string, cloudevents.Event) bool,
+	action func(map[string]cloudevents.Event),
+	defaultSubject *string) error {
+	if c == nil {
+		return fmt.Errorf("Subscribe() failed; MultiTriggerConn is nil")
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	c.lock.Lock()
+	c.stopped = make(chan struct{})
+	c.lock.Unlock()
+
+	closeChs := make(map[string]chan struct{}, len(c.conns))
+	resetChs := make(map[string]chan struct{}, len(c.conns))
+	errCh := make(chan error, len(c.conns))
+	wg := &sync.WaitGroup{}
+	for name, conn := range c.conns {
+		closeChs[name] = make(chan struct{}, 1)
+		resetChs[name] = make(chan struct{}, 1)
+		wg.Add(1)
+		go func(name string, conn eventbuscommon.TriggerConnection, closeCh, resetCh <-chan struct{}) {
+			defer wg.Done()
+			if err := conn.Subscribe(ctx, closeCh, resetCh, lastResetTime, transform, filter, c.join(name, action), defaultSubject); err != nil {
+				errCh <- fmt.Errorf("eventbus %s: %w", name, err)
+			}
+			// The subscriptions end together
+			cancel()
+		}(name, conn, closeChs[name], resetChs[name])
+	}
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+
+	for {
+		select {
+		case <-done:
+			c.stop()
+			select {
+			case err := <-errCh:
+				return err
+			default:
+				return nil
+			}
+		case <-ctx.Done():
+			c.stop()
+			<-done
+			select {
+			case err := <-errCh:
+				return err
+			default:
+				return nil
+			}
+		case <-closeCh:
+			c.stop()
+			for _, ch := range closeChs {
+				ch <- struct{}{}
+			}
+			<-done
+			return nil
+		case <-resetConditionsCh:
+			c.logger.Info("reset conditions")
+			c.reset()
+			for _, ch := range resetChs {
+				select {
+				case ch <- struct{}{}:
+				default:
+				}
+			}
+		}
+	}
+}
+
+// join returns the action of a connection, it holds the events until the dependency expression is satisfied, and
+// runs the action on the joined events. The action of a holding connection returns once the trigger fired, so that
+// the connection acknowledges the held event after the trigger.
+func (c *MultiTriggerConn) join(connName string, action func(map[string]cloudevents.Event)) func(map[string]cloudevents.Event) {
+	return func(events map[string]cloudevents.Event) {
+		c.lock.Lock()
+		for depName, event := range events {
+			c.events[depName] = event
+		}
+		parameters := make(map[string]interface{}, len(c.depNames))
+		received := make(map[string]bool, len(c.depNames))
+		for _, depName := range c.depNames {
+			_, ok := c.events[depName]
+			parameters[depName] = ok
+			received[depName] = ok
+		}
+		result, err := c.expr.Evaluate(parameters)
+		satisfied := err == nil && result == true
+		if c.observeConditions != nil {
+			for depName, event := range events {
+				c.observeConditions(depName, event, received, satisfied, err)
+			}
+		}
+		if err != nil {
+			c.lock.Unlock()
+			c.logger.Errorw("failed to evaluate the dependency expression", zap.Error(err))
+			return
+		}
+		if !satisfied {
+			var met []string
+			for depName := range c.events {
+				met = append(met, depName)
+			}
+			var waiter chan struct{}
+			if c.holds[connName] {
+				waiter = make(chan struct{})
+				c.waiters = append(c.waiters, waiter)
+			}
+			stopped := c.stopped
+			c.lock.Unlock()
+			sort.Strings(met)
+			c.logger.Infow("trigger conditions not met", zap.Strings("meetDependencies", met))
+			if waiter != nil {
+				select {
+				case <-waiter:
+				case <-stopped:
+				}
+			}
+			return
+		}
+		joined := c.events
+		waiters := c.waiters
+		c.events = make(map[string]cloudevents.Event)
+		c.waiters = nil
+		c.lock.Unlock()
+		action(joined)
+		for _, w := range waiters {
+			close(w)
+		}
+	}
+}
+
+// reset drops the held events, and releases the blocked actions, their events are acknowledged.
+func (c *MultiTriggerConn) reset() {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.events = make(map[string]cloudevents.Event)
+	for _, w := range c.waiters {
+		close(w)
+	}
+	c.waiters = nil
+}
+
+// stop releases the blocked actions when the subscriptions end. The holding connections are closed first, so that
+// the held events are not acknowledged, and are delivered again once the connections are reconnected.
+func (c *MultiTriggerConn) stop() {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if c.stopped == nil {
+		return
+	}
+	if len(c.waiters) > 0 {
+		for name := range c.holds {
+			if conn := c.conns[name]; conn != nil && !conn.IsClosed() {
+				_ = conn.Close()
+			}
+		}
+	}
+	c.events = make(map[string]cloudevents.Event)
+	c.waiters = nil
+	close(c.stopped)
+	c.stopped = nil
+}
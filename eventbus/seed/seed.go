@@ -0,0 +1,147 @@
+// Package seed publishes the synthetic events declared in the seed of an EventBus.
+package seed
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"sigs.k8s.io/controller-runtime/pkg/manager/signals"
+
+	"github.com/argoproj/argo-events/common"
+	"github.com/argoproj/argo-events/common/logging"
+	"github.com/argoproj/argo-events/eventbus"
+	eventbuscommon "github.com/argoproj/argo-events/eventbus/common"
+	"github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1"
+)
+
+// DefaultEventType is the type of the seed events which do not specify one
+const DefaultEventType = "seed"
+
+// GetDriverFunc returns the EventBus driver to publish the events of an EventSource.
+type GetDriverFunc func(ctx context.Context, eventSourceName string) (eventbuscommon.EventSourceDriver, error)
+
+// Start publishes the seed events configured by the environment variables, it is the entrypoint of the seed Jobs.
+func Start() {
+	logger := logging.NewArgoEventsLogger().Named("eventbus-seed")
+	busConfig := v1alpha1.BusConfig{}
+	if err := decodeEnv(common.EnvVarEventBusConfig, &busConfig); err != nil {
+		logger.Fatalw("failed to get the eventbus config", zap.Error(err))
+	}
+	busSeed := v1alpha1.EventBusSeed{}
+	if err := decodeEnv(common.EnvVarEventBusSeed, &busSeed); err != nil {
+		logger.Fatalw("failed to get the eventbus seed", zap.Error(err))
+	}
+	subject, defined := os.LookupEnv(common.EnvVarEventBusSubject)
+	if !defined {
+		logger.Fatalf("required environment variable '%s' not defined", common.EnvVarEventBusSubject)
+	}
+	ctx := logging.WithLogger(signals.SetupSignalHandler(), logger)
+	if delay, err := time.ParseDuration(busSeed.Delay); err == nil && delay > 0 {
+		logger.Infof("waiting %v before publishing the seed events", delay)
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(delay):
+		}
+	}
+	getDriver := func(ctx context.Context, eventSourceName string) (eventbuscommon.EventSourceDriver, error) {
+		return eventbus.GetEventSourceDriver(ctx, busConfig, eventSourceName, subject)
+	}
+	if err := Publish(ctx, busSeed.Events, busConfig.Format, getDriver); err != nil {
+		logger.Fatalw("failed to publish the seed events", zap.Error(err))
+	}
+	logger.Infof("published %d seed events", len(busSeed.Events))
+}
+
+func decodeEnv(name string, v interface{}) error {
+	encoded, defined := os.LookupEnv(name)
+	if !defined {
+		return fmt.Errorf("required environment variable '%s' not defined", name)
+	}
+	b, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return fmt.Errorf("failed to decode %s, %w", name, err)
+	}
+	return json.Unmarshal(b, v)
+}
+
+// Publish publishes the events in order, with a connection per EventSource, in the format of the EventBus.
+func Publish(ctx context.Context, events []v1alpha1.SeedEvent, format v1alpha1.EventFormat, getDriver GetDriverFunc) error {
+	logger := logging.FromContext(ctx)
+	conns := make(map[string]eventbuscommon.EventSourceConnection)
+	defer func() {
+		for _, conn := range conns {
+			_ = conn.Close()
+		}
+	}()
+	for i, e := range events {
+		conn, ok := conns[e.EventSourceName]
+		if !ok {
+			driver, err := getDriver(ctx, e.EventSourceName)
+			if err != nil {
+				return fmt.Errorf("failed to get the eventbus driver, %w", err)
+			}
+			if err := common.DoWithRetry(&common.DefaultBackoff, driver.Initialize); err != nil {
+				return fmt.Errorf("failed to initialize the eventbus driver, %w", err)
+			}
+			if err := common.DoWithRetry(&common.DefaultBackoff, func() error {
+				var err error
+				conn, err = driver.Connect(fmt.Sprintf("seed-%s", e.EventSourceName))
+				return err
+			}); err != nil {
+				return fmt.Errorf("failed to connect to the eventbus, %w", err)
+			}
+			conns[e.EventSourceName] = conn
+		}
+		msg, err := newMessage(e, format)
+		if err != nil {
+			return fmt.Errorf("invalid seed event %d, %w", i, err)
+		}
+		if err := common.DoWithRetry(&common.DefaultBackoff, func() error {
+			return conn.Publish(ctx, msg)
+		}); err != nil {
+			return fmt.Errorf("failed to publish seed event %d, %w", i, err)
+		}
+		logger.Infow("published a seed event", zap.String(logging.LabelEventSourceName, e.EventSourceName),
+			zap.String(logging.LabelEventName, e.EventName), zap.String("eventID", msg.ID))
+	}
+	return nil
+}
+
+// newMessage returns the EventBus message of a seed event, a CloudEvent like the ones of the EventSources.
+func newMessage(e v1alpha1.SeedEvent, format v1alpha1.EventFormat) (eventbuscommon.Message, error) {
+	eventType := e.Type
+	if eventType == "" {
+		eventType = DefaultEventType
+	}
+	uuidNew := uuid.New()
+	event := cloudevents.NewEvent()
+	event.SetID(fmt.Sprintf("%x", uuidNew[:]))
+	event.SetType(eventType)
+	event.SetSource(e.EventSourceName)
+	event.SetSubject(e.EventName)
+	event.SetTime(time.Now())
+	if e.Data != "" {
+		if !json.Valid([]byte(e.Data)) {
+			return eventbuscommon.Message{}, fmt.Errorf("data is not valid JSON")
+		}
+		if err := event.SetData(cloudevents.ApplicationJSON, []byte(e.Data)); err != nil {
+			return eventbuscommon.Message{}, err
+		}
+	}
+	body, err := eventbuscommon.EncodeEvent(format, &event)
+	if err != nil {
+		return eventbuscommon.Message{}, err
+	}
+	return eventbuscommon.Message{
+		MsgHeader: eventbuscommon.MsgHeader{EventSourceName: e.EventSourceName, EventName: e.EventName, ID: event.ID()},
+		Body:      body,
+	}, nil
+}
@@ -0,0 +1,77 @@
+package seed
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/stretchr/testify/assert"
+
+	eventbuscommon "github.com/argoproj/argo-events/eventbus/common"
+	"github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1"
+)
+
+type fakeConnection struct {
+	published []eventbuscommon.Message
+	closed    bool
+}
+
+func (c *fakeConnection) Close() error {
+	c.closed = true
+	return nil
+}
+
+func (c *fakeConnection) IsClosed() bool {
+	return c.closed
+}
+
+func (c *fakeConnection) Publish(ctx context.Context, msg eventbuscommon.Message) error {
+	c.published = append(c.published, msg)
+	return nil
+}
+
+type fakeDriver struct {
+	conn *fakeConnection
+}
+
+func (d *fakeDriver) Initialize() error {
+	return nil
+}
+
+func (d *fakeDriver) Connect(clientID string) (eventbuscommon.EventSourceConnection, error) {
+	return d.conn, nil
+}
+
+func TestPublish(t *testing.T) {
+	drivers := map[string]*fakeDriver{}
+	getDriver := func(ctx context.Context, eventSourceName string) (eventbuscommon.EventSourceDriver, error) {
+		d := &fakeDriver{conn: &fakeConnection{}}
+		drivers[eventSourceName] = d
+		return d, nil
+	}
+	events := []v1alpha1.SeedEvent{
+		{EventSourceName: "webhook", EventName: "example", Data: `{"a":1}`},
+		{EventSourceName: "calendar", EventName: "daily", Type: "calendar"},
+		{EventSourceName: "webhook", EventName: "example", Data: `{"a":2}`},
+	}
+	assert.NoError(t, Publish(context.Background(), events, v1alpha1.EventFormatJSON, getDriver))
+	assert.Len(t, drivers, 2)
+	assert.Len(t, drivers["webhook"].conn.published, 2)
+	assert.Len(t, drivers["calendar"].conn.published, 1)
+	assert.True(t, drivers["webhook"].conn.closed)
+
+	msg := drivers["webhook"].conn.published[1]
+	assert.Equal(t, "example", msg.EventName)
+	event := cloudevents.NewEvent()
+	assert.NoError(t, json.Unmarshal(msg.Body, &event))
+	assert.Equal(t, msg.ID, event.ID())
+	assert.Equal(t, DefaultEventType, event.Type())
+	assert.Equal(t, "webhook", event.Source())
+	assert.JSONEq(t, `{"a":2}`, string(event.Data()))
+}
+
+func TestNewMessageInvalidData(t *testing.T) {
+	_, err := newMessage(v1alpha1.SeedEvent{EventSourceName: "webhook", EventName: "example", Data: "{"}, v1alpha1.EventFormatJSON)
+	assert.Error(t, err)
+}
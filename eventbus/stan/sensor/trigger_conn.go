@@ -2,7 +2,6 @@ package sensor
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"strings"
 	"sync"
@@ -27,10 +26,11 @@ type STANTriggerConn struct {
 	triggerName          string
 	dependencyExpression string
 	deps                 []eventbuscommon.Dependency
+	observeConditions    eventbuscommon.ConditionsObserveFunc
 }
 
 func NewSTANTriggerConn(conn *stanbase.STANConnection, sensorName string, triggerName string, dependencyExpression string, deps []eventbuscommon.Dependency) *STANTriggerConn {
-	n := &STANTriggerConn{conn, sensorName, triggerName, dependencyExpression, deps}
+	n := &STANTriggerConn{STANConnection: conn, sensorName: sensorName, triggerName: triggerName, dependencyExpression: dependencyExpression, deps: deps}
 	n.Logger = n.Logger.With("triggerName", n.triggerName).With("clientID", n.ClientID)
 	return n
 }
@@ -42,6 +42,11 @@ func (n *STANTriggerConn) String() string {
 	return fmt.Sprintf("STANTriggerConn{ClientID:%s,Sensor:%s,Trigger:%s}", n.ClientID, n.sensorName, n.triggerName)
 }
 
+// ObserveConditions implements eventbuscommon.ConditionsObserver.
+func (n *STANTriggerConn) ObserveConditions(observe eventbuscommon.ConditionsObserveFunc) {
+	n.observeConditions = observe
+}
+
 func (conn *STANTriggerConn) IsClosed() bool {
 	return conn == nil || conn.STANConnection.IsClosed()
 }
@@ -162,8 +167,8 @@ func (n *STANTriggerConn) Subscribe(
 }
 
 func (n *STANTriggerConn) processEventSourceMsg(m *stan.Msg, msgHolder *eventSourceMessageHolder, transform func(depName string, event cloudevents.Event) (*cloudevents.Event, error), filter func(dependencyName string, event cloudevents.Event) bool, action func(map[string]cloudevents.Event), log *zap.SugaredLogger) {
-	var event *cloudevents.Event
-	if err := json.Unmarshal(m.Data, &event); err != nil {
+	event, err := eventbuscommon.DecodeEvent(m.Data)
+	if err != nil {
 		log.Errorf("Failed to convert to a cloudevent, discarding it... err: %v", err)
 		_ = m.Ack()
 		return
@@ -256,6 +261,13 @@ func (n *STANTriggerConn) processEventSourceMsg(m *stan.Msg, msgHolder *eventSou
 	}
 
 	result, err := msgHolder.expr.Evaluate(msgHolder.parameters)
+	if n.observeConditions != nil {
+		received := make(map[string]bool, len(msgHolder.parameters))
+		for k, v := range msgHolder.parameters {
+			received[k] = v == true
+		}
+		n.observeConditions(depName, *event, received, result == true, err)
+	}
 	if err != nil {
 		log.Errorf("failed to evaluate dependency expression: %v", err)
 		// TODO: how to handle this situation?
@@ -4,9 +4,11 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"net/http"
 	"os"
 
 	"go.uber.org/zap"
+	"k8s.io/client-go/dynamic"
 	"sigs.k8s.io/controller-runtime/pkg/manager/signals"
 
 	argoevents "github.com/argoproj/argo-events"
@@ -58,10 +60,40 @@ func Start() {
 	logger = logger.With(logging.LabelEventSourceName, eventSource.Name)
 	ctx := logging.WithLogger(signals.SetupSignalHandler(), logger)
 	m := metrics.NewMetrics(eventSource.Namespace)
+	if eventSource.Spec.Metrics != nil {
+		m.SetLabelLimits(eventSource.Spec.Metrics.LabelLimits)
+	}
+	// The client is only required by the dependency probes, the operations being retried are otherwise not
+	// reported in the status without it
+	var dynamicClient dynamic.Interface
+	kubeConfig, _ := os.LookupEnv(common.EnvVarKubeConfig)
+	restConfig, err := common.GetClientConfig(kubeConfig)
+	if err == nil {
+		dynamicClient, err = dynamic.NewForConfig(restConfig)
+	}
+	if err != nil {
+		if len(eventSource.Spec.DependencyProbes) > 0 {
+			logger.Fatalw("failed to get kubeconfig", zap.Error(err))
+		}
+		logger.Warnw("failed to get kubeconfig, the operations being retried are not reported in the status", zap.Error(err))
+	}
+	if len(eventSource.Spec.DependencyProbes) > 0 {
+		prober := eventsources.NewDependencyProber(eventSource, dynamicClient)
+		// The readiness endpoint is served by the metrics server
+		http.Handle(common.EventSourceReadinessPath, prober)
+		go prober.Run(ctx)
+	}
 	go m.Run(ctx, fmt.Sprintf(":%d", common.EventSourceMetricsPort))
+	if dynamicClient != nil {
+		go eventsources.ReportRetryStatus(ctx, eventSource, dynamicClient, hostname)
+	}
 
 	logger.Infow("starting eventsource server", "version", argoevents.GetVersion())
 	adaptor := eventsources.NewEventSourceAdaptor(eventSource, busConfig, ebSubject, hostname, m)
+	if token := os.Getenv(common.EnvVarDebugToken); token != "" {
+		// The debug endpoint is served by the metrics server
+		http.Handle(common.DebugConfigPath, common.DebugConfigHandler(token, adaptor.DebugConfig))
+	}
 
 	if err := adaptor.Start(ctx); err != nil {
 		logger.Fatalw("failed to start eventsource server", zap.Error(err))
@@ -1,6 +1,10 @@
 package common
 
-import "github.com/cloudevents/sdk-go/v2/event"
+import (
+	"context"
+
+	"github.com/cloudevents/sdk-go/v2/event"
+)
 
 type Option func(*event.Event) error
 
@@ -11,3 +15,30 @@ func WithID(id string) Option {
 		return nil
 	}
 }
+
+// Option to set CloudEvent extensions of the event
+func WithExtensions(extensions map[string]string) Option {
+	return func(e *event.Event) error {
+		for name, value := range extensions {
+			if err := e.Context.SetExtension(name, value); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+type maxEventSizeKey struct{}
+
+// WithMaxEventSize returns a copy of the context carrying the maximum size of the events to be accepted.
+func WithMaxEventSize(ctx context.Context, size int64) context.Context {
+	return context.WithValue(ctx, maxEventSizeKey{}, size)
+}
+
+// MaxEventSizeFromContext returns the maximum size of the events to be accepted, 0 means unlimited.
+func MaxEventSizeFromContext(ctx context.Context) int64 {
+	if size, ok := ctx.Value(maxEventSizeKey{}).(int64); ok {
+		return size
+	}
+	return 0
+}
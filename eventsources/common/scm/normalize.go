@@ -0,0 +1,237 @@
+package scm
+
+import (
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/tidwall/gjson"
+
+	"github.com/argoproj/argo-events/pkg/apis/events"
+)
+
+const (
+	ProviderGitHub          = "github"
+	ProviderGitLab          = "gitlab"
+	ProviderBitbucket       = "bitbucket"
+	ProviderBitbucketServer = "bitbucketserver"
+
+	TypePush        = "push"
+	TypePullRequest = "pullRequest"
+)
+
+// NormalizeGitHub returns the normalized form of a GitHub push or pull request event, nil for the other events.
+func NormalizeGitHub(header http.Header, body []byte) *events.SCMEventData {
+	b := gjson.ParseBytes(body)
+	switch header.Get("X-GitHub-Event") {
+	case "push":
+		return &events.SCMEventData{
+			Provider: ProviderGitHub,
+			Type:     TypePush,
+			Repo:     b.Get("repository.full_name").String(),
+			Ref:      b.Get("ref").String(),
+			SHA:      b.Get("after").String(),
+			Author: events.SCMAuthor{
+				Name:     b.Get("head_commit.author.name").String(),
+				Email:    b.Get("pusher.email").String(),
+				Username: b.Get("pusher.name").String(),
+			},
+			FilesChanged: filesChanged(b.Get("commits")),
+		}
+	case "pull_request":
+		pr := b.Get("pull_request")
+		return &events.SCMEventData{
+			Provider: ProviderGitHub,
+			Type:     TypePullRequest,
+			Repo:     b.Get("repository.full_name").String(),
+			Ref:      branchRef(pr.Get("head.ref").String()),
+			SHA:      pr.Get("head.sha").String(),
+			Author:   events.SCMAuthor{Username: pr.Get("user.login").String()},
+			PullRequest: &events.SCMPullRequest{
+				Number:    b.Get("number").Int(),
+				Action:    b.Get("action").String(),
+				Title:     pr.Get("title").String(),
+				URL:       pr.Get("html_url").String(),
+				TargetRef: branchRef(pr.Get("base.ref").String()),
+			},
+		}
+	}
+	return nil
+}
+
+// NormalizeGitLab returns the normalized form of a GitLab push, tag push or merge request event, nil for the other
+// events.
+func NormalizeGitLab(header http.Header, body []byte) *events.SCMEventData {
+	b := gjson.ParseBytes(body)
+	switch header.Get("X-Gitlab-Event") {
+	case "Push Hook", "Tag Push Hook":
+		sha := b.Get("checkout_sha").String()
+		if sha == "" {
+			sha = b.Get("after").String()
+		}
+		return &events.SCMEventData{
+			Provider: ProviderGitLab,
+			Type:     TypePush,
+			Repo:     b.Get("project.path_with_namespace").String(),
+			Ref:      b.Get("ref").String(),
+			SHA:      sha,
+			Author: events.SCMAuthor{
+				Name:     b.Get("user_name").String(),
+				Email:    b.Get("user_email").String(),
+				Username: b.Get("user_username").String(),
+			},
+			FilesChanged: filesChanged(b.Get("commits")),
+		}
+	case "Merge Request Hook":
+		mr := b.Get("object_attributes")
+		return &events.SCMEventData{
+			Provider: ProviderGitLab,
+			Type:     TypePullRequest,
+			Repo:     b.Get("project.path_with_namespace").String(),
+			Ref:      branchRef(mr.Get("source_branch").String()),
+			SHA:      mr.Get("last_commit.id").String(),
+			Author: events.SCMAuthor{
+				Name:     b.Get("user.name").String(),
+				Email:    b.Get("user.email").String(),
+				Username: b.Get("user.username").String(),
+			},
+			PullRequest: &events.SCMPullRequest{
+				Number:    mr.Get("iid").Int(),
+				Action:    mr.Get("action").String(),
+				Title:     mr.Get("title").String(),
+				URL:       mr.Get("url").String(),
+				TargetRef: branchRef(mr.Get("target_branch").String()),
+			},
+		}
+	}
+	return nil
+}
+
+// NormalizeBitbucket returns the normalized form of a Bitbucket push or pull request event, nil for the other
+// events. The Bitbucket payloads do not include the changed files.
+func NormalizeBitbucket(header http.Header, body []byte) *events.SCMEventData {
+	b := gjson.ParseBytes(body)
+	key := header.Get("X-Event-Key")
+	switch {
+	case key == "repo:push":
+		change := b.Get("push.changes.0")
+		state := change.Get("new")
+		if !state.Exists() || state.Type == gjson.Null {
+			// The ref was deleted
+			state = change.Get("old")
+		}
+		ref := state.Get("name").String()
+		if state.Get("type").String() == "tag" {
+			ref = "refs/tags/" + ref
+		} else {
+			ref = branchRef(ref)
+		}
+		return &events.SCMEventData{
+			Provider: ProviderBitbucket,
+			Type:     TypePush,
+			Repo:     b.Get("repository.full_name").String(),
+			Ref:      ref,
+			SHA:      change.Get("new.target.hash").String(),
+			Author: events.SCMAuthor{
+				Name:     b.Get("actor.display_name").String(),
+				Username: b.Get("actor.nickname").String(),
+			},
+		}
+	case strings.HasPrefix(key, "pullrequest:"):
+		pr := b.Get("pullrequest")
+		return &events.SCMEventData{
+			Provider: ProviderBitbucket,
+			Type:     TypePullRequest,
+			Repo:     b.Get("repository.full_name").String(),
+			Ref:      branchRef(pr.Get("source.branch.name").String()),
+			SHA:      pr.Get("source.commit.hash").String(),
+			Author: events.SCMAuthor{
+				Name:     pr.Get("author.display_name").String(),
+				Username: pr.Get("author.nickname").String(),
+			},
+			PullRequest: &events.SCMPullRequest{
+				Number:    pr.Get("id").Int(),
+				Action:    strings.TrimPrefix(key, "pullrequest:"),
+				Title:     pr.Get("title").String(),
+				URL:       pr.Get("links.html.href").String(),
+				TargetRef: branchRef(pr.Get("destination.branch.name").String()),
+			},
+		}
+	}
+	return nil
+}
+
+// NormalizeBitbucketServer returns the normalized form of a Bitbucket Server refs changed or pull request event,
+// nil for the other events. The Bitbucket Server payloads do not include the changed files.
+func NormalizeBitbucketServer(header http.Header, body []byte) *events.SCMEventData {
+	b := gjson.ParseBytes(body)
+	key := header.Get("X-Event-Key")
+	switch {
+	case key == "repo:refs_changed":
+		change := b.Get("changes.0")
+		return &events.SCMEventData{
+			Provider: ProviderBitbucketServer,
+			Type:     TypePush,
+			Repo:     bitbucketServerRepo(b.Get("repository")),
+			Ref:      change.Get("ref.id").String(),
+			SHA:      change.Get("toHash").String(),
+			Author: events.SCMAuthor{
+				Name:     b.Get("actor.displayName").String(),
+				Email:    b.Get("actor.emailAddress").String(),
+				Username: b.Get("actor.name").String(),
+			},
+		}
+	case strings.HasPrefix(key, "pr:"):
+		pr := b.Get("pullRequest")
+		return &events.SCMEventData{
+			Provider: ProviderBitbucketServer,
+			Type:     TypePullRequest,
+			Repo:     bitbucketServerRepo(pr.Get("toRef.repository")),
+			Ref:      pr.Get("fromRef.id").String(),
+			SHA:      pr.Get("fromRef.latestCommit").String(),
+			Author: events.SCMAuthor{
+				Name:     pr.Get("author.user.displayName").String(),
+				Email:    pr.Get("author.user.emailAddress").String(),
+				Username: pr.Get("author.user.name").String(),
+			},
+			PullRequest: &events.SCMPullRequest{
+				Number:    pr.Get("id").Int(),
+				Action:    strings.TrimPrefix(key, "pr:"),
+				Title:     pr.Get("title").String(),
+				URL:       pr.Get("links.self.0.href").String(),
+				TargetRef: pr.Get("toRef.id").String(),
+			},
+		}
+	}
+	return nil
+}
+
+func bitbucketServerRepo(repo gjson.Result) string {
+	return repo.Get("project.key").String() + "/" + repo.Get("slug").String()
+}
+
+// branchRef returns the full ref name of a branch.
+func branchRef(branch string) string {
+	if branch == "" || strings.HasPrefix(branch, "refs/") {
+		return branch
+	}
+	return "refs/heads/" + branch
+}
+
+// filesChanged returns the sorted paths of the files added, modified or removed by the commits.
+func filesChanged(commits gjson.Result) []string {
+	files := map[string]bool{}
+	for _, commit := range commits.Array() {
+		for _, key := range []string{"added", "modified", "removed"} {
+			for _, file := range commit.Get(key).Array() {
+				files[file.String()] = true
+			}
+		}
+	}
+	result := make([]string, 0, len(files))
+	for file := range files {
+		result = append(result, file)
+	}
+	sort.Strings(result)
+	return result
+}
@@ -0,0 +1,131 @@
+package scm
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/argoproj/argo-events/pkg/apis/events"
+)
+
+func header(key, value string) http.Header {
+	h := http.Header{}
+	h.Set(key, value)
+	return h
+}
+
+func TestNormalizeGitHub(t *testing.T) {
+	push := `{"ref":"refs/heads/main","after":"abc123","repository":{"full_name":"argoproj/argo-events"},
+		"pusher":{"name":"octocat","email":"octocat@example.com"},"head_commit":{"author":{"name":"Octo Cat"}},
+		"commits":[{"added":["b.go"],"modified":["a.go"],"removed":[]},{"added":[],"modified":["a.go"],"removed":["c.go"]}]}`
+	assert.Equal(t, &events.SCMEventData{
+		Provider:     ProviderGitHub,
+		Type:         TypePush,
+		Repo:         "argoproj/argo-events",
+		Ref:          "refs/heads/main",
+		SHA:          "abc123",
+		Author:       events.SCMAuthor{Name: "Octo Cat", Email: "octocat@example.com", Username: "octocat"},
+		FilesChanged: []string{"a.go", "b.go", "c.go"},
+	}, NormalizeGitHub(header("X-GitHub-Event", "push"), []byte(push)))
+
+	pr := `{"action":"opened","number":42,"repository":{"full_name":"argoproj/argo-events"},
+		"pull_request":{"title":"Fix","html_url":"https://github.com/argoproj/argo-events/pull/42","user":{"login":"octocat"},
+		"head":{"ref":"fix","sha":"def456"},"base":{"ref":"main"}}}`
+	assert.Equal(t, &events.SCMEventData{
+		Provider: ProviderGitHub,
+		Type:     TypePullRequest,
+		Repo:     "argoproj/argo-events",
+		Ref:      "refs/heads/fix",
+		SHA:      "def456",
+		Author:   events.SCMAuthor{Username: "octocat"},
+		PullRequest: &events.SCMPullRequest{
+			Number:    42,
+			Action:    "opened",
+			Title:     "Fix",
+			URL:       "https://github.com/argoproj/argo-events/pull/42",
+			TargetRef: "refs/heads/main",
+		},
+	}, NormalizeGitHub(header("X-GitHub-Event", "pull_request"), []byte(pr)))
+
+	assert.Nil(t, NormalizeGitHub(header("X-GitHub-Event", "issues"), []byte(`{}`)))
+}
+
+func TestNormalizeGitLab(t *testing.T) {
+	push := `{"ref":"refs/tags/v1.0.0","after":"abc123","checkout_sha":"abc123","project":{"path_with_namespace":"group/project"},
+		"user_name":"Jane","user_email":"jane@example.com","user_username":"jane","commits":[{"added":["a.go"]}]}`
+	e := NormalizeGitLab(header("X-Gitlab-Event", "Tag Push Hook"), []byte(push))
+	assert.Equal(t, TypePush, e.Type)
+	assert.Equal(t, "group/project", e.Repo)
+	assert.Equal(t, "refs/tags/v1.0.0", e.Ref)
+	assert.Equal(t, events.SCMAuthor{Name: "Jane", Email: "jane@example.com", Username: "jane"}, e.Author)
+	assert.Equal(t, []string{"a.go"}, e.FilesChanged)
+
+	mr := `{"project":{"path_with_namespace":"group/project"},"user":{"name":"Jane","username":"jane"},
+		"object_attributes":{"iid":7,"action":"merge","title":"Feature","url":"https://gitlab.com/group/project/-/merge_requests/7",
+		"source_branch":"feature","target_branch":"main","last_commit":{"id":"def456"}}}`
+	e = NormalizeGitLab(header("X-Gitlab-Event", "Merge Request Hook"), []byte(mr))
+	assert.Equal(t, TypePullRequest, e.Type)
+	assert.Equal(t, "refs/heads/feature", e.Ref)
+	assert.Equal(t, "def456", e.SHA)
+	assert.Equal(t, int64(7), e.PullRequest.Number)
+	assert.Equal(t, "merge", e.PullRequest.Action)
+	assert.Equal(t, "refs/heads/main", e.PullRequest.TargetRef)
+}
+
+func TestNormalizeBitbucket(t *testing.T) {
+	push := `{"repository":{"full_name":"team/repo"},"actor":{"display_name":"Jane Doe","nickname":"jane"},
+		"push":{"changes":[{"new":{"type":"branch","name":"main","target":{"hash":"abc123"}}}]}}`
+	e := NormalizeBitbucket(header("X-Event-Key", "repo:push"), []byte(push))
+	assert.Equal(t, &events.SCMEventData{
+		Provider: ProviderBitbucket,
+		Type:     TypePush,
+		Repo:     "team/repo",
+		Ref:      "refs/heads/main",
+		SHA:      "abc123",
+		Author:   events.SCMAuthor{Name: "Jane Doe", Username: "jane"},
+	}, e)
+
+	deleted := `{"repository":{"full_name":"team/repo"},"push":{"changes":[{"new":null,"old":{"type":"tag","name":"v1"}}]}}`
+	e = NormalizeBitbucket(header("X-Event-Key", "repo:push"), []byte(deleted))
+	assert.Equal(t, "refs/tags/v1", e.Ref)
+	assert.Empty(t, e.SHA)
+
+	pr := `{"repository":{"full_name":"team/repo"},"pullrequest":{"id":3,"title":"Fix","links":{"html":{"href":"https://bitbucket.org/team/repo/pull-requests/3"}},
+		"author":{"display_name":"Jane Doe","nickname":"jane"},"source":{"branch":{"name":"fix"},"commit":{"hash":"def456"}},
+		"destination":{"branch":{"name":"main"}}}}`
+	e = NormalizeBitbucket(header("X-Event-Key", "pullrequest:fulfilled"), []byte(pr))
+	assert.Equal(t, "refs/heads/fix", e.Ref)
+	assert.Equal(t, &events.SCMPullRequest{
+		Number:    3,
+		Action:    "fulfilled",
+		Title:     "Fix",
+		URL:       "https://bitbucket.org/team/repo/pull-requests/3",
+		TargetRef: "refs/heads/main",
+	}, e.PullRequest)
+}
+
+func TestNormalizeBitbucketServer(t *testing.T) {
+	push := `{"repository":{"slug":"repo","project":{"key":"PRJ"}},"actor":{"name":"jane","emailAddress":"jane@example.com","displayName":"Jane Doe"},
+		"changes":[{"ref":{"id":"refs/heads/main","type":"BRANCH"},"toHash":"abc123"}]}`
+	e := NormalizeBitbucketServer(header("X-Event-Key", "repo:refs_changed"), []byte(push))
+	assert.Equal(t, &events.SCMEventData{
+		Provider: ProviderBitbucketServer,
+		Type:     TypePush,
+		Repo:     "PRJ/repo",
+		Ref:      "refs/heads/main",
+		SHA:      "abc123",
+		Author:   events.SCMAuthor{Name: "Jane Doe", Email: "jane@example.com", Username: "jane"},
+	}, e)
+
+	pr := `{"pullRequest":{"id":5,"title":"Fix","links":{"self":[{"href":"https://bitbucket.example.com/projects/PRJ/repos/repo/pull-requests/5"}]},
+		"author":{"user":{"name":"jane"}},"fromRef":{"id":"refs/heads/fix","latestCommit":"def456"},
+		"toRef":{"id":"refs/heads/main","repository":{"slug":"repo","project":{"key":"PRJ"}}}}}`
+	e = NormalizeBitbucketServer(header("X-Event-Key", "pr:opened"), []byte(pr))
+	assert.Equal(t, "PRJ/repo", e.Repo)
+	assert.Equal(t, "def456", e.SHA)
+	assert.Equal(t, "opened", e.PullRequest.Action)
+	assert.Equal(t, "refs/heads/main", e.PullRequest.TargetRef)
+
+	assert.Nil(t, NormalizeBitbucketServer(header("X-Event-Key", "repo:modified"), []byte(`{}`)))
+}
@@ -0,0 +1,254 @@
+package webhook
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/argoproj/argo-events/pkg/apis/eventsource/v1alpha1"
+)
+
+// RequestMetadata is the metadata of a request, added to its event payload when the enrichment is enabled.
+type RequestMetadata struct {
+	// SourceIP is the IP of the client, the address of the peer or the one forwarded by the trusted proxies.
+	SourceIP string `json:"sourceIP"`
+	// ForwardedFor are the addresses of the X-Forwarded-For header, only set when the peer is a trusted proxy.
+	ForwardedFor []string `json:"forwardedFor,omitempty"`
+	// Networks are the names of the networks the source IP belongs to.
+	Networks []string `json:"networks,omitempty"`
+	Method   string   `json:"method"`
+	Host     string   `json:"host"`
+	Path     string   `json:"path"`
+	// UserAgent is the parsed User-Agent header, if any.
+	UserAgent *UserAgent `json:"userAgent,omitempty"`
+	// TLS is the TLS connection, when the TLS is terminated by the EventSource.
+	TLS *TLSMetadata `json:"tls,omitempty"`
+	// ClientIdentity is the identity of the TLS client, set by a trusted proxy terminating TLS.
+	ClientIdentity string `json:"clientIdentity,omitempty"`
+	// ReceivedAt is the time the request was received.
+	ReceivedAt time.Time `json:"receivedAt"`
+}
+
+// UserAgent is a parsed User-Agent header.
+type UserAgent struct {
+	Raw     string `json:"raw"`
+	Name    string `json:"name,omitempty"`
+	Version string `json:"version,omitempty"`
+	OS      string `json:"os,omitempty"`
+	// Browser is whether the client is a web browser, rather than a program calling the endpoint.
+	Browser bool `json:"browser"`
+}
+
+// TLSMetadata describes the TLS connection of a request.
+type TLSMetadata struct {
+	Version     string `json:"version"`
+	CipherSuite string `json:"cipherSuite"`
+	ServerName  string `json:"serverName,omitempty"`
+}
+
+// enricher adds the metadata of the requests to the event payloads of a route.
+type enricher struct {
+	key                  string
+	trustedProxies       []*net.IPNet
+	clientIdentityHeader string
+	networks             []network
+}
+
+type network struct {
+	name  string
+	cidrs []*net.IPNet
+}
+
+func newEnricher(e *v1alpha1.WebhookEnrichment) (*enricher, error) {
+	result := &enricher{key: e.GetKey(), clientIdentityHeader: e.ClientIdentityHeader}
+	var err error
+	if result.trustedProxies, err = parseCIDRs(e.TrustedProxies); err != nil {
+		return nil, fmt.Errorf("invalid trustedProxies, %w", err)
+	}
+	for _, n := range e.Networks {
+		cidrs, err := parseCIDRs(n.CIDRs)
+		if err != nil {
+			return nil, fmt.Errorf("invalid cidrs of the network %s, %w", n.Name, err)
+		}
+		result.networks = append(result.networks, network{name: n.Name, cidrs: cidrs})
+	}
+	return result, nil
+}
+
+func parseCIDRs(values []string) ([]*net.IPNet, error) {
+	result := make([]*net.IPNet, 0, len(values))
+	for _, value := range values {
+		_, cidr, err := net.ParseCIDR(value)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, cidr)
+	}
+	return result, nil
+}
+
+func containsIP(cidrs []*net.IPNet, ip net.IP) bool {
+	for _, cidr := range cidrs {
+		if ip != nil && cidr.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// metadata returns the metadata of a request.
+func (e *enricher) metadata(request *http.Request) *RequestMetadata {
+	peer, _, err := net.SplitHostPort(request.RemoteAddr)
+	if err != nil {
+		peer = request.RemoteAddr
+	}
+	result := &RequestMetadata{
+		SourceIP:   peer,
+		Method:     request.Method,
+		Host:       request.Host,
+		Path:       request.URL.Path,
+		ReceivedAt: receivedAtFromContext(request.Context()),
+	}
+	if containsIP(e.trustedProxies, net.ParseIP(peer)) {
+		for _, value := range request.Header.Values("X-Forwarded-For") {
+			for _, address := range strings.Split(value, ",") {
+				if address = strings.TrimSpace(address); address != "" {
+					result.ForwardedFor = append(result.ForwardedFor, address)
+				}
+			}
+		}
+		// The client is the last address which is not a trusted proxy, the addresses before it can be forged
+		for i := len(result.ForwardedFor) - 1; i >= 0; i-- {
+			result.SourceIP = result.ForwardedFor[i]
+			if !containsIP(e.trustedProxies, net.ParseIP(result.SourceIP)) {
+				break
+			}
+		}
+		if e.clientIdentityHeader != "" {
+			result.ClientIdentity = request.Header.Get(e.clientIdentityHeader)
+		}
+	}
+	sourceIP := net.ParseIP(result.SourceIP)
+	for _, n := range e.networks {
+		if containsIP(n.cidrs, sourceIP) {
+			result.Networks = append(result.Networks, n.name)
+		}
+	}
+	if ua := request.UserAgent(); ua != "" {
+		result.UserAgent = parseUserAgent(ua)
+	}
+	if request.TLS != nil {
+		result.TLS = &TLSMetadata{
+			Version:     tls.VersionName(request.TLS.Version),
+			CipherSuite: tls.CipherSuiteName(request.TLS.CipherSuite),
+			ServerName:  request.TLS.ServerName,
+		}
+	}
+	return result
+}
+
+var (
+	userAgentProduct = regexp.MustCompile(`([A-Za-z][\w.\-]*)/([\w.\-]+)`)
+	userAgentComment = regexp.MustCompile(`\(([^)]*)\)`)
+	// userAgentBrowsers are the product tokens of the browsers, in the order they are looked for, since the
+	// browsers also advertise the products they are compatible with.
+	userAgentBrowsers = []struct{ token, name string }{
+		{"Edg", "Edge"},
+		{"OPR", "Opera"},
+		{"Firefox", "Firefox"},
+		{"Chrome", "Chrome"},
+		{"Safari", "Safari"},
+	}
+	// userAgentOSes are the operating systems found in the comments, in the order they are looked for.
+	userAgentOSes = []struct{ token, name string }{
+		{"Android", "Android"},
+		{"iPhone", "iOS"},
+		{"iPad", "iOS"},
+		{"Windows", "Windows"},
+		{"Mac OS X", "macOS"},
+		{"Linux", "Linux"},
+	}
+)
+
+// parseUserAgent parses a User-Agent header, the name and version are the ones of the browser or of the first
+// product of the header.
+func parseUserAgent(ua string) *UserAgent {
+	result := &UserAgent{Raw: ua}
+	products := map[string]string{}
+	matches := userAgentProduct.FindAllStringSubmatch(ua, -1)
+	for _, m := range matches {
+		if _, ok := products[m[1]]; !ok {
+			products[m[1]] = m[2]
+		}
+	}
+	if _, ok := products["Mozilla"]; ok {
+		for _, b := range userAgentBrowsers {
+			if version, ok := products[b.token]; ok {
+				result.Name, result.Version, result.Browser = b.name, version, true
+				if b.token == "Safari" && products["Version"] != "" {
+					result.Version = products["Version"]
+				}
+				break
+			}
+		}
+	}
+	if result.Name == "" && len(matches) > 0 {
+		result.Name, result.Version = matches[0][1], matches[0][2]
+	}
+	for _, comment := range userAgentComment.FindAllStringSubmatch(ua, -1) {
+		for _, os := range userAgentOSes {
+			if strings.Contains(comment[1], os.token) {
+				result.OS = os.name
+				return result
+			}
+		}
+	}
+	return result
+}
+
+type receivedAtKey struct{}
+
+// withReceivedAt returns a copy of the context carrying the time the request was received.
+func withReceivedAt(ctx context.Context, t time.Time) context.Context {
+	return context.WithValue(ctx, receivedAtKey{}, t)
+}
+
+func receivedAtFromContext(ctx context.Context) time.Time {
+	if t, ok := ctx.Value(receivedAtKey{}).(time.Time); ok {
+		return t
+	}
+	return time.Now()
+}
+
+// Enrich adds the metadata of the request to the event payload when the enrichment is enabled. The payload is
+// returned unchanged if it is not a JSON object.
+func (route *Route) Enrich(request *http.Request, data []byte) []byte {
+	if route.enricher == nil {
+		return data
+	}
+	payload := map[string]json.RawMessage{}
+	if err := json.Unmarshal(data, &payload); err != nil {
+		route.Logger.Warnw("the event payload is not a JSON object, it is not enriched", zap.Error(err))
+		return data
+	}
+	metadata, err := json.Marshal(route.enricher.metadata(request))
+	if err != nil {
+		route.Logger.Warnw("failed to marshal the request metadata, the event payload is not enriched", zap.Error(err))
+		return data
+	}
+	payload[route.enricher.key] = metadata
+	enriched, err := json.Marshal(payload)
+	if err != nil {
+		route.Logger.Warnw("failed to marshal the enriched event payload", zap.Error(err))
+		return data
+	}
+	return enriched
+}
@@ -0,0 +1,99 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/goconvey/convey"
+
+	"github.com/argoproj/argo-events/pkg/apis/eventsource/v1alpha1"
+)
+
+func TestValidateWebhookEnrichment(t *testing.T) {
+	convey.Convey("Given a webhook with request enrichment, validate it", t, func() {
+		hook := Hook.DeepCopy()
+		hook.Enrichment = &v1alpha1.WebhookEnrichment{
+			TrustedProxies: []string{"10.0.0.0/8"},
+			Networks:       []v1alpha1.WebhookNetwork{{Name: "github", CIDRs: []string{"192.30.252.0/22"}}},
+		}
+		convey.So(ValidateWebhookContext(hook), convey.ShouldBeNil)
+		hook.Enrichment.TrustedProxies = []string{"10.0.0.1"}
+		convey.So(ValidateWebhookContext(hook), convey.ShouldNotBeNil)
+		hook.Enrichment.TrustedProxies = nil
+		hook.Enrichment.Networks[0].Name = ""
+		convey.So(ValidateWebhookContext(hook), convey.ShouldNotBeNil)
+	})
+}
+
+func TestEnrich(t *testing.T) {
+	convey.Convey("Given a route with request enrichment, enrich the event payloads", t, func() {
+		route := GetFakeRoute()
+		enricher, err := newEnricher(&v1alpha1.WebhookEnrichment{
+			TrustedProxies:       []string{"10.0.0.0/8"},
+			ClientIdentityHeader: "X-Forwarded-Client-Cert",
+			Networks:             []v1alpha1.WebhookNetwork{{Name: "github", CIDRs: []string{"192.30.252.0/22"}}},
+		})
+		convey.So(err, convey.ShouldBeNil)
+		route.enricher = enricher
+		receivedAt := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+
+		request := httptest.NewRequest(http.MethodPost, "http://github.example.com/push", strings.NewReader("{}"))
+		request = request.WithContext(withReceivedAt(context.Background(), receivedAt))
+		request.RemoteAddr = "10.1.2.3:41000"
+		request.Header.Set("X-Forwarded-For", "203.0.113.7, 192.30.252.10, 10.4.0.1")
+		request.Header.Set("X-Forwarded-Client-Cert", "Subject=\"CN=github\"")
+		request.Header.Set("User-Agent", "GitHub-Hookshot/044aadd")
+
+		payload := map[string]json.RawMessage{}
+		convey.So(json.Unmarshal(route.Enrich(request, []byte(`{"body":{"ref":"main"}}`)), &payload), convey.ShouldBeNil)
+		convey.So(string(payload["body"]), convey.ShouldEqual, `{"ref":"main"}`)
+		metadata := RequestMetadata{}
+		convey.So(json.Unmarshal(payload["request"], &metadata), convey.ShouldBeNil)
+		convey.So(metadata.SourceIP, convey.ShouldEqual, "192.30.252.10")
+		convey.So(metadata.ForwardedFor, convey.ShouldResemble, []string{"203.0.113.7", "192.30.252.10", "10.4.0.1"})
+		convey.So(metadata.Networks, convey.ShouldResemble, []string{"github"})
+		convey.So(metadata.ClientIdentity, convey.ShouldEqual, "Subject=\"CN=github\"")
+		convey.So(metadata.Host, convey.ShouldEqual, "github.example.com")
+		convey.So(metadata.Path, convey.ShouldEqual, "/push")
+		convey.So(metadata.ReceivedAt.Equal(receivedAt), convey.ShouldBeTrue)
+		convey.So(*metadata.UserAgent, convey.ShouldResemble, UserAgent{Raw: "GitHub-Hookshot/044aadd", Name: "GitHub-Hookshot", Version: "044aadd"})
+
+		// The forwarded headers are ignored when the peer is not a trusted proxy
+		request.RemoteAddr = "198.51.100.1:41000"
+		metadata = *route.enricher.metadata(request)
+		convey.So(metadata.SourceIP, convey.ShouldEqual, "198.51.100.1")
+		convey.So(metadata.ForwardedFor, convey.ShouldBeEmpty)
+		convey.So(metadata.ClientIdentity, convey.ShouldBeEmpty)
+		convey.So(metadata.Networks, convey.ShouldBeEmpty)
+
+		// The payloads which are not JSON objects are not enriched
+		convey.So(string(route.Enrich(request, []byte(`[1]`))), convey.ShouldEqual, `[1]`)
+		route.enricher = nil
+		convey.So(string(route.Enrich(request, []byte(`{}`))), convey.ShouldEqual, `{}`)
+	})
+}
+
+func TestParseUserAgent(t *testing.T) {
+	convey.Convey("Given User-Agent headers, parse them", t, func() {
+		ua := parseUserAgent("Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/124.0.0.0 Safari/537.36 Edg/124.0.2478.80")
+		convey.So(ua.Name, convey.ShouldEqual, "Edge")
+		convey.So(ua.Version, convey.ShouldEqual, "124.0.2478.80")
+		convey.So(ua.OS, convey.ShouldEqual, "Windows")
+		convey.So(ua.Browser, convey.ShouldBeTrue)
+
+		ua = parseUserAgent("Mozilla/5.0 (iPhone; CPU iPhone OS 17_4 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/17.4 Mobile/15E148 Safari/604.1")
+		convey.So(ua.Name, convey.ShouldEqual, "Safari")
+		convey.So(ua.Version, convey.ShouldEqual, "17.4")
+		convey.So(ua.OS, convey.ShouldEqual, "iOS")
+
+		ua = parseUserAgent("curl/8.4.0")
+		convey.So(ua.Name, convey.ShouldEqual, "curl")
+		convey.So(ua.Version, convey.ShouldEqual, "8.4.0")
+		convey.So(ua.Browser, convey.ShouldBeFalse)
+	})
+}
@@ -0,0 +1,63 @@
+package webhook
+
+import (
+	"context"
+	"net/http"
+	"time"
+)
+
+type authFailureKey struct{}
+
+// AuthFailed records that the request failed the authentication of the event source, e.g. its signature or token
+// did not match, for the sources which do not respond with 401 or 403 to these requests.
+func AuthFailed(request *http.Request) {
+	if failed, ok := request.Context().Value(authFailureKey{}).(*bool); ok {
+		*failed = true
+	}
+}
+
+// statusRecorder records the status code of the response written by a handler.
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+func (r *statusRecorder) WriteHeader(status int) {
+	if r.status == 0 {
+		r.status = status
+	}
+	r.ResponseWriter.WriteHeader(status)
+}
+
+func (r *statusRecorder) Write(b []byte) (int, error) {
+	if r.status == 0 {
+		r.status = http.StatusOK
+	}
+	return r.ResponseWriter.Write(b)
+}
+
+func (r *statusRecorder) Unwrap() http.ResponseWriter {
+	return r.ResponseWriter
+}
+
+// instrument wraps the handler of a route to count its requests by status code and authentication failures, and
+// to observe their durations.
+func instrument(route *Route, handler http.HandlerFunc) http.HandlerFunc {
+	return func(writer http.ResponseWriter, request *http.Request) {
+		start := time.Now()
+		recorder := &statusRecorder{ResponseWriter: writer}
+		authFailed := false
+		request = request.WithContext(context.WithValue(request.Context(), authFailureKey{}, &authFailed))
+		defer func() {
+			status := recorder.status
+			if status == 0 {
+				status = http.StatusOK
+			}
+			route.Metrics.WebhookRequestHandled(route.EventSourceName, route.EventName, route.Context.Endpoint, status, time.Since(start).Seconds())
+			if authFailed || status == http.StatusUnauthorized || status == http.StatusForbidden {
+				route.Metrics.WebhookAuthFailed(route.EventSourceName, route.EventName, route.Context.Endpoint)
+			}
+		}()
+		handler(recorder, request)
+	}
+}
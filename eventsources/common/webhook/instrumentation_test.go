@@ -0,0 +1,59 @@
+package webhook
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeStatusRouter struct {
+	route *Route
+}
+
+func (f *fakeStatusRouter) GetRoute() *Route { return f.route }
+
+func (f *fakeStatusRouter) HandleRoute(writer http.ResponseWriter, request *http.Request) {
+	switch request.URL.Query().Get("reject") {
+	case "token":
+		writer.WriteHeader(http.StatusUnauthorized)
+	case "signature":
+		AuthFailed(request)
+		writer.WriteHeader(http.StatusBadRequest)
+	default:
+		_, _ = writer.Write([]byte("success"))
+	}
+}
+
+func (f *fakeStatusRouter) PostActivate() error { return nil }
+
+func (f *fakeStatusRouter) PostInactivate() error { return nil }
+
+func TestInstrumentRoute(t *testing.T) {
+	controller := NewController()
+	route := GetFakeRoute()
+	route.Context = route.Context.DeepCopy()
+	route.Context.Port = "0"
+	startServer(&fakeStatusRouter{route: route}, controller)
+	handler := controller.ActiveServerHandlers["0"]
+
+	for _, query := range []string{"", "", "?reject=token", "?reject=signature"} {
+		handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodPost, Hook.Endpoint+query, nil))
+	}
+
+	expected := `
+# HELP argo_events_webhook_auth_failures_total How many requests to a webhook endpoint of the EventSource have failed the authentication. https://argoproj.github.io/argo-events/metrics/#argo_events_webhook_auth_failures_total
+# TYPE argo_events_webhook_auth_failures_total counter
+argo_events_webhook_auth_failures_total{endpoint="/fake",event_name="fake-event",eventsource_name="fake-event-source",namespace="fake-ns"} 2
+# HELP argo_events_webhook_requests_total How many requests have been received by a webhook endpoint of the EventSource, labeled by the status code of the response. https://argoproj.github.io/argo-events/metrics/#argo_events_webhook_requests_total
+# TYPE argo_events_webhook_requests_total counter
+argo_events_webhook_requests_total{code="200",endpoint="/fake",event_name="fake-event",eventsource_name="fake-event-source",namespace="fake-ns"} 2
+argo_events_webhook_requests_total{code="400",endpoint="/fake",event_name="fake-event",eventsource_name="fake-event-source",namespace="fake-ns"} 1
+argo_events_webhook_requests_total{code="401",endpoint="/fake",event_name="fake-event",eventsource_name="fake-event-source",namespace="fake-ns"} 1
+`
+	assert.NoError(t, testutil.CollectAndCompare(route.Metrics, strings.NewReader(expected), "argo_events_webhook_requests_total", "argo_events_webhook_auth_failures_total"))
+	assert.Equal(t, 1, testutil.CollectAndCount(route.Metrics, "argo_events_webhook_request_duration_seconds"))
+}
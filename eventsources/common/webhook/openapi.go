@@ -0,0 +1,140 @@
+/*
+Copyright 2018 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package webhook
+
+import (
+	"encoding/json"
+	"net/http"
+	"sort"
+	"strings"
+
+	argoevents "github.com/argoproj/argo-events"
+	"github.com/argoproj/argo-events/common"
+)
+
+// openAPIPath is the path of the OpenAPI document describing the active endpoints of a server
+const openAPIPath = "/openapi.json"
+
+// bearerAuthScheme is the name of the security scheme of the endpoints with an auth secret
+const bearerAuthScheme = "bearerAuth"
+
+// registerRoute keeps the route in the routes of its port, so that it is described by the OpenAPI document of
+// the server. It must be called with the lock held.
+func (c *Controller) registerRoute(route *Route) {
+	if c.routes == nil {
+		c.routes = map[string]map[string]*Route{}
+	}
+	if c.routes[route.Context.Port] == nil {
+		c.routes[route.Context.Port] = map[string]*Route{}
+	}
+	c.routes[route.Context.Port][route.Context.Host+route.Context.Endpoint] = route
+}
+
+// openAPIHandler serves the OpenAPI document of the active endpoints of the server of the port.
+func (c *Controller) openAPIHandler(port string) http.HandlerFunc {
+	return func(writer http.ResponseWriter, request *http.Request) {
+		Lock.Lock()
+		routes := make([]*Route, 0, len(c.routes[port]))
+		for _, route := range c.routes[port] {
+			routes = append(routes, route)
+		}
+		Lock.Unlock()
+		b, err := json.Marshal(openAPIDocument(routes))
+		if err != nil {
+			common.SendInternalErrorResponse(writer, err.Error())
+			return
+		}
+		writer.Header().Set("Content-Type", "application/json")
+		common.SendSuccessResponse(writer, string(b))
+	}
+}
+
+// openAPIDocument returns the OpenAPI 3 document describing the active routes.
+func openAPIDocument(routes []*Route) map[string]interface{} {
+	sort.Slice(routes, func(i, j int) bool {
+		return routes[i].Context.Endpoint < routes[j].Context.Endpoint
+	})
+	paths := map[string]interface{}{}
+	secured := false
+	for _, route := range routes {
+		if !route.Active {
+			continue
+		}
+		method := strings.ToLower(route.Context.Method)
+		if method == "" {
+			method = "post"
+		}
+		operation := map[string]interface{}{
+			"operationId": route.EventSourceName + "." + route.EventName,
+			"summary":     "Event " + route.EventName + " of the EventSource " + route.EventSourceName,
+			"tags":        []string{route.EventSourceName},
+			"responses":   openAPIResponses(route),
+		}
+		schema := json.RawMessage("{}")
+		if route.Context.PayloadSchema != "" {
+			schema = json.RawMessage(route.Context.PayloadSchema)
+		}
+		if method == "get" {
+			operation["description"] = "The query parameters are the payload of the event."
+		} else {
+			operation["requestBody"] = map[string]interface{}{
+				"required": true,
+				"content": map[string]interface{}{
+					"application/json": map[string]interface{}{"schema": schema},
+				},
+			}
+		}
+		if route.Context.AuthSecret != nil {
+			operation["security"] = []map[string][]string{{bearerAuthScheme: {}}}
+			secured = true
+		}
+		pathItem := map[string]interface{}{method: operation}
+		if route.Context.URL != "" {
+			pathItem["servers"] = []map[string]string{{"url": route.Context.URL}}
+		}
+		paths[route.Context.Endpoint] = pathItem
+	}
+	document := map[string]interface{}{
+		"openapi": "3.0.3",
+		"info": map[string]string{
+			"title":   "Argo Events webhooks",
+			"version": argoevents.GetVersion().Version,
+		},
+		"paths": paths,
+	}
+	if secured {
+		document["components"] = map[string]interface{}{
+			"securitySchemes": map[string]interface{}{
+				bearerAuthScheme: map[string]string{"type": "http", "scheme": "bearer"},
+			},
+		}
+	}
+	return document
+}
+
+func openAPIResponses(route *Route) map[string]interface{} {
+	responses := map[string]interface{}{
+		"200": map[string]string{"description": "The event has been accepted."},
+		"400": map[string]string{"description": "The request is invalid."},
+	}
+	if route.Context.AuthSecret != nil {
+		responses["401"] = map[string]string{"description": "The bearer token is missing or invalid."}
+	}
+	if route.MaxEventSize > 0 {
+		responses["413"] = map[string]string{"description": "The payload exceeds the maximum event size."}
+	}
+	return responses
+}
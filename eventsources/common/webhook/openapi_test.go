@@ -0,0 +1,83 @@
+/*
+Copyright 2018 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package webhook
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/smartystreets/goconvey/convey"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/argoproj/argo-events/common/logging"
+	metrics "github.com/argoproj/argo-events/metrics"
+	"github.com/argoproj/argo-events/pkg/apis/eventsource/v1alpha1"
+)
+
+func TestOpenAPIDocument(t *testing.T) {
+	convey.Convey("Given the routes of a server", t, func() {
+		logger := logging.NewArgoEventsLogger()
+		m := metrics.NewMetrics("fake-ns")
+		orders := NewRoute(&v1alpha1.WebhookContext{
+			Endpoint:      "/orders",
+			Method:        http.MethodPost,
+			Port:          "12000",
+			URL:           "https://events.example.com",
+			AuthSecret:    &corev1.SecretKeySelector{Key: "token"},
+			PayloadSchema: `{"type": "object", "required": ["id"]}`,
+		}, logger, "shop", "orders", m)
+		orders.Active = true
+		orders.MaxEventSize = 1024
+		refunds := NewRoute(&v1alpha1.WebhookContext{Endpoint: "/refunds", Method: http.MethodGet, Port: "12000"}, logger, "shop", "refunds", m)
+		refunds.Active = true
+		inactive := NewRoute(&v1alpha1.WebhookContext{Endpoint: "/inactive", Method: http.MethodPost, Port: "12000"}, logger, "shop", "inactive", m)
+
+		controller := NewController()
+		for _, route := range []*Route{orders, refunds, inactive} {
+			controller.registerRoute(route)
+		}
+		recorder := httptest.NewRecorder()
+		controller.openAPIHandler("12000")(recorder, httptest.NewRequest(http.MethodGet, openAPIPath, nil))
+		convey.So(recorder.Code, convey.ShouldEqual, http.StatusOK)
+		var document map[string]interface{}
+		convey.So(json.Unmarshal(recorder.Body.Bytes(), &document), convey.ShouldBeNil)
+		paths := document["paths"].(map[string]interface{})
+
+		convey.Convey("Only the active routes are described", func() {
+			convey.So(paths, convey.ShouldContainKey, "/orders")
+			convey.So(paths, convey.ShouldContainKey, "/refunds")
+			convey.So(paths, convey.ShouldNotContainKey, "/inactive")
+		})
+
+		convey.Convey("Describe the method, the auth and the payload schema", func() {
+			operation := paths["/orders"].(map[string]interface{})["post"].(map[string]interface{})
+			convey.So(operation["operationId"], convey.ShouldEqual, "shop.orders")
+			convey.So(operation["security"], convey.ShouldResemble, []interface{}{map[string]interface{}{bearerAuthScheme: []interface{}{}}})
+			schema := operation["requestBody"].(map[string]interface{})["content"].(map[string]interface{})["application/json"].(map[string]interface{})["schema"]
+			convey.So(schema, convey.ShouldResemble, map[string]interface{}{"type": "object", "required": []interface{}{"id"}})
+			convey.So(operation["responses"], convey.ShouldContainKey, "401")
+			convey.So(operation["responses"], convey.ShouldContainKey, "413")
+			convey.So(paths["/orders"].(map[string]interface{})["servers"], convey.ShouldResemble, []interface{}{map[string]interface{}{"url": "https://events.example.com"}})
+			convey.So(document["components"], convey.ShouldNotBeNil)
+
+			get := paths["/refunds"].(map[string]interface{})["get"].(map[string]interface{})
+			convey.So(get, convey.ShouldNotContainKey, "requestBody")
+			convey.So(get, convey.ShouldNotContainKey, "security")
+		})
+	})
+}
@@ -0,0 +1,126 @@
+package webhook
+
+import (
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/tidwall/gjson"
+
+	eventsourcecommon "github.com/argoproj/argo-events/eventsources/common"
+	"github.com/argoproj/argo-events/pkg/apis/eventsource/v1alpha1"
+)
+
+// The CloudEvent extensions of the events of the routes detecting the redeliveries
+const (
+	redeliveredExtension     = "redelivered"
+	deliveryIDExtension      = "deliveryid"
+	deliveryAttemptExtension = "deliveryattempt"
+)
+
+var (
+	// defaultDeliveryIDHeaders are the headers holding the delivery ids of the providers, kept across their retries
+	defaultDeliveryIDHeaders = []string{"X-GitHub-Delivery", "X-Gitlab-Event-UUID", "Idempotency-Key"}
+	// defaultAttemptHeader is the header holding the number of retries of the deliveries
+	defaultAttemptHeader = "X-Slack-Retry-Num"
+)
+
+// Payload is an event payload received on a route, with the delivery attributes of its request.
+type Payload struct {
+	Data []byte
+	// DeliveryID is the id of the delivery given by the provider, kept across its retries, if any
+	DeliveryID string
+	// Attempt is the attempt number of the delivery given by the provider, 0 if it is unknown
+	Attempt int
+}
+
+// redeliveries detects the redeliveries of a route, remembering the ids of the dispatched deliveries within the
+// window.
+type redeliveries struct {
+	config *v1alpha1.WebhookRedelivery
+	window time.Duration
+	mu     sync.Mutex
+	seen   map[string]time.Time
+}
+
+func newRedeliveries(config *v1alpha1.WebhookRedelivery) *redeliveries {
+	return &redeliveries{config: config, window: config.GetWindow(), seen: map[string]time.Time{}}
+}
+
+// payload returns the payload of a request, with its delivery attributes.
+func (r *redeliveries) payload(request *http.Request, data []byte) *Payload {
+	result := &Payload{Data: data}
+	switch {
+	case r.config.DeliveryIDPath != "":
+		result.DeliveryID = gjson.GetBytes(data, r.config.DeliveryIDPath).String()
+	case r.config.DeliveryIDHeader != "":
+		result.DeliveryID = request.Header.Get(r.config.DeliveryIDHeader)
+	default:
+		for _, header := range defaultDeliveryIDHeaders {
+			if id := request.Header.Get(header); id != "" {
+				result.DeliveryID = id
+				break
+			}
+		}
+	}
+	attemptHeader := r.config.AttemptHeader
+	if attemptHeader == "" {
+		attemptHeader = defaultAttemptHeader
+	}
+	if retries, err := strconv.Atoi(request.Header.Get(attemptHeader)); err == nil && retries >= 0 {
+		result.Attempt = retries + 1
+	}
+	return result
+}
+
+// dispatched returns whether a delivery with the id was dispatched within the window.
+func (r *redeliveries) dispatched(id string, now time.Time) bool {
+	if id == "" {
+		return false
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	t, ok := r.seen[id]
+	return ok && now.Sub(t) < r.window
+}
+
+// markDispatched remembers the id of a dispatched delivery, and forgets the ones older than the window.
+func (r *redeliveries) markDispatched(id string, now time.Time) {
+	if id == "" {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for k, t := range r.seen {
+		if now.Sub(t) >= r.window {
+			delete(r.seen, k)
+		}
+	}
+	if _, ok := r.seen[id]; !ok {
+		r.seen[id] = now
+	}
+}
+
+// options returns the options adding the redelivery extensions to the event of a payload.
+func (r *redeliveries) options(p *Payload, redelivered bool) []eventsourcecommon.Option {
+	extensions := map[string]string{redeliveredExtension: strconv.FormatBool(redelivered)}
+	if p.DeliveryID != "" {
+		extensions[deliveryIDExtension] = p.DeliveryID
+	}
+	if p.Attempt > 0 {
+		extensions[deliveryAttemptExtension] = strconv.Itoa(p.Attempt)
+	}
+	return []eventsourcecommon.Option{eventsourcecommon.WithExtensions(extensions)}
+}
+
+// Deliver sends the event payload of a request to the data channel of the route, enriched with the metadata of the
+// request if the enrichment is enabled.
+func (route *Route) Deliver(request *http.Request, data []byte) {
+	data = route.Enrich(request, data)
+	if route.redeliveries == nil {
+		route.DataCh <- &Payload{Data: data}
+		return
+	}
+	route.DataCh <- route.redeliveries.payload(request, data)
+}
@@ -0,0 +1,86 @@
+package webhook
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cloudevents/sdk-go/v2/event"
+	"github.com/stretchr/testify/assert"
+
+	eventsourcecommon "github.com/argoproj/argo-events/eventsources/common"
+	"github.com/argoproj/argo-events/pkg/apis/eventsource/v1alpha1"
+)
+
+func TestValidateWebhookRedelivery(t *testing.T) {
+	hook := Hook.DeepCopy()
+	hook.Redelivery = &v1alpha1.WebhookRedelivery{Window: "30m", Suppress: true}
+	assert.NoError(t, ValidateWebhookContext(hook))
+	hook.Redelivery.Window = "soon"
+	assert.ErrorContains(t, ValidateWebhookContext(hook), "failed to parse redelivery window")
+	hook.Redelivery.Window = "-1m"
+	assert.EqualError(t, ValidateWebhookContext(hook), "redelivery window must be positive")
+}
+
+func TestRedeliveryPayload(t *testing.T) {
+	r := newRedeliveries(&v1alpha1.WebhookRedelivery{})
+	request := httptest.NewRequest(http.MethodPost, "/github", strings.NewReader("{}"))
+	request.Header.Set("X-GitHub-Delivery", "72d3162e-cc78-11e3-81ab-4c9367dc0958")
+	p := r.payload(request, []byte(`{}`))
+	assert.Equal(t, "72d3162e-cc78-11e3-81ab-4c9367dc0958", p.DeliveryID)
+	assert.Equal(t, 0, p.Attempt)
+
+	request = httptest.NewRequest(http.MethodPost, "/slack", strings.NewReader("{}"))
+	request.Header.Set("X-Slack-Retry-Num", "2")
+	assert.Equal(t, 3, r.payload(request, []byte(`{}`)).Attempt)
+
+	r = newRedeliveries(&v1alpha1.WebhookRedelivery{DeliveryIDPath: "event.id"})
+	assert.Equal(t, "evt_1", r.payload(request, []byte(`{"event":{"id":"evt_1"}}`)).DeliveryID)
+}
+
+func TestRedeliveryWindow(t *testing.T) {
+	r := newRedeliveries(&v1alpha1.WebhookRedelivery{Window: "1h"})
+	now := time.Now()
+	assert.False(t, r.dispatched("1", now))
+	r.markDispatched("1", now)
+	r.markDispatched("", now)
+	assert.True(t, r.dispatched("1", now.Add(30*time.Minute)))
+	assert.False(t, r.dispatched("1", now.Add(time.Hour)))
+	assert.False(t, r.dispatched("", now))
+	r.markDispatched("2", now.Add(2*time.Hour))
+	assert.NotContains(t, r.seen, "1")
+}
+
+func TestManageRouteChannelsRedelivery(t *testing.T) {
+	route := GetFakeRoute()
+	route.Context = Hook.DeepCopy()
+	route.Context.Redelivery = &v1alpha1.WebhookRedelivery{Suppress: true}
+	route.redeliveries = newRedeliveries(route.Context.Redelivery)
+	events := make(chan event.Event, 10)
+	dispatch := func(data []byte, opts ...eventsourcecommon.Option) error {
+		e := event.New()
+		for _, opt := range opts {
+			assert.NoError(t, opt(&e))
+		}
+		events <- e
+		return nil
+	}
+	go manageRouteChannels(&FakeRouter{route: route}, dispatch)
+	defer func() { route.StopChan <- struct{}{} }()
+
+	route.DataCh <- &Payload{Data: []byte(`{}`), DeliveryID: "1"}
+	e := <-events
+	assert.Equal(t, "false", e.Extensions()[redeliveredExtension])
+	assert.Equal(t, "1", e.Extensions()[deliveryIDExtension])
+
+	// The redelivery of a dispatched delivery is suppressed, the retry of one which was not dispatched is not
+	route.DataCh <- &Payload{Data: []byte(`{}`), DeliveryID: "1"}
+	route.DataCh <- &Payload{Data: []byte(`{}`), DeliveryID: "2", Attempt: 2}
+	e = <-events
+	assert.Equal(t, "2", e.Extensions()[deliveryIDExtension])
+	assert.Equal(t, "true", e.Extensions()[redeliveredExtension])
+	assert.Equal(t, "2", e.Extensions()[deliveryAttemptExtension])
+	assert.Empty(t, events)
+}
@@ -0,0 +1,186 @@
+/*
+Copyright 2018 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/gorilla/mux"
+	"go.uber.org/zap"
+
+	"github.com/argoproj/argo-events/common"
+	eventsourcecommon "github.com/argoproj/argo-events/eventsources/common"
+	"github.com/argoproj/argo-events/pkg/apis/eventsource/v1alpha1"
+)
+
+// replayPath is the path of the replay endpoints, relative to the endpoint of the route
+const replayPath = "/_replay"
+
+// Delivery is a delivery kept by the replay store of a route
+type Delivery struct {
+	// ID is the identifier of the delivery, unique within the route
+	ID string `json:"id"`
+	// Time is the time the delivery was received
+	Time time.Time `json:"time"`
+	// Data is the payload dispatched for the delivery
+	Data []byte `json:"data"`
+}
+
+// replayStore keeps the last deliveries of a route in a ring buffer, persisted in a file if a directory is set.
+type replayStore struct {
+	mu         sync.Mutex
+	size       int
+	file       string
+	lastID     uint64
+	deliveries []Delivery
+	// dispatch republishes a delivery to the EventBus
+	dispatch func([]byte, ...eventsourcecommon.Option) error
+}
+
+// newReplayStore returns the replay store of a route, with the deliveries persisted previously if any.
+func newReplayStore(replay *v1alpha1.WebhookReplay, eventSourceName, eventName string) (*replayStore, error) {
+	s := &replayStore{size: replay.GetMaxDeliveries()}
+	if replay.Dir == "" {
+		return s, nil
+	}
+	s.file = filepath.Join(replay.Dir, fmt.Sprintf("%s-%s.json", eventSourceName, eventName))
+	b, err := os.ReadFile(s.file)
+	if errors.Is(err, os.ErrNotExist) {
+		return s, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the deliveries from %s, %w", s.file, err)
+	}
+	if err := json.Unmarshal(b, &s.deliveries); err != nil {
+		return nil, fmt.Errorf("failed to parse the deliveries in %s, %w", s.file, err)
+	}
+	if len(s.deliveries) > s.size {
+		s.deliveries = s.deliveries[len(s.deliveries)-s.size:]
+	}
+	if n := len(s.deliveries); n > 0 {
+		s.lastID, _ = strconv.ParseUint(s.deliveries[n-1].ID, 10, 64)
+	}
+	return s, nil
+}
+
+// add keeps a delivery, dropping the oldest one if the store is full.
+func (s *replayStore) add(data []byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.lastID++
+	s.deliveries = append(s.deliveries, Delivery{ID: strconv.FormatUint(s.lastID, 10), Time: time.Now().UTC(), Data: data})
+	if len(s.deliveries) > s.size {
+		s.deliveries = append(s.deliveries[:0:0], s.deliveries[len(s.deliveries)-s.size:]...)
+	}
+	return s.persist()
+}
+
+// persist writes the deliveries to the file of the store, if any. It must be called with the lock held.
+func (s *replayStore) persist() error {
+	if s.file == "" {
+		return nil
+	}
+	b, err := json.Marshal(s.deliveries)
+	if err != nil {
+		return err
+	}
+	tmp := s.file + ".tmp"
+	if err := os.WriteFile(tmp, b, 0o600); err != nil {
+		return fmt.Errorf("failed to persist the deliveries, %w", err)
+	}
+	return os.Rename(tmp, s.file)
+}
+
+// list returns the kept deliveries, the oldest first.
+func (s *replayStore) list() []Delivery {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]Delivery(nil), s.deliveries...)
+}
+
+// get returns a kept delivery.
+func (s *replayStore) get(id string) (Delivery, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, d := range s.deliveries {
+		if d.ID == id {
+			return d, true
+		}
+	}
+	return Delivery{}, false
+}
+
+// registerReplayRoutes registers the endpoints listing and republishing the deliveries of the route.
+func registerReplayRoutes(handler *mux.Router, route *Route) {
+	name := route.Context.Port + route.Context.Host + route.Context.Endpoint + replayPath
+	if handler.GetRoute(name) != nil {
+		return
+	}
+	newRoute := func(name string) *mux.Route {
+		r := handler.NewRoute().Name(name)
+		if route.Context.Host != "" {
+			r = r.Host(route.Context.Host)
+		}
+		return r
+	}
+	newRoute(name).Path(route.Context.Endpoint + replayPath).Methods(http.MethodGet).HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		if !authenticate(writer, request, route.Context.Replay.AuthSecret, nil, route.Logger) {
+			return
+		}
+		if route.replays == nil {
+			common.SendResponse(writer, http.StatusServiceUnavailable, "route is not active")
+			return
+		}
+		b, err := json.Marshal(route.replays.list())
+		if err != nil {
+			common.SendInternalErrorResponse(writer, err.Error())
+			return
+		}
+		writer.Header().Set("Content-Type", "application/json")
+		common.SendSuccessResponse(writer, string(b))
+	})
+	newRoute(name + "/id").Path(route.Context.Endpoint + replayPath + "/{id}").Methods(http.MethodPost).HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		if !authenticate(writer, request, route.Context.Replay.AuthSecret, nil, route.Logger) {
+			return
+		}
+		if route.replays == nil || !route.Active {
+			common.SendResponse(writer, http.StatusServiceUnavailable, "route is not active")
+			return
+		}
+		id := mux.Vars(request)["id"]
+		delivery, ok := route.replays.get(id)
+		if !ok {
+			common.SendResponse(writer, http.StatusNotFound, fmt.Sprintf("delivery %s not found", id))
+			return
+		}
+		route.Logger.Infow("republishing a delivery...", "deliveryId", id)
+		if err := route.replays.dispatch(delivery.Data); err != nil {
+			route.Logger.Errorw("failed to republish the delivery", "deliveryId", id, zap.Error(err))
+			common.SendInternalErrorResponse(writer, "failed to republish the delivery")
+			return
+		}
+		common.SendSuccessResponse(writer, fmt.Sprintf("delivery %s republished", id))
+	})
+}
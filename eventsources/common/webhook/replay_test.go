@@ -0,0 +1,67 @@
+/*
+Copyright 2018 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"testing"
+
+	"github.com/smartystreets/goconvey/convey"
+
+	"github.com/argoproj/argo-events/pkg/apis/eventsource/v1alpha1"
+)
+
+func TestReplayStore(t *testing.T) {
+	convey.Convey("Given a replay store of 2 deliveries", t, func() {
+		replay := &v1alpha1.WebhookReplay{MaxDeliveries: 2, Dir: t.TempDir()}
+		store, err := newReplayStore(replay, "fake-event-source", "fake-event")
+		convey.So(err, convey.ShouldBeNil)
+
+		convey.Convey("Keep the last deliveries", func() {
+			for _, data := range []string{"a", "b", "c"} {
+				convey.So(store.add([]byte(data)), convey.ShouldBeNil)
+			}
+			deliveries := store.list()
+			convey.So(deliveries, convey.ShouldHaveLength, 2)
+			convey.So(deliveries[0].ID, convey.ShouldEqual, "2")
+			convey.So(string(deliveries[1].Data), convey.ShouldEqual, "c")
+
+			_, ok := store.get("1")
+			convey.So(ok, convey.ShouldBeFalse)
+			delivery, ok := store.get("2")
+			convey.So(ok, convey.ShouldBeTrue)
+			convey.So(string(delivery.Data), convey.ShouldEqual, "b")
+		})
+
+		convey.Convey("Load the persisted deliveries", func() {
+			convey.So(store.add([]byte("a")), convey.ShouldBeNil)
+			convey.So(store.add([]byte("b")), convey.ShouldBeNil)
+
+			reloaded, err := newReplayStore(replay, "fake-event-source", "fake-event")
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(reloaded.list(), convey.ShouldHaveLength, 2)
+			convey.So(reloaded.add([]byte("c")), convey.ShouldBeNil)
+			deliveries := reloaded.list()
+			convey.So(deliveries[1].ID, convey.ShouldEqual, "3")
+		})
+	})
+
+	convey.Convey("Given a webhook with replays, validate it", t, func() {
+		hook := Hook.DeepCopy()
+		hook.Replay = &v1alpha1.WebhookReplay{}
+		convey.So(ValidateWebhookContext(hook), convey.ShouldNotBeNil)
+	})
+}
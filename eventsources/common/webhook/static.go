@@ -0,0 +1,97 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// AllEndpoints is the key of the static response applying to all the endpoints of an EventSource.
+const AllEndpoints = "*"
+
+// StaticResponse is a response returned by an endpoint instead of processing the requests, e.g. a 503 with a
+// Retry-After header during a planned downtime, or the answer to the verification challenge of a producer.
+type StaticResponse struct {
+	// StatusCode is the status code of the response.
+	StatusCode int `json:"statusCode"`
+	// Body is the body of the response.
+	Body string `json:"body,omitempty"`
+	// ContentType is the content type of the body. Defaults to "text/plain".
+	ContentType string `json:"contentType,omitempty"`
+	// RetryAfter is how long the producers should wait before retrying, e.g. "10m", sent as the Retry-After header.
+	RetryAfter string `json:"retryAfter,omitempty"`
+	// Methods are the methods of the requests the response applies to, e.g. GET for a challenge. Defaults to all.
+	Methods []string `json:"methods,omitempty"`
+}
+
+// ParseStaticResponses parses the static responses of an EventSource, a JSON object keyed by event name, or
+// AllEndpoints for all the endpoints.
+func ParseStaticResponses(value string) (map[string]StaticResponse, error) {
+	responses := map[string]StaticResponse{}
+	if value == "" {
+		return responses, nil
+	}
+	if err := json.Unmarshal([]byte(value), &responses); err != nil {
+		return nil, fmt.Errorf("failed to parse the static responses, %w", err)
+	}
+	for eventName, response := range responses {
+		if response.StatusCode < 100 || response.StatusCode > 599 {
+			return nil, fmt.Errorf("static response of %q has an invalid status code %d", eventName, response.StatusCode)
+		}
+		if response.RetryAfter != "" {
+			if d, err := time.ParseDuration(response.RetryAfter); err != nil || d < 0 {
+				return nil, fmt.Errorf("static response of %q has an invalid retryAfter %q", eventName, response.RetryAfter)
+			}
+		}
+	}
+	return responses, nil
+}
+
+type staticResponsesKey struct{}
+
+// WithStaticResponses returns a copy of the context carrying the static responses of the endpoints.
+func WithStaticResponses(ctx context.Context, responses map[string]StaticResponse) context.Context {
+	return context.WithValue(ctx, staticResponsesKey{}, responses)
+}
+
+// staticResponseFromContext returns the static response of the endpoint of an event, nil if there is none.
+func staticResponseFromContext(ctx context.Context, eventName string) *StaticResponse {
+	responses, _ := ctx.Value(staticResponsesKey{}).(map[string]StaticResponse)
+	if response, ok := responses[eventName]; ok {
+		return &response
+	}
+	if response, ok := responses[AllEndpoints]; ok {
+		return &response
+	}
+	return nil
+}
+
+// matches returns whether the response applies to the request.
+func (r *StaticResponse) matches(request *http.Request) bool {
+	if len(r.Methods) == 0 {
+		return true
+	}
+	for _, method := range r.Methods {
+		if strings.EqualFold(method, request.Method) {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *StaticResponse) write(writer http.ResponseWriter) {
+	contentType := r.ContentType
+	if contentType == "" {
+		contentType = "text/plain"
+	}
+	writer.Header().Set("Content-Type", contentType)
+	if d, err := time.ParseDuration(r.RetryAfter); err == nil {
+		writer.Header().Set("Retry-After", strconv.Itoa(int(d.Seconds())))
+	}
+	writer.WriteHeader(r.StatusCode)
+	_, _ = writer.Write([]byte(r.Body))
+}
@@ -0,0 +1,60 @@
+package webhook
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/smartystreets/goconvey/convey"
+)
+
+func TestParseStaticResponses(t *testing.T) {
+	convey.Convey("Given the static responses of an EventSource, parse them", t, func() {
+		responses, err := ParseStaticResponses(`{"fake-event": {"statusCode": 503, "retryAfter": "10m"}, "*": {"statusCode": 200, "methods": ["GET"]}}`)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(responses, convey.ShouldHaveLength, 2)
+
+		_, err = ParseStaticResponses(`{"fake-event": {"statusCode": 99}}`)
+		convey.So(err, convey.ShouldNotBeNil)
+		_, err = ParseStaticResponses(`{"fake-event": {"statusCode": 503, "retryAfter": "soon"}}`)
+		convey.So(err, convey.ShouldNotBeNil)
+		_, err = ParseStaticResponses(`503`)
+		convey.So(err, convey.ShouldNotBeNil)
+	})
+
+	convey.Convey("Given the static responses in the context, get the one of an endpoint", t, func() {
+		ctx := WithStaticResponses(context.Background(), map[string]StaticResponse{
+			"fake-event": {StatusCode: 503},
+			AllEndpoints: {StatusCode: 200},
+		})
+		convey.So(staticResponseFromContext(ctx, "fake-event").StatusCode, convey.ShouldEqual, 503)
+		convey.So(staticResponseFromContext(ctx, "other-event").StatusCode, convey.ShouldEqual, 200)
+		convey.So(staticResponseFromContext(context.Background(), "fake-event"), convey.ShouldBeNil)
+	})
+}
+
+func TestStartServerStaticResponse(t *testing.T) {
+	convey.Convey("Given a route returning a static response to the GET requests", t, func() {
+		controller := NewController()
+		var handled []string
+		route := GetFakeRoute()
+		route.Context = route.Context.DeepCopy()
+		route.Context.Port = "0"
+		route.StaticResponse = &StaticResponse{StatusCode: http.StatusServiceUnavailable, Body: "maintenance", RetryAfter: "2m", Methods: []string{"get"}}
+		startServer(&fakeHostRouter{route: route, handled: &handled}, controller)
+		handler := controller.ActiveServerHandlers["0"]
+
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, Hook.Endpoint, nil))
+		convey.So(recorder.Code, convey.ShouldEqual, http.StatusServiceUnavailable)
+		convey.So(recorder.Header().Get("Retry-After"), convey.ShouldEqual, "120")
+		convey.So(recorder.Body.String(), convey.ShouldEqual, "maintenance")
+		convey.So(handled, convey.ShouldBeEmpty)
+
+		// The other requests are processed
+		handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodPost, Hook.Endpoint, strings.NewReader("{}")))
+		convey.So(handled, convey.ShouldHaveLength, 1)
+	})
+}
@@ -60,11 +60,21 @@ type Route struct {
 	// or it is an inactive route
 	Active bool
 	// data channel to receive data on this endpoint
-	DataCh chan []byte
+	DataCh chan *Payload
 	// Stop channel to signal the end of the event source.
 	StopChan chan struct{}
 
 	Metrics *metrics.Metrics
+	// MaxEventSize is the maximum size of the request bodies, larger requests are rejected with 413.
+	MaxEventSize int64
+	// StaticResponse is returned instead of processing the requests, if any
+	StaticResponse *StaticResponse
+	// replays keeps the last deliveries of the route, if replays are enabled
+	replays *replayStore
+	// enricher adds the request metadata to the event payloads, if the enrichment is enabled
+	enricher *enricher
+	// redeliveries detects the deliveries retried by the provider, if the redelivery detection is enabled
+	redeliveries *redeliveries
 }
 
 // Controller controls the active servers and endpoints
@@ -77,4 +87,6 @@ type Controller struct {
 	RouteActivateChan chan Router
 	// RouteDeactivateChan handles inactivation of routes
 	RouteDeactivateChan chan Router
+	// routes keeps the routes registered with each server, by port and endpoint
+	routes map[string]map[string]*Route
 }
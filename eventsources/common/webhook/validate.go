@@ -17,8 +17,13 @@ limitations under the License.
 package webhook
 
 import (
+	"encoding/json"
 	"fmt"
 	"strconv"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/validation"
 
 	"github.com/argoproj/argo-events/pkg/apis/eventsource/v1alpha1"
 )
@@ -40,6 +45,64 @@ func ValidateWebhookContext(context *v1alpha1.WebhookContext) error {
 			return fmt.Errorf("failed to parse server port %s. err: %+v", context.Port, err)
 		}
 	}
+	if context.Host != "" {
+		if errs := validation.IsDNS1123Subdomain(context.Host); len(errs) > 0 {
+			return fmt.Errorf("invalid host %s, %s", context.Host, strings.Join(errs, ", "))
+		}
+	}
+	if context.ServiceGroup != "" {
+		if errs := validation.IsDNS1123Label(context.ServiceGroup); len(errs) > 0 {
+			return fmt.Errorf("invalid serviceGroup %s, %s", context.ServiceGroup, strings.Join(errs, ", "))
+		}
+	}
+	if context.GenerateToken && context.AuthSecret != nil {
+		return fmt.Errorf("authSecret and generateToken can't be used together")
+	}
+	if context.TokenRotationPeriod != "" {
+		if !context.GenerateToken {
+			return fmt.Errorf("tokenRotationPeriod requires generateToken")
+		}
+		period, err := time.ParseDuration(context.TokenRotationPeriod)
+		if err != nil {
+			return fmt.Errorf("failed to parse tokenRotationPeriod %s, %w", context.TokenRotationPeriod, err)
+		}
+		if period < time.Hour {
+			return fmt.Errorf("tokenRotationPeriod can't be shorter than 1h")
+		}
+	}
+	if context.PayloadSchema != "" {
+		var schema map[string]interface{}
+		if err := json.Unmarshal([]byte(context.PayloadSchema), &schema); err != nil {
+			return fmt.Errorf("payloadSchema must be a JSON object, %w", err)
+		}
+	}
+	if context.Replay != nil {
+		if context.Replay.AuthSecret == nil {
+			return fmt.Errorf("replay authSecret can't be empty")
+		}
+		if context.Replay.MaxDeliveries < 0 {
+			return fmt.Errorf("replay maxDeliveries can't be negative")
+		}
+	}
+	if context.Enrichment != nil {
+		for _, n := range context.Enrichment.Networks {
+			if n.Name == "" {
+				return fmt.Errorf("enrichment network name can't be empty")
+			}
+		}
+		if _, err := newEnricher(context.Enrichment); err != nil {
+			return fmt.Errorf("invalid enrichment, %w", err)
+		}
+	}
+	if context.Redelivery != nil && context.Redelivery.Window != "" {
+		window, err := time.ParseDuration(context.Redelivery.Window)
+		if err != nil {
+			return fmt.Errorf("failed to parse redelivery window %s, %w", context.Redelivery.Window, err)
+		}
+		if window <= 0 {
+			return fmt.Errorf("redelivery window must be positive")
+		}
+	}
 	return nil
 }
 
@@ -17,13 +17,17 @@ limitations under the License.
 package webhook
 
 import (
+	"bytes"
 	"context"
 	"fmt"
+	"io"
 	"net/http"
 	"strings"
+	"time"
 
 	"github.com/gorilla/mux"
 	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
 
 	"github.com/argoproj/argo-events/common"
 	"github.com/argoproj/argo-events/common/logging"
@@ -50,7 +54,7 @@ func NewRoute(hookContext *v1alpha1.WebhookContext, logger *zap.SugaredLogger, e
 		EventSourceName: eventSourceName,
 		EventName:       eventName,
 		Active:          false,
-		DataCh:          make(chan []byte),
+		DataCh:          make(chan *Payload),
 		StartCh:         make(chan struct{}),
 		StopChan:        make(chan struct{}),
 		Metrics:         metrics,
@@ -116,37 +120,40 @@ func startServer(router Router, controller *Controller) {
 
 	handler := controller.ActiveServerHandlers[route.Context.Port]
 
-	routeName := route.Context.Port + route.Context.Endpoint
+	routeName := route.Context.Port + route.Context.Host + route.Context.Endpoint
 	r := handler.GetRoute(routeName)
 	if r == nil {
 		r = handler.NewRoute().Name(routeName)
 		r = r.Path(route.Context.Endpoint)
-		r.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
-			if route.Context.AuthSecret != nil {
-				token, err := common.GetSecretFromVolume(route.Context.AuthSecret)
-				if err != nil {
-					route.Logger.Errorw("failed to get auth secret from volume", "error", err)
-					common.SendInternalErrorResponse(writer, "Error loading auth token")
-					return
-				}
-				authHeader := request.Header.Get("Authorization")
-				if !strings.HasPrefix(authHeader, "Bearer ") {
-					route.Logger.Error("invalid auth header")
-					common.SendResponse(writer, http.StatusUnauthorized, "Invalid Authorization Header")
-					return
-				}
-				if strings.TrimPrefix(authHeader, "Bearer ") != token {
-					route.Logger.Error("invalid auth token")
-					common.SendResponse(writer, http.StatusUnauthorized, "Invalid Auth token")
-					return
-				}
+		if route.Context.Host != "" {
+			r = r.Host(route.Context.Host)
+		}
+		r.HandlerFunc(instrument(route, func(writer http.ResponseWriter, request *http.Request) {
+			if route.enricher != nil {
+				request = request.WithContext(withReceivedAt(request.Context(), time.Now()))
+			}
+			if route.StaticResponse != nil && route.StaticResponse.matches(request) {
+				route.StaticResponse.write(writer)
+				return
+			}
+			if route.Context.AuthSecret != nil && !authenticate(writer, request, route.Context.AuthSecret, route.Context.PreviousAuthSecret(), route.Logger) {
+				return
 			}
 			if request.Header.Get("Authorization") != "" {
 				// Auth secret stops here
 				request.Header.Set("Authorization", "*** Masked Auth Secret ***")
 			}
+			if route.MaxEventSize > 0 && !limitRequestBody(writer, request, route) {
+				return
+			}
 			router.HandleRoute(writer, request)
-		})
+		}))
+	}
+
+	controller.registerRoute(route)
+
+	if route.Context.Replay != nil {
+		registerReplayRoutes(handler, route)
 	}
 
 	healthCheckRouteName := route.Context.Port + "/health"
@@ -159,9 +166,64 @@ func startServer(router Router, controller *Controller) {
 		})
 	}
 
+	openAPIRouteName := route.Context.Port + openAPIPath
+	if handler.GetRoute(openAPIRouteName) == nil {
+		handler.NewRoute().Name(openAPIRouteName).Path(openAPIPath).Methods(http.MethodGet).HandlerFunc(controller.openAPIHandler(route.Context.Port))
+	}
+
 	Lock.Unlock()
 }
 
+// authenticate checks the bearer token of the request against the token in the secret.
+func authenticate(writer http.ResponseWriter, request *http.Request, secret, previous *corev1.SecretKeySelector, logger *zap.SugaredLogger) bool {
+	token, err := common.GetSecretFromVolume(secret)
+	if err != nil {
+		logger.Errorw("failed to get auth secret from volume", "error", err)
+		common.SendInternalErrorResponse(writer, "Error loading auth token")
+		return false
+	}
+	authHeader := request.Header.Get("Authorization")
+	if !strings.HasPrefix(authHeader, "Bearer ") {
+		logger.Error("invalid auth header")
+		common.SendResponse(writer, http.StatusUnauthorized, "Invalid Authorization Header")
+		return false
+	}
+	if given := strings.TrimPrefix(authHeader, "Bearer "); given != token && !isPreviousToken(given, previous) {
+		logger.Error("invalid auth token")
+		common.SendResponse(writer, http.StatusUnauthorized, "Invalid Auth token")
+		return false
+	}
+	return true
+}
+
+// isPreviousToken returns whether the token is the previous generated token of the route, which remains
+// valid until the next rotation.
+func isPreviousToken(token string, previous *corev1.SecretKeySelector) bool {
+	if previous == nil {
+		return false
+	}
+	previousToken, err := common.GetSecretFromVolume(previous)
+	return err == nil && previousToken != "" && token == previousToken
+}
+
+// limitRequestBody rejects the request with 413 if the body is larger than the maximum event size of the route.
+func limitRequestBody(writer http.ResponseWriter, request *http.Request, route *Route) bool {
+	body, err := io.ReadAll(io.LimitReader(request.Body, route.MaxEventSize+1))
+	if err != nil {
+		route.Logger.Errorw("failed to read request body", zap.Error(err))
+		common.SendErrorResponse(writer, "failed to read request body")
+		return false
+	}
+	if int64(len(body)) > route.MaxEventSize {
+		route.Logger.Errorw("request body exceeds the maximum event size", "maxEventSize", route.MaxEventSize)
+		common.SendResponse(writer, http.StatusRequestEntityTooLarge, "request body exceeds the maximum event size")
+		route.Metrics.EventOversized(route.EventSourceName, route.EventName, string(v1alpha1.OversizePolicyReject))
+		return false
+	}
+	request.Body = io.NopCloser(bytes.NewReader(body))
+	return true
+}
+
 // activateRoute activates a route to process incoming requests
 func activateRoute(router Router, controller *Controller) {
 	route := router.GetRoute()
@@ -183,13 +245,32 @@ func manageRouteChannels(router Router, dispatch func([]byte, ...eventsourcecomm
 	logger := route.Logger
 	for {
 		select {
-		case data := <-route.DataCh:
+		case payload := <-route.DataCh:
 			logger.Info("new event received, dispatching it...")
-			if err := dispatch(data); err != nil {
+			var opts []eventsourcecommon.Option
+			if route.redeliveries != nil {
+				now := time.Now()
+				dispatched := route.redeliveries.dispatched(payload.DeliveryID, now)
+				if dispatched && route.Context.Redelivery.Suppress {
+					logger.Infow("the delivery was already dispatched, suppressing the redelivery", "deliveryID", payload.DeliveryID)
+					route.Metrics.EventRedeliverySuppressed(route.EventSourceName, route.EventName)
+					continue
+				}
+				opts = route.redeliveries.options(payload, dispatched || payload.Attempt > 1)
+			}
+			if route.replays != nil {
+				if err := route.replays.add(payload.Data); err != nil {
+					logger.Errorw("failed to keep the delivery for replays", zap.Error(err))
+				}
+			}
+			if err := dispatch(payload.Data, opts...); err != nil {
 				logger.Errorw("failed to send event", zap.Error(err))
 				route.Metrics.EventProcessingFailed(route.EventSourceName, route.EventName)
 				continue
 			}
+			if route.redeliveries != nil {
+				route.redeliveries.markDispatched(payload.DeliveryID, time.Now())
+			}
 
 		case <-route.StopChan:
 			logger.Info("event source is stopped")
@@ -217,6 +298,35 @@ func ManageRoute(ctx context.Context, router Router, controller *Controller, dis
 		return err
 	}
 
+	route.MaxEventSize = eventsourcecommon.MaxEventSizeFromContext(ctx)
+	route.StaticResponse = staticResponseFromContext(ctx, route.EventName)
+	if route.StaticResponse != nil {
+		logger.Infow("the route returns a static response", "statusCode", route.StaticResponse.StatusCode)
+	}
+
+	if route.Context.Replay != nil {
+		replays, err := newReplayStore(route.Context.Replay, route.EventSourceName, route.EventName)
+		if err != nil {
+			logger.Errorw("failed to initialize the replay store", zap.Error(err))
+			return err
+		}
+		replays.dispatch = dispatch
+		route.replays = replays
+	}
+
+	if route.Context.Enrichment != nil {
+		enricher, err := newEnricher(route.Context.Enrichment)
+		if err != nil {
+			logger.Errorw("failed to initialize the request enrichment", zap.Error(err))
+			return err
+		}
+		route.enricher = enricher
+	}
+
+	if route.Context.Redelivery != nil {
+		route.redeliveries = newRedeliveries(route.Context.Redelivery)
+	}
+
 	logger.Info("listening to payloads for the route...")
 	go manageRouteChannels(router, dispatch)
 
@@ -17,15 +17,35 @@ limitations under the License.
 package webhook
 
 import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"github.com/smartystreets/goconvey/convey"
+	corev1 "k8s.io/api/core/v1"
 )
 
 func TestValidateWebhook(t *testing.T) {
 	convey.Convey("Given a webhook, validate it", t, func() {
 		convey.So(ValidateWebhookContext(Hook), convey.ShouldBeNil)
 	})
+
+	convey.Convey("Given a webhook generating its token, validate it", t, func() {
+		hook := Hook.DeepCopy()
+		hook.GenerateToken = true
+		hook.TokenRotationPeriod = "720h"
+		convey.So(ValidateWebhookContext(hook), convey.ShouldBeNil)
+		hook.TokenRotationPeriod = "10m"
+		convey.So(ValidateWebhookContext(hook), convey.ShouldNotBeNil)
+		hook.TokenRotationPeriod = ""
+		hook.AuthSecret = &corev1.SecretKeySelector{Key: "token"}
+		convey.So(ValidateWebhookContext(hook), convey.ShouldNotBeNil)
+		hook.GenerateToken = false
+		hook.TokenRotationPeriod = "720h"
+		convey.So(ValidateWebhookContext(hook), convey.ShouldNotBeNil)
+	})
 }
 
 func TestNewWebhookHelper(t *testing.T) {
@@ -34,3 +54,89 @@ func TestNewWebhookHelper(t *testing.T) {
 		convey.So(controller, convey.ShouldNotBeNil)
 	})
 }
+
+func TestLimitRequestBody(t *testing.T) {
+	convey.Convey("Given a route with a maximum event size", t, func() {
+		route := GetFakeRoute()
+		route.MaxEventSize = 5
+
+		convey.Convey("Accept a request within the limit", func() {
+			writer := &FakeHttpWriter{}
+			request := httptest.NewRequest(http.MethodPost, "/fake", strings.NewReader("12345"))
+			convey.So(limitRequestBody(writer, request, route), convey.ShouldBeTrue)
+			body, err := io.ReadAll(request.Body)
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(string(body), convey.ShouldEqual, "12345")
+		})
+
+		convey.Convey("Reject a request exceeding the limit with 413", func() {
+			writer := &FakeHttpWriter{}
+			request := httptest.NewRequest(http.MethodPost, "/fake", strings.NewReader("123456"))
+			convey.So(limitRequestBody(writer, request, route), convey.ShouldBeFalse)
+			convey.So(writer.HeaderStatus, convey.ShouldEqual, http.StatusRequestEntityTooLarge)
+		})
+	})
+}
+
+func TestValidateWebhookPayloadSchema(t *testing.T) {
+	convey.Convey("Given a webhook with a payload schema, validate it", t, func() {
+		hook := Hook.DeepCopy()
+		hook.PayloadSchema = `{"type": "object"}`
+		convey.So(ValidateWebhookContext(hook), convey.ShouldBeNil)
+		hook.PayloadSchema = `object`
+		convey.So(ValidateWebhookContext(hook), convey.ShouldNotBeNil)
+	})
+}
+
+func TestValidateWebhookHostAndServiceGroup(t *testing.T) {
+	convey.Convey("Given a webhook routed by host in a service group, validate it", t, func() {
+		hook := Hook.DeepCopy()
+		hook.Host = "github.example.com"
+		hook.ServiceGroup = "github"
+		convey.So(ValidateWebhookContext(hook), convey.ShouldBeNil)
+		hook.Host = "GitHub_example"
+		convey.So(ValidateWebhookContext(hook), convey.ShouldNotBeNil)
+		hook.Host = ""
+		hook.ServiceGroup = "github.com"
+		convey.So(ValidateWebhookContext(hook), convey.ShouldNotBeNil)
+	})
+}
+
+func TestStartServerRoutesByHost(t *testing.T) {
+	convey.Convey("Given two routes with the same endpoint on different hosts", t, func() {
+		controller := NewController()
+		var handled []string
+		for _, host := range []string{"a.example.com", "b.example.com"} {
+			route := GetFakeRoute()
+			route.Context = route.Context.DeepCopy()
+			route.Context.Port = "0"
+			route.Context.Host = host
+			route.EventName = host
+			startServer(&fakeHostRouter{route: route, handled: &handled}, controller)
+		}
+		handler := controller.ActiveServerHandlers["0"]
+
+		for _, host := range []string{"b.example.com:12000", "a.example.com", "c.example.com"} {
+			request := httptest.NewRequest(http.MethodPost, Hook.Endpoint, strings.NewReader("{}"))
+			request.Host = host
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, request)
+		}
+		convey.So(handled, convey.ShouldResemble, []string{"b.example.com", "a.example.com"})
+	})
+}
+
+type fakeHostRouter struct {
+	route   *Route
+	handled *[]string
+}
+
+func (f *fakeHostRouter) GetRoute() *Route { return f.route }
+
+func (f *fakeHostRouter) HandleRoute(writer http.ResponseWriter, request *http.Request) {
+	*f.handled = append(*f.handled, f.route.EventName)
+}
+
+func (f *fakeHostRouter) PostActivate() error { return nil }
+
+func (f *fakeHostRouter) PostInactivate() error { return nil }
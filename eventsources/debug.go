@@ -0,0 +1,119 @@
+package eventsources
+
+import (
+	"sort"
+	"sync"
+
+	argoevents "github.com/argoproj/argo-events"
+	"github.com/argoproj/argo-events/common"
+	eventbuscommon "github.com/argoproj/argo-events/eventbus/common"
+	apicommon "github.com/argoproj/argo-events/pkg/apis/common"
+)
+
+// The states of the event listeners.
+const (
+	// ListenerRunning is the state of a listener which is listening, or retrying to.
+	ListenerRunning = "Running"
+	// ListenerInvalid is the state of a listener whose event source is invalid, it is not started.
+	ListenerInvalid = "Invalid"
+	// ListenerStopped is the state of a listener which stopped listening, e.g. its retries are exhausted.
+	ListenerStopped = "Stopped"
+)
+
+// DebugConfig is the configuration of an EventSource pod, served by its debug endpoint to troubleshoot the events
+// which are not published.
+type DebugConfig struct {
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+	Hostname  string `json:"hostname"`
+	Version   string `json:"version"`
+	// Spec is the resolved spec of the EventSource, with the secret values redacted.
+	Spec interface{} `json:"spec"`
+	// EventBus is the config of the EventBus, with the secret values redacted.
+	EventBus interface{} `json:"eventBus"`
+	// Connection is the connection to the EventBus, nil until the pod connects, e.g. while it is not the leader.
+	Connection *EventBusConnection `json:"connection,omitempty"`
+	// Listeners are the listeners of the events, sorted by event source type and event name.
+	Listeners []EventListener `json:"listeners"`
+}
+
+// EventBusConnection is the state of the connection of an EventSource pod to the EventBus.
+type EventBusConnection struct {
+	ClientID  string `json:"clientID"`
+	Connected bool   `json:"connected"`
+}
+
+// EventListener is the state of the listener of an event.
+type EventListener struct {
+	Type      apicommon.EventSourceType `json:"type"`
+	EventName string                    `json:"eventName"`
+	State     string                    `json:"state"`
+	Error     string                    `json:"error,omitempty"`
+}
+
+// adaptorState holds the connection to the EventBus and the states of the listeners, served by the debug endpoint.
+type adaptorState struct {
+	lock      sync.Mutex
+	clientID  string
+	conn      eventbuscommon.EventSourceConnection
+	listeners map[string]*EventListener
+}
+
+func newAdaptorState() *adaptorState {
+	return &adaptorState{listeners: make(map[string]*EventListener)}
+}
+
+// connected records the connection to the EventBus, on each (re)connection.
+func (s *adaptorState) connected(clientID string, conn eventbuscommon.EventSourceConnection) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.clientID = clientID
+	s.conn = conn
+}
+
+// listener records the state of the listener of a server, err is the error which stopped it, if any.
+func (s *adaptorState) listener(server EventingServer, state string, err error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	l := &EventListener{Type: server.GetEventSourceType(), EventName: server.GetEventName(), State: state}
+	if err != nil {
+		l.Error = err.Error()
+	}
+	s.listeners[string(l.Type)+"/"+l.EventName] = l
+}
+
+func (s *adaptorState) snapshot() (*EventBusConnection, []EventListener) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	var conn *EventBusConnection
+	if s.conn != nil {
+		conn = &EventBusConnection{ClientID: s.clientID, Connected: !s.conn.IsClosed()}
+	}
+	listeners := make([]EventListener, 0, len(s.listeners))
+	for _, l := range s.listeners {
+		listeners = append(listeners, *l)
+	}
+	sort.Slice(listeners, func(i, j int) bool {
+		if listeners[i].Type != listeners[j].Type {
+			return listeners[i].Type < listeners[j].Type
+		}
+		return listeners[i].EventName < listeners[j].EventName
+	})
+	return conn, listeners
+}
+
+// DebugConfig returns the configuration of the EventSource pod, with the states of its connection to the EventBus
+// and of its listeners.
+func (e *EventSourceAdaptor) DebugConfig() interface{} {
+	conn, listeners := e.state.snapshot()
+	return &DebugConfig{
+		Namespace:  e.eventSource.Namespace,
+		Name:       e.eventSource.Name,
+		Hostname:   e.hostname,
+		Version:    argoevents.GetVersion().Version,
+		Spec:       common.Redact(e.eventSource.Spec),
+		EventBus:   common.Redact(e.eventBusConfig),
+		Connection: conn,
+		Listeners:  listeners,
+	}
+}
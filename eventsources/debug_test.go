@@ -0,0 +1,63 @@
+package eventsources
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	eventbuscommon "github.com/argoproj/argo-events/eventbus/common"
+	apicommon "github.com/argoproj/argo-events/pkg/apis/common"
+	eventbusv1alpha1 "github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1"
+	"github.com/argoproj/argo-events/pkg/apis/eventsource/v1alpha1"
+)
+
+type fakeEventingServer struct {
+	EventingServer
+	eventType apicommon.EventSourceType
+	eventName string
+}
+
+func (s *fakeEventingServer) GetEventSourceType() apicommon.EventSourceType { return s.eventType }
+
+func (s *fakeEventingServer) GetEventName() string { return s.eventName }
+
+type fakeEventSourceConn struct {
+	eventbuscommon.EventSourceConnection
+	closed bool
+}
+
+func (c *fakeEventSourceConn) IsClosed() bool { return c.closed }
+
+func TestEventSourceDebugConfig(t *testing.T) {
+	eventSource := &v1alpha1.EventSource{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "argo-events", Name: "es"},
+		Spec: v1alpha1.EventSourceSpec{
+			Calendar: map[string]v1alpha1.CalendarEventSource{"every-minute": {Interval: "1m"}},
+		},
+	}
+	busConfig := &eventbusv1alpha1.BusConfig{JetStream: &eventbusv1alpha1.JetStreamConfig{URL: "nats://eventbus:4222"}}
+	adaptor := NewEventSourceAdaptor(eventSource, busConfig, "eventbus-default", "es-pod", nil)
+
+	config := adaptor.DebugConfig().(*DebugConfig)
+	assert.Equal(t, "es", config.Name)
+	assert.Nil(t, config.Connection)
+	assert.Empty(t, config.Listeners)
+	assert.Equal(t, "1m", config.Spec.(map[string]interface{})["calendar"].(map[string]interface{})["every-minute"].(map[string]interface{})["interval"])
+
+	conn := &fakeEventSourceConn{}
+	adaptor.state.connected("client-es-pod-1", conn)
+	adaptor.state.listener(&fakeEventingServer{eventType: apicommon.WebhookEvent, eventName: "example"}, ListenerRunning, nil)
+	adaptor.state.listener(&fakeEventingServer{eventType: apicommon.CalendarEvent, eventName: "every-minute"}, ListenerStopped, fmt.Errorf("invalid schedule"))
+	config = adaptor.DebugConfig().(*DebugConfig)
+	assert.Equal(t, &EventBusConnection{ClientID: "client-es-pod-1", Connected: true}, config.Connection)
+	assert.Equal(t, []EventListener{
+		{Type: apicommon.CalendarEvent, EventName: "every-minute", State: ListenerStopped, Error: "invalid schedule"},
+		{Type: apicommon.WebhookEvent, EventName: "example", State: ListenerRunning},
+	}, config.Listeners)
+
+	conn.closed = true
+	config = adaptor.DebugConfig().(*DebugConfig)
+	assert.False(t, config.Connection.Connected)
+}
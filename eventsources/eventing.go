@@ -21,6 +21,7 @@ import (
 	"github.com/argoproj/argo-events/eventbus"
 	eventbuscommon "github.com/argoproj/argo-events/eventbus/common"
 	eventsourcecommon "github.com/argoproj/argo-events/eventsources/common"
+	commonwebhook "github.com/argoproj/argo-events/eventsources/common/webhook"
 	"github.com/argoproj/argo-events/eventsources/sources/amqp"
 	"github.com/argoproj/argo-events/eventsources/sources/awssns"
 	"github.com/argoproj/argo-events/eventsources/sources/awssqs"
@@ -30,6 +31,8 @@ import (
 	"github.com/argoproj/argo-events/eventsources/sources/bitbucket"
 	"github.com/argoproj/argo-events/eventsources/sources/bitbucketserver"
 	"github.com/argoproj/argo-events/eventsources/sources/calendar"
+	"github.com/argoproj/argo-events/eventsources/sources/dynamodbstreams"
+	"github.com/argoproj/argo-events/eventsources/sources/elasticsearch"
 	"github.com/argoproj/argo-events/eventsources/sources/emitter"
 	"github.com/argoproj/argo-events/eventsources/sources/file"
 	"github.com/argoproj/argo-events/eventsources/sources/gcppubsub"
@@ -37,7 +40,9 @@ import (
 	"github.com/argoproj/argo-events/eventsources/sources/gerrit"
 	"github.com/argoproj/argo-events/eventsources/sources/github"
 	"github.com/argoproj/argo-events/eventsources/sources/gitlab"
+	"github.com/argoproj/argo-events/eventsources/sources/grpcstream"
 	"github.com/argoproj/argo-events/eventsources/sources/hdfs"
+	"github.com/argoproj/argo-events/eventsources/sources/jenkins"
 	"github.com/argoproj/argo-events/eventsources/sources/kafka"
 	"github.com/argoproj/argo-events/eventsources/sources/minio"
 	"github.com/argoproj/argo-events/eventsources/sources/mqtt"
@@ -49,6 +54,7 @@ import (
 	"github.com/argoproj/argo-events/eventsources/sources/resource"
 	"github.com/argoproj/argo-events/eventsources/sources/sftp"
 	"github.com/argoproj/argo-events/eventsources/sources/slack"
+	"github.com/argoproj/argo-events/eventsources/sources/sql"
 	"github.com/argoproj/argo-events/eventsources/sources/storagegrid"
 	"github.com/argoproj/argo-events/eventsources/sources/stripe"
 	"github.com/argoproj/argo-events/eventsources/sources/webhook"
@@ -74,16 +80,21 @@ type EventingServer interface {
 	StartListening(ctx context.Context, dispatch func([]byte, ...eventsourcecommon.Option) error) error
 }
 
-// GetEventingServers returns the mapping of event source type and list of eventing servers
-func GetEventingServers(eventSource *v1alpha1.EventSource, metrics *eventsourcemetrics.Metrics) (map[apicommon.EventSourceType][]EventingServer, map[string]*v1alpha1.EventSourceFilter) {
+// GetEventingServers returns the mapping of event source type and list of eventing servers, along with the
+// filters and the transforms of the events
+func GetEventingServers(eventSource *v1alpha1.EventSource, metrics *eventsourcemetrics.Metrics) (map[apicommon.EventSourceType][]EventingServer, map[string]*v1alpha1.EventSourceFilter, map[string]*v1alpha1.EventSourceTransform) {
 	result := make(map[apicommon.EventSourceType][]EventingServer)
 	filters := make(map[string]*v1alpha1.EventSourceFilter)
+	transforms := make(map[string]*v1alpha1.EventSourceTransform)
 	if len(eventSource.Spec.AMQP) != 0 {
 		servers := []EventingServer{}
 		for k, v := range eventSource.Spec.AMQP {
 			if v.Filter != nil {
 				filters[k] = v.Filter
 			}
+			if v.Transform != nil {
+				transforms[k] = v.Transform
+			}
 			servers = append(servers, &amqp.EventListener{EventSourceName: eventSource.Name, EventName: k, AMQPEventSource: v, Metrics: metrics})
 		}
 		result[apicommon.AMQPEvent] = servers
@@ -94,6 +105,9 @@ func GetEventingServers(eventSource *v1alpha1.EventSource, metrics *eventsourcem
 			if v.Filter != nil {
 				filters[k] = v.Filter
 			}
+			if v.Transform != nil {
+				transforms[k] = v.Transform
+			}
 			servers = append(servers, &azureeventshub.EventListener{EventSourceName: eventSource.Name, EventName: k, AzureEventsHubEventSource: v, Metrics: metrics})
 		}
 		result[apicommon.AzureEventsHub] = servers
@@ -104,6 +118,9 @@ func GetEventingServers(eventSource *v1alpha1.EventSource, metrics *eventsourcem
 			if v.Filter != nil {
 				filters[k] = v.Filter
 			}
+			if v.Transform != nil {
+				transforms[k] = v.Transform
+			}
 			servers = append(servers, &azurequeuestorage.EventListener{EventSourceName: eventSource.Name, EventName: k, AzureQueueStorageEventSource: v, Metrics: metrics})
 		}
 		result[apicommon.AzureQueueStorage] = servers
@@ -114,6 +131,9 @@ func GetEventingServers(eventSource *v1alpha1.EventSource, metrics *eventsourcem
 			if v.Filter != nil {
 				filters[k] = v.Filter
 			}
+			if v.Transform != nil {
+				transforms[k] = v.Transform
+			}
 			servers = append(servers, &azureservicebus.EventListener{EventSourceName: eventSource.Name, EventName: k, AzureServiceBusEventSource: v, Metrics: metrics})
 		}
 		result[apicommon.AzureServiceBus] = servers
@@ -124,6 +144,9 @@ func GetEventingServers(eventSource *v1alpha1.EventSource, metrics *eventsourcem
 			if v.Filter != nil {
 				filters[k] = v.Filter
 			}
+			if v.Transform != nil {
+				transforms[k] = v.Transform
+			}
 			servers = append(servers, &bitbucket.EventListener{EventSourceName: eventSource.Name, EventName: k, BitbucketEventSource: v, Metrics: metrics})
 		}
 		result[apicommon.BitbucketEvent] = servers
@@ -134,6 +157,9 @@ func GetEventingServers(eventSource *v1alpha1.EventSource, metrics *eventsourcem
 			if v.Filter != nil {
 				filters[k] = v.Filter
 			}
+			if v.Transform != nil {
+				transforms[k] = v.Transform
+			}
 			servers = append(servers, &bitbucketserver.EventListener{EventSourceName: eventSource.Name, EventName: k, BitbucketServerEventSource: v, Metrics: metrics})
 		}
 		result[apicommon.BitbucketServerEvent] = servers
@@ -144,6 +170,9 @@ func GetEventingServers(eventSource *v1alpha1.EventSource, metrics *eventsourcem
 			if v.Filter != nil {
 				filters[k] = v.Filter
 			}
+			if v.Transform != nil {
+				transforms[k] = v.Transform
+			}
 			servers = append(servers, &calendar.EventListener{EventSourceName: eventSource.Name, EventName: k, CalendarEventSource: v, Namespace: eventSource.Namespace, Metrics: metrics})
 		}
 		result[apicommon.CalendarEvent] = servers
@@ -154,6 +183,9 @@ func GetEventingServers(eventSource *v1alpha1.EventSource, metrics *eventsourcem
 			if v.Filter != nil {
 				filters[k] = v.Filter
 			}
+			if v.Transform != nil {
+				transforms[k] = v.Transform
+			}
 			servers = append(servers, &emitter.EventListener{EventSourceName: eventSource.Name, EventName: k, EmitterEventSource: v, Metrics: metrics})
 		}
 		result[apicommon.EmitterEvent] = servers
@@ -164,6 +196,9 @@ func GetEventingServers(eventSource *v1alpha1.EventSource, metrics *eventsourcem
 			if v.Filter != nil {
 				filters[k] = v.Filter
 			}
+			if v.Transform != nil {
+				transforms[k] = v.Transform
+			}
 			servers = append(servers, &file.EventListener{EventSourceName: eventSource.Name, EventName: k, FileEventSource: v, Metrics: metrics})
 		}
 		result[apicommon.FileEvent] = servers
@@ -174,6 +209,9 @@ func GetEventingServers(eventSource *v1alpha1.EventSource, metrics *eventsourcem
 			if v.Filter != nil {
 				filters[k] = v.Filter
 			}
+			if v.Transform != nil {
+				transforms[k] = v.Transform
+			}
 			servers = append(servers, &sftp.EventListener{EventSourceName: eventSource.Name, EventName: k, SFTPEventSource: v, Metrics: metrics})
 		}
 		result[apicommon.SFTPEvent] = servers
@@ -184,6 +222,9 @@ func GetEventingServers(eventSource *v1alpha1.EventSource, metrics *eventsourcem
 			if v.Filter != nil {
 				filters[k] = v.Filter
 			}
+			if v.Transform != nil {
+				transforms[k] = v.Transform
+			}
 			servers = append(servers, &gerrit.EventListener{EventSourceName: eventSource.Name, EventName: k, GerritEventSource: v, Metrics: metrics})
 		}
 		result[apicommon.GerritEvent] = servers
@@ -194,7 +235,10 @@ func GetEventingServers(eventSource *v1alpha1.EventSource, metrics *eventsourcem
 			if v.Filter != nil {
 				filters[k] = v.Filter
 			}
-			servers = append(servers, &github.EventListener{EventSourceName: eventSource.Name, EventName: k, GithubEventSource: v, Metrics: metrics})
+			if v.Transform != nil {
+				transforms[k] = v.Transform
+			}
+			servers = append(servers, &github.EventListener{EventSourceName: eventSource.Name, EventName: k, GithubEventSource: v, Namespace: eventSource.Namespace, Metrics: metrics})
 		}
 		result[apicommon.GithubEvent] = servers
 	}
@@ -204,7 +248,10 @@ func GetEventingServers(eventSource *v1alpha1.EventSource, metrics *eventsourcem
 			if v.Filter != nil {
 				filters[k] = v.Filter
 			}
-			servers = append(servers, &gitlab.EventListener{EventSourceName: eventSource.Name, EventName: k, GitlabEventSource: v, Metrics: metrics})
+			if v.Transform != nil {
+				transforms[k] = v.Transform
+			}
+			servers = append(servers, &gitlab.EventListener{EventSourceName: eventSource.Name, EventName: k, GitlabEventSource: v, Namespace: eventSource.Namespace, Metrics: metrics})
 		}
 		result[apicommon.GitlabEvent] = servers
 	}
@@ -214,6 +261,9 @@ func GetEventingServers(eventSource *v1alpha1.EventSource, metrics *eventsourcem
 			if v.Filter != nil {
 				filters[k] = v.Filter
 			}
+			if v.Transform != nil {
+				transforms[k] = v.Transform
+			}
 			servers = append(servers, &hdfs.EventListener{EventSourceName: eventSource.Name, EventName: k, HDFSEventSource: v, Metrics: metrics})
 		}
 		result[apicommon.HDFSEvent] = servers
@@ -224,6 +274,9 @@ func GetEventingServers(eventSource *v1alpha1.EventSource, metrics *eventsourcem
 			if v.Filter != nil {
 				filters[k] = v.Filter
 			}
+			if v.Transform != nil {
+				transforms[k] = v.Transform
+			}
 			servers = append(servers, &kafka.EventListener{EventSourceName: eventSource.Name, EventName: k, KafkaEventSource: v, Metrics: metrics})
 		}
 		result[apicommon.KafkaEvent] = servers
@@ -234,6 +287,9 @@ func GetEventingServers(eventSource *v1alpha1.EventSource, metrics *eventsourcem
 			if v.Filter != nil {
 				filters[k] = v.Filter
 			}
+			if v.Transform != nil {
+				transforms[k] = v.Transform
+			}
 			servers = append(servers, &mqtt.EventListener{EventSourceName: eventSource.Name, EventName: k, MQTTEventSource: v, Metrics: metrics})
 		}
 		result[apicommon.MQTTEvent] = servers
@@ -251,6 +307,9 @@ func GetEventingServers(eventSource *v1alpha1.EventSource, metrics *eventsourcem
 			if v.Filter != nil {
 				filters[k] = v.Filter
 			}
+			if v.Transform != nil {
+				transforms[k] = v.Transform
+			}
 			servers = append(servers, &nats.EventListener{EventSourceName: eventSource.Name, EventName: k, NATSEventSource: v, Metrics: metrics})
 		}
 		result[apicommon.NATSEvent] = servers
@@ -261,6 +320,9 @@ func GetEventingServers(eventSource *v1alpha1.EventSource, metrics *eventsourcem
 			if v.Filter != nil {
 				filters[k] = v.Filter
 			}
+			if v.Transform != nil {
+				transforms[k] = v.Transform
+			}
 			servers = append(servers, &nsq.EventListener{EventSourceName: eventSource.Name, EventName: k, NSQEventSource: v, Metrics: metrics})
 		}
 		result[apicommon.NSQEvent] = servers
@@ -271,6 +333,9 @@ func GetEventingServers(eventSource *v1alpha1.EventSource, metrics *eventsourcem
 			if v.Filter != nil {
 				filters[k] = v.Filter
 			}
+			if v.Transform != nil {
+				transforms[k] = v.Transform
+			}
 			servers = append(servers, &gcppubsub.EventListener{EventSourceName: eventSource.Name, EventName: k, PubSubEventSource: v, Metrics: metrics})
 		}
 		result[apicommon.PubSubEvent] = servers
@@ -281,6 +346,9 @@ func GetEventingServers(eventSource *v1alpha1.EventSource, metrics *eventsourcem
 			if v.Filter != nil {
 				filters[k] = v.Filter
 			}
+			if v.Transform != nil {
+				transforms[k] = v.Transform
+			}
 			servers = append(servers, &redis.EventListener{EventSourceName: eventSource.Name, EventName: k, RedisEventSource: v, Metrics: metrics})
 		}
 		result[apicommon.RedisEvent] = servers
@@ -291,16 +359,74 @@ func GetEventingServers(eventSource *v1alpha1.EventSource, metrics *eventsourcem
 			if v.Filter != nil {
 				filters[k] = v.Filter
 			}
+			if v.Transform != nil {
+				transforms[k] = v.Transform
+			}
 			servers = append(servers, &redisstream.EventListener{EventSourceName: eventSource.Name, EventName: k, EventSource: v, Metrics: metrics})
 		}
 		result[apicommon.RedisStreamEvent] = servers
 	}
+	if len(eventSource.Spec.Elasticsearch) != 0 {
+		servers := []EventingServer{}
+		for k, v := range eventSource.Spec.Elasticsearch {
+			if v.Filter != nil {
+				filters[k] = v.Filter
+			}
+			if v.Transform != nil {
+				transforms[k] = v.Transform
+			}
+			servers = append(servers, &elasticsearch.EventListener{EventSourceName: eventSource.Name, EventName: k, EventSource: v, Metrics: metrics})
+		}
+		result[apicommon.ElasticsearchEvent] = servers
+	}
+	if len(eventSource.Spec.Jenkins) != 0 {
+		servers := []EventingServer{}
+		for k, v := range eventSource.Spec.Jenkins {
+			if v.Filter != nil {
+				filters[k] = v.Filter
+			}
+			if v.Transform != nil {
+				transforms[k] = v.Transform
+			}
+			servers = append(servers, &jenkins.EventListener{EventSourceName: eventSource.Name, EventName: k, JenkinsEventSource: v, Metrics: metrics})
+		}
+		result[apicommon.JenkinsEvent] = servers
+	}
+	if len(eventSource.Spec.SQL) != 0 {
+		servers := []EventingServer{}
+		for k, v := range eventSource.Spec.SQL {
+			if v.Filter != nil {
+				filters[k] = v.Filter
+			}
+			if v.Transform != nil {
+				transforms[k] = v.Transform
+			}
+			servers = append(servers, &sql.EventListener{EventSourceName: eventSource.Name, EventName: k, EventSource: v, Namespace: eventSource.Namespace, Metrics: metrics})
+		}
+		result[apicommon.SQLEvent] = servers
+	}
+	if len(eventSource.Spec.DynamoDBStreams) != 0 {
+		servers := []EventingServer{}
+		for k, v := range eventSource.Spec.DynamoDBStreams {
+			if v.Filter != nil {
+				filters[k] = v.Filter
+			}
+			if v.Transform != nil {
+				transforms[k] = v.Transform
+			}
+			servers = append(servers, &dynamodbstreams.EventListener{EventSourceName: eventSource.Name, EventName: k, EventSource: v, Namespace: eventSource.Namespace, Metrics: metrics})
+		}
+		result[apicommon.DynamoDBStreamsEvent] = servers
+	}
 	if len(eventSource.Spec.SNS) != 0 {
 		servers := []EventingServer{}
 		for k, v := range eventSource.Spec.SNS {
 			if v.Filter != nil {
 				filters[k] = v.Filter
 			}
+			if v.Transform != nil {
+				transforms[k] = v.Transform
+			}
 			servers = append(servers, &awssns.EventListener{EventSourceName: eventSource.Name, EventName: k, SNSEventSource: v, Metrics: metrics})
 		}
 		result[apicommon.SNSEvent] = servers
@@ -311,6 +437,9 @@ func GetEventingServers(eventSource *v1alpha1.EventSource, metrics *eventsourcem
 			if v.Filter != nil {
 				filters[k] = v.Filter
 			}
+			if v.Transform != nil {
+				transforms[k] = v.Transform
+			}
 			servers = append(servers, &awssqs.EventListener{EventSourceName: eventSource.Name, EventName: k, SQSEventSource: v, Metrics: metrics})
 		}
 		result[apicommon.SQSEvent] = servers
@@ -321,6 +450,9 @@ func GetEventingServers(eventSource *v1alpha1.EventSource, metrics *eventsourcem
 			if v.Filter != nil {
 				filters[k] = v.Filter
 			}
+			if v.Transform != nil {
+				transforms[k] = v.Transform
+			}
 			servers = append(servers, &slack.EventListener{EventSourceName: eventSource.Name, EventName: k, SlackEventSource: v, Metrics: metrics})
 		}
 		result[apicommon.SlackEvent] = servers
@@ -345,6 +477,9 @@ func GetEventingServers(eventSource *v1alpha1.EventSource, metrics *eventsourcem
 			if v.Filter != nil {
 				filters[k] = v.Filter
 			}
+			if v.Transform != nil {
+				transforms[k] = v.Transform
+			}
 			servers = append(servers, &webhook.EventListener{EventSourceName: eventSource.Name, EventName: k, Webhook: v, Metrics: metrics})
 		}
 		result[apicommon.WebhookEvent] = servers
@@ -362,21 +497,40 @@ func GetEventingServers(eventSource *v1alpha1.EventSource, metrics *eventsourcem
 			if v.Filter != nil {
 				filters[k] = v.Filter
 			}
+			if v.Transform != nil {
+				transforms[k] = v.Transform
+			}
 			servers = append(servers, &pulsar.EventListener{EventSourceName: eventSource.Name, EventName: k, PulsarEventSource: v, Metrics: metrics})
 		}
 		result[apicommon.PulsarEvent] = servers
 	}
+	if len(eventSource.Spec.GRPCStream) != 0 {
+		servers := []EventingServer{}
+		for k, v := range eventSource.Spec.GRPCStream {
+			if v.Filter != nil {
+				filters[k] = v.Filter
+			}
+			if v.Transform != nil {
+				transforms[k] = v.Transform
+			}
+			servers = append(servers, &grpcstream.EventListener{EventSourceName: eventSource.Name, EventName: k, EventSource: v, Metrics: metrics})
+		}
+		result[apicommon.GRPCStreamEvent] = servers
+	}
 	if len(eventSource.Spec.Generic) != 0 {
 		servers := []EventingServer{}
 		for k, v := range eventSource.Spec.Generic {
 			if v.Filter != nil {
 				filters[k] = v.Filter
 			}
+			if v.Transform != nil {
+				transforms[k] = v.Transform
+			}
 			servers = append(servers, &generic.EventListener{EventSourceName: eventSource.Name, EventName: k, GenericEventSource: v, Metrics: metrics})
 		}
 		result[apicommon.GenericEvent] = servers
 	}
-	return result, filters
+	return result, filters, transforms
 }
 
 // EventSourceAdaptor is the adaptor for eventsource service
@@ -387,6 +541,8 @@ type EventSourceAdaptor struct {
 	hostname        string
 
 	eventBusConn eventbuscommon.EventSourceConnection
+	// state holds the connection and the listeners served by the debug endpoint.
+	state *adaptorState
 
 	metrics *eventsourcemetrics.Metrics
 }
@@ -398,6 +554,7 @@ func NewEventSourceAdaptor(eventSource *v1alpha1.EventSource, eventBusConfig *ev
 		eventBusConfig:  eventBusConfig,
 		eventBusSubject: eventBusSubject,
 		hostname:        hostname,
+		state:           newAdaptorState(),
 		metrics:         metrics,
 	}
 }
@@ -411,7 +568,7 @@ func (e *EventSourceAdaptor) Start(ctx context.Context) error {
 		recreateTypes[esType] = true
 	}
 	isRecreateType := false
-	servers, filters := GetEventingServers(e.eventSource, e.metrics)
+	servers, filters, transforms := GetEventingServers(e.eventSource, e.metrics)
 	for k := range servers {
 		if _, ok := recreateTypes[k]; ok {
 			isRecreateType = true
@@ -421,8 +578,10 @@ func (e *EventSourceAdaptor) Start(ctx context.Context) error {
 		break
 	}
 
-	if !isRecreateType {
-		return e.run(ctx, servers, filters)
+	// the replicas of an event source whose subscriptions are shared
+	// can run active-active, the broker balances the messages
+	if !isRecreateType || (len(servers) == 1 && sharesSubscriptions(e.eventSource)) {
+		return e.run(ctx, servers, filters, transforms)
 	}
 
 	clusterName := fmt.Sprintf("%s-eventsource-%s", e.eventSource.Namespace, e.eventSource.Name)
@@ -437,7 +596,7 @@ func (e *EventSourceAdaptor) Start(ctx context.Context) error {
 
 	elector.RunOrDie(ctx, leaderelection.LeaderCallbacks{
 		OnStartedLeading: func(ctx context.Context) {
-			if err := e.run(ctx, servers, filters); err != nil {
+			if err := e.run(ctx, servers, filters, transforms); err != nil {
 				log.Fatalw("failed to start", zap.Error(err))
 			}
 		},
@@ -449,7 +608,20 @@ func (e *EventSourceAdaptor) Start(ctx context.Context) error {
 	return nil
 }
 
-func (e *EventSourceAdaptor) run(ctx context.Context, servers map[apicommon.EventSourceType][]EventingServer, filters map[string]*v1alpha1.EventSourceFilter) error {
+// sharesSubscriptions returns whether all the events of the event source are MQTT events with a shared subscription.
+func sharesSubscriptions(eventSource *v1alpha1.EventSource) bool {
+	if len(eventSource.Spec.MQTT) == 0 {
+		return false
+	}
+	for _, v := range eventSource.Spec.MQTT {
+		if v.SharedSubscription == nil {
+			return false
+		}
+	}
+	return true
+}
+
+func (e *EventSourceAdaptor) run(ctx context.Context, servers map[apicommon.EventSourceType][]EventingServer, filters map[string]*v1alpha1.EventSourceFilter, transforms map[string]*v1alpha1.EventSourceTransform) error {
 	logger := logging.FromContext(ctx)
 	logger.Info("Starting event source server...")
 	clientID := generateClientID(e.hostname)
@@ -458,7 +630,7 @@ func (e *EventSourceAdaptor) run(ctx context.Context, servers map[apicommon.Even
 		logger.Errorw("failed to get eventbus driver", zap.Error(err))
 		return err
 	}
-	if err = common.DoWithRetry(&common.DefaultBackoff, func() error {
+	if err = common.DoWithTrackedRetry("eventbus.initialize", e.eventSource.Name, &common.DefaultBackoff, func() error {
 		err = driver.Initialize()
 		if err != nil {
 			return err
@@ -469,9 +641,32 @@ func (e *EventSourceAdaptor) run(ctx context.Context, servers map[apicommon.Even
 		logger.Errorw("failed to connect to eventbus", zap.Error(err))
 		return err
 	}
+	e.state.connected(clientID, e.eventBusConn)
 	defer e.eventBusConn.Close()
 
+	var sizeLimiter *eventSizeLimiter
+	if limit := e.eventSource.Spec.MaxEventSize; limit != nil {
+		if sizeLimiter, err = newEventSizeLimiter(limit); err != nil {
+			logger.Errorw("failed to set up the maximum event size", zap.Error(err))
+			return err
+		}
+	}
+
 	ctx, cancel := context.WithCancel(ctx)
+	listenCtx := ctx
+	if sizeLimiter != nil && sizeLimiter.limit.GetPolicy() == v1alpha1.OversizePolicyReject {
+		// Let the webhook based event sources reject the oversized requests with 413
+		listenCtx = eventsourcecommon.WithMaxEventSize(ctx, sizeLimiter.limit.Bytes)
+	}
+	if value := e.eventSource.Annotations[common.AnnotationWebhookResponses]; value != "" {
+		// Let the webhook based event sources return the static responses, e.g. during a maintenance
+		responses, err := commonwebhook.ParseStaticResponses(value)
+		if err != nil {
+			logger.Errorw("failed to parse the webhook static responses, ignoring them", zap.Error(err))
+		} else {
+			listenCtx = commonwebhook.WithStaticResponses(listenCtx, responses)
+		}
+	}
 	connWG := &sync.WaitGroup{}
 
 	// Daemon to reconnect
@@ -501,6 +696,7 @@ func (e *EventSourceAdaptor) run(ctx context.Context, servers map[apicommon.Even
 						logger.Errorw("failed to reconnect to eventbus", zap.Error(err))
 						continue
 					}
+					e.state.connected(clientID, e.eventBusConn)
 					logger.Info("reconnected to eventbus successfully")
 				}
 			}
@@ -515,6 +711,7 @@ func (e *EventSourceAdaptor) run(ctx context.Context, servers map[apicommon.Even
 			if err != nil {
 				logger.Errorw("Validation failed", zap.Error(err), zap.Any(logging.LabelEventName,
 					server.GetEventName()), zap.Any(logging.LabelEventSourceType, server.GetEventSourceType()))
+				e.state.listener(server, ListenerInvalid, err)
 				// Continue starting other event services instead of failing all of them
 				continue
 			}
@@ -523,6 +720,7 @@ func (e *EventSourceAdaptor) run(ctx context.Context, servers map[apicommon.Even
 				defer wg.Done()
 				e.metrics.IncRunningServices(s.GetEventSourceName())
 				defer e.metrics.DecRunningServices(s.GetEventSourceName())
+				e.state.listener(s, ListenerRunning, nil)
 				duration := apicommon.FromString("1s")
 				factor := apicommon.NewAmount("1")
 				jitter := apicommon.NewAmount("30")
@@ -532,19 +730,31 @@ func (e *EventSourceAdaptor) run(ctx context.Context, servers map[apicommon.Even
 					Factor:   &factor,
 					Jitter:   &jitter,
 				}
-				if err = common.DoWithRetry(&backoff, func() error {
-					return s.StartListening(ctx, func(data []byte, opts ...eventsourcecommon.Option) error {
+				if err = common.DoWithTrackedRetry("eventsource.start", s.GetEventSourceName()+"/"+s.GetEventName(), &backoff, func() error {
+					return s.StartListening(listenCtx, func(data []byte, opts ...eventsourcecommon.Option) error {
+						e.metrics.EventReceived(s.GetEventSourceName(), s.GetEventName())
 						if filter, ok := filters[s.GetEventName()]; ok {
 							proceed, err := filterEvent(data, filter)
 							if err != nil {
 								logger.Errorw("Failed to filter event", zap.Error(err))
+								e.metrics.EventDropped(s.GetEventSourceName(), s.GetEventName())
 								return nil
 							}
 							if !proceed {
 								logger.Info("Filter condition not met, skip dispatching")
+								e.metrics.EventFiltered(s.GetEventSourceName(), s.GetEventName())
 								return nil
 							}
 						}
+						if transform, ok := transforms[s.GetEventName()]; ok {
+							transformed, err := transformEvent(data, transform)
+							if err != nil {
+								logger.Errorw("Failed to transform event", zap.Error(err))
+								e.metrics.EventDropped(s.GetEventSourceName(), s.GetEventName())
+								return nil
+							}
+							data = transformed
+						}
 
 						uuidNew := uuid.New()
 						event := cloudevents.NewEvent()
@@ -559,11 +769,31 @@ func (e *EventSourceAdaptor) run(ctx context.Context, servers map[apicommon.Even
 								return err
 							}
 						}
+						if sizeLimiter != nil && int64(len(data)) > sizeLimiter.limit.Bytes {
+							policy := sizeLimiter.limit.GetPolicy()
+							e.metrics.EventOversized(s.GetEventSourceName(), s.GetEventName(), string(policy))
+							limited, ok, err := sizeLimiter.apply(ctx, s.GetEventSourceName(), s.GetEventName(), event.ID(), data)
+							if err != nil {
+								logger.Errorw("Failed to apply the oversize policy", zap.Error(err), zap.String(logging.LabelEventName,
+									s.GetEventName()), zap.String("eventID", event.ID()))
+								e.metrics.EventProcessingFailed(s.GetEventSourceName(), s.GetEventName())
+								return err
+							}
+							if !ok {
+								logger.Warnw("Dropped an event exceeding the maximum event size", zap.String(logging.LabelEventName,
+									s.GetEventName()), zap.Int("size", len(data)), zap.String("eventID", event.ID()))
+								e.metrics.EventDropped(s.GetEventSourceName(), s.GetEventName())
+								return nil
+							}
+							logger.Infow("Applied the oversize policy on an event", zap.String(logging.LabelEventName,
+								s.GetEventName()), zap.String("policy", string(policy)), zap.Int("size", len(data)), zap.String("eventID", event.ID()))
+							data = limited
+						}
 						err := event.SetData(cloudevents.ApplicationJSON, data)
 						if err != nil {
 							return err
 						}
-						eventBody, err := json.Marshal(event)
+						eventBody, err := eventbuscommon.EncodeEvent(e.eventBusConfig.Format, &event)
 						if err != nil {
 							return err
 						}
@@ -581,7 +811,7 @@ func (e *EventSourceAdaptor) run(ctx context.Context, servers map[apicommon.Even
 							Body: eventBody,
 						}
 						logger.Debugw(string(data), zap.String("eventID", event.ID()))
-						if err = common.DoWithRetry(&common.DefaultBackoff, func() error {
+						if err = common.DoWithTrackedRetry("eventbus.publish", s.GetEventSourceName()+"/"+s.GetEventName(), &common.DefaultBackoff, func() error {
 							return e.eventBusConn.Publish(ctx, msg)
 						}); err != nil {
 							logger.Errorw("Failed to publish an event", zap.Error(err), zap.String(logging.LabelEventName,
@@ -598,6 +828,7 @@ func (e *EventSourceAdaptor) run(ctx context.Context, servers map[apicommon.Even
 					logger.Errorw("Failed to start listening eventsource", zap.Any(logging.LabelEventSourceType,
 						s.GetEventSourceType()), zap.Any(logging.LabelEventName, s.GetEventName()), zap.Error(err))
 				}
+				e.state.listener(s, ListenerStopped, err)
 			}(server)
 		}
 	}
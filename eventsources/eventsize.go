@@ -0,0 +1,151 @@
+package eventsources
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"path"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+
+	"github.com/argoproj/argo-events/common"
+	apicommon "github.com/argoproj/argo-events/pkg/apis/common"
+	"github.com/argoproj/argo-events/pkg/apis/eventsource/v1alpha1"
+)
+
+// truncatedEventData replaces the data of an event truncated by the Truncate policy.
+type truncatedEventData struct {
+	Truncated    bool   `json:"truncated"`
+	OriginalSize int    `json:"originalSize"`
+	Data         string `json:"data"`
+}
+
+// claimCheckEventData replaces the data of an event offloaded by the ClaimCheck policy.
+type claimCheckEventData struct {
+	ClaimCheck claimCheck `json:"claimCheck"`
+}
+
+type claimCheck struct {
+	Endpoint string `json:"endpoint"`
+	Bucket   string `json:"bucket"`
+	Key      string `json:"key"`
+	Size     int    `json:"size"`
+}
+
+// eventSizeLimiter applies the oversize policy on the events exceeding the maximum size.
+type eventSizeLimiter struct {
+	limit       *v1alpha1.EventSizeLimit
+	minioClient *minio.Client
+}
+
+func newEventSizeLimiter(limit *v1alpha1.EventSizeLimit) (*eventSizeLimiter, error) {
+	l := &eventSizeLimiter{limit: limit}
+	if limit.GetPolicy() != v1alpha1.OversizePolicyClaimCheck {
+		return l, nil
+	}
+	s3 := limit.ClaimCheck
+	if s3 == nil || s3.Bucket == nil {
+		return nil, fmt.Errorf("claimCheck bucket is required by the ClaimCheck policy")
+	}
+	client, err := newS3Client(s3)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create the claimCheck client, %w", err)
+	}
+	l.minioClient = client
+	return l, nil
+}
+
+// newS3Client returns a client of the S3 endpoint, authenticated with the access and secret keys if specified,
+// or the IAM credentials otherwise.
+func newS3Client(s3 *apicommon.S3Artifact) (*minio.Client, error) {
+	opts := &minio.Options{Secure: !s3.Insecure, Region: s3.Region}
+	if s3.AccessKey != nil && s3.SecretKey != nil {
+		accessKey, err := common.GetSecretFromVolume(s3.AccessKey)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get the access key, %w", err)
+		}
+		secretKey, err := common.GetSecretFromVolume(s3.SecretKey)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get the secret key, %w", err)
+		}
+		opts.Creds = credentials.NewStaticV4(accessKey, secretKey, "")
+	} else {
+		opts.Creds = credentials.NewIAM("")
+	}
+	if s3.CACertificate != nil {
+		caCertificate, err := common.GetSecretFromVolume(s3.CACertificate)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get the CA certificate, %w", err)
+		}
+		caCertPool := x509.NewCertPool()
+		caCertPool.AppendCertsFromPEM([]byte(caCertificate))
+		opts.Transport = &http.Transport{TLSClientConfig: &tls.Config{RootCAs: caCertPool}}
+	}
+	return minio.New(s3.Endpoint, opts)
+}
+
+// apply returns the data to be published, it returns false if the event should be dropped.
+func (l *eventSizeLimiter) apply(ctx context.Context, eventSourceName, eventName, eventID string, data []byte) ([]byte, bool, error) {
+	if int64(len(data)) <= l.limit.Bytes {
+		return data, true, nil
+	}
+	switch l.limit.GetPolicy() {
+	case v1alpha1.OversizePolicyTruncate:
+		b, err := l.truncate(data)
+		return b, err == nil, err
+	case v1alpha1.OversizePolicyClaimCheck:
+		s3 := l.limit.ClaimCheck
+		key := path.Join(s3.Bucket.Key, eventSourceName, eventName, eventID)
+		if _, err := l.minioClient.PutObject(ctx, s3.Bucket.Name, key, bytes.NewReader(data), int64(len(data)), minio.PutObjectOptions{ContentType: "application/json"}); err != nil {
+			return nil, false, fmt.Errorf("failed to offload the event data to the claimCheck bucket, %w", err)
+		}
+		b, err := json.Marshal(&claimCheckEventData{
+			ClaimCheck: claimCheck{
+				Endpoint: s3.Endpoint,
+				Bucket:   s3.Bucket.Name,
+				Key:      key,
+				Size:     len(data),
+			},
+		})
+		return b, err == nil, err
+	default:
+		return nil, false, nil
+	}
+}
+
+// truncate returns the marker of the truncated data, which is within the limit once marshaled. The data is escaped in
+// the marker, so the longest prefix of the data whose marker fits is searched.
+func (l *eventSizeLimiter) truncate(data []byte) ([]byte, error) {
+	marshal := func(n int) ([]byte, error) {
+		return json.Marshal(&truncatedEventData{
+			Truncated:    true,
+			OriginalSize: len(data),
+			Data:         string(bytes.ToValidUTF8(data[:n], nil)),
+		})
+	}
+	var result []byte
+	// Each byte of the data takes at least one byte of the marker
+	lo, hi := 0, int(min(int64(len(data)), l.limit.Bytes))
+	for lo <= hi {
+		n := lo + (hi-lo)/2
+		b, err := marshal(n)
+		if err != nil {
+			return nil, err
+		}
+		if int64(len(b)) <= l.limit.Bytes {
+			result = b
+			lo = n + 1
+		} else {
+			hi = n - 1
+		}
+	}
+	if result == nil {
+		return nil, fmt.Errorf("the maximum event size %d is smaller than the truncation marker", l.limit.Bytes)
+	}
+	return result, nil
+}
@@ -0,0 +1,64 @@
+package eventsources
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/argoproj/argo-events/pkg/apis/eventsource/v1alpha1"
+)
+
+func TestEventSizeLimiter(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("test within the limit", func(t *testing.T) {
+		l, err := newEventSizeLimiter(&v1alpha1.EventSizeLimit{Bytes: 10})
+		assert.NoError(t, err)
+		data, ok, err := l.apply(ctx, "es", "e", "id", []byte(`{"a":"b"}`))
+		assert.NoError(t, err)
+		assert.True(t, ok)
+		assert.Equal(t, `{"a":"b"}`, string(data))
+	})
+
+	t.Run("test reject", func(t *testing.T) {
+		l, err := newEventSizeLimiter(&v1alpha1.EventSizeLimit{Bytes: 5})
+		assert.NoError(t, err)
+		_, ok, err := l.apply(ctx, "es", "e", "id", []byte(`{"a":"b"}`))
+		assert.NoError(t, err)
+		assert.False(t, ok)
+	})
+
+	t.Run("test truncate", func(t *testing.T) {
+		limit := int64(60)
+		l, err := newEventSizeLimiter(&v1alpha1.EventSizeLimit{Bytes: limit, Policy: v1alpha1.OversizePolicyTruncate})
+		assert.NoError(t, err)
+		original := []byte(`{"a":"<b>","c":"\n\t","d":"` + strings.Repeat("x", 100) + `"}`)
+		data, ok, err := l.apply(ctx, "es", "e", "id", original)
+		assert.NoError(t, err)
+		assert.True(t, ok)
+		// The marker, including the escaped data, is within the limit
+		assert.LessOrEqual(t, int64(len(data)), limit)
+		truncated := &truncatedEventData{}
+		assert.NoError(t, json.Unmarshal(data, truncated))
+		assert.True(t, truncated.Truncated)
+		assert.Equal(t, len(original), truncated.OriginalSize)
+		assert.NotEmpty(t, truncated.Data)
+		assert.True(t, strings.HasPrefix(string(original), truncated.Data))
+	})
+
+	t.Run("test truncate with a limit smaller than the marker", func(t *testing.T) {
+		l, err := newEventSizeLimiter(&v1alpha1.EventSizeLimit{Bytes: 5, Policy: v1alpha1.OversizePolicyTruncate})
+		assert.NoError(t, err)
+		_, ok, err := l.apply(ctx, "es", "e", "id", []byte(`{"a":"b"}`))
+		assert.Error(t, err)
+		assert.False(t, ok)
+	})
+
+	t.Run("test claim check without bucket", func(t *testing.T) {
+		_, err := newEventSizeLimiter(&v1alpha1.EventSizeLimit{Bytes: 5, Policy: v1alpha1.OversizePolicyClaimCheck})
+		assert.Error(t, err)
+	})
+}
@@ -3,6 +3,7 @@ package persist
 import (
 	"context"
 	"fmt"
+	"os"
 
 	v1 "k8s.io/api/core/v1"
 	apierr "k8s.io/apimachinery/pkg/api/errors"
@@ -66,6 +67,20 @@ func NewConfigMapPersist(ctx context.Context, client kubernetes.Interface, confi
 	return &cmp, nil
 }
 
+// NewInClusterConfigMapPersist returns a ConfigMap persistence using the K8s client of the event source pod.
+func NewInClusterConfigMapPersist(ctx context.Context, configmap *v1alpha1.ConfigMapPersistence, namespace string) (EventPersist, error) {
+	kubeConfig, _ := os.LookupEnv(common.EnvVarKubeConfig)
+	restConfig, err := common.GetClientConfig(kubeConfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get a K8s rest config, %w", err)
+	}
+	kubeClientset, err := kubernetes.NewForConfig(restConfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to set up a K8s client, %w", err)
+	}
+	return NewConfigMapPersist(ctx, kubeClientset, configmap, namespace)
+}
+
 func (cmp *ConfigMapPersist) IsEnabled() bool {
 	return true
 }
@@ -75,7 +90,7 @@ func (cmp *ConfigMapPersist) Save(event *Event) error {
 		return fmt.Errorf("event object is nil")
 	}
 	// Using Connect util func for backoff retry if K8s API returns error
-	err := common.DoWithRetry(&common.DefaultBackoff, func() error {
+	err := common.DoWithTrackedRetry("configmap.update", cmp.namespace+"/"+cmp.name, &common.DefaultBackoff, func() error {
 		cm, err := cmp.kubeClient.CoreV1().ConfigMaps(cmp.namespace).Get(cmp.ctx, cmp.name, metav1.GetOptions{})
 		if err != nil {
 			if apierr.IsNotFound(err) && cmp.createIfNotExist {
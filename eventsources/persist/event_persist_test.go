@@ -73,3 +73,21 @@ func TestConfigMapPersist(t *testing.T) {
 		assert.Nil(t, event1)
 	})
 }
+
+func TestRegistrations(t *testing.T) {
+	cp, err := NewConfigMapPersist(context.TODO(), fake.NewSimpleClientset(), &v1alpha1.ConfigMapPersistence{Name: "test-config", CreateIfNotExist: true}, "default")
+	assert.NoError(t, err)
+
+	registrations, err := LoadRegistrations(cp, "test.test")
+	assert.NoError(t, err)
+	assert.Empty(t, registrations)
+
+	assert.NoError(t, SaveRegistrations(cp, "test.test", Registrations{"repo:argoproj/argo-events": "42"}))
+	registrations, err = LoadRegistrations(cp, "test.test")
+	assert.NoError(t, err)
+	assert.Equal(t, Registrations{"repo:argoproj/argo-events": "42"}, registrations)
+
+	assert.NoError(t, cp.Save(&Event{EventKey: "test.test", EventPayload: "{"}))
+	_, err = LoadRegistrations(cp, "test.test")
+	assert.Error(t, err)
+}
@@ -0,0 +1,36 @@
+package persist
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// Registrations are the IDs of the registrations an event source owns in an external system, e.g. the hooks it
+// created, keyed by the registered resource. They are persisted so that a restarted pod resumes them instead of
+// registering them again.
+type Registrations map[string]string
+
+// LoadRegistrations returns the persisted registrations, empty if none were persisted.
+func LoadRegistrations(p EventPersist, key string) (Registrations, error) {
+	registrations := Registrations{}
+	persisted, err := p.Get(key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the persisted registrations, %w", err)
+	}
+	if persisted == nil {
+		return registrations, nil
+	}
+	if err := json.Unmarshal([]byte(persisted.EventPayload), &registrations); err != nil {
+		return nil, fmt.Errorf("failed to parse the persisted registrations, %w", err)
+	}
+	return registrations, nil
+}
+
+// SaveRegistrations persists the registrations.
+func SaveRegistrations(p EventPersist, key string, registrations Registrations) error {
+	b, err := json.Marshal(registrations)
+	if err != nil {
+		return err
+	}
+	return p.Save(&Event{EventKey: key, EventPayload: string(b)})
+}
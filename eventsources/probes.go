@@ -0,0 +1,296 @@
+package eventsources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/util/retry"
+
+	"github.com/argoproj/argo-events/common"
+	"github.com/argoproj/argo-events/common/logging"
+	"github.com/argoproj/argo-events/pkg/apis/eventsource"
+	"github.com/argoproj/argo-events/pkg/apis/eventsource/v1alpha1"
+)
+
+const (
+	// defaultProbeInterval is the default interval between the dependency probes.
+	defaultProbeInterval = 30 * time.Second
+	// defaultProbeTimeout is the default timeout of a dependency probe.
+	defaultProbeTimeout = 5 * time.Second
+)
+
+// probeCheck checks a dependency, it returns an error describing why the dependency is not reachable.
+type probeCheck func(ctx context.Context) error
+
+// DependencyProber runs the dependency probes of an EventSource. It serves the readiness of the pod,
+// which is ready once all the probes succeeded, and reports the results in the DependenciesReachable condition.
+type DependencyProber struct {
+	eventSource   *v1alpha1.EventSource
+	dynamicClient dynamic.Interface
+
+	lock sync.Mutex
+	// results holds the error of the last run of each probe, empty if it succeeded.
+	results map[string]string
+	// reported is the condition message last reported, nil if not reported yet.
+	reported *string
+}
+
+// NewDependencyProber returns a prober of the dependencies of the EventSource, the dynamic client is used to
+// report the results in the status, which is skipped if it is nil.
+func NewDependencyProber(eventSource *v1alpha1.EventSource, dynamicClient dynamic.Interface) *DependencyProber {
+	return &DependencyProber{
+		eventSource:   eventSource,
+		dynamicClient: dynamicClient,
+		results:       make(map[string]string),
+	}
+}
+
+// Run runs the probes at startup and periodically until the context is done.
+func (p *DependencyProber) Run(ctx context.Context) {
+	var wg sync.WaitGroup
+	for _, probe := range p.eventSource.Spec.DependencyProbes {
+		wg.Add(1)
+		go func(probe v1alpha1.DependencyProbe) {
+			defer wg.Done()
+			p.runProbe(ctx, probe)
+		}(probe)
+	}
+	wg.Wait()
+}
+
+func (p *DependencyProber) runProbe(ctx context.Context, probe v1alpha1.DependencyProbe) {
+	log := logging.FromContext(ctx).With("probe", probe.Name)
+	interval := parseProbeDuration(probe.Interval, defaultProbeInterval)
+	timeout := parseProbeDuration(probe.Timeout, defaultProbeTimeout)
+	check, err := newProbeCheck(probe)
+	if err != nil {
+		check = func(context.Context) error { return err }
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		checkCtx, cancel := context.WithTimeout(ctx, timeout)
+		err := check(checkCtx)
+		cancel()
+		if ctx.Err() != nil {
+			return
+		}
+		if p.record(probe.Name, err) {
+			if err != nil {
+				log.Warnw("dependency probe failed", zap.Error(err))
+			} else {
+				log.Info("dependency probe succeeded")
+			}
+		}
+		if err := p.report(ctx); err != nil {
+			log.Warnw("failed to report the dependency probes", zap.Error(err))
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// record records the result of a probe, it returns true if the result changed.
+func (p *DependencyProber) record(name string, err error) bool {
+	result := ""
+	if err != nil {
+		result = err.Error()
+	}
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	previous, ok := p.results[name]
+	p.results[name] = result
+	return !ok || previous != result
+}
+
+// state returns whether all the probes ran, and the message describing the failing probes.
+func (p *DependencyProber) state() (bool, string) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	var failures []string
+	for name, result := range p.results {
+		if result != "" {
+			failures = append(failures, fmt.Sprintf("%s: %s", name, result))
+		}
+	}
+	sort.Strings(failures)
+	return len(p.results) == len(p.eventSource.Spec.DependencyProbes), strings.Join(failures, "; ")
+}
+
+// Ready returns nil if all the probes ran, and succeeded.
+func (p *DependencyProber) Ready() error {
+	complete, message := p.state()
+	if message != "" {
+		return errors.New(message)
+	}
+	if !complete {
+		return fmt.Errorf("dependency probes are not completed yet")
+	}
+	return nil
+}
+
+// ServeHTTP serves the readiness of the pod.
+func (p *DependencyProber) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if err := p.Ready(); err != nil {
+		http.Error(w, err.Error(), http.StatusServiceUnavailable)
+		return
+	}
+	_, _ = w.Write([]byte("ok"))
+}
+
+// report updates the DependenciesReachable condition once all the probes ran, if it changed since the
+// previous report.
+func (p *DependencyProber) report(ctx context.Context) error {
+	complete, message := p.state()
+	if !complete || p.dynamicClient == nil {
+		return nil
+	}
+	p.lock.Lock()
+	unchanged := p.reported != nil && *p.reported == message
+	p.lock.Unlock()
+	if unchanged {
+		return nil
+	}
+	client := p.dynamicClient.Resource(v1alpha1.SchemeGroupVersion.WithResource(eventsource.Plural)).Namespace(p.eventSource.Namespace)
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		obj, err := client.Get(ctx, p.eventSource.Name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		es := &v1alpha1.EventSource{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, es); err != nil {
+			return err
+		}
+		if message == "" {
+			es.Status.MarkDependenciesReachable()
+		} else {
+			es.Status.MarkDependenciesUnreachable("ProbeFailed", message)
+		}
+		un, err := runtime.DefaultUnstructuredConverter.ToUnstructured(es)
+		if err != nil {
+			return err
+		}
+		_, err = client.UpdateStatus(ctx, &unstructured.Unstructured{Object: un}, metav1.UpdateOptions{})
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	p.lock.Lock()
+	p.reported = &message
+	p.lock.Unlock()
+	return nil
+}
+
+func parseProbeDuration(value string, defaultValue time.Duration) time.Duration {
+	if d, err := time.ParseDuration(value); err == nil && d > 0 {
+		return d
+	}
+	return defaultValue
+}
+
+// newProbeCheck returns the check of a probe.
+func newProbeCheck(probe v1alpha1.DependencyProbe) (probeCheck, error) {
+	switch {
+	case probe.TCP != nil:
+		return tcpProbeCheck(probe.TCP), nil
+	case probe.HTTP != nil:
+		return httpProbeCheck(probe.HTTP)
+	case probe.S3 != nil:
+		return s3ProbeCheck(probe)
+	default:
+		return nil, fmt.Errorf("no tcp, http or s3 probe is specified")
+	}
+}
+
+// tcpProbeCheck succeeds if a connection can be opened to one of the addresses.
+func tcpProbeCheck(probe *v1alpha1.TCPDependencyProbe) probeCheck {
+	return func(ctx context.Context) error {
+		var dialer net.Dialer
+		var errs []string
+		for _, address := range probe.Addresses {
+			conn, err := dialer.DialContext(ctx, "tcp", address)
+			if err == nil {
+				_ = conn.Close()
+				return nil
+			}
+			errs = append(errs, err.Error())
+		}
+		return fmt.Errorf("none of the addresses %v accepts connections, %s", probe.Addresses, strings.Join(errs, ", "))
+	}
+}
+
+// httpProbeCheck succeeds if a GET request to the URL responds with one of the expected status codes.
+func httpProbeCheck(probe *v1alpha1.HTTPDependencyProbe) (probeCheck, error) {
+	client := &http.Client{}
+	if probe.TLS != nil {
+		tlsConfig, err := common.GetTLSConfig(probe.TLS)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get the tls configuration, %w", err)
+		}
+		client.Transport = &http.Transport{TLSClientConfig: tlsConfig}
+	}
+	return func(ctx context.Context) error {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, probe.URL, nil)
+		if err != nil {
+			return fmt.Errorf("failed to create the request to %s, %w", probe.URL, err)
+		}
+		for name, value := range probe.Headers {
+			req.Header.Set(name, value)
+		}
+		resp, err := client.Do(req)
+		if err != nil {
+			return fmt.Errorf("failed to reach %s, %w", probe.URL, err)
+		}
+		_ = resp.Body.Close()
+		if len(probe.StatusCodes) == 0 {
+			if resp.StatusCode < 200 || resp.StatusCode > 299 {
+				return fmt.Errorf("GET %s responded with status %d, expected a 2xx status", probe.URL, resp.StatusCode)
+			}
+			return nil
+		}
+		for _, code := range probe.StatusCodes {
+			if int(code) == resp.StatusCode {
+				return nil
+			}
+		}
+		return fmt.Errorf("GET %s responded with status %d, expected one of %v", probe.URL, resp.StatusCode, probe.StatusCodes)
+	}, nil
+}
+
+// s3ProbeCheck succeeds if the bucket exists and is accessible with the credentials.
+func s3ProbeCheck(probe v1alpha1.DependencyProbe) (probeCheck, error) {
+	s3 := probe.S3
+	if s3.Bucket == nil || s3.Bucket.Name == "" {
+		return nil, fmt.Errorf("s3 bucket is required")
+	}
+	client, err := newS3Client(s3)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create the s3 client, %w", err)
+	}
+	return func(ctx context.Context) error {
+		exists, err := client.BucketExists(ctx, s3.Bucket.Name)
+		if err != nil {
+			return fmt.Errorf("failed to access the bucket %q at %s, %w", s3.Bucket.Name, s3.Endpoint, err)
+		}
+		if !exists {
+			return fmt.Errorf("bucket %q does not exist at %s", s3.Bucket.Name, s3.Endpoint)
+		}
+		return nil
+	}, nil
+}
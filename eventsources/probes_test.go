@@ -0,0 +1,109 @@
+package eventsources
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+
+	"github.com/argoproj/argo-events/pkg/apis/eventsource/v1alpha1"
+)
+
+func TestTCPProbeCheck(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+	defer listener.Close()
+	closed, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+	closedAddress := closed.Addr().String()
+	closed.Close()
+
+	check := tcpProbeCheck(&v1alpha1.TCPDependencyProbe{Addresses: []string{closedAddress, listener.Addr().String()}})
+	assert.NoError(t, check(context.Background()))
+
+	check = tcpProbeCheck(&v1alpha1.TCPDependencyProbe{Addresses: []string{closedAddress}})
+	err = check(context.Background())
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), closedAddress)
+}
+
+func TestHTTPProbeCheck(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer token" {
+			w.WriteHeader(http.StatusUnauthorized)
+		}
+	}))
+	defer server.Close()
+
+	check, err := httpProbeCheck(&v1alpha1.HTTPDependencyProbe{URL: server.URL, Headers: map[string]string{"Authorization": "Bearer token"}})
+	assert.NoError(t, err)
+	assert.NoError(t, check(context.Background()))
+
+	check, err = httpProbeCheck(&v1alpha1.HTTPDependencyProbe{URL: server.URL})
+	assert.NoError(t, err)
+	err = check(context.Background())
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "status 401")
+
+	check, err = httpProbeCheck(&v1alpha1.HTTPDependencyProbe{URL: server.URL, StatusCodes: []int32{http.StatusUnauthorized}})
+	assert.NoError(t, err)
+	assert.NoError(t, check(context.Background()))
+}
+
+func TestDependencyProber(t *testing.T) {
+	es := &v1alpha1.EventSource{
+		ObjectMeta: metav1.ObjectMeta{Name: "fake-eventsource", Namespace: "fake"},
+		Spec: v1alpha1.EventSourceSpec{
+			DependencyProbes: []v1alpha1.DependencyProbe{{Name: "kafka"}, {Name: "github"}},
+		},
+	}
+	scheme := runtime.NewScheme()
+	assert.NoError(t, v1alpha1.AddToScheme(scheme))
+	dynamicClient := dynamicfake.NewSimpleDynamicClient(scheme, es.DeepCopy())
+	prober := NewDependencyProber(es, dynamicClient)
+	ctx := context.Background()
+
+	getCondition := func() *corev1.ConditionStatus {
+		u, err := dynamicClient.Resource(v1alpha1.SchemeGroupVersion.WithResource("eventsources")).Namespace(es.Namespace).Get(ctx, es.Name, metav1.GetOptions{})
+		assert.NoError(t, err)
+		obj := &v1alpha1.EventSource{}
+		assert.NoError(t, runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, obj))
+		c := obj.Status.GetCondition(v1alpha1.EventSourceConditionDependenciesReachable)
+		if c == nil {
+			return nil
+		}
+		return &c.Status
+	}
+
+	assert.True(t, prober.record("kafka", nil))
+	assert.Error(t, prober.Ready())
+	assert.NoError(t, prober.report(ctx))
+	assert.Nil(t, getCondition())
+
+	assert.True(t, prober.record("github", fmt.Errorf("failed to reach https://api.github.com")))
+	err := prober.Ready()
+	assert.Error(t, err)
+	assert.Equal(t, "github: failed to reach https://api.github.com", err.Error())
+	rec := httptest.NewRecorder()
+	prober.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/ready", nil))
+	assert.Equal(t, http.StatusServiceUnavailable, rec.Code)
+	assert.NoError(t, prober.report(ctx))
+	assert.Equal(t, corev1.ConditionFalse, *getCondition())
+
+	assert.False(t, prober.record("kafka", nil))
+	assert.True(t, prober.record("github", nil))
+	assert.NoError(t, prober.Ready())
+	rec = httptest.NewRecorder()
+	prober.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/ready", nil))
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.NoError(t, prober.report(ctx))
+	assert.Equal(t, corev1.ConditionTrue, *getCondition())
+}
@@ -0,0 +1,50 @@
+package eventsources
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/util/retry"
+
+	"github.com/argoproj/argo-events/common"
+	"github.com/argoproj/argo-events/common/logging"
+	apicommon "github.com/argoproj/argo-events/pkg/apis/common"
+	"github.com/argoproj/argo-events/pkg/apis/eventsource"
+	"github.com/argoproj/argo-events/pkg/apis/eventsource/v1alpha1"
+)
+
+// retryStatusInterval is the interval the operations being retried are reported at.
+const retryStatusInterval = 30 * time.Second
+
+// ReportRetryStatus reports the operations retried by the pod in the status of the EventSource, until the
+// context is done.
+func ReportRetryStatus(ctx context.Context, eventSource *v1alpha1.EventSource, dynamicClient dynamic.Interface, pod string) {
+	log := logging.FromContext(ctx)
+	client := dynamicClient.Resource(v1alpha1.SchemeGroupVersion.WithResource(eventsource.Plural)).Namespace(eventSource.Namespace)
+	common.ReportRetryStatuses(ctx, pod, retryStatusInterval, func(ctx context.Context, statuses []apicommon.RetryStatus) error {
+		return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+			obj, err := client.Get(ctx, eventSource.Name, metav1.GetOptions{})
+			if err != nil {
+				return err
+			}
+			es := &v1alpha1.EventSource{}
+			if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, es); err != nil {
+				return err
+			}
+			es.Status.Retries = common.MergeRetryStatuses(es.Status.Retries, pod, statuses)
+			un, err := runtime.DefaultUnstructuredConverter.ToUnstructured(es)
+			if err != nil {
+				return err
+			}
+			_, err = client.UpdateStatus(ctx, &unstructured.Unstructured{Object: un}, metav1.UpdateOptions{})
+			return err
+		})
+	}, func(err error) {
+		log.Warnw("failed to report the operations being retried in the status", zap.Error(err))
+	})
+}
@@ -70,7 +70,7 @@ func (el *EventListener) StartListening(ctx context.Context, dispatch func([]byt
 
 	amqpEventSource := &el.AMQPEventSource
 	var conn *amqplib.Connection
-	if err := common.DoWithRetry(amqpEventSource.ConnectionBackoff, func() error {
+	if err := common.DoWithTrackedRetry("eventsource.connect", el.GetEventSourceName()+"/"+el.GetEventName(), amqpEventSource.ConnectionBackoff, func() error {
 		c := amqplib.Config{
 			Heartbeat: 10 * time.Second,
 			Locale:    "en_US",
@@ -144,6 +144,7 @@ func (router *Router) HandleRoute(writer http.ResponseWriter, request *http.Requ
 		err = notification.verify()
 		if err != nil {
 			logger.Errorw("failed to verify sns message", zap.Error(err))
+			webhook.AuthFailed(request)
 			common.SendErrorResponse(writer, err.Error())
 			route.Metrics.EventProcessingFailed(route.EventSourceName, route.EventName)
 			return
@@ -184,7 +185,7 @@ func (router *Router) HandleRoute(writer http.ResponseWriter, request *http.Requ
 			route.Metrics.EventProcessingFailed(route.EventSourceName, route.EventName)
 			return
 		}
-		route.DataCh <- eventBytes
+		route.Deliver(request, eventBytes)
 	}
 
 	logger.Info("request has been successfully processed")
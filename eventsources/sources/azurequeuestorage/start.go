@@ -9,6 +9,7 @@ import (
 
 	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
 	"github.com/Azure/azure-sdk-for-go/sdk/storage/azqueue"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azqueue/queueerror"
 	"go.uber.org/zap"
 
 	"github.com/argoproj/argo-events/common"
@@ -85,11 +86,23 @@ func (el *EventListener) StartListening(ctx context.Context, dispatch func([]byt
 	}
 
 	queueClient := client.NewQueueClient(el.AzureQueueStorageEventSource.QueueName)
+	var poisonQueueClient *azqueue.QueueClient
+	if queueStorageEventSource.MaxDequeueCount > 0 {
+		poisonQueueName := queueStorageEventSource.GetPoisonQueueName()
+		poisonQueueClient = client.NewQueueClient(poisonQueueName)
+		if _, err := poisonQueueClient.Create(ctx, nil); err != nil && !queueerror.HasCode(err, queueerror.QueueAlreadyExists) {
+			// the poison queue may exist without the permission to create queues
+			log.With("poison-queue", poisonQueueName).Warnw("failed to create the poison queue", zap.Error(err))
+		}
+	}
 	if queueStorageEventSource.JSONBody {
 		log.Info("assuming all events have a json body...")
 	}
 	var numMessages int32 = 10
 	var visibilityTimeout int32 = 120
+	if el.AzureQueueStorageEventSource.VisibilityTimeoutInSeconds != nil {
+		visibilityTimeout = *el.AzureQueueStorageEventSource.VisibilityTimeoutInSeconds
+	}
 	var waitTime int32 = 3 // Defaults to 3 seconds
 	if el.AzureQueueStorageEventSource.WaitTimeInSeconds != nil {
 		waitTime = *el.AzureQueueStorageEventSource.WaitTimeInSeconds
@@ -113,6 +126,10 @@ func (el *EventListener) StartListening(ctx context.Context, dispatch func([]byt
 			continue
 		}
 		for _, m := range messages.Messages {
+			if el.isPoisonMessage(m) {
+				el.movePoisonMessage(ctx, m, queueClient, poisonQueueClient, log)
+				continue
+			}
 			el.processMessage(m, dispatch, func() {
 				_, err = queueClient.DeleteMessage(ctx, *m.MessageID, *m.PopReceipt, &azqueue.DeleteMessageOptions{})
 				if err != nil {
@@ -126,6 +143,34 @@ func (el *EventListener) StartListening(ctx context.Context, dispatch func([]byt
 	}
 }
 
+// queue is the subset of the operations of a queue client used to move the poison messages
+type queue interface {
+	EnqueueMessage(ctx context.Context, content string, o *azqueue.EnqueueMessageOptions) (azqueue.EnqueueMessagesResponse, error)
+	DeleteMessage(ctx context.Context, messageID string, popReceipt string, o *azqueue.DeleteMessageOptions) (azqueue.DeleteMessageResponse, error)
+}
+
+// isPoisonMessage returns true if the message has been dequeued more than the max dequeue count
+func (el *EventListener) isPoisonMessage(message *azqueue.DequeuedMessage) bool {
+	maxDequeueCount := el.AzureQueueStorageEventSource.MaxDequeueCount
+	return maxDequeueCount > 0 && message.DequeueCount != nil && *message.DequeueCount > int64(maxDequeueCount)
+}
+
+// movePoisonMessage enqueues the message as is on the poison queue, and deletes it from the queue. The message is
+// dequeued again after the visibility timeout if it fails to be enqueued.
+func (el *EventListener) movePoisonMessage(ctx context.Context, message *azqueue.DequeuedMessage, queueClient, poisonQueueClient queue, log *zap.SugaredLogger) {
+	log = log.With("message-id", *message.MessageID, "dequeue-count", *message.DequeueCount)
+	log.Warn("moving a poison message to the poison queue...")
+	el.Metrics.EventProcessingFailed(el.GetEventSourceName(), el.GetEventName())
+	var timeToLive int32 = -1
+	if _, err := poisonQueueClient.EnqueueMessage(ctx, *message.MessageText, &azqueue.EnqueueMessageOptions{TimeToLive: &timeToLive}); err != nil {
+		log.Errorw("failed to enqueue the message on the poison queue", zap.Error(err))
+		return
+	}
+	if _, err := queueClient.DeleteMessage(ctx, *message.MessageID, *message.PopReceipt, &azqueue.DeleteMessageOptions{}); err != nil {
+		log.Errorw("failed to delete the poison message", zap.Error(err))
+	}
+}
+
 func (el *EventListener) processMessage(message *azqueue.DequeuedMessage, dispatch func([]byte, ...eventsourcecommon.Option) error, ack func(), log *zap.SugaredLogger) {
 	defer func(start time.Time) {
 		el.Metrics.EventProcessingDuration(el.GetEventSourceName(), el.GetEventName(), float64(time.Since(start)/time.Millisecond))
@@ -0,0 +1,92 @@
+/*
+Copyright 2018 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package azurequeuestorage
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azqueue"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/argoproj/argo-events/common/logging"
+	"github.com/argoproj/argo-events/metrics"
+	"github.com/argoproj/argo-events/pkg/apis/eventsource/v1alpha1"
+)
+
+type fakeQueue struct {
+	enqueued   []string
+	deleted    []string
+	enqueueErr error
+}
+
+func (q *fakeQueue) EnqueueMessage(ctx context.Context, content string, o *azqueue.EnqueueMessageOptions) (azqueue.EnqueueMessagesResponse, error) {
+	if q.enqueueErr != nil {
+		return azqueue.EnqueueMessagesResponse{}, q.enqueueErr
+	}
+	q.enqueued = append(q.enqueued, content)
+	return azqueue.EnqueueMessagesResponse{}, nil
+}
+
+func (q *fakeQueue) DeleteMessage(ctx context.Context, messageID string, popReceipt string, o *azqueue.DeleteMessageOptions) (azqueue.DeleteMessageResponse, error) {
+	q.deleted = append(q.deleted, messageID)
+	return azqueue.DeleteMessageResponse{}, nil
+}
+
+func TestPoisonMessages(t *testing.T) {
+	el := &EventListener{
+		EventSourceName: "test",
+		EventName:       "example",
+		AzureQueueStorageEventSource: v1alpha1.AzureQueueStorageEventSource{
+			QueueName: "orders",
+		},
+		Metrics: metrics.NewMetrics("argo-events"),
+	}
+	message := &azqueue.DequeuedMessage{
+		MessageID:    to.Ptr("1"),
+		PopReceipt:   to.Ptr("receipt"),
+		MessageText:  to.Ptr("hello"),
+		DequeueCount: to.Ptr(int64(6)),
+	}
+
+	t.Run("no max dequeue count", func(t *testing.T) {
+		assert.False(t, el.isPoisonMessage(message))
+		assert.Equal(t, "orders-poison", el.AzureQueueStorageEventSource.GetPoisonQueueName())
+	})
+
+	el.AzureQueueStorageEventSource.MaxDequeueCount = 5
+
+	t.Run("below max dequeue count", func(t *testing.T) {
+		assert.False(t, el.isPoisonMessage(&azqueue.DequeuedMessage{DequeueCount: to.Ptr(int64(5))}))
+	})
+
+	t.Run("move poison message", func(t *testing.T) {
+		assert.True(t, el.isPoisonMessage(message))
+		queue, poisonQueue := &fakeQueue{}, &fakeQueue{}
+		el.movePoisonMessage(context.Background(), message, queue, poisonQueue, logging.NewArgoEventsLogger())
+		assert.Equal(t, []string{"hello"}, poisonQueue.enqueued)
+		assert.Equal(t, []string{"1"}, queue.deleted)
+	})
+
+	t.Run("keep message failing to be moved", func(t *testing.T) {
+		queue, poisonQueue := &fakeQueue{}, &fakeQueue{enqueueErr: fmt.Errorf("unavailable")}
+		el.movePoisonMessage(context.Background(), message, queue, poisonQueue, logging.NewArgoEventsLogger())
+		assert.Empty(t, queue.deleted)
+	})
+}
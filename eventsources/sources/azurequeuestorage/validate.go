@@ -39,5 +39,17 @@ func validate(eventSource *v1alpha1.AzureQueueStorageEventSource) error {
 	if eventSource.QueueName == "" {
 		return fmt.Errorf("must specify queue name")
 	}
+	if eventSource.VisibilityTimeoutInSeconds != nil && (*eventSource.VisibilityTimeoutInSeconds < 1 || *eventSource.VisibilityTimeoutInSeconds > 604800) {
+		return fmt.Errorf("visibilityTimeoutInSeconds must be between 1 and 604800")
+	}
+	if eventSource.MaxDequeueCount < 0 {
+		return fmt.Errorf("maxDequeueCount can't be negative")
+	}
+	if eventSource.PoisonQueueName != "" && eventSource.MaxDequeueCount == 0 {
+		return fmt.Errorf("poisonQueueName requires maxDequeueCount")
+	}
+	if eventSource.MaxDequeueCount > 0 && eventSource.GetPoisonQueueName() == eventSource.QueueName {
+		return fmt.Errorf("poisonQueueName must be different from queueName")
+	}
 	return nil
 }
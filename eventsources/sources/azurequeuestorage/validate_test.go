@@ -51,4 +51,13 @@ func TestValidateEventSource(t *testing.T) {
 		err := l.ValidateEventSource(context.Background())
 		assert.NoError(t, err)
 	}
+
+	invalid := v1alpha1.AzureQueueStorageEventSource{StorageAccountName: "account", QueueName: "orders", VisibilityTimeoutInSeconds: new(int32)}
+	assert.EqualError(t, validate(&invalid), "visibilityTimeoutInSeconds must be between 1 and 604800")
+
+	invalid = v1alpha1.AzureQueueStorageEventSource{StorageAccountName: "account", QueueName: "orders", PoisonQueueName: "failed"}
+	assert.EqualError(t, validate(&invalid), "poisonQueueName requires maxDequeueCount")
+
+	invalid = v1alpha1.AzureQueueStorageEventSource{StorageAccountName: "account", QueueName: "orders", MaxDequeueCount: 5, PoisonQueueName: "orders"}
+	assert.EqualError(t, validate(&invalid), "poisonQueueName must be different from queueName")
 }
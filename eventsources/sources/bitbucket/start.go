@@ -33,6 +33,7 @@ import (
 	"github.com/argoproj/argo-events/common"
 	"github.com/argoproj/argo-events/common/logging"
 	eventsourcecommon "github.com/argoproj/argo-events/eventsources/common"
+	"github.com/argoproj/argo-events/eventsources/common/scm"
 	"github.com/argoproj/argo-events/eventsources/common/webhook"
 	"github.com/argoproj/argo-events/eventsources/sources"
 	"github.com/argoproj/argo-events/pkg/apis/events"
@@ -90,6 +91,9 @@ func (router *Router) HandleRoute(writer http.ResponseWriter, request *http.Requ
 		Body:     (*json.RawMessage)(&body),
 		Metadata: router.bitbucketEventSource.Metadata,
 	}
+	if router.bitbucketEventSource.Normalize {
+		event.Normalized = scm.NormalizeBitbucket(request.Header, body)
+	}
 
 	eventBody, err := json.Marshal(event)
 	if err != nil {
@@ -99,7 +103,7 @@ func (router *Router) HandleRoute(writer http.ResponseWriter, request *http.Requ
 	}
 
 	logger.Info("dispatching event on route's data channel")
-	route.DataCh <- eventBody
+	route.Deliver(request, eventBody)
 
 	logger.Info("request successfully processed")
 	common.SendSuccessResponse(writer, "success")
@@ -22,6 +22,7 @@ import (
 	"crypto/sha256"
 	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"math/big"
@@ -38,6 +39,7 @@ import (
 	"github.com/argoproj/argo-events/common"
 	"github.com/argoproj/argo-events/common/logging"
 	eventsourcecommon "github.com/argoproj/argo-events/eventsources/common"
+	"github.com/argoproj/argo-events/eventsources/common/scm"
 	"github.com/argoproj/argo-events/eventsources/common/webhook"
 	"github.com/argoproj/argo-events/eventsources/sources"
 	"github.com/argoproj/argo-events/pkg/apis/events"
@@ -93,6 +95,9 @@ func (router *Router) HandleRoute(writer http.ResponseWriter, request *http.Requ
 	body, err := router.parseAndValidateBitbucketServerRequest(request)
 	if err != nil {
 		logger.Errorw("failed to parse/validate request", zap.Error(err))
+		if errors.Is(err, errInvalidSignature) {
+			webhook.AuthFailed(request)
+		}
 		common.SendErrorResponse(writer, err.Error())
 		route.Metrics.EventProcessingFailed(route.EventSourceName, route.EventName)
 		return
@@ -137,6 +142,9 @@ func (router *Router) HandleRoute(writer http.ResponseWriter, request *http.Requ
 		Body:     (*json.RawMessage)(&body),
 		Metadata: router.bitbucketserverEventSource.Metadata,
 	}
+	if router.bitbucketserverEventSource.Normalize {
+		event.Normalized = scm.NormalizeBitbucketServer(request.Header, body)
+	}
 
 	eventBody, err := json.Marshal(event)
 	if err != nil {
@@ -147,7 +155,7 @@ func (router *Router) HandleRoute(writer http.ResponseWriter, request *http.Requ
 	}
 
 	logger.Info("dispatching event on route's data channel")
-	route.DataCh <- eventBody
+	route.Deliver(request, eventBody)
 
 	logger.Info("request successfully processed")
 	common.SendSuccessResponse(writer, "success")
@@ -272,13 +280,16 @@ func (el *EventListener) StartListening(ctx context.Context, dispatch func([]byt
 		router.hookSecret = webhookSecret
 	}
 
-	applyWebhooks := func() {
+	// applyWebhooks applies the webhooks, it returns the errors of the ones failing to be applied.
+	applyWebhooks := func() error {
+		var errs []error
 		bitbucketRepositories := bitbucketserverEventSource.GetBitbucketServerRepositories()
 
 		if len(bitbucketserverEventSource.Projects) > 0 {
 			bitbucketProjectRepositories, err := getProjectRepositories(router.client, bitbucketserverEventSource.Projects)
 			if err != nil {
 				logger.Errorw("failed to apply Bitbucket webhook", zap.Error(err))
+				errs = append(errs, fmt.Errorf("failed to get the repositories of the projects, %w", err))
 			}
 
 			bitbucketRepositories = append(bitbucketRepositories, bitbucketProjectRepositories...)
@@ -288,18 +299,23 @@ func (el *EventListener) StartListening(ctx context.Context, dispatch func([]byt
 			if err = router.applyBitbucketServerWebhook(repo); err != nil {
 				logger.Errorw("failed to apply Bitbucket webhook",
 					zap.String("project-key", repo.ProjectKey), zap.String("repository-slug", repo.RepositorySlug), zap.Error(err))
+				errs = append(errs, fmt.Errorf("failed to apply the webhook of %s/%s, %w", repo.ProjectKey, repo.RepositorySlug, err))
 				continue
 			}
 
 			time.Sleep(500 * time.Millisecond)
 		}
+		return errors.Join(errs...)
 	}
 
 	// When running multiple replicas of this event source, they will try to create webhooks at the same time.
 	// Randomly delay running the initial apply webhooks func to mitigate the issue.
 	randomNum, _ := rand.Int(rand.Reader, big.NewInt(int64(2000)))
 	time.Sleep(time.Duration(randomNum.Int64()) * time.Millisecond)
-	applyWebhooks()
+	target := el.GetEventSourceName() + "/" + el.GetEventName()
+	if err := common.DoWithTrackedRetry("eventsource.register", target, nil, applyWebhooks); err != nil {
+		logger.Errorw("failed to apply the webhooks, retrying with the hooks manager daemon", zap.Error(err))
+	}
 
 	var checkInterval time.Duration
 	if bitbucketserverEventSource.CheckInterval == "" {
@@ -316,18 +332,8 @@ func (el *EventListener) StartListening(ctx context.Context, dispatch func([]byt
 		// and old pod terminates, if DeleteHookOnFinish is true, the hook will be deleted from Bitbucket.
 		// This is a workaround to mitigate the race conditions.
 		logger.Info("starting bitbucket hooks manager daemon")
-
-		ticker := time.NewTicker(checkInterval)
-		defer ticker.Stop()
-		for {
-			select {
-			case <-ctx.Done():
-				logger.Info("exiting bitbucket hooks manager daemon")
-				return
-			case <-ticker.C:
-				applyWebhooks()
-			}
-		}
+		common.PollWithTrackedRetry(ctx, "eventsource.register", target, checkInterval, applyWebhooks)
+		logger.Info("exiting bitbucket hooks manager daemon")
 	}()
 
 	return webhook.ManageRoute(ctx, router, controller, dispatch)
@@ -474,6 +480,9 @@ func (router *Router) createRequestBodyFromWebhook(hook bitbucketv1.Webhook) ([]
 	return requestBody, nil
 }
 
+// errInvalidSignature is the error of the requests whose signature is missing or does not match the secret.
+var errInvalidSignature = errors.New("invalid signature")
+
 func (router *Router) parseAndValidateBitbucketServerRequest(request *http.Request) ([]byte, error) {
 	body, err := io.ReadAll(request.Body)
 	if err != nil {
@@ -483,7 +492,7 @@ func (router *Router) parseAndValidateBitbucketServerRequest(request *http.Reque
 	if len(router.hookSecret) != 0 {
 		signature := request.Header.Get("X-Hub-Signature")
 		if len(signature) == 0 {
-			return nil, fmt.Errorf("missing signature header")
+			return nil, fmt.Errorf("%w, missing signature header", errInvalidSignature)
 		}
 
 		mac := hmac.New(sha256.New, []byte(router.hookSecret))
@@ -491,7 +500,7 @@ func (router *Router) parseAndValidateBitbucketServerRequest(request *http.Reque
 		expectedMAC := hex.EncodeToString(mac.Sum(nil))
 
 		if !hmac.Equal([]byte(signature[7:]), []byte(expectedMAC)) {
-			return nil, fmt.Errorf("hmac verification failed")
+			return nil, fmt.Errorf("%w, hmac verification failed", errInvalidSignature)
 		}
 	}
 
@@ -0,0 +1,180 @@
+/*
+Copyright 2020 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package calendar
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	// The timezone database is embedded, for the images without one
+	_ "time/tzdata"
+
+	cronlib "github.com/robfig/cron/v3"
+
+	"github.com/argoproj/argo-events/common"
+	"github.com/argoproj/argo-events/pkg/apis/eventsource/v1alpha1"
+)
+
+// maxDSTShift bounds the offset change of a daylight saving time transition
+const maxDSTShift = 2 * time.Hour
+
+// dstSchedule is a cron schedule which handles the times affected by the daylight saving time
+// transitions of its location according to a policy.
+type dstSchedule struct {
+	// wall computes the wall clock times of the schedule, as UTC times
+	wall     cronlib.Schedule
+	location *time.Location
+	policy   v1alpha1.CalendarDSTPolicy
+}
+
+func newDSTSchedule(schedule *cronlib.SpecSchedule, location *time.Location, policy v1alpha1.CalendarDSTPolicy) *dstSchedule {
+	if schedule.Location != time.Local {
+		// CRON_TZ in the schedule
+		location = schedule.Location
+	}
+	wall := *schedule
+	wall.Location = time.UTC
+	return &dstSchedule{wall: &wall, location: location, policy: policy}
+}
+
+// wallClock returns the wall clock of t in the location of the schedule, as a UTC time.
+func (s *dstSchedule) wallClock(t time.Time) time.Time {
+	t = t.In(s.location)
+	return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), time.UTC)
+}
+
+// instants returns the instants of a wall clock time in the location of the schedule, none if the
+// time is skipped by a transition, and two if it is repeated by a transition.
+func (s *dstSchedule) instants(wall time.Time) []time.Time {
+	var instants []time.Time
+	for _, probe := range []time.Time{wall.Add(-24 * time.Hour), wall, wall.Add(24 * time.Hour)} {
+		_, offset := probe.In(s.location).Zone()
+		t := wall.Add(-time.Duration(offset) * time.Second).In(s.location)
+		if !s.wallClock(t).Equal(wall) {
+			continue
+		}
+		found := false
+		for _, i := range instants {
+			found = found || i.Equal(t)
+		}
+		if !found {
+			instants = append(instants, t)
+		}
+	}
+	sort.Slice(instants, func(i, j int) bool { return instants[i].Before(instants[j]) })
+	return instants
+}
+
+// fireTimes returns the times the schedule fires for a wall clock time.
+func (s *dstSchedule) fireTimes(wall time.Time) []time.Time {
+	instants := s.instants(wall)
+	switch {
+	case len(instants) == 0 && s.policy != v1alpha1.CalendarDSTPolicySkip:
+		// Shifted by the offset change, with the offset before the transition
+		_, offset := wall.Add(-24 * time.Hour).In(s.location).Zone()
+		return []time.Time{wall.Add(-time.Duration(offset) * time.Second).In(s.location)}
+	case len(instants) > 1 && s.policy == v1alpha1.CalendarDSTPolicySkip:
+		return nil
+	case len(instants) > 1 && s.policy == v1alpha1.CalendarDSTPolicyFireOnce:
+		return instants[:1]
+	}
+	return instants
+}
+
+// Next returns the next time the schedule fires after t.
+func (s *dstSchedule) Next(t time.Time) time.Time {
+	var next time.Time
+	// The wall clock times are computed from before t, as the ones repeated by a transition
+	// can fire after t.
+	wall := s.wallClock(t).Add(-maxDSTShift)
+	for {
+		wall = s.wall.Next(wall)
+		if wall.IsZero() || (!next.IsZero() && wall.Sub(s.wallClock(next)) > maxDSTShift) {
+			break
+		}
+		for _, ft := range s.fireTimes(wall) {
+			if ft.After(t) && (next.IsZero() || ft.Before(next)) {
+				next = ft
+			}
+		}
+	}
+	if next.IsZero() {
+		return next
+	}
+	return next.In(t.Location())
+}
+
+// loadLocation returns the location of the calendar, nil if no timezone is specified.
+func loadLocation(cal *v1alpha1.CalendarEventSource) (*time.Location, error) {
+	if cal.Timezone == "" {
+		return nil, nil
+	}
+	location, err := time.LoadLocation(cal.Timezone)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load location %s, %w", cal.Timezone, err)
+	}
+	return location, nil
+}
+
+// newNext returns the function computing the next event time of the schedule, skipping the exclusion dates.
+func newNext(schedule cronlib.Schedule, exDates []time.Time) Next {
+	var next Next
+	next = func(last time.Time) time.Time {
+		nextT := schedule.Next(last)
+		nextYear := nextT.Year()
+		nextMonth := nextT.Month()
+		nextDay := nextT.Day()
+		for _, exDate := range exDates {
+			// if exDate == nextEvent, then we need to skip this and get the next
+			if exDate.Year() == nextYear && exDate.Month() == nextMonth && exDate.Day() == nextDay {
+				return next(nextT)
+			}
+		}
+		return nextT
+	}
+	return next
+}
+
+// NextFireTimes returns the next n times the schedule of the calendar fires after the given time, in its timezone.
+// It returns none for intervals, which are relative to the start of the event source.
+func NextFireTimes(cal *v1alpha1.CalendarEventSource, from time.Time, n int) ([]time.Time, error) {
+	if cal.Schedule == "" {
+		return nil, nil
+	}
+	schedule, err := resolveSchedule(cal)
+	if err != nil {
+		return nil, err
+	}
+	exDates, err := common.ParseExclusionDates(cal.ExclusionDates)
+	if err != nil {
+		return nil, err
+	}
+	location, err := loadLocation(cal)
+	if err != nil {
+		return nil, err
+	}
+	if location != nil {
+		from = from.In(location)
+	}
+	next := newNext(schedule, exDates)
+	var times []time.Time
+	for t := next(from); !t.IsZero() && len(times) < n; t = next(t) {
+		times = append(times, t)
+	}
+	return times, nil
+}
@@ -0,0 +1,82 @@
+/*
+Copyright 2020 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package calendar
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/argoproj/argo-events/pkg/apis/eventsource/v1alpha1"
+)
+
+func utcTimes(times []time.Time) []string {
+	var result []string
+	for _, t := range times {
+		result = append(result, t.UTC().Format(time.RFC3339))
+	}
+	return result
+}
+
+func TestNextFireTimesDST(t *testing.T) {
+	// America/New_York skips 02:00-03:00 on 2024-03-10, and repeats 01:00-02:00 on 2024-11-03
+	springForward := time.Date(2024, 3, 9, 12, 0, 0, 0, time.UTC)
+	fallBack := time.Date(2024, 11, 2, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		schedule string
+		policy   v1alpha1.CalendarDSTPolicy
+		from     time.Time
+		expected []string
+	}{
+		{"skipped time, no policy", "30 2 * * *", "", springForward, []string{"2024-03-11T06:30:00Z"}},
+		{"skipped time, Skip", "30 2 * * *", v1alpha1.CalendarDSTPolicySkip, springForward, []string{"2024-03-11T06:30:00Z"}},
+		{"skipped time, FireOnce", "30 2 * * *", v1alpha1.CalendarDSTPolicyFireOnce, springForward, []string{"2024-03-10T07:30:00Z"}},
+		{"skipped time, FireTwice", "30 2 * * *", v1alpha1.CalendarDSTPolicyFireTwice, springForward, []string{"2024-03-10T07:30:00Z"}},
+		{"repeated time, no policy", "30 1 * * *", "", fallBack, []string{"2024-11-03T05:30:00Z", "2024-11-03T06:30:00Z"}},
+		{"repeated time, Skip", "30 1 * * *", v1alpha1.CalendarDSTPolicySkip, fallBack, []string{"2024-11-04T06:30:00Z"}},
+		{"repeated time, FireOnce", "30 1 * * *", v1alpha1.CalendarDSTPolicyFireOnce, fallBack, []string{"2024-11-03T05:30:00Z", "2024-11-04T06:30:00Z"}},
+		{"repeated time, FireTwice", "30 1 * * *", v1alpha1.CalendarDSTPolicyFireTwice, fallBack, []string{"2024-11-03T05:30:00Z", "2024-11-03T06:30:00Z"}},
+		{"unaffected time", "0 12 * * *", v1alpha1.CalendarDSTPolicyFireOnce, fallBack, []string{"2024-11-02T16:00:00Z", "2024-11-03T17:00:00Z"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cal := &v1alpha1.CalendarEventSource{Schedule: tt.schedule, Timezone: "America/New_York", DSTPolicy: tt.policy}
+			assert.NoError(t, validate(cal))
+			times, err := NextFireTimes(cal, tt.from, len(tt.expected))
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, utcTimes(times))
+		})
+	}
+}
+
+func TestNextFireTimes(t *testing.T) {
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	times, err := NextFireTimes(&v1alpha1.CalendarEventSource{
+		Schedule:       "0 9 * * *",
+		Timezone:       "Europe/Paris",
+		ExclusionDates: []string{"EXDATE:20240102T000000Z"},
+	}, from, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"2024-01-01T08:00:00Z", "2024-01-03T08:00:00Z"}, utcTimes(times))
+
+	times, err = NextFireTimes(&v1alpha1.CalendarEventSource{Interval: "1h"}, from, 2)
+	assert.NoError(t, err)
+	assert.Empty(t, times)
+}
@@ -161,33 +161,19 @@ func (el *EventListener) StartListening(ctx context.Context, dispatch func([]byt
 		el.log.Info("Persistence not enabled")
 	}
 
-	var next Next
-	next = func(last time.Time) time.Time {
-		nextT := schedule.Next(last)
-		nextYear := nextT.Year()
-		nextMonth := nextT.Month()
-		nextDay := nextT.Day()
-		for _, exDate := range exDates {
-			// if exDate == nextEvent, then we need to skip this and get the next
-			if exDate.Year() == nextYear && exDate.Month() == nextMonth && exDate.Day() == nextDay {
-				return next(nextT)
-			}
-		}
-		return nextT
-	}
+	next := newNext(schedule, exDates)
 
 	lastT, err := el.getExecutionTime()
 	if err != nil {
 		return err
 	}
 
-	var location *time.Location
-	if calendarEventSource.Timezone != "" {
-		el.log.Infow("loading location for the schedule...", zap.Any("location", calendarEventSource.Timezone))
-		location, err = time.LoadLocation(calendarEventSource.Timezone)
-		if err != nil {
-			return fmt.Errorf("failed to load location for event source %s / %s, , %w", el.GetEventSourceName(), el.GetEventName(), err)
-		}
+	location, err := loadLocation(calendarEventSource)
+	if err != nil {
+		return fmt.Errorf("failed to load location for event source %s / %s, , %w", el.GetEventSourceName(), el.GetEventName(), err)
+	}
+	if location != nil {
+		el.log.Infow("loaded location for the schedule", zap.Any("location", calendarEventSource.Timezone))
 		lastT = lastT.In(location)
 	}
 	sendEventFunc := func(tx time.Time) error {
@@ -279,6 +265,16 @@ func resolveSchedule(cal *v1alpha1.CalendarEventSource) (cronlib.Schedule, error
 		if err != nil {
 			return nil, fmt.Errorf("failed to parse schedule %s from calendar event. Cause: %w", cal.Schedule, err)
 		}
+		if spec, ok := schedule.(*cronlib.SpecSchedule); ok && cal.DSTPolicy != "" {
+			location, err := loadLocation(cal)
+			if err != nil {
+				return nil, err
+			}
+			if location == nil {
+				location = time.Local
+			}
+			return newDSTSchedule(spec, location, cal.DSTPolicy), nil
+		}
 		return schedule, nil
 	}
 	if cal.Interval != "" {
@@ -36,6 +36,18 @@ func validate(calendarEventSource *v1alpha1.CalendarEventSource) error {
 	if calendarEventSource.Schedule == "" && calendarEventSource.Interval == "" {
 		return fmt.Errorf("must have either schedule or interval")
 	}
+	if _, err := loadLocation(calendarEventSource); err != nil {
+		return err
+	}
+	switch calendarEventSource.DSTPolicy {
+	case "", v1alpha1.CalendarDSTPolicySkip, v1alpha1.CalendarDSTPolicyFireOnce, v1alpha1.CalendarDSTPolicyFireTwice:
+	default:
+		return fmt.Errorf("invalid dstPolicy %q, it should be one of %s, %s or %s", calendarEventSource.DSTPolicy,
+			v1alpha1.CalendarDSTPolicySkip, v1alpha1.CalendarDSTPolicyFireOnce, v1alpha1.CalendarDSTPolicyFireTwice)
+	}
+	if calendarEventSource.DSTPolicy != "" && calendarEventSource.Schedule == "" {
+		return fmt.Errorf("dstPolicy is only applicable to schedules")
+	}
 	if _, err := resolveSchedule(calendarEventSource); err != nil {
 		return err
 	}
@@ -54,4 +54,8 @@ func TestValidateEventSource(t *testing.T) {
 		err = l.ValidateEventSource(context.Background())
 		assert.NoError(t, err)
 	}
+
+	assert.ErrorContains(t, validate(&v1alpha1.CalendarEventSource{Schedule: "* * * * *", Timezone: "Mars/Olympus"}), "failed to load location")
+	assert.ErrorContains(t, validate(&v1alpha1.CalendarEventSource{Schedule: "* * * * *", DSTPolicy: "Always"}), "invalid dstPolicy")
+	assert.ErrorContains(t, validate(&v1alpha1.CalendarEventSource{Interval: "1h", DSTPolicy: v1alpha1.CalendarDSTPolicySkip}), "only applicable to schedules")
 }
@@ -0,0 +1,490 @@
+/*
+Copyright 2020 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dynamodbstreams
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	streams "github.com/aws/aws-sdk-go/service/dynamodbstreams"
+	"github.com/aws/aws-sdk-go/service/dynamodbstreams/dynamodbstreamsiface"
+	"go.uber.org/zap"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/argoproj/argo-events/common"
+	"github.com/argoproj/argo-events/common/logging"
+	eventsourcecommon "github.com/argoproj/argo-events/eventsources/common"
+	commonaws "github.com/argoproj/argo-events/eventsources/common/aws"
+	"github.com/argoproj/argo-events/eventsources/persist"
+	"github.com/argoproj/argo-events/eventsources/sources"
+	metrics "github.com/argoproj/argo-events/metrics"
+	apicommon "github.com/argoproj/argo-events/pkg/apis/common"
+	"github.com/argoproj/argo-events/pkg/apis/events"
+	"github.com/argoproj/argo-events/pkg/apis/eventsource/v1alpha1"
+)
+
+const (
+	defaultPollInterval = time.Second
+	// maxRecords is the maximum number of records read from a shard by a poll
+	maxRecords = 1000
+)
+
+// EventListener implements Eventing for the DynamoDB Streams event source
+type EventListener struct {
+	EventSourceName string
+	EventName       string
+	EventSource     v1alpha1.DynamoDBStreamsEventSource
+	Namespace       string
+	Metrics         *metrics.Metrics
+}
+
+// GetEventSourceName returns name of event source
+func (el *EventListener) GetEventSourceName() string {
+	return el.EventSourceName
+}
+
+// GetEventName returns name of event
+func (el *EventListener) GetEventName() string {
+	return el.EventName
+}
+
+// GetEventSourceType return type of event server
+func (el *EventListener) GetEventSourceType() apicommon.EventSourceType {
+	return apicommon.DynamoDBStreamsEvent
+}
+
+// StartListening polls the shards of the stream for the change records
+func (el *EventListener) StartListening(ctx context.Context, dispatch func([]byte, ...eventsourcecommon.Option) error) error {
+	log := logging.FromContext(ctx).
+		With(logging.LabelEventSourceType, el.GetEventSourceType(), logging.LabelEventName, el.GetEventName())
+	log.Info("started processing the DynamoDB Streams event source...")
+	defer sources.Recover(el.GetEventName())
+
+	streamsEventSource := &el.EventSource
+	pollInterval := defaultPollInterval
+	if streamsEventSource.PollInterval != "" {
+		var err error
+		if pollInterval, err = time.ParseDuration(streamsEventSource.PollInterval); err != nil {
+			return fmt.Errorf("failed to parse the poll interval, %w", err)
+		}
+	}
+
+	awsSession, err := commonaws.CreateAWSSessionWithCredsInVolume(streamsEventSource.Region, streamsEventSource.RoleARN, streamsEventSource.AccessKey, streamsEventSource.SecretKey, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create aws session, %w", err)
+	}
+	config := &aws.Config{Region: &streamsEventSource.Region}
+	if streamsEventSource.Endpoint != "" {
+		config.Endpoint = &streamsEventSource.Endpoint
+	}
+	client := streams.New(awsSession, config)
+
+	streamARN, err := resolveStreamARN(ctx, client, streamsEventSource)
+	if err != nil {
+		return err
+	}
+
+	var checkpointPersistence persist.EventPersist = &persist.NullPersistence{}
+	if streamsEventSource.Persistence != nil {
+		if checkpointPersistence, err = newConfigMapPersistence(ctx, streamsEventSource.Persistence, el.Namespace); err != nil {
+			return fmt.Errorf("failed to initialize the checkpoint persistence, %w", err)
+		}
+	}
+	checkpoints, err := el.loadCheckpoints(checkpointPersistence)
+	if err != nil {
+		return err
+	}
+
+	consumer := newStreamConsumer(client, streamARN, streamsEventSource.StartingPosition, checkpoints)
+	log.Infow("polling the shards of the stream...", zap.String("streamARN", streamARN))
+
+	// The failed polls are tracked as the retries of the poll until one succeeds
+	common.PollWithTrackedRetry(ctx, "eventsource.poll", el.GetEventSourceName()+"/"+el.GetEventName(), pollInterval, func() error {
+		changed, err := consumer.poll(ctx, func(shardID string, record *streams.Record) error {
+			return el.handleOne(streamARN, shardID, record, dispatch, log)
+		})
+		if err != nil {
+			log.Errorw("failed to poll the shards of the stream", zap.Error(err))
+		}
+		if changed {
+			if err := el.saveCheckpoints(checkpointPersistence, consumer.checkpoints); err != nil {
+				log.Errorw("failed to persist the checkpoints", zap.Error(err))
+			}
+		}
+		return err
+	})
+	log.Info("event source is stopped")
+	return nil
+}
+
+func newConfigMapPersistence(ctx context.Context, configMap *v1alpha1.ConfigMapPersistence, namespace string) (persist.EventPersist, error) {
+	kubeConfig, _ := os.LookupEnv(common.EnvVarKubeConfig)
+	restConfig, err := common.GetClientConfig(kubeConfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get a K8s rest config, %w", err)
+	}
+	kubeClientset, err := kubernetes.NewForConfig(restConfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to set up a K8s client, %w", err)
+	}
+	return persist.NewConfigMapPersist(ctx, kubeClientset, configMap, namespace)
+}
+
+func (el *EventListener) persistenceKey() string {
+	return fmt.Sprintf("%s.%s", el.EventSourceName, el.EventName)
+}
+
+// loadCheckpoints returns the persisted sequence numbers of the last emitted records, keyed by shard ID.
+func (el *EventListener) loadCheckpoints(checkpointPersistence persist.EventPersist) (map[string]string, error) {
+	checkpoints := map[string]string{}
+	persisted, err := checkpointPersistence.Get(el.persistenceKey())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the persisted checkpoints, %w", err)
+	}
+	if persisted == nil {
+		return checkpoints, nil
+	}
+	if err := json.Unmarshal([]byte(persisted.EventPayload), &checkpoints); err != nil {
+		return nil, fmt.Errorf("failed to parse the persisted checkpoints, %w", err)
+	}
+	return checkpoints, nil
+}
+
+func (el *EventListener) saveCheckpoints(checkpointPersistence persist.EventPersist, checkpoints map[string]string) error {
+	b, err := json.Marshal(checkpoints)
+	if err != nil {
+		return err
+	}
+	return checkpointPersistence.Save(&persist.Event{EventKey: el.persistenceKey(), EventPayload: string(b)})
+}
+
+// resolveStreamARN returns the ARN of the stream of the event source, the latest stream of the table if the
+// ARN is not specified.
+func resolveStreamARN(ctx context.Context, client dynamodbstreamsiface.DynamoDBStreamsAPI, eventSource *v1alpha1.DynamoDBStreamsEventSource) (string, error) {
+	if eventSource.StreamARN != "" {
+		return eventSource.StreamARN, nil
+	}
+	var latest *streams.Stream
+	input := &streams.ListStreamsInput{TableName: &eventSource.Table}
+	for {
+		output, err := client.ListStreamsWithContext(ctx, input)
+		if err != nil {
+			return "", fmt.Errorf("failed to list the streams of the table %s, %w", eventSource.Table, err)
+		}
+		for _, stream := range output.Streams {
+			// The stream labels are the timestamps of the streams
+			if latest == nil || aws.StringValue(stream.StreamLabel) > aws.StringValue(latest.StreamLabel) {
+				latest = stream
+			}
+		}
+		if output.LastEvaluatedStreamArn == nil {
+			break
+		}
+		input.ExclusiveStartStreamArn = output.LastEvaluatedStreamArn
+	}
+	if latest == nil {
+		return "", fmt.Errorf("no stream is enabled on the table %s", eventSource.Table)
+	}
+	return aws.StringValue(latest.StreamArn), nil
+}
+
+func (el *EventListener) handleOne(streamARN, shardID string, record *streams.Record, dispatch func([]byte, ...eventsourcecommon.Option) error, log *zap.SugaredLogger) error {
+	defer func(start time.Time) {
+		el.Metrics.EventProcessingDuration(el.GetEventSourceName(), el.GetEventName(), float64(time.Since(start)/time.Millisecond))
+	}(time.Now())
+
+	if record.Dynamodb == nil {
+		return fmt.Errorf("record %s has no change", aws.StringValue(record.EventID))
+	}
+	log.Infow("received a record", zap.String("shardID", shardID), zap.String("sequenceNumber", aws.StringValue(record.Dynamodb.SequenceNumber)))
+	eventData := &events.DynamoDBStreamsEventData{
+		EventID:                     aws.StringValue(record.EventID),
+		EventName:                   aws.StringValue(record.EventName),
+		StreamARN:                   streamARN,
+		ShardID:                     shardID,
+		SequenceNumber:              aws.StringValue(record.Dynamodb.SequenceNumber),
+		ApproximateCreationDateTime: aws.TimeValue(record.Dynamodb.ApproximateCreationDateTime),
+		Keys:                        attributeValues(record.Dynamodb.Keys),
+		Metadata:                    el.EventSource.Metadata,
+	}
+	images := el.EventSource.Images
+	if images == "" {
+		images = v1alpha1.DynamoDBStreamsNewAndOldImages
+	}
+	if images != v1alpha1.DynamoDBStreamsOldImage {
+		eventData.NewImage = attributeValues(record.Dynamodb.NewImage)
+	}
+	if images != v1alpha1.DynamoDBStreamsNewImage {
+		eventData.OldImage = attributeValues(record.Dynamodb.OldImage)
+	}
+	eventBody, err := json.Marshal(eventData)
+	if err != nil {
+		return fmt.Errorf("failed to marshal the event data, rejecting the event, %w", err)
+	}
+	if err = dispatch(eventBody); err != nil {
+		return fmt.Errorf("failed to dispatch a DynamoDB Streams event, %w", err)
+	}
+	return nil
+}
+
+// attributeValues converts the attributes of an item to their JSON values, the numbers are kept as JSON numbers.
+func attributeValues(item map[string]*dynamodb.AttributeValue) map[string]interface{} {
+	if item == nil {
+		return nil
+	}
+	result := make(map[string]interface{}, len(item))
+	for name, value := range item {
+		result[name] = attributeValue(value)
+	}
+	return result
+}
+
+func attributeValue(value *dynamodb.AttributeValue) interface{} {
+	switch {
+	case value == nil:
+		return nil
+	case value.S != nil:
+		return *value.S
+	case value.N != nil:
+		return json.Number(*value.N)
+	case value.B != nil:
+		return value.B
+	case value.BOOL != nil:
+		return *value.BOOL
+	case value.NULL != nil:
+		return nil
+	case value.SS != nil:
+		return aws.StringValueSlice(value.SS)
+	case value.NS != nil:
+		numbers := make([]json.Number, 0, len(value.NS))
+		for _, n := range value.NS {
+			numbers = append(numbers, json.Number(aws.StringValue(n)))
+		}
+		return numbers
+	case value.BS != nil:
+		return value.BS
+	case value.L != nil:
+		list := make([]interface{}, 0, len(value.L))
+		for _, v := range value.L {
+			list = append(list, attributeValue(v))
+		}
+		return list
+	case value.M != nil:
+		return attributeValues(value.M)
+	default:
+		return nil
+	}
+}
+
+// shard is the state of the consumption of a shard of the stream
+type shard struct {
+	parentID string
+	// iterator is the iterator of the next records, it is requested again from the checkpoint when empty
+	iterator string
+	// fromStart reads the shard from its start when it has no checkpoint, for the shards created after the
+	// consumer started and the children of the shards which are read
+	fromStart bool
+	// finished is true when the shard is closed and all its records have been read
+	finished bool
+}
+
+// streamConsumer reads the records of the shards of a stream, the child shards are read after their parents
+// are finished so that the changes of an item are emitted in order.
+type streamConsumer struct {
+	client           dynamodbstreamsiface.DynamoDBStreamsAPI
+	streamARN        string
+	startingPosition string
+	shards           map[string]*shard
+	// checkpoints are the sequence numbers of the last emitted records, keyed by shard ID
+	checkpoints map[string]string
+	initialized bool
+}
+
+func newStreamConsumer(client dynamodbstreamsiface.DynamoDBStreamsAPI, streamARN, startingPosition string, checkpoints map[string]string) *streamConsumer {
+	if startingPosition == "" {
+		startingPosition = streams.ShardIteratorTypeLatest
+	}
+	return &streamConsumer{
+		client:           client,
+		streamARN:        streamARN,
+		startingPosition: startingPosition,
+		shards:           map[string]*shard{},
+		checkpoints:      checkpoints,
+	}
+}
+
+// poll reads the next records of the shards ready to be read, and returns whether the checkpoints changed.
+func (c *streamConsumer) poll(ctx context.Context, handle func(shardID string, record *streams.Record) error) (bool, error) {
+	shards, err := c.listShards(ctx)
+	if err != nil {
+		return false, err
+	}
+	changed := false
+	present := make(map[string]bool, len(shards))
+	for _, s := range shards {
+		id := aws.StringValue(s.ShardId)
+		present[id] = true
+		if _, ok := c.shards[id]; !ok {
+			parentID := aws.StringValue(s.ParentShardId)
+			c.shards[id] = &shard{parentID: parentID, fromStart: c.initialized || c.readsParent(parentID)}
+		}
+	}
+	c.initialized = true
+	// The shards are trimmed from the stream after 24 hours
+	for id := range c.shards {
+		if !present[id] {
+			delete(c.shards, id)
+		}
+	}
+	for id := range c.checkpoints {
+		if !present[id] {
+			delete(c.checkpoints, id)
+			changed = true
+		}
+	}
+
+	ids := make([]string, 0, len(c.shards))
+	for id := range c.shards {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	var errs []error
+	for _, id := range ids {
+		s := c.shards[id]
+		if s.finished {
+			continue
+		}
+		if parent, ok := c.shards[s.parentID]; ok && !parent.finished {
+			continue
+		}
+		read, err := c.readShard(ctx, id, s, handle)
+		changed = changed || read
+		if err != nil {
+			errs = append(errs, fmt.Errorf("shard %s: %w", id, err))
+		}
+	}
+	return changed, errors.Join(errs...)
+}
+
+// readsParent returns whether the parent of a shard is read, from a checkpoint or from its start, the shards
+// are listed after their parents.
+func (c *streamConsumer) readsParent(parentID string) bool {
+	if _, ok := c.checkpoints[parentID]; ok {
+		return true
+	}
+	parent, ok := c.shards[parentID]
+	return ok && parent.fromStart
+}
+
+// readShard reads the next records of the shard, and returns whether records were emitted.
+func (c *streamConsumer) readShard(ctx context.Context, id string, s *shard, handle func(shardID string, record *streams.Record) error) (bool, error) {
+	if s.iterator == "" {
+		iterator, err := c.shardIterator(ctx, id, s)
+		if err != nil {
+			return false, err
+		}
+		s.iterator = iterator
+	}
+	output, err := c.client.GetRecordsWithContext(ctx, &streams.GetRecordsInput{
+		ShardIterator: &s.iterator,
+		Limit:         aws.Int64(maxRecords),
+	})
+	if err != nil {
+		var awsErr awserr.Error
+		if errors.As(err, &awsErr) && awsErr.Code() == streams.ErrCodeExpiredIteratorException {
+			s.iterator = ""
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to get the records, %w", err)
+	}
+	read := false
+	for _, record := range output.Records {
+		if err := handle(id, record); err != nil {
+			// The records are read again from the checkpoint, or with the same iterator if none was emitted
+			if read {
+				s.iterator = ""
+			}
+			return read, err
+		}
+		c.checkpoints[id] = aws.StringValue(record.Dynamodb.SequenceNumber)
+		read = true
+	}
+	s.iterator = aws.StringValue(output.NextShardIterator)
+	if s.iterator == "" {
+		s.finished = true
+	}
+	return read, nil
+}
+
+// shardIterator returns the iterator of the records after the checkpoint of the shard, or of the records from
+// the starting position if the shard has no checkpoint.
+func (c *streamConsumer) shardIterator(ctx context.Context, id string, s *shard) (string, error) {
+	input := &streams.GetShardIteratorInput{
+		StreamArn:         &c.streamARN,
+		ShardId:           &id,
+		ShardIteratorType: aws.String(c.startingPosition),
+	}
+	if s.fromStart {
+		input.ShardIteratorType = aws.String(streams.ShardIteratorTypeTrimHorizon)
+	}
+	if checkpoint, ok := c.checkpoints[id]; ok {
+		input.ShardIteratorType = aws.String(streams.ShardIteratorTypeAfterSequenceNumber)
+		input.SequenceNumber = aws.String(checkpoint)
+	}
+	output, err := c.client.GetShardIteratorWithContext(ctx, input)
+	if err != nil {
+		var awsErr awserr.Error
+		if input.SequenceNumber == nil || !errors.As(err, &awsErr) || awsErr.Code() != streams.ErrCodeTrimmedDataAccessException {
+			return "", fmt.Errorf("failed to get the shard iterator, %w", err)
+		}
+		// The records after the checkpoint have been trimmed, the shard is read from its oldest record
+		input.ShardIteratorType = aws.String(streams.ShardIteratorTypeTrimHorizon)
+		input.SequenceNumber = nil
+		if output, err = c.client.GetShardIteratorWithContext(ctx, input); err != nil {
+			return "", fmt.Errorf("failed to get the shard iterator, %w", err)
+		}
+	}
+	return aws.StringValue(output.ShardIterator), nil
+}
+
+// listShards returns the shards of the stream.
+func (c *streamConsumer) listShards(ctx context.Context) ([]*streams.Shard, error) {
+	var shards []*streams.Shard
+	input := &streams.DescribeStreamInput{StreamArn: &c.streamARN}
+	for {
+		output, err := c.client.DescribeStreamWithContext(ctx, input)
+		if err != nil {
+			return nil, fmt.Errorf("failed to describe the stream, %w", err)
+		}
+		if output.StreamDescription == nil {
+			return shards, nil
+		}
+		shards = append(shards, output.StreamDescription.Shards...)
+		if output.StreamDescription.LastEvaluatedShardId == nil {
+			return shards, nil
+		}
+		input.ExclusiveStartShardId = output.StreamDescription.LastEvaluatedShardId
+	}
+}
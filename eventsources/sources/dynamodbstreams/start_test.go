@@ -0,0 +1,237 @@
+/*
+Copyright 2020 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dynamodbstreams
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	streams "github.com/aws/aws-sdk-go/service/dynamodbstreams"
+	"github.com/aws/aws-sdk-go/service/dynamodbstreams/dynamodbstreamsiface"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/argoproj/argo-events/common/logging"
+	eventsourcecommon "github.com/argoproj/argo-events/eventsources/common"
+	"github.com/argoproj/argo-events/eventsources/persist"
+	metrics "github.com/argoproj/argo-events/metrics"
+	"github.com/argoproj/argo-events/pkg/apis/events"
+	"github.com/argoproj/argo-events/pkg/apis/eventsource/v1alpha1"
+)
+
+const testStreamARN = "arn:aws:dynamodb:us-east-1:123456789012:table/orders/stream/2024-01-01T00:00:00.000"
+
+// fakeStreams is a stream whose iterators are "<shard>/<index of the next record>"
+type fakeStreams struct {
+	dynamodbstreamsiface.DynamoDBStreamsAPI
+	shards  []*streams.Shard
+	records map[string][]*streams.Record
+	closed  map[string]bool
+}
+
+func newRecord(seq, id string) *streams.Record {
+	return &streams.Record{
+		EventID:   aws.String("event-" + seq),
+		EventName: aws.String(streams.OperationTypeModify),
+		Dynamodb: &streams.StreamRecord{
+			SequenceNumber: aws.String(seq),
+			Keys:           map[string]*dynamodb.AttributeValue{"id": {S: aws.String(id)}},
+			NewImage:       map[string]*dynamodb.AttributeValue{"id": {S: aws.String(id)}, "total": {N: aws.String(seq)}},
+			OldImage:       map[string]*dynamodb.AttributeValue{"id": {S: aws.String(id)}},
+		},
+	}
+}
+
+func (f *fakeStreams) DescribeStreamWithContext(ctx aws.Context, input *streams.DescribeStreamInput, opts ...request.Option) (*streams.DescribeStreamOutput, error) {
+	return &streams.DescribeStreamOutput{StreamDescription: &streams.StreamDescription{Shards: f.shards}}, nil
+}
+
+func (f *fakeStreams) GetShardIteratorWithContext(ctx aws.Context, input *streams.GetShardIteratorInput, opts ...request.Option) (*streams.GetShardIteratorOutput, error) {
+	id := *input.ShardId
+	position := 0
+	switch *input.ShardIteratorType {
+	case streams.ShardIteratorTypeLatest:
+		position = len(f.records[id])
+	case streams.ShardIteratorTypeAfterSequenceNumber:
+		for i, record := range f.records[id] {
+			if *record.Dynamodb.SequenceNumber == *input.SequenceNumber {
+				position = i + 1
+			}
+		}
+	}
+	return &streams.GetShardIteratorOutput{ShardIterator: aws.String(fmt.Sprintf("%s/%d", id, position))}, nil
+}
+
+func (f *fakeStreams) GetRecordsWithContext(ctx aws.Context, input *streams.GetRecordsInput, opts ...request.Option) (*streams.GetRecordsOutput, error) {
+	parts := strings.Split(*input.ShardIterator, "/")
+	position, _ := strconv.Atoi(parts[1])
+	records := f.records[parts[0]]
+	output := &streams.GetRecordsOutput{Records: records[position:]}
+	if !f.closed[parts[0]] {
+		output.NextShardIterator = aws.String(fmt.Sprintf("%s/%d", parts[0], len(records)))
+	}
+	return output, nil
+}
+
+func newFakeStreams() *fakeStreams {
+	return &fakeStreams{
+		shards: []*streams.Shard{
+			{ShardId: aws.String("shard-1")},
+			{ShardId: aws.String("shard-2"), ParentShardId: aws.String("shard-1")},
+		},
+		records: map[string][]*streams.Record{
+			"shard-1": {newRecord("1", "a"), newRecord("2", "b")},
+			"shard-2": {newRecord("3", "a")},
+		},
+		closed: map[string]bool{"shard-1": true},
+	}
+}
+
+func sequenceNumbers(consumer *streamConsumer, fail string) ([]string, bool, error) {
+	var emitted []string
+	changed, err := consumer.poll(context.Background(), func(shardID string, record *streams.Record) error {
+		seq := *record.Dynamodb.SequenceNumber
+		if seq == fail {
+			return fmt.Errorf("eventbus is down")
+		}
+		emitted = append(emitted, seq)
+		return nil
+	})
+	return emitted, changed, err
+}
+
+func TestStreamConsumer(t *testing.T) {
+	t.Run("read the parents before their children", func(t *testing.T) {
+		fake := newFakeStreams()
+		consumer := newStreamConsumer(fake, testStreamARN, streams.ShardIteratorTypeTrimHorizon, map[string]string{})
+		emitted, changed, err := sequenceNumbers(consumer, "")
+		assert.NoError(t, err)
+		assert.True(t, changed)
+		assert.Equal(t, []string{"1", "2", "3"}, emitted)
+		assert.Equal(t, map[string]string{"shard-1": "2", "shard-2": "3"}, consumer.checkpoints)
+
+		fake.records["shard-2"] = append(fake.records["shard-2"], newRecord("4", "b"))
+		emitted, _, err = sequenceNumbers(consumer, "")
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"4"}, emitted)
+	})
+
+	t.Run("start from the latest records", func(t *testing.T) {
+		fake := newFakeStreams()
+		consumer := newStreamConsumer(fake, testStreamARN, "", map[string]string{})
+		emitted, changed, err := sequenceNumbers(consumer, "")
+		assert.NoError(t, err)
+		assert.False(t, changed)
+		assert.Empty(t, emitted)
+
+		// The shards created afterwards are read from their start
+		fake.shards = append(fake.shards, &streams.Shard{ShardId: aws.String("shard-3"), ParentShardId: aws.String("shard-2")})
+		fake.records["shard-3"] = []*streams.Record{newRecord("5", "c")}
+		fake.closed["shard-2"] = true
+		emitted, _, err = sequenceNumbers(consumer, "")
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"5"}, emitted)
+	})
+
+	t.Run("resume from the checkpoints", func(t *testing.T) {
+		fake := newFakeStreams()
+		consumer := newStreamConsumer(fake, testStreamARN, "", map[string]string{"shard-1": "1", "shard-0": "9"})
+		emitted, changed, err := sequenceNumbers(consumer, "3")
+		assert.Error(t, err)
+		assert.True(t, changed)
+		assert.Equal(t, []string{"2"}, emitted)
+		// The checkpoints of the trimmed shards are dropped
+		assert.Equal(t, map[string]string{"shard-1": "2"}, consumer.checkpoints)
+
+		emitted, _, err = sequenceNumbers(consumer, "")
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"3"}, emitted)
+	})
+}
+
+func TestHandleOne(t *testing.T) {
+	el := &EventListener{
+		EventSourceName: "dynamodb-streams",
+		EventName:       "example",
+		EventSource:     v1alpha1.DynamoDBStreamsEventSource{Images: v1alpha1.DynamoDBStreamsNewImage, Metadata: map[string]string{"env": "test"}},
+		Metrics:         metrics.NewMetrics("argo-events"),
+	}
+	var eventData events.DynamoDBStreamsEventData
+	dispatch := func(data []byte, opts ...eventsourcecommon.Option) error {
+		return json.Unmarshal(data, &eventData)
+	}
+	require.NoError(t, el.handleOne(testStreamARN, "shard-1", newRecord("7", "a"), dispatch, logging.NewArgoEventsLogger()))
+	assert.Equal(t, "event-7", eventData.EventID)
+	assert.Equal(t, "MODIFY", eventData.EventName)
+	assert.Equal(t, "shard-1", eventData.ShardID)
+	assert.Equal(t, map[string]interface{}{"id": "a"}, eventData.Keys)
+	assert.Equal(t, map[string]interface{}{"id": "a", "total": float64(7)}, eventData.NewImage)
+	assert.Nil(t, eventData.OldImage)
+	assert.Equal(t, "test", eventData.Metadata["env"])
+}
+
+func TestAttributeValue(t *testing.T) {
+	value := &dynamodb.AttributeValue{M: map[string]*dynamodb.AttributeValue{
+		"tags":   {SS: aws.StringSlice([]string{"a", "b"})},
+		"scores": {NS: aws.StringSlice([]string{"1", "2.5"})},
+		"items":  {L: []*dynamodb.AttributeValue{{BOOL: aws.Bool(true)}, {NULL: aws.Bool(true)}}},
+	}}
+	b, err := json.Marshal(attributeValue(value))
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"tags":["a","b"],"scores":[1,2.5],"items":[true,null]}`, string(b))
+}
+
+func TestCheckpoints(t *testing.T) {
+	el := &EventListener{EventSourceName: "dynamodb-streams", EventName: "example"}
+	checkpointPersistence := &fakePersistence{events: map[string]string{}}
+	checkpoints, err := el.loadCheckpoints(checkpointPersistence)
+	require.NoError(t, err)
+	assert.Empty(t, checkpoints)
+	require.NoError(t, el.saveCheckpoints(checkpointPersistence, map[string]string{"shard-1": "2"}))
+	assert.Equal(t, `{"shard-1":"2"}`, checkpointPersistence.events["dynamodb-streams.example"])
+	checkpoints, err = el.loadCheckpoints(checkpointPersistence)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"shard-1": "2"}, checkpoints)
+}
+
+type fakePersistence struct {
+	events map[string]string
+}
+
+func (f *fakePersistence) Save(event *persist.Event) error {
+	f.events[event.EventKey] = event.EventPayload
+	return nil
+}
+
+func (f *fakePersistence) Get(key string) (*persist.Event, error) {
+	payload, ok := f.events[key]
+	if !ok {
+		return nil, nil
+	}
+	return &persist.Event{EventKey: key, EventPayload: payload}, nil
+}
+
+func (f *fakePersistence) IsEnabled() bool {
+	return true
+}
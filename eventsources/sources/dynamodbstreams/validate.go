@@ -0,0 +1,64 @@
+/*
+Copyright 2020 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dynamodbstreams
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	streams "github.com/aws/aws-sdk-go/service/dynamodbstreams"
+
+	"github.com/argoproj/argo-events/common"
+	"github.com/argoproj/argo-events/pkg/apis/eventsource/v1alpha1"
+)
+
+// ValidateEventSource validates the DynamoDB Streams event source
+func (el *EventListener) ValidateEventSource(ctx context.Context) error {
+	return validate(&el.EventSource)
+}
+
+func validate(eventSource *v1alpha1.DynamoDBStreamsEventSource) error {
+	if eventSource == nil {
+		return common.ErrNilEventSource
+	}
+	if (eventSource.Table == "") == (eventSource.StreamARN == "") {
+		return fmt.Errorf("either table or streamARN must be specified")
+	}
+	if eventSource.Region == "" {
+		return fmt.Errorf("region must be specified")
+	}
+	switch eventSource.StartingPosition {
+	case "", streams.ShardIteratorTypeLatest, streams.ShardIteratorTypeTrimHorizon:
+	default:
+		return fmt.Errorf("invalid startingPosition %q, it must be either LATEST or TRIM_HORIZON", eventSource.StartingPosition)
+	}
+	switch eventSource.Images {
+	case "", v1alpha1.DynamoDBStreamsNewImage, v1alpha1.DynamoDBStreamsOldImage, v1alpha1.DynamoDBStreamsNewAndOldImages:
+	default:
+		return fmt.Errorf("invalid images %q, it must be one of NewImage, OldImage or NewAndOldImages", eventSource.Images)
+	}
+	if eventSource.PollInterval != "" {
+		if _, err := time.ParseDuration(eventSource.PollInterval); err != nil {
+			return fmt.Errorf("failed to parse the poll interval, %w", err)
+		}
+	}
+	if eventSource.Persistence != nil && eventSource.Persistence.Name == "" {
+		return fmt.Errorf("persistence configmap name must be specified")
+	}
+	return nil
+}
@@ -0,0 +1,65 @@
+/*
+Copyright 2020 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dynamodbstreams
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/ghodss/yaml"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/argoproj/argo-events/eventsources/sources"
+	"github.com/argoproj/argo-events/pkg/apis/eventsource/v1alpha1"
+)
+
+func TestValidateEventSource(t *testing.T) {
+	listener := &EventListener{}
+
+	err := listener.ValidateEventSource(context.Background())
+	assert.Error(t, err)
+	assert.Equal(t, "either table or streamARN must be specified", err.Error())
+
+	content, err := os.ReadFile(fmt.Sprintf("%s/%s", sources.EventSourceDir, "dynamodb-streams.yaml"))
+	assert.Nil(t, err)
+
+	var eventSource *v1alpha1.EventSource
+	err = yaml.Unmarshal(content, &eventSource)
+	assert.Nil(t, err)
+	assert.NotNil(t, eventSource.Spec.DynamoDBStreams)
+
+	for _, value := range eventSource.Spec.DynamoDBStreams {
+		l := &EventListener{
+			EventSource: value,
+		}
+		assert.NoError(t, l.ValidateEventSource(context.Background()))
+
+		both := value
+		both.StreamARN = "arn:aws:dynamodb:us-east-1:123456789012:table/orders/stream/2024-01-01T00:00:00.000"
+		assert.ErrorContains(t, validate(&both), "either table or streamARN must be specified")
+
+		invalidPosition := value
+		invalidPosition.StartingPosition = "AT_TIMESTAMP"
+		assert.ErrorContains(t, validate(&invalidPosition), "invalid startingPosition")
+
+		invalidImages := value
+		invalidImages.Images = "KeysOnly"
+		assert.ErrorContains(t, validate(&invalidImages), "invalid images")
+	}
+}
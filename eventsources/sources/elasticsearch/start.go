@@ -0,0 +1,281 @@
+/*
+Copyright 2020 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package elasticsearch
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	neturl "net/url"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/argoproj/argo-events/common"
+	"github.com/argoproj/argo-events/common/logging"
+	eventsourcecommon "github.com/argoproj/argo-events/eventsources/common"
+	"github.com/argoproj/argo-events/eventsources/sources"
+	metrics "github.com/argoproj/argo-events/metrics"
+	apicommon "github.com/argoproj/argo-events/pkg/apis/common"
+	"github.com/argoproj/argo-events/pkg/apis/events"
+	"github.com/argoproj/argo-events/pkg/apis/eventsource/v1alpha1"
+)
+
+const (
+	defaultPollInterval = 10 * time.Second
+	defaultBatchSize    = 100
+)
+
+// EventListener implements Eventing for the Elasticsearch event source
+type EventListener struct {
+	EventSourceName string
+	EventName       string
+	EventSource     v1alpha1.ElasticsearchEventSource
+	Metrics         *metrics.Metrics
+}
+
+// GetEventSourceName returns name of event source
+func (el *EventListener) GetEventSourceName() string {
+	return el.EventSourceName
+}
+
+// GetEventName returns name of event
+func (el *EventListener) GetEventName() string {
+	return el.EventName
+}
+
+// GetEventSourceType return type of event server
+func (el *EventListener) GetEventSourceType() apicommon.EventSourceType {
+	return apicommon.ElasticsearchEvent
+}
+
+// StartListening polls the index for the new documents matching the query
+func (el *EventListener) StartListening(ctx context.Context, dispatch func([]byte, ...eventsourcecommon.Option) error) error {
+	log := logging.FromContext(ctx).
+		With(logging.LabelEventSourceType, el.GetEventSourceType(), logging.LabelEventName, el.GetEventName())
+	log.Info("started processing the Elasticsearch event source...")
+	defer sources.Recover(el.GetEventName())
+
+	esEventSource := &el.EventSource
+	client, err := newSearchClient(esEventSource)
+	if err != nil {
+		return err
+	}
+
+	pollInterval := defaultPollInterval
+	if esEventSource.PollInterval != "" {
+		if pollInterval, err = time.ParseDuration(esEventSource.PollInterval); err != nil {
+			return fmt.Errorf("failed to parse the poll interval, %w", err)
+		}
+	}
+
+	// Only the documents indexed from now on are emitted, the cursor starts after the latest matching one
+	var cursor []interface{}
+	if err := common.DoWithTrackedRetry("eventsource.connect", el.GetEventSourceName()+"/"+el.GetEventName(), nil, func() error {
+		hits, err := client.search(ctx, nil, true, 1)
+		if err != nil {
+			return err
+		}
+		if len(hits) > 0 {
+			cursor = hits[0].Sort
+		}
+		return nil
+	}); err != nil {
+		return fmt.Errorf("failed to search the latest document of %s, %w", esEventSource.Index, err)
+	}
+	log.Infow("listening to the new documents...", zap.String("index", esEventSource.Index), zap.Any("cursor", cursor))
+
+	// The failed polls are tracked as the retries of the poll until one succeeds
+	common.PollWithTrackedRetry(ctx, "eventsource.poll", el.GetEventSourceName()+"/"+el.GetEventName(), pollInterval, func() error {
+		var err error
+		cursor, err = el.poll(ctx, client, cursor, dispatch, log)
+		return err
+	})
+	log.Info("event source is stopped")
+	return nil
+}
+
+// poll emits the documents found after the cursor, until the search is drained or fails, and returns the
+// cursor of the last emitted document, with the error which stopped it. The documents failing to be dispatched
+// are searched again on the next poll.
+func (el *EventListener) poll(ctx context.Context, client *searchClient, cursor []interface{}, dispatch func([]byte, ...eventsourcecommon.Option) error, log *zap.SugaredLogger) ([]interface{}, error) {
+	batchSize := int(el.EventSource.BatchSize)
+	if batchSize <= 0 {
+		batchSize = defaultBatchSize
+	}
+	for {
+		hits, err := client.search(ctx, cursor, false, batchSize)
+		if err != nil {
+			log.Errorw("failed to search the new documents", zap.Error(err))
+			return cursor, fmt.Errorf("failed to search the new documents, %w", err)
+		}
+		for _, hit := range hits {
+			if err := el.handleOne(hit, dispatch, log); err != nil {
+				log.Errorw("failed to process a document", zap.String("id", hit.ID), zap.Error(err))
+				el.Metrics.EventProcessingFailed(el.GetEventSourceName(), el.GetEventName())
+				return cursor, err
+			}
+			cursor = hit.Sort
+		}
+		if len(hits) < batchSize {
+			return cursor, nil
+		}
+	}
+}
+
+func (el *EventListener) handleOne(hit searchHit, dispatch func([]byte, ...eventsourcecommon.Option) error, log *zap.SugaredLogger) error {
+	defer func(start time.Time) {
+		el.Metrics.EventProcessingDuration(el.GetEventSourceName(), el.GetEventName(), float64(time.Since(start)/time.Millisecond))
+	}(time.Now())
+
+	log.Infow("received a document", zap.String("index", hit.Index), zap.String("id", hit.ID))
+	eventData := &events.ElasticsearchEventData{
+		Index:    hit.Index,
+		ID:       hit.ID,
+		Source:   hit.Source,
+		Sort:     hit.Sort,
+		Metadata: el.EventSource.Metadata,
+	}
+	eventBody, err := json.Marshal(eventData)
+	if err != nil {
+		return fmt.Errorf("failed to marshal the event data, rejecting the event, %w", err)
+	}
+	if err = dispatch(eventBody); err != nil {
+		return fmt.Errorf("failed to dispatch an Elasticsearch event, %w", err)
+	}
+	return nil
+}
+
+// searchClient searches the index of the event source with the search-after cursor
+type searchClient struct {
+	client    *http.Client
+	url       string
+	query     json.RawMessage
+	sortField string
+	tiebreak  string
+	auth      func(*http.Request)
+}
+
+type searchHit struct {
+	Index  string          `json:"_index"`
+	ID     string          `json:"_id"`
+	Source json.RawMessage `json:"_source"`
+	Sort   []interface{}   `json:"sort"`
+}
+
+func newSearchClient(esEventSource *v1alpha1.ElasticsearchEventSource) (*searchClient, error) {
+	client := &http.Client{Timeout: 30 * time.Second}
+	if esEventSource.TLS != nil {
+		tlsConfig, err := common.GetTLSConfig(esEventSource.TLS)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get the tls configuration, %w", err)
+		}
+		client.Transport = &http.Transport{
+			TLSClientConfig: tlsConfig,
+		}
+	}
+
+	auth := func(*http.Request) {}
+	switch {
+	case esEventSource.BasicAuth != nil:
+		username, password, err := common.GetBasicAuthFromVolume(esEventSource.BasicAuth)
+		if err != nil {
+			return nil, err
+		}
+		auth = func(request *http.Request) {
+			request.SetBasicAuth(username, password)
+		}
+	case esEventSource.APIKey != nil:
+		apiKey, err := common.GetSecretFromVolume(esEventSource.APIKey)
+		if err != nil {
+			return nil, fmt.Errorf("failed to retrieve the api key, %w", err)
+		}
+		auth = func(request *http.Request) {
+			request.Header.Set("Authorization", "ApiKey "+apiKey)
+		}
+	}
+
+	query := json.RawMessage(`{"match_all": {}}`)
+	if esEventSource.Query != "" {
+		query = json.RawMessage(esEventSource.Query)
+	}
+	return &searchClient{
+		client:    client,
+		url:       fmt.Sprintf("%s/%s/_search", strings.TrimSuffix(esEventSource.URL, "/"), neturl.PathEscape(esEventSource.Index)),
+		query:     query,
+		sortField: esEventSource.SortField,
+		tiebreak:  esEventSource.TiebreakerField,
+		auth:      auth,
+	}, nil
+}
+
+// search returns the documents matching the query after the cursor, in the ascending or descending order.
+func (c *searchClient) search(ctx context.Context, after []interface{}, desc bool, size int) ([]searchHit, error) {
+	order := "asc"
+	if desc {
+		order = "desc"
+	}
+	sort := []map[string]string{{c.sortField: order}}
+	if c.tiebreak != "" {
+		sort = append(sort, map[string]string{c.tiebreak: order})
+	}
+	searchRequest := map[string]interface{}{
+		"size":             size,
+		"query":            c.query,
+		"sort":             sort,
+		"track_total_hits": false,
+	}
+	if after != nil {
+		searchRequest["search_after"] = after
+	}
+	body, err := json.Marshal(searchRequest)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal the search request, %w", err)
+	}
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, c.url, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to construct request for %s, %w", c.url, err)
+	}
+	request.Header.Set("Content-Type", "application/json")
+	c.auth(request)
+
+	response, err := c.client.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+	if response.StatusCode/100 != 2 {
+		message, _ := io.ReadAll(io.LimitReader(response.Body, 1024))
+		return nil, fmt.Errorf("search failed with status %d: %s", response.StatusCode, bytes.TrimSpace(message))
+	}
+	var result struct {
+		Hits struct {
+			Hits []searchHit `json:"hits"`
+		} `json:"hits"`
+	}
+	decoder := json.NewDecoder(response.Body)
+	// The sort values are sent back as they are, the large numbers must keep their precision
+	decoder.UseNumber()
+	if err := decoder.Decode(&result); err != nil {
+		return nil, fmt.Errorf("failed to decode the search response, %w", err)
+	}
+	return result.Hits.Hits, nil
+}
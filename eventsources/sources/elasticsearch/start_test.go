@@ -0,0 +1,113 @@
+/*
+Copyright 2020 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package elasticsearch
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/argoproj/argo-events/common/logging"
+	eventsourcecommon "github.com/argoproj/argo-events/eventsources/common"
+	metrics "github.com/argoproj/argo-events/metrics"
+	"github.com/argoproj/argo-events/pkg/apis/events"
+	"github.com/argoproj/argo-events/pkg/apis/eventsource/v1alpha1"
+)
+
+// fakeIndex serves the searches of an index holding documents 1 to total, sorted by their number
+func fakeIndex(t *testing.T, total int, requests *[]map[string]interface{}) *httptest.Server {
+	t.Helper()
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/logs-%2A/_search", r.URL.EscapedPath())
+		var request map[string]interface{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+		*requests = append(*requests, request)
+
+		size := int(request["size"].(float64))
+		after := 0
+		if searchAfter, ok := request["search_after"].([]interface{}); ok {
+			after = int(searchAfter[0].(float64))
+		}
+		var hits []map[string]interface{}
+		if request["sort"].([]interface{})[0].(map[string]interface{})["n"] == "desc" {
+			hits = append(hits, map[string]interface{}{"_index": "logs-1", "_id": fmt.Sprint(total), "_source": map[string]int{"n": total}, "sort": []int{total}})
+		} else {
+			for n := after + 1; n <= total && len(hits) < size; n++ {
+				hits = append(hits, map[string]interface{}{"_index": "logs-1", "_id": fmt.Sprint(n), "_source": map[string]int{"n": n}, "sort": []int{n}})
+			}
+		}
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"hits": map[string]interface{}{"hits": hits}})
+	}))
+}
+
+func TestPoll(t *testing.T) {
+	var requests []map[string]interface{}
+	server := fakeIndex(t, 5, &requests)
+	defer server.Close()
+
+	el := &EventListener{
+		EventSourceName: "es",
+		EventName:       "example",
+		EventSource:     v1alpha1.ElasticsearchEventSource{URL: server.URL, Index: "logs-*", SortField: "n", BatchSize: 2, Metadata: map[string]string{"env": "test"}},
+		Metrics:         metrics.NewMetrics("argo-events"),
+	}
+	client, err := newSearchClient(&el.EventSource)
+	require.NoError(t, err)
+
+	// The latest document gives the initial cursor
+	hits, err := client.search(context.TODO(), nil, true, 1)
+	require.NoError(t, err)
+	require.Len(t, hits, 1)
+	assert.Equal(t, []interface{}{json.Number("5")}, hits[0].Sort)
+	assert.NotContains(t, requests[0], "search_after")
+	assert.Equal(t, map[string]interface{}{"match_all": map[string]interface{}{}}, requests[0]["query"])
+
+	var ids []string
+	dispatch := func(data []byte, opts ...eventsourcecommon.Option) error {
+		var eventData events.ElasticsearchEventData
+		require.NoError(t, json.Unmarshal(data, &eventData))
+		assert.Equal(t, "test", eventData.Metadata["env"])
+		if eventData.ID == "4" {
+			return fmt.Errorf("eventbus is down")
+		}
+		ids = append(ids, eventData.ID)
+		return nil
+	}
+	// The searches are drained until a batch is not full, and stop at the first failed document
+	cursor, err := el.poll(context.TODO(), client, []interface{}{json.Number("0")}, dispatch, logging.NewArgoEventsLogger())
+	assert.ErrorContains(t, err, "eventbus is down")
+	assert.Equal(t, []string{"1", "2", "3"}, ids)
+	assert.Equal(t, []interface{}{json.Number("3")}, cursor)
+
+	dispatch = func(data []byte, opts ...eventsourcecommon.Option) error {
+		var eventData events.ElasticsearchEventData
+		require.NoError(t, json.Unmarshal(data, &eventData))
+		ids = append(ids, eventData.ID)
+		return nil
+	}
+	cursor, err = el.poll(context.TODO(), client, cursor, dispatch, logging.NewArgoEventsLogger())
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"1", "2", "3", "4", "5"}, ids)
+	assert.Equal(t, []interface{}{json.Number("5")}, cursor)
+	assert.Equal(t, []interface{}{float64(5)}, requests[len(requests)-1]["search_after"])
+}
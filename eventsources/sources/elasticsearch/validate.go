@@ -0,0 +1,69 @@
+/*
+Copyright 2020 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package elasticsearch
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	neturl "net/url"
+	"time"
+
+	"github.com/argoproj/argo-events/common"
+	"github.com/argoproj/argo-events/pkg/apis/eventsource/v1alpha1"
+)
+
+// ValidateEventSource validates the Elasticsearch event source
+func (el *EventListener) ValidateEventSource(ctx context.Context) error {
+	return validate(&el.EventSource)
+}
+
+func validate(eventSource *v1alpha1.ElasticsearchEventSource) error {
+	if eventSource == nil {
+		return common.ErrNilEventSource
+	}
+	if eventSource.URL == "" {
+		return fmt.Errorf("url must be specified")
+	}
+	if _, err := neturl.ParseRequestURI(eventSource.URL); err != nil {
+		return fmt.Errorf("invalid url %s, %w", eventSource.URL, err)
+	}
+	if eventSource.Index == "" {
+		return fmt.Errorf("index must be specified")
+	}
+	if eventSource.SortField == "" {
+		return fmt.Errorf("sortField must be specified")
+	}
+	if eventSource.Query != "" {
+		var query map[string]interface{}
+		if err := json.Unmarshal([]byte(eventSource.Query), &query); err != nil {
+			return fmt.Errorf("query must be a JSON object, %w", err)
+		}
+	}
+	if eventSource.PollInterval != "" {
+		if _, err := time.ParseDuration(eventSource.PollInterval); err != nil {
+			return fmt.Errorf("failed to parse the poll interval, %w", err)
+		}
+	}
+	if eventSource.BatchSize < 0 {
+		return fmt.Errorf("batchSize can't be negative")
+	}
+	if eventSource.BasicAuth != nil && eventSource.APIKey != nil {
+		return fmt.Errorf("basicAuth and apiKey can't be both specified")
+	}
+	return nil
+}
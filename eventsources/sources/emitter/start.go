@@ -100,7 +100,7 @@ func (el *EventListener) StartListening(ctx context.Context, dispatch func([]byt
 	log.Infow("creating a client", zap.Any("channelName", emitterEventSource.ChannelName))
 	client := emitter.NewClient(options...)
 
-	if err := common.DoWithRetry(emitterEventSource.ConnectionBackoff, func() error {
+	if err := common.DoWithTrackedRetry("eventsource.connect", el.GetEventSourceName()+"/"+el.GetEventName(), emitterEventSource.ConnectionBackoff, func() error {
 		if err := client.Connect(); err != nil {
 			return err
 		}
@@ -20,6 +20,7 @@ import (
 	"context"
 	"crypto/rand"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"math/big"
@@ -103,7 +104,7 @@ func (router *Router) HandleRoute(writer http.ResponseWriter, request *http.Requ
 	}
 
 	logger.Info("dispatching event on route's data channel")
-	route.DataCh <- eventBody
+	route.Deliver(request, eventBody)
 
 	logger.Info("request successfully processed")
 	common.SendSuccessResponse(writer, "success")
@@ -188,11 +189,14 @@ func (el *EventListener) StartListening(ctx context.Context, dispatch func([]byt
 		}
 		router.gerritHookService = newGerritWebhookService(router.gerritClient)
 
-		f := func() {
+		// f registers the hooks which are not registered yet, it returns the errors of the ones failing to be registered.
+		f := func() error {
+			var errs []error
 			for _, p := range gerritEventSource.Projects {
 				hooks, err := router.gerritHookService.List(p)
 				if err != nil {
 					logger.Errorf("failed to list existing webhooks of project %s. err: %+v", p, err)
+					errs = append(errs, fmt.Errorf("failed to list the webhooks of project %s, %w", p, err))
 					continue
 				}
 				// hook already exist
@@ -205,17 +209,22 @@ func (el *EventListener) StartListening(ctx context.Context, dispatch func([]byt
 				logger.Infof("hook not found for project %s, creating ...", p)
 				if _, err := router.gerritHookService.Create(p, gerritEventSource.HookName, opt); err != nil {
 					logger.Errorf("failed to create gerrit webhook for project %s. err: %+v", p, err)
+					errs = append(errs, fmt.Errorf("failed to create the webhook of project %s, %w", p, err))
 					continue
 				}
 				router.projectHooks[p] = gerritEventSource.HookName
 				time.Sleep(500 * time.Millisecond)
 			}
+			return errors.Join(errs...)
 		}
 
 		// Mitigate race condtions - it might create multiple hooks with same config when replicas > 1
 		randomNum, _ := rand.Int(rand.Reader, big.NewInt(int64(2000)))
 		time.Sleep(time.Duration(randomNum.Int64()) * time.Millisecond)
-		f()
+		target := el.GetEventSourceName() + "/" + el.GetEventName()
+		if err := common.DoWithTrackedRetry("eventsource.register", target, nil, f); err != nil {
+			logger.Errorw("failed to register the webhooks, retrying with the hooks manager daemon", zap.Error(err))
+		}
 
 		ctx, cancel := context.WithCancel(ctx)
 		defer cancel()
@@ -225,17 +234,8 @@ func (el *EventListener) StartListening(ctx context.Context, dispatch func([]byt
 			// and old pod terminates, if DeleteHookOnFinish is true, the hook will be deleted from gerrit.
 			// This is a workround to mitigate the race conditions.
 			logger.Info("starting gerrit hooks manager daemon")
-			ticker := time.NewTicker(60 * time.Second)
-			defer ticker.Stop()
-			for {
-				select {
-				case <-ctx.Done():
-					logger.Info("exiting gerrit hooks manager daemon")
-					return
-				case <-ticker.C:
-					f()
-				}
-			}
+			common.PollWithTrackedRetry(ctx, "eventsource.register", target, 60*time.Second, f)
+			logger.Info("exiting gerrit hooks manager daemon")
 		}()
 	} else {
 		logger.Info("no need to create webhooks")
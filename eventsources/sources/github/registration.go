@@ -0,0 +1,106 @@
+package github
+
+import (
+	"context"
+	"reflect"
+	"strconv"
+
+	"go.uber.org/zap"
+
+	"github.com/argoproj/argo-events/eventsources/persist"
+)
+
+func orgRegistrationKey(org string) string {
+	return "org:" + org
+}
+
+func repoRegistrationKey(owner, name string) string {
+	return "repo:" + owner + "/" + name
+}
+
+// registeredHookID returns the persisted hook ID of a registration key.
+func (router *Router) registeredHookID(key string) (int64, bool) {
+	value, ok := router.registrations[key]
+	if !ok {
+		return 0, false
+	}
+	id, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return 0, false
+	}
+	return id, true
+}
+
+// allRegistered returns whether a hook ID is persisted for all the organizations and repositories.
+func (router *Router) allRegistered() bool {
+	if len(router.registrations) == 0 {
+		return false
+	}
+	for _, org := range router.githubEventSource.Organizations {
+		if _, ok := router.registeredHookID(orgRegistrationKey(org)); !ok {
+			return false
+		}
+	}
+	for _, r := range router.githubEventSource.GetOwnedRepositories() {
+		for _, name := range r.Names {
+			if _, ok := router.registeredHookID(repoRegistrationKey(r.Owner, name)); !ok {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// resumeOrgHook returns the persisted hook ID of an organization, if the hook still exists and matches the url and
+// events.
+func (router *Router) resumeOrgHook(ctx context.Context, org, url string) (int64, bool) {
+	id, ok := router.registeredHookID(orgRegistrationKey(org))
+	if !ok {
+		return 0, false
+	}
+	hook, _, err := router.githubClient.Organizations.GetHook(ctx, org, id)
+	if err != nil || !compareHook(hook, url, router.githubEventSource.Events) {
+		return 0, false
+	}
+	return id, true
+}
+
+// resumeRepoHook returns the persisted hook ID of a repository, if the hook still exists and matches the url and
+// events.
+func (router *Router) resumeRepoHook(ctx context.Context, owner, name, url string) (int64, bool) {
+	id, ok := router.registeredHookID(repoRegistrationKey(owner, name))
+	if !ok {
+		return 0, false
+	}
+	hook, _, err := router.githubClient.Repositories.GetHook(ctx, owner, name, id)
+	if err != nil || !compareHook(hook, url, router.githubEventSource.Events) {
+		return 0, false
+	}
+	return id, true
+}
+
+// saveRegistrations persists the hook IDs, if they changed.
+func (router *Router) saveRegistrations(logger *zap.SugaredLogger) {
+	if router.registrationPersistence == nil {
+		return
+	}
+	registrations := persist.Registrations{}
+	for org, id := range router.orgHookIDs {
+		registrations[orgRegistrationKey(org)] = strconv.FormatInt(id, 10)
+	}
+	for _, r := range router.githubEventSource.GetOwnedRepositories() {
+		for _, name := range r.Names {
+			if id, ok := router.repoHookIDs[r.Owner+","+name]; ok {
+				registrations[repoRegistrationKey(r.Owner, name)] = strconv.FormatInt(id, 10)
+			}
+		}
+	}
+	if reflect.DeepEqual(registrations, router.registrations) {
+		return
+	}
+	if err := persist.SaveRegistrations(router.registrationPersistence, router.registrationKey, registrations); err != nil {
+		logger.Errorw("failed to persist the hook IDs", zap.Error(err))
+		return
+	}
+	router.registrations = registrations
+}
@@ -0,0 +1,81 @@
+package github
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	gh "github.com/google/go-github/v50/github"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/argoproj/argo-events/common/logging"
+	"github.com/argoproj/argo-events/eventsources/persist"
+	"github.com/argoproj/argo-events/pkg/apis/eventsource/v1alpha1"
+)
+
+func TestResumeHooks(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/repos/fake/fake0/hooks/1":
+			_, _ = w.Write([]byte(`{"id":1,"events":["push"],"config":{"url":"http://webhook-gateway-svc/push"}}`))
+		case "/repos/fake/fake1/hooks/2":
+			_, _ = w.Write([]byte(`{"id":2,"events":["push"],"config":{"url":"http://other-svc/push"}}`))
+		case "/orgs/fake/hooks/3":
+			_, _ = w.Write([]byte(`{"id":3,"events":["push"],"config":{"url":"http://webhook-gateway-svc/push"}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := gh.NewClient(nil)
+	client.BaseURL, _ = url.Parse(server.URL + "/")
+	registrationPersistence, err := persist.NewConfigMapPersist(context.Background(), fake.NewSimpleClientset(), &v1alpha1.ConfigMapPersistence{Name: "registrations", CreateIfNotExist: true}, "default")
+	assert.NoError(t, err)
+	r := &Router{
+		githubEventSource: &v1alpha1.GithubEventSource{
+			Repositories: []v1alpha1.OwnedRepositories{{Owner: "fake", Names: []string{"fake0", "fake1"}}},
+			Events:       []string{"push"},
+		},
+		githubClient:            client,
+		repoHookIDs:             map[string]int64{},
+		orgHookIDs:              map[string]int64{},
+		registrationPersistence: registrationPersistence,
+		registrationKey:         "github.example",
+		registrations: persist.Registrations{
+			"repo:fake/fake0": "1",
+			"repo:fake/fake1": "2",
+			"org:fake":        "3",
+		},
+	}
+	ctx := context.Background()
+	hookURL := "http://webhook-gateway-svc/push"
+
+	assert.True(t, r.allRegistered())
+	id, ok := r.resumeRepoHook(ctx, "fake", "fake0", hookURL)
+	assert.True(t, ok)
+	assert.Equal(t, int64(1), id)
+	// The hook doesn't match the url anymore
+	_, ok = r.resumeRepoHook(ctx, "fake", "fake1", hookURL)
+	assert.False(t, ok)
+	id, ok = r.resumeOrgHook(ctx, "fake", hookURL)
+	assert.True(t, ok)
+	assert.Equal(t, int64(3), id)
+	// The hook doesn't exist anymore
+	r.registrations["org:fake"] = "4"
+	_, ok = r.resumeOrgHook(ctx, "fake", hookURL)
+	assert.False(t, ok)
+
+	r.repoHookIDs["fake,fake0"] = 1
+	r.repoHookIDs["fake,fake1"] = 5
+	r.saveRegistrations(logging.NewArgoEventsLogger())
+	registrations, err := persist.LoadRegistrations(registrationPersistence, "github.example")
+	assert.NoError(t, err)
+	assert.Equal(t, persist.Registrations{"repo:fake/fake0": "1", "repo:fake/fake1": "5"}, registrations)
+	assert.True(t, r.allRegistered())
+	delete(r.registrations, "repo:fake/fake1")
+	assert.False(t, r.allRegistered())
+}
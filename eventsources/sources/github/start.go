@@ -19,6 +19,7 @@ import (
 	"context"
 	"crypto/rand"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"math/big"
 	"net/http"
@@ -33,7 +34,9 @@ import (
 	"github.com/argoproj/argo-events/common"
 	"github.com/argoproj/argo-events/common/logging"
 	eventsourcecommon "github.com/argoproj/argo-events/eventsources/common"
+	"github.com/argoproj/argo-events/eventsources/common/scm"
 	"github.com/argoproj/argo-events/eventsources/common/webhook"
+	"github.com/argoproj/argo-events/eventsources/persist"
 	"github.com/argoproj/argo-events/pkg/apis/events"
 )
 
@@ -43,6 +46,13 @@ const (
 	githubDeliveryHeader = "X-GitHub-Delivery"
 )
 
+const (
+	// hooksManagerInterval is the interval the hooks manager daemon checks the hooks at.
+	hooksManagerInterval = 60 * time.Second
+	// hooksManagerDuration is how long the hooks manager daemon runs, it stops after its 10th check.
+	hooksManagerDuration = 10*hooksManagerInterval + hooksManagerInterval/2
+)
+
 // controller controls the webhook operations
 var (
 	controller = webhook.NewController()
@@ -145,6 +155,9 @@ func (router *Router) HandleRoute(writer http.ResponseWriter, request *http.Requ
 	body, err := parseValidateRequest(request, []byte(router.hookSecret))
 	if err != nil {
 		logger.Errorw("request is not valid event notification, discarding it", zap.Error(err))
+		if errors.Is(err, errInvalidSignature) {
+			webhook.AuthFailed(request)
+		}
 		common.SendErrorResponse(writer, err.Error())
 		return
 	}
@@ -154,6 +167,9 @@ func (router *Router) HandleRoute(writer http.ResponseWriter, request *http.Requ
 		Body:     (*json.RawMessage)(&body),
 		Metadata: router.githubEventSource.Metadata,
 	}
+	if router.githubEventSource.Normalize {
+		event.Normalized = scm.NormalizeGitHub(request.Header, body)
+	}
 
 	eventBody, err := json.Marshal(event)
 	if err != nil {
@@ -164,7 +180,7 @@ func (router *Router) HandleRoute(writer http.ResponseWriter, request *http.Requ
 	}
 
 	logger.Info("dispatching event on route's data channel")
-	route.DataCh <- eventBody
+	route.Deliver(request, eventBody)
 	logger.Info("request successfully processed")
 
 	common.SendSuccessResponse(writer, "success")
@@ -299,6 +315,18 @@ func (el *EventListener) StartListening(ctx context.Context, dispatch func([]byt
 		router.repoHookIDs = make(map[string]int64)
 		router.orgHookIDs = make(map[string]int64)
 
+		if githubEventSource.RegistrationPersistence != nil {
+			logger.Info("loading the persisted hook IDs...")
+			router.registrationPersistence, err = persist.NewInClusterConfigMapPersist(ctx, githubEventSource.RegistrationPersistence, el.Namespace)
+			if err != nil {
+				return fmt.Errorf("failed to set up the registration persistence, %w", err)
+			}
+			router.registrationKey = fmt.Sprintf("%s.%s", el.EventSourceName, el.EventName)
+			if router.registrations, err = persist.LoadRegistrations(router.registrationPersistence, router.registrationKey); err != nil {
+				logger.Warnw("failed to load the persisted hook IDs, the hooks will be looked up", zap.Error(err))
+			}
+		}
+
 		hook := &gh.Hook{
 			Events: githubEventSource.Events,
 			Active: gh.Bool(githubEventSource.Active),
@@ -308,11 +336,18 @@ func (el *EventListener) StartListening(ctx context.Context, dispatch func([]byt
 		ctx, cancel := context.WithCancel(ctx)
 		defer cancel()
 
-		f := func() {
+		// f registers the hooks which are not registered yet, it returns the errors of the ones failing to be registered.
+		f := func() error {
+			var errs []error
 			for _, org := range githubEventSource.Organizations {
+				if id, ok := router.resumeOrgHook(ctx, org, formattedURL); ok {
+					router.orgHookIDs[org] = id
+					continue
+				}
 				hooks, _, err := router.githubClient.Organizations.ListHooks(ctx, org, nil)
 				if err != nil {
 					logger.Errorf("failed to list existing webhooks of organization %s. err: %+v", org, err)
+					errs = append(errs, fmt.Errorf("failed to list the webhooks of organization %s, %w", org, err))
 					continue
 				}
 				h := getHook(hooks, formattedURL, githubEventSource.Events)
@@ -324,6 +359,7 @@ func (el *EventListener) StartListening(ctx context.Context, dispatch func([]byt
 				h, _, err = router.githubClient.Organizations.CreateHook(ctx, org, hook)
 				if err != nil {
 					logger.Errorf("failed to create github webhook for organization %s. err: %+v", org, err)
+					errs = append(errs, fmt.Errorf("failed to create the webhook of organization %s, %w", org, err))
 					continue
 				}
 				router.orgHookIDs[org] = *h.ID
@@ -332,9 +368,14 @@ func (el *EventListener) StartListening(ctx context.Context, dispatch func([]byt
 
 			for _, r := range githubEventSource.GetOwnedRepositories() {
 				for _, name := range r.Names {
+					if id, ok := router.resumeRepoHook(ctx, r.Owner, name, formattedURL); ok {
+						router.repoHookIDs[r.Owner+","+name] = id
+						continue
+					}
 					hooks, _, err := router.githubClient.Repositories.ListHooks(ctx, r.Owner, name, nil)
 					if err != nil {
 						logger.Errorf("failed to list existing webhooks of %s/%s. err: %+v", r.Owner, name, err)
+						errs = append(errs, fmt.Errorf("failed to list the webhooks of %s/%s, %w", r.Owner, name, err))
 						continue
 					}
 					h := getHook(hooks, formattedURL, githubEventSource.Events)
@@ -346,30 +387,38 @@ func (el *EventListener) StartListening(ctx context.Context, dispatch func([]byt
 					h, _, err = router.githubClient.Repositories.CreateHook(ctx, r.Owner, name, hook)
 					if err != nil {
 						logger.Errorf("failed to create github webhook for %s/%s. err: %+v", r.Owner, name, err)
+						errs = append(errs, fmt.Errorf("failed to create the webhook of %s/%s, %w", r.Owner, name, err))
 						continue
 					}
 					router.repoHookIDs[r.Owner+","+name] = *h.ID
 					time.Sleep(500 * time.Millisecond)
 				}
 			}
+			router.saveRegistrations(logger)
+			return errors.Join(errs...)
 		}
 
 		// Github can not handle race conditions well - it might create multiple hooks with same config
 		// when replicas > 1
-		// Randomly sleep some time to mitigate the issue.
-		randomNum, _ := rand.Int(rand.Reader, big.NewInt(int64(2000)))
-		time.Sleep(time.Duration(randomNum.Int64()) * time.Millisecond)
-		f()
+		// Randomly sleep some time to mitigate the issue, unless the hooks are resumed from the persisted IDs.
+		if !router.allRegistered() {
+			randomNum, _ := rand.Int(rand.Reader, big.NewInt(int64(2000)))
+			time.Sleep(time.Duration(randomNum.Int64()) * time.Millisecond)
+		}
+		target := el.GetEventSourceName() + "/" + el.GetEventName()
+		if err := common.DoWithTrackedRetry("eventsource.register", target, nil, f); err != nil {
+			logger.Errorw("failed to register the webhooks, retrying with the hooks manager daemon", zap.Error(err))
+		}
 
 		go func() {
 			// Another kind of race conditions might happen when pods do rolling upgrade - new pod starts
 			// and old pod terminates, if DeleteHookOnFinish is true, the hook will be deleted from github.
-			// This is a workaround to mitigate the race conditions.
+			// This is a workaround to mitigate the race conditions, the hooks are checked every minute
+			// for 10 minutes.
 			logger.Info("starting github hooks manager daemon")
-			for i := 0; i < 10; i++ {
-				time.Sleep(60 * time.Second)
-				f()
-			}
+			daemonCtx, cancel := context.WithTimeout(ctx, hooksManagerDuration)
+			defer cancel()
+			common.PollWithTrackedRetry(daemonCtx, "eventsource.register", target, hooksManagerInterval, f)
 			logger.Info("exiting github hooks manager daemon")
 		}()
 	} else {
@@ -380,9 +429,16 @@ func (el *EventListener) StartListening(ctx context.Context, dispatch func([]byt
 }
 
 // parseValidateRequest parses a http request and checks if it is valid GitHub notification
+// errInvalidSignature is the error of the requests whose signature is missing or does not match the secret.
+var errInvalidSignature = errors.New("invalid signature")
+
 func parseValidateRequest(r *http.Request, secret []byte) ([]byte, error) {
 	body, err := gh.ValidatePayload(r, secret)
 	if err != nil {
+		// go-github does not export the errors of the signature validation
+		if len(secret) > 0 && strings.Contains(err.Error(), "signature") {
+			return nil, fmt.Errorf("%w, %v", errInvalidSignature, err)
+		}
 		return nil, err
 	}
 
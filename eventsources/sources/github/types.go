@@ -21,6 +21,7 @@ import (
 	"github.com/google/go-github/v50/github"
 
 	"github.com/argoproj/argo-events/eventsources/common/webhook"
+	"github.com/argoproj/argo-events/eventsources/persist"
 	"github.com/argoproj/argo-events/metrics"
 	apicommon "github.com/argoproj/argo-events/pkg/apis/common"
 	"github.com/argoproj/argo-events/pkg/apis/eventsource/v1alpha1"
@@ -31,6 +32,7 @@ type EventListener struct {
 	EventSourceName   string
 	EventName         string
 	GithubEventSource v1alpha1.GithubEventSource
+	Namespace         string
 	Metrics           *metrics.Metrics
 }
 
@@ -61,6 +63,12 @@ type Router struct {
 	repoHookIDs map[string]int64
 	// org name -> hook ID
 	orgHookIDs map[string]int64
+	// registrationPersistence persists the hook IDs when the registration persistence is enabled
+	registrationPersistence persist.EventPersist
+	// registrationKey is the key of the hook IDs in the registration persistence
+	registrationKey string
+	// registrations are the persisted hook IDs
+	registrations persist.Registrations
 	// hookSecret is a GitHub webhook secret
 	hookSecret string
 }
@@ -57,5 +57,8 @@ func validate(githubEventSource *v1alpha1.GithubEventSource) error {
 			return fmt.Errorf("githubUploadURL is required when githubBaseURL is set")
 		}
 	}
+	if githubEventSource.RegistrationPersistence != nil && githubEventSource.RegistrationPersistence.Name == "" {
+		return fmt.Errorf("registration persistence configmap name must be specified")
+	}
 	return webhook.ValidateWebhookContext(githubEventSource.Webhook)
 }
@@ -51,3 +51,15 @@ func TestValidateEventSource(t *testing.T) {
 		assert.NoError(t, err)
 	}
 }
+
+func TestValidateRegistrationPersistence(t *testing.T) {
+	eventSource := &v1alpha1.GithubEventSource{
+		Repositories:            []v1alpha1.OwnedRepositories{{Owner: "fake", Names: []string{"fake0"}}},
+		Webhook:                 &v1alpha1.WebhookContext{Endpoint: "/push", Port: "12000", Method: "POST"},
+		RegistrationPersistence: &v1alpha1.ConfigMapPersistence{},
+	}
+	err := validate(eventSource)
+	assert.EqualError(t, err, "registration persistence configmap name must be specified")
+	eventSource.RegistrationPersistence.Name = "github-registrations"
+	assert.NoError(t, validate(eventSource))
+}
@@ -0,0 +1,97 @@
+package gitlab
+
+import (
+	"reflect"
+	"strconv"
+
+	"go.uber.org/zap"
+
+	"github.com/argoproj/argo-events/eventsources/persist"
+)
+
+func groupRegistrationKey(group string) string {
+	return "group:" + group
+}
+
+func projectRegistrationKey(project string) string {
+	return "project:" + project
+}
+
+// registeredHookID returns the persisted hook ID of a registration key.
+func (router *Router) registeredHookID(key string) (int, bool) {
+	value, ok := router.registrations[key]
+	if !ok {
+		return 0, false
+	}
+	id, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, false
+	}
+	return id, true
+}
+
+// allRegistered returns whether a hook ID is persisted for all the groups and projects.
+func (router *Router) allRegistered() bool {
+	if len(router.registrations) == 0 {
+		return false
+	}
+	for _, g := range router.gitlabEventSource.GetGroups() {
+		if _, ok := router.registeredHookID(groupRegistrationKey(g)); !ok {
+			return false
+		}
+	}
+	for _, p := range router.gitlabEventSource.GetProjects() {
+		if _, ok := router.registeredHookID(projectRegistrationKey(p)); !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// resumeGroupHook returns the persisted hook ID of a group, if the hook still exists and matches the url.
+func (router *Router) resumeGroupHook(group, url string) (int, bool) {
+	id, ok := router.registeredHookID(groupRegistrationKey(group))
+	if !ok {
+		return 0, false
+	}
+	hook, _, err := router.gitlabClient.Groups.GetGroupHook(group, id)
+	if err != nil || hook.URL != url {
+		return 0, false
+	}
+	return id, true
+}
+
+// resumeProjectHook returns the persisted hook ID of a project, if the hook still exists and matches the url.
+func (router *Router) resumeProjectHook(project, url string) (int, bool) {
+	id, ok := router.registeredHookID(projectRegistrationKey(project))
+	if !ok {
+		return 0, false
+	}
+	hook, _, err := router.gitlabClient.Projects.GetProjectHook(project, id)
+	if err != nil || hook.URL != url {
+		return 0, false
+	}
+	return id, true
+}
+
+// saveRegistrations persists the hook IDs, if they changed.
+func (router *Router) saveRegistrations(logger *zap.SugaredLogger) {
+	if router.registrationPersistence == nil {
+		return
+	}
+	registrations := persist.Registrations{}
+	for g, id := range router.groupHookIDs {
+		registrations[groupRegistrationKey(g)] = strconv.Itoa(id)
+	}
+	for p, id := range router.projectHookIDs {
+		registrations[projectRegistrationKey(p)] = strconv.Itoa(id)
+	}
+	if reflect.DeepEqual(registrations, router.registrations) {
+		return
+	}
+	if err := persist.SaveRegistrations(router.registrationPersistence, router.registrationKey, registrations); err != nil {
+		logger.Errorw("failed to persist the hook IDs", zap.Error(err))
+		return
+	}
+	router.registrations = registrations
+}
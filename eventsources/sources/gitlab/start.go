@@ -20,6 +20,7 @@ import (
 	"context"
 	"crypto/rand"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"math/big"
@@ -30,7 +31,9 @@ import (
 	"github.com/argoproj/argo-events/common"
 	"github.com/argoproj/argo-events/common/logging"
 	eventsourcecommon "github.com/argoproj/argo-events/eventsources/common"
+	"github.com/argoproj/argo-events/eventsources/common/scm"
 	"github.com/argoproj/argo-events/eventsources/common/webhook"
+	"github.com/argoproj/argo-events/eventsources/persist"
 	"github.com/argoproj/argo-events/eventsources/sources"
 	"github.com/argoproj/argo-events/pkg/apis/events"
 	"github.com/xanzy/go-gitlab"
@@ -82,6 +85,7 @@ func (router *Router) HandleRoute(writer http.ResponseWriter, request *http.Requ
 
 	if router.secretToken != "" {
 		if t := request.Header.Get("X-Gitlab-Token"); t != router.secretToken {
+			webhook.AuthFailed(request)
 			common.SendErrorResponse(writer, "token mismatch")
 			return
 		}
@@ -100,6 +104,9 @@ func (router *Router) HandleRoute(writer http.ResponseWriter, request *http.Requ
 		Body:     (*json.RawMessage)(&body),
 		Metadata: router.gitlabEventSource.Metadata,
 	}
+	if router.gitlabEventSource.Normalize {
+		event.Normalized = scm.NormalizeGitLab(request.Header, body)
+	}
 
 	eventBody, err := json.Marshal(event)
 	if err != nil {
@@ -110,7 +117,7 @@ func (router *Router) HandleRoute(writer http.ResponseWriter, request *http.Requ
 	}
 
 	logger.Info("dispatching event on route's data channel")
-	route.DataCh <- eventBody
+	route.Deliver(request, eventBody)
 
 	logger.Info("request successfully processed")
 	common.SendSuccessResponse(writer, "success")
@@ -244,11 +251,30 @@ func (el *EventListener) StartListening(ctx context.Context, dispatch func([]byt
 			return fmt.Errorf("failed to initialize client, %w", err)
 		}
 
-		f := func() {
+		if gitlabEventSource.RegistrationPersistence != nil {
+			logger.Info("loading the persisted hook IDs...")
+			router.registrationPersistence, err = persist.NewInClusterConfigMapPersist(ctx, gitlabEventSource.RegistrationPersistence, el.Namespace)
+			if err != nil {
+				return fmt.Errorf("failed to set up the registration persistence, %w", err)
+			}
+			router.registrationKey = fmt.Sprintf("%s.%s", el.EventSourceName, el.EventName)
+			if router.registrations, err = persist.LoadRegistrations(router.registrationPersistence, router.registrationKey); err != nil {
+				logger.Warnw("failed to load the persisted hook IDs, the hooks will be looked up", zap.Error(err))
+			}
+		}
+
+		// f registers the hooks which are not registered yet, it returns the errors of the ones failing to be registered.
+		f := func() error {
+			var errs []error
 			for _, g := range gitlabEventSource.GetGroups() {
+				if id, ok := router.resumeGroupHook(g, formattedURL); ok {
+					router.groupHookIDs[g] = id
+					continue
+				}
 				hooks, _, err := router.gitlabClient.Groups.ListGroupHooks(g, &gitlab.ListGroupHooksOptions{})
 				if err != nil {
 					logger.Errorf("failed to list existing webhooks of group %s. err: %+v", g, err)
+					errs = append(errs, fmt.Errorf("failed to list the webhooks of group %s, %w", g, err))
 					continue
 				}
 				hook := getGroupHook(hooks, formattedURL)
@@ -260,6 +286,7 @@ func (el *EventListener) StartListening(ctx context.Context, dispatch func([]byt
 				hook, _, err = router.gitlabClient.Groups.AddGroupHook(g, groupHookOpt)
 				if err != nil {
 					logger.Errorf("failed to create gitlab webhook for group %s. err: %+v", g, err)
+					errs = append(errs, fmt.Errorf("failed to create the webhook of group %s, %w", g, err))
 					continue
 				}
 				router.groupHookIDs[g] = hook.ID
@@ -267,9 +294,14 @@ func (el *EventListener) StartListening(ctx context.Context, dispatch func([]byt
 			}
 
 			for _, p := range gitlabEventSource.GetProjects() {
+				if id, ok := router.resumeProjectHook(p, formattedURL); ok {
+					router.projectHookIDs[p] = id
+					continue
+				}
 				hooks, _, err := router.gitlabClient.Projects.ListProjectHooks(p, &gitlab.ListProjectHooksOptions{})
 				if err != nil {
 					logger.Errorf("failed to list existing webhooks of project %s. err: %+v", p, err)
+					errs = append(errs, fmt.Errorf("failed to list the webhooks of project %s, %w", p, err))
 					continue
 				}
 				hook := getProjectHook(hooks, formattedURL)
@@ -281,17 +313,26 @@ func (el *EventListener) StartListening(ctx context.Context, dispatch func([]byt
 				hook, _, err = router.gitlabClient.Projects.AddProjectHook(p, opt)
 				if err != nil {
 					logger.Errorf("failed to create gitlab webhook for project %s. err: %+v", p, err)
+					errs = append(errs, fmt.Errorf("failed to create the webhook of project %s, %w", p, err))
 					continue
 				}
 				router.projectHookIDs[p] = hook.ID
 				time.Sleep(500 * time.Millisecond)
 			}
+			router.saveRegistrations(logger)
+			return errors.Join(errs...)
 		}
 
-		// Mitigate race condtions - it might create multiple hooks with same config when replicas > 1
-		randomNum, _ := rand.Int(rand.Reader, big.NewInt(int64(2000)))
-		time.Sleep(time.Duration(randomNum.Int64()) * time.Millisecond)
-		f()
+		// Mitigate race condtions - it might create multiple hooks with same config when replicas > 1,
+		// unless the hooks are resumed from the persisted IDs.
+		if !router.allRegistered() {
+			randomNum, _ := rand.Int(rand.Reader, big.NewInt(int64(2000)))
+			time.Sleep(time.Duration(randomNum.Int64()) * time.Millisecond)
+		}
+		target := el.GetEventSourceName() + "/" + el.GetEventName()
+		if err := common.DoWithTrackedRetry("eventsource.register", target, nil, f); err != nil {
+			logger.Errorw("failed to register the webhooks, retrying with the hooks manager daemon", zap.Error(err))
+		}
 
 		ctx, cancel := context.WithCancel(ctx)
 		defer cancel()
@@ -301,17 +342,8 @@ func (el *EventListener) StartListening(ctx context.Context, dispatch func([]byt
 			// and old pod terminates, if DeleteHookOnFinish is true, the hook will be deleted from gitlab.
 			// This is a workround to mitigate the race conditions.
 			logger.Info("starting gitlab hooks manager daemon")
-			ticker := time.NewTicker(60 * time.Second)
-			defer ticker.Stop()
-			for {
-				select {
-				case <-ctx.Done():
-					logger.Info("exiting gitlab hooks manager daemon")
-					return
-				case <-ticker.C:
-					f()
-				}
-			}
+			common.PollWithTrackedRetry(ctx, "eventsource.register", target, 60*time.Second, f)
+			logger.Info("exiting gitlab hooks manager daemon")
 		}()
 	} else {
 		logger.Info("no need to create webhooks")
@@ -20,6 +20,7 @@ import (
 	"github.com/xanzy/go-gitlab"
 
 	"github.com/argoproj/argo-events/eventsources/common/webhook"
+	"github.com/argoproj/argo-events/eventsources/persist"
 	metrics "github.com/argoproj/argo-events/metrics"
 	apicommon "github.com/argoproj/argo-events/pkg/apis/common"
 	"github.com/argoproj/argo-events/pkg/apis/eventsource/v1alpha1"
@@ -30,6 +31,7 @@ type EventListener struct {
 	EventSourceName   string
 	EventName         string
 	GitlabEventSource v1alpha1.GitlabEventSource
+	Namespace         string
 	Metrics           *metrics.Metrics
 }
 
@@ -58,6 +60,12 @@ type Router struct {
 	projectHookIDs map[string]int
 	// groupID -> hook ID
 	groupHookIDs map[string]int
+	// registrationPersistence persists the hook IDs when the registration persistence is enabled
+	registrationPersistence persist.EventPersist
+	// registrationKey is the key of the hook IDs in the registration persistence
+	registrationKey string
+	// registrations are the persisted hook IDs
+	registrations persist.Registrations
 	// gitlabEventSource is the event source that contains configuration necessary to consume events from GitLab
 	gitlabEventSource *v1alpha1.GitlabEventSource
 	// gitlab webhook secret token
@@ -43,5 +43,8 @@ func validate(eventSource *v1alpha1.GitlabEventSource) error {
 	if eventSource.AccessToken == nil {
 		return fmt.Errorf("access token can't be nil")
 	}
+	if eventSource.RegistrationPersistence != nil && eventSource.RegistrationPersistence.Name == "" {
+		return fmt.Errorf("registration persistence configmap name must be specified")
+	}
 	return webhook.ValidateWebhookContext(eventSource.Webhook)
 }
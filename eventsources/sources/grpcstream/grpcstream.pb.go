@@ -0,0 +1,309 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: grpcstream.proto
+
+package grpcstream
+
+import (
+	context "context"
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+type SubscribeRequest struct {
+	// The event name of the event source.
+	EventName string `protobuf:"bytes,1,opt,name=event_name,json=eventName,proto3" json:"event_name,omitempty"`
+	// The topic to subscribe to, as configured in the event source.
+	Topic string `protobuf:"bytes,2,opt,name=topic,proto3" json:"topic,omitempty"`
+	// The ID of the last event received by the event source, empty on the first subscription.
+	// The server should resume the stream after this event.
+	LastEventId          string   `protobuf:"bytes,3,opt,name=last_event_id,json=lastEventId,proto3" json:"last_event_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SubscribeRequest) Reset()         { *m = SubscribeRequest{} }
+func (m *SubscribeRequest) String() string { return proto.CompactTextString(m) }
+func (*SubscribeRequest) ProtoMessage()    {}
+func (*SubscribeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ac82794415c4375f, []int{0}
+}
+func (m *SubscribeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SubscribeRequest.Unmarshal(m, b)
+}
+func (m *SubscribeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SubscribeRequest.Marshal(b, m, deterministic)
+}
+func (m *SubscribeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubscribeRequest.Merge(m, src)
+}
+func (m *SubscribeRequest) XXX_Size() int {
+	return xxx_messageInfo_SubscribeRequest.Size(m)
+}
+func (m *SubscribeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubscribeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubscribeRequest proto.InternalMessageInfo
+
+func (m *SubscribeRequest) GetEventName() string {
+	if m != nil {
+		return m.EventName
+	}
+	return ""
+}
+
+func (m *SubscribeRequest) GetTopic() string {
+	if m != nil {
+		return m.Topic
+	}
+	return ""
+}
+
+func (m *SubscribeRequest) GetLastEventId() string {
+	if m != nil {
+		return m.LastEventId
+	}
+	return ""
+}
+
+// *
+// Represents an event
+type Event struct {
+	// The event ID, used as the CloudEvent ID and to resume the stream.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The event type.
+	Type string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	// The event subject.
+	Subject string `protobuf:"bytes,3,opt,name=subject,proto3" json:"subject,omitempty"`
+	// The content type of the data, the data is embedded as JSON if it is "application/json".
+	ContentType string `protobuf:"bytes,4,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	// The event payload.
+	Data []byte `protobuf:"bytes,5,opt,name=data,proto3" json:"data,omitempty"`
+	// The event attributes.
+	Attributes           map[string]string `protobuf:"bytes,6,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *Event) Reset()         { *m = Event{} }
+func (m *Event) String() string { return proto.CompactTextString(m) }
+func (*Event) ProtoMessage()    {}
+func (*Event) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ac82794415c4375f, []int{1}
+}
+func (m *Event) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Event.Unmarshal(m, b)
+}
+func (m *Event) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Event.Marshal(b, m, deterministic)
+}
+func (m *Event) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Event.Merge(m, src)
+}
+func (m *Event) XXX_Size() int {
+	return xxx_messageInfo_Event.Size(m)
+}
+func (m *Event) XXX_DiscardUnknown() {
+	xxx_messageInfo_Event.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Event proto.InternalMessageInfo
+
+func (m *Event) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *Event) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *Event) GetSubject() string {
+	if m != nil {
+		return m.Subject
+	}
+	return ""
+}
+
+func (m *Event) GetContentType() string {
+	if m != nil {
+		return m.ContentType
+	}
+	return ""
+}
+
+func (m *Event) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *Event) GetAttributes() map[string]string {
+	if m != nil {
+		return m.Attributes
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*SubscribeRequest)(nil), "grpcstream.SubscribeRequest")
+	proto.RegisterType((*Event)(nil), "grpcstream.Event")
+	proto.RegisterMapType((map[string]string)(nil), "grpcstream.Event.AttributesEntry")
+}
+
+func init() {
+	proto.RegisterFile("grpcstream.proto", fileDescriptor_ac82794415c4375f)
+}
+
+var fileDescriptor_ac82794415c4375f = []byte{
+	// 300 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x51, 0xcf, 0x4f, 0xf2, 0x40,
+	0x10, 0xcd, 0x96, 0x1f, 0x5f, 0x18, 0xf8, 0x14, 0x27, 0x1e, 0x36, 0x44, 0x13, 0xe0, 0xc4, 0x89,
+	0x18, 0xbc, 0x18, 0x13, 0x4d, 0x38, 0x70, 0xf0, 0xa0, 0x87, 0xe2, 0x9d, 0x6c, 0xdb, 0x89, 0x59,
+	0x81, 0xb6, 0xee, 0x4e, 0x49, 0xfa, 0xcf, 0x1b, 0xb3, 0xdb, 0x82, 0x0d, 0xde, 0xde, 0xbc, 0xd9,
+	0x37, 0x6f, 0xf6, 0x0d, 0x0c, 0x3f, 0x4c, 0x1e, 0x5b, 0x36, 0xa4, 0xf6, 0xf3, 0xdc, 0x64, 0x9c,
+	0x21, 0xfc, 0x32, 0xd3, 0x2d, 0x0c, 0xd7, 0x45, 0x64, 0x63, 0xa3, 0x23, 0x0a, 0xe9, 0xab, 0x20,
+	0xcb, 0x78, 0x0b, 0x40, 0x07, 0x4a, 0x79, 0x93, 0xaa, 0x3d, 0x49, 0x31, 0x16, 0xb3, 0x5e, 0xd8,
+	0xf3, 0xcc, 0x9b, 0xda, 0x13, 0x5e, 0x43, 0x87, 0xb3, 0x5c, 0xc7, 0x32, 0xf0, 0x9d, 0xaa, 0xc0,
+	0x29, 0xfc, 0xdf, 0x29, 0xcb, 0x9b, 0x4a, 0xa9, 0x13, 0xd9, 0xf2, 0xdd, 0xbe, 0x23, 0x57, 0x8e,
+	0x7b, 0x49, 0xa6, 0xdf, 0x02, 0x3a, 0x1e, 0xe3, 0x05, 0x04, 0x3a, 0xa9, 0x47, 0x07, 0x3a, 0x41,
+	0x84, 0x36, 0x97, 0x39, 0xd5, 0x23, 0x3d, 0x46, 0x09, 0xff, 0x6c, 0x11, 0x7d, 0x52, 0xcc, 0xf5,
+	0xac, 0x63, 0x89, 0x13, 0x18, 0xc4, 0x59, 0xca, 0xce, 0xc8, 0xab, 0xda, 0x95, 0x55, 0xcd, 0xbd,
+	0x3b, 0x31, 0x42, 0x3b, 0x51, 0xac, 0x64, 0x67, 0x2c, 0x66, 0x83, 0xd0, 0x63, 0x5c, 0x02, 0x28,
+	0x66, 0xa3, 0xa3, 0x82, 0xc9, 0xca, 0xee, 0xb8, 0x35, 0xeb, 0x2f, 0x26, 0xf3, 0x46, 0x3c, 0x7e,
+	0xb7, 0xf9, 0xf2, 0xf4, 0x66, 0x95, 0xb2, 0x29, 0xc3, 0x86, 0x68, 0xf4, 0x04, 0x97, 0x67, 0x6d,
+	0x1c, 0x42, 0x6b, 0x4b, 0x65, 0xfd, 0x17, 0x07, 0x5d, 0x40, 0x07, 0xb5, 0x2b, 0x8e, 0xbf, 0xa9,
+	0x8a, 0xc7, 0xe0, 0x41, 0x2c, 0x5e, 0xa1, 0xef, 0x3d, 0xd6, 0xde, 0x0f, 0x9f, 0xa1, 0x77, 0x0a,
+	0x1f, 0x6f, 0x9a, 0x9b, 0x9c, 0xdf, 0x64, 0x74, 0xf5, 0x67, 0xcf, 0x3b, 0x11, 0x75, 0xfd, 0x3d,
+	0xef, 0x7f, 0x06, 0x00, 0xe8, 0x43, 0xb3, 0xa4, 0xe3, 0x01, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// EventStreamClient is the client API for EventStream service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type EventStreamClient interface {
+	Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (EventStream_SubscribeClient, error)
+}
+
+type eventStreamClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewEventStreamClient(cc grpc.ClientConnInterface) EventStreamClient {
+	return &eventStreamClient{cc}
+}
+
+func (c *eventStreamClient) Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (EventStream_SubscribeClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_EventStream_serviceDesc.Streams[0], "/grpcstream.EventStream/Subscribe", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &eventStreamSubscribeClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type EventStream_SubscribeClient interface {
+	Recv() (*Event, error)
+	grpc.ClientStream
+}
+
+type eventStreamSubscribeClient struct {
+	grpc.ClientStream
+}
+
+func (x *eventStreamSubscribeClient) Recv() (*Event, error) {
+	m := new(Event)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// EventStreamServer is the server API for EventStream service.
+type EventStreamServer interface {
+	Subscribe(*SubscribeRequest, EventStream_SubscribeServer) error
+}
+
+// UnimplementedEventStreamServer can be embedded to have forward compatible implementations.
+type UnimplementedEventStreamServer struct {
+}
+
+func (*UnimplementedEventStreamServer) Subscribe(req *SubscribeRequest, srv EventStream_SubscribeServer) error {
+	return status.Errorf(codes.Unimplemented, "method Subscribe not implemented")
+}
+
+func RegisterEventStreamServer(s *grpc.Server, srv EventStreamServer) {
+	s.RegisterService(&_EventStream_serviceDesc, srv)
+}
+
+func _EventStream_Subscribe_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(EventStreamServer).Subscribe(m, &eventStreamSubscribeServer{stream})
+}
+
+type EventStream_SubscribeServer interface {
+	Send(*Event) error
+	grpc.ServerStream
+}
+
+type eventStreamSubscribeServer struct {
+	grpc.ServerStream
+}
+
+func (x *eventStreamSubscribeServer) Send(m *Event) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var _EventStream_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "grpcstream.EventStream",
+	HandlerType: (*EventStreamServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Subscribe",
+			Handler:       _EventStream_Subscribe_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "grpcstream.proto",
+}
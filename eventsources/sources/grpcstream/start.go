@@ -0,0 +1,220 @@
+/*
+Copyright 2020 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpcstream
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"mime"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/argoproj/argo-events/common"
+	"github.com/argoproj/argo-events/common/logging"
+	eventsourcecommon "github.com/argoproj/argo-events/eventsources/common"
+	"github.com/argoproj/argo-events/eventsources/sources"
+	metrics "github.com/argoproj/argo-events/metrics"
+	apicommon "github.com/argoproj/argo-events/pkg/apis/common"
+	"github.com/argoproj/argo-events/pkg/apis/events"
+	"github.com/argoproj/argo-events/pkg/apis/eventsource/v1alpha1"
+)
+
+// resubscribeDelay is the delay before resubscribing to a broken stream, the connection backoff only applying
+// to the failed subscriptions.
+const resubscribeDelay = time.Second
+
+// EventListener implements Eventing for the gRPC stream event source
+type EventListener struct {
+	EventSourceName string
+	EventName       string
+	EventSource     v1alpha1.GRPCStreamEventSource
+	Metrics         *metrics.Metrics
+}
+
+// GetEventSourceName returns name of event source
+func (el *EventListener) GetEventSourceName() string {
+	return el.EventSourceName
+}
+
+// GetEventName returns name of event
+func (el *EventListener) GetEventName() string {
+	return el.EventName
+}
+
+// GetEventSourceType return type of event server
+func (el *EventListener) GetEventSourceType() apicommon.EventSourceType {
+	return apicommon.GRPCStreamEvent
+}
+
+// StartListening subscribes to the event stream of the server, and resubscribes after the last received
+// event once the stream is broken.
+func (el *EventListener) StartListening(ctx context.Context, dispatch func([]byte, ...eventsourcecommon.Option) error) error {
+	log := logging.FromContext(ctx).
+		With(zap.String(logging.LabelEventSourceType, string(el.GetEventSourceType())),
+			zap.String(logging.LabelEventName, el.GetEventName()),
+			zap.String("url", el.EventSource.URL))
+	log.Info("started processing the gRPC stream event source...")
+	defer sources.Recover(el.GetEventName())
+
+	lastEventID := ""
+	for {
+		var conn *grpc.ClientConn
+		var stream EventStream_SubscribeClient
+		if err := common.DoWithTrackedRetry("eventsource.connect", el.GetEventSourceName()+"/"+el.GetEventName(), el.EventSource.ConnectionBackoff, func() error {
+			var err error
+			conn, stream, err = el.subscribe(ctx, lastEventID)
+			return err
+		}); err != nil {
+			if ctx.Err() != nil {
+				log.Info("event source is stopped")
+				return nil
+			}
+			return fmt.Errorf("failed to subscribe to the event stream of %s, %w", el.EventSource.URL, err)
+		}
+		log.Infow("subscribed to the event stream", zap.String("lastEventID", lastEventID))
+		lastEventID = el.consume(stream, lastEventID, dispatch, log)
+		_ = conn.Close()
+		select {
+		case <-ctx.Done():
+			log.Info("event source is stopped")
+			return nil
+		case <-time.After(resubscribeDelay):
+		}
+	}
+}
+
+// consume handles the events of the stream one at a time until it is broken, and returns the ID of the last
+// received event. The next event is only received once the previous one is dispatched, the gRPC flow control
+// slowing the server down when the events can't be dispatched as fast as they are produced.
+func (el *EventListener) consume(stream EventStream_SubscribeClient, lastEventID string, dispatch func([]byte, ...eventsourcecommon.Option) error, log *zap.SugaredLogger) string {
+	for {
+		event, err := stream.Recv()
+		if err != nil {
+			if stream.Context().Err() == nil {
+				log.Errorw("the event stream is broken, resubscribing...", zap.Error(err))
+			}
+			return lastEventID
+		}
+		if err := el.handleOne(event, dispatch, log); err != nil {
+			log.Errorw("failed to process an event", zap.String("id", event.Id), zap.Error(err))
+			el.Metrics.EventProcessingFailed(el.GetEventSourceName(), el.GetEventName())
+		}
+		if event.Id != "" {
+			lastEventID = event.Id
+		}
+	}
+}
+
+func (el *EventListener) handleOne(event *Event, dispatch func([]byte, ...eventsourcecommon.Option) error, log *zap.SugaredLogger) error {
+	defer func(start time.Time) {
+		el.Metrics.EventProcessingDuration(el.GetEventSourceName(), el.GetEventName(), float64(time.Since(start)/time.Millisecond))
+	}(time.Now())
+
+	log.Infow("received an event", zap.String("id", event.Id))
+	eventData := &events.GRPCStreamEventData{
+		ID:          event.Id,
+		Type:        event.Type,
+		Subject:     event.Subject,
+		ContentType: event.ContentType,
+		Attributes:  event.Attributes,
+		Body:        event.Data,
+		Metadata:    el.EventSource.Metadata,
+	}
+	if isJSON(event.ContentType) {
+		if !json.Valid(event.Data) {
+			return fmt.Errorf("the data of the event is not valid JSON, rejecting the event")
+		}
+		eventData.Body = json.RawMessage(event.Data)
+	}
+	eventBody, err := json.Marshal(eventData)
+	if err != nil {
+		return fmt.Errorf("failed to marshal the event data, rejecting the event, %w", err)
+	}
+	var opts []eventsourcecommon.Option
+	if event.Id != "" {
+		opts = append(opts, eventsourcecommon.WithID(event.Id))
+	}
+	if err = dispatch(eventBody, opts...); err != nil {
+		return fmt.Errorf("failed to dispatch a gRPC stream event, %w", err)
+	}
+	return nil
+}
+
+// isJSON tells whether the content type is "application/json" or a "+json" structured syntax.
+func isJSON(contentType string) bool {
+	if contentType == "" {
+		return false
+	}
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false
+	}
+	return mediaType == "application/json" || strings.HasSuffix(mediaType, "+json")
+}
+
+// subscribe connects to the server and subscribes to the events following lastEventID.
+func (el *EventListener) subscribe(ctx context.Context, lastEventID string) (*grpc.ClientConn, EventStream_SubscribeClient, error) {
+	creds, err := el.transportCredentials()
+	if err != nil {
+		return nil, nil, err
+	}
+	conn, err := grpc.DialContext(ctx, el.EventSource.URL, grpc.WithTransportCredentials(creds))
+	if err != nil {
+		return nil, nil, err
+	}
+	if el.EventSource.AuthSecret != nil {
+		token, err := common.GetSecretFromVolume(el.EventSource.AuthSecret)
+		if err != nil {
+			_ = conn.Close()
+			return nil, nil, err
+		}
+		ctx = metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+token)
+	}
+	stream, err := NewEventStreamClient(conn).Subscribe(ctx, &SubscribeRequest{
+		EventName:   el.GetEventName(),
+		Topic:       el.EventSource.Topic,
+		LastEventId: lastEventID,
+	})
+	if err != nil {
+		_ = conn.Close()
+		return nil, nil, err
+	}
+	return conn, stream, nil
+}
+
+func (el *EventListener) transportCredentials() (credentials.TransportCredentials, error) {
+	switch {
+	case el.EventSource.Insecure:
+		return insecure.NewCredentials(), nil
+	case el.EventSource.TLS != nil:
+		tlsConfig, err := common.GetTLSConfig(el.EventSource.TLS)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get the tls configuration, %w", err)
+		}
+		return credentials.NewTLS(tlsConfig), nil
+	default:
+		return credentials.NewTLS(&tls.Config{}), nil
+	}
+}
@@ -0,0 +1,130 @@
+/*
+Copyright 2020 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpcstream
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"sync"
+	"testing"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2/event"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	eventsourcecommon "github.com/argoproj/argo-events/eventsources/common"
+	metrics "github.com/argoproj/argo-events/metrics"
+	"github.com/argoproj/argo-events/pkg/apis/events"
+	"github.com/argoproj/argo-events/pkg/apis/eventsource/v1alpha1"
+)
+
+// fakeServer streams the events 1 to total after the last event ID of the request, and breaks the first
+// stream after two events
+type fakeServer struct {
+	UnimplementedEventStreamServer
+	total int
+
+	mu       sync.Mutex
+	requests []*SubscribeRequest
+}
+
+func (s *fakeServer) Subscribe(req *SubscribeRequest, stream EventStream_SubscribeServer) error {
+	s.mu.Lock()
+	s.requests = append(s.requests, req)
+	first := len(s.requests) == 1
+	s.mu.Unlock()
+	after := 0
+	if req.LastEventId != "" {
+		_, _ = fmt.Sscan(req.LastEventId, &after)
+	}
+	for n := after + 1; n <= s.total; n++ {
+		if first && n > 2 {
+			return fmt.Errorf("stream is broken")
+		}
+		event := &Event{Id: fmt.Sprint(n), Type: "order.created", ContentType: "application/json", Data: []byte(fmt.Sprintf(`{"n":%d}`, n))}
+		if n == s.total {
+			event.ContentType = "text/plain"
+			event.Data = []byte("last")
+		}
+		if err := stream.Send(event); err != nil {
+			return err
+		}
+	}
+	<-stream.Context().Done()
+	return nil
+}
+
+func TestStartListening(t *testing.T) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	server := grpc.NewServer()
+	fake := &fakeServer{total: 4}
+	RegisterEventStreamServer(server, fake)
+	go func() { _ = server.Serve(lis) }()
+	defer server.Stop()
+
+	el := &EventListener{
+		EventSourceName: "grpc",
+		EventName:       "example",
+		EventSource:     v1alpha1.GRPCStreamEventSource{URL: lis.Addr().String(), Topic: "orders", Insecure: true, Metadata: map[string]string{"env": "test"}},
+		Metrics:         metrics.NewMetrics("argo-events"),
+	}
+	ctx, cancel := context.WithCancel(context.TODO())
+	defer cancel()
+	var received []events.GRPCStreamEventData
+	var ids []string
+	dispatch := func(data []byte, opts ...eventsourcecommon.Option) error {
+		var eventData events.GRPCStreamEventData
+		require.NoError(t, json.Unmarshal(data, &eventData))
+		received = append(received, eventData)
+		event := cloudevents.New()
+		for _, opt := range opts {
+			require.NoError(t, opt(&event))
+		}
+		ids = append(ids, event.ID())
+		if len(received) == fake.total {
+			cancel()
+		}
+		return nil
+	}
+	require.NoError(t, el.StartListening(ctx, dispatch))
+
+	assert.Equal(t, []string{"1", "2", "3", "4"}, ids)
+	assert.Equal(t, map[string]interface{}{"n": float64(1)}, received[0].Body)
+	assert.Equal(t, "order.created", received[0].Type)
+	assert.Equal(t, "test", received[0].Metadata["env"])
+	// The data which is not JSON is base64 encoded
+	assert.Equal(t, "bGFzdA==", received[3].Body)
+
+	// The broken stream is resumed after the last received event
+	require.Len(t, fake.requests, 2)
+	assert.Equal(t, "example", fake.requests[0].EventName)
+	assert.Equal(t, "orders", fake.requests[0].Topic)
+	assert.Equal(t, "", fake.requests[0].LastEventId)
+	assert.Equal(t, "2", fake.requests[1].LastEventId)
+}
+
+func TestIsJSON(t *testing.T) {
+	assert.True(t, isJSON("application/json"))
+	assert.True(t, isJSON("application/json; charset=utf-8"))
+	assert.True(t, isJSON("application/cloudevents+json"))
+	assert.False(t, isJSON("text/plain"))
+	assert.False(t, isJSON(""))
+}
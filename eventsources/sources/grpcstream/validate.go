@@ -0,0 +1,54 @@
+/*
+Copyright 2020 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpcstream
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/argoproj/argo-events/common"
+	apicommon "github.com/argoproj/argo-events/pkg/apis/common"
+	"github.com/argoproj/argo-events/pkg/apis/eventsource/v1alpha1"
+)
+
+// ValidateEventSource validates the gRPC stream event source
+func (el *EventListener) ValidateEventSource(ctx context.Context) error {
+	return validate(&el.EventSource)
+}
+
+func validate(eventSource *v1alpha1.GRPCStreamEventSource) error {
+	if eventSource == nil {
+		return common.ErrNilEventSource
+	}
+	if eventSource.URL == "" {
+		return fmt.Errorf("url must be specified")
+	}
+	if eventSource.Insecure && eventSource.TLS != nil {
+		return fmt.Errorf("insecure and tls can't be both specified")
+	}
+	if eventSource.TLS != nil {
+		if err := apicommon.ValidateTLSConfig(eventSource.TLS); err != nil {
+			return err
+		}
+	}
+	if eventSource.ConnectionBackoff != nil {
+		if _, err := common.Convert2WaitBackoff(eventSource.ConnectionBackoff); err != nil {
+			return fmt.Errorf("invalid connection backoff, %w", err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,222 @@
+/*
+Copyright 2020 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package jenkins
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/argoproj/argo-events/common"
+	"github.com/argoproj/argo-events/common/logging"
+	eventsourcecommon "github.com/argoproj/argo-events/eventsources/common"
+	"github.com/argoproj/argo-events/eventsources/common/webhook"
+	"github.com/argoproj/argo-events/eventsources/sources"
+	apicommon "github.com/argoproj/argo-events/pkg/apis/common"
+	"github.com/argoproj/argo-events/pkg/apis/events"
+)
+
+// controller controls the webhook operations
+var (
+	controller = webhook.NewController()
+)
+
+// set up the activation and inactivation channels to control the state of routes.
+func init() {
+	go webhook.ProcessRouteStatus(controller)
+}
+
+// GetEventSourceName returns name of event source
+func (el *EventListener) GetEventSourceName() string {
+	return el.EventSourceName
+}
+
+// GetEventName returns name of event
+func (el *EventListener) GetEventName() string {
+	return el.EventName
+}
+
+// GetEventSourceType return type of event server
+func (el *EventListener) GetEventSourceType() apicommon.EventSourceType {
+	return apicommon.JenkinsEvent
+}
+
+// Implement Router
+// 1. GetRoute
+// 2. HandleRoute
+// 3. PostActivate
+// 4. PostDeactivate
+
+// GetRoute returns the route
+func (rc *Router) GetRoute() *webhook.Route {
+	return rc.route
+}
+
+// HandleRoute handles incoming requests on the route
+func (rc *Router) HandleRoute(writer http.ResponseWriter, request *http.Request) {
+	route := rc.route
+
+	logger := route.Logger.With(
+		logging.LabelEndpoint, route.Context.Endpoint,
+		logging.LabelPort, route.Context.Port,
+		logging.LabelHTTPMethod, route.Context.Method,
+	)
+
+	logger.Info("request a received, processing it...")
+
+	if !route.Active {
+		logger.Warn("endpoint is not active, won't process it")
+		common.SendErrorResponse(writer, "endpoint is inactive")
+		return
+	}
+
+	defer func(start time.Time) {
+		route.Metrics.EventProcessingDuration(route.EventSourceName, route.EventName, float64(time.Since(start)/time.Millisecond))
+	}(time.Now())
+
+	if rc.token != nil {
+		valid, err := rc.authenticate(request.URL.Query().Get("token"))
+		if err != nil {
+			logger.Errorw("failed to retrieve the token", zap.Error(err))
+			common.SendInternalErrorResponse(writer, "failed to retrieve the token")
+			route.Metrics.EventProcessingFailed(route.EventSourceName, route.EventName)
+			return
+		}
+		if !valid {
+			logger.Error("invalid token")
+			common.SendResponse(writer, http.StatusUnauthorized, "invalid token")
+			route.Metrics.EventProcessingFailed(route.EventSourceName, route.EventName)
+			return
+		}
+	}
+
+	request.Body = http.MaxBytesReader(writer, request.Body, route.Context.GetMaxPayloadSize())
+	body, err := io.ReadAll(request.Body)
+	if err != nil {
+		logger.Errorw("failed to read the request body", zap.Error(err))
+		common.SendErrorResponse(writer, "failed to read the request body")
+		route.Metrics.EventProcessingFailed(route.EventSourceName, route.EventName)
+		return
+	}
+
+	var n notification
+	if err := json.Unmarshal(body, &n); err != nil {
+		logger.Errorw("failed to parse the notification", zap.Error(err))
+		common.SendErrorResponse(writer, "failed to parse the notification")
+		route.Metrics.EventProcessingFailed(route.EventSourceName, route.EventName)
+		return
+	}
+	if !matches(rc.jenkinsEventSource.Jobs, n.Name) || !matches(rc.jenkinsEventSource.Phases, n.Build.Phase) {
+		logger.Debugw("ignored the notification", zap.String("job", n.Name), zap.String("phase", n.Build.Phase))
+		common.SendSuccessResponse(writer, "ignored")
+		return
+	}
+
+	rawBody := json.RawMessage(body)
+	data, err := json.Marshal(&events.JenkinsEventData{
+		Body:     &rawBody,
+		Metadata: rc.jenkinsEventSource.Metadata,
+	})
+	if err != nil {
+		logger.Errorw("failed to marshal the event data", zap.Error(err))
+		common.SendErrorResponse(writer, "invalid event")
+		route.Metrics.EventProcessingFailed(route.EventSourceName, route.EventName)
+		return
+	}
+
+	logger.Infow("dispatching event on route's data channel...", zap.String("job", n.Name), zap.String("phase", n.Build.Phase))
+	route.Deliver(request, data)
+	logger.Info("request successfully processed")
+	common.SendSuccessResponse(writer, "success")
+}
+
+// authenticate checks the token of a notification against the token secret, and the previous generated token
+// if any. The secrets are read for each notification, so that the rotated tokens are used without a restart.
+func (rc *Router) authenticate(given string) (bool, error) {
+	token, err := rc.readSecret(rc.token)
+	if err != nil {
+		return false, err
+	}
+	if subtle.ConstantTimeCompare([]byte(given), []byte(token)) == 1 {
+		return true, nil
+	}
+	if rc.previousToken == nil {
+		return false, nil
+	}
+	previous, err := rc.readSecret(rc.previousToken)
+	return err == nil && previous != "" && subtle.ConstantTimeCompare([]byte(given), []byte(previous)) == 1, nil
+}
+
+// matches returns whether the value is in the list, the empty list matching all the values
+func matches(list []string, value string) bool {
+	if len(list) == 0 {
+		return true
+	}
+	for _, v := range list {
+		if strings.EqualFold(v, value) {
+			return true
+		}
+	}
+	return false
+}
+
+// PostActivate performs operations once the route is activated and ready to consume requests
+func (rc *Router) PostActivate() error {
+	return nil
+}
+
+// PostInactivate performs operations after the route is inactivated
+func (rc *Router) PostInactivate() error {
+	return nil
+}
+
+// StartListening starts an event source
+func (el *EventListener) StartListening(ctx context.Context, dispatch func([]byte, ...eventsourcecommon.Option) error) error {
+	log := logging.FromContext(ctx).
+		With(logging.LabelEventSourceType, el.GetEventSourceType(), logging.LabelEventName, el.GetEventName())
+	log.Info("started processing the Jenkins event source...")
+	defer sources.Recover(el.GetEventName())
+
+	jenkinsEventSource := el.JenkinsEventSource.DeepCopy()
+	token, previousToken := jenkinsEventSource.Token, (*corev1.SecretKeySelector)(nil)
+	if jenkinsEventSource.Webhook.GenerateToken {
+		// The notification plugin can't set the Authorization header, the generated token is expected in the
+		// query parameter instead
+		if jenkinsEventSource.Webhook.AuthSecret == nil {
+			return fmt.Errorf("the generated token is not available")
+		}
+		token, previousToken = jenkinsEventSource.Webhook.AuthSecret, jenkinsEventSource.Webhook.PreviousAuthSecret()
+		jenkinsEventSource.Webhook.AuthSecret = nil
+	}
+	route := webhook.NewRoute(jenkinsEventSource.Webhook, log, el.GetEventSourceName(), el.GetEventName(), el.Metrics)
+
+	return webhook.ManageRoute(ctx, &Router{
+		route:              route,
+		jenkinsEventSource: jenkinsEventSource,
+		token:              token,
+		previousToken:      previousToken,
+		readSecret:         common.GetSecretFromVolume,
+	}, controller, dispatch)
+}
@@ -0,0 +1,122 @@
+/*
+Copyright 2020 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package jenkins
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/argoproj/argo-events/eventsources/common/webhook"
+	"github.com/argoproj/argo-events/pkg/apis/events"
+	"github.com/argoproj/argo-events/pkg/apis/eventsource/v1alpha1"
+)
+
+const completed = `{"name": "release/argo-events", "url": "job/release/job/argo-events/", "build": {"number": 7, "phase": "COMPLETED", "status": "SUCCESS"}}`
+
+func newRequest(query, body string) *http.Request {
+	return &http.Request{
+		Method: http.MethodPost,
+		URL:    &url.URL{Path: "/example", RawQuery: query},
+		Body:   io.NopCloser(bytes.NewReader([]byte(body))),
+	}
+}
+
+// fakeSecrets holds the values of the secrets by key
+type fakeSecrets map[string]string
+
+func (s fakeSecrets) read(selector *corev1.SecretKeySelector) (string, error) {
+	value, ok := s[selector.Key]
+	if !ok {
+		return "", fmt.Errorf("secret key %s not found", selector.Key)
+	}
+	return value, nil
+}
+
+func TestHandleRoute(t *testing.T) {
+	secrets := fakeSecrets{"token": "secret"}
+	router := &Router{
+		route: webhook.GetFakeRoute(),
+		jenkinsEventSource: &v1alpha1.JenkinsEventSource{
+			Jobs:     []string{"release/argo-events"},
+			Phases:   []string{"COMPLETED"},
+			Metadata: map[string]string{"env": "test"},
+		},
+		token:         &corev1.SecretKeySelector{Key: "token"},
+		previousToken: &corev1.SecretKeySelector{Key: "token-previous"},
+		readSecret:    secrets.read,
+	}
+
+	t.Run("inactive route", func(t *testing.T) {
+		writer := &webhook.FakeHttpWriter{}
+		router.HandleRoute(writer, newRequest("token=secret", completed))
+		assert.Equal(t, http.StatusBadRequest, writer.HeaderStatus)
+	})
+
+	router.route.Active = true
+
+	t.Run("invalid token", func(t *testing.T) {
+		writer := &webhook.FakeHttpWriter{}
+		router.HandleRoute(writer, newRequest("token=wrong", completed))
+		assert.Equal(t, http.StatusUnauthorized, writer.HeaderStatus)
+	})
+
+	t.Run("rotated token", func(t *testing.T) {
+		secrets["token"], secrets["token-previous"] = "rotated", "secret"
+		defer func() {
+			secrets["token"] = "secret"
+			delete(secrets, "token-previous")
+		}()
+		writer := &webhook.FakeHttpWriter{}
+		router.HandleRoute(writer, newRequest("token=secret", `{"name": "release/argo-events", "build": {"phase": "STARTED"}}`))
+		assert.Equal(t, http.StatusOK, writer.HeaderStatus)
+		secrets["token-previous"] = "old"
+		writer = &webhook.FakeHttpWriter{}
+		router.HandleRoute(writer, newRequest("token=secret", completed))
+		assert.Equal(t, http.StatusUnauthorized, writer.HeaderStatus)
+	})
+
+	t.Run("ignored phase", func(t *testing.T) {
+		writer := &webhook.FakeHttpWriter{}
+		router.HandleRoute(writer, newRequest("token=secret", `{"name": "release/argo-events", "build": {"phase": "STARTED"}}`))
+		assert.Equal(t, http.StatusOK, writer.HeaderStatus)
+		assert.Empty(t, router.route.DataCh)
+	})
+
+	t.Run("dispatched notification", func(t *testing.T) {
+		dataCh := make(chan []byte, 1)
+		go func() {
+			dataCh <- (<-router.route.DataCh).Data
+		}()
+		writer := &webhook.FakeHttpWriter{}
+		router.HandleRoute(writer, newRequest("token=secret", completed))
+		assert.Equal(t, http.StatusOK, writer.HeaderStatus)
+
+		var eventData events.JenkinsEventData
+		require.NoError(t, json.Unmarshal(<-dataCh, &eventData))
+		assert.JSONEq(t, completed, string(*eventData.Body))
+		assert.Equal(t, "test", eventData.Metadata["env"])
+	})
+}
@@ -0,0 +1,56 @@
+/*
+Copyright 2020 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package jenkins
+
+import (
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/argoproj/argo-events/eventsources/common/webhook"
+	metrics "github.com/argoproj/argo-events/metrics"
+	"github.com/argoproj/argo-events/pkg/apis/eventsource/v1alpha1"
+)
+
+// EventListener implements Eventing for the Jenkins event source
+type EventListener struct {
+	EventSourceName    string
+	EventName          string
+	JenkinsEventSource v1alpha1.JenkinsEventSource
+	Metrics            *metrics.Metrics
+}
+
+// Router contains information about a REST endpoint
+type Router struct {
+	// route holds information to process an incoming request
+	route *webhook.Route
+	// jenkinsEventSource is the event source which refers to configuration required to consume the notifications
+	jenkinsEventSource *v1alpha1.JenkinsEventSource
+	// token is the secret holding the token expected in the notifications
+	token *corev1.SecretKeySelector
+	// previousToken is the secret holding the previous generated token, which remains valid until the next rotation
+	previousToken *corev1.SecretKeySelector
+	// readSecret reads the value of a secret, for each notification so that the rotated tokens are used
+	readSecret func(*corev1.SecretKeySelector) (string, error)
+}
+
+// notification is the part of a notification of the notification plugin the event source filters on
+type notification struct {
+	// Name is the full name of the job
+	Name  string `json:"name"`
+	Build struct {
+		Phase string `json:"phase"`
+	} `json:"build"`
+}
@@ -0,0 +1,53 @@
+/*
+Copyright 2020 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package jenkins
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/argoproj/argo-events/common"
+	"github.com/argoproj/argo-events/eventsources/common/webhook"
+	"github.com/argoproj/argo-events/pkg/apis/eventsource/v1alpha1"
+)
+
+// phases are the build phases of the notifications
+var phases = []string{"QUEUED", "STARTED", "COMPLETED", "FINALIZED"}
+
+// ValidateEventSource validates the Jenkins event source
+func (el *EventListener) ValidateEventSource(ctx context.Context) error {
+	return validate(&el.JenkinsEventSource)
+}
+
+func validate(eventSource *v1alpha1.JenkinsEventSource) error {
+	if eventSource == nil {
+		return common.ErrNilEventSource
+	}
+	if eventSource.Webhook == nil {
+		return fmt.Errorf("webhook must be specified")
+	}
+	if eventSource.Token != nil && eventSource.Webhook.GenerateToken {
+		return fmt.Errorf("token and webhook generateToken can't be used together")
+	}
+	for _, phase := range eventSource.Phases {
+		if !matches(phases, phase) {
+			return fmt.Errorf("invalid phase %s, must be one of %s", phase, strings.Join(phases, ", "))
+		}
+	}
+	return webhook.ValidateWebhookContext(eventSource.Webhook)
+}
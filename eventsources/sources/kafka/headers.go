@@ -0,0 +1,114 @@
+package kafka
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/IBM/sarama"
+
+	"github.com/argoproj/argo-events/pkg/apis/eventsource/v1alpha1"
+)
+
+// extensionName matches the CloudEvent extension names, which consist of lowercase letters and digits.
+var extensionName = regexp.MustCompile(`^[a-z0-9]{1,20}$`)
+
+// reservedExtensionNames are the CloudEvent context attributes, which can't be used as extension names.
+var reservedExtensionNames = map[string]bool{
+	"id": true, "source": true, "specversion": true, "type": true, "datacontenttype": true,
+	"dataschema": true, "subject": true, "time": true, "data": true, "data_base64": true,
+}
+
+// headerFilter is a compiled KafkaHeaderFilter.
+type headerFilter struct {
+	key    string
+	values []*regexp.Regexp
+	negate bool
+}
+
+func compileHeaderFilters(filters []v1alpha1.KafkaHeaderFilter) ([]headerFilter, error) {
+	result := make([]headerFilter, 0, len(filters))
+	for _, f := range filters {
+		hf := headerFilter{key: f.Key, negate: f.Negate}
+		for _, value := range f.Values {
+			re, err := regexp.Compile(value)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value %q of the header filter %s, %w", value, f.Key, err)
+			}
+			hf.values = append(hf.values, re)
+		}
+		result = append(result, hf)
+	}
+	return result, nil
+}
+
+func (f headerFilter) match(headers map[string]string) bool {
+	value, ok := headers[f.key]
+	if ok && len(f.values) > 0 {
+		ok = false
+		for _, re := range f.values {
+			if re.MatchString(value) {
+				ok = true
+				break
+			}
+		}
+	}
+	return ok != f.negate
+}
+
+// matchHeaders returns whether the headers match all the filters.
+func matchHeaders(filters []headerFilter, headers map[string]string) bool {
+	for _, f := range filters {
+		if !f.match(headers) {
+			return false
+		}
+	}
+	return true
+}
+
+// recordHeaders returns the headers of a record, the last value of a key wins.
+func recordHeaders(recordHeaders []*sarama.RecordHeader) map[string]string {
+	headers := make(map[string]string)
+	for _, recordHeader := range recordHeaders {
+		headers[string(recordHeader.Key)] = string(recordHeader.Value)
+	}
+	return headers
+}
+
+// headerExtensions returns the CloudEvent extensions mapped from the headers.
+func headerExtensions(mapping map[string]string, headers map[string]string) map[string]string {
+	if len(mapping) == 0 {
+		return nil
+	}
+	result := make(map[string]string)
+	for header, extension := range mapping {
+		if value, ok := headers[header]; ok {
+			result[extension] = value
+		}
+	}
+	return result
+}
+
+func validateHeaders(eventSource *v1alpha1.KafkaEventSource) error {
+	for _, f := range eventSource.HeaderFilters {
+		if f.Key == "" {
+			return fmt.Errorf("header filter key must be specified")
+		}
+	}
+	if _, err := compileHeaderFilters(eventSource.HeaderFilters); err != nil {
+		return err
+	}
+	extensions := map[string]string{}
+	for header, extension := range eventSource.HeaderExtensions {
+		if header == "" {
+			return fmt.Errorf("header of the extension %s must be specified", extension)
+		}
+		if !extensionName.MatchString(extension) || reservedExtensionNames[extension] {
+			return fmt.Errorf("invalid extension name %q of the header %s, it must consist of up to 20 lowercase letters and digits, and not be a CloudEvent attribute", extension, header)
+		}
+		if other, ok := extensions[extension]; ok {
+			return fmt.Errorf("the headers %s and %s are mapped to the same extension %s", other, header, extension)
+		}
+		extensions[extension] = header
+	}
+	return nil
+}
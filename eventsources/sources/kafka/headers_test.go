@@ -0,0 +1,43 @@
+package kafka
+
+import (
+	"testing"
+
+	"github.com/IBM/sarama"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/argoproj/argo-events/pkg/apis/eventsource/v1alpha1"
+)
+
+func TestMatchHeaders(t *testing.T) {
+	filters, err := compileHeaderFilters([]v1alpha1.KafkaHeaderFilter{
+		{Key: "tenant", Values: []string{"^acme$", "^globex$"}},
+		{Key: "replay"},
+		{Key: "env", Values: []string{"test"}, Negate: true},
+	})
+	assert.NoError(t, err)
+
+	headers := recordHeaders([]*sarama.RecordHeader{
+		{Key: []byte("tenant"), Value: []byte("acme")},
+		{Key: []byte("replay"), Value: []byte("")},
+		{Key: []byte("env"), Value: []byte("prod")},
+	})
+	assert.True(t, matchHeaders(filters, headers))
+	assert.True(t, matchHeaders(nil, headers))
+
+	headers["tenant"] = "initech"
+	assert.False(t, matchHeaders(filters, headers))
+	headers["tenant"] = "globex"
+	headers["env"] = "integration-test"
+	assert.False(t, matchHeaders(filters, headers))
+	delete(headers, "env")
+	assert.True(t, matchHeaders(filters, headers))
+	delete(headers, "replay")
+	assert.False(t, matchHeaders(filters, headers))
+}
+
+func TestHeaderExtensions(t *testing.T) {
+	headers := map[string]string{"x-tenant": "acme", "x-region": "eu"}
+	assert.Nil(t, headerExtensions(nil, headers))
+	assert.Equal(t, map[string]string{"tenant": "acme"}, headerExtensions(map[string]string{"x-tenant": "tenant", "x-trace": "trace"}, headers))
+}
@@ -106,6 +106,11 @@ func (el *EventListener) consumerGroupConsumer(ctx context.Context, log *zap.Sug
 		config.Consumer.Group.Rebalance.GroupStrategies = []sarama.BalanceStrategy{sarama.NewBalanceStrategyRange()}
 	}
 
+	headerFilters, err := compileHeaderFilters(kafkaEventSource.HeaderFilters)
+	if err != nil {
+		return err
+	}
+
 	consumer := Consumer{
 		ready:            make(chan bool),
 		dispatch:         dispatch,
@@ -114,6 +119,7 @@ func (el *EventListener) consumerGroupConsumer(ctx context.Context, log *zap.Sug
 		eventSourceName:  el.EventSourceName,
 		eventName:        el.EventName,
 		metrics:          el.Metrics,
+		headerFilters:    headerFilters,
 	}
 
 	urls := strings.Split(kafkaEventSource.URL, ",")
@@ -166,7 +172,7 @@ func (el *EventListener) partitionConsumer(ctx context.Context, log *zap.Sugared
 	var consumer sarama.Consumer
 
 	log.Info("connecting to Kafka cluster...")
-	if err := common.DoWithRetry(kafkaEventSource.ConnectionBackoff, func() error {
+	if err := common.DoWithTrackedRetry("eventsource.connect", el.GetEventSourceName()+"/"+el.GetEventName(), kafkaEventSource.ConnectionBackoff, func() error {
 		var err error
 
 		config, err := getSaramaConfig(kafkaEventSource, log)
@@ -210,11 +216,23 @@ func (el *EventListener) partitionConsumer(ctx context.Context, log *zap.Sugared
 		return fmt.Errorf("failed to create consumer partition for event source %s, %w", el.GetEventName(), err)
 	}
 
+	headerFilters, err := compileHeaderFilters(kafkaEventSource.HeaderFilters)
+	if err != nil {
+		return err
+	}
+
 	processOne := func(msg *sarama.ConsumerMessage) error {
 		defer func(start time.Time) {
 			el.Metrics.EventProcessingDuration(el.GetEventSourceName(), el.GetEventName(), float64(time.Since(start)/time.Millisecond))
 		}(time.Now())
 
+		headers := recordHeaders(msg.Headers)
+		if !matchHeaders(headerFilters, headers) {
+			log.Debugw("the record headers do not match the filters, skipping it", "offset", msg.Offset)
+			el.Metrics.EventFiltered(el.GetEventSourceName(), el.GetEventName())
+			return nil
+		}
+
 		log.Info("dispatching event on the data channel...")
 		eventData := &events.KafkaEventData{
 			Topic:     msg.Topic,
@@ -222,16 +240,9 @@ func (el *EventListener) partitionConsumer(ctx context.Context, log *zap.Sugared
 			Partition: int(msg.Partition),
 			Timestamp: msg.Timestamp.String(),
 			Metadata:  kafkaEventSource.Metadata,
+			Headers:   headers,
 		}
 
-		headers := make(map[string]string)
-
-		for _, recordHeader := range msg.Headers {
-			headers[string(recordHeader.Key)] = string(recordHeader.Value)
-		}
-
-		eventData.Headers = headers
-
 		if kafkaEventSource.JSONBody {
 			eventData.Body = (*json.RawMessage)(&msg.Value)
 		} else {
@@ -244,7 +255,7 @@ func (el *EventListener) partitionConsumer(ctx context.Context, log *zap.Sugared
 
 		kafkaID := genUniqueID(el.GetEventSourceName(), el.GetEventName(), kafkaEventSource.URL, msg.Topic, msg.Partition, msg.Offset)
 
-		if err = dispatch(eventBody, eventsourcecommon.WithID(kafkaID)); err != nil {
+		if err = dispatch(eventBody, eventsourcecommon.WithID(kafkaID), eventsourcecommon.WithExtensions(headerExtensions(kafkaEventSource.HeaderExtensions, headers))); err != nil {
 			return fmt.Errorf("failed to dispatch a Kafka event, %w", err)
 		}
 		return nil
@@ -340,6 +351,7 @@ type Consumer struct {
 	eventSourceName  string
 	eventName        string
 	metrics          *metrics.Metrics
+	headerFilters    []headerFilter
 }
 
 // Setup is run at the beginning of a new session, before ConsumeClaim
@@ -389,6 +401,14 @@ func (consumer *Consumer) processOne(session sarama.ConsumerGroupSession, messag
 		consumer.metrics.EventProcessingDuration(consumer.eventSourceName, consumer.eventName, float64(time.Since(start)/time.Millisecond))
 	}(time.Now())
 
+	headers := recordHeaders(message.Headers)
+	if !matchHeaders(consumer.headerFilters, headers) {
+		consumer.logger.Debugw("the record headers do not match the filters, skipping it", "offset", message.Offset)
+		consumer.metrics.EventFiltered(consumer.eventSourceName, consumer.eventName)
+		session.MarkMessage(message, "")
+		return nil
+	}
+
 	consumer.logger.Info("dispatching event on the data channel...")
 	eventData := &events.KafkaEventData{
 		Topic:     message.Topic,
@@ -396,16 +416,9 @@ func (consumer *Consumer) processOne(session sarama.ConsumerGroupSession, messag
 		Partition: int(message.Partition),
 		Timestamp: message.Timestamp.String(),
 		Metadata:  consumer.kafkaEventSource.Metadata,
+		Headers:   headers,
 	}
 
-	headers := make(map[string]string)
-
-	for _, recordHeader := range message.Headers {
-		headers[string(recordHeader.Key)] = string(recordHeader.Value)
-	}
-
-	eventData.Headers = headers
-
 	if consumer.kafkaEventSource.JSONBody {
 		eventData.Body = (*json.RawMessage)(&message.Value)
 	} else {
@@ -418,7 +431,7 @@ func (consumer *Consumer) processOne(session sarama.ConsumerGroupSession, messag
 
 	messageID := genUniqueID(consumer.eventSourceName, consumer.eventName, consumer.kafkaEventSource.URL, message.Topic, message.Partition, message.Offset)
 
-	if err = consumer.dispatch(eventBody, eventsourcecommon.WithID(messageID)); err != nil {
+	if err = consumer.dispatch(eventBody, eventsourcecommon.WithID(messageID), eventsourcecommon.WithExtensions(headerExtensions(consumer.kafkaEventSource.HeaderExtensions, headers))); err != nil {
 		return fmt.Errorf("failed to dispatch a kafka event, %w", err)
 	}
 	session.MarkMessage(message, "")
@@ -43,6 +43,9 @@ func validate(eventSource *v1alpha1.KafkaEventSource) error {
 	if eventSource.Partition == "" && eventSource.ConsumerGroup == nil {
 		return fmt.Errorf("consumerGroup or partition must be specified")
 	}
+	if err := validateHeaders(eventSource); err != nil {
+		return err
+	}
 	if eventSource.TLS != nil {
 		return apicommon.ValidateTLSConfig(eventSource.TLS)
 	}
@@ -53,3 +53,27 @@ func TestValidateEventSource(t *testing.T) {
 		assert.NoError(t, err)
 	}
 }
+
+func TestValidateHeaders(t *testing.T) {
+	eventSource := &v1alpha1.KafkaEventSource{
+		URL:              "kafka.argo-events:9092",
+		Topic:            "orders",
+		Partition:        "0",
+		HeaderFilters:    []v1alpha1.KafkaHeaderFilter{{Key: "tenant", Values: []string{"^acme$"}}},
+		HeaderExtensions: map[string]string{"x-tenant": "tenant"},
+	}
+	assert.NoError(t, validate(eventSource))
+
+	eventSource.HeaderFilters[0].Values = []string{"("}
+	assert.ErrorContains(t, validate(eventSource), "invalid value")
+	eventSource.HeaderFilters[0] = v1alpha1.KafkaHeaderFilter{}
+	assert.EqualError(t, validate(eventSource), "header filter key must be specified")
+	eventSource.HeaderFilters = nil
+
+	for _, name := range []string{"Tenant", "x-tenant", "subject", "averyveryverylongextension"} {
+		eventSource.HeaderExtensions = map[string]string{"x-tenant": name}
+		assert.ErrorContains(t, validate(eventSource), "invalid extension name")
+	}
+	eventSource.HeaderExtensions = map[string]string{"x-tenant": "tenant", "tenant-id": "tenant"}
+	assert.ErrorContains(t, validate(eventSource), "mapped to the same extension tenant")
+}
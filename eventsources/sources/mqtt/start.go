@@ -20,6 +20,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"os"
 	"time"
 
 	mqttlib "github.com/eclipse/paho.mqtt.golang"
@@ -94,17 +95,24 @@ func (el *EventListener) StartListening(ctx context.Context, dispatch func([]byt
 		if err != nil {
 			log.Errorw("failed to marshal the event data, rejecting the event...", zap.Error(err))
 			el.Metrics.EventProcessingFailed(el.GetEventSourceName(), el.GetEventName())
+			msg.Ack()
 			return
 		}
 		log.Info("dispatching event on the data channel...")
 		if err = dispatch(eventBody); err != nil {
 			log.Errorw("failed to dispatch MQTT event...", zap.Error(err))
 			el.Metrics.EventProcessingFailed(el.GetEventSourceName(), el.GetEventName())
+			return
 		}
+		// Only acknowledged once dispatched, so that the broker redelivers the message when the session resumes
+		msg.Ack()
 	}
 
 	log.Info("setting up the mqtt broker client...")
-	opts := mqttlib.NewClientOptions().AddBroker(mqttEventSource.URL).SetClientID(mqttEventSource.ClientID)
+	opts := mqttlib.NewClientOptions().AddBroker(mqttEventSource.URL).SetClientID(clientID(mqttEventSource, os.Getenv(common.EnvVarPodName)))
+	if mqttEventSource.PersistentSession {
+		opts.SetCleanSession(false).SetResumeSubs(true).SetAutoAckDisabled(true)
+	}
 	if mqttEventSource.TLS != nil {
 		tlsConfig, err := common.GetTLSConfig(mqttEventSource.TLS)
 		if err != nil {
@@ -129,7 +137,7 @@ func (el *EventListener) StartListening(ctx context.Context, dispatch func([]byt
 	var client mqttlib.Client
 
 	log.Info("connecting to mqtt broker...")
-	if err := common.DoWithRetry(mqttEventSource.ConnectionBackoff, func() error {
+	if err := common.DoWithTrackedRetry("eventsource.connect", el.GetEventSourceName()+"/"+el.GetEventName(), mqttEventSource.ConnectionBackoff, func() error {
 		client = mqttlib.NewClient(opts)
 		if token := client.Connect(); token.Wait() && token.Error() != nil {
 			return token.Error()
@@ -139,18 +147,43 @@ func (el *EventListener) StartListening(ctx context.Context, dispatch func([]byt
 		return fmt.Errorf("failed to connect to the mqtt broker for event source %s, %w", el.GetEventName(), err)
 	}
 
-	log.Info("subscribing to the topic...")
-	if token := client.Subscribe(mqttEventSource.Topic, 0, handler); token.Wait() && token.Error() != nil {
-		return fmt.Errorf("failed to subscribe to the topic %s for event source %s, %w", mqttEventSource.Topic, el.GetEventName(), token.Error())
+	topic := topicFilter(mqttEventSource)
+	log.Infow("subscribing to the topic...", "topic", topic, "qos", mqttEventSource.QoS)
+	if token := client.Subscribe(topic, byte(mqttEventSource.QoS), handler); token.Wait() && token.Error() != nil {
+		return fmt.Errorf("failed to subscribe to the topic %s for event source %s, %w", topic, el.GetEventName(), token.Error())
 	}
 
 	<-ctx.Done()
+	if mqttEventSource.PersistentSession {
+		// The subscription is kept in the session, for the messages published until the client reconnects
+		log.Info("event source is stopped, disconnecting the client...")
+		client.Disconnect(250)
+		return nil
+	}
 	log.Info("event source is stopped, unsubscribing the client...")
 
-	token := client.Unsubscribe(mqttEventSource.Topic)
+	token := client.Unsubscribe(topic)
 	if token.Error() != nil {
 		log.Errorw("failed to unsubscribe client", zap.Error(token.Error()))
 	}
 
 	return nil
 }
+
+// topicFilter returns the topic filter to subscribe to, "$share/<group>/<topic>" for a shared subscription.
+func topicFilter(eventSource *v1alpha1.MQTTEventSource) string {
+	if eventSource.SharedSubscription == nil {
+		return eventSource.Topic
+	}
+	return "$share/" + eventSource.SharedSubscription.Group + "/" + eventSource.Topic
+}
+
+// clientID returns the client id of the replica, the replicas sharing a subscription are distinguished by their
+// pod name, since the broker disconnects a client when another one connects with the same id. Their sessions are
+// clean, so the broker does not keep one for each pod that ever ran.
+func clientID(eventSource *v1alpha1.MQTTEventSource, podName string) string {
+	if eventSource.SharedSubscription == nil || podName == "" {
+		return eventSource.ClientID
+	}
+	return eventSource.ClientID + "-" + podName
+}
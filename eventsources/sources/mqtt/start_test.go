@@ -0,0 +1,20 @@
+package mqtt
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/argoproj/argo-events/pkg/apis/eventsource/v1alpha1"
+)
+
+func TestSharedSubscription(t *testing.T) {
+	eventSource := &v1alpha1.MQTTEventSource{Topic: "sensors/+/temperature", ClientID: "argo-events"}
+	assert.Equal(t, "sensors/+/temperature", topicFilter(eventSource))
+	assert.Equal(t, "argo-events", clientID(eventSource, "mqtt-eventsource-7c9f-x2b4"))
+
+	eventSource.SharedSubscription = &v1alpha1.MQTTSharedSubscription{Group: "argo-events"}
+	assert.Equal(t, "$share/argo-events/sensors/+/temperature", topicFilter(eventSource))
+	assert.Equal(t, "argo-events-mqtt-eventsource-7c9f-x2b4", clientID(eventSource, "mqtt-eventsource-7c9f-x2b4"))
+	assert.Equal(t, "argo-events", clientID(eventSource, ""))
+}
@@ -19,6 +19,7 @@ package mqtt
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/argoproj/argo-events/common"
 	apicommon "github.com/argoproj/argo-events/pkg/apis/common"
@@ -43,6 +44,24 @@ func validate(eventSource *v1alpha1.MQTTEventSource) error {
 	if eventSource.ClientID == "" {
 		return fmt.Errorf("client id must be specified")
 	}
+	if eventSource.QoS < 0 || eventSource.QoS > 2 {
+		return fmt.Errorf("qos must be 0, 1 or 2")
+	}
+	if eventSource.PersistentSession && eventSource.QoS == 0 {
+		return fmt.Errorf("persistent session requires a qos of 1 or 2")
+	}
+	if s := eventSource.SharedSubscription; s != nil {
+		if s.Group == "" {
+			return fmt.Errorf("shared subscription group must be specified")
+		}
+		if strings.ContainsAny(s.Group, "/+#") {
+			return fmt.Errorf("shared subscription group must not contain \"/\", \"+\" or \"#\"")
+		}
+		if eventSource.PersistentSession {
+			// The client ids of the replicas change with their pods, so their sessions would never be resumed
+			return fmt.Errorf("persistent session can not be used with a shared subscription")
+		}
+	}
 	if eventSource.TLS != nil {
 		return apicommon.ValidateTLSConfig(eventSource.TLS)
 	}
@@ -52,3 +52,27 @@ func TestValidateEventSource(t *testing.T) {
 		assert.NoError(t, err)
 	}
 }
+
+func TestValidateSubscription(t *testing.T) {
+	eventSource := &v1alpha1.MQTTEventSource{
+		URL:                "tcp://mqtt.argo-events:1883",
+		Topic:              "sensors/+/temperature",
+		ClientID:           "argo-events",
+		QoS:                2,
+		SharedSubscription: &v1alpha1.MQTTSharedSubscription{Group: "argo-events"},
+	}
+	assert.NoError(t, validate(eventSource))
+
+	eventSource.QoS = 3
+	assert.EqualError(t, validate(eventSource), "qos must be 0, 1 or 2")
+	eventSource.QoS = 0
+	eventSource.PersistentSession = true
+	assert.EqualError(t, validate(eventSource), "persistent session requires a qos of 1 or 2")
+	eventSource.QoS = 1
+	assert.EqualError(t, validate(eventSource), "persistent session can not be used with a shared subscription")
+	eventSource.PersistentSession = false
+	eventSource.SharedSubscription.Group = ""
+	assert.EqualError(t, validate(eventSource), "shared subscription group must be specified")
+	eventSource.SharedSubscription.Group = "argo/events"
+	assert.Error(t, validate(eventSource))
+}
@@ -143,7 +143,7 @@ func (el *EventListener) StartListening(ctx context.Context, dispatch func([]byt
 
 	var client pulsar.Client
 
-	if err := common.DoWithRetry(pulsarEventSource.ConnectionBackoff, func() error {
+	if err := common.DoWithTrackedRetry("eventsource.connect", el.GetEventSourceName()+"/"+el.GetEventName(), pulsarEventSource.ConnectionBackoff, func() error {
 		var err error
 		if client, err = pulsar.NewClient(clientOpt); err != nil {
 			return err
@@ -117,7 +117,7 @@ func (el *EventListener) StartListening(ctx context.Context, dispatch func([]byt
 	}
 
 	var sshClient *ssh.Client
-	err = common.DoWithRetry(nil, func() error {
+	err = common.DoWithTrackedRetry("eventsource.connect", el.GetEventSourceName()+"/"+el.GetEventName(), nil, func() error {
 		var err error
 		sshClient, err = ssh.Dial("tcp", address, sftpConfig)
 		return err
@@ -144,7 +144,7 @@ func (el *EventListener) listenEvents(ctx context.Context, sftpClient *sftp.Clie
 	sftpEventSource := &el.SFTPEventSource
 
 	log.Info("identifying new files in sftp...")
-	startingFiles, err := sftpNonDirFiles(sftpClient, sftpEventSource.WatchPathConfig.Directory)
+	startingFiles, err := el.sftpNonDirFiles(sftpClient, sftpEventSource.WatchPathConfig.Directory)
 	if err != nil {
 		return fmt.Errorf("failed to read directory %s for %s, %w", sftpEventSource.WatchPathConfig.Directory, el.GetEventName(), err)
 	}
@@ -213,7 +213,7 @@ func (el *EventListener) listenEvents(ctx context.Context, sftpClient *sftp.Clie
 		select {
 		case <-time.After(pollIntervalDuration):
 
-			files, err := sftpNonDirFiles(sftpClient, sftpEventSource.WatchPathConfig.Directory)
+			files, err := el.sftpNonDirFiles(sftpClient, sftpEventSource.WatchPathConfig.Directory)
 			if err != nil {
 				return fmt.Errorf("failed to read directory %s for %s, %w", sftpEventSource.WatchPathConfig.Directory, el.GetEventName(), err)
 			}
@@ -250,9 +250,9 @@ func (el *EventListener) listenEvents(ctx context.Context, sftpClient *sftp.Clie
 	}
 }
 
-func sftpNonDirFiles(sftpClient *sftp.Client, dir string) ([]fs.FileInfo, error) {
+func (el *EventListener) sftpNonDirFiles(sftpClient *sftp.Client, dir string) ([]fs.FileInfo, error) {
 	var files []fs.FileInfo
-	err := common.DoWithRetry(nil, func() error {
+	err := common.DoWithTrackedRetry("eventsource.poll", el.GetEventSourceName()+"/"+el.GetEventName(), nil, func() error {
 		var err error
 		files, err = sftpClient.ReadDir(dir)
 		return err
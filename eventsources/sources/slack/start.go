@@ -188,7 +188,7 @@ func (rc *Router) HandleRoute(writer http.ResponseWriter, request *http.Request)
 
 	if data != nil {
 		logger.Info("dispatching event on route's data channel...")
-		route.DataCh <- data
+		route.Deliver(request, data)
 	}
 
 	logger.Debug("request successfully processed")
@@ -126,7 +126,7 @@ func TestInteractionHandler(t *testing.T) {
 			router.route.Active = true
 
 			go func() {
-				out <- <-router.route.DataCh
+				out <- (<-router.route.DataCh).Data
 			}()
 
 			var buf bytes.Buffer
@@ -162,7 +162,7 @@ func TestSlackCommandHandler(t *testing.T) {
 			router.route.Active = true
 
 			go func() {
-				out <- <-router.route.DataCh
+				out <- (<-router.route.DataCh).Data
 			}()
 
 			var buf bytes.Buffer
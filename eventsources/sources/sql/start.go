@@ -0,0 +1,261 @@
+/*
+Copyright 2020 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sql
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	// The supported database drivers
+	_ "github.com/go-sql-driver/mysql"
+	_ "github.com/lib/pq"
+	"go.uber.org/zap"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/argoproj/argo-events/common"
+	"github.com/argoproj/argo-events/common/logging"
+	eventsourcecommon "github.com/argoproj/argo-events/eventsources/common"
+	"github.com/argoproj/argo-events/eventsources/persist"
+	"github.com/argoproj/argo-events/eventsources/sources"
+	metrics "github.com/argoproj/argo-events/metrics"
+	apicommon "github.com/argoproj/argo-events/pkg/apis/common"
+	"github.com/argoproj/argo-events/pkg/apis/events"
+	"github.com/argoproj/argo-events/pkg/apis/eventsource/v1alpha1"
+)
+
+const (
+	defaultPollInterval  = 10 * time.Second
+	defaultInitialCursor = "0"
+)
+
+// EventListener implements Eventing for the SQL event source
+type EventListener struct {
+	EventSourceName string
+	EventName       string
+	EventSource     v1alpha1.SQLEventSource
+	Namespace       string
+	Metrics         *metrics.Metrics
+}
+
+// GetEventSourceName returns name of event source
+func (el *EventListener) GetEventSourceName() string {
+	return el.EventSourceName
+}
+
+// GetEventName returns name of event
+func (el *EventListener) GetEventName() string {
+	return el.EventName
+}
+
+// GetEventSourceType return type of event server
+func (el *EventListener) GetEventSourceType() apicommon.EventSourceType {
+	return apicommon.SQLEvent
+}
+
+// StartListening polls the database for the rows after the cursor
+func (el *EventListener) StartListening(ctx context.Context, dispatch func([]byte, ...eventsourcecommon.Option) error) error {
+	log := logging.FromContext(ctx).
+		With(logging.LabelEventSourceType, el.GetEventSourceType(), logging.LabelEventName, el.GetEventName())
+	log.Info("started processing the SQL event source...")
+	defer sources.Recover(el.GetEventName())
+
+	sqlEventSource := &el.EventSource
+	pollInterval := defaultPollInterval
+	if sqlEventSource.PollInterval != "" {
+		var err error
+		if pollInterval, err = time.ParseDuration(sqlEventSource.PollInterval); err != nil {
+			return fmt.Errorf("failed to parse the poll interval, %w", err)
+		}
+	}
+
+	dsn, err := common.GetSecretFromVolume(sqlEventSource.DSN)
+	if err != nil {
+		return fmt.Errorf("failed to retrieve the dsn, %w", err)
+	}
+	db, err := sql.Open(sqlEventSource.Driver, dsn)
+	if err != nil {
+		return fmt.Errorf("failed to open the database, %w", err)
+	}
+	defer db.Close()
+
+	var cursorPersistence persist.EventPersist = &persist.NullPersistence{}
+	if sqlEventSource.Persistence != nil {
+		if cursorPersistence, err = newConfigMapPersistence(ctx, sqlEventSource.Persistence, el.Namespace); err != nil {
+			return fmt.Errorf("failed to initialize the cursor persistence, %w", err)
+		}
+	}
+	cursor, err := el.initialCursor(cursorPersistence)
+	if err != nil {
+		return err
+	}
+	log.Infow("polling the new rows...", zap.String("cursor", cursor))
+
+	// The failed polls are tracked as the retries of the poll until one succeeds
+	common.PollWithTrackedRetry(ctx, "eventsource.poll", el.GetEventSourceName()+"/"+el.GetEventName(), pollInterval, func() error {
+		newCursor, err := el.poll(ctx, db, cursor, dispatch, log)
+		if newCursor == cursor {
+			return err
+		}
+		cursor = newCursor
+		if err := cursorPersistence.Save(&persist.Event{EventKey: el.persistenceKey(), EventPayload: cursor}); err != nil {
+			log.Errorw("failed to persist the cursor", zap.String("cursor", cursor), zap.Error(err))
+		}
+		return err
+	})
+	log.Info("event source is stopped")
+	return nil
+}
+
+func newConfigMapPersistence(ctx context.Context, configMap *v1alpha1.ConfigMapPersistence, namespace string) (persist.EventPersist, error) {
+	kubeConfig, _ := os.LookupEnv(common.EnvVarKubeConfig)
+	restConfig, err := common.GetClientConfig(kubeConfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get a K8s rest config, %w", err)
+	}
+	kubeClientset, err := kubernetes.NewForConfig(restConfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to set up a K8s client, %w", err)
+	}
+	return persist.NewConfigMapPersist(ctx, kubeClientset, configMap, namespace)
+}
+
+func (el *EventListener) persistenceKey() string {
+	return fmt.Sprintf("%s.%s", el.EventSourceName, el.EventName)
+}
+
+// initialCursor returns the persisted cursor if any, the configured initial cursor otherwise.
+func (el *EventListener) initialCursor(cursorPersistence persist.EventPersist) (string, error) {
+	persisted, err := cursorPersistence.Get(el.persistenceKey())
+	if err != nil {
+		return "", fmt.Errorf("failed to get the persisted cursor, %w", err)
+	}
+	if persisted != nil {
+		return persisted.EventPayload, nil
+	}
+	if el.EventSource.InitialCursor != "" {
+		return el.EventSource.InitialCursor, nil
+	}
+	return defaultInitialCursor, nil
+}
+
+// poll emits the rows returned by the query after the cursor, until the query returns no new row or fails, and
+// returns the cursor of the last emitted row, with the error which stopped it. The rows failing to be dispatched are
+// queried again on the next poll.
+func (el *EventListener) poll(ctx context.Context, db *sql.DB, cursor string, dispatch func([]byte, ...eventsourcecommon.Option) error, log *zap.SugaredLogger) (string, error) {
+	for {
+		rows, err := queryRows(ctx, db, el.EventSource.Query, cursor)
+		if err != nil {
+			log.Errorw("failed to query the new rows", zap.Error(err))
+			return cursor, fmt.Errorf("failed to query the new rows, %w", err)
+		}
+		lastCursor := cursor
+		for _, row := range rows {
+			rowCursor, err := cursorValue(row, el.EventSource.CursorColumn)
+			if err != nil {
+				log.Errorw("failed to get the cursor of a row", zap.Error(err))
+				el.Metrics.EventProcessingFailed(el.GetEventSourceName(), el.GetEventName())
+				return cursor, fmt.Errorf("failed to get the cursor of a row, %w", err)
+			}
+			if err := el.handleOne(row, rowCursor, dispatch, log); err != nil {
+				log.Errorw("failed to process a row", zap.String("cursor", rowCursor), zap.Error(err))
+				el.Metrics.EventProcessingFailed(el.GetEventSourceName(), el.GetEventName())
+				return cursor, err
+			}
+			cursor = rowCursor
+		}
+		// The cursor does not move forward when the query is drained, or does not honor the cursor
+		if cursor == lastCursor {
+			return cursor, nil
+		}
+	}
+}
+
+func (el *EventListener) handleOne(row map[string]interface{}, cursor string, dispatch func([]byte, ...eventsourcecommon.Option) error, log *zap.SugaredLogger) error {
+	defer func(start time.Time) {
+		el.Metrics.EventProcessingDuration(el.GetEventSourceName(), el.GetEventName(), float64(time.Since(start)/time.Millisecond))
+	}(time.Now())
+
+	log.Infow("received a row", zap.String("cursor", cursor))
+	eventData := &events.SQLEventData{
+		Row:      row,
+		Cursor:   cursor,
+		Metadata: el.EventSource.Metadata,
+	}
+	eventBody, err := json.Marshal(eventData)
+	if err != nil {
+		return fmt.Errorf("failed to marshal the event data, rejecting the event, %w", err)
+	}
+	if err = dispatch(eventBody); err != nil {
+		return fmt.Errorf("failed to dispatch a SQL event, %w", err)
+	}
+	return nil
+}
+
+// queryRows runs the query with the cursor, and returns the rows as maps of the column names to their values.
+func queryRows(ctx context.Context, db *sql.DB, query, cursor string) ([]map[string]interface{}, error) {
+	rows, err := db.QueryContext(ctx, query, cursor)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	columns, err := rows.Columns()
+	if err != nil {
+		return nil, err
+	}
+	var result []map[string]interface{}
+	for rows.Next() {
+		values := make([]interface{}, len(columns))
+		pointers := make([]interface{}, len(columns))
+		for i := range values {
+			pointers[i] = &values[i]
+		}
+		if err := rows.Scan(pointers...); err != nil {
+			return nil, err
+		}
+		row := make(map[string]interface{}, len(columns))
+		for i, column := range columns {
+			// The text columns are scanned as bytes by some drivers
+			if b, ok := values[i].([]byte); ok {
+				row[column] = string(b)
+			} else {
+				row[column] = values[i]
+			}
+		}
+		result = append(result, row)
+	}
+	return result, rows.Err()
+}
+
+// cursorValue returns the value of the cursor column of the row, formatted as the parameter of the next query.
+func cursorValue(row map[string]interface{}, column string) (string, error) {
+	value, ok := row[column]
+	if !ok {
+		return "", fmt.Errorf("cursor column %q not found in the row", column)
+	}
+	switch v := value.(type) {
+	case nil:
+		return "", fmt.Errorf("cursor column %q is null", column)
+	case time.Time:
+		return v.Format(time.RFC3339Nano), nil
+	default:
+		return fmt.Sprint(v), nil
+	}
+}
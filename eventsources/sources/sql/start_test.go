@@ -0,0 +1,139 @@
+/*
+Copyright 2020 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package sql
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/argoproj/argo-events/common/logging"
+	eventsourcecommon "github.com/argoproj/argo-events/eventsources/common"
+	"github.com/argoproj/argo-events/eventsources/persist"
+	metrics "github.com/argoproj/argo-events/metrics"
+	"github.com/argoproj/argo-events/pkg/apis/events"
+	"github.com/argoproj/argo-events/pkg/apis/eventsource/v1alpha1"
+)
+
+const testQuery = "SELECT id, name FROM orders WHERE id > $1 ORDER BY id LIMIT 2"
+
+func TestPoll(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	el := &EventListener{
+		EventSourceName: "sql",
+		EventName:       "example",
+		EventSource:     v1alpha1.SQLEventSource{Driver: "postgres", Query: testQuery, CursorColumn: "id", Metadata: map[string]string{"env": "test"}},
+		Metrics:         metrics.NewMetrics("argo-events"),
+	}
+	columns := []string{"id", "name"}
+	mock.ExpectQuery(testQuery).WithArgs("0").WillReturnRows(sqlmock.NewRows(columns).AddRow(1, []byte("a")).AddRow(2, []byte("b")))
+	mock.ExpectQuery(testQuery).WithArgs("2").WillReturnRows(sqlmock.NewRows(columns).AddRow(3, []byte("c")).AddRow(4, []byte("d")))
+
+	var names []string
+	dispatch := func(data []byte, opts ...eventsourcecommon.Option) error {
+		var eventData events.SQLEventData
+		require.NoError(t, json.Unmarshal(data, &eventData))
+		assert.Equal(t, "test", eventData.Metadata["env"])
+		if eventData.Cursor == "4" {
+			return fmt.Errorf("eventbus is down")
+		}
+		names = append(names, eventData.Row["name"].(string))
+		return nil
+	}
+	// The query is run again after each batch, and stops at the first failed row
+	cursor, err := el.poll(context.TODO(), db, "0", dispatch, logging.NewArgoEventsLogger())
+	assert.ErrorContains(t, err, "eventbus is down")
+	assert.Equal(t, []string{"a", "b", "c"}, names)
+	assert.Equal(t, "3", cursor)
+
+	mock.ExpectQuery(testQuery).WithArgs("3").WillReturnRows(sqlmock.NewRows(columns).AddRow(4, []byte("d")))
+	mock.ExpectQuery(testQuery).WithArgs("4").WillReturnRows(sqlmock.NewRows(columns))
+	dispatch = func(data []byte, opts ...eventsourcecommon.Option) error {
+		var eventData events.SQLEventData
+		require.NoError(t, json.Unmarshal(data, &eventData))
+		names = append(names, eventData.Row["name"].(string))
+		return nil
+	}
+	cursor, err = el.poll(context.TODO(), db, cursor, dispatch, logging.NewArgoEventsLogger())
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"a", "b", "c", "d"}, names)
+	assert.Equal(t, "4", cursor)
+
+	// The query failures are retried on the next poll
+	mock.ExpectQuery(testQuery).WithArgs("4").WillReturnError(fmt.Errorf("connection refused"))
+	cursor, err = el.poll(context.TODO(), db, cursor, dispatch, logging.NewArgoEventsLogger())
+	assert.ErrorContains(t, err, "connection refused")
+	assert.Equal(t, "4", cursor)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCursorValue(t *testing.T) {
+	created := time.Date(2024, 1, 2, 3, 4, 5, 6, time.UTC)
+	row := map[string]interface{}{"id": int64(42), "created_at": created, "deleted_at": nil}
+	cursor, err := cursorValue(row, "id")
+	assert.NoError(t, err)
+	assert.Equal(t, "42", cursor)
+	cursor, err = cursorValue(row, "created_at")
+	assert.NoError(t, err)
+	assert.Equal(t, "2024-01-02T03:04:05.000000006Z", cursor)
+	_, err = cursorValue(row, "deleted_at")
+	assert.ErrorContains(t, err, "is null")
+	_, err = cursorValue(row, "updated_at")
+	assert.ErrorContains(t, err, "not found")
+}
+
+type fakePersistence map[string]string
+
+func (f fakePersistence) Save(event *persist.Event) error {
+	f[event.EventKey] = event.EventPayload
+	return nil
+}
+
+func (f fakePersistence) Get(key string) (*persist.Event, error) {
+	if payload, ok := f[key]; ok {
+		return &persist.Event{EventKey: key, EventPayload: payload}, nil
+	}
+	return nil, nil
+}
+
+func (f fakePersistence) IsEnabled() bool {
+	return true
+}
+
+func TestInitialCursor(t *testing.T) {
+	el := &EventListener{EventSourceName: "sql", EventName: "example"}
+	cursor, err := el.initialCursor(&persist.NullPersistence{})
+	assert.NoError(t, err)
+	assert.Equal(t, "0", cursor)
+
+	el.EventSource.InitialCursor = "2024-01-01T00:00:00Z"
+	cursor, err = el.initialCursor(&persist.NullPersistence{})
+	assert.NoError(t, err)
+	assert.Equal(t, "2024-01-01T00:00:00Z", cursor)
+
+	cursor, err = el.initialCursor(fakePersistence{"sql.example": "2024-02-01T00:00:00Z"})
+	assert.NoError(t, err)
+	assert.Equal(t, "2024-02-01T00:00:00Z", cursor)
+}
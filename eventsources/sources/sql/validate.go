@@ -0,0 +1,64 @@
+/*
+Copyright 2020 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sql
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/argoproj/argo-events/common"
+	"github.com/argoproj/argo-events/pkg/apis/eventsource/v1alpha1"
+)
+
+// supportedDrivers are the names of the database drivers built in the event source
+var supportedDrivers = map[string]bool{
+	"postgres": true,
+	"mysql":    true,
+}
+
+// ValidateEventSource validates the SQL event source
+func (el *EventListener) ValidateEventSource(ctx context.Context) error {
+	return validate(&el.EventSource)
+}
+
+func validate(eventSource *v1alpha1.SQLEventSource) error {
+	if eventSource == nil {
+		return common.ErrNilEventSource
+	}
+	if !supportedDrivers[eventSource.Driver] {
+		return fmt.Errorf("unsupported driver %q, it must be either postgres or mysql", eventSource.Driver)
+	}
+	if eventSource.DSN == nil {
+		return fmt.Errorf("dsn must be specified")
+	}
+	if eventSource.Query == "" {
+		return fmt.Errorf("query must be specified")
+	}
+	if eventSource.CursorColumn == "" {
+		return fmt.Errorf("cursorColumn must be specified")
+	}
+	if eventSource.PollInterval != "" {
+		if _, err := time.ParseDuration(eventSource.PollInterval); err != nil {
+			return fmt.Errorf("failed to parse the poll interval, %w", err)
+		}
+	}
+	if eventSource.Persistence != nil && eventSource.Persistence.Name == "" {
+		return fmt.Errorf("persistence configmap name must be specified")
+	}
+	return nil
+}
@@ -200,7 +200,7 @@ func (router *Router) HandleRoute(writer http.ResponseWriter, request *http.Requ
 			route.Metrics.EventProcessingFailed(route.EventSourceName, route.EventName)
 			return
 		}
-		route.DataCh <- eventBody
+		route.Deliver(request, eventBody)
 		return
 	}
 
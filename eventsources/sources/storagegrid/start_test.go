@@ -113,7 +113,7 @@ func TestRouteActiveHandler(t *testing.T) {
 			dataCh := make(chan []byte)
 			go func() {
 				resp := <-router.route.DataCh
-				dataCh <- resp
+				dataCh <- resp.Data
 			}()
 
 			router.HandleRoute(writer, &http.Request{
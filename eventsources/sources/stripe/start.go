@@ -133,7 +133,7 @@ func (rc *Router) HandleRoute(writer http.ResponseWriter, request *http.Request)
 	}
 
 	logger.Info("dispatching event on route's data channel...")
-	route.DataCh <- data
+	route.Deliver(request, data)
 	logger.Info("request successfully processed")
 	common.SendSuccessResponse(writer, "success")
 }
@@ -135,7 +135,7 @@ func (router *Router) HandleRoute(writer http.ResponseWriter, request *http.Requ
 	}
 
 	logger.Info("dispatching event on route's data channel...")
-	route.DataCh <- data
+	route.Deliver(request, data)
 	logger.Info("successfully processed the request")
 	common.SendSuccessResponse(writer, "success")
 }
@@ -27,7 +27,7 @@ func TestHandleRoute(t *testing.T) {
 			router.route.Context.Method = http.MethodGet
 
 			go func() {
-				out <- <-router.route.DataCh
+				out <- (<-router.route.DataCh).Data
 			}()
 
 			router.HandleRoute(writer, &http.Request{
@@ -45,7 +45,7 @@ func TestHandleRoute(t *testing.T) {
 			router.route.Context.Method = http.MethodGet
 
 			go func() {
-				out <- <-router.route.DataCh
+				out <- (<-router.route.DataCh).Data
 			}()
 
 			router.HandleRoute(writer, &http.Request{
@@ -64,7 +64,7 @@ func TestHandleRoute(t *testing.T) {
 			router.route.Context.Method = http.MethodPost
 
 			go func() {
-				out <- <-router.route.DataCh
+				out <- (<-router.route.DataCh).Data
 			}()
 
 			var buf bytes.Buffer
@@ -90,7 +90,7 @@ func TestHandleRoute(t *testing.T) {
 			router.route.Context.Method = http.MethodPost
 
 			go func() {
-				out <- <-router.route.DataCh
+				out <- (<-router.route.DataCh).Data
 			}()
 
 			var buf bytes.Buffer
@@ -0,0 +1,53 @@
+package eventsources
+
+import (
+	"fmt"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/itchyny/gojq"
+	lua "github.com/yuin/gopher-lua"
+
+	"github.com/argoproj/argo-events/pkg/apis/eventsource/v1alpha1"
+	sensorv1alpha1 "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+	"github.com/argoproj/argo-events/sensors/dependencies"
+)
+
+// ValidateTransform validates the transform of an event source.
+func ValidateTransform(transform *v1alpha1.EventSourceTransform) error {
+	if transform == nil {
+		return nil
+	}
+	switch {
+	case transform.JQ != "" && transform.Script != "":
+		return fmt.Errorf("only one of jq and script can be specified")
+	case transform.JQ != "":
+		if _, err := gojq.Parse(transform.JQ); err != nil {
+			return fmt.Errorf("invalid jq command, %w", err)
+		}
+	case transform.Script != "":
+		l := lua.NewState()
+		defer l.Close()
+		if _, err := l.LoadString(transform.Script); err != nil {
+			return fmt.Errorf("invalid script, %w", err)
+		}
+	default:
+		return fmt.Errorf("either jq or script must be specified")
+	}
+	return nil
+}
+
+// transformEvent applies the transform on the payload of an event, the same way as the transform of a Sensor dependency.
+func transformEvent(data []byte, transform *v1alpha1.EventSourceTransform) ([]byte, error) {
+	event := cloudevents.NewEvent()
+	if err := event.SetData(cloudevents.ApplicationJSON, data); err != nil {
+		return nil, err
+	}
+	transformed, err := dependencies.ApplyTransform(&event, &sensorv1alpha1.EventDependencyTransformer{
+		JQ:     transform.JQ,
+		Script: transform.Script,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return transformed.Data(), nil
+}
@@ -0,0 +1,40 @@
+package eventsources
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/argoproj/argo-events/pkg/apis/eventsource/v1alpha1"
+)
+
+func TestTransformEvent(t *testing.T) {
+	data := []byte(`{"header": {"Content-Type": ["application/json"]}, "body": {"message": "hello", "metadata": {"uid": "abc"}}}`)
+
+	t.Run("test jq", func(t *testing.T) {
+		transformed, err := transformEvent(data, &v1alpha1.EventSourceTransform{JQ: "{message: .body.message}"})
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"message": "hello"}`, string(transformed))
+	})
+
+	t.Run("test script", func(t *testing.T) {
+		transformed, err := transformEvent(data, &v1alpha1.EventSourceTransform{Script: "event.header = nil\nreturn event"})
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"body": {"message": "hello", "metadata": {"uid": "abc"}}}`, string(transformed))
+	})
+
+	t.Run("test output not an object", func(t *testing.T) {
+		_, err := transformEvent(data, &v1alpha1.EventSourceTransform{JQ: ".body.message"})
+		assert.Error(t, err)
+	})
+}
+
+func TestValidateTransform(t *testing.T) {
+	assert.NoError(t, ValidateTransform(nil))
+	assert.NoError(t, ValidateTransform(&v1alpha1.EventSourceTransform{JQ: "del(.header)"}))
+	assert.NoError(t, ValidateTransform(&v1alpha1.EventSourceTransform{Script: "return event"}))
+	assert.Error(t, ValidateTransform(&v1alpha1.EventSourceTransform{}))
+	assert.Error(t, ValidateTransform(&v1alpha1.EventSourceTransform{JQ: "del(.header", Script: "return event"}))
+	assert.Error(t, ValidateTransform(&v1alpha1.EventSourceTransform{JQ: "del(.header"}))
+	assert.Error(t, ValidateTransform(&v1alpha1.EventSourceTransform{Script: "return event end"}))
+}
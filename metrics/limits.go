@@ -0,0 +1,74 @@
+package metrics
+
+import (
+	"fmt"
+	"hash/fnv"
+	"sync"
+
+	apicommon "github.com/argoproj/argo-events/pkg/apis/common"
+)
+
+const (
+	// otherLabelValue replaces the label values above the limit with the Drop policy
+	otherLabelValue = "_other"
+)
+
+// limitedLabels are the labels whose cardinality can be limited
+var limitedLabels = []string{labelEventName, labelTriggerName, labelDependencyName}
+
+// IsLimitedLabel returns whether the cardinality of the label can be limited.
+func IsLimitedLabel(label string) bool {
+	for _, l := range limitedLabels {
+		if l == label {
+			return true
+		}
+	}
+	return false
+}
+
+// labelLimiter keeps the first distinct values of each limited label, and replaces the other ones according to
+// the policy.
+type labelLimiter struct {
+	lock    sync.Mutex
+	max     int
+	policy  apicommon.MetricsLabelPolicy
+	buckets uint32
+	values  map[string]map[string]bool
+}
+
+func newLabelLimiter(limits *apicommon.MetricsLabelLimits) *labelLimiter {
+	labels := limits.Labels
+	if len(labels) == 0 {
+		labels = limitedLabels
+	}
+	values := map[string]map[string]bool{}
+	for _, label := range labels {
+		values[label] = map[string]bool{}
+	}
+	return &labelLimiter{
+		max:     int(limits.MaxValues),
+		policy:  limits.GetPolicy(),
+		buckets: uint32(limits.GetHashBuckets()),
+		values:  values,
+	}
+}
+
+// value returns the value to use for the label, and whether it has been replaced.
+func (l *labelLimiter) value(label, value string) (string, bool) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	seen, ok := l.values[label]
+	if !ok || seen[value] {
+		return value, false
+	}
+	if len(seen) < l.max {
+		seen[value] = true
+		return value, false
+	}
+	if l.policy == apicommon.MetricsLabelHash {
+		h := fnv.New32a()
+		_, _ = h.Write([]byte(value))
+		return fmt.Sprintf("_hash_%d", h.Sum32()%l.buckets), true
+	}
+	return otherLabelValue, true
+}
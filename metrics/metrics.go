@@ -2,8 +2,11 @@ package metrics
 
 import (
 	"context"
+	"encoding/json"
 	"net/http"
 	"runtime"
+	"strconv"
+	"sync"
 
 	"github.com/prometheus/client_golang/prometheus/collectors"
 
@@ -12,7 +15,9 @@ import (
 	"go.uber.org/zap"
 
 	argoevents "github.com/argoproj/argo-events"
+	"github.com/argoproj/argo-events/common"
 	"github.com/argoproj/argo-events/common/logging"
+	apicommon "github.com/argoproj/argo-events/pkg/apis/common"
 )
 
 const (
@@ -23,6 +28,13 @@ const (
 	labelEventName       = "event_name"
 	labelSensorName      = "sensor_name"
 	labelTriggerName     = "trigger_name"
+	labelPolicy          = "policy"
+	labelDependencyName  = "dependency_name"
+	labelLabel           = "label"
+	labelOperation       = "operation"
+	labelTarget          = "target"
+	labelEndpoint        = "endpoint"
+	labelCode            = "code"
 )
 
 var (
@@ -37,16 +49,44 @@ var (
 
 // Metrics represents EventSource metrics information
 type Metrics struct {
-	namespace               string
-	runningEventServices    *prometheus.GaugeVec
-	eventsSent              *prometheus.CounterVec
-	eventsSentFailed        *prometheus.CounterVec
-	eventsProcessingFailed  *prometheus.CounterVec
-	eventProcessingDuration *prometheus.SummaryVec
-	actionTriggered         *prometheus.CounterVec
-	actionFailed            *prometheus.CounterVec
-	actionRetriesFailed     *prometheus.CounterVec
-	actionDuration          *prometheus.SummaryVec
+	namespace                  string
+	limiterLock                sync.RWMutex
+	limiter                    *labelLimiter
+	runningEventServices       *prometheus.GaugeVec
+	eventsSent                 *prometheus.CounterVec
+	eventsSentFailed           *prometheus.CounterVec
+	eventsProcessingFailed     *prometheus.CounterVec
+	eventProcessingDuration    *prometheus.SummaryVec
+	eventsOversized            *prometheus.CounterVec
+	eventsReceived             *prometheus.CounterVec
+	eventsFiltered             *prometheus.CounterVec
+	eventsDropped              *prometheus.CounterVec
+	redeliveriesSuppressed     *prometheus.CounterVec
+	webhookRequests            *prometheus.CounterVec
+	webhookAuthFailures        *prometheus.CounterVec
+	webhookRequestDuration     *prometheus.HistogramVec
+	dependencyEventsReceived   *prometheus.CounterVec
+	dependencyEventsFiltered   *prometheus.CounterVec
+	dependencyEventsInvalid    *prometheus.CounterVec
+	dependencyEventsGuarded    *prometheus.CounterVec
+	dependencyMetricUnresolved *prometheus.CounterVec
+	actionTriggered            *prometheus.CounterVec
+	actionFailed               *prometheus.CounterVec
+	actionRetriesFailed        *prometheus.CounterVec
+	actionDuration             *prometheus.SummaryVec
+	actionDeduplicated         *prometheus.CounterVec
+	actionCached               *prometheus.CounterVec
+	actionGated                *prometheus.CounterVec
+	actionOutsideWindows       *prometheus.CounterVec
+	actionQuotaExceeded        *prometheus.CounterVec
+	actionOversizeRouted       *prometheus.CounterVec
+	triggerPoolBusy            *prometheus.GaugeVec
+	triggerPoolQueued          *prometheus.GaugeVec
+	triggerPoolSaturated       *prometheus.CounterVec
+	labelValuesLimited         *prometheus.CounterVec
+	retryAttempts              *prometheus.Desc
+	retryAttemptsFailed        *prometheus.Desc
+	retriesExhausted           *prometheus.Desc
 }
 
 // NewMetrics returns a Metrics instance
@@ -93,6 +133,111 @@ func NewMetrics(namespace string) *Metrics {
 				labelNamespace: namespace,
 			},
 		}, []string{labelEventSourceName, labelEventName}),
+		eventsOversized: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: prefix,
+			Name:      "events_oversized_total",
+			Help:      "How many events exceeded the maximum event size, labeled by the applied policy. https://argoproj.github.io/argo-events/metrics/#argo_events_events_oversized_total",
+			ConstLabels: prometheus.Labels{
+				labelNamespace: namespace,
+			},
+		}, []string{labelEventSourceName, labelEventName, labelPolicy}),
+		eventsReceived: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: prefix,
+			Name:      "events_received_total",
+			Help:      "How many events have been received by the EventSource, before filtering. https://argoproj.github.io/argo-events/metrics/#argo_events_events_received_total",
+			ConstLabels: prometheus.Labels{
+				labelNamespace: namespace,
+			},
+		}, []string{labelEventSourceName, labelEventName}),
+		eventsFiltered: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: prefix,
+			Name:      "events_filtered_total",
+			Help:      "How many events have been discarded by the EventSource filter. https://argoproj.github.io/argo-events/metrics/#argo_events_events_filtered_total",
+			ConstLabels: prometheus.Labels{
+				labelNamespace: namespace,
+			},
+		}, []string{labelEventSourceName, labelEventName}),
+		eventsDropped: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: prefix,
+			Name:      "events_dropped_total",
+			Help:      "How many events have been dropped by the EventSource without being published. https://argoproj.github.io/argo-events/metrics/#argo_events_events_dropped_total",
+			ConstLabels: prometheus.Labels{
+				labelNamespace: namespace,
+			},
+		}, []string{labelEventSourceName, labelEventName}),
+		redeliveriesSuppressed: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: prefix,
+			Name:      "webhook_redeliveries_suppressed_total",
+			Help:      "How many webhook redeliveries have been suppressed by the EventSource. https://argoproj.github.io/argo-events/metrics/#argo_events_webhook_redeliveries_suppressed_total",
+			ConstLabels: prometheus.Labels{
+				labelNamespace: namespace,
+			},
+		}, []string{labelEventSourceName, labelEventName}),
+		webhookRequests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: prefix,
+			Name:      "webhook_requests_total",
+			Help:      "How many requests have been received by a webhook endpoint of the EventSource, labeled by the status code of the response. https://argoproj.github.io/argo-events/metrics/#argo_events_webhook_requests_total",
+			ConstLabels: prometheus.Labels{
+				labelNamespace: namespace,
+			},
+		}, []string{labelEventSourceName, labelEventName, labelEndpoint, labelCode}),
+		webhookAuthFailures: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: prefix,
+			Name:      "webhook_auth_failures_total",
+			Help:      "How many requests to a webhook endpoint of the EventSource have failed the authentication. https://argoproj.github.io/argo-events/metrics/#argo_events_webhook_auth_failures_total",
+			ConstLabels: prometheus.Labels{
+				labelNamespace: namespace,
+			},
+		}, []string{labelEventSourceName, labelEventName, labelEndpoint}),
+		webhookRequestDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: prefix,
+			Name:      "webhook_request_duration_seconds",
+			Help:      "Histogram of the durations of the requests handled by a webhook endpoint of the EventSource. https://argoproj.github.io/argo-events/metrics/#argo_events_webhook_request_duration_seconds",
+			Buckets:   prometheus.DefBuckets,
+			ConstLabels: prometheus.Labels{
+				labelNamespace: namespace,
+			},
+		}, []string{labelEventSourceName, labelEventName, labelEndpoint}),
+		dependencyEventsReceived: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: prefix,
+			Name:      "dependency_events_received_total",
+			Help:      "How many events have been received by a Sensor dependency, per trigger subscription. https://argoproj.github.io/argo-events/metrics/#argo_events_dependency_events_received_total",
+			ConstLabels: prometheus.Labels{
+				labelNamespace: namespace,
+			},
+		}, []string{labelSensorName, labelTriggerName, labelEventSourceName, labelDependencyName}),
+		dependencyEventsFiltered: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: prefix,
+			Name:      "dependency_events_filtered_total",
+			Help:      "How many events have been discarded by the filters of a Sensor dependency. https://argoproj.github.io/argo-events/metrics/#argo_events_dependency_events_filtered_total",
+			ConstLabels: prometheus.Labels{
+				labelNamespace: namespace,
+			},
+		}, []string{labelSensorName, labelTriggerName, labelEventSourceName, labelDependencyName}),
+		dependencyEventsInvalid: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: prefix,
+			Name:      "dependency_events_schema_invalid_total",
+			Help:      "How many events have been discarded by a Sensor dependency because their data does not conform to their dataschema. https://argoproj.github.io/argo-events/metrics/#argo_events_dependency_events_schema_invalid_total",
+			ConstLabels: prometheus.Labels{
+				labelNamespace: namespace,
+			},
+		}, []string{labelSensorName, labelTriggerName, labelEventSourceName, labelDependencyName}),
+		dependencyEventsGuarded: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: prefix,
+			Name:      "dependency_events_guard_rejected_total",
+			Help:      "How many events have been discarded by the payload guards of a Sensor dependency. https://argoproj.github.io/argo-events/metrics/#argo_events_dependency_events_guard_rejected_total",
+			ConstLabels: prometheus.Labels{
+				labelNamespace: namespace,
+			},
+		}, []string{labelSensorName, labelTriggerName, labelEventSourceName, labelDependencyName}),
+		dependencyMetricUnresolved: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: prefix,
+			Name:      "dependency_metric_unresolved_total",
+			Help:      "How many trigger executions have been skipped because a metric dependency was not resolved. https://argoproj.github.io/argo-events/metrics/#argo_events_dependency_metric_unresolved_total",
+			ConstLabels: prometheus.Labels{
+				labelNamespace: namespace,
+			},
+		}, []string{labelSensorName, labelTriggerName, labelDependencyName}),
 		actionTriggered: prometheus.NewCounterVec(prometheus.CounterOpts{
 			Namespace: prefix,
 			Name:      "action_triggered_total",
@@ -125,7 +270,125 @@ func NewMetrics(namespace string) *Metrics {
 				labelNamespace: namespace,
 			},
 		}, []string{labelSensorName, labelTriggerName}),
+		actionDeduplicated: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: prefix,
+			Name:      "action_deduplicated_total",
+			Help:      "How many actions were skipped as duplicates. https://argoproj.github.io/argo-events/metrics/#argo_events_action_deduplicated_total",
+			ConstLabels: prometheus.Labels{
+				labelNamespace: namespace,
+			},
+		}, []string{labelSensorName, labelTriggerName}),
+		actionCached: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: prefix,
+			Name:      "action_cached_total",
+			Help:      "How many actions were skipped because an identical request was executed recently. https://argoproj.github.io/argo-events/metrics/#argo_events_action_cached_total",
+			ConstLabels: prometheus.Labels{
+				labelNamespace: namespace,
+			},
+		}, []string{labelSensorName, labelTriggerName}),
+		actionGated: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: prefix,
+			Name:      "action_gated_total",
+			Help:      "How many actions were skipped because their feature flag was off. https://argoproj.github.io/argo-events/metrics/#argo_events_action_gated_total",
+			ConstLabels: prometheus.Labels{
+				labelNamespace: namespace,
+			},
+		}, []string{labelSensorName, labelTriggerName}),
+		actionOutsideWindows: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: prefix,
+			Name:      "action_outside_windows_total",
+			Help:      "How many actions were dropped outside the active windows of their triggers. https://argoproj.github.io/argo-events/metrics/#argo_events_action_outside_windows_total",
+			ConstLabels: prometheus.Labels{
+				labelNamespace: namespace,
+			},
+		}, []string{labelSensorName, labelTriggerName}),
+		actionQuotaExceeded: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: prefix,
+			Name:      "action_quota_exceeded_total",
+			Help:      "How many actions exceeded the execution quota of their sensor. https://argoproj.github.io/argo-events/metrics/#argo_events_action_quota_exceeded_total",
+			ConstLabels: prometheus.Labels{
+				labelNamespace: namespace,
+			},
+		}, []string{labelSensorName, labelTriggerName}),
+		actionOversizeRouted: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: prefix,
+			Name:      "action_oversize_routed_total",
+			Help:      "How many oversized executions have been routed to the alternate trigger of their oversize route. https://argoproj.github.io/argo-events/metrics/#argo_events_action_oversize_routed_total",
+			ConstLabels: prometheus.Labels{
+				labelNamespace: namespace,
+			},
+		}, []string{labelSensorName, labelTriggerName}),
+		triggerPoolBusy: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: prefix,
+			Name:      "trigger_pool_busy_workers",
+			Help:      "How many workers of the pool of a trigger are running an execution. https://argoproj.github.io/argo-events/metrics/#argo_events_trigger_pool_busy_workers",
+			ConstLabels: prometheus.Labels{
+				labelNamespace: namespace,
+			},
+		}, []string{labelSensorName, labelTriggerName}),
+		triggerPoolQueued: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: prefix,
+			Name:      "trigger_pool_queued_executions",
+			Help:      "How many executions of a trigger are waiting for a worker of its pool. https://argoproj.github.io/argo-events/metrics/#argo_events_trigger_pool_queued_executions",
+			ConstLabels: prometheus.Labels{
+				labelNamespace: namespace,
+			},
+		}, []string{labelSensorName, labelTriggerName}),
+		triggerPoolSaturated: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: prefix,
+			Name:      "trigger_pool_saturated_total",
+			Help:      "How many executions of a trigger have found the queue of its pool full, and waited to be queued. https://argoproj.github.io/argo-events/metrics/#argo_events_trigger_pool_saturated_total",
+			ConstLabels: prometheus.Labels{
+				labelNamespace: namespace,
+			},
+		}, []string{labelSensorName, labelTriggerName}),
+		labelValuesLimited: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: prefix,
+			Name:      "metric_label_values_limited_total",
+			Help:      "How many label values have been replaced because of the label limits. https://argoproj.github.io/argo-events/metrics/#argo_events_metric_label_values_limited_total",
+			ConstLabels: prometheus.Labels{
+				labelNamespace: namespace,
+			},
+		}, []string{labelLabel}),
+		retryAttempts: prometheus.NewDesc(
+			prometheus.BuildFQName(prefix, "", "retry_attempts"),
+			"How many attempts of an operation being retried have failed, until it succeeds or its retries are exhausted. https://argoproj.github.io/argo-events/metrics/#argo_events_retry_attempts",
+			[]string{labelOperation, labelTarget}, prometheus.Labels{labelNamespace: namespace}),
+		retryAttemptsFailed: prometheus.NewDesc(
+			prometheus.BuildFQName(prefix, "", "retry_attempts_failed_total"),
+			"How many attempts of the retried operations have failed. https://argoproj.github.io/argo-events/metrics/#argo_events_retry_attempts_failed_total",
+			[]string{labelOperation, labelTarget}, prometheus.Labels{labelNamespace: namespace}),
+		retriesExhausted: prometheus.NewDesc(
+			prometheus.BuildFQName(prefix, "", "retries_exhausted_total"),
+			"How many times the retries of an operation have been exhausted. https://argoproj.github.io/argo-events/metrics/#argo_events_retries_exhausted_total",
+			[]string{labelOperation, labelTarget}, prometheus.Labels{labelNamespace: namespace}),
+	}
+}
+
+// SetLabelLimits limits the cardinality of the labels of the metrics, they are not limited if limits is nil.
+func (m *Metrics) SetLabelLimits(limits *apicommon.MetricsLabelLimits) {
+	m.limiterLock.Lock()
+	defer m.limiterLock.Unlock()
+	if limits == nil || limits.MaxValues <= 0 {
+		m.limiter = nil
+		return
+	}
+	m.limiter = newLabelLimiter(limits)
+}
+
+// limit returns the value to use for the label, according to the label limits.
+func (m *Metrics) limit(label, value string) string {
+	m.limiterLock.RLock()
+	limiter := m.limiter
+	m.limiterLock.RUnlock()
+	if limiter == nil {
+		return value
+	}
+	v, limited := limiter.value(label, value)
+	if limited {
+		m.labelValuesLimited.WithLabelValues(label).Inc()
 	}
+	return v
 }
 
 func (m *Metrics) Collect(ch chan<- prometheus.Metric) {
@@ -134,10 +397,40 @@ func (m *Metrics) Collect(ch chan<- prometheus.Metric) {
 	m.eventsSentFailed.Collect(ch)
 	m.eventsProcessingFailed.Collect(ch)
 	m.eventProcessingDuration.Collect(ch)
+	m.eventsOversized.Collect(ch)
+	m.eventsReceived.Collect(ch)
+	m.eventsFiltered.Collect(ch)
+	m.eventsDropped.Collect(ch)
+	m.redeliveriesSuppressed.Collect(ch)
+	m.webhookRequests.Collect(ch)
+	m.webhookAuthFailures.Collect(ch)
+	m.webhookRequestDuration.Collect(ch)
+	m.dependencyEventsReceived.Collect(ch)
+	m.dependencyEventsFiltered.Collect(ch)
+	m.dependencyEventsInvalid.Collect(ch)
+	m.dependencyEventsGuarded.Collect(ch)
+	m.dependencyMetricUnresolved.Collect(ch)
 	m.actionTriggered.Collect(ch)
 	m.actionFailed.Collect(ch)
 	m.actionRetriesFailed.Collect(ch)
 	m.actionDuration.Collect(ch)
+	m.actionDeduplicated.Collect(ch)
+	m.actionCached.Collect(ch)
+	m.actionGated.Collect(ch)
+	m.actionOutsideWindows.Collect(ch)
+	m.actionQuotaExceeded.Collect(ch)
+	m.actionOversizeRouted.Collect(ch)
+	m.triggerPoolBusy.Collect(ch)
+	m.triggerPoolQueued.Collect(ch)
+	m.triggerPoolSaturated.Collect(ch)
+	m.labelValuesLimited.Collect(ch)
+	for _, state := range common.RetryStates() {
+		ch <- prometheus.MustNewConstMetric(m.retryAttempts, prometheus.GaugeValue, float64(state.Attempts), state.Operation, state.Target)
+	}
+	for _, count := range common.RetryCounts() {
+		ch <- prometheus.MustNewConstMetric(m.retryAttemptsFailed, prometheus.CounterValue, float64(count.FailedAttempts), count.Operation, count.Target)
+		ch <- prometheus.MustNewConstMetric(m.retriesExhausted, prometheus.CounterValue, float64(count.Exhausted), count.Operation, count.Target)
+	}
 }
 
 func (m *Metrics) Describe(ch chan<- *prometheus.Desc) {
@@ -146,10 +439,36 @@ func (m *Metrics) Describe(ch chan<- *prometheus.Desc) {
 	m.eventsSentFailed.Describe(ch)
 	m.eventsProcessingFailed.Describe(ch)
 	m.eventProcessingDuration.Describe(ch)
+	m.eventsOversized.Describe(ch)
+	m.eventsReceived.Describe(ch)
+	m.eventsFiltered.Describe(ch)
+	m.eventsDropped.Describe(ch)
+	m.redeliveriesSuppressed.Describe(ch)
+	m.webhookRequests.Describe(ch)
+	m.webhookAuthFailures.Describe(ch)
+	m.webhookRequestDuration.Describe(ch)
+	m.dependencyEventsReceived.Describe(ch)
+	m.dependencyEventsFiltered.Describe(ch)
+	m.dependencyEventsInvalid.Describe(ch)
+	m.dependencyEventsGuarded.Describe(ch)
+	m.dependencyMetricUnresolved.Describe(ch)
 	m.actionTriggered.Describe(ch)
 	m.actionFailed.Describe(ch)
 	m.actionRetriesFailed.Describe(ch)
 	m.actionDuration.Describe(ch)
+	m.actionDeduplicated.Describe(ch)
+	m.actionCached.Describe(ch)
+	m.actionGated.Describe(ch)
+	m.actionOutsideWindows.Describe(ch)
+	m.actionQuotaExceeded.Describe(ch)
+	m.actionOversizeRouted.Describe(ch)
+	m.triggerPoolBusy.Describe(ch)
+	m.triggerPoolQueued.Describe(ch)
+	m.triggerPoolSaturated.Describe(ch)
+	m.labelValuesLimited.Describe(ch)
+	ch <- m.retryAttempts
+	ch <- m.retryAttemptsFailed
+	ch <- m.retriesExhausted
 }
 
 func (m *Metrics) IncRunningServices(eventSourceName string) {
@@ -161,35 +480,125 @@ func (m *Metrics) DecRunningServices(eventSourceName string) {
 }
 
 func (m *Metrics) EventSent(eventSourceName, eventName string) {
-	m.eventsSent.WithLabelValues(eventSourceName, eventName).Inc()
+	m.eventsSent.WithLabelValues(eventSourceName, m.limit(labelEventName, eventName)).Inc()
 }
 
 func (m *Metrics) EventSentFailed(eventSourceName, eventName string) {
-	m.eventsSentFailed.WithLabelValues(eventSourceName, eventName).Inc()
+	m.eventsSentFailed.WithLabelValues(eventSourceName, m.limit(labelEventName, eventName)).Inc()
 }
 
 func (m *Metrics) EventProcessingFailed(eventSourceName, eventName string) {
-	m.eventsProcessingFailed.WithLabelValues(eventSourceName, eventName).Inc()
+	m.eventsProcessingFailed.WithLabelValues(eventSourceName, m.limit(labelEventName, eventName)).Inc()
 }
 
 func (m *Metrics) EventProcessingDuration(eventSourceName, eventName string, num float64) {
-	m.eventProcessingDuration.WithLabelValues(eventSourceName, eventName).Observe(num)
+	m.eventProcessingDuration.WithLabelValues(eventSourceName, m.limit(labelEventName, eventName)).Observe(num)
+}
+
+func (m *Metrics) EventOversized(eventSourceName, eventName, policy string) {
+	m.eventsOversized.WithLabelValues(eventSourceName, m.limit(labelEventName, eventName), policy).Inc()
+}
+
+func (m *Metrics) EventReceived(eventSourceName, eventName string) {
+	m.eventsReceived.WithLabelValues(eventSourceName, m.limit(labelEventName, eventName)).Inc()
+}
+
+func (m *Metrics) EventFiltered(eventSourceName, eventName string) {
+	m.eventsFiltered.WithLabelValues(eventSourceName, m.limit(labelEventName, eventName)).Inc()
+}
+
+func (m *Metrics) EventDropped(eventSourceName, eventName string) {
+	m.eventsDropped.WithLabelValues(eventSourceName, m.limit(labelEventName, eventName)).Inc()
+}
+
+func (m *Metrics) EventRedeliverySuppressed(eventSourceName, eventName string) {
+	m.redeliveriesSuppressed.WithLabelValues(eventSourceName, m.limit(labelEventName, eventName)).Inc()
+}
+
+func (m *Metrics) WebhookRequestHandled(eventSourceName, eventName, endpoint string, code int, seconds float64) {
+	eventName = m.limit(labelEventName, eventName)
+	m.webhookRequests.WithLabelValues(eventSourceName, eventName, endpoint, strconv.Itoa(code)).Inc()
+	m.webhookRequestDuration.WithLabelValues(eventSourceName, eventName, endpoint).Observe(seconds)
+}
+
+func (m *Metrics) WebhookAuthFailed(eventSourceName, eventName, endpoint string) {
+	m.webhookAuthFailures.WithLabelValues(eventSourceName, m.limit(labelEventName, eventName), endpoint).Inc()
+}
+
+func (m *Metrics) DependencyEventReceived(sensorName, triggerName, eventSourceName, dependencyName string) {
+	m.dependencyEventsReceived.WithLabelValues(sensorName, m.limit(labelTriggerName, triggerName), eventSourceName, m.limit(labelDependencyName, dependencyName)).Inc()
+}
+
+func (m *Metrics) DependencyEventFiltered(sensorName, triggerName, eventSourceName, dependencyName string) {
+	m.dependencyEventsFiltered.WithLabelValues(sensorName, m.limit(labelTriggerName, triggerName), eventSourceName, m.limit(labelDependencyName, dependencyName)).Inc()
+}
+
+func (m *Metrics) DependencyEventSchemaInvalid(sensorName, triggerName, eventSourceName, dependencyName string) {
+	m.dependencyEventsInvalid.WithLabelValues(sensorName, m.limit(labelTriggerName, triggerName), eventSourceName, m.limit(labelDependencyName, dependencyName)).Inc()
+}
+
+func (m *Metrics) DependencyEventGuardRejected(sensorName, triggerName, eventSourceName, dependencyName string) {
+	m.dependencyEventsGuarded.WithLabelValues(sensorName, m.limit(labelTriggerName, triggerName), eventSourceName, m.limit(labelDependencyName, dependencyName)).Inc()
+}
+
+func (m *Metrics) DependencyMetricUnresolved(sensorName, triggerName, dependencyName string) {
+	m.dependencyMetricUnresolved.WithLabelValues(sensorName, m.limit(labelTriggerName, triggerName), m.limit(labelDependencyName, dependencyName)).Inc()
 }
 
 func (m *Metrics) ActionTriggered(sensorName, triggerName string) {
-	m.actionTriggered.WithLabelValues(sensorName, triggerName).Inc()
+	m.actionTriggered.WithLabelValues(sensorName, m.limit(labelTriggerName, triggerName)).Inc()
 }
 
 func (m *Metrics) ActionFailed(sensorName, triggerName string) {
-	m.actionFailed.WithLabelValues(sensorName, triggerName).Inc()
+	m.actionFailed.WithLabelValues(sensorName, m.limit(labelTriggerName, triggerName)).Inc()
 }
 
 func (m *Metrics) ActionRetriesFailed(sensorName, triggerName string) {
-	m.actionRetriesFailed.WithLabelValues(sensorName, triggerName).Inc()
+	m.actionRetriesFailed.WithLabelValues(sensorName, m.limit(labelTriggerName, triggerName)).Inc()
+}
+
+func (m *Metrics) ActionDeduplicated(sensorName, triggerName string) {
+	m.actionDeduplicated.WithLabelValues(sensorName, m.limit(labelTriggerName, triggerName)).Inc()
+}
+
+func (m *Metrics) ActionCached(sensorName, triggerName string) {
+	m.actionCached.WithLabelValues(sensorName, m.limit(labelTriggerName, triggerName)).Inc()
+}
+
+func (m *Metrics) ActionGated(sensorName, triggerName string) {
+	m.actionGated.WithLabelValues(sensorName, m.limit(labelTriggerName, triggerName)).Inc()
+}
+
+func (m *Metrics) ActionOutsideWindows(sensorName, triggerName string) {
+	m.actionOutsideWindows.WithLabelValues(sensorName, m.limit(labelTriggerName, triggerName)).Inc()
+}
+
+func (m *Metrics) ActionQuotaExceeded(sensorName, triggerName string) {
+	m.actionQuotaExceeded.WithLabelValues(sensorName, m.limit(labelTriggerName, triggerName)).Inc()
+}
+
+func (m *Metrics) ActionOversizeRouted(sensorName, triggerName string) {
+	m.actionOversizeRouted.WithLabelValues(sensorName, m.limit(labelTriggerName, triggerName)).Inc()
+}
+
+func (m *Metrics) IncTriggerPoolBusy(sensorName, triggerName string) {
+	m.triggerPoolBusy.WithLabelValues(sensorName, m.limit(labelTriggerName, triggerName)).Inc()
+}
+
+func (m *Metrics) DecTriggerPoolBusy(sensorName, triggerName string) {
+	m.triggerPoolBusy.WithLabelValues(sensorName, m.limit(labelTriggerName, triggerName)).Dec()
+}
+
+func (m *Metrics) TriggerPoolQueued(sensorName, triggerName string, queued int) {
+	m.triggerPoolQueued.WithLabelValues(sensorName, m.limit(labelTriggerName, triggerName)).Set(float64(queued))
+}
+
+func (m *Metrics) TriggerPoolSaturated(sensorName, triggerName string) {
+	m.triggerPoolSaturated.WithLabelValues(sensorName, m.limit(labelTriggerName, triggerName)).Inc()
 }
 
 func (m *Metrics) ActionDuration(sensorName, triggerName string, num float64) {
-	m.actionDuration.WithLabelValues(sensorName, triggerName).Observe(num)
+	m.actionDuration.WithLabelValues(sensorName, m.limit(labelTriggerName, triggerName)).Observe(num)
 }
 
 // Run starts a metrics server
@@ -201,6 +610,7 @@ func (m *Metrics) Run(ctx context.Context, addr string) {
 	recordBuildInfo()
 
 	http.Handle("/metrics", promhttp.HandlerFor(metricsRegistry, promhttp.HandlerOpts{}))
+	http.HandleFunc("/retries", RetriesHandler)
 
 	log.Info("starting metrics server")
 	if err := http.ListenAndServe(addr, nil); err != nil {
@@ -208,6 +618,12 @@ func (m *Metrics) Run(ctx context.Context, addr string) {
 	}
 }
 
+// RetriesHandler serves the states of the operations being retried, as a JSON array.
+func RetriesHandler(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(common.RetryStates())
+}
+
 // recordBuildInfo publishes information about Argo-Rollouts version and runtime info through an info metric (gauge).
 func recordBuildInfo() {
 	vers := argoevents.GetVersion()
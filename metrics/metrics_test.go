@@ -2,14 +2,20 @@ package metrics
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/assert"
 
+	"github.com/argoproj/argo-events/common"
 	"github.com/argoproj/argo-events/common/logging"
+	apicommon "github.com/argoproj/argo-events/pkg/apis/common"
 )
 
 func TestRun(t *testing.T) {
@@ -21,3 +27,92 @@ func TestRun(t *testing.T) {
 	assert.Nil(t, err)
 	assert.Equal(t, resp.StatusCode, 200)
 }
+
+func TestEventCounters(t *testing.T) {
+	m := NewMetrics("test-ns")
+	m.EventReceived("test-es", "test-event")
+	m.EventReceived("test-es", "test-event")
+	m.EventFiltered("test-es", "test-event")
+	m.EventDropped("test-es", "test-event")
+	m.DependencyEventReceived("test-sensor", "test-trigger", "test-es", "test-dep")
+	m.DependencyEventFiltered("test-sensor", "test-trigger", "test-es", "test-dep")
+	assert.Equal(t, float64(2), testutil.ToFloat64(m.eventsReceived.WithLabelValues("test-es", "test-event")))
+	assert.Equal(t, float64(1), testutil.ToFloat64(m.eventsFiltered.WithLabelValues("test-es", "test-event")))
+	assert.Equal(t, float64(1), testutil.ToFloat64(m.eventsDropped.WithLabelValues("test-es", "test-event")))
+	assert.Equal(t, float64(1), testutil.ToFloat64(m.dependencyEventsReceived.WithLabelValues("test-sensor", "test-trigger", "test-es", "test-dep")))
+	assert.Equal(t, float64(1), testutil.ToFloat64(m.dependencyEventsFiltered.WithLabelValues("test-sensor", "test-trigger", "test-es", "test-dep")))
+}
+
+func TestWebhookMetrics(t *testing.T) {
+	m := NewMetrics("test-ns")
+	m.WebhookRequestHandled("test-es", "test-event", "/push", 200, 0.01)
+	m.WebhookRequestHandled("test-es", "test-event", "/push", 401, 0.02)
+	m.WebhookAuthFailed("test-es", "test-event", "/push")
+	assert.Equal(t, float64(1), testutil.ToFloat64(m.webhookRequests.WithLabelValues("test-es", "test-event", "/push", "200")))
+	assert.Equal(t, float64(1), testutil.ToFloat64(m.webhookRequests.WithLabelValues("test-es", "test-event", "/push", "401")))
+	assert.Equal(t, float64(1), testutil.ToFloat64(m.webhookAuthFailures.WithLabelValues("test-es", "test-event", "/push")))
+	assert.Equal(t, 1, testutil.CollectAndCount(m.webhookRequestDuration))
+}
+
+func TestLabelLimits(t *testing.T) {
+	t.Run("drop", func(t *testing.T) {
+		m := NewMetrics("test-ns")
+		m.SetLabelLimits(&apicommon.MetricsLabelLimits{MaxValues: 2})
+		m.EventSent("test-es", "e1")
+		m.EventSent("test-es", "e2")
+		m.EventSent("test-es", "e3")
+		m.EventSent("test-es", "e4")
+		m.EventSent("test-es", "e1")
+		assert.Equal(t, float64(2), testutil.ToFloat64(m.eventsSent.WithLabelValues("test-es", "e1")))
+		assert.Equal(t, float64(1), testutil.ToFloat64(m.eventsSent.WithLabelValues("test-es", "e2")))
+		assert.Equal(t, float64(2), testutil.ToFloat64(m.eventsSent.WithLabelValues("test-es", otherLabelValue)))
+		assert.Equal(t, float64(2), testutil.ToFloat64(m.labelValuesLimited.WithLabelValues(labelEventName)))
+	})
+
+	t.Run("hash", func(t *testing.T) {
+		m := NewMetrics("test-ns")
+		m.SetLabelLimits(&apicommon.MetricsLabelLimits{MaxValues: 1, Policy: apicommon.MetricsLabelHash, HashBuckets: 4})
+		for i := 0; i < 100; i++ {
+			m.ActionTriggered("test-sensor", fmt.Sprintf("trigger-%d", i))
+		}
+		assert.Equal(t, 1+4, testutil.CollectAndCount(m.actionTriggered))
+		assert.Equal(t, float64(99), testutil.ToFloat64(m.labelValuesLimited.WithLabelValues(labelTriggerName)))
+	})
+
+	t.Run("selected labels", func(t *testing.T) {
+		m := NewMetrics("test-ns")
+		m.SetLabelLimits(&apicommon.MetricsLabelLimits{MaxValues: 1, Labels: []string{labelDependencyName}})
+		m.DependencyEventReceived("test-sensor", "t1", "test-es", "d1")
+		m.DependencyEventReceived("test-sensor", "t2", "test-es", "d2")
+		assert.Equal(t, float64(1), testutil.ToFloat64(m.dependencyEventsReceived.WithLabelValues("test-sensor", "t2", "test-es", otherLabelValue)))
+	})
+
+	t.Run("no limits", func(t *testing.T) {
+		m := NewMetrics("test-ns")
+		m.SetLabelLimits(nil)
+		m.EventSent("test-es", "e1")
+		assert.Equal(t, float64(1), testutil.ToFloat64(m.eventsSent.WithLabelValues("test-es", "e1")))
+	})
+}
+
+func TestRetries(t *testing.T) {
+	run := common.TrackRetries("test.connect", "test-es/test-event")
+	run.AttemptFailed(fmt.Errorf("connection refused"))
+	defer run.Done(nil)
+
+	m := NewMetrics("test-ns")
+	expected := `
+		# HELP argo_events_retry_attempts How many attempts of an operation being retried have failed, until it succeeds or its retries are exhausted. https://argoproj.github.io/argo-events/metrics/#argo_events_retry_attempts
+		# TYPE argo_events_retry_attempts gauge
+		argo_events_retry_attempts{namespace="test-ns",operation="test.connect",target="test-es/test-event"} 1
+	`
+	assert.NoError(t, testutil.CollectAndCompare(m, strings.NewReader(expected), "argo_events_retry_attempts"))
+
+	recorder := httptest.NewRecorder()
+	RetriesHandler(recorder, httptest.NewRequest(http.MethodGet, "/retries", nil))
+	var states []common.RetryState
+	assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &states))
+	assert.Len(t, states, 1)
+	assert.Equal(t, "test.connect", states[0].Operation)
+	assert.Equal(t, "connection refused", states[0].LastError)
+}
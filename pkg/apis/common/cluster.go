@@ -0,0 +1,30 @@
+package common
+
+const (
+	// ClusterConditionInstantiated has the status True when a cluster-scoped resource is instantiated
+	// into all the selected namespaces.
+	ClusterConditionInstantiated ConditionType = "Instantiated"
+)
+
+// ClusterResourceStatus holds the status of a cluster-scoped resource instantiated into namespaces
+type ClusterResourceStatus struct {
+	Status `json:",inline" protobuf:"bytes,1,opt,name=status"`
+	// Namespaces are the namespaces the resource is instantiated into
+	// +optional
+	Namespaces []string `json:"namespaces,omitempty" protobuf:"bytes,2,rep,name=namespaces"`
+}
+
+// InitConditions sets conditions to Unknown state.
+func (s *ClusterResourceStatus) InitConditions() {
+	s.InitializeConditions(ClusterConditionInstantiated)
+}
+
+// MarkInstantiated set the resource has been instantiated into all the selected namespaces.
+func (s *ClusterResourceStatus) MarkInstantiated() {
+	s.MarkTrue(ClusterConditionInstantiated)
+}
+
+// MarkNotInstantiated set the resource failed to be instantiated into some selected namespaces.
+func (s *ClusterResourceStatus) MarkNotInstantiated(reason, message string) {
+	s.MarkFalse(ClusterConditionInstantiated, reason, message)
+}
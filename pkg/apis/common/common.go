@@ -56,6 +56,11 @@ var (
 	GenericEvent         EventSourceType = "generic"
 	BitbucketServerEvent EventSourceType = "bitbucketserver"
 	BitbucketEvent       EventSourceType = "bitbucket"
+	ElasticsearchEvent   EventSourceType = "elasticsearch"
+	JenkinsEvent         EventSourceType = "jenkins"
+	SQLEvent             EventSourceType = "sql"
+	DynamoDBStreamsEvent EventSourceType = "dynamoDBStreams"
+	GRPCStreamEvent      EventSourceType = "grpcStream"
 )
 
 var (
@@ -81,6 +86,10 @@ var (
 		FileEvent,
 		SFTPEvent,
 		GenericEvent,
+		ElasticsearchEvent,
+		SQLEvent,
+		DynamoDBStreamsEvent,
+		GRPCStreamEvent,
 	}
 )
 
@@ -89,20 +98,28 @@ type TriggerType string
 
 // possible trigger types
 var (
-	OpenWhiskTrigger       TriggerType = "OpenWhisk"
-	ArgoWorkflowTrigger    TriggerType = "ArgoWorkflow"
-	LambdaTrigger          TriggerType = "Lambda"
-	CustomTrigger          TriggerType = "Custom"
-	HTTPTrigger            TriggerType = "HTTP"
-	KafkaTrigger           TriggerType = "Kafka"
-	PulsarTrigger          TriggerType = "Pulsar"
-	LogTrigger             TriggerType = "Log"
-	NATSTrigger            TriggerType = "NATS"
-	SlackTrigger           TriggerType = "Slack"
-	K8sTrigger             TriggerType = "Kubernetes"
-	AzureEventHubsTrigger  TriggerType = "AzureEventHubs"
-	AzureServiceBusTrigger TriggerType = "AzureServiceBus"
-	EmailTrigger           TriggerType = "Email"
+	OpenWhiskTrigger         TriggerType = "OpenWhisk"
+	ArgoWorkflowTrigger      TriggerType = "ArgoWorkflow"
+	LambdaTrigger            TriggerType = "Lambda"
+	CustomTrigger            TriggerType = "Custom"
+	HTTPTrigger              TriggerType = "HTTP"
+	KafkaTrigger             TriggerType = "Kafka"
+	PulsarTrigger            TriggerType = "Pulsar"
+	LogTrigger               TriggerType = "Log"
+	NATSTrigger              TriggerType = "NATS"
+	SlackTrigger             TriggerType = "Slack"
+	K8sTrigger               TriggerType = "Kubernetes"
+	AzureEventHubsTrigger    TriggerType = "AzureEventHubs"
+	AzureServiceBusTrigger   TriggerType = "AzureServiceBus"
+	EmailTrigger             TriggerType = "Email"
+	PrometheusTrigger        TriggerType = "Prometheus"
+	LokiTrigger              TriggerType = "Loki"
+	ElasticsearchTrigger     TriggerType = "Elasticsearch"
+	JenkinsTrigger           TriggerType = "Jenkins"
+	GithubWorkflowTrigger    TriggerType = "GithubWorkflow"
+	AWSSQSTrigger            TriggerType = "AWSSQS"
+	AWSSNSTrigger            TriggerType = "AWSSNS"
+	AzureQueueStorageTrigger TriggerType = "AzureQueueStorage"
 )
 
 // EventBusType is the type of event bus
@@ -166,11 +183,21 @@ type SASLConfig struct {
 type SchemaRegistryConfig struct {
 	// Schema Registry URL.
 	URL string `json:"url" protobuf:"bytes,1,opt,name=url"`
-	// Schema ID
+	// Schema ID, takes precedence over Subject.
 	SchemaID int32 `json:"schemaId" protobuf:"varint,2,name=schemaId"`
 	// +optional
 	// SchemaRegistry - basic authentication
 	Auth BasicAuth `json:"auth,omitempty" protobuf:"bytes,3,opt,name=auth"`
+	// Subject to look up the schema by, used when SchemaID is not specified.
+	// +optional
+	Subject string `json:"subject,omitempty" protobuf:"bytes,4,opt,name=subject"`
+	// Version of the subject, defaults to the latest version.
+	// +optional
+	Version int32 `json:"version,omitempty" protobuf:"varint,5,opt,name=version"`
+	// MessageType is the fully qualified name of the message to use with a Protobuf schema,
+	// defaults to the first message of the schema.
+	// +optional
+	MessageType string `json:"messageType,omitempty" protobuf:"bytes,6,opt,name=messageType"`
 }
 
 // Backoff for an operation
@@ -187,12 +214,92 @@ type Backoff struct {
 	// Exit with error after this many steps
 	// +optional
 	Steps int32 `json:"steps,omitempty" protobuf:"varint,4,opt,name=steps"`
+	// Cap is the maximum duration between two retries, in nanoseconds or strings like "1s", "3m".
+	// Once reached, the retries go on at the cap until the steps are exhausted.
+	// +optional
+	Cap *Int64OrString `json:"cap,omitempty" protobuf:"bytes,5,opt,name=cap"`
 }
 
 func (b Backoff) GetSteps() int {
 	return int(b.Steps)
 }
 
+// RemoteEventBus refers to an EventBus running outside of the namespace, typically in a central cluster.
+type RemoteEventBus struct {
+	// ConnectionSecret refers to a Secret key holding the connection details of the remote EventBus in YAML format,
+	// including the server "url" and optionally "streamConfig", "username" and "password", or "token".
+	ConnectionSecret *corev1.SecretKeySelector `json:"connectionSecret" protobuf:"bytes,1,opt,name=connectionSecret"`
+	// TLS configures the verification of the certificate of the remote EventBus and the client certificate,
+	// the certificate of the remote EventBus is not verified if not specified.
+	// +optional
+	TLS *TLSConfig `json:"tls,omitempty" protobuf:"bytes,2,opt,name=tls"`
+}
+
+// MetricsConfig is the configuration of the metrics endpoint exposed by the generated Deployment.
+type MetricsConfig struct {
+	// ServiceMonitor, if specified, makes the controller create a Service exposing the metrics port,
+	// along with a Prometheus Operator ServiceMonitor scraping it.
+	// +optional
+	ServiceMonitor *ServiceMonitorConfig `json:"serviceMonitor,omitempty" protobuf:"bytes,1,opt,name=serviceMonitor"`
+	// LabelLimits limits the cardinality of the labels of the metrics of each pod, e.g. for the
+	// adapters with thousands of events or triggers. The labels are not limited if not specified.
+	// +optional
+	LabelLimits *MetricsLabelLimits `json:"labelLimits,omitempty" protobuf:"bytes,2,opt,name=labelLimits"`
+}
+
+// MetricsLabelPolicy is how the label values above the limit are replaced.
+type MetricsLabelPolicy string
+
+const (
+	// MetricsLabelDrop replaces the values above the limit with "_other".
+	MetricsLabelDrop MetricsLabelPolicy = "Drop"
+	// MetricsLabelHash replaces the values above the limit with one of the hash buckets "_hash_<n>".
+	MetricsLabelHash MetricsLabelPolicy = "Hash"
+)
+
+// MetricsLabelLimits limits the number of distinct values of the high cardinality labels of the metrics.
+type MetricsLabelLimits struct {
+	// MaxValues is the maximum number of distinct values of each limited label, the values seen after
+	// the limit is reached are replaced according to the policy.
+	MaxValues int32 `json:"maxValues" protobuf:"varint,1,opt,name=maxValues"`
+	// Policy is how the values above the limit are replaced, either "Drop" or "Hash". Defaults to "Drop".
+	// +optional
+	Policy MetricsLabelPolicy `json:"policy,omitempty" protobuf:"bytes,2,opt,name=policy,casttype=MetricsLabelPolicy"`
+	// HashBuckets is the number of hash buckets of the "Hash" policy. Defaults to 16.
+	// +optional
+	HashBuckets int32 `json:"hashBuckets,omitempty" protobuf:"varint,3,opt,name=hashBuckets"`
+	// Labels are the names of the limited labels, among "event_name", "trigger_name" and "dependency_name".
+	// Defaults to all of them.
+	// +optional
+	Labels []string `json:"labels,omitempty" protobuf:"bytes,4,rep,name=labels"`
+}
+
+// GetPolicy returns the policy, "Drop" by default.
+func (l MetricsLabelLimits) GetPolicy() MetricsLabelPolicy {
+	if l.Policy == "" {
+		return MetricsLabelDrop
+	}
+	return l.Policy
+}
+
+// GetHashBuckets returns the number of hash buckets, 16 by default.
+func (l MetricsLabelLimits) GetHashBuckets() int32 {
+	if l.HashBuckets <= 0 {
+		return 16
+	}
+	return l.HashBuckets
+}
+
+// ServiceMonitorConfig is the configuration of the Prometheus Operator ServiceMonitor.
+type ServiceMonitorConfig struct {
+	// Labels of the ServiceMonitor, typically used by the Prometheus instance to select it.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty" protobuf:"bytes,1,rep,name=labels"`
+	// Interval at which the metrics are scraped, e.g. "30s". Defaults to the scrape interval of Prometheus.
+	// +optional
+	Interval string `json:"interval,omitempty" protobuf:"bytes,2,opt,name=interval"`
+}
+
 // Metadata holds the annotations and labels of an event source pod
 type Metadata struct {
 	Annotations map[string]string `json:"annotations,omitempty" protobuf:"bytes,1,rep,name=annotations"`
@@ -128,10 +128,38 @@ func (m *BasicAuth) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_BasicAuth proto.InternalMessageInfo
 
+func (m *ClusterResourceStatus) Reset()      { *m = ClusterResourceStatus{} }
+func (*ClusterResourceStatus) ProtoMessage() {}
+func (*ClusterResourceStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02aae6165a434fa7, []int{3}
+}
+func (m *ClusterResourceStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClusterResourceStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ClusterResourceStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClusterResourceStatus.Merge(m, src)
+}
+func (m *ClusterResourceStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClusterResourceStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClusterResourceStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClusterResourceStatus proto.InternalMessageInfo
+
 func (m *Condition) Reset()      { *m = Condition{} }
 func (*Condition) ProtoMessage() {}
 func (*Condition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02aae6165a434fa7, []int{3}
+	return fileDescriptor_02aae6165a434fa7, []int{4}
 }
 func (m *Condition) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -159,7 +187,7 @@ var xxx_messageInfo_Condition proto.InternalMessageInfo
 func (m *Int64OrString) Reset()      { *m = Int64OrString{} }
 func (*Int64OrString) ProtoMessage() {}
 func (*Int64OrString) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02aae6165a434fa7, []int{4}
+	return fileDescriptor_02aae6165a434fa7, []int{5}
 }
 func (m *Int64OrString) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -187,7 +215,7 @@ var xxx_messageInfo_Int64OrString proto.InternalMessageInfo
 func (m *Metadata) Reset()      { *m = Metadata{} }
 func (*Metadata) ProtoMessage() {}
 func (*Metadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02aae6165a434fa7, []int{5}
+	return fileDescriptor_02aae6165a434fa7, []int{6}
 }
 func (m *Metadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -212,10 +240,94 @@ func (m *Metadata) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Metadata proto.InternalMessageInfo
 
+func (m *MetricsConfig) Reset()      { *m = MetricsConfig{} }
+func (*MetricsConfig) ProtoMessage() {}
+func (*MetricsConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02aae6165a434fa7, []int{7}
+}
+func (m *MetricsConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MetricsConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *MetricsConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MetricsConfig.Merge(m, src)
+}
+func (m *MetricsConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *MetricsConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_MetricsConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MetricsConfig proto.InternalMessageInfo
+
+func (m *MetricsLabelLimits) Reset()      { *m = MetricsLabelLimits{} }
+func (*MetricsLabelLimits) ProtoMessage() {}
+func (*MetricsLabelLimits) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02aae6165a434fa7, []int{8}
+}
+func (m *MetricsLabelLimits) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MetricsLabelLimits) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *MetricsLabelLimits) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MetricsLabelLimits.Merge(m, src)
+}
+func (m *MetricsLabelLimits) XXX_Size() int {
+	return m.Size()
+}
+func (m *MetricsLabelLimits) XXX_DiscardUnknown() {
+	xxx_messageInfo_MetricsLabelLimits.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MetricsLabelLimits proto.InternalMessageInfo
+
+func (m *RemoteEventBus) Reset()      { *m = RemoteEventBus{} }
+func (*RemoteEventBus) ProtoMessage() {}
+func (*RemoteEventBus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02aae6165a434fa7, []int{9}
+}
+func (m *RemoteEventBus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RemoteEventBus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *RemoteEventBus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoteEventBus.Merge(m, src)
+}
+func (m *RemoteEventBus) XXX_Size() int {
+	return m.Size()
+}
+func (m *RemoteEventBus) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoteEventBus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemoteEventBus proto.InternalMessageInfo
+
 func (m *Resource) Reset()      { *m = Resource{} }
 func (*Resource) ProtoMessage() {}
 func (*Resource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02aae6165a434fa7, []int{6}
+	return fileDescriptor_02aae6165a434fa7, []int{10}
 }
 func (m *Resource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -240,10 +352,38 @@ func (m *Resource) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Resource proto.InternalMessageInfo
 
+func (m *RetryStatus) Reset()      { *m = RetryStatus{} }
+func (*RetryStatus) ProtoMessage() {}
+func (*RetryStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02aae6165a434fa7, []int{11}
+}
+func (m *RetryStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RetryStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *RetryStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RetryStatus.Merge(m, src)
+}
+func (m *RetryStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *RetryStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_RetryStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RetryStatus proto.InternalMessageInfo
+
 func (m *S3Artifact) Reset()      { *m = S3Artifact{} }
 func (*S3Artifact) ProtoMessage() {}
 func (*S3Artifact) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02aae6165a434fa7, []int{7}
+	return fileDescriptor_02aae6165a434fa7, []int{12}
 }
 func (m *S3Artifact) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -271,7 +411,7 @@ var xxx_messageInfo_S3Artifact proto.InternalMessageInfo
 func (m *S3Bucket) Reset()      { *m = S3Bucket{} }
 func (*S3Bucket) ProtoMessage() {}
 func (*S3Bucket) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02aae6165a434fa7, []int{8}
+	return fileDescriptor_02aae6165a434fa7, []int{13}
 }
 func (m *S3Bucket) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -299,7 +439,7 @@ var xxx_messageInfo_S3Bucket proto.InternalMessageInfo
 func (m *S3Filter) Reset()      { *m = S3Filter{} }
 func (*S3Filter) ProtoMessage() {}
 func (*S3Filter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02aae6165a434fa7, []int{9}
+	return fileDescriptor_02aae6165a434fa7, []int{14}
 }
 func (m *S3Filter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -327,7 +467,7 @@ var xxx_messageInfo_S3Filter proto.InternalMessageInfo
 func (m *SASLConfig) Reset()      { *m = SASLConfig{} }
 func (*SASLConfig) ProtoMessage() {}
 func (*SASLConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02aae6165a434fa7, []int{10}
+	return fileDescriptor_02aae6165a434fa7, []int{15}
 }
 func (m *SASLConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -355,7 +495,7 @@ var xxx_messageInfo_SASLConfig proto.InternalMessageInfo
 func (m *SchemaRegistryConfig) Reset()      { *m = SchemaRegistryConfig{} }
 func (*SchemaRegistryConfig) ProtoMessage() {}
 func (*SchemaRegistryConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02aae6165a434fa7, []int{11}
+	return fileDescriptor_02aae6165a434fa7, []int{16}
 }
 func (m *SchemaRegistryConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -383,7 +523,7 @@ var xxx_messageInfo_SchemaRegistryConfig proto.InternalMessageInfo
 func (m *SecureHeader) Reset()      { *m = SecureHeader{} }
 func (*SecureHeader) ProtoMessage() {}
 func (*SecureHeader) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02aae6165a434fa7, []int{12}
+	return fileDescriptor_02aae6165a434fa7, []int{17}
 }
 func (m *SecureHeader) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -408,10 +548,38 @@ func (m *SecureHeader) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_SecureHeader proto.InternalMessageInfo
 
+func (m *ServiceMonitorConfig) Reset()      { *m = ServiceMonitorConfig{} }
+func (*ServiceMonitorConfig) ProtoMessage() {}
+func (*ServiceMonitorConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02aae6165a434fa7, []int{18}
+}
+func (m *ServiceMonitorConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ServiceMonitorConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ServiceMonitorConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ServiceMonitorConfig.Merge(m, src)
+}
+func (m *ServiceMonitorConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *ServiceMonitorConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_ServiceMonitorConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ServiceMonitorConfig proto.InternalMessageInfo
+
 func (m *Status) Reset()      { *m = Status{} }
 func (*Status) ProtoMessage() {}
 func (*Status) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02aae6165a434fa7, []int{13}
+	return fileDescriptor_02aae6165a434fa7, []int{19}
 }
 func (m *Status) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -439,7 +607,7 @@ var xxx_messageInfo_Status proto.InternalMessageInfo
 func (m *TLSConfig) Reset()      { *m = TLSConfig{} }
 func (*TLSConfig) ProtoMessage() {}
 func (*TLSConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02aae6165a434fa7, []int{14}
+	return fileDescriptor_02aae6165a434fa7, []int{20}
 }
 func (m *TLSConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -467,7 +635,7 @@ var xxx_messageInfo_TLSConfig proto.InternalMessageInfo
 func (m *ValueFromSource) Reset()      { *m = ValueFromSource{} }
 func (*ValueFromSource) ProtoMessage() {}
 func (*ValueFromSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02aae6165a434fa7, []int{15}
+	return fileDescriptor_02aae6165a434fa7, []int{21}
 }
 func (m *ValueFromSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -496,12 +664,17 @@ func init() {
 	proto.RegisterType((*Amount)(nil), "github.com.argoproj.argo_events.pkg.apis.common.Amount")
 	proto.RegisterType((*Backoff)(nil), "github.com.argoproj.argo_events.pkg.apis.common.Backoff")
 	proto.RegisterType((*BasicAuth)(nil), "github.com.argoproj.argo_events.pkg.apis.common.BasicAuth")
+	proto.RegisterType((*ClusterResourceStatus)(nil), "github.com.argoproj.argo_events.pkg.apis.common.ClusterResourceStatus")
 	proto.RegisterType((*Condition)(nil), "github.com.argoproj.argo_events.pkg.apis.common.Condition")
 	proto.RegisterType((*Int64OrString)(nil), "github.com.argoproj.argo_events.pkg.apis.common.Int64OrString")
 	proto.RegisterType((*Metadata)(nil), "github.com.argoproj.argo_events.pkg.apis.common.Metadata")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_events.pkg.apis.common.Metadata.AnnotationsEntry")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_events.pkg.apis.common.Metadata.LabelsEntry")
+	proto.RegisterType((*MetricsConfig)(nil), "github.com.argoproj.argo_events.pkg.apis.common.MetricsConfig")
+	proto.RegisterType((*MetricsLabelLimits)(nil), "github.com.argoproj.argo_events.pkg.apis.common.MetricsLabelLimits")
+	proto.RegisterType((*RemoteEventBus)(nil), "github.com.argoproj.argo_events.pkg.apis.common.RemoteEventBus")
 	proto.RegisterType((*Resource)(nil), "github.com.argoproj.argo_events.pkg.apis.common.Resource")
+	proto.RegisterType((*RetryStatus)(nil), "github.com.argoproj.argo_events.pkg.apis.common.RetryStatus")
 	proto.RegisterType((*S3Artifact)(nil), "github.com.argoproj.argo_events.pkg.apis.common.S3Artifact")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_events.pkg.apis.common.S3Artifact.MetadataEntry")
 	proto.RegisterType((*S3Bucket)(nil), "github.com.argoproj.argo_events.pkg.apis.common.S3Bucket")
@@ -509,6 +682,8 @@ func init() {
 	proto.RegisterType((*SASLConfig)(nil), "github.com.argoproj.argo_events.pkg.apis.common.SASLConfig")
 	proto.RegisterType((*SchemaRegistryConfig)(nil), "github.com.argoproj.argo_events.pkg.apis.common.SchemaRegistryConfig")
 	proto.RegisterType((*SecureHeader)(nil), "github.com.argoproj.argo_events.pkg.apis.common.SecureHeader")
+	proto.RegisterType((*ServiceMonitorConfig)(nil), "github.com.argoproj.argo_events.pkg.apis.common.ServiceMonitorConfig")
+	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_events.pkg.apis.common.ServiceMonitorConfig.LabelsEntry")
 	proto.RegisterType((*Status)(nil), "github.com.argoproj.argo_events.pkg.apis.common.Status")
 	proto.RegisterType((*TLSConfig)(nil), "github.com.argoproj.argo_events.pkg.apis.common.TLSConfig")
 	proto.RegisterType((*ValueFromSource)(nil), "github.com.argoproj.argo_events.pkg.apis.common.ValueFromSource")
@@ -519,97 +694,128 @@ func init() {
 }
 
 var fileDescriptor_02aae6165a434fa7 = []byte{
-	// 1435 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x97, 0xcd, 0x6e, 0xdb, 0x46,
-	0x10, 0x80, 0x4d, 0xcb, 0x96, 0xc5, 0xf1, 0x6f, 0x36, 0x3e, 0x08, 0x02, 0x22, 0x19, 0x2c, 0x5a,
-	0x38, 0x6d, 0x43, 0x21, 0x3f, 0x68, 0x93, 0x14, 0x48, 0x2b, 0x3a, 0x0e, 0xea, 0xc4, 0x6e, 0x82,
-	0x65, 0xec, 0x43, 0xd2, 0x1f, 0xac, 0xa9, 0x95, 0xc4, 0x48, 0x24, 0x05, 0x72, 0xe9, 0x44, 0xb7,
-	0x16, 0x7d, 0x80, 0xf6, 0x0d, 0xfa, 0x04, 0x05, 0xfa, 0x0a, 0xbd, 0xe5, 0x18, 0xf4, 0x92, 0x9c,
-	0x84, 0x86, 0x7d, 0x88, 0x16, 0x39, 0x15, 0xfb, 0x43, 0x8a, 0x52, 0x5c, 0xb4, 0x34, 0x7a, 0xa3,
-	0x66, 0x67, 0xbe, 0xd9, 0x9d, 0xbf, 0x5d, 0xc1, 0xa7, 0x5d, 0x97, 0xf5, 0xe2, 0x63, 0xd3, 0x09,
-	0xbc, 0x26, 0x09, 0xbb, 0xc1, 0x30, 0x0c, 0x9e, 0x88, 0x8f, 0x4b, 0xf4, 0x84, 0xfa, 0x2c, 0x6a,
-	0x0e, 0xfb, 0xdd, 0x26, 0x19, 0xba, 0x51, 0xd3, 0x09, 0x3c, 0x2f, 0xf0, 0x9b, 0x5d, 0xea, 0xd3,
-	0x90, 0x30, 0xda, 0x36, 0x87, 0x61, 0xc0, 0x02, 0xd4, 0x9c, 0x00, 0xcc, 0x14, 0x20, 0x3e, 0xbe,
-	0x91, 0x00, 0x73, 0xd8, 0xef, 0x9a, 0x1c, 0x60, 0x4a, 0x40, 0xed, 0x52, 0xce, 0x63, 0x37, 0xe8,
-	0x06, 0x4d, 0xc1, 0x39, 0x8e, 0x3b, 0xe2, 0x97, 0xf8, 0x21, 0xbe, 0x24, 0xbf, 0x66, 0xf4, 0xaf,
-	0x47, 0xa6, 0x1b, 0xf0, 0x3d, 0x34, 0x9d, 0x20, 0xa4, 0xcd, 0x93, 0xcb, 0xb3, 0x7b, 0xa8, 0x5d,
-	0x9b, 0xe8, 0x78, 0xc4, 0xe9, 0xb9, 0x3e, 0x0d, 0x47, 0x93, 0x8d, 0x7b, 0x94, 0x91, 0x53, 0xac,
-	0x8c, 0x8b, 0x50, 0x6e, 0x79, 0x41, 0xec, 0x33, 0xd4, 0x80, 0xc5, 0x13, 0x32, 0x88, 0x69, 0x55,
-	0xdb, 0xd2, 0xb6, 0x57, 0x2c, 0x3d, 0x19, 0x37, 0x16, 0x8f, 0xb8, 0x00, 0x4b, 0xb9, 0xf1, 0xdb,
-	0x3c, 0x2c, 0x59, 0xc4, 0xe9, 0x07, 0x9d, 0x0e, 0xea, 0x41, 0xa5, 0x1d, 0x87, 0x84, 0xb9, 0x81,
-	0x2f, 0xf4, 0x97, 0xaf, 0xdc, 0x32, 0x0b, 0xc6, 0xc0, 0xdc, 0xf3, 0xd9, 0x47, 0xd7, 0xee, 0x87,
-	0x36, 0x0b, 0x5d, 0xbf, 0x6b, 0xad, 0x24, 0xe3, 0x46, 0xe5, 0xb6, 0x62, 0xe2, 0x8c, 0x8e, 0x1e,
-	0x43, 0xb9, 0x43, 0x1c, 0x16, 0x84, 0xd5, 0x79, 0xe1, 0xe7, 0xe3, 0xc2, 0x7e, 0xe4, 0xf9, 0x2c,
-	0x48, 0xc6, 0x8d, 0xf2, 0x1d, 0x81, 0xc2, 0x0a, 0xc9, 0xe1, 0x4f, 0x5c, 0xc6, 0x68, 0x58, 0x2d,
-	0xfd, 0x0f, 0xf0, 0xbb, 0x02, 0x85, 0x15, 0x12, 0xbd, 0x03, 0x8b, 0x11, 0xa3, 0xc3, 0xa8, 0xba,
-	0xb0, 0xa5, 0x6d, 0x2f, 0x5a, 0xab, 0xcf, 0xc7, 0x8d, 0x39, 0x1e, 0x54, 0x9b, 0x0b, 0xb1, 0x5c,
-	0x33, 0x7e, 0xd6, 0x40, 0xb7, 0x48, 0xe4, 0x3a, 0xad, 0x98, 0xf5, 0xd0, 0x7d, 0xa8, 0xc4, 0x11,
-	0x0d, 0x7d, 0xe2, 0x51, 0x15, 0xd6, 0x77, 0x4d, 0x99, 0x56, 0xee, 0xd4, 0xe4, 0xa9, 0x37, 0x4f,
-	0x2e, 0x9b, 0x36, 0x75, 0x42, 0xca, 0xee, 0xd1, 0x91, 0x4d, 0x07, 0x94, 0x1f, 0x44, 0x46, 0xef,
-	0x50, 0x99, 0xe2, 0x0c, 0xc2, 0x81, 0x43, 0x12, 0x45, 0x4f, 0x83, 0xb0, 0xad, 0xe2, 0x57, 0x04,
-	0xf8, 0x40, 0x99, 0xe2, 0x0c, 0x62, 0xbc, 0x9c, 0x07, 0x7d, 0x27, 0xf0, 0xdb, 0xae, 0x48, 0xce,
-	0x65, 0x58, 0x60, 0xa3, 0xa1, 0xdc, 0xab, 0x6e, 0x5d, 0x50, 0x27, 0x5c, 0x78, 0x38, 0x1a, 0xd2,
-	0x37, 0xe3, 0xc6, 0x6a, 0xa6, 0xc8, 0x05, 0x58, 0xa8, 0xa2, 0x7d, 0x28, 0x47, 0x8c, 0xb0, 0x38,
-	0x12, 0xfb, 0xd1, 0xad, 0x6b, 0xca, 0xa8, 0x6c, 0x0b, 0xe9, 0x9b, 0x71, 0xe3, 0x94, 0x62, 0x37,
-	0x33, 0x92, 0xd4, 0xc2, 0x8a, 0x81, 0x4e, 0x00, 0x0d, 0x48, 0xc4, 0x1e, 0x86, 0xc4, 0x8f, 0xa4,
-	0x27, 0xd7, 0xa3, 0x2a, 0x99, 0xef, 0xe7, 0x4e, 0x9a, 0x75, 0xc4, 0x24, 0x81, 0xbc, 0x23, 0xf8,
-	0xd9, 0xb9, 0x85, 0x55, 0x53, 0xbb, 0x40, 0xfb, 0x6f, 0xd1, 0xf0, 0x29, 0x1e, 0xd0, 0x7b, 0x50,
-	0x0e, 0x29, 0x89, 0x02, 0x5f, 0x24, 0x57, 0xb7, 0xd6, 0xd2, 0x53, 0x60, 0x21, 0xc5, 0x6a, 0x15,
-	0x5d, 0x84, 0x25, 0x8f, 0x46, 0x11, 0xe9, 0xd2, 0xea, 0xa2, 0x50, 0x5c, 0x57, 0x8a, 0x4b, 0x07,
-	0x52, 0x8c, 0xd3, 0x75, 0xe3, 0x07, 0x0d, 0x56, 0xa7, 0x5a, 0x02, 0x6d, 0xe7, 0xa2, 0x5b, 0xb2,
-	0x36, 0x67, 0xa2, 0xbb, 0x90, 0x0b, 0xea, 0x87, 0x50, 0x71, 0xb9, 0xe9, 0x11, 0x19, 0x88, 0xb0,
-	0x96, 0xac, 0x0d, 0xa5, 0x5d, 0xd9, 0x53, 0x72, 0x9c, 0x69, 0xf0, 0xcd, 0x47, 0x2c, 0xe4, 0xba,
-	0xa5, 0xe9, 0xcd, 0xdb, 0x42, 0x8a, 0xd5, 0xaa, 0xf1, 0xd7, 0x3c, 0x54, 0x0e, 0x28, 0x23, 0x6d,
-	0xc2, 0x08, 0xfa, 0x4e, 0x83, 0x65, 0xe2, 0xfb, 0x01, 0x13, 0x6d, 0x19, 0x55, 0xb5, 0xad, 0xd2,
-	0xf6, 0xf2, 0x95, 0xbb, 0x85, 0x1b, 0x26, 0x05, 0x9a, 0xad, 0x09, 0x6c, 0xd7, 0x67, 0xe1, 0xc8,
-	0x3a, 0xaf, 0xb6, 0xb1, 0x9c, 0x5b, 0xc1, 0x79, 0x9f, 0xc8, 0x83, 0xf2, 0x80, 0x1c, 0xd3, 0x01,
-	0xaf, 0x1d, 0xee, 0x7d, 0xf7, 0xec, 0xde, 0xf7, 0x05, 0x47, 0x3a, 0xce, 0xce, 0x2f, 0x85, 0x58,
-	0x39, 0xa9, 0xdd, 0x82, 0x8d, 0xd9, 0x4d, 0xa2, 0x0d, 0x28, 0xf5, 0xe9, 0x48, 0x16, 0x3c, 0xe6,
-	0x9f, 0x68, 0x33, 0x9d, 0x9b, 0xa2, 0x9e, 0xd5, 0xb0, 0xbc, 0x39, 0x7f, 0x5d, 0xab, 0xdd, 0x80,
-	0xe5, 0x9c, 0x9b, 0x22, 0xa6, 0xc6, 0x07, 0x50, 0xc1, 0x34, 0x0a, 0xe2, 0xd0, 0xa1, 0xff, 0x3e,
-	0x98, 0x7f, 0x29, 0x03, 0xd8, 0x57, 0x5b, 0x21, 0x73, 0xf9, 0x58, 0xe3, 0xc5, 0x40, 0xfd, 0xf6,
-	0x30, 0x70, 0x7d, 0xa6, 0x1a, 0x33, 0x2b, 0x86, 0x5d, 0x25, 0xc7, 0x99, 0x06, 0xfa, 0x0a, 0xca,
-	0xc7, 0xb1, 0xd3, 0xa7, 0x4c, 0xcd, 0x87, 0x1b, 0x85, 0x63, 0x6a, 0x5f, 0xb5, 0x04, 0x40, 0x0e,
-	0x41, 0xf9, 0x8d, 0x15, 0x54, 0x36, 0x4a, 0x97, 0x5f, 0x13, 0xa5, 0xd9, 0x46, 0xe1, 0x52, 0xac,
-	0x56, 0x65, 0x05, 0x47, 0xd4, 0x89, 0x43, 0x2a, 0x5a, 0xaa, 0x92, 0xaf, 0x60, 0x29, 0xc7, 0x99,
-	0x06, 0xc2, 0xa0, 0x13, 0xc7, 0xa1, 0x51, 0x74, 0x8f, 0x8e, 0x44, 0x63, 0xfd, 0xe7, 0xb9, 0xb6,
-	0x9a, 0x8c, 0x1b, 0x7a, 0x2b, 0xb5, 0xc5, 0x13, 0x0c, 0x67, 0x46, 0xa9, 0x7a, 0xb5, 0x5c, 0x98,
-	0x99, 0x89, 0xf1, 0x04, 0x83, 0x0c, 0x28, 0xcb, 0xa0, 0x55, 0x97, 0xb6, 0x4a, 0xdb, 0xba, 0x8c,
-	0xd0, 0xae, 0x90, 0x60, 0xb5, 0xc2, 0x13, 0xd0, 0x71, 0x07, 0xfc, 0x0e, 0xaa, 0x9c, 0x39, 0x01,
-	0x77, 0x04, 0x40, 0x5d, 0x71, 0xe2, 0x1b, 0x2b, 0x28, 0x7a, 0x0a, 0x15, 0x4f, 0x15, 0x7d, 0x55,
-	0x17, 0x5d, 0xb3, 0x77, 0x06, 0x07, 0x69, 0x71, 0x65, 0x0d, 0x24, 0x3b, 0x27, 0xcb, 0x51, 0x2a,
-	0xc6, 0x99, 0x33, 0xf4, 0x35, 0xac, 0x3a, 0x64, 0x87, 0x72, 0x43, 0xd7, 0x21, 0x8c, 0x56, 0xa1,
-	0x48, 0x4c, 0xcf, 0x25, 0xfc, 0xfe, 0x68, 0xe5, 0xec, 0xf1, 0x34, 0xae, 0xf6, 0x09, 0xac, 0x4e,
-	0x6d, 0xa6, 0x50, 0x7f, 0xdd, 0x83, 0x4a, 0x5a, 0xb6, 0xe8, 0x42, 0xce, 0xce, 0x5a, 0x56, 0x27,
-	0x2a, 0xf1, 0x4c, 0x0a, 0xc8, 0x16, 0x2c, 0x88, 0xfb, 0x58, 0x5e, 0x57, 0x2b, 0xe9, 0x14, 0xfe,
-	0x82, 0x5f, 0xb4, 0x62, 0xc5, 0x78, 0xc4, 0x61, 0x32, 0xec, 0xbc, 0xde, 0x87, 0x21, 0xed, 0xb8,
-	0xcf, 0x14, 0x2f, 0xab, 0xf7, 0x07, 0x42, 0x8a, 0xd5, 0xaa, 0x98, 0xc1, 0x71, 0x87, 0xeb, 0xcd,
-	0xcf, 0xcc, 0x60, 0x21, 0xc5, 0x6a, 0xd5, 0xf8, 0x53, 0x03, 0xb0, 0x5b, 0xf6, 0xfe, 0x4e, 0xe0,
-	0x77, 0xdc, 0x2e, 0x6a, 0x82, 0xee, 0x51, 0xa7, 0x47, 0x7c, 0x37, 0xf2, 0x94, 0x87, 0x73, 0xca,
-	0x52, 0x3f, 0x48, 0x17, 0xf0, 0x44, 0x07, 0x1d, 0x02, 0xf0, 0xc7, 0x80, 0x0c, 0x70, 0xb1, 0x27,
-	0xc0, 0x5a, 0x32, 0x6e, 0xc0, 0x61, 0x66, 0x8c, 0x73, 0x20, 0x44, 0x60, 0x2d, 0x7d, 0x12, 0x28,
-	0x74, 0xa9, 0x08, 0x1a, 0x25, 0xe3, 0xc6, 0xda, 0x83, 0x29, 0x00, 0x9e, 0x01, 0x1a, 0xbf, 0x6a,
-	0xb0, 0x69, 0x3b, 0x3d, 0xea, 0x11, 0x3e, 0x2a, 0x22, 0x16, 0x8e, 0x54, 0x0c, 0x2e, 0x40, 0x29,
-	0x0e, 0x07, 0xb3, 0xf9, 0x3a, 0xc4, 0xfb, 0x98, 0xcb, 0xf9, 0x24, 0x89, 0x84, 0xd9, 0x9e, 0x7c,
-	0xf2, 0x2c, 0x4e, 0xaa, 0x54, 0xe2, 0xf6, 0x6e, 0xe3, 0x4c, 0x03, 0x7d, 0x09, 0x0b, 0x24, 0x66,
-	0x3d, 0xb5, 0xfd, 0x9b, 0x85, 0x5b, 0x23, 0x7b, 0xbb, 0x4d, 0x2a, 0x83, 0xff, 0xc2, 0x82, 0x6a,
-	0xfc, 0xa4, 0xc1, 0x8a, 0x2d, 0x46, 0xd6, 0xe7, 0x94, 0xb4, 0x69, 0x98, 0x15, 0x93, 0xf6, 0x4f,
-	0xc5, 0x84, 0x3c, 0xd0, 0x45, 0x99, 0xde, 0x09, 0x03, 0x4f, 0xe5, 0xeb, 0xb3, 0xc2, 0xbb, 0x3a,
-	0x4a, 0x09, 0xb6, 0xb8, 0x42, 0xe4, 0x84, 0xca, 0x84, 0x78, 0xe2, 0xc1, 0x78, 0x06, 0xea, 0xe1,
-	0x85, 0x7c, 0x00, 0x27, 0x7d, 0x65, 0xa5, 0xd7, 0x7b, 0xf1, 0x78, 0x64, 0x0f, 0x35, 0x0b, 0xa9,
-	0xc3, 0x41, 0x26, 0x8a, 0x70, 0xce, 0x83, 0xf1, 0x7d, 0x09, 0xf4, 0x87, 0xfb, 0xb6, 0x4a, 0xea,
-	0x63, 0x58, 0x91, 0xed, 0xad, 0xca, 0xa9, 0xd0, 0xeb, 0x77, 0x23, 0x19, 0x37, 0x56, 0xe4, 0xb0,
-	0x50, 0xc5, 0x34, 0x05, 0x43, 0x5d, 0xd8, 0x70, 0x06, 0x2e, 0xf5, 0x59, 0xce, 0x41, 0xa1, 0x56,
-	0xd8, 0x4c, 0xc6, 0x8d, 0x8d, 0x9d, 0x19, 0x04, 0x7e, 0x0b, 0x8a, 0xda, 0xb0, 0x2e, 0x65, 0xc2,
-	0xb8, 0x78, 0x5f, 0x9c, 0x4f, 0xc6, 0x8d, 0xf5, 0x9d, 0x69, 0x02, 0x9e, 0x45, 0xa2, 0xbb, 0x80,
-	0xd2, 0x9b, 0xd0, 0xee, 0xbb, 0xc3, 0x23, 0x1a, 0xba, 0x9d, 0x91, 0xba, 0x35, 0xb3, 0x87, 0xec,
-	0xde, 0x5b, 0x1a, 0xf8, 0x14, 0x2b, 0xe3, 0xa5, 0x06, 0xeb, 0x33, 0xd5, 0xc2, 0x73, 0x91, 0x5d,
-	0x61, 0x98, 0x76, 0xce, 0x90, 0x0b, 0x3b, 0x67, 0x8e, 0xa7, 0x60, 0xa8, 0x0b, 0xeb, 0x8e, 0x48,
-	0xf9, 0x01, 0x19, 0x2a, 0xbe, 0x4c, 0xc5, 0xf6, 0x69, 0xfc, 0x9d, 0x9c, 0xea, 0x4c, 0x94, 0xa6,
-	0x21, 0x78, 0x96, 0x6a, 0x1d, 0x3e, 0x7f, 0x5d, 0x9f, 0x7b, 0xf1, 0xba, 0x3e, 0xf7, 0xea, 0x75,
-	0x7d, 0xee, 0xdb, 0xa4, 0xae, 0x3d, 0x4f, 0xea, 0xda, 0x8b, 0xa4, 0xae, 0xbd, 0x4a, 0xea, 0xda,
-	0xef, 0x49, 0x5d, 0xfb, 0xf1, 0x8f, 0xfa, 0xdc, 0xa3, 0x66, 0xc1, 0xbf, 0xfe, 0x7f, 0x07, 0x00,
-	0x00, 0xff, 0xff, 0xdd, 0x9f, 0xa2, 0xd6, 0x2c, 0x10, 0x00, 0x00,
+	// 1923 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0x4b, 0x6f, 0x23, 0x59,
+	0x15, 0x4e, 0xb9, 0x62, 0xc7, 0x3e, 0xce, 0x6b, 0xee, 0x04, 0x51, 0x8a, 0xd4, 0x71, 0xab, 0x78,
+	0x28, 0x03, 0x8c, 0xad, 0xee, 0x69, 0x60, 0x66, 0x40, 0x03, 0xa9, 0x4c, 0x5a, 0x93, 0x6e, 0x87,
+	0x0e, 0xb7, 0x92, 0x48, 0xcc, 0x00, 0xa3, 0x9b, 0xf2, 0xb5, 0x53, 0x1d, 0xd7, 0x43, 0x75, 0xaf,
+	0x33, 0xed, 0x1d, 0x88, 0x25, 0x0b, 0xf8, 0x07, 0x6c, 0x58, 0xb0, 0x19, 0x89, 0x9f, 0xd1, 0x2b,
+	0xd4, 0xbb, 0xe9, 0x95, 0x45, 0x9b, 0x1f, 0x01, 0xea, 0x15, 0xba, 0x8f, 0x7a, 0x39, 0x41, 0x50,
+	0xa1, 0x57, 0x29, 0x9f, 0xc7, 0x77, 0x4e, 0x9d, 0x73, 0xea, 0x3c, 0x02, 0x3f, 0x19, 0xf9, 0xfc,
+	0x62, 0x72, 0xde, 0xf5, 0xa2, 0xa0, 0x47, 0x92, 0x51, 0x14, 0x27, 0xd1, 0x53, 0xf9, 0xf0, 0x2e,
+	0xbd, 0xa2, 0x21, 0x67, 0xbd, 0xf8, 0x72, 0xd4, 0x23, 0xb1, 0xcf, 0x7a, 0x5e, 0x14, 0x04, 0x51,
+	0xd8, 0x1b, 0xd1, 0x90, 0x26, 0x84, 0xd3, 0x41, 0x37, 0x4e, 0x22, 0x1e, 0xa1, 0x5e, 0x0e, 0xd0,
+	0x4d, 0x01, 0xe4, 0xc3, 0xe7, 0x0a, 0xa0, 0x1b, 0x5f, 0x8e, 0xba, 0x02, 0xa0, 0xab, 0x00, 0xb6,
+	0xdf, 0x2d, 0x58, 0x1c, 0x45, 0xa3, 0xa8, 0x27, 0x71, 0xce, 0x27, 0x43, 0xf9, 0x4b, 0xfe, 0x90,
+	0x4f, 0x0a, 0x7f, 0xdb, 0xbe, 0x7c, 0x9f, 0x75, 0xfd, 0x48, 0xf8, 0xd0, 0xf3, 0xa2, 0x84, 0xf6,
+	0xae, 0xee, 0x2d, 0xfa, 0xb0, 0xfd, 0x20, 0x97, 0x09, 0x88, 0x77, 0xe1, 0x87, 0x34, 0x99, 0xe6,
+	0x8e, 0x07, 0x94, 0x93, 0x1b, 0xb4, 0xec, 0x77, 0xa0, 0xb1, 0x17, 0x44, 0x93, 0x90, 0xa3, 0x0e,
+	0xd4, 0xaf, 0xc8, 0x78, 0x42, 0x2d, 0xe3, 0xae, 0xb1, 0xbb, 0xea, 0xb4, 0xe6, 0xb3, 0x4e, 0xfd,
+	0x4c, 0x10, 0xb0, 0xa2, 0xdb, 0x5f, 0x9a, 0xb0, 0xe2, 0x10, 0xef, 0x32, 0x1a, 0x0e, 0xd1, 0x05,
+	0x34, 0x07, 0x93, 0x84, 0x70, 0x3f, 0x0a, 0xa5, 0x7c, 0xfb, 0xfe, 0x47, 0xdd, 0x8a, 0x31, 0xe8,
+	0x1e, 0x86, 0xfc, 0x07, 0x0f, 0x9e, 0x24, 0x2e, 0x4f, 0xfc, 0x70, 0xe4, 0xac, 0xce, 0x67, 0x9d,
+	0xe6, 0xc7, 0x1a, 0x13, 0x67, 0xe8, 0xe8, 0x33, 0x68, 0x0c, 0x89, 0xc7, 0xa3, 0xc4, 0xaa, 0x49,
+	0x3b, 0x3f, 0xac, 0x6c, 0x47, 0xbd, 0x9f, 0x03, 0xf3, 0x59, 0xa7, 0xf1, 0x50, 0x42, 0x61, 0x0d,
+	0x29, 0xc0, 0x9f, 0xfa, 0x9c, 0xd3, 0xc4, 0x32, 0xdf, 0x00, 0xf8, 0x23, 0x09, 0x85, 0x35, 0x24,
+	0xfa, 0x06, 0xd4, 0x19, 0xa7, 0x31, 0xb3, 0x96, 0xef, 0x1a, 0xbb, 0x75, 0x67, 0xed, 0xf9, 0xac,
+	0xb3, 0x24, 0x82, 0xea, 0x0a, 0x22, 0x56, 0x3c, 0xf4, 0x0b, 0x30, 0x3d, 0x12, 0x5b, 0xf5, 0x37,
+	0x12, 0xc3, 0x95, 0xf9, 0xac, 0x63, 0xee, 0x93, 0x18, 0x0b, 0x4c, 0xfb, 0x4b, 0x03, 0x5a, 0x0e,
+	0x61, 0xbe, 0xb7, 0x37, 0xe1, 0x17, 0xe8, 0x09, 0x34, 0x27, 0x8c, 0x26, 0x21, 0x09, 0xa8, 0xce,
+	0xd8, 0xb7, 0xba, 0xaa, 0x62, 0x04, 0x60, 0x57, 0x54, 0x55, 0xf7, 0xea, 0x5e, 0xd7, 0xa5, 0x5e,
+	0x42, 0xf9, 0x63, 0x3a, 0x75, 0xe9, 0x98, 0x8a, 0x18, 0xa9, 0xc4, 0x9c, 0x6a, 0x55, 0x9c, 0x81,
+	0x08, 0xc0, 0x98, 0x30, 0xf6, 0x45, 0x94, 0x0c, 0xac, 0x5a, 0x65, 0xc0, 0x63, 0xad, 0x8a, 0x33,
+	0x10, 0xfb, 0x2f, 0x06, 0x7c, 0x6d, 0x7f, 0x3c, 0x61, 0x22, 0x86, 0x94, 0x45, 0x93, 0xc4, 0xa3,
+	0x2e, 0x27, 0x7c, 0xc2, 0xd0, 0xe7, 0xd0, 0x60, 0xf2, 0xc9, 0x32, 0x6e, 0x99, 0x26, 0x05, 0xe4,
+	0xac, 0xeb, 0x1c, 0x34, 0xd4, 0x6f, 0xac, 0x61, 0x51, 0x17, 0x40, 0xbc, 0x13, 0x8b, 0x89, 0x47,
+	0x99, 0x55, 0xbb, 0x6b, 0xee, 0xb6, 0x9c, 0xf5, 0xf9, 0xac, 0x03, 0x3f, 0xcb, 0xa8, 0xb8, 0x20,
+	0x61, 0x7f, 0x55, 0x83, 0xd6, 0x7e, 0x14, 0x0e, 0x7c, 0x59, 0xa2, 0xf7, 0x60, 0x99, 0x4f, 0x63,
+	0x15, 0xd6, 0x96, 0x73, 0x47, 0xdb, 0x58, 0x3e, 0x99, 0xc6, 0xf4, 0xf5, 0xac, 0xb3, 0x96, 0x09,
+	0x0a, 0x02, 0x96, 0xa2, 0xa8, 0x9f, 0xbd, 0x51, 0x4d, 0x2a, 0x3d, 0x28, 0x3b, 0xf6, 0x7a, 0xd6,
+	0xb9, 0xe1, 0x93, 0xef, 0x66, 0x48, 0x0b, 0xee, 0x5f, 0x01, 0x1a, 0x13, 0xc6, 0x4f, 0x12, 0x12,
+	0x32, 0x65, 0xc9, 0x0f, 0xa8, 0x2e, 0xe9, 0xef, 0x14, 0x92, 0x92, 0xf5, 0x85, 0x3c, 0x3e, 0xa2,
+	0x2f, 0x88, 0x34, 0x09, 0x0d, 0x67, 0x5b, 0x7b, 0x81, 0xfa, 0xd7, 0xd0, 0xf0, 0x0d, 0x16, 0xd0,
+	0xb7, 0xa1, 0x91, 0x50, 0xc2, 0xa2, 0x50, 0x96, 0x78, 0x2b, 0x0f, 0x2f, 0x96, 0x54, 0xac, 0xb9,
+	0xe8, 0x1d, 0x58, 0x09, 0x28, 0x63, 0x64, 0x44, 0x65, 0xa1, 0xb7, 0x9c, 0x0d, 0x2d, 0xb8, 0x72,
+	0xa4, 0xc8, 0x38, 0xe5, 0xdb, 0x7f, 0x30, 0x60, 0xad, 0x54, 0xd4, 0x68, 0xb7, 0x10, 0x5d, 0xd3,
+	0xd9, 0x5a, 0x88, 0xee, 0x72, 0x21, 0xa8, 0xdf, 0x83, 0xa6, 0x2f, 0x54, 0xcf, 0xc8, 0x58, 0x86,
+	0xd5, 0x74, 0x36, 0xb5, 0x74, 0xf3, 0x50, 0xd3, 0x71, 0x26, 0x21, 0x9c, 0x67, 0x3c, 0x11, 0xb2,
+	0x66, 0xd9, 0x79, 0x57, 0x52, 0xb1, 0xe6, 0xda, 0xff, 0xaa, 0x41, 0xf3, 0x88, 0x72, 0x32, 0x20,
+	0x9c, 0xa0, 0xdf, 0x1a, 0xd0, 0x26, 0x61, 0x18, 0x71, 0xd9, 0x9c, 0x44, 0x3d, 0x9a, 0xbb, 0xed,
+	0xfb, 0x8f, 0x2a, 0xd7, 0x63, 0x0a, 0xd8, 0xdd, 0xcb, 0xc1, 0x0e, 0x42, 0x9e, 0x4c, 0x9d, 0xb7,
+	0xb5, 0x1b, 0xed, 0x02, 0x07, 0x17, 0x6d, 0xa2, 0x00, 0x1a, 0x63, 0x72, 0x4e, 0xc7, 0xaa, 0x50,
+	0xdb, 0xf7, 0x0f, 0x6e, 0x6f, 0xbd, 0x2f, 0x71, 0x94, 0xe1, 0xec, 0xfd, 0x15, 0x11, 0x6b, 0x23,
+	0xdb, 0x1f, 0xc1, 0xe6, 0xa2, 0x93, 0x68, 0x13, 0xcc, 0x4b, 0x3a, 0x55, 0x05, 0x8f, 0xc5, 0x23,
+	0xda, 0x4a, 0xa7, 0x87, 0xac, 0x67, 0x3d, 0x32, 0x3e, 0xac, 0xbd, 0x6f, 0x6c, 0x7f, 0x00, 0xed,
+	0x82, 0x99, 0x2a, 0xaa, 0xf6, 0xef, 0x6b, 0xb0, 0x76, 0x44, 0x79, 0xe2, 0x7b, 0x6c, 0x3f, 0x0a,
+	0x87, 0xfe, 0x48, 0xc4, 0x7f, 0x9d, 0xd1, 0xe4, 0xca, 0xf7, 0xe8, 0x51, 0x14, 0xfa, 0x62, 0x2c,
+	0xa8, 0x96, 0x50, 0x3d, 0x08, 0x6e, 0x09, 0x46, 0xe1, 0x3b, 0x68, 0x3e, 0xeb, 0xac, 0x97, 0x39,
+	0x78, 0xc1, 0x20, 0xba, 0x82, 0xb6, 0x0c, 0x4d, 0xdf, 0x0f, 0x7c, 0xce, 0x74, 0xef, 0xdb, 0xbf,
+	0x4d, 0x12, 0xc4, 0x8b, 0xf5, 0x73, 0x28, 0x67, 0x43, 0xe4, 0xbd, 0x40, 0xc0, 0x45, 0x43, 0xf6,
+	0xcc, 0x00, 0x74, 0x5d, 0x09, 0xf5, 0xa0, 0x15, 0x90, 0x67, 0x72, 0x52, 0xab, 0xfe, 0x58, 0x77,
+	0xde, 0xd2, 0xa9, 0x6c, 0x1d, 0xa5, 0x0c, 0x9c, 0xcb, 0xa0, 0x1f, 0x43, 0x23, 0x8e, 0xc6, 0xbe,
+	0x37, 0xd5, 0xbd, 0xe7, 0x9b, 0x69, 0xe2, 0x8f, 0x25, 0xf5, 0xf5, 0xac, 0x53, 0x32, 0xa3, 0xa8,
+	0x58, 0xeb, 0xa0, 0xef, 0x43, 0xfb, 0x82, 0xb0, 0x0b, 0x67, 0xe2, 0x5d, 0x52, 0xce, 0xe4, 0xb7,
+	0x53, 0xcf, 0x8b, 0xf6, 0x93, 0x9c, 0x85, 0x8b, 0x72, 0xc8, 0xce, 0x8a, 0x76, 0x59, 0x76, 0x57,
+	0xb8, 0x5e, 0x69, 0xf6, 0x0b, 0x03, 0xd6, 0x31, 0x0d, 0x22, 0x4e, 0x0f, 0x44, 0xc8, 0x9c, 0x09,
+	0x43, 0x23, 0xd8, 0xf4, 0xa2, 0x30, 0xa4, 0x9e, 0xec, 0x7a, 0x72, 0x94, 0x54, 0x9b, 0x5e, 0x5b,
+	0xf3, 0x59, 0x67, 0x73, 0x7f, 0x01, 0x02, 0x5f, 0x03, 0x45, 0xa7, 0x60, 0xf2, 0x71, 0x9a, 0xcc,
+	0x0f, 0x2b, 0x27, 0xf3, 0xa4, 0xef, 0xea, 0x0a, 0x92, 0x33, 0xf8, 0xa4, 0xef, 0x62, 0x81, 0x67,
+	0x7f, 0x17, 0x9a, 0xe9, 0x2c, 0xfb, 0xef, 0x0b, 0xd6, 0x9f, 0x4d, 0x68, 0x63, 0xca, 0x93, 0xa9,
+	0x1e, 0x7b, 0x77, 0xc0, 0x8c, 0xa3, 0x81, 0x1e, 0x2b, 0x6d, 0x1d, 0x62, 0xf3, 0x38, 0x1a, 0x60,
+	0x41, 0x17, 0x89, 0x8f, 0x62, 0xaa, 0x97, 0x30, 0x95, 0xca, 0x2c, 0xf1, 0x4f, 0x52, 0x06, 0xce,
+	0x65, 0x44, 0xc7, 0xe3, 0x24, 0x19, 0x51, 0xbe, 0xd8, 0xf1, 0x4e, 0x24, 0x15, 0x6b, 0xae, 0xe8,
+	0xa3, 0x84, 0x73, 0x1a, 0xc4, 0x3c, 0xdd, 0x5d, 0xb2, 0x3e, 0xba, 0xa7, 0xe9, 0x38, 0x93, 0x10,
+	0x6e, 0x88, 0xd1, 0x70, 0x90, 0x24, 0x51, 0x62, 0xd5, 0xcb, 0x6e, 0xf4, 0x53, 0x06, 0xce, 0x65,
+	0xd0, 0x13, 0xa8, 0x33, 0x3f, 0xf4, 0xa8, 0xd5, 0xa8, 0x3c, 0xa0, 0xf2, 0x1d, 0x4a, 0x00, 0x60,
+	0x85, 0x83, 0x02, 0xd8, 0x10, 0xe8, 0xda, 0x37, 0x39, 0xfb, 0x56, 0x2a, 0x43, 0x7f, 0x5d, 0x43,
+	0x6f, 0xf4, 0xcb, 0x50, 0x78, 0x11, 0xdb, 0xfe, 0x6b, 0x03, 0xc0, 0x7d, 0x6f, 0x2f, 0xe1, 0xbe,
+	0xd8, 0x22, 0x45, 0xb4, 0x68, 0x38, 0x88, 0x23, 0x3f, 0xe4, 0x3a, 0x55, 0x59, 0xb4, 0x0e, 0x34,
+	0x1d, 0x67, 0x12, 0xe8, 0x57, 0xd0, 0x38, 0x97, 0x9f, 0x84, 0x2e, 0xb5, 0x0f, 0xaa, 0xf7, 0xad,
+	0xf7, 0xd4, 0x37, 0xa5, 0x3e, 0x21, 0xf5, 0x8c, 0x35, 0xa8, 0x9a, 0xc8, 0x23, 0x51, 0x10, 0xe6,
+	0xe2, 0x44, 0x1e, 0xf9, 0x6a, 0x22, 0x8b, 0xbf, 0x6a, 0x54, 0x32, 0xea, 0x4d, 0x12, 0x2a, 0x53,
+	0xdc, 0x2c, 0x8e, 0x4a, 0x45, 0xc7, 0x99, 0x04, 0xc2, 0xd0, 0x22, 0x9e, 0x47, 0x19, 0x7b, 0x4c,
+	0xa7, 0x56, 0xbd, 0xca, 0xe7, 0xb7, 0x26, 0xaa, 0x60, 0x2f, 0xd5, 0xc5, 0x39, 0x8c, 0xc0, 0x64,
+	0xa9, 0xb8, 0xd5, 0xa8, 0x8c, 0x99, 0x91, 0x71, 0x0e, 0x23, 0x9a, 0x8c, 0x0a, 0x9a, 0xb5, 0x92,
+	0x37, 0x19, 0xd9, 0x4b, 0x18, 0xd6, 0x1c, 0x91, 0x80, 0xa1, 0x3f, 0x16, 0x2b, 0x7f, 0xf3, 0xd6,
+	0x09, 0x78, 0x28, 0x01, 0xf4, 0x45, 0x21, 0x9f, 0xb1, 0x06, 0x45, 0x5f, 0x40, 0x33, 0xd0, 0xd3,
+	0xd5, 0x6a, 0xc9, 0xf1, 0x7c, 0x78, 0x0b, 0x03, 0x69, 0x71, 0x65, 0x93, 0x5a, 0x8d, 0xe8, 0x2c,
+	0x47, 0x29, 0x19, 0x67, 0xc6, 0xd0, 0xaf, 0x61, 0xcd, 0x23, 0xfb, 0x54, 0x28, 0xfa, 0x1e, 0xe1,
+	0xd4, 0x82, 0x2a, 0x31, 0x7d, 0x6b, 0x2e, 0x16, 0xd5, 0xbd, 0x82, 0x3e, 0x2e, 0xc3, 0x6d, 0xff,
+	0x48, 0x8e, 0xe2, 0xdc, 0x99, 0x4a, 0x83, 0xfc, 0x31, 0x34, 0xd3, 0xb2, 0x45, 0x77, 0x0a, 0x7a,
+	0x79, 0x57, 0x13, 0x99, 0x94, 0x20, 0x77, 0x61, 0x59, 0xde, 0x28, 0xaa, 0xa1, 0xad, 0xa6, 0xeb,
+	0x9e, 0x58, 0xc4, 0xb1, 0xe4, 0xd8, 0x9f, 0x0a, 0x30, 0x15, 0x76, 0x51, 0xef, 0x71, 0x42, 0x87,
+	0xfe, 0x33, 0xcb, 0x28, 0xd7, 0xfb, 0xb1, 0xa4, 0x62, 0xcd, 0x15, 0x72, 0x6c, 0x32, 0x14, 0x72,
+	0xb5, 0xb2, 0x9c, 0x2b, 0xa9, 0x58, 0x73, 0xed, 0x7f, 0x1a, 0x00, 0xee, 0x9e, 0xdb, 0xd7, 0xeb,
+	0x86, 0x98, 0xad, 0xd4, 0xbb, 0x20, 0xa1, 0xcf, 0x02, 0xcb, 0x28, 0xf7, 0xb6, 0xa3, 0x94, 0x81,
+	0x73, 0x19, 0x74, 0x0a, 0x20, 0x0e, 0x24, 0x3d, 0xa9, 0x2a, 0x9d, 0x45, 0xf2, 0xde, 0x38, 0xcd,
+	0x94, 0x71, 0x01, 0x08, 0x11, 0x58, 0x4f, 0xcf, 0x24, 0x0d, 0x6d, 0x56, 0x81, 0x96, 0x5b, 0xcd,
+	0x71, 0x09, 0x00, 0x2f, 0x00, 0xda, 0x7f, 0xab, 0xc1, 0x96, 0xeb, 0x5d, 0xd0, 0x80, 0x88, 0x56,
+	0xc1, 0x78, 0x32, 0xd5, 0x31, 0xb8, 0x03, 0xe6, 0x24, 0x19, 0x2f, 0xe6, 0xeb, 0x14, 0xf7, 0xb1,
+	0xa0, 0x8b, 0x4e, 0xc2, 0xa4, 0xda, 0xa1, 0x3a, 0x03, 0x0b, 0xc3, 0x42, 0xc1, 0x1d, 0x7e, 0x8c,
+	0x33, 0x09, 0xf4, 0x4b, 0x58, 0x26, 0x13, 0x7e, 0x61, 0x99, 0xb7, 0x9c, 0xb3, 0xd9, 0x3d, 0x9b,
+	0x57, 0x86, 0xf8, 0x85, 0x25, 0xaa, 0xb8, 0x33, 0xd8, 0xe4, 0xfc, 0x29, 0xf5, 0xb8, 0xb5, 0x5c,
+	0xbe, 0x33, 0x5c, 0x45, 0xc6, 0x29, 0x5f, 0x88, 0x5e, 0xd1, 0x84, 0x89, 0x4e, 0x59, 0x97, 0x5e,
+	0x67, 0xa2, 0x67, 0x8a, 0x8c, 0x53, 0xbe, 0xd8, 0x78, 0xf4, 0x75, 0x22, 0x6e, 0x0d, 0xd9, 0xab,
+	0x5a, 0xf9, 0xc6, 0x73, 0x94, 0xb3, 0x70, 0x51, 0xce, 0xfe, 0x93, 0x01, 0xab, 0xae, 0xec, 0x9f,
+	0x9f, 0x50, 0x32, 0xa0, 0x49, 0x56, 0xd9, 0xc6, 0x7f, 0xaa, 0x6c, 0x14, 0x40, 0x4b, 0x7e, 0x33,
+	0x0f, 0x93, 0x28, 0xd0, 0xc5, 0xf3, 0xd3, 0xca, 0x21, 0x3a, 0x4b, 0x11, 0x5c, 0xb9, 0x76, 0xa8,
+	0x76, 0x99, 0x11, 0x71, 0x6e, 0xc1, 0x7e, 0x6d, 0xc0, 0xd6, 0x4d, 0x5b, 0x30, 0x9a, 0x66, 0xcb,
+	0x9a, 0xba, 0x6f, 0x7e, 0xfe, 0x46, 0x96, 0xeb, 0xff, 0xe5, 0xda, 0xd0, 0x37, 0x1c, 0x4d, 0xae,
+	0xf4, 0x0d, 0xd7, 0x2a, 0xdd, 0x70, 0x92, 0x8e, 0x33, 0x89, 0xff, 0xe7, 0xb6, 0x78, 0x06, 0xfa,
+	0xd6, 0x46, 0x21, 0x80, 0x97, 0x1e, 0xd6, 0xe9, 0x1b, 0x57, 0xaf, 0xcc, 0xec, 0x36, 0x77, 0x90,
+	0x76, 0x18, 0x32, 0x12, 0xc3, 0x05, 0x0b, 0xf6, 0xef, 0x4c, 0x68, 0x65, 0xfb, 0x22, 0xfa, 0x0c,
+	0x56, 0x55, 0xa3, 0xbd, 0xcd, 0x76, 0xbb, 0x39, 0x9f, 0x75, 0x56, 0x55, 0xdb, 0xd6, 0x9f, 0x75,
+	0x09, 0x4c, 0xae, 0xcf, 0x63, 0x9f, 0x86, 0xbc, 0x60, 0xa0, 0x56, 0x7d, 0x7d, 0x5e, 0x80, 0xc0,
+	0xd7, 0x40, 0xd1, 0x00, 0x36, 0x14, 0x4d, 0x2a, 0x57, 0xef, 0x50, 0x6f, 0x8b, 0xcd, 0x6b, 0xbf,
+	0x8c, 0x80, 0x17, 0x21, 0xd1, 0x23, 0x40, 0xe9, 0x4e, 0xe2, 0x5e, 0xfa, 0xf1, 0x19, 0x4d, 0xfc,
+	0xe1, 0x54, 0xef, 0x2f, 0xd9, 0xff, 0x2e, 0x0e, 0xaf, 0x49, 0xe0, 0x1b, 0xb4, 0xec, 0xaf, 0x0c,
+	0xd8, 0x58, 0xf8, 0x54, 0x44, 0x2e, 0xb2, 0x65, 0x02, 0xd3, 0xe1, 0x2d, 0x72, 0xe1, 0x16, 0xd4,
+	0x71, 0x09, 0x0c, 0x8d, 0x60, 0xc3, 0x93, 0x29, 0x3f, 0x22, 0xb1, 0xc6, 0x57, 0xa9, 0xd8, 0xbd,
+	0x09, 0x7f, 0xbf, 0x20, 0xba, 0x10, 0xa5, 0x32, 0x08, 0x5e, 0x44, 0x75, 0x4e, 0x9f, 0xbf, 0xda,
+	0x59, 0x7a, 0xf1, 0x6a, 0x67, 0xe9, 0xe5, 0xab, 0x9d, 0xa5, 0xdf, 0xcc, 0x77, 0x8c, 0xe7, 0xf3,
+	0x1d, 0xe3, 0xc5, 0x7c, 0xc7, 0x78, 0x39, 0xdf, 0x31, 0xfe, 0x3e, 0xdf, 0x31, 0xfe, 0xf8, 0x8f,
+	0x9d, 0xa5, 0x4f, 0x7b, 0x15, 0xff, 0xe7, 0xfd, 0xef, 0x01, 0x00, 0x58, 0x43, 0x06, 0x47, 0x25,
+	0x17, 0x00, 0x00,
 }
 
 func (m *Amount) Marshal() (dAtA []byte, err error) {
@@ -662,6 +868,18 @@ func (m *Backoff) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Cap != nil {
+		{
+			size, err := m.Cap.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
 	i = encodeVarintGenerated(dAtA, i, uint64(m.Steps))
 	i--
 	dAtA[i] = 0x20
@@ -751,6 +969,48 @@ func (m *BasicAuth) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ClusterResourceStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClusterResourceStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClusterResourceStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Namespaces) > 0 {
+		for iNdEx := len(m.Namespaces) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Namespaces[iNdEx])
+			copy(dAtA[i:], m.Namespaces[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Namespaces[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *Condition) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -909,7 +1169,7 @@ func (m *Metadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *Resource) Marshal() (dAtA []byte, err error) {
+func (m *MetricsConfig) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -919,27 +1179,44 @@ func (m *Resource) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Resource) MarshalTo(dAtA []byte) (int, error) {
+func (m *MetricsConfig) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Resource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MetricsConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Value != nil {
-		i -= len(m.Value)
-		copy(dAtA[i:], m.Value)
-		i = encodeVarintGenerated(dAtA, i, uint64(len(m.Value)))
+	if m.LabelLimits != nil {
+		{
+			size, err := m.LabelLimits.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ServiceMonitor != nil {
+		{
+			size, err := m.ServiceMonitor.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *S3Artifact) Marshal() (dAtA []byte, err error) {
+func (m *MetricsLabelLimits) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -949,19 +1226,62 @@ func (m *S3Artifact) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *S3Artifact) MarshalTo(dAtA []byte) (int, error) {
+func (m *MetricsLabelLimits) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *S3Artifact) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MetricsLabelLimits) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.CACertificate != nil {
+	if len(m.Labels) > 0 {
+		for iNdEx := len(m.Labels) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Labels[iNdEx])
+			copy(dAtA[i:], m.Labels[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Labels[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	i = encodeVarintGenerated(dAtA, i, uint64(m.HashBuckets))
+	i--
+	dAtA[i] = 0x18
+	i -= len(m.Policy)
+	copy(dAtA[i:], m.Policy)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Policy)))
+	i--
+	dAtA[i] = 0x12
+	i = encodeVarintGenerated(dAtA, i, uint64(m.MaxValues))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+
+func (m *RemoteEventBus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RemoteEventBus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemoteEventBus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TLS != nil {
 		{
-			size, err := m.CACertificate.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.TLS.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -969,35 +1289,11 @@ func (m *S3Artifact) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x52
-	}
-	if len(m.Metadata) > 0 {
-		keysForMetadata := make([]string, 0, len(m.Metadata))
-		for k := range m.Metadata {
-			keysForMetadata = append(keysForMetadata, string(k))
-		}
-		github_com_gogo_protobuf_sortkeys.Strings(keysForMetadata)
-		for iNdEx := len(keysForMetadata) - 1; iNdEx >= 0; iNdEx-- {
-			v := m.Metadata[string(keysForMetadata[iNdEx])]
-			baseI := i
-			i -= len(v)
-			copy(dAtA[i:], v)
-			i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
-			i--
-			dAtA[i] = 0x12
-			i -= len(keysForMetadata[iNdEx])
-			copy(dAtA[i:], keysForMetadata[iNdEx])
-			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForMetadata[iNdEx])))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x4a
-		}
+		dAtA[i] = 0x12
 	}
-	if m.Filter != nil {
+	if m.ConnectionSecret != nil {
 		{
-			size, err := m.Filter.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.ConnectionSecret.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -1005,11 +1301,178 @@ func (m *S3Artifact) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x42
+		dAtA[i] = 0xa
 	}
-	if len(m.Events) > 0 {
-		for iNdEx := len(m.Events) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Events[iNdEx])
+	return len(dAtA) - i, nil
+}
+
+func (m *Resource) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Resource) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Resource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Value != nil {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RetryStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RetryStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RetryStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.LastAttemptTime.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size, err := m.Since.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	i -= len(m.LastError)
+	copy(dAtA[i:], m.LastError)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.LastError)))
+	i--
+	dAtA[i] = 0x2a
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Attempts))
+	i--
+	dAtA[i] = 0x20
+	i -= len(m.Target)
+	copy(dAtA[i:], m.Target)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Target)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Operation)
+	copy(dAtA[i:], m.Operation)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Operation)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Pod)
+	copy(dAtA[i:], m.Pod)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Pod)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *S3Artifact) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *S3Artifact) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *S3Artifact) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CACertificate != nil {
+		{
+			size, err := m.CACertificate.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.Metadata) > 0 {
+		keysForMetadata := make([]string, 0, len(m.Metadata))
+		for k := range m.Metadata {
+			keysForMetadata = append(keysForMetadata, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForMetadata)
+		for iNdEx := len(keysForMetadata) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Metadata[string(keysForMetadata[iNdEx])]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForMetadata[iNdEx])
+			copy(dAtA[i:], keysForMetadata[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForMetadata[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.Filter != nil {
+		{
+			size, err := m.Filter.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Events) > 0 {
+		for iNdEx := len(m.Events) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Events[iNdEx])
 			copy(dAtA[i:], m.Events[iNdEx])
 			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Events[iNdEx])))
 			i--
@@ -1211,6 +1674,19 @@ func (m *SchemaRegistryConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i -= len(m.MessageType)
+	copy(dAtA[i:], m.MessageType)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.MessageType)))
+	i--
+	dAtA[i] = 0x32
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Version))
+	i--
+	dAtA[i] = 0x28
+	i -= len(m.Subject)
+	copy(dAtA[i:], m.Subject)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Subject)))
+	i--
+	dAtA[i] = 0x22
 	{
 		size, err := m.Auth.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -1272,6 +1748,58 @@ func (m *SecureHeader) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ServiceMonitorConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ServiceMonitorConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ServiceMonitorConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Interval)
+	copy(dAtA[i:], m.Interval)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Interval)))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Labels) > 0 {
+		keysForLabels := make([]string, 0, len(m.Labels))
+		for k := range m.Labels {
+			keysForLabels = append(keysForLabels, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForLabels)
+		for iNdEx := len(keysForLabels) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Labels[string(keysForLabels[iNdEx])]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForLabels[iNdEx])
+			copy(dAtA[i:], keysForLabels[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForLabels[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Status) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1466,6 +1994,10 @@ func (m *Backoff) Size() (n int) {
 		n += 1 + l + sovGenerated(uint64(l))
 	}
 	n += 1 + sovGenerated(uint64(m.Steps))
+	if m.Cap != nil {
+		l = m.Cap.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -1486,6 +2018,23 @@ func (m *BasicAuth) Size() (n int) {
 	return n
 }
 
+func (m *ClusterResourceStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Status.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Namespaces) > 0 {
+		for _, s := range m.Namespaces {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *Condition) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1543,64 +2092,139 @@ func (m *Metadata) Size() (n int) {
 	return n
 }
 
-func (m *Resource) Size() (n int) {
+func (m *MetricsConfig) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Value != nil {
-		l = len(m.Value)
+	if m.ServiceMonitor != nil {
+		l = m.ServiceMonitor.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.LabelLimits != nil {
+		l = m.LabelLimits.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
 	return n
 }
 
-func (m *S3Artifact) Size() (n int) {
+func (m *MetricsLabelLimits) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Endpoint)
-	n += 1 + l + sovGenerated(uint64(l))
-	if m.Bucket != nil {
-		l = m.Bucket.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	l = len(m.Region)
+	n += 1 + sovGenerated(uint64(m.MaxValues))
+	l = len(m.Policy)
 	n += 1 + l + sovGenerated(uint64(l))
-	n += 2
-	if m.AccessKey != nil {
-		l = m.AccessKey.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	if m.SecretKey != nil {
-		l = m.SecretKey.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	if len(m.Events) > 0 {
-		for _, s := range m.Events {
+	n += 1 + sovGenerated(uint64(m.HashBuckets))
+	if len(m.Labels) > 0 {
+		for _, s := range m.Labels {
 			l = len(s)
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
-	if m.Filter != nil {
-		l = m.Filter.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	if len(m.Metadata) > 0 {
-		for k, v := range m.Metadata {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
-			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
-		}
-	}
-	if m.CACertificate != nil {
-		l = m.CACertificate.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
+	return n
+}
+
+func (m *RemoteEventBus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ConnectionSecret != nil {
+		l = m.ConnectionSecret.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.TLS != nil {
+		l = m.TLS.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *Resource) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Value != nil {
+		l = len(m.Value)
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *RetryStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Pod)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Operation)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Target)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.Attempts))
+	l = len(m.LastError)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Since.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.LastAttemptTime.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *S3Artifact) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Endpoint)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Bucket != nil {
+		l = m.Bucket.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.Region)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
+	if m.AccessKey != nil {
+		l = m.AccessKey.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.SecretKey != nil {
+		l = m.SecretKey.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if len(m.Events) > 0 {
+		for _, s := range m.Events {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.Filter != nil {
+		l = m.Filter.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if len(m.Metadata) > 0 {
+		for k, v := range m.Metadata {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	if m.CACertificate != nil {
+		l = m.CACertificate.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -1660,6 +2284,11 @@ func (m *SchemaRegistryConfig) Size() (n int) {
 	n += 1 + sovGenerated(uint64(m.SchemaID))
 	l = m.Auth.Size()
 	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Subject)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.Version))
+	l = len(m.MessageType)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -1678,6 +2307,25 @@ func (m *SecureHeader) Size() (n int) {
 	return n
 }
 
+func (m *ServiceMonitorConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Labels) > 0 {
+		for k, v := range m.Labels {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	l = len(m.Interval)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *Status) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1757,6 +2405,7 @@ func (this *Backoff) String() string {
 		`Factor:` + strings.Replace(this.Factor.String(), "Amount", "Amount", 1) + `,`,
 		`Jitter:` + strings.Replace(this.Jitter.String(), "Amount", "Amount", 1) + `,`,
 		`Steps:` + fmt.Sprintf("%v", this.Steps) + `,`,
+		`Cap:` + strings.Replace(this.Cap.String(), "Int64OrString", "Int64OrString", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -1772,6 +2421,17 @@ func (this *BasicAuth) String() string {
 	}, "")
 	return s
 }
+func (this *ClusterResourceStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ClusterResourceStatus{`,
+		`Status:` + strings.Replace(strings.Replace(this.Status.String(), "Status", "Status", 1), `&`, ``, 1) + `,`,
+		`Namespaces:` + fmt.Sprintf("%v", this.Namespaces) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *Condition) String() string {
 	if this == nil {
 		return "nil"
@@ -1829,6 +2489,41 @@ func (this *Metadata) String() string {
 	}, "")
 	return s
 }
+func (this *MetricsConfig) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MetricsConfig{`,
+		`ServiceMonitor:` + strings.Replace(this.ServiceMonitor.String(), "ServiceMonitorConfig", "ServiceMonitorConfig", 1) + `,`,
+		`LabelLimits:` + strings.Replace(this.LabelLimits.String(), "MetricsLabelLimits", "MetricsLabelLimits", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MetricsLabelLimits) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MetricsLabelLimits{`,
+		`MaxValues:` + fmt.Sprintf("%v", this.MaxValues) + `,`,
+		`Policy:` + fmt.Sprintf("%v", this.Policy) + `,`,
+		`HashBuckets:` + fmt.Sprintf("%v", this.HashBuckets) + `,`,
+		`Labels:` + fmt.Sprintf("%v", this.Labels) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RemoteEventBus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RemoteEventBus{`,
+		`ConnectionSecret:` + strings.Replace(fmt.Sprintf("%v", this.ConnectionSecret), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
+		`TLS:` + strings.Replace(this.TLS.String(), "TLSConfig", "TLSConfig", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *Resource) String() string {
 	if this == nil {
 		return "nil"
@@ -1839,6 +2534,22 @@ func (this *Resource) String() string {
 	}, "")
 	return s
 }
+func (this *RetryStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RetryStatus{`,
+		`Pod:` + fmt.Sprintf("%v", this.Pod) + `,`,
+		`Operation:` + fmt.Sprintf("%v", this.Operation) + `,`,
+		`Target:` + fmt.Sprintf("%v", this.Target) + `,`,
+		`Attempts:` + fmt.Sprintf("%v", this.Attempts) + `,`,
+		`LastError:` + fmt.Sprintf("%v", this.LastError) + `,`,
+		`Since:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Since), "Time", "v11.Time", 1), `&`, ``, 1) + `,`,
+		`LastAttemptTime:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.LastAttemptTime), "Time", "v11.Time", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *S3Artifact) String() string {
 	if this == nil {
 		return "nil"
@@ -1910,6 +2621,9 @@ func (this *SchemaRegistryConfig) String() string {
 		`URL:` + fmt.Sprintf("%v", this.URL) + `,`,
 		`SchemaID:` + fmt.Sprintf("%v", this.SchemaID) + `,`,
 		`Auth:` + strings.Replace(strings.Replace(this.Auth.String(), "BasicAuth", "BasicAuth", 1), `&`, ``, 1) + `,`,
+		`Subject:` + fmt.Sprintf("%v", this.Subject) + `,`,
+		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
+		`MessageType:` + fmt.Sprintf("%v", this.MessageType) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -1925,6 +2639,27 @@ func (this *SecureHeader) String() string {
 	}, "")
 	return s
 }
+func (this *ServiceMonitorConfig) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForLabels := make([]string, 0, len(this.Labels))
+	for k := range this.Labels {
+		keysForLabels = append(keysForLabels, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForLabels)
+	mapStringForLabels := "map[string]string{"
+	for _, k := range keysForLabels {
+		mapStringForLabels += fmt.Sprintf("%v: %v,", k, this.Labels[k])
+	}
+	mapStringForLabels += "}"
+	s := strings.Join([]string{`&ServiceMonitorConfig{`,
+		`Labels:` + mapStringForLabels + `,`,
+		`Interval:` + fmt.Sprintf("%v", this.Interval) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *Status) String() string {
 	if this == nil {
 		return "nil"
@@ -2212,6 +2947,42 @@ func (m *Backoff) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cap", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Cap == nil {
+				m.Cap = &Int64OrString{}
+			}
+			if err := m.Cap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -2355,7 +3126,7 @@ func (m *BasicAuth) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Condition) Unmarshal(dAtA []byte) error {
+func (m *ClusterResourceStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2378,17 +3149,17 @@ func (m *Condition) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Condition: wiretype end group for non-group")
+			return fmt.Errorf("proto: ClusterResourceStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Condition: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ClusterResourceStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -2398,27 +3169,28 @@ func (m *Condition) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = ConditionType(dAtA[iNdEx:postIndex])
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespaces", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2446,52 +3218,166 @@ func (m *Condition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Status = k8s_io_api_core_v1.ConditionStatus(dAtA[iNdEx:postIndex])
+			m.Namespaces = append(m.Namespaces, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastTransitionTime", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LastTransitionTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Condition) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Condition: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Condition: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = ConditionType(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = k8s_io_api_core_v1.ConditionStatus(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastTransitionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LastTransitionTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
@@ -2990,7 +3876,7 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Resource) Unmarshal(dAtA []byte) error {
+func (m *MetricsConfig) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3013,17 +3899,17 @@ func (m *Resource) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Resource: wiretype end group for non-group")
+			return fmt.Errorf("proto: MetricsConfig: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Resource: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MetricsConfig: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceMonitor", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -3033,24 +3919,62 @@ func (m *Resource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
-			if m.Value == nil {
-				m.Value = []byte{}
+			if m.ServiceMonitor == nil {
+				m.ServiceMonitor = &ServiceMonitorConfig{}
+			}
+			if err := m.ServiceMonitor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LabelLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LabelLimits == nil {
+				m.LabelLimits = &MetricsLabelLimits{}
+			}
+			if err := m.LabelLimits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -3074,7 +3998,7 @@ func (m *Resource) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *S3Artifact) Unmarshal(dAtA []byte) error {
+func (m *MetricsLabelLimits) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3097,15 +4021,34 @@ func (m *S3Artifact) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: S3Artifact: wiretype end group for non-group")
+			return fmt.Errorf("proto: MetricsLabelLimits: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: S3Artifact: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MetricsLabelLimits: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxValues", wireType)
+			}
+			m.MaxValues = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxValues |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Endpoint", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3133,13 +4076,13 @@ func (m *S3Artifact) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Endpoint = string(dAtA[iNdEx:postIndex])
+			m.Policy = MetricsLabelPolicy(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Bucket", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HashBuckets", wireType)
 			}
-			var msglen int
+			m.HashBuckets = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -3149,31 +4092,14 @@ func (m *S3Artifact) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.HashBuckets |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Bucket == nil {
-				m.Bucket = &S3Bucket{}
-			}
-			if err := m.Bucket.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Region", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3201,15 +4127,634 @@ func (m *S3Artifact) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Region = string(dAtA[iNdEx:postIndex])
+			m.Labels = append(m.Labels, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Insecure", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RemoteEventBus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RemoteEventBus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RemoteEventBus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionSecret", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ConnectionSecret == nil {
+				m.ConnectionSecret = &v1.SecretKeySelector{}
+			}
+			if err := m.ConnectionSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TLS", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TLS == nil {
+				m.TLS = &TLSConfig{}
+			}
+			if err := m.TLS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Resource) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Resource: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Resource: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RetryStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RetryStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RetryStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pod", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pod = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operation = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Target = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempts", wireType)
+			}
+			m.Attempts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Attempts |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastError", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LastError = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Since", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Since.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastAttemptTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LastAttemptTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *S3Artifact) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: S3Artifact: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: S3Artifact: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Endpoint", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Endpoint = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bucket", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Bucket == nil {
+				m.Bucket = &S3Bucket{}
+			}
+			if err := m.Bucket.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Region", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Region = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Insecure", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
 					return ErrIntOverflowGenerated
 				}
 				if iNdEx >= l {
@@ -3801,12 +5346,166 @@ func (m *SASLConfig) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: SASLConfig: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SASLConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SASLConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mechanism", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Mechanism = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserSecret", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.UserSecret == nil {
+				m.UserSecret = &v1.SecretKeySelector{}
+			}
+			if err := m.UserSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PasswordSecret", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PasswordSecret == nil {
+				m.PasswordSecret = &v1.SecretKeySelector{}
+			}
+			if err := m.PasswordSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SchemaRegistryConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SchemaRegistryConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SchemaRegistryConfig: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Mechanism", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3834,11 +5533,30 @@ func (m *SASLConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Mechanism = string(dAtA[iNdEx:postIndex])
+			m.URL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SchemaID", wireType)
+			}
+			m.SchemaID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SchemaID |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UserSecret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Auth", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3865,18 +5583,15 @@ func (m *SASLConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.UserSecret == nil {
-				m.UserSecret = &v1.SecretKeySelector{}
-			}
-			if err := m.UserSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Auth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PasswordSecret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Subject", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -3886,27 +5601,74 @@ func (m *SASLConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PasswordSecret == nil {
-				m.PasswordSecret = &v1.SecretKeySelector{}
+			m.Subject = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
-			if err := m.PasswordSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.MessageType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3929,7 +5691,7 @@ func (m *SASLConfig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SchemaRegistryConfig) Unmarshal(dAtA []byte) error {
+func (m *SecureHeader) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3952,15 +5714,15 @@ func (m *SchemaRegistryConfig) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SchemaRegistryConfig: wiretype end group for non-group")
+			return fmt.Errorf("proto: SecureHeader: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SchemaRegistryConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SecureHeader: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3988,30 +5750,11 @@ func (m *SchemaRegistryConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.URL = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SchemaID", wireType)
-			}
-			m.SchemaID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SchemaID |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Auth", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueFrom", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4038,7 +5781,10 @@ func (m *SchemaRegistryConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Auth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.ValueFrom == nil {
+				m.ValueFrom = &ValueFromSource{}
+			}
+			if err := m.ValueFrom.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4063,7 +5809,7 @@ func (m *SchemaRegistryConfig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SecureHeader) Unmarshal(dAtA []byte) error {
+func (m *ServiceMonitorConfig) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4086,17 +5832,17 @@ func (m *SecureHeader) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SecureHeader: wiretype end group for non-group")
+			return fmt.Errorf("proto: ServiceMonitorConfig: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SecureHeader: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ServiceMonitorConfig: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -4106,29 +5852,124 @@ func (m *SecureHeader) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if m.Labels == nil {
+				m.Labels = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Labels[mapkey] = mapvalue
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValueFrom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -4138,27 +5979,23 @@ func (m *SecureHeader) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ValueFrom == nil {
-				m.ValueFrom = &ValueFromSource{}
-			}
-			if err := m.ValueFrom.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Interval = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -30,22 +30,28 @@ import (
 
 func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenAPIDefinition {
 	return map[string]common.OpenAPIDefinition{
-		"github.com/argoproj/argo-events/pkg/apis/common.Amount":               schema_argo_events_pkg_apis_common_Amount(ref),
-		"github.com/argoproj/argo-events/pkg/apis/common.Backoff":              schema_argo_events_pkg_apis_common_Backoff(ref),
-		"github.com/argoproj/argo-events/pkg/apis/common.BasicAuth":            schema_argo_events_pkg_apis_common_BasicAuth(ref),
-		"github.com/argoproj/argo-events/pkg/apis/common.Condition":            schema_argo_events_pkg_apis_common_Condition(ref),
-		"github.com/argoproj/argo-events/pkg/apis/common.Int64OrString":        schema_argo_events_pkg_apis_common_Int64OrString(ref),
-		"github.com/argoproj/argo-events/pkg/apis/common.Metadata":             schema_argo_events_pkg_apis_common_Metadata(ref),
-		"github.com/argoproj/argo-events/pkg/apis/common.Resource":             schema_argo_events_pkg_apis_common_Resource(ref),
-		"github.com/argoproj/argo-events/pkg/apis/common.S3Artifact":           schema_argo_events_pkg_apis_common_S3Artifact(ref),
-		"github.com/argoproj/argo-events/pkg/apis/common.S3Bucket":             schema_argo_events_pkg_apis_common_S3Bucket(ref),
-		"github.com/argoproj/argo-events/pkg/apis/common.S3Filter":             schema_argo_events_pkg_apis_common_S3Filter(ref),
-		"github.com/argoproj/argo-events/pkg/apis/common.SASLConfig":           schema_argo_events_pkg_apis_common_SASLConfig(ref),
-		"github.com/argoproj/argo-events/pkg/apis/common.SchemaRegistryConfig": schema_argo_events_pkg_apis_common_SchemaRegistryConfig(ref),
-		"github.com/argoproj/argo-events/pkg/apis/common.SecureHeader":         schema_argo_events_pkg_apis_common_SecureHeader(ref),
-		"github.com/argoproj/argo-events/pkg/apis/common.Status":               schema_argo_events_pkg_apis_common_Status(ref),
-		"github.com/argoproj/argo-events/pkg/apis/common.TLSConfig":            schema_argo_events_pkg_apis_common_TLSConfig(ref),
-		"github.com/argoproj/argo-events/pkg/apis/common.ValueFromSource":      schema_argo_events_pkg_apis_common_ValueFromSource(ref),
+		"github.com/argoproj/argo-events/pkg/apis/common.Amount":                schema_argo_events_pkg_apis_common_Amount(ref),
+		"github.com/argoproj/argo-events/pkg/apis/common.Backoff":               schema_argo_events_pkg_apis_common_Backoff(ref),
+		"github.com/argoproj/argo-events/pkg/apis/common.BasicAuth":             schema_argo_events_pkg_apis_common_BasicAuth(ref),
+		"github.com/argoproj/argo-events/pkg/apis/common.ClusterResourceStatus": schema_argo_events_pkg_apis_common_ClusterResourceStatus(ref),
+		"github.com/argoproj/argo-events/pkg/apis/common.Condition":             schema_argo_events_pkg_apis_common_Condition(ref),
+		"github.com/argoproj/argo-events/pkg/apis/common.Int64OrString":         schema_argo_events_pkg_apis_common_Int64OrString(ref),
+		"github.com/argoproj/argo-events/pkg/apis/common.Metadata":              schema_argo_events_pkg_apis_common_Metadata(ref),
+		"github.com/argoproj/argo-events/pkg/apis/common.MetricsConfig":         schema_argo_events_pkg_apis_common_MetricsConfig(ref),
+		"github.com/argoproj/argo-events/pkg/apis/common.MetricsLabelLimits":    schema_argo_events_pkg_apis_common_MetricsLabelLimits(ref),
+		"github.com/argoproj/argo-events/pkg/apis/common.RemoteEventBus":        schema_argo_events_pkg_apis_common_RemoteEventBus(ref),
+		"github.com/argoproj/argo-events/pkg/apis/common.Resource":              schema_argo_events_pkg_apis_common_Resource(ref),
+		"github.com/argoproj/argo-events/pkg/apis/common.RetryStatus":           schema_argo_events_pkg_apis_common_RetryStatus(ref),
+		"github.com/argoproj/argo-events/pkg/apis/common.S3Artifact":            schema_argo_events_pkg_apis_common_S3Artifact(ref),
+		"github.com/argoproj/argo-events/pkg/apis/common.S3Bucket":              schema_argo_events_pkg_apis_common_S3Bucket(ref),
+		"github.com/argoproj/argo-events/pkg/apis/common.S3Filter":              schema_argo_events_pkg_apis_common_S3Filter(ref),
+		"github.com/argoproj/argo-events/pkg/apis/common.SASLConfig":            schema_argo_events_pkg_apis_common_SASLConfig(ref),
+		"github.com/argoproj/argo-events/pkg/apis/common.SchemaRegistryConfig":  schema_argo_events_pkg_apis_common_SchemaRegistryConfig(ref),
+		"github.com/argoproj/argo-events/pkg/apis/common.SecureHeader":          schema_argo_events_pkg_apis_common_SecureHeader(ref),
+		"github.com/argoproj/argo-events/pkg/apis/common.ServiceMonitorConfig":  schema_argo_events_pkg_apis_common_ServiceMonitorConfig(ref),
+		"github.com/argoproj/argo-events/pkg/apis/common.Status":                schema_argo_events_pkg_apis_common_Status(ref),
+		"github.com/argoproj/argo-events/pkg/apis/common.TLSConfig":             schema_argo_events_pkg_apis_common_TLSConfig(ref),
+		"github.com/argoproj/argo-events/pkg/apis/common.ValueFromSource":       schema_argo_events_pkg_apis_common_ValueFromSource(ref),
 	}
 }
 
@@ -93,6 +99,12 @@ func schema_argo_events_pkg_apis_common_Backoff(ref common.ReferenceCallback) co
 							Format:      "int32",
 						},
 					},
+					"cap": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Cap is the maximum duration between two retries, in nanoseconds or strings like \"1s\", \"3m\". Once reached, the retries go on at the cap until the steps are exhausted.",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/common.Int64OrString"),
+						},
+					},
 				},
 			},
 		},
@@ -128,6 +140,56 @@ func schema_argo_events_pkg_apis_common_BasicAuth(ref common.ReferenceCallback)
 	}
 }
 
+func schema_argo_events_pkg_apis_common_ClusterResourceStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ClusterResourceStatus holds the status of a cluster-scoped resource instantiated into namespaces",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"conditions": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-patch-merge-key": "type",
+								"x-kubernetes-patch-strategy":  "merge",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Conditions are the latest available observations of a resource's current state.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/argoproj/argo-events/pkg/apis/common.Condition"),
+									},
+								},
+							},
+						},
+					},
+					"namespaces": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Namespaces are the namespaces the resource is instantiated into",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/argoproj/argo-events/pkg/apis/common.Condition"},
+	}
+}
+
 func schema_argo_events_pkg_apis_common_Condition(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -234,6 +296,112 @@ func schema_argo_events_pkg_apis_common_Metadata(ref common.ReferenceCallback) c
 	}
 }
 
+func schema_argo_events_pkg_apis_common_MetricsConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MetricsConfig is the configuration of the metrics endpoint exposed by the generated Deployment.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"serviceMonitor": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ServiceMonitor, if specified, makes the controller create a Service exposing the metrics port, along with a Prometheus Operator ServiceMonitor scraping it.",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/common.ServiceMonitorConfig"),
+						},
+					},
+					"labelLimits": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LabelLimits limits the cardinality of the labels of the metrics of each pod, e.g. for the adapters with thousands of events or triggers. The labels are not limited if not specified.",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/common.MetricsLabelLimits"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/argoproj/argo-events/pkg/apis/common.MetricsLabelLimits", "github.com/argoproj/argo-events/pkg/apis/common.ServiceMonitorConfig"},
+	}
+}
+
+func schema_argo_events_pkg_apis_common_MetricsLabelLimits(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MetricsLabelLimits limits the number of distinct values of the high cardinality labels of the metrics.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"maxValues": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxValues is the maximum number of distinct values of each limited label, the values seen after the limit is reached are replaced according to the policy.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"policy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Policy is how the values above the limit are replaced, either \"Drop\" or \"Hash\". Defaults to \"Drop\".",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"hashBuckets": {
+						SchemaProps: spec.SchemaProps{
+							Description: "HashBuckets is the number of hash buckets of the \"Hash\" policy. Defaults to 16.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"labels": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Labels are the names of the limited labels, among \"event_name\", \"trigger_name\" and \"dependency_name\". Defaults to all of them.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"maxValues"},
+			},
+		},
+	}
+}
+
+func schema_argo_events_pkg_apis_common_RemoteEventBus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RemoteEventBus refers to an EventBus running outside of the namespace, typically in a central cluster.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"connectionSecret": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ConnectionSecret refers to a Secret key holding the connection details of the remote EventBus in YAML format, including the server \"url\" and optionally \"streamConfig\", \"username\" and \"password\", or \"token\".",
+							Ref:         ref("k8s.io/api/core/v1.SecretKeySelector"),
+						},
+					},
+					"tls": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TLS configures the verification of the certificate of the remote EventBus and the client certificate, the certificate of the remote EventBus is not verified if not specified.",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/common.TLSConfig"),
+						},
+					},
+				},
+				Required: []string{"connectionSecret"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/argoproj/argo-events/pkg/apis/common.TLSConfig", "k8s.io/api/core/v1.SecretKeySelector"},
+	}
+}
+
 func schema_argo_events_pkg_apis_common_Resource(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -246,6 +414,73 @@ func schema_argo_events_pkg_apis_common_Resource(ref common.ReferenceCallback) c
 	}
 }
 
+func schema_argo_events_pkg_apis_common_RetryStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RetryStatus is the state of an operation being retried by a pod of a resource, e.g. the connection of an event source to its server, from its first failed attempt until it succeeds or its retries are exhausted.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"pod": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Pod is the name of the pod retrying the operation.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"operation": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Operation is the kind of the operation, e.g. \"eventsource.connect\".",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"target": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Target is what the operation is retried for, e.g. \"<event source name>/<event name>\".",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"attempts": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Attempts is the number of failed attempts.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"lastError": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastError is the error of the last failed attempt.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"since": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Since is the time of the first failed attempt.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"lastAttemptTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastAttemptTime is the time of the last failed attempt.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+				Required: []string{"pod", "operation", "target", "attempts", "since", "lastAttemptTime"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
 func schema_argo_events_pkg_apis_common_S3Artifact(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -441,7 +676,7 @@ func schema_argo_events_pkg_apis_common_SchemaRegistryConfig(ref common.Referenc
 					},
 					"schemaId": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Schema ID",
+							Description: "Schema ID, takes precedence over Subject.",
 							Default:     0,
 							Type:        []string{"integer"},
 							Format:      "int32",
@@ -454,6 +689,27 @@ func schema_argo_events_pkg_apis_common_SchemaRegistryConfig(ref common.Referenc
 							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/common.BasicAuth"),
 						},
 					},
+					"subject": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Subject to look up the schema by, used when SchemaID is not specified.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"version": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Version of the subject, defaults to the latest version.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"messageType": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MessageType is the fully qualified name of the message to use with a Protobuf schema, defaults to the first message of the schema.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 				Required: []string{"url", "schemaId"},
 			},
@@ -490,6 +746,42 @@ func schema_argo_events_pkg_apis_common_SecureHeader(ref common.ReferenceCallbac
 	}
 }
 
+func schema_argo_events_pkg_apis_common_ServiceMonitorConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ServiceMonitorConfig is the configuration of the Prometheus Operator ServiceMonitor.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"labels": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Labels of the ServiceMonitor, typically used by the Prometheus instance to select it.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"interval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Interval at which the metrics are scraped, e.g. \"30s\". Defaults to the scrape interval of Prometheus.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_argo_events_pkg_apis_common_Status(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -86,6 +86,26 @@ type Status struct {
 	Conditions []Condition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type" protobuf:"bytes,1,rep,name=conditions"`
 }
 
+// RetryStatus is the state of an operation being retried by a pod of a resource, e.g. the connection of an
+// event source to its server, from its first failed attempt until it succeeds or its retries are exhausted.
+type RetryStatus struct {
+	// Pod is the name of the pod retrying the operation.
+	Pod string `json:"pod" protobuf:"bytes,1,opt,name=pod"`
+	// Operation is the kind of the operation, e.g. "eventsource.connect".
+	Operation string `json:"operation" protobuf:"bytes,2,opt,name=operation"`
+	// Target is what the operation is retried for, e.g. "<event source name>/<event name>".
+	Target string `json:"target" protobuf:"bytes,3,opt,name=target"`
+	// Attempts is the number of failed attempts.
+	Attempts int32 `json:"attempts" protobuf:"varint,4,opt,name=attempts"`
+	// LastError is the error of the last failed attempt.
+	// +optional
+	LastError string `json:"lastError,omitempty" protobuf:"bytes,5,opt,name=lastError"`
+	// Since is the time of the first failed attempt.
+	Since metav1.Time `json:"since" protobuf:"bytes,6,opt,name=since"`
+	// LastAttemptTime is the time of the last failed attempt.
+	LastAttemptTime metav1.Time `json:"lastAttemptTime" protobuf:"bytes,7,opt,name=lastAttemptTime"`
+}
+
 // InitializeConditions initializes the contions to Unknown
 func (s *Status) InitializeConditions(conditionTypes ...ConditionType) {
 	for _, t := range conditionTypes {
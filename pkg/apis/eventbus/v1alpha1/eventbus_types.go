@@ -42,6 +42,75 @@ type EventBusSpec struct {
 	// Exotic JetStream
 	// +optional
 	JetStreamExotic *JetStreamConfig `json:"jetstreamExotic,omitempty" protobuf:"bytes,4,opt,name=jetstreamExotic"`
+	// Seed holds synthetic events published to the EventBus once it is deployed
+	// +optional
+	Seed *EventBusSeed `json:"seed,omitempty" protobuf:"bytes,5,opt,name=seed"`
+	// Shared uses a JetStream EventBus of another namespace, which serves the tenant namespaces with an
+	// isolated account each, instead of deploying one.
+	// +optional
+	Shared *SharedEventBus `json:"shared,omitempty" protobuf:"bytes,6,opt,name=shared"`
+	// Format is the format of the CloudEvents published to the EventBus, "JSON" or "Protobuf". Defaults to "JSON".
+	// The protobuf format reduces the size of the messages, the receivers decode both formats, so that the format
+	// can be changed without losing the events already published.
+	// +optional
+	Format EventFormat `json:"format,omitempty" protobuf:"bytes,7,opt,name=format,casttype=EventFormat"`
+	// RequirePlanApproval makes the controller wait for the approval of the plan of the destructive actions
+	// required by a spec change, e.g. the recreation of the StatefulSet, before executing them. A plan is approved
+	// by annotating the EventBus with "events.argoproj.io/approved-plan: <plan id>".
+	// +optional
+	RequirePlanApproval bool `json:"requirePlanApproval,omitempty" protobuf:"varint,8,opt,name=requirePlanApproval"`
+}
+
+// EventFormat is the format of the CloudEvents on an EventBus
+type EventFormat string
+
+const (
+	// EventFormatJSON is the JSON event format of CloudEvents, the batches are JSON arrays
+	EventFormatJSON EventFormat = "JSON"
+	// EventFormatProtobuf is the protobuf event format of CloudEvents
+	EventFormatProtobuf EventFormat = "Protobuf"
+)
+
+// SharedEventBus refers to a JetStream EventBus serving tenant namespaces.
+type SharedEventBus struct {
+	// Namespace of the shared EventBus, e.g. the system namespace.
+	Namespace string `json:"namespace" protobuf:"bytes,1,opt,name=namespace"`
+	// Name of the shared EventBus. Defaults to "default".
+	// +optional
+	Name string `json:"name,omitempty" protobuf:"bytes,2,opt,name=name"`
+}
+
+// GetName returns the name of the shared EventBus
+func (s SharedEventBus) GetName() string {
+	if s.Name == "" {
+		return "default"
+	}
+	return s.Name
+}
+
+// EventBusSeed describes synthetic events published once to the EventBus after it is deployed, e.g. for a
+// preview environment to come up with data. They are published by a Job, again only if the seed changes.
+type EventBusSeed struct {
+	// Events are the events to publish, in order.
+	Events []SeedEvent `json:"events" protobuf:"bytes,1,rep,name=events"`
+	// Delay is how long to wait after the EventBus is deployed before publishing the events, e.g. "1m",
+	// to let the Sensors subscribe. Defaults to no delay.
+	// +optional
+	Delay string `json:"delay,omitempty" protobuf:"bytes,2,opt,name=delay"`
+}
+
+// SeedEvent is a synthetic event, published as if it was emitted by an EventSource.
+type SeedEvent struct {
+	// EventSourceName is the name of the EventSource the event is published for.
+	EventSourceName string `json:"eventSourceName" protobuf:"bytes,1,opt,name=eventSourceName"`
+	// EventName is the name of the event within the EventSource.
+	EventName string `json:"eventName" protobuf:"bytes,2,opt,name=eventName"`
+	// Type is the type of the event. Defaults to "seed".
+	// +optional
+	Type string `json:"type,omitempty" protobuf:"bytes,3,opt,name=type"`
+	// Data is the JSON payload of the event.
+	// +optional
+	Data string `json:"data,omitempty" protobuf:"bytes,4,opt,name=data"`
 }
 
 // EventBusStatus holds the status of the eventbus resource
@@ -49,6 +118,89 @@ type EventBusStatus struct {
 	common.Status `json:",inline" protobuf:"bytes,1,opt,name=status"`
 	// Config holds the fininalized configuration of EventBus
 	Config BusConfig `json:"config,omitempty" protobuf:"bytes,2,opt,name=config"`
+	// Ordering is the ordering guarantee of the events delivered to the Sensors requiring ordering, one of
+	// "PerSubject" and "None"
+	// +optional
+	Ordering Ordering `json:"ordering,omitempty" protobuf:"bytes,3,opt,name=ordering,casttype=Ordering"`
+	// TightenedStreams are the streams whose retention has been tightened because of the storage budget, with
+	// their original max age, which is restored once the storage usage is back under the warning watermark.
+	// +optional
+	TightenedStreams map[string]string `json:"tightenedStreams,omitempty" protobuf:"bytes,4,rep,name=tightenedStreams"`
+	// PendingActions is the plan of the destructive actions required by the last spec change, written before
+	// they are executed, and removed once the EventBus is deployed.
+	// +optional
+	PendingActions *ActionPlan `json:"pendingActions,omitempty" protobuf:"bytes,5,opt,name=pendingActions"`
+	// Mirrors are the names of the mirror streams created by the controller, which are deleted once they are
+	// removed from the spec.
+	// +optional
+	Mirrors []string `json:"mirrors,omitempty" protobuf:"bytes,6,rep,name=mirrors"`
+}
+
+// ActionPlanVersion is the version of the format of the action plans
+const ActionPlanVersion = "v1"
+
+// ActionPlan lists the destructive actions the controller is about to execute to apply a spec change of an
+// EventBus.
+type ActionPlan struct {
+	// Version is the version of the format of the plan, "v1".
+	Version string `json:"version" protobuf:"bytes,1,opt,name=version"`
+	// ID identifies the plan, it changes with the actions and with the generation of the EventBus.
+	ID string `json:"id" protobuf:"bytes,2,opt,name=id"`
+	// Generation is the generation of the EventBus spec the plan applies.
+	Generation int64 `json:"generation" protobuf:"varint,3,opt,name=generation"`
+	// Actions are the destructive actions, in the order they are executed.
+	Actions []PendingAction `json:"actions" protobuf:"bytes,4,rep,name=actions"`
+	// Approved is whether the actions can be executed, it is false while the EventBus requires the approval of
+	// the plans and the plan isn't approved.
+	Approved bool `json:"approved" protobuf:"varint,5,opt,name=approved"`
+	// CreatedAt is the time the plan was computed.
+	CreatedAt metav1.Time `json:"createdAt" protobuf:"bytes,6,opt,name=createdAt"`
+}
+
+// PendingActionType is the type of a destructive action
+type PendingActionType string
+
+const (
+	// PendingActionRecreateStatefulSet deletes the StatefulSet of the EventBus and creates it again, e.g. to
+	// change its immutable volume claim templates. The pods are kept, and adopted by the new StatefulSet.
+	PendingActionRecreateStatefulSet PendingActionType = "RecreateStatefulSet"
+	// PendingActionRecreateVolumes deletes the volumes of the EventBus and creates them again, one replica at a
+	// time, e.g. when their storage class does not allow expanding them.
+	PendingActionRecreateVolumes PendingActionType = "RecreateVolumes"
+	// PendingActionDeleteMirror deletes a mirror stream removed from the spec, with the events it mirrored.
+	PendingActionDeleteMirror PendingActionType = "DeleteMirror"
+	// PendingActionRecreateMirror deletes a mirror stream and creates it again to change its filter subject, the
+	// events it mirrored are lost, and the ones still in the stream of the events are mirrored again.
+	PendingActionRecreateMirror PendingActionType = "RecreateMirror"
+)
+
+// PendingAction is a destructive action of a plan.
+type PendingAction struct {
+	// Type is the type of the action.
+	Type PendingActionType `json:"type" protobuf:"bytes,1,opt,name=type,casttype=PendingActionType"`
+	// Target is the object the action applies to, "<kind>/<name>".
+	Target string `json:"target" protobuf:"bytes,2,opt,name=target"`
+	// Description describes the action, and the spec change requiring it.
+	Description string `json:"description" protobuf:"bytes,3,opt,name=description"`
+	// DataLoss is whether the action deletes the data of the streams, the replicated streams are restored from
+	// the other replicas when the volumes are recreated one replica at a time.
+	// +optional
+	DataLoss bool `json:"dataLoss,omitempty" protobuf:"varint,4,opt,name=dataLoss"`
+}
+
+// Ordering is the ordering guarantee of the events delivered to the Sensors by an EventBus
+type Ordering string
+
+const (
+	// OrderingPerSubject delivers the events of each event of an EventSource in the order they are published
+	OrderingPerSubject Ordering = "PerSubject"
+	// OrderingNone does not guarantee the order, the redeliveries can overtake the later events
+	OrderingNone Ordering = "None"
+)
+
+// IsOrdered returns whether the events of each event of an EventSource are delivered in order
+func (o Ordering) IsOrdered() bool {
+	return o == OrderingPerSubject
 }
 
 // BusConfig has the finalized configuration for EventBus
@@ -59,6 +211,40 @@ type BusConfig struct {
 	JetStream *JetStreamConfig `json:"jetstream,omitempty" protobuf:"bytes,2,opt,name=jetstream"`
 	// +optional
 	Kafka *KafkaBus `json:"kafka,omitempty" protobuf:"bytes,3,opt,name=kafka"`
+	// Format is the format of the CloudEvents published to the EventBus
+	// +optional
+	Format EventFormat `json:"format,omitempty" protobuf:"bytes,4,opt,name=format,casttype=EventFormat"`
+}
+
+// GetOrdering returns the ordering guarantee of the configured EventBus to the Sensors requiring ordering, empty
+// if it is not configured. NATS Streaming redelivers the unacknowledged events after the later ones. The JetStream
+// consumers of these Sensors have one unacknowledged event at a time, so the events of each subject are delivered
+// in order, and the Kafka Sensors consume the partitions sequentially, so the events of each key are delivered in
+// order, the key being the event of an EventSource.
+func (c BusConfig) GetOrdering() Ordering {
+	switch {
+	case c.NATS != nil:
+		return OrderingNone
+	case c.JetStream != nil, c.Kafka != nil:
+		return OrderingPerSubject
+	default:
+		return ""
+	}
+}
+
+// GetOrdering returns the ordering guarantee of the EventBus, from its spec if it is not configured yet
+func (e *EventBus) GetOrdering() Ordering {
+	if ordering := e.Status.Config.GetOrdering(); ordering != "" {
+		return ordering
+	}
+	switch {
+	case e.Spec.NATS != nil:
+		return OrderingNone
+	case e.Spec.JetStream != nil, e.Spec.JetStreamExotic != nil, e.Spec.Shared != nil, e.Spec.Kafka != nil:
+		return OrderingPerSubject
+	default:
+		return ""
+	}
 }
 
 const (
@@ -68,6 +254,11 @@ const (
 	// EventBusConditionConfigured has the status True when the EventBus
 	// has its configuration ready.
 	EventBusConditionConfigured common.ConditionType = "Configured"
+	// EventBusConditionStorageWithinBudget has the status True when the
+	// storage usage of the EventBus is under the warning watermark. It is
+	// only set when the EventBus has a storage budget, and does not affect
+	// the readiness of the EventBus.
+	EventBusConditionStorageWithinBudget common.ConditionType = "StorageWithinBudget"
 )
 
 // InitConditions sets conditions to Unknown state.
@@ -75,6 +266,19 @@ func (s *EventBusStatus) InitConditions() {
 	s.InitializeConditions(EventBusConditionDeployed, EventBusConditionConfigured)
 }
 
+// IsReady returns true when all the conditions are true, except the storage budget which is only a warning.
+func (s *EventBusStatus) IsReady() bool {
+	if len(s.Conditions) == 0 {
+		return false
+	}
+	for _, c := range s.Conditions {
+		if c.Type != EventBusConditionStorageWithinBudget && !c.IsTrue() {
+			return false
+		}
+	}
+	return true
+}
+
 // MarkDeployed set the bus has been deployed.
 func (s *EventBusStatus) MarkDeployed(reason, message string) {
 	s.MarkTrueWithReason(EventBusConditionDeployed, reason, message)
@@ -99,3 +303,29 @@ func (s *EventBusStatus) MarkConfigured() {
 func (s *EventBusStatus) MarkNotConfigured(reason, message string) {
 	s.MarkFalse(EventBusConditionConfigured, reason, message)
 }
+
+// MarkStorageWithinBudget set the storage usage of the bus is under the warning watermark.
+func (s *EventBusStatus) MarkStorageWithinBudget(reason, message string) {
+	s.MarkTrueWithReason(EventBusConditionStorageWithinBudget, reason, message)
+}
+
+// MarkStorageOverBudget set the storage usage of the bus is over a watermark.
+func (s *EventBusStatus) MarkStorageOverBudget(reason, message string) {
+	s.MarkFalse(EventBusConditionStorageWithinBudget, reason, message)
+}
+
+// MarkStorageBudgetUnknown set the storage usage of the bus is unknown.
+func (s *EventBusStatus) MarkStorageBudgetUnknown(reason, message string) {
+	s.MarkUnknown(EventBusConditionStorageWithinBudget, reason, message)
+}
+
+// ClearStorageBudget removes the storage budget condition, when the bus does not have a storage budget anymore.
+func (s *EventBusStatus) ClearStorageBudget() {
+	conditions := []common.Condition{}
+	for _, c := range s.Conditions {
+		if c.Type != EventBusConditionStorageWithinBudget {
+			conditions = append(conditions, c)
+		}
+	}
+	s.Conditions = conditions
+}
@@ -54,6 +54,18 @@ func TestEventBusStatusIsReady(t *testing.T) {
 			}(),
 			expect: true,
 		},
+		{
+			name: "storage over budget",
+			s: func() *EventBusStatus {
+				s := &EventBusStatus{}
+				s.InitConditions()
+				s.MarkDeployed("test", "test")
+				s.MarkConfigured()
+				s.MarkStorageOverBudget("WarningWatermark", "test")
+				return s
+			}(),
+			expect: true,
+		},
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
@@ -167,3 +179,37 @@ func TestEventBusStatusGetCondition(t *testing.T) {
 		})
 	}
 }
+
+func TestGetOrdering(t *testing.T) {
+	eventBus := &EventBus{}
+	if got := eventBus.GetOrdering(); got != "" {
+		t.Errorf("unexpected ordering %q", got)
+	}
+	eventBus.Spec.NATS = &NATSBus{}
+	if got := eventBus.GetOrdering(); got != OrderingNone || got.IsOrdered() {
+		t.Errorf("unexpected ordering %q", got)
+	}
+	// The finalized configuration prevails over the spec
+	eventBus.Status.Config.JetStream = &JetStreamConfig{}
+	if got := eventBus.GetOrdering(); got != OrderingPerSubject || !got.IsOrdered() {
+		t.Errorf("unexpected ordering %q", got)
+	}
+	if got := (BusConfig{Kafka: &KafkaBus{}}).GetOrdering(); got != OrderingPerSubject {
+		t.Errorf("unexpected ordering %q", got)
+	}
+}
+
+func TestJetStreamTenancyIsAllowed(t *testing.T) {
+	tenancy := &JetStreamTenancy{}
+	if tenancy.IsAllowed("a") {
+		t.Error("no namespace should be allowed by default")
+	}
+	tenancy.AllowedNamespaces = []string{"a"}
+	if !tenancy.IsAllowed("a") || tenancy.IsAllowed("b") {
+		t.Error("only the listed namespaces should be allowed")
+	}
+	tenancy.AllowedNamespaces = []string{"*"}
+	if !tenancy.IsAllowed("b") {
+		t.Error("all the namespaces should be allowed by a wildcard")
+	}
+}
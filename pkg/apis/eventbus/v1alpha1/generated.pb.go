@@ -28,7 +28,7 @@ import (
 	proto "github.com/gogo/protobuf/proto"
 	github_com_gogo_protobuf_sortkeys "github.com/gogo/protobuf/sortkeys"
 	k8s_io_api_core_v1 "k8s.io/api/core/v1"
-	v1 "k8s.io/api/core/v1"
+	v11 "k8s.io/api/core/v1"
 	resource "k8s.io/apimachinery/pkg/api/resource"
 
 	math "math"
@@ -48,10 +48,38 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+func (m *ActionPlan) Reset()      { *m = ActionPlan{} }
+func (*ActionPlan) ProtoMessage() {}
+func (*ActionPlan) Descriptor() ([]byte, []int) {
+	return fileDescriptor_871e47633eb7aad4, []int{0}
+}
+func (m *ActionPlan) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ActionPlan) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ActionPlan) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ActionPlan.Merge(m, src)
+}
+func (m *ActionPlan) XXX_Size() int {
+	return m.Size()
+}
+func (m *ActionPlan) XXX_DiscardUnknown() {
+	xxx_messageInfo_ActionPlan.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ActionPlan proto.InternalMessageInfo
+
 func (m *BusConfig) Reset()      { *m = BusConfig{} }
 func (*BusConfig) ProtoMessage() {}
 func (*BusConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_871e47633eb7aad4, []int{0}
+	return fileDescriptor_871e47633eb7aad4, []int{1}
 }
 func (m *BusConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -79,7 +107,7 @@ var xxx_messageInfo_BusConfig proto.InternalMessageInfo
 func (m *ContainerTemplate) Reset()      { *m = ContainerTemplate{} }
 func (*ContainerTemplate) ProtoMessage() {}
 func (*ContainerTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_871e47633eb7aad4, []int{1}
+	return fileDescriptor_871e47633eb7aad4, []int{2}
 }
 func (m *ContainerTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -107,7 +135,7 @@ var xxx_messageInfo_ContainerTemplate proto.InternalMessageInfo
 func (m *EventBus) Reset()      { *m = EventBus{} }
 func (*EventBus) ProtoMessage() {}
 func (*EventBus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_871e47633eb7aad4, []int{2}
+	return fileDescriptor_871e47633eb7aad4, []int{3}
 }
 func (m *EventBus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -135,7 +163,7 @@ var xxx_messageInfo_EventBus proto.InternalMessageInfo
 func (m *EventBusList) Reset()      { *m = EventBusList{} }
 func (*EventBusList) ProtoMessage() {}
 func (*EventBusList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_871e47633eb7aad4, []int{3}
+	return fileDescriptor_871e47633eb7aad4, []int{4}
 }
 func (m *EventBusList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -160,10 +188,38 @@ func (m *EventBusList) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_EventBusList proto.InternalMessageInfo
 
+func (m *EventBusSeed) Reset()      { *m = EventBusSeed{} }
+func (*EventBusSeed) ProtoMessage() {}
+func (*EventBusSeed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_871e47633eb7aad4, []int{5}
+}
+func (m *EventBusSeed) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventBusSeed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *EventBusSeed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventBusSeed.Merge(m, src)
+}
+func (m *EventBusSeed) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventBusSeed) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventBusSeed.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventBusSeed proto.InternalMessageInfo
+
 func (m *EventBusSpec) Reset()      { *m = EventBusSpec{} }
 func (*EventBusSpec) ProtoMessage() {}
 func (*EventBusSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_871e47633eb7aad4, []int{4}
+	return fileDescriptor_871e47633eb7aad4, []int{6}
 }
 func (m *EventBusSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -191,7 +247,7 @@ var xxx_messageInfo_EventBusSpec proto.InternalMessageInfo
 func (m *EventBusStatus) Reset()      { *m = EventBusStatus{} }
 func (*EventBusStatus) ProtoMessage() {}
 func (*EventBusStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_871e47633eb7aad4, []int{5}
+	return fileDescriptor_871e47633eb7aad4, []int{7}
 }
 func (m *EventBusStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -216,10 +272,38 @@ func (m *EventBusStatus) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_EventBusStatus proto.InternalMessageInfo
 
+func (m *JetStreamAccountLimits) Reset()      { *m = JetStreamAccountLimits{} }
+func (*JetStreamAccountLimits) ProtoMessage() {}
+func (*JetStreamAccountLimits) Descriptor() ([]byte, []int) {
+	return fileDescriptor_871e47633eb7aad4, []int{8}
+}
+func (m *JetStreamAccountLimits) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JetStreamAccountLimits) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *JetStreamAccountLimits) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JetStreamAccountLimits.Merge(m, src)
+}
+func (m *JetStreamAccountLimits) XXX_Size() int {
+	return m.Size()
+}
+func (m *JetStreamAccountLimits) XXX_DiscardUnknown() {
+	xxx_messageInfo_JetStreamAccountLimits.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JetStreamAccountLimits proto.InternalMessageInfo
+
 func (m *JetStreamBus) Reset()      { *m = JetStreamBus{} }
 func (*JetStreamBus) ProtoMessage() {}
 func (*JetStreamBus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_871e47633eb7aad4, []int{6}
+	return fileDescriptor_871e47633eb7aad4, []int{9}
 }
 func (m *JetStreamBus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -247,7 +331,7 @@ var xxx_messageInfo_JetStreamBus proto.InternalMessageInfo
 func (m *JetStreamConfig) Reset()      { *m = JetStreamConfig{} }
 func (*JetStreamConfig) ProtoMessage() {}
 func (*JetStreamConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_871e47633eb7aad4, []int{7}
+	return fileDescriptor_871e47633eb7aad4, []int{10}
 }
 func (m *JetStreamConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -272,10 +356,94 @@ func (m *JetStreamConfig) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_JetStreamConfig proto.InternalMessageInfo
 
+func (m *JetStreamMirror) Reset()      { *m = JetStreamMirror{} }
+func (*JetStreamMirror) ProtoMessage() {}
+func (*JetStreamMirror) Descriptor() ([]byte, []int) {
+	return fileDescriptor_871e47633eb7aad4, []int{11}
+}
+func (m *JetStreamMirror) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JetStreamMirror) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *JetStreamMirror) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JetStreamMirror.Merge(m, src)
+}
+func (m *JetStreamMirror) XXX_Size() int {
+	return m.Size()
+}
+func (m *JetStreamMirror) XXX_DiscardUnknown() {
+	xxx_messageInfo_JetStreamMirror.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JetStreamMirror proto.InternalMessageInfo
+
+func (m *JetStreamStorageBudget) Reset()      { *m = JetStreamStorageBudget{} }
+func (*JetStreamStorageBudget) ProtoMessage() {}
+func (*JetStreamStorageBudget) Descriptor() ([]byte, []int) {
+	return fileDescriptor_871e47633eb7aad4, []int{12}
+}
+func (m *JetStreamStorageBudget) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JetStreamStorageBudget) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *JetStreamStorageBudget) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JetStreamStorageBudget.Merge(m, src)
+}
+func (m *JetStreamStorageBudget) XXX_Size() int {
+	return m.Size()
+}
+func (m *JetStreamStorageBudget) XXX_DiscardUnknown() {
+	xxx_messageInfo_JetStreamStorageBudget.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JetStreamStorageBudget proto.InternalMessageInfo
+
+func (m *JetStreamTenancy) Reset()      { *m = JetStreamTenancy{} }
+func (*JetStreamTenancy) ProtoMessage() {}
+func (*JetStreamTenancy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_871e47633eb7aad4, []int{13}
+}
+func (m *JetStreamTenancy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JetStreamTenancy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *JetStreamTenancy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JetStreamTenancy.Merge(m, src)
+}
+func (m *JetStreamTenancy) XXX_Size() int {
+	return m.Size()
+}
+func (m *JetStreamTenancy) XXX_DiscardUnknown() {
+	xxx_messageInfo_JetStreamTenancy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JetStreamTenancy proto.InternalMessageInfo
+
 func (m *KafkaBus) Reset()      { *m = KafkaBus{} }
 func (*KafkaBus) ProtoMessage() {}
 func (*KafkaBus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_871e47633eb7aad4, []int{8}
+	return fileDescriptor_871e47633eb7aad4, []int{14}
 }
 func (m *KafkaBus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -303,7 +471,7 @@ var xxx_messageInfo_KafkaBus proto.InternalMessageInfo
 func (m *KafkaConsumerGroup) Reset()      { *m = KafkaConsumerGroup{} }
 func (*KafkaConsumerGroup) ProtoMessage() {}
 func (*KafkaConsumerGroup) Descriptor() ([]byte, []int) {
-	return fileDescriptor_871e47633eb7aad4, []int{9}
+	return fileDescriptor_871e47633eb7aad4, []int{15}
 }
 func (m *KafkaConsumerGroup) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -328,10 +496,66 @@ func (m *KafkaConsumerGroup) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_KafkaConsumerGroup proto.InternalMessageInfo
 
+func (m *KafkaMirror) Reset()      { *m = KafkaMirror{} }
+func (*KafkaMirror) ProtoMessage() {}
+func (*KafkaMirror) Descriptor() ([]byte, []int) {
+	return fileDescriptor_871e47633eb7aad4, []int{16}
+}
+func (m *KafkaMirror) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *KafkaMirror) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *KafkaMirror) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KafkaMirror.Merge(m, src)
+}
+func (m *KafkaMirror) XXX_Size() int {
+	return m.Size()
+}
+func (m *KafkaMirror) XXX_DiscardUnknown() {
+	xxx_messageInfo_KafkaMirror.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_KafkaMirror proto.InternalMessageInfo
+
+func (m *KafkaTopics) Reset()      { *m = KafkaTopics{} }
+func (*KafkaTopics) ProtoMessage() {}
+func (*KafkaTopics) Descriptor() ([]byte, []int) {
+	return fileDescriptor_871e47633eb7aad4, []int{17}
+}
+func (m *KafkaTopics) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *KafkaTopics) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *KafkaTopics) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KafkaTopics.Merge(m, src)
+}
+func (m *KafkaTopics) XXX_Size() int {
+	return m.Size()
+}
+func (m *KafkaTopics) XXX_DiscardUnknown() {
+	xxx_messageInfo_KafkaTopics.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_KafkaTopics proto.InternalMessageInfo
+
 func (m *NATSBus) Reset()      { *m = NATSBus{} }
 func (*NATSBus) ProtoMessage() {}
 func (*NATSBus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_871e47633eb7aad4, []int{10}
+	return fileDescriptor_871e47633eb7aad4, []int{18}
 }
 func (m *NATSBus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -359,7 +583,7 @@ var xxx_messageInfo_NATSBus proto.InternalMessageInfo
 func (m *NATSConfig) Reset()      { *m = NATSConfig{} }
 func (*NATSConfig) ProtoMessage() {}
 func (*NATSConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_871e47633eb7aad4, []int{11}
+	return fileDescriptor_871e47633eb7aad4, []int{19}
 }
 func (m *NATSConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -387,7 +611,7 @@ var xxx_messageInfo_NATSConfig proto.InternalMessageInfo
 func (m *NativeStrategy) Reset()      { *m = NativeStrategy{} }
 func (*NativeStrategy) ProtoMessage() {}
 func (*NativeStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_871e47633eb7aad4, []int{12}
+	return fileDescriptor_871e47633eb7aad4, []int{20}
 }
 func (m *NativeStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -412,10 +636,38 @@ func (m *NativeStrategy) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_NativeStrategy proto.InternalMessageInfo
 
+func (m *PendingAction) Reset()      { *m = PendingAction{} }
+func (*PendingAction) ProtoMessage() {}
+func (*PendingAction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_871e47633eb7aad4, []int{21}
+}
+func (m *PendingAction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingAction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *PendingAction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingAction.Merge(m, src)
+}
+func (m *PendingAction) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingAction) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingAction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingAction proto.InternalMessageInfo
+
 func (m *PersistenceStrategy) Reset()      { *m = PersistenceStrategy{} }
 func (*PersistenceStrategy) ProtoMessage() {}
 func (*PersistenceStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_871e47633eb7aad4, []int{13}
+	return fileDescriptor_871e47633eb7aad4, []int{22}
 }
 func (m *PersistenceStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -440,23 +692,149 @@ func (m *PersistenceStrategy) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_PersistenceStrategy proto.InternalMessageInfo
 
+func (m *SPIFFEAuth) Reset()      { *m = SPIFFEAuth{} }
+func (*SPIFFEAuth) ProtoMessage() {}
+func (*SPIFFEAuth) Descriptor() ([]byte, []int) {
+	return fileDescriptor_871e47633eb7aad4, []int{23}
+}
+func (m *SPIFFEAuth) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SPIFFEAuth) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *SPIFFEAuth) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SPIFFEAuth.Merge(m, src)
+}
+func (m *SPIFFEAuth) XXX_Size() int {
+	return m.Size()
+}
+func (m *SPIFFEAuth) XXX_DiscardUnknown() {
+	xxx_messageInfo_SPIFFEAuth.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SPIFFEAuth proto.InternalMessageInfo
+
+func (m *SPIFFEConfig) Reset()      { *m = SPIFFEConfig{} }
+func (*SPIFFEConfig) ProtoMessage() {}
+func (*SPIFFEConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_871e47633eb7aad4, []int{24}
+}
+func (m *SPIFFEConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SPIFFEConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *SPIFFEConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SPIFFEConfig.Merge(m, src)
+}
+func (m *SPIFFEConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *SPIFFEConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_SPIFFEConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SPIFFEConfig proto.InternalMessageInfo
+
+func (m *SeedEvent) Reset()      { *m = SeedEvent{} }
+func (*SeedEvent) ProtoMessage() {}
+func (*SeedEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_871e47633eb7aad4, []int{25}
+}
+func (m *SeedEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SeedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *SeedEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SeedEvent.Merge(m, src)
+}
+func (m *SeedEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *SeedEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_SeedEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SeedEvent proto.InternalMessageInfo
+
+func (m *SharedEventBus) Reset()      { *m = SharedEventBus{} }
+func (*SharedEventBus) ProtoMessage() {}
+func (*SharedEventBus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_871e47633eb7aad4, []int{26}
+}
+func (m *SharedEventBus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SharedEventBus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *SharedEventBus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SharedEventBus.Merge(m, src)
+}
+func (m *SharedEventBus) XXX_Size() int {
+	return m.Size()
+}
+func (m *SharedEventBus) XXX_DiscardUnknown() {
+	xxx_messageInfo_SharedEventBus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SharedEventBus proto.InternalMessageInfo
+
 func init() {
+	proto.RegisterType((*ActionPlan)(nil), "github.com.argoproj.argo_events.pkg.apis.eventbus.v1alpha1.ActionPlan")
 	proto.RegisterType((*BusConfig)(nil), "github.com.argoproj.argo_events.pkg.apis.eventbus.v1alpha1.BusConfig")
 	proto.RegisterType((*ContainerTemplate)(nil), "github.com.argoproj.argo_events.pkg.apis.eventbus.v1alpha1.ContainerTemplate")
 	proto.RegisterType((*EventBus)(nil), "github.com.argoproj.argo_events.pkg.apis.eventbus.v1alpha1.EventBus")
 	proto.RegisterType((*EventBusList)(nil), "github.com.argoproj.argo_events.pkg.apis.eventbus.v1alpha1.EventBusList")
+	proto.RegisterType((*EventBusSeed)(nil), "github.com.argoproj.argo_events.pkg.apis.eventbus.v1alpha1.EventBusSeed")
 	proto.RegisterType((*EventBusSpec)(nil), "github.com.argoproj.argo_events.pkg.apis.eventbus.v1alpha1.EventBusSpec")
 	proto.RegisterType((*EventBusStatus)(nil), "github.com.argoproj.argo_events.pkg.apis.eventbus.v1alpha1.EventBusStatus")
+	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_events.pkg.apis.eventbus.v1alpha1.EventBusStatus.TightenedStreamsEntry")
+	proto.RegisterType((*JetStreamAccountLimits)(nil), "github.com.argoproj.argo_events.pkg.apis.eventbus.v1alpha1.JetStreamAccountLimits")
 	proto.RegisterType((*JetStreamBus)(nil), "github.com.argoproj.argo_events.pkg.apis.eventbus.v1alpha1.JetStreamBus")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_events.pkg.apis.eventbus.v1alpha1.JetStreamBus.NodeSelectorEntry")
 	proto.RegisterType((*JetStreamConfig)(nil), "github.com.argoproj.argo_events.pkg.apis.eventbus.v1alpha1.JetStreamConfig")
+	proto.RegisterType((*JetStreamMirror)(nil), "github.com.argoproj.argo_events.pkg.apis.eventbus.v1alpha1.JetStreamMirror")
+	proto.RegisterType((*JetStreamStorageBudget)(nil), "github.com.argoproj.argo_events.pkg.apis.eventbus.v1alpha1.JetStreamStorageBudget")
+	proto.RegisterType((*JetStreamTenancy)(nil), "github.com.argoproj.argo_events.pkg.apis.eventbus.v1alpha1.JetStreamTenancy")
 	proto.RegisterType((*KafkaBus)(nil), "github.com.argoproj.argo_events.pkg.apis.eventbus.v1alpha1.KafkaBus")
 	proto.RegisterType((*KafkaConsumerGroup)(nil), "github.com.argoproj.argo_events.pkg.apis.eventbus.v1alpha1.KafkaConsumerGroup")
+	proto.RegisterType((*KafkaMirror)(nil), "github.com.argoproj.argo_events.pkg.apis.eventbus.v1alpha1.KafkaMirror")
+	proto.RegisterType((*KafkaTopics)(nil), "github.com.argoproj.argo_events.pkg.apis.eventbus.v1alpha1.KafkaTopics")
 	proto.RegisterType((*NATSBus)(nil), "github.com.argoproj.argo_events.pkg.apis.eventbus.v1alpha1.NATSBus")
 	proto.RegisterType((*NATSConfig)(nil), "github.com.argoproj.argo_events.pkg.apis.eventbus.v1alpha1.NATSConfig")
 	proto.RegisterType((*NativeStrategy)(nil), "github.com.argoproj.argo_events.pkg.apis.eventbus.v1alpha1.NativeStrategy")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_events.pkg.apis.eventbus.v1alpha1.NativeStrategy.NodeSelectorEntry")
+	proto.RegisterType((*PendingAction)(nil), "github.com.argoproj.argo_events.pkg.apis.eventbus.v1alpha1.PendingAction")
 	proto.RegisterType((*PersistenceStrategy)(nil), "github.com.argoproj.argo_events.pkg.apis.eventbus.v1alpha1.PersistenceStrategy")
+	proto.RegisterType((*SPIFFEAuth)(nil), "github.com.argoproj.argo_events.pkg.apis.eventbus.v1alpha1.SPIFFEAuth")
+	proto.RegisterType((*SPIFFEConfig)(nil), "github.com.argoproj.argo_events.pkg.apis.eventbus.v1alpha1.SPIFFEConfig")
+	proto.RegisterType((*SeedEvent)(nil), "github.com.argoproj.argo_events.pkg.apis.eventbus.v1alpha1.SeedEvent")
+	proto.RegisterType((*SharedEventBus)(nil), "github.com.argoproj.argo_events.pkg.apis.eventbus.v1alpha1.SharedEventBus")
 }
 
 func init() {
@@ -464,138 +842,213 @@ func init() {
 }
 
 var fileDescriptor_871e47633eb7aad4 = []byte{
-	// 2037 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x59, 0x4f, 0x6f, 0x1b, 0xc7,
-	0x15, 0xd7, 0x8a, 0x94, 0x44, 0x0e, 0x29, 0x51, 0x1c, 0x29, 0xcd, 0x5a, 0x88, 0x49, 0x83, 0x41,
-	0x0a, 0x17, 0x89, 0x97, 0x75, 0x91, 0xb6, 0xae, 0x7b, 0x70, 0xb9, 0x8a, 0x62, 0xcb, 0x16, 0x65,
-	0x75, 0x28, 0x1b, 0x48, 0x1a, 0xd4, 0x19, 0xad, 0x46, 0xd4, 0x4a, 0xfb, 0x87, 0xdd, 0x99, 0x25,
-	0xc4, 0x9e, 0x8a, 0xf6, 0x50, 0xa0, 0xa7, 0xa0, 0x28, 0x8a, 0x7e, 0x83, 0x02, 0xbd, 0xb7, 0xdf,
-	0xa0, 0xa8, 0x0f, 0x3d, 0x04, 0xbd, 0x34, 0x87, 0x82, 0x88, 0x19, 0xf4, 0x4b, 0xf8, 0x54, 0xcc,
-	0xec, 0xec, 0x1f, 0xee, 0x52, 0xb1, 0x64, 0xd2, 0x35, 0x72, 0xdb, 0x79, 0xef, 0xcd, 0xef, 0xbd,
-	0x37, 0x7f, 0xde, 0xfb, 0x0d, 0x09, 0xee, 0x77, 0x4d, 0x76, 0xec, 0x1f, 0x68, 0x86, 0x6b, 0x37,
-	0xb1, 0xd7, 0x75, 0x7b, 0x9e, 0x7b, 0x22, 0x3e, 0x6e, 0x90, 0x3e, 0x71, 0x18, 0x6d, 0xf6, 0x4e,
-	0xbb, 0x4d, 0xdc, 0x33, 0x69, 0x53, 0x8c, 0x0f, 0x7c, 0xda, 0xec, 0xdf, 0xc4, 0x56, 0xef, 0x18,
-	0xdf, 0x6c, 0x76, 0x89, 0x43, 0x3c, 0xcc, 0xc8, 0xa1, 0xd6, 0xf3, 0x5c, 0xe6, 0xc2, 0xdb, 0x31,
-	0x96, 0x16, 0x62, 0x89, 0x8f, 0x27, 0x01, 0x96, 0xd6, 0x3b, 0xed, 0x6a, 0x1c, 0x4b, 0x0b, 0xb1,
-	0xb4, 0x10, 0x6b, 0xe3, 0xce, 0x85, 0xe3, 0x30, 0x5c, 0xdb, 0x76, 0x9d, 0xb4, 0xf3, 0x8d, 0x1b,
-	0x09, 0x80, 0xae, 0xdb, 0x75, 0x9b, 0x42, 0x7c, 0xe0, 0x1f, 0x89, 0x91, 0x18, 0x88, 0x2f, 0x69,
-	0xde, 0x38, 0xbd, 0x45, 0x35, 0xd3, 0xe5, 0x90, 0x4d, 0xc3, 0xf5, 0x48, 0xb3, 0x9f, 0xc9, 0x67,
-	0xe3, 0xfd, 0xd8, 0xc6, 0xc6, 0xc6, 0xb1, 0xe9, 0x10, 0x6f, 0x10, 0xc6, 0xd1, 0xf4, 0x08, 0x75,
-	0x7d, 0xcf, 0x20, 0x97, 0x9a, 0x45, 0x9b, 0x36, 0x61, 0x78, 0x92, 0xaf, 0xe6, 0x79, 0xb3, 0x3c,
-	0xdf, 0x61, 0xa6, 0x9d, 0x75, 0xf3, 0x83, 0x17, 0x4d, 0xa0, 0xc6, 0x31, 0xb1, 0x71, 0x7a, 0x5e,
-	0xe3, 0x5f, 0xf3, 0xa0, 0xa8, 0xfb, 0x74, 0xd3, 0x75, 0x8e, 0xcc, 0x2e, 0x3c, 0x04, 0x79, 0x07,
-	0x33, 0xaa, 0x2a, 0xd7, 0x94, 0xeb, 0xa5, 0xef, 0x7d, 0xa8, 0xbd, 0xfc, 0x0e, 0x6a, 0xbb, 0xad,
-	0xfd, 0x4e, 0x80, 0xaa, 0x17, 0x46, 0xc3, 0x7a, 0x9e, 0x8f, 0x91, 0x40, 0x87, 0x67, 0xa0, 0x78,
-	0x42, 0x18, 0x65, 0x1e, 0xc1, 0xb6, 0x3a, 0x2f, 0x5c, 0x3d, 0x98, 0xc6, 0xd5, 0x7d, 0xc2, 0x3a,
-	0x02, 0x4c, 0xfa, 0x5b, 0x1e, 0x0d, 0xeb, 0xc5, 0x48, 0x88, 0x62, 0x67, 0x90, 0x80, 0x85, 0x53,
-	0x7c, 0x74, 0x8a, 0xd5, 0x9c, 0xf0, 0xfa, 0xc1, 0x34, 0x5e, 0x1f, 0x70, 0x20, 0xdd, 0xa7, 0x7a,
-	0x71, 0x34, 0xac, 0x2f, 0x88, 0x11, 0x0a, 0xd0, 0x1b, 0x7f, 0x9b, 0x07, 0xd5, 0x4d, 0xd7, 0x61,
-	0x98, 0x6f, 0xc3, 0x3e, 0xb1, 0x7b, 0x16, 0x66, 0x04, 0x7e, 0x04, 0x8a, 0xe1, 0x29, 0x09, 0x57,
-	0xf8, 0xba, 0x16, 0x6c, 0x1b, 0xf7, 0xa1, 0xf1, 0x73, 0xa7, 0xf5, 0x6f, 0x6a, 0x48, 0x1a, 0x21,
-	0xf2, 0x0b, 0xdf, 0xf4, 0x88, 0xcd, 0x03, 0xd1, 0xab, 0x4f, 0x87, 0xf5, 0x39, 0x9e, 0x57, 0xa8,
-	0xa5, 0x28, 0x46, 0x83, 0x07, 0xa0, 0x62, 0xda, 0xb8, 0x4b, 0xf6, 0x7c, 0xcb, 0xda, 0x73, 0x2d,
-	0xd3, 0x18, 0x88, 0x75, 0x2d, 0xea, 0xb7, 0xe4, 0xb4, 0xca, 0xf6, 0xb8, 0xfa, 0xf9, 0xb0, 0x7e,
-	0x35, 0x7b, 0xe4, 0xb5, 0xd8, 0x00, 0xa5, 0x01, 0xb9, 0x0f, 0x4a, 0x0c, 0xdf, 0x33, 0xd9, 0x80,
-	0xe7, 0x46, 0xce, 0x98, 0x5c, 0xc5, 0xb7, 0x27, 0x25, 0xd1, 0x19, 0x37, 0xd5, 0xd7, 0x78, 0x10,
-	0x29, 0x21, 0x4a, 0x03, 0x36, 0xfe, 0x39, 0x0f, 0x0a, 0x5b, 0x7c, 0xa5, 0x75, 0x9f, 0xc2, 0x4f,
-	0x41, 0x81, 0x5f, 0x8f, 0x43, 0xcc, 0xb0, 0x5c, 0xae, 0xef, 0x26, 0x3c, 0x45, 0xa7, 0x3c, 0xde,
-	0x23, 0x6e, 0xcd, 0x7d, 0x3f, 0x3c, 0x38, 0x21, 0x06, 0x6b, 0x13, 0x86, 0x75, 0x28, 0xf3, 0x07,
-	0xb1, 0x0c, 0x45, 0xa8, 0xf0, 0x04, 0xe4, 0x69, 0x8f, 0x18, 0xf2, 0x0c, 0xde, 0x9b, 0xe6, 0x34,
-	0x84, 0x51, 0x77, 0x7a, 0xc4, 0xd0, 0xcb, 0xd2, 0x6b, 0x9e, 0x8f, 0x90, 0xf0, 0x01, 0x3d, 0xb0,
-	0x48, 0x19, 0x66, 0x3e, 0x95, 0xab, 0x76, 0x7f, 0x26, 0xde, 0x04, 0xa2, 0xbe, 0x22, 0xfd, 0x2d,
-	0x06, 0x63, 0x24, 0x3d, 0x35, 0xfe, 0xad, 0x80, 0x72, 0x68, 0xba, 0x63, 0x52, 0x06, 0x3f, 0xc9,
-	0x2c, 0xa9, 0x76, 0xb1, 0x25, 0xe5, 0xb3, 0xc5, 0x82, 0xae, 0x4a, 0x57, 0x85, 0x50, 0x92, 0x58,
-	0x4e, 0x13, 0x2c, 0x98, 0x8c, 0xd8, 0x54, 0x9d, 0xbf, 0x96, 0x9b, 0xf6, 0x76, 0x85, 0x61, 0xeb,
-	0xcb, 0xd2, 0xe1, 0xc2, 0x36, 0x87, 0x46, 0x81, 0x87, 0xc6, 0x7f, 0x72, 0x71, 0x66, 0x7c, 0x91,
-	0x21, 0x1e, 0xab, 0x5c, 0x9b, 0xd3, 0x56, 0x2e, 0xee, 0x39, 0x5d, 0xb6, 0xfc, 0x6c, 0xd9, 0xba,
-	0x37, 0x93, 0xb2, 0x25, 0xd2, 0x7c, 0xcd, 0x35, 0x0b, 0xfe, 0x4e, 0x01, 0x95, 0xc8, 0xe9, 0xd6,
-	0x99, 0xcb, 0x4c, 0x43, 0xcd, 0xcf, 0xbe, 0x36, 0x8b, 0x3a, 0x10, 0x09, 0x03, 0x3f, 0x28, 0xed,
-	0xb8, 0xf1, 0xa5, 0x02, 0x56, 0xc6, 0xcf, 0x38, 0x7c, 0x12, 0xdd, 0x9f, 0x60, 0x8b, 0x7f, 0x78,
-	0xf1, 0xa8, 0x02, 0x8a, 0xa0, 0x7d, 0xfd, 0x65, 0x81, 0x36, 0x58, 0x34, 0x44, 0x8c, 0x72, 0x6f,
-	0xb7, 0xa6, 0x49, 0x3b, 0x6a, 0xa9, 0xb1, 0xbb, 0x60, 0x8c, 0xa4, 0x93, 0xc6, 0x6f, 0x56, 0x40,
-	0x39, 0x79, 0x02, 0xe0, 0x77, 0xc0, 0x52, 0x9f, 0x78, 0xd4, 0x74, 0x1d, 0x91, 0x61, 0x51, 0xaf,
-	0xc8, 0x99, 0x4b, 0x8f, 0x03, 0x31, 0x0a, 0xf5, 0xf0, 0x3a, 0x28, 0x78, 0xa4, 0x67, 0x99, 0x06,
-	0xa6, 0x22, 0xd8, 0x05, 0xbd, 0xcc, 0xaf, 0x24, 0x92, 0x32, 0x14, 0x69, 0xe1, 0xef, 0x15, 0x50,
-	0x35, 0xd2, 0x9d, 0x48, 0x9e, 0xa4, 0xf6, 0x34, 0x09, 0x66, 0xda, 0x9b, 0xfe, 0xc6, 0x68, 0x58,
-	0xcf, 0x76, 0x3d, 0x94, 0x75, 0x0f, 0xff, 0xa2, 0x80, 0x2b, 0x1e, 0xb1, 0x5c, 0x7c, 0x48, 0xbc,
-	0xcc, 0x04, 0x79, 0xe8, 0x66, 0x1c, 0xdc, 0xd5, 0xd1, 0xb0, 0x7e, 0x05, 0x9d, 0xe7, 0x13, 0x9d,
-	0x1f, 0x0e, 0xfc, 0xb3, 0x02, 0x54, 0x9b, 0x30, 0xcf, 0x34, 0x68, 0x36, 0xd6, 0x85, 0x57, 0x11,
-	0xeb, 0x5b, 0xa3, 0x61, 0x5d, 0x6d, 0x9f, 0xe3, 0x12, 0x9d, 0x1b, 0x0c, 0xfc, 0xb5, 0x02, 0x4a,
-	0x3d, 0x7e, 0x42, 0x28, 0x23, 0x8e, 0x41, 0xd4, 0x45, 0x11, 0xdc, 0xc3, 0x69, 0x82, 0xdb, 0x8b,
-	0xe1, 0x3a, 0x8c, 0xd3, 0xc6, 0xee, 0x40, 0xaf, 0x8c, 0x86, 0xf5, 0x52, 0x42, 0x81, 0x92, 0x4e,
-	0xa1, 0x91, 0xe8, 0x30, 0x4b, 0x22, 0x80, 0x1f, 0x5d, 0xfa, 0xa2, 0xb6, 0x25, 0x40, 0x70, 0xaa,
-	0xc3, 0x51, 0xa2, 0xd1, 0xfc, 0x41, 0x01, 0x65, 0xc7, 0x3d, 0x24, 0x1d, 0x62, 0x11, 0x83, 0xb9,
-	0x9e, 0x5a, 0x10, 0x0d, 0xe7, 0xe3, 0x59, 0x55, 0x63, 0x6d, 0x37, 0x01, 0xbe, 0xe5, 0x30, 0x6f,
-	0xa0, 0xaf, 0xcb, 0xcb, 0x58, 0x4e, 0xaa, 0xd0, 0x58, 0x14, 0xf0, 0x11, 0x28, 0x31, 0xd7, 0xe2,
-	0xf4, 0xda, 0x74, 0x1d, 0xaa, 0x16, 0x45, 0x50, 0xb5, 0x49, 0xec, 0x68, 0x3f, 0x32, 0xd3, 0xd7,
-	0x24, 0x70, 0x29, 0x96, 0x51, 0x94, 0xc4, 0x81, 0x24, 0x4b, 0xbc, 0x80, 0x58, 0xd9, 0x6f, 0x4f,
-	0x82, 0xde, 0x73, 0x0f, 0x5f, 0x8a, 0x7b, 0x41, 0x07, 0xac, 0x46, 0x94, 0xaf, 0x43, 0x0c, 0x8f,
-	0x30, 0xaa, 0x96, 0x44, 0x0a, 0x13, 0x59, 0xea, 0x8e, 0x6b, 0x60, 0x2b, 0x60, 0x55, 0x88, 0x1c,
-	0x11, 0x8f, 0xef, 0xbe, 0xae, 0xca, 0x64, 0x56, 0xb7, 0x53, 0x48, 0x28, 0x83, 0x0d, 0xef, 0x82,
-	0x6a, 0xcf, 0x33, 0x5d, 0x11, 0x82, 0x85, 0x29, 0xdd, 0xc5, 0x36, 0x51, 0xcb, 0xa2, 0xf2, 0x5d,
-	0x91, 0x30, 0xd5, 0xbd, 0xb4, 0x01, 0xca, 0xce, 0xe1, 0xd5, 0x30, 0x14, 0xaa, 0xcb, 0x71, 0x35,
-	0x0c, 0xe7, 0xa2, 0x48, 0x0b, 0x3f, 0x04, 0x05, 0x7c, 0x74, 0x64, 0x3a, 0xdc, 0x72, 0x45, 0x2c,
-	0xe1, 0x5b, 0x93, 0x52, 0x6b, 0x49, 0x9b, 0x00, 0x27, 0x1c, 0xa1, 0x68, 0x2e, 0xbc, 0x0f, 0x20,
-	0x25, 0x5e, 0xdf, 0x34, 0x48, 0xcb, 0x30, 0x5c, 0xdf, 0x61, 0x22, 0xf6, 0x8a, 0x88, 0x7d, 0x43,
-	0xc6, 0x0e, 0x3b, 0x19, 0x0b, 0x34, 0x61, 0x16, 0x8f, 0x9e, 0x12, 0xc6, 0x4c, 0xa7, 0x4b, 0xd5,
-	0x55, 0x81, 0x20, 0xbc, 0x76, 0xa4, 0x0c, 0x45, 0x5a, 0xf8, 0x2e, 0x28, 0x52, 0x86, 0x3d, 0xd6,
-	0xf2, 0xba, 0x54, 0xad, 0x5e, 0xcb, 0x5d, 0x2f, 0x06, 0xac, 0xa1, 0x13, 0x0a, 0x51, 0xac, 0x87,
-	0xef, 0x83, 0x32, 0x4d, 0xf4, 0x5d, 0x15, 0x0a, 0xe8, 0x55, 0x7e, 0x82, 0x93, 0xfd, 0x18, 0x8d,
-	0x59, 0x41, 0x0d, 0x00, 0x1b, 0x9f, 0xed, 0xe1, 0x01, 0xaf, 0x86, 0xea, 0x9a, 0x98, 0xb3, 0xc2,
-	0xe9, 0x73, 0x3b, 0x92, 0xa2, 0x84, 0xc5, 0xc6, 0x1d, 0x50, 0xcd, 0x5c, 0x15, 0xb8, 0x0a, 0x72,
-	0xa7, 0x64, 0x10, 0x34, 0x31, 0xc4, 0x3f, 0xe1, 0x3a, 0x58, 0xe8, 0x63, 0xcb, 0x27, 0xc1, 0xa3,
-	0x04, 0x05, 0x83, 0xdb, 0xf3, 0xb7, 0x94, 0xc6, 0x3f, 0x14, 0x50, 0x49, 0x51, 0x04, 0x78, 0x15,
-	0xe4, 0x7c, 0xcf, 0x92, 0x4d, 0xb0, 0x24, 0x97, 0x33, 0xf7, 0x08, 0xed, 0x20, 0x2e, 0x87, 0x3f,
-	0x03, 0x65, 0x6c, 0x18, 0x84, 0xd2, 0xe0, 0x20, 0xc9, 0x6e, 0xfd, 0xce, 0x39, 0x8f, 0x10, 0x8f,
-	0xb0, 0x07, 0x64, 0x10, 0x06, 0x18, 0x2c, 0x40, 0x2b, 0x31, 0x1d, 0x8d, 0x81, 0xc1, 0x5b, 0xa9,
-	0x65, 0xcb, 0x89, 0x20, 0xa2, 0xcb, 0x7f, 0xfe, 0xd2, 0x35, 0xfe, 0x9a, 0x03, 0x85, 0x90, 0x5e,
-	0xbd, 0x28, 0x85, 0xb7, 0xc1, 0x02, 0x73, 0x7b, 0xa6, 0x21, 0x1f, 0x69, 0x11, 0xc5, 0xdd, 0xe7,
-	0x42, 0x14, 0xe8, 0x92, 0x7c, 0x20, 0xf7, 0x02, 0x3e, 0xf0, 0x08, 0xe4, 0x98, 0x45, 0x65, 0xe7,
-	0xbc, 0x7d, 0xe9, 0x7a, 0xbb, 0xbf, 0x13, 0xbe, 0xd4, 0x97, 0x78, 0x98, 0xfb, 0x3b, 0x1d, 0xc4,
-	0xf1, 0xe0, 0x47, 0x20, 0x4f, 0x31, 0xb5, 0x64, 0x97, 0xfb, 0xf1, 0xe5, 0x09, 0x57, 0xab, 0xb3,
-	0x93, 0xfc, 0x09, 0x80, 0x8f, 0x91, 0x80, 0x84, 0xbf, 0x55, 0xc0, 0xb2, 0xe1, 0x3a, 0xd4, 0xb7,
-	0x89, 0x77, 0xd7, 0x73, 0xfd, 0x9e, 0xec, 0x56, 0xbb, 0x53, 0xb3, 0xdb, 0xcd, 0x24, 0xaa, 0x5e,
-	0x1d, 0x0d, 0xeb, 0xcb, 0x63, 0x22, 0x34, 0xee, 0xb7, 0xf1, 0x77, 0x05, 0xc0, 0xec, 0x44, 0xd8,
-	0x04, 0xc5, 0x2e, 0xff, 0x10, 0x37, 0x3b, 0xd8, 0xc7, 0xe8, 0x09, 0x7e, 0x37, 0x54, 0xa0, 0xd8,
-	0x86, 0x97, 0x33, 0x8f, 0x1c, 0x60, 0x0b, 0x27, 0x7a, 0xa5, 0xdc, 0xdf, 0xa8, 0x9c, 0xa1, 0xb4,
-	0x01, 0xca, 0xce, 0x81, 0xdf, 0x07, 0x25, 0x71, 0x8d, 0x1f, 0x5a, 0x87, 0x84, 0x06, 0x6f, 0xec,
-	0x42, 0xdc, 0x25, 0x3a, 0xb1, 0x0a, 0x25, 0xed, 0x1a, 0xff, 0x55, 0xc0, 0x92, 0x7c, 0xb9, 0x40,
-	0x07, 0x2c, 0x3a, 0x98, 0x99, 0x7d, 0x22, 0xb9, 0xf2, 0x54, 0x6f, 0xcd, 0x5d, 0x81, 0x14, 0xb5,
-	0x7f, 0xc0, 0xb9, 0x6c, 0x20, 0x43, 0xd2, 0x0b, 0x3c, 0x01, 0x8b, 0x24, 0x78, 0x31, 0xcc, 0xcf,
-	0xf4, 0x87, 0x23, 0xe1, 0x4b, 0xbe, 0x11, 0xa4, 0x87, 0xc6, 0x57, 0x0a, 0x00, 0xb1, 0xc9, 0x8b,
-	0x6e, 0xda, 0xbb, 0xa0, 0x68, 0x58, 0x3e, 0x65, 0xc4, 0xdb, 0xfe, 0x20, 0xbc, 0x6d, 0x7c, 0x0b,
-	0x37, 0x43, 0x21, 0x8a, 0xf5, 0xf0, 0x3d, 0x90, 0xc7, 0x3e, 0x3b, 0x96, 0xd7, 0x4d, 0xe5, 0x47,
-	0xb6, 0xe5, 0xb3, 0xe3, 0xe7, 0xbc, 0x64, 0xf8, 0xec, 0x38, 0xda, 0x34, 0x61, 0x95, 0xa9, 0x43,
-	0xf9, 0x19, 0xd6, 0xa1, 0xc6, 0x67, 0x15, 0xb0, 0x32, 0xbe, 0xf0, 0xf0, 0xbd, 0x04, 0xe9, 0x57,
-	0x44, 0x9b, 0x8b, 0xde, 0xe2, 0x13, 0x88, 0x7f, 0x98, 0xcb, 0xfc, 0x85, 0x72, 0x49, 0x53, 0xc7,
-	0xdc, 0xeb, 0xa0, 0x8e, 0x93, 0xdf, 0x2a, 0xf9, 0xd7, 0xfb, 0x56, 0xf9, 0xe6, 0xd0, 0xff, 0x3f,
-	0xa6, 0x49, 0xf1, 0xa2, 0x20, 0x6f, 0x9f, 0xcc, 0xee, 0xee, 0xcf, 0x86, 0x16, 0x2f, 0xcd, 0x88,
-	0x16, 0x27, 0x5f, 0x1a, 0x85, 0x57, 0xf5, 0xd2, 0x98, 0xc0, 0xbd, 0x8b, 0xaf, 0x80, 0x7b, 0x37,
-	0xc0, 0xa2, 0x8d, 0xcf, 0x5a, 0x5d, 0x22, 0x98, 0x7d, 0x31, 0x28, 0x7c, 0x6d, 0x21, 0x41, 0x52,
-	0xf3, 0x7f, 0xe7, 0xe7, 0x93, 0x49, 0x6e, 0xf9, 0xa5, 0x48, 0xee, 0x44, 0xae, 0xbf, 0x3c, 0x25,
-	0xd7, 0x5f, 0xb9, 0x30, 0xd7, 0xaf, 0x4c, 0xc1, 0xf5, 0xdf, 0x01, 0x4b, 0x36, 0x3e, 0x6b, 0x53,
-	0x49, 0xcf, 0xf3, 0x7a, 0x89, 0x53, 0xb0, 0x76, 0x20, 0x42, 0xa1, 0x8e, 0x07, 0x66, 0xe3, 0x33,
-	0x7d, 0xc0, 0x08, 0xe7, 0xe6, 0x11, 0x8d, 0x6f, 0x4b, 0x19, 0x8a, 0xb4, 0x12, 0xb0, 0xe3, 0x1f,
-	0x50, 0x41, 0xca, 0x63, 0x40, 0x2e, 0x42, 0xa1, 0xee, 0xb2, 0x54, 0x1c, 0xee, 0x80, 0x75, 0x0f,
-	0x1f, 0xb1, 0x7b, 0x04, 0x7b, 0xec, 0x80, 0x60, 0xb6, 0x6f, 0xda, 0xc4, 0xf5, 0x99, 0xba, 0x1e,
-	0x35, 0x80, 0x75, 0x34, 0x41, 0x8f, 0x26, 0xce, 0x82, 0xdb, 0x60, 0x8d, 0xcb, 0xb7, 0xf8, 0x15,
-	0x36, 0x5d, 0x27, 0x04, 0x7b, 0x43, 0x80, 0xbd, 0x39, 0x1a, 0xd6, 0xd7, 0x50, 0x56, 0x8d, 0x26,
-	0xcd, 0x81, 0x3f, 0x01, 0xab, 0x5c, 0xbc, 0x43, 0x30, 0x25, 0x21, 0xce, 0xb7, 0x02, 0x5a, 0xcd,
-	0x4f, 0x22, 0x4a, 0xe9, 0x50, 0xc6, 0x1a, 0x6e, 0x82, 0x2a, 0x97, 0x6d, 0xba, 0xb6, 0x6d, 0x46,
-	0x79, 0xbd, 0x29, 0x20, 0x44, 0x21, 0x47, 0x69, 0x25, 0xca, 0xda, 0x4f, 0xff, 0x54, 0xf9, 0xd3,
-	0x3c, 0x58, 0x9b, 0xd0, 0xd4, 0x78, 0x7e, 0x94, 0xb9, 0x1e, 0xee, 0x92, 0xf8, 0x68, 0x2b, 0x71,
-	0x7e, 0x9d, 0x94, 0x0e, 0x65, 0xac, 0xe1, 0x13, 0x00, 0x82, 0xe6, 0xdf, 0x76, 0x0f, 0xa5, 0x63,
-	0xfd, 0x0e, 0xdf, 0xea, 0x56, 0x24, 0x7d, 0x3e, 0xac, 0xdf, 0x98, 0xf4, 0x7f, 0x4d, 0x18, 0x0f,
-	0x7b, 0xec, 0x5a, 0xbe, 0x4d, 0xe2, 0x09, 0x28, 0x01, 0x09, 0x7f, 0x0e, 0x40, 0x5f, 0xe8, 0x3b,
-	0xe6, 0x2f, 0xc3, 0xe6, 0xfe, 0xb5, 0x3f, 0xfc, 0x6b, 0xe1, 0x5f, 0x4b, 0xda, 0x4f, 0x7d, 0xec,
-	0x30, 0x7e, 0x3f, 0xc4, 0xd9, 0x7b, 0x1c, 0xa1, 0xa0, 0x04, 0xa2, 0xfe, 0xe9, 0xd3, 0x67, 0xb5,
-	0xb9, 0xcf, 0x9f, 0xd5, 0xe6, 0xbe, 0x78, 0x56, 0x9b, 0xfb, 0xd5, 0xa8, 0xa6, 0x3c, 0x1d, 0xd5,
-	0x94, 0xcf, 0x47, 0x35, 0xe5, 0x8b, 0x51, 0x4d, 0xf9, 0x72, 0x54, 0x53, 0x3e, 0xfb, 0xaa, 0x36,
-	0xf7, 0xf1, 0xed, 0x97, 0xff, 0x6f, 0xf9, 0x7f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x3f, 0x06, 0x32,
-	0xa6, 0x98, 0x1e, 0x00, 0x00,
+	// 3233 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x5b, 0xc9, 0x6f, 0x23, 0xc7,
+	0xb9, 0x9f, 0xe6, 0x22, 0x91, 0x45, 0xad, 0x35, 0x8b, 0xdb, 0xf3, 0x3c, 0x92, 0x1e, 0x0d, 0x1b,
+	0x7a, 0xcf, 0x36, 0xf5, 0x3c, 0xb0, 0x5f, 0x26, 0x63, 0x04, 0x0e, 0x29, 0x69, 0xc6, 0x1a, 0x8b,
+	0x33, 0x4a, 0x51, 0xb6, 0xe1, 0x05, 0xb1, 0x4b, 0xcd, 0x12, 0xd5, 0x23, 0x76, 0x37, 0x53, 0x55,
+	0x2d, 0x8b, 0x39, 0x05, 0xb9, 0x64, 0x3d, 0x18, 0x41, 0x60, 0xe4, 0x9c, 0x83, 0x03, 0x04, 0xb9,
+	0x25, 0xc8, 0x21, 0x39, 0x06, 0x01, 0x7c, 0xc8, 0xc1, 0x48, 0x0e, 0xf1, 0x89, 0x88, 0x69, 0x04,
+	0xf9, 0x1b, 0x32, 0xa7, 0xa0, 0x96, 0xde, 0xa9, 0x19, 0x69, 0xc8, 0xc9, 0x20, 0x37, 0xf6, 0xf7,
+	0x7d, 0xf5, 0xfb, 0xbe, 0xae, 0xe5, 0xdb, 0xaa, 0x09, 0x6e, 0x75, 0x6c, 0x7e, 0xe0, 0xef, 0xd5,
+	0x2c, 0xcf, 0x59, 0xc3, 0xb4, 0xe3, 0xf5, 0xa8, 0x77, 0x57, 0xfe, 0x78, 0x81, 0x1c, 0x11, 0x97,
+	0xb3, 0xb5, 0xde, 0x61, 0x67, 0x0d, 0xf7, 0x6c, 0xb6, 0x26, 0x9f, 0xf7, 0x7c, 0xb6, 0x76, 0xf4,
+	0x22, 0xee, 0xf6, 0x0e, 0xf0, 0x8b, 0x6b, 0x1d, 0xe2, 0x12, 0x8a, 0x39, 0x69, 0xd7, 0x7a, 0xd4,
+	0xe3, 0x1e, 0xbc, 0x1e, 0x61, 0xd5, 0x02, 0x2c, 0xf9, 0xe3, 0x7d, 0x85, 0x55, 0xeb, 0x1d, 0x76,
+	0x6a, 0x02, 0xab, 0x16, 0x60, 0xd5, 0x02, 0xac, 0xcb, 0xaf, 0x9e, 0xda, 0x0e, 0xcb, 0x73, 0x1c,
+	0xcf, 0x4d, 0x2b, 0xbf, 0xfc, 0x42, 0x0c, 0xa0, 0xe3, 0x75, 0xbc, 0x35, 0x49, 0xde, 0xf3, 0xf7,
+	0xe5, 0x93, 0x7c, 0x90, 0xbf, 0xb4, 0x78, 0xf5, 0xf0, 0x1a, 0xab, 0xd9, 0x9e, 0x80, 0x5c, 0xb3,
+	0x3c, 0x4a, 0xd6, 0x8e, 0x32, 0xef, 0x73, 0xf9, 0xa5, 0x48, 0xc6, 0xc1, 0xd6, 0x81, 0xed, 0x12,
+	0xda, 0x0f, 0xec, 0x58, 0xa3, 0x84, 0x79, 0x3e, 0xb5, 0xc8, 0x99, 0x46, 0xb1, 0x35, 0x87, 0x70,
+	0x3c, 0x4a, 0xd7, 0xda, 0x49, 0xa3, 0xa8, 0xef, 0x72, 0xdb, 0xc9, 0xaa, 0xf9, 0xff, 0x07, 0x0d,
+	0x60, 0xd6, 0x01, 0x71, 0x70, 0x7a, 0x5c, 0xf5, 0xfb, 0x79, 0x00, 0xea, 0x16, 0xb7, 0x3d, 0x77,
+	0xa7, 0x8b, 0x5d, 0xf8, 0x3f, 0x60, 0xfa, 0x88, 0x50, 0x66, 0x7b, 0xae, 0x69, 0xac, 0x18, 0xab,
+	0xe5, 0xc6, 0xfc, 0xa7, 0x83, 0xe5, 0x73, 0xc3, 0xc1, 0xf2, 0xf4, 0x9b, 0x8a, 0x8c, 0x02, 0x3e,
+	0xbc, 0x0c, 0x72, 0x76, 0xdb, 0xcc, 0x49, 0x29, 0xa0, 0xa5, 0x72, 0x5b, 0x1b, 0x28, 0x67, 0xb7,
+	0xe1, 0x55, 0x00, 0xb4, 0x22, 0x81, 0x94, 0x5f, 0x31, 0x56, 0xf3, 0x0d, 0xa8, 0x65, 0xc0, 0xcd,
+	0x90, 0x83, 0x62, 0x52, 0x90, 0x83, 0x69, 0x2c, 0x0d, 0x61, 0x66, 0x61, 0x25, 0xbf, 0x5a, 0xb9,
+	0xba, 0x55, 0x7b, 0xf8, 0x0d, 0x54, 0xdb, 0x21, 0x6e, 0xdb, 0x76, 0x3b, 0xea, 0xd5, 0xa2, 0xb7,
+	0x50, 0xcf, 0x0c, 0x05, 0xaa, 0xe0, 0xf3, 0xa0, 0x84, 0x7b, 0x3d, 0xea, 0x1d, 0x91, 0xb6, 0x59,
+	0x5c, 0x31, 0x56, 0x4b, 0x8d, 0x05, 0x2d, 0x5b, 0xaa, 0x6b, 0x3a, 0x0a, 0x25, 0xe0, 0xbb, 0xa0,
+	0x6c, 0x51, 0x22, 0xa6, 0xaf, 0xce, 0xcd, 0xa9, 0x15, 0x63, 0xb5, 0x72, 0xf5, 0x7f, 0x6b, 0x6a,
+	0xe6, 0x6b, 0xf1, 0x99, 0x8f, 0x2c, 0x13, 0x0b, 0x5c, 0x3b, 0x7a, 0xb1, 0xb6, 0x6b, 0x3b, 0xa4,
+	0xb1, 0xa8, 0xa1, 0xcb, 0xeb, 0x01, 0x08, 0x8a, 0xf0, 0xaa, 0x3f, 0xc8, 0x83, 0x72, 0xc3, 0x67,
+	0xeb, 0x9e, 0xbb, 0x6f, 0x77, 0x60, 0x1b, 0x14, 0x5c, 0xcc, 0x99, 0x5c, 0x86, 0xca, 0xd5, 0x1b,
+	0xe3, 0xcc, 0xc5, 0xed, 0xfa, 0x6e, 0x4b, 0xa1, 0x36, 0x4a, 0xc3, 0xc1, 0x72, 0x41, 0x3c, 0x23,
+	0x89, 0x0e, 0x8f, 0x41, 0xf9, 0x2e, 0xe1, 0x8c, 0x53, 0x82, 0x1d, 0xb9, 0x96, 0x95, 0xab, 0xaf,
+	0x8f, 0xa3, 0xea, 0x16, 0xe1, 0x2d, 0x09, 0xa6, 0xf5, 0xcd, 0x8a, 0xb7, 0x0d, 0x89, 0x28, 0x52,
+	0x06, 0x09, 0x28, 0x1e, 0xe2, 0xfd, 0x43, 0x2c, 0x77, 0x47, 0xe5, 0xea, 0xc6, 0x38, 0x5a, 0x5f,
+	0x17, 0x40, 0x0d, 0x9f, 0x35, 0xca, 0xc3, 0xc1, 0x72, 0x51, 0x3e, 0x21, 0x85, 0x0e, 0x5f, 0x06,
+	0x53, 0xfb, 0x1e, 0x75, 0x30, 0x37, 0x0b, 0x72, 0xa7, 0x5e, 0xd1, 0x4b, 0x30, 0x75, 0x43, 0x52,
+	0xef, 0x0d, 0x96, 0x2b, 0x9b, 0x02, 0x4f, 0x3d, 0x22, 0x2d, 0x5c, 0xfd, 0x6d, 0x0e, 0x2c, 0xae,
+	0x7b, 0x2e, 0xc7, 0x62, 0x39, 0x77, 0x89, 0xd3, 0xeb, 0x62, 0x4e, 0xe0, 0xdb, 0xa0, 0x1c, 0x9c,
+	0xf3, 0x60, 0x61, 0x56, 0x63, 0xcb, 0x5f, 0x13, 0x9e, 0x43, 0x2c, 0x36, 0xd2, 0x42, 0x88, 0x7c,
+	0xcb, 0xb7, 0x29, 0x71, 0x84, 0xfd, 0xd1, 0xe2, 0x07, 0x5c, 0x86, 0x22, 0x34, 0xb8, 0x07, 0xe6,
+	0x6d, 0x07, 0x77, 0xc8, 0x8e, 0xdf, 0xed, 0xee, 0x78, 0x5d, 0xdb, 0xea, 0xeb, 0xa3, 0x75, 0x4d,
+	0x0f, 0x9b, 0xdf, 0x4a, 0xb2, 0xef, 0x0d, 0x96, 0xaf, 0x64, 0x9d, 0x56, 0x2d, 0x12, 0x40, 0x69,
+	0x40, 0xa1, 0x83, 0x11, 0xcb, 0xa7, 0x36, 0xef, 0x8b, 0x77, 0x23, 0xc7, 0x5c, 0x4f, 0xfe, 0xd3,
+	0xa3, 0x5e, 0xa2, 0x95, 0x14, 0x6d, 0x9c, 0x17, 0x46, 0xa4, 0x88, 0x28, 0x0d, 0x58, 0xfd, 0x53,
+	0x0e, 0x94, 0xe4, 0x84, 0x36, 0x7c, 0x06, 0x3f, 0x00, 0x25, 0xb1, 0xff, 0xdb, 0x98, 0x63, 0x3d,
+	0x5d, 0xff, 0x77, 0xba, 0xd3, 0x72, 0x67, 0xef, 0x2e, 0xb1, 0x78, 0x93, 0x70, 0x1c, 0xb9, 0x8d,
+	0x88, 0x86, 0x42, 0x54, 0x78, 0x17, 0x14, 0x58, 0x8f, 0x58, 0x7a, 0xeb, 0xbe, 0x36, 0xce, 0x26,
+	0x0a, 0xac, 0x6e, 0xf5, 0x88, 0xd5, 0x98, 0xd1, 0x5a, 0x0b, 0xe2, 0x09, 0x49, 0x1d, 0x90, 0x82,
+	0x29, 0xc6, 0x31, 0xf7, 0x99, 0x9e, 0xb5, 0x5b, 0x13, 0xd1, 0x26, 0x11, 0x1b, 0x73, 0xc1, 0xb6,
+	0x54, 0xcf, 0x48, 0x6b, 0xaa, 0xfe, 0xd5, 0x00, 0x33, 0x81, 0xe8, 0xb6, 0xcd, 0x38, 0x7c, 0x2f,
+	0x33, 0xa5, 0xb5, 0xd3, 0x4d, 0xa9, 0x18, 0x2d, 0x27, 0x34, 0xf4, 0x6f, 0x01, 0x25, 0x36, 0x9d,
+	0x36, 0x28, 0xda, 0x9c, 0x38, 0xcc, 0xcc, 0xad, 0xe4, 0xc7, 0x3d, 0x94, 0x81, 0xd9, 0x8d, 0x59,
+	0xad, 0xb0, 0xb8, 0x25, 0xa0, 0x91, 0xd2, 0x50, 0xfd, 0x79, 0xec, 0xcd, 0x5a, 0x84, 0xb4, 0xa1,
+	0x03, 0xa6, 0x14, 0xa8, 0x69, 0x48, 0xe5, 0x9b, 0xe3, 0x28, 0x17, 0x88, 0x0a, 0x3d, 0x9c, 0x59,
+	0xf9, 0xc8, 0x90, 0x56, 0x02, 0x9f, 0x06, 0xc5, 0x36, 0xe9, 0xe2, 0xe0, 0x98, 0x85, 0x46, 0x6e,
+	0x08, 0x22, 0x52, 0xbc, 0xea, 0xef, 0xa6, 0x62, 0x46, 0x8a, 0x3d, 0x80, 0x13, 0x5e, 0x79, 0x7d,
+	0x5c, 0xaf, 0x2c, 0xa6, 0x27, 0xed, 0x92, 0xfd, 0xac, 0x4b, 0x7e, 0x6d, 0x22, 0x2e, 0x59, 0xae,
+	0xc5, 0xe3, 0xf6, 0xc7, 0x3f, 0x34, 0xc0, 0x7c, 0xa8, 0x74, 0xf3, 0xd8, 0xe3, 0xb6, 0x65, 0x16,
+	0x26, 0x1f, 0x77, 0xa4, 0xb3, 0x0a, 0x89, 0x4a, 0x0f, 0x4a, 0x2b, 0x86, 0xfb, 0xa0, 0xc0, 0x88,
+	0x0e, 0xfc, 0x93, 0xf2, 0x1e, 0x84, 0xb4, 0xd5, 0x92, 0x8a, 0x5f, 0x48, 0xe2, 0x43, 0x17, 0x4c,
+	0xb1, 0x03, 0x4c, 0x49, 0xdb, 0x9c, 0x1a, 0xdf, 0x73, 0xb4, 0x24, 0x52, 0x78, 0xba, 0x80, 0xf4,
+	0x1a, 0x92, 0x86, 0xb4, 0x96, 0x58, 0xd0, 0x9b, 0x3e, 0x43, 0xd0, 0x83, 0x4d, 0x70, 0x9e, 0xaa,
+	0x88, 0x25, 0x72, 0x41, 0x95, 0xfe, 0xe0, 0xae, 0x59, 0x92, 0x69, 0xd1, 0x7f, 0x69, 0x8c, 0xf3,
+	0x28, 0x2b, 0x82, 0x46, 0x8d, 0xab, 0xfe, 0xa6, 0x08, 0xe6, 0x92, 0x6e, 0x0e, 0xbe, 0x1f, 0xba,
+	0x50, 0x75, 0x80, 0xbe, 0x72, 0xfa, 0x89, 0x50, 0x79, 0x7e, 0xed, 0xfe, 0xfe, 0x52, 0x38, 0x11,
+	0x4b, 0xee, 0x00, 0x7d, 0x72, 0xc6, 0x72, 0x22, 0x61, 0x32, 0x16, 0xa9, 0x53, 0xcf, 0x48, 0x2b,
+	0x81, 0xd7, 0x40, 0xc9, 0xa3, 0x6d, 0x42, 0x6d, 0xb7, 0x23, 0xcf, 0x4d, 0xb9, 0xf1, 0x54, 0xe0,
+	0x5d, 0xef, 0x68, 0xfa, 0xbd, 0xd8, 0x6f, 0x14, 0x4a, 0xc3, 0x4f, 0x0c, 0xb0, 0xc0, 0xed, 0xce,
+	0x01, 0x27, 0x2e, 0x69, 0xab, 0x5d, 0x1a, 0xe4, 0xbd, 0x1f, 0x4c, 0x2e, 0xae, 0xd4, 0x76, 0x53,
+	0x2a, 0x36, 0x5d, 0x4e, 0xfb, 0x0d, 0x53, 0x1b, 0xb9, 0x90, 0x66, 0xa3, 0x8c, 0x4d, 0xf0, 0xbb,
+	0x06, 0x98, 0xeb, 0xc5, 0x93, 0x69, 0x66, 0x16, 0xc7, 0x4f, 0x49, 0xa3, 0x92, 0xa3, 0x01, 0x87,
+	0x83, 0xe5, 0xb9, 0x44, 0xba, 0xce, 0x50, 0x4a, 0x23, 0x7c, 0x06, 0x4c, 0x3b, 0x36, 0xa5, 0x1e,
+	0x65, 0xe6, 0xd4, 0x4a, 0x7e, 0xb5, 0xdc, 0xa8, 0x88, 0x64, 0xbe, 0xa9, 0x48, 0x28, 0xe0, 0x5d,
+	0x5e, 0x07, 0x17, 0x47, 0xbe, 0x30, 0x5c, 0x00, 0xf9, 0x43, 0xd2, 0x57, 0x25, 0x0d, 0x12, 0x3f,
+	0xe1, 0x05, 0x50, 0x3c, 0xc2, 0x5d, 0x9f, 0x28, 0xf7, 0x8f, 0xd4, 0xc3, 0xf5, 0xdc, 0x35, 0xa3,
+	0xfa, 0xfb, 0x1c, 0xb8, 0x14, 0x7a, 0x8e, 0xba, 0x65, 0x79, 0xbe, 0xcb, 0xb7, 0x6d, 0xc7, 0xe6,
+	0x4c, 0xa4, 0xff, 0x0e, 0x3e, 0x6e, 0x12, 0xc7, 0xa3, 0xfd, 0xd3, 0x44, 0xdf, 0x5a, 0x90, 0xdf,
+	0xd5, 0xbe, 0xe1, 0x63, 0x97, 0xdb, 0xbc, 0xaf, 0x1c, 0x70, 0x33, 0x00, 0x41, 0x11, 0x1e, 0xfc,
+	0x26, 0x00, 0x0e, 0x3e, 0x6e, 0x71, 0x8f, 0xe2, 0x0e, 0x31, 0x73, 0x0f, 0x85, 0x3e, 0x27, 0x12,
+	0xa5, 0x66, 0x88, 0x82, 0x62, 0x88, 0xb0, 0xa6, 0xf1, 0xd5, 0x56, 0x13, 0xbb, 0xb5, 0x18, 0x93,
+	0x57, 0xcb, 0x1f, 0x93, 0x80, 0x2f, 0x81, 0x19, 0x07, 0x1f, 0xaf, 0x7b, 0x2e, 0xf3, 0x1d, 0x42,
+	0x99, 0xf4, 0xd2, 0xc5, 0xc6, 0xc2, 0x70, 0xb0, 0x3c, 0xd3, 0x8c, 0xd1, 0x51, 0x42, 0xaa, 0xfa,
+	0x2b, 0x08, 0x66, 0xe2, 0x11, 0xe7, 0x2c, 0x15, 0xe5, 0x2a, 0x28, 0x51, 0xd2, 0xeb, 0xda, 0x16,
+	0x66, 0xf2, 0xfd, 0x8b, 0x8d, 0x19, 0x71, 0x92, 0x90, 0xa6, 0xa1, 0x90, 0x0b, 0x7f, 0x62, 0x80,
+	0x45, 0x2b, 0x9d, 0x9e, 0xeb, 0xc8, 0xd5, 0x1c, 0x67, 0x5f, 0x66, 0x72, 0xfe, 0xc6, 0xc5, 0xe1,
+	0x60, 0x39, 0x5b, 0x0a, 0xa0, 0xac, 0x7a, 0xf8, 0x4b, 0x03, 0x3c, 0x49, 0x49, 0xd7, 0xc3, 0x6d,
+	0x42, 0x33, 0x03, 0xcc, 0xc2, 0xa3, 0x30, 0xee, 0xca, 0x70, 0xb0, 0xfc, 0x24, 0x3a, 0x49, 0x27,
+	0x3a, 0xd9, 0x1c, 0xf8, 0x0b, 0x03, 0x98, 0x0e, 0xe1, 0xd4, 0xb6, 0x58, 0xd6, 0xd6, 0xe2, 0xa3,
+	0xb0, 0xf5, 0xa9, 0xe1, 0x60, 0xd9, 0x6c, 0x9e, 0xa0, 0x12, 0x9d, 0x68, 0x8c, 0x70, 0x40, 0x95,
+	0x9e, 0xd8, 0x21, 0x8c, 0x13, 0xd7, 0x22, 0x3a, 0x84, 0xde, 0x19, 0xaf, 0x39, 0x10, 0xc2, 0xb5,
+	0x38, 0xc5, 0x9c, 0x74, 0xfa, 0x8d, 0xf9, 0xe1, 0x60, 0xb9, 0x12, 0x63, 0xa0, 0xb8, 0x52, 0x68,
+	0xc5, 0xd2, 0xee, 0x69, 0x69, 0xc0, 0x57, 0xcf, 0x1c, 0xba, 0x9a, 0x1a, 0x40, 0xed, 0xea, 0xe0,
+	0x29, 0x96, 0x7d, 0xff, 0xd4, 0x00, 0x33, 0xae, 0xd7, 0x26, 0x2d, 0xd2, 0x25, 0x16, 0xf7, 0xa8,
+	0x59, 0x92, 0xf1, 0xe0, 0x9d, 0x49, 0x65, 0x7f, 0xb5, 0xdb, 0x31, 0x70, 0x15, 0x09, 0x2e, 0xe8,
+	0xc3, 0x38, 0x13, 0x67, 0xa1, 0x84, 0x15, 0xf0, 0x0d, 0x50, 0xe1, 0x5e, 0x57, 0xb7, 0x69, 0x98,
+	0x59, 0x96, 0x46, 0x2d, 0x8d, 0x2a, 0x19, 0x77, 0x43, 0xb1, 0xc6, 0x79, 0x0d, 0x5c, 0x89, 0x68,
+	0x0c, 0xc5, 0x71, 0x20, 0xc9, 0x56, 0xa3, 0x40, 0xce, 0xec, 0xb3, 0xa3, 0xa0, 0x77, 0xbc, 0xf6,
+	0x43, 0x15, 0xa4, 0xd0, 0x05, 0x0b, 0x61, 0x1d, 0xdc, 0x22, 0x16, 0x25, 0x9c, 0x99, 0x95, 0x95,
+	0xfc, 0x49, 0xa5, 0xfb, 0xb6, 0x67, 0xe1, 0xae, 0x2a, 0x35, 0x11, 0xd9, 0x27, 0x54, 0xac, 0x7e,
+	0x14, 0x2f, 0xb7, 0x52, 0x48, 0x28, 0x83, 0x0d, 0x6f, 0x82, 0xc5, 0x1e, 0xb5, 0x3d, 0x69, 0x42,
+	0x17, 0x33, 0x76, 0x1b, 0x3b, 0xc4, 0x9c, 0x91, 0x9e, 0xef, 0x49, 0x0d, 0xb3, 0xb8, 0x93, 0x16,
+	0x40, 0xd9, 0x31, 0xc2, 0x1b, 0x06, 0x44, 0x73, 0x36, 0xf2, 0x86, 0xc1, 0x58, 0x14, 0x72, 0xe1,
+	0x0d, 0x50, 0xc2, 0xfb, 0xfb, 0xb6, 0x2b, 0x24, 0xe7, 0xe4, 0x14, 0x3e, 0x35, 0xea, 0xd5, 0xea,
+	0x5a, 0x46, 0xe1, 0x04, 0x4f, 0x28, 0x1c, 0x0b, 0x6f, 0x01, 0xc8, 0x08, 0x3d, 0xb2, 0x2d, 0xa2,
+	0xc3, 0x9e, 0xb4, 0x7d, 0x5e, 0xda, 0x7e, 0x59, 0xdb, 0x0e, 0x5b, 0x19, 0x09, 0x34, 0x62, 0x94,
+	0xb0, 0x9e, 0x11, 0xce, 0x6d, 0xb7, 0xc3, 0xcc, 0x05, 0x89, 0x20, 0xb5, 0xb6, 0x34, 0x0d, 0x85,
+	0x5c, 0xf8, 0x1c, 0x28, 0x33, 0x8e, 0x29, 0xaf, 0xd3, 0x0e, 0x33, 0x17, 0x65, 0x74, 0x97, 0x41,
+	0xb2, 0x15, 0x10, 0x51, 0xc4, 0x17, 0x41, 0x89, 0xc5, 0xf2, 0x7c, 0x13, 0x4a, 0x68, 0x19, 0x94,
+	0xe2, 0xf9, 0x3f, 0x4a, 0x48, 0xe9, 0xd0, 0xb7, 0x83, 0xfb, 0xc2, 0x1b, 0x9a, 0xe7, 0xe5, 0x98,
+	0x20, 0xf4, 0x69, 0x2a, 0x8a, 0x49, 0xc0, 0xaf, 0x83, 0x05, 0xdd, 0x36, 0x8d, 0x96, 0xf0, 0x82,
+	0x1c, 0x75, 0x41, 0xec, 0x02, 0x94, 0xe2, 0xa1, 0x8c, 0x34, 0xbc, 0x0b, 0xa6, 0x58, 0xcf, 0xde,
+	0xdf, 0x27, 0xe6, 0xc5, 0xf1, 0x93, 0xa5, 0xd6, 0xce, 0xd6, 0x8d, 0x1b, 0x9b, 0x75, 0x9f, 0x1f,
+	0xe8, 0x6c, 0x5f, 0x3e, 0x23, 0xad, 0x01, 0x32, 0x30, 0xcd, 0x89, 0x8b, 0x5d, 0xab, 0x6f, 0x5e,
+	0x92, 0xca, 0xb6, 0x27, 0xe2, 0x30, 0x76, 0x15, 0xa6, 0x4a, 0xb5, 0xf4, 0x03, 0x0a, 0x34, 0xc1,
+	0x1f, 0x19, 0x60, 0x96, 0xa9, 0xcc, 0xa2, 0xe1, 0xb7, 0x3b, 0x84, 0x9b, 0x4f, 0x48, 0xdd, 0x68,
+	0x22, 0xba, 0x5b, 0x71, 0xe4, 0xc6, 0xe2, 0x70, 0xb0, 0x3c, 0x9b, 0x20, 0xa1, 0xa4, 0x6e, 0x78,
+	0x14, 0xe5, 0x87, 0xe6, 0x4a, 0x7e, 0x62, 0xc5, 0xa4, 0x4a, 0x30, 0xa3, 0x8c, 0x25, 0x93, 0x70,
+	0xbe, 0x0a, 0x16, 0x33, 0x3e, 0xf5, 0x4c, 0xc9, 0xe6, 0x8f, 0xf3, 0x60, 0x3e, 0x55, 0xbb, 0xc2,
+	0x2b, 0x20, 0xef, 0xd3, 0xae, 0xce, 0x96, 0x2a, 0x5a, 0x77, 0xfe, 0x0d, 0xb4, 0x8d, 0x04, 0x1d,
+	0xbe, 0x0b, 0x66, 0xb0, 0x65, 0x11, 0xc6, 0x94, 0xc7, 0xd1, 0x99, 0xe2, 0x33, 0x27, 0xb4, 0xf0,
+	0x28, 0xe1, 0xaf, 0x93, 0x7e, 0x60, 0xa0, 0x3a, 0x29, 0xf5, 0xd8, 0x70, 0x94, 0x00, 0x83, 0xd7,
+	0x52, 0xe7, 0x4b, 0x15, 0x35, 0x61, 0x94, 0xb8, 0xcf, 0x19, 0xeb, 0x86, 0x3b, 0xbe, 0x30, 0x7e,
+	0x35, 0xad, 0x76, 0xb8, 0x2e, 0xbe, 0x46, 0xed, 0xf9, 0x37, 0x40, 0x9e, 0x77, 0x83, 0x4a, 0xe4,
+	0xfa, 0x99, 0x43, 0xf1, 0xee, 0x76, 0xd0, 0x10, 0x9f, 0x16, 0x73, 0xbb, 0xbb, 0xdd, 0x42, 0x02,
+	0xaf, 0xfa, 0x4f, 0x23, 0xb6, 0x1c, 0x6a, 0xb5, 0xe1, 0x8a, 0x68, 0xf9, 0x38, 0x44, 0xaf, 0x47,
+	0xd8, 0x18, 0x94, 0x07, 0x5f, 0x72, 0x60, 0x1d, 0xcc, 0x4b, 0x3d, 0x2d, 0x99, 0x8d, 0x0b, 0x86,
+	0x6e, 0x2a, 0x3d, 0x11, 0xf4, 0x6e, 0x37, 0x93, 0x6c, 0x94, 0x96, 0x87, 0x6b, 0xa0, 0x2c, 0x49,
+	0x72, 0xb0, 0x9a, 0xf4, 0xb0, 0x5f, 0xbc, 0x19, 0x30, 0x50, 0x24, 0x03, 0x9f, 0x05, 0x53, 0x0e,
+	0x3e, 0xae, 0x77, 0x88, 0xee, 0x6b, 0x87, 0x15, 0x6a, 0x53, 0x52, 0x91, 0xe6, 0x26, 0x72, 0xea,
+	0xe2, 0xfd, 0x72, 0xea, 0xea, 0x3f, 0xe2, 0x75, 0x4f, 0xe2, 0xb4, 0x09, 0x7f, 0xf8, 0x21, 0xa6,
+	0xae, 0xed, 0x76, 0xde, 0xc2, 0x9c, 0x50, 0x07, 0xd3, 0x43, 0x39, 0x1d, 0x45, 0xe5, 0x0f, 0xdf,
+	0x4a, 0xf1, 0x50, 0x46, 0x1a, 0xae, 0x83, 0x45, 0x8b, 0xda, 0xdc, 0xb6, 0x70, 0x37, 0x82, 0x50,
+	0x39, 0xbe, 0x4a, 0xb0, 0xd3, 0x4c, 0x94, 0x95, 0x87, 0xaf, 0x80, 0x59, 0xeb, 0x80, 0x58, 0x87,
+	0x5b, 0x2e, 0x27, 0x54, 0x74, 0x26, 0xd4, 0x44, 0x5d, 0xd4, 0xaf, 0x3e, 0xbb, 0x1e, 0x67, 0xa2,
+	0xa4, 0x2c, 0xbc, 0x01, 0x60, 0xd7, 0xfb, 0x30, 0x88, 0x9e, 0xf1, 0x8a, 0xbb, 0xdc, 0xb8, 0x24,
+	0x02, 0xdb, 0x76, 0x86, 0x8b, 0x46, 0x8c, 0x10, 0x8b, 0x1d, 0xd6, 0xc8, 0x6a, 0xae, 0xcd, 0x62,
+	0x72, 0xb1, 0x77, 0x93, 0x6c, 0x94, 0x96, 0xaf, 0xfe, 0xdd, 0x00, 0x0b, 0x69, 0x37, 0x2b, 0x66,
+	0x08, 0x77, 0xbb, 0xde, 0x87, 0xa4, 0x2d, 0xd6, 0x97, 0xf5, 0xb0, 0xba, 0x63, 0x10, 0xe6, 0xc9,
+	0x19, 0xaa, 0xa7, 0x99, 0x28, 0x2b, 0x2f, 0xbd, 0x32, 0x8e, 0x97, 0xac, 0x66, 0x6e, 0x82, 0x5e,
+	0x39, 0x51, 0x0c, 0x2b, 0xaf, 0x9c, 0x20, 0xa1, 0xa4, 0xee, 0xea, 0xc7, 0x45, 0x50, 0x0a, 0x5a,
+	0x81, 0x0f, 0xf2, 0x6a, 0x4f, 0x83, 0x22, 0xf7, 0x7a, 0xb6, 0x95, 0x6e, 0xc7, 0xee, 0x0a, 0x22,
+	0x52, 0xbc, 0x78, 0x2d, 0x99, 0x7f, 0x40, 0x2d, 0xa9, 0x1d, 0x44, 0x61, 0xb2, 0x0e, 0x02, 0xbe,
+	0x0d, 0x0a, 0x0c, 0xb3, 0xae, 0x76, 0x3c, 0xaf, 0x9c, 0xbd, 0x7d, 0x55, 0x6f, 0x6d, 0xc7, 0xaf,
+	0xe2, 0xc4, 0x33, 0x92, 0x90, 0xf0, 0x7b, 0x06, 0x98, 0xb5, 0x74, 0x1d, 0x7d, 0x93, 0x7a, 0x7e,
+	0x4f, 0x57, 0x3a, 0xb7, 0xc7, 0xee, 0xc4, 0xae, 0xc7, 0x51, 0xd5, 0xba, 0x25, 0x48, 0x28, 0xa9,
+	0x17, 0x1e, 0x82, 0x29, 0x39, 0xdf, 0x4c, 0x97, 0x3a, 0x37, 0xc7, 0xb6, 0x40, 0xae, 0xa2, 0xee,
+	0x55, 0xaa, 0xdf, 0x48, 0xab, 0x80, 0x34, 0x0a, 0xdd, 0xaa, 0xdc, 0x19, 0x5f, 0xdb, 0x83, 0xc2,
+	0x76, 0xf5, 0x8f, 0x06, 0x80, 0xd9, 0x99, 0x11, 0x4e, 0xb8, 0x23, 0x7e, 0xdc, 0x8e, 0xdc, 0x7d,
+	0xe8, 0x84, 0x6f, 0x06, 0x0c, 0x14, 0xc9, 0x88, 0x5c, 0x9f, 0x92, 0x3d, 0xdc, 0xc5, 0xb1, 0x42,
+	0xd2, 0xcc, 0x25, 0x73, 0x7d, 0x94, 0x16, 0x40, 0xd9, 0x31, 0xf0, 0x65, 0x50, 0x91, 0x39, 0xee,
+	0x9d, 0x6e, 0x9b, 0x30, 0x75, 0x2b, 0x57, 0x8a, 0x4a, 0xa8, 0x56, 0xc4, 0x42, 0x71, 0xb9, 0xea,
+	0x27, 0x06, 0xa8, 0xc4, 0xde, 0x38, 0x3a, 0x44, 0xc6, 0x7d, 0x0e, 0xd1, 0x63, 0x88, 0x56, 0xd5,
+	0x8f, 0x02, 0x43, 0xd5, 0xe2, 0x8b, 0x84, 0x1c, 0xfb, 0xdc, 0x53, 0xd7, 0xe0, 0xd2, 0xda, 0x92,
+	0x4a, 0xc8, 0xeb, 0x21, 0x15, 0xc5, 0x24, 0xc4, 0xc1, 0xe7, 0xd4, 0xee, 0x74, 0x08, 0xd5, 0xb6,
+	0x86, 0x6b, 0xbb, 0xab, 0xc8, 0x28, 0xe0, 0x8b, 0xc0, 0xa8, 0xee, 0xf6, 0xcd, 0x7c, 0x32, 0x30,
+	0xaa, 0x5e, 0x22, 0xd2, 0x5c, 0xe1, 0x84, 0xa7, 0xf5, 0x15, 0x8c, 0xe8, 0xcf, 0xbb, 0x98, 0xdb,
+	0x47, 0xc4, 0x34, 0xc6, 0xef, 0xcf, 0xdf, 0x96, 0x48, 0x61, 0x5f, 0x41, 0xee, 0x79, 0x45, 0x43,
+	0x5a, 0x8b, 0xa8, 0x0e, 0x88, 0xba, 0xfa, 0xc8, 0x4d, 0xf4, 0x76, 0x5f, 0xea, 0xd2, 0x97, 0x1d,
+	0x5a, 0x43, 0xf5, 0x4b, 0x03, 0x80, 0x48, 0xe4, 0x41, 0x6e, 0xf8, 0x39, 0x50, 0xb6, 0xba, 0x3e,
+	0xe3, 0x84, 0x6e, 0x6d, 0x04, 0xae, 0x58, 0x7e, 0xb0, 0x10, 0x10, 0x51, 0xc4, 0x87, 0xcf, 0x83,
+	0x02, 0xf6, 0xf9, 0x81, 0x9e, 0x68, 0x53, 0xf8, 0x33, 0x51, 0xa4, 0xdc, 0x13, 0x29, 0xa6, 0xcf,
+	0x0f, 0xc2, 0x0d, 0x2f, 0xa5, 0x32, 0x79, 0x6b, 0x61, 0x82, 0x79, 0x6b, 0xf5, 0xcf, 0xf3, 0x60,
+	0x2e, 0x39, 0xf1, 0xe2, 0xcb, 0x8e, 0x30, 0xf3, 0x51, 0xc9, 0x4a, 0x78, 0xf3, 0x39, 0xa2, 0xa3,
+	0x18, 0xbc, 0x4b, 0xee, 0x54, 0xef, 0x92, 0xee, 0x49, 0xe5, 0x1f, 0x47, 0x4f, 0x6a, 0x74, 0x13,
+	0xb4, 0xf0, 0x78, 0x9b, 0xa0, 0xff, 0x39, 0x7d, 0xc5, 0x8f, 0xd3, 0xdd, 0xb6, 0x29, 0x19, 0x7e,
+	0xde, 0x9b, 0xdc, 0xd9, 0x9f, 0x4c, 0xbf, 0x6d, 0x7a, 0x42, 0xfd, 0xb6, 0x78, 0x0b, 0xb3, 0xf4,
+	0xa8, 0x5a, 0x98, 0x23, 0x9a, 0x7a, 0xe5, 0x47, 0xd0, 0xd4, 0xab, 0x86, 0xd5, 0x0f, 0x50, 0xdf,
+	0x9f, 0x8d, 0xa8, 0x7c, 0xfe, 0xdd, 0x8d, 0xbf, 0xd1, 0xdd, 0xb3, 0x99, 0x87, 0xea, 0x9e, 0x8d,
+	0x6c, 0x22, 0xce, 0x8e, 0xd9, 0x44, 0x9c, 0x3b, 0x75, 0x13, 0x71, 0x7e, 0x8c, 0x26, 0xa2, 0xb8,
+	0xaa, 0xc3, 0xc7, 0x4d, 0xa6, 0xfb, 0x7e, 0x05, 0x7d, 0x55, 0xa7, 0x48, 0x28, 0xe0, 0x09, 0xc3,
+	0x1c, 0x7c, 0xdc, 0xe8, 0x73, 0x22, 0x9a, 0x7e, 0x61, 0x7f, 0xb0, 0xa9, 0x69, 0x28, 0xe4, 0x6a,
+	0xc0, 0x96, 0xbf, 0xc7, 0x4c, 0x98, 0x00, 0x14, 0x24, 0x14, 0xf0, 0xce, 0xdc, 0xe3, 0xdb, 0x06,
+	0x17, 0x28, 0xde, 0xe7, 0xaf, 0x11, 0x4c, 0xf9, 0x1e, 0xc1, 0x5c, 0x7c, 0xa0, 0xe7, 0xf9, 0xdc,
+	0xbc, 0x10, 0x06, 0x80, 0x0b, 0x68, 0x04, 0x1f, 0x8d, 0x1c, 0x05, 0xb7, 0xc0, 0x79, 0x41, 0xdf,
+	0x14, 0x47, 0xd8, 0xf6, 0xdc, 0x00, 0xec, 0xa2, 0x4a, 0xac, 0xe4, 0xb5, 0x79, 0x96, 0x8d, 0x46,
+	0x8d, 0x91, 0xcd, 0x47, 0xbc, 0xcf, 0xb7, 0x09, 0x66, 0x24, 0xc0, 0xb9, 0x14, 0x6b, 0x3e, 0xa6,
+	0x78, 0x28, 0x23, 0x2d, 0x4a, 0x49, 0x41, 0x5b, 0xf7, 0x1c, 0xc7, 0x0e, 0xdf, 0xeb, 0x09, 0x55,
+	0x2b, 0xcb, 0x94, 0x34, 0xcd, 0x44, 0x59, 0xf9, 0x91, 0x3d, 0x50, 0xf3, 0x2c, 0x3d, 0xd0, 0xf1,
+	0x9b, 0x63, 0x7f, 0x31, 0xc0, 0x6c, 0xe2, 0x62, 0x18, 0xbe, 0x0c, 0x0a, 0xbc, 0xdf, 0x0b, 0x92,
+	0xf3, 0xff, 0x0e, 0x7a, 0x31, 0xbb, 0xfd, 0x1e, 0xb9, 0x27, 0x8e, 0x44, 0x5c, 0x58, 0x10, 0x91,
+	0x14, 0x17, 0x39, 0x21, 0xc7, 0xb4, 0xa3, 0x9b, 0x65, 0xb1, 0x9c, 0x70, 0x57, 0x52, 0x91, 0xe6,
+	0x8a, 0x34, 0xbc, 0x4d, 0x98, 0x45, 0xed, 0x5e, 0x2c, 0x81, 0x0c, 0x3d, 0xeb, 0x46, 0xc4, 0x42,
+	0x71, 0x39, 0x91, 0x69, 0x08, 0xe7, 0xb7, 0xed, 0x31, 0x55, 0x70, 0xc6, 0xbe, 0x21, 0xdd, 0xd0,
+	0x74, 0x14, 0x4a, 0x54, 0x7f, 0x96, 0x03, 0xe7, 0x47, 0x04, 0x7b, 0x31, 0xe1, 0xba, 0xa9, 0x19,
+	0x4d, 0xb8, 0x11, 0x4d, 0x78, 0x2b, 0xc5, 0x43, 0x19, 0x69, 0xf8, 0x3e, 0x00, 0x2a, 0x29, 0x6a,
+	0x7a, 0xed, 0x20, 0xa9, 0x7f, 0x55, 0x66, 0xd5, 0x21, 0xf5, 0xde, 0x60, 0xf9, 0x85, 0x51, 0x5f,
+	0x0d, 0x06, 0xf6, 0xf0, 0x37, 0xbd, 0xae, 0xef, 0x90, 0x68, 0x00, 0x8a, 0x41, 0x8a, 0x2b, 0xea,
+	0x23, 0xc9, 0x6f, 0xd9, 0xdf, 0x0e, 0x92, 0x9e, 0x87, 0xba, 0xa2, 0x7e, 0x33, 0x44, 0x41, 0x31,
+	0xc4, 0xea, 0xaf, 0x0d, 0x00, 0xa2, 0x66, 0xb7, 0x58, 0x0e, 0x4e, 0x7d, 0xc6, 0x37, 0x3c, 0x07,
+	0xdb, 0xc1, 0xf5, 0x71, 0x14, 0xe8, 0x22, 0x16, 0x8a, 0xcb, 0xc1, 0xaf, 0x81, 0xf9, 0xa4, 0x4b,
+	0x55, 0x9f, 0xb3, 0x95, 0x83, 0xd8, 0x92, 0x60, 0xa1, 0xb4, 0xac, 0x28, 0x6e, 0x2c, 0x66, 0x6f,
+	0x50, 0xfb, 0x88, 0xd0, 0x74, 0x71, 0xb3, 0xde, 0xda, 0x52, 0x0c, 0x14, 0xc9, 0x54, 0x1d, 0x30,
+	0x13, 0xef, 0x57, 0x26, 0x01, 0x8c, 0x07, 0x03, 0x88, 0xfd, 0x23, 0x8c, 0x88, 0xe5, 0xdc, 0xe1,
+	0xfe, 0x69, 0x69, 0x3a, 0x0a, 0x25, 0xaa, 0x7f, 0x30, 0x40, 0x39, 0xfc, 0xbc, 0x6d, 0x54, 0x35,
+	0x67, 0x8c, 0x53, 0xcd, 0xe5, 0x4e, 0xd1, 0x7b, 0x5c, 0xd1, 0xa7, 0x30, 0x9f, 0xec, 0x88, 0xc6,
+	0x0e, 0xdc, 0x0a, 0x28, 0xc8, 0x3c, 0xa3, 0x90, 0x94, 0x10, 0xa7, 0x01, 0x49, 0x4e, 0xd5, 0x02,
+	0x73, 0xc9, 0x0f, 0x99, 0x84, 0x19, 0x6e, 0xd0, 0xc9, 0x4a, 0x4f, 0x5b, 0xd8, 0xe2, 0x42, 0x91,
+	0x4c, 0xd8, 0x98, 0xcd, 0x9d, 0xd4, 0x98, 0x6d, 0x7c, 0xf0, 0xe9, 0x17, 0x4b, 0xe7, 0x3e, 0xfb,
+	0x62, 0xe9, 0xdc, 0xe7, 0x5f, 0x2c, 0x9d, 0xfb, 0xce, 0x70, 0xc9, 0xf8, 0x74, 0xb8, 0x64, 0x7c,
+	0x36, 0x5c, 0x32, 0x3e, 0x1f, 0x2e, 0x19, 0x7f, 0x1b, 0x2e, 0x19, 0x1f, 0x7d, 0xb9, 0x74, 0xee,
+	0x9d, 0xeb, 0x0f, 0xff, 0x9f, 0x87, 0x7f, 0x0d, 0x00, 0x71, 0xd1, 0x7f, 0xfc, 0x30, 0x31, 0x00,
+	0x00,
 }
 
-func (m *BusConfig) Marshal() (dAtA []byte, err error) {
+func (m *ActionPlan) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -605,30 +1058,103 @@ func (m *BusConfig) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *BusConfig) MarshalTo(dAtA []byte) (int, error) {
+func (m *ActionPlan) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *BusConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ActionPlan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Kafka != nil {
-		{
-			size, err := m.Kafka.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
+	{
+		size, err := m.CreatedAt.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
-		i--
-		dAtA[i] = 0x1a
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
-	if m.JetStream != nil {
-		{
+	i--
+	dAtA[i] = 0x32
+	i--
+	if m.Approved {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x28
+	if len(m.Actions) > 0 {
+		for iNdEx := len(m.Actions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Actions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Generation))
+	i--
+	dAtA[i] = 0x18
+	i -= len(m.ID)
+	copy(dAtA[i:], m.ID)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ID)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Version)
+	copy(dAtA[i:], m.Version)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Version)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *BusConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BusConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BusConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Format)
+	copy(dAtA[i:], m.Format)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Format)))
+	i--
+	dAtA[i] = 0x22
+	if m.Kafka != nil {
+		{
+			size, err := m.Kafka.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.JetStream != nil {
+		{
 			size, err := m.JetStream.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
@@ -804,6 +1330,48 @@ func (m *EventBusList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EventBusSeed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventBusSeed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventBusSeed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Delay)
+	copy(dAtA[i:], m.Delay)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Delay)))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Events) > 0 {
+		for iNdEx := len(m.Events) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Events[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *EventBusSpec) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -824,6 +1392,43 @@ func (m *EventBusSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i--
+	if m.RequirePlanApproval {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x40
+	i -= len(m.Format)
+	copy(dAtA[i:], m.Format)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Format)))
+	i--
+	dAtA[i] = 0x3a
+	if m.Shared != nil {
+		{
+			size, err := m.Shared.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Seed != nil {
+		{
+			size, err := m.Seed.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
 	if m.JetStreamExotic != nil {
 		{
 			size, err := m.JetStreamExotic.MarshalToSizedBuffer(dAtA[:i])
@@ -895,6 +1500,56 @@ func (m *EventBusStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Mirrors) > 0 {
+		for iNdEx := len(m.Mirrors) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Mirrors[iNdEx])
+			copy(dAtA[i:], m.Mirrors[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Mirrors[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.PendingActions != nil {
+		{
+			size, err := m.PendingActions.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.TightenedStreams) > 0 {
+		keysForTightenedStreams := make([]string, 0, len(m.TightenedStreams))
+		for k := range m.TightenedStreams {
+			keysForTightenedStreams = append(keysForTightenedStreams, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForTightenedStreams)
+		for iNdEx := len(keysForTightenedStreams) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.TightenedStreams[string(keysForTightenedStreams[iNdEx])]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForTightenedStreams[iNdEx])
+			copy(dAtA[i:], keysForTightenedStreams[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForTightenedStreams[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	i -= len(m.Ordering)
+	copy(dAtA[i:], m.Ordering)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Ordering)))
+	i--
+	dAtA[i] = 0x1a
 	{
 		size, err := m.Config.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -918,6 +1573,63 @@ func (m *EventBusStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *JetStreamAccountLimits) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JetStreamAccountLimits) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JetStreamAccountLimits) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxConsumers != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.MaxConsumers))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MaxStreams != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.MaxStreams))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MaxStorage != nil {
+		{
+			size, err := m.MaxStorage.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.MaxMemory != nil {
+		{
+			size, err := m.MaxMemory.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *JetStreamBus) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -938,6 +1650,73 @@ func (m *JetStreamBus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Mirrors) > 0 {
+		for iNdEx := len(m.Mirrors) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Mirrors[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xc2
+		}
+	}
+	if m.StorageBudget != nil {
+		{
+			size, err := m.StorageBudget.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xba
+	}
+	if m.Tenancy != nil {
+		{
+			size, err := m.Tenancy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb2
+	}
+	if m.SPIFFE != nil {
+		{
+			size, err := m.SPIFFE.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xaa
+	}
+	if m.RuntimeClassName != nil {
+		i -= len(*m.RuntimeClassName)
+		copy(dAtA[i:], *m.RuntimeClassName)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.RuntimeClassName)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa2
+	}
 	if m.MaxPayload != nil {
 		i -= len(*m.MaxPayload)
 		copy(dAtA[i:], *m.MaxPayload)
@@ -1160,12 +1939,36 @@ func (m *JetStreamConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	i -= len(m.StreamConfig)
-	copy(dAtA[i:], m.StreamConfig)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.StreamConfig)))
-	i--
-	dAtA[i] = 0x1a
-	if m.AccessSecret != nil {
+	if m.TLS != nil {
+		{
+			size, err := m.TLS.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.SPIFFE != nil {
+		{
+			size, err := m.SPIFFE.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	i -= len(m.StreamConfig)
+	copy(dAtA[i:], m.StreamConfig)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.StreamConfig)))
+	i--
+	dAtA[i] = 0x1a
+	if m.AccessSecret != nil {
 		{
 			size, err := m.AccessSecret.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
@@ -1185,6 +1988,150 @@ func (m *JetStreamConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *JetStreamMirror) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JetStreamMirror) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JetStreamMirror) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Replicas != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.Replicas))
+		i--
+		dAtA[i] = 0x28
+	}
+	i -= len(m.MaxAge)
+	copy(dAtA[i:], m.MaxAge)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.MaxAge)))
+	i--
+	dAtA[i] = 0x22
+	i -= len(m.EventName)
+	copy(dAtA[i:], m.EventName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.EventName)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.EventSourceName)
+	copy(dAtA[i:], m.EventSourceName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.EventSourceName)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *JetStreamStorageBudget) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JetStreamStorageBudget) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JetStreamStorageBudget) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.TightenedMaxAge)
+	copy(dAtA[i:], m.TightenedMaxAge)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.TightenedMaxAge)))
+	i--
+	dAtA[i] = 0x2a
+	if len(m.LowPriorityStreams) > 0 {
+		for iNdEx := len(m.LowPriorityStreams) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.LowPriorityStreams[iNdEx])
+			copy(dAtA[i:], m.LowPriorityStreams[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.LowPriorityStreams[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	i -= len(m.CheckInterval)
+	copy(dAtA[i:], m.CheckInterval)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.CheckInterval)))
+	i--
+	dAtA[i] = 0x1a
+	if m.CriticalWatermark != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.CriticalWatermark))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.WarningWatermark != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.WarningWatermark))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *JetStreamTenancy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JetStreamTenancy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JetStreamTenancy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AccountLimits != nil {
+		{
+			size, err := m.AccountLimits.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.AllowedNamespaces) > 0 {
+		for iNdEx := len(m.AllowedNamespaces) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedNamespaces[iNdEx])
+			copy(dAtA[i:], m.AllowedNamespaces[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.AllowedNamespaces[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *KafkaBus) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1205,6 +2152,32 @@ func (m *KafkaBus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Mirrors) > 0 {
+		for iNdEx := len(m.Mirrors) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Mirrors[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.Topics != nil {
+		{
+			size, err := m.Topics.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
 	if m.ConsumerGroup != nil {
 		{
 			size, err := m.ConsumerGroup.MarshalToSizedBuffer(dAtA[:i])
@@ -1300,6 +2273,87 @@ func (m *KafkaConsumerGroup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *KafkaMirror) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *KafkaMirror) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *KafkaMirror) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.EventName)
+	copy(dAtA[i:], m.EventName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.EventName)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.EventSourceName)
+	copy(dAtA[i:], m.EventSourceName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.EventSourceName)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Topic)
+	copy(dAtA[i:], m.Topic)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Topic)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *KafkaTopics) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *KafkaTopics) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *KafkaTopics) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Action)
+	copy(dAtA[i:], m.Action)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Action)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Trigger)
+	copy(dAtA[i:], m.Trigger)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Trigger)))
+	i--
+	dAtA[i] = 0x12
+	if m.AutoCreate != nil {
+		i--
+		if *m.AutoCreate {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *NATSBus) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1421,6 +2475,15 @@ func (m *NativeStrategy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.RuntimeClassName != nil {
+		i -= len(*m.RuntimeClassName)
+		copy(dAtA[i:], *m.RuntimeClassName)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.RuntimeClassName)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc2
+	}
 	if m.RaftCommitTimeout != nil {
 		i -= len(*m.RaftCommitTimeout)
 		copy(dAtA[i:], *m.RaftCommitTimeout)
@@ -1648,6 +2711,52 @@ func (m *NativeStrategy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PendingAction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingAction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingAction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i--
+	if m.DataLoss {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x20
+	i -= len(m.Description)
+	copy(dAtA[i:], m.Description)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Description)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Target)
+	copy(dAtA[i:], m.Target)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Target)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Type)
+	copy(dAtA[i:], m.Type)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Type)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *PersistenceStrategy) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1697,20 +2806,194 @@ func (m *PersistenceStrategy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintGenerated(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGenerated(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *SPIFFEAuth) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *BusConfig) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *SPIFFEAuth) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SPIFFEAuth) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.CSIDriver)
+	copy(dAtA[i:], m.CSIDriver)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.CSIDriver)))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ServiceAccounts) > 0 {
+		for iNdEx := len(m.ServiceAccounts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ServiceAccounts[iNdEx])
+			copy(dAtA[i:], m.ServiceAccounts[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.ServiceAccounts[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	i -= len(m.TrustDomain)
+	copy(dAtA[i:], m.TrustDomain)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.TrustDomain)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *SPIFFEConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SPIFFEConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SPIFFEConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.ServerID)
+	copy(dAtA[i:], m.ServerID)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ServerID)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.CSIDriver)
+	copy(dAtA[i:], m.CSIDriver)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.CSIDriver)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *SeedEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SeedEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SeedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Data)
+	copy(dAtA[i:], m.Data)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Data)))
+	i--
+	dAtA[i] = 0x22
+	i -= len(m.Type)
+	copy(dAtA[i:], m.Type)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Type)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.EventName)
+	copy(dAtA[i:], m.EventName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.EventName)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.EventSourceName)
+	copy(dAtA[i:], m.EventSourceName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.EventSourceName)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *SharedEventBus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SharedEventBus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SharedEventBus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Namespace)
+	copy(dAtA[i:], m.Namespace)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Namespace)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenerated(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenerated(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ActionPlan) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Version)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.ID)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.Generation))
+	if len(m.Actions) > 0 {
+		for _, e := range m.Actions {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	n += 2
+	l = m.CreatedAt.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *BusConfig) Size() (n int) {
+	if m == nil {
+		return 0
 	}
 	var l int
 	_ = l
@@ -1726,6 +3009,8 @@ func (m *BusConfig) Size() (n int) {
 		l = m.Kafka.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	l = len(m.Format)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -1778,6 +3063,23 @@ func (m *EventBusList) Size() (n int) {
 	return n
 }
 
+func (m *EventBusSeed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Events) > 0 {
+		for _, e := range m.Events {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	l = len(m.Delay)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *EventBusSpec) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1800,6 +3102,17 @@ func (m *EventBusSpec) Size() (n int) {
 		l = m.JetStreamExotic.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.Seed != nil {
+		l = m.Seed.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Shared != nil {
+		l = m.Shared.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.Format)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
 	return n
 }
 
@@ -1813,6 +3126,49 @@ func (m *EventBusStatus) Size() (n int) {
 	n += 1 + l + sovGenerated(uint64(l))
 	l = m.Config.Size()
 	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Ordering)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.TightenedStreams) > 0 {
+		for k, v := range m.TightenedStreams {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	if m.PendingActions != nil {
+		l = m.PendingActions.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if len(m.Mirrors) > 0 {
+		for _, s := range m.Mirrors {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *JetStreamAccountLimits) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxMemory != nil {
+		l = m.MaxMemory.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.MaxStorage != nil {
+		l = m.MaxStorage.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.MaxStreams != nil {
+		n += 1 + sovGenerated(uint64(*m.MaxStreams))
+	}
+	if m.MaxConsumers != nil {
+		n += 1 + sovGenerated(uint64(*m.MaxConsumers))
+	}
 	return n
 }
 
@@ -1900,6 +3256,28 @@ func (m *JetStreamBus) Size() (n int) {
 		l = len(*m.MaxPayload)
 		n += 2 + l + sovGenerated(uint64(l))
 	}
+	if m.RuntimeClassName != nil {
+		l = len(*m.RuntimeClassName)
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	if m.SPIFFE != nil {
+		l = m.SPIFFE.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	if m.Tenancy != nil {
+		l = m.Tenancy.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	if m.StorageBudget != nil {
+		l = m.StorageBudget.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	if len(m.Mirrors) > 0 {
+		for _, e := range m.Mirrors {
+			l = e.Size()
+			n += 2 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1917,68 +3295,82 @@ func (m *JetStreamConfig) Size() (n int) {
 	}
 	l = len(m.StreamConfig)
 	n += 1 + l + sovGenerated(uint64(l))
+	if m.SPIFFE != nil {
+		l = m.SPIFFE.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.TLS != nil {
+		l = m.TLS.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
-func (m *KafkaBus) Size() (n int) {
+func (m *JetStreamMirror) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.URL)
+	l = len(m.Name)
 	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Topic)
+	l = len(m.EventSourceName)
 	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Version)
+	l = len(m.EventName)
 	n += 1 + l + sovGenerated(uint64(l))
-	if m.TLS != nil {
-		l = m.TLS.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	if m.SASL != nil {
-		l = m.SASL.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	if m.ConsumerGroup != nil {
-		l = m.ConsumerGroup.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.MaxAge)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Replicas != nil {
+		n += 1 + sovGenerated(uint64(*m.Replicas))
 	}
 	return n
 }
 
-func (m *KafkaConsumerGroup) Size() (n int) {
+func (m *JetStreamStorageBudget) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.GroupName)
+	if m.WarningWatermark != nil {
+		n += 1 + sovGenerated(uint64(*m.WarningWatermark))
+	}
+	if m.CriticalWatermark != nil {
+		n += 1 + sovGenerated(uint64(*m.CriticalWatermark))
+	}
+	l = len(m.CheckInterval)
 	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.RebalanceStrategy)
+	if len(m.LowPriorityStreams) > 0 {
+		for _, s := range m.LowPriorityStreams {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	l = len(m.TightenedMaxAge)
 	n += 1 + l + sovGenerated(uint64(l))
-	n += 2
 	return n
 }
 
-func (m *NATSBus) Size() (n int) {
+func (m *JetStreamTenancy) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Native != nil {
-		l = m.Native.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+	if len(m.AllowedNamespaces) > 0 {
+		for _, s := range m.AllowedNamespaces {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	if m.Exotic != nil {
-		l = m.Exotic.Size()
+	if m.AccountLimits != nil {
+		l = m.AccountLimits.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
 	return n
 }
 
-func (m *NATSConfig) Size() (n int) {
+func (m *KafkaBus) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -1986,29 +3378,128 @@ func (m *NATSConfig) Size() (n int) {
 	_ = l
 	l = len(m.URL)
 	n += 1 + l + sovGenerated(uint64(l))
-	if m.ClusterID != nil {
-		l = len(*m.ClusterID)
+	l = len(m.Topic)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Version)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.TLS != nil {
+		l = m.TLS.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
-	if m.Auth != nil {
-		l = len(*m.Auth)
+	if m.SASL != nil {
+		l = m.SASL.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
-	if m.AccessSecret != nil {
-		l = m.AccessSecret.Size()
+	if m.ConsumerGroup != nil {
+		l = m.ConsumerGroup.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Topics != nil {
+		l = m.Topics.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if len(m.Mirrors) > 0 {
+		for _, e := range m.Mirrors {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
-func (m *NativeStrategy) Size() (n int) {
+func (m *KafkaConsumerGroup) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	n += 1 + sovGenerated(uint64(m.Replicas))
-	if m.Auth != nil {
+	l = len(m.GroupName)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.RebalanceStrategy)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
+	return n
+}
+
+func (m *KafkaMirror) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Topic)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.EventSourceName)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.EventName)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *KafkaTopics) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AutoCreate != nil {
+		n += 2
+	}
+	l = len(m.Trigger)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Action)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *NATSBus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Native != nil {
+		l = m.Native.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Exotic != nil {
+		l = m.Exotic.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *NATSConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.URL)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.ClusterID != nil {
+		l = len(*m.ClusterID)
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Auth != nil {
+		l = len(*m.Auth)
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.AccessSecret != nil {
+		l = m.AccessSecret.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *NativeStrategy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovGenerated(uint64(m.Replicas))
+	if m.Auth != nil {
 		l = len(*m.Auth)
 		n += 1 + l + sovGenerated(uint64(l))
 	}
@@ -2097,6 +3588,26 @@ func (m *NativeStrategy) Size() (n int) {
 		l = len(*m.RaftCommitTimeout)
 		n += 2 + l + sovGenerated(uint64(l))
 	}
+	if m.RuntimeClassName != nil {
+		l = len(*m.RuntimeClassName)
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *PendingAction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Type)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Target)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Description)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
 	return n
 }
 
@@ -2121,12 +3632,94 @@ func (m *PersistenceStrategy) Size() (n int) {
 	return n
 }
 
+func (m *SPIFFEAuth) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TrustDomain)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.ServiceAccounts) > 0 {
+		for _, s := range m.ServiceAccounts {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	l = len(m.CSIDriver)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *SPIFFEConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CSIDriver)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.ServerID)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *SeedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EventSourceName)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.EventName)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Type)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Data)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *SharedEventBus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func sovGenerated(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
 func sozGenerated(x uint64) (n int) {
 	return sovGenerated(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
+func (this *ActionPlan) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForActions := "[]PendingAction{"
+	for _, f := range this.Actions {
+		repeatedStringForActions += strings.Replace(strings.Replace(f.String(), "PendingAction", "PendingAction", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForActions += "}"
+	s := strings.Join([]string{`&ActionPlan{`,
+		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
+		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
+		`Generation:` + fmt.Sprintf("%v", this.Generation) + `,`,
+		`Actions:` + repeatedStringForActions + `,`,
+		`Approved:` + fmt.Sprintf("%v", this.Approved) + `,`,
+		`CreatedAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.CreatedAt), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *BusConfig) String() string {
 	if this == nil {
 		return "nil"
@@ -2135,6 +3728,7 @@ func (this *BusConfig) String() string {
 		`NATS:` + strings.Replace(this.NATS.String(), "NATSConfig", "NATSConfig", 1) + `,`,
 		`JetStream:` + strings.Replace(this.JetStream.String(), "JetStreamConfig", "JetStreamConfig", 1) + `,`,
 		`Kafka:` + strings.Replace(this.Kafka.String(), "KafkaBus", "KafkaBus", 1) + `,`,
+		`Format:` + fmt.Sprintf("%v", this.Format) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -2144,9 +3738,9 @@ func (this *ContainerTemplate) String() string {
 		return "nil"
 	}
 	s := strings.Join([]string{`&ContainerTemplate{`,
-		`Resources:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Resources), "ResourceRequirements", "v1.ResourceRequirements", 1), `&`, ``, 1) + `,`,
+		`Resources:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Resources), "ResourceRequirements", "v11.ResourceRequirements", 1), `&`, ``, 1) + `,`,
 		`ImagePullPolicy:` + fmt.Sprintf("%v", this.ImagePullPolicy) + `,`,
-		`SecurityContext:` + strings.Replace(fmt.Sprintf("%v", this.SecurityContext), "SecurityContext", "v1.SecurityContext", 1) + `,`,
+		`SecurityContext:` + strings.Replace(fmt.Sprintf("%v", this.SecurityContext), "SecurityContext", "v11.SecurityContext", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -2156,7 +3750,7 @@ func (this *EventBus) String() string {
 		return "nil"
 	}
 	s := strings.Join([]string{`&EventBus{`,
-		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v11.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
 		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "EventBusSpec", "EventBusSpec", 1), `&`, ``, 1) + `,`,
 		`Status:` + strings.Replace(strings.Replace(this.Status.String(), "EventBusStatus", "EventBusStatus", 1), `&`, ``, 1) + `,`,
 		`}`,
@@ -2173,12 +3767,28 @@ func (this *EventBusList) String() string {
 	}
 	repeatedStringForItems += "}"
 	s := strings.Join([]string{`&EventBusList{`,
-		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v11.ListMeta", 1), `&`, ``, 1) + `,`,
+		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
 		`Items:` + repeatedStringForItems + `,`,
 		`}`,
 	}, "")
 	return s
 }
+func (this *EventBusSeed) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForEvents := "[]SeedEvent{"
+	for _, f := range this.Events {
+		repeatedStringForEvents += strings.Replace(strings.Replace(f.String(), "SeedEvent", "SeedEvent", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForEvents += "}"
+	s := strings.Join([]string{`&EventBusSeed{`,
+		`Events:` + repeatedStringForEvents + `,`,
+		`Delay:` + fmt.Sprintf("%v", this.Delay) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *EventBusSpec) String() string {
 	if this == nil {
 		return "nil"
@@ -2188,6 +3798,10 @@ func (this *EventBusSpec) String() string {
 		`JetStream:` + strings.Replace(this.JetStream.String(), "JetStreamBus", "JetStreamBus", 1) + `,`,
 		`Kafka:` + strings.Replace(this.Kafka.String(), "KafkaBus", "KafkaBus", 1) + `,`,
 		`JetStreamExotic:` + strings.Replace(this.JetStreamExotic.String(), "JetStreamConfig", "JetStreamConfig", 1) + `,`,
+		`Seed:` + strings.Replace(this.Seed.String(), "EventBusSeed", "EventBusSeed", 1) + `,`,
+		`Shared:` + strings.Replace(this.Shared.String(), "SharedEventBus", "SharedEventBus", 1) + `,`,
+		`Format:` + fmt.Sprintf("%v", this.Format) + `,`,
+		`RequirePlanApproval:` + fmt.Sprintf("%v", this.RequirePlanApproval) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -2196,9 +3810,36 @@ func (this *EventBusStatus) String() string {
 	if this == nil {
 		return "nil"
 	}
+	keysForTightenedStreams := make([]string, 0, len(this.TightenedStreams))
+	for k := range this.TightenedStreams {
+		keysForTightenedStreams = append(keysForTightenedStreams, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForTightenedStreams)
+	mapStringForTightenedStreams := "map[string]string{"
+	for _, k := range keysForTightenedStreams {
+		mapStringForTightenedStreams += fmt.Sprintf("%v: %v,", k, this.TightenedStreams[k])
+	}
+	mapStringForTightenedStreams += "}"
 	s := strings.Join([]string{`&EventBusStatus{`,
 		`Status:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Status), "Status", "common.Status", 1), `&`, ``, 1) + `,`,
 		`Config:` + strings.Replace(strings.Replace(this.Config.String(), "BusConfig", "BusConfig", 1), `&`, ``, 1) + `,`,
+		`Ordering:` + fmt.Sprintf("%v", this.Ordering) + `,`,
+		`TightenedStreams:` + mapStringForTightenedStreams + `,`,
+		`PendingActions:` + strings.Replace(this.PendingActions.String(), "ActionPlan", "ActionPlan", 1) + `,`,
+		`Mirrors:` + fmt.Sprintf("%v", this.Mirrors) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *JetStreamAccountLimits) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&JetStreamAccountLimits{`,
+		`MaxMemory:` + strings.Replace(fmt.Sprintf("%v", this.MaxMemory), "Quantity", "resource.Quantity", 1) + `,`,
+		`MaxStorage:` + strings.Replace(fmt.Sprintf("%v", this.MaxStorage), "Quantity", "resource.Quantity", 1) + `,`,
+		`MaxStreams:` + valueToStringGenerated(this.MaxStreams) + `,`,
+		`MaxConsumers:` + valueToStringGenerated(this.MaxConsumers) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -2217,6 +3858,11 @@ func (this *JetStreamBus) String() string {
 		repeatedStringForImagePullSecrets += fmt.Sprintf("%v", f) + ","
 	}
 	repeatedStringForImagePullSecrets += "}"
+	repeatedStringForMirrors := "[]JetStreamMirror{"
+	for _, f := range this.Mirrors {
+		repeatedStringForMirrors += strings.Replace(strings.Replace(f.String(), "JetStreamMirror", "JetStreamMirror", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForMirrors += "}"
 	keysForNodeSelector := make([]string, 0, len(this.NodeSelector))
 	for k := range this.NodeSelector {
 		keysForNodeSelector = append(keysForNodeSelector, k)
@@ -2237,16 +3883,21 @@ func (this *JetStreamBus) String() string {
 		`Metadata:` + strings.Replace(fmt.Sprintf("%v", this.Metadata), "Metadata", "common.Metadata", 1) + `,`,
 		`NodeSelector:` + mapStringForNodeSelector + `,`,
 		`Tolerations:` + repeatedStringForTolerations + `,`,
-		`SecurityContext:` + strings.Replace(fmt.Sprintf("%v", this.SecurityContext), "PodSecurityContext", "v1.PodSecurityContext", 1) + `,`,
+		`SecurityContext:` + strings.Replace(fmt.Sprintf("%v", this.SecurityContext), "PodSecurityContext", "v11.PodSecurityContext", 1) + `,`,
 		`ImagePullSecrets:` + repeatedStringForImagePullSecrets + `,`,
 		`PriorityClassName:` + fmt.Sprintf("%v", this.PriorityClassName) + `,`,
 		`Priority:` + valueToStringGenerated(this.Priority) + `,`,
-		`Affinity:` + strings.Replace(fmt.Sprintf("%v", this.Affinity), "Affinity", "v1.Affinity", 1) + `,`,
+		`Affinity:` + strings.Replace(fmt.Sprintf("%v", this.Affinity), "Affinity", "v11.Affinity", 1) + `,`,
 		`ServiceAccountName:` + fmt.Sprintf("%v", this.ServiceAccountName) + `,`,
 		`Settings:` + valueToStringGenerated(this.Settings) + `,`,
 		`StartArgs:` + fmt.Sprintf("%v", this.StartArgs) + `,`,
 		`StreamConfig:` + valueToStringGenerated(this.StreamConfig) + `,`,
 		`MaxPayload:` + valueToStringGenerated(this.MaxPayload) + `,`,
+		`RuntimeClassName:` + valueToStringGenerated(this.RuntimeClassName) + `,`,
+		`SPIFFE:` + strings.Replace(this.SPIFFE.String(), "SPIFFEAuth", "SPIFFEAuth", 1) + `,`,
+		`Tenancy:` + strings.Replace(this.Tenancy.String(), "JetStreamTenancy", "JetStreamTenancy", 1) + `,`,
+		`StorageBudget:` + strings.Replace(this.StorageBudget.String(), "JetStreamStorageBudget", "JetStreamStorageBudget", 1) + `,`,
+		`Mirrors:` + repeatedStringForMirrors + `,`,
 		`}`,
 	}, "")
 	return s
@@ -2257,8 +3908,49 @@ func (this *JetStreamConfig) String() string {
 	}
 	s := strings.Join([]string{`&JetStreamConfig{`,
 		`URL:` + fmt.Sprintf("%v", this.URL) + `,`,
-		`AccessSecret:` + strings.Replace(fmt.Sprintf("%v", this.AccessSecret), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
+		`AccessSecret:` + strings.Replace(fmt.Sprintf("%v", this.AccessSecret), "SecretKeySelector", "v11.SecretKeySelector", 1) + `,`,
 		`StreamConfig:` + fmt.Sprintf("%v", this.StreamConfig) + `,`,
+		`SPIFFE:` + strings.Replace(this.SPIFFE.String(), "SPIFFEConfig", "SPIFFEConfig", 1) + `,`,
+		`TLS:` + strings.Replace(fmt.Sprintf("%v", this.TLS), "TLSConfig", "common.TLSConfig", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *JetStreamMirror) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&JetStreamMirror{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`EventSourceName:` + fmt.Sprintf("%v", this.EventSourceName) + `,`,
+		`EventName:` + fmt.Sprintf("%v", this.EventName) + `,`,
+		`MaxAge:` + fmt.Sprintf("%v", this.MaxAge) + `,`,
+		`Replicas:` + valueToStringGenerated(this.Replicas) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *JetStreamStorageBudget) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&JetStreamStorageBudget{`,
+		`WarningWatermark:` + valueToStringGenerated(this.WarningWatermark) + `,`,
+		`CriticalWatermark:` + valueToStringGenerated(this.CriticalWatermark) + `,`,
+		`CheckInterval:` + fmt.Sprintf("%v", this.CheckInterval) + `,`,
+		`LowPriorityStreams:` + fmt.Sprintf("%v", this.LowPriorityStreams) + `,`,
+		`TightenedMaxAge:` + fmt.Sprintf("%v", this.TightenedMaxAge) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *JetStreamTenancy) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&JetStreamTenancy{`,
+		`AllowedNamespaces:` + fmt.Sprintf("%v", this.AllowedNamespaces) + `,`,
+		`AccountLimits:` + strings.Replace(this.AccountLimits.String(), "JetStreamAccountLimits", "JetStreamAccountLimits", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -2267,6 +3959,11 @@ func (this *KafkaBus) String() string {
 	if this == nil {
 		return "nil"
 	}
+	repeatedStringForMirrors := "[]KafkaMirror{"
+	for _, f := range this.Mirrors {
+		repeatedStringForMirrors += strings.Replace(strings.Replace(f.String(), "KafkaMirror", "KafkaMirror", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForMirrors += "}"
 	s := strings.Join([]string{`&KafkaBus{`,
 		`URL:` + fmt.Sprintf("%v", this.URL) + `,`,
 		`Topic:` + fmt.Sprintf("%v", this.Topic) + `,`,
@@ -2274,6 +3971,8 @@ func (this *KafkaBus) String() string {
 		`TLS:` + strings.Replace(fmt.Sprintf("%v", this.TLS), "TLSConfig", "common.TLSConfig", 1) + `,`,
 		`SASL:` + strings.Replace(fmt.Sprintf("%v", this.SASL), "SASLConfig", "common.SASLConfig", 1) + `,`,
 		`ConsumerGroup:` + strings.Replace(this.ConsumerGroup.String(), "KafkaConsumerGroup", "KafkaConsumerGroup", 1) + `,`,
+		`Topics:` + strings.Replace(this.Topics.String(), "KafkaTopics", "KafkaTopics", 1) + `,`,
+		`Mirrors:` + repeatedStringForMirrors + `,`,
 		`}`,
 	}, "")
 	return s
@@ -2290,6 +3989,30 @@ func (this *KafkaConsumerGroup) String() string {
 	}, "")
 	return s
 }
+func (this *KafkaMirror) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&KafkaMirror{`,
+		`Topic:` + fmt.Sprintf("%v", this.Topic) + `,`,
+		`EventSourceName:` + fmt.Sprintf("%v", this.EventSourceName) + `,`,
+		`EventName:` + fmt.Sprintf("%v", this.EventName) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *KafkaTopics) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&KafkaTopics{`,
+		`AutoCreate:` + valueToStringGenerated(this.AutoCreate) + `,`,
+		`Trigger:` + fmt.Sprintf("%v", this.Trigger) + `,`,
+		`Action:` + fmt.Sprintf("%v", this.Action) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *NATSBus) String() string {
 	if this == nil {
 		return "nil"
@@ -2309,7 +4032,7 @@ func (this *NATSConfig) String() string {
 		`URL:` + fmt.Sprintf("%v", this.URL) + `,`,
 		`ClusterID:` + valueToStringGenerated(this.ClusterID) + `,`,
 		`Auth:` + valueToStringGenerated(this.Auth) + `,`,
-		`AccessSecret:` + strings.Replace(fmt.Sprintf("%v", this.AccessSecret), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
+		`AccessSecret:` + strings.Replace(fmt.Sprintf("%v", this.AccessSecret), "SecretKeySelector", "v11.SecretKeySelector", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -2347,13 +4070,13 @@ func (this *NativeStrategy) String() string {
 		`NodeSelector:` + mapStringForNodeSelector + `,`,
 		`Tolerations:` + repeatedStringForTolerations + `,`,
 		`Metadata:` + strings.Replace(fmt.Sprintf("%v", this.Metadata), "Metadata", "common.Metadata", 1) + `,`,
-		`SecurityContext:` + strings.Replace(fmt.Sprintf("%v", this.SecurityContext), "PodSecurityContext", "v1.PodSecurityContext", 1) + `,`,
+		`SecurityContext:` + strings.Replace(fmt.Sprintf("%v", this.SecurityContext), "PodSecurityContext", "v11.PodSecurityContext", 1) + `,`,
 		`MaxAge:` + valueToStringGenerated(this.MaxAge) + `,`,
 		`ImagePullSecrets:` + repeatedStringForImagePullSecrets + `,`,
 		`ServiceAccountName:` + fmt.Sprintf("%v", this.ServiceAccountName) + `,`,
 		`PriorityClassName:` + fmt.Sprintf("%v", this.PriorityClassName) + `,`,
 		`Priority:` + valueToStringGenerated(this.Priority) + `,`,
-		`Affinity:` + strings.Replace(fmt.Sprintf("%v", this.Affinity), "Affinity", "v1.Affinity", 1) + `,`,
+		`Affinity:` + strings.Replace(fmt.Sprintf("%v", this.Affinity), "Affinity", "v11.Affinity", 1) + `,`,
 		`MaxMsgs:` + valueToStringGenerated(this.MaxMsgs) + `,`,
 		`MaxBytes:` + valueToStringGenerated(this.MaxBytes) + `,`,
 		`MaxSubs:` + valueToStringGenerated(this.MaxSubs) + `,`,
@@ -2362,6 +4085,20 @@ func (this *NativeStrategy) String() string {
 		`RaftElectionTimeout:` + valueToStringGenerated(this.RaftElectionTimeout) + `,`,
 		`RaftLeaseTimeout:` + valueToStringGenerated(this.RaftLeaseTimeout) + `,`,
 		`RaftCommitTimeout:` + valueToStringGenerated(this.RaftCommitTimeout) + `,`,
+		`RuntimeClassName:` + valueToStringGenerated(this.RuntimeClassName) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PendingAction) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PendingAction{`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`Target:` + fmt.Sprintf("%v", this.Target) + `,`,
+		`Description:` + fmt.Sprintf("%v", this.Description) + `,`,
+		`DataLoss:` + fmt.Sprintf("%v", this.DataLoss) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -2378,6 +4115,53 @@ func (this *PersistenceStrategy) String() string {
 	}, "")
 	return s
 }
+func (this *SPIFFEAuth) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SPIFFEAuth{`,
+		`TrustDomain:` + fmt.Sprintf("%v", this.TrustDomain) + `,`,
+		`ServiceAccounts:` + fmt.Sprintf("%v", this.ServiceAccounts) + `,`,
+		`CSIDriver:` + fmt.Sprintf("%v", this.CSIDriver) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SPIFFEConfig) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SPIFFEConfig{`,
+		`CSIDriver:` + fmt.Sprintf("%v", this.CSIDriver) + `,`,
+		`ServerID:` + fmt.Sprintf("%v", this.ServerID) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SeedEvent) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SeedEvent{`,
+		`EventSourceName:` + fmt.Sprintf("%v", this.EventSourceName) + `,`,
+		`EventName:` + fmt.Sprintf("%v", this.EventName) + `,`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`Data:` + fmt.Sprintf("%v", this.Data) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SharedEventBus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SharedEventBus{`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func valueToStringGenerated(v interface{}) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -2386,7 +4170,7 @@ func valueToStringGenerated(v interface{}) string {
 	pv := reflect.Indirect(rv).Interface()
 	return fmt.Sprintf("*%v", pv)
 }
-func (m *BusConfig) Unmarshal(dAtA []byte) error {
+func (m *ActionPlan) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2409,17 +4193,17 @@ func (m *BusConfig) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BusConfig: wiretype end group for non-group")
+			return fmt.Errorf("proto: ActionPlan: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BusConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ActionPlan: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NATS", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -2429,23 +4213,243 @@ func (m *BusConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.NATS == nil {
-				m.NATS = &NATSConfig{}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Generation", wireType)
+			}
+			m.Generation = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Generation |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Actions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Actions = append(m.Actions, PendingAction{})
+			if err := m.Actions[len(m.Actions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Approved", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Approved = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BusConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BusConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BusConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NATS", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NATS == nil {
+				m.NATS = &NATSConfig{}
 			}
 			if err := m.NATS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -2523,6 +4527,38 @@ func (m *BusConfig) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Format", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Format = EventFormat(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -2668,7 +4704,7 @@ func (m *ContainerTemplate) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.SecurityContext == nil {
-				m.SecurityContext = &v1.SecurityContext{}
+				m.SecurityContext = &v11.SecurityContext{}
 			}
 			if err := m.SecurityContext.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -2961,7 +4997,7 @@ func (m *EventBusList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventBusSpec) Unmarshal(dAtA []byte) error {
+func (m *EventBusSeed) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2984,15 +5020,15 @@ func (m *EventBusSpec) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventBusSpec: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventBusSeed: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventBusSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventBusSeed: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NATS", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3019,18 +5055,16 @@ func (m *EventBusSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.NATS == nil {
-				m.NATS = &NATSBus{}
-			}
-			if err := m.NATS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Events = append(m.Events, SeedEvent{})
+			if err := m.Events[len(m.Events)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JetStream", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Delay", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -3040,99 +5074,23 @@ func (m *EventBusSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.JetStream == nil {
-				m.JetStream = &JetStreamBus{}
-			}
-			if err := m.JetStream.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kafka", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Kafka == nil {
-				m.Kafka = &KafkaBus{}
-			}
-			if err := m.Kafka.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JetStreamExotic", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.JetStreamExotic == nil {
-				m.JetStreamExotic = &JetStreamConfig{}
-			}
-			if err := m.JetStreamExotic.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Delay = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3155,7 +5113,7 @@ func (m *EventBusSpec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventBusStatus) Unmarshal(dAtA []byte) error {
+func (m *EventBusSpec) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3178,15 +5136,15 @@ func (m *EventBusStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventBusStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventBusSpec: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventBusStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventBusSpec: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NATS", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3213,13 +5171,16 @@ func (m *EventBusStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.NATS == nil {
+				m.NATS = &NATSBus{}
+			}
+			if err := m.NATS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JetStream", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3246,115 +5207,16 @@ func (m *EventBusStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.JetStream == nil {
+				m.JetStream = &JetStreamBus{}
 			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.JetStream.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *JetStreamBus) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: JetStreamBus: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JetStreamBus: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Version = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Replicas", wireType)
-			}
-			var v int32
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Replicas = &v
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContainerTemplate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kafka", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3381,16 +5243,16 @@ func (m *JetStreamBus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ContainerTemplate == nil {
-				m.ContainerTemplate = &ContainerTemplate{}
+			if m.Kafka == nil {
+				m.Kafka = &KafkaBus{}
 			}
-			if err := m.ContainerTemplate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Kafka.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReloaderContainerTemplate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JetStreamExotic", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3417,16 +5279,16 @@ func (m *JetStreamBus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ReloaderContainerTemplate == nil {
-				m.ReloaderContainerTemplate = &ContainerTemplate{}
+			if m.JetStreamExotic == nil {
+				m.JetStreamExotic = &JetStreamConfig{}
 			}
-			if err := m.ReloaderContainerTemplate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.JetStreamExotic.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MetricsContainerTemplate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Seed", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3453,16 +5315,16 @@ func (m *JetStreamBus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.MetricsContainerTemplate == nil {
-				m.MetricsContainerTemplate = &ContainerTemplate{}
+			if m.Seed == nil {
+				m.Seed = &EventBusSeed{}
 			}
-			if err := m.MetricsContainerTemplate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Seed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Persistence", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Shared", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3489,18 +5351,18 @@ func (m *JetStreamBus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Persistence == nil {
-				m.Persistence = &PersistenceStrategy{}
+			if m.Shared == nil {
+				m.Shared = &SharedEventBus{}
 			}
-			if err := m.Persistence.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Shared.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Format", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -3510,33 +5372,29 @@ func (m *JetStreamBus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Metadata == nil {
-				m.Metadata = &common.Metadata{}
-			}
-			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Format = EventFormat(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NodeSelector", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequirePlanApproval", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -3546,23 +5404,191 @@ func (m *JetStreamBus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
+			m.RequirePlanApproval = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventBusStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventBusStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventBusStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.NodeSelector == nil {
-				m.NodeSelector = make(map[string]string)
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ordering", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ordering = Ordering(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TightenedStreams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TightenedStreams == nil {
+				m.TightenedStreams = make(map[string]string)
 			}
 			var mapkey string
 			var mapvalue string
@@ -3657,11 +5683,11 @@ func (m *JetStreamBus) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.NodeSelector[mapkey] = mapvalue
+			m.TightenedStreams[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 9:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tolerations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingActions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3688,16 +5714,18 @@ func (m *JetStreamBus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tolerations = append(m.Tolerations, v1.Toleration{})
-			if err := m.Tolerations[len(m.Tolerations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.PendingActions == nil {
+				m.PendingActions = &ActionPlan{}
+			}
+			if err := m.PendingActions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SecurityContext", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Mirrors", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -3707,31 +5735,77 @@ func (m *JetStreamBus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SecurityContext == nil {
-				m.SecurityContext = &v1.PodSecurityContext{}
-			}
-			if err := m.SecurityContext.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Mirrors = append(m.Mirrors, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 11:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JetStreamAccountLimits) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JetStreamAccountLimits: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JetStreamAccountLimits: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ImagePullSecrets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMemory", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3758,16 +5832,18 @@ func (m *JetStreamBus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ImagePullSecrets = append(m.ImagePullSecrets, v1.LocalObjectReference{})
-			if err := m.ImagePullSecrets[len(m.ImagePullSecrets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.MaxMemory == nil {
+				m.MaxMemory = &resource.Quantity{}
+			}
+			if err := m.MaxMemory.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 12:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PriorityClassName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxStorage", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -3777,27 +5853,31 @@ func (m *JetStreamBus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PriorityClassName = string(dAtA[iNdEx:postIndex])
+			if m.MaxStorage == nil {
+				m.MaxStorage = &resource.Quantity{}
+			}
+			if err := m.MaxStorage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 13:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxStreams", wireType)
 			}
 			var v int32
 			for shift := uint(0); ; shift += 7 {
@@ -3814,12 +5894,12 @@ func (m *JetStreamBus) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.Priority = &v
-		case 14:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Affinity", wireType)
+			m.MaxStreams = &v
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxConsumers", wireType)
 			}
-			var msglen int
+			var v int32
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -3829,31 +5909,65 @@ func (m *JetStreamBus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
+			m.MaxConsumers = &v
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Affinity == nil {
-				m.Affinity = &v1.Affinity{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JetStreamBus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
 			}
-			if err := m.Affinity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 15:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JetStreamBus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JetStreamBus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServiceAccountName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3881,13 +5995,13 @@ func (m *JetStreamBus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ServiceAccountName = string(dAtA[iNdEx:postIndex])
+			m.Version = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 16:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Settings", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Replicas", wireType)
 			}
-			var stringLen uint64
+			var v int32
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -3897,30 +6011,17 @@ func (m *JetStreamBus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.Settings = &s
-			iNdEx = postIndex
-		case 17:
+			m.Replicas = &v
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartArgs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerTemplate", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -3930,29 +6031,33 @@ func (m *JetStreamBus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StartArgs = append(m.StartArgs, string(dAtA[iNdEx:postIndex]))
+			if m.ContainerTemplate == nil {
+				m.ContainerTemplate = &ContainerTemplate{}
+			}
+			if err := m.ContainerTemplate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 18:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StreamConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReloaderContainerTemplate", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -3962,30 +6067,33 @@ func (m *JetStreamBus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.StreamConfig = &s
+			if m.ReloaderContainerTemplate == nil {
+				m.ReloaderContainerTemplate = &ContainerTemplate{}
+			}
+			if err := m.ReloaderContainerTemplate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 19:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxPayload", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MetricsContainerTemplate", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -3995,80 +6103,33 @@ func (m *JetStreamBus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.MaxPayload = &s
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *JetStreamConfig) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.MetricsContainerTemplate == nil {
+				m.MetricsContainerTemplate = &ContainerTemplate{}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.MetricsContainerTemplate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: JetStreamConfig: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JetStreamConfig: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Persistence", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -4078,27 +6139,31 @@ func (m *JetStreamConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.URL = string(dAtA[iNdEx:postIndex])
+			if m.Persistence == nil {
+				m.Persistence = &PersistenceStrategy{}
+			}
+			if err := m.Persistence.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccessSecret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4125,18 +6190,18 @@ func (m *JetStreamConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.AccessSecret == nil {
-				m.AccessSecret = &v1.SecretKeySelector{}
+			if m.Metadata == nil {
+				m.Metadata = &common.Metadata{}
 			}
-			if err := m.AccessSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StreamConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeSelector", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -4146,79 +6211,124 @@ func (m *JetStreamConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StreamConfig = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			if m.NodeSelector == nil {
+				m.NodeSelector = make(map[string]string)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *KafkaBus) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: KafkaBus: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KafkaBus: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.NodeSelector[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tolerations", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -4228,29 +6338,31 @@ func (m *KafkaBus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.URL = string(dAtA[iNdEx:postIndex])
+			m.Tolerations = append(m.Tolerations, v11.Toleration{})
+			if err := m.Tolerations[len(m.Tolerations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Topic", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SecurityContext", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -4260,29 +6372,33 @@ func (m *KafkaBus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Topic = string(dAtA[iNdEx:postIndex])
+			if m.SecurityContext == nil {
+				m.SecurityContext = &v11.PodSecurityContext{}
+			}
+			if err := m.SecurityContext.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ImagePullSecrets", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -4292,29 +6408,31 @@ func (m *KafkaBus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Version = string(dAtA[iNdEx:postIndex])
+			m.ImagePullSecrets = append(m.ImagePullSecrets, v11.LocalObjectReference{})
+			if err := m.ImagePullSecrets[len(m.ImagePullSecrets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TLS", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PriorityClassName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -4324,31 +6442,47 @@ func (m *KafkaBus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TLS == nil {
-				m.TLS = &common.TLSConfig{}
+			m.PriorityClassName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
 			}
-			if err := m.TLS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 5:
+			m.Priority = &v
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SASL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Affinity", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4375,18 +6509,18 @@ func (m *KafkaBus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SASL == nil {
-				m.SASL = &common.SASLConfig{}
+			if m.Affinity == nil {
+				m.Affinity = &v11.Affinity{}
 			}
-			if err := m.SASL.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Affinity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerGroup", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceAccountName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -4396,81 +6530,92 @@ func (m *KafkaBus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ConsumerGroup == nil {
-				m.ConsumerGroup = &KafkaConsumerGroup{}
-			}
-			if err := m.ConsumerGroup.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ServiceAccountName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Settings", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *KafkaConsumerGroup) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			s := string(dAtA[iNdEx:postIndex])
+			m.Settings = &s
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartArgs", wireType)
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: KafkaConsumerGroup: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KafkaConsumerGroup: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StartArgs = append(m.StartArgs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 18:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StreamConfig", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4498,11 +6643,12 @@ func (m *KafkaConsumerGroup) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GroupName = string(dAtA[iNdEx:postIndex])
+			s := string(dAtA[iNdEx:postIndex])
+			m.StreamConfig = &s
 			iNdEx = postIndex
-		case 2:
+		case 19:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RebalanceStrategy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPayload", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4530,13 +6676,14 @@ func (m *KafkaConsumerGroup) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RebalanceStrategy = string(dAtA[iNdEx:postIndex])
+			s := string(dAtA[iNdEx:postIndex])
+			m.MaxPayload = &s
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartOldest", wireType)
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RuntimeClassName", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -4546,65 +6693,64 @@ func (m *KafkaConsumerGroup) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.StartOldest = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *NATSBus) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			s := string(dAtA[iNdEx:postIndex])
+			m.RuntimeClassName = &s
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SPIFFE", wireType)
 			}
-			if iNdEx >= l {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.SPIFFE == nil {
+				m.SPIFFE = &SPIFFEAuth{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: NATSBus: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NATSBus: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if err := m.SPIFFE.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 22:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Native", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tenancy", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4631,16 +6777,16 @@ func (m *NATSBus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Native == nil {
-				m.Native = &NativeStrategy{}
+			if m.Tenancy == nil {
+				m.Tenancy = &JetStreamTenancy{}
 			}
-			if err := m.Native.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Tenancy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 23:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Exotic", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageBudget", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4667,10 +6813,44 @@ func (m *NATSBus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Exotic == nil {
-				m.Exotic = &NATSConfig{}
+			if m.StorageBudget == nil {
+				m.StorageBudget = &JetStreamStorageBudget{}
 			}
-			if err := m.Exotic.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.StorageBudget.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mirrors", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Mirrors = append(m.Mirrors, JetStreamMirror{})
+			if err := m.Mirrors[len(m.Mirrors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4695,7 +6875,7 @@ func (m *NATSBus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NATSConfig) Unmarshal(dAtA []byte) error {
+func (m *JetStreamConfig) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4718,10 +6898,10 @@ func (m *NATSConfig) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NATSConfig: wiretype end group for non-group")
+			return fmt.Errorf("proto: JetStreamConfig: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NATSConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JetStreamConfig: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4758,9 +6938,9 @@ func (m *NATSConfig) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClusterID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccessSecret", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -4770,28 +6950,31 @@ func (m *NATSConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.ClusterID = &s
+			if m.AccessSecret == nil {
+				m.AccessSecret = &v11.SecretKeySelector{}
+			}
+			if err := m.AccessSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Auth", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StreamConfig", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4819,12 +7002,11 @@ func (m *NATSConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := AuthStrategy(dAtA[iNdEx:postIndex])
-			m.Auth = &s
+			m.StreamConfig = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccessSecret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SPIFFE", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4851,10 +7033,46 @@ func (m *NATSConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.AccessSecret == nil {
-				m.AccessSecret = &v1.SecretKeySelector{}
+			if m.SPIFFE == nil {
+				m.SPIFFE = &SPIFFEConfig{}
 			}
-			if err := m.AccessSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SPIFFE.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TLS", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TLS == nil {
+				m.TLS = &common.TLSConfig{}
+			}
+			if err := m.TLS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4879,7 +7097,7 @@ func (m *NATSConfig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NativeStrategy) Unmarshal(dAtA []byte) error {
+func (m *JetStreamMirror) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4902,17 +7120,17 @@ func (m *NativeStrategy) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NativeStrategy: wiretype end group for non-group")
+			return fmt.Errorf("proto: JetStreamMirror: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NativeStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JetStreamMirror: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Replicas", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			m.Replicas = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -4922,14 +7140,27 @@ func (m *NativeStrategy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Replicas |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Auth", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EventSourceName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4957,14 +7188,13 @@ func (m *NativeStrategy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := AuthStrategy(dAtA[iNdEx:postIndex])
-			m.Auth = &s
+			m.EventSourceName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Persistence", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EventName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -4974,33 +7204,29 @@ func (m *NativeStrategy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Persistence == nil {
-				m.Persistence = &PersistenceStrategy{}
-			}
-			if err := m.Persistence.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.EventName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContainerTemplate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAge", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -5010,33 +7236,139 @@ func (m *NativeStrategy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ContainerTemplate == nil {
-				m.ContainerTemplate = &ContainerTemplate{}
+			m.MaxAge = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Replicas", wireType)
 			}
-			if err := m.ContainerTemplate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Replicas = &v
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 5:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JetStreamStorageBudget) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JetStreamStorageBudget: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JetStreamStorageBudget: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WarningWatermark", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.WarningWatermark = &v
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CriticalWatermark", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CriticalWatermark = &v
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MetricsContainerTemplate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CheckInterval", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -5046,33 +7378,29 @@ func (m *NativeStrategy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.MetricsContainerTemplate == nil {
-				m.MetricsContainerTemplate = &ContainerTemplate{}
-			}
-			if err := m.MetricsContainerTemplate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.CheckInterval = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NodeSelector", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LowPriorityStreams", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -5082,35 +7410,1472 @@ func (m *NativeStrategy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.NodeSelector == nil {
-				m.NodeSelector = make(map[string]string)
+			m.LowPriorityStreams = append(m.LowPriorityStreams, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TightenedMaxAge", wireType)
 			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TightenedMaxAge = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JetStreamTenancy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JetStreamTenancy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JetStreamTenancy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedNamespaces", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedNamespaces = append(m.AllowedNamespaces, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AccountLimits == nil {
+				m.AccountLimits = &JetStreamAccountLimits{}
+			}
+			if err := m.AccountLimits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *KafkaBus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KafkaBus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KafkaBus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.URL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Topic", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Topic = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TLS", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TLS == nil {
+				m.TLS = &common.TLSConfig{}
+			}
+			if err := m.TLS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SASL", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SASL == nil {
+				m.SASL = &common.SASLConfig{}
+			}
+			if err := m.SASL.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerGroup", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ConsumerGroup == nil {
+				m.ConsumerGroup = &KafkaConsumerGroup{}
+			}
+			if err := m.ConsumerGroup.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Topics", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Topics == nil {
+				m.Topics = &KafkaTopics{}
+			}
+			if err := m.Topics.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mirrors", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Mirrors = append(m.Mirrors, KafkaMirror{})
+			if err := m.Mirrors[len(m.Mirrors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *KafkaConsumerGroup) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KafkaConsumerGroup: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KafkaConsumerGroup: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GroupName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RebalanceStrategy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RebalanceStrategy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartOldest", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.StartOldest = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *KafkaMirror) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KafkaMirror: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KafkaMirror: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Topic", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Topic = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventSourceName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EventSourceName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EventName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *KafkaTopics) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KafkaTopics: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KafkaTopics: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCreate", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.AutoCreate = &b
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Trigger", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Trigger = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Action = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NATSBus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NATSBus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NATSBus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Native", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Native == nil {
+				m.Native = &NativeStrategy{}
+			}
+			if err := m.Native.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Exotic", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Exotic == nil {
+				m.Exotic = &NATSConfig{}
+			}
+			if err := m.Exotic.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NATSConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NATSConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NATSConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.URL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.ClusterID = &s
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Auth", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := AuthStrategy(dAtA[iNdEx:postIndex])
+			m.Auth = &s
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccessSecret", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AccessSecret == nil {
+				m.AccessSecret = &v11.SecretKeySelector{}
+			}
+			if err := m.AccessSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NativeStrategy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NativeStrategy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NativeStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Replicas", wireType)
+			}
+			m.Replicas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Replicas |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Auth", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := AuthStrategy(dAtA[iNdEx:postIndex])
+			m.Auth = &s
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Persistence", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Persistence == nil {
+				m.Persistence = &PersistenceStrategy{}
+			}
+			if err := m.Persistence.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerTemplate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ContainerTemplate == nil {
+				m.ContainerTemplate = &ContainerTemplate{}
+			}
+			if err := m.ContainerTemplate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetricsContainerTemplate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MetricsContainerTemplate == nil {
+				m.MetricsContainerTemplate = &ContainerTemplate{}
+			}
+			if err := m.MetricsContainerTemplate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeSelector", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NodeSelector == nil {
+				m.NodeSelector = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
@@ -5119,87 +8884,701 @@ func (m *NativeStrategy) Unmarshal(dAtA []byte) error {
 						break
 					}
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.NodeSelector[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tolerations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tolerations = append(m.Tolerations, v11.Toleration{})
+			if err := m.Tolerations[len(m.Tolerations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &common.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecurityContext", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SecurityContext == nil {
+				m.SecurityContext = &v11.PodSecurityContext{}
+			}
+			if err := m.SecurityContext.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAge", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.MaxAge = &s
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ImagePullSecrets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ImagePullSecrets = append(m.ImagePullSecrets, v11.LocalObjectReference{})
+			if err := m.ImagePullSecrets[len(m.ImagePullSecrets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceAccountName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ServiceAccountName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriorityClassName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriorityClassName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Priority = &v
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Affinity", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Affinity == nil {
+				m.Affinity = &v11.Affinity{}
+			}
+			if err := m.Affinity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMsgs", wireType)
+			}
+			var v uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.MaxMsgs = &v
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBytes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.MaxBytes = &s
+			iNdEx = postIndex
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSubs", wireType)
+			}
+			var v uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.MaxSubs = &v
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPayload", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.MaxPayload = &s
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RaftHeartbeatTimeout", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.RaftHeartbeatTimeout = &s
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RaftElectionTimeout", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.RaftElectionTimeout = &s
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RaftLeaseTimeout", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.RaftLeaseTimeout = &s
+			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RaftCommitTimeout", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.RaftCommitTimeout = &s
+			iNdEx = postIndex
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RuntimeClassName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.NodeSelector[mapkey] = mapvalue
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.RuntimeClassName = &s
 			iNdEx = postIndex
-		case 7:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PendingAction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingAction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingAction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tolerations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -5209,31 +9588,29 @@ func (m *NativeStrategy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tolerations = append(m.Tolerations, v1.Toleration{})
-			if err := m.Tolerations[len(m.Tolerations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Type = PendingActionType(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -5243,33 +9620,29 @@ func (m *NativeStrategy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Metadata == nil {
-				m.Metadata = &common.Metadata{}
-			}
-			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Target = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SecurityContext", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -5279,31 +9652,97 @@ func (m *NativeStrategy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SecurityContext == nil {
-				m.SecurityContext = &v1.PodSecurityContext{}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataLoss", wireType)
 			}
-			if err := m.SecurityContext.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DataLoss = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 10:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PersistenceStrategy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PersistenceStrategy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PersistenceStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxAge", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageClassName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5332,11 +9771,44 @@ func (m *NativeStrategy) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			s := string(dAtA[iNdEx:postIndex])
-			m.MaxAge = &s
+			m.StorageClassName = &s
 			iNdEx = postIndex
-		case 11:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ImagePullSecrets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccessMode", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := k8s_io_api_core_v1.PersistentVolumeAccessMode(dAtA[iNdEx:postIndex])
+			m.AccessMode = &s
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VolumeSize", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5363,14 +9835,66 @@ func (m *NativeStrategy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ImagePullSecrets = append(m.ImagePullSecrets, v1.LocalObjectReference{})
-			if err := m.ImagePullSecrets[len(m.ImagePullSecrets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.VolumeSize == nil {
+				m.VolumeSize = &resource.Quantity{}
+			}
+			if err := m.VolumeSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 12:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SPIFFEAuth) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SPIFFEAuth: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SPIFFEAuth: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServiceAccountName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TrustDomain", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5398,11 +9922,11 @@ func (m *NativeStrategy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ServiceAccountName = string(dAtA[iNdEx:postIndex])
+			m.TrustDomain = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 13:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PriorityClassName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceAccounts", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5430,33 +9954,13 @@ func (m *NativeStrategy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PriorityClassName = string(dAtA[iNdEx:postIndex])
+			m.ServiceAccounts = append(m.ServiceAccounts, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 14:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
-			}
-			var v int32
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Priority = &v
-		case 15:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Affinity", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CSIDriver", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -5466,51 +9970,77 @@ func (m *NativeStrategy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Affinity == nil {
-				m.Affinity = &v1.Affinity{}
-			}
-			if err := m.Affinity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.CSIDriver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 16:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxMsgs", wireType)
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			var v uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.MaxMsgs = &v
-		case 17:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SPIFFEConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SPIFFEConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SPIFFEConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxBytes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CSIDriver", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5538,32 +10068,11 @@ func (m *NativeStrategy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.MaxBytes = &s
+			m.CSIDriver = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 18:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxSubs", wireType)
-			}
-			var v uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.MaxSubs = &v
-		case 19:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxPayload", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ServerID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5591,12 +10100,61 @@ func (m *NativeStrategy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.MaxPayload = &s
+			m.ServerID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 20:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SeedEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SeedEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SeedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RaftHeartbeatTimeout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EventSourceName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5624,12 +10182,11 @@ func (m *NativeStrategy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.RaftHeartbeatTimeout = &s
+			m.EventSourceName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 21:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RaftElectionTimeout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EventName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5657,12 +10214,11 @@ func (m *NativeStrategy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.RaftElectionTimeout = &s
+			m.EventName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 22:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RaftLeaseTimeout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5690,12 +10246,11 @@ func (m *NativeStrategy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.RaftLeaseTimeout = &s
+			m.Type = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 23:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RaftCommitTimeout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5723,8 +10278,7 @@ func (m *NativeStrategy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.RaftCommitTimeout = &s
+			m.Data = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5747,7 +10301,7 @@ func (m *NativeStrategy) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PersistenceStrategy) Unmarshal(dAtA []byte) error {
+func (m *SharedEventBus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5770,15 +10324,15 @@ func (m *PersistenceStrategy) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PersistenceStrategy: wiretype end group for non-group")
+			return fmt.Errorf("proto: SharedEventBus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PersistenceStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SharedEventBus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StorageClassName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5806,12 +10360,11 @@ func (m *PersistenceStrategy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.StorageClassName = &s
+			m.Namespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccessMode", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5839,44 +10392,7 @@ func (m *PersistenceStrategy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := k8s_io_api_core_v1.PersistentVolumeAccessMode(dAtA[iNdEx:postIndex])
-			m.AccessMode = &s
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VolumeSize", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.VolumeSize == nil {
-				m.VolumeSize = &resource.Quantity{}
-			}
-			if err := m.VolumeSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1,8 +1,11 @@
 package v1alpha1
 
 import (
+	"time"
+
 	"github.com/argoproj/argo-events/pkg/apis/common"
 	corev1 "k8s.io/api/core/v1"
+	apiresource "k8s.io/apimachinery/pkg/api/resource"
 )
 
 // JetStreamBus holds the JetStream EventBus information
@@ -86,6 +89,155 @@ type JetStreamBus struct {
 	// Maximum number of bytes in a message payload, 0 means unlimited. Defaults to 1MB
 	// +optional
 	MaxPayload *string `json:"maxPayload,omitempty" protobuf:"bytes,19,opt,name=maxPayload"`
+	// RuntimeClassName refers to a RuntimeClass object in the node.k8s.io group, which should be used to run the JetStream pods.
+	// More info: https://kubernetes.io/docs/concepts/containers/runtime-class/
+	// +optional
+	RuntimeClassName *string `json:"runtimeClassName,omitempty" protobuf:"bytes,20,opt,name=runtimeClassName"`
+	// SPIFFE enables the mutual TLS authentication of the EventSource and Sensor pods with their SPIFFE workload
+	// identity, instead of the generated credentials shared through a Secret.
+	// +optional
+	SPIFFE *SPIFFEAuth `json:"spiffe,omitempty" protobuf:"bytes,21,opt,name=spiffe"`
+	// Tenancy makes the EventBus serve the shared EventBuses of other namespaces, each tenant EventBus
+	// getting its own account, with its own streams, on this JetStream cluster.
+	// +optional
+	Tenancy *JetStreamTenancy `json:"tenancy,omitempty" protobuf:"bytes,22,opt,name=tenancy"`
+	// StorageBudget makes the controller watch the storage usage of the JetStream servers, to warn and optionally
+	// tighten the retention of the low priority streams before the storage limit is reached and the publishes are
+	// rejected.
+	// +optional
+	StorageBudget *JetStreamStorageBudget `json:"storageBudget,omitempty" protobuf:"bytes,23,opt,name=storageBudget"`
+	// Mirrors are read-only copies of the stream of the events, created and kept in sync by the JetStream servers,
+	// that other consumers, e.g. analytics, can read without consuming or delaying the events of the Sensors.
+	// With the SPIFFE authentication, the controller needs to be allowed to connect.
+	// +optional
+	Mirrors []JetStreamMirror `json:"mirrors,omitempty" protobuf:"bytes,24,rep,name=mirrors"`
+}
+
+// JetStreamMirror is a stream mirroring the events of a JetStream EventBus, or a subset of them.
+type JetStreamMirror struct {
+	// Name is the name of the mirror stream, it can't be "default", the name of the stream of the events.
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// EventSourceName only mirrors the events of this EventSource, all the EventSources are mirrored if not specified.
+	// +optional
+	EventSourceName string `json:"eventSourceName,omitempty" protobuf:"bytes,2,opt,name=eventSourceName"`
+	// EventName only mirrors the events with this name, all the events are mirrored if not specified.
+	// +optional
+	EventName string `json:"eventName,omitempty" protobuf:"bytes,3,opt,name=eventName"`
+	// MaxAge is the max age of the messages of the mirror, e.g. 168h, defaults to the max age of the stream of the
+	// events.
+	// +optional
+	MaxAge string `json:"maxAge,omitempty" protobuf:"bytes,4,opt,name=maxAge"`
+	// Replicas is the number of replicas of the mirror, defaults to the number of replicas of the stream of the
+	// events.
+	// +optional
+	Replicas *int32 `json:"replicas,omitempty" protobuf:"varint,5,opt,name=replicas"`
+}
+
+// FilterSubject returns the subject of the events mirrored, "default.*.*" for all the events.
+func (m JetStreamMirror) FilterSubject(stream string) string {
+	eventSource, event := m.EventSourceName, m.EventName
+	if eventSource == "" {
+		eventSource = "*"
+	}
+	if event == "" {
+		event = "*"
+	}
+	return stream + "." + eventSource + "." + event
+}
+
+// JetStreamStorageBudget configures the watermarks of the storage usage of a JetStream EventBus, in percent of the
+// storage limit of the JetStream servers. The usage of the most used server is compared to the watermarks.
+type JetStreamStorageBudget struct {
+	// WarningWatermark is the storage usage above which the StorageWithinBudget condition is set to False,
+	// defaults to 75.
+	// +optional
+	WarningWatermark *int32 `json:"warningWatermark,omitempty" protobuf:"varint,1,opt,name=warningWatermark"`
+	// CriticalWatermark is the storage usage above which the retention of the low priority streams is tightened,
+	// defaults to 90.
+	// +optional
+	CriticalWatermark *int32 `json:"criticalWatermark,omitempty" protobuf:"varint,2,opt,name=criticalWatermark"`
+	// CheckInterval is how often the storage usage is checked, defaults to 1m.
+	// +optional
+	CheckInterval string `json:"checkInterval,omitempty" protobuf:"bytes,3,opt,name=checkInterval"`
+	// LowPriorityStreams are the names of the streams whose retention is tightened above the critical watermark,
+	// e.g. "KV_my-sensor" for the Key/Value store of the Sensor "my-sensor". The retention is never tightened if not
+	// specified.
+	// With the SPIFFE authentication, the controller needs to be allowed to connect.
+	// +optional
+	LowPriorityStreams []string `json:"lowPriorityStreams,omitempty" protobuf:"bytes,4,rep,name=lowPriorityStreams"`
+	// TightenedMaxAge is the max age of the messages of the low priority streams above the critical watermark,
+	// defaults to 1h. Their original max age is restored once the storage usage is back under the warning watermark.
+	// +optional
+	TightenedMaxAge string `json:"tightenedMaxAge,omitempty" protobuf:"bytes,5,opt,name=tightenedMaxAge"`
+}
+
+// GetWarningWatermark returns the warning watermark in percent, defaults to 75.
+func (b JetStreamStorageBudget) GetWarningWatermark() int32 {
+	if b.WarningWatermark == nil {
+		return 75
+	}
+	return *b.WarningWatermark
+}
+
+// GetCriticalWatermark returns the critical watermark in percent, defaults to 90.
+func (b JetStreamStorageBudget) GetCriticalWatermark() int32 {
+	if b.CriticalWatermark == nil {
+		return 90
+	}
+	return *b.CriticalWatermark
+}
+
+// GetCheckInterval returns how often the storage usage is checked, defaults to 1m.
+func (b JetStreamStorageBudget) GetCheckInterval() time.Duration {
+	if d, err := time.ParseDuration(b.CheckInterval); err == nil && d > 0 {
+		return d
+	}
+	return time.Minute
+}
+
+// GetTightenedMaxAge returns the max age of the low priority streams above the critical watermark, defaults to 1h.
+func (b JetStreamStorageBudget) GetTightenedMaxAge() time.Duration {
+	if d, err := time.ParseDuration(b.TightenedMaxAge); err == nil && d > 0 {
+		return d
+	}
+	return time.Hour
+}
+
+// JetStreamTenancy configures the tenant EventBuses served by a JetStream EventBus.
+type JetStreamTenancy struct {
+	// AllowedNamespaces are the namespaces of the tenant EventBuses, "*" allows all the namespaces.
+	// No namespace is allowed if not specified.
+	// +optional
+	AllowedNamespaces []string `json:"allowedNamespaces,omitempty" protobuf:"bytes,1,rep,name=allowedNamespaces"`
+	// AccountLimits are the JetStream limits of the account of each tenant EventBus, not limited if not specified.
+	// +optional
+	AccountLimits *JetStreamAccountLimits `json:"accountLimits,omitempty" protobuf:"bytes,2,opt,name=accountLimits"`
+}
+
+// IsAllowed returns whether the EventBuses of a namespace can be tenants
+func (t *JetStreamTenancy) IsAllowed(namespace string) bool {
+	for _, ns := range t.AllowedNamespaces {
+		if ns == namespace || ns == "*" {
+			return true
+		}
+	}
+	return false
+}
+
+// JetStreamAccountLimits are the JetStream resources an account can use.
+type JetStreamAccountLimits struct {
+	// MaxMemory is the memory the memory streams of the account can use, such as 1Gi.
+	// +optional
+	MaxMemory *apiresource.Quantity `json:"maxMemory,omitempty" protobuf:"bytes,1,opt,name=maxMemory"`
+	// MaxStorage is the storage the file streams of the account can use, such as 10Gi.
+	// +optional
+	MaxStorage *apiresource.Quantity `json:"maxStorage,omitempty" protobuf:"bytes,2,opt,name=maxStorage"`
+	// MaxStreams is the number of streams of the account.
+	// +optional
+	MaxStreams *int32 `json:"maxStreams,omitempty" protobuf:"varint,3,opt,name=maxStreams"`
+	// MaxConsumers is the number of consumers of the account.
+	// +optional
+	MaxConsumers *int32 `json:"maxConsumers,omitempty" protobuf:"varint,4,opt,name=maxConsumers"`
 }
 
 func (j JetStreamBus) GetReplicas() int {
@@ -103,4 +255,55 @@ type JetStreamConfig struct {
 	AccessSecret *corev1.SecretKeySelector `json:"accessSecret,omitempty" protobuf:"bytes,2,opt,name=accessSecret"`
 	// +optional
 	StreamConfig string `json:"streamConfig,omitempty" protobuf:"bytes,3,opt,name=streamConfig"`
+	// SPIFFE authenticates the clients with the X.509 SVID of their workload identity, instead of the access secret.
+	// +optional
+	SPIFFE *SPIFFEConfig `json:"spiffe,omitempty" protobuf:"bytes,4,opt,name=spiffe"`
+	// TLS verifies the certificate of the servers, instead of skipping the verification.
+	// +optional
+	TLS *common.TLSConfig `json:"tls,omitempty" protobuf:"bytes,5,opt,name=tls"`
+}
+
+// DefaultSPIFFECSIDriver is the CSI driver of cert-manager mounting the SPIFFE X.509 SVIDs of the pods.
+const DefaultSPIFFECSIDriver = "spiffe.csi.cert-manager.io"
+
+// SPIFFEAuth configures the JetStream servers to authenticate their clients with their SPIFFE IDs, in the
+// "spiffe://<trust domain>/ns/<namespace>/sa/<service account>" format.
+type SPIFFEAuth struct {
+	// TrustDomain of the SPIFFE IDs, e.g. "cluster.local".
+	TrustDomain string `json:"trustDomain" protobuf:"bytes,1,opt,name=trustDomain"`
+	// ServiceAccounts of the EventSource and Sensor pods allowed to connect, as "<namespace>/<name>", or "<name>"
+	// in the namespace of the EventBus. Defaults to the "default" service account of the namespace of the EventBus.
+	// +optional
+	ServiceAccounts []string `json:"serviceAccounts,omitempty" protobuf:"bytes,2,rep,name=serviceAccounts"`
+	// CSIDriver is the CSI driver mounting the X.509 SVID of the pods, as the "tls.crt", "tls.key" and "ca.crt"
+	// files, defaults to "spiffe.csi.cert-manager.io".
+	// +optional
+	CSIDriver string `json:"csiDriver,omitempty" protobuf:"bytes,3,opt,name=csiDriver"`
+}
+
+// GetCSIDriver returns the CSI driver mounting the SVIDs, defaults to "spiffe.csi.cert-manager.io".
+func (s SPIFFEAuth) GetCSIDriver() string {
+	if s.CSIDriver == "" {
+		return DefaultSPIFFECSIDriver
+	}
+	return s.CSIDriver
+}
+
+// SPIFFEConfig is the SPIFFE authentication of the clients of a JetStream EventBus.
+type SPIFFEConfig struct {
+	// CSIDriver is the CSI driver mounting the X.509 SVID of the pods, as the "tls.crt", "tls.key" and "ca.crt"
+	// files, defaults to "spiffe.csi.cert-manager.io".
+	// +optional
+	CSIDriver string `json:"csiDriver,omitempty" protobuf:"bytes,1,opt,name=csiDriver"`
+	// ServerID is the SPIFFE ID expected from the JetStream servers, only their certificate chain is verified if empty.
+	// +optional
+	ServerID string `json:"serverID,omitempty" protobuf:"bytes,2,opt,name=serverID"`
+}
+
+// GetCSIDriver returns the CSI driver mounting the SVIDs, defaults to "spiffe.csi.cert-manager.io".
+func (s SPIFFEConfig) GetCSIDriver() string {
+	if s.CSIDriver == "" {
+		return DefaultSPIFFECSIDriver
+	}
+	return s.CSIDriver
 }
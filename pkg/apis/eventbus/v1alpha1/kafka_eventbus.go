@@ -23,6 +23,79 @@ type KafkaBus struct {
 	// Consumer group for kafka client
 	// +optional
 	ConsumerGroup *KafkaConsumerGroup `json:"consumerGroup,omitempty" protobuf:"bytes,6,opt,name=consumerGroup"`
+	// Topics configures the creation and the naming of the topics
+	// +optional
+	Topics *KafkaTopics `json:"topics,omitempty" protobuf:"bytes,7,opt,name=topics"`
+	// Mirrors are topics the EventSources publish a copy of the events to, or a subset of them, that other
+	// consumers, e.g. analytics, can read without consuming or delaying the events of the Sensors.
+	// +optional
+	Mirrors []KafkaMirror `json:"mirrors,omitempty" protobuf:"bytes,8,rep,name=mirrors"`
+}
+
+// KafkaMirror is a topic mirroring the events of a Kafka EventBus, or a subset of them.
+type KafkaMirror struct {
+	// Topic is the name of the mirror topic, it can't be the topic of the events.
+	Topic string `json:"topic" protobuf:"bytes,1,opt,name=topic"`
+	// EventSourceName only mirrors the events of this EventSource, all the EventSources are mirrored if not specified.
+	// +optional
+	EventSourceName string `json:"eventSourceName,omitempty" protobuf:"bytes,2,opt,name=eventSourceName"`
+	// EventName only mirrors the events with this name, all the events are mirrored if not specified.
+	// +optional
+	EventName string `json:"eventName,omitempty" protobuf:"bytes,3,opt,name=eventName"`
+}
+
+// Matches returns whether the events of the EventSource with the name are mirrored to the topic.
+func (m KafkaMirror) Matches(eventSourceName, eventName string) bool {
+	return (m.EventSourceName == "" || m.EventSourceName == eventSourceName) && (m.EventName == "" || m.EventName == eventName)
+}
+
+// KafkaTopics configures the creation and the naming of the topics.
+type KafkaTopics struct {
+	// AutoCreate determines whether the topics that do not exist are created by the brokers when they are first used,
+	// which requires the "auto.create.topics.enable" setting of the brokers. Defaults to true.
+	// Set it to false when the topics are provisioned externally, the EventSources and Sensors then fail fast if a
+	// topic does not exist.
+	// +optional
+	AutoCreate *bool `json:"autoCreate,omitempty" protobuf:"varint,1,opt,name=autoCreate"`
+	// Trigger is a Go template of the name of the trigger topic of a Sensor, defaults to "{{.Topic}}-{{.SensorName}}-trigger".
+	// The available variables are .Topic (the event topic), .Namespace and .SensorName.
+	// +optional
+	Trigger string `json:"trigger,omitempty" protobuf:"bytes,2,opt,name=trigger"`
+	// Action is a Go template of the name of the action topic of a Sensor, defaults to "{{.Topic}}-{{.SensorName}}-action".
+	// The available variables are .Topic (the event topic), .Namespace and .SensorName.
+	// +optional
+	Action string `json:"action,omitempty" protobuf:"bytes,3,opt,name=action"`
+}
+
+const (
+	// DefaultKafkaTriggerTopic is the default template of the name of the trigger topic of a Sensor
+	DefaultKafkaTriggerTopic = "{{.Topic}}-{{.SensorName}}-trigger"
+	// DefaultKafkaActionTopic is the default template of the name of the action topic of a Sensor
+	DefaultKafkaActionTopic = "{{.Topic}}-{{.SensorName}}-action"
+)
+
+// GetAutoCreate returns whether the topics are created automatically, defaults to true
+func (t *KafkaTopics) GetAutoCreate() bool {
+	if t == nil || t.AutoCreate == nil {
+		return true
+	}
+	return *t.AutoCreate
+}
+
+// GetTrigger returns the template of the name of the trigger topic
+func (t *KafkaTopics) GetTrigger() string {
+	if t == nil || t.Trigger == "" {
+		return DefaultKafkaTriggerTopic
+	}
+	return t.Trigger
+}
+
+// GetAction returns the template of the name of the action topic
+func (t *KafkaTopics) GetAction() string {
+	if t == nil || t.Action == "" {
+		return DefaultKafkaActionTopic
+	}
+	return t.Action
 }
 
 type KafkaConsumerGroup struct {
@@ -101,6 +101,10 @@ type NativeStrategy struct {
 	RaftLeaseTimeout *string `json:"raftLeaseTimeout,omitempty" protobuf:"bytes,22,opt,name=raftLeaseTimeout"`
 	// Specifies the time without an Apply() operation before sending an heartbeat to ensure timely commit, i.e. "72h", “4h35m”. Defaults to 100ms
 	RaftCommitTimeout *string `json:"raftCommitTimeout,omitempty" protobuf:"bytes,23,opt,name=raftCommitTimeout"`
+	// RuntimeClassName refers to a RuntimeClass object in the node.k8s.io group, which should be used to run the NATS pods.
+	// More info: https://kubernetes.io/docs/concepts/containers/runtime-class/
+	// +optional
+	RuntimeClassName *string `json:"runtimeClassName,omitempty" protobuf:"bytes,24,opt,name=runtimeClassName"`
 }
 
 // GetReplicas return the replicas of statefulset
@@ -30,20 +30,101 @@ import (
 
 func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenAPIDefinition {
 	return map[string]common.OpenAPIDefinition{
-		"github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1.BusConfig":           schema_pkg_apis_eventbus_v1alpha1_BusConfig(ref),
-		"github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1.ContainerTemplate":   schema_pkg_apis_eventbus_v1alpha1_ContainerTemplate(ref),
-		"github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1.EventBus":            schema_pkg_apis_eventbus_v1alpha1_EventBus(ref),
-		"github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1.EventBusList":        schema_pkg_apis_eventbus_v1alpha1_EventBusList(ref),
-		"github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1.EventBusSpec":        schema_pkg_apis_eventbus_v1alpha1_EventBusSpec(ref),
-		"github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1.EventBusStatus":      schema_pkg_apis_eventbus_v1alpha1_EventBusStatus(ref),
-		"github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1.JetStreamBus":        schema_pkg_apis_eventbus_v1alpha1_JetStreamBus(ref),
-		"github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1.JetStreamConfig":     schema_pkg_apis_eventbus_v1alpha1_JetStreamConfig(ref),
-		"github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1.KafkaBus":            schema_pkg_apis_eventbus_v1alpha1_KafkaBus(ref),
-		"github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1.KafkaConsumerGroup":  schema_pkg_apis_eventbus_v1alpha1_KafkaConsumerGroup(ref),
-		"github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1.NATSBus":             schema_pkg_apis_eventbus_v1alpha1_NATSBus(ref),
-		"github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1.NATSConfig":          schema_pkg_apis_eventbus_v1alpha1_NATSConfig(ref),
-		"github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1.NativeStrategy":      schema_pkg_apis_eventbus_v1alpha1_NativeStrategy(ref),
-		"github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1.PersistenceStrategy": schema_pkg_apis_eventbus_v1alpha1_PersistenceStrategy(ref),
+		"github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1.ActionPlan":             schema_pkg_apis_eventbus_v1alpha1_ActionPlan(ref),
+		"github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1.BusConfig":              schema_pkg_apis_eventbus_v1alpha1_BusConfig(ref),
+		"github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1.ContainerTemplate":      schema_pkg_apis_eventbus_v1alpha1_ContainerTemplate(ref),
+		"github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1.EventBus":               schema_pkg_apis_eventbus_v1alpha1_EventBus(ref),
+		"github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1.EventBusList":           schema_pkg_apis_eventbus_v1alpha1_EventBusList(ref),
+		"github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1.EventBusSeed":           schema_pkg_apis_eventbus_v1alpha1_EventBusSeed(ref),
+		"github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1.EventBusSpec":           schema_pkg_apis_eventbus_v1alpha1_EventBusSpec(ref),
+		"github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1.EventBusStatus":         schema_pkg_apis_eventbus_v1alpha1_EventBusStatus(ref),
+		"github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1.JetStreamAccountLimits": schema_pkg_apis_eventbus_v1alpha1_JetStreamAccountLimits(ref),
+		"github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1.JetStreamBus":           schema_pkg_apis_eventbus_v1alpha1_JetStreamBus(ref),
+		"github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1.JetStreamConfig":        schema_pkg_apis_eventbus_v1alpha1_JetStreamConfig(ref),
+		"github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1.JetStreamMirror":        schema_pkg_apis_eventbus_v1alpha1_JetStreamMirror(ref),
+		"github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1.JetStreamStorageBudget": schema_pkg_apis_eventbus_v1alpha1_JetStreamStorageBudget(ref),
+		"github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1.JetStreamTenancy":       schema_pkg_apis_eventbus_v1alpha1_JetStreamTenancy(ref),
+		"github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1.KafkaBus":               schema_pkg_apis_eventbus_v1alpha1_KafkaBus(ref),
+		"github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1.KafkaConsumerGroup":     schema_pkg_apis_eventbus_v1alpha1_KafkaConsumerGroup(ref),
+		"github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1.KafkaMirror":            schema_pkg_apis_eventbus_v1alpha1_KafkaMirror(ref),
+		"github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1.KafkaTopics":            schema_pkg_apis_eventbus_v1alpha1_KafkaTopics(ref),
+		"github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1.NATSBus":                schema_pkg_apis_eventbus_v1alpha1_NATSBus(ref),
+		"github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1.NATSConfig":             schema_pkg_apis_eventbus_v1alpha1_NATSConfig(ref),
+		"github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1.NativeStrategy":         schema_pkg_apis_eventbus_v1alpha1_NativeStrategy(ref),
+		"github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1.PendingAction":          schema_pkg_apis_eventbus_v1alpha1_PendingAction(ref),
+		"github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1.PersistenceStrategy":    schema_pkg_apis_eventbus_v1alpha1_PersistenceStrategy(ref),
+		"github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1.SPIFFEAuth":             schema_pkg_apis_eventbus_v1alpha1_SPIFFEAuth(ref),
+		"github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1.SPIFFEConfig":           schema_pkg_apis_eventbus_v1alpha1_SPIFFEConfig(ref),
+		"github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1.SeedEvent":              schema_pkg_apis_eventbus_v1alpha1_SeedEvent(ref),
+		"github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1.SharedEventBus":         schema_pkg_apis_eventbus_v1alpha1_SharedEventBus(ref),
+	}
+}
+
+func schema_pkg_apis_eventbus_v1alpha1_ActionPlan(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ActionPlan lists the destructive actions the controller is about to execute to apply a spec change of an EventBus.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"version": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Version is the version of the format of the plan, \"v1\".",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"id": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ID identifies the plan, it changes with the actions and with the generation of the EventBus.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"generation": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Generation is the generation of the EventBus spec the plan applies.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"actions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Actions are the destructive actions, in the order they are executed.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1.PendingAction"),
+									},
+								},
+							},
+						},
+					},
+					"approved": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Approved is whether the actions can be executed, it is false while the EventBus requires the approval of the plans and the plan isn't approved.",
+							Default:     false,
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"createdAt": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CreatedAt is the time the plan was computed.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+				Required: []string{"version", "id", "generation", "actions", "approved", "createdAt"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1.PendingAction", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
@@ -69,6 +150,13 @@ func schema_pkg_apis_eventbus_v1alpha1_BusConfig(ref common.ReferenceCallback) c
 							Ref: ref("github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1.KafkaBus"),
 						},
 					},
+					"format": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Format is the format of the CloudEvents published to the EventBus",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
@@ -206,6 +294,43 @@ func schema_pkg_apis_eventbus_v1alpha1_EventBusList(ref common.ReferenceCallback
 	}
 }
 
+func schema_pkg_apis_eventbus_v1alpha1_EventBusSeed(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "EventBusSeed describes synthetic events published once to the EventBus after it is deployed, e.g. for a preview environment to come up with data. They are published by a Job, again only if the seed changes.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"events": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Events are the events to publish, in order.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1.SeedEvent"),
+									},
+								},
+							},
+						},
+					},
+					"delay": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Delay is how long to wait after the EventBus is deployed before publishing the events, e.g. \"1m\", to let the Sensors subscribe. Defaults to no delay.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"events"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1.SeedEvent"},
+	}
+}
+
 func schema_pkg_apis_eventbus_v1alpha1_EventBusSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -236,11 +361,37 @@ func schema_pkg_apis_eventbus_v1alpha1_EventBusSpec(ref common.ReferenceCallback
 							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1.JetStreamConfig"),
 						},
 					},
+					"seed": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Seed holds synthetic events published to the EventBus once it is deployed",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1.EventBusSeed"),
+						},
+					},
+					"shared": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Shared uses a JetStream EventBus of another namespace, which serves the tenant namespaces with an isolated account each, instead of deploying one.",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1.SharedEventBus"),
+						},
+					},
+					"format": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Format is the format of the CloudEvents published to the EventBus, \"JSON\" or \"Protobuf\". Defaults to \"JSON\". The protobuf format reduces the size of the messages, the receivers decode both formats, so that the format can be changed without losing the events already published.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"requirePlanApproval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RequirePlanApproval makes the controller wait for the approval of the plan of the destructive actions required by a spec change, e.g. the recreation of the StatefulSet, before executing them. A plan is approved by annotating the EventBus with \"events.argoproj.io/approved-plan: <plan id>\".",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1.JetStreamBus", "github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1.JetStreamConfig", "github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1.KafkaBus", "github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1.NATSBus"},
+			"github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1.EventBusSeed", "github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1.JetStreamBus", "github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1.JetStreamConfig", "github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1.KafkaBus", "github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1.NATSBus", "github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1.SharedEventBus"},
 	}
 }
 
@@ -278,11 +429,96 @@ func schema_pkg_apis_eventbus_v1alpha1_EventBusStatus(ref common.ReferenceCallba
 							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1.BusConfig"),
 						},
 					},
+					"ordering": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Ordering is the ordering guarantee of the events delivered to the Sensors requiring ordering, one of \"PerSubject\" and \"None\"",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"tightenedStreams": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TightenedStreams are the streams whose retention has been tightened because of the storage budget, with their original max age, which is restored once the storage usage is back under the warning watermark.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"pendingActions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PendingActions is the plan of the destructive actions required by the last spec change, written before they are executed, and removed once the EventBus is deployed.",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1.ActionPlan"),
+						},
+					},
+					"mirrors": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Mirrors are the names of the mirror streams created by the controller, which are deleted once they are removed from the spec.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/argoproj/argo-events/pkg/apis/common.Condition", "github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1.ActionPlan", "github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1.BusConfig"},
+	}
+}
+
+func schema_pkg_apis_eventbus_v1alpha1_JetStreamAccountLimits(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "JetStreamAccountLimits are the JetStream resources an account can use.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"maxMemory": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxMemory is the memory the memory streams of the account can use, such as 1Gi.",
+							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+						},
+					},
+					"maxStorage": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxStorage is the storage the file streams of the account can use, such as 10Gi.",
+							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+						},
+					},
+					"maxStreams": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxStreams is the number of streams of the account.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"maxConsumers": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxConsumers is the number of consumers of the account.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/argoproj/argo-events/pkg/apis/common.Condition", "github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1.BusConfig"},
+			"k8s.io/apimachinery/pkg/api/resource.Quantity"},
 	}
 }
 
@@ -455,11 +691,50 @@ func schema_pkg_apis_eventbus_v1alpha1_JetStreamBus(ref common.ReferenceCallback
 							Format:      "",
 						},
 					},
+					"runtimeClassName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RuntimeClassName refers to a RuntimeClass object in the node.k8s.io group, which should be used to run the JetStream pods. More info: https://kubernetes.io/docs/concepts/containers/runtime-class/",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"spiffe": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SPIFFE enables the mutual TLS authentication of the EventSource and Sensor pods with their SPIFFE workload identity, instead of the generated credentials shared through a Secret.",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1.SPIFFEAuth"),
+						},
+					},
+					"tenancy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Tenancy makes the EventBus serve the shared EventBuses of other namespaces, each tenant EventBus getting its own account, with its own streams, on this JetStream cluster.",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1.JetStreamTenancy"),
+						},
+					},
+					"storageBudget": {
+						SchemaProps: spec.SchemaProps{
+							Description: "StorageBudget makes the controller watch the storage usage of the JetStream servers, to warn and optionally tighten the retention of the low priority streams before the storage limit is reached and the publishes are rejected.",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1.JetStreamStorageBudget"),
+						},
+					},
+					"mirrors": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Mirrors are read-only copies of the stream of the events, created and kept in sync by the JetStream servers, that other consumers, e.g. analytics, can read without consuming or delaying the events of the Sensors. With the SPIFFE authentication, the controller needs to be allowed to connect.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1.JetStreamMirror"),
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/argoproj/argo-events/pkg/apis/common.Metadata", "github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1.ContainerTemplate", "github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1.PersistenceStrategy", "k8s.io/api/core/v1.Affinity", "k8s.io/api/core/v1.LocalObjectReference", "k8s.io/api/core/v1.PodSecurityContext", "k8s.io/api/core/v1.Toleration"},
+			"github.com/argoproj/argo-events/pkg/apis/common.Metadata", "github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1.ContainerTemplate", "github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1.JetStreamMirror", "github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1.JetStreamStorageBudget", "github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1.JetStreamTenancy", "github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1.PersistenceStrategy", "github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1.SPIFFEAuth", "k8s.io/api/core/v1.Affinity", "k8s.io/api/core/v1.LocalObjectReference", "k8s.io/api/core/v1.PodSecurityContext", "k8s.io/api/core/v1.Toleration"},
 	}
 }
 
@@ -488,97 +763,341 @@ func schema_pkg_apis_eventbus_v1alpha1_JetStreamConfig(ref common.ReferenceCallb
 							Format: "",
 						},
 					},
+					"spiffe": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SPIFFE authenticates the clients with the X.509 SVID of their workload identity, instead of the access secret.",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1.SPIFFEConfig"),
+						},
+					},
+					"tls": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TLS verifies the certificate of the servers, instead of skipping the verification.",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/common.TLSConfig"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/api/core/v1.SecretKeySelector"},
+			"github.com/argoproj/argo-events/pkg/apis/common.TLSConfig", "github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1.SPIFFEConfig", "k8s.io/api/core/v1.SecretKeySelector"},
 	}
 }
 
-func schema_pkg_apis_eventbus_v1alpha1_KafkaBus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_eventbus_v1alpha1_JetStreamMirror(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "KafkaBus holds the KafkaBus EventBus information",
+				Description: "JetStreamMirror is a stream mirroring the events of a JetStream EventBus, or a subset of them.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"url": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "URL to kafka cluster, multiple URLs separated by comma",
+							Description: "Name is the name of the mirror stream, it can't be \"default\", the name of the stream of the events.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"topic": {
+					"eventSourceName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Topic name, defaults to {namespace_name}-{eventbus_name}",
+							Description: "EventSourceName only mirrors the events of this EventSource, all the EventSources are mirrored if not specified.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"version": {
+					"eventName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kafka version, sarama defaults to the oldest supported stable version",
+							Description: "EventName only mirrors the events with this name, all the events are mirrored if not specified.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"tls": {
-						SchemaProps: spec.SchemaProps{
-							Description: "TLS configuration for the kafka client.",
-							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/common.TLSConfig"),
-						},
-					},
-					"sasl": {
+					"maxAge": {
 						SchemaProps: spec.SchemaProps{
-							Description: "SASL configuration for the kafka client",
-							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/common.SASLConfig"),
+							Description: "MaxAge is the max age of the messages of the mirror, e.g. 168h, defaults to the max age of the stream of the events.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"consumerGroup": {
+					"replicas": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Consumer group for kafka client",
-							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1.KafkaConsumerGroup"),
+							Description: "Replicas is the number of replicas of the mirror, defaults to the number of replicas of the stream of the events.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
 				},
+				Required: []string{"name"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/argoproj/argo-events/pkg/apis/common.SASLConfig", "github.com/argoproj/argo-events/pkg/apis/common.TLSConfig", "github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1.KafkaConsumerGroup"},
 	}
 }
 
-func schema_pkg_apis_eventbus_v1alpha1_KafkaConsumerGroup(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_eventbus_v1alpha1_JetStreamStorageBudget(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "JetStreamStorageBudget configures the watermarks of the storage usage of a JetStream EventBus, in percent of the storage limit of the JetStream servers. The usage of the most used server is compared to the watermarks.",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"groupName": {
+					"warningWatermark": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Consumer group name, defaults to {namespace_name}-{sensor_name}",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "WarningWatermark is the storage usage above which the StorageWithinBudget condition is set to False, defaults to 75.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"rebalanceStrategy": {
+					"criticalWatermark": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Rebalance strategy can be one of: sticky, roundrobin, range. Range is the default.",
+							Description: "CriticalWatermark is the storage usage above which the retention of the low priority streams is tightened, defaults to 90.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"checkInterval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CheckInterval is how often the storage usage is checked, defaults to 1m.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"startOldest": {
+					"lowPriorityStreams": {
 						SchemaProps: spec.SchemaProps{
-							Description: "When starting up a new group do we want to start from the oldest event (true) or the newest event (false), defaults to false",
-							Type:        []string{"boolean"},
-							Format:      "",
+							Description: "LowPriorityStreams are the names of the streams whose retention is tightened above the critical watermark, e.g. \"KV_my-sensor\" for the Key/Value store of the Sensor \"my-sensor\". The retention is never tightened if not specified. With the SPIFFE authentication, the controller needs to be allowed to connect.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
-				},
-			},
+					"tightenedMaxAge": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TightenedMaxAge is the max age of the messages of the low priority streams above the critical watermark, defaults to 1h. Their original max age is restored once the storage usage is back under the warning watermark.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_eventbus_v1alpha1_JetStreamTenancy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "JetStreamTenancy configures the tenant EventBuses served by a JetStream EventBus.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"allowedNamespaces": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AllowedNamespaces are the namespaces of the tenant EventBuses, \"*\" allows all the namespaces. No namespace is allowed if not specified.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"accountLimits": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AccountLimits are the JetStream limits of the account of each tenant EventBus, not limited if not specified.",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1.JetStreamAccountLimits"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1.JetStreamAccountLimits"},
+	}
+}
+
+func schema_pkg_apis_eventbus_v1alpha1_KafkaBus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "KafkaBus holds the KafkaBus EventBus information",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"url": {
+						SchemaProps: spec.SchemaProps{
+							Description: "URL to kafka cluster, multiple URLs separated by comma",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"topic": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Topic name, defaults to {namespace_name}-{eventbus_name}",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"version": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kafka version, sarama defaults to the oldest supported stable version",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"tls": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TLS configuration for the kafka client.",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/common.TLSConfig"),
+						},
+					},
+					"sasl": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SASL configuration for the kafka client",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/common.SASLConfig"),
+						},
+					},
+					"consumerGroup": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Consumer group for kafka client",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1.KafkaConsumerGroup"),
+						},
+					},
+					"topics": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Topics configures the creation and the naming of the topics",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1.KafkaTopics"),
+						},
+					},
+					"mirrors": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Mirrors are topics the EventSources publish a copy of the events to, or a subset of them, that other consumers, e.g. analytics, can read without consuming or delaying the events of the Sensors.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1.KafkaMirror"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/argoproj/argo-events/pkg/apis/common.SASLConfig", "github.com/argoproj/argo-events/pkg/apis/common.TLSConfig", "github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1.KafkaConsumerGroup", "github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1.KafkaMirror", "github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1.KafkaTopics"},
+	}
+}
+
+func schema_pkg_apis_eventbus_v1alpha1_KafkaConsumerGroup(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"groupName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Consumer group name, defaults to {namespace_name}-{sensor_name}",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"rebalanceStrategy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Rebalance strategy can be one of: sticky, roundrobin, range. Range is the default.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"startOldest": {
+						SchemaProps: spec.SchemaProps{
+							Description: "When starting up a new group do we want to start from the oldest event (true) or the newest event (false), defaults to false",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_eventbus_v1alpha1_KafkaMirror(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "KafkaMirror is a topic mirroring the events of a Kafka EventBus, or a subset of them.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"topic": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Topic is the name of the mirror topic, it can't be the topic of the events.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"eventSourceName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EventSourceName only mirrors the events of this EventSource, all the EventSources are mirrored if not specified.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"eventName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EventName only mirrors the events with this name, all the events are mirrored if not specified.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"topic"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_eventbus_v1alpha1_KafkaTopics(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "KafkaTopics configures the creation and the naming of the topics.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"autoCreate": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AutoCreate determines whether the topics that do not exist are created by the brokers when they are first used, which requires the \"auto.create.topics.enable\" setting of the brokers. Defaults to true. Set it to false when the topics are provisioned externally, the EventSources and Sensors then fail fast if a topic does not exist.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"trigger": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Trigger is a Go template of the name of the trigger topic of a Sensor, defaults to \"{{.Topic}}-{{.SensorName}}-trigger\". The available variables are .Topic (the event topic), .Namespace and .SensorName.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"action": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Action is a Go template of the name of the action topic of a Sensor, defaults to \"{{.Topic}}-{{.SensorName}}-action\". The available variables are .Topic (the event topic), .Namespace and .SensorName.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -841,6 +1360,13 @@ func schema_pkg_apis_eventbus_v1alpha1_NativeStrategy(ref common.ReferenceCallba
 							Format:      "",
 						},
 					},
+					"runtimeClassName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RuntimeClassName refers to a RuntimeClass object in the node.k8s.io group, which should be used to run the NATS pods. More info: https://kubernetes.io/docs/concepts/containers/runtime-class/",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
@@ -849,6 +1375,51 @@ func schema_pkg_apis_eventbus_v1alpha1_NativeStrategy(ref common.ReferenceCallba
 	}
 }
 
+func schema_pkg_apis_eventbus_v1alpha1_PendingAction(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PendingAction is a destructive action of a plan.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Type is the type of the action.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"target": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Target is the object the action applies to, \"<kind>/<name>\".",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"description": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Description describes the action, and the spec change requiring it.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"dataLoss": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DataLoss is whether the action deletes the data of the streams, the replicated streams are restored from the other replicas when the volumes are recreated one replica at a time.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"type", "target", "description"},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_eventbus_v1alpha1_PersistenceStrategy(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -883,3 +1454,147 @@ func schema_pkg_apis_eventbus_v1alpha1_PersistenceStrategy(ref common.ReferenceC
 			"k8s.io/apimachinery/pkg/api/resource.Quantity"},
 	}
 }
+
+func schema_pkg_apis_eventbus_v1alpha1_SPIFFEAuth(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SPIFFEAuth configures the JetStream servers to authenticate their clients with their SPIFFE IDs, in the \"spiffe://<trust domain>/ns/<namespace>/sa/<service account>\" format.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"trustDomain": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TrustDomain of the SPIFFE IDs, e.g. \"cluster.local\".",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"serviceAccounts": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ServiceAccounts of the EventSource and Sensor pods allowed to connect, as \"<namespace>/<name>\", or \"<name>\" in the namespace of the EventBus. Defaults to the \"default\" service account of the namespace of the EventBus.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"csiDriver": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CSIDriver is the CSI driver mounting the X.509 SVID of the pods, as the \"tls.crt\", \"tls.key\" and \"ca.crt\" files, defaults to \"spiffe.csi.cert-manager.io\".",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"trustDomain"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_eventbus_v1alpha1_SPIFFEConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SPIFFEConfig is the SPIFFE authentication of the clients of a JetStream EventBus.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"csiDriver": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CSIDriver is the CSI driver mounting the X.509 SVID of the pods, as the \"tls.crt\", \"tls.key\" and \"ca.crt\" files, defaults to \"spiffe.csi.cert-manager.io\".",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"serverID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ServerID is the SPIFFE ID expected from the JetStream servers, only their certificate chain is verified if empty.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_eventbus_v1alpha1_SeedEvent(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SeedEvent is a synthetic event, published as if it was emitted by an EventSource.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"eventSourceName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EventSourceName is the name of the EventSource the event is published for.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"eventName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EventName is the name of the event within the EventSource.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Type is the type of the event. Defaults to \"seed\".",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"data": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Data is the JSON payload of the event.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"eventSourceName", "eventName"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_eventbus_v1alpha1_SharedEventBus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SharedEventBus refers to a JetStream EventBus serving tenant namespaces.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"namespace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Namespace of the shared EventBus, e.g. the system namespace.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the shared EventBus. Defaults to \"default\".",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"namespace"},
+			},
+		},
+	}
+}
@@ -27,6 +27,28 @@ import (
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ActionPlan) DeepCopyInto(out *ActionPlan) {
+	*out = *in
+	if in.Actions != nil {
+		in, out := &in.Actions, &out.Actions
+		*out = make([]PendingAction, len(*in))
+		copy(*out, *in)
+	}
+	in.CreatedAt.DeepCopyInto(&out.CreatedAt)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ActionPlan.
+func (in *ActionPlan) DeepCopy() *ActionPlan {
+	if in == nil {
+		return nil
+	}
+	out := new(ActionPlan)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BusConfig) DeepCopyInto(out *BusConfig) {
 	*out = *in
@@ -141,6 +163,27 @@ func (in *EventBusList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EventBusSeed) DeepCopyInto(out *EventBusSeed) {
+	*out = *in
+	if in.Events != nil {
+		in, out := &in.Events, &out.Events
+		*out = make([]SeedEvent, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EventBusSeed.
+func (in *EventBusSeed) DeepCopy() *EventBusSeed {
+	if in == nil {
+		return nil
+	}
+	out := new(EventBusSeed)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EventBusSpec) DeepCopyInto(out *EventBusSpec) {
 	*out = *in
@@ -164,6 +207,16 @@ func (in *EventBusSpec) DeepCopyInto(out *EventBusSpec) {
 		*out = new(JetStreamConfig)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Seed != nil {
+		in, out := &in.Seed, &out.Seed
+		*out = new(EventBusSeed)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Shared != nil {
+		in, out := &in.Shared, &out.Shared
+		*out = new(SharedEventBus)
+		**out = **in
+	}
 	return
 }
 
@@ -182,6 +235,23 @@ func (in *EventBusStatus) DeepCopyInto(out *EventBusStatus) {
 	*out = *in
 	in.Status.DeepCopyInto(&out.Status)
 	in.Config.DeepCopyInto(&out.Config)
+	if in.TightenedStreams != nil {
+		in, out := &in.TightenedStreams, &out.TightenedStreams
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.PendingActions != nil {
+		in, out := &in.PendingActions, &out.PendingActions
+		*out = new(ActionPlan)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Mirrors != nil {
+		in, out := &in.Mirrors, &out.Mirrors
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -195,6 +265,42 @@ func (in *EventBusStatus) DeepCopy() *EventBusStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JetStreamAccountLimits) DeepCopyInto(out *JetStreamAccountLimits) {
+	*out = *in
+	if in.MaxMemory != nil {
+		in, out := &in.MaxMemory, &out.MaxMemory
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.MaxStorage != nil {
+		in, out := &in.MaxStorage, &out.MaxStorage
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.MaxStreams != nil {
+		in, out := &in.MaxStreams, &out.MaxStreams
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxConsumers != nil {
+		in, out := &in.MaxConsumers, &out.MaxConsumers
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JetStreamAccountLimits.
+func (in *JetStreamAccountLimits) DeepCopy() *JetStreamAccountLimits {
+	if in == nil {
+		return nil
+	}
+	out := new(JetStreamAccountLimits)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *JetStreamBus) DeepCopyInto(out *JetStreamBus) {
 	*out = *in
@@ -282,6 +388,33 @@ func (in *JetStreamBus) DeepCopyInto(out *JetStreamBus) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.RuntimeClassName != nil {
+		in, out := &in.RuntimeClassName, &out.RuntimeClassName
+		*out = new(string)
+		**out = **in
+	}
+	if in.SPIFFE != nil {
+		in, out := &in.SPIFFE, &out.SPIFFE
+		*out = new(SPIFFEAuth)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Tenancy != nil {
+		in, out := &in.Tenancy, &out.Tenancy
+		*out = new(JetStreamTenancy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.StorageBudget != nil {
+		in, out := &in.StorageBudget, &out.StorageBudget
+		*out = new(JetStreamStorageBudget)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Mirrors != nil {
+		in, out := &in.Mirrors, &out.Mirrors
+		*out = make([]JetStreamMirror, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -303,6 +436,16 @@ func (in *JetStreamConfig) DeepCopyInto(out *JetStreamConfig) {
 		*out = new(v1.SecretKeySelector)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.SPIFFE != nil {
+		in, out := &in.SPIFFE, &out.SPIFFE
+		*out = new(SPIFFEConfig)
+		**out = **in
+	}
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(common.TLSConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -316,6 +459,84 @@ func (in *JetStreamConfig) DeepCopy() *JetStreamConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JetStreamMirror) DeepCopyInto(out *JetStreamMirror) {
+	*out = *in
+	if in.Replicas != nil {
+		in, out := &in.Replicas, &out.Replicas
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JetStreamMirror.
+func (in *JetStreamMirror) DeepCopy() *JetStreamMirror {
+	if in == nil {
+		return nil
+	}
+	out := new(JetStreamMirror)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JetStreamStorageBudget) DeepCopyInto(out *JetStreamStorageBudget) {
+	*out = *in
+	if in.WarningWatermark != nil {
+		in, out := &in.WarningWatermark, &out.WarningWatermark
+		*out = new(int32)
+		**out = **in
+	}
+	if in.CriticalWatermark != nil {
+		in, out := &in.CriticalWatermark, &out.CriticalWatermark
+		*out = new(int32)
+		**out = **in
+	}
+	if in.LowPriorityStreams != nil {
+		in, out := &in.LowPriorityStreams, &out.LowPriorityStreams
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JetStreamStorageBudget.
+func (in *JetStreamStorageBudget) DeepCopy() *JetStreamStorageBudget {
+	if in == nil {
+		return nil
+	}
+	out := new(JetStreamStorageBudget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JetStreamTenancy) DeepCopyInto(out *JetStreamTenancy) {
+	*out = *in
+	if in.AllowedNamespaces != nil {
+		in, out := &in.AllowedNamespaces, &out.AllowedNamespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AccountLimits != nil {
+		in, out := &in.AccountLimits, &out.AccountLimits
+		*out = new(JetStreamAccountLimits)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JetStreamTenancy.
+func (in *JetStreamTenancy) DeepCopy() *JetStreamTenancy {
+	if in == nil {
+		return nil
+	}
+	out := new(JetStreamTenancy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KafkaBus) DeepCopyInto(out *KafkaBus) {
 	*out = *in
@@ -334,6 +555,16 @@ func (in *KafkaBus) DeepCopyInto(out *KafkaBus) {
 		*out = new(KafkaConsumerGroup)
 		**out = **in
 	}
+	if in.Topics != nil {
+		in, out := &in.Topics, &out.Topics
+		*out = new(KafkaTopics)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Mirrors != nil {
+		in, out := &in.Mirrors, &out.Mirrors
+		*out = make([]KafkaMirror, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -363,6 +594,43 @@ func (in *KafkaConsumerGroup) DeepCopy() *KafkaConsumerGroup {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaMirror) DeepCopyInto(out *KafkaMirror) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaMirror.
+func (in *KafkaMirror) DeepCopy() *KafkaMirror {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaMirror)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaTopics) DeepCopyInto(out *KafkaTopics) {
+	*out = *in
+	if in.AutoCreate != nil {
+		in, out := &in.AutoCreate, &out.AutoCreate
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaTopics.
+func (in *KafkaTopics) DeepCopy() *KafkaTopics {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaTopics)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NATSBus) DeepCopyInto(out *NATSBus) {
 	*out = *in
@@ -527,6 +795,11 @@ func (in *NativeStrategy) DeepCopyInto(out *NativeStrategy) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.RuntimeClassName != nil {
+		in, out := &in.RuntimeClassName, &out.RuntimeClassName
+		*out = new(string)
+		**out = **in
+	}
 	return
 }
 
@@ -540,6 +813,22 @@ func (in *NativeStrategy) DeepCopy() *NativeStrategy {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PendingAction) DeepCopyInto(out *PendingAction) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PendingAction.
+func (in *PendingAction) DeepCopy() *PendingAction {
+	if in == nil {
+		return nil
+	}
+	out := new(PendingAction)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PersistenceStrategy) DeepCopyInto(out *PersistenceStrategy) {
 	*out = *in
@@ -570,3 +859,72 @@ func (in *PersistenceStrategy) DeepCopy() *PersistenceStrategy {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SPIFFEAuth) DeepCopyInto(out *SPIFFEAuth) {
+	*out = *in
+	if in.ServiceAccounts != nil {
+		in, out := &in.ServiceAccounts, &out.ServiceAccounts
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SPIFFEAuth.
+func (in *SPIFFEAuth) DeepCopy() *SPIFFEAuth {
+	if in == nil {
+		return nil
+	}
+	out := new(SPIFFEAuth)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SPIFFEConfig) DeepCopyInto(out *SPIFFEConfig) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SPIFFEConfig.
+func (in *SPIFFEConfig) DeepCopy() *SPIFFEConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(SPIFFEConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SeedEvent) DeepCopyInto(out *SeedEvent) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedEvent.
+func (in *SeedEvent) DeepCopy() *SeedEvent {
+	if in == nil {
+		return nil
+	}
+	out := new(SeedEvent)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SharedEventBus) DeepCopyInto(out *SharedEventBus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SharedEventBus.
+func (in *SharedEventBus) DeepCopy() *SharedEventBus {
+	if in == nil {
+		return nil
+	}
+	out := new(SharedEventBus)
+	in.DeepCopyInto(out)
+	return out
+}
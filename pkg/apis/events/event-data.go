@@ -145,6 +145,9 @@ type BitbucketEventData struct {
 	Body *json.RawMessage `json:"body"`
 	// Metadata holds the user defined metadata which will passed along the event payload.
 	Metadata map[string]string `json:"metadata,omitempty"`
+	// Normalized is the event mapped to the schema common to the SCM providers, if normalization is enabled
+	// and the event is a push or a pull request event.
+	Normalized *SCMEventData `json:"normalized,omitempty"`
 }
 
 // BitbucketServerEventData represents the event data generated by the Bitbucket Server eventsource.
@@ -155,6 +158,49 @@ type BitbucketServerEventData struct {
 	Body *json.RawMessage `json:"body"`
 	// Metadata holds the user defined metadata which will passed along the event payload.
 	Metadata map[string]string `json:"metadata,omitempty"`
+	// Normalized is the event mapped to the schema common to the SCM providers, if normalization is enabled
+	// and the event is a push or a pull request event.
+	Normalized *SCMEventData `json:"normalized,omitempty"`
+}
+
+// SCMEventData is a push or pull request event of a source code management provider, in a schema common to the
+// GitHub, GitLab, Bitbucket and Bitbucket Server eventsources.
+type SCMEventData struct {
+	// Provider is the SCM provider, "github", "gitlab", "bitbucket" or "bitbucketserver".
+	Provider string `json:"provider"`
+	// Type is the type of the event, "push" or "pullRequest".
+	Type string `json:"type"`
+	// Repo is the full name of the repository, e.g. "owner/name", or "project/slug" for Bitbucket Server.
+	Repo string `json:"repo"`
+	// Ref is the full name of the pushed ref, e.g. "refs/heads/main", or of the source branch of a pull request.
+	Ref string `json:"ref"`
+	// SHA is the commit the ref points to after the push, or the head commit of the pull request.
+	SHA string `json:"sha"`
+	// Author is the user who pushed, or the author of the pull request.
+	Author SCMAuthor `json:"author"`
+	// FilesChanged are the paths of the files added, modified or removed by the pushed commits, when the
+	// provider includes them in the payload.
+	FilesChanged []string `json:"filesChanged,omitempty"`
+	// PullRequest is set for the pull request events.
+	PullRequest *SCMPullRequest `json:"pullRequest,omitempty"`
+}
+
+// SCMAuthor is the author of an SCM event.
+type SCMAuthor struct {
+	Name     string `json:"name,omitempty"`
+	Email    string `json:"email,omitempty"`
+	Username string `json:"username,omitempty"`
+}
+
+// SCMPullRequest is the pull request of an SCM event.
+type SCMPullRequest struct {
+	Number int64 `json:"number"`
+	// Action is the action of the provider, e.g. "opened" or "merged", as named by the provider.
+	Action string `json:"action"`
+	Title  string `json:"title"`
+	URL    string `json:"url,omitempty"`
+	// TargetRef is the full name of the target branch of the pull request.
+	TargetRef string `json:"targetRef"`
 }
 
 // CalendarEventData represents the event data generated by the Calendar eventsource.
@@ -209,6 +255,9 @@ type GithubEventData struct {
 	Body *json.RawMessage `json:"body"`
 	// Metadata holds the user defined metadata which will passed along the event payload.
 	Metadata map[string]string `json:"metadata,omitempty"`
+	// Normalized is the event mapped to the schema common to the SCM providers, if normalization is enabled
+	// and the event is a push or a pull request event.
+	Normalized *SCMEventData `json:"normalized,omitempty"`
 }
 
 // GitLabEventData represents the event data generated by the GitLab eventsource.
@@ -219,6 +268,9 @@ type GitLabEventData struct {
 	Body *json.RawMessage `json:"body"`
 	// Metadata holds the user defined metadata which will passed along the event payload.
 	Metadata map[string]string `json:"metadata,omitempty"`
+	// Normalized is the event mapped to the schema common to the SCM providers, if normalization is enabled
+	// and the event is a push or a pull request event.
+	Normalized *SCMEventData `json:"normalized,omitempty"`
 }
 
 // KafkaEventData represents the event data generated by the Kafka eventsource.
@@ -306,6 +358,28 @@ type RedisStreamEventData struct {
 	Metadata map[string]string `json:"metadata,omitempty"`
 }
 
+// ElasticsearchEventData represents the event data generated by the Elasticsearch eventsource.
+type ElasticsearchEventData struct {
+	// Index of the document.
+	Index string `json:"index"`
+	// ID of the document.
+	ID string `json:"id"`
+	// Source is the body of the document.
+	Source json.RawMessage `json:"source"`
+	// Sort holds the sort values of the document, the cursor of the searches.
+	Sort []interface{} `json:"sort"`
+	// Metadata holds the user defined metadata which will passed along the event payload.
+	Metadata map[string]string `json:"metadata,omitempty"`
+}
+
+// JenkinsEventData represents the event data generated by the Jenkins eventsource.
+type JenkinsEventData struct {
+	// Body is the notification sent by the notification plugin
+	Body *json.RawMessage `json:"body"`
+	// Metadata holds the user defined metadata which will passed along the event payload.
+	Metadata map[string]string `json:"metadata,omitempty"`
+}
+
 // ResourceEventData represents the event data generated by the Resource eventsource.
 type ResourceEventData struct {
 	// EventType of the type of the event.
@@ -408,3 +482,55 @@ type GenericEventData struct {
 	// Metadata holds the user defined metadata which will passed along the event payload.
 	Metadata map[string]string `json:"metadata,omitempty"`
 }
+
+// SQLEventData represents the event data generated by the SQL eventsource.
+type SQLEventData struct {
+	// Row maps the columns of the row to their values.
+	Row map[string]interface{} `json:"row"`
+	// Cursor is the value of the cursor column of the row.
+	Cursor string `json:"cursor"`
+	// Metadata holds the user defined metadata which will passed along the event payload.
+	Metadata map[string]string `json:"metadata,omitempty"`
+}
+
+// DynamoDBStreamsEventData represents the event data generated by the DynamoDB Streams eventsource.
+type DynamoDBStreamsEventData struct {
+	// EventID is the unique identifier of the change record.
+	EventID string `json:"eventID"`
+	// EventName is the type of the change, "INSERT", "MODIFY" or "REMOVE".
+	EventName string `json:"eventName"`
+	// StreamARN is the ARN of the stream.
+	StreamARN string `json:"streamARN"`
+	// ShardID is the ID of the shard of the record.
+	ShardID string `json:"shardID"`
+	// SequenceNumber is the sequence number of the record in the shard.
+	SequenceNumber string `json:"sequenceNumber"`
+	// ApproximateCreationDateTime is the time the change was made.
+	ApproximateCreationDateTime time.Time `json:"approximateCreationDateTime"`
+	// Keys are the primary key attributes of the item.
+	Keys map[string]interface{} `json:"keys"`
+	// NewImage is the item after the change, if selected and written to the stream.
+	NewImage map[string]interface{} `json:"newImage,omitempty"`
+	// OldImage is the item before the change, if selected and written to the stream.
+	OldImage map[string]interface{} `json:"oldImage,omitempty"`
+	// Metadata holds the user defined metadata which will passed along the event payload.
+	Metadata map[string]string `json:"metadata,omitempty"`
+}
+
+// GRPCStreamEventData represents the event data generated by the gRPC stream eventsource.
+type GRPCStreamEventData struct {
+	// ID of the event.
+	ID string `json:"id"`
+	// Type of the event.
+	Type string `json:"type,omitempty"`
+	// Subject of the event.
+	Subject string `json:"subject,omitempty"`
+	// ContentType is the content type of the body.
+	ContentType string `json:"contentType,omitempty"`
+	// Attributes of the event.
+	Attributes map[string]string `json:"attributes,omitempty"`
+	// Body is the payload of the event, embedded as is if it is JSON, base64 encoded otherwise.
+	Body interface{} `json:"body"`
+	// Metadata holds the user defined metadata which will passed along the event payload.
+	Metadata map[string]string `json:"metadata,omitempty"`
+}
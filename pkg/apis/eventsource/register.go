@@ -25,4 +25,9 @@ const (
 	Singular string = "eventsource"
 	Plural   string = "eventsources"
 	FullName string = Plural + "." + Group
+
+	// ClusterEventSource constants
+	ClusterKind     string = "ClusterEventSource"
+	ClusterSingular string = "clustereventsource"
+	ClusterPlural   string = "clustereventsources"
 )
@@ -28,6 +28,7 @@ import (
 	proto "github.com/gogo/protobuf/proto"
 	github_com_gogo_protobuf_sortkeys "github.com/gogo/protobuf/sortkeys"
 	v1 "k8s.io/api/core/v1"
+	v11 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	math "math"
 	math_bits "math/bits"
@@ -466,10 +467,38 @@ func (m *CalendarEventSource) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_CalendarEventSource proto.InternalMessageInfo
 
+func (m *CalendarStatus) Reset()      { *m = CalendarStatus{} }
+func (*CalendarStatus) ProtoMessage() {}
+func (*CalendarStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9ac5d6cd016403b, []int{15}
+}
+func (m *CalendarStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CalendarStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *CalendarStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CalendarStatus.Merge(m, src)
+}
+func (m *CalendarStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *CalendarStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_CalendarStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CalendarStatus proto.InternalMessageInfo
+
 func (m *CatchupConfiguration) Reset()      { *m = CatchupConfiguration{} }
 func (*CatchupConfiguration) ProtoMessage() {}
 func (*CatchupConfiguration) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9ac5d6cd016403b, []int{15}
+	return fileDescriptor_c9ac5d6cd016403b, []int{16}
 }
 func (m *CatchupConfiguration) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -494,10 +523,94 @@ func (m *CatchupConfiguration) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_CatchupConfiguration proto.InternalMessageInfo
 
+func (m *ClusterEventSource) Reset()      { *m = ClusterEventSource{} }
+func (*ClusterEventSource) ProtoMessage() {}
+func (*ClusterEventSource) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9ac5d6cd016403b, []int{17}
+}
+func (m *ClusterEventSource) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClusterEventSource) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ClusterEventSource) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClusterEventSource.Merge(m, src)
+}
+func (m *ClusterEventSource) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClusterEventSource) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClusterEventSource.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClusterEventSource proto.InternalMessageInfo
+
+func (m *ClusterEventSourceList) Reset()      { *m = ClusterEventSourceList{} }
+func (*ClusterEventSourceList) ProtoMessage() {}
+func (*ClusterEventSourceList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9ac5d6cd016403b, []int{18}
+}
+func (m *ClusterEventSourceList) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClusterEventSourceList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ClusterEventSourceList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClusterEventSourceList.Merge(m, src)
+}
+func (m *ClusterEventSourceList) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClusterEventSourceList) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClusterEventSourceList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClusterEventSourceList proto.InternalMessageInfo
+
+func (m *ClusterEventSourceSpec) Reset()      { *m = ClusterEventSourceSpec{} }
+func (*ClusterEventSourceSpec) ProtoMessage() {}
+func (*ClusterEventSourceSpec) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9ac5d6cd016403b, []int{19}
+}
+func (m *ClusterEventSourceSpec) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClusterEventSourceSpec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ClusterEventSourceSpec) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClusterEventSourceSpec.Merge(m, src)
+}
+func (m *ClusterEventSourceSpec) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClusterEventSourceSpec) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClusterEventSourceSpec.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClusterEventSourceSpec proto.InternalMessageInfo
+
 func (m *ConfigMapPersistence) Reset()      { *m = ConfigMapPersistence{} }
 func (*ConfigMapPersistence) ProtoMessage() {}
 func (*ConfigMapPersistence) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9ac5d6cd016403b, []int{16}
+	return fileDescriptor_c9ac5d6cd016403b, []int{20}
 }
 func (m *ConfigMapPersistence) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -522,10 +635,94 @@ func (m *ConfigMapPersistence) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ConfigMapPersistence proto.InternalMessageInfo
 
+func (m *DependencyProbe) Reset()      { *m = DependencyProbe{} }
+func (*DependencyProbe) ProtoMessage() {}
+func (*DependencyProbe) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9ac5d6cd016403b, []int{21}
+}
+func (m *DependencyProbe) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DependencyProbe) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *DependencyProbe) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DependencyProbe.Merge(m, src)
+}
+func (m *DependencyProbe) XXX_Size() int {
+	return m.Size()
+}
+func (m *DependencyProbe) XXX_DiscardUnknown() {
+	xxx_messageInfo_DependencyProbe.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DependencyProbe proto.InternalMessageInfo
+
+func (m *DynamoDBStreamsEventSource) Reset()      { *m = DynamoDBStreamsEventSource{} }
+func (*DynamoDBStreamsEventSource) ProtoMessage() {}
+func (*DynamoDBStreamsEventSource) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9ac5d6cd016403b, []int{22}
+}
+func (m *DynamoDBStreamsEventSource) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DynamoDBStreamsEventSource) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *DynamoDBStreamsEventSource) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DynamoDBStreamsEventSource.Merge(m, src)
+}
+func (m *DynamoDBStreamsEventSource) XXX_Size() int {
+	return m.Size()
+}
+func (m *DynamoDBStreamsEventSource) XXX_DiscardUnknown() {
+	xxx_messageInfo_DynamoDBStreamsEventSource.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DynamoDBStreamsEventSource proto.InternalMessageInfo
+
+func (m *ElasticsearchEventSource) Reset()      { *m = ElasticsearchEventSource{} }
+func (*ElasticsearchEventSource) ProtoMessage() {}
+func (*ElasticsearchEventSource) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9ac5d6cd016403b, []int{23}
+}
+func (m *ElasticsearchEventSource) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ElasticsearchEventSource) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ElasticsearchEventSource) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ElasticsearchEventSource.Merge(m, src)
+}
+func (m *ElasticsearchEventSource) XXX_Size() int {
+	return m.Size()
+}
+func (m *ElasticsearchEventSource) XXX_DiscardUnknown() {
+	xxx_messageInfo_ElasticsearchEventSource.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ElasticsearchEventSource proto.InternalMessageInfo
+
 func (m *EmitterEventSource) Reset()      { *m = EmitterEventSource{} }
 func (*EmitterEventSource) ProtoMessage() {}
 func (*EmitterEventSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9ac5d6cd016403b, []int{17}
+	return fileDescriptor_c9ac5d6cd016403b, []int{24}
 }
 func (m *EmitterEventSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -550,10 +747,38 @@ func (m *EmitterEventSource) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_EmitterEventSource proto.InternalMessageInfo
 
+func (m *EventCatalogEntry) Reset()      { *m = EventCatalogEntry{} }
+func (*EventCatalogEntry) ProtoMessage() {}
+func (*EventCatalogEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9ac5d6cd016403b, []int{25}
+}
+func (m *EventCatalogEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventCatalogEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *EventCatalogEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventCatalogEntry.Merge(m, src)
+}
+func (m *EventCatalogEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventCatalogEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventCatalogEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventCatalogEntry proto.InternalMessageInfo
+
 func (m *EventPersistence) Reset()      { *m = EventPersistence{} }
 func (*EventPersistence) ProtoMessage() {}
 func (*EventPersistence) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9ac5d6cd016403b, []int{18}
+	return fileDescriptor_c9ac5d6cd016403b, []int{26}
 }
 func (m *EventPersistence) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -578,10 +803,38 @@ func (m *EventPersistence) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_EventPersistence proto.InternalMessageInfo
 
+func (m *EventSizeLimit) Reset()      { *m = EventSizeLimit{} }
+func (*EventSizeLimit) ProtoMessage() {}
+func (*EventSizeLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9ac5d6cd016403b, []int{27}
+}
+func (m *EventSizeLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventSizeLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *EventSizeLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSizeLimit.Merge(m, src)
+}
+func (m *EventSizeLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventSizeLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSizeLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventSizeLimit proto.InternalMessageInfo
+
 func (m *EventSource) Reset()      { *m = EventSource{} }
 func (*EventSource) ProtoMessage() {}
 func (*EventSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9ac5d6cd016403b, []int{19}
+	return fileDescriptor_c9ac5d6cd016403b, []int{28}
 }
 func (m *EventSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -609,7 +862,7 @@ var xxx_messageInfo_EventSource proto.InternalMessageInfo
 func (m *EventSourceFilter) Reset()      { *m = EventSourceFilter{} }
 func (*EventSourceFilter) ProtoMessage() {}
 func (*EventSourceFilter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9ac5d6cd016403b, []int{20}
+	return fileDescriptor_c9ac5d6cd016403b, []int{29}
 }
 func (m *EventSourceFilter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -634,10 +887,38 @@ func (m *EventSourceFilter) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_EventSourceFilter proto.InternalMessageInfo
 
+func (m *EventSourceInclude) Reset()      { *m = EventSourceInclude{} }
+func (*EventSourceInclude) ProtoMessage() {}
+func (*EventSourceInclude) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9ac5d6cd016403b, []int{30}
+}
+func (m *EventSourceInclude) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventSourceInclude) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *EventSourceInclude) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSourceInclude.Merge(m, src)
+}
+func (m *EventSourceInclude) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventSourceInclude) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSourceInclude.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventSourceInclude proto.InternalMessageInfo
+
 func (m *EventSourceList) Reset()      { *m = EventSourceList{} }
 func (*EventSourceList) ProtoMessage() {}
 func (*EventSourceList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9ac5d6cd016403b, []int{21}
+	return fileDescriptor_c9ac5d6cd016403b, []int{31}
 }
 func (m *EventSourceList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -665,7 +946,7 @@ var xxx_messageInfo_EventSourceList proto.InternalMessageInfo
 func (m *EventSourceSpec) Reset()      { *m = EventSourceSpec{} }
 func (*EventSourceSpec) ProtoMessage() {}
 func (*EventSourceSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9ac5d6cd016403b, []int{22}
+	return fileDescriptor_c9ac5d6cd016403b, []int{32}
 }
 func (m *EventSourceSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -693,7 +974,7 @@ var xxx_messageInfo_EventSourceSpec proto.InternalMessageInfo
 func (m *EventSourceStatus) Reset()      { *m = EventSourceStatus{} }
 func (*EventSourceStatus) ProtoMessage() {}
 func (*EventSourceStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9ac5d6cd016403b, []int{23}
+	return fileDescriptor_c9ac5d6cd016403b, []int{33}
 }
 func (m *EventSourceStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -718,10 +999,38 @@ func (m *EventSourceStatus) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_EventSourceStatus proto.InternalMessageInfo
 
+func (m *EventSourceTransform) Reset()      { *m = EventSourceTransform{} }
+func (*EventSourceTransform) ProtoMessage() {}
+func (*EventSourceTransform) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9ac5d6cd016403b, []int{34}
+}
+func (m *EventSourceTransform) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventSourceTransform) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *EventSourceTransform) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSourceTransform.Merge(m, src)
+}
+func (m *EventSourceTransform) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventSourceTransform) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSourceTransform.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventSourceTransform proto.InternalMessageInfo
+
 func (m *FileEventSource) Reset()      { *m = FileEventSource{} }
 func (*FileEventSource) ProtoMessage() {}
 func (*FileEventSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9ac5d6cd016403b, []int{24}
+	return fileDescriptor_c9ac5d6cd016403b, []int{35}
 }
 func (m *FileEventSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -746,10 +1055,38 @@ func (m *FileEventSource) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_FileEventSource proto.InternalMessageInfo
 
+func (m *GRPCStreamEventSource) Reset()      { *m = GRPCStreamEventSource{} }
+func (*GRPCStreamEventSource) ProtoMessage() {}
+func (*GRPCStreamEventSource) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9ac5d6cd016403b, []int{36}
+}
+func (m *GRPCStreamEventSource) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GRPCStreamEventSource) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *GRPCStreamEventSource) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GRPCStreamEventSource.Merge(m, src)
+}
+func (m *GRPCStreamEventSource) XXX_Size() int {
+	return m.Size()
+}
+func (m *GRPCStreamEventSource) XXX_DiscardUnknown() {
+	xxx_messageInfo_GRPCStreamEventSource.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GRPCStreamEventSource proto.InternalMessageInfo
+
 func (m *GenericEventSource) Reset()      { *m = GenericEventSource{} }
 func (*GenericEventSource) ProtoMessage() {}
 func (*GenericEventSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9ac5d6cd016403b, []int{25}
+	return fileDescriptor_c9ac5d6cd016403b, []int{37}
 }
 func (m *GenericEventSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -777,7 +1114,7 @@ var xxx_messageInfo_GenericEventSource proto.InternalMessageInfo
 func (m *GerritEventSource) Reset()      { *m = GerritEventSource{} }
 func (*GerritEventSource) ProtoMessage() {}
 func (*GerritEventSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9ac5d6cd016403b, []int{26}
+	return fileDescriptor_c9ac5d6cd016403b, []int{38}
 }
 func (m *GerritEventSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -805,7 +1142,7 @@ var xxx_messageInfo_GerritEventSource proto.InternalMessageInfo
 func (m *GithubAppCreds) Reset()      { *m = GithubAppCreds{} }
 func (*GithubAppCreds) ProtoMessage() {}
 func (*GithubAppCreds) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9ac5d6cd016403b, []int{27}
+	return fileDescriptor_c9ac5d6cd016403b, []int{39}
 }
 func (m *GithubAppCreds) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -833,7 +1170,7 @@ var xxx_messageInfo_GithubAppCreds proto.InternalMessageInfo
 func (m *GithubEventSource) Reset()      { *m = GithubEventSource{} }
 func (*GithubEventSource) ProtoMessage() {}
 func (*GithubEventSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9ac5d6cd016403b, []int{28}
+	return fileDescriptor_c9ac5d6cd016403b, []int{40}
 }
 func (m *GithubEventSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -861,7 +1198,7 @@ var xxx_messageInfo_GithubEventSource proto.InternalMessageInfo
 func (m *GitlabEventSource) Reset()      { *m = GitlabEventSource{} }
 func (*GitlabEventSource) ProtoMessage() {}
 func (*GitlabEventSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9ac5d6cd016403b, []int{29}
+	return fileDescriptor_c9ac5d6cd016403b, []int{41}
 }
 func (m *GitlabEventSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -889,7 +1226,7 @@ var xxx_messageInfo_GitlabEventSource proto.InternalMessageInfo
 func (m *HDFSEventSource) Reset()      { *m = HDFSEventSource{} }
 func (*HDFSEventSource) ProtoMessage() {}
 func (*HDFSEventSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9ac5d6cd016403b, []int{30}
+	return fileDescriptor_c9ac5d6cd016403b, []int{42}
 }
 func (m *HDFSEventSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -914,10 +1251,66 @@ func (m *HDFSEventSource) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_HDFSEventSource proto.InternalMessageInfo
 
+func (m *HTTPDependencyProbe) Reset()      { *m = HTTPDependencyProbe{} }
+func (*HTTPDependencyProbe) ProtoMessage() {}
+func (*HTTPDependencyProbe) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9ac5d6cd016403b, []int{43}
+}
+func (m *HTTPDependencyProbe) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HTTPDependencyProbe) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *HTTPDependencyProbe) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HTTPDependencyProbe.Merge(m, src)
+}
+func (m *HTTPDependencyProbe) XXX_Size() int {
+	return m.Size()
+}
+func (m *HTTPDependencyProbe) XXX_DiscardUnknown() {
+	xxx_messageInfo_HTTPDependencyProbe.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HTTPDependencyProbe proto.InternalMessageInfo
+
+func (m *JenkinsEventSource) Reset()      { *m = JenkinsEventSource{} }
+func (*JenkinsEventSource) ProtoMessage() {}
+func (*JenkinsEventSource) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9ac5d6cd016403b, []int{44}
+}
+func (m *JenkinsEventSource) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JenkinsEventSource) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *JenkinsEventSource) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JenkinsEventSource.Merge(m, src)
+}
+func (m *JenkinsEventSource) XXX_Size() int {
+	return m.Size()
+}
+func (m *JenkinsEventSource) XXX_DiscardUnknown() {
+	xxx_messageInfo_JenkinsEventSource.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JenkinsEventSource proto.InternalMessageInfo
+
 func (m *KafkaConsumerGroup) Reset()      { *m = KafkaConsumerGroup{} }
 func (*KafkaConsumerGroup) ProtoMessage() {}
 func (*KafkaConsumerGroup) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9ac5d6cd016403b, []int{31}
+	return fileDescriptor_c9ac5d6cd016403b, []int{45}
 }
 func (m *KafkaConsumerGroup) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -945,7 +1338,7 @@ var xxx_messageInfo_KafkaConsumerGroup proto.InternalMessageInfo
 func (m *KafkaEventSource) Reset()      { *m = KafkaEventSource{} }
 func (*KafkaEventSource) ProtoMessage() {}
 func (*KafkaEventSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9ac5d6cd016403b, []int{32}
+	return fileDescriptor_c9ac5d6cd016403b, []int{46}
 }
 func (m *KafkaEventSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -970,10 +1363,38 @@ func (m *KafkaEventSource) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_KafkaEventSource proto.InternalMessageInfo
 
+func (m *KafkaHeaderFilter) Reset()      { *m = KafkaHeaderFilter{} }
+func (*KafkaHeaderFilter) ProtoMessage() {}
+func (*KafkaHeaderFilter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9ac5d6cd016403b, []int{47}
+}
+func (m *KafkaHeaderFilter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *KafkaHeaderFilter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *KafkaHeaderFilter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KafkaHeaderFilter.Merge(m, src)
+}
+func (m *KafkaHeaderFilter) XXX_Size() int {
+	return m.Size()
+}
+func (m *KafkaHeaderFilter) XXX_DiscardUnknown() {
+	xxx_messageInfo_KafkaHeaderFilter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_KafkaHeaderFilter proto.InternalMessageInfo
+
 func (m *MQTTEventSource) Reset()      { *m = MQTTEventSource{} }
 func (*MQTTEventSource) ProtoMessage() {}
 func (*MQTTEventSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9ac5d6cd016403b, []int{33}
+	return fileDescriptor_c9ac5d6cd016403b, []int{48}
 }
 func (m *MQTTEventSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -998,10 +1419,38 @@ func (m *MQTTEventSource) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MQTTEventSource proto.InternalMessageInfo
 
+func (m *MQTTSharedSubscription) Reset()      { *m = MQTTSharedSubscription{} }
+func (*MQTTSharedSubscription) ProtoMessage() {}
+func (*MQTTSharedSubscription) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9ac5d6cd016403b, []int{49}
+}
+func (m *MQTTSharedSubscription) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MQTTSharedSubscription) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *MQTTSharedSubscription) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MQTTSharedSubscription.Merge(m, src)
+}
+func (m *MQTTSharedSubscription) XXX_Size() int {
+	return m.Size()
+}
+func (m *MQTTSharedSubscription) XXX_DiscardUnknown() {
+	xxx_messageInfo_MQTTSharedSubscription.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MQTTSharedSubscription proto.InternalMessageInfo
+
 func (m *NATSAuth) Reset()      { *m = NATSAuth{} }
 func (*NATSAuth) ProtoMessage() {}
 func (*NATSAuth) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9ac5d6cd016403b, []int{34}
+	return fileDescriptor_c9ac5d6cd016403b, []int{50}
 }
 func (m *NATSAuth) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1029,7 +1478,7 @@ var xxx_messageInfo_NATSAuth proto.InternalMessageInfo
 func (m *NATSEventsSource) Reset()      { *m = NATSEventsSource{} }
 func (*NATSEventsSource) ProtoMessage() {}
 func (*NATSEventsSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9ac5d6cd016403b, []int{35}
+	return fileDescriptor_c9ac5d6cd016403b, []int{51}
 }
 func (m *NATSEventsSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1057,7 +1506,7 @@ var xxx_messageInfo_NATSEventsSource proto.InternalMessageInfo
 func (m *NSQEventSource) Reset()      { *m = NSQEventSource{} }
 func (*NSQEventSource) ProtoMessage() {}
 func (*NSQEventSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9ac5d6cd016403b, []int{36}
+	return fileDescriptor_c9ac5d6cd016403b, []int{52}
 }
 func (m *NSQEventSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1085,7 +1534,7 @@ var xxx_messageInfo_NSQEventSource proto.InternalMessageInfo
 func (m *OwnedRepositories) Reset()      { *m = OwnedRepositories{} }
 func (*OwnedRepositories) ProtoMessage() {}
 func (*OwnedRepositories) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9ac5d6cd016403b, []int{37}
+	return fileDescriptor_c9ac5d6cd016403b, []int{53}
 }
 func (m *OwnedRepositories) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1113,7 +1562,7 @@ var xxx_messageInfo_OwnedRepositories proto.InternalMessageInfo
 func (m *PubSubEventSource) Reset()      { *m = PubSubEventSource{} }
 func (*PubSubEventSource) ProtoMessage() {}
 func (*PubSubEventSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9ac5d6cd016403b, []int{38}
+	return fileDescriptor_c9ac5d6cd016403b, []int{54}
 }
 func (m *PubSubEventSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1141,7 +1590,7 @@ var xxx_messageInfo_PubSubEventSource proto.InternalMessageInfo
 func (m *PulsarEventSource) Reset()      { *m = PulsarEventSource{} }
 func (*PulsarEventSource) ProtoMessage() {}
 func (*PulsarEventSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9ac5d6cd016403b, []int{39}
+	return fileDescriptor_c9ac5d6cd016403b, []int{55}
 }
 func (m *PulsarEventSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1169,7 +1618,7 @@ var xxx_messageInfo_PulsarEventSource proto.InternalMessageInfo
 func (m *RedisEventSource) Reset()      { *m = RedisEventSource{} }
 func (*RedisEventSource) ProtoMessage() {}
 func (*RedisEventSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9ac5d6cd016403b, []int{40}
+	return fileDescriptor_c9ac5d6cd016403b, []int{56}
 }
 func (m *RedisEventSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1197,7 +1646,7 @@ var xxx_messageInfo_RedisEventSource proto.InternalMessageInfo
 func (m *RedisStreamEventSource) Reset()      { *m = RedisStreamEventSource{} }
 func (*RedisStreamEventSource) ProtoMessage() {}
 func (*RedisStreamEventSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9ac5d6cd016403b, []int{41}
+	return fileDescriptor_c9ac5d6cd016403b, []int{57}
 }
 func (m *RedisStreamEventSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1225,7 +1674,7 @@ var xxx_messageInfo_RedisStreamEventSource proto.InternalMessageInfo
 func (m *ResourceEventSource) Reset()      { *m = ResourceEventSource{} }
 func (*ResourceEventSource) ProtoMessage() {}
 func (*ResourceEventSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9ac5d6cd016403b, []int{42}
+	return fileDescriptor_c9ac5d6cd016403b, []int{58}
 }
 func (m *ResourceEventSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1253,7 +1702,7 @@ var xxx_messageInfo_ResourceEventSource proto.InternalMessageInfo
 func (m *ResourceFilter) Reset()      { *m = ResourceFilter{} }
 func (*ResourceFilter) ProtoMessage() {}
 func (*ResourceFilter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9ac5d6cd016403b, []int{43}
+	return fileDescriptor_c9ac5d6cd016403b, []int{59}
 }
 func (m *ResourceFilter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1281,7 +1730,7 @@ var xxx_messageInfo_ResourceFilter proto.InternalMessageInfo
 func (m *SFTPEventSource) Reset()      { *m = SFTPEventSource{} }
 func (*SFTPEventSource) ProtoMessage() {}
 func (*SFTPEventSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9ac5d6cd016403b, []int{44}
+	return fileDescriptor_c9ac5d6cd016403b, []int{60}
 }
 func (m *SFTPEventSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1309,7 +1758,7 @@ var xxx_messageInfo_SFTPEventSource proto.InternalMessageInfo
 func (m *SNSEventSource) Reset()      { *m = SNSEventSource{} }
 func (*SNSEventSource) ProtoMessage() {}
 func (*SNSEventSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9ac5d6cd016403b, []int{45}
+	return fileDescriptor_c9ac5d6cd016403b, []int{61}
 }
 func (m *SNSEventSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1334,10 +1783,38 @@ func (m *SNSEventSource) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_SNSEventSource proto.InternalMessageInfo
 
+func (m *SQLEventSource) Reset()      { *m = SQLEventSource{} }
+func (*SQLEventSource) ProtoMessage() {}
+func (*SQLEventSource) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9ac5d6cd016403b, []int{62}
+}
+func (m *SQLEventSource) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SQLEventSource) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *SQLEventSource) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SQLEventSource.Merge(m, src)
+}
+func (m *SQLEventSource) XXX_Size() int {
+	return m.Size()
+}
+func (m *SQLEventSource) XXX_DiscardUnknown() {
+	xxx_messageInfo_SQLEventSource.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SQLEventSource proto.InternalMessageInfo
+
 func (m *SQSEventSource) Reset()      { *m = SQSEventSource{} }
 func (*SQSEventSource) ProtoMessage() {}
 func (*SQSEventSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9ac5d6cd016403b, []int{46}
+	return fileDescriptor_c9ac5d6cd016403b, []int{63}
 }
 func (m *SQSEventSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1365,7 +1842,7 @@ var xxx_messageInfo_SQSEventSource proto.InternalMessageInfo
 func (m *Selector) Reset()      { *m = Selector{} }
 func (*Selector) ProtoMessage() {}
 func (*Selector) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9ac5d6cd016403b, []int{47}
+	return fileDescriptor_c9ac5d6cd016403b, []int{64}
 }
 func (m *Selector) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1393,7 +1870,7 @@ var xxx_messageInfo_Selector proto.InternalMessageInfo
 func (m *Service) Reset()      { *m = Service{} }
 func (*Service) ProtoMessage() {}
 func (*Service) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9ac5d6cd016403b, []int{48}
+	return fileDescriptor_c9ac5d6cd016403b, []int{65}
 }
 func (m *Service) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1421,7 +1898,7 @@ var xxx_messageInfo_Service proto.InternalMessageInfo
 func (m *SlackEventSource) Reset()      { *m = SlackEventSource{} }
 func (*SlackEventSource) ProtoMessage() {}
 func (*SlackEventSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9ac5d6cd016403b, []int{49}
+	return fileDescriptor_c9ac5d6cd016403b, []int{66}
 }
 func (m *SlackEventSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1449,7 +1926,7 @@ var xxx_messageInfo_SlackEventSource proto.InternalMessageInfo
 func (m *StorageGridEventSource) Reset()      { *m = StorageGridEventSource{} }
 func (*StorageGridEventSource) ProtoMessage() {}
 func (*StorageGridEventSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9ac5d6cd016403b, []int{50}
+	return fileDescriptor_c9ac5d6cd016403b, []int{67}
 }
 func (m *StorageGridEventSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1477,7 +1954,7 @@ var xxx_messageInfo_StorageGridEventSource proto.InternalMessageInfo
 func (m *StorageGridFilter) Reset()      { *m = StorageGridFilter{} }
 func (*StorageGridFilter) ProtoMessage() {}
 func (*StorageGridFilter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9ac5d6cd016403b, []int{51}
+	return fileDescriptor_c9ac5d6cd016403b, []int{68}
 }
 func (m *StorageGridFilter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1505,7 +1982,7 @@ var xxx_messageInfo_StorageGridFilter proto.InternalMessageInfo
 func (m *StripeEventSource) Reset()      { *m = StripeEventSource{} }
 func (*StripeEventSource) ProtoMessage() {}
 func (*StripeEventSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9ac5d6cd016403b, []int{52}
+	return fileDescriptor_c9ac5d6cd016403b, []int{69}
 }
 func (m *StripeEventSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1530,10 +2007,38 @@ func (m *StripeEventSource) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_StripeEventSource proto.InternalMessageInfo
 
+func (m *TCPDependencyProbe) Reset()      { *m = TCPDependencyProbe{} }
+func (*TCPDependencyProbe) ProtoMessage() {}
+func (*TCPDependencyProbe) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9ac5d6cd016403b, []int{70}
+}
+func (m *TCPDependencyProbe) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TCPDependencyProbe) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *TCPDependencyProbe) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TCPDependencyProbe.Merge(m, src)
+}
+func (m *TCPDependencyProbe) XXX_Size() int {
+	return m.Size()
+}
+func (m *TCPDependencyProbe) XXX_DiscardUnknown() {
+	xxx_messageInfo_TCPDependencyProbe.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TCPDependencyProbe proto.InternalMessageInfo
+
 func (m *Template) Reset()      { *m = Template{} }
 func (*Template) ProtoMessage() {}
 func (*Template) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9ac5d6cd016403b, []int{53}
+	return fileDescriptor_c9ac5d6cd016403b, []int{71}
 }
 func (m *Template) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1561,7 +2066,7 @@ var xxx_messageInfo_Template proto.InternalMessageInfo
 func (m *WatchPathConfig) Reset()      { *m = WatchPathConfig{} }
 func (*WatchPathConfig) ProtoMessage() {}
 func (*WatchPathConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9ac5d6cd016403b, []int{54}
+	return fileDescriptor_c9ac5d6cd016403b, []int{72}
 }
 func (m *WatchPathConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1589,7 +2094,7 @@ var xxx_messageInfo_WatchPathConfig proto.InternalMessageInfo
 func (m *WebhookContext) Reset()      { *m = WebhookContext{} }
 func (*WebhookContext) ProtoMessage() {}
 func (*WebhookContext) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9ac5d6cd016403b, []int{55}
+	return fileDescriptor_c9ac5d6cd016403b, []int{73}
 }
 func (m *WebhookContext) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1614,10 +2119,38 @@ func (m *WebhookContext) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_WebhookContext proto.InternalMessageInfo
 
+func (m *WebhookEnrichment) Reset()      { *m = WebhookEnrichment{} }
+func (*WebhookEnrichment) ProtoMessage() {}
+func (*WebhookEnrichment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9ac5d6cd016403b, []int{74}
+}
+func (m *WebhookEnrichment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WebhookEnrichment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *WebhookEnrichment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WebhookEnrichment.Merge(m, src)
+}
+func (m *WebhookEnrichment) XXX_Size() int {
+	return m.Size()
+}
+func (m *WebhookEnrichment) XXX_DiscardUnknown() {
+	xxx_messageInfo_WebhookEnrichment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WebhookEnrichment proto.InternalMessageInfo
+
 func (m *WebhookEventSource) Reset()      { *m = WebhookEventSource{} }
 func (*WebhookEventSource) ProtoMessage() {}
 func (*WebhookEventSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9ac5d6cd016403b, []int{56}
+	return fileDescriptor_c9ac5d6cd016403b, []int{75}
 }
 func (m *WebhookEventSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1642,6 +2175,118 @@ func (m *WebhookEventSource) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_WebhookEventSource proto.InternalMessageInfo
 
+func (m *WebhookNetwork) Reset()      { *m = WebhookNetwork{} }
+func (*WebhookNetwork) ProtoMessage() {}
+func (*WebhookNetwork) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9ac5d6cd016403b, []int{76}
+}
+func (m *WebhookNetwork) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WebhookNetwork) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *WebhookNetwork) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WebhookNetwork.Merge(m, src)
+}
+func (m *WebhookNetwork) XXX_Size() int {
+	return m.Size()
+}
+func (m *WebhookNetwork) XXX_DiscardUnknown() {
+	xxx_messageInfo_WebhookNetwork.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WebhookNetwork proto.InternalMessageInfo
+
+func (m *WebhookRedelivery) Reset()      { *m = WebhookRedelivery{} }
+func (*WebhookRedelivery) ProtoMessage() {}
+func (*WebhookRedelivery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9ac5d6cd016403b, []int{77}
+}
+func (m *WebhookRedelivery) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WebhookRedelivery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *WebhookRedelivery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WebhookRedelivery.Merge(m, src)
+}
+func (m *WebhookRedelivery) XXX_Size() int {
+	return m.Size()
+}
+func (m *WebhookRedelivery) XXX_DiscardUnknown() {
+	xxx_messageInfo_WebhookRedelivery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WebhookRedelivery proto.InternalMessageInfo
+
+func (m *WebhookReplay) Reset()      { *m = WebhookReplay{} }
+func (*WebhookReplay) ProtoMessage() {}
+func (*WebhookReplay) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9ac5d6cd016403b, []int{78}
+}
+func (m *WebhookReplay) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WebhookReplay) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *WebhookReplay) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WebhookReplay.Merge(m, src)
+}
+func (m *WebhookReplay) XXX_Size() int {
+	return m.Size()
+}
+func (m *WebhookReplay) XXX_DiscardUnknown() {
+	xxx_messageInfo_WebhookReplay.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WebhookReplay proto.InternalMessageInfo
+
+func (m *WebhookTokenRotation) Reset()      { *m = WebhookTokenRotation{} }
+func (*WebhookTokenRotation) ProtoMessage() {}
+func (*WebhookTokenRotation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9ac5d6cd016403b, []int{79}
+}
+func (m *WebhookTokenRotation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WebhookTokenRotation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *WebhookTokenRotation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WebhookTokenRotation.Merge(m, src)
+}
+func (m *WebhookTokenRotation) XXX_Size() int {
+	return m.Size()
+}
+func (m *WebhookTokenRotation) XXX_DiscardUnknown() {
+	xxx_messageInfo_WebhookTokenRotation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WebhookTokenRotation proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*AMQPConsumeConfig)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.AMQPConsumeConfig")
 	proto.RegisterType((*AMQPEventSource)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.AMQPEventSource")
@@ -1665,13 +2310,25 @@ func init() {
 	proto.RegisterType((*BitbucketServerRepository)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.BitbucketServerRepository")
 	proto.RegisterType((*CalendarEventSource)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.CalendarEventSource")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.CalendarEventSource.MetadataEntry")
+	proto.RegisterType((*CalendarStatus)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.CalendarStatus")
 	proto.RegisterType((*CatchupConfiguration)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.CatchupConfiguration")
+	proto.RegisterType((*ClusterEventSource)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.ClusterEventSource")
+	proto.RegisterType((*ClusterEventSourceList)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.ClusterEventSourceList")
+	proto.RegisterType((*ClusterEventSourceSpec)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.ClusterEventSourceSpec")
 	proto.RegisterType((*ConfigMapPersistence)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.ConfigMapPersistence")
+	proto.RegisterType((*DependencyProbe)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.DependencyProbe")
+	proto.RegisterType((*DynamoDBStreamsEventSource)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.DynamoDBStreamsEventSource")
+	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.DynamoDBStreamsEventSource.MetadataEntry")
+	proto.RegisterType((*ElasticsearchEventSource)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.ElasticsearchEventSource")
+	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.ElasticsearchEventSource.MetadataEntry")
 	proto.RegisterType((*EmitterEventSource)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.EmitterEventSource")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.EmitterEventSource.MetadataEntry")
+	proto.RegisterType((*EventCatalogEntry)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.EventCatalogEntry")
 	proto.RegisterType((*EventPersistence)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.EventPersistence")
+	proto.RegisterType((*EventSizeLimit)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.EventSizeLimit")
 	proto.RegisterType((*EventSource)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.EventSource")
 	proto.RegisterType((*EventSourceFilter)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.EventSourceFilter")
+	proto.RegisterType((*EventSourceInclude)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.EventSourceInclude")
 	proto.RegisterType((*EventSourceList)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.EventSourceList")
 	proto.RegisterType((*EventSourceSpec)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.EventSourceSpec")
 	proto.RegisterMapType((map[string]AMQPEventSource)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.EventSourceSpec.AmqpEntry")
@@ -1681,13 +2338,18 @@ func init() {
 	proto.RegisterMapType((map[string]BitbucketEventSource)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.EventSourceSpec.BitbucketEntry")
 	proto.RegisterMapType((map[string]BitbucketServerEventSource)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.EventSourceSpec.BitbucketserverEntry")
 	proto.RegisterMapType((map[string]CalendarEventSource)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.EventSourceSpec.CalendarEntry")
+	proto.RegisterMapType((map[string]EventCatalogEntry)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.EventSourceSpec.CatalogEntry")
+	proto.RegisterMapType((map[string]DynamoDBStreamsEventSource)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.EventSourceSpec.DynamoDBStreamsEntry")
+	proto.RegisterMapType((map[string]ElasticsearchEventSource)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.EventSourceSpec.ElasticsearchEntry")
 	proto.RegisterMapType((map[string]EmitterEventSource)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.EventSourceSpec.EmitterEntry")
 	proto.RegisterMapType((map[string]FileEventSource)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.EventSourceSpec.FileEntry")
 	proto.RegisterMapType((map[string]GenericEventSource)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.EventSourceSpec.GenericEntry")
 	proto.RegisterMapType((map[string]GerritEventSource)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.EventSourceSpec.GerritEntry")
 	proto.RegisterMapType((map[string]GithubEventSource)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.EventSourceSpec.GithubEntry")
 	proto.RegisterMapType((map[string]GitlabEventSource)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.EventSourceSpec.GitlabEntry")
+	proto.RegisterMapType((map[string]GRPCStreamEventSource)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.EventSourceSpec.GrpcStreamEntry")
 	proto.RegisterMapType((map[string]HDFSEventSource)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.EventSourceSpec.HdfsEntry")
+	proto.RegisterMapType((map[string]JenkinsEventSource)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.EventSourceSpec.JenkinsEntry")
 	proto.RegisterMapType((map[string]KafkaEventSource)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.EventSourceSpec.KafkaEntry")
 	proto.RegisterMapType((map[string]common.S3Artifact)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.EventSourceSpec.MinioEntry")
 	proto.RegisterMapType((map[string]MQTTEventSource)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.EventSourceSpec.MqttEntry")
@@ -1701,13 +2363,17 @@ func init() {
 	proto.RegisterMapType((map[string]SFTPEventSource)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.EventSourceSpec.SftpEntry")
 	proto.RegisterMapType((map[string]SlackEventSource)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.EventSourceSpec.SlackEntry")
 	proto.RegisterMapType((map[string]SNSEventSource)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.EventSourceSpec.SnsEntry")
+	proto.RegisterMapType((map[string]SQLEventSource)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.EventSourceSpec.SqlEntry")
 	proto.RegisterMapType((map[string]SQSEventSource)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.EventSourceSpec.SqsEntry")
 	proto.RegisterMapType((map[string]StorageGridEventSource)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.EventSourceSpec.StorageGridEntry")
 	proto.RegisterMapType((map[string]StripeEventSource)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.EventSourceSpec.StripeEntry")
 	proto.RegisterMapType((map[string]WebhookEventSource)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.EventSourceSpec.WebhookEntry")
 	proto.RegisterType((*EventSourceStatus)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.EventSourceStatus")
+	proto.RegisterType((*EventSourceTransform)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.EventSourceTransform")
 	proto.RegisterType((*FileEventSource)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.FileEventSource")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.FileEventSource.MetadataEntry")
+	proto.RegisterType((*GRPCStreamEventSource)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.GRPCStreamEventSource")
+	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.GRPCStreamEventSource.MetadataEntry")
 	proto.RegisterType((*GenericEventSource)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.GenericEventSource")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.GenericEventSource.MetadataEntry")
 	proto.RegisterType((*GerritEventSource)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.GerritEventSource")
@@ -1719,11 +2385,18 @@ func init() {
 	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.GitlabEventSource.MetadataEntry")
 	proto.RegisterType((*HDFSEventSource)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.HDFSEventSource")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.HDFSEventSource.MetadataEntry")
+	proto.RegisterType((*HTTPDependencyProbe)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.HTTPDependencyProbe")
+	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.HTTPDependencyProbe.HeadersEntry")
+	proto.RegisterType((*JenkinsEventSource)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.JenkinsEventSource")
+	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.JenkinsEventSource.MetadataEntry")
 	proto.RegisterType((*KafkaConsumerGroup)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.KafkaConsumerGroup")
 	proto.RegisterType((*KafkaEventSource)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.KafkaEventSource")
+	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.KafkaEventSource.HeaderExtensionsEntry")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.KafkaEventSource.MetadataEntry")
+	proto.RegisterType((*KafkaHeaderFilter)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.KafkaHeaderFilter")
 	proto.RegisterType((*MQTTEventSource)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.MQTTEventSource")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.MQTTEventSource.MetadataEntry")
+	proto.RegisterType((*MQTTSharedSubscription)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.MQTTSharedSubscription")
 	proto.RegisterType((*NATSAuth)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.NATSAuth")
 	proto.RegisterType((*NATSEventsSource)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.NATSEventsSource")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.NATSEventsSource.MetadataEntry")
@@ -1746,6 +2419,8 @@ func init() {
 	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.SFTPEventSource.MetadataEntry")
 	proto.RegisterType((*SNSEventSource)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.SNSEventSource")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.SNSEventSource.MetadataEntry")
+	proto.RegisterType((*SQLEventSource)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.SQLEventSource")
+	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.SQLEventSource.MetadataEntry")
 	proto.RegisterType((*SQSEventSource)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.SQSEventSource")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.SQSEventSource.MetadataEntry")
 	proto.RegisterType((*Selector)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.Selector")
@@ -1757,12 +2432,18 @@ func init() {
 	proto.RegisterType((*StorageGridFilter)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.StorageGridFilter")
 	proto.RegisterType((*StripeEventSource)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.StripeEventSource")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.StripeEventSource.MetadataEntry")
+	proto.RegisterType((*TCPDependencyProbe)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.TCPDependencyProbe")
 	proto.RegisterType((*Template)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.Template")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.Template.NodeSelectorEntry")
 	proto.RegisterType((*WatchPathConfig)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.WatchPathConfig")
 	proto.RegisterType((*WebhookContext)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.WebhookContext")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.WebhookContext.MetadataEntry")
+	proto.RegisterType((*WebhookEnrichment)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.WebhookEnrichment")
 	proto.RegisterType((*WebhookEventSource)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.WebhookEventSource")
+	proto.RegisterType((*WebhookNetwork)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.WebhookNetwork")
+	proto.RegisterType((*WebhookRedelivery)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.WebhookRedelivery")
+	proto.RegisterType((*WebhookReplay)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.WebhookReplay")
+	proto.RegisterType((*WebhookTokenRotation)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.WebhookTokenRotation")
 }
 
 func init() {
@@ -1770,466 +2451,630 @@ func init() {
 }
 
 var fileDescriptor_c9ac5d6cd016403b = []byte{
-	// 7343 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7d, 0x6d, 0x6c, 0x24, 0xc7,
-	0x75, 0xa0, 0x86, 0x33, 0x1c, 0xce, 0xbc, 0xe1, 0x67, 0xed, 0x6a, 0x35, 0xa2, 0xb5, 0xcb, 0x3d,
-	0x0a, 0x5e, 0x48, 0x77, 0x12, 0x79, 0xda, 0x3b, 0x9f, 0x65, 0xe9, 0x2c, 0x63, 0x86, 0x5c, 0xee,
-	0x52, 0x4b, 0x72, 0x87, 0xd5, 0x5c, 0x69, 0x65, 0x59, 0x92, 0x9b, 0x3d, 0xc5, 0x61, 0x9b, 0x3d,
-	0xdd, 0xc3, 0xee, 0x9e, 0xdd, 0xe5, 0x02, 0x67, 0x1b, 0x06, 0x7c, 0x77, 0xd6, 0x87, 0x6d, 0xdd,
-	0xc5, 0x49, 0x90, 0xc0, 0x40, 0x9c, 0x04, 0x0e, 0x82, 0x04, 0xf9, 0x17, 0x23, 0x7f, 0x83, 0xe4,
-	0x87, 0x91, 0xe4, 0x87, 0x93, 0x5f, 0x4e, 0x0c, 0x2c, 0x6c, 0x06, 0xf9, 0x97, 0x3f, 0x81, 0x7f,
-	0x25, 0xbf, 0x82, 0xfa, 0xe8, 0xea, 0xea, 0x8f, 0xe1, 0x72, 0x38, 0x3d, 0xe4, 0xca, 0xc8, 0x2f,
-	0x72, 0xea, 0xbd, 0x7a, 0xef, 0x75, 0xf5, 0x7b, 0xaf, 0x5e, 0xbd, 0xaa, 0x7a, 0x0d, 0xeb, 0x2d,
-	0xd3, 0xdf, 0xed, 0x6e, 0x2f, 0x18, 0x4e, 0x7b, 0x51, 0x77, 0x5b, 0x4e, 0xc7, 0x75, 0xbe, 0xc2,
-	0xfe, 0x79, 0x91, 0xdc, 0x25, 0xb6, 0xef, 0x2d, 0x76, 0xf6, 0x5a, 0x8b, 0x7a, 0xc7, 0xf4, 0x16,
-	0xf9, 0x6f, 0xa7, 0xeb, 0x1a, 0x64, 0xf1, 0xee, 0x4b, 0xba, 0xd5, 0xd9, 0xd5, 0x5f, 0x5a, 0x6c,
-	0x11, 0x9b, 0xb8, 0xba, 0x4f, 0x9a, 0x0b, 0x1d, 0xd7, 0xf1, 0x1d, 0xf4, 0xf9, 0x90, 0xdc, 0x42,
-	0x40, 0x8e, 0xfd, 0xf3, 0x1e, 0xef, 0xbe, 0xd0, 0xd9, 0x6b, 0x2d, 0x50, 0x72, 0x0b, 0x0a, 0xb9,
-	0x85, 0x80, 0xdc, 0xec, 0x17, 0x8e, 0x2d, 0x8d, 0xe1, 0xb4, 0xdb, 0x8e, 0x1d, 0xe7, 0x3f, 0xfb,
-	0xa2, 0x42, 0xa0, 0xe5, 0xb4, 0x9c, 0x45, 0xd6, 0xbc, 0xdd, 0xdd, 0x61, 0xbf, 0xd8, 0x0f, 0xf6,
-	0x9f, 0x40, 0x9f, 0xdf, 0x7b, 0xd9, 0x5b, 0x30, 0x1d, 0x4a, 0x72, 0xd1, 0x70, 0x5c, 0xfa, 0x60,
-	0x09, 0x92, 0xff, 0x3d, 0xc4, 0x69, 0xeb, 0xc6, 0xae, 0x69, 0x13, 0xf7, 0x20, 0x94, 0xa3, 0x4d,
-	0x7c, 0x3d, 0xad, 0xd7, 0x62, 0xaf, 0x5e, 0x6e, 0xd7, 0xf6, 0xcd, 0x36, 0x49, 0x74, 0xf8, 0x1f,
-	0x8f, 0xea, 0xe0, 0x19, 0xbb, 0xa4, 0xad, 0xc7, 0xfb, 0xcd, 0xff, 0x6b, 0x0e, 0x66, 0x6a, 0xeb,
-	0x9b, 0x8d, 0x25, 0xc7, 0xf6, 0xba, 0x6d, 0xb2, 0xe4, 0xd8, 0x3b, 0x66, 0x0b, 0x7d, 0x06, 0x2a,
-	0x06, 0x6f, 0x70, 0xb7, 0xf4, 0x56, 0x35, 0x77, 0x39, 0xf7, 0x5c, 0xb9, 0x7e, 0xee, 0xc7, 0x0f,
-	0xe7, 0x9e, 0x38, 0x7c, 0x38, 0x57, 0x59, 0x0a, 0x41, 0x58, 0xc5, 0x43, 0xcf, 0xc3, 0x98, 0xde,
-	0xf5, 0x9d, 0x9a, 0xb1, 0x57, 0x1d, 0xb9, 0x9c, 0x7b, 0xae, 0x54, 0x9f, 0x12, 0x5d, 0xc6, 0x6a,
-	0xbc, 0x19, 0x07, 0x70, 0xb4, 0x08, 0x65, 0x72, 0xdf, 0xb0, 0xba, 0x9e, 0x79, 0x97, 0x54, 0xf3,
-	0x0c, 0x79, 0x46, 0x20, 0x97, 0xaf, 0x05, 0x00, 0x1c, 0xe2, 0x50, 0xda, 0xb6, 0xb3, 0xe6, 0x18,
-	0xba, 0x55, 0x2d, 0x44, 0x69, 0x6f, 0xf0, 0x66, 0x1c, 0xc0, 0xd1, 0x15, 0x28, 0xda, 0xce, 0x9b,
-	0xba, 0xe9, 0x57, 0x47, 0x19, 0xe6, 0xa4, 0xc0, 0x2c, 0x6e, 0xb0, 0x56, 0x2c, 0xa0, 0xf3, 0xff,
-	0x5c, 0x81, 0x29, 0xfa, 0xec, 0xd7, 0xa8, 0x72, 0x68, 0x4c, 0x97, 0xd0, 0x45, 0xc8, 0x77, 0x5d,
-	0x4b, 0x3c, 0x71, 0x45, 0x74, 0xcc, 0xdf, 0xc6, 0x6b, 0x98, 0xb6, 0xa3, 0x97, 0x61, 0x9c, 0xdc,
-	0x37, 0x76, 0x75, 0xbb, 0x45, 0x36, 0xf4, 0x36, 0x61, 0x8f, 0x59, 0xae, 0x9f, 0x17, 0x78, 0xe3,
-	0xd7, 0x14, 0x18, 0x8e, 0x60, 0xaa, 0x3d, 0xb7, 0x0e, 0x3a, 0xfc, 0x99, 0x53, 0x7a, 0x52, 0x18,
-	0x8e, 0x60, 0xa2, 0xab, 0x00, 0xae, 0xd3, 0xf5, 0x4d, 0xbb, 0x75, 0x93, 0x1c, 0xb0, 0x87, 0x2f,
-	0xd7, 0x91, 0xe8, 0x07, 0x58, 0x42, 0xb0, 0x82, 0x85, 0xfe, 0x17, 0xcc, 0x18, 0x8e, 0x6d, 0x13,
-	0xc3, 0x37, 0x1d, 0xbb, 0xae, 0x1b, 0x7b, 0xce, 0xce, 0x0e, 0x1b, 0x8d, 0xca, 0xd5, 0x97, 0x17,
-	0x8e, 0x6d, 0x64, 0xdc, 0x4a, 0x16, 0x44, 0xff, 0xfa, 0x93, 0x87, 0x0f, 0xe7, 0x66, 0x96, 0xe2,
-	0x64, 0x71, 0x92, 0x13, 0x7a, 0x01, 0x4a, 0x5f, 0xf1, 0x1c, 0xbb, 0xee, 0x34, 0x0f, 0xaa, 0x45,
-	0xf6, 0x0e, 0xa6, 0x85, 0xc0, 0xa5, 0xd7, 0xb5, 0x5b, 0x1b, 0xb4, 0x1d, 0x4b, 0x0c, 0x74, 0x1b,
-	0xf2, 0xbe, 0xe5, 0x55, 0xc7, 0x98, 0x78, 0xaf, 0xf4, 0x2d, 0xde, 0xd6, 0x9a, 0xc6, 0xd5, 0xb6,
-	0x3e, 0x46, 0xdf, 0xd5, 0xd6, 0x9a, 0x86, 0x29, 0x3d, 0xf4, 0x7e, 0x0e, 0x4a, 0xd4, 0xbe, 0x9a,
-	0xba, 0xaf, 0x57, 0x4b, 0x97, 0xf3, 0xcf, 0x55, 0xae, 0x7e, 0x69, 0x61, 0x20, 0x07, 0xb3, 0x10,
-	0xd3, 0x96, 0x85, 0x75, 0x41, 0xfe, 0x9a, 0xed, 0xbb, 0x07, 0xe1, 0x33, 0x06, 0xcd, 0x58, 0xf2,
-	0x47, 0xbf, 0x91, 0x83, 0xa9, 0xe0, 0xad, 0x2e, 0x13, 0xc3, 0xd2, 0x5d, 0x52, 0x2d, 0xb3, 0x07,
-	0xbe, 0x93, 0x85, 0x4c, 0x51, 0xca, 0x62, 0x38, 0xce, 0x1d, 0x3e, 0x9c, 0x9b, 0x8a, 0x81, 0x70,
-	0x5c, 0x0a, 0xf4, 0x41, 0x0e, 0xc6, 0xf7, 0xbb, 0xa4, 0x2b, 0xc5, 0x02, 0x26, 0xd6, 0xed, 0x0c,
-	0xc4, 0xda, 0x54, 0xc8, 0x0a, 0x99, 0xa6, 0xa9, 0xb2, 0xab, 0xed, 0x38, 0xc2, 0x1c, 0x7d, 0x0d,
-	0xca, 0xec, 0x77, 0xdd, 0xb4, 0x9b, 0xd5, 0x0a, 0x93, 0x04, 0x67, 0x25, 0x09, 0xa5, 0x29, 0xc4,
-	0x98, 0xa0, 0x7e, 0x46, 0x36, 0xe2, 0x90, 0x27, 0xba, 0x07, 0x63, 0xc2, 0xa5, 0x55, 0xc7, 0x19,
-	0xfb, 0x46, 0x06, 0xec, 0x23, 0xde, 0xb5, 0x5e, 0xa1, 0x5e, 0x4b, 0x34, 0xe1, 0x80, 0x1b, 0xba,
-	0x03, 0x05, 0xbd, 0xeb, 0xef, 0x56, 0x27, 0x4e, 0x68, 0x06, 0x75, 0xdd, 0x33, 0x8d, 0x5a, 0xd7,
-	0xdf, 0xad, 0x97, 0x0e, 0x1f, 0xce, 0x15, 0xe8, 0x7f, 0x98, 0x51, 0x44, 0x18, 0xca, 0x5d, 0xd7,
-	0xd2, 0x88, 0xe1, 0x12, 0xbf, 0x3a, 0xc9, 0xc8, 0x7f, 0x7a, 0x81, 0xcf, 0x17, 0x94, 0xc2, 0x02,
-	0x9d, 0xba, 0x16, 0xee, 0xbe, 0xb4, 0xc0, 0x31, 0x6e, 0x92, 0x03, 0x8d, 0x58, 0xc4, 0xf0, 0x1d,
-	0x97, 0x0f, 0xd3, 0x6d, 0xbc, 0xc6, 0x21, 0x38, 0x24, 0x83, 0x7c, 0x28, 0xee, 0x98, 0x96, 0x4f,
-	0xdc, 0xea, 0x54, 0x26, 0xa3, 0xa4, 0x58, 0xd5, 0x0a, 0xa3, 0x5b, 0x07, 0xea, 0xb1, 0xf9, 0xff,
-	0x58, 0xf0, 0x9a, 0x7d, 0x15, 0x26, 0x22, 0x26, 0x87, 0xa6, 0x21, 0xbf, 0x47, 0x0e, 0xb8, 0xbb,
-	0xc6, 0xf4, 0x5f, 0x74, 0x1e, 0x46, 0xef, 0xea, 0x56, 0x57, 0xb8, 0x66, 0xcc, 0x7f, 0xbc, 0x32,
-	0xf2, 0x72, 0x6e, 0xfe, 0x27, 0x39, 0x78, 0xba, 0xa7, 0xb1, 0xd0, 0xf9, 0xa5, 0xd9, 0x75, 0xf5,
-	0x6d, 0x8b, 0x30, 0x6a, 0xca, 0xfc, 0xb2, 0xcc, 0x9b, 0x71, 0x00, 0xa7, 0x0e, 0x99, 0x4e, 0x63,
-	0xcb, 0xc4, 0x22, 0x3e, 0x11, 0x33, 0x9d, 0x74, 0xc8, 0x35, 0x09, 0xc1, 0x0a, 0x16, 0xf5, 0x88,
-	0xa6, 0xed, 0x13, 0xd7, 0xd6, 0x2d, 0x31, 0xdd, 0x49, 0x6f, 0xb1, 0x2a, 0xda, 0xb1, 0xc4, 0x50,
-	0x66, 0xb0, 0xc2, 0x91, 0x33, 0xd8, 0xe7, 0xe1, 0x5c, 0x8a, 0x76, 0x2b, 0xdd, 0x73, 0x47, 0x76,
-	0xff, 0xbd, 0x11, 0xb8, 0x90, 0x6e, 0xa7, 0xe8, 0x32, 0x14, 0x6c, 0x3a, 0xc1, 0xf1, 0x89, 0x70,
-	0x5c, 0x10, 0x28, 0xb0, 0x89, 0x8d, 0x41, 0xd4, 0x01, 0x1b, 0xe9, 0x6b, 0xc0, 0xf2, 0xc7, 0x1a,
-	0xb0, 0x48, 0x80, 0x50, 0x38, 0x46, 0x80, 0x70, 0xcc, 0x59, 0x9f, 0x12, 0xd6, 0xdd, 0x56, 0xb7,
-	0x4d, 0x95, 0x90, 0x4d, 0x4e, 0xe5, 0x90, 0x70, 0x2d, 0x00, 0xe0, 0x10, 0x67, 0xfe, 0xfd, 0x51,
-	0x78, 0xba, 0xf6, 0xa0, 0xeb, 0x12, 0xa6, 0xa3, 0xde, 0x8d, 0xee, 0xb6, 0x1a, 0x30, 0x5c, 0x86,
-	0xc2, 0xce, 0x7e, 0xd3, 0x8e, 0x0f, 0xd4, 0xca, 0xe6, 0xf2, 0x06, 0x66, 0x10, 0xd4, 0x81, 0x73,
-	0xde, 0xae, 0xee, 0x92, 0x66, 0xcd, 0x30, 0x88, 0xe7, 0xdd, 0x24, 0x07, 0x32, 0x74, 0x38, 0xb6,
-	0x21, 0x3e, 0x75, 0xf8, 0x70, 0xee, 0x9c, 0x96, 0xa4, 0x82, 0xd3, 0x48, 0xa3, 0x26, 0x4c, 0xc5,
-	0x9a, 0xd9, 0xa0, 0x1f, 0x9b, 0x1b, 0x9b, 0x38, 0x62, 0xdc, 0x70, 0x9c, 0x24, 0x55, 0x80, 0xdd,
-	0xee, 0x36, 0x7b, 0x16, 0x1e, 0x94, 0x48, 0x05, 0xb8, 0xc1, 0x9b, 0x71, 0x00, 0x47, 0xbf, 0xa6,
-	0x4e, 0xc5, 0xa3, 0x6c, 0x2a, 0xde, 0x19, 0xd4, 0xad, 0xf6, 0x7a, 0x23, 0x7d, 0x4c, 0xca, 0xa1,
-	0x13, 0x2b, 0x7e, 0x52, 0x9c, 0xd8, 0xef, 0x14, 0xe1, 0x19, 0xf6, 0xe8, 0xcc, 0x66, 0x35, 0xdf,
-	0x71, 0xf5, 0x16, 0x51, 0xf5, 0xf1, 0x75, 0x40, 0x1e, 0x6f, 0xad, 0x19, 0x86, 0xd3, 0xb5, 0xfd,
-	0x8d, 0xd0, 0x8c, 0x67, 0xc5, 0x58, 0x20, 0x2d, 0x81, 0x81, 0x53, 0x7a, 0xa1, 0x16, 0x4c, 0x87,
-	0xb1, 0x9d, 0xe6, 0xbb, 0xa6, 0xdd, 0xea, 0x4f, 0x6d, 0xcf, 0x1f, 0x3e, 0x9c, 0x9b, 0x5e, 0x8a,
-	0x91, 0xc0, 0x09, 0xa2, 0xd4, 0x26, 0xd9, 0x0c, 0xcc, 0x64, 0xcd, 0x47, 0x6d, 0x72, 0x33, 0x00,
-	0xe0, 0x10, 0x27, 0x12, 0x60, 0x16, 0x1e, 0x19, 0x60, 0x5e, 0x84, 0x7c, 0xd3, 0xda, 0x17, 0x7e,
-	0x41, 0x06, 0xf5, 0xcb, 0x6b, 0x9b, 0x98, 0xb6, 0xd3, 0xd8, 0x2c, 0xd4, 0xce, 0x22, 0xd3, 0x4e,
-	0x33, 0x0b, 0xed, 0xec, 0xf1, 0x8a, 0x4e, 0xa4, 0xa0, 0x63, 0xa7, 0xa7, 0xa0, 0xe8, 0x55, 0x98,
-	0x68, 0x12, 0xc3, 0x69, 0x92, 0x75, 0xe2, 0x79, 0x7a, 0x8b, 0x54, 0x4b, 0x6c, 0xe0, 0x9e, 0x14,
-	0x82, 0x4e, 0x2c, 0xab, 0x40, 0x1c, 0xc5, 0x45, 0x4b, 0x30, 0x73, 0x4f, 0x37, 0xfd, 0x2d, 0xb3,
-	0x4d, 0x56, 0x6d, 0x8d, 0x18, 0x8e, 0xdd, 0xf4, 0x58, 0xa4, 0x3b, 0xca, 0xd7, 0x0f, 0x6f, 0xc6,
-	0x81, 0x38, 0x89, 0x3f, 0x98, 0x89, 0xfc, 0xb4, 0x08, 0xb3, 0x6c, 0xfc, 0x35, 0xe2, 0xde, 0x35,
-	0x0d, 0x52, 0xef, 0x7a, 0xaa, 0x81, 0xa4, 0x29, 0x75, 0x6e, 0xe8, 0x4a, 0x3d, 0x72, 0x0c, 0xa5,
-	0x5e, 0x84, 0xb2, 0xef, 0x74, 0x4c, 0x23, 0xcd, 0x0a, 0xb6, 0x02, 0x00, 0x0e, 0x71, 0xd0, 0x32,
-	0x4c, 0x7b, 0xdd, 0x6d, 0xcf, 0x70, 0xcd, 0x0e, 0xe5, 0xab, 0xb8, 0xe2, 0xaa, 0xe8, 0x37, 0xad,
-	0xc5, 0xe0, 0x38, 0xd1, 0x23, 0x58, 0x7e, 0x8d, 0x66, 0xbc, 0xfc, 0xea, 0x6f, 0x0d, 0xf8, 0x3d,
-	0xd5, 0x06, 0xc7, 0x98, 0x0d, 0xb6, 0xb2, 0xb0, 0xc1, 0x54, 0x1d, 0x38, 0x91, 0x05, 0x96, 0x4e,
-	0xd1, 0x02, 0xdf, 0x82, 0xa7, 0x76, 0xba, 0x96, 0x75, 0xb0, 0xd9, 0xd5, 0x2d, 0x73, 0xc7, 0x24,
-	0x4d, 0xfa, 0xa2, 0xbc, 0x8e, 0x6e, 0xf0, 0x45, 0x63, 0xb9, 0x3e, 0x27, 0x44, 0x7e, 0x6a, 0x25,
-	0x1d, 0x0d, 0xf7, 0xea, 0x3f, 0x98, 0x69, 0xfd, 0x43, 0x0e, 0x26, 0xea, 0xa6, 0xbf, 0xdd, 0x35,
-	0xf6, 0x88, 0x4f, 0x57, 0x18, 0xc8, 0x85, 0xd1, 0x6d, 0xba, 0xf0, 0x10, 0x26, 0xb4, 0x39, 0xe0,
-	0xf0, 0x48, 0xe2, 0xe1, 0x6a, 0xa6, 0x7c, 0xf8, 0x70, 0x6e, 0x94, 0xfd, 0xc4, 0x9c, 0x15, 0xba,
-	0x0d, 0xe0, 0xd0, 0x85, 0xcd, 0x96, 0xb3, 0x47, 0xec, 0xfe, 0x26, 0xa4, 0x49, 0x1a, 0x71, 0xde,
-	0xaa, 0x05, 0x9d, 0xb1, 0x42, 0x68, 0xfe, 0x47, 0x39, 0x40, 0x49, 0xfe, 0xe8, 0x16, 0x94, 0xba,
-	0x1e, 0x0d, 0xcb, 0xc5, 0x34, 0x7a, 0x6c, 0x5e, 0xe3, 0x54, 0xa5, 0x6e, 0x8b, 0xae, 0x58, 0x12,
-	0xa1, 0x04, 0x3b, 0xba, 0xe7, 0xdd, 0x73, 0xdc, 0x66, 0x7f, 0xc2, 0x33, 0x82, 0x0d, 0xd1, 0x15,
-	0x4b, 0x22, 0xf3, 0xbf, 0x1c, 0x83, 0xf3, 0x52, 0xf0, 0x58, 0x2c, 0xd0, 0x64, 0xd1, 0xf4, 0x0d,
-	0xc7, 0xd9, 0xbb, 0x65, 0xaf, 0x98, 0xb6, 0xe9, 0xed, 0x8a, 0x35, 0x81, 0x8c, 0x05, 0x96, 0x13,
-	0x18, 0x38, 0xa5, 0x17, 0xfa, 0x8e, 0x6a, 0xa0, 0x23, 0xcc, 0x40, 0xf5, 0xac, 0x5e, 0xf6, 0x49,
-	0x4d, 0x73, 0xec, 0x1e, 0xd9, 0xde, 0x75, 0x9c, 0x3d, 0x11, 0xdd, 0xae, 0x0f, 0x28, 0xcf, 0x9b,
-	0x9c, 0xda, 0x92, 0x63, 0xfb, 0xe4, 0xbe, 0xcf, 0x97, 0xe9, 0xa2, 0x0d, 0x07, 0xac, 0xd0, 0x57,
-	0xc4, 0x32, 0xbd, 0xc0, 0x58, 0xae, 0x65, 0x35, 0x04, 0xa9, 0x0b, 0xf7, 0x79, 0x28, 0xf2, 0x5e,
-	0x2c, 0x66, 0x2e, 0x73, 0x57, 0xc1, 0x63, 0x5e, 0x2c, 0x20, 0xe8, 0x45, 0x18, 0x75, 0xee, 0xd9,
-	0x22, 0x84, 0x2d, 0xd7, 0x9f, 0x12, 0x03, 0x36, 0xb5, 0x4c, 0x3a, 0x2e, 0x31, 0x74, 0x9f, 0x34,
-	0x6f, 0x51, 0x30, 0xe6, 0x58, 0xe8, 0x7f, 0x02, 0x50, 0x11, 0x89, 0x41, 0x35, 0x8b, 0x45, 0x15,
-	0xe5, 0xfa, 0x33, 0xa2, 0xcf, 0xf9, 0xb0, 0x4f, 0x43, 0xe2, 0x60, 0x05, 0x1f, 0xdd, 0x80, 0x49,
-	0x97, 0x74, 0x1c, 0xcf, 0xf4, 0x1d, 0xf7, 0x40, 0xb3, 0xba, 0x2d, 0xe6, 0x15, 0xcb, 0xf5, 0xcb,
-	0x82, 0x42, 0x35, 0xa4, 0x80, 0x23, 0x78, 0x38, 0xd6, 0x0f, 0x7d, 0x98, 0x83, 0x71, 0xd9, 0x64,
-	0x12, 0x1a, 0x22, 0xe4, 0x33, 0xc8, 0xf5, 0xc8, 0xf1, 0x0c, 0xd9, 0x87, 0x39, 0x56, 0xac, 0xf0,
-	0xc3, 0x11, 0xee, 0x8a, 0x9b, 0x87, 0x4f, 0xca, 0x4a, 0xe0, 0x01, 0x9c, 0x4b, 0x79, 0x5a, 0xf4,
-	0x6c, 0xa0, 0x0f, 0x3c, 0xe4, 0x9f, 0x10, 0x0f, 0x3f, 0x1a, 0xd1, 0x82, 0xd7, 0x12, 0xef, 0x91,
-	0xc7, 0x27, 0x17, 0x04, 0xf6, 0xe4, 0xd1, 0x6f, 0x6f, 0xfe, 0x0f, 0x2a, 0x30, 0x2b, 0x99, 0xd3,
-	0x29, 0x96, 0xb8, 0xaa, 0xdf, 0x51, 0x2c, 0x33, 0x77, 0x7a, 0x96, 0x19, 0x55, 0xed, 0x91, 0x81,
-	0x55, 0x3b, 0x7f, 0x42, 0xd5, 0x7e, 0x0e, 0x4a, 0x82, 0xae, 0x57, 0x2d, 0x30, 0xbb, 0xe5, 0x8e,
-	0x5b, 0xb4, 0x61, 0x09, 0x45, 0xff, 0x2f, 0x6e, 0x04, 0x7c, 0x69, 0x7c, 0x27, 0x2b, 0x23, 0xe0,
-	0x6f, 0xa6, 0x4f, 0x53, 0x08, 0x9d, 0x4e, 0xb1, 0xa7, 0xd3, 0xd9, 0x83, 0x8b, 0xde, 0x9e, 0xd9,
-	0xa9, 0xbb, 0xba, 0x6d, 0xec, 0x62, 0xb2, 0xe3, 0x2d, 0xb1, 0x8c, 0x5a, 0xf3, 0x96, 0x7d, 0xab,
-	0x43, 0xec, 0x06, 0x66, 0x8e, 0xa5, 0x54, 0xff, 0xb4, 0x60, 0x77, 0x51, 0x3b, 0x0a, 0x19, 0x1f,
-	0x4d, 0x0b, 0xdd, 0x81, 0x8a, 0xce, 0x92, 0x0e, 0x7c, 0xbe, 0x2f, 0xf5, 0x33, 0x65, 0x4e, 0x1d,
-	0x3e, 0x9c, 0xab, 0xd4, 0xc2, 0xde, 0x58, 0x25, 0x85, 0xde, 0x85, 0x09, 0xa1, 0x3c, 0x22, 0x39,
-	0x5a, 0xee, 0x87, 0xf6, 0x0c, 0x5d, 0x0b, 0xbd, 0xa9, 0xf6, 0xc7, 0x51, 0x72, 0xe8, 0x0d, 0xb8,
-	0xb0, 0x1d, 0xbc, 0x0b, 0x8f, 0xbd, 0x8b, 0xba, 0xee, 0x91, 0xdb, 0x78, 0x8d, 0x79, 0x99, 0x72,
-	0xfd, 0x92, 0x18, 0x9f, 0x0b, 0xb1, 0x37, 0x26, 0xb0, 0x70, 0x8f, 0xde, 0x3d, 0xe6, 0xf5, 0xca,
-	0x89, 0xe6, 0xf5, 0x48, 0xe0, 0x3d, 0x9e, 0x49, 0xe0, 0xdd, 0xdb, 0x33, 0x9c, 0x28, 0xf0, 0x9e,
-	0x38, 0xc5, 0xc0, 0x5b, 0xac, 0x85, 0x26, 0x33, 0x5e, 0x0b, 0xbd, 0x0a, 0x13, 0xc6, 0x2e, 0x31,
-	0xf6, 0x58, 0xaa, 0xf7, 0xae, 0x6e, 0xb1, 0xa4, 0x79, 0x39, 0x5c, 0x51, 0x2f, 0xa9, 0x40, 0x1c,
-	0xc5, 0x1d, 0x6c, 0x96, 0xf8, 0x4e, 0x0e, 0x9e, 0xee, 0xe9, 0x0f, 0xd0, 0xd5, 0x88, 0xcb, 0xcc,
-	0x45, 0xb7, 0x16, 0x7b, 0x38, 0xca, 0x41, 0xe7, 0x8e, 0xdf, 0x1f, 0x85, 0x73, 0x4b, 0xba, 0x45,
-	0xec, 0xa6, 0x1e, 0x99, 0x34, 0x5e, 0x80, 0x92, 0x67, 0xec, 0x92, 0x66, 0xd7, 0x0a, 0xd2, 0x55,
-	0x52, 0x3d, 0x34, 0xd1, 0x8e, 0x25, 0x86, 0xcc, 0xa7, 0xd3, 0xc1, 0x1c, 0x89, 0x62, 0xcb, 0x71,
-	0x94, 0x18, 0xe8, 0x15, 0x98, 0x14, 0x89, 0x62, 0xc7, 0x5e, 0xd6, 0x7d, 0xe2, 0x55, 0xf3, 0xcc,
-	0xb7, 0x21, 0x2a, 0xef, 0xb5, 0x08, 0x04, 0xc7, 0x30, 0x29, 0x27, 0xdf, 0x6c, 0x93, 0x07, 0x8e,
-	0x1d, 0x2c, 0xae, 0x25, 0xa7, 0x2d, 0xd1, 0x8e, 0x25, 0x06, 0xfa, 0x76, 0x32, 0xd3, 0xf9, 0xe5,
-	0x01, 0x35, 0x37, 0x65, 0xb0, 0xfa, 0xb0, 0xa3, 0x6f, 0xe4, 0xa0, 0xd2, 0x21, 0xae, 0x67, 0x7a,
-	0x3e, 0xb1, 0x0d, 0x22, 0x32, 0x9d, 0xb7, 0xb2, 0xb0, 0xa6, 0x46, 0x48, 0x96, 0x3b, 0x5a, 0xa5,
-	0x01, 0xab, 0x4c, 0xcf, 0x66, 0x15, 0x3d, 0x98, 0xe1, 0xdc, 0x87, 0xf3, 0x4b, 0xba, 0x6f, 0xec,
-	0x76, 0x3b, 0xdc, 0xa2, 0xbb, 0xae, 0xee, 0x9b, 0x8e, 0x8d, 0x9e, 0x87, 0x31, 0x62, 0xeb, 0xdb,
-	0x16, 0x69, 0xc6, 0xf7, 0x89, 0xae, 0xf1, 0x66, 0x1c, 0xc0, 0xd1, 0x67, 0xa0, 0xd2, 0xd6, 0xef,
-	0x2f, 0x8b, 0x9e, 0x42, 0x4d, 0xe5, 0x29, 0x8a, 0xf5, 0x10, 0x84, 0x55, 0xbc, 0xf9, 0xaf, 0xc2,
-	0x79, 0xce, 0x72, 0x5d, 0xef, 0x28, 0x23, 0x7a, 0x8c, 0x2d, 0x99, 0x65, 0x98, 0x36, 0x5c, 0xa2,
-	0xfb, 0x64, 0x75, 0x67, 0xc3, 0xf1, 0xaf, 0xdd, 0x37, 0x3d, 0x5f, 0xec, 0xcd, 0xc8, 0x7c, 0xd0,
-	0x52, 0x0c, 0x8e, 0x13, 0x3d, 0xe6, 0xbf, 0x3b, 0x06, 0xe8, 0x5a, 0xdb, 0xf4, 0xfd, 0x68, 0x50,
-	0x77, 0x05, 0x8a, 0xdb, 0xae, 0xb3, 0x27, 0x23, 0x4b, 0xb9, 0xbf, 0x52, 0x67, 0xad, 0x58, 0x40,
-	0xa9, 0x4f, 0x31, 0x76, 0x75, 0xdb, 0x26, 0x56, 0x18, 0x86, 0x49, 0x9f, 0xb2, 0x24, 0x21, 0x58,
-	0xc1, 0x62, 0xe7, 0x4d, 0xf8, 0x2f, 0x25, 0xf7, 0x15, 0x9e, 0x37, 0x09, 0x41, 0x58, 0xc5, 0x8b,
-	0x2c, 0xcd, 0x0b, 0x59, 0x2f, 0xcd, 0x47, 0x33, 0x58, 0x9a, 0xa7, 0x9f, 0xc3, 0x28, 0x9e, 0xc9,
-	0x39, 0x8c, 0xb1, 0xe3, 0x9e, 0xc3, 0x28, 0x65, 0x3c, 0xf9, 0x7d, 0xa4, 0xba, 0x44, 0xbe, 0xcc,
-	0x7b, 0x6f, 0x50, 0xfb, 0x4f, 0xa8, 0xe7, 0x89, 0x22, 0x8b, 0x4f, 0xcc, 0x5a, 0xef, 0xe3, 0x11,
-	0x98, 0x8e, 0xbb, 0x5c, 0xf4, 0x00, 0xc6, 0x0c, 0xee, 0xa1, 0xc4, 0x2a, 0x4b, 0x1b, 0x78, 0xa2,
-	0x49, 0xfa, 0x3b, 0x71, 0x58, 0x81, 0x43, 0x70, 0xc0, 0x10, 0x7d, 0x3d, 0x07, 0x65, 0x23, 0x70,
-	0x52, 0x22, 0x8b, 0x35, 0x30, 0xfb, 0x14, 0xa7, 0xc7, 0x4f, 0x20, 0x48, 0x08, 0x0e, 0x99, 0xce,
-	0xff, 0x6c, 0x04, 0x2a, 0xaa, 0x7f, 0xfa, 0xb2, 0xa2, 0x65, 0x7c, 0x3c, 0xfe, 0xab, 0x62, 0xbb,
-	0xf2, 0x50, 0x5c, 0x28, 0x04, 0xc5, 0xa6, 0xd6, 0x7c, 0x6b, 0x9b, 0x86, 0x36, 0xf4, 0xe5, 0x84,
-	0x7e, 0x2a, 0x6c, 0x53, 0x14, 0xa7, 0x03, 0x05, 0xaf, 0x43, 0x0c, 0xf1, 0xb8, 0x1b, 0xd9, 0xa9,
-	0x8d, 0xd6, 0x21, 0x46, 0xe8, 0xd0, 0xe9, 0x2f, 0xcc, 0x38, 0xa1, 0xfb, 0x50, 0xf4, 0x7c, 0xdd,
-	0xef, 0x7a, 0x22, 0xc3, 0x95, 0xa1, 0xaa, 0x6a, 0x8c, 0x6e, 0xe8, 0xc5, 0xf9, 0x6f, 0x2c, 0xf8,
-	0xcd, 0x5f, 0x87, 0x99, 0x84, 0x5e, 0x53, 0xd7, 0x4e, 0xee, 0x77, 0x5c, 0xe2, 0xd1, 0xe8, 0x28,
-	0x1e, 0x2e, 0x5e, 0x93, 0x10, 0xac, 0x60, 0xcd, 0xff, 0x3c, 0x07, 0x53, 0x0a, 0xa5, 0x35, 0xd3,
-	0xf3, 0xd1, 0x97, 0x12, 0xaf, 0x6a, 0xe1, 0x78, 0xaf, 0x8a, 0xf6, 0x66, 0x2f, 0x4a, 0xda, 0x77,
-	0xd0, 0xa2, 0xbc, 0x26, 0x07, 0x46, 0x4d, 0x9f, 0xb4, 0x3d, 0x91, 0xa5, 0x7c, 0x3d, 0xbb, 0x31,
-	0x0b, 0xb3, 0x29, 0xab, 0x94, 0x01, 0xe6, 0x7c, 0xe6, 0xff, 0x62, 0x25, 0xf2, 0x88, 0xf4, 0xfd,
-	0xb1, 0xe3, 0x7e, 0xb4, 0xa9, 0xde, 0xf5, 0x94, 0x0d, 0xd8, 0xf0, 0xb8, 0x9f, 0x02, 0xc3, 0x11,
-	0x4c, 0xb4, 0x0f, 0x25, 0x9f, 0xb4, 0x3b, 0x96, 0xee, 0x07, 0x67, 0x04, 0xae, 0x0f, 0xf8, 0x04,
-	0x5b, 0x82, 0x1c, 0x9f, 0xa5, 0x82, 0x5f, 0x58, 0xb2, 0x41, 0x6d, 0x18, 0xf3, 0xf8, 0x3e, 0x89,
-	0xd0, 0xb3, 0x95, 0x01, 0x39, 0x06, 0xbb, 0x2e, 0xcc, 0x79, 0x88, 0x1f, 0x38, 0xe0, 0x81, 0xbe,
-	0x0a, 0xa3, 0x6d, 0xd3, 0x36, 0x1d, 0x96, 0x1d, 0xa9, 0x5c, 0x7d, 0x2b, 0x5b, 0x43, 0x5a, 0x58,
-	0xa7, 0xb4, 0xf9, 0x34, 0x20, 0xdf, 0x17, 0x6b, 0xc3, 0x9c, 0x2d, 0x3b, 0x18, 0x68, 0x88, 0xa0,
-	0x5a, 0xc4, 0xe8, 0x5f, 0xca, 0x58, 0x06, 0x19, 0xb3, 0x47, 0x67, 0xa3, 0xa0, 0x19, 0x4b, 0xfe,
-	0xe8, 0x01, 0x14, 0x76, 0x4c, 0x8b, 0x88, 0x7d, 0xe7, 0x3b, 0x19, 0xcb, 0xb1, 0x62, 0x5a, 0x84,
-	0xcb, 0x10, 0x9e, 0x4c, 0x31, 0x2d, 0x82, 0x19, 0x4f, 0x36, 0x10, 0x2e, 0xe1, 0x34, 0xc4, 0xa6,
-	0x5b, 0xd6, 0x03, 0x81, 0x05, 0xf9, 0xd8, 0x40, 0x04, 0xcd, 0x58, 0xf2, 0x47, 0xff, 0x3b, 0x17,
-	0x66, 0x0d, 0xf9, 0x69, 0xcd, 0xb7, 0x33, 0x96, 0x45, 0xe4, 0x6a, 0xb8, 0x28, 0x32, 0x6c, 0x4f,
-	0xe4, 0x11, 0x1f, 0x40, 0x41, 0x6f, 0xef, 0x77, 0x44, 0xa8, 0x92, 0xf5, 0x1b, 0xa9, 0xb5, 0xf7,
-	0x3b, 0xb1, 0x37, 0x52, 0x5b, 0xdf, 0x6c, 0x60, 0xc6, 0x93, 0x9a, 0xc6, 0x9e, 0xbe, 0xb3, 0xa7,
-	0x57, 0x61, 0x28, 0xa6, 0x71, 0x93, 0xd2, 0x8e, 0x99, 0x06, 0x6b, 0xc3, 0x9c, 0x2d, 0x7d, 0xf6,
-	0xf6, 0xbe, 0xef, 0x57, 0x2b, 0x43, 0x79, 0xf6, 0xf5, 0x7d, 0xdf, 0x8f, 0x3d, 0xfb, 0xfa, 0xe6,
-	0xd6, 0x16, 0x66, 0x3c, 0x29, 0x6f, 0x5b, 0xf7, 0x3d, 0x91, 0x84, 0xca, 0x9a, 0xf7, 0x86, 0xee,
-	0x7b, 0x31, 0xde, 0x1b, 0xb5, 0x2d, 0x0d, 0x33, 0x9e, 0xe8, 0x2e, 0xe4, 0x3d, 0xdb, 0xab, 0x4e,
-	0x30, 0xd6, 0x6f, 0x66, 0xcc, 0x5a, 0xb3, 0x05, 0x67, 0x79, 0xf4, 0x44, 0xdb, 0xd0, 0x30, 0x65,
-	0xc8, 0xf8, 0xee, 0x7b, 0xd5, 0xc9, 0xe1, 0xf0, 0xdd, 0x4f, 0xf0, 0xdd, 0xa4, 0x7c, 0xf7, 0x3d,
-	0xf4, 0x8d, 0x1c, 0x14, 0x3b, 0xdd, 0x6d, 0xad, 0xbb, 0x5d, 0x9d, 0x62, 0xbc, 0xbf, 0x98, 0x31,
-	0xef, 0x06, 0x23, 0xce, 0xd9, 0xcb, 0x18, 0x83, 0x37, 0x62, 0xc1, 0x99, 0x09, 0xc1, 0xb9, 0x56,
-	0xa7, 0x87, 0x22, 0xc4, 0x75, 0x46, 0x2d, 0x26, 0x04, 0x6f, 0xc4, 0x82, 0x73, 0x20, 0x84, 0xa5,
-	0x6f, 0x57, 0x67, 0x86, 0x25, 0x84, 0xa5, 0xa7, 0x08, 0x61, 0xe9, 0x5c, 0x08, 0x4b, 0xdf, 0xa6,
-	0xaa, 0xbf, 0xdb, 0xdc, 0xf1, 0xaa, 0x68, 0x28, 0xaa, 0x7f, 0xa3, 0xb9, 0x13, 0x57, 0xfd, 0x1b,
-	0xcb, 0x2b, 0x1a, 0x66, 0x3c, 0xa9, 0xcb, 0xf1, 0x2c, 0xdd, 0xd8, 0xab, 0x9e, 0x1b, 0x8a, 0xcb,
-	0xd1, 0x28, 0xed, 0x98, 0xcb, 0x61, 0x6d, 0x98, 0xb3, 0x45, 0xbf, 0x9e, 0x83, 0x8a, 0x38, 0x7b,
-	0x76, 0xdd, 0x35, 0x9b, 0xd5, 0xf3, 0xd9, 0xac, 0x10, 0xe3, 0x62, 0x84, 0x1c, 0xb8, 0x30, 0x32,
-	0xbb, 0xa0, 0x40, 0xb0, 0x2a, 0x08, 0xfa, 0xdd, 0x1c, 0x4c, 0xea, 0x91, 0x53, 0x86, 0xd5, 0x27,
-	0x99, 0x6c, 0xdb, 0x59, 0x4f, 0x09, 0xd1, 0xa3, 0x8c, 0x4c, 0x3c, 0x99, 0x4d, 0x8d, 0x02, 0x71,
-	0x4c, 0x22, 0xa6, 0xbe, 0x9e, 0xef, 0x9a, 0x1d, 0x52, 0xbd, 0x30, 0x14, 0xf5, 0xd5, 0x18, 0xf1,
-	0x98, 0xfa, 0xf2, 0x46, 0x2c, 0x38, 0xb3, 0xa9, 0x9b, 0xf0, 0x25, 0x79, 0xf5, 0xa9, 0xa1, 0x4c,
-	0xdd, 0xc1, 0x82, 0x3f, 0x3a, 0x75, 0x8b, 0x56, 0x1c, 0x30, 0xa7, 0xba, 0xec, 0x92, 0xa6, 0xe9,
-	0x55, 0xab, 0x43, 0xd1, 0x65, 0x4c, 0x69, 0xc7, 0x74, 0x99, 0xb5, 0x61, 0xce, 0x96, 0xba, 0x73,
-	0xdb, 0xdb, 0xaf, 0x3e, 0x3d, 0x14, 0x77, 0xbe, 0xe1, 0xed, 0xc7, 0xdc, 0xf9, 0x86, 0xb6, 0x89,
-	0x29, 0x43, 0xe1, 0xce, 0x2d, 0x4f, 0x77, 0xab, 0xb3, 0x43, 0x72, 0xe7, 0x94, 0x78, 0xc2, 0x9d,
-	0xd3, 0x46, 0x2c, 0x38, 0x33, 0x2d, 0x60, 0xd7, 0xcb, 0x4c, 0xa3, 0xfa, 0xa9, 0xa1, 0x68, 0xc1,
-	0x75, 0x4e, 0x3d, 0xa6, 0x05, 0xa2, 0x15, 0x07, 0xcc, 0xd1, 0x73, 0x34, 0xaa, 0xed, 0x58, 0xa6,
-	0xa1, 0x7b, 0xd5, 0x67, 0xd8, 0xc9, 0xc3, 0x71, 0x1e, 0x73, 0xf2, 0x36, 0x2c, 0xa1, 0xe8, 0x87,
-	0x39, 0x98, 0x8a, 0xed, 0xb1, 0x55, 0x2f, 0x32, 0xd1, 0x8d, 0x8c, 0x45, 0xaf, 0x47, 0xb9, 0xf0,
-	0x47, 0x90, 0x87, 0x35, 0xe2, 0x3b, 0x34, 0x71, 0xa1, 0xd0, 0xb7, 0x73, 0x50, 0x96, 0x6d, 0xd5,
-	0x4b, 0x4c, 0xc4, 0x77, 0x86, 0x25, 0x22, 0x17, 0x4e, 0x1e, 0x3d, 0x0c, 0x4f, 0x19, 0x84, 0x22,
-	0x30, 0xaf, 0xcd, 0x74, 0x5e, 0xf3, 0x5d, 0xa2, 0xb7, 0xab, 0x73, 0x43, 0xf1, 0xda, 0x38, 0xe4,
-	0x10, 0xf3, 0xda, 0x0a, 0x04, 0xab, 0x82, 0xb0, 0x57, 0xaa, 0x47, 0x4f, 0xfe, 0x55, 0x2f, 0x0f,
-	0xe5, 0x95, 0xc6, 0xcf, 0x17, 0x46, 0x5f, 0x69, 0x0c, 0x8a, 0xe3, 0x42, 0xa1, 0x3f, 0xc9, 0xc1,
-	0x8c, 0x1e, 0x3f, 0x26, 0x5c, 0xfd, 0x4f, 0x4c, 0x54, 0x32, 0x0c, 0x51, 0x23, 0xc7, 0x91, 0x99,
-	0xb0, 0x4f, 0x0b, 0x61, 0x67, 0x12, 0x70, 0x9c, 0x14, 0x8d, 0x06, 0x29, 0xde, 0x8e, 0xdf, 0xa9,
-	0xce, 0x0f, 0x25, 0x48, 0xd1, 0x76, 0xfc, 0xf8, 0xba, 0x48, 0x5b, 0xd9, 0x6a, 0x60, 0xc6, 0x93,
-	0x47, 0x69, 0xc4, 0x75, 0x4d, 0xbf, 0xfa, 0xec, 0x70, 0xa2, 0x34, 0x46, 0x3c, 0x1e, 0xa5, 0xb1,
-	0x46, 0x2c, 0x38, 0xcf, 0x76, 0x01, 0xc2, 0xdc, 0x42, 0x4a, 0xfe, 0x76, 0x53, 0xcd, 0xdf, 0x56,
-	0xae, 0xbe, 0xda, 0x77, 0x06, 0x5d, 0xfb, 0x6f, 0x35, 0xd7, 0x37, 0x77, 0x74, 0xc3, 0x57, 0x92,
-	0xbf, 0xb3, 0xdf, 0xc9, 0xc1, 0x44, 0x24, 0x9f, 0x90, 0xc2, 0x7a, 0x37, 0xca, 0x1a, 0x67, 0xbf,
-	0xe5, 0xa8, 0x4a, 0xf4, 0x7f, 0x72, 0x50, 0x96, 0x99, 0x85, 0x14, 0x69, 0x9a, 0x51, 0x69, 0x06,
-	0xcd, 0x94, 0x32, 0x56, 0xe9, 0x92, 0xd0, 0xb1, 0x89, 0xa4, 0x18, 0x86, 0x3f, 0x36, 0x92, 0x5d,
-	0xba, 0x44, 0x1f, 0xe5, 0x60, 0x5c, 0x4d, 0x34, 0xa4, 0x08, 0xd4, 0x8a, 0x0a, 0xb4, 0x99, 0xcd,
-	0xe1, 0xa8, 0x23, 0xde, 0x95, 0xcc, 0x39, 0x0c, 0xff, 0x5d, 0xc5, 0x6e, 0xc8, 0xaa, 0x92, 0x7c,
-	0x2b, 0x07, 0x10, 0x26, 0x20, 0x52, 0x44, 0x21, 0x51, 0x51, 0x06, 0xdd, 0xa3, 0xe6, 0xbc, 0x7a,
-	0x8f, 0x8a, 0xcc, 0x46, 0x0c, 0x7f, 0x54, 0xd6, 0x37, 0xb7, 0xb6, 0x7a, 0x48, 0xf2, 0x7f, 0x73,
-	0x50, 0x96, 0xb9, 0x89, 0xe1, 0x0f, 0xca, 0x46, 0x6d, 0x4b, 0xe3, 0xab, 0x87, 0xa4, 0x28, 0xdf,
-	0xcc, 0x41, 0x29, 0xc8, 0x55, 0xa4, 0x48, 0x62, 0x44, 0x25, 0x19, 0xf4, 0x4c, 0x9f, 0xb6, 0xa1,
-	0xf5, 0x18, 0x12, 0x26, 0xc7, 0xfe, 0xa9, 0xc9, 0xb1, 0xd9, 0x4b, 0x8e, 0x0f, 0x72, 0x50, 0x51,
-	0xf2, 0x18, 0x29, 0xa2, 0xec, 0x44, 0x45, 0x19, 0x74, 0x7b, 0x46, 0x30, 0xeb, 0x2d, 0x8d, 0x92,
-	0xd0, 0x18, 0xbe, 0x34, 0x82, 0xd9, 0x91, 0xd2, 0x04, 0x99, 0x8d, 0x53, 0x91, 0x86, 0x32, 0xeb,
-	0x6d, 0xce, 0x32, 0xcb, 0x31, 0x7c, 0x73, 0xbe, 0xb1, 0xbc, 0xa2, 0x1d, 0xe1, 0xe4, 0xc2, 0x94,
-	0xc7, 0xf0, 0xed, 0x99, 0xf3, 0x4a, 0x97, 0xe5, 0x7b, 0x39, 0x98, 0x8e, 0xe7, 0x3d, 0x52, 0x24,
-	0xda, 0x8b, 0x4a, 0x34, 0xe8, 0xc5, 0x7f, 0x95, 0x63, 0xba, 0x5c, 0xbf, 0x9d, 0x83, 0x73, 0x29,
-	0x39, 0x8f, 0x14, 0xd1, 0xec, 0xa8, 0x68, 0x77, 0x86, 0x75, 0x67, 0x34, 0xae, 0xd9, 0x4a, 0xd2,
-	0x63, 0xf8, 0x9a, 0x2d, 0x98, 0xf5, 0x0e, 0x27, 0xd4, 0xe4, 0xc7, 0xf0, 0xc3, 0x89, 0xe4, 0xd9,
-	0x8a, 0xb8, 0x7e, 0x87, 0x69, 0x90, 0xe1, 0xeb, 0x37, 0xe7, 0xd5, 0x7b, 0x9e, 0x08, 0x92, 0x22,
-	0xc3, 0x9f, 0x27, 0x36, 0xb4, 0xcd, 0x23, 0xe7, 0x09, 0x99, 0x20, 0x39, 0x8d, 0x79, 0x82, 0x31,
-	0xeb, 0xad, 0x31, 0x6a, 0xa2, 0x64, 0xf8, 0x1a, 0x13, 0x70, 0x4b, 0x97, 0xe7, 0xfb, 0x39, 0xe5,
-	0x76, 0x92, 0x92, 0xfd, 0x48, 0x91, 0xcb, 0x89, 0xca, 0xf5, 0xd6, 0xd0, 0xce, 0x21, 0xab, 0xf2,
-	0x7d, 0x9c, 0x83, 0xc9, 0x68, 0xea, 0x23, 0x45, 0x32, 0x33, 0x2a, 0x99, 0x36, 0x84, 0x9b, 0x4f,
-	0x71, 0xcf, 0x1d, 0xcf, 0x7d, 0x0c, 0xdf, 0x73, 0xab, 0x1c, 0x7b, 0xbf, 0xcb, 0xb4, 0xb4, 0xc7,
-	0xf0, 0xdf, 0x65, 0xef, 0xcb, 0x9c, 0xaa, 0x7c, 0x3f, 0xc8, 0xc1, 0x85, 0xf4, 0x5c, 0x47, 0x8a,
-	0x84, 0xfb, 0x51, 0x09, 0xdf, 0x1e, 0xe2, 0x95, 0xef, 0x78, 0xac, 0x22, 0x93, 0x1d, 0xc3, 0x8f,
-	0x55, 0xb4, 0x95, 0xad, 0xc6, 0x51, 0x31, 0x5c, 0x98, 0xf7, 0x38, 0x85, 0x18, 0x8e, 0x33, 0x4b,
-	0x95, 0x66, 0xde, 0x8f, 0x9c, 0x38, 0xe2, 0xc7, 0x91, 0xd0, 0x7b, 0xf2, 0x00, 0x14, 0x3f, 0x27,
-	0xf4, 0xd9, 0xfe, 0x73, 0x2a, 0x47, 0x9f, 0x73, 0xfa, 0xf3, 0x02, 0x4c, 0xc5, 0xf2, 0x0b, 0xac,
-	0xf4, 0x08, 0xfd, 0xc9, 0xea, 0x74, 0xe5, 0xa2, 0xf7, 0xb0, 0xaf, 0x05, 0x00, 0x1c, 0xe2, 0xa0,
-	0x8f, 0x73, 0x30, 0x75, 0x4f, 0xf7, 0x8d, 0xdd, 0x86, 0xee, 0xef, 0xf2, 0xc3, 0x6a, 0x19, 0xbd,
-	0xbd, 0x37, 0xa3, 0x54, 0xc3, 0xf4, 0x62, 0x0c, 0x80, 0xe3, 0xfc, 0xd1, 0xf3, 0x30, 0xd6, 0x71,
-	0x2c, 0xcb, 0xb4, 0x5b, 0xa2, 0xe0, 0x8a, 0xcc, 0x97, 0x37, 0x78, 0x33, 0x0e, 0xe0, 0xd1, 0x42,
-	0x59, 0x85, 0x4c, 0x8e, 0x81, 0xc4, 0x86, 0xf4, 0x44, 0xa7, 0x33, 0x47, 0x3f, 0x29, 0xa7, 0x33,
-	0xff, 0xb6, 0x00, 0x28, 0x39, 0x07, 0x3e, 0xaa, 0x94, 0xdc, 0x15, 0x28, 0x1a, 0xa1, 0xaa, 0x28,
-	0xe7, 0xa9, 0xc5, 0x1b, 0x15, 0x50, 0x7e, 0xd3, 0xc1, 0x23, 0x46, 0xd7, 0x25, 0xc9, 0xca, 0x41,
-	0xbc, 0x1d, 0x4b, 0x8c, 0x3e, 0x0b, 0x63, 0x7c, 0x94, 0xbc, 0xad, 0xf0, 0x5e, 0xe6, 0xc1, 0x40,
-	0x1f, 0x2f, 0xff, 0x36, 0x2b, 0x14, 0xb4, 0x2b, 0x6e, 0x63, 0x15, 0xfb, 0xbe, 0xd9, 0x5d, 0x93,
-	0x9d, 0xb1, 0x42, 0xe8, 0x6c, 0xca, 0x68, 0x0c, 0xa6, 0x53, 0x3f, 0x2b, 0xc2, 0x4c, 0xc2, 0x5d,
-	0x9e, 0xd1, 0xc5, 0xca, 0x17, 0xa0, 0x44, 0xff, 0x2a, 0x75, 0x2c, 0xe4, 0x3b, 0xbc, 0x21, 0xda,
-	0xb1, 0xc4, 0x50, 0xee, 0x0f, 0xe6, 0x7b, 0xde, 0x1f, 0xbc, 0x13, 0xb9, 0x44, 0x9d, 0x65, 0xad,
-	0xb3, 0x57, 0x61, 0x82, 0x67, 0xeb, 0x83, 0x9b, 0x76, 0xa3, 0xd1, 0x9b, 0x56, 0xd7, 0x55, 0x20,
-	0x8e, 0xe2, 0xf6, 0xb8, 0x57, 0x57, 0x3c, 0xd1, 0xbd, 0xba, 0x0f, 0x93, 0x05, 0x2d, 0xde, 0xcd,
-	0x7a, 0xfa, 0xec, 0xc3, 0xb2, 0xd4, 0x4b, 0xa9, 0xa5, 0x23, 0x2f, 0xa5, 0x2e, 0x42, 0xd9, 0xf3,
-	0xac, 0x37, 0x88, 0x6b, 0xee, 0x1c, 0xb0, 0x0b, 0x91, 0x4a, 0xe1, 0x2d, 0x2d, 0x00, 0xe0, 0x10,
-	0xe7, 0x93, 0x78, 0x9e, 0xfe, 0x6f, 0x72, 0x30, 0xc9, 0xd3, 0x5b, 0xb5, 0x4e, 0x67, 0xc9, 0x25,
-	0x4d, 0x8f, 0xba, 0x9e, 0x8e, 0x6b, 0xde, 0xd5, 0x7d, 0x12, 0x5c, 0x85, 0xeb, 0xcf, 0xf5, 0x34,
-	0x64, 0x67, 0xac, 0x10, 0x42, 0xcf, 0xc2, 0xa8, 0xde, 0xe9, 0xac, 0x2e, 0x33, 0x19, 0xf2, 0xe1,
-	0xb1, 0x81, 0x1a, 0x6d, 0xc4, 0x1c, 0x86, 0x5e, 0x83, 0x49, 0xd3, 0xf6, 0x7c, 0xdd, 0xb2, 0xd8,
-	0x99, 0xfb, 0xd5, 0x65, 0xe6, 0xe8, 0xf3, 0xe1, 0x21, 0x90, 0xd5, 0x08, 0x14, 0xc7, 0xb0, 0xe7,
-	0xff, 0xb2, 0x02, 0x33, 0x89, 0x6c, 0x1d, 0x9a, 0x85, 0x11, 0x93, 0x5f, 0x52, 0xca, 0xd7, 0x41,
-	0x50, 0x1a, 0x59, 0x5d, 0xc6, 0x23, 0x66, 0x53, 0x75, 0x24, 0x23, 0xa7, 0xe7, 0x48, 0x64, 0xad,
-	0x82, 0xfc, 0x71, 0x6b, 0x15, 0x84, 0x77, 0x07, 0xc5, 0xdd, 0xbb, 0x94, 0x0b, 0xdd, 0xe1, 0x7d,
-	0x43, 0xac, 0xe0, 0x1f, 0xab, 0x78, 0xc2, 0x2d, 0x28, 0xe9, 0x1d, 0x93, 0xdf, 0x2b, 0x2e, 0xf6,
-	0x7d, 0xdf, 0xa7, 0xd6, 0x58, 0xe5, 0x97, 0x8a, 0x25, 0x91, 0xe4, 0x8d, 0xe2, 0xb1, 0x6c, 0x6f,
-	0x14, 0xab, 0xc1, 0x40, 0xe9, 0x91, 0xc1, 0xc0, 0x15, 0x28, 0xea, 0x86, 0x6f, 0xde, 0x25, 0xc2,
-	0x8e, 0x65, 0x88, 0x51, 0x63, 0xad, 0x58, 0x40, 0x45, 0xb9, 0x5f, 0x3f, 0x08, 0x79, 0x21, 0x51,
-	0xee, 0x37, 0x00, 0x61, 0x15, 0x8f, 0xf9, 0x5a, 0xa6, 0x34, 0x81, 0xaf, 0xad, 0xc4, 0x7c, 0xad,
-	0x0a, 0xc4, 0x51, 0x5c, 0x54, 0x83, 0x29, 0xde, 0x70, 0xbb, 0x63, 0x39, 0x7a, 0x93, 0x76, 0x1f,
-	0x8f, 0x6a, 0xc5, 0xf5, 0x28, 0x18, 0xc7, 0xf1, 0x7b, 0xb8, 0xeb, 0x89, 0xc1, 0xdd, 0xf5, 0x64,
-	0x36, 0xee, 0x3a, 0x6e, 0x91, 0x7d, 0xb8, 0xeb, 0xf7, 0xe3, 0x95, 0x01, 0xf8, 0x29, 0xcd, 0x41,
-	0x5d, 0x2b, 0x35, 0xaf, 0xa6, 0x7a, 0xf7, 0xff, 0x58, 0x15, 0x01, 0x3e, 0x0b, 0x13, 0x8e, 0xdb,
-	0xd2, 0x6d, 0xf3, 0x01, 0x73, 0x38, 0x1e, 0x3b, 0xad, 0x59, 0xe6, 0xda, 0x7a, 0x4b, 0x05, 0xe0,
-	0x28, 0x1e, 0x7a, 0x00, 0xe5, 0x56, 0xe0, 0x65, 0xab, 0x33, 0x99, 0xf8, 0x99, 0xa8, 0xd7, 0xe6,
-	0xd7, 0x83, 0x64, 0x1b, 0x0e, 0xd9, 0x29, 0xb3, 0x12, 0xfa, 0xa4, 0xcc, 0x4a, 0xef, 0x97, 0x98,
-	0x1b, 0x8f, 0x6e, 0x73, 0x9c, 0x51, 0xcc, 0xf7, 0x39, 0x28, 0x8b, 0x88, 0x40, 0xcc, 0x5d, 0xe5,
-	0xfa, 0xa7, 0x84, 0xaa, 0x9c, 0x4b, 0xd4, 0xd2, 0x58, 0x5d, 0xc6, 0x21, 0xf6, 0x31, 0x03, 0xc0,
-	0x48, 0x4d, 0x87, 0x42, 0x76, 0x35, 0x1d, 0x34, 0x78, 0x92, 0xdf, 0xbf, 0xd5, 0xb4, 0x35, 0x16,
-	0xa0, 0x98, 0x06, 0xbf, 0x7e, 0xcb, 0xab, 0xff, 0x5d, 0x14, 0x0f, 0xf1, 0xe4, 0xb5, 0x34, 0x24,
-	0x9c, 0xde, 0x57, 0x78, 0x3a, 0x4b, 0x97, 0x9e, 0xae, 0x98, 0xf0, 0x74, 0x21, 0x10, 0x47, 0x71,
-	0x7b, 0xb8, 0xa9, 0xd2, 0xe0, 0x6e, 0xaa, 0x9c, 0x95, 0x9b, 0x8a, 0x6a, 0xdc, 0x09, 0xa3, 0x4a,
-	0x38, 0x32, 0xaa, 0xbc, 0x03, 0x15, 0x8f, 0xbd, 0x49, 0xfe, 0xc2, 0x2b, 0x7d, 0xbf, 0x70, 0x2d,
-	0xec, 0x8d, 0x55, 0x52, 0x8a, 0xa1, 0x8f, 0x9f, 0x62, 0xa1, 0x88, 0x79, 0x28, 0xb6, 0x5c, 0xa7,
-	0xdb, 0xe1, 0x77, 0x06, 0x84, 0x92, 0x5f, 0x67, 0x2d, 0x58, 0x40, 0x06, 0x73, 0x06, 0xdf, 0x2f,
-	0xc3, 0x54, 0x6c, 0x9f, 0x31, 0x35, 0xcf, 0x94, 0x3b, 0xe3, 0x3c, 0xd3, 0x65, 0x28, 0xf8, 0x34,
-	0x68, 0x18, 0x89, 0xde, 0x4a, 0x67, 0xd1, 0x02, 0x83, 0x24, 0x8b, 0x5f, 0xe4, 0x8f, 0x5f, 0xfc,
-	0x02, 0xfd, 0x17, 0x28, 0xeb, 0xcd, 0xa6, 0x4b, 0x3c, 0x8f, 0x04, 0xd5, 0x74, 0x98, 0xcf, 0xaf,
-	0x05, 0x8d, 0x38, 0x84, 0xb3, 0x85, 0x6a, 0x73, 0xc7, 0xbb, 0xed, 0x89, 0xec, 0x91, 0xba, 0x50,
-	0x5d, 0x5e, 0xd1, 0x68, 0x3b, 0x96, 0x18, 0xa8, 0x09, 0x53, 0x7b, 0xee, 0xf6, 0xd2, 0x92, 0x6e,
-	0xec, 0x92, 0x93, 0x64, 0x1c, 0x58, 0x95, 0xdc, 0x9b, 0x51, 0x0a, 0x38, 0x4e, 0x52, 0x70, 0xb9,
-	0x49, 0x0e, 0x7c, 0x7d, 0xfb, 0x24, 0x31, 0x61, 0xc0, 0x45, 0xa5, 0x80, 0xe3, 0x24, 0x69, 0x04,
-	0xb7, 0xe7, 0x6e, 0x07, 0x37, 0xda, 0x45, 0x55, 0x2e, 0x19, 0xc1, 0xdd, 0x0c, 0x41, 0x58, 0xc5,
-	0xa3, 0x03, 0xb6, 0xe7, 0x6e, 0x63, 0xa2, 0x5b, 0x6d, 0x51, 0x58, 0x50, 0x0e, 0xd8, 0x4d, 0xd1,
-	0x8e, 0x25, 0x06, 0xea, 0x00, 0xa2, 0x4f, 0xc7, 0xde, 0xbb, 0xbc, 0x92, 0x2b, 0x16, 0x7d, 0xcf,
-	0xa5, 0x3d, 0x8d, 0x44, 0x52, 0x1f, 0xe8, 0x02, 0x75, 0x77, 0x37, 0x13, 0x74, 0x70, 0x0a, 0x6d,
-	0xf4, 0x16, 0x3c, 0xb5, 0xe7, 0x6e, 0x8b, 0xb4, 0x7f, 0xc3, 0x35, 0x6d, 0xc3, 0xec, 0xe8, 0xbc,
-	0x46, 0x40, 0x25, 0x5a, 0x07, 0xf1, 0x66, 0x3a, 0x1a, 0xee, 0xd5, 0x3f, 0x9a, 0xf4, 0x1c, 0xcf,
-	0x24, 0xe9, 0x19, 0x33, 0xd7, 0xc7, 0xbd, 0xd8, 0xcd, 0x60, 0xfe, 0xe9, 0x47, 0x39, 0x40, 0xec,
-	0x84, 0x55, 0xf0, 0x35, 0x10, 0xe6, 0xfc, 0xd0, 0x22, 0x94, 0x99, 0xf7, 0x53, 0x2e, 0xbd, 0xca,
-	0xec, 0xc1, 0xf5, 0x00, 0x80, 0x43, 0x1c, 0xba, 0x46, 0x71, 0xac, 0x26, 0x91, 0x95, 0x2a, 0xe4,
-	0x1a, 0xe5, 0x16, 0x6b, 0xc5, 0x02, 0x8a, 0xae, 0xc3, 0x8c, 0x4b, 0xb6, 0x75, 0x4b, 0xb7, 0x0d,
-	0xa2, 0xf9, 0xae, 0xee, 0x93, 0xd6, 0x81, 0xf0, 0x24, 0xf2, 0x18, 0x2b, 0x8e, 0x23, 0xe0, 0x64,
-	0x9f, 0xf9, 0xbf, 0x2f, 0xc1, 0x74, 0xfc, 0x68, 0xd8, 0xa3, 0x72, 0xb5, 0x8b, 0x50, 0xee, 0xe8,
-	0xae, 0x6f, 0x2a, 0x75, 0x3c, 0xe4, 0x53, 0x35, 0x02, 0x00, 0x0e, 0x71, 0xe8, 0xb2, 0x9f, 0x95,
-	0x69, 0x15, 0x12, 0xca, 0x65, 0x3f, 0x2b, 0xe3, 0x8a, 0x39, 0x2c, 0xbd, 0x38, 0x44, 0xe1, 0xd4,
-	0x8a, 0x43, 0x3c, 0x16, 0x75, 0x5f, 0x3f, 0x48, 0xa6, 0xc9, 0xde, 0xc9, 0xf8, 0xdc, 0x5f, 0x7f,
-	0xcb, 0xae, 0x09, 0x43, 0xd5, 0x67, 0x51, 0x0c, 0x63, 0x33, 0x0b, 0x91, 0x22, 0x86, 0xc2, 0x57,
-	0x4f, 0x91, 0x26, 0x1c, 0x65, 0x8d, 0x1a, 0x70, 0xde, 0x32, 0xdb, 0x22, 0xe1, 0xe7, 0x35, 0x88,
-	0xcb, 0xab, 0x23, 0x33, 0x47, 0x9d, 0x0f, 0x13, 0x21, 0x6b, 0x29, 0x38, 0x38, 0xb5, 0x27, 0x7a,
-	0x1e, 0xc6, 0xee, 0x12, 0x97, 0x5d, 0xde, 0x87, 0x68, 0xc5, 0xf6, 0x37, 0x78, 0x33, 0x0e, 0xe0,
-	0xe8, 0x2d, 0x28, 0x78, 0xba, 0x67, 0x89, 0x40, 0xed, 0x04, 0x47, 0x99, 0x6b, 0xda, 0x9a, 0x50,
-	0x0f, 0x96, 0xa2, 0xa5, 0xbf, 0x31, 0x23, 0x79, 0x46, 0x01, 0x5b, 0xb8, 0xdd, 0x32, 0x71, 0xd4,
-	0x76, 0xcb, 0x60, 0x4e, 0xf1, 0x07, 0x45, 0x98, 0x8a, 0x9d, 0xf5, 0x7c, 0x94, 0x6b, 0x91, 0x9e,
-	0x62, 0xe4, 0x08, 0x4f, 0xf1, 0x02, 0x94, 0x0c, 0xcb, 0x24, 0xb6, 0xbf, 0xda, 0x14, 0x1e, 0x25,
-	0xbc, 0x52, 0xce, 0xdb, 0x97, 0xb1, 0xc4, 0x38, 0x6b, 0xbf, 0xa2, 0x3a, 0x80, 0xd1, 0xe3, 0x16,
-	0x9d, 0x29, 0x0e, 0xf3, 0xe3, 0x3f, 0xd9, 0x5c, 0x6d, 0x8f, 0xbd, 0xd8, 0xc7, 0xbe, 0x88, 0x74,
-	0xb0, 0xc9, 0x52, 0xce, 0x7a, 0x93, 0x65, 0x30, 0x1b, 0xf9, 0xeb, 0x11, 0x28, 0x6d, 0xd4, 0xb6,
-	0x34, 0x56, 0x5c, 0xf9, 0xed, 0x68, 0xf9, 0xe8, 0x41, 0x84, 0x4c, 0xd6, 0x89, 0x5e, 0xa1, 0xa6,
-	0xd5, 0x77, 0x89, 0xe8, 0x32, 0xb7, 0x3e, 0xba, 0xce, 0xe4, 0xdd, 0xd1, 0x12, 0x14, 0xec, 0xbd,
-	0x7e, 0xbf, 0xa1, 0xc1, 0xc6, 0x6c, 0xe3, 0x26, 0x39, 0xc0, 0xac, 0x33, 0xba, 0x0d, 0x60, 0xb8,
-	0xa4, 0x49, 0x6c, 0xdf, 0x14, 0x9f, 0x30, 0xeb, 0x6f, 0x7f, 0x61, 0x49, 0x76, 0xc6, 0x0a, 0xa1,
-	0xf9, 0x3f, 0x2c, 0xc2, 0x74, 0xfc, 0x4c, 0xf7, 0xa3, 0x5c, 0xce, 0xf3, 0x30, 0xe6, 0x75, 0x59,
-	0x81, 0x1b, 0xe1, 0x74, 0xe4, 0x34, 0xa0, 0xf1, 0x66, 0x1c, 0xc0, 0xd3, 0x5d, 0x49, 0xfe, 0x4c,
-	0x5c, 0x49, 0xe1, 0xb8, 0xae, 0x24, 0xeb, 0x80, 0xe6, 0x83, 0xe4, 0xe7, 0x21, 0xde, 0xc9, 0xf8,
-	0x14, 0x7e, 0x1f, 0xbe, 0x84, 0x08, 0xab, 0x1e, 0xcb, 0xa4, 0x34, 0x4c, 0x60, 0x88, 0x89, 0x7d,
-	0xd4, 0xb3, 0x71, 0x59, 0x73, 0x30, 0xca, 0x3e, 0x87, 0x20, 0x16, 0xa3, 0xcc, 0x14, 0xd9, 0x91,
-	0x2a, 0xcc, 0xdb, 0x07, 0xac, 0x5e, 0x3f, 0x0a, 0x93, 0xd1, 0x53, 0x9c, 0x74, 0xdd, 0xbc, 0xeb,
-	0x78, 0xbe, 0xc8, 0x26, 0xc4, 0x3f, 0x74, 0x78, 0x23, 0x04, 0x61, 0x15, 0xef, 0x78, 0x93, 0xf6,
-	0xf3, 0x30, 0x26, 0x8a, 0xd5, 0x89, 0x39, 0x5b, 0x9a, 0x99, 0x28, 0x68, 0x87, 0x03, 0xf8, 0x7f,
-	0xcc, 0xd8, 0x96, 0x87, 0xbe, 0x95, 0x9c, 0xb1, 0xdf, 0xce, 0xf4, 0xc8, 0xee, 0xe3, 0x3e, 0x61,
-	0x0f, 0xa6, 0xdc, 0x6f, 0xc1, 0x4c, 0x62, 0x77, 0xe7, 0x78, 0xc5, 0xc0, 0xe7, 0x60, 0xd4, 0xd6,
-	0xdb, 0x84, 0xd7, 0xcb, 0x12, 0x46, 0xc7, 0xbe, 0x17, 0x81, 0x79, 0xfb, 0xfc, 0x0f, 0x8b, 0x30,
-	0x93, 0xb8, 0x9a, 0xc2, 0xd6, 0xc4, 0x72, 0x87, 0x20, 0xb6, 0xd2, 0x4f, 0xdd, 0x17, 0x78, 0x0d,
-	0x26, 0x99, 0x61, 0x34, 0x62, 0xfb, 0x0a, 0x72, 0x97, 0x7b, 0x2b, 0x02, 0xc5, 0x31, 0xec, 0xe3,
-	0xad, 0xa9, 0x5f, 0x83, 0x49, 0xf5, 0x03, 0x27, 0xab, 0xcb, 0x62, 0xdf, 0x58, 0x32, 0xd1, 0x22,
-	0x50, 0x1c, 0xc3, 0x66, 0x5f, 0x87, 0x91, 0xb3, 0xab, 0xc8, 0xd7, 0x8d, 0xf6, 0xff, 0x75, 0x98,
-	0x18, 0x09, 0x9c, 0x20, 0x8a, 0xb6, 0x61, 0x96, 0xe7, 0xf7, 0x55, 0x81, 0x62, 0x67, 0x4e, 0xe6,
-	0x85, 0xd0, 0xb3, 0xcb, 0x3d, 0x31, 0xf1, 0x11, 0x54, 0xfa, 0x2c, 0xff, 0xf8, 0x61, 0xf2, 0x7b,
-	0x99, 0xef, 0x66, 0x7d, 0xa1, 0xe9, 0x44, 0x36, 0x58, 0xfe, 0xa4, 0xd8, 0xe0, 0x0f, 0x2b, 0xd4,
-	0x50, 0x62, 0x67, 0xf3, 0xd1, 0x3c, 0x14, 0x99, 0x6e, 0xd2, 0xe9, 0x45, 0x6e, 0x15, 0x30, 0xa5,
-	0xf5, 0xb0, 0x80, 0x1c, 0x23, 0x8b, 0x2e, 0x62, 0xba, 0x7c, 0x8f, 0x98, 0xae, 0x03, 0xe7, 0x7c,
-	0xcb, 0xdb, 0x72, 0xbb, 0x9e, 0xbf, 0x44, 0x5c, 0xdf, 0x13, 0xaa, 0x5b, 0xe8, 0xfb, 0x23, 0x73,
-	0x5b, 0x6b, 0x5a, 0x9c, 0x0a, 0x4e, 0x23, 0x4d, 0x15, 0xd8, 0xb7, 0xbc, 0x9a, 0x65, 0x39, 0xf7,
-	0x82, 0xa3, 0x07, 0xe1, 0x64, 0x23, 0xa6, 0x11, 0xa9, 0xc0, 0x5b, 0x6b, 0x5a, 0x0f, 0x4c, 0x7c,
-	0x04, 0x15, 0xb4, 0xce, 0x9e, 0xea, 0x0d, 0xdd, 0x32, 0x9b, 0xba, 0x4f, 0xe8, 0x74, 0xcc, 0xd2,
-	0xdb, 0xdc, 0x3a, 0xe4, 0x7e, 0xe4, 0xd6, 0x9a, 0x16, 0x47, 0xc1, 0x69, 0xfd, 0x86, 0xf5, 0xa1,
-	0xd9, 0xd4, 0xd9, 0xbb, 0x74, 0x26, 0xb3, 0x77, 0xb9, 0x3f, 0x2b, 0x87, 0x8c, 0xac, 0x3c, 0xa6,
-	0xf2, 0x7d, 0x58, 0x79, 0x13, 0xa6, 0xe4, 0x17, 0x78, 0x84, 0xce, 0x56, 0xfa, 0xde, 0x1e, 0xa9,
-	0x45, 0x29, 0xe0, 0x38, 0xc9, 0x33, 0x4a, 0x39, 0xfd, 0x71, 0x0e, 0xa6, 0xa9, 0x24, 0x35, 0x7f,
-	0x97, 0xd8, 0x0f, 0x1a, 0xba, 0xab, 0xb7, 0x83, 0x12, 0x63, 0x3b, 0x99, 0x0f, 0x79, 0x2d, 0xc6,
-	0x88, 0x0f, 0xbd, 0xac, 0xfb, 0x1c, 0x07, 0xe3, 0x84, 0x64, 0x74, 0xea, 0x0b, 0xdb, 0x4e, 0xf2,
-	0xb5, 0xd8, 0xf3, 0x51, 0x46, 0xc1, 0xd4, 0x17, 0x27, 0x3a, 0x90, 0x8f, 0x9d, 0x5d, 0x82, 0x27,
-	0x53, 0x1f, 0xb5, 0x2f, 0x47, 0xfd, 0xcd, 0xa2, 0xb8, 0x5f, 0x93, 0xc1, 0x5a, 0x20, 0xeb, 0xcf,
-	0x39, 0xd1, 0xc0, 0xca, 0x96, 0x9f, 0xfb, 0x8a, 0x7d, 0x06, 0x2e, 0xfc, 0xc0, 0x57, 0x88, 0x83,
-	0x66, 0x61, 0xa4, 0xb9, 0xcd, 0x5c, 0xfd, 0x68, 0x78, 0xd0, 0x6f, 0xb9, 0x8e, 0x47, 0x9a, 0xdb,
-	0xe8, 0x39, 0x28, 0x89, 0x45, 0x46, 0x70, 0x0e, 0x8e, 0xb1, 0x15, 0x2b, 0x10, 0x0f, 0x4b, 0xe8,
-	0xb0, 0xc2, 0xfa, 0x21, 0x24, 0xf8, 0xe3, 0x6f, 0xee, 0xb1, 0xcf, 0xc4, 0xf5, 0xe7, 0xa1, 0x5f,
-	0x50, 0xaa, 0x9a, 0x43, 0x34, 0xd9, 0x9b, 0x2c, 0x59, 0x3e, 0x58, 0xc0, 0xf2, 0x67, 0x45, 0xb8,
-	0x90, 0x7e, 0xeb, 0xeb, 0xb1, 0xb1, 0x06, 0xae, 0xdc, 0xf9, 0x54, 0xe5, 0xfe, 0x34, 0x8c, 0x79,
-	0x4c, 0xf0, 0xe0, 0x68, 0x00, 0xaf, 0x37, 0xcb, 0x9b, 0x70, 0x00, 0x43, 0xaf, 0x03, 0x6a, 0xeb,
-	0xf7, 0xd7, 0xbd, 0xd6, 0x92, 0xd3, 0x65, 0x25, 0xb4, 0x31, 0xd1, 0x79, 0x7d, 0xf7, 0xd1, 0xf0,
-	0x00, 0xce, 0x7a, 0x02, 0x03, 0xa7, 0xf4, 0x62, 0x87, 0x19, 0x22, 0x1b, 0x44, 0xb1, 0x93, 0x40,
-	0x47, 0xee, 0xe8, 0x0c, 0x29, 0xfe, 0xf8, 0x38, 0x19, 0xb8, 0x1b, 0x43, 0xb9, 0x0a, 0xf8, 0xb8,
-	0x47, 0xef, 0xa7, 0x69, 0x3a, 0x3f, 0x2b, 0xc0, 0xb9, 0x94, 0x52, 0x30, 0x51, 0xef, 0x9d, 0x3b,
-	0x86, 0xf7, 0xde, 0x97, 0x23, 0x95, 0xcd, 0x49, 0xec, 0x40, 0xa8, 0x23, 0x86, 0xe9, 0xc3, 0x1c,
-	0x9c, 0x67, 0x3b, 0xf0, 0xc1, 0xb6, 0x5f, 0x50, 0x03, 0x38, 0x2f, 0x34, 0xf3, 0x58, 0xc5, 0xb8,
-	0xaf, 0xa7, 0x50, 0x08, 0xb7, 0x25, 0xd3, 0xa0, 0x38, 0x95, 0x2b, 0x5a, 0x02, 0x90, 0x77, 0xe9,
-	0x02, 0x4b, 0x7e, 0x96, 0x95, 0x14, 0x97, 0xad, 0xff, 0xc6, 0x76, 0xf7, 0x95, 0xd1, 0x66, 0x2b,
-	0x23, 0xa5, 0xdb, 0x30, 0x3e, 0xbc, 0x92, 0xf2, 0x7a, 0x8f, 0x6f, 0x01, 0x83, 0x69, 0xd7, 0x1f,
-	0xe5, 0x61, 0x32, 0xfa, 0x22, 0xd1, 0x15, 0x28, 0x76, 0x5c, 0xb2, 0x63, 0xde, 0x8f, 0x7f, 0x7f,
-	0xa3, 0xc1, 0x5a, 0xb1, 0x80, 0x22, 0x07, 0x8a, 0x96, 0xbe, 0x4d, 0xe7, 0x7b, 0x5e, 0xff, 0xfc,
-	0xfa, 0xc0, 0xb5, 0xbc, 0x83, 0x6d, 0x88, 0x80, 0xe1, 0x1a, 0x23, 0x8f, 0x05, 0x1b, 0xca, 0x70,
-	0xc7, 0x24, 0x56, 0x93, 0x9f, 0xf7, 0x1c, 0x06, 0xc3, 0x15, 0x46, 0x1e, 0x0b, 0x36, 0xe8, 0x6d,
-	0x28, 0xf3, 0x8f, 0x96, 0x34, 0xeb, 0x07, 0x62, 0x85, 0xfb, 0x9f, 0x8f, 0xa7, 0xb2, 0x5b, 0x66,
-	0x9b, 0x84, 0xe6, 0xb8, 0x14, 0x10, 0xc1, 0x21, 0x3d, 0xf6, 0xad, 0xfa, 0x1d, 0x9f, 0xb8, 0x9a,
-	0xaf, 0xbb, 0xc1, 0xa7, 0xe4, 0xc3, 0x6f, 0xd5, 0x4b, 0x08, 0x56, 0xb0, 0xe6, 0xff, 0x74, 0x0c,
-	0xa6, 0x62, 0xf7, 0x6c, 0x7f, 0x35, 0x2e, 0x91, 0xaa, 0x1f, 0x58, 0xc9, 0x67, 0xfd, 0x81, 0x95,
-	0x42, 0x16, 0xe1, 0xc1, 0xdb, 0x30, 0xee, 0x79, 0xbb, 0x0c, 0xb3, 0xff, 0x5c, 0xdd, 0xf4, 0xe1,
-	0xc3, 0xb9, 0x71, 0x4d, 0xbb, 0x21, 0xbb, 0xe3, 0x08, 0x31, 0xb4, 0x06, 0x63, 0xe2, 0x70, 0x61,
-	0x7f, 0x27, 0x03, 0x59, 0x18, 0x12, 0x84, 0x47, 0x01, 0x89, 0x61, 0x6c, 0x49, 0xc7, 0x94, 0xee,
-	0xb1, 0x0f, 0x84, 0x1b, 0x70, 0xbe, 0xe3, 0x58, 0x56, 0x70, 0xba, 0x53, 0x7e, 0x1a, 0xa9, 0x1c,
-	0xbd, 0xdb, 0xd3, 0x48, 0xc1, 0xc1, 0xa9, 0x3d, 0x07, 0xf3, 0xb2, 0xff, 0x54, 0x84, 0xc9, 0x68,
-	0x19, 0xaa, 0xb3, 0xbb, 0x61, 0xc9, 0x12, 0x81, 0x35, 0xd7, 0x8e, 0xdf, 0xb0, 0xdc, 0x12, 0xed,
-	0x58, 0x62, 0x20, 0x0c, 0x65, 0x7e, 0xe2, 0xfd, 0x66, 0xbf, 0x9b, 0xd2, 0xfc, 0xe8, 0x6c, 0xd0,
-	0x17, 0x87, 0x64, 0x28, 0x4d, 0x2f, 0x40, 0xef, 0xcf, 0x32, 0x19, 0x4d, 0xd9, 0x8c, 0x43, 0x32,
-	0x74, 0xc6, 0x72, 0x49, 0x2b, 0xc8, 0x06, 0x2a, 0x33, 0x16, 0x66, 0xad, 0x58, 0x40, 0xd1, 0xf3,
-	0x30, 0xe6, 0x3a, 0x16, 0xa9, 0xe1, 0x0d, 0x11, 0x4d, 0xcb, 0x8d, 0x32, 0xcc, 0x9b, 0x71, 0x00,
-	0x1f, 0xc6, 0x26, 0x51, 0x54, 0x01, 0xfa, 0x30, 0xa1, 0xeb, 0x30, 0x73, 0x57, 0x64, 0x18, 0x35,
-	0xb3, 0x65, 0xeb, 0x7e, 0x78, 0x29, 0x4b, 0x9e, 0x48, 0x7c, 0x23, 0x8e, 0x80, 0x93, 0x7d, 0xce,
-	0x2e, 0x56, 0x26, 0x76, 0xb3, 0xe3, 0x98, 0xb6, 0x1f, 0x8f, 0x95, 0xaf, 0x89, 0x76, 0x2c, 0x31,
-	0x06, 0xb3, 0xb3, 0xbf, 0x1a, 0x83, 0xc9, 0x68, 0x99, 0xb5, 0xa8, 0x0e, 0xe7, 0x86, 0xa0, 0xc3,
-	0x23, 0x59, 0xeb, 0x70, 0xfe, 0x48, 0x1d, 0x7e, 0x36, 0xd8, 0xb9, 0x2e, 0x44, 0x37, 0xa7, 0xd4,
-	0xdd, 0x6b, 0x54, 0xa3, 0x33, 0xbc, 0xe9, 0xd3, 0x28, 0x84, 0x9f, 0xc8, 0xe3, 0x87, 0x15, 0xf2,
-	0xea, 0x8c, 0x1c, 0x01, 0xe3, 0x38, 0x7e, 0x3f, 0xb6, 0xd2, 0xdf, 0xee, 0xcf, 0x6b, 0x30, 0xc9,
-	0x84, 0xac, 0x19, 0x06, 0x5d, 0xef, 0xae, 0x36, 0xc5, 0x21, 0x72, 0xb9, 0x71, 0xb6, 0xa9, 0x42,
-	0x97, 0x71, 0x0c, 0x3b, 0x6a, 0x99, 0xe5, 0x6c, 0x2c, 0x73, 0xf3, 0x84, 0x96, 0x79, 0x11, 0xf2,
-	0x4d, 0x6b, 0x9f, 0x69, 0x75, 0x29, 0xdc, 0x2b, 0x59, 0x5e, 0xdb, 0xc4, 0xb4, 0x5d, 0xb1, 0xb7,
-	0xca, 0x19, 0xd9, 0xdb, 0xf8, 0xa3, 0xec, 0x8d, 0xc5, 0x35, 0xfc, 0x0b, 0x4a, 0xfc, 0xc2, 0xcc,
-	0x44, 0xff, 0x71, 0x8d, 0xd2, 0x1d, 0x47, 0x88, 0x0d, 0x66, 0xcc, 0x5f, 0x83, 0x52, 0xc0, 0x88,
-	0x0e, 0xb4, 0xec, 0x17, 0x0e, 0x34, 0x35, 0x21, 0x46, 0x64, 0x11, 0xca, 0x4e, 0x87, 0x44, 0x3e,
-	0x7f, 0x28, 0x63, 0xe0, 0x5b, 0x01, 0x00, 0x87, 0x38, 0xd4, 0x8a, 0x38, 0xd7, 0xd8, 0x16, 0xef,
-	0x1b, 0xb4, 0x51, 0x08, 0x31, 0xff, 0xf5, 0x1c, 0x04, 0xdf, 0x14, 0x42, 0xcb, 0x30, 0xda, 0x71,
-	0x5c, 0x9f, 0x6f, 0xad, 0x55, 0xae, 0xce, 0xa5, 0x8f, 0x0f, 0x3f, 0xfe, 0xef, 0xb8, 0x7e, 0x48,
-	0x91, 0xfe, 0xf2, 0x30, 0xef, 0x4c, 0xe5, 0x34, 0xac, 0xae, 0xe7, 0x13, 0x77, 0xb5, 0x11, 0x97,
-	0x73, 0x29, 0x00, 0xe0, 0x10, 0x67, 0xfe, 0x5f, 0x0a, 0x30, 0x1d, 0xaf, 0xbc, 0x87, 0xde, 0x85,
-	0x09, 0xcf, 0x6c, 0xd9, 0xa6, 0xdd, 0x12, 0xb1, 0x68, 0xae, 0xef, 0xbb, 0xbf, 0x9a, 0xda, 0x1f,
-	0x47, 0xc9, 0x65, 0x76, 0x9c, 0xed, 0x6c, 0xbe, 0x9b, 0xff, 0x41, 0xb2, 0xc8, 0xcc, 0x3b, 0x19,
-	0xd7, 0x3e, 0xfc, 0xd5, 0xae, 0x32, 0xf3, 0xcb, 0x51, 0xb8, 0x90, 0x5e, 0x5b, 0xf1, 0x8c, 0x82,
-	0xd6, 0xf0, 0x9e, 0xe7, 0x48, 0xcf, 0x7b, 0x9e, 0xe1, 0x38, 0xe7, 0x33, 0xaa, 0x95, 0x28, 0x07,
-	0xe0, 0x68, 0x57, 0x2b, 0xc3, 0xe9, 0xc2, 0x23, 0xc3, 0xe9, 0x2b, 0x50, 0x14, 0x75, 0xf5, 0x63,
-	0x61, 0x6a, 0x9d, 0x57, 0xbd, 0x17, 0x50, 0x25, 0x14, 0x28, 0x1e, 0x19, 0x0a, 0xd0, 0xd0, 0x26,
-	0xd8, 0x7f, 0xec, 0xef, 0xae, 0x17, 0x0f, 0x6d, 0x82, 0xbe, 0x38, 0x24, 0xc3, 0x6e, 0xf2, 0x77,
-	0xcc, 0xdb, 0x78, 0x4d, 0xcc, 0xca, 0xe1, 0x4d, 0xfe, 0xc6, 0xea, 0x6d, 0xbc, 0x86, 0x05, 0x34,
-	0x9a, 0x0a, 0x2e, 0x67, 0x92, 0x0a, 0x4e, 0xd7, 0xb9, 0xd3, 0x4a, 0x84, 0x19, 0x30, 0x93, 0x78,
-	0xe7, 0xc7, 0x4e, 0x85, 0x5d, 0x81, 0xa2, 0xd7, 0xdd, 0xa1, 0x78, 0xb1, 0x12, 0x4b, 0x1a, 0x6b,
-	0xc5, 0x02, 0x3a, 0xff, 0xdd, 0x02, 0xe5, 0x12, 0xab, 0xc2, 0x79, 0x46, 0x56, 0xf5, 0x2a, 0x4c,
-	0xf0, 0x64, 0xd4, 0x9b, 0x4a, 0x7d, 0x8e, 0x92, 0xb2, 0xc1, 0xa0, 0x02, 0x71, 0x14, 0x17, 0xad,
-	0x32, 0x35, 0xe9, 0x7b, 0x59, 0x08, 0x42, 0x93, 0xe8, 0xc4, 0x2d, 0x08, 0xa0, 0x97, 0xa0, 0xc2,
-	0x1e, 0x82, 0x0f, 0xb9, 0xc8, 0xca, 0xb2, 0x9b, 0xb8, 0xd7, 0xc2, 0x66, 0xac, 0xe2, 0x44, 0x8f,
-	0x16, 0x8c, 0x66, 0x72, 0xb4, 0x20, 0xf1, 0x56, 0x4e, 0x4b, 0xef, 0xbe, 0x5d, 0x02, 0xf9, 0xa5,
-	0x44, 0x64, 0x24, 0xbe, 0x57, 0xf9, 0xb9, 0xbe, 0x37, 0x6f, 0x02, 0x51, 0x78, 0x26, 0x2b, 0x65,
-	0x4a, 0x7a, 0x1d, 0x90, 0xf8, 0x40, 0xa2, 0x08, 0xaa, 0x95, 0x7a, 0x4b, 0x72, 0x97, 0x4a, 0x4b,
-	0x60, 0xe0, 0x94, 0x5e, 0xe8, 0x75, 0xf6, 0x75, 0x56, 0x5f, 0x37, 0x6d, 0xe9, 0x79, 0x2f, 0xf6,
-	0xb8, 0xa0, 0xc9, 0x91, 0xe4, 0x77, 0x56, 0xf9, 0x4f, 0x1c, 0x76, 0x47, 0xd7, 0x60, 0xec, 0xae,
-	0x63, 0x75, 0xdb, 0x22, 0x35, 0x5f, 0xb9, 0x3a, 0x9b, 0x46, 0xe9, 0x0d, 0x86, 0xa2, 0x5c, 0x28,
-	0xe2, 0x5d, 0x70, 0xd0, 0x17, 0x11, 0x98, 0x62, 0xc7, 0x7b, 0x4c, 0xff, 0x40, 0x18, 0x80, 0x98,
-	0x7a, 0xaf, 0xa4, 0x91, 0x6b, 0x38, 0x4d, 0x2d, 0x8a, 0xcd, 0x4f, 0x7a, 0xc4, 0x1a, 0x71, 0x9c,
-	0x26, 0x5a, 0x81, 0x92, 0xbe, 0xb3, 0x63, 0xda, 0xa6, 0x7f, 0x20, 0x72, 0x76, 0xcf, 0xa4, 0xd1,
-	0xaf, 0x09, 0x1c, 0x51, 0xc8, 0x45, 0xfc, 0xc2, 0xb2, 0x2f, 0xba, 0x0d, 0x15, 0xdf, 0xb1, 0x44,
-	0x5c, 0xea, 0x89, 0x54, 0xc3, 0xa5, 0x34, 0x52, 0x5b, 0x12, 0x2d, 0xdc, 0x1e, 0x0d, 0xdb, 0x3c,
-	0xac, 0xd2, 0x41, 0xff, 0x3f, 0x07, 0xe3, 0xb6, 0xd3, 0x24, 0x81, 0xe9, 0x89, 0xed, 0xba, 0xb7,
-	0x32, 0xfa, 0xc2, 0xe7, 0xc2, 0x86, 0x42, 0x9b, 0x5b, 0x88, 0x2c, 0xf0, 0xa1, 0x82, 0x70, 0x44,
-	0x08, 0x64, 0xc3, 0xb4, 0xd9, 0xd6, 0x5b, 0xa4, 0xd1, 0xb5, 0xc4, 0xf1, 0x44, 0x4f, 0x4c, 0x1e,
-	0xa9, 0xd7, 0x7a, 0xd7, 0x1c, 0x43, 0xb7, 0xf8, 0x17, 0x72, 0x31, 0xd9, 0x21, 0x2e, 0xfb, 0x50,
-	0xaf, 0x3c, 0x69, 0xb2, 0x1a, 0xa3, 0x84, 0x13, 0xb4, 0xd1, 0x75, 0x98, 0xe9, 0xb8, 0xa6, 0xc3,
-	0xde, 0x9b, 0xa5, 0x7b, 0xfc, 0x0b, 0xa9, 0x10, 0xbd, 0xcb, 0xd9, 0x88, 0x23, 0xe0, 0x64, 0x1f,
-	0x5e, 0x7f, 0x80, 0x37, 0xb2, 0xb5, 0xdc, 0x68, 0x50, 0x7f, 0x80, 0xb7, 0x61, 0x09, 0x9d, 0xfd,
-	0x02, 0xcc, 0x24, 0xc6, 0xa6, 0x2f, 0x87, 0xf0, 0x9b, 0x39, 0x88, 0xe7, 0xcb, 0xe9, 0xba, 0xa1,
-	0x69, 0xba, 0x8c, 0xe0, 0x41, 0x3c, 0xc7, 0xbf, 0x1c, 0x00, 0x70, 0x88, 0x83, 0x2e, 0x43, 0xa1,
-	0xa3, 0xfb, 0xbb, 0xf1, 0x63, 0x7e, 0x94, 0x24, 0x66, 0x10, 0x74, 0x15, 0x80, 0xfe, 0xc5, 0xa4,
-	0x45, 0xee, 0x77, 0xc4, 0x32, 0x48, 0x6e, 0x3f, 0x34, 0x24, 0x04, 0x2b, 0x58, 0xf3, 0x7f, 0x37,
-	0x0a, 0x93, 0xd1, 0xb9, 0x25, 0xb2, 0xd8, 0xcc, 0x3d, 0x72, 0xb1, 0x79, 0x05, 0x8a, 0x6d, 0xe2,
-	0xef, 0x3a, 0xcd, 0xf8, 0x3c, 0xb9, 0xce, 0x5a, 0xb1, 0x80, 0x32, 0xf1, 0x1d, 0xd7, 0x17, 0x62,
-	0x85, 0xe2, 0x3b, 0xae, 0x8f, 0x19, 0x24, 0x38, 0xa5, 0x58, 0xe8, 0x71, 0x4a, 0xb1, 0x05, 0xd3,
-	0xbc, 0x02, 0xf0, 0x12, 0x71, 0xfd, 0x13, 0x9f, 0xae, 0xd5, 0x62, 0x24, 0x70, 0x82, 0x28, 0x6a,
-	0x52, 0x6f, 0x43, 0xdb, 0xc2, 0x9d, 0x81, 0xfe, 0xef, 0xf6, 0x6b, 0x51, 0x0a, 0x38, 0x4e, 0x72,
-	0x18, 0xd9, 0xc8, 0xe8, 0x7b, 0x3c, 0x71, 0xe9, 0xc4, 0x52, 0x56, 0xa5, 0x13, 0x5f, 0x81, 0xc9,
-	0xb6, 0x7e, 0xbf, 0xa1, 0x1f, 0x58, 0x8e, 0xde, 0xd4, 0xcc, 0x07, 0x44, 0x5c, 0x3f, 0x45, 0x87,
-	0x0f, 0xe7, 0x26, 0xd7, 0x23, 0x10, 0x1c, 0xc3, 0x1c, 0x6c, 0x02, 0xfe, 0xad, 0x11, 0x40, 0xc9,
-	0x2f, 0x9b, 0xa0, 0x8f, 0x72, 0x30, 0x79, 0x2f, 0x32, 0x46, 0xc3, 0x09, 0xce, 0x64, 0xda, 0x2b,
-	0xda, 0x8e, 0x63, 0xcc, 0x95, 0x05, 0xce, 0xc8, 0xe9, 0x2d, 0x24, 0xeb, 0xc6, 0x8f, 0x7f, 0x71,
-	0xe9, 0x89, 0x9f, 0xfc, 0xe2, 0xd2, 0x13, 0x3f, 0xfd, 0xc5, 0xa5, 0x27, 0xbe, 0x7e, 0x78, 0x29,
-	0xf7, 0xe3, 0xc3, 0x4b, 0xb9, 0x9f, 0x1c, 0x5e, 0xca, 0xfd, 0xf4, 0xf0, 0x52, 0xee, 0xe7, 0x87,
-	0x97, 0x72, 0xdf, 0xfd, 0xc7, 0x4b, 0x4f, 0x7c, 0xf1, 0xf3, 0xa1, 0x28, 0x8b, 0x81, 0x28, 0xec,
-	0x9f, 0x17, 0x39, 0xeb, 0xc5, 0xce, 0x5e, 0x6b, 0x91, 0x8a, 0xb2, 0xa8, 0x88, 0xb2, 0x18, 0x88,
-	0xf2, 0xef, 0x01, 0x00, 0x00, 0xff, 0xff, 0x62, 0x2f, 0xb6, 0x86, 0xdc, 0xa5, 0x00, 0x00,
+	// 9955 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7d, 0x5b, 0x70, 0x24, 0xc7,
+	0x91, 0x98, 0x06, 0xf3, 0xc0, 0x4c, 0xe1, 0xdd, 0xfb, 0x60, 0x6b, 0xa5, 0xdd, 0xa5, 0x87, 0x16,
+	0x8f, 0xd4, 0x91, 0x80, 0x45, 0xfa, 0x7c, 0x3c, 0xf2, 0x44, 0x1d, 0x80, 0xd9, 0x07, 0xb8, 0x00,
+	0x76, 0x90, 0x03, 0x72, 0x49, 0x51, 0x22, 0xd5, 0xe8, 0x29, 0x0c, 0x9a, 0xe8, 0xe9, 0x1e, 0x74,
+	0xf7, 0xec, 0x2e, 0xf6, 0xc2, 0x92, 0xc2, 0x8e, 0xf3, 0x1d, 0x25, 0xd1, 0x12, 0x2d, 0x9f, 0x1f,
+	0x77, 0x96, 0xc3, 0x8f, 0xb0, 0xcf, 0x77, 0xa7, 0xf0, 0x8f, 0x23, 0x1c, 0xbe, 0x70, 0xf8, 0xc3,
+	0x0e, 0x7f, 0x28, 0xc2, 0x76, 0x84, 0x3e, 0xee, 0xe3, 0xc2, 0xb2, 0xf7, 0x4e, 0xeb, 0x1f, 0x47,
+	0x38, 0xec, 0xfb, 0xb0, 0x7f, 0xac, 0x1f, 0x3b, 0xea, 0xd1, 0xd5, 0x55, 0xd5, 0x3d, 0x58, 0x0c,
+	0xa6, 0x67, 0xc1, 0x3d, 0xf2, 0x0b, 0x98, 0xca, 0xac, 0xcc, 0xec, 0xee, 0xaa, 0xac, 0xac, 0xcc,
+	0xac, 0x2c, 0xb4, 0xd1, 0x71, 0xa2, 0xbd, 0xfe, 0xce, 0xa2, 0xed, 0x77, 0x97, 0xac, 0xa0, 0xe3,
+	0xf7, 0x02, 0xff, 0x3d, 0xfa, 0xcf, 0xf3, 0xf8, 0x36, 0xf6, 0xa2, 0x70, 0xa9, 0xb7, 0xdf, 0x59,
+	0xb2, 0x7a, 0x4e, 0xb8, 0xc4, 0x7e, 0xfb, 0xfd, 0xc0, 0xc6, 0x4b, 0xb7, 0xbf, 0x60, 0xb9, 0xbd,
+	0x3d, 0xeb, 0x0b, 0x4b, 0x1d, 0xec, 0xe1, 0xc0, 0x8a, 0x70, 0x7b, 0xb1, 0x17, 0xf8, 0x91, 0x6f,
+	0x7c, 0x31, 0x21, 0xb7, 0x18, 0x93, 0xa3, 0xff, 0xbc, 0xcb, 0xba, 0x2f, 0xf6, 0xf6, 0x3b, 0x8b,
+	0x84, 0xdc, 0xa2, 0x44, 0x6e, 0x31, 0x26, 0x77, 0xe1, 0x4b, 0xc7, 0x96, 0xc6, 0xf6, 0xbb, 0x5d,
+	0xdf, 0xd3, 0xf9, 0x5f, 0x78, 0x5e, 0x22, 0xd0, 0xf1, 0x3b, 0xfe, 0x12, 0x6d, 0xde, 0xe9, 0xef,
+	0xd2, 0x5f, 0xf4, 0x07, 0xfd, 0x8f, 0xa3, 0xd7, 0xf7, 0x5f, 0x0a, 0x17, 0x1d, 0x9f, 0x90, 0x5c,
+	0xb2, 0xfd, 0x80, 0x3c, 0x58, 0x8a, 0xe4, 0x5f, 0x4c, 0x70, 0xba, 0x96, 0xbd, 0xe7, 0x78, 0x38,
+	0x38, 0x4c, 0xe4, 0xe8, 0xe2, 0xc8, 0xca, 0xea, 0xb5, 0x34, 0xa8, 0x57, 0xd0, 0xf7, 0x22, 0xa7,
+	0x8b, 0x53, 0x1d, 0xfe, 0xd2, 0xc3, 0x3a, 0x84, 0xf6, 0x1e, 0xee, 0x5a, 0x7a, 0xbf, 0xfa, 0xff,
+	0x2d, 0xa0, 0x85, 0xe5, 0x8d, 0xad, 0xe6, 0xaa, 0xef, 0x85, 0xfd, 0x2e, 0x5e, 0xf5, 0xbd, 0x5d,
+	0xa7, 0x63, 0xfc, 0x02, 0x9a, 0xb2, 0x59, 0x43, 0xb0, 0x6d, 0x75, 0xcc, 0xc2, 0x93, 0x85, 0x67,
+	0x6a, 0x2b, 0x67, 0x7e, 0x74, 0xff, 0xf2, 0xa7, 0x1e, 0xdc, 0xbf, 0x3c, 0xb5, 0x9a, 0x80, 0x40,
+	0xc6, 0x33, 0x9e, 0x45, 0x93, 0x56, 0x3f, 0xf2, 0x97, 0xed, 0x7d, 0x73, 0xe2, 0xc9, 0xc2, 0x33,
+	0xd5, 0x95, 0x39, 0xde, 0x65, 0x72, 0x99, 0x35, 0x43, 0x0c, 0x37, 0x96, 0x50, 0x0d, 0xdf, 0xb5,
+	0xdd, 0x7e, 0xe8, 0xdc, 0xc6, 0x66, 0x91, 0x22, 0x2f, 0x70, 0xe4, 0xda, 0x95, 0x18, 0x00, 0x09,
+	0x0e, 0xa1, 0xed, 0xf9, 0xeb, 0xbe, 0x6d, 0xb9, 0x66, 0x49, 0xa5, 0xbd, 0xc9, 0x9a, 0x21, 0x86,
+	0x1b, 0x4f, 0xa3, 0x8a, 0xe7, 0xdf, 0xb2, 0x9c, 0xc8, 0x2c, 0x53, 0xcc, 0x59, 0x8e, 0x59, 0xd9,
+	0xa4, 0xad, 0xc0, 0xa1, 0xf5, 0x3f, 0x9d, 0x46, 0x73, 0xe4, 0xd9, 0xaf, 0x90, 0xc1, 0xd1, 0xa2,
+	0x63, 0xc9, 0xb8, 0x88, 0x8a, 0xfd, 0xc0, 0xe5, 0x4f, 0x3c, 0xc5, 0x3b, 0x16, 0x5f, 0x87, 0x75,
+	0x20, 0xed, 0xc6, 0x4b, 0x68, 0x1a, 0xdf, 0xb5, 0xf7, 0x2c, 0xaf, 0x83, 0x37, 0xad, 0x2e, 0xa6,
+	0x8f, 0x59, 0x5b, 0x39, 0xcb, 0xf1, 0xa6, 0xaf, 0x48, 0x30, 0x50, 0x30, 0xe5, 0x9e, 0xdb, 0x87,
+	0x3d, 0xf6, 0xcc, 0x19, 0x3d, 0x09, 0x0c, 0x14, 0x4c, 0xe3, 0x05, 0x84, 0x02, 0xbf, 0x1f, 0x39,
+	0x5e, 0xe7, 0x06, 0x3e, 0xa4, 0x0f, 0x5f, 0x5b, 0x31, 0x78, 0x3f, 0x04, 0x02, 0x02, 0x12, 0x96,
+	0xf1, 0x97, 0xd1, 0x82, 0xed, 0x7b, 0x1e, 0xb6, 0x23, 0xc7, 0xf7, 0x56, 0x2c, 0x7b, 0xdf, 0xdf,
+	0xdd, 0xa5, 0x6f, 0x63, 0xea, 0x85, 0x97, 0x16, 0x8f, 0x3d, 0xc9, 0xd8, 0x2c, 0x59, 0xe4, 0xfd,
+	0x57, 0xce, 0x3d, 0xb8, 0x7f, 0x79, 0x61, 0x55, 0x27, 0x0b, 0x69, 0x4e, 0xc6, 0x73, 0xa8, 0xfa,
+	0x5e, 0xe8, 0x7b, 0x2b, 0x7e, 0xfb, 0xd0, 0xac, 0xd0, 0x6f, 0x30, 0xcf, 0x05, 0xae, 0xbe, 0xd6,
+	0xba, 0xb9, 0x49, 0xda, 0x41, 0x60, 0x18, 0xaf, 0xa3, 0x62, 0xe4, 0x86, 0xe6, 0x24, 0x15, 0xef,
+	0xe5, 0xa1, 0xc5, 0xdb, 0x5e, 0x6f, 0xb1, 0x61, 0xbb, 0x32, 0x49, 0xbe, 0xd5, 0xf6, 0x7a, 0x0b,
+	0x08, 0x3d, 0xe3, 0x5b, 0x05, 0x54, 0x25, 0xf3, 0xab, 0x6d, 0x45, 0x96, 0x59, 0x7d, 0xb2, 0xf8,
+	0xcc, 0xd4, 0x0b, 0x5f, 0x59, 0x1c, 0x49, 0xc1, 0x2c, 0x6a, 0xa3, 0x65, 0x71, 0x83, 0x93, 0xbf,
+	0xe2, 0x45, 0xc1, 0x61, 0xf2, 0x8c, 0x71, 0x33, 0x08, 0xfe, 0xc6, 0xdf, 0x2e, 0xa0, 0xb9, 0xf8,
+	0xab, 0x36, 0xb0, 0xed, 0x5a, 0x01, 0x36, 0x6b, 0xf4, 0x81, 0xdf, 0xcc, 0x43, 0x26, 0x95, 0x32,
+	0x7f, 0x1d, 0x67, 0x1e, 0xdc, 0xbf, 0x3c, 0xa7, 0x81, 0x40, 0x97, 0xc2, 0xf8, 0x76, 0x01, 0x4d,
+	0x1f, 0xf4, 0x71, 0x5f, 0x88, 0x85, 0xa8, 0x58, 0xaf, 0xe7, 0x20, 0xd6, 0x96, 0x44, 0x96, 0xcb,
+	0x34, 0x4f, 0x06, 0xbb, 0xdc, 0x0e, 0x0a, 0x73, 0xe3, 0x1b, 0xa8, 0x46, 0x7f, 0xaf, 0x38, 0x5e,
+	0xdb, 0x9c, 0xa2, 0x92, 0x40, 0x5e, 0x92, 0x10, 0x9a, 0x5c, 0x8c, 0x19, 0xa2, 0x67, 0x44, 0x23,
+	0x24, 0x3c, 0x8d, 0x3b, 0x68, 0x92, 0xab, 0x34, 0x73, 0x9a, 0xb2, 0x6f, 0xe6, 0xc0, 0x5e, 0xd1,
+	0xae, 0x2b, 0x53, 0x44, 0x6b, 0xf1, 0x26, 0x88, 0xb9, 0x19, 0x6f, 0xa2, 0x92, 0xd5, 0x8f, 0xf6,
+	0xcc, 0x99, 0x13, 0x4e, 0x83, 0x15, 0x2b, 0x74, 0xec, 0xe5, 0x7e, 0xb4, 0xb7, 0x52, 0x7d, 0x70,
+	0xff, 0x72, 0x89, 0xfc, 0x07, 0x94, 0xa2, 0x01, 0xa8, 0xd6, 0x0f, 0xdc, 0x16, 0xb6, 0x03, 0x1c,
+	0x99, 0xb3, 0x94, 0xfc, 0xe7, 0x16, 0xd9, 0x7a, 0x41, 0x28, 0x2c, 0x92, 0xa5, 0x6b, 0xf1, 0xf6,
+	0x17, 0x16, 0x19, 0xc6, 0x0d, 0x7c, 0xd8, 0xc2, 0x2e, 0xb6, 0x23, 0x3f, 0x60, 0xaf, 0xe9, 0x75,
+	0x58, 0x67, 0x10, 0x48, 0xc8, 0x18, 0x11, 0xaa, 0xec, 0x3a, 0x6e, 0x84, 0x03, 0x73, 0x2e, 0x97,
+	0xb7, 0x24, 0xcd, 0xaa, 0xab, 0x94, 0xee, 0x0a, 0x22, 0x1a, 0x9b, 0xfd, 0x0f, 0x9c, 0x97, 0xf1,
+	0xcd, 0x02, 0xaa, 0x45, 0x81, 0xe5, 0x85, 0xbb, 0x7e, 0xd0, 0x35, 0xe7, 0x29, 0xe7, 0x56, 0x7e,
+	0x9c, 0xb7, 0x63, 0xd2, 0xec, 0xc1, 0xc5, 0x4f, 0x48, 0x98, 0x5e, 0x78, 0x05, 0xcd, 0x28, 0xb3,
+	0xde, 0x98, 0x47, 0xc5, 0x7d, 0x7c, 0xc8, 0x56, 0x0c, 0x20, 0xff, 0x1a, 0x67, 0x51, 0xf9, 0xb6,
+	0xe5, 0xf6, 0xf9, 0xea, 0x00, 0xec, 0xc7, 0xcb, 0x13, 0x2f, 0x15, 0xea, 0x3f, 0x2e, 0xa0, 0x4f,
+	0x0f, 0x9c, 0xaf, 0x64, 0x89, 0x6b, 0xf7, 0x03, 0x6b, 0xc7, 0xc5, 0x66, 0x41, 0x5d, 0xe2, 0x1a,
+	0xac, 0x19, 0x62, 0x38, 0x59, 0x13, 0xc8, 0x4a, 0xda, 0xc0, 0x2e, 0x8e, 0x30, 0x5f, 0x6c, 0xc5,
+	0x9a, 0xb0, 0x2c, 0x20, 0x20, 0x61, 0x11, 0xa5, 0xec, 0x78, 0x11, 0x0e, 0x3c, 0xcb, 0xe5, 0x2b,
+	0xae, 0x50, 0x58, 0x6b, 0xbc, 0x1d, 0x04, 0x86, 0xb4, 0x88, 0x96, 0x8e, 0x5c, 0x44, 0xbf, 0x88,
+	0xce, 0x64, 0x4c, 0x30, 0xa9, 0x7b, 0xe1, 0xc8, 0xee, 0xff, 0x78, 0x02, 0x9d, 0xcf, 0x56, 0x15,
+	0xc6, 0x93, 0xa8, 0xe4, 0x91, 0x35, 0x96, 0xad, 0xc5, 0xd3, 0x9c, 0x40, 0x89, 0xae, 0xad, 0x14,
+	0x22, 0xbf, 0xb0, 0x89, 0xa1, 0x5e, 0x58, 0xf1, 0x58, 0x2f, 0x4c, 0xb1, 0x51, 0x4a, 0xc7, 0xb0,
+	0x51, 0x8e, 0x69, 0x78, 0x10, 0xc2, 0x56, 0xd0, 0xe9, 0x77, 0xc9, 0x68, 0xa4, 0xeb, 0x63, 0x2d,
+	0x21, 0xbc, 0x1c, 0x03, 0x20, 0xc1, 0xa9, 0x7f, 0x50, 0x41, 0x9f, 0x5e, 0xbe, 0xd7, 0x0f, 0x30,
+	0x1d, 0xac, 0xe1, 0xf5, 0xfe, 0x8e, 0x6c, 0xb3, 0x3c, 0x89, 0x4a, 0xbb, 0x07, 0x6d, 0x4f, 0x7f,
+	0x51, 0x57, 0xb7, 0x1a, 0x9b, 0x40, 0x21, 0x46, 0x0f, 0x9d, 0x09, 0xf7, 0xac, 0x00, 0xb7, 0x97,
+	0x6d, 0x1b, 0x87, 0xe1, 0x0d, 0x7c, 0x28, 0xac, 0x97, 0x63, 0xeb, 0x82, 0x27, 0x1e, 0xdc, 0xbf,
+	0x7c, 0xa6, 0x95, 0xa6, 0x02, 0x59, 0xa4, 0x8d, 0x36, 0x9a, 0xd3, 0x9a, 0xcd, 0xe2, 0x30, 0xdc,
+	0xe8, 0xda, 0xa5, 0x71, 0x03, 0x9d, 0x24, 0x19, 0x00, 0x7b, 0xfd, 0x1d, 0xfa, 0x2c, 0xcc, 0x2e,
+	0x12, 0x03, 0xe0, 0x3a, 0x6b, 0x86, 0x18, 0x6e, 0xfc, 0x4d, 0xd9, 0x1a, 0x28, 0x53, 0x6b, 0x60,
+	0x77, 0x54, 0xcd, 0x3e, 0xe8, 0x8b, 0x0c, 0x61, 0x17, 0x24, 0x7a, 0xb4, 0x72, 0x6a, 0x7a, 0x74,
+	0xf2, 0xb1, 0xd3, 0xa3, 0xef, 0xd7, 0xd0, 0x67, 0xe9, 0xdb, 0xa7, 0x6a, 0xa3, 0x15, 0xf9, 0x81,
+	0xd5, 0xc1, 0xf2, 0x94, 0x78, 0x0d, 0x19, 0x21, 0x6b, 0x5d, 0xb6, 0x6d, 0xbf, 0xef, 0x45, 0x9b,
+	0x89, 0x26, 0xb9, 0xc0, 0x3f, 0x87, 0xd1, 0x4a, 0x61, 0x40, 0x46, 0x2f, 0xa3, 0x83, 0xe6, 0x13,
+	0x0b, 0xb7, 0x15, 0x05, 0x8e, 0xd7, 0x19, 0x6e, 0xe6, 0x9c, 0x7d, 0x70, 0xff, 0xf2, 0xfc, 0xaa,
+	0x46, 0x02, 0x52, 0x44, 0x89, 0x5a, 0xa0, 0x76, 0x08, 0x95, 0xb5, 0xa8, 0xaa, 0x85, 0xad, 0x18,
+	0x00, 0x09, 0x8e, 0x62, 0x66, 0x97, 0x1e, 0x6a, 0x66, 0x5f, 0x44, 0xc5, 0xb6, 0x7b, 0xc0, 0x55,
+	0x93, 0xd8, 0xda, 0x34, 0xd6, 0xb7, 0x80, 0xb4, 0x13, 0x0b, 0x35, 0x99, 0x20, 0x15, 0x3a, 0x41,
+	0x9c, 0x3c, 0x26, 0xc8, 0x80, 0x4f, 0x74, 0xa2, 0x39, 0x32, 0x79, 0x6a, 0x73, 0x04, 0x9d, 0xc2,
+	0x1c, 0x31, 0x5e, 0x41, 0x33, 0x6d, 0x6c, 0xfb, 0x6d, 0xbc, 0x81, 0xc3, 0xd0, 0xea, 0x60, 0xb3,
+	0x4a, 0xbf, 0xdd, 0x39, 0xfe, 0xae, 0x66, 0x1a, 0x32, 0x10, 0x54, 0x5c, 0x63, 0x15, 0x2d, 0xdc,
+	0xb1, 0x9c, 0x68, 0xdb, 0xe9, 0xe2, 0x35, 0xaf, 0x85, 0x6d, 0xdf, 0x6b, 0x87, 0x74, 0xcb, 0x51,
+	0x66, 0x1b, 0xb9, 0x5b, 0x3a, 0x10, 0xd2, 0xf8, 0xc6, 0x3b, 0xe8, 0xc2, 0x6d, 0x27, 0x74, 0x76,
+	0x1c, 0xd7, 0x89, 0x0e, 0x09, 0xc8, 0xef, 0x47, 0x09, 0xb5, 0x29, 0x4a, 0xed, 0xd2, 0x83, 0xfb,
+	0x97, 0x2f, 0xbc, 0x31, 0x10, 0x0b, 0x8e, 0xa0, 0x60, 0x2c, 0xa3, 0xb9, 0xae, 0x75, 0xb7, 0x81,
+	0xe9, 0x98, 0x5e, 0x25, 0x53, 0x8e, 0x5a, 0xdd, 0xe5, 0x95, 0x27, 0xf8, 0x33, 0xce, 0x6d, 0xa8,
+	0x60, 0xd0, 0xf1, 0x09, 0x89, 0x9e, 0xef, 0x84, 0xbe, 0x27, 0xa6, 0x08, 0x35, 0xa1, 0x6b, 0x09,
+	0x89, 0xa6, 0x0a, 0x06, 0x1d, 0x7f, 0x34, 0x5d, 0xf4, 0x93, 0x49, 0x74, 0x81, 0x0e, 0xf4, 0x16,
+	0x0e, 0x6e, 0x3b, 0x36, 0x5e, 0xe9, 0x87, 0xb2, 0x26, 0xca, 0xd2, 0x1e, 0x85, 0xb1, 0x6b, 0x8f,
+	0x89, 0x63, 0x68, 0x8f, 0x25, 0x54, 0x8b, 0xfc, 0x9e, 0x63, 0x67, 0xa9, 0x9b, 0xed, 0x18, 0x00,
+	0x09, 0x8e, 0xd1, 0x40, 0xf3, 0x61, 0x7f, 0x27, 0xb4, 0x03, 0xa7, 0x47, 0xf8, 0x4a, 0xcb, 0xae,
+	0xc9, 0xfb, 0xcd, 0xb7, 0x34, 0x38, 0xa4, 0x7a, 0xc4, 0xbb, 0xfd, 0x72, 0xce, 0xbb, 0xfd, 0xe1,
+	0x5c, 0x0e, 0xbf, 0x29, 0x2b, 0xbb, 0x49, 0xaa, 0xec, 0x3a, 0x79, 0x28, 0xbb, 0xcc, 0x31, 0x70,
+	0x22, 0x55, 0x57, 0xfd, 0x78, 0xa9, 0xba, 0xb7, 0xd0, 0x13, 0xbb, 0x7d, 0xd7, 0x3d, 0xdc, 0xea,
+	0x5b, 0xae, 0xb3, 0xeb, 0xe0, 0x36, 0x19, 0x2b, 0x61, 0xcf, 0xb2, 0x99, 0x9b, 0xa4, 0xb6, 0x72,
+	0x99, 0xbf, 0xb5, 0x27, 0xae, 0x66, 0xa3, 0xc1, 0xa0, 0xfe, 0xa3, 0xcd, 0xee, 0xff, 0x5c, 0x40,
+	0x33, 0x2b, 0x4e, 0xb4, 0xd3, 0xb7, 0xf7, 0x71, 0x44, 0xf6, 0xd4, 0x46, 0x80, 0xca, 0x3b, 0x64,
+	0xab, 0xcd, 0x67, 0xf1, 0xd6, 0x88, 0xef, 0x49, 0x10, 0x4f, 0xf6, 0xef, 0xb5, 0x07, 0xf7, 0x2f,
+	0x97, 0xe9, 0x4f, 0x60, 0xac, 0x8c, 0xd7, 0x11, 0xf2, 0xc9, 0x56, 0x7e, 0xdb, 0xdf, 0xc7, 0xde,
+	0x70, 0xc6, 0xc7, 0x2c, 0xd9, 0xe0, 0xdc, 0x5c, 0x8e, 0x3b, 0x83, 0x44, 0xa8, 0xfe, 0x2f, 0x0b,
+	0xc8, 0x48, 0xf3, 0x37, 0x6e, 0xa2, 0x6a, 0x3f, 0xc4, 0x81, 0xd8, 0x7c, 0x1d, 0x9b, 0xd7, 0x34,
+	0x19, 0xd5, 0xaf, 0xf3, 0xae, 0x20, 0x88, 0x10, 0x82, 0x3d, 0x2b, 0x0c, 0xef, 0xf8, 0x41, 0xdb,
+	0x9c, 0x18, 0x9a, 0x60, 0x93, 0x77, 0x05, 0x41, 0xa4, 0xfe, 0x3b, 0x35, 0x74, 0x56, 0x08, 0xae,
+	0xd9, 0x7d, 0x6d, 0xba, 0x79, 0xbb, 0xee, 0xfb, 0xfb, 0x37, 0xbd, 0xab, 0x8e, 0xe7, 0x84, 0x7b,
+	0x7c, 0x0b, 0x2a, 0xec, 0xbe, 0x46, 0x0a, 0x03, 0x32, 0x7a, 0x19, 0xdf, 0x95, 0x75, 0xc4, 0x04,
+	0xd5, 0x11, 0x56, 0x5e, 0x1f, 0xfb, 0xa4, 0xda, 0x61, 0xf2, 0x0e, 0xde, 0xd9, 0xf3, 0xfd, 0x7d,
+	0xbe, 0x99, 0xda, 0x18, 0x51, 0x9e, 0x5b, 0x8c, 0xda, 0xaa, 0xef, 0x45, 0xf8, 0x6e, 0xc4, 0x1c,
+	0x53, 0xbc, 0x0d, 0x62, 0x56, 0xc6, 0x7b, 0xdc, 0x31, 0x55, 0xa2, 0x2c, 0xd7, 0xf3, 0x7a, 0x05,
+	0x99, 0xae, 0xaa, 0x3a, 0xaa, 0xb0, 0x5e, 0x74, 0x8b, 0x56, 0x63, 0xda, 0x8a, 0x6d, 0xb1, 0x80,
+	0x43, 0x8c, 0xe7, 0x51, 0xd9, 0xbf, 0xe3, 0xf1, 0x1d, 0x93, 0xb4, 0xcc, 0x37, 0x70, 0x2f, 0xc0,
+	0x36, 0x89, 0x6d, 0xdc, 0x24, 0x60, 0x60, 0x58, 0xc6, 0x2f, 0x23, 0x44, 0x44, 0xc4, 0x36, 0x19,
+	0x59, 0xd4, 0x82, 0xac, 0xad, 0x7c, 0x96, 0xf7, 0x39, 0x9b, 0xf4, 0x69, 0x0a, 0x1c, 0x90, 0xf0,
+	0x8d, 0xeb, 0x68, 0x36, 0xc0, 0x3d, 0x3f, 0x74, 0x22, 0x3f, 0x38, 0x6c, 0xb9, 0xfd, 0x0e, 0x55,
+	0xcc, 0xb5, 0x95, 0x27, 0x39, 0x05, 0x33, 0xa1, 0x00, 0x0a, 0x1e, 0x68, 0xfd, 0x8c, 0xef, 0x14,
+	0xd0, 0xb4, 0x68, 0x72, 0x30, 0xb1, 0xc5, 0x8a, 0x39, 0x78, 0x37, 0xc5, 0xfb, 0x4c, 0xd8, 0x27,
+	0x51, 0x05, 0x90, 0xf8, 0x81, 0xc2, 0x5d, 0x5a, 0x69, 0xd0, 0xa9, 0xad, 0x34, 0x53, 0xa7, 0xb1,
+	0xd2, 0x2c, 0xa1, 0x9a, 0xe7, 0x07, 0x5d, 0xcb, 0x75, 0xee, 0x31, 0x17, 0xaf, 0xe4, 0xd5, 0xd9,
+	0x8c, 0x01, 0x90, 0xe0, 0x8c, 0xb6, 0x7e, 0xdc, 0x43, 0x67, 0x32, 0xbe, 0x90, 0xf1, 0x54, 0x3c,
+	0x86, 0xd9, 0x96, 0x74, 0x86, 0x0b, 0x50, 0x56, 0x46, 0xee, 0xab, 0xa9, 0xb1, 0xc7, 0xcc, 0xba,
+	0xf3, 0x1c, 0x7b, 0xf6, 0xe8, 0x11, 0x57, 0xff, 0xc9, 0x34, 0xba, 0x20, 0x98, 0x13, 0xcb, 0x04,
+	0x07, 0xb2, 0xae, 0x94, 0xb4, 0x49, 0xe1, 0xd1, 0x69, 0x13, 0x75, 0x3a, 0x4e, 0x8c, 0x3c, 0x1d,
+	0x8b, 0x27, 0x9c, 0x8e, 0xcf, 0xa0, 0x2a, 0xa7, 0x1b, 0x9a, 0x25, 0xaa, 0x6b, 0xd8, 0x62, 0xc3,
+	0xdb, 0x40, 0x40, 0x8d, 0xbf, 0xa1, 0x4f, 0x5c, 0xe6, 0x3d, 0x7a, 0x33, 0xaf, 0x89, 0xcb, 0xbe,
+	0xcc, 0x90, 0xd3, 0x37, 0x51, 0x94, 0x95, 0x81, 0x8a, 0x72, 0x1f, 0x5d, 0x0c, 0xf7, 0x9d, 0xde,
+	0x4a, 0x60, 0x79, 0xf6, 0x1e, 0xe0, 0xdd, 0x70, 0x95, 0x3a, 0x9d, 0xdb, 0x37, 0xbd, 0x9b, 0x3d,
+	0xec, 0x35, 0x81, 0x2a, 0xc3, 0xea, 0xca, 0xe7, 0x38, 0xbb, 0x8b, 0xad, 0xa3, 0x90, 0xe1, 0x68,
+	0x5a, 0xc6, 0x9b, 0x68, 0xca, 0xa2, 0x7e, 0x39, 0x66, 0xa3, 0x54, 0x87, 0x59, 0xe6, 0xe7, 0x48,
+	0x54, 0x79, 0x39, 0xe9, 0x0d, 0x32, 0x29, 0xe3, 0x1d, 0x34, 0xc3, 0x07, 0x0f, 0xeb, 0x69, 0xd6,
+	0x86, 0xa1, 0xbd, 0x40, 0x36, 0xca, 0xb7, 0xe4, 0xfe, 0xa0, 0x92, 0x33, 0xde, 0x40, 0xe7, 0x77,
+	0xe2, 0x6f, 0x11, 0xd2, 0x6f, 0xb1, 0x62, 0x85, 0xf8, 0x75, 0x58, 0xa7, 0x9a, 0xb1, 0xb6, 0x72,
+	0x89, 0xbf, 0x9f, 0xf3, 0xda, 0x17, 0xe3, 0x58, 0x30, 0xa0, 0xf7, 0x00, 0x5b, 0x64, 0xea, 0x44,
+	0xb6, 0x88, 0xb2, 0x5f, 0x99, 0xce, 0x65, 0xbf, 0x32, 0x58, 0x33, 0x9c, 0x68, 0xbf, 0x32, 0xf3,
+	0xb1, 0x0a, 0x03, 0xc5, 0xbb, 0xd8, 0xd9, 0x9c, 0x77, 0xb1, 0xaf, 0xa0, 0x19, 0x7b, 0x0f, 0xdb,
+	0xfb, 0x34, 0x20, 0x73, 0xdb, 0x72, 0x69, 0x74, 0xad, 0x96, 0x78, 0x7c, 0x56, 0x65, 0x20, 0xa8,
+	0xb8, 0xea, 0xca, 0xb6, 0x30, 0xee, 0x95, 0xed, 0xbb, 0x05, 0xf4, 0xe9, 0x81, 0x3a, 0x8c, 0xc4,
+	0x5b, 0x24, 0x35, 0x5f, 0x50, 0x93, 0x16, 0x06, 0x28, 0xf7, 0x51, 0xd7, 0xbb, 0xff, 0x59, 0x41,
+	0x67, 0x56, 0x2d, 0x17, 0x7b, 0x6d, 0x4b, 0x59, 0xe8, 0x9e, 0x43, 0x55, 0x92, 0xfd, 0xd2, 0xee,
+	0xbb, 0xb1, 0x0b, 0x58, 0x0c, 0xe9, 0x16, 0x6f, 0x07, 0x81, 0x21, 0xc2, 0x64, 0xe4, 0xed, 0x4f,
+	0xa8, 0xd8, 0xe2, 0xc5, 0x0b, 0x0c, 0xe3, 0x65, 0x34, 0xcb, 0xe3, 0x3f, 0xbe, 0xd7, 0xb0, 0x22,
+	0x1c, 0x9a, 0x45, 0xaa, 0x8f, 0x0d, 0x22, 0xef, 0x15, 0x05, 0x02, 0x1a, 0x26, 0xe1, 0x14, 0x39,
+	0x5d, 0x7c, 0xcf, 0xf7, 0x62, 0x3f, 0x8a, 0xe0, 0xb4, 0xcd, 0xdb, 0x41, 0x60, 0x18, 0x7f, 0x3d,
+	0x1d, 0xc0, 0xf8, 0xda, 0x88, 0x63, 0x3e, 0xe3, 0x65, 0x0d, 0x31, 0xf7, 0xff, 0x4a, 0x01, 0x4d,
+	0xf5, 0x70, 0x10, 0x3a, 0x61, 0x84, 0x3d, 0x1b, 0xf3, 0x00, 0xc6, 0xcd, 0x3c, 0xe6, 0x61, 0x33,
+	0x21, 0xcb, 0x16, 0x07, 0xa9, 0x01, 0x64, 0xa6, 0x1f, 0x09, 0x87, 0x49, 0xed, 0x34, 0x14, 0x50,
+	0x03, 0xd5, 0xda, 0x61, 0xd4, 0xf4, 0x5d, 0xc7, 0x3e, 0xe4, 0x0b, 0xd5, 0xd3, 0xf1, 0x64, 0x6f,
+	0xb4, 0xb6, 0x19, 0xe0, 0x67, 0x24, 0x61, 0x87, 0x7f, 0x64, 0xd1, 0x08, 0x49, 0xc7, 0xd1, 0x34,
+	0xc0, 0xef, 0x15, 0xd0, 0x6c, 0x4c, 0xbd, 0x15, 0x59, 0x51, 0x3f, 0xa4, 0x21, 0x53, 0xf2, 0x1c,
+	0x52, 0xb8, 0x25, 0x09, 0x99, 0xc6, 0x00, 0x48, 0x70, 0x8c, 0x0e, 0x9a, 0xf1, 0xf0, 0xdd, 0xe8,
+	0xaa, 0x13, 0x60, 0x32, 0xe6, 0x43, 0xbe, 0xd1, 0xfe, 0xbc, 0xb4, 0xb8, 0x8b, 0x7c, 0xb6, 0xe4,
+	0x05, 0x92, 0x31, 0x48, 0x96, 0x7b, 0xd2, 0x25, 0x51, 0x8e, 0x9b, 0x32, 0x21, 0x50, 0xe9, 0xd6,
+	0xef, 0xa2, 0xb3, 0xab, 0x56, 0x64, 0xef, 0xf5, 0x7b, 0x4c, 0xf1, 0xf6, 0x03, 0x2b, 0x72, 0x7c,
+	0x8f, 0x84, 0x10, 0xb1, 0x47, 0x42, 0xc4, 0x6d, 0x3d, 0xe8, 0x7e, 0x85, 0x35, 0x43, 0x0c, 0x27,
+	0x59, 0x71, 0xc4, 0xf9, 0xcc, 0x7b, 0x9a, 0x13, 0x6a, 0x56, 0xdc, 0x46, 0x02, 0x02, 0x19, 0xaf,
+	0xfe, 0xc7, 0x13, 0xc8, 0x58, 0x75, 0xfb, 0x61, 0xa4, 0x9a, 0xdf, 0x5f, 0x93, 0xa6, 0x33, 0xb3,
+	0xbf, 0xff, 0xc2, 0xf1, 0x1e, 0xfa, 0xe6, 0x0e, 0x51, 0x98, 0xe4, 0xb3, 0x25, 0x1a, 0x35, 0x69,
+	0x93, 0x26, 0xe8, 0x1d, 0x54, 0x0a, 0x7b, 0xd8, 0x36, 0x27, 0x72, 0x49, 0xe8, 0x49, 0x3f, 0x42,
+	0xab, 0x87, 0xed, 0x24, 0xdc, 0x4c, 0x7e, 0x01, 0x65, 0x68, 0x78, 0xa8, 0x12, 0xd2, 0xf1, 0xc0,
+	0xdd, 0x14, 0x57, 0x87, 0x5e, 0x1f, 0x39, 0x33, 0xc0, 0x4c, 0x0a, 0x36, 0xba, 0x92, 0x78, 0x3a,
+	0xfb, 0x0d, 0x9c, 0x4b, 0xfd, 0x7f, 0x15, 0xd0, 0xf9, 0xb4, 0x78, 0xeb, 0x4e, 0x18, 0x19, 0x5f,
+	0x49, 0xbd, 0xe5, 0xc5, 0xe3, 0xbd, 0x65, 0xd2, 0x9b, 0xbe, 0x63, 0xa1, 0x02, 0xe3, 0x16, 0xe9,
+	0x0d, 0xdf, 0x46, 0x65, 0x27, 0xc2, 0xdd, 0x78, 0xd4, 0x6e, 0xe5, 0xfe, 0x8a, 0x93, 0x9d, 0xe1,
+	0x1a, 0xe1, 0x03, 0x8c, 0x5d, 0xfd, 0xb7, 0x26, 0xb2, 0x1e, 0x98, 0x7c, 0x01, 0xe3, 0x2e, 0x5a,
+	0xf0, 0x62, 0xd7, 0x67, 0x6c, 0x04, 0xf3, 0x27, 0x7f, 0xf1, 0x98, 0x4f, 0x6e, 0xed, 0x60, 0x57,
+	0xd8, 0xcf, 0x34, 0x56, 0xb4, 0xa9, 0x53, 0x84, 0x34, 0x13, 0xe3, 0xd7, 0x0a, 0x68, 0x0a, 0x27,
+	0xd2, 0xf0, 0x61, 0xb7, 0x99, 0x9f, 0x5a, 0xa4, 0xe3, 0x4d, 0xcc, 0x37, 0x09, 0x00, 0x32, 0xdf,
+	0xfa, 0xd7, 0xd1, 0x59, 0x36, 0xc5, 0x37, 0xac, 0x9e, 0xb4, 0x6e, 0x1c, 0x23, 0x9f, 0xa4, 0x81,
+	0xe6, 0xed, 0x00, 0x5b, 0x11, 0x5e, 0xdb, 0xdd, 0xf4, 0xa3, 0x2b, 0x77, 0x9d, 0x30, 0xe2, 0x89,
+	0x25, 0x22, 0xc0, 0xb1, 0xaa, 0xc1, 0x21, 0xd5, 0xa3, 0xfe, 0x6f, 0x8b, 0x88, 0xf8, 0xa2, 0xb0,
+	0xd7, 0xc6, 0x9e, 0x7d, 0xd8, 0x0c, 0xfc, 0x9d, 0xe3, 0xf0, 0x76, 0x51, 0x31, 0xb2, 0x7b, 0xfc,
+	0xa5, 0x8d, 0x3a, 0x90, 0xb6, 0x57, 0x9b, 0x9a, 0x04, 0xdc, 0xce, 0x5c, 0x6d, 0x02, 0x61, 0x63,
+	0xf4, 0x50, 0x69, 0x2f, 0x8a, 0x7a, 0x7c, 0x7e, 0x8e, 0xea, 0x83, 0xba, 0xbe, 0xbd, 0x9d, 0xe2,
+	0x47, 0x3d, 0x7b, 0x04, 0x00, 0x94, 0x93, 0xd1, 0x42, 0x13, 0xe1, 0x8b, 0xdc, 0x87, 0xf8, 0xca,
+	0xd0, 0xfa, 0xa0, 0xf5, 0xe2, 0x72, 0x10, 0x39, 0xbb, 0x96, 0x1d, 0xad, 0x54, 0x1e, 0xdc, 0xbf,
+	0x3c, 0xd1, 0x7a, 0x11, 0x26, 0xc2, 0x17, 0x15, 0x5b, 0xad, 0xfc, 0x50, 0x5b, 0xed, 0x59, 0x34,
+	0x19, 0xb1, 0x00, 0x24, 0x77, 0x1d, 0x0a, 0x55, 0xcf, 0xe3, 0x92, 0x10, 0xc3, 0xeb, 0xff, 0xa2,
+	0x86, 0x2e, 0x34, 0x0e, 0x3d, 0xab, 0xeb, 0x37, 0x56, 0x5a, 0x51, 0x80, 0xad, 0xae, 0x12, 0xd4,
+	0x7b, 0x0a, 0x95, 0x23, 0x91, 0xa7, 0x25, 0xb9, 0x6f, 0xb6, 0x49, 0x23, 0x30, 0x18, 0x59, 0x0b,
+	0x43, 0xda, 0x75, 0x19, 0x36, 0xf5, 0x80, 0x5c, 0x2b, 0x06, 0x40, 0x82, 0x43, 0xd2, 0x87, 0x02,
+	0xdc, 0x21, 0x4b, 0x0b, 0x73, 0x6a, 0x08, 0x75, 0x07, 0xb4, 0x15, 0x38, 0x94, 0xe4, 0xf3, 0x59,
+	0x22, 0xab, 0xa6, 0x34, 0x74, 0x3e, 0x5f, 0x92, 0x4f, 0x93, 0x90, 0x21, 0x34, 0xc3, 0x18, 0xdd,
+	0x2c, 0x0f, 0x4d, 0x53, 0x34, 0x43, 0x42, 0x86, 0xbc, 0xef, 0xc0, 0x77, 0x31, 0x79, 0x7c, 0xed,
+	0x7d, 0x03, 0x6b, 0x86, 0x18, 0x4e, 0x3e, 0x24, 0xf6, 0xda, 0x3d, 0xdf, 0xf1, 0x22, 0x73, 0x52,
+	0xfd, 0x90, 0x57, 0x78, 0x3b, 0x08, 0x0c, 0x1a, 0x88, 0x8c, 0xac, 0x20, 0x72, 0xbc, 0x4e, 0x93,
+	0x6c, 0x00, 0xc8, 0x2b, 0xab, 0x6a, 0x81, 0x48, 0x0d, 0x0e, 0xa9, 0x1e, 0xc6, 0x97, 0x50, 0xc5,
+	0xe9, 0x5a, 0x1d, 0x1c, 0xf2, 0x08, 0xd3, 0xcf, 0xc5, 0xaf, 0x7b, 0x8d, 0xb6, 0xfe, 0xec, 0xfe,
+	0xe5, 0x73, 0xda, 0x10, 0x60, 0x00, 0xe0, 0xdd, 0x48, 0x4a, 0x77, 0xcf, 0x77, 0x5d, 0xb1, 0x57,
+	0x43, 0x6a, 0x4a, 0x77, 0x53, 0x82, 0x81, 0x82, 0x69, 0xfc, 0x35, 0xcd, 0x74, 0xce, 0xc7, 0x11,
+	0x9a, 0xa5, 0xf5, 0x1e, 0x62, 0x3e, 0x8f, 0xc1, 0xaf, 0x30, 0x78, 0xda, 0x3c, 0x66, 0x7e, 0x85,
+	0xd9, 0xc7, 0x2e, 0x2d, 0xea, 0x0f, 0xaa, 0xc8, 0xbc, 0xe2, 0x5a, 0x61, 0xe4, 0xd8, 0x21, 0xb6,
+	0x02, 0x7b, 0x6f, 0x88, 0x93, 0x0d, 0x4f, 0xa1, 0xb2, 0xe3, 0xb5, 0xf1, 0x5d, 0x73, 0x42, 0x55,
+	0x69, 0x6b, 0xa4, 0x11, 0x18, 0x8c, 0x20, 0x1d, 0xf4, 0x71, 0x70, 0x68, 0x16, 0x55, 0xa4, 0x2d,
+	0xd2, 0x08, 0x0c, 0x46, 0xf5, 0x9e, 0x1f, 0x44, 0x57, 0x1d, 0xec, 0xb6, 0xcd, 0x92, 0xa6, 0xf7,
+	0x62, 0x00, 0x24, 0x38, 0x24, 0x83, 0x23, 0x72, 0xf0, 0x4e, 0x80, 0xad, 0x7d, 0x1c, 0xb0, 0x6e,
+	0x65, 0x35, 0xb4, 0xb3, 0xad, 0x82, 0x41, 0xc7, 0x4f, 0x4d, 0xc5, 0xca, 0xb1, 0xa7, 0xe2, 0x12,
+	0xaa, 0xed, 0x90, 0x7d, 0x41, 0x8b, 0x38, 0x4d, 0x26, 0x69, 0xee, 0x89, 0x90, 0x76, 0x25, 0x06,
+	0x40, 0x82, 0x63, 0x74, 0x48, 0x07, 0x1e, 0x2a, 0x35, 0xab, 0x27, 0xf4, 0xff, 0x24, 0xc1, 0xde,
+	0x19, 0xc6, 0x88, 0xff, 0x84, 0x84, 0xb6, 0xb1, 0x86, 0x2a, 0x56, 0xcf, 0x21, 0xfa, 0x78, 0x28,
+	0x87, 0x27, 0x1d, 0xd8, 0xcb, 0xcd, 0x35, 0xa2, 0x8c, 0x39, 0x81, 0xd8, 0x5b, 0x85, 0x72, 0xf6,
+	0x56, 0x7d, 0x5f, 0xd6, 0x1e, 0x53, 0x54, 0x7b, 0xe0, 0x51, 0xa7, 0xcb, 0x80, 0xe1, 0x7b, 0x22,
+	0xdd, 0x31, 0x7d, 0x6a, 0xba, 0x63, 0xe6, 0xb1, 0xd3, 0x1d, 0xdf, 0xaf, 0x22, 0xe3, 0x4a, 0xd7,
+	0x89, 0xb4, 0x5d, 0xea, 0xd3, 0xa8, 0xb2, 0x13, 0xf8, 0xfb, 0x22, 0x52, 0x25, 0x6c, 0x92, 0x15,
+	0xda, 0x0a, 0x1c, 0x4a, 0xfc, 0x7d, 0x24, 0xa5, 0xdd, 0xc3, 0x6e, 0x12, 0xd6, 0x11, 0xbb, 0xd3,
+	0x55, 0x01, 0x01, 0x09, 0x8b, 0x9e, 0x32, 0x63, 0xbf, 0xa4, 0x14, 0xa4, 0xe4, 0x94, 0x59, 0x02,
+	0x02, 0x19, 0x4f, 0x49, 0x4f, 0x28, 0xe5, 0x9d, 0x9e, 0x50, 0xce, 0x21, 0x3d, 0x21, 0xfb, 0xf4,
+	0x55, 0xe5, 0x54, 0x4e, 0x5f, 0x4d, 0x1e, 0xf7, 0xf4, 0x55, 0x35, 0x67, 0xdd, 0xf0, 0x81, 0xac,
+	0x1b, 0x58, 0xa8, 0xfb, 0xdd, 0x51, 0xa7, 0x43, 0x6a, 0x78, 0x9e, 0x48, 0x2b, 0x7c, 0xbc, 0xe2,
+	0xdd, 0xa3, 0x69, 0x85, 0xdf, 0x2a, 0xa0, 0x05, 0xca, 0x6f, 0xd5, 0x8a, 0x2c, 0xd7, 0xef, 0x30,
+	0x0a, 0xbf, 0x80, 0xa6, 0xda, 0x58, 0x64, 0xf5, 0xe9, 0xc7, 0x43, 0x1b, 0x09, 0x08, 0x64, 0x3c,
+	0xa2, 0x4b, 0xd8, 0x29, 0x54, 0x73, 0x42, 0xd5, 0x25, 0x2d, 0xda, 0x0a, 0x1c, 0x4a, 0xf1, 0xac,
+	0x6e, 0xcf, 0xc5, 0xfa, 0x3e, 0xa8, 0x45, 0x5b, 0x81, 0x43, 0xeb, 0x1f, 0x4e, 0xa0, 0x79, 0xdd,
+	0x5d, 0x6c, 0xdc, 0x43, 0x93, 0x36, 0xf3, 0xf3, 0x99, 0x85, 0x5c, 0x5e, 0x77, 0x96, 0xd7, 0x90,
+	0x1f, 0xe1, 0x62, 0x10, 0x88, 0x19, 0xd2, 0xaf, 0x6d, 0xc7, 0x46, 0xb8, 0x39, 0x91, 0x0f, 0xfb,
+	0x2c, 0xa3, 0x9e, 0x7e, 0x6d, 0x01, 0x81, 0x84, 0x69, 0xfd, 0xbf, 0x14, 0xd0, 0x2c, 0x1b, 0x20,
+	0xce, 0x3d, 0xbc, 0xee, 0x74, 0x9d, 0x88, 0x18, 0x6d, 0x3b, 0x87, 0x24, 0x32, 0x41, 0xde, 0x47,
+	0x31, 0x31, 0xda, 0x56, 0x48, 0x23, 0x30, 0x98, 0xf1, 0x12, 0xaa, 0xf4, 0x98, 0x2f, 0x79, 0x42,
+	0x09, 0xa8, 0x57, 0x84, 0x23, 0x79, 0xf6, 0xe6, 0x6d, 0x22, 0xc1, 0x3d, 0xcc, 0x5a, 0x80, 0xe3,
+	0x1b, 0xfb, 0x08, 0xd9, 0xae, 0xe5, 0x74, 0x69, 0x68, 0xca, 0x2c, 0x8e, 0xbe, 0xc1, 0xa7, 0x19,
+	0x6b, 0xab, 0x82, 0x24, 0x48, 0xe4, 0xeb, 0x3f, 0x99, 0x40, 0x53, 0x8f, 0xd6, 0x89, 0xda, 0x53,
+	0x9c, 0xa8, 0x79, 0x7b, 0xb3, 0xb2, 0xbc, 0xa7, 0x77, 0x35, 0xef, 0x69, 0x8e, 0x9a, 0xea, 0x21,
+	0x7e, 0xd4, 0x6b, 0x68, 0x41, 0x42, 0x66, 0xaa, 0x8c, 0xac, 0xec, 0xf8, 0x6e, 0x2f, 0xc0, 0x61,
+	0x98, 0xcc, 0x75, 0xf1, 0xca, 0xae, 0x08, 0x08, 0x48, 0x58, 0xf5, 0xbf, 0x57, 0x40, 0x86, 0x44,
+	0x69, 0xcd, 0xb3, 0xdd, 0x7e, 0x9b, 0xa4, 0xfe, 0x4a, 0xd3, 0x83, 0x7d, 0xae, 0x67, 0xb2, 0x56,
+	0x5a, 0x31, 0xb2, 0x53, 0x7e, 0x86, 0xac, 0x31, 0x4f, 0xe3, 0x9e, 0x22, 0x5b, 0x54, 0x73, 0xb4,
+	0x24, 0xf9, 0xa1, 0x09, 0x4e, 0xfd, 0x4f, 0x0a, 0x68, 0xee, 0xd1, 0x3a, 0x8a, 0x7d, 0xd5, 0x51,
+	0xfc, 0x5a, 0x7e, 0x9f, 0x74, 0x80, 0x87, 0xf8, 0x77, 0xde, 0x52, 0x1e, 0x91, 0xba, 0x86, 0xc9,
+	0x11, 0x74, 0xd2, 0xb4, 0xd2, 0x0f, 0xa5, 0xf8, 0x4c, 0x72, 0x04, 0x5d, 0x82, 0x81, 0x82, 0x69,
+	0x1c, 0xa0, 0x6a, 0x84, 0xbb, 0x3d, 0xd7, 0x8a, 0x62, 0xb7, 0xee, 0xb5, 0x51, 0x3d, 0x94, 0x9c,
+	0x1c, 0xb3, 0xa1, 0xe2, 0x5f, 0x20, 0xd8, 0x18, 0x5d, 0x34, 0x19, 0xb2, 0x64, 0xea, 0xe1, 0x83,
+	0x08, 0x99, 0x1c, 0xe3, 0xd4, 0x6c, 0xaa, 0xba, 0xf9, 0x0f, 0x88, 0x79, 0x18, 0x5f, 0x47, 0xe5,
+	0xae, 0xe3, 0x39, 0x3e, 0xcd, 0x05, 0x9a, 0x7a, 0xe1, 0xad, 0x7c, 0xe7, 0xf9, 0xe2, 0x06, 0xa1,
+	0xcd, 0x8c, 0x14, 0xf1, 0xbd, 0x68, 0x1b, 0x30, 0xb6, 0xf4, 0xb0, 0xba, 0xcd, 0x63, 0x69, 0x66,
+	0x39, 0x97, 0xc3, 0xea, 0xba, 0x0c, 0x22, 0xda, 0xab, 0xda, 0x4a, 0x71, 0x33, 0x08, 0xfe, 0xc6,
+	0x3d, 0x54, 0xda, 0x75, 0x5c, 0x6c, 0x56, 0x72, 0x49, 0x74, 0xd2, 0xe5, 0xb8, 0xea, 0xb8, 0x98,
+	0xc9, 0x90, 0x1c, 0x55, 0x74, 0x5c, 0x0c, 0x94, 0x27, 0x7d, 0x11, 0x01, 0x0f, 0xfb, 0x98, 0x93,
+	0x63, 0x79, 0x11, 0x71, 0x54, 0x49, 0x7b, 0x11, 0x71, 0x33, 0x08, 0xfe, 0xc4, 0x4f, 0x27, 0x72,
+	0xe4, 0x58, 0x05, 0x81, 0xb7, 0x73, 0x96, 0x85, 0x67, 0x26, 0x31, 0x51, 0x84, 0x7f, 0x34, 0x95,
+	0x35, 0x77, 0x0f, 0x95, 0xac, 0xee, 0x41, 0xcf, 0xac, 0x8d, 0xe5, 0x8b, 0x2c, 0x77, 0x0f, 0x7a,
+	0xda, 0x17, 0x21, 0x67, 0x72, 0x81, 0xf2, 0x24, 0x53, 0x63, 0xdf, 0xda, 0xdd, 0xb7, 0x4c, 0x34,
+	0x96, 0xa9, 0x71, 0x83, 0xd0, 0xd6, 0xa6, 0x06, 0x6d, 0x03, 0xc6, 0x96, 0x3c, 0x7b, 0xf7, 0x20,
+	0x8a, 0xcc, 0xa9, 0xb1, 0x3c, 0xfb, 0xc6, 0x41, 0x14, 0x69, 0xcf, 0xbe, 0xb1, 0xb5, 0xbd, 0x0d,
+	0x94, 0x27, 0xe1, 0xed, 0x59, 0x51, 0x68, 0x4e, 0x8f, 0x85, 0xf7, 0xa6, 0x15, 0x85, 0x1a, 0xef,
+	0xcd, 0xe5, 0xed, 0x16, 0x50, 0x9e, 0xc6, 0x6d, 0x54, 0x0c, 0xbd, 0xd0, 0x9c, 0xa1, 0xac, 0x6f,
+	0xe5, 0xcc, 0xba, 0xe5, 0x71, 0xce, 0xc2, 0x13, 0xd8, 0xda, 0x6c, 0x01, 0x61, 0x48, 0xf9, 0x1e,
+	0x90, 0xd4, 0xa6, 0xb1, 0xf0, 0x3d, 0x48, 0xf1, 0xdd, 0x22, 0x7c, 0x0f, 0x42, 0x92, 0x4f, 0x52,
+	0xe9, 0xf5, 0x77, 0x5a, 0xfd, 0x1d, 0x73, 0x8e, 0xf2, 0xfe, 0x72, 0xce, 0xbc, 0x9b, 0x94, 0x38,
+	0x63, 0x2f, 0x4c, 0x20, 0xd6, 0x08, 0x9c, 0x33, 0x15, 0x82, 0x71, 0x35, 0xe7, 0xc7, 0x22, 0xc4,
+	0x35, 0x4a, 0x4d, 0x13, 0x82, 0x35, 0x02, 0xe7, 0x1c, 0x0b, 0xe1, 0x5a, 0x3b, 0xe6, 0xc2, 0xb8,
+	0x84, 0x70, 0xad, 0x0c, 0x21, 0x5c, 0x8b, 0x09, 0xe1, 0x5a, 0x3b, 0x64, 0xe8, 0xef, 0xb5, 0x77,
+	0x43, 0xd3, 0x18, 0xcb, 0xd0, 0xbf, 0xde, 0xde, 0xd5, 0x87, 0xfe, 0xf5, 0xc6, 0xd5, 0x16, 0x50,
+	0x9e, 0x44, 0xe5, 0x84, 0xae, 0x65, 0xef, 0x9b, 0x67, 0xc6, 0xa2, 0x72, 0x5a, 0x84, 0xb6, 0xa6,
+	0x72, 0x68, 0x1b, 0x30, 0xb6, 0xc6, 0xdf, 0x2a, 0xa0, 0x29, 0x7e, 0x12, 0xf8, 0x5a, 0xe0, 0xb4,
+	0xcd, 0xb3, 0xf9, 0xf8, 0x2f, 0x74, 0x31, 0x12, 0x0e, 0x4c, 0x18, 0xb1, 0x85, 0x96, 0x20, 0x20,
+	0x0b, 0x62, 0xfc, 0xa3, 0x02, 0x9a, 0xb5, 0x94, 0x63, 0xe7, 0xe6, 0x39, 0x2a, 0xdb, 0x4e, 0xde,
+	0x4b, 0x82, 0xc2, 0x84, 0x89, 0x27, 0xf2, 0xf0, 0x54, 0x20, 0x68, 0x12, 0xd1, 0xe1, 0x1b, 0x46,
+	0x81, 0xd3, 0xc3, 0xe6, 0xf9, 0xb1, 0x0c, 0xdf, 0x16, 0x25, 0xae, 0x0d, 0x5f, 0xd6, 0x08, 0x9c,
+	0x33, 0x5d, 0xba, 0x31, 0x73, 0x18, 0x99, 0x4f, 0x8c, 0x65, 0xe9, 0x8e, 0xdd, 0x51, 0xea, 0xd2,
+	0xcd, 0x5b, 0x21, 0x66, 0x4e, 0xc6, 0x72, 0x80, 0xdb, 0x4e, 0x68, 0x9a, 0x63, 0x19, 0xcb, 0x40,
+	0x68, 0x6b, 0x63, 0x99, 0xb6, 0x01, 0x63, 0x4b, 0xd4, 0xb9, 0x17, 0x1e, 0x98, 0x9f, 0x1e, 0x8b,
+	0x3a, 0xdf, 0x0c, 0x0f, 0x34, 0x75, 0xbe, 0xd9, 0xda, 0x02, 0xc2, 0x90, 0xab, 0x73, 0x37, 0xb4,
+	0x02, 0xf3, 0xc2, 0x98, 0xd4, 0x39, 0x21, 0x9e, 0x52, 0xe7, 0xa4, 0x11, 0x38, 0x67, 0x3a, 0x0a,
+	0x68, 0xc9, 0x33, 0xc7, 0x36, 0x3f, 0x33, 0x96, 0x51, 0x70, 0x8d, 0x51, 0xd7, 0x46, 0x01, 0x6f,
+	0x85, 0x98, 0x39, 0x39, 0x6e, 0x10, 0xe0, 0x9e, 0xeb, 0xd8, 0x56, 0x68, 0x7e, 0x96, 0x46, 0x99,
+	0xa6, 0x99, 0xcd, 0xc9, 0xda, 0x40, 0x40, 0x8d, 0x7f, 0x5a, 0x40, 0x73, 0x5a, 0x46, 0xb9, 0x79,
+	0x91, 0x8a, 0x6e, 0xe7, 0x2c, 0xfa, 0x8a, 0xca, 0x85, 0x3d, 0x82, 0x88, 0xb9, 0xe9, 0xb9, 0xbd,
+	0xba, 0x50, 0x24, 0x21, 0xb5, 0x26, 0xda, 0xcc, 0x4b, 0x54, 0xc4, 0xaf, 0x8e, 0x4b, 0x44, 0x26,
+	0x5c, 0x12, 0x99, 0x8b, 0xdb, 0x21, 0x11, 0x81, 0x6a, 0x6d, 0x3a, 0xe6, 0x59, 0xe8, 0xd9, 0xbc,
+	0x3c, 0x16, 0xad, 0x0d, 0x09, 0x07, 0x4d, 0x6b, 0x4b, 0x10, 0x90, 0x05, 0xa1, 0x9f, 0xd4, 0x52,
+	0x8f, 0x07, 0x9b, 0x4f, 0x8e, 0xe5, 0x93, 0xea, 0x87, 0x90, 0xd5, 0x4f, 0xaa, 0x41, 0x41, 0x17,
+	0xca, 0xf8, 0xe7, 0x05, 0xb4, 0x60, 0xe9, 0x45, 0x1b, 0xcc, 0x3f, 0x97, 0x4f, 0x64, 0x2f, 0x4b,
+	0x54, 0x99, 0x0f, 0x13, 0xf6, 0xd3, 0x5c, 0xd8, 0x85, 0x14, 0x1c, 0xd2, 0xa2, 0x11, 0x23, 0x25,
+	0xdc, 0x8d, 0x7a, 0x66, 0x7d, 0x2c, 0x46, 0x4a, 0x6b, 0x37, 0xd2, 0xf7, 0x45, 0xad, 0xab, 0x24,
+	0xa3, 0x89, 0xf0, 0x64, 0x56, 0x1a, 0x0e, 0x02, 0x27, 0x32, 0x9f, 0x1a, 0x8f, 0x95, 0x46, 0x89,
+	0xeb, 0x56, 0x1a, 0x6d, 0x04, 0xce, 0xd9, 0xf8, 0x55, 0x92, 0x33, 0xdf, 0xf5, 0x23, 0x1c, 0x7b,
+	0x6f, 0xcc, 0x3f, 0x4f, 0xbd, 0x25, 0x5f, 0x1a, 0xda, 0x03, 0x0b, 0x0a, 0x19, 0x96, 0xc0, 0xae,
+	0xb6, 0x81, 0xc6, 0xca, 0xf8, 0x06, 0x49, 0xbf, 0xa2, 0xae, 0xbd, 0xd0, 0xfc, 0x5c, 0x2e, 0x19,
+	0x90, 0x69, 0xa7, 0xa1, 0x9c, 0xd1, 0xc5, 0x58, 0x81, 0x60, 0x6a, 0xfc, 0xd5, 0x02, 0x9a, 0xee,
+	0x5a, 0x77, 0x85, 0xc3, 0xdb, 0x7c, 0x3a, 0x97, 0x83, 0x6c, 0xaa, 0x03, 0x9d, 0xd5, 0xac, 0xdb,
+	0x90, 0xd8, 0x80, 0xc2, 0xd4, 0xc0, 0x68, 0xb2, 0x8b, 0xa3, 0xc0, 0xb1, 0x43, 0xf3, 0xe7, 0x28,
+	0xff, 0x57, 0x87, 0x7e, 0xf9, 0x1b, 0xac, 0xbf, 0x5c, 0x20, 0x8e, 0x37, 0x41, 0x4c, 0xdb, 0xf8,
+	0xfb, 0x05, 0x34, 0x83, 0xe5, 0xf0, 0xb8, 0xf9, 0x4c, 0x2e, 0x87, 0x92, 0x53, 0x76, 0x8d, 0x12,
+	0x82, 0xa7, 0xa3, 0x4f, 0xa4, 0x58, 0x2b, 0x30, 0x50, 0xc5, 0xa1, 0x8b, 0xed, 0x7b, 0xd8, 0xdb,
+	0x77, 0xbc, 0xd0, 0x7c, 0x76, 0x2c, 0x8b, 0xed, 0x6b, 0x8c, 0xba, 0xb6, 0xd8, 0xf2, 0x56, 0x88,
+	0x99, 0xb3, 0x1d, 0xac, 0x6b, 0x7e, 0x7e, 0x4c, 0x3b, 0x58, 0x37, 0xb5, 0x83, 0x5d, 0x27, 0x3b,
+	0x58, 0x97, 0xea, 0xf9, 0xb6, 0x9a, 0xfe, 0x64, 0x3e, 0x37, 0x16, 0x3d, 0xaf, 0x27, 0x59, 0xa9,
+	0x7a, 0x5e, 0x83, 0x82, 0x2e, 0x14, 0x29, 0x86, 0x35, 0xdf, 0x56, 0x13, 0x36, 0x43, 0xf3, 0xe7,
+	0x9f, 0x2c, 0xe6, 0x10, 0xe1, 0xd0, 0xf3, 0x40, 0x45, 0x46, 0x9e, 0x06, 0x08, 0x21, 0x25, 0x01,
+	0x1d, 0x40, 0x36, 0x0b, 0x34, 0x9a, 0xcf, 0x8f, 0x65, 0x00, 0xc9, 0x61, 0xcc, 0x64, 0x00, 0xf1,
+	0x56, 0x88, 0x99, 0x93, 0x23, 0x9f, 0xa8, 0x13, 0xf4, 0x6c, 0x6e, 0x48, 0x2c, 0x52, 0x59, 0xde,
+	0xc9, 0x5b, 0xbd, 0x0b, 0x06, 0x4c, 0x1c, 0x11, 0x54, 0xb9, 0x06, 0xcd, 0x55, 0x06, 0x00, 0x49,
+	0x8a, 0x0b, 0x7d, 0x84, 0x12, 0x37, 0x72, 0x46, 0x14, 0x77, 0x4b, 0x8e, 0xe2, 0x8e, 0x16, 0x83,
+	0x93, 0x42, 0xc0, 0x17, 0xbe, 0x5b, 0x40, 0x33, 0x8a, 0xeb, 0x38, 0x83, 0xf5, 0x9e, 0xca, 0x1a,
+	0xf2, 0x3f, 0x97, 0x24, 0x4b, 0xf4, 0xeb, 0x05, 0x54, 0x13, 0x4e, 0xe4, 0x0c, 0x69, 0xda, 0xaa,
+	0x34, 0xa3, 0x8e, 0x68, 0xca, 0x2a, 0x5b, 0x12, 0xf2, 0x6e, 0x14, 0x6f, 0xf2, 0xf8, 0xdf, 0x8d,
+	0x60, 0x97, 0x2d, 0xd1, 0x07, 0x05, 0x34, 0x2d, 0xfb, 0x94, 0x33, 0x04, 0xea, 0xa8, 0x02, 0x6d,
+	0xe5, 0x73, 0xea, 0xfb, 0x88, 0x6f, 0x25, 0xdc, 0xcb, 0xe3, 0xff, 0x56, 0x5a, 0x81, 0x5e, 0x59,
+	0x92, 0xf7, 0x0b, 0x08, 0x25, 0xbe, 0xe6, 0x0c, 0x51, 0xb0, 0x2a, 0xca, 0xa8, 0x07, 0xd9, 0x18,
+	0xaf, 0xc1, 0x6f, 0x45, 0x38, 0x9e, 0xc7, 0xff, 0x56, 0x88, 0x43, 0x7b, 0x80, 0x24, 0xbf, 0x51,
+	0x40, 0x35, 0xe1, 0x86, 0x1e, 0xff, 0x4b, 0x21, 0xee, 0x6d, 0x2a, 0x49, 0x98, 0x16, 0xe5, 0xd7,
+	0x0a, 0xa8, 0xda, 0xf2, 0x06, 0x4a, 0x62, 0xab, 0x92, 0x8c, 0x6a, 0xe3, 0xb5, 0x36, 0x5b, 0x03,
+	0x5e, 0x09, 0x95, 0xe3, 0xe0, 0x91, 0xc9, 0xb1, 0x35, 0x48, 0x8e, 0x6f, 0x17, 0xd0, 0x94, 0xe4,
+	0xb2, 0xce, 0x10, 0x65, 0x57, 0x15, 0x65, 0xd4, 0x44, 0x01, 0xce, 0x6c, 0xb0, 0x34, 0x92, 0xef,
+	0x7a, 0xfc, 0xd2, 0x70, 0x66, 0x47, 0x4a, 0xe3, 0x5a, 0x8f, 0x50, 0x1a, 0xc2, 0x6c, 0xf0, 0x74,
+	0x16, 0x0e, 0xed, 0xf1, 0x4f, 0x67, 0xe2, 0x28, 0x3f, 0x42, 0xc9, 0x25, 0xde, 0xed, 0xf1, 0xcf,
+	0x67, 0xc6, 0x2b, 0x5b, 0x96, 0xdf, 0x2c, 0xa0, 0x79, 0xdd, 0xc5, 0x9d, 0x21, 0xd1, 0xbe, 0x2a,
+	0xd1, 0xa8, 0xc7, 0x14, 0x65, 0x8e, 0xd9, 0x72, 0xfd, 0x76, 0x01, 0x9d, 0xc9, 0x70, 0x6f, 0x67,
+	0x88, 0xe6, 0xa9, 0xa2, 0xbd, 0x39, 0xae, 0x7a, 0xb1, 0xfa, 0xc8, 0x96, 0xfc, 0xdb, 0xe3, 0x1f,
+	0xd9, 0x9c, 0xd9, 0x60, 0x73, 0x42, 0xf6, 0x73, 0x8f, 0xdf, 0x9c, 0x48, 0x27, 0x79, 0xea, 0xe3,
+	0x3b, 0xf1, 0x78, 0x8f, 0x7f, 0x7c, 0x33, 0x5e, 0x83, 0xd7, 0x89, 0xd8, 0xff, 0x3d, 0xfe, 0x75,
+	0x62, 0xb3, 0xb5, 0x75, 0xe4, 0x3a, 0x21, 0x7c, 0xe1, 0x8f, 0x62, 0x9d, 0xa0, 0xcc, 0x06, 0x8f,
+	0x18, 0xd9, 0x27, 0x3e, 0xfe, 0x11, 0x13, 0x73, 0xcb, 0x96, 0xe7, 0x07, 0x05, 0xa9, 0x54, 0x9c,
+	0xe4, 0xe8, 0xce, 0x90, 0xcb, 0x57, 0xe5, 0x7a, 0x6b, 0x6c, 0x05, 0x56, 0x64, 0xf9, 0x3e, 0x2c,
+	0xa0, 0x59, 0xd5, 0xcb, 0x9d, 0x21, 0x99, 0xa3, 0x4a, 0xd6, 0x1a, 0x43, 0x19, 0x3a, 0x5d, 0x73,
+	0xeb, 0x6e, 0xee, 0xf1, 0x6b, 0x6e, 0x99, 0xe3, 0xe0, 0x6f, 0x99, 0xe5, 0xe1, 0x1e, 0xff, 0xb7,
+	0x1c, 0x5c, 0xdc, 0x53, 0x96, 0xef, 0x1f, 0x16, 0xd0, 0xf9, 0x6c, 0xb7, 0x76, 0x86, 0x84, 0x07,
+	0xaa, 0x84, 0x6f, 0x8f, 0xb1, 0xd6, 0xb2, 0x6e, 0xab, 0x08, 0xbf, 0xf6, 0xf8, 0x6d, 0x15, 0xe2,
+	0x2f, 0x3f, 0xca, 0x86, 0x4b, 0x5c, 0xdc, 0x8f, 0xc0, 0x86, 0x63, 0xcc, 0xb2, 0xa5, 0xf9, 0xbb,
+	0x24, 0x65, 0x35, 0xe5, 0xf9, 0xcc, 0x10, 0xaa, 0xab, 0x0a, 0x75, 0x6b, 0x4c, 0x07, 0x9e, 0x74,
+	0x9d, 0x2a, 0xbb, 0x3e, 0xc7, 0xaf, 0x53, 0x63, 0x6e, 0x47, 0xed, 0x90, 0xdc, 0x47, 0xb6, 0x43,
+	0x5a, 0x3f, 0x42, 0x1f, 0x64, 0x79, 0x42, 0xc7, 0xaf, 0x0f, 0x06, 0x1f, 0x72, 0x95, 0xe5, 0x23,
+	0x25, 0x1f, 0x95, 0x83, 0x13, 0x63, 0x1f, 0xe2, 0xa9, 0xb3, 0x1a, 0xb2, 0x38, 0xdf, 0x2f, 0xa0,
+	0x39, 0xcd, 0xe9, 0x98, 0x21, 0xd1, 0x7b, 0xaa, 0x44, 0xdb, 0xa3, 0x4e, 0x3a, 0xe1, 0xcc, 0xcc,
+	0x7e, 0x49, 0xf5, 0x3f, 0x2c, 0x29, 0x59, 0xe7, 0xbc, 0x90, 0xcc, 0xbb, 0x22, 0x09, 0x9e, 0x25,
+	0x63, 0xff, 0xe2, 0xf0, 0xde, 0xcc, 0x23, 0x73, 0xdd, 0x8d, 0xaf, 0xa3, 0x5a, 0x9c, 0xef, 0x1a,
+	0x67, 0x65, 0x6f, 0xe4, 0xe4, 0xb6, 0xe4, 0x9c, 0x45, 0xb0, 0x3a, 0x6e, 0x0f, 0x21, 0x61, 0x49,
+	0xaa, 0xc3, 0xf1, 0xe4, 0x4e, 0x5a, 0xe5, 0x8e, 0x97, 0xb6, 0x2b, 0xaa, 0x37, 0x14, 0xdc, 0x4a,
+	0x61, 0x40, 0x46, 0x2f, 0xe3, 0x9f, 0x15, 0xd0, 0x39, 0xb9, 0x19, 0xfc, 0x88, 0x1e, 0x53, 0x09,
+	0x79, 0x36, 0x73, 0x2b, 0x1f, 0x17, 0x9f, 0x42, 0x7b, 0xe5, 0x22, 0x17, 0xf2, 0x5c, 0x16, 0x34,
+	0x84, 0x6c, 0x81, 0x8c, 0x0e, 0x9a, 0x0c, 0x70, 0x24, 0x95, 0x51, 0xfc, 0xe5, 0x13, 0x04, 0x2a,
+	0xa3, 0xe0, 0x90, 0xbf, 0xe3, 0xa4, 0xa2, 0x00, 0x23, 0x0a, 0x31, 0xf5, 0xfa, 0x97, 0xd1, 0xd9,
+	0xac, 0x83, 0x52, 0xc6, 0x05, 0x34, 0xf1, 0xde, 0x01, 0x4f, 0x7d, 0x47, 0xbc, 0xf7, 0xc4, 0x6b,
+	0x5b, 0x30, 0xf1, 0xde, 0x01, 0x3b, 0xa0, 0x14, 0x38, 0xbd, 0x28, 0x7d, 0x40, 0x89, 0xb4, 0x02,
+	0x87, 0xd6, 0xff, 0x7d, 0x19, 0xcd, 0x69, 0x5e, 0x61, 0x51, 0xf9, 0x88, 0x5e, 0xee, 0x96, 0x55,
+	0xf9, 0x88, 0x00, 0x20, 0xc1, 0x31, 0x3e, 0x2c, 0xa0, 0xb9, 0x3b, 0x56, 0x64, 0xef, 0x35, 0xad,
+	0x68, 0x8f, 0x05, 0xfe, 0x72, 0x5a, 0x73, 0x6f, 0xa9, 0x54, 0x93, 0xb8, 0x90, 0x06, 0x00, 0x9d,
+	0x3f, 0xa9, 0xd8, 0x40, 0x0e, 0x47, 0x93, 0x1a, 0xf5, 0x45, 0xb5, 0x18, 0x52, 0x93, 0x35, 0x43,
+	0x0c, 0x57, 0x6f, 0x57, 0x2b, 0xe5, 0x92, 0xa7, 0xad, 0xbd, 0xd2, 0x13, 0x1d, 0xee, 0x2b, 0x9f,
+	0xda, 0xe1, 0xbe, 0xca, 0x63, 0x77, 0xb8, 0xef, 0xff, 0x55, 0xd0, 0xb9, 0x4c, 0xf5, 0x7c, 0x8c,
+	0x5a, 0x01, 0xf4, 0x56, 0x00, 0xbd, 0x56, 0x00, 0xbd, 0x35, 0x00, 0x18, 0x2c, 0x3e, 0x57, 0x5a,
+	0xcc, 0xbf, 0xce, 0xbf, 0xe3, 0x85, 0xd8, 0xee, 0x07, 0x58, 0xbf, 0xf3, 0x64, 0x8d, 0xb7, 0x83,
+	0xc0, 0x20, 0x85, 0xd3, 0xad, 0x7e, 0xb4, 0xc7, 0xb5, 0x6b, 0x79, 0xe8, 0xc2, 0xe9, 0xcb, 0xa2,
+	0x33, 0x48, 0x84, 0x4e, 0xfb, 0x80, 0xef, 0xf7, 0xd2, 0xb7, 0x17, 0xec, 0x8c, 0x63, 0x99, 0x7e,
+	0xcc, 0x2e, 0x2e, 0xa8, 0x3d, 0x76, 0x33, 0xf0, 0x0f, 0xcb, 0xc8, 0x48, 0xbb, 0x2f, 0x1e, 0x36,
+	0xfd, 0x9e, 0x46, 0x15, 0x3b, 0x59, 0x2f, 0xa4, 0x65, 0x8a, 0xab, 0x75, 0x0e, 0x55, 0xa6, 0x4a,
+	0xf1, 0xa1, 0x53, 0x65, 0xb8, 0xcb, 0x84, 0x3e, 0x48, 0x57, 0xa3, 0x7c, 0x37, 0x77, 0x3f, 0xce,
+	0x10, 0xe3, 0x4f, 0x9d, 0xe8, 0x95, 0xbc, 0x26, 0xfa, 0x47, 0xe1, 0xea, 0xa1, 0xea, 0x63, 0x37,
+	0xac, 0xef, 0x4f, 0xa2, 0x85, 0xd4, 0x66, 0xfb, 0x94, 0xea, 0x8d, 0x3f, 0x87, 0xaa, 0xe4, 0xaf,
+	0x74, 0x2b, 0x8e, 0x18, 0x46, 0xd7, 0x79, 0x3b, 0x08, 0x0c, 0xa9, 0xac, 0x76, 0x71, 0x60, 0x59,
+	0xed, 0x37, 0x95, 0xfb, 0x10, 0xf2, 0xbc, 0xa8, 0xf3, 0x15, 0x34, 0xc3, 0xd2, 0xfa, 0xe2, 0x02,
+	0xd4, 0x65, 0xb5, 0xfa, 0xef, 0x35, 0x19, 0x08, 0x2a, 0xee, 0x80, 0x72, 0xd3, 0x95, 0x13, 0x95,
+	0x9b, 0xfe, 0x4e, 0x7a, 0x81, 0x79, 0x27, 0x6f, 0xe7, 0xcb, 0x10, 0x93, 0x5b, 0xae, 0xd5, 0x5e,
+	0x3d, 0xb2, 0x56, 0x3b, 0xa9, 0x3d, 0x14, 0xba, 0x6f, 0xe0, 0xc0, 0xd9, 0x65, 0x65, 0x73, 0xa4,
+	0x12, 0xc8, 0xad, 0x18, 0x00, 0x09, 0xce, 0x27, 0x65, 0x21, 0x4e, 0x34, 0xc1, 0xff, 0x63, 0x01,
+	0xcd, 0xb2, 0xf8, 0xec, 0x72, 0xaf, 0xb7, 0x1a, 0xe0, 0x76, 0x48, 0x14, 0x70, 0x2f, 0x70, 0x6e,
+	0x5b, 0x11, 0x8e, 0x0b, 0x3e, 0x0f, 0xa7, 0x80, 0x9b, 0xa2, 0x33, 0x48, 0x84, 0x88, 0xa9, 0x69,
+	0xf5, 0x7a, 0x6b, 0x0d, 0x73, 0x42, 0x2d, 0x5e, 0xb0, 0x4c, 0x1a, 0x81, 0xc1, 0x48, 0xe1, 0x68,
+	0xc7, 0x0b, 0x23, 0xcb, 0x75, 0xe9, 0x2e, 0x73, 0xad, 0x41, 0x97, 0xbb, 0x62, 0x72, 0x60, 0x65,
+	0x4d, 0x81, 0x82, 0x86, 0x5d, 0xff, 0x27, 0xb3, 0x68, 0x21, 0x15, 0x6e, 0x26, 0x3b, 0x45, 0xa7,
+	0xcd, 0x8b, 0x26, 0x88, 0x9d, 0xe2, 0x5a, 0x03, 0x26, 0x9c, 0xb6, 0xac, 0xcb, 0x26, 0x1e, 0x9d,
+	0x2e, 0x13, 0x37, 0x9f, 0x14, 0x8f, 0x7b, 0xf3, 0x49, 0x52, 0x21, 0xdb, 0x2c, 0x0d, 0xba, 0x6a,
+	0x21, 0xa9, 0xaa, 0x0d, 0x12, 0xfe, 0xb1, 0xae, 0x62, 0xb9, 0x89, 0xaa, 0x56, 0xcf, 0x61, 0x15,
+	0xff, 0x2b, 0x43, 0x57, 0xce, 0x59, 0x6e, 0xae, 0xd1, 0xae, 0x20, 0x88, 0xa4, 0x6b, 0xfd, 0x4f,
+	0xe6, 0x5b, 0xeb, 0x5f, 0x36, 0x89, 0xaa, 0x0f, 0x35, 0x89, 0x9e, 0x46, 0x15, 0xcb, 0x8e, 0xc8,
+	0xed, 0xaf, 0x35, 0xf5, 0x3e, 0xd7, 0x65, 0xda, 0x0a, 0x1c, 0xca, 0xaf, 0xcb, 0x8f, 0xe2, 0xdd,
+	0x3f, 0x4a, 0x5d, 0x97, 0x1f, 0x83, 0x40, 0xc6, 0xa3, 0xea, 0x9e, 0x0e, 0x9a, 0x58, 0xdd, 0x4f,
+	0x69, 0xea, 0x5e, 0x06, 0x82, 0x8a, 0x4b, 0x8a, 0xa6, 0xb1, 0x86, 0xd7, 0x7b, 0xae, 0x6f, 0xb5,
+	0x49, 0xf7, 0x69, 0x75, 0x54, 0x5c, 0x53, 0xc1, 0xa0, 0xe3, 0x0f, 0x58, 0x31, 0x66, 0x46, 0x5f,
+	0x31, 0x66, 0xf3, 0x59, 0x31, 0xf4, 0x19, 0x39, 0xc4, 0x8a, 0xf1, 0x2d, 0xfd, 0xce, 0x0e, 0x76,
+	0xa2, 0x74, 0x54, 0xed, 0x4e, 0xa6, 0x57, 0x5b, 0xbe, 0x95, 0xe3, 0x58, 0x77, 0x75, 0xfc, 0x22,
+	0x9a, 0xf1, 0x83, 0x8e, 0xe5, 0x39, 0xf7, 0xb8, 0x57, 0x6e, 0x9e, 0x4e, 0x28, 0x3a, 0x5a, 0x6f,
+	0xca, 0x00, 0x50, 0xf1, 0x8c, 0x7b, 0xa8, 0xd6, 0x89, 0xb5, 0xac, 0xb9, 0x90, 0x8b, 0x9e, 0x51,
+	0xb5, 0x36, 0x5b, 0x1f, 0x44, 0x1b, 0x24, 0xec, 0xa4, 0x85, 0xd1, 0x38, 0xb5, 0x85, 0xf1, 0xcc,
+	0x69, 0x14, 0x56, 0xff, 0xbd, 0x02, 0x7a, 0x22, 0xc0, 0x1d, 0x27, 0x8c, 0x58, 0xad, 0x1f, 0xa9,
+	0xee, 0x8e, 0x79, 0x76, 0x7c, 0x25, 0x7d, 0x3e, 0x43, 0xee, 0xb6, 0x83, 0x6c, 0xbe, 0x30, 0x48,
+	0x20, 0xf5, 0xca, 0x87, 0x73, 0xe3, 0xbe, 0xf2, 0xe1, 0x7f, 0x20, 0xb4, 0x90, 0x4a, 0x84, 0x3a,
+	0x25, 0xbb, 0xfe, 0x97, 0x50, 0x8d, 0x5b, 0x7d, 0xdc, 0x38, 0xa8, 0xad, 0x7c, 0x86, 0x3f, 0xf9,
+	0x99, 0xd4, 0x35, 0x42, 0x6b, 0x0d, 0x48, 0xb0, 0x8f, 0x69, 0xe4, 0x2b, 0xd7, 0xd9, 0x94, 0xf2,
+	0xbb, 0xce, 0xa6, 0x85, 0xce, 0xb1, 0x82, 0xf2, 0xad, 0xd6, 0x3a, 0x35, 0x42, 0x1d, 0x9b, 0xd5,
+	0x93, 0x67, 0x17, 0xf3, 0x0a, 0xb7, 0xfa, 0x95, 0x2c, 0x24, 0xc8, 0xee, 0xcb, 0x97, 0x12, 0xd7,
+	0x12, 0x4b, 0x49, 0x25, 0xb5, 0x94, 0xb8, 0x96, 0xb2, 0x94, 0x24, 0x3f, 0x07, 0xac, 0x03, 0xd5,
+	0xd1, 0xd7, 0x81, 0x5a, 0x5e, 0xeb, 0x80, 0x6b, 0x9d, 0x70, 0x1d, 0x90, 0x77, 0x0e, 0xe8, 0xc8,
+	0x9d, 0xc3, 0x9b, 0x68, 0x8a, 0x55, 0x2e, 0x66, 0x1f, 0x7c, 0x6a, 0xe8, 0x0f, 0xde, 0x4a, 0x7a,
+	0x83, 0x4c, 0xea, 0x23, 0x51, 0x8f, 0xf2, 0x34, 0x6a, 0xd9, 0x92, 0x79, 0xd6, 0x09, 0xfc, 0x7e,
+	0x8f, 0xd5, 0xb0, 0xe0, 0xf3, 0xec, 0x1a, 0x6d, 0x01, 0x0e, 0x39, 0x52, 0xdb, 0xce, 0x7d, 0xa4,
+	0xb5, 0xed, 0xfc, 0xb8, 0xb5, 0xed, 0x3f, 0x40, 0x68, 0x4e, 0x4b, 0xf5, 0xcc, 0x0c, 0x1a, 0x15,
+	0x4e, 0x39, 0x68, 0xf4, 0x24, 0x2a, 0x45, 0x87, 0x3d, 0xfe, 0x00, 0xc9, 0x49, 0x49, 0x6a, 0xef,
+	0x52, 0x48, 0xfa, 0x56, 0xa3, 0xe2, 0x10, 0xb7, 0x1a, 0xfd, 0x3c, 0xaa, 0x59, 0xed, 0x76, 0x80,
+	0xc3, 0x10, 0xc7, 0x37, 0xb5, 0xb1, 0x32, 0xe6, 0x71, 0x23, 0x24, 0x70, 0xea, 0xed, 0x69, 0xef,
+	0x86, 0xa4, 0xc8, 0xa7, 0x5e, 0x10, 0x9e, 0xbc, 0x4a, 0xd2, 0x0e, 0x02, 0xc3, 0x68, 0xa3, 0xb9,
+	0xfd, 0x60, 0x67, 0x75, 0xd5, 0xb2, 0xf7, 0xf0, 0x49, 0x3c, 0x87, 0x67, 0xc8, 0xfb, 0xb9, 0xa1,
+	0x52, 0x00, 0x9d, 0x24, 0xe7, 0x72, 0x03, 0x1f, 0x46, 0xd6, 0xce, 0x49, 0x76, 0x35, 0x31, 0x17,
+	0x99, 0x02, 0xe8, 0x24, 0xc9, 0x1e, 0x64, 0x3f, 0xd8, 0x89, 0xab, 0x9b, 0x9a, 0x55, 0x75, 0x0f,
+	0x72, 0x23, 0x01, 0x81, 0x8c, 0x47, 0x5e, 0xd8, 0x7e, 0xb0, 0x03, 0xd8, 0x72, 0xbb, 0x66, 0x4d,
+	0x7d, 0x61, 0x37, 0x78, 0x3b, 0x08, 0x0c, 0xa3, 0x87, 0x0c, 0xf2, 0x74, 0xf4, 0xbb, 0x8b, 0x79,
+	0x65, 0xa2, 0x21, 0x2b, 0xb9, 0x9d, 0x27, 0xeb, 0xc9, 0x8d, 0x14, 0x1d, 0xc8, 0xa0, 0x4d, 0xee,
+	0x05, 0xde, 0x0f, 0x76, 0x78, 0xe6, 0x55, 0x33, 0x70, 0x3c, 0xdb, 0xe9, 0x59, 0xac, 0x5e, 0xec,
+	0x94, 0x7a, 0x2f, 0xf0, 0x8d, 0x6c, 0x34, 0x18, 0xd4, 0x5f, 0x8d, 0x60, 0x4e, 0xe7, 0x12, 0xc1,
+	0xd4, 0xa6, 0xeb, 0x27, 0x05, 0xcf, 0xc7, 0xec, 0x87, 0xfa, 0xf5, 0x22, 0x3a, 0x93, 0x71, 0xf9,
+	0xc4, 0xc3, 0x02, 0x28, 0xdf, 0x2a, 0xa0, 0xc9, 0x3d, 0x6c, 0xb5, 0xb1, 0x48, 0xfd, 0x78, 0x37,
+	0xff, 0x1b, 0x30, 0x16, 0xaf, 0x33, 0x0e, 0xda, 0x59, 0x43, 0xde, 0x0a, 0xb1, 0x00, 0xc6, 0x17,
+	0x48, 0xa9, 0x19, 0x2b, 0xea, 0x87, 0xab, 0x7e, 0x9b, 0x5f, 0x1f, 0x56, 0xe6, 0x16, 0x45, 0xd2,
+	0x0c, 0x32, 0x4e, 0x1c, 0x5a, 0x2d, 0xe5, 0x1b, 0x5a, 0xbd, 0xf0, 0x32, 0x9a, 0x96, 0x65, 0x1e,
+	0xee, 0x9e, 0xec, 0x32, 0x32, 0xd2, 0x49, 0x63, 0xa7, 0xb4, 0x37, 0xb8, 0x4a, 0xe2, 0xd3, 0x43,
+	0xdf, 0x94, 0x5d, 0x63, 0x21, 0x6c, 0x62, 0xbf, 0xb1, 0xee, 0xc6, 0x67, 0x51, 0xe9, 0x3d, 0x7f,
+	0x27, 0xde, 0x26, 0x50, 0x6f, 0xfd, 0x6b, 0xfe, 0x4e, 0x08, 0xb4, 0x95, 0x98, 0x37, 0xbd, 0x3d,
+	0x2b, 0x59, 0x95, 0xe8, 0x04, 0x6b, 0xd2, 0x16, 0xe0, 0x90, 0x71, 0x84, 0xc9, 0xd2, 0x6f, 0xf9,
+	0x44, 0x6a, 0xa6, 0x72, 0x6a, 0x6a, 0x66, 0xf2, 0xb1, 0x53, 0x33, 0xe4, 0x9e, 0x74, 0x7a, 0x9c,
+	0x6f, 0xd5, 0xf7, 0xc2, 0x7e, 0x17, 0x07, 0xd4, 0x88, 0x25, 0xe6, 0x20, 0xb5, 0x62, 0xb3, 0x2e,
+	0x3b, 0xbb, 0x16, 0x03, 0x20, 0xc1, 0x21, 0xfe, 0x44, 0xdf, 0x6d, 0x63, 0x71, 0xab, 0x90, 0xf0,
+	0x27, 0xde, 0xa4, 0xad, 0xc0, 0xa1, 0xc6, 0x35, 0xb4, 0x10, 0xe0, 0x1d, 0xcb, 0xb5, 0x3c, 0x1b,
+	0xb7, 0xa2, 0xc0, 0x8a, 0x70, 0x27, 0xbe, 0x72, 0x41, 0x94, 0xc7, 0x00, 0x1d, 0x01, 0xd2, 0x7d,
+	0xea, 0x7f, 0x3c, 0x8d, 0xe6, 0xf5, 0x73, 0x88, 0x0f, 0x53, 0x8e, 0x4b, 0xa8, 0xd6, 0xb3, 0x82,
+	0xc8, 0x91, 0xee, 0x38, 0x13, 0x4f, 0xd5, 0x8c, 0x01, 0x90, 0xe0, 0x24, 0xd9, 0x20, 0xc5, 0x23,
+	0xb2, 0x41, 0x32, 0x33, 0x26, 0x4a, 0x8f, 0x2c, 0x63, 0x82, 0x6b, 0xcc, 0x72, 0xfe, 0xc9, 0x28,
+	0x22, 0x66, 0x5e, 0x79, 0x68, 0xcc, 0xfc, 0xdb, 0xe9, 0xa8, 0xda, 0x57, 0x73, 0x3e, 0x64, 0x3a,
+	0x9c, 0x8b, 0x74, 0xc6, 0x96, 0xc7, 0xb3, 0x59, 0xcd, 0x25, 0x75, 0x38, 0x3d, 0x51, 0x98, 0xa7,
+	0x53, 0x69, 0x02, 0x95, 0xb5, 0xd1, 0x44, 0x67, 0x5d, 0xa7, 0xcb, 0xe3, 0x83, 0x61, 0x13, 0x07,
+	0x2d, 0x6c, 0xfb, 0x5e, 0x9b, 0x9a, 0xa4, 0xc5, 0x24, 0x68, 0xb1, 0x9e, 0x81, 0x03, 0x99, 0x3d,
+	0x49, 0x2a, 0x1b, 0xad, 0x57, 0xed, 0x7b, 0xdc, 0x1f, 0x2f, 0x56, 0xe0, 0x37, 0x58, 0x33, 0xc4,
+	0x70, 0xe3, 0x2d, 0x54, 0x0a, 0xad, 0xd0, 0x35, 0xa7, 0x4e, 0x7a, 0x6e, 0x7e, 0xb9, 0xb5, 0xce,
+	0x87, 0x07, 0x5d, 0x23, 0xc8, 0x6f, 0xa0, 0x24, 0x3f, 0xbe, 0x7b, 0xff, 0x24, 0x47, 0x65, 0xe6,
+	0xc8, 0x1c, 0x95, 0x0f, 0x0a, 0x68, 0x86, 0x59, 0x42, 0xec, 0x19, 0xf2, 0xf2, 0xd4, 0xd3, 0x51,
+	0x78, 0x5d, 0x22, 0x9c, 0xec, 0x36, 0xe5, 0xd6, 0x10, 0x54, 0xee, 0xc6, 0xef, 0x17, 0xd0, 0x3c,
+	0x6b, 0xb9, 0x72, 0x37, 0xc2, 0x5e, 0x28, 0xfc, 0xf5, 0xa3, 0x17, 0x40, 0x4a, 0xcd, 0xd5, 0xeb,
+	0x1a, 0x1f, 0x36, 0x67, 0x45, 0xc1, 0x0c, 0x1d, 0x0c, 0x29, 0xc1, 0x46, 0x5a, 0xd5, 0x2e, 0xac,
+	0xa2, 0x73, 0x99, 0x12, 0x0c, 0xb5, 0x34, 0x7e, 0x1d, 0x2d, 0xa4, 0x5e, 0xb5, 0x71, 0x51, 0x22,
+	0x90, 0xac, 0x30, 0x24, 0xb4, 0x4b, 0xa9, 0xd5, 0x51, 0x85, 0x12, 0x60, 0xc6, 0x37, 0x37, 0x9c,
+	0xde, 0xa0, 0x2d, 0xc0, 0x21, 0x64, 0xfc, 0x78, 0xb8, 0x63, 0x45, 0x71, 0xe6, 0x92, 0x18, 0x3f,
+	0x9b, 0xb4, 0x15, 0x38, 0xb4, 0xfe, 0xc3, 0x1a, 0x9a, 0xd3, 0x8e, 0xb7, 0xe7, 0x92, 0xbd, 0xf8,
+	0x1c, 0xaa, 0xda, 0xae, 0x83, 0xbd, 0x68, 0xad, 0xcd, 0xd7, 0xb5, 0xa4, 0x60, 0x32, 0x6b, 0x6f,
+	0x80, 0xc0, 0x38, 0xed, 0xd5, 0x4d, 0x5e, 0x86, 0xca, 0xc7, 0xbd, 0xf0, 0xa3, 0x92, 0xf3, 0x5a,
+	0xf8, 0xad, 0xf4, 0xea, 0xf6, 0x95, 0x7c, 0xeb, 0x16, 0x3c, 0x66, 0xe9, 0x88, 0xe8, 0x34, 0xf4,
+	0x6e, 0x9c, 0x9c, 0x54, 0xcb, 0x3d, 0x39, 0xe9, 0x22, 0x2a, 0x1e, 0xf8, 0x21, 0x5d, 0x24, 0xcb,
+	0xc9, 0xac, 0xda, 0xf2, 0x5b, 0x40, 0xda, 0x8d, 0x1f, 0x14, 0x90, 0x11, 0xee, 0x59, 0x01, 0x6e,
+	0xb7, 0xfa, 0x3b, 0xc9, 0xdd, 0x20, 0xd3, 0xb9, 0x1c, 0x0d, 0x24, 0x03, 0xa1, 0x95, 0x22, 0xce,
+	0x1c, 0x49, 0xe9, 0x76, 0xc8, 0x10, 0x84, 0xd8, 0xd4, 0xe2, 0xe2, 0xbb, 0xa8, 0xc5, 0x6f, 0x33,
+	0x60, 0xb1, 0x6e, 0x61, 0x53, 0x37, 0x75, 0x04, 0x48, 0xf7, 0x19, 0x6d, 0x27, 0xf1, 0x45, 0x74,
+	0x3e, 0xfb, 0x59, 0x88, 0x56, 0xa2, 0x1b, 0x05, 0xfd, 0x4a, 0x49, 0x66, 0x2e, 0x31, 0x58, 0xfd,
+	0x3f, 0x4c, 0xa0, 0x2a, 0x29, 0xa1, 0x41, 0xef, 0x07, 0x7b, 0x1b, 0x95, 0xe9, 0x65, 0x61, 0x66,
+	0x61, 0xe4, 0x6f, 0x4d, 0xb7, 0xbe, 0xf4, 0x27, 0x30, 0x9a, 0xb9, 0x6d, 0xa1, 0x57, 0x51, 0xc9,
+	0x23, 0x6f, 0xa7, 0x38, 0x0c, 0x19, 0x3a, 0xf4, 0x36, 0xc9, 0x7a, 0x41, 0x3b, 0x93, 0xdc, 0x22,
+	0x3b, 0xc0, 0x6d, 0xec, 0x45, 0x8e, 0xe5, 0x9a, 0xa5, 0xa1, 0x73, 0x8b, 0x56, 0x45, 0x67, 0x90,
+	0x08, 0xd5, 0x7f, 0x38, 0x89, 0xe6, 0xf5, 0x82, 0x24, 0x0f, 0x5b, 0x3c, 0x9e, 0x45, 0x93, 0x61,
+	0x9f, 0xde, 0x13, 0x62, 0x4e, 0xa8, 0x66, 0x65, 0x8b, 0x35, 0x43, 0x0c, 0xcf, 0x5e, 0x14, 0x8a,
+	0xa7, 0xb2, 0x28, 0x94, 0x8e, 0xbb, 0x28, 0xe4, 0xbd, 0x41, 0x52, 0xb6, 0x3c, 0x95, 0x5c, 0xb6,
+	0x3c, 0xfa, 0x17, 0x1b, 0x62, 0x55, 0xc0, 0x5c, 0x39, 0x4e, 0xe6, 0x72, 0x85, 0x45, 0x3c, 0x11,
+	0x53, 0x9a, 0xf2, 0x63, 0xbb, 0xf8, 0x5c, 0xa6, 0xd7, 0x43, 0xf6, 0x31, 0x8f, 0x24, 0xd4, 0xf8,
+	0xd5, 0x90, 0x7d, 0x0c, 0xac, 0x7d, 0x34, 0xdd, 0xf9, 0x5f, 0x2b, 0x68, 0x56, 0xad, 0x82, 0x40,
+	0x82, 0x1e, 0x7b, 0x7e, 0x18, 0xf1, 0x50, 0x90, 0x7e, 0x11, 0xd5, 0xf5, 0x04, 0x04, 0x32, 0xde,
+	0xf1, 0x2c, 0xc0, 0x67, 0xd1, 0x24, 0xbf, 0x75, 0xce, 0x2c, 0xaa, 0x33, 0x9d, 0xdf, 0x4c, 0x07,
+	0x31, 0xfc, 0x13, 0xf3, 0xcf, 0x0d, 0x8d, 0xf7, 0xd3, 0xe6, 0xdf, 0xdb, 0xb9, 0x96, 0xbc, 0xf8,
+	0xe4, 0x30, 0xca, 0x98, 0xbd, 0x9c, 0x6f, 0xa1, 0x85, 0x54, 0x7e, 0x1b, 0x99, 0x2a, 0x2c, 0xe5,
+	0x54, 0x33, 0x4b, 0x94, 0x44, 0xd3, 0xcb, 0xa8, 0x4c, 0x2f, 0x57, 0xe2, 0xfb, 0x39, 0x3a, 0xef,
+	0xe9, 0xc5, 0x4b, 0xc0, 0xda, 0xeb, 0xbf, 0x3b, 0x89, 0x16, 0x52, 0xd5, 0xa5, 0xa8, 0xa7, 0x51,
+	0xa4, 0xf0, 0x68, 0xfe, 0xd3, 0xcc, 0xc4, 0x9d, 0x57, 0xd1, 0x2c, 0x9d, 0x9b, 0x4d, 0x2d, 0xf1,
+	0x47, 0xe4, 0xf9, 0x6e, 0x2b, 0x50, 0xd0, 0xb0, 0x8f, 0xe7, 0xa9, 0x7c, 0x15, 0xcd, 0x86, 0x92,
+	0x61, 0xb6, 0xd6, 0x30, 0x4b, 0x2a, 0x93, 0x96, 0x02, 0x05, 0x0d, 0xdb, 0xe8, 0xa0, 0xf9, 0xc4,
+	0xc6, 0x38, 0xc9, 0xc1, 0xb3, 0xb3, 0xfc, 0x9e, 0x79, 0x85, 0x04, 0xa4, 0x88, 0x1a, 0x3b, 0xe8,
+	0x02, 0x4b, 0xc0, 0x91, 0x05, 0xd2, 0x12, 0xff, 0xeb, 0x5c, 0xe8, 0x0b, 0x8d, 0x81, 0x98, 0x70,
+	0x04, 0x95, 0x21, 0xaf, 0x92, 0x54, 0x92, 0x7f, 0xaa, 0xb9, 0x24, 0xff, 0xa4, 0x46, 0xcd, 0x89,
+	0xd4, 0x40, 0xed, 0x63, 0xb5, 0x0e, 0x8f, 0xa6, 0x06, 0x7e, 0x77, 0x1a, 0x2d, 0xa4, 0x2a, 0xfc,
+	0x10, 0x9f, 0x0d, 0x9d, 0x1e, 0x64, 0x91, 0x15, 0x3e, 0x1b, 0x3a, 0x6f, 0x42, 0xe0, 0x90, 0x63,
+	0x24, 0x82, 0x70, 0xe3, 0xba, 0x38, 0xc0, 0xb8, 0xee, 0xa1, 0x33, 0x91, 0x1b, 0x6e, 0x07, 0xfd,
+	0x30, 0x5a, 0xc5, 0x41, 0x14, 0xf2, 0xd9, 0x33, 0x94, 0xc1, 0xff, 0x04, 0x49, 0x00, 0xdc, 0x5e,
+	0x6f, 0xe9, 0x54, 0x20, 0x8b, 0x34, 0x99, 0x43, 0x91, 0x1b, 0x2e, 0xbb, 0xae, 0x7f, 0x27, 0xce,
+	0xff, 0x4e, 0x96, 0x5c, 0xb3, 0xac, 0xce, 0xa1, 0xed, 0xf5, 0xd6, 0x00, 0x4c, 0x38, 0x82, 0x8a,
+	0xb1, 0x41, 0x9f, 0xea, 0x0d, 0xcb, 0x75, 0xda, 0x16, 0xc9, 0x96, 0x0b, 0x23, 0x9a, 0xa1, 0xc1,
+	0x26, 0xa8, 0xc8, 0x59, 0xdc, 0x5e, 0x6f, 0xe9, 0x28, 0x90, 0xd5, 0x2f, 0x5e, 0xbf, 0x27, 0x73,
+	0x5e, 0xbf, 0x33, 0x6d, 0x98, 0xea, 0xa9, 0xd8, 0x30, 0xb5, 0xe1, 0x14, 0x0d, 0xca, 0x49, 0xd1,
+	0x68, 0x43, 0x7e, 0x08, 0x45, 0xd3, 0x46, 0x73, 0xc4, 0xf0, 0x97, 0x0b, 0x39, 0x4c, 0x0d, 0x9d,
+	0xe1, 0xb3, 0xac, 0x52, 0x00, 0x9d, 0xe4, 0x47, 0x22, 0x96, 0x30, 0x77, 0x1a, 0xdb, 0x8a, 0x1f,
+	0x16, 0xd0, 0x3c, 0x79, 0x19, 0xcb, 0xd1, 0x1e, 0xf6, 0xee, 0x35, 0xad, 0xc0, 0xea, 0xb2, 0x94,
+	0xc2, 0xa9, 0x17, 0x76, 0x73, 0xff, 0xea, 0xcb, 0x1a, 0x23, 0xcd, 0x29, 0xaf, 0x83, 0x21, 0x25,
+	0x19, 0x31, 0x00, 0x92, 0x36, 0x3e, 0x1c, 0x66, 0x87, 0x36, 0x00, 0x96, 0x35, 0x12, 0x90, 0x22,
+	0x3a, 0xb2, 0xf7, 0x3f, 0xf3, 0x51, 0x87, 0x5a, 0x2b, 0x7e, 0x63, 0x92, 0x17, 0x0a, 0xcb, 0x61,
+	0x53, 0x26, 0xdf, 0xc2, 0x3d, 0x91, 0xc7, 0x2d, 0xdc, 0xca, 0xb5, 0xa0, 0xc5, 0x87, 0x5f, 0x0b,
+	0x4a, 0x0e, 0x7c, 0xb5, 0x77, 0xe8, 0x6a, 0x53, 0x4e, 0x0e, 0x7c, 0x35, 0x56, 0x60, 0xa2, 0xbd,
+	0x43, 0x12, 0x89, 0xf9, 0x6e, 0x2f, 0x3e, 0x0f, 0x45, 0xd9, 0xf2, 0xad, 0x60, 0x08, 0x02, 0x3a,
+	0xae, 0xfd, 0xd5, 0x18, 0x82, 0xc7, 0xfa, 0x97, 0x7b, 0xcc, 0x76, 0x58, 0xa7, 0x71, 0x6c, 0x72,
+	0xc8, 0x75, 0xea, 0x39, 0xe9, 0xaa, 0x7a, 0xa4, 0x46, 0x91, 0xd2, 0xf7, 0xd0, 0x8f, 0x66, 0xb6,
+	0xfd, 0xeb, 0x49, 0x74, 0x3e, 0xbb, 0x82, 0xde, 0x47, 0x66, 0x42, 0xb2, 0xf9, 0x55, 0xcc, 0x9c,
+	0x5f, 0x9f, 0x43, 0x93, 0x21, 0xbf, 0x31, 0x81, 0x65, 0x53, 0xb1, 0x6b, 0x5a, 0x59, 0x13, 0xc4,
+	0x30, 0x72, 0x54, 0xa1, 0x6b, 0xdd, 0xdd, 0x08, 0x3b, 0xab, 0x7e, 0x9f, 0xde, 0xfb, 0x0d, 0xd8,
+	0x62, 0x97, 0xf6, 0x97, 0x93, 0xa3, 0x0a, 0x1b, 0x29, 0x0c, 0xc8, 0xe8, 0x45, 0xb3, 0x92, 0x95,
+	0xfc, 0x07, 0xed, 0xcc, 0xc4, 0x91, 0x09, 0x0b, 0x63, 0xb2, 0xc2, 0x3e, 0x4c, 0xef, 0xa0, 0xec,
+	0xb1, 0x94, 0x55, 0x7c, 0xcc, 0xb6, 0x51, 0xa7, 0x35, 0xd7, 0x1f, 0xd5, 0xec, 0xfd, 0x49, 0x09,
+	0x9d, 0xc9, 0xa8, 0xec, 0xaf, 0xae, 0x61, 0x85, 0x63, 0xac, 0x61, 0x07, 0xe2, 0x63, 0xe5, 0x73,
+	0x2e, 0x39, 0x16, 0xea, 0x88, 0x2f, 0xf5, 0x9d, 0x02, 0x3a, 0x4b, 0xc3, 0x53, 0x71, 0x62, 0x0d,
+	0xef, 0x22, 0x4a, 0xff, 0x1c, 0xeb, 0x1a, 0xed, 0x6b, 0x19, 0x14, 0x92, 0xc4, 0x9f, 0x2c, 0x28,
+	0x64, 0x72, 0x35, 0x56, 0x11, 0x12, 0x45, 0xb6, 0x62, 0x65, 0xf2, 0x14, 0xbd, 0xab, 0x5c, 0xb4,
+	0xfe, 0x8c, 0xe6, 0xcf, 0x49, 0x6f, 0x9b, 0xb4, 0x82, 0xd4, 0x8d, 0xdc, 0x6d, 0xa6, 0xe7, 0x6d,
+	0x7e, 0x2d, 0xff, 0x8b, 0x1b, 0x8e, 0x3f, 0x09, 0x47, 0x1b, 0x5d, 0xbf, 0x5f, 0x44, 0xb3, 0xea,
+	0x87, 0x24, 0xf9, 0x15, 0xbd, 0x00, 0xef, 0x3a, 0x77, 0xf9, 0xa8, 0x4a, 0x2e, 0xd0, 0xa3, 0xad,
+	0xc0, 0xa1, 0x86, 0x8f, 0x2a, 0xae, 0xb5, 0x83, 0x5d, 0xe6, 0xdb, 0x1b, 0x3d, 0x68, 0x92, 0x04,
+	0xe6, 0x62, 0x86, 0xeb, 0x94, 0x3c, 0x70, 0x36, 0x84, 0xe1, 0xae, 0x83, 0xdd, 0x36, 0xcb, 0xba,
+	0x1d, 0x07, 0xc3, 0xab, 0x94, 0x3c, 0x70, 0x36, 0xc6, 0xdb, 0xa8, 0x66, 0x07, 0xd8, 0x8a, 0x70,
+	0x7b, 0xe5, 0x90, 0xbb, 0x1a, 0x3e, 0x7f, 0xbc, 0x21, 0xbb, 0xed, 0x74, 0xb1, 0x54, 0xe5, 0x2f,
+	0x26, 0x02, 0x09, 0x3d, 0x72, 0x79, 0xbe, 0xb5, 0x1b, 0xe1, 0xa0, 0x15, 0x59, 0x41, 0xc4, 0xfd,
+	0x09, 0xe2, 0x9e, 0x97, 0x65, 0x01, 0x01, 0x09, 0xab, 0xfe, 0xaf, 0xaa, 0x68, 0x4e, 0x2b, 0x9b,
+	0xfa, 0x67, 0xa3, 0xba, 0xdc, 0x4d, 0x49, 0x9f, 0x16, 0x87, 0x36, 0x28, 0xd2, 0x2a, 0x57, 0xb1,
+	0x50, 0x4a, 0x79, 0x58, 0x28, 0x6f, 0xa3, 0xe9, 0x30, 0xdc, 0xa3, 0x98, 0xc3, 0xfb, 0x6d, 0xe9,
+	0x35, 0x61, 0xad, 0xd6, 0x75, 0xd1, 0x1d, 0x14, 0x62, 0xc6, 0x3a, 0x9a, 0xe4, 0x07, 0x95, 0x86,
+	0x3b, 0x65, 0x44, 0x2d, 0xa1, 0xd8, 0x42, 0x8b, 0x49, 0x8c, 0x23, 0xdd, 0x46, 0x1b, 0x74, 0x9f,
+	0xa4, 0xdb, 0x3c, 0xdc, 0x44, 0x68, 0xa2, 0xb3, 0xa4, 0x20, 0x62, 0x7c, 0x58, 0xad, 0xd1, 0x67,
+	0x87, 0x06, 0x79, 0x00, 0x54, 0x2c, 0x5f, 0xcd, 0x0c, 0x1c, 0xc8, 0xec, 0x39, 0x9a, 0xa2, 0xff,
+	0xef, 0x93, 0x68, 0x56, 0xbd, 0xd8, 0xe4, 0xf4, 0xaa, 0x2e, 0x51, 0xa7, 0xf0, 0x72, 0xe0, 0xe9,
+	0x55, 0x97, 0xb6, 0x79, 0x3b, 0x08, 0x0c, 0x03, 0x50, 0x8d, 0x9d, 0x90, 0xbe, 0x31, 0x6c, 0xa6,
+	0x08, 0x3b, 0x09, 0x18, 0xf7, 0x85, 0x84, 0x0c, 0xa1, 0x19, 0xc6, 0xe8, 0x66, 0x69, 0x68, 0x9a,
+	0xa2, 0x19, 0x12, 0x32, 0x64, 0xd1, 0x0c, 0x70, 0x27, 0xf6, 0x0c, 0x4b, 0x8b, 0x26, 0xd0, 0x56,
+	0xe0, 0x50, 0x12, 0x3a, 0x0e, 0x7c, 0x17, 0x2f, 0xc3, 0xa6, 0x59, 0x51, 0x43, 0xc7, 0xc0, 0x9a,
+	0x21, 0x86, 0x8f, 0x23, 0x6c, 0xaa, 0x0e, 0x80, 0x21, 0x66, 0xf1, 0x35, 0xb4, 0x70, 0x9b, 0x7b,
+	0x9b, 0x5b, 0x4e, 0xc7, 0xb3, 0xa2, 0xa4, 0x4a, 0x8a, 0x48, 0x91, 0x7a, 0x43, 0x47, 0x80, 0x74,
+	0x9f, 0x8f, 0xf5, 0x8e, 0x01, 0x7b, 0xed, 0x9e, 0xef, 0x78, 0x91, 0xbe, 0x63, 0xb8, 0xc2, 0xdb,
+	0x41, 0x60, 0x8c, 0x36, 0xd5, 0x7f, 0x9b, 0x4c, 0x75, 0xa5, 0x32, 0x36, 0x19, 0x9e, 0xed, 0xc0,
+	0xb9, 0x2d, 0x82, 0xb5, 0x62, 0x78, 0x36, 0x68, 0x2b, 0x70, 0xa8, 0xf1, 0x2b, 0xa8, 0xd8, 0x0e,
+	0x87, 0xcc, 0xec, 0xa2, 0xdb, 0xd4, 0x46, 0x6b, 0x13, 0x48, 0x57, 0x12, 0x48, 0x3d, 0xe8, 0xe3,
+	0xe0, 0x50, 0x0f, 0xa4, 0x6e, 0x91, 0x46, 0x60, 0x30, 0xe3, 0x25, 0x34, 0x6d, 0xf7, 0x83, 0xd0,
+	0x0f, 0x56, 0x7d, 0xb7, 0xdf, 0xf5, 0x78, 0x18, 0x55, 0x14, 0x4c, 0x59, 0x95, 0x60, 0xa0, 0x60,
+	0x92, 0x9d, 0xb9, 0xe3, 0x39, 0x24, 0xd4, 0xc9, 0x90, 0xf4, 0x3a, 0x68, 0x6b, 0x32, 0x10, 0x54,
+	0x5c, 0xc2, 0x56, 0x56, 0xac, 0x66, 0x45, 0x65, 0x2b, 0xab, 0x62, 0x50, 0x30, 0xc9, 0xf5, 0x83,
+	0x53, 0x3d, 0xe9, 0xfc, 0xf9, 0xe4, 0xf8, 0xce, 0x9f, 0xd3, 0xf3, 0x7d, 0x52, 0x03, 0xc8, 0x8c,
+	0x8d, 0xf7, 0xd3, 0x5e, 0x80, 0xb7, 0x73, 0x2d, 0xa2, 0xfe, 0x49, 0x10, 0x75, 0xcc, 0x41, 0xd4,
+	0xff, 0x54, 0x25, 0xb3, 0x53, 0x59, 0x88, 0x95, 0x45, 0xae, 0x30, 0x86, 0x45, 0x6e, 0x22, 0xef,
+	0x45, 0xae, 0x78, 0xe4, 0x22, 0xf7, 0x54, 0x9c, 0xec, 0x55, 0x4a, 0xe9, 0x00, 0x91, 0xf0, 0x45,
+	0xaa, 0x54, 0xdd, 0xb1, 0x9c, 0x88, 0xec, 0x94, 0xd8, 0xb9, 0x1c, 0x96, 0x62, 0x58, 0x94, 0x77,
+	0x0d, 0x0a, 0x18, 0x74, 0xfc, 0x61, 0x16, 0xd3, 0xe1, 0xb2, 0x15, 0x5e, 0x45, 0xb3, 0x54, 0xc8,
+	0x65, 0xdb, 0xf6, 0xfb, 0x34, 0xd1, 0xbf, 0xaa, 0x26, 0x7a, 0x6c, 0xc9, 0xd0, 0x06, 0x68, 0xd8,
+	0xc6, 0xfb, 0xe9, 0x52, 0x27, 0x6f, 0xe7, 0x7a, 0x19, 0xdc, 0x10, 0xb3, 0xf4, 0x22, 0x2a, 0xb6,
+	0xdd, 0x03, 0x3a, 0x51, 0xaa, 0x49, 0x60, 0xbd, 0xb1, 0xbe, 0x05, 0xa4, 0x5d, 0x9a, 0xc4, 0x53,
+	0x1f, 0xaf, 0x63, 0x48, 0xf2, 0x82, 0x3c, 0xfd, 0xb0, 0x05, 0x99, 0x6e, 0xff, 0x58, 0x96, 0x37,
+	0x2b, 0x02, 0x33, 0x33, 0xfc, 0xf6, 0x4f, 0xea, 0x0e, 0x0a, 0xb1, 0xd1, 0xf4, 0xc9, 0x37, 0x50,
+	0x35, 0x66, 0xf4, 0xb0, 0xd3, 0x35, 0x4b, 0xa8, 0xe6, 0xf7, 0x30, 0xdf, 0x87, 0x68, 0xe7, 0x37,
+	0x6f, 0xc6, 0x00, 0x48, 0x70, 0xc8, 0x44, 0x66, 0x5c, 0xb5, 0xc5, 0x9c, 0x9e, 0xc8, 0xe1, 0x42,
+	0xd4, 0xbf, 0x59, 0x40, 0x93, 0xbc, 0x8a, 0x82, 0xd1, 0x40, 0xe5, 0x9e, 0x1f, 0x44, 0x2c, 0x15,
+	0x64, 0xea, 0x85, 0xcb, 0xd9, 0xef, 0x87, 0xe2, 0x36, 0xfd, 0x20, 0x4a, 0x28, 0x92, 0x5f, 0x21,
+	0xb0, 0xce, 0x44, 0x4e, 0xdb, 0xed, 0x87, 0x11, 0x0e, 0xd6, 0x9a, 0xba, 0x9c, 0xab, 0x31, 0x00,
+	0x12, 0x9c, 0xfa, 0xff, 0x29, 0xa3, 0x79, 0xfd, 0xbe, 0x39, 0x52, 0x30, 0x30, 0x74, 0x3a, 0x9e,
+	0xe3, 0x75, 0xf8, 0x96, 0xbd, 0x30, 0x74, 0xc1, 0xc0, 0x96, 0xdc, 0x1f, 0x54, 0x72, 0xb9, 0xe5,
+	0xc1, 0x4b, 0xdb, 0xb0, 0xe2, 0xa3, 0xdb, 0x86, 0x7d, 0x3b, 0x5d, 0xa4, 0xff, 0xab, 0x39, 0xdf,
+	0xf8, 0xf7, 0x49, 0x95, 0xfe, 0x31, 0x9b, 0x12, 0xff, 0xbb, 0x8c, 0xce, 0x67, 0x5f, 0x6a, 0x78,
+	0x4a, 0x7b, 0xfb, 0xa4, 0x7c, 0xda, 0xc4, 0xc0, 0xf2, 0x69, 0xc9, 0xa7, 0x2e, 0xe6, 0x74, 0x49,
+	0xa1, 0x78, 0x01, 0x47, 0x7c, 0x6a, 0xd9, 0xeb, 0x50, 0x7a, 0xa8, 0xd7, 0xe1, 0x69, 0x54, 0x61,
+	0xb7, 0xa0, 0xe9, 0xbb, 0xf9, 0x15, 0xda, 0x0a, 0x1c, 0x2a, 0x19, 0x44, 0x95, 0x23, 0x0d, 0x22,
+	0x62, 0xe0, 0xc5, 0x29, 0x3b, 0xc3, 0x55, 0xf8, 0x61, 0x06, 0x5e, 0xdc, 0x17, 0x12, 0x32, 0x84,
+	0xb7, 0xd5, 0x73, 0x48, 0x41, 0xb7, 0xaa, 0xca, 0x7b, 0xb9, 0xb9, 0x46, 0xd2, 0xe6, 0x38, 0xd4,
+	0xf8, 0x30, 0x6d, 0x8b, 0xd8, 0x63, 0xb9, 0x48, 0xf3, 0x51, 0x85, 0x2c, 0x6c, 0xb4, 0x90, 0xfa,
+	0xe6, 0xc7, 0x0e, 0x5a, 0x90, 0x7b, 0x5c, 0xfa, 0xbb, 0x04, 0x4f, 0xbf, 0xc7, 0x85, 0xb6, 0x02,
+	0x87, 0xd6, 0xbf, 0x57, 0x42, 0x0b, 0xa9, 0xeb, 0x2f, 0x4f, 0x69, 0x56, 0x91, 0x68, 0x34, 0x0d,
+	0x1b, 0xdc, 0x92, 0xea, 0x0a, 0x57, 0xa5, 0x68, 0xb4, 0x0c, 0x04, 0x15, 0xd7, 0x58, 0xa3, 0xc3,
+	0x64, 0x68, 0xef, 0x19, 0xe2, 0x23, 0x89, 0xd8, 0x0e, 0x9c, 0x00, 0x29, 0x47, 0x43, 0x1f, 0x82,
+	0xbd, 0x72, 0x1e, 0x3f, 0xa3, 0xdb, 0xd5, 0x2b, 0x49, 0x33, 0xc8, 0x38, 0xc6, 0x77, 0xd2, 0xc1,
+	0xb2, 0x77, 0xf2, 0xbe, 0x94, 0xf4, 0x51, 0x8d, 0xbb, 0x65, 0x64, 0x6c, 0xaf, 0xa6, 0xea, 0x09,
+	0x29, 0x35, 0xc8, 0x0a, 0x47, 0xd7, 0x20, 0xab, 0xff, 0xb8, 0x8a, 0xaa, 0xdb, 0xb8, 0xdb, 0x73,
+	0xad, 0x08, 0x1b, 0xb6, 0xf4, 0x6a, 0xd8, 0x68, 0xfa, 0xa5, 0xa1, 0x93, 0x05, 0xe2, 0xa7, 0x61,
+	0x61, 0x8b, 0x8c, 0x85, 0xf5, 0x35, 0x64, 0x84, 0xcc, 0xde, 0xe2, 0xbb, 0x13, 0xa9, 0xda, 0xbd,
+	0xc8, 0x8a, 0x68, 0xa5, 0x30, 0x20, 0xa3, 0x97, 0xf1, 0x1a, 0xaa, 0xd9, 0xbe, 0x17, 0x59, 0x8e,
+	0x27, 0x94, 0xf7, 0xc5, 0x01, 0x95, 0xbd, 0x18, 0x12, 0x7b, 0x13, 0xe2, 0x27, 0x24, 0xdd, 0x8d,
+	0x2b, 0x68, 0xf2, 0x36, 0xf1, 0xe8, 0xe0, 0xf8, 0x22, 0xaa, 0x0b, 0x59, 0x94, 0xde, 0xa0, 0x28,
+	0x52, 0x7d, 0x06, 0xd6, 0x05, 0xe2, 0xbe, 0x06, 0x46, 0x73, 0x34, 0xa9, 0xd6, 0x89, 0x0e, 0xf9,
+	0x1c, 0xe2, 0x06, 0xc4, 0xd3, 0x59, 0xe4, 0x9a, 0x7e, 0xbb, 0xa5, 0x62, 0xb3, 0xfc, 0x4a, 0xad,
+	0x11, 0x74, 0x9a, 0xc6, 0x55, 0x54, 0xb5, 0x76, 0x77, 0x89, 0x33, 0xe9, 0x90, 0x9b, 0x09, 0x9f,
+	0xcd, 0xa2, 0xbf, 0xcc, 0x71, 0x78, 0x0d, 0x6b, 0xfe, 0x0b, 0x44, 0x5f, 0xe3, 0x75, 0x34, 0x15,
+	0xf9, 0x2e, 0xb7, 0xae, 0x43, 0xee, 0xd4, 0xbd, 0x94, 0x45, 0x6a, 0x5b, 0xa0, 0x25, 0xe9, 0x38,
+	0x49, 0x5b, 0x08, 0x32, 0x1d, 0xe3, 0xfb, 0x05, 0x34, 0xed, 0xf9, 0x6d, 0x1c, 0xcf, 0x5e, 0xee,
+	0x18, 0x1a, 0xf5, 0x26, 0xbb, 0x78, 0xa4, 0x2e, 0x6e, 0x4a, 0xb4, 0xd9, 0x24, 0x13, 0x3e, 0x33,
+	0x19, 0x04, 0x8a, 0x10, 0x86, 0x87, 0xe6, 0x9d, 0xae, 0xd5, 0xc1, 0xcd, 0xbe, 0xcb, 0xcf, 0x25,
+	0x84, 0x7c, 0xfd, 0xc9, 0xac, 0x07, 0xb7, 0xee, 0xdb, 0x96, 0x7b, 0x93, 0x1d, 0x94, 0xc4, 0xbb,
+	0x38, 0xa0, 0xce, 0x30, 0x91, 0x5c, 0xb9, 0xa6, 0x51, 0x82, 0x14, 0x6d, 0x7a, 0x8c, 0x37, 0x70,
+	0x7c, 0xfa, 0xdd, 0x5c, 0x2b, 0x0c, 0x37, 0x93, 0xe4, 0x8c, 0xe4, 0x18, 0xaf, 0x8e, 0x00, 0xe9,
+	0x3e, 0xac, 0x32, 0x28, 0x6b, 0xe4, 0x67, 0x9a, 0x79, 0x65, 0x50, 0xd6, 0x06, 0x02, 0x6a, 0xfc,
+	0x0a, 0x9a, 0x0f, 0xfa, 0x5e, 0xe4, 0x74, 0x71, 0xc2, 0x91, 0xed, 0x25, 0x69, 0xa2, 0x26, 0x68,
+	0x30, 0x48, 0x61, 0x5f, 0xf8, 0x12, 0x5a, 0x48, 0xbd, 0xdd, 0xa1, 0xb4, 0xd2, 0xdf, 0x29, 0x20,
+	0x3d, 0xbc, 0x4a, 0xf6, 0x4f, 0x6d, 0x27, 0xa0, 0x04, 0x0f, 0xf5, 0x90, 0x70, 0x23, 0x06, 0x40,
+	0x82, 0x43, 0xd2, 0xf3, 0x7b, 0x56, 0xb4, 0xa7, 0xa7, 0xe7, 0x13, 0x92, 0x40, 0x21, 0x24, 0x5a,
+	0x4d, 0xfe, 0x02, 0xee, 0xe0, 0xbb, 0x3d, 0xbe, 0x1d, 0x14, 0xd1, 0xea, 0xa6, 0x80, 0x80, 0x84,
+	0x55, 0xff, 0x53, 0x84, 0x66, 0xd5, 0x05, 0x4e, 0xd9, 0x74, 0x17, 0x1e, 0xba, 0xe9, 0x7e, 0x1a,
+	0x55, 0xba, 0x38, 0xda, 0xf3, 0xdb, 0xfa, 0x62, 0xbd, 0x41, 0x5b, 0x81, 0x43, 0xa9, 0xf8, 0x7e,
+	0x10, 0x5f, 0x91, 0x97, 0x88, 0xef, 0x07, 0x11, 0x50, 0x48, 0x7c, 0xba, 0xa0, 0x34, 0xe0, 0x74,
+	0x41, 0x07, 0xcd, 0xb3, 0xfb, 0x7f, 0xc9, 0x01, 0x80, 0x13, 0x1f, 0xcc, 0x69, 0x69, 0x24, 0x20,
+	0x45, 0x94, 0xa4, 0x83, 0xb3, 0xb6, 0x24, 0x90, 0x3c, 0x7c, 0x59, 0xc9, 0x96, 0x4a, 0x01, 0x74,
+	0x92, 0xe3, 0x88, 0x1c, 0xa9, 0xdf, 0xf1, 0xc4, 0xb7, 0xef, 0x54, 0xf3, 0xba, 0x7d, 0xe7, 0x65,
+	0x34, 0xdb, 0xb5, 0xee, 0x36, 0xad, 0x43, 0x52, 0xb1, 0xbe, 0x45, 0x8a, 0xae, 0xb2, 0x7a, 0x40,
+	0x06, 0xf1, 0xce, 0x6d, 0x28, 0x10, 0xd0, 0x30, 0x8d, 0x1e, 0xb1, 0xda, 0x7b, 0xae, 0x75, 0xc8,
+	0xbd, 0xc7, 0xeb, 0xf9, 0xbc, 0x1b, 0xa0, 0x34, 0x99, 0xe5, 0xc4, 0xfe, 0x07, 0xce, 0x87, 0xdd,
+	0xde, 0xe2, 0xe1, 0xc0, 0x8a, 0x70, 0x52, 0x43, 0xb8, 0x2a, 0xdf, 0xde, 0x22, 0x01, 0x41, 0xc5,
+	0xa5, 0x87, 0x44, 0xe4, 0x9b, 0x12, 0x9b, 0x38, 0x70, 0xfc, 0x36, 0xd7, 0x33, 0xc9, 0x21, 0x91,
+	0x34, 0x0a, 0x64, 0xf5, 0x23, 0xb2, 0xf4, 0xf8, 0xcb, 0xb0, 0xf7, 0x70, 0xd7, 0xe2, 0x55, 0x78,
+	0x84, 0x2c, 0x4d, 0x19, 0x08, 0x2a, 0x2e, 0x99, 0x69, 0x7b, 0x7e, 0xc8, 0x92, 0xd6, 0xa5, 0x99,
+	0x46, 0x12, 0x45, 0x81, 0x42, 0x48, 0x8c, 0x85, 0x9b, 0x0e, 0x2c, 0x73, 0x72, 0x4e, 0x8d, 0xb1,
+	0xb4, 0x24, 0x18, 0x28, 0x98, 0x64, 0x37, 0x8e, 0xb0, 0x17, 0x38, 0xf6, 0x5e, 0x17, 0x7b, 0x91,
+	0x39, 0x9f, 0xcb, 0xee, 0x90, 0x7f, 0x9b, 0x2b, 0x82, 0x2e, 0x1b, 0x55, 0xc9, 0x6f, 0x90, 0x78,
+	0x52, 0x11, 0x02, 0xdc, 0xc6, 0xae, 0x73, 0x9b, 0x84, 0xb0, 0x16, 0xf2, 0x14, 0x01, 0x04, 0x5d,
+	0x26, 0x42, 0xf2, 0x1b, 0x24, 0x9e, 0xa3, 0x99, 0xa8, 0xff, 0x66, 0x02, 0x2d, 0xa4, 0x9e, 0xf8,
+	0x61, 0x5e, 0xc1, 0x97, 0xd1, 0x6c, 0x14, 0xf4, 0x43, 0x56, 0x12, 0xfd, 0xae, 0x23, 0xce, 0x6a,
+	0xd2, 0xa9, 0xb4, 0xad, 0x40, 0x40, 0xc3, 0x24, 0x49, 0x0e, 0x71, 0xa5, 0x1b, 0xec, 0x45, 0x4e,
+	0x74, 0xc8, 0x8a, 0xfd, 0x98, 0x45, 0x35, 0xc9, 0x61, 0x35, 0x03, 0x07, 0x32, 0x7b, 0x1a, 0xbf,
+	0x8a, 0xaa, 0x1e, 0x8e, 0xee, 0xf8, 0xc1, 0x7e, 0x6c, 0x19, 0xe6, 0xb4, 0xc7, 0xda, 0x64, 0x54,
+	0x13, 0x65, 0xc5, 0x1b, 0x42, 0x10, 0x0c, 0xeb, 0x3f, 0x28, 0xa2, 0xf8, 0x62, 0x55, 0x79, 0xdb,
+	0xf7, 0x41, 0x01, 0xcd, 0xde, 0x51, 0x14, 0xe0, 0x78, 0xb6, 0x7f, 0x22, 0xbc, 0xa0, 0xb6, 0x83,
+	0xc6, 0x5c, 0x72, 0xa1, 0x4c, 0x9c, 0x9a, 0xb7, 0xac, 0x78, 0x0a, 0xde, 0xb2, 0x7a, 0x4b, 0x18,
+	0x14, 0xfc, 0xe3, 0x11, 0x85, 0xe4, 0x25, 0x35, 0x16, 0x85, 0x42, 0xa2, 0xd6, 0x16, 0x85, 0x90,
+	0x13, 0xc8, 0xb6, 0xd3, 0x0e, 0x94, 0x13, 0xc8, 0xab, 0x6b, 0x0d, 0x08, 0x81, 0xb5, 0xd7, 0x7f,
+	0x98, 0x4c, 0x9a, 0x64, 0x4e, 0x1a, 0x0d, 0x34, 0x1f, 0xff, 0xbf, 0xd6, 0xe0, 0xa3, 0x9a, 0x31,
+	0x11, 0x36, 0x69, 0x43, 0x83, 0x43, 0xaa, 0x07, 0x09, 0x24, 0x25, 0x6d, 0xcd, 0xc4, 0xc4, 0x12,
+	0x5f, 0xba, 0xa1, 0x40, 0x41, 0xc3, 0x26, 0xca, 0xda, 0x8a, 0x22, 0xdc, 0xed, 0x45, 0xca, 0xc4,
+	0x12, 0xca, 0x7a, 0x59, 0x06, 0x82, 0x8a, 0x4b, 0xcc, 0xa7, 0x3b, 0x8e, 0xd7, 0xf6, 0xef, 0x98,
+	0x25, 0xd5, 0x7c, 0xba, 0x45, 0x5b, 0x81, 0x43, 0x89, 0x51, 0x16, 0xf6, 0x7b, 0x3d, 0x9a, 0x7e,
+	0xa6, 0x15, 0x09, 0x68, 0xf1, 0x76, 0x10, 0x18, 0xf5, 0x7f, 0x57, 0x40, 0x33, 0xca, 0x8a, 0x47,
+	0x84, 0xec, 0x5a, 0x77, 0xf9, 0x93, 0x38, 0x98, 0x1d, 0x23, 0x28, 0x27, 0x42, 0x6e, 0xc8, 0x40,
+	0x50, 0x71, 0x35, 0xfb, 0x60, 0x22, 0x2f, 0xfb, 0x80, 0x44, 0xbd, 0x9c, 0x40, 0x3f, 0x4e, 0xda,
+	0x70, 0x02, 0x20, 0xed, 0xf5, 0x3f, 0x28, 0xa0, 0xb3, 0x59, 0x97, 0x13, 0x8b, 0x6c, 0xca, 0xac,
+	0xc2, 0x9d, 0x57, 0x62, 0x00, 0x24, 0x38, 0x46, 0x0f, 0xcd, 0x7b, 0x64, 0x8e, 0x72, 0x02, 0x24,
+	0x3c, 0x69, 0x4e, 0x0c, 0x9d, 0x2a, 0x2a, 0xc6, 0xd4, 0xa6, 0x46, 0x0b, 0x52, 0xd4, 0x57, 0xec,
+	0x1f, 0xfd, 0xf4, 0xd2, 0xa7, 0x7e, 0xfc, 0xd3, 0x4b, 0x9f, 0xfa, 0xa3, 0x9f, 0x5e, 0xfa, 0xd4,
+	0x37, 0x1f, 0x5c, 0x2a, 0xfc, 0xe8, 0xc1, 0xa5, 0xc2, 0x8f, 0x1f, 0x5c, 0x2a, 0xfc, 0xd1, 0x83,
+	0x4b, 0x85, 0x3f, 0x79, 0x70, 0xa9, 0xf0, 0xbd, 0xff, 0x76, 0xe9, 0x53, 0x5f, 0xfe, 0x62, 0x32,
+	0x31, 0x97, 0xe2, 0x89, 0x49, 0xff, 0x79, 0x9e, 0x4d, 0xc4, 0xa5, 0xde, 0x7e, 0x67, 0x89, 0x08,
+	0xb2, 0x24, 0x4d, 0xcc, 0xa5, 0x78, 0x62, 0xfe, 0xff, 0x01, 0x00, 0x4b, 0x7a, 0x72, 0xa2, 0xbe,
+	0xe9, 0x00, 0x00,
 }
 
 func (m *AMQPConsumeConfig) Marshal() (dAtA []byte, err error) {
@@ -2312,6 +3157,20 @@ func (m *AMQPEventSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Transform != nil {
+		{
+			size, err := m.Transform.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
 	if m.Filter != nil {
 		{
 			size, err := m.Filter.MarshalToSizedBuffer(dAtA[:i])
@@ -2646,6 +3505,18 @@ func (m *AzureEventsHubEventSource) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	_ = i
 	var l int
 	_ = l
+	if m.Transform != nil {
+		{
+			size, err := m.Transform.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
 	if m.Filter != nil {
 		{
 			size, err := m.Filter.MarshalToSizedBuffer(dAtA[:i])
@@ -2739,6 +3610,31 @@ func (m *AzureQueueStorageEventSource) MarshalToSizedBuffer(dAtA []byte) (int, e
 	_ = i
 	var l int
 	_ = l
+	i -= len(m.PoisonQueueName)
+	copy(dAtA[i:], m.PoisonQueueName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.PoisonQueueName)))
+	i--
+	dAtA[i] = 0x6a
+	i = encodeVarintGenerated(dAtA, i, uint64(m.MaxDequeueCount))
+	i--
+	dAtA[i] = 0x60
+	if m.VisibilityTimeoutInSeconds != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.VisibilityTimeoutInSeconds))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.Transform != nil {
+		{
+			size, err := m.Transform.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
 	if m.WaitTimeInSeconds != nil {
 		i = encodeVarintGenerated(dAtA, i, uint64(*m.WaitTimeInSeconds))
 		i--
@@ -2849,6 +3745,18 @@ func (m *AzureServiceBusEventSource) MarshalToSizedBuffer(dAtA []byte) (int, err
 	_ = i
 	var l int
 	_ = l
+	if m.Transform != nil {
+		{
+			size, err := m.Transform.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
 	i -= len(m.FullyQualifiedNamespace)
 	copy(dAtA[i:], m.FullyQualifiedNamespace)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.FullyQualifiedNamespace)))
@@ -3054,6 +3962,26 @@ func (m *BitbucketEventSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i--
+	if m.Normalize {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x60
+	if m.Transform != nil {
+		{
+			size, err := m.Transform.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
 	if m.Filter != nil {
 		{
 			size, err := m.Filter.MarshalToSizedBuffer(dAtA[:i])
@@ -3216,6 +4144,30 @@ func (m *BitbucketServerEventSource) MarshalToSizedBuffer(dAtA []byte) (int, err
 	_ = i
 	var l int
 	_ = l
+	i--
+	if m.Normalize {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x88
+	if m.Transform != nil {
+		{
+			size, err := m.Transform.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
 	i -= len(m.CheckInterval)
 	copy(dAtA[i:], m.CheckInterval)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.CheckInterval)))
@@ -3424,6 +4376,23 @@ func (m *CalendarEventSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i -= len(m.DSTPolicy)
+	copy(dAtA[i:], m.DSTPolicy)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.DSTPolicy)))
+	i--
+	dAtA[i] = 0x52
+	if m.Transform != nil {
+		{
+			size, err := m.Transform.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
 	if m.Filter != nil {
 		{
 			size, err := m.Filter.MarshalToSizedBuffer(dAtA[:i])
@@ -3499,6 +4468,48 @@ func (m *CalendarEventSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *CalendarStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CalendarStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CalendarStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NextFireTimes) > 0 {
+		for iNdEx := len(m.NextFireTimes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NextFireTimes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	i -= len(m.EventName)
+	copy(dAtA[i:], m.EventName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.EventName)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *CatchupConfiguration) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3535,6 +4546,151 @@ func (m *CatchupConfiguration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ClusterEventSource) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClusterEventSource) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClusterEventSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ClusterEventSourceList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClusterEventSourceList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClusterEventSourceList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.ListMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ClusterEventSourceSpec) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClusterEventSourceSpec) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClusterEventSourceSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.EventSource.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.NamespaceSelector != nil {
+		{
+			size, err := m.NamespaceSelector.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ConfigMapPersistence) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3571,6 +4727,367 @@ func (m *ConfigMapPersistence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *DependencyProbe) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DependencyProbe) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DependencyProbe) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Timeout)
+	copy(dAtA[i:], m.Timeout)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Timeout)))
+	i--
+	dAtA[i] = 0x32
+	i -= len(m.Interval)
+	copy(dAtA[i:], m.Interval)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Interval)))
+	i--
+	dAtA[i] = 0x2a
+	if m.S3 != nil {
+		{
+			size, err := m.S3.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.HTTP != nil {
+		{
+			size, err := m.HTTP.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.TCP != nil {
+		{
+			size, err := m.TCP.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *DynamoDBStreamsEventSource) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DynamoDBStreamsEventSource) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DynamoDBStreamsEventSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Transform != nil {
+		{
+			size, err := m.Transform.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
+	}
+	if m.Filter != nil {
+		{
+			size, err := m.Filter.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
+	if len(m.Metadata) > 0 {
+		keysForMetadata := make([]string, 0, len(m.Metadata))
+		for k := range m.Metadata {
+			keysForMetadata = append(keysForMetadata, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForMetadata)
+		for iNdEx := len(keysForMetadata) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Metadata[string(keysForMetadata[iNdEx])]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForMetadata[iNdEx])
+			copy(dAtA[i:], keysForMetadata[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForMetadata[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if m.Persistence != nil {
+		{
+			size, err := m.Persistence.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	i -= len(m.PollInterval)
+	copy(dAtA[i:], m.PollInterval)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.PollInterval)))
+	i--
+	dAtA[i] = 0x52
+	i -= len(m.Images)
+	copy(dAtA[i:], m.Images)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Images)))
+	i--
+	dAtA[i] = 0x4a
+	i -= len(m.StartingPosition)
+	copy(dAtA[i:], m.StartingPosition)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.StartingPosition)))
+	i--
+	dAtA[i] = 0x42
+	i -= len(m.Endpoint)
+	copy(dAtA[i:], m.Endpoint)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Endpoint)))
+	i--
+	dAtA[i] = 0x3a
+	i -= len(m.RoleARN)
+	copy(dAtA[i:], m.RoleARN)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.RoleARN)))
+	i--
+	dAtA[i] = 0x32
+	if m.SecretKey != nil {
+		{
+			size, err := m.SecretKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.AccessKey != nil {
+		{
+			size, err := m.AccessKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	i -= len(m.Region)
+	copy(dAtA[i:], m.Region)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Region)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.StreamARN)
+	copy(dAtA[i:], m.StreamARN)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.StreamARN)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Table)
+	copy(dAtA[i:], m.Table)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Table)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ElasticsearchEventSource) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ElasticsearchEventSource) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ElasticsearchEventSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Transform != nil {
+		{
+			size, err := m.Transform.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.Filter != nil {
+		{
+			size, err := m.Filter.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.Metadata) > 0 {
+		keysForMetadata := make([]string, 0, len(m.Metadata))
+		for k := range m.Metadata {
+			keysForMetadata = append(keysForMetadata, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForMetadata)
+		for iNdEx := len(keysForMetadata) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Metadata[string(keysForMetadata[iNdEx])]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForMetadata[iNdEx])
+			copy(dAtA[i:], keysForMetadata[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForMetadata[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if m.TLS != nil {
+		{
+			size, err := m.TLS.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.APIKey != nil {
+		{
+			size, err := m.APIKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.BasicAuth != nil {
+		{
+			size, err := m.BasicAuth.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	i = encodeVarintGenerated(dAtA, i, uint64(m.BatchSize))
+	i--
+	dAtA[i] = 0x38
+	i -= len(m.PollInterval)
+	copy(dAtA[i:], m.PollInterval)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.PollInterval)))
+	i--
+	dAtA[i] = 0x32
+	i -= len(m.TiebreakerField)
+	copy(dAtA[i:], m.TiebreakerField)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.TiebreakerField)))
+	i--
+	dAtA[i] = 0x2a
+	i -= len(m.SortField)
+	copy(dAtA[i:], m.SortField)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.SortField)))
+	i--
+	dAtA[i] = 0x22
+	i -= len(m.Query)
+	copy(dAtA[i:], m.Query)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Query)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Index)
+	copy(dAtA[i:], m.Index)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Index)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.URL)
+	copy(dAtA[i:], m.URL)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.URL)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *EmitterEventSource) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3591,6 +5108,18 @@ func (m *EmitterEventSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Transform != nil {
+		{
+			size, err := m.Transform.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
 	if m.Filter != nil {
 		{
 			size, err := m.Filter.MarshalToSizedBuffer(dAtA[:i])
@@ -3701,6 +5230,44 @@ func (m *EmitterEventSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EventCatalogEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventCatalogEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventCatalogEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Sample)
+	copy(dAtA[i:], m.Sample)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Sample)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Schema)
+	copy(dAtA[i:], m.Schema)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Schema)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Description)
+	copy(dAtA[i:], m.Description)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Description)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *EventPersistence) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3748,6 +5315,49 @@ func (m *EventPersistence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EventSizeLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSizeLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSizeLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ClaimCheck != nil {
+		{
+			size, err := m.ClaimCheck.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	i -= len(m.Policy)
+	copy(dAtA[i:], m.Policy)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Policy)))
+	i--
+	dAtA[i] = 0x12
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Bytes))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+
 func (m *EventSource) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3829,7 +5439,7 @@ func (m *EventSourceFilter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *EventSourceList) Marshal() (dAtA []byte, err error) {
+func (m *EventSourceInclude) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3839,12 +5449,52 @@ func (m *EventSourceList) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventSourceList) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventSourceInclude) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventSourceList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventSourceInclude) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Namespace)
+	copy(dAtA[i:], m.Namespace)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Namespace)))
+	i--
+	dAtA[i] = 0x12
+	if m.ConfigMap != nil {
+		{
+			size, err := m.ConfigMap.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventSourceList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSourceList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSourceList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3896,6 +5546,266 @@ func (m *EventSourceSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.GRPCStream) > 0 {
+		keysForGRPCStream := make([]string, 0, len(m.GRPCStream))
+		for k := range m.GRPCStream {
+			keysForGRPCStream = append(keysForGRPCStream, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForGRPCStream)
+		for iNdEx := len(keysForGRPCStream) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.GRPCStream[string(keysForGRPCStream[iNdEx])]
+			baseI := i
+			{
+				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForGRPCStream[iNdEx])
+			copy(dAtA[i:], keysForGRPCStream[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForGRPCStream[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xf2
+		}
+	}
+	if len(m.Catalog) > 0 {
+		keysForCatalog := make([]string, 0, len(m.Catalog))
+		for k := range m.Catalog {
+			keysForCatalog = append(keysForCatalog, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForCatalog)
+		for iNdEx := len(keysForCatalog) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Catalog[string(keysForCatalog[iNdEx])]
+			baseI := i
+			{
+				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForCatalog[iNdEx])
+			copy(dAtA[i:], keysForCatalog[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForCatalog[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xea
+		}
+	}
+	if len(m.DynamoDBStreams) > 0 {
+		keysForDynamoDBStreams := make([]string, 0, len(m.DynamoDBStreams))
+		for k := range m.DynamoDBStreams {
+			keysForDynamoDBStreams = append(keysForDynamoDBStreams, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForDynamoDBStreams)
+		for iNdEx := len(keysForDynamoDBStreams) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.DynamoDBStreams[string(keysForDynamoDBStreams[iNdEx])]
+			baseI := i
+			{
+				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForDynamoDBStreams[iNdEx])
+			copy(dAtA[i:], keysForDynamoDBStreams[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForDynamoDBStreams[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xe2
+		}
+	}
+	if len(m.DependencyProbes) > 0 {
+		for iNdEx := len(m.DependencyProbes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DependencyProbes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xda
+		}
+	}
+	if len(m.SQL) > 0 {
+		keysForSQL := make([]string, 0, len(m.SQL))
+		for k := range m.SQL {
+			keysForSQL = append(keysForSQL, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForSQL)
+		for iNdEx := len(keysForSQL) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.SQL[string(keysForSQL[iNdEx])]
+			baseI := i
+			{
+				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForSQL[iNdEx])
+			copy(dAtA[i:], keysForSQL[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForSQL[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xd2
+		}
+	}
+	if len(m.Jenkins) > 0 {
+		keysForJenkins := make([]string, 0, len(m.Jenkins))
+		for k := range m.Jenkins {
+			keysForJenkins = append(keysForJenkins, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForJenkins)
+		for iNdEx := len(keysForJenkins) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Jenkins[string(keysForJenkins[iNdEx])]
+			baseI := i
+			{
+				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForJenkins[iNdEx])
+			copy(dAtA[i:], keysForJenkins[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForJenkins[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xca
+		}
+	}
+	if len(m.Elasticsearch) > 0 {
+		keysForElasticsearch := make([]string, 0, len(m.Elasticsearch))
+		for k := range m.Elasticsearch {
+			keysForElasticsearch = append(keysForElasticsearch, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForElasticsearch)
+		for iNdEx := len(keysForElasticsearch) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Elasticsearch[string(keysForElasticsearch[iNdEx])]
+			baseI := i
+			{
+				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForElasticsearch[iNdEx])
+			copy(dAtA[i:], keysForElasticsearch[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForElasticsearch[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xc2
+		}
+	}
+	if m.Metrics != nil {
+		{
+			size, err := m.Metrics.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xba
+	}
+	if m.MaxEventSize != nil {
+		{
+			size, err := m.MaxEventSize.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xb2
+	}
+	if len(m.Includes) > 0 {
+		for iNdEx := len(m.Includes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Includes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xaa
+		}
+	}
+	if m.RemoteEventBus != nil {
+		{
+			size, err := m.RemoteEventBus.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xa2
+	}
 	if len(m.Gerrit) > 0 {
 		keysForGerrit := make([]string, 0, len(m.Gerrit))
 		for k := range m.Gerrit {
@@ -4892,6 +6802,53 @@ func (m *EventSourceStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Retries) > 0 {
+		for iNdEx := len(m.Retries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Retries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.WebhookTokenRotations) > 0 {
+		for iNdEx := len(m.WebhookTokenRotations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.WebhookTokenRotations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	i -= len(m.WebhookTokenSecret)
+	copy(dAtA[i:], m.WebhookTokenSecret)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.WebhookTokenSecret)))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Calendars) > 0 {
+		for iNdEx := len(m.Calendars) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Calendars[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	{
 		size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -4905,6 +6862,39 @@ func (m *EventSourceStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EventSourceTransform) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSourceTransform) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSourceTransform) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Script)
+	copy(dAtA[i:], m.Script)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Script)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.JQ)
+	copy(dAtA[i:], m.JQ)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.JQ)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *FileEventSource) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -4925,6 +6915,18 @@ func (m *FileEventSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Transform != nil {
+		{
+			size, err := m.Transform.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
 	if m.Filter != nil {
 		{
 			size, err := m.Filter.MarshalToSizedBuffer(dAtA[:i])
@@ -4987,6 +6989,131 @@ func (m *FileEventSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *GRPCStreamEventSource) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GRPCStreamEventSource) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GRPCStreamEventSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Transform != nil {
+		{
+			size, err := m.Transform.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.Filter != nil {
+		{
+			size, err := m.Filter.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Metadata) > 0 {
+		keysForMetadata := make([]string, 0, len(m.Metadata))
+		for k := range m.Metadata {
+			keysForMetadata = append(keysForMetadata, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForMetadata)
+		for iNdEx := len(keysForMetadata) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Metadata[string(keysForMetadata[iNdEx])]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForMetadata[iNdEx])
+			copy(dAtA[i:], keysForMetadata[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForMetadata[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.ConnectionBackoff != nil {
+		{
+			size, err := m.ConnectionBackoff.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.AuthSecret != nil {
+		{
+			size, err := m.AuthSecret.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	i--
+	if m.Insecure {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x20
+	if m.TLS != nil {
+		{
+			size, err := m.TLS.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	i -= len(m.Topic)
+	copy(dAtA[i:], m.Topic)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Topic)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.URL)
+	copy(dAtA[i:], m.URL)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.URL)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *GenericEventSource) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -5007,6 +7134,18 @@ func (m *GenericEventSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Transform != nil {
+		{
+			size, err := m.Transform.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
 	if m.Filter != nil {
 		{
 			size, err := m.Filter.MarshalToSizedBuffer(dAtA[:i])
@@ -5104,6 +7243,18 @@ func (m *GerritEventSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Transform != nil {
+		{
+			size, err := m.Transform.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
 	if m.Filter != nil {
 		{
 			size, err := m.Filter.MarshalToSizedBuffer(dAtA[:i])
@@ -5272,6 +7423,44 @@ func (m *GithubEventSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i--
+	if m.Normalize {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xa8
+	if m.RegistrationPersistence != nil {
+		{
+			size, err := m.RegistrationPersistence.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa2
+	}
+	if m.Transform != nil {
+		{
+			size, err := m.Transform.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
+	}
 	if m.Filter != nil {
 		{
 			size, err := m.Filter.MarshalToSizedBuffer(dAtA[:i])
@@ -5469,6 +7658,40 @@ func (m *GitlabEventSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i--
+	if m.Normalize {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x80
+	if m.RegistrationPersistence != nil {
+		{
+			size, err := m.RegistrationPersistence.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x7a
+	}
+	if m.Transform != nil {
+		{
+			size, err := m.Transform.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
+	}
 	if len(m.Groups) > 0 {
 		for iNdEx := len(m.Groups) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.Groups[iNdEx])
@@ -5617,6 +7840,18 @@ func (m *HDFSEventSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Transform != nil {
+		{
+			size, err := m.Transform.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
+	}
 	if m.Filter != nil {
 		{
 			size, err := m.Filter.MarshalToSizedBuffer(dAtA[:i])
@@ -5741,7 +7976,7 @@ func (m *HDFSEventSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *KafkaConsumerGroup) Marshal() (dAtA []byte, err error) {
+func (m *HTTPDependencyProbe) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5751,38 +7986,68 @@ func (m *KafkaConsumerGroup) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *KafkaConsumerGroup) MarshalTo(dAtA []byte) (int, error) {
+func (m *HTTPDependencyProbe) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *KafkaConsumerGroup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *HTTPDependencyProbe) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	i -= len(m.RebalanceStrategy)
-	copy(dAtA[i:], m.RebalanceStrategy)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.RebalanceStrategy)))
-	i--
-	dAtA[i] = 0x1a
-	i--
-	if m.Oldest {
-		dAtA[i] = 1
-	} else {
-		dAtA[i] = 0
+	if m.TLS != nil {
+		{
+			size, err := m.TLS.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
 	}
-	i--
-	dAtA[i] = 0x10
-	i -= len(m.GroupName)
-	copy(dAtA[i:], m.GroupName)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.GroupName)))
+	if len(m.StatusCodes) > 0 {
+		for iNdEx := len(m.StatusCodes) - 1; iNdEx >= 0; iNdEx-- {
+			i = encodeVarintGenerated(dAtA, i, uint64(m.StatusCodes[iNdEx]))
+			i--
+			dAtA[i] = 0x18
+		}
+	}
+	if len(m.Headers) > 0 {
+		keysForHeaders := make([]string, 0, len(m.Headers))
+		for k := range m.Headers {
+			keysForHeaders = append(keysForHeaders, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForHeaders)
+		for iNdEx := len(keysForHeaders) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Headers[string(keysForHeaders[iNdEx])]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForHeaders[iNdEx])
+			copy(dAtA[i:], keysForHeaders[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForHeaders[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	i -= len(m.URL)
+	copy(dAtA[i:], m.URL)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.URL)))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *KafkaEventSource) Marshal() (dAtA []byte, err error) {
+func (m *JenkinsEventSource) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5792,36 +8057,19 @@ func (m *KafkaEventSource) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *KafkaEventSource) MarshalTo(dAtA []byte) (int, error) {
+func (m *JenkinsEventSource) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *KafkaEventSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *JenkinsEventSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	i -= len(m.Config)
-	copy(dAtA[i:], m.Config)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Config)))
-	i--
-	dAtA[i] = 0x6a
-	if m.Filter != nil {
-		{
-			size, err := m.Filter.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x62
-	}
-	if m.SASL != nil {
+	if m.Transform != nil {
 		{
-			size, err := m.SASL.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Transform.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5829,19 +8077,11 @@ func (m *KafkaEventSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x5a
+		dAtA[i] = 0x3a
 	}
-	i -= len(m.Version)
-	copy(dAtA[i:], m.Version)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Version)))
-	i--
-	dAtA[i] = 0x52
-	i = encodeVarintGenerated(dAtA, i, uint64(m.LimitEventsPerSecond))
-	i--
-	dAtA[i] = 0x48
-	if m.ConsumerGroup != nil {
+	if m.Filter != nil {
 		{
-			size, err := m.ConsumerGroup.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Filter.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5849,7 +8089,238 @@ func (m *KafkaEventSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x42
+		dAtA[i] = 0x32
+	}
+	if len(m.Metadata) > 0 {
+		keysForMetadata := make([]string, 0, len(m.Metadata))
+		for k := range m.Metadata {
+			keysForMetadata = append(keysForMetadata, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForMetadata)
+		for iNdEx := len(keysForMetadata) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Metadata[string(keysForMetadata[iNdEx])]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForMetadata[iNdEx])
+			copy(dAtA[i:], keysForMetadata[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForMetadata[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Phases) > 0 {
+		for iNdEx := len(m.Phases) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Phases[iNdEx])
+			copy(dAtA[i:], m.Phases[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Phases[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Jobs) > 0 {
+		for iNdEx := len(m.Jobs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Jobs[iNdEx])
+			copy(dAtA[i:], m.Jobs[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Jobs[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Token != nil {
+		{
+			size, err := m.Token.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Webhook != nil {
+		{
+			size, err := m.Webhook.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *KafkaConsumerGroup) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *KafkaConsumerGroup) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *KafkaConsumerGroup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.RebalanceStrategy)
+	copy(dAtA[i:], m.RebalanceStrategy)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.RebalanceStrategy)))
+	i--
+	dAtA[i] = 0x1a
+	i--
+	if m.Oldest {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x10
+	i -= len(m.GroupName)
+	copy(dAtA[i:], m.GroupName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.GroupName)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *KafkaEventSource) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *KafkaEventSource) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *KafkaEventSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.HeaderExtensions) > 0 {
+		keysForHeaderExtensions := make([]string, 0, len(m.HeaderExtensions))
+		for k := range m.HeaderExtensions {
+			keysForHeaderExtensions = append(keysForHeaderExtensions, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForHeaderExtensions)
+		for iNdEx := len(keysForHeaderExtensions) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.HeaderExtensions[string(keysForHeaderExtensions[iNdEx])]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForHeaderExtensions[iNdEx])
+			copy(dAtA[i:], keysForHeaderExtensions[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForHeaderExtensions[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.HeaderFilters) > 0 {
+		for iNdEx := len(m.HeaderFilters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HeaderFilters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if m.Transform != nil {
+		{
+			size, err := m.Transform.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
+	}
+	i -= len(m.Config)
+	copy(dAtA[i:], m.Config)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Config)))
+	i--
+	dAtA[i] = 0x6a
+	if m.Filter != nil {
+		{
+			size, err := m.Filter.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.SASL != nil {
+		{
+			size, err := m.SASL.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	i -= len(m.Version)
+	copy(dAtA[i:], m.Version)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Version)))
+	i--
+	dAtA[i] = 0x52
+	i = encodeVarintGenerated(dAtA, i, uint64(m.LimitEventsPerSecond))
+	i--
+	dAtA[i] = 0x48
+	if m.ConsumerGroup != nil {
+		{
+			size, err := m.ConsumerGroup.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
 	}
 	if len(m.Metadata) > 0 {
 		keysForMetadata := make([]string, 0, len(m.Metadata))
@@ -5925,6 +8396,51 @@ func (m *KafkaEventSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *KafkaHeaderFilter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *KafkaHeaderFilter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *KafkaHeaderFilter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i--
+	if m.Negate {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x18
+	if len(m.Values) > 0 {
+		for iNdEx := len(m.Values) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Values[iNdEx])
+			copy(dAtA[i:], m.Values[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Values[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	i -= len(m.Key)
+	copy(dAtA[i:], m.Key)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Key)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *MQTTEventSource) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -5945,6 +8461,41 @@ func (m *MQTTEventSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i--
+	if m.PersistentSession {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x68
+	if m.SharedSubscription != nil {
+		{
+			size, err := m.SharedSubscription.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	i = encodeVarintGenerated(dAtA, i, uint64(m.QoS))
+	i--
+	dAtA[i] = 0x58
+	if m.Transform != nil {
+		{
+			size, err := m.Transform.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
 	if m.Auth != nil {
 		{
 			size, err := m.Auth.MarshalToSizedBuffer(dAtA[:i])
@@ -6043,6 +8594,34 @@ func (m *MQTTEventSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MQTTSharedSubscription) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MQTTSharedSubscription) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MQTTSharedSubscription) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Group)
+	copy(dAtA[i:], m.Group)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Group)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *NATSAuth) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -6134,6 +8713,18 @@ func (m *NATSEventsSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Transform != nil {
+		{
+			size, err := m.Transform.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
 	if m.Queue != nil {
 		i -= len(*m.Queue)
 		copy(dAtA[i:], *m.Queue)
@@ -6254,6 +8845,18 @@ func (m *NSQEventSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Transform != nil {
+		{
+			size, err := m.Transform.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
 	if m.Filter != nil {
 		{
 			size, err := m.Filter.MarshalToSizedBuffer(dAtA[:i])
@@ -6397,6 +9000,18 @@ func (m *PubSubEventSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Transform != nil {
+		{
+			size, err := m.Transform.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
 	if m.Filter != nil {
 		{
 			size, err := m.Filter.MarshalToSizedBuffer(dAtA[:i])
@@ -6504,6 +9119,18 @@ func (m *PulsarEventSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Transform != nil {
+		{
+			size, err := m.Transform.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x7a
+	}
 	if m.AuthAthenzSecret != nil {
 		{
 			size, err := m.AuthAthenzSecret.MarshalToSizedBuffer(dAtA[:i])
@@ -6690,6 +9317,18 @@ func (m *RedisEventSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Transform != nil {
+		{
+			size, err := m.Transform.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
 	i -= len(m.Username)
 	copy(dAtA[i:], m.Username)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Username)))
@@ -6808,6 +9447,18 @@ func (m *RedisStreamEventSource) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if m.Transform != nil {
+		{
+			size, err := m.Transform.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
 	i -= len(m.Username)
 	copy(dAtA[i:], m.Username)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Username)))
@@ -7078,6 +9729,18 @@ func (m *SFTPEventSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Transform != nil {
+		{
+			size, err := m.Transform.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
 	i -= len(m.PollIntervalDuration)
 	copy(dAtA[i:], m.PollIntervalDuration)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.PollIntervalDuration)))
@@ -7205,6 +9868,18 @@ func (m *SNSEventSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Transform != nil {
+		{
+			size, err := m.Transform.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
 	i -= len(m.Endpoint)
 	copy(dAtA[i:], m.Endpoint)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Endpoint)))
@@ -7308,7 +9983,7 @@ func (m *SNSEventSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *SQSEventSource) Marshal() (dAtA []byte, err error) {
+func (m *SQLEventSource) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7318,19 +9993,19 @@ func (m *SQSEventSource) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SQSEventSource) MarshalTo(dAtA []byte) (int, error) {
+func (m *SQLEventSource) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SQSEventSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SQLEventSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.SessionToken != nil {
+	if m.Transform != nil {
 		{
-			size, err := m.SessionToken.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Transform.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -7338,13 +10013,8 @@ func (m *SQSEventSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x6a
+		dAtA[i] = 0x52
 	}
-	i -= len(m.Endpoint)
-	copy(dAtA[i:], m.Endpoint)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Endpoint)))
-	i--
-	dAtA[i] = 0x62
 	if m.Filter != nil {
 		{
 			size, err := m.Filter.MarshalToSizedBuffer(dAtA[:i])
@@ -7355,16 +10025,8 @@ func (m *SQSEventSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x5a
-	}
-	i--
-	if m.DLQ {
-		dAtA[i] = 1
-	} else {
-		dAtA[i] = 0
+		dAtA[i] = 0x4a
 	}
-	i--
-	dAtA[i] = 0x50
 	if len(m.Metadata) > 0 {
 		keysForMetadata := make([]string, 0, len(m.Metadata))
 		for k := range m.Metadata {
@@ -7386,43 +10048,12 @@ func (m *SQSEventSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			dAtA[i] = 0xa
 			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
 			i--
-			dAtA[i] = 0x4a
+			dAtA[i] = 0x42
 		}
 	}
-	i -= len(m.QueueAccountID)
-	copy(dAtA[i:], m.QueueAccountID)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.QueueAccountID)))
-	i--
-	dAtA[i] = 0x42
-	i--
-	if m.JSONBody {
-		dAtA[i] = 1
-	} else {
-		dAtA[i] = 0
-	}
-	i--
-	dAtA[i] = 0x38
-	i -= len(m.RoleARN)
-	copy(dAtA[i:], m.RoleARN)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.RoleARN)))
-	i--
-	dAtA[i] = 0x32
-	i = encodeVarintGenerated(dAtA, i, uint64(m.WaitTimeSeconds))
-	i--
-	dAtA[i] = 0x28
-	i -= len(m.Queue)
-	copy(dAtA[i:], m.Queue)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Queue)))
-	i--
-	dAtA[i] = 0x22
-	i -= len(m.Region)
-	copy(dAtA[i:], m.Region)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Region)))
-	i--
-	dAtA[i] = 0x1a
-	if m.SecretKey != nil {
+	if m.Persistence != nil {
 		{
-			size, err := m.SecretKey.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Persistence.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -7430,11 +10061,31 @@ func (m *SQSEventSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x3a
 	}
-	if m.AccessKey != nil {
+	i -= len(m.PollInterval)
+	copy(dAtA[i:], m.PollInterval)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.PollInterval)))
+	i--
+	dAtA[i] = 0x32
+	i -= len(m.InitialCursor)
+	copy(dAtA[i:], m.InitialCursor)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.InitialCursor)))
+	i--
+	dAtA[i] = 0x2a
+	i -= len(m.CursorColumn)
+	copy(dAtA[i:], m.CursorColumn)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.CursorColumn)))
+	i--
+	dAtA[i] = 0x22
+	i -= len(m.Query)
+	copy(dAtA[i:], m.Query)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Query)))
+	i--
+	dAtA[i] = 0x1a
+	if m.DSN != nil {
 		{
-			size, err := m.AccessKey.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.DSN.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -7442,92 +10093,17 @@ func (m *SQSEventSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *Selector) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+		dAtA[i] = 0x12
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Selector) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *Selector) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	i -= len(m.Value)
-	copy(dAtA[i:], m.Value)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Value)))
-	i--
-	dAtA[i] = 0x1a
-	i -= len(m.Operation)
-	copy(dAtA[i:], m.Operation)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Operation)))
-	i--
-	dAtA[i] = 0x12
-	i -= len(m.Key)
-	copy(dAtA[i:], m.Key)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Key)))
+	i -= len(m.Driver)
+	copy(dAtA[i:], m.Driver)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Driver)))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *Service) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *Service) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *Service) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	i -= len(m.ClusterIP)
-	copy(dAtA[i:], m.ClusterIP)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ClusterIP)))
-	i--
-	dAtA[i] = 0x12
-	if len(m.Ports) > 0 {
-		for iNdEx := len(m.Ports) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Ports[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenerated(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *SlackEventSource) Marshal() (dAtA []byte, err error) {
+func (m *SQSEventSource) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7537,19 +10113,19 @@ func (m *SlackEventSource) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SlackEventSource) MarshalTo(dAtA []byte) (int, error) {
+func (m *SQSEventSource) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SlackEventSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SQSEventSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Filter != nil {
+	if m.Transform != nil {
 		{
-			size, err := m.Filter.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Transform.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -7557,47 +10133,11 @@ func (m *SlackEventSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.Metadata) > 0 {
-		keysForMetadata := make([]string, 0, len(m.Metadata))
-		for k := range m.Metadata {
-			keysForMetadata = append(keysForMetadata, string(k))
-		}
-		github_com_gogo_protobuf_sortkeys.Strings(keysForMetadata)
-		for iNdEx := len(keysForMetadata) - 1; iNdEx >= 0; iNdEx-- {
-			v := m.Metadata[string(keysForMetadata[iNdEx])]
-			baseI := i
-			i -= len(v)
-			copy(dAtA[i:], v)
-			i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
-			i--
-			dAtA[i] = 0x12
-			i -= len(keysForMetadata[iNdEx])
-			copy(dAtA[i:], keysForMetadata[iNdEx])
-			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForMetadata[iNdEx])))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x22
-		}
-	}
-	if m.Webhook != nil {
-		{
-			size, err := m.Webhook.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x72
 	}
-	if m.Token != nil {
+	if m.SessionToken != nil {
 		{
-			size, err := m.Token.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.SessionToken.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -7605,11 +10145,16 @@ func (m *SlackEventSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x6a
 	}
-	if m.SigningSecret != nil {
+	i -= len(m.Endpoint)
+	copy(dAtA[i:], m.Endpoint)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Endpoint)))
+	i--
+	dAtA[i] = 0x62
+	if m.Filter != nil {
 		{
-			size, err := m.SigningSecret.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Filter.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -7617,31 +10162,305 @@ func (m *SlackEventSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x5a
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *StorageGridEventSource) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	i--
+	if m.DLQ {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
 	}
-	return dAtA[:n], nil
-}
-
-func (m *StorageGridEventSource) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *StorageGridEventSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
+	i--
+	dAtA[i] = 0x50
+	if len(m.Metadata) > 0 {
+		keysForMetadata := make([]string, 0, len(m.Metadata))
+		for k := range m.Metadata {
+			keysForMetadata = append(keysForMetadata, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForMetadata)
+		for iNdEx := len(keysForMetadata) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Metadata[string(keysForMetadata[iNdEx])]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForMetadata[iNdEx])
+			copy(dAtA[i:], keysForMetadata[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForMetadata[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	i -= len(m.QueueAccountID)
+	copy(dAtA[i:], m.QueueAccountID)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.QueueAccountID)))
+	i--
+	dAtA[i] = 0x42
+	i--
+	if m.JSONBody {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x38
+	i -= len(m.RoleARN)
+	copy(dAtA[i:], m.RoleARN)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.RoleARN)))
+	i--
+	dAtA[i] = 0x32
+	i = encodeVarintGenerated(dAtA, i, uint64(m.WaitTimeSeconds))
+	i--
+	dAtA[i] = 0x28
+	i -= len(m.Queue)
+	copy(dAtA[i:], m.Queue)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Queue)))
+	i--
+	dAtA[i] = 0x22
+	i -= len(m.Region)
+	copy(dAtA[i:], m.Region)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Region)))
+	i--
+	dAtA[i] = 0x1a
+	if m.SecretKey != nil {
+		{
+			size, err := m.SecretKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.AccessKey != nil {
+		{
+			size, err := m.AccessKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Selector) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Selector) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Selector) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Value)
+	copy(dAtA[i:], m.Value)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Value)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Operation)
+	copy(dAtA[i:], m.Operation)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Operation)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Key)
+	copy(dAtA[i:], m.Key)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Key)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Service) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Service) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Service) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.ClusterIP)
+	copy(dAtA[i:], m.ClusterIP)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ClusterIP)))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Ports) > 0 {
+		for iNdEx := len(m.Ports) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Ports[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SlackEventSource) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SlackEventSource) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SlackEventSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Transform != nil {
+		{
+			size, err := m.Transform.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Filter != nil {
+		{
+			size, err := m.Filter.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Metadata) > 0 {
+		keysForMetadata := make([]string, 0, len(m.Metadata))
+		for k := range m.Metadata {
+			keysForMetadata = append(keysForMetadata, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForMetadata)
+		for iNdEx := len(keysForMetadata) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Metadata[string(keysForMetadata[iNdEx])]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForMetadata[iNdEx])
+			copy(dAtA[i:], keysForMetadata[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForMetadata[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Webhook != nil {
+		{
+			size, err := m.Webhook.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Token != nil {
+		{
+			size, err := m.Token.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.SigningSecret != nil {
+		{
+			size, err := m.SigningSecret.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StorageGridEventSource) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StorageGridEventSource) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StorageGridEventSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	if len(m.Metadata) > 0 {
 		keysForMetadata := make([]string, 0, len(m.Metadata))
 		for k := range m.Metadata {
@@ -7855,6 +10674,38 @@ func (m *StripeEventSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *TCPDependencyProbe) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TCPDependencyProbe) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TCPDependencyProbe) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addresses[iNdEx])
+			copy(dAtA[i:], m.Addresses[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Template) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -7875,6 +10726,13 @@ func (m *Template) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.RuntimeClassName != nil {
+		i -= len(*m.RuntimeClassName)
+		copy(dAtA[i:], *m.RuntimeClassName)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.RuntimeClassName)))
+		i--
+		dAtA[i] = 0x62
+	}
 	if m.Priority != nil {
 		i = encodeVarintGenerated(dAtA, i, uint64(*m.Priority))
 		i--
@@ -8065,6 +10923,74 @@ func (m *WebhookContext) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Redelivery != nil {
+		{
+			size, err := m.Redelivery.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if m.Enrichment != nil {
+		{
+			size, err := m.Enrichment.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	i -= len(m.ServiceGroup)
+	copy(dAtA[i:], m.ServiceGroup)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ServiceGroup)))
+	i--
+	dAtA[i] = 0x7a
+	i -= len(m.Host)
+	copy(dAtA[i:], m.Host)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Host)))
+	i--
+	dAtA[i] = 0x72
+	i -= len(m.PayloadSchema)
+	copy(dAtA[i:], m.PayloadSchema)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.PayloadSchema)))
+	i--
+	dAtA[i] = 0x6a
+	i -= len(m.TokenRotationPeriod)
+	copy(dAtA[i:], m.TokenRotationPeriod)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.TokenRotationPeriod)))
+	i--
+	dAtA[i] = 0x62
+	i--
+	if m.GenerateToken {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x58
+	if m.Replay != nil {
+		{
+			size, err := m.Replay.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
 	if m.MaxPayloadSize != nil {
 		i = encodeVarintGenerated(dAtA, i, uint64(*m.MaxPayloadSize))
 		i--
@@ -8153,6 +11079,62 @@ func (m *WebhookContext) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *WebhookEnrichment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WebhookEnrichment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WebhookEnrichment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Networks) > 0 {
+		for iNdEx := len(m.Networks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Networks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	i -= len(m.ClientIdentityHeader)
+	copy(dAtA[i:], m.ClientIdentityHeader)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ClientIdentityHeader)))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.TrustedProxies) > 0 {
+		for iNdEx := len(m.TrustedProxies) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.TrustedProxies[iNdEx])
+			copy(dAtA[i:], m.TrustedProxies[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.TrustedProxies[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	i -= len(m.Key)
+	copy(dAtA[i:], m.Key)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Key)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *WebhookEventSource) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -8173,6 +11155,18 @@ func (m *WebhookEventSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Transform != nil {
+		{
+			size, err := m.Transform.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.Filter != nil {
 		{
 			size, err := m.Filter.MarshalToSizedBuffer(dAtA[:i])
@@ -8198,69 +11192,238 @@ func (m *WebhookEventSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintGenerated(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGenerated(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *WebhookNetwork) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *AMQPConsumeConfig) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *WebhookNetwork) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WebhookNetwork) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ConsumerTag)
-	n += 1 + l + sovGenerated(uint64(l))
-	n += 2
-	n += 2
-	n += 2
-	n += 2
-	return n
+	if len(m.CIDRs) > 0 {
+		for iNdEx := len(m.CIDRs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.CIDRs[iNdEx])
+			copy(dAtA[i:], m.CIDRs[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.CIDRs[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *AMQPEventSource) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *WebhookRedelivery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *WebhookRedelivery) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WebhookRedelivery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.URL)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.ExchangeName)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.ExchangeType)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.RoutingKey)
-	n += 1 + l + sovGenerated(uint64(l))
-	if m.ConnectionBackoff != nil {
-		l = m.ConnectionBackoff.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	n += 2
-	if m.TLS != nil {
-		l = m.TLS.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	if len(m.Metadata) > 0 {
-		for k, v := range m.Metadata {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
-			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
-		}
-	}
-	if m.ExchangeDeclare != nil {
-		l = m.ExchangeDeclare.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+	i--
+	if m.Suppress {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
 	}
-	if m.QueueDeclare != nil {
-		l = m.QueueDeclare.Size()
+	i--
+	dAtA[i] = 0x28
+	i -= len(m.Window)
+	copy(dAtA[i:], m.Window)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Window)))
+	i--
+	dAtA[i] = 0x22
+	i -= len(m.AttemptHeader)
+	copy(dAtA[i:], m.AttemptHeader)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.AttemptHeader)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.DeliveryIDPath)
+	copy(dAtA[i:], m.DeliveryIDPath)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.DeliveryIDPath)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.DeliveryIDHeader)
+	copy(dAtA[i:], m.DeliveryIDHeader)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.DeliveryIDHeader)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *WebhookReplay) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WebhookReplay) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WebhookReplay) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Dir)
+	copy(dAtA[i:], m.Dir)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Dir)))
+	i--
+	dAtA[i] = 0x1a
+	if m.AuthSecret != nil {
+		{
+			size, err := m.AuthSecret.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	i = encodeVarintGenerated(dAtA, i, uint64(m.MaxDeliveries))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+
+func (m *WebhookTokenRotation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WebhookTokenRotation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WebhookTokenRotation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.NextRotationTime.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.EventName)
+	copy(dAtA[i:], m.EventName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.EventName)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenerated(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenerated(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *AMQPConsumeConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsumerTag)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
+	n += 2
+	n += 2
+	n += 2
+	return n
+}
+
+func (m *AMQPEventSource) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.URL)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.ExchangeName)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.ExchangeType)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.RoutingKey)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.ConnectionBackoff != nil {
+		l = m.ConnectionBackoff.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	n += 2
+	if m.TLS != nil {
+		l = m.TLS.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if len(m.Metadata) > 0 {
+		for k, v := range m.Metadata {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	if m.ExchangeDeclare != nil {
+		l = m.ExchangeDeclare.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.QueueDeclare != nil {
+		l = m.QueueDeclare.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
 	if m.QueueBind != nil {
@@ -8283,6 +11446,10 @@ func (m *AMQPEventSource) Size() (n int) {
 		l = m.Filter.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.Transform != nil {
+		l = m.Transform.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -8356,6 +11523,10 @@ func (m *AzureEventsHubEventSource) Size() (n int) {
 		l = m.Filter.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.Transform != nil {
+		l = m.Transform.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -8391,6 +11562,16 @@ func (m *AzureQueueStorageEventSource) Size() (n int) {
 	if m.WaitTimeInSeconds != nil {
 		n += 1 + sovGenerated(uint64(*m.WaitTimeInSeconds))
 	}
+	if m.Transform != nil {
+		l = m.Transform.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.VisibilityTimeoutInSeconds != nil {
+		n += 1 + sovGenerated(uint64(*m.VisibilityTimeoutInSeconds))
+	}
+	n += 1 + sovGenerated(uint64(m.MaxDequeueCount))
+	l = len(m.PoisonQueueName)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -8429,6 +11610,10 @@ func (m *AzureServiceBusEventSource) Size() (n int) {
 	}
 	l = len(m.FullyQualifiedNamespace)
 	n += 1 + l + sovGenerated(uint64(l))
+	if m.Transform != nil {
+		l = m.Transform.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -8511,6 +11696,11 @@ func (m *BitbucketEventSource) Size() (n int) {
 		l = m.Filter.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.Transform != nil {
+		l = m.Transform.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	n += 2
 	return n
 }
 
@@ -8589,6 +11779,11 @@ func (m *BitbucketServerEventSource) Size() (n int) {
 	}
 	l = len(m.CheckInterval)
 	n += 1 + l + sovGenerated(uint64(l))
+	if m.Transform != nil {
+		l = m.Transform.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	n += 3
 	return n
 }
 
@@ -8639,6 +11834,29 @@ func (m *CalendarEventSource) Size() (n int) {
 		l = m.Filter.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.Transform != nil {
+		l = m.Transform.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.DSTPolicy)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *CalendarStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EventName)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.NextFireTimes) > 0 {
+		for _, e := range m.NextFireTimes {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -8654,6 +11872,53 @@ func (m *CatchupConfiguration) Size() (n int) {
 	return n
 }
 
+func (m *ClusterEventSource) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ObjectMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Spec.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Status.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *ClusterEventSourceList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ListMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ClusterEventSourceSpec) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NamespaceSelector != nil {
+		l = m.NamespaceSelector.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = m.EventSource.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *ConfigMapPersistence) Size() (n int) {
 	if m == nil {
 		return 0
@@ -8666,6 +11931,136 @@ func (m *ConfigMapPersistence) Size() (n int) {
 	return n
 }
 
+func (m *DependencyProbe) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.TCP != nil {
+		l = m.TCP.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.HTTP != nil {
+		l = m.HTTP.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.S3 != nil {
+		l = m.S3.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.Interval)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Timeout)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *DynamoDBStreamsEventSource) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Table)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.StreamARN)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Region)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.AccessKey != nil {
+		l = m.AccessKey.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.SecretKey != nil {
+		l = m.SecretKey.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.RoleARN)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Endpoint)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.StartingPosition)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Images)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.PollInterval)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Persistence != nil {
+		l = m.Persistence.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if len(m.Metadata) > 0 {
+		for k, v := range m.Metadata {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	if m.Filter != nil {
+		l = m.Filter.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Transform != nil {
+		l = m.Transform.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *ElasticsearchEventSource) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.URL)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Index)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Query)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.SortField)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.TiebreakerField)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.PollInterval)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.BatchSize))
+	if m.BasicAuth != nil {
+		l = m.BasicAuth.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.APIKey != nil {
+		l = m.APIKey.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.TLS != nil {
+		l = m.TLS.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if len(m.Metadata) > 0 {
+		for k, v := range m.Metadata {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	if m.Filter != nil {
+		l = m.Filter.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Transform != nil {
+		l = m.Transform.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
 func (m *EmitterEventSource) Size() (n int) {
 	if m == nil {
 		return 0
@@ -8707,6 +12102,25 @@ func (m *EmitterEventSource) Size() (n int) {
 		l = m.Filter.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.Transform != nil {
+		l = m.Transform.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *EventCatalogEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Description)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Schema)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Sample)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -8727,6 +12141,22 @@ func (m *EventPersistence) Size() (n int) {
 	return n
 }
 
+func (m *EventSizeLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovGenerated(uint64(m.Bytes))
+	l = len(m.Policy)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.ClaimCheck != nil {
+		l = m.ClaimCheck.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
 func (m *EventSource) Size() (n int) {
 	if m == nil {
 		return 0
@@ -8753,6 +12183,21 @@ func (m *EventSourceFilter) Size() (n int) {
 	return n
 }
 
+func (m *EventSourceInclude) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ConfigMap != nil {
+		l = m.ConfigMap.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.Namespace)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *EventSourceList) Size() (n int) {
 	if m == nil {
 		return 0
@@ -9068,6 +12513,84 @@ func (m *EventSourceSpec) Size() (n int) {
 			n += mapEntrySize + 2 + sovGenerated(uint64(mapEntrySize))
 		}
 	}
+	if m.RemoteEventBus != nil {
+		l = m.RemoteEventBus.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	if len(m.Includes) > 0 {
+		for _, e := range m.Includes {
+			l = e.Size()
+			n += 2 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.MaxEventSize != nil {
+		l = m.MaxEventSize.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	if m.Metrics != nil {
+		l = m.Metrics.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	if len(m.Elasticsearch) > 0 {
+		for k, v := range m.Elasticsearch {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + l + sovGenerated(uint64(l))
+			n += mapEntrySize + 2 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Jenkins) > 0 {
+		for k, v := range m.Jenkins {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + l + sovGenerated(uint64(l))
+			n += mapEntrySize + 2 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	if len(m.SQL) > 0 {
+		for k, v := range m.SQL {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + l + sovGenerated(uint64(l))
+			n += mapEntrySize + 2 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	if len(m.DependencyProbes) > 0 {
+		for _, e := range m.DependencyProbes {
+			l = e.Size()
+			n += 2 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.DynamoDBStreams) > 0 {
+		for k, v := range m.DynamoDBStreams {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + l + sovGenerated(uint64(l))
+			n += mapEntrySize + 2 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Catalog) > 0 {
+		for k, v := range m.Catalog {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + l + sovGenerated(uint64(l))
+			n += mapEntrySize + 2 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	if len(m.GRPCStream) > 0 {
+		for k, v := range m.GRPCStream {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + l + sovGenerated(uint64(l))
+			n += mapEntrySize + 2 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
 	return n
 }
 
@@ -9079,6 +12602,39 @@ func (m *EventSourceStatus) Size() (n int) {
 	_ = l
 	l = m.Status.Size()
 	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Calendars) > 0 {
+		for _, e := range m.Calendars {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	l = len(m.WebhookTokenSecret)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.WebhookTokenRotations) > 0 {
+		for _, e := range m.WebhookTokenRotations {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.Retries) > 0 {
+		for _, e := range m.Retries {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventSourceTransform) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.JQ)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Script)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -9105,6 +12661,52 @@ func (m *FileEventSource) Size() (n int) {
 		l = m.Filter.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.Transform != nil {
+		l = m.Transform.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *GRPCStreamEventSource) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.URL)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Topic)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.TLS != nil {
+		l = m.TLS.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	n += 2
+	if m.AuthSecret != nil {
+		l = m.AuthSecret.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.ConnectionBackoff != nil {
+		l = m.ConnectionBackoff.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if len(m.Metadata) > 0 {
+		for k, v := range m.Metadata {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	if m.Filter != nil {
+		l = m.Filter.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Transform != nil {
+		l = m.Transform.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -9136,6 +12738,10 @@ func (m *GenericEventSource) Size() (n int) {
 		l = m.Filter.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.Transform != nil {
+		l = m.Transform.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -9183,6 +12789,10 @@ func (m *GerritEventSource) Size() (n int) {
 		l = m.Filter.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.Transform != nil {
+		l = m.Transform.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -9267,6 +12877,15 @@ func (m *GithubEventSource) Size() (n int) {
 		l = m.Filter.Size()
 		n += 2 + l + sovGenerated(uint64(l))
 	}
+	if m.Transform != nil {
+		l = m.Transform.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	if m.RegistrationPersistence != nil {
+		l = m.RegistrationPersistence.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	n += 3
 	return n
 }
 
@@ -9324,6 +12943,15 @@ func (m *GitlabEventSource) Size() (n int) {
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
+	if m.Transform != nil {
+		l = m.Transform.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.RegistrationPersistence != nil {
+		l = m.RegistrationPersistence.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	n += 3
 	return n
 }
 
@@ -9377,6 +13005,83 @@ func (m *HDFSEventSource) Size() (n int) {
 		l = m.Filter.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.Transform != nil {
+		l = m.Transform.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *HTTPDependencyProbe) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.URL)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Headers) > 0 {
+		for k, v := range m.Headers {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	if len(m.StatusCodes) > 0 {
+		for _, e := range m.StatusCodes {
+			n += 1 + sovGenerated(uint64(e))
+		}
+	}
+	if m.TLS != nil {
+		l = m.TLS.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *JenkinsEventSource) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Webhook != nil {
+		l = m.Webhook.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Token != nil {
+		l = m.Token.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if len(m.Jobs) > 0 {
+		for _, s := range m.Jobs {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.Phases) > 0 {
+		for _, s := range m.Phases {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.Metadata) > 0 {
+		for k, v := range m.Metadata {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	if m.Filter != nil {
+		l = m.Filter.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Transform != nil {
+		l = m.Transform.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -9440,6 +13145,42 @@ func (m *KafkaEventSource) Size() (n int) {
 	}
 	l = len(m.Config)
 	n += 1 + l + sovGenerated(uint64(l))
+	if m.Transform != nil {
+		l = m.Transform.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if len(m.HeaderFilters) > 0 {
+		for _, e := range m.HeaderFilters {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.HeaderExtensions) > 0 {
+		for k, v := range m.HeaderExtensions {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			n += mapEntrySize + 2 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *KafkaHeaderFilter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Values) > 0 {
+		for _, s := range m.Values {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	n += 2
 	return n
 }
 
@@ -9480,6 +13221,27 @@ func (m *MQTTEventSource) Size() (n int) {
 		l = m.Auth.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.Transform != nil {
+		l = m.Transform.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	n += 1 + sovGenerated(uint64(m.QoS))
+	if m.SharedSubscription != nil {
+		l = m.SharedSubscription.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	n += 2
+	return n
+}
+
+func (m *MQTTSharedSubscription) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Group)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -9547,6 +13309,10 @@ func (m *NATSEventsSource) Size() (n int) {
 		l = len(*m.Queue)
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.Transform != nil {
+		l = m.Transform.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -9583,6 +13349,10 @@ func (m *NSQEventSource) Size() (n int) {
 		l = m.Filter.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.Transform != nil {
+		l = m.Transform.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -9635,6 +13405,10 @@ func (m *PubSubEventSource) Size() (n int) {
 		l = m.Filter.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.Transform != nil {
+		l = m.Transform.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -9697,6 +13471,10 @@ func (m *PulsarEventSource) Size() (n int) {
 		l = m.AuthAthenzSecret.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.Transform != nil {
+		l = m.Transform.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -9740,6 +13518,10 @@ func (m *RedisEventSource) Size() (n int) {
 	n += 2
 	l = len(m.Username)
 	n += 1 + l + sovGenerated(uint64(l))
+	if m.Transform != nil {
+		l = m.Transform.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -9783,6 +13565,10 @@ func (m *RedisStreamEventSource) Size() (n int) {
 	}
 	l = len(m.Username)
 	n += 1 + l + sovGenerated(uint64(l))
+	if m.Transform != nil {
+		l = m.Transform.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -9883,6 +13669,10 @@ func (m *SFTPEventSource) Size() (n int) {
 	}
 	l = len(m.PollIntervalDuration)
 	n += 1 + l + sovGenerated(uint64(l))
+	if m.Transform != nil {
+		l = m.Transform.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -9925,6 +13715,53 @@ func (m *SNSEventSource) Size() (n int) {
 	}
 	l = len(m.Endpoint)
 	n += 1 + l + sovGenerated(uint64(l))
+	if m.Transform != nil {
+		l = m.Transform.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *SQLEventSource) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Driver)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.DSN != nil {
+		l = m.DSN.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.Query)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.CursorColumn)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.InitialCursor)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.PollInterval)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Persistence != nil {
+		l = m.Persistence.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if len(m.Metadata) > 0 {
+		for k, v := range m.Metadata {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	if m.Filter != nil {
+		l = m.Filter.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Transform != nil {
+		l = m.Transform.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -9971,6 +13808,10 @@ func (m *SQSEventSource) Size() (n int) {
 		l = m.SessionToken.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.Transform != nil {
+		l = m.Transform.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -10036,6 +13877,10 @@ func (m *SlackEventSource) Size() (n int) {
 		l = m.Filter.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.Transform != nil {
+		l = m.Transform.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -10127,6 +13972,21 @@ func (m *StripeEventSource) Size() (n int) {
 	return n
 }
 
+func (m *TCPDependencyProbe) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *Template) Size() (n int) {
 	if m == nil {
 		return 0
@@ -10182,6 +14042,10 @@ func (m *Template) Size() (n int) {
 	if m.Priority != nil {
 		n += 1 + sovGenerated(uint64(*m.Priority))
 	}
+	if m.RuntimeClassName != nil {
+		l = len(*m.RuntimeClassName)
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -10237,6 +14101,52 @@ func (m *WebhookContext) Size() (n int) {
 	if m.MaxPayloadSize != nil {
 		n += 1 + sovGenerated(uint64(*m.MaxPayloadSize))
 	}
+	if m.Replay != nil {
+		l = m.Replay.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	n += 2
+	l = len(m.TokenRotationPeriod)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.PayloadSchema)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Host)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.ServiceGroup)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Enrichment != nil {
+		l = m.Enrichment.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	if m.Redelivery != nil {
+		l = m.Redelivery.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *WebhookEnrichment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.TrustedProxies) > 0 {
+		for _, s := range m.TrustedProxies {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	l = len(m.ClientIdentityHeader)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Networks) > 0 {
+		for _, e := range m.Networks {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -10252,6 +14162,74 @@ func (m *WebhookEventSource) Size() (n int) {
 		l = m.Filter.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.Transform != nil {
+		l = m.Transform.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *WebhookNetwork) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.CIDRs) > 0 {
+		for _, s := range m.CIDRs {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *WebhookRedelivery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DeliveryIDHeader)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.DeliveryIDPath)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.AttemptHeader)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Window)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
+	return n
+}
+
+func (m *WebhookReplay) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovGenerated(uint64(m.MaxDeliveries))
+	if m.AuthSecret != nil {
+		l = m.AuthSecret.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.Dir)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *WebhookTokenRotation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EventName)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.NextRotationTime.Size()
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -10305,6 +14283,7 @@ func (this *AMQPEventSource) String() string {
 		`Auth:` + strings.Replace(fmt.Sprintf("%v", this.Auth), "BasicAuth", "common.BasicAuth", 1) + `,`,
 		`URLSecret:` + strings.Replace(fmt.Sprintf("%v", this.URLSecret), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
 		`Filter:` + strings.Replace(this.Filter.String(), "EventSourceFilter", "EventSourceFilter", 1) + `,`,
+		`Transform:` + strings.Replace(this.Transform.String(), "EventSourceTransform", "EventSourceTransform", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -10368,6 +14347,7 @@ func (this *AzureEventsHubEventSource) String() string {
 		`HubName:` + fmt.Sprintf("%v", this.HubName) + `,`,
 		`Metadata:` + mapStringForMetadata + `,`,
 		`Filter:` + strings.Replace(this.Filter.String(), "EventSourceFilter", "EventSourceFilter", 1) + `,`,
+		`Transform:` + strings.Replace(this.Transform.String(), "EventSourceTransform", "EventSourceTransform", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -10396,6 +14376,10 @@ func (this *AzureQueueStorageEventSource) String() string {
 		`Filter:` + strings.Replace(this.Filter.String(), "EventSourceFilter", "EventSourceFilter", 1) + `,`,
 		`DecodeMessage:` + fmt.Sprintf("%v", this.DecodeMessage) + `,`,
 		`WaitTimeInSeconds:` + valueToStringGenerated(this.WaitTimeInSeconds) + `,`,
+		`Transform:` + strings.Replace(this.Transform.String(), "EventSourceTransform", "EventSourceTransform", 1) + `,`,
+		`VisibilityTimeoutInSeconds:` + valueToStringGenerated(this.VisibilityTimeoutInSeconds) + `,`,
+		`MaxDequeueCount:` + fmt.Sprintf("%v", this.MaxDequeueCount) + `,`,
+		`PoisonQueueName:` + fmt.Sprintf("%v", this.PoisonQueueName) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -10424,6 +14408,7 @@ func (this *AzureServiceBusEventSource) String() string {
 		`Metadata:` + mapStringForMetadata + `,`,
 		`Filter:` + strings.Replace(this.Filter.String(), "EventSourceFilter", "EventSourceFilter", 1) + `,`,
 		`FullyQualifiedNamespace:` + fmt.Sprintf("%v", this.FullyQualifiedNamespace) + `,`,
+		`Transform:` + strings.Replace(this.Transform.String(), "EventSourceTransform", "EventSourceTransform", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -10480,6 +14465,8 @@ func (this *BitbucketEventSource) String() string {
 		`DeprecatedRepositorySlug:` + fmt.Sprintf("%v", this.DeprecatedRepositorySlug) + `,`,
 		`Repositories:` + repeatedStringForRepositories + `,`,
 		`Filter:` + strings.Replace(this.Filter.String(), "EventSourceFilter", "EventSourceFilter", 1) + `,`,
+		`Transform:` + strings.Replace(this.Transform.String(), "EventSourceTransform", "EventSourceTransform", 1) + `,`,
+		`Normalize:` + fmt.Sprintf("%v", this.Normalize) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -10530,6 +14517,8 @@ func (this *BitbucketServerEventSource) String() string {
 		`Filter:` + strings.Replace(this.Filter.String(), "EventSourceFilter", "EventSourceFilter", 1) + `,`,
 		`TLS:` + strings.Replace(fmt.Sprintf("%v", this.TLS), "TLSConfig", "common.TLSConfig", 1) + `,`,
 		`CheckInterval:` + fmt.Sprintf("%v", this.CheckInterval) + `,`,
+		`Transform:` + strings.Replace(this.Transform.String(), "EventSourceTransform", "EventSourceTransform", 1) + `,`,
+		`Normalize:` + fmt.Sprintf("%v", this.Normalize) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -10567,6 +14556,24 @@ func (this *CalendarEventSource) String() string {
 		`Metadata:` + mapStringForMetadata + `,`,
 		`Persistence:` + strings.Replace(this.Persistence.String(), "EventPersistence", "EventPersistence", 1) + `,`,
 		`Filter:` + strings.Replace(this.Filter.String(), "EventSourceFilter", "EventSourceFilter", 1) + `,`,
+		`Transform:` + strings.Replace(this.Transform.String(), "EventSourceTransform", "EventSourceTransform", 1) + `,`,
+		`DSTPolicy:` + fmt.Sprintf("%v", this.DSTPolicy) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CalendarStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForNextFireTimes := "[]Time{"
+	for _, f := range this.NextFireTimes {
+		repeatedStringForNextFireTimes += fmt.Sprintf("%v", f) + ","
+	}
+	repeatedStringForNextFireTimes += "}"
+	s := strings.Join([]string{`&CalendarStatus{`,
+		`EventName:` + fmt.Sprintf("%v", this.EventName) + `,`,
+		`NextFireTimes:` + repeatedStringForNextFireTimes + `,`,
 		`}`,
 	}, "")
 	return s
@@ -10582,6 +14589,45 @@ func (this *CatchupConfiguration) String() string {
 	}, "")
 	return s
 }
+func (this *ClusterEventSource) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ClusterEventSource{`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v11.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "ClusterEventSourceSpec", "ClusterEventSourceSpec", 1), `&`, ``, 1) + `,`,
+		`Status:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Status), "ClusterResourceStatus", "common.ClusterResourceStatus", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ClusterEventSourceList) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForItems := "[]ClusterEventSource{"
+	for _, f := range this.Items {
+		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "ClusterEventSource", "ClusterEventSource", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForItems += "}"
+	s := strings.Join([]string{`&ClusterEventSourceList{`,
+		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v11.ListMeta", 1), `&`, ``, 1) + `,`,
+		`Items:` + repeatedStringForItems + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ClusterEventSourceSpec) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ClusterEventSourceSpec{`,
+		`NamespaceSelector:` + strings.Replace(fmt.Sprintf("%v", this.NamespaceSelector), "LabelSelector", "v11.LabelSelector", 1) + `,`,
+		`EventSource:` + strings.Replace(strings.Replace(this.EventSource.String(), "EventSourceSpec", "EventSourceSpec", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *ConfigMapPersistence) String() string {
 	if this == nil {
 		return "nil"
@@ -10593,6 +14639,86 @@ func (this *ConfigMapPersistence) String() string {
 	}, "")
 	return s
 }
+func (this *DependencyProbe) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DependencyProbe{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`TCP:` + strings.Replace(this.TCP.String(), "TCPDependencyProbe", "TCPDependencyProbe", 1) + `,`,
+		`HTTP:` + strings.Replace(this.HTTP.String(), "HTTPDependencyProbe", "HTTPDependencyProbe", 1) + `,`,
+		`S3:` + strings.Replace(fmt.Sprintf("%v", this.S3), "S3Artifact", "common.S3Artifact", 1) + `,`,
+		`Interval:` + fmt.Sprintf("%v", this.Interval) + `,`,
+		`Timeout:` + fmt.Sprintf("%v", this.Timeout) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DynamoDBStreamsEventSource) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForMetadata := make([]string, 0, len(this.Metadata))
+	for k := range this.Metadata {
+		keysForMetadata = append(keysForMetadata, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForMetadata)
+	mapStringForMetadata := "map[string]string{"
+	for _, k := range keysForMetadata {
+		mapStringForMetadata += fmt.Sprintf("%v: %v,", k, this.Metadata[k])
+	}
+	mapStringForMetadata += "}"
+	s := strings.Join([]string{`&DynamoDBStreamsEventSource{`,
+		`Table:` + fmt.Sprintf("%v", this.Table) + `,`,
+		`StreamARN:` + fmt.Sprintf("%v", this.StreamARN) + `,`,
+		`Region:` + fmt.Sprintf("%v", this.Region) + `,`,
+		`AccessKey:` + strings.Replace(fmt.Sprintf("%v", this.AccessKey), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
+		`SecretKey:` + strings.Replace(fmt.Sprintf("%v", this.SecretKey), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
+		`RoleARN:` + fmt.Sprintf("%v", this.RoleARN) + `,`,
+		`Endpoint:` + fmt.Sprintf("%v", this.Endpoint) + `,`,
+		`StartingPosition:` + fmt.Sprintf("%v", this.StartingPosition) + `,`,
+		`Images:` + fmt.Sprintf("%v", this.Images) + `,`,
+		`PollInterval:` + fmt.Sprintf("%v", this.PollInterval) + `,`,
+		`Persistence:` + strings.Replace(this.Persistence.String(), "ConfigMapPersistence", "ConfigMapPersistence", 1) + `,`,
+		`Metadata:` + mapStringForMetadata + `,`,
+		`Filter:` + strings.Replace(this.Filter.String(), "EventSourceFilter", "EventSourceFilter", 1) + `,`,
+		`Transform:` + strings.Replace(this.Transform.String(), "EventSourceTransform", "EventSourceTransform", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ElasticsearchEventSource) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForMetadata := make([]string, 0, len(this.Metadata))
+	for k := range this.Metadata {
+		keysForMetadata = append(keysForMetadata, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForMetadata)
+	mapStringForMetadata := "map[string]string{"
+	for _, k := range keysForMetadata {
+		mapStringForMetadata += fmt.Sprintf("%v: %v,", k, this.Metadata[k])
+	}
+	mapStringForMetadata += "}"
+	s := strings.Join([]string{`&ElasticsearchEventSource{`,
+		`URL:` + fmt.Sprintf("%v", this.URL) + `,`,
+		`Index:` + fmt.Sprintf("%v", this.Index) + `,`,
+		`Query:` + fmt.Sprintf("%v", this.Query) + `,`,
+		`SortField:` + fmt.Sprintf("%v", this.SortField) + `,`,
+		`TiebreakerField:` + fmt.Sprintf("%v", this.TiebreakerField) + `,`,
+		`PollInterval:` + fmt.Sprintf("%v", this.PollInterval) + `,`,
+		`BatchSize:` + fmt.Sprintf("%v", this.BatchSize) + `,`,
+		`BasicAuth:` + strings.Replace(fmt.Sprintf("%v", this.BasicAuth), "BasicAuth", "common.BasicAuth", 1) + `,`,
+		`APIKey:` + strings.Replace(fmt.Sprintf("%v", this.APIKey), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
+		`TLS:` + strings.Replace(fmt.Sprintf("%v", this.TLS), "TLSConfig", "common.TLSConfig", 1) + `,`,
+		`Metadata:` + mapStringForMetadata + `,`,
+		`Filter:` + strings.Replace(this.Filter.String(), "EventSourceFilter", "EventSourceFilter", 1) + `,`,
+		`Transform:` + strings.Replace(this.Transform.String(), "EventSourceTransform", "EventSourceTransform", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *EmitterEventSource) String() string {
 	if this == nil {
 		return "nil"
@@ -10618,6 +14744,19 @@ func (this *EmitterEventSource) String() string {
 		`TLS:` + strings.Replace(fmt.Sprintf("%v", this.TLS), "TLSConfig", "common.TLSConfig", 1) + `,`,
 		`Metadata:` + mapStringForMetadata + `,`,
 		`Filter:` + strings.Replace(this.Filter.String(), "EventSourceFilter", "EventSourceFilter", 1) + `,`,
+		`Transform:` + strings.Replace(this.Transform.String(), "EventSourceTransform", "EventSourceTransform", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EventCatalogEntry) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EventCatalogEntry{`,
+		`Description:` + fmt.Sprintf("%v", this.Description) + `,`,
+		`Schema:` + fmt.Sprintf("%v", this.Schema) + `,`,
+		`Sample:` + fmt.Sprintf("%v", this.Sample) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -10633,6 +14772,18 @@ func (this *EventPersistence) String() string {
 	}, "")
 	return s
 }
+func (this *EventSizeLimit) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EventSizeLimit{`,
+		`Bytes:` + fmt.Sprintf("%v", this.Bytes) + `,`,
+		`Policy:` + fmt.Sprintf("%v", this.Policy) + `,`,
+		`ClaimCheck:` + strings.Replace(fmt.Sprintf("%v", this.ClaimCheck), "S3Artifact", "common.S3Artifact", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *EventSource) String() string {
 	if this == nil {
 		return "nil"
@@ -10655,6 +14806,17 @@ func (this *EventSourceFilter) String() string {
 	}, "")
 	return s
 }
+func (this *EventSourceInclude) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EventSourceInclude{`,
+		`ConfigMap:` + strings.Replace(fmt.Sprintf("%v", this.ConfigMap), "ConfigMapKeySelector", "v1.ConfigMapKeySelector", 1) + `,`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *EventSourceList) String() string {
 	if this == nil {
 		return "nil"
@@ -10675,6 +14837,16 @@ func (this *EventSourceSpec) String() string {
 	if this == nil {
 		return "nil"
 	}
+	repeatedStringForIncludes := "[]EventSourceInclude{"
+	for _, f := range this.Includes {
+		repeatedStringForIncludes += strings.Replace(strings.Replace(f.String(), "EventSourceInclude", "EventSourceInclude", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForIncludes += "}"
+	repeatedStringForDependencyProbes := "[]DependencyProbe{"
+	for _, f := range this.DependencyProbes {
+		repeatedStringForDependencyProbes += strings.Replace(strings.Replace(f.String(), "DependencyProbe", "DependencyProbe", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForDependencyProbes += "}"
 	keysForMinio := make([]string, 0, len(this.Minio))
 	for k := range this.Minio {
 		keysForMinio = append(keysForMinio, k)
@@ -10985,6 +15157,66 @@ func (this *EventSourceSpec) String() string {
 		mapStringForGerrit += fmt.Sprintf("%v: %v,", k, this.Gerrit[k])
 	}
 	mapStringForGerrit += "}"
+	keysForElasticsearch := make([]string, 0, len(this.Elasticsearch))
+	for k := range this.Elasticsearch {
+		keysForElasticsearch = append(keysForElasticsearch, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForElasticsearch)
+	mapStringForElasticsearch := "map[string]ElasticsearchEventSource{"
+	for _, k := range keysForElasticsearch {
+		mapStringForElasticsearch += fmt.Sprintf("%v: %v,", k, this.Elasticsearch[k])
+	}
+	mapStringForElasticsearch += "}"
+	keysForJenkins := make([]string, 0, len(this.Jenkins))
+	for k := range this.Jenkins {
+		keysForJenkins = append(keysForJenkins, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForJenkins)
+	mapStringForJenkins := "map[string]JenkinsEventSource{"
+	for _, k := range keysForJenkins {
+		mapStringForJenkins += fmt.Sprintf("%v: %v,", k, this.Jenkins[k])
+	}
+	mapStringForJenkins += "}"
+	keysForSQL := make([]string, 0, len(this.SQL))
+	for k := range this.SQL {
+		keysForSQL = append(keysForSQL, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForSQL)
+	mapStringForSQL := "map[string]SQLEventSource{"
+	for _, k := range keysForSQL {
+		mapStringForSQL += fmt.Sprintf("%v: %v,", k, this.SQL[k])
+	}
+	mapStringForSQL += "}"
+	keysForDynamoDBStreams := make([]string, 0, len(this.DynamoDBStreams))
+	for k := range this.DynamoDBStreams {
+		keysForDynamoDBStreams = append(keysForDynamoDBStreams, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForDynamoDBStreams)
+	mapStringForDynamoDBStreams := "map[string]DynamoDBStreamsEventSource{"
+	for _, k := range keysForDynamoDBStreams {
+		mapStringForDynamoDBStreams += fmt.Sprintf("%v: %v,", k, this.DynamoDBStreams[k])
+	}
+	mapStringForDynamoDBStreams += "}"
+	keysForCatalog := make([]string, 0, len(this.Catalog))
+	for k := range this.Catalog {
+		keysForCatalog = append(keysForCatalog, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForCatalog)
+	mapStringForCatalog := "map[string]EventCatalogEntry{"
+	for _, k := range keysForCatalog {
+		mapStringForCatalog += fmt.Sprintf("%v: %v,", k, this.Catalog[k])
+	}
+	mapStringForCatalog += "}"
+	keysForGRPCStream := make([]string, 0, len(this.GRPCStream))
+	for k := range this.GRPCStream {
+		keysForGRPCStream = append(keysForGRPCStream, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForGRPCStream)
+	mapStringForGRPCStream := "map[string]GRPCStreamEventSource{"
+	for _, k := range keysForGRPCStream {
+		mapStringForGRPCStream += fmt.Sprintf("%v: %v,", k, this.GRPCStream[k])
+	}
+	mapStringForGRPCStream += "}"
 	s := strings.Join([]string{`&EventSourceSpec{`,
 		`EventBusName:` + fmt.Sprintf("%v", this.EventBusName) + `,`,
 		`Template:` + strings.Replace(this.Template.String(), "Template", "Template", 1) + `,`,
@@ -11021,6 +15253,17 @@ func (this *EventSourceSpec) String() string {
 		`AzureQueueStorage:` + mapStringForAzureQueueStorage + `,`,
 		`SFTP:` + mapStringForSFTP + `,`,
 		`Gerrit:` + mapStringForGerrit + `,`,
+		`RemoteEventBus:` + strings.Replace(fmt.Sprintf("%v", this.RemoteEventBus), "RemoteEventBus", "common.RemoteEventBus", 1) + `,`,
+		`Includes:` + repeatedStringForIncludes + `,`,
+		`MaxEventSize:` + strings.Replace(this.MaxEventSize.String(), "EventSizeLimit", "EventSizeLimit", 1) + `,`,
+		`Metrics:` + strings.Replace(fmt.Sprintf("%v", this.Metrics), "MetricsConfig", "common.MetricsConfig", 1) + `,`,
+		`Elasticsearch:` + mapStringForElasticsearch + `,`,
+		`Jenkins:` + mapStringForJenkins + `,`,
+		`SQL:` + mapStringForSQL + `,`,
+		`DependencyProbes:` + repeatedStringForDependencyProbes + `,`,
+		`DynamoDBStreams:` + mapStringForDynamoDBStreams + `,`,
+		`Catalog:` + mapStringForCatalog + `,`,
+		`GRPCStream:` + mapStringForGRPCStream + `,`,
 		`}`,
 	}, "")
 	return s
@@ -11029,8 +15272,38 @@ func (this *EventSourceStatus) String() string {
 	if this == nil {
 		return "nil"
 	}
+	repeatedStringForCalendars := "[]CalendarStatus{"
+	for _, f := range this.Calendars {
+		repeatedStringForCalendars += strings.Replace(strings.Replace(f.String(), "CalendarStatus", "CalendarStatus", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForCalendars += "}"
+	repeatedStringForWebhookTokenRotations := "[]WebhookTokenRotation{"
+	for _, f := range this.WebhookTokenRotations {
+		repeatedStringForWebhookTokenRotations += strings.Replace(strings.Replace(f.String(), "WebhookTokenRotation", "WebhookTokenRotation", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForWebhookTokenRotations += "}"
+	repeatedStringForRetries := "[]RetryStatus{"
+	for _, f := range this.Retries {
+		repeatedStringForRetries += fmt.Sprintf("%v", f) + ","
+	}
+	repeatedStringForRetries += "}"
 	s := strings.Join([]string{`&EventSourceStatus{`,
 		`Status:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Status), "Status", "common.Status", 1), `&`, ``, 1) + `,`,
+		`Calendars:` + repeatedStringForCalendars + `,`,
+		`WebhookTokenSecret:` + fmt.Sprintf("%v", this.WebhookTokenSecret) + `,`,
+		`WebhookTokenRotations:` + repeatedStringForWebhookTokenRotations + `,`,
+		`Retries:` + repeatedStringForRetries + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EventSourceTransform) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EventSourceTransform{`,
+		`JQ:` + fmt.Sprintf("%v", this.JQ) + `,`,
+		`Script:` + fmt.Sprintf("%v", this.Script) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -11055,6 +15328,35 @@ func (this *FileEventSource) String() string {
 		`Polling:` + fmt.Sprintf("%v", this.Polling) + `,`,
 		`Metadata:` + mapStringForMetadata + `,`,
 		`Filter:` + strings.Replace(this.Filter.String(), "EventSourceFilter", "EventSourceFilter", 1) + `,`,
+		`Transform:` + strings.Replace(this.Transform.String(), "EventSourceTransform", "EventSourceTransform", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GRPCStreamEventSource) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForMetadata := make([]string, 0, len(this.Metadata))
+	for k := range this.Metadata {
+		keysForMetadata = append(keysForMetadata, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForMetadata)
+	mapStringForMetadata := "map[string]string{"
+	for _, k := range keysForMetadata {
+		mapStringForMetadata += fmt.Sprintf("%v: %v,", k, this.Metadata[k])
+	}
+	mapStringForMetadata += "}"
+	s := strings.Join([]string{`&GRPCStreamEventSource{`,
+		`URL:` + fmt.Sprintf("%v", this.URL) + `,`,
+		`Topic:` + fmt.Sprintf("%v", this.Topic) + `,`,
+		`TLS:` + strings.Replace(fmt.Sprintf("%v", this.TLS), "TLSConfig", "common.TLSConfig", 1) + `,`,
+		`Insecure:` + fmt.Sprintf("%v", this.Insecure) + `,`,
+		`AuthSecret:` + strings.Replace(fmt.Sprintf("%v", this.AuthSecret), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
+		`ConnectionBackoff:` + strings.Replace(fmt.Sprintf("%v", this.ConnectionBackoff), "Backoff", "common.Backoff", 1) + `,`,
+		`Metadata:` + mapStringForMetadata + `,`,
+		`Filter:` + strings.Replace(this.Filter.String(), "EventSourceFilter", "EventSourceFilter", 1) + `,`,
+		`Transform:` + strings.Replace(this.Transform.String(), "EventSourceTransform", "EventSourceTransform", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -11081,6 +15383,7 @@ func (this *GenericEventSource) String() string {
 		`Metadata:` + mapStringForMetadata + `,`,
 		`AuthSecret:` + strings.Replace(fmt.Sprintf("%v", this.AuthSecret), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
 		`Filter:` + strings.Replace(this.Filter.String(), "EventSourceFilter", "EventSourceFilter", 1) + `,`,
+		`Transform:` + strings.Replace(this.Transform.String(), "EventSourceTransform", "EventSourceTransform", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -11110,6 +15413,7 @@ func (this *GerritEventSource) String() string {
 		`Projects:` + fmt.Sprintf("%v", this.Projects) + `,`,
 		`SslVerify:` + fmt.Sprintf("%v", this.SslVerify) + `,`,
 		`Filter:` + strings.Replace(this.Filter.String(), "EventSourceFilter", "EventSourceFilter", 1) + `,`,
+		`Transform:` + strings.Replace(this.Transform.String(), "EventSourceTransform", "EventSourceTransform", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -11164,6 +15468,9 @@ func (this *GithubEventSource) String() string {
 		`Organizations:` + fmt.Sprintf("%v", this.Organizations) + `,`,
 		`GithubApp:` + strings.Replace(this.GithubApp.String(), "GithubAppCreds", "GithubAppCreds", 1) + `,`,
 		`Filter:` + strings.Replace(this.Filter.String(), "EventSourceFilter", "EventSourceFilter", 1) + `,`,
+		`Transform:` + strings.Replace(this.Transform.String(), "EventSourceTransform", "EventSourceTransform", 1) + `,`,
+		`RegistrationPersistence:` + strings.Replace(this.RegistrationPersistence.String(), "ConfigMapPersistence", "ConfigMapPersistence", 1) + `,`,
+		`Normalize:` + fmt.Sprintf("%v", this.Normalize) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -11195,6 +15502,9 @@ func (this *GitlabEventSource) String() string {
 		`SecretToken:` + strings.Replace(fmt.Sprintf("%v", this.SecretToken), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
 		`Filter:` + strings.Replace(this.Filter.String(), "EventSourceFilter", "EventSourceFilter", 1) + `,`,
 		`Groups:` + fmt.Sprintf("%v", this.Groups) + `,`,
+		`Transform:` + strings.Replace(this.Transform.String(), "EventSourceTransform", "EventSourceTransform", 1) + `,`,
+		`RegistrationPersistence:` + strings.Replace(this.RegistrationPersistence.String(), "ConfigMapPersistence", "ConfigMapPersistence", 1) + `,`,
+		`Normalize:` + fmt.Sprintf("%v", this.Normalize) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -11227,6 +15537,56 @@ func (this *HDFSEventSource) String() string {
 		`KrbServicePrincipalName:` + fmt.Sprintf("%v", this.KrbServicePrincipalName) + `,`,
 		`Metadata:` + mapStringForMetadata + `,`,
 		`Filter:` + strings.Replace(this.Filter.String(), "EventSourceFilter", "EventSourceFilter", 1) + `,`,
+		`Transform:` + strings.Replace(this.Transform.String(), "EventSourceTransform", "EventSourceTransform", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *HTTPDependencyProbe) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForHeaders := make([]string, 0, len(this.Headers))
+	for k := range this.Headers {
+		keysForHeaders = append(keysForHeaders, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForHeaders)
+	mapStringForHeaders := "map[string]string{"
+	for _, k := range keysForHeaders {
+		mapStringForHeaders += fmt.Sprintf("%v: %v,", k, this.Headers[k])
+	}
+	mapStringForHeaders += "}"
+	s := strings.Join([]string{`&HTTPDependencyProbe{`,
+		`URL:` + fmt.Sprintf("%v", this.URL) + `,`,
+		`Headers:` + mapStringForHeaders + `,`,
+		`StatusCodes:` + fmt.Sprintf("%v", this.StatusCodes) + `,`,
+		`TLS:` + strings.Replace(fmt.Sprintf("%v", this.TLS), "TLSConfig", "common.TLSConfig", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *JenkinsEventSource) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForMetadata := make([]string, 0, len(this.Metadata))
+	for k := range this.Metadata {
+		keysForMetadata = append(keysForMetadata, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForMetadata)
+	mapStringForMetadata := "map[string]string{"
+	for _, k := range keysForMetadata {
+		mapStringForMetadata += fmt.Sprintf("%v: %v,", k, this.Metadata[k])
+	}
+	mapStringForMetadata += "}"
+	s := strings.Join([]string{`&JenkinsEventSource{`,
+		`Webhook:` + strings.Replace(this.Webhook.String(), "WebhookContext", "WebhookContext", 1) + `,`,
+		`Token:` + strings.Replace(fmt.Sprintf("%v", this.Token), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
+		`Jobs:` + fmt.Sprintf("%v", this.Jobs) + `,`,
+		`Phases:` + fmt.Sprintf("%v", this.Phases) + `,`,
+		`Metadata:` + mapStringForMetadata + `,`,
+		`Filter:` + strings.Replace(this.Filter.String(), "EventSourceFilter", "EventSourceFilter", 1) + `,`,
+		`Transform:` + strings.Replace(this.Transform.String(), "EventSourceTransform", "EventSourceTransform", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -11247,6 +15607,11 @@ func (this *KafkaEventSource) String() string {
 	if this == nil {
 		return "nil"
 	}
+	repeatedStringForHeaderFilters := "[]KafkaHeaderFilter{"
+	for _, f := range this.HeaderFilters {
+		repeatedStringForHeaderFilters += strings.Replace(strings.Replace(f.String(), "KafkaHeaderFilter", "KafkaHeaderFilter", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForHeaderFilters += "}"
 	keysForMetadata := make([]string, 0, len(this.Metadata))
 	for k := range this.Metadata {
 		keysForMetadata = append(keysForMetadata, k)
@@ -11257,6 +15622,16 @@ func (this *KafkaEventSource) String() string {
 		mapStringForMetadata += fmt.Sprintf("%v: %v,", k, this.Metadata[k])
 	}
 	mapStringForMetadata += "}"
+	keysForHeaderExtensions := make([]string, 0, len(this.HeaderExtensions))
+	for k := range this.HeaderExtensions {
+		keysForHeaderExtensions = append(keysForHeaderExtensions, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForHeaderExtensions)
+	mapStringForHeaderExtensions := "map[string]string{"
+	for _, k := range keysForHeaderExtensions {
+		mapStringForHeaderExtensions += fmt.Sprintf("%v: %v,", k, this.HeaderExtensions[k])
+	}
+	mapStringForHeaderExtensions += "}"
 	s := strings.Join([]string{`&KafkaEventSource{`,
 		`URL:` + fmt.Sprintf("%v", this.URL) + `,`,
 		`Partition:` + fmt.Sprintf("%v", this.Partition) + `,`,
@@ -11271,6 +15646,21 @@ func (this *KafkaEventSource) String() string {
 		`SASL:` + strings.Replace(fmt.Sprintf("%v", this.SASL), "SASLConfig", "common.SASLConfig", 1) + `,`,
 		`Filter:` + strings.Replace(this.Filter.String(), "EventSourceFilter", "EventSourceFilter", 1) + `,`,
 		`Config:` + fmt.Sprintf("%v", this.Config) + `,`,
+		`Transform:` + strings.Replace(this.Transform.String(), "EventSourceTransform", "EventSourceTransform", 1) + `,`,
+		`HeaderFilters:` + repeatedStringForHeaderFilters + `,`,
+		`HeaderExtensions:` + mapStringForHeaderExtensions + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *KafkaHeaderFilter) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&KafkaHeaderFilter{`,
+		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
+		`Values:` + fmt.Sprintf("%v", this.Values) + `,`,
+		`Negate:` + fmt.Sprintf("%v", this.Negate) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -11299,6 +15689,20 @@ func (this *MQTTEventSource) String() string {
 		`Metadata:` + mapStringForMetadata + `,`,
 		`Filter:` + strings.Replace(this.Filter.String(), "EventSourceFilter", "EventSourceFilter", 1) + `,`,
 		`Auth:` + strings.Replace(fmt.Sprintf("%v", this.Auth), "BasicAuth", "common.BasicAuth", 1) + `,`,
+		`Transform:` + strings.Replace(this.Transform.String(), "EventSourceTransform", "EventSourceTransform", 1) + `,`,
+		`QoS:` + fmt.Sprintf("%v", this.QoS) + `,`,
+		`SharedSubscription:` + strings.Replace(this.SharedSubscription.String(), "MQTTSharedSubscription", "MQTTSharedSubscription", 1) + `,`,
+		`PersistentSession:` + fmt.Sprintf("%v", this.PersistentSession) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MQTTSharedSubscription) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MQTTSharedSubscription{`,
+		`Group:` + fmt.Sprintf("%v", this.Group) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -11340,6 +15744,7 @@ func (this *NATSEventsSource) String() string {
 		`Auth:` + strings.Replace(this.Auth.String(), "NATSAuth", "NATSAuth", 1) + `,`,
 		`Filter:` + strings.Replace(this.Filter.String(), "EventSourceFilter", "EventSourceFilter", 1) + `,`,
 		`Queue:` + valueToStringGenerated(this.Queue) + `,`,
+		`Transform:` + strings.Replace(this.Transform.String(), "EventSourceTransform", "EventSourceTransform", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -11367,6 +15772,7 @@ func (this *NSQEventSource) String() string {
 		`TLS:` + strings.Replace(fmt.Sprintf("%v", this.TLS), "TLSConfig", "common.TLSConfig", 1) + `,`,
 		`Metadata:` + mapStringForMetadata + `,`,
 		`Filter:` + strings.Replace(this.Filter.String(), "EventSourceFilter", "EventSourceFilter", 1) + `,`,
+		`Transform:` + strings.Replace(this.Transform.String(), "EventSourceTransform", "EventSourceTransform", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -11406,6 +15812,7 @@ func (this *PubSubEventSource) String() string {
 		`JSONBody:` + fmt.Sprintf("%v", this.JSONBody) + `,`,
 		`Metadata:` + mapStringForMetadata + `,`,
 		`Filter:` + strings.Replace(this.Filter.String(), "EventSourceFilter", "EventSourceFilter", 1) + `,`,
+		`Transform:` + strings.Replace(this.Transform.String(), "EventSourceTransform", "EventSourceTransform", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -11449,6 +15856,7 @@ func (this *PulsarEventSource) String() string {
 		`Filter:` + strings.Replace(this.Filter.String(), "EventSourceFilter", "EventSourceFilter", 1) + `,`,
 		`AuthAthenzParams:` + mapStringForAuthAthenzParams + `,`,
 		`AuthAthenzSecret:` + strings.Replace(fmt.Sprintf("%v", this.AuthAthenzSecret), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
+		`Transform:` + strings.Replace(this.Transform.String(), "EventSourceTransform", "EventSourceTransform", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -11478,6 +15886,7 @@ func (this *RedisEventSource) String() string {
 		`Filter:` + strings.Replace(this.Filter.String(), "EventSourceFilter", "EventSourceFilter", 1) + `,`,
 		`JSONBody:` + fmt.Sprintf("%v", this.JSONBody) + `,`,
 		`Username:` + fmt.Sprintf("%v", this.Username) + `,`,
+		`Transform:` + strings.Replace(this.Transform.String(), "EventSourceTransform", "EventSourceTransform", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -11507,6 +15916,7 @@ func (this *RedisStreamEventSource) String() string {
 		`Metadata:` + mapStringForMetadata + `,`,
 		`Filter:` + strings.Replace(this.Filter.String(), "EventSourceFilter", "EventSourceFilter", 1) + `,`,
 		`Username:` + fmt.Sprintf("%v", this.Username) + `,`,
+		`Transform:` + strings.Replace(this.Transform.String(), "EventSourceTransform", "EventSourceTransform", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -11583,6 +15993,7 @@ func (this *SFTPEventSource) String() string {
 		`Metadata:` + mapStringForMetadata + `,`,
 		`Filter:` + strings.Replace(this.Filter.String(), "EventSourceFilter", "EventSourceFilter", 1) + `,`,
 		`PollIntervalDuration:` + fmt.Sprintf("%v", this.PollIntervalDuration) + `,`,
+		`Transform:` + strings.Replace(this.Transform.String(), "EventSourceTransform", "EventSourceTransform", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -11612,6 +16023,36 @@ func (this *SNSEventSource) String() string {
 		`ValidateSignature:` + fmt.Sprintf("%v", this.ValidateSignature) + `,`,
 		`Filter:` + strings.Replace(this.Filter.String(), "EventSourceFilter", "EventSourceFilter", 1) + `,`,
 		`Endpoint:` + fmt.Sprintf("%v", this.Endpoint) + `,`,
+		`Transform:` + strings.Replace(this.Transform.String(), "EventSourceTransform", "EventSourceTransform", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SQLEventSource) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForMetadata := make([]string, 0, len(this.Metadata))
+	for k := range this.Metadata {
+		keysForMetadata = append(keysForMetadata, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForMetadata)
+	mapStringForMetadata := "map[string]string{"
+	for _, k := range keysForMetadata {
+		mapStringForMetadata += fmt.Sprintf("%v: %v,", k, this.Metadata[k])
+	}
+	mapStringForMetadata += "}"
+	s := strings.Join([]string{`&SQLEventSource{`,
+		`Driver:` + fmt.Sprintf("%v", this.Driver) + `,`,
+		`DSN:` + strings.Replace(fmt.Sprintf("%v", this.DSN), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
+		`Query:` + fmt.Sprintf("%v", this.Query) + `,`,
+		`CursorColumn:` + fmt.Sprintf("%v", this.CursorColumn) + `,`,
+		`InitialCursor:` + fmt.Sprintf("%v", this.InitialCursor) + `,`,
+		`PollInterval:` + fmt.Sprintf("%v", this.PollInterval) + `,`,
+		`Persistence:` + strings.Replace(this.Persistence.String(), "ConfigMapPersistence", "ConfigMapPersistence", 1) + `,`,
+		`Metadata:` + mapStringForMetadata + `,`,
+		`Filter:` + strings.Replace(this.Filter.String(), "EventSourceFilter", "EventSourceFilter", 1) + `,`,
+		`Transform:` + strings.Replace(this.Transform.String(), "EventSourceTransform", "EventSourceTransform", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -11644,6 +16085,7 @@ func (this *SQSEventSource) String() string {
 		`Filter:` + strings.Replace(this.Filter.String(), "EventSourceFilter", "EventSourceFilter", 1) + `,`,
 		`Endpoint:` + fmt.Sprintf("%v", this.Endpoint) + `,`,
 		`SessionToken:` + strings.Replace(fmt.Sprintf("%v", this.SessionToken), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
+		`Transform:` + strings.Replace(this.Transform.String(), "EventSourceTransform", "EventSourceTransform", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -11696,6 +16138,7 @@ func (this *SlackEventSource) String() string {
 		`Webhook:` + strings.Replace(this.Webhook.String(), "WebhookContext", "WebhookContext", 1) + `,`,
 		`Metadata:` + mapStringForMetadata + `,`,
 		`Filter:` + strings.Replace(this.Filter.String(), "EventSourceFilter", "EventSourceFilter", 1) + `,`,
+		`Transform:` + strings.Replace(this.Transform.String(), "EventSourceTransform", "EventSourceTransform", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -11763,6 +16206,16 @@ func (this *StripeEventSource) String() string {
 	}, "")
 	return s
 }
+func (this *TCPDependencyProbe) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TCPDependencyProbe{`,
+		`Addresses:` + fmt.Sprintf("%v", this.Addresses) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *Template) String() string {
 	if this == nil {
 		return "nil"
@@ -11804,6 +16257,7 @@ func (this *Template) String() string {
 		`ImagePullSecrets:` + repeatedStringForImagePullSecrets + `,`,
 		`PriorityClassName:` + fmt.Sprintf("%v", this.PriorityClassName) + `,`,
 		`Priority:` + valueToStringGenerated(this.Priority) + `,`,
+		`RuntimeClassName:` + valueToStringGenerated(this.RuntimeClassName) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -11844,6 +16298,32 @@ func (this *WebhookContext) String() string {
 		`Metadata:` + mapStringForMetadata + `,`,
 		`AuthSecret:` + strings.Replace(fmt.Sprintf("%v", this.AuthSecret), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
 		`MaxPayloadSize:` + valueToStringGenerated(this.MaxPayloadSize) + `,`,
+		`Replay:` + strings.Replace(this.Replay.String(), "WebhookReplay", "WebhookReplay", 1) + `,`,
+		`GenerateToken:` + fmt.Sprintf("%v", this.GenerateToken) + `,`,
+		`TokenRotationPeriod:` + fmt.Sprintf("%v", this.TokenRotationPeriod) + `,`,
+		`PayloadSchema:` + fmt.Sprintf("%v", this.PayloadSchema) + `,`,
+		`Host:` + fmt.Sprintf("%v", this.Host) + `,`,
+		`ServiceGroup:` + fmt.Sprintf("%v", this.ServiceGroup) + `,`,
+		`Enrichment:` + strings.Replace(this.Enrichment.String(), "WebhookEnrichment", "WebhookEnrichment", 1) + `,`,
+		`Redelivery:` + strings.Replace(this.Redelivery.String(), "WebhookRedelivery", "WebhookRedelivery", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *WebhookEnrichment) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForNetworks := "[]WebhookNetwork{"
+	for _, f := range this.Networks {
+		repeatedStringForNetworks += strings.Replace(strings.Replace(f.String(), "WebhookNetwork", "WebhookNetwork", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForNetworks += "}"
+	s := strings.Join([]string{`&WebhookEnrichment{`,
+		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
+		`TrustedProxies:` + fmt.Sprintf("%v", this.TrustedProxies) + `,`,
+		`ClientIdentityHeader:` + fmt.Sprintf("%v", this.ClientIdentityHeader) + `,`,
+		`Networks:` + repeatedStringForNetworks + `,`,
 		`}`,
 	}, "")
 	return s
@@ -11855,6 +16335,55 @@ func (this *WebhookEventSource) String() string {
 	s := strings.Join([]string{`&WebhookEventSource{`,
 		`WebhookContext:` + strings.Replace(strings.Replace(this.WebhookContext.String(), "WebhookContext", "WebhookContext", 1), `&`, ``, 1) + `,`,
 		`Filter:` + strings.Replace(this.Filter.String(), "EventSourceFilter", "EventSourceFilter", 1) + `,`,
+		`Transform:` + strings.Replace(this.Transform.String(), "EventSourceTransform", "EventSourceTransform", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *WebhookNetwork) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&WebhookNetwork{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`CIDRs:` + fmt.Sprintf("%v", this.CIDRs) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *WebhookRedelivery) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&WebhookRedelivery{`,
+		`DeliveryIDHeader:` + fmt.Sprintf("%v", this.DeliveryIDHeader) + `,`,
+		`DeliveryIDPath:` + fmt.Sprintf("%v", this.DeliveryIDPath) + `,`,
+		`AttemptHeader:` + fmt.Sprintf("%v", this.AttemptHeader) + `,`,
+		`Window:` + fmt.Sprintf("%v", this.Window) + `,`,
+		`Suppress:` + fmt.Sprintf("%v", this.Suppress) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *WebhookReplay) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&WebhookReplay{`,
+		`MaxDeliveries:` + fmt.Sprintf("%v", this.MaxDeliveries) + `,`,
+		`AuthSecret:` + strings.Replace(fmt.Sprintf("%v", this.AuthSecret), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
+		`Dir:` + fmt.Sprintf("%v", this.Dir) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *WebhookTokenRotation) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&WebhookTokenRotation{`,
+		`EventName:` + fmt.Sprintf("%v", this.EventName) + `,`,
+		`NextRotationTime:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.NextRotationTime), "Time", "v11.Time", 1), `&`, ``, 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -12657,121 +17186,11 @@ func (m *AMQPEventSource) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *AMQPExchangeDeclareConfig) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: AMQPExchangeDeclareConfig: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AMQPExchangeDeclareConfig: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Durable", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Durable = bool(v != 0)
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AutoDelete", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.AutoDelete = bool(v != 0)
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Internal", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Internal = bool(v != 0)
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NoWait", wireType)
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transform", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -12781,82 +17200,228 @@ func (m *AMQPExchangeDeclareConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.NoWait = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *AMQPQueueBindConfig) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			if m.Transform == nil {
+				m.Transform = &EventSourceTransform{}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: AMQPQueueBindConfig: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AMQPQueueBindConfig: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NoWait", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.Transform.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.NoWait = bool(v != 0)
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AMQPExchangeDeclareConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AMQPExchangeDeclareConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AMQPExchangeDeclareConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Durable", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Durable = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoDelete", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AutoDelete = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Internal", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Internal = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NoWait", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.NoWait = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AMQPQueueBindConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AMQPQueueBindConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AMQPQueueBindConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NoWait", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.NoWait = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -13400,6 +17965,42 @@ func (m *AzureEventsHubEventSource) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transform", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Transform == nil {
+				m.Transform = &EventSourceTransform{}
+			}
+			if err := m.Transform.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -13793,59 +18394,166 @@ func (m *AzureQueueStorageEventSource) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.WaitTimeInSeconds = &v
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *AzureServiceBusEventSource) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: AzureServiceBusEventSource: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AzureServiceBusEventSource: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionString", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Transform", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Transform == nil {
+				m.Transform = &EventSourceTransform{}
+			}
+			if err := m.Transform.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VisibilityTimeoutInSeconds", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.VisibilityTimeoutInSeconds = &v
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDequeueCount", wireType)
+			}
+			m.MaxDequeueCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxDequeueCount |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoisonQueueName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoisonQueueName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AzureServiceBusEventSource) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AzureServiceBusEventSource: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AzureServiceBusEventSource: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionString", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14226,95 +18934,9 @@ func (m *AzureServiceBusEventSource) Unmarshal(dAtA []byte) error {
 			}
 			m.FullyQualifiedNamespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *BitbucketAuth) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: BitbucketAuth: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BitbucketAuth: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Basic", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Basic == nil {
-				m.Basic = &BitbucketBasicAuth{}
-			}
-			if err := m.Basic.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OAuthToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Transform", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14341,10 +18963,10 @@ func (m *BitbucketAuth) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.OAuthToken == nil {
-				m.OAuthToken = &v1.SecretKeySelector{}
+			if m.Transform == nil {
+				m.Transform = &EventSourceTransform{}
 			}
-			if err := m.OAuthToken.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Transform.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -14369,7 +18991,7 @@ func (m *BitbucketAuth) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BitbucketBasicAuth) Unmarshal(dAtA []byte) error {
+func (m *BitbucketAuth) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14392,15 +19014,15 @@ func (m *BitbucketBasicAuth) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BitbucketBasicAuth: wiretype end group for non-group")
+			return fmt.Errorf("proto: BitbucketAuth: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BitbucketBasicAuth: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BitbucketAuth: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Basic", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14427,16 +19049,16 @@ func (m *BitbucketBasicAuth) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Username == nil {
-				m.Username = &v1.SecretKeySelector{}
+			if m.Basic == nil {
+				m.Basic = &BitbucketBasicAuth{}
 			}
-			if err := m.Username.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Basic.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OAuthToken", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14463,10 +19085,132 @@ func (m *BitbucketBasicAuth) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Password == nil {
-				m.Password = &v1.SecretKeySelector{}
+			if m.OAuthToken == nil {
+				m.OAuthToken = &v1.SecretKeySelector{}
 			}
-			if err := m.Password.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.OAuthToken.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BitbucketBasicAuth) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BitbucketBasicAuth: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BitbucketBasicAuth: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Username == nil {
+				m.Username = &v1.SecretKeySelector{}
+			}
+			if err := m.Username.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Password == nil {
+				m.Password = &v1.SecretKeySelector{}
+			}
+			if err := m.Password.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -14937,6 +19681,62 @@ func (m *BitbucketEventSource) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transform", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Transform == nil {
+				m.Transform = &EventSourceTransform{}
+			}
+			if err := m.Transform.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Normalize", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Normalize = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -15674,61 +20474,11 @@ func (m *BitbucketServerEventSource) Unmarshal(dAtA []byte) error {
 			}
 			m.CheckInterval = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *BitbucketServerRepository) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: BitbucketServerRepository: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BitbucketServerRepository: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 16:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProjectKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Transform", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -15738,29 +20488,33 @@ func (m *BitbucketServerRepository) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProjectKey = string(dAtA[iNdEx:postIndex])
+			if m.Transform == nil {
+				m.Transform = &EventSourceTransform{}
+			}
+			if err := m.Transform.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RepositorySlug", wireType)
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Normalize", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -15770,24 +20524,126 @@ func (m *BitbucketServerRepository) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.RepositorySlug = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.Normalize = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BitbucketServerRepository) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BitbucketServerRepository: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BitbucketServerRepository: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProjectKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProjectKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepositorySlug", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RepositorySlug = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -16165,6 +21021,74 @@ func (m *CalendarEventSource) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transform", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Transform == nil {
+				m.Transform = &EventSourceTransform{}
+			}
+			if err := m.Transform.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DSTPolicy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DSTPolicy = CalendarDSTPolicy(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -16186,7 +21110,7 @@ func (m *CalendarEventSource) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CatchupConfiguration) Unmarshal(dAtA []byte) error {
+func (m *CalendarStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16209,17 +21133,17 @@ func (m *CatchupConfiguration) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CatchupConfiguration: wiretype end group for non-group")
+			return fmt.Errorf("proto: CalendarStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CatchupConfiguration: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CalendarStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventName", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -16229,17 +21153,29 @@ func (m *CatchupConfiguration) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Enabled = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EventName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxDuration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NextFireTimes", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -16249,23 +21185,25 @@ func (m *CatchupConfiguration) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MaxDuration = string(dAtA[iNdEx:postIndex])
+			m.NextFireTimes = append(m.NextFireTimes, v11.Time{})
+			if err := m.NextFireTimes[len(m.NextFireTimes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -16288,7 +21226,7 @@ func (m *CatchupConfiguration) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ConfigMapPersistence) Unmarshal(dAtA []byte) error {
+func (m *CatchupConfiguration) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16311,17 +21249,17 @@ func (m *ConfigMapPersistence) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ConfigMapPersistence: wiretype end group for non-group")
+			return fmt.Errorf("proto: CatchupConfiguration: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ConfigMapPersistence: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CatchupConfiguration: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -16331,29 +21269,17 @@ func (m *ConfigMapPersistence) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.Enabled = bool(v != 0)
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreateIfNotExist", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDuration", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -16363,12 +21289,24 @@ func (m *ConfigMapPersistence) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.CreateIfNotExist = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxDuration = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -16390,7 +21328,7 @@ func (m *ConfigMapPersistence) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EmitterEventSource) Unmarshal(dAtA []byte) error {
+func (m *ClusterEventSource) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16413,17 +21351,17 @@ func (m *EmitterEventSource) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EmitterEventSource: wiretype end group for non-group")
+			return fmt.Errorf("proto: ClusterEventSource: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EmitterEventSource: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ClusterEventSource: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Broker", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -16433,29 +21371,30 @@ func (m *EmitterEventSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Broker = string(dAtA[iNdEx:postIndex])
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChannelKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -16465,29 +21404,30 @@ func (m *EmitterEventSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChannelKey = string(dAtA[iNdEx:postIndex])
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChannelName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -16497,63 +21437,78 @@ func (m *EmitterEventSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChannelName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Username == nil {
-				m.Username = &v1.SecretKeySelector{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClusterEventSourceList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
 			}
-			if err := m.Username.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 5:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClusterEventSourceList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClusterEventSourceList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16580,16 +21535,13 @@ func (m *EmitterEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Password == nil {
-				m.Password = &v1.SecretKeySelector{}
-			}
-			if err := m.Password.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionBackoff", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16616,72 +21568,64 @@ func (m *EmitterEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ConnectionBackoff == nil {
-				m.ConnectionBackoff = &common.Backoff{}
-			}
-			if err := m.ConnectionBackoff.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Items = append(m.Items, ClusterEventSource{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JSONBody", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.JSONBody = bool(v != 0)
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TLS", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TLS == nil {
-				m.TLS = &common.TLSConfig{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClusterEventSourceSpec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
 			}
-			if err := m.TLS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 9:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClusterEventSourceSpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClusterEventSourceSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceSelector", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16708,107 +21652,16 @@ func (m *EmitterEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Metadata == nil {
-				m.Metadata = make(map[string]string)
+			if m.NamespaceSelector == nil {
+				m.NamespaceSelector = &v11.LabelSelector{}
 			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			if err := m.NamespaceSelector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Metadata[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 10:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EventSource", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16835,10 +21688,7 @@ func (m *EmitterEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Filter == nil {
-				m.Filter = &EventSourceFilter{}
-			}
-			if err := m.Filter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.EventSource.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -16863,7 +21713,7 @@ func (m *EmitterEventSource) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventPersistence) Unmarshal(dAtA []byte) error {
+func (m *ConfigMapPersistence) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16886,17 +21736,17 @@ func (m *EventPersistence) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventPersistence: wiretype end group for non-group")
+			return fmt.Errorf("proto: ConfigMapPersistence: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventPersistence: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ConfigMapPersistence: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Catchup", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -16906,33 +21756,29 @@ func (m *EventPersistence) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Catchup == nil {
-				m.Catchup = &CatchupConfiguration{}
-			}
-			if err := m.Catchup.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConfigMap", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateIfNotExist", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -16942,28 +21788,12 @@ func (m *EventPersistence) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.ConfigMap == nil {
-				m.ConfigMap = &ConfigMapPersistence{}
-			}
-			if err := m.ConfigMap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.CreateIfNotExist = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -16985,7 +21815,7 @@ func (m *EventPersistence) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventSource) Unmarshal(dAtA []byte) error {
+func (m *DependencyProbe) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17008,15 +21838,47 @@ func (m *EventSource) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventSource: wiretype end group for non-group")
+			return fmt.Errorf("proto: DependencyProbe: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventSource: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DependencyProbe: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TCP", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17043,13 +21905,16 @@ func (m *EventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.TCP == nil {
+				m.TCP = &TCPDependencyProbe{}
+			}
+			if err := m.TCP.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HTTP", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17076,13 +21941,16 @@ func (m *EventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.HTTP == nil {
+				m.HTTP = &HTTPDependencyProbe{}
+			}
+			if err := m.HTTP.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field S3", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17109,63 +21977,48 @@ func (m *EventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.S3 == nil {
+				m.S3 = &common.S3Artifact{}
+			}
+			if err := m.S3.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *EventSourceFilter) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EventSourceFilter: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventSourceFilter: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Interval = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Expression", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -17193,7 +22046,7 @@ func (m *EventSourceFilter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Expression = string(dAtA[iNdEx:postIndex])
+			m.Timeout = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -17216,7 +22069,7 @@ func (m *EventSourceFilter) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventSourceList) Unmarshal(dAtA []byte) error {
+func (m *DynamoDBStreamsEventSource) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17239,17 +22092,17 @@ func (m *EventSourceList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventSourceList: wiretype end group for non-group")
+			return fmt.Errorf("proto: DynamoDBStreamsEventSource: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventSourceList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DynamoDBStreamsEventSource: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Table", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -17259,28 +22112,91 @@ func (m *EventSourceList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Table = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StreamARN", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StreamARN = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Region", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Region = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccessKey", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17307,64 +22223,52 @@ func (m *EventSourceList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, EventSource{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.AccessKey == nil {
+				m.AccessKey = &v1.SecretKeySelector{}
+			}
+			if err := m.AccessKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecretKey", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *EventSourceSpec) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.SecretKey == nil {
+				m.SecretKey = &v1.SecretKeySelector{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EventSourceSpec: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventSourceSpec: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if err := m.SecretKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EventBusName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RoleARN", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -17392,13 +22296,13 @@ func (m *EventSourceSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EventBusName = string(dAtA[iNdEx:postIndex])
+			m.RoleARN = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Endpoint", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -17408,33 +22312,61 @@ func (m *EventSourceSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Template == nil {
-				m.Template = &Template{}
+			m.Endpoint = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartingPosition", wireType)
 			}
-			if err := m.Template.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.StartingPosition = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Images", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -17444,31 +22376,59 @@ func (m *EventSourceSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Service == nil {
-				m.Service = &Service{}
+			m.Images = DynamoDBStreamsImages(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PollInterval", wireType)
 			}
-			if err := m.Service.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.PollInterval = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Minio", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Persistence", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17495,109 +22455,16 @@ func (m *EventSourceSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Minio == nil {
-				m.Minio = make(map[string]common.S3Artifact)
+			if m.Persistence == nil {
+				m.Persistence = &ConfigMapPersistence{}
 			}
-			var mapkey string
-			mapvalue := &common.S3Artifact{}
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &common.S3Artifact{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			if err := m.Persistence.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Minio[mapkey] = *mapvalue
 			iNdEx = postIndex
-		case 5:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Calendar", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17624,11 +22491,11 @@ func (m *EventSourceSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Calendar == nil {
-				m.Calendar = make(map[string]CalendarEventSource)
+			if m.Metadata == nil {
+				m.Metadata = make(map[string]string)
 			}
 			var mapkey string
-			mapvalue := &CalendarEventSource{}
+			var mapvalue string
 			for iNdEx < postIndex {
 				entryPreIndex := iNdEx
 				var wire uint64
@@ -17677,7 +22544,7 @@ func (m *EventSourceSpec) Unmarshal(dAtA []byte) error {
 					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
 					iNdEx = postStringIndexmapkey
 				} else if fieldNum == 2 {
-					var mapmsglen int
+					var stringLenmapvalue uint64
 					for shift := uint(0); ; shift += 7 {
 						if shift >= 64 {
 							return ErrIntOverflowGenerated
@@ -17687,26 +22554,24 @@ func (m *EventSourceSpec) Unmarshal(dAtA []byte) error {
 						}
 						b := dAtA[iNdEx]
 						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
+						stringLenmapvalue |= uint64(b&0x7F) << shift
 						if b < 0x80 {
 							break
 						}
 					}
-					if mapmsglen < 0 {
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
 						return ErrInvalidLengthGenerated
 					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
 						return ErrInvalidLengthGenerated
 					}
-					if postmsgIndex > l {
+					if postStringIndexmapvalue > l {
 						return io.ErrUnexpectedEOF
 					}
-					mapvalue = &CalendarEventSource{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
 				} else {
 					iNdEx = entryPreIndex
 					skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -17722,11 +22587,11 @@ func (m *EventSourceSpec) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.Calendar[mapkey] = *mapvalue
+			m.Metadata[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 6:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field File", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17753,109 +22618,16 @@ func (m *EventSourceSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.File == nil {
-				m.File = make(map[string]FileEventSource)
+			if m.Filter == nil {
+				m.Filter = &EventSourceFilter{}
 			}
-			var mapkey string
-			mapvalue := &FileEventSource{}
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &FileEventSource{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			if err := m.Filter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.File[mapkey] = *mapvalue
 			iNdEx = postIndex
-		case 7:
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Resource", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Transform", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17882,109 +22654,277 @@ func (m *EventSourceSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Resource == nil {
-				m.Resource = make(map[string]ResourceEventSource)
+			if m.Transform == nil {
+				m.Transform = &EventSourceTransform{}
 			}
-			var mapkey string
-			mapvalue := &ResourceEventSource{}
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &ResourceEventSource{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+			if err := m.Transform.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ElasticsearchEventSource) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ElasticsearchEventSource: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ElasticsearchEventSource: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.Resource[mapkey] = *mapvalue
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.URL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Index = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Query = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SortField", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SortField = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TiebreakerField", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TiebreakerField = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PollInterval", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PollInterval = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchSize", wireType)
+			}
+			m.BatchSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchSize |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Webhook", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BasicAuth", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18011,11 +22951,119 @@ func (m *EventSourceSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Webhook == nil {
-				m.Webhook = make(map[string]WebhookEventSource)
+			if m.BasicAuth == nil {
+				m.BasicAuth = &common.BasicAuth{}
+			}
+			if err := m.BasicAuth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field APIKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.APIKey == nil {
+				m.APIKey = &v1.SecretKeySelector{}
+			}
+			if err := m.APIKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TLS", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TLS == nil {
+				m.TLS = &common.TLSConfig{}
+			}
+			if err := m.TLS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = make(map[string]string)
 			}
 			var mapkey string
-			mapvalue := &WebhookEventSource{}
+			var mapvalue string
 			for iNdEx < postIndex {
 				entryPreIndex := iNdEx
 				var wire uint64
@@ -18064,7 +23112,7 @@ func (m *EventSourceSpec) Unmarshal(dAtA []byte) error {
 					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
 					iNdEx = postStringIndexmapkey
 				} else if fieldNum == 2 {
-					var mapmsglen int
+					var stringLenmapvalue uint64
 					for shift := uint(0); ; shift += 7 {
 						if shift >= 64 {
 							return ErrIntOverflowGenerated
@@ -18074,26 +23122,24 @@ func (m *EventSourceSpec) Unmarshal(dAtA []byte) error {
 						}
 						b := dAtA[iNdEx]
 						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
+						stringLenmapvalue |= uint64(b&0x7F) << shift
 						if b < 0x80 {
 							break
 						}
 					}
-					if mapmsglen < 0 {
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
 						return ErrInvalidLengthGenerated
 					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
 						return ErrInvalidLengthGenerated
 					}
-					if postmsgIndex > l {
+					if postStringIndexmapvalue > l {
 						return io.ErrUnexpectedEOF
 					}
-					mapvalue = &WebhookEventSource{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
 				} else {
 					iNdEx = entryPreIndex
 					skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -18109,11 +23155,11 @@ func (m *EventSourceSpec) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.Webhook[mapkey] = *mapvalue
+			m.Metadata[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 9:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AMQP", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18140,109 +23186,198 @@ func (m *EventSourceSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.AMQP == nil {
-				m.AMQP = make(map[string]AMQPEventSource)
+			if m.Filter == nil {
+				m.Filter = &EventSourceFilter{}
 			}
-			var mapkey string
-			mapvalue := &AMQPEventSource{}
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			if err := m.Filter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transform", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &AMQPEventSource{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.AMQP[mapkey] = *mapvalue
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Transform == nil {
+				m.Transform = &EventSourceTransform{}
+			}
+			if err := m.Transform.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 10:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EmitterEventSource) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EmitterEventSource: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EmitterEventSource: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kafka", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Broker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Broker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18269,109 +23404,52 @@ func (m *EventSourceSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Kafka == nil {
-				m.Kafka = make(map[string]KafkaEventSource)
+			if m.Username == nil {
+				m.Username = &v1.SecretKeySelector{}
 			}
-			var mapkey string
-			mapvalue := &KafkaEventSource{}
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			if err := m.Username.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &KafkaEventSource{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.Kafka[mapkey] = *mapvalue
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Password == nil {
+				m.Password = &v1.SecretKeySelector{}
+			}
+			if err := m.Password.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 11:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MQTT", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionBackoff", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18398,11 +23476,103 @@ func (m *EventSourceSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.MQTT == nil {
-				m.MQTT = make(map[string]MQTTEventSource)
+			if m.ConnectionBackoff == nil {
+				m.ConnectionBackoff = &common.Backoff{}
+			}
+			if err := m.ConnectionBackoff.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JSONBody", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.JSONBody = bool(v != 0)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TLS", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TLS == nil {
+				m.TLS = &common.TLSConfig{}
+			}
+			if err := m.TLS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = make(map[string]string)
 			}
 			var mapkey string
-			mapvalue := &MQTTEventSource{}
+			var mapvalue string
 			for iNdEx < postIndex {
 				entryPreIndex := iNdEx
 				var wire uint64
@@ -18451,7 +23621,7 @@ func (m *EventSourceSpec) Unmarshal(dAtA []byte) error {
 					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
 					iNdEx = postStringIndexmapkey
 				} else if fieldNum == 2 {
-					var mapmsglen int
+					var stringLenmapvalue uint64
 					for shift := uint(0); ; shift += 7 {
 						if shift >= 64 {
 							return ErrIntOverflowGenerated
@@ -18461,26 +23631,24 @@ func (m *EventSourceSpec) Unmarshal(dAtA []byte) error {
 						}
 						b := dAtA[iNdEx]
 						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
+						stringLenmapvalue |= uint64(b&0x7F) << shift
 						if b < 0x80 {
 							break
 						}
 					}
-					if mapmsglen < 0 {
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
 						return ErrInvalidLengthGenerated
 					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
 						return ErrInvalidLengthGenerated
 					}
-					if postmsgIndex > l {
+					if postStringIndexmapvalue > l {
 						return io.ErrUnexpectedEOF
 					}
-					mapvalue = &MQTTEventSource{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
 				} else {
 					iNdEx = entryPreIndex
 					skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -18496,11 +23664,11 @@ func (m *EventSourceSpec) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.MQTT[mapkey] = *mapvalue
+			m.Metadata[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 12:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NATS", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18527,32 +23695,7005 @@ func (m *EventSourceSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.NATS == nil {
-				m.NATS = make(map[string]NATSEventsSource)
+			if m.Filter == nil {
+				m.Filter = &EventSourceFilter{}
 			}
-			var mapkey string
-			mapvalue := &NATSEventsSource{}
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
+			if err := m.Filter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transform", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Transform == nil {
+				m.Transform = &EventSourceTransform{}
+			}
+			if err := m.Transform.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventCatalogEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventCatalogEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventCatalogEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schema", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Schema = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sample", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sample = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventPersistence) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventPersistence: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventPersistence: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Catchup", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Catchup == nil {
+				m.Catchup = &CatchupConfiguration{}
+			}
+			if err := m.Catchup.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigMap", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ConfigMap == nil {
+				m.ConfigMap = &ConfigMapPersistence{}
+			}
+			if err := m.ConfigMap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventSizeLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventSizeLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventSizeLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bytes", wireType)
+			}
+			m.Bytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Bytes |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Policy = OversizePolicy(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimCheck", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ClaimCheck == nil {
+				m.ClaimCheck = &common.S3Artifact{}
+			}
+			if err := m.ClaimCheck.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventSource) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventSource: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventSource: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventSourceFilter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventSourceFilter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventSourceFilter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expression", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Expression = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventSourceInclude) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventSourceInclude: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventSourceInclude: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigMap", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ConfigMap == nil {
+				m.ConfigMap = &v1.ConfigMapKeySelector{}
+			}
+			if err := m.ConfigMap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventSourceList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventSourceList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventSourceList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, EventSource{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventSourceSpec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventSourceSpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventSourceSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventBusName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EventBusName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Template == nil {
+				m.Template = &Template{}
+			}
+			if err := m.Template.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Service == nil {
+				m.Service = &Service{}
+			}
+			if err := m.Service.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minio", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Minio == nil {
+				m.Minio = make(map[string]common.S3Artifact)
+			}
+			var mapkey string
+			mapvalue := &common.S3Artifact{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &common.S3Artifact{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Minio[mapkey] = *mapvalue
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Calendar", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Calendar == nil {
+				m.Calendar = make(map[string]CalendarEventSource)
+			}
+			var mapkey string
+			mapvalue := &CalendarEventSource{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &CalendarEventSource{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Calendar[mapkey] = *mapvalue
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field File", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.File == nil {
+				m.File = make(map[string]FileEventSource)
+			}
+			var mapkey string
+			mapvalue := &FileEventSource{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &FileEventSource{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.File[mapkey] = *mapvalue
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resource", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Resource == nil {
+				m.Resource = make(map[string]ResourceEventSource)
+			}
+			var mapkey string
+			mapvalue := &ResourceEventSource{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &ResourceEventSource{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Resource[mapkey] = *mapvalue
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Webhook", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Webhook == nil {
+				m.Webhook = make(map[string]WebhookEventSource)
+			}
+			var mapkey string
+			mapvalue := &WebhookEventSource{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &WebhookEventSource{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Webhook[mapkey] = *mapvalue
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AMQP", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AMQP == nil {
+				m.AMQP = make(map[string]AMQPEventSource)
+			}
+			var mapkey string
+			mapvalue := &AMQPEventSource{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &AMQPEventSource{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.AMQP[mapkey] = *mapvalue
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kafka", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Kafka == nil {
+				m.Kafka = make(map[string]KafkaEventSource)
+			}
+			var mapkey string
+			mapvalue := &KafkaEventSource{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &KafkaEventSource{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Kafka[mapkey] = *mapvalue
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MQTT", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MQTT == nil {
+				m.MQTT = make(map[string]MQTTEventSource)
+			}
+			var mapkey string
+			mapvalue := &MQTTEventSource{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &MQTTEventSource{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.MQTT[mapkey] = *mapvalue
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NATS", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NATS == nil {
+				m.NATS = make(map[string]NATSEventsSource)
+			}
+			var mapkey string
+			mapvalue := &NATSEventsSource{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &NATSEventsSource{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.NATS[mapkey] = *mapvalue
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SNS", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SNS == nil {
+				m.SNS = make(map[string]SNSEventSource)
+			}
+			var mapkey string
+			mapvalue := &SNSEventSource{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &SNSEventSource{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.SNS[mapkey] = *mapvalue
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SQS", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SQS == nil {
+				m.SQS = make(map[string]SQSEventSource)
+			}
+			var mapkey string
+			mapvalue := &SQSEventSource{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &SQSEventSource{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.SQS[mapkey] = *mapvalue
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubSub", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PubSub == nil {
+				m.PubSub = make(map[string]PubSubEventSource)
+			}
+			var mapkey string
+			mapvalue := &PubSubEventSource{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &PubSubEventSource{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.PubSub[mapkey] = *mapvalue
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Github", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Github == nil {
+				m.Github = make(map[string]GithubEventSource)
+			}
+			var mapkey string
+			mapvalue := &GithubEventSource{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &GithubEventSource{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Github[mapkey] = *mapvalue
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gitlab", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Gitlab == nil {
+				m.Gitlab = make(map[string]GitlabEventSource)
+			}
+			var mapkey string
+			mapvalue := &GitlabEventSource{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &GitlabEventSource{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Gitlab[mapkey] = *mapvalue
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HDFS", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.HDFS == nil {
+				m.HDFS = make(map[string]HDFSEventSource)
+			}
+			var mapkey string
+			mapvalue := &HDFSEventSource{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &HDFSEventSource{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.HDFS[mapkey] = *mapvalue
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slack", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Slack == nil {
+				m.Slack = make(map[string]SlackEventSource)
+			}
+			var mapkey string
+			mapvalue := &SlackEventSource{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &SlackEventSource{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Slack[mapkey] = *mapvalue
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageGrid", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StorageGrid == nil {
+				m.StorageGrid = make(map[string]StorageGridEventSource)
+			}
+			var mapkey string
+			mapvalue := &StorageGridEventSource{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &StorageGridEventSource{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.StorageGrid[mapkey] = *mapvalue
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AzureEventsHub", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AzureEventsHub == nil {
+				m.AzureEventsHub = make(map[string]AzureEventsHubEventSource)
+			}
+			var mapkey string
+			mapvalue := &AzureEventsHubEventSource{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &AzureEventsHubEventSource{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.AzureEventsHub[mapkey] = *mapvalue
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stripe", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Stripe == nil {
+				m.Stripe = make(map[string]StripeEventSource)
+			}
+			var mapkey string
+			mapvalue := &StripeEventSource{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &StripeEventSource{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Stripe[mapkey] = *mapvalue
+			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Emitter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Emitter == nil {
+				m.Emitter = make(map[string]EmitterEventSource)
+			}
+			var mapkey string
+			mapvalue := &EmitterEventSource{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &EmitterEventSource{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Emitter[mapkey] = *mapvalue
+			iNdEx = postIndex
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Redis", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Redis == nil {
+				m.Redis = make(map[string]RedisEventSource)
+			}
+			var mapkey string
+			mapvalue := &RedisEventSource{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &RedisEventSource{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Redis[mapkey] = *mapvalue
+			iNdEx = postIndex
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NSQ", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NSQ == nil {
+				m.NSQ = make(map[string]NSQEventSource)
+			}
+			var mapkey string
+			mapvalue := &NSQEventSource{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &NSQEventSource{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.NSQ[mapkey] = *mapvalue
+			iNdEx = postIndex
+		case 26:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pulsar", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pulsar == nil {
+				m.Pulsar = make(map[string]PulsarEventSource)
+			}
+			var mapkey string
+			mapvalue := &PulsarEventSource{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &PulsarEventSource{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Pulsar[mapkey] = *mapvalue
+			iNdEx = postIndex
+		case 27:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Generic", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Generic == nil {
+				m.Generic = make(map[string]GenericEventSource)
+			}
+			var mapkey string
+			mapvalue := &GenericEventSource{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &GenericEventSource{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Generic[mapkey] = *mapvalue
+			iNdEx = postIndex
+		case 28:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Replicas", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Replicas = &v
+		case 29:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BitbucketServer", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BitbucketServer == nil {
+				m.BitbucketServer = make(map[string]BitbucketServerEventSource)
+			}
+			var mapkey string
+			mapvalue := &BitbucketServerEventSource{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &BitbucketServerEventSource{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.BitbucketServer[mapkey] = *mapvalue
+			iNdEx = postIndex
+		case 30:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bitbucket", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Bitbucket == nil {
+				m.Bitbucket = make(map[string]BitbucketEventSource)
+			}
+			var mapkey string
+			mapvalue := &BitbucketEventSource{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &BitbucketEventSource{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Bitbucket[mapkey] = *mapvalue
+			iNdEx = postIndex
+		case 31:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedisStream", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RedisStream == nil {
+				m.RedisStream = make(map[string]RedisStreamEventSource)
+			}
+			var mapkey string
+			mapvalue := &RedisStreamEventSource{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &RedisStreamEventSource{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.RedisStream[mapkey] = *mapvalue
+			iNdEx = postIndex
+		case 32:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AzureServiceBus", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AzureServiceBus == nil {
+				m.AzureServiceBus = make(map[string]AzureServiceBusEventSource)
+			}
+			var mapkey string
+			mapvalue := &AzureServiceBusEventSource{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &AzureServiceBusEventSource{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.AzureServiceBus[mapkey] = *mapvalue
+			iNdEx = postIndex
+		case 33:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AzureQueueStorage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AzureQueueStorage == nil {
+				m.AzureQueueStorage = make(map[string]AzureQueueStorageEventSource)
+			}
+			var mapkey string
+			mapvalue := &AzureQueueStorageEventSource{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &AzureQueueStorageEventSource{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.AzureQueueStorage[mapkey] = *mapvalue
+			iNdEx = postIndex
+		case 34:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SFTP", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SFTP == nil {
+				m.SFTP = make(map[string]SFTPEventSource)
+			}
+			var mapkey string
+			mapvalue := &SFTPEventSource{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &SFTPEventSource{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.SFTP[mapkey] = *mapvalue
+			iNdEx = postIndex
+		case 35:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gerrit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Gerrit == nil {
+				m.Gerrit = make(map[string]GerritEventSource)
+			}
+			var mapkey string
+			mapvalue := &GerritEventSource{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &GerritEventSource{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Gerrit[mapkey] = *mapvalue
+			iNdEx = postIndex
+		case 36:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoteEventBus", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RemoteEventBus == nil {
+				m.RemoteEventBus = &common.RemoteEventBus{}
+			}
+			if err := m.RemoteEventBus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 37:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Includes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Includes = append(m.Includes, EventSourceInclude{})
+			if err := m.Includes[len(m.Includes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 38:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxEventSize", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MaxEventSize == nil {
+				m.MaxEventSize = &EventSizeLimit{}
+			}
+			if err := m.MaxEventSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 39:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metrics", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metrics == nil {
+				m.Metrics = &common.MetricsConfig{}
+			}
+			if err := m.Metrics.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 40:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Elasticsearch", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Elasticsearch == nil {
+				m.Elasticsearch = make(map[string]ElasticsearchEventSource)
+			}
+			var mapkey string
+			mapvalue := &ElasticsearchEventSource{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &ElasticsearchEventSource{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Elasticsearch[mapkey] = *mapvalue
+			iNdEx = postIndex
+		case 41:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Jenkins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Jenkins == nil {
+				m.Jenkins = make(map[string]JenkinsEventSource)
+			}
+			var mapkey string
+			mapvalue := &JenkinsEventSource{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &JenkinsEventSource{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Jenkins[mapkey] = *mapvalue
+			iNdEx = postIndex
+		case 42:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SQL", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SQL == nil {
+				m.SQL = make(map[string]SQLEventSource)
+			}
+			var mapkey string
+			mapvalue := &SQLEventSource{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &SQLEventSource{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.SQL[mapkey] = *mapvalue
+			iNdEx = postIndex
+		case 43:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DependencyProbes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DependencyProbes = append(m.DependencyProbes, DependencyProbe{})
+			if err := m.DependencyProbes[len(m.DependencyProbes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 44:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DynamoDBStreams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DynamoDBStreams == nil {
+				m.DynamoDBStreams = make(map[string]DynamoDBStreamsEventSource)
+			}
+			var mapkey string
+			mapvalue := &DynamoDBStreamsEventSource{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &DynamoDBStreamsEventSource{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.DynamoDBStreams[mapkey] = *mapvalue
+			iNdEx = postIndex
+		case 45:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Catalog", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Catalog == nil {
+				m.Catalog = make(map[string]EventCatalogEntry)
+			}
+			var mapkey string
+			mapvalue := &EventCatalogEntry{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &EventCatalogEntry{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Catalog[mapkey] = *mapvalue
+			iNdEx = postIndex
+		case 46:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GRPCStream", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GRPCStream == nil {
+				m.GRPCStream = make(map[string]GRPCStreamEventSource)
+			}
+			var mapkey string
+			mapvalue := &GRPCStreamEventSource{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &GRPCStreamEventSource{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.GRPCStream[mapkey] = *mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventSourceStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventSourceStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventSourceStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Calendars", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Calendars = append(m.Calendars, CalendarStatus{})
+			if err := m.Calendars[len(m.Calendars)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WebhookTokenSecret", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WebhookTokenSecret = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WebhookTokenRotations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WebhookTokenRotations = append(m.WebhookTokenRotations, WebhookTokenRotation{})
+			if err := m.WebhookTokenRotations[len(m.WebhookTokenRotations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Retries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Retries = append(m.Retries, common.RetryStatus{})
+			if err := m.Retries[len(m.Retries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventSourceTransform) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventSourceTransform: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventSourceTransform: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JQ", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JQ = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Script", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Script = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FileEventSource) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FileEventSource: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FileEventSource: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EventType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WatchPathConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.WatchPathConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Polling", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Polling = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Metadata[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Filter == nil {
+				m.Filter = &EventSourceFilter{}
+			}
+			if err := m.Filter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transform", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Transform == nil {
+				m.Transform = &EventSourceTransform{}
+			}
+			if err := m.Transform.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GRPCStreamEventSource) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GRPCStreamEventSource: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GRPCStreamEventSource: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.URL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Topic", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Topic = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TLS", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TLS == nil {
+				m.TLS = &common.TLSConfig{}
+			}
+			if err := m.TLS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Insecure", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Insecure = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthSecret", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AuthSecret == nil {
+				m.AuthSecret = &v1.SecretKeySelector{}
+			}
+			if err := m.AuthSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionBackoff", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ConnectionBackoff == nil {
+				m.ConnectionBackoff = &common.Backoff{}
+			}
+			if err := m.ConnectionBackoff.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
 						if shift >= 64 {
 							return ErrIntOverflowGenerated
 						}
@@ -18580,7 +30721,7 @@ func (m *EventSourceSpec) Unmarshal(dAtA []byte) error {
 					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
 					iNdEx = postStringIndexmapkey
 				} else if fieldNum == 2 {
-					var mapmsglen int
+					var stringLenmapvalue uint64
 					for shift := uint(0); ; shift += 7 {
 						if shift >= 64 {
 							return ErrIntOverflowGenerated
@@ -18590,26 +30731,24 @@ func (m *EventSourceSpec) Unmarshal(dAtA []byte) error {
 						}
 						b := dAtA[iNdEx]
 						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
+						stringLenmapvalue |= uint64(b&0x7F) << shift
 						if b < 0x80 {
 							break
 						}
 					}
-					if mapmsglen < 0 {
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
 						return ErrInvalidLengthGenerated
 					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
 						return ErrInvalidLengthGenerated
 					}
-					if postmsgIndex > l {
+					if postStringIndexmapvalue > l {
 						return io.ErrUnexpectedEOF
 					}
-					mapvalue = &NATSEventsSource{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
 				} else {
 					iNdEx = entryPreIndex
 					skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -18625,11 +30764,237 @@ func (m *EventSourceSpec) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.NATS[mapkey] = *mapvalue
-			iNdEx = postIndex
-		case 13:
+			m.Metadata[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Filter == nil {
+				m.Filter = &EventSourceFilter{}
+			}
+			if err := m.Filter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transform", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Transform == nil {
+				m.Transform = &EventSourceTransform{}
+			}
+			if err := m.Transform.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GenericEventSource) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenericEventSource: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenericEventSource: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.URL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Config = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Insecure", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Insecure = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JSONBody", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.JSONBody = bool(v != 0)
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SNS", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18656,11 +31021,11 @@ func (m *EventSourceSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SNS == nil {
-				m.SNS = make(map[string]SNSEventSource)
+			if m.Metadata == nil {
+				m.Metadata = make(map[string]string)
 			}
 			var mapkey string
-			mapvalue := &SNSEventSource{}
+			var mapvalue string
 			for iNdEx < postIndex {
 				entryPreIndex := iNdEx
 				var wire uint64
@@ -18709,7 +31074,7 @@ func (m *EventSourceSpec) Unmarshal(dAtA []byte) error {
 					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
 					iNdEx = postStringIndexmapkey
 				} else if fieldNum == 2 {
-					var mapmsglen int
+					var stringLenmapvalue uint64
 					for shift := uint(0); ; shift += 7 {
 						if shift >= 64 {
 							return ErrIntOverflowGenerated
@@ -18719,26 +31084,24 @@ func (m *EventSourceSpec) Unmarshal(dAtA []byte) error {
 						}
 						b := dAtA[iNdEx]
 						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
+						stringLenmapvalue |= uint64(b&0x7F) << shift
 						if b < 0x80 {
 							break
 						}
 					}
-					if mapmsglen < 0 {
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
 						return ErrInvalidLengthGenerated
 					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
 						return ErrInvalidLengthGenerated
 					}
-					if postmsgIndex > l {
+					if postStringIndexmapvalue > l {
 						return io.ErrUnexpectedEOF
 					}
-					mapvalue = &SNSEventSource{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
 				} else {
 					iNdEx = entryPreIndex
 					skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -18754,11 +31117,11 @@ func (m *EventSourceSpec) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.SNS[mapkey] = *mapvalue
+			m.Metadata[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 14:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SQS", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthSecret", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18785,109 +31148,52 @@ func (m *EventSourceSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SQS == nil {
-				m.SQS = make(map[string]SQSEventSource)
+			if m.AuthSecret == nil {
+				m.AuthSecret = &v1.SecretKeySelector{}
 			}
-			var mapkey string
-			mapvalue := &SQSEventSource{}
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			if err := m.AuthSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &SQSEventSource{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.SQS[mapkey] = *mapvalue
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Filter == nil {
+				m.Filter = &EventSourceFilter{}
+			}
+			if err := m.Filter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 15:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PubSub", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Transform", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18914,109 +31220,254 @@ func (m *EventSourceSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PubSub == nil {
-				m.PubSub = make(map[string]PubSubEventSource)
+			if m.Transform == nil {
+				m.Transform = &EventSourceTransform{}
 			}
-			var mapkey string
-			mapvalue := &PubSubEventSource{}
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			if err := m.Transform.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GerritEventSource) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GerritEventSource: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GerritEventSource: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Webhook", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &PubSubEventSource{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.PubSub[mapkey] = *mapvalue
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Webhook == nil {
+				m.Webhook = &WebhookContext{}
+			}
+			if err := m.Webhook.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 16:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Github", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HookName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HookName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Events = append(m.Events, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Auth", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Auth == nil {
+				m.Auth = &common.BasicAuth{}
+			}
+			if err := m.Auth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GerritBaseURL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GerritBaseURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeleteHookOnFinish", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DeleteHookOnFinish = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19043,11 +31494,11 @@ func (m *EventSourceSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Github == nil {
-				m.Github = make(map[string]GithubEventSource)
+			if m.Metadata == nil {
+				m.Metadata = make(map[string]string)
 			}
 			var mapkey string
-			mapvalue := &GithubEventSource{}
+			var mapvalue string
 			for iNdEx < postIndex {
 				entryPreIndex := iNdEx
 				var wire uint64
@@ -19096,7 +31547,7 @@ func (m *EventSourceSpec) Unmarshal(dAtA []byte) error {
 					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
 					iNdEx = postStringIndexmapkey
 				} else if fieldNum == 2 {
-					var mapmsglen int
+					var stringLenmapvalue uint64
 					for shift := uint(0); ; shift += 7 {
 						if shift >= 64 {
 							return ErrIntOverflowGenerated
@@ -19106,26 +31557,24 @@ func (m *EventSourceSpec) Unmarshal(dAtA []byte) error {
 						}
 						b := dAtA[iNdEx]
 						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
+						stringLenmapvalue |= uint64(b&0x7F) << shift
 						if b < 0x80 {
 							break
 						}
 					}
-					if mapmsglen < 0 {
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
 						return ErrInvalidLengthGenerated
 					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
 						return ErrInvalidLengthGenerated
 					}
-					if postmsgIndex > l {
+					if postStringIndexmapvalue > l {
 						return io.ErrUnexpectedEOF
 					}
-					mapvalue = &GithubEventSource{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
 				} else {
 					iNdEx = entryPreIndex
 					skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -19141,11 +31590,63 @@ func (m *EventSourceSpec) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.Github[mapkey] = *mapvalue
+			m.Metadata[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 17:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Gitlab", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Projects", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Projects = append(m.Projects, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SslVerify", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SslVerify = bool(v != 0)
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19172,109 +31673,377 @@ func (m *EventSourceSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Gitlab == nil {
-				m.Gitlab = make(map[string]GitlabEventSource)
+			if m.Filter == nil {
+				m.Filter = &EventSourceFilter{}
 			}
-			var mapkey string
-			mapvalue := &GitlabEventSource{}
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			if err := m.Filter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transform", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &GitlabEventSource{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Transform == nil {
+				m.Transform = &EventSourceTransform{}
+			}
+			if err := m.Transform.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GithubAppCreds) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GithubAppCreds: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GithubAppCreds: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrivateKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PrivateKey == nil {
+				m.PrivateKey = &v1.SecretKeySelector{}
+			}
+			if err := m.PrivateKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppID", wireType)
+			}
+			m.AppID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AppID |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InstallationID", wireType)
+			}
+			m.InstallationID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InstallationID |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GithubEventSource) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GithubEventSource: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GithubEventSource: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Webhook", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Webhook == nil {
+				m.Webhook = &WebhookContext{}
+			}
+			if err := m.Webhook.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeprecatedOwner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeprecatedOwner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeprecatedRepository", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeprecatedRepository = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.Gitlab[mapkey] = *mapvalue
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Events = append(m.Events, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 18:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HDFS", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field APIToken", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19301,109 +32070,16 @@ func (m *EventSourceSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.HDFS == nil {
-				m.HDFS = make(map[string]HDFSEventSource)
+			if m.APIToken == nil {
+				m.APIToken = &v1.SecretKeySelector{}
 			}
-			var mapkey string
-			mapvalue := &HDFSEventSource{}
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &HDFSEventSource{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			if err := m.APIToken.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.HDFS[mapkey] = *mapvalue
 			iNdEx = postIndex
-		case 19:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Slack", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WebhookSecret", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19430,111 +32106,58 @@ func (m *EventSourceSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Slack == nil {
-				m.Slack = make(map[string]SlackEventSource)
+			if m.WebhookSecret == nil {
+				m.WebhookSecret = &v1.SecretKeySelector{}
 			}
-			var mapkey string
-			mapvalue := &SlackEventSource{}
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			if err := m.WebhookSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Insecure", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &SlackEventSource{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.Slack[mapkey] = *mapvalue
-			iNdEx = postIndex
-		case 20:
+			m.Insecure = bool(v != 0)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Active", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Active = bool(v != 0)
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StorageGrid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContentType", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -19544,126 +32167,29 @@ func (m *EventSourceSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.StorageGrid == nil {
-				m.StorageGrid = make(map[string]StorageGridEventSource)
-			}
-			var mapkey string
-			mapvalue := &StorageGridEventSource{}
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &StorageGridEventSource{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.StorageGrid[mapkey] = *mapvalue
+			m.ContentType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 21:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AzureEventsHub", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GithubBaseURL", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -19673,124 +32199,79 @@ func (m *EventSourceSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.AzureEventsHub == nil {
-				m.AzureEventsHub = make(map[string]AzureEventsHubEventSource)
+			m.GithubBaseURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GithubUploadURL", wireType)
 			}
-			var mapkey string
-			mapvalue := &AzureEventsHubEventSource{}
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &AzureEventsHubEventSource{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.AzureEventsHub[mapkey] = *mapvalue
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GithubUploadURL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 22:
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeleteHookOnFinish", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DeleteHookOnFinish = bool(v != 0)
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stripe", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19817,11 +32298,11 @@ func (m *EventSourceSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Stripe == nil {
-				m.Stripe = make(map[string]StripeEventSource)
+			if m.Metadata == nil {
+				m.Metadata = make(map[string]string)
 			}
 			var mapkey string
-			mapvalue := &StripeEventSource{}
+			var mapvalue string
 			for iNdEx < postIndex {
 				entryPreIndex := iNdEx
 				var wire uint64
@@ -19870,7 +32351,7 @@ func (m *EventSourceSpec) Unmarshal(dAtA []byte) error {
 					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
 					iNdEx = postStringIndexmapkey
 				} else if fieldNum == 2 {
-					var mapmsglen int
+					var stringLenmapvalue uint64
 					for shift := uint(0); ; shift += 7 {
 						if shift >= 64 {
 							return ErrIntOverflowGenerated
@@ -19880,26 +32361,24 @@ func (m *EventSourceSpec) Unmarshal(dAtA []byte) error {
 						}
 						b := dAtA[iNdEx]
 						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
+						stringLenmapvalue |= uint64(b&0x7F) << shift
 						if b < 0x80 {
 							break
 						}
 					}
-					if mapmsglen < 0 {
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
 						return ErrInvalidLengthGenerated
 					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
 						return ErrInvalidLengthGenerated
 					}
-					if postmsgIndex > l {
+					if postStringIndexmapvalue > l {
 						return io.ErrUnexpectedEOF
 					}
-					mapvalue = &StripeEventSource{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
 				} else {
 					iNdEx = entryPreIndex
 					skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -19915,11 +32394,11 @@ func (m *EventSourceSpec) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.Stripe[mapkey] = *mapvalue
+			m.Metadata[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 23:
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Emitter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Repositories", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19946,109 +32425,46 @@ func (m *EventSourceSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Emitter == nil {
-				m.Emitter = make(map[string]EmitterEventSource)
+			m.Repositories = append(m.Repositories, OwnedRepositories{})
+			if err := m.Repositories[len(m.Repositories)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			var mapkey string
-			mapvalue := &EmitterEventSource{}
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Organizations", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &EmitterEventSource{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.Emitter[mapkey] = *mapvalue
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Organizations = append(m.Organizations, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 24:
+		case 17:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Redis", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GithubApp", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20075,109 +32491,52 @@ func (m *EventSourceSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Redis == nil {
-				m.Redis = make(map[string]RedisEventSource)
+			if m.GithubApp == nil {
+				m.GithubApp = &GithubAppCreds{}
 			}
-			var mapkey string
-			mapvalue := &RedisEventSource{}
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			if err := m.GithubApp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &RedisEventSource{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.Redis[mapkey] = *mapvalue
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Filter == nil {
+				m.Filter = &EventSourceFilter{}
+			}
+			if err := m.Filter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 25:
+		case 19:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NSQ", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Transform", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20204,109 +32563,122 @@ func (m *EventSourceSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.NSQ == nil {
-				m.NSQ = make(map[string]NSQEventSource)
+			if m.Transform == nil {
+				m.Transform = &EventSourceTransform{}
 			}
-			var mapkey string
-			mapvalue := &NSQEventSource{}
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			if err := m.Transform.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegistrationPersistence", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &NSQEventSource{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RegistrationPersistence == nil {
+				m.RegistrationPersistence = &ConfigMapPersistence{}
+			}
+			if err := m.RegistrationPersistence.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Normalize", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.NSQ[mapkey] = *mapvalue
-			iNdEx = postIndex
-		case 26:
+			m.Normalize = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GitlabEventSource) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GitlabEventSource: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GitlabEventSource: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pulsar", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Webhook", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20333,111 +32705,18 @@ func (m *EventSourceSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pulsar == nil {
-				m.Pulsar = make(map[string]PulsarEventSource)
+			if m.Webhook == nil {
+				m.Webhook = &WebhookContext{}
 			}
-			var mapkey string
-			mapvalue := &PulsarEventSource{}
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &PulsarEventSource{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			if err := m.Webhook.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Pulsar[mapkey] = *mapvalue
 			iNdEx = postIndex
-		case 27:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Generic", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DeprecatedProjectID", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -20447,126 +32726,29 @@ func (m *EventSourceSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Generic == nil {
-				m.Generic = make(map[string]GenericEventSource)
-			}
-			var mapkey string
-			mapvalue := &GenericEventSource{}
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &GenericEventSource{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.Generic[mapkey] = *mapvalue
+			m.DeprecatedProjectID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 28:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Replicas", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
 			}
-			var v int32
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -20576,15 +32758,27 @@ func (m *EventSourceSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Replicas = &v
-		case 29:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Events = append(m.Events, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BitbucketServer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccessToken", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20611,111 +32805,38 @@ func (m *EventSourceSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.BitbucketServer == nil {
-				m.BitbucketServer = make(map[string]BitbucketServerEventSource)
+			if m.AccessToken == nil {
+				m.AccessToken = &v1.SecretKeySelector{}
 			}
-			var mapkey string
-			mapvalue := &BitbucketServerEventSource{}
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			if err := m.AccessToken.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableSSLVerification", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &BitbucketServerEventSource{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.BitbucketServer[mapkey] = *mapvalue
-			iNdEx = postIndex
-		case 30:
+			m.EnableSSLVerification = bool(v != 0)
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Bitbucket", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GitlabBaseURL", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -20725,124 +32846,47 @@ func (m *EventSourceSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Bitbucket == nil {
-				m.Bitbucket = make(map[string]BitbucketEventSource)
+			m.GitlabBaseURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeleteHookOnFinish", wireType)
 			}
-			var mapkey string
-			mapvalue := &BitbucketEventSource{}
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &BitbucketEventSource{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.Bitbucket[mapkey] = *mapvalue
-			iNdEx = postIndex
-		case 31:
+			m.DeleteHookOnFinish = bool(v != 0)
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RedisStream", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20869,11 +32913,11 @@ func (m *EventSourceSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.RedisStream == nil {
-				m.RedisStream = make(map[string]RedisStreamEventSource)
+			if m.Metadata == nil {
+				m.Metadata = make(map[string]string)
 			}
 			var mapkey string
-			mapvalue := &RedisStreamEventSource{}
+			var mapvalue string
 			for iNdEx < postIndex {
 				entryPreIndex := iNdEx
 				var wire uint64
@@ -20922,7 +32966,7 @@ func (m *EventSourceSpec) Unmarshal(dAtA []byte) error {
 					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
 					iNdEx = postStringIndexmapkey
 				} else if fieldNum == 2 {
-					var mapmsglen int
+					var stringLenmapvalue uint64
 					for shift := uint(0); ; shift += 7 {
 						if shift >= 64 {
 							return ErrIntOverflowGenerated
@@ -20932,26 +32976,24 @@ func (m *EventSourceSpec) Unmarshal(dAtA []byte) error {
 						}
 						b := dAtA[iNdEx]
 						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
+						stringLenmapvalue |= uint64(b&0x7F) << shift
 						if b < 0x80 {
 							break
 						}
 					}
-					if mapmsglen < 0 {
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
 						return ErrInvalidLengthGenerated
 					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
 						return ErrInvalidLengthGenerated
 					}
-					if postmsgIndex > l {
+					if postStringIndexmapvalue > l {
 						return io.ErrUnexpectedEOF
 					}
-					mapvalue = &RedisStreamEventSource{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
 				} else {
 					iNdEx = entryPreIndex
 					skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -20967,11 +33009,43 @@ func (m *EventSourceSpec) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.RedisStream[mapkey] = *mapvalue
+			m.Metadata[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 32:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AzureServiceBus", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Projects", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Projects = append(m.Projects, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecretToken", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20998,109 +33072,16 @@ func (m *EventSourceSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.AzureServiceBus == nil {
-				m.AzureServiceBus = make(map[string]AzureServiceBusEventSource)
+			if m.SecretToken == nil {
+				m.SecretToken = &v1.SecretKeySelector{}
 			}
-			var mapkey string
-			mapvalue := &AzureServiceBusEventSource{}
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &AzureServiceBusEventSource{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			if err := m.SecretToken.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.AzureServiceBus[mapkey] = *mapvalue
 			iNdEx = postIndex
-		case 33:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AzureQueueStorage", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -21127,109 +33108,48 @@ func (m *EventSourceSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.AzureQueueStorage == nil {
-				m.AzureQueueStorage = make(map[string]AzureQueueStorageEventSource)
+			if m.Filter == nil {
+				m.Filter = &EventSourceFilter{}
 			}
-			var mapkey string
-			mapvalue := &AzureQueueStorageEventSource{}
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			if err := m.Filter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Groups", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &AzureQueueStorageEventSource{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.AzureQueueStorage[mapkey] = *mapvalue
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Groups = append(m.Groups, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 34:
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SFTP", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Transform", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -21256,109 +33176,16 @@ func (m *EventSourceSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SFTP == nil {
-				m.SFTP = make(map[string]SFTPEventSource)
+			if m.Transform == nil {
+				m.Transform = &EventSourceTransform{}
 			}
-			var mapkey string
-			mapvalue := &SFTPEventSource{}
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &SFTPEventSource{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			if err := m.Transform.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.SFTP[mapkey] = *mapvalue
 			iNdEx = postIndex
-		case 35:
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Gerrit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RegistrationPersistence", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -21385,106 +33212,33 @@ func (m *EventSourceSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Gerrit == nil {
-				m.Gerrit = make(map[string]GerritEventSource)
+			if m.RegistrationPersistence == nil {
+				m.RegistrationPersistence = &ConfigMapPersistence{}
 			}
-			var mapkey string
-			mapvalue := &GerritEventSource{}
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			if err := m.RegistrationPersistence.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Normalize", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &GerritEventSource{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.Gerrit[mapkey] = *mapvalue
-			iNdEx = postIndex
+			m.Normalize = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -21506,7 +33260,7 @@ func (m *EventSourceSpec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventSourceStatus) Unmarshal(dAtA []byte) error {
+func (m *HDFSEventSource) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -21529,15 +33283,15 @@ func (m *EventSourceStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventSourceStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: HDFSEventSource: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventSourceStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HDFSEventSource: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WatchPathConfig", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -21564,63 +33318,109 @@ func (m *EventSourceStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.WatchPathConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *FileEventSource) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CheckInterval", wireType)
 			}
-			if iNdEx >= l {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.CheckInterval = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: FileEventSource: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FileEventSource: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EventType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HDFSUser", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -21648,11 +33448,11 @@ func (m *FileEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EventType = string(dAtA[iNdEx:postIndex])
+			m.HDFSUser = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WatchPathConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KrbCCacheSecret", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -21679,15 +33479,18 @@ func (m *FileEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.WatchPathConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.KrbCCacheSecret == nil {
+				m.KrbCCacheSecret = &v1.SecretKeySelector{}
+			}
+			if err := m.KrbCCacheSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Polling", wireType)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KrbKeytabSecret", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -21697,13 +33500,161 @@ func (m *FileEventSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Polling = bool(v != 0)
-		case 4:
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.KrbKeytabSecret == nil {
+				m.KrbKeytabSecret = &v1.SecretKeySelector{}
+			}
+			if err := m.KrbKeytabSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KrbUsername", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KrbUsername = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KrbRealm", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KrbRealm = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KrbConfigConfigMap", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.KrbConfigConfigMap == nil {
+				m.KrbConfigConfigMap = &v1.ConfigMapKeySelector{}
+			}
+			if err := m.KrbConfigConfigMap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KrbServicePrincipalName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KrbServicePrincipalName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
@@ -21830,7 +33781,7 @@ func (m *FileEventSource) Unmarshal(dAtA []byte) error {
 			}
 			m.Metadata[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 5:
+		case 13:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
 			}
@@ -21866,6 +33817,42 @@ func (m *FileEventSource) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transform", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Transform == nil {
+				m.Transform = &EventSourceTransform{}
+			}
+			if err := m.Transform.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -21887,7 +33874,7 @@ func (m *FileEventSource) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GenericEventSource) Unmarshal(dAtA []byte) error {
+func (m *HTTPDependencyProbe) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -21910,10 +33897,10 @@ func (m *GenericEventSource) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GenericEventSource: wiretype end group for non-group")
+			return fmt.Errorf("proto: HTTPDependencyProbe: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GenericEventSource: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HTTPDependencyProbe: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -21950,79 +33937,7 @@ func (m *GenericEventSource) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Config = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Insecure", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Insecure = bool(v != 0)
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JSONBody", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.JSONBody = bool(v != 0)
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -22049,8 +33964,8 @@ func (m *GenericEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Metadata == nil {
-				m.Metadata = make(map[string]string)
+			if m.Headers == nil {
+				m.Headers = make(map[string]string)
 			}
 			var mapkey string
 			var mapvalue string
@@ -22145,47 +34060,87 @@ func (m *GenericEventSource) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.Metadata[mapkey] = mapvalue
+			m.Headers[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AuthSecret", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
+		case 3:
+			if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				if iNdEx >= l {
+				m.StatusCodes = append(m.StatusCodes, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthGenerated
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthGenerated
+				}
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
 				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.AuthSecret == nil {
-				m.AuthSecret = &v1.SecretKeySelector{}
-			}
-			if err := m.AuthSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 7:
+				elementCount = count
+				if elementCount != 0 && len(m.StatusCodes) == 0 {
+					m.StatusCodes = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.StatusCodes = append(m.StatusCodes, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field StatusCodes", wireType)
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TLS", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -22212,10 +34167,10 @@ func (m *GenericEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Filter == nil {
-				m.Filter = &EventSourceFilter{}
+			if m.TLS == nil {
+				m.TLS = &common.TLSConfig{}
 			}
-			if err := m.Filter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TLS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -22240,7 +34195,7 @@ func (m *GenericEventSource) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GerritEventSource) Unmarshal(dAtA []byte) error {
+func (m *JenkinsEventSource) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -22263,10 +34218,10 @@ func (m *GerritEventSource) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GerritEventSource: wiretype end group for non-group")
+			return fmt.Errorf("proto: JenkinsEventSource: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GerritEventSource: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JenkinsEventSource: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -22307,9 +34262,9 @@ func (m *GerritEventSource) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HookName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -22319,27 +34274,31 @@ func (m *GerritEventSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HookName = string(dAtA[iNdEx:postIndex])
+			if m.Token == nil {
+				m.Token = &v1.SecretKeySelector{}
+			}
+			if err := m.Token.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Jobs", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -22367,47 +34326,11 @@ func (m *GerritEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Events = append(m.Events, string(dAtA[iNdEx:postIndex]))
+			m.Jobs = append(m.Jobs, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Auth", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Auth == nil {
-				m.Auth = &common.BasicAuth{}
-			}
-			if err := m.Auth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GerritBaseURL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Phases", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -22435,29 +34358,9 @@ func (m *GerritEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GerritBaseURL = string(dAtA[iNdEx:postIndex])
+			m.Phases = append(m.Phases, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeleteHookOnFinish", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.DeleteHookOnFinish = bool(v != 0)
-		case 7:
+		case 5:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
@@ -22584,11 +34487,11 @@ func (m *GerritEventSource) Unmarshal(dAtA []byte) error {
 			}
 			m.Metadata[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 8:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Projects", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -22598,47 +34501,31 @@ func (m *GerritEventSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Projects = append(m.Projects, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SslVerify", wireType)
+			if m.Filter == nil {
+				m.Filter = &EventSourceFilter{}
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.Filter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.SslVerify = bool(v != 0)
-		case 10:
+			iNdEx = postIndex
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Transform", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -22665,10 +34552,10 @@ func (m *GerritEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Filter == nil {
-				m.Filter = &EventSourceFilter{}
+			if m.Transform == nil {
+				m.Transform = &EventSourceTransform{}
 			}
-			if err := m.Filter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Transform.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -22693,7 +34580,7 @@ func (m *GerritEventSource) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GithubAppCreds) Unmarshal(dAtA []byte) error {
+func (m *KafkaConsumerGroup) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -22716,17 +34603,17 @@ func (m *GithubAppCreds) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GithubAppCreds: wiretype end group for non-group")
+			return fmt.Errorf("proto: KafkaConsumerGroup: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GithubAppCreds: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: KafkaConsumerGroup: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PrivateKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -22736,33 +34623,29 @@ func (m *GithubAppCreds) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PrivateKey == nil {
-				m.PrivateKey = &v1.SecretKeySelector{}
-			}
-			if err := m.PrivateKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.GroupName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AppID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Oldest", wireType)
 			}
-			m.AppID = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -22772,16 +34655,17 @@ func (m *GithubAppCreds) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AppID |= int64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.Oldest = bool(v != 0)
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InstallationID", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RebalanceStrategy", wireType)
 			}
-			m.InstallationID = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -22791,11 +34675,24 @@ func (m *GithubAppCreds) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.InstallationID |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RebalanceStrategy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -22817,7 +34714,7 @@ func (m *GithubAppCreds) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GithubEventSource) Unmarshal(dAtA []byte) error {
+func (m *KafkaEventSource) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -22840,17 +34737,17 @@ func (m *GithubEventSource) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GithubEventSource: wiretype end group for non-group")
+			return fmt.Errorf("proto: KafkaEventSource: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GithubEventSource: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: KafkaEventSource: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
 			}
-			m.ID = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -22860,16 +34757,29 @@ func (m *GithubEventSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ID |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.URL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Webhook", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Partition", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -22879,31 +34789,27 @@ func (m *GithubEventSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Webhook == nil {
-				m.Webhook = &WebhookContext{}
-			}
-			if err := m.Webhook.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Partition = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeprecatedOwner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Topic", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -22931,13 +34837,13 @@ func (m *GithubEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DeprecatedOwner = string(dAtA[iNdEx:postIndex])
+			m.Topic = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeprecatedRepository", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionBackoff", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -22947,27 +34853,269 @@ func (m *GithubEventSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DeprecatedRepository = string(dAtA[iNdEx:postIndex])
+			if m.ConnectionBackoff == nil {
+				m.ConnectionBackoff = &common.Backoff{}
+			}
+			if err := m.ConnectionBackoff.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TLS", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TLS == nil {
+				m.TLS = &common.TLSConfig{}
+			}
+			if err := m.TLS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JSONBody", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.JSONBody = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Metadata[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerGroup", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ConsumerGroup == nil {
+				m.ConsumerGroup = &KafkaConsumerGroup{}
+			}
+			if err := m.ConsumerGroup.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LimitEventsPerSecond", wireType)
+			}
+			m.LimitEventsPerSecond = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LimitEventsPerSecond |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -22995,11 +35143,11 @@ func (m *GithubEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Events = append(m.Events, string(dAtA[iNdEx:postIndex]))
+			m.Version = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field APIToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SASL", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -23026,16 +35174,16 @@ func (m *GithubEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.APIToken == nil {
-				m.APIToken = &v1.SecretKeySelector{}
+			if m.SASL == nil {
+				m.SASL = &common.SASLConfig{}
 			}
-			if err := m.APIToken.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SASL.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WebhookSecret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -23062,56 +35210,16 @@ func (m *GithubEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.WebhookSecret == nil {
-				m.WebhookSecret = &v1.SecretKeySelector{}
+			if m.Filter == nil {
+				m.Filter = &EventSourceFilter{}
 			}
-			if err := m.WebhookSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Filter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Insecure", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Insecure = bool(v != 0)
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Active", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Active = bool(v != 0)
-		case 10:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContentType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -23139,13 +35247,13 @@ func (m *GithubEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContentType = string(dAtA[iNdEx:postIndex])
+			m.Config = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GithubBaseURL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Transform", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -23155,29 +35263,33 @@ func (m *GithubEventSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GithubBaseURL = string(dAtA[iNdEx:postIndex])
+			if m.Transform == nil {
+				m.Transform = &EventSourceTransform{}
+			}
+			if err := m.Transform.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 12:
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GithubUploadURL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HeaderFilters", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -23187,47 +35299,29 @@ func (m *GithubEventSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GithubUploadURL = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 13:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeleteHookOnFinish", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.HeaderFilters = append(m.HeaderFilters, KafkaHeaderFilter{})
+			if err := m.HeaderFilters[len(m.HeaderFilters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.DeleteHookOnFinish = bool(v != 0)
-		case 14:
+			iNdEx = postIndex
+		case 16:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HeaderExtensions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -23254,8 +35348,8 @@ func (m *GithubEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Metadata == nil {
-				m.Metadata = make(map[string]string)
+			if m.HeaderExtensions == nil {
+				m.HeaderExtensions = make(map[string]string)
 			}
 			var mapkey string
 			var mapvalue string
@@ -23350,45 +35444,61 @@ func (m *GithubEventSource) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.Metadata[mapkey] = mapvalue
+			m.HeaderExtensions[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 15:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Repositories", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *KafkaHeaderFilter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Repositories = append(m.Repositories, OwnedRepositories{})
-			if err := m.Repositories[len(m.Repositories)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 16:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KafkaHeaderFilter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KafkaHeaderFilter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Organizations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -23416,13 +35526,13 @@ func (m *GithubEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Organizations = append(m.Organizations, string(dAtA[iNdEx:postIndex]))
+			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 17:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GithubApp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -23432,33 +35542,29 @@ func (m *GithubEventSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.GithubApp == nil {
-				m.GithubApp = &GithubAppCreds{}
-			}
-			if err := m.GithubApp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Values = append(m.Values, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 18:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Negate", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -23468,28 +35574,12 @@ func (m *GithubEventSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Filter == nil {
-				m.Filter = &EventSourceFilter{}
-			}
-			if err := m.Filter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.Negate = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -23511,7 +35601,7 @@ func (m *GithubEventSource) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GitlabEventSource) Unmarshal(dAtA []byte) error {
+func (m *MQTTEventSource) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -23534,17 +35624,17 @@ func (m *GitlabEventSource) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GitlabEventSource: wiretype end group for non-group")
+			return fmt.Errorf("proto: MQTTEventSource: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GitlabEventSource: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MQTTEventSource: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Webhook", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -23554,31 +35644,27 @@ func (m *GitlabEventSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Webhook == nil {
-				m.Webhook = &WebhookContext{}
-			}
-			if err := m.Webhook.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.URL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeprecatedProjectID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Topic", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -23606,11 +35692,11 @@ func (m *GitlabEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DeprecatedProjectID = string(dAtA[iNdEx:postIndex])
+			m.Topic = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -23638,11 +35724,11 @@ func (m *GitlabEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Events = append(m.Events, string(dAtA[iNdEx:postIndex]))
+			m.ClientID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccessToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionBackoff", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -23669,16 +35755,16 @@ func (m *GitlabEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.AccessToken == nil {
-				m.AccessToken = &v1.SecretKeySelector{}
+			if m.ConnectionBackoff == nil {
+				m.ConnectionBackoff = &common.Backoff{}
 			}
-			if err := m.AccessToken.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ConnectionBackoff.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EnableSSLVerification", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JSONBody", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -23695,12 +35781,12 @@ func (m *GitlabEventSource) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.EnableSSLVerification = bool(v != 0)
+			m.JSONBody = bool(v != 0)
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GitlabBaseURL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TLS", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -23710,45 +35796,29 @@ func (m *GitlabEventSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GitlabBaseURL = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeleteHookOnFinish", wireType)
+			if m.TLS == nil {
+				m.TLS = &common.TLSConfig{}
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.TLS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.DeleteHookOnFinish = bool(v != 0)
-		case 9:
+			iNdEx = postIndex
+		case 7:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
@@ -23875,11 +35945,11 @@ func (m *GitlabEventSource) Unmarshal(dAtA []byte) error {
 			}
 			m.Metadata[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 10:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Projects", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -23889,27 +35959,31 @@ func (m *GitlabEventSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Projects = append(m.Projects, string(dAtA[iNdEx:postIndex]))
+			if m.Filter == nil {
+				m.Filter = &EventSourceFilter{}
+			}
+			if err := m.Filter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 11:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SecretToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Auth", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -23936,16 +36010,16 @@ func (m *GitlabEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SecretToken == nil {
-				m.SecretToken = &v1.SecretKeySelector{}
+			if m.Auth == nil {
+				m.Auth = &common.BasicAuth{}
 			}
-			if err := m.SecretToken.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Auth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 12:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Transform", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -23972,18 +36046,37 @@ func (m *GitlabEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Filter == nil {
-				m.Filter = &EventSourceFilter{}
+			if m.Transform == nil {
+				m.Transform = &EventSourceTransform{}
 			}
-			if err := m.Filter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Transform.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 13:
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QoS", wireType)
+			}
+			m.QoS = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QoS |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Groups", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SharedSubscription", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -23993,24 +36086,48 @@ func (m *GitlabEventSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Groups = append(m.Groups, string(dAtA[iNdEx:postIndex]))
+			if m.SharedSubscription == nil {
+				m.SharedSubscription = &MQTTSharedSubscription{}
+			}
+			if err := m.SharedSubscription.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PersistentSession", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.PersistentSession = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -24032,7 +36149,7 @@ func (m *GitlabEventSource) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HDFSEventSource) Unmarshal(dAtA []byte) error {
+func (m *MQTTSharedSubscription) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -24055,48 +36172,15 @@ func (m *HDFSEventSource) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HDFSEventSource: wiretype end group for non-group")
+			return fmt.Errorf("proto: MQTTSharedSubscription: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HDFSEventSource: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MQTTSharedSubscription: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WatchPathConfig", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.WatchPathConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -24124,45 +36208,63 @@ func (m *HDFSEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = string(dAtA[iNdEx:postIndex])
+			m.Group = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CheckInterval", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NATSAuth) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CheckInterval = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NATSAuth: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NATSAuth: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Basic", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -24172,29 +36274,33 @@ func (m *HDFSEventSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			if m.Basic == nil {
+				m.Basic = &common.BasicAuth{}
+			}
+			if err := m.Basic.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HDFSUser", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -24204,27 +36310,31 @@ func (m *HDFSEventSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HDFSUser = string(dAtA[iNdEx:postIndex])
+			if m.Token == nil {
+				m.Token = &v1.SecretKeySelector{}
+			}
+			if err := m.Token.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KrbCCacheSecret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NKey", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -24251,16 +36361,16 @@ func (m *HDFSEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.KrbCCacheSecret == nil {
-				m.KrbCCacheSecret = &v1.SecretKeySelector{}
+			if m.NKey == nil {
+				m.NKey = &v1.SecretKeySelector{}
 			}
-			if err := m.KrbCCacheSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.NKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KrbKeytabSecret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Credential", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -24287,16 +36397,66 @@ func (m *HDFSEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.KrbKeytabSecret == nil {
-				m.KrbKeytabSecret = &v1.SecretKeySelector{}
+			if m.Credential == nil {
+				m.Credential = &v1.SecretKeySelector{}
 			}
-			if err := m.KrbKeytabSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Credential.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NATSEventsSource) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NATSEventsSource: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NATSEventsSource: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KrbUsername", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -24324,11 +36484,11 @@ func (m *HDFSEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.KrbUsername = string(dAtA[iNdEx:postIndex])
+			m.URL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KrbRealm", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Subject", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -24356,11 +36516,11 @@ func (m *HDFSEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.KrbRealm = string(dAtA[iNdEx:postIndex])
+			m.Subject = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KrbConfigConfigMap", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionBackoff", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -24387,18 +36547,38 @@ func (m *HDFSEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.KrbConfigConfigMap == nil {
-				m.KrbConfigConfigMap = &v1.ConfigMapKeySelector{}
+			if m.ConnectionBackoff == nil {
+				m.ConnectionBackoff = &common.Backoff{}
 			}
-			if err := m.KrbConfigConfigMap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ConnectionBackoff.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 11:
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JSONBody", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.JSONBody = bool(v != 0)
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KrbServicePrincipalName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TLS", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -24408,25 +36588,29 @@ func (m *HDFSEventSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.KrbServicePrincipalName = string(dAtA[iNdEx:postIndex])
+			if m.TLS == nil {
+				m.TLS = &common.TLSConfig{}
+			}
+			if err := m.TLS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 12:
+		case 6:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
@@ -24553,9 +36737,9 @@ func (m *HDFSEventSource) Unmarshal(dAtA []byte) error {
 			}
 			m.Metadata[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 13:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Auth", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -24582,68 +36766,18 @@ func (m *HDFSEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Filter == nil {
-				m.Filter = &EventSourceFilter{}
+			if m.Auth == nil {
+				m.Auth = &NATSAuth{}
 			}
-			if err := m.Filter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Auth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *KafkaConsumerGroup) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: KafkaConsumerGroup: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KafkaConsumerGroup: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -24653,29 +36787,33 @@ func (m *KafkaConsumerGroup) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GroupName = string(dAtA[iNdEx:postIndex])
+			if m.Filter == nil {
+				m.Filter = &EventSourceFilter{}
+			}
+			if err := m.Filter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Oldest", wireType)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -24685,17 +36823,30 @@ func (m *KafkaConsumerGroup) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Oldest = bool(v != 0)
-		case 3:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Queue = &s
+			iNdEx = postIndex
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RebalanceStrategy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Transform", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -24705,23 +36856,27 @@ func (m *KafkaConsumerGroup) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RebalanceStrategy = string(dAtA[iNdEx:postIndex])
+			if m.Transform == nil {
+				m.Transform = &EventSourceTransform{}
+			}
+			if err := m.Transform.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -24744,7 +36899,7 @@ func (m *KafkaConsumerGroup) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *KafkaEventSource) Unmarshal(dAtA []byte) error {
+func (m *NSQEventSource) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -24767,15 +36922,15 @@ func (m *KafkaEventSource) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: KafkaEventSource: wiretype end group for non-group")
+			return fmt.Errorf("proto: NSQEventSource: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KafkaEventSource: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NSQEventSource: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HostAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -24803,11 +36958,11 @@ func (m *KafkaEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.URL = string(dAtA[iNdEx:postIndex])
+			m.HostAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Partition", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Topic", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -24835,11 +36990,11 @@ func (m *KafkaEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Partition = string(dAtA[iNdEx:postIndex])
+			m.Topic = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Topic", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -24867,7 +37022,7 @@ func (m *KafkaEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Topic = string(dAtA[iNdEx:postIndex])
+			m.Channel = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
@@ -24906,6 +37061,26 @@ func (m *KafkaEventSource) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JSONBody", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.JSONBody = bool(v != 0)
+		case 6:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field TLS", wireType)
 			}
@@ -24941,26 +37116,6 @@ func (m *KafkaEventSource) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JSONBody", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.JSONBody = bool(v != 0)
 		case 7:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
@@ -25090,7 +37245,7 @@ func (m *KafkaEventSource) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerGroup", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -25117,37 +37272,18 @@ func (m *KafkaEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ConsumerGroup == nil {
-				m.ConsumerGroup = &KafkaConsumerGroup{}
+			if m.Filter == nil {
+				m.Filter = &EventSourceFilter{}
 			}
-			if err := m.ConsumerGroup.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Filter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LimitEventsPerSecond", wireType)
-			}
-			m.LimitEventsPerSecond = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.LimitEventsPerSecond |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Transform", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -25157,65 +37293,83 @@ func (m *KafkaEventSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Version = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 11:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SASL", wireType)
+			if m.Transform == nil {
+				m.Transform = &EventSourceTransform{}
 			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.Transform.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SASL == nil {
-				m.SASL = &common.SASLConfig{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OwnedRepositories) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
 			}
-			if err := m.SASL.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 12:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OwnedRepositories: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OwnedRepositories: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -25225,31 +37379,27 @@ func (m *KafkaEventSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Filter == nil {
-				m.Filter = &EventSourceFilter{}
-			}
-			if err := m.Filter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 13:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Names", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -25277,7 +37427,7 @@ func (m *KafkaEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Config = string(dAtA[iNdEx:postIndex])
+			m.Names = append(m.Names, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -25300,7 +37450,7 @@ func (m *KafkaEventSource) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MQTTEventSource) Unmarshal(dAtA []byte) error {
+func (m *PubSubEventSource) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -25323,15 +37473,15 @@ func (m *MQTTEventSource) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MQTTEventSource: wiretype end group for non-group")
+			return fmt.Errorf("proto: PubSubEventSource: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MQTTEventSource: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PubSubEventSource: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProjectID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -25359,11 +37509,11 @@ func (m *MQTTEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.URL = string(dAtA[iNdEx:postIndex])
+			m.ProjectID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Topic", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicProjectID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -25391,11 +37541,11 @@ func (m *MQTTEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Topic = string(dAtA[iNdEx:postIndex])
+			m.TopicProjectID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Topic", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -25423,11 +37573,43 @@ func (m *MQTTEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClientID = string(dAtA[iNdEx:postIndex])
+			m.Topic = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionBackoff", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SubscriptionID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SubscriptionID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CredentialSecret", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -25454,16 +37636,16 @@ func (m *MQTTEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ConnectionBackoff == nil {
-				m.ConnectionBackoff = &common.Backoff{}
+			if m.CredentialSecret == nil {
+				m.CredentialSecret = &v1.SecretKeySelector{}
 			}
-			if err := m.ConnectionBackoff.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CredentialSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JSONBody", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DeleteSubscriptionOnFinish", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -25480,12 +37662,12 @@ func (m *MQTTEventSource) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.JSONBody = bool(v != 0)
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TLS", wireType)
+			m.DeleteSubscriptionOnFinish = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JSONBody", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -25495,29 +37677,13 @@ func (m *MQTTEventSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.TLS == nil {
-				m.TLS = &common.TLSConfig{}
-			}
-			if err := m.TLS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 7:
+			m.JSONBody = bool(v != 0)
+		case 8:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
@@ -25644,7 +37810,7 @@ func (m *MQTTEventSource) Unmarshal(dAtA []byte) error {
 			}
 			m.Metadata[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 8:
+		case 9:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
 			}
@@ -25680,9 +37846,9 @@ func (m *MQTTEventSource) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Auth", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Transform", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -25709,10 +37875,10 @@ func (m *MQTTEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Auth == nil {
-				m.Auth = &common.BasicAuth{}
+			if m.Transform == nil {
+				m.Transform = &EventSourceTransform{}
 			}
-			if err := m.Auth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Transform.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -25737,7 +37903,7 @@ func (m *MQTTEventSource) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NATSAuth) Unmarshal(dAtA []byte) error {
+func (m *PulsarEventSource) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -25760,17 +37926,17 @@ func (m *NATSAuth) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NATSAuth: wiretype end group for non-group")
+			return fmt.Errorf("proto: PulsarEventSource: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NATSAuth: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PulsarEventSource: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Basic", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Topics", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -25780,33 +37946,29 @@ func (m *NATSAuth) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Basic == nil {
-				m.Basic = &common.BasicAuth{}
-			}
-			if err := m.Basic.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Topics = append(m.Topics, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -25816,33 +37978,29 @@ func (m *NATSAuth) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Token == nil {
-				m.Token = &v1.SecretKeySelector{}
-			}
-			if err := m.Token.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Type = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -25852,31 +38010,27 @@ func (m *NATSAuth) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.NKey == nil {
-				m.NKey = &v1.SecretKeySelector{}
-			}
-			if err := m.NKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.URL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Credential", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TLSTrustCertsSecret", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -25903,68 +38057,58 @@ func (m *NATSAuth) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Credential == nil {
-				m.Credential = &v1.SecretKeySelector{}
+			if m.TLSTrustCertsSecret == nil {
+				m.TLSTrustCertsSecret = &v1.SecretKeySelector{}
 			}
-			if err := m.Credential.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TLSTrustCertsSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TLSAllowInsecureConnection", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *NATSEventsSource) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			m.TLSAllowInsecureConnection = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TLSValidateHostname", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: NATSEventsSource: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NATSEventsSource: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.TLSValidateHostname = bool(v != 0)
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TLS", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -25974,29 +38118,33 @@ func (m *NATSEventsSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.URL = string(dAtA[iNdEx:postIndex])
+			if m.TLS == nil {
+				m.TLS = &common.TLSConfig{}
+			}
+			if err := m.TLS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Subject", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionBackoff", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -26006,27 +38154,51 @@ func (m *NATSEventsSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Subject = string(dAtA[iNdEx:postIndex])
+			if m.ConnectionBackoff == nil {
+				m.ConnectionBackoff = &common.Backoff{}
+			}
+			if err := m.ConnectionBackoff.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JSONBody", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.JSONBody = bool(v != 0)
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionBackoff", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -26053,18 +38225,109 @@ func (m *NATSEventsSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ConnectionBackoff == nil {
-				m.ConnectionBackoff = &common.Backoff{}
+			if m.Metadata == nil {
+				m.Metadata = make(map[string]string)
 			}
-			if err := m.ConnectionBackoff.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
 			}
+			m.Metadata[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JSONBody", wireType)
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthTokenSecret", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -26074,15 +38337,31 @@ func (m *NATSEventsSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.JSONBody = bool(v != 0)
-		case 5:
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AuthTokenSecret == nil {
+				m.AuthTokenSecret = &v1.SecretKeySelector{}
+			}
+			if err := m.AuthTokenSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TLS", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -26109,16 +38388,16 @@ func (m *NATSEventsSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TLS == nil {
-				m.TLS = &common.TLSConfig{}
+			if m.Filter == nil {
+				m.Filter = &EventSourceFilter{}
 			}
-			if err := m.TLS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Filter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthAthenzParams", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -26145,8 +38424,8 @@ func (m *NATSEventsSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Metadata == nil {
-				m.Metadata = make(map[string]string)
+			if m.AuthAthenzParams == nil {
+				m.AuthAthenzParams = make(map[string]string)
 			}
 			var mapkey string
 			var mapvalue string
@@ -26241,11 +38520,11 @@ func (m *NATSEventsSource) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.Metadata[mapkey] = mapvalue
+			m.AuthAthenzParams[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 7:
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Auth", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthAthenzSecret", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -26272,16 +38551,16 @@ func (m *NATSEventsSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Auth == nil {
-				m.Auth = &NATSAuth{}
+			if m.AuthAthenzSecret == nil {
+				m.AuthAthenzSecret = &v1.SecretKeySelector{}
 			}
-			if err := m.Auth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AuthAthenzSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Transform", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -26308,46 +38587,13 @@ func (m *NATSEventsSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Filter == nil {
-				m.Filter = &EventSourceFilter{}
+			if m.Transform == nil {
+				m.Transform = &EventSourceTransform{}
 			}
-			if err := m.Filter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Transform.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.Queue = &s
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -26369,7 +38615,7 @@ func (m *NATSEventsSource) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NSQEventSource) Unmarshal(dAtA []byte) error {
+func (m *RedisEventSource) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -26392,10 +38638,10 @@ func (m *NSQEventSource) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NSQEventSource: wiretype end group for non-group")
+			return fmt.Errorf("proto: RedisEventSource: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NSQEventSource: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RedisEventSource: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -26432,9 +38678,9 @@ func (m *NSQEventSource) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Topic", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -26444,27 +38690,31 @@ func (m *NSQEventSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Topic = string(dAtA[iNdEx:postIndex])
+			if m.Password == nil {
+				m.Password = &v1.SecretKeySelector{}
+			}
+			if err := m.Password.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -26492,13 +38742,13 @@ func (m *NSQEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Channel = string(dAtA[iNdEx:postIndex])
+			m.Namespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionBackoff", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DB", wireType)
 			}
-			var msglen int
+			m.DB = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -26508,33 +38758,16 @@ func (m *NSQEventSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.DB |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.ConnectionBackoff == nil {
-				m.ConnectionBackoff = &common.Backoff{}
-			}
-			if err := m.ConnectionBackoff.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JSONBody", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Channels", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -26544,12 +38777,24 @@ func (m *NSQEventSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.JSONBody = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Channels = append(m.Channels, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field TLS", wireType)
@@ -26749,59 +38994,29 @@ func (m *NSQEventSource) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *OwnedRepositories) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JSONBody", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: OwnedRepositories: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OwnedRepositories: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.JSONBody = bool(v != 0)
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -26829,13 +39044,13 @@ func (m *OwnedRepositories) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.Username = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Names", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Transform", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -26845,23 +39060,27 @@ func (m *OwnedRepositories) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Names = append(m.Names, string(dAtA[iNdEx:postIndex]))
+			if m.Transform == nil {
+				m.Transform = &EventSourceTransform{}
+			}
+			if err := m.Transform.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -26884,7 +39103,7 @@ func (m *OwnedRepositories) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PubSubEventSource) Unmarshal(dAtA []byte) error {
+func (m *RedisStreamEventSource) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -26907,15 +39126,15 @@ func (m *PubSubEventSource) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PubSubEventSource: wiretype end group for non-group")
+			return fmt.Errorf("proto: RedisStreamEventSource: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PubSubEventSource: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RedisStreamEventSource: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProjectID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HostAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -26943,13 +39162,13 @@ func (m *PubSubEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProjectID = string(dAtA[iNdEx:postIndex])
+			m.HostAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicProjectID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -26959,29 +39178,33 @@ func (m *PubSubEventSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TopicProjectID = string(dAtA[iNdEx:postIndex])
+			if m.Password == nil {
+				m.Password = &v1.SecretKeySelector{}
+			}
+			if err := m.Password.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Topic", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DB", wireType)
 			}
-			var stringLen uint64
+			m.DB = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -26991,27 +39214,14 @@ func (m *PubSubEventSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.DB |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Topic = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubscriptionID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Streams", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -27039,13 +39249,13 @@ func (m *PubSubEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SubscriptionID = string(dAtA[iNdEx:postIndex])
+			m.Streams = append(m.Streams, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CredentialSecret", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMsgCountPerRead", wireType)
 			}
-			var msglen int
+			m.MaxMsgCountPerRead = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -27055,33 +39265,16 @@ func (m *PubSubEventSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.MaxMsgCountPerRead |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.CredentialSecret == nil {
-				m.CredentialSecret = &v1.SecretKeySelector{}
-			}
-			if err := m.CredentialSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeleteSubscriptionOnFinish", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerGroup", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -27091,17 +39284,29 @@ func (m *PubSubEventSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.DeleteSubscriptionOnFinish = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerGroup = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JSONBody", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TLS", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -27111,12 +39316,28 @@ func (m *PubSubEventSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.JSONBody = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TLS == nil {
+				m.TLS = &common.TLSConfig{}
+			}
+			if err := m.TLS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 8:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
@@ -27242,11 +39463,79 @@ func (m *PubSubEventSource) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.Metadata[mapkey] = mapvalue
+			m.Metadata[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Filter == nil {
+				m.Filter = &EventSourceFilter{}
+			}
+			if err := m.Filter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Username = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Transform", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -27273,10 +39562,10 @@ func (m *PubSubEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Filter == nil {
-				m.Filter = &EventSourceFilter{}
+			if m.Transform == nil {
+				m.Transform = &EventSourceTransform{}
 			}
-			if err := m.Filter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Transform.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -27301,7 +39590,7 @@ func (m *PubSubEventSource) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PulsarEventSource) Unmarshal(dAtA []byte) error {
+func (m *ResourceEventSource) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -27324,15 +39613,15 @@ func (m *PulsarEventSource) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PulsarEventSource: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResourceEventSource: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PulsarEventSource: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResourceEventSource: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Topics", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -27360,75 +39649,11 @@ func (m *PulsarEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Topics = append(m.Topics, string(dAtA[iNdEx:postIndex]))
+			m.Namespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Type = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.URL = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TLSTrustCertsSecret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -27455,56 +39680,16 @@ func (m *PulsarEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TLSTrustCertsSecret == nil {
-				m.TLSTrustCertsSecret = &v1.SecretKeySelector{}
+			if m.Filter == nil {
+				m.Filter = &ResourceFilter{}
 			}
-			if err := m.TLSTrustCertsSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Filter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TLSAllowInsecureConnection", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.TLSAllowInsecureConnection = bool(v != 0)
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TLSValidateHostname", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.TLSValidateHostname = bool(v != 0)
-		case 7:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TLS", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupVersionResource", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -27531,18 +39716,15 @@ func (m *PulsarEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TLS == nil {
-				m.TLS = &common.TLSConfig{}
-			}
-			if err := m.TLS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.GroupVersionResource.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionBackoff", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EventTypes", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -27552,49 +39734,25 @@ func (m *PulsarEventSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ConnectionBackoff == nil {
-				m.ConnectionBackoff = &common.Backoff{}
-			}
-			if err := m.ConnectionBackoff.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.EventTypes = append(m.EventTypes, ResourceEventType(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JSONBody", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.JSONBody = bool(v != 0)
-		case 10:
+		case 5:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
@@ -27721,11 +39879,61 @@ func (m *PulsarEventSource) Unmarshal(dAtA []byte) error {
 			}
 			m.Metadata[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 11:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResourceFilter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResourceFilter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResourceFilter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AuthTokenSecret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Prefix", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -27735,31 +39943,27 @@ func (m *PulsarEventSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.AuthTokenSecret == nil {
-				m.AuthTokenSecret = &v1.SecretKeySelector{}
-			}
-			if err := m.AuthTokenSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Prefix = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 12:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -27786,16 +39990,14 @@ func (m *PulsarEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Filter == nil {
-				m.Filter = &EventSourceFilter{}
-			}
-			if err := m.Filter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Labels = append(m.Labels, Selector{})
+			if err := m.Labels[len(m.Labels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 13:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AuthAthenzParams", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fields", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -27822,107 +40024,14 @@ func (m *PulsarEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.AuthAthenzParams == nil {
-				m.AuthAthenzParams = make(map[string]string)
-			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			m.Fields = append(m.Fields, Selector{})
+			if err := m.Fields[len(m.Fields)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.AuthAthenzParams[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 14:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AuthAthenzSecret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedBy", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -27949,13 +40058,30 @@ func (m *PulsarEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.AuthAthenzSecret == nil {
-				m.AuthAthenzSecret = &v1.SecretKeySelector{}
-			}
-			if err := m.AuthAthenzSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CreatedBy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AfterStart", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AfterStart = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -27977,7 +40103,7 @@ func (m *PulsarEventSource) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RedisEventSource) Unmarshal(dAtA []byte) error {
+func (m *SFTPEventSource) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -28000,15 +40126,15 @@ func (m *RedisEventSource) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RedisEventSource: wiretype end group for non-group")
+			return fmt.Errorf("proto: SFTPEventSource: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RedisEventSource: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SFTPEventSource: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HostAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EventType", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -28036,9 +40162,78 @@ func (m *RedisEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HostAddress = string(dAtA[iNdEx:postIndex])
+			m.EventType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WatchPathConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.WatchPathConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Username == nil {
+				m.Username = &v1.SecretKeySelector{}
+			}
+			if err := m.Username.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
 			}
@@ -28067,99 +40262,52 @@ func (m *RedisEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Password == nil {
-				m.Password = &v1.SecretKeySelector{}
+			if m.Password == nil {
+				m.Password = &v1.SecretKeySelector{}
+			}
+			if err := m.Password.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SSHKeySecret", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SSHKeySecret == nil {
+				m.SSHKeySecret = &v1.SecretKeySelector{}
 			}
-			if err := m.Password.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SSHKeySecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DB", wireType)
-			}
-			m.DB = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.DB |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Channels", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Channels = append(m.Channels, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TLS", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -28186,10 +40334,10 @@ func (m *RedisEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TLS == nil {
-				m.TLS = &common.TLSConfig{}
+			if m.Address == nil {
+				m.Address = &v1.SecretKeySelector{}
 			}
-			if err := m.TLS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Address.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -28357,10 +40505,10 @@ func (m *RedisEventSource) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JSONBody", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PollIntervalDuration", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -28370,17 +40518,29 @@ func (m *RedisEventSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.JSONBody = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PollIntervalDuration = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Transform", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -28390,23 +40550,27 @@ func (m *RedisEventSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Username = string(dAtA[iNdEx:postIndex])
+			if m.Transform == nil {
+				m.Transform = &EventSourceTransform{}
+			}
+			if err := m.Transform.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -28429,7 +40593,7 @@ func (m *RedisEventSource) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RedisStreamEventSource) Unmarshal(dAtA []byte) error {
+func (m *SNSEventSource) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -28452,17 +40616,17 @@ func (m *RedisStreamEventSource) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RedisStreamEventSource: wiretype end group for non-group")
+			return fmt.Errorf("proto: SNSEventSource: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RedisStreamEventSource: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SNSEventSource: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HostAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Webhook", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -28472,29 +40636,33 @@ func (m *RedisStreamEventSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HostAddress = string(dAtA[iNdEx:postIndex])
+			if m.Webhook == nil {
+				m.Webhook = &WebhookContext{}
+			}
+			if err := m.Webhook.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicArn", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -28504,33 +40672,29 @@ func (m *RedisStreamEventSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Password == nil {
-				m.Password = &v1.SecretKeySelector{}
-			}
-			if err := m.Password.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TopicArn = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DB", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccessKey", wireType)
 			}
-			m.DB = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -28540,16 +40704,33 @@ func (m *RedisStreamEventSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DB |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AccessKey == nil {
+				m.AccessKey = &v1.SecretKeySelector{}
+			}
+			if err := m.AccessKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Streams", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SecretKey", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -28559,46 +40740,31 @@ func (m *RedisStreamEventSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Streams = append(m.Streams, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxMsgCountPerRead", wireType)
+			if m.SecretKey == nil {
+				m.SecretKey = &v1.SecretKeySelector{}
 			}
-			m.MaxMsgCountPerRead = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MaxMsgCountPerRead |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.SecretKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		case 6:
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerGroup", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Region", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -28626,13 +40792,13 @@ func (m *RedisStreamEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConsumerGroup = string(dAtA[iNdEx:postIndex])
+			m.Region = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TLS", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RoleARN", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -28642,29 +40808,25 @@ func (m *RedisStreamEventSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TLS == nil {
-				m.TLS = &common.TLSConfig{}
-			}
-			if err := m.TLS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.RoleARN = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 7:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
@@ -28791,6 +40953,26 @@ func (m *RedisStreamEventSource) Unmarshal(dAtA []byte) error {
 			}
 			m.Metadata[mapkey] = mapvalue
 			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidateSignature", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ValidateSignature = bool(v != 0)
 		case 9:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
@@ -28829,7 +41011,7 @@ func (m *RedisStreamEventSource) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Endpoint", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -28857,7 +41039,43 @@ func (m *RedisStreamEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Username = string(dAtA[iNdEx:postIndex])
+			m.Endpoint = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transform", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Transform == nil {
+				m.Transform = &EventSourceTransform{}
+			}
+			if err := m.Transform.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -28880,7 +41098,7 @@ func (m *RedisStreamEventSource) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResourceEventSource) Unmarshal(dAtA []byte) error {
+func (m *SQLEventSource) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -28903,15 +41121,15 @@ func (m *ResourceEventSource) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResourceEventSource: wiretype end group for non-group")
+			return fmt.Errorf("proto: SQLEventSource: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResourceEventSource: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SQLEventSource: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Driver", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -28939,11 +41157,11 @@ func (m *ResourceEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
+			m.Driver = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DSN", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -28970,18 +41188,18 @@ func (m *ResourceEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Filter == nil {
-				m.Filter = &ResourceFilter{}
+			if m.DSN == nil {
+				m.DSN = &v1.SecretKeySelector{}
 			}
-			if err := m.Filter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DSN.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupVersionResource", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -28991,28 +41209,27 @@ func (m *ResourceEventSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.GroupVersionResource.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Query = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EventTypes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CursorColumn", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -29040,9 +41257,109 @@ func (m *ResourceEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EventTypes = append(m.EventTypes, ResourceEventType(dAtA[iNdEx:postIndex]))
+			m.CursorColumn = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialCursor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InitialCursor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PollInterval", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PollInterval = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Persistence", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Persistence == nil {
+				m.Persistence = &ConfigMapPersistence{}
+			}
+			if err := m.Persistence.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
@@ -29147,147 +41464,31 @@ func (m *ResourceEventSource) Unmarshal(dAtA []byte) error {
 					if postStringIndexmapvalue < 0 {
 						return ErrInvalidLengthGenerated
 					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.Metadata[mapkey] = mapvalue
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ResourceFilter) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ResourceFilter: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResourceFilter: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Prefix", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Prefix = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Labels = append(m.Labels, Selector{})
-			if err := m.Labels[len(m.Labels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Metadata[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 3:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fields", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -29314,14 +41515,16 @@ func (m *ResourceFilter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Fields = append(m.Fields, Selector{})
-			if err := m.Fields[len(m.Fields)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Filter == nil {
+				m.Filter = &EventSourceFilter{}
+			}
+			if err := m.Filter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedBy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Transform", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -29348,30 +41551,13 @@ func (m *ResourceFilter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CreatedBy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Transform == nil {
+				m.Transform = &EventSourceTransform{}
+			}
+			if err := m.Transform.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AfterStart", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.AfterStart = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -29393,7 +41579,7 @@ func (m *ResourceFilter) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SFTPEventSource) Unmarshal(dAtA []byte) error {
+func (m *SQSEventSource) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -29416,17 +41602,17 @@ func (m *SFTPEventSource) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SFTPEventSource: wiretype end group for non-group")
+			return fmt.Errorf("proto: SQSEventSource: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SFTPEventSource: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SQSEventSource: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EventType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccessKey", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -29436,27 +41622,31 @@ func (m *SFTPEventSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EventType = string(dAtA[iNdEx:postIndex])
+			if m.AccessKey == nil {
+				m.AccessKey = &v1.SecretKeySelector{}
+			}
+			if err := m.AccessKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WatchPathConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SecretKey", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -29483,15 +41673,18 @@ func (m *SFTPEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.WatchPathConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.SecretKey == nil {
+				m.SecretKey = &v1.SecretKeySelector{}
+			}
+			if err := m.SecretKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Region", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -29501,33 +41694,29 @@ func (m *SFTPEventSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Username == nil {
-				m.Username = &v1.SecretKeySelector{}
-			}
-			if err := m.Username.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Region = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -29537,33 +41726,48 @@ func (m *SFTPEventSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Password == nil {
-				m.Password = &v1.SecretKeySelector{}
-			}
-			if err := m.Password.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Queue = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WaitTimeSeconds", wireType)
+			}
+			m.WaitTimeSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WaitTimeSeconds |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SSHKeySecret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RoleARN", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -29573,33 +41777,49 @@ func (m *SFTPEventSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SSHKeySecret == nil {
-				m.SSHKeySecret = &v1.SecretKeySelector{}
+			m.RoleARN = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JSONBody", wireType)
 			}
-			if err := m.SSHKeySecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 6:
+			m.JSONBody = bool(v != 0)
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field QueueAccountID", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -29609,29 +41829,25 @@ func (m *SFTPEventSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Address == nil {
-				m.Address = &v1.SecretKeySelector{}
-			}
-			if err := m.Address.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.QueueAccountID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 9:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
@@ -29758,7 +41974,27 @@ func (m *SFTPEventSource) Unmarshal(dAtA []byte) error {
 			}
 			m.Metadata[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 8:
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DLQ", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DLQ = bool(v != 0)
+		case 11:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
 			}
@@ -29794,9 +42030,9 @@ func (m *SFTPEventSource) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PollIntervalDuration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Endpoint", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -29824,7 +42060,79 @@ func (m *SFTPEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PollIntervalDuration = string(dAtA[iNdEx:postIndex])
+			m.Endpoint = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionToken", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SessionToken == nil {
+				m.SessionToken = &v1.SecretKeySelector{}
+			}
+			if err := m.SessionToken.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transform", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Transform == nil {
+				m.Transform = &EventSourceTransform{}
+			}
+			if err := m.Transform.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -29847,7 +42155,7 @@ func (m *SFTPEventSource) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SNSEventSource) Unmarshal(dAtA []byte) error {
+func (m *Selector) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -29870,15 +42178,161 @@ func (m *SNSEventSource) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SNSEventSource: wiretype end group for non-group")
+			return fmt.Errorf("proto: Selector: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SNSEventSource: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Selector: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Webhook", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operation = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Service) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Service: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Service: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ports", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -29905,16 +42359,14 @@ func (m *SNSEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Webhook == nil {
-				m.Webhook = &WebhookContext{}
-			}
-			if err := m.Webhook.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Ports = append(m.Ports, v1.ServicePort{})
+			if err := m.Ports[len(m.Ports)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicArn", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterIP", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -29942,11 +42394,61 @@ func (m *SNSEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TopicArn = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+			m.ClusterIP = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SlackEventSource) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SlackEventSource: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SlackEventSource: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccessKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SigningSecret", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -29973,16 +42475,16 @@ func (m *SNSEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.AccessKey == nil {
-				m.AccessKey = &v1.SecretKeySelector{}
+			if m.SigningSecret == nil {
+				m.SigningSecret = &v1.SecretKeySelector{}
 			}
-			if err := m.AccessKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SigningSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SecretKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -30009,18 +42511,18 @@ func (m *SNSEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SecretKey == nil {
-				m.SecretKey = &v1.SecretKeySelector{}
+			if m.Token == nil {
+				m.Token = &v1.SecretKeySelector{}
 			}
-			if err := m.SecretKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Token.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Region", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Webhook", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -30030,57 +42532,29 @@ func (m *SNSEventSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Region = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RoleARN", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+			if m.Webhook == nil {
+				m.Webhook = &WebhookContext{}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.Webhook.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.RoleARN = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
@@ -30207,27 +42681,7 @@ func (m *SNSEventSource) Unmarshal(dAtA []byte) error {
 			}
 			m.Metadata[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidateSignature", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.ValidateSignature = bool(v != 0)
-		case 9:
+		case 5:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
 			}
@@ -30263,11 +42717,11 @@ func (m *SNSEventSource) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Endpoint", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Transform", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -30277,23 +42731,27 @@ func (m *SNSEventSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Endpoint = string(dAtA[iNdEx:postIndex])
+			if m.Transform == nil {
+				m.Transform = &EventSourceTransform{}
+			}
+			if err := m.Transform.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -30316,7 +42774,7 @@ func (m *SNSEventSource) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SQSEventSource) Unmarshal(dAtA []byte) error {
+func (m *StorageGridEventSource) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -30339,15 +42797,15 @@ func (m *SQSEventSource) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SQSEventSource: wiretype end group for non-group")
+			return fmt.Errorf("proto: StorageGridEventSource: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SQSEventSource: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StorageGridEventSource: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccessKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Webhook", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -30374,18 +42832,18 @@ func (m *SQSEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.AccessKey == nil {
-				m.AccessKey = &v1.SecretKeySelector{}
+			if m.Webhook == nil {
+				m.Webhook = &WebhookContext{}
 			}
-			if err := m.AccessKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Webhook.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SecretKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -30395,33 +42853,29 @@ func (m *SQSEventSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SecretKey == nil {
-				m.SecretKey = &v1.SecretKeySelector{}
-			}
-			if err := m.SecretKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Events = append(m.Events, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Region", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -30431,27 +42885,31 @@ func (m *SQSEventSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Region = string(dAtA[iNdEx:postIndex])
+			if m.Filter == nil {
+				m.Filter = &StorageGridFilter{}
+			}
+			if err := m.Filter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicArn", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -30479,13 +42937,13 @@ func (m *SQSEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Queue = string(dAtA[iNdEx:postIndex])
+			m.TopicArn = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WaitTimeSeconds", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bucket", wireType)
 			}
-			m.WaitTimeSeconds = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -30495,14 +42953,27 @@ func (m *SQSEventSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.WaitTimeSeconds |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bucket = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RoleARN", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Region", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -30530,13 +43001,13 @@ func (m *SQSEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RoleARN = string(dAtA[iNdEx:postIndex])
+			m.Region = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JSONBody", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthToken", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -30546,15 +43017,31 @@ func (m *SQSEventSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.JSONBody = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AuthToken == nil {
+				m.AuthToken = &v1.SecretKeySelector{}
+			}
+			if err := m.AuthToken.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QueueAccountID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field APIURL", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -30582,7 +43069,7 @@ func (m *SQSEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.QueueAccountID = string(dAtA[iNdEx:postIndex])
+			m.APIURL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 9:
 			if wireType != 2 {
@@ -30711,9 +43198,209 @@ func (m *SQSEventSource) Unmarshal(dAtA []byte) error {
 			}
 			m.Metadata[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 10:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StorageGridFilter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StorageGridFilter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StorageGridFilter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Prefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Suffix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Suffix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StripeEventSource) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StripeEventSource: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StripeEventSource: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Webhook", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Webhook == nil {
+				m.Webhook = &WebhookContext{}
+			}
+			if err := m.Webhook.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DLQ", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateWebhook", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -30730,10 +43417,10 @@ func (m *SQSEventSource) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.DLQ = bool(v != 0)
-		case 11:
+			m.CreateWebhook = bool(v != 0)
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field APIKey", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -30760,16 +43447,16 @@ func (m *SQSEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Filter == nil {
-				m.Filter = &EventSourceFilter{}
+			if m.APIKey == nil {
+				m.APIKey = &v1.SecretKeySelector{}
 			}
-			if err := m.Filter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.APIKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 12:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Endpoint", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EventFilter", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -30797,11 +43484,11 @@ func (m *SQSEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Endpoint = string(dAtA[iNdEx:postIndex])
+			m.EventFilter = append(m.EventFilter, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 13:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SessionToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -30818,22 +43505,113 @@ func (m *SQSEventSource) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.SessionToken == nil {
-				m.SessionToken = &v1.SecretKeySelector{}
-			}
-			if err := m.SessionToken.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Metadata[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -30856,7 +43634,7 @@ func (m *SQSEventSource) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Selector) Unmarshal(dAtA []byte) error {
+func (m *TCPDependencyProbe) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -30879,79 +43657,15 @@ func (m *Selector) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Selector: wiretype end group for non-group")
+			return fmt.Errorf("proto: TCPDependencyProbe: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Selector: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TCPDependencyProbe: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Key = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Operation", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Operation = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -30979,7 +43693,7 @@ func (m *Selector) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -31002,7 +43716,7 @@ func (m *Selector) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Service) Unmarshal(dAtA []byte) error {
+func (m *Template) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -31025,15 +43739,15 @@ func (m *Service) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Service: wiretype end group for non-group")
+			return fmt.Errorf("proto: Template: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Service: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Template: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ports", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -31060,14 +43774,16 @@ func (m *Service) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Ports = append(m.Ports, v1.ServicePort{})
-			if err := m.Ports[len(m.Ports)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Metadata == nil {
+				m.Metadata = &common.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClusterIP", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceAccountName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -31095,61 +43811,81 @@ func (m *Service) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClusterIP = string(dAtA[iNdEx:postIndex])
+			m.ServiceAccountName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Container", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SlackEventSource) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			if m.Container == nil {
+				m.Container = &v1.Container{}
 			}
-			if iNdEx >= l {
+			if err := m.Container.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Volumes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Volumes = append(m.Volumes, v1.Volume{})
+			if err := m.Volumes[len(m.Volumes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SlackEventSource: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SlackEventSource: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SigningSecret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SecurityContext", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -31176,16 +43912,16 @@ func (m *SlackEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SigningSecret == nil {
-				m.SigningSecret = &v1.SecretKeySelector{}
+			if m.SecurityContext == nil {
+				m.SecurityContext = &v1.PodSecurityContext{}
 			}
-			if err := m.SigningSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SecurityContext.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Affinity", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -31212,16 +43948,16 @@ func (m *SlackEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Token == nil {
-				m.Token = &v1.SecretKeySelector{}
+			if m.Affinity == nil {
+				m.Affinity = &v1.Affinity{}
 			}
-			if err := m.Token.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Affinity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Webhook", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tolerations", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -31248,16 +43984,14 @@ func (m *SlackEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Webhook == nil {
-				m.Webhook = &WebhookContext{}
-			}
-			if err := m.Webhook.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Tolerations = append(m.Tolerations, v1.Toleration{})
+			if err := m.Tolerations[len(m.Tolerations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeSelector", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -31284,8 +44018,8 @@ func (m *SlackEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Metadata == nil {
-				m.Metadata = make(map[string]string)
+			if m.NodeSelector == nil {
+				m.NodeSelector = make(map[string]string)
 			}
 			var mapkey string
 			var mapvalue string
@@ -31380,11 +44114,11 @@ func (m *SlackEventSource) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.Metadata[mapkey] = mapvalue
+			m.NodeSelector[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 5:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ImagePullSecrets", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -31411,13 +44145,96 @@ func (m *SlackEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Filter == nil {
-				m.Filter = &EventSourceFilter{}
-			}
-			if err := m.Filter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ImagePullSecrets = append(m.ImagePullSecrets, v1.LocalObjectReference{})
+			if err := m.ImagePullSecrets[len(m.ImagePullSecrets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriorityClassName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriorityClassName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Priority = &v
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RuntimeClassName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.RuntimeClassName = &s
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -31439,7 +44256,7 @@ func (m *SlackEventSource) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StorageGridEventSource) Unmarshal(dAtA []byte) error {
+func (m *WatchPathConfig) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -31462,17 +44279,17 @@ func (m *StorageGridEventSource) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StorageGridEventSource: wiretype end group for non-group")
+			return fmt.Errorf("proto: WatchPathConfig: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StorageGridEventSource: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WatchPathConfig: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Webhook", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Directory", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -31482,31 +44299,27 @@ func (m *StorageGridEventSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Webhook == nil {
-				m.Webhook = &WebhookContext{}
-			}
-			if err := m.Webhook.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Directory = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -31534,13 +44347,13 @@ func (m *StorageGridEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Events = append(m.Events, string(dAtA[iNdEx:postIndex]))
+			m.Path = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PathRegexp", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -31550,31 +44363,109 @@ func (m *StorageGridEventSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Filter == nil {
-				m.Filter = &StorageGridFilter{}
-			}
-			if err := m.Filter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PathRegexp = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WebhookContext) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WebhookContext: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WebhookContext: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Endpoint", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Endpoint = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicArn", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Method", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -31602,11 +44493,11 @@ func (m *StorageGridEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TopicArn = string(dAtA[iNdEx:postIndex])
+			m.Method = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Bucket", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Port", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -31634,11 +44525,11 @@ func (m *StorageGridEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Bucket = string(dAtA[iNdEx:postIndex])
+			m.Port = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Region", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -31666,11 +44557,11 @@ func (m *StorageGridEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Region = string(dAtA[iNdEx:postIndex])
+			m.URL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AuthToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ServerCertSecret", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -31697,18 +44588,18 @@ func (m *StorageGridEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.AuthToken == nil {
-				m.AuthToken = &v1.SecretKeySelector{}
+			if m.ServerCertSecret == nil {
+				m.ServerCertSecret = &v1.SecretKeySelector{}
 			}
-			if err := m.AuthToken.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ServerCertSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field APIURL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ServerKeySecret", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -31718,25 +44609,29 @@ func (m *StorageGridEventSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.APIURL = string(dAtA[iNdEx:postIndex])
+			if m.ServerKeySecret == nil {
+				m.ServerKeySecret = &v1.SecretKeySelector{}
+			}
+			if err := m.ServerKeySecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 9:
+		case 7:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
@@ -31863,61 +44758,11 @@ func (m *StorageGridEventSource) Unmarshal(dAtA []byte) error {
 			}
 			m.Metadata[mapkey] = mapvalue
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *StorageGridFilter) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: StorageGridFilter: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StorageGridFilter: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Prefix", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthSecret", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -31927,29 +44772,33 @@ func (m *StorageGridFilter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Prefix = string(dAtA[iNdEx:postIndex])
+			if m.AuthSecret == nil {
+				m.AuthSecret = &v1.SecretKeySelector{}
+			}
+			if err := m.AuthSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Suffix", wireType)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPayloadSize", wireType)
 			}
-			var stringLen uint64
+			var v int64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -31959,77 +44808,15 @@ func (m *StorageGridFilter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Suffix = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *StripeEventSource) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: StripeEventSource: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StripeEventSource: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.MaxPayloadSize = &v
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Webhook", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Replay", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -32056,16 +44843,16 @@ func (m *StripeEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Webhook == nil {
-				m.Webhook = &WebhookContext{}
+			if m.Replay == nil {
+				m.Replay = &WebhookReplay{}
 			}
-			if err := m.Webhook.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Replay.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 11:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreateWebhook", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GenerateToken", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -32082,12 +44869,12 @@ func (m *StripeEventSource) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.CreateWebhook = bool(v != 0)
-		case 3:
+			m.GenerateToken = bool(v != 0)
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field APIKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenRotationPeriod", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -32097,31 +44884,27 @@ func (m *StripeEventSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.APIKey == nil {
-				m.APIKey = &v1.SecretKeySelector{}
-			}
-			if err := m.APIKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TokenRotationPeriod = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EventFilter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PayloadSchema", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -32149,13 +44932,13 @@ func (m *StripeEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EventFilter = append(m.EventFilter, string(dAtA[iNdEx:postIndex]))
+			m.PayloadSchema = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Host", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -32165,174 +44948,29 @@ func (m *StripeEventSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Metadata == nil {
-				m.Metadata = make(map[string]string)
-			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.Metadata[mapkey] = mapvalue
+			m.Host = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Template) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Template: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Template: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceGroup", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -32342,33 +44980,29 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Metadata == nil {
-				m.Metadata = &common.Metadata{}
-			}
-			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ServiceGroup = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 16:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServiceAccountName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Enrichment", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -32378,27 +45012,31 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ServiceAccountName = string(dAtA[iNdEx:postIndex])
+			if m.Enrichment == nil {
+				m.Enrichment = &WebhookEnrichment{}
+			}
+			if err := m.Enrichment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 17:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Container", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Redelivery", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -32425,18 +45063,68 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Container == nil {
-				m.Container = &v1.Container{}
+			if m.Redelivery == nil {
+				m.Redelivery = &WebhookRedelivery{}
 			}
-			if err := m.Container.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Redelivery.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WebhookEnrichment) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WebhookEnrichment: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WebhookEnrichment: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Volumes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -32446,31 +45134,29 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Volumes = append(m.Volumes, v1.Volume{})
-			if err := m.Volumes[len(m.Volumes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SecurityContext", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TrustedProxies", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -32480,33 +45166,29 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SecurityContext == nil {
-				m.SecurityContext = &v1.PodSecurityContext{}
-			}
-			if err := m.SecurityContext.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TrustedProxies = append(m.TrustedProxies, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Affinity", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientIdentityHeader", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -32516,31 +45198,27 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Affinity == nil {
-				m.Affinity = &v1.Affinity{}
-			}
-			if err := m.Affinity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ClientIdentityHeader = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tolerations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Networks", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -32567,14 +45245,64 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tolerations = append(m.Tolerations, v1.Toleration{})
-			if err := m.Tolerations[len(m.Tolerations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Networks = append(m.Networks, WebhookNetwork{})
+			if err := m.Networks[len(m.Networks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WebhookEventSource) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WebhookEventSource: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WebhookEventSource: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NodeSelector", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WebhookContext", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -32601,107 +45329,13 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.NodeSelector == nil {
-				m.NodeSelector = make(map[string]string)
-			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			if err := m.WebhookContext.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.NodeSelector[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 9:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ImagePullSecrets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -32728,16 +45362,18 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ImagePullSecrets = append(m.ImagePullSecrets, v1.LocalObjectReference{})
-			if err := m.ImagePullSecrets[len(m.ImagePullSecrets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Filter == nil {
+				m.Filter = &EventSourceFilter{}
+			}
+			if err := m.Filter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PriorityClassName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Transform", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -32747,44 +45383,28 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PriorityClassName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+			if m.Transform == nil {
+				m.Transform = &EventSourceTransform{}
 			}
-			var v int32
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.Transform.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Priority = &v
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -32806,7 +45426,7 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *WatchPathConfig) Unmarshal(dAtA []byte) error {
+func (m *WebhookNetwork) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -32829,15 +45449,15 @@ func (m *WatchPathConfig) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: WatchPathConfig: wiretype end group for non-group")
+			return fmt.Errorf("proto: WebhookNetwork: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WatchPathConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WebhookNetwork: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Directory", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -32865,43 +45485,11 @@ func (m *WatchPathConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Directory = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Path = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PathRegexp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CIDRs", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -32929,7 +45517,7 @@ func (m *WatchPathConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PathRegexp = string(dAtA[iNdEx:postIndex])
+			m.CIDRs = append(m.CIDRs, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -32952,7 +45540,7 @@ func (m *WatchPathConfig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *WebhookContext) Unmarshal(dAtA []byte) error {
+func (m *WebhookRedelivery) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -32975,15 +45563,15 @@ func (m *WebhookContext) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: WebhookContext: wiretype end group for non-group")
+			return fmt.Errorf("proto: WebhookRedelivery: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WebhookContext: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WebhookRedelivery: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Endpoint", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DeliveryIDHeader", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -33011,11 +45599,11 @@ func (m *WebhookContext) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Endpoint = string(dAtA[iNdEx:postIndex])
+			m.DeliveryIDHeader = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Method", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DeliveryIDPath", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -33043,11 +45631,11 @@ func (m *WebhookContext) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Method = string(dAtA[iNdEx:postIndex])
+			m.DeliveryIDPath = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Port", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AttemptHeader", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -33075,11 +45663,11 @@ func (m *WebhookContext) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Port = string(dAtA[iNdEx:postIndex])
+			m.AttemptHeader = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -33107,13 +45695,13 @@ func (m *WebhookContext) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.URL = string(dAtA[iNdEx:postIndex])
+			m.Window = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServerCertSecret", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Suppress", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -33123,33 +45711,67 @@ func (m *WebhookContext) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
+			m.Suppress = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ServerCertSecret == nil {
-				m.ServerCertSecret = &v1.SecretKeySelector{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WebhookReplay) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
 			}
-			if err := m.ServerCertSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServerKeySecret", wireType)
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			var msglen int
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WebhookReplay: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WebhookReplay: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDeliveries", wireType)
+			}
+			m.MaxDeliveries = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -33159,31 +45781,14 @@ func (m *WebhookContext) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.MaxDeliveries |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.ServerKeySecret == nil {
-				m.ServerKeySecret = &v1.SecretKeySelector{}
-			}
-			if err := m.ServerKeySecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthSecret", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -33210,109 +45815,18 @@ func (m *WebhookContext) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Metadata == nil {
-				m.Metadata = make(map[string]string)
+			if m.AuthSecret == nil {
+				m.AuthSecret = &v1.SecretKeySelector{}
 			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			if err := m.AuthSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Metadata[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 8:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AuthSecret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Dir", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -33322,48 +45836,24 @@ func (m *WebhookContext) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.AuthSecret == nil {
-				m.AuthSecret = &v1.SecretKeySelector{}
-			}
-			if err := m.AuthSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Dir = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxPayloadSize", wireType)
-			}
-			var v int64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.MaxPayloadSize = &v
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -33385,7 +45875,7 @@ func (m *WebhookContext) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *WebhookEventSource) Unmarshal(dAtA []byte) error {
+func (m *WebhookTokenRotation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -33408,17 +45898,17 @@ func (m *WebhookEventSource) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: WebhookEventSource: wiretype end group for non-group")
+			return fmt.Errorf("proto: WebhookTokenRotation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WebhookEventSource: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WebhookTokenRotation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WebhookContext", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EventName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -33428,28 +45918,27 @@ func (m *WebhookEventSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.WebhookContext.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.EventName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NextRotationTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -33476,10 +45965,7 @@ func (m *WebhookEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Filter == nil {
-				m.Filter = &EventSourceFilter{}
-			}
-			if err := m.Filter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.NextRotationTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
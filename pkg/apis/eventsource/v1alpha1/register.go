@@ -36,6 +36,9 @@ var (
 	// SchemaGroupVersionKind is a group version kind used to attach owner references to eventsource-controller
 	SchemaGroupVersionKind = SchemeGroupVersion.WithKind(eventsource.Kind)
 
+	// ClusterSchemaGroupVersionKind is a group version kind used to attach owner references to the instantiated EventSources
+	ClusterSchemaGroupVersionKind = SchemeGroupVersion.WithKind(eventsource.ClusterKind)
+
 	// SchemeBuilder is used to add go types to the GroupVersionKind scheme
 	SchemeBuilder = runtime.NewSchemeBuilder(addKnownTypes)
 
@@ -57,6 +60,8 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 	scheme.AddKnownTypes(SchemeGroupVersion,
 		&EventSource{},
 		&EventSourceList{},
+		&ClusterEventSource{},
+		&ClusterEventSourceList{},
 	)
 	v1.AddToGroupVersion(scheme, SchemeGroupVersion)
 	return nil
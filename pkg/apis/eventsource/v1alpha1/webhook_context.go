@@ -1,10 +1,22 @@
 package v1alpha1
 
 import (
+	"time"
+
 	corev1 "k8s.io/api/core/v1"
 )
 
-const DefaultMaxWebhookPayloadSize int64 = 1048576 // 1MB
+const (
+	DefaultMaxWebhookPayloadSize      int64 = 1048576 // 1MB
+	DefaultWebhookReplayMaxDeliveries       = 100
+	// PreviousWebhookTokenKeySuffix is the suffix of the key of the previous generated token of an endpoint,
+	// which remains valid until the next rotation
+	PreviousWebhookTokenKeySuffix = "-previous"
+	// DefaultWebhookEnrichmentKey is the default key of the request metadata in the event payloads
+	DefaultWebhookEnrichmentKey = "request"
+	// DefaultWebhookRedeliveryWindow is the default window the ids of the dispatched deliveries are remembered
+	DefaultWebhookRedeliveryWindow = time.Hour
+)
 
 // WebhookContext holds a general purpose REST API context
 type WebhookContext struct {
@@ -32,6 +44,155 @@ type WebhookContext struct {
 	// Default value: 1048576 (1MB).
 	// +optional
 	MaxPayloadSize *int64 `json:"maxPayloadSize,omitempty" protobuf:"bytes,9,opt,name=maxPayloadSize"`
+	// Replay keeps the last deliveries of the endpoint, which can be listed and republished to the EventBus
+	// with the replay endpoints, e.g. after fixing a Sensor which mishandled them.
+	// +optional
+	Replay *WebhookReplay `json:"replay,omitempty" protobuf:"bytes,10,opt,name=replay"`
+	// GenerateToken makes the controller generate a random bearer token for the endpoint, used instead of
+	// the AuthSecret. The token is kept in a Secret named in the status of the EventSource, under the key
+	// of the event name.
+	// +optional
+	GenerateToken bool `json:"generateToken,omitempty" protobuf:"varint,11,opt,name=generateToken"`
+	// TokenRotationPeriod is the period the generated token is rotated with, e.g. "720h". The previous
+	// token remains valid until the next rotation. The token is not rotated if it is not set.
+	// +optional
+	TokenRotationPeriod string `json:"tokenRotationPeriod,omitempty" protobuf:"bytes,12,opt,name=tokenRotationPeriod"`
+	// PayloadSchema is the JSON schema of the payload expected by the endpoint, published in the OpenAPI
+	// document served on /openapi.json by the server of the endpoint. It is only documentation, the
+	// payloads are not validated against it.
+	// +optional
+	PayloadSchema string `json:"payloadSchema,omitempty" protobuf:"bytes,13,opt,name=payloadSchema"`
+	// Host is the hostname the requests of the endpoint are sent to, matched against the Host header of
+	// the requests, e.g. "github.example.com". The endpoints of a port are routed by host and path, so
+	// the same endpoint can be used by several events with different hosts. Any host is accepted if it
+	// is not set.
+	// +optional
+	Host string `json:"host,omitempty" protobuf:"bytes,14,opt,name=host"`
+	// ServiceGroup is the name of the group of endpoints exposed by a dedicated ClusterIP Service named
+	// "<eventsource-name>-eventsource-<serviceGroup>-svc", with the ports of the endpoints of the group,
+	// so that different producers can be given different DNS names and network policies.
+	// +optional
+	ServiceGroup string `json:"serviceGroup,omitempty" protobuf:"bytes,15,opt,name=serviceGroup"`
+	// Enrichment adds the metadata of the requests to the event payloads, e.g. the source IP and the parsed
+	// user agent, which the Sensors can filter on, e.g. for abuse detection.
+	// +optional
+	Enrichment *WebhookEnrichment `json:"enrichment,omitempty" protobuf:"bytes,16,opt,name=enrichment"`
+	// Redelivery detects the deliveries retried by the provider of the webhook, e.g. GitHub redeliveries or Slack
+	// retries, and adds their delivery attributes to the CloudEvent extensions of the events, so that the Sensors
+	// can choose whether to honor them. The redeliveries can also be suppressed.
+	// +optional
+	Redelivery *WebhookRedelivery `json:"redelivery,omitempty" protobuf:"bytes,17,opt,name=redelivery"`
+}
+
+// WebhookRedelivery describes how the deliveries retried by the provider of a webhook are detected. A delivery is
+// a redelivery if its attempt number is greater than 1, or if a delivery with the same id was dispatched within the
+// window.
+type WebhookRedelivery struct {
+	// DeliveryIDHeader is the header holding the id of the delivery, kept by the provider across its retries.
+	// Defaults to the first header found among "X-GitHub-Delivery", "X-Gitlab-Event-UUID" and "Idempotency-Key".
+	// +optional
+	DeliveryIDHeader string `json:"deliveryIDHeader,omitempty" protobuf:"bytes,1,opt,name=deliveryIDHeader"`
+	// DeliveryIDPath is the path of the delivery id in the event payload, used instead of the header, e.g.
+	// "event.id" for Stripe, whose retries keep the id of the event.
+	// +optional
+	DeliveryIDPath string `json:"deliveryIDPath,omitempty" protobuf:"bytes,2,opt,name=deliveryIDPath"`
+	// AttemptHeader is the header holding the number of retries of the delivery, the attempt number is this
+	// number plus 1. Defaults to "X-Slack-Retry-Num".
+	// +optional
+	AttemptHeader string `json:"attemptHeader,omitempty" protobuf:"bytes,3,opt,name=attemptHeader"`
+	// Window is how long the ids of the dispatched deliveries are remembered, e.g. "1h".
+	// Default value: "1h".
+	// +optional
+	Window string `json:"window,omitempty" protobuf:"bytes,4,opt,name=window"`
+	// Suppress acknowledges the redeliveries of the deliveries dispatched within the window without dispatching
+	// them again. They are dispatched with the redelivery extensions otherwise.
+	// +optional
+	Suppress bool `json:"suppress,omitempty" protobuf:"varint,5,opt,name=suppress"`
+}
+
+// GetWindow returns how long the ids of the dispatched deliveries are remembered
+func (r *WebhookRedelivery) GetWindow() time.Duration {
+	if r == nil || r.Window == "" {
+		return DefaultWebhookRedeliveryWindow
+	}
+	d, err := time.ParseDuration(r.Window)
+	if err != nil {
+		return DefaultWebhookRedeliveryWindow
+	}
+	return d
+}
+
+// WebhookEnrichment describes the metadata of the requests added to the event payloads of a webhook endpoint,
+// as a JSON object under a dedicated key of the payload.
+type WebhookEnrichment struct {
+	// Key is the key of the request metadata in the event payload.
+	// Default value: "request".
+	// +optional
+	Key string `json:"key,omitempty" protobuf:"bytes,1,opt,name=key"`
+	// TrustedProxies are the CIDRs of the proxies in front of the endpoint, e.g. the ingress controller. The
+	// X-Forwarded-For header and the client identity header are only trusted from them, the source IP is the
+	// address of the peer otherwise.
+	// +optional
+	TrustedProxies []string `json:"trustedProxies,omitempty" protobuf:"bytes,2,rep,name=trustedProxies"`
+	// ClientIdentityHeader is the header holding the identity of the TLS client, set by a trusted proxy
+	// terminating TLS, e.g. "X-Forwarded-Client-Cert" for Envoy or "ssl-client-subject-dn" for NGINX.
+	// +optional
+	ClientIdentityHeader string `json:"clientIdentityHeader,omitempty" protobuf:"bytes,3,opt,name=clientIdentityHeader"`
+	// Networks label the source IP with the names of the networks it belongs to, e.g. the published IP ranges of
+	// a SaaS producer or the internal networks.
+	// +optional
+	Networks []WebhookNetwork `json:"networks,omitempty" protobuf:"bytes,4,rep,name=networks"`
+}
+
+// WebhookNetwork is a named set of IP ranges.
+type WebhookNetwork struct {
+	// Name of the network, added to the request metadata when the source IP belongs to it.
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// CIDRs of the network, e.g. "192.30.252.0/22".
+	CIDRs []string `json:"cidrs" protobuf:"bytes,2,rep,name=cidrs"`
+}
+
+// GetKey returns the key of the request metadata in the event payload
+func (e *WebhookEnrichment) GetKey() string {
+	if e == nil || e.Key == "" {
+		return DefaultWebhookEnrichmentKey
+	}
+	return e.Key
+}
+
+// WebhookReplay describes the store of the last deliveries of a webhook endpoint. The deliveries are listed with
+// GET <endpoint>/_replay, and republished to the EventBus with POST <endpoint>/_replay/<id>.
+type WebhookReplay struct {
+	// MaxDeliveries is the number of deliveries kept, the oldest ones are dropped first.
+	// Default value: 100.
+	// +optional
+	MaxDeliveries int32 `json:"maxDeliveries,omitempty" protobuf:"varint,1,opt,name=maxDeliveries"`
+	// AuthSecret holds a secret selector that contains the bearer token required by the replay endpoints.
+	AuthSecret *corev1.SecretKeySelector `json:"authSecret" protobuf:"bytes,2,opt,name=authSecret"`
+	// Dir is the directory the deliveries are persisted in, so that they survive the restarts of the pod,
+	// e.g. a PersistentVolumeClaim mounted with the volumes of the EventSource template. They are kept
+	// in memory only if it is not set.
+	// +optional
+	Dir string `json:"dir,omitempty" protobuf:"bytes,3,opt,name=dir"`
+}
+
+// GetMaxDeliveries returns the number of deliveries kept
+func (r *WebhookReplay) GetMaxDeliveries() int {
+	if r == nil || r.MaxDeliveries <= 0 {
+		return DefaultWebhookReplayMaxDeliveries
+	}
+	return int(r.MaxDeliveries)
+}
+
+// PreviousAuthSecret returns the selector of the previous generated token of the endpoint, nil if the
+// token is not generated.
+func (wc *WebhookContext) PreviousAuthSecret() *corev1.SecretKeySelector {
+	if wc == nil || !wc.GenerateToken || wc.AuthSecret == nil {
+		return nil
+	}
+	previous := wc.AuthSecret.DeepCopy()
+	previous.Key += PreviousWebhookTokenKeySuffix
+	return previous
 }
 
 func (wc *WebhookContext) GetMaxPayloadSize() int64 {
@@ -24,6 +24,7 @@ package v1alpha1
 import (
 	common "github.com/argoproj/argo-events/pkg/apis/common"
 	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -98,6 +99,11 @@ func (in *AMQPEventSource) DeepCopyInto(out *AMQPEventSource) {
 		*out = new(EventSourceFilter)
 		**out = **in
 	}
+	if in.Transform != nil {
+		in, out := &in.Transform, &out.Transform
+		*out = new(EventSourceTransform)
+		**out = **in
+	}
 	return
 }
 
@@ -184,6 +190,11 @@ func (in *AzureEventsHubEventSource) DeepCopyInto(out *AzureEventsHubEventSource
 		*out = new(EventSourceFilter)
 		**out = **in
 	}
+	if in.Transform != nil {
+		in, out := &in.Transform, &out.Transform
+		*out = new(EventSourceTransform)
+		**out = **in
+	}
 	return
 }
 
@@ -217,11 +228,21 @@ func (in *AzureQueueStorageEventSource) DeepCopyInto(out *AzureQueueStorageEvent
 		*out = new(EventSourceFilter)
 		**out = **in
 	}
+	if in.Transform != nil {
+		in, out := &in.Transform, &out.Transform
+		*out = new(EventSourceTransform)
+		**out = **in
+	}
 	if in.WaitTimeInSeconds != nil {
 		in, out := &in.WaitTimeInSeconds, &out.WaitTimeInSeconds
 		*out = new(int32)
 		**out = **in
 	}
+	if in.VisibilityTimeoutInSeconds != nil {
+		in, out := &in.VisibilityTimeoutInSeconds, &out.VisibilityTimeoutInSeconds
+		*out = new(int32)
+		**out = **in
+	}
 	return
 }
 
@@ -260,6 +281,11 @@ func (in *AzureServiceBusEventSource) DeepCopyInto(out *AzureServiceBusEventSour
 		*out = new(EventSourceFilter)
 		**out = **in
 	}
+	if in.Transform != nil {
+		in, out := &in.Transform, &out.Transform
+		*out = new(EventSourceTransform)
+		**out = **in
+	}
 	return
 }
 
@@ -360,6 +386,11 @@ func (in *BitbucketEventSource) DeepCopyInto(out *BitbucketEventSource) {
 		*out = new(EventSourceFilter)
 		**out = **in
 	}
+	if in.Transform != nil {
+		in, out := &in.Transform, &out.Transform
+		*out = new(EventSourceTransform)
+		**out = **in
+	}
 	return
 }
 
@@ -434,6 +465,11 @@ func (in *BitbucketServerEventSource) DeepCopyInto(out *BitbucketServerEventSour
 		*out = new(EventSourceFilter)
 		**out = **in
 	}
+	if in.Transform != nil {
+		in, out := &in.Transform, &out.Transform
+		*out = new(EventSourceTransform)
+		**out = **in
+	}
 	if in.TLS != nil {
 		in, out := &in.TLS, &out.TLS
 		*out = new(common.TLSConfig)
@@ -493,6 +529,11 @@ func (in *CalendarEventSource) DeepCopyInto(out *CalendarEventSource) {
 		*out = new(EventSourceFilter)
 		**out = **in
 	}
+	if in.Transform != nil {
+		in, out := &in.Transform, &out.Transform
+		*out = new(EventSourceTransform)
+		**out = **in
+	}
 	return
 }
 
@@ -506,6 +547,29 @@ func (in *CalendarEventSource) DeepCopy() *CalendarEventSource {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CalendarStatus) DeepCopyInto(out *CalendarStatus) {
+	*out = *in
+	if in.NextFireTimes != nil {
+		in, out := &in.NextFireTimes, &out.NextFireTimes
+		*out = make([]metav1.Time, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CalendarStatus.
+func (in *CalendarStatus) DeepCopy() *CalendarStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CalendarStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CatchupConfiguration) DeepCopyInto(out *CatchupConfiguration) {
 	*out = *in
@@ -522,6 +586,89 @@ func (in *CatchupConfiguration) DeepCopy() *CatchupConfiguration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterEventSource) DeepCopyInto(out *ClusterEventSource) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterEventSource.
+func (in *ClusterEventSource) DeepCopy() *ClusterEventSource {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterEventSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterEventSource) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterEventSourceList) DeepCopyInto(out *ClusterEventSourceList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ClusterEventSource, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterEventSourceList.
+func (in *ClusterEventSourceList) DeepCopy() *ClusterEventSourceList {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterEventSourceList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterEventSourceList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterEventSourceSpec) DeepCopyInto(out *ClusterEventSourceSpec) {
+	*out = *in
+	if in.NamespaceSelector != nil {
+		in, out := &in.NamespaceSelector, &out.NamespaceSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	in.EventSource.DeepCopyInto(&out.EventSource)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterEventSourceSpec.
+func (in *ClusterEventSourceSpec) DeepCopy() *ClusterEventSourceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterEventSourceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ConfigMapPersistence) DeepCopyInto(out *ConfigMapPersistence) {
 	*out = *in
@@ -538,6 +685,133 @@ func (in *ConfigMapPersistence) DeepCopy() *ConfigMapPersistence {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DependencyProbe) DeepCopyInto(out *DependencyProbe) {
+	*out = *in
+	if in.TCP != nil {
+		in, out := &in.TCP, &out.TCP
+		*out = new(TCPDependencyProbe)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HTTP != nil {
+		in, out := &in.HTTP, &out.HTTP
+		*out = new(HTTPDependencyProbe)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.S3 != nil {
+		in, out := &in.S3, &out.S3
+		*out = new(common.S3Artifact)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DependencyProbe.
+func (in *DependencyProbe) DeepCopy() *DependencyProbe {
+	if in == nil {
+		return nil
+	}
+	out := new(DependencyProbe)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DynamoDBStreamsEventSource) DeepCopyInto(out *DynamoDBStreamsEventSource) {
+	*out = *in
+	if in.AccessKey != nil {
+		in, out := &in.AccessKey, &out.AccessKey
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SecretKey != nil {
+		in, out := &in.SecretKey, &out.SecretKey
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Persistence != nil {
+		in, out := &in.Persistence, &out.Persistence
+		*out = new(ConfigMapPersistence)
+		**out = **in
+	}
+	if in.Metadata != nil {
+		in, out := &in.Metadata, &out.Metadata
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Filter != nil {
+		in, out := &in.Filter, &out.Filter
+		*out = new(EventSourceFilter)
+		**out = **in
+	}
+	if in.Transform != nil {
+		in, out := &in.Transform, &out.Transform
+		*out = new(EventSourceTransform)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DynamoDBStreamsEventSource.
+func (in *DynamoDBStreamsEventSource) DeepCopy() *DynamoDBStreamsEventSource {
+	if in == nil {
+		return nil
+	}
+	out := new(DynamoDBStreamsEventSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ElasticsearchEventSource) DeepCopyInto(out *ElasticsearchEventSource) {
+	*out = *in
+	if in.BasicAuth != nil {
+		in, out := &in.BasicAuth, &out.BasicAuth
+		*out = new(common.BasicAuth)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.APIKey != nil {
+		in, out := &in.APIKey, &out.APIKey
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(common.TLSConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Metadata != nil {
+		in, out := &in.Metadata, &out.Metadata
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Filter != nil {
+		in, out := &in.Filter, &out.Filter
+		*out = new(EventSourceFilter)
+		**out = **in
+	}
+	if in.Transform != nil {
+		in, out := &in.Transform, &out.Transform
+		*out = new(EventSourceTransform)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ElasticsearchEventSource.
+func (in *ElasticsearchEventSource) DeepCopy() *ElasticsearchEventSource {
+	if in == nil {
+		return nil
+	}
+	out := new(ElasticsearchEventSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EmitterEventSource) DeepCopyInto(out *EmitterEventSource) {
 	*out = *in
@@ -573,6 +847,11 @@ func (in *EmitterEventSource) DeepCopyInto(out *EmitterEventSource) {
 		*out = new(EventSourceFilter)
 		**out = **in
 	}
+	if in.Transform != nil {
+		in, out := &in.Transform, &out.Transform
+		*out = new(EventSourceTransform)
+		**out = **in
+	}
 	return
 }
 
@@ -586,6 +865,22 @@ func (in *EmitterEventSource) DeepCopy() *EmitterEventSource {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EventCatalogEntry) DeepCopyInto(out *EventCatalogEntry) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EventCatalogEntry.
+func (in *EventCatalogEntry) DeepCopy() *EventCatalogEntry {
+	if in == nil {
+		return nil
+	}
+	out := new(EventCatalogEntry)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EventPersistence) DeepCopyInto(out *EventPersistence) {
 	*out = *in
@@ -612,6 +907,27 @@ func (in *EventPersistence) DeepCopy() *EventPersistence {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EventSizeLimit) DeepCopyInto(out *EventSizeLimit) {
+	*out = *in
+	if in.ClaimCheck != nil {
+		in, out := &in.ClaimCheck, &out.ClaimCheck
+		*out = new(common.S3Artifact)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EventSizeLimit.
+func (in *EventSizeLimit) DeepCopy() *EventSizeLimit {
+	if in == nil {
+		return nil
+	}
+	out := new(EventSizeLimit)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EventSource) DeepCopyInto(out *EventSource) {
 	*out = *in
@@ -656,6 +972,27 @@ func (in *EventSourceFilter) DeepCopy() *EventSourceFilter {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EventSourceInclude) DeepCopyInto(out *EventSourceInclude) {
+	*out = *in
+	if in.ConfigMap != nil {
+		in, out := &in.ConfigMap, &out.ConfigMap
+		*out = new(v1.ConfigMapKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EventSourceInclude.
+func (in *EventSourceInclude) DeepCopy() *EventSourceInclude {
+	if in == nil {
+		return nil
+	}
+	out := new(EventSourceInclude)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EventSourceList) DeepCopyInto(out *EventSourceList) {
 	*out = *in
@@ -924,6 +1261,77 @@ func (in *EventSourceSpec) DeepCopyInto(out *EventSourceSpec) {
 			(*out)[key] = *val.DeepCopy()
 		}
 	}
+	if in.RemoteEventBus != nil {
+		in, out := &in.RemoteEventBus, &out.RemoteEventBus
+		*out = new(common.RemoteEventBus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Includes != nil {
+		in, out := &in.Includes, &out.Includes
+		*out = make([]EventSourceInclude, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.MaxEventSize != nil {
+		in, out := &in.MaxEventSize, &out.MaxEventSize
+		*out = new(EventSizeLimit)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Metrics != nil {
+		in, out := &in.Metrics, &out.Metrics
+		*out = new(common.MetricsConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Elasticsearch != nil {
+		in, out := &in.Elasticsearch, &out.Elasticsearch
+		*out = make(map[string]ElasticsearchEventSource, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+	if in.Jenkins != nil {
+		in, out := &in.Jenkins, &out.Jenkins
+		*out = make(map[string]JenkinsEventSource, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+	if in.SQL != nil {
+		in, out := &in.SQL, &out.SQL
+		*out = make(map[string]SQLEventSource, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+	if in.DynamoDBStreams != nil {
+		in, out := &in.DynamoDBStreams, &out.DynamoDBStreams
+		*out = make(map[string]DynamoDBStreamsEventSource, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+	if in.DependencyProbes != nil {
+		in, out := &in.DependencyProbes, &out.DependencyProbes
+		*out = make([]DependencyProbe, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Catalog != nil {
+		in, out := &in.Catalog, &out.Catalog
+		*out = make(map[string]EventCatalogEntry, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.GRPCStream != nil {
+		in, out := &in.GRPCStream, &out.GRPCStream
+		*out = make(map[string]GRPCStreamEventSource, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
 	return
 }
 
@@ -941,6 +1349,27 @@ func (in *EventSourceSpec) DeepCopy() *EventSourceSpec {
 func (in *EventSourceStatus) DeepCopyInto(out *EventSourceStatus) {
 	*out = *in
 	in.Status.DeepCopyInto(&out.Status)
+	if in.Calendars != nil {
+		in, out := &in.Calendars, &out.Calendars
+		*out = make([]CalendarStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.WebhookTokenRotations != nil {
+		in, out := &in.WebhookTokenRotations, &out.WebhookTokenRotations
+		*out = make([]WebhookTokenRotation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Retries != nil {
+		in, out := &in.Retries, &out.Retries
+		*out = make([]common.RetryStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -954,6 +1383,22 @@ func (in *EventSourceStatus) DeepCopy() *EventSourceStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EventSourceTransform) DeepCopyInto(out *EventSourceTransform) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EventSourceTransform.
+func (in *EventSourceTransform) DeepCopy() *EventSourceTransform {
+	if in == nil {
+		return nil
+	}
+	out := new(EventSourceTransform)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *FileEventSource) DeepCopyInto(out *FileEventSource) {
 	*out = *in
@@ -970,6 +1415,11 @@ func (in *FileEventSource) DeepCopyInto(out *FileEventSource) {
 		*out = new(EventSourceFilter)
 		**out = **in
 	}
+	if in.Transform != nil {
+		in, out := &in.Transform, &out.Transform
+		*out = new(EventSourceTransform)
+		**out = **in
+	}
 	return
 }
 
@@ -983,6 +1433,54 @@ func (in *FileEventSource) DeepCopy() *FileEventSource {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GRPCStreamEventSource) DeepCopyInto(out *GRPCStreamEventSource) {
+	*out = *in
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(common.TLSConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AuthSecret != nil {
+		in, out := &in.AuthSecret, &out.AuthSecret
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ConnectionBackoff != nil {
+		in, out := &in.ConnectionBackoff, &out.ConnectionBackoff
+		*out = new(common.Backoff)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Metadata != nil {
+		in, out := &in.Metadata, &out.Metadata
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Filter != nil {
+		in, out := &in.Filter, &out.Filter
+		*out = new(EventSourceFilter)
+		**out = **in
+	}
+	if in.Transform != nil {
+		in, out := &in.Transform, &out.Transform
+		*out = new(EventSourceTransform)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GRPCStreamEventSource.
+func (in *GRPCStreamEventSource) DeepCopy() *GRPCStreamEventSource {
+	if in == nil {
+		return nil
+	}
+	out := new(GRPCStreamEventSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GenericEventSource) DeepCopyInto(out *GenericEventSource) {
 	*out = *in
@@ -1003,6 +1501,11 @@ func (in *GenericEventSource) DeepCopyInto(out *GenericEventSource) {
 		*out = new(EventSourceFilter)
 		**out = **in
 	}
+	if in.Transform != nil {
+		in, out := &in.Transform, &out.Transform
+		*out = new(EventSourceTransform)
+		**out = **in
+	}
 	return
 }
 
@@ -1051,6 +1554,11 @@ func (in *GerritEventSource) DeepCopyInto(out *GerritEventSource) {
 		*out = new(EventSourceFilter)
 		**out = **in
 	}
+	if in.Transform != nil {
+		in, out := &in.Transform, &out.Transform
+		*out = new(EventSourceTransform)
+		**out = **in
+	}
 	return
 }
 
@@ -1137,6 +1645,16 @@ func (in *GithubEventSource) DeepCopyInto(out *GithubEventSource) {
 		*out = new(EventSourceFilter)
 		**out = **in
 	}
+	if in.Transform != nil {
+		in, out := &in.Transform, &out.Transform
+		*out = new(EventSourceTransform)
+		**out = **in
+	}
+	if in.RegistrationPersistence != nil {
+		in, out := &in.RegistrationPersistence, &out.RegistrationPersistence
+		*out = new(ConfigMapPersistence)
+		**out = **in
+	}
 	return
 }
 
@@ -1175,62 +1693,158 @@ func (in *GitlabEventSource) DeepCopyInto(out *GitlabEventSource) {
 			(*out)[key] = val
 		}
 	}
-	if in.Projects != nil {
-		in, out := &in.Projects, &out.Projects
-		*out = make([]string, len(*in))
+	if in.Projects != nil {
+		in, out := &in.Projects, &out.Projects
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SecretToken != nil {
+		in, out := &in.SecretToken, &out.SecretToken
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Filter != nil {
+		in, out := &in.Filter, &out.Filter
+		*out = new(EventSourceFilter)
+		**out = **in
+	}
+	if in.Transform != nil {
+		in, out := &in.Transform, &out.Transform
+		*out = new(EventSourceTransform)
+		**out = **in
+	}
+	if in.Groups != nil {
+		in, out := &in.Groups, &out.Groups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.RegistrationPersistence != nil {
+		in, out := &in.RegistrationPersistence, &out.RegistrationPersistence
+		*out = new(ConfigMapPersistence)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitlabEventSource.
+func (in *GitlabEventSource) DeepCopy() *GitlabEventSource {
+	if in == nil {
+		return nil
+	}
+	out := new(GitlabEventSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HDFSEventSource) DeepCopyInto(out *HDFSEventSource) {
+	*out = *in
+	out.WatchPathConfig = in.WatchPathConfig
+	if in.Addresses != nil {
+		in, out := &in.Addresses, &out.Addresses
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.KrbCCacheSecret != nil {
+		in, out := &in.KrbCCacheSecret, &out.KrbCCacheSecret
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.KrbKeytabSecret != nil {
+		in, out := &in.KrbKeytabSecret, &out.KrbKeytabSecret
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.KrbConfigConfigMap != nil {
+		in, out := &in.KrbConfigConfigMap, &out.KrbConfigConfigMap
+		*out = new(v1.ConfigMapKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Metadata != nil {
+		in, out := &in.Metadata, &out.Metadata
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Filter != nil {
+		in, out := &in.Filter, &out.Filter
+		*out = new(EventSourceFilter)
+		**out = **in
+	}
+	if in.Transform != nil {
+		in, out := &in.Transform, &out.Transform
+		*out = new(EventSourceTransform)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HDFSEventSource.
+func (in *HDFSEventSource) DeepCopy() *HDFSEventSource {
+	if in == nil {
+		return nil
+	}
+	out := new(HDFSEventSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPDependencyProbe) DeepCopyInto(out *HTTPDependencyProbe) {
+	*out = *in
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.StatusCodes != nil {
+		in, out := &in.StatusCodes, &out.StatusCodes
+		*out = make([]int32, len(*in))
 		copy(*out, *in)
 	}
-	if in.SecretToken != nil {
-		in, out := &in.SecretToken, &out.SecretToken
-		*out = new(v1.SecretKeySelector)
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(common.TLSConfig)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.Filter != nil {
-		in, out := &in.Filter, &out.Filter
-		*out = new(EventSourceFilter)
-		**out = **in
-	}
-	if in.Groups != nil {
-		in, out := &in.Groups, &out.Groups
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitlabEventSource.
-func (in *GitlabEventSource) DeepCopy() *GitlabEventSource {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPDependencyProbe.
+func (in *HTTPDependencyProbe) DeepCopy() *HTTPDependencyProbe {
 	if in == nil {
 		return nil
 	}
-	out := new(GitlabEventSource)
+	out := new(HTTPDependencyProbe)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *HDFSEventSource) DeepCopyInto(out *HDFSEventSource) {
+func (in *JenkinsEventSource) DeepCopyInto(out *JenkinsEventSource) {
 	*out = *in
-	out.WatchPathConfig = in.WatchPathConfig
-	if in.Addresses != nil {
-		in, out := &in.Addresses, &out.Addresses
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.KrbCCacheSecret != nil {
-		in, out := &in.KrbCCacheSecret, &out.KrbCCacheSecret
-		*out = new(v1.SecretKeySelector)
+	if in.Webhook != nil {
+		in, out := &in.Webhook, &out.Webhook
+		*out = new(WebhookContext)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.KrbKeytabSecret != nil {
-		in, out := &in.KrbKeytabSecret, &out.KrbKeytabSecret
+	if in.Token != nil {
+		in, out := &in.Token, &out.Token
 		*out = new(v1.SecretKeySelector)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.KrbConfigConfigMap != nil {
-		in, out := &in.KrbConfigConfigMap, &out.KrbConfigConfigMap
-		*out = new(v1.ConfigMapKeySelector)
-		(*in).DeepCopyInto(*out)
+	if in.Jobs != nil {
+		in, out := &in.Jobs, &out.Jobs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Phases != nil {
+		in, out := &in.Phases, &out.Phases
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
 	if in.Metadata != nil {
 		in, out := &in.Metadata, &out.Metadata
@@ -1244,15 +1858,20 @@ func (in *HDFSEventSource) DeepCopyInto(out *HDFSEventSource) {
 		*out = new(EventSourceFilter)
 		**out = **in
 	}
+	if in.Transform != nil {
+		in, out := &in.Transform, &out.Transform
+		*out = new(EventSourceTransform)
+		**out = **in
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HDFSEventSource.
-func (in *HDFSEventSource) DeepCopy() *HDFSEventSource {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JenkinsEventSource.
+func (in *JenkinsEventSource) DeepCopy() *JenkinsEventSource {
 	if in == nil {
 		return nil
 	}
-	out := new(HDFSEventSource)
+	out := new(JenkinsEventSource)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -1308,6 +1927,25 @@ func (in *KafkaEventSource) DeepCopyInto(out *KafkaEventSource) {
 		*out = new(EventSourceFilter)
 		**out = **in
 	}
+	if in.Transform != nil {
+		in, out := &in.Transform, &out.Transform
+		*out = new(EventSourceTransform)
+		**out = **in
+	}
+	if in.HeaderFilters != nil {
+		in, out := &in.HeaderFilters, &out.HeaderFilters
+		*out = make([]KafkaHeaderFilter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.HeaderExtensions != nil {
+		in, out := &in.HeaderExtensions, &out.HeaderExtensions
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 	return
 }
 
@@ -1321,6 +1959,27 @@ func (in *KafkaEventSource) DeepCopy() *KafkaEventSource {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaHeaderFilter) DeepCopyInto(out *KafkaHeaderFilter) {
+	*out = *in
+	if in.Values != nil {
+		in, out := &in.Values, &out.Values
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaHeaderFilter.
+func (in *KafkaHeaderFilter) DeepCopy() *KafkaHeaderFilter {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaHeaderFilter)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MQTTEventSource) DeepCopyInto(out *MQTTEventSource) {
 	*out = *in
@@ -1346,11 +2005,21 @@ func (in *MQTTEventSource) DeepCopyInto(out *MQTTEventSource) {
 		*out = new(EventSourceFilter)
 		**out = **in
 	}
+	if in.Transform != nil {
+		in, out := &in.Transform, &out.Transform
+		*out = new(EventSourceTransform)
+		**out = **in
+	}
 	if in.Auth != nil {
 		in, out := &in.Auth, &out.Auth
 		*out = new(common.BasicAuth)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.SharedSubscription != nil {
+		in, out := &in.SharedSubscription, &out.SharedSubscription
+		*out = new(MQTTSharedSubscription)
+		**out = **in
+	}
 	return
 }
 
@@ -1364,6 +2033,22 @@ func (in *MQTTEventSource) DeepCopy() *MQTTEventSource {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MQTTSharedSubscription) DeepCopyInto(out *MQTTSharedSubscription) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MQTTSharedSubscription.
+func (in *MQTTSharedSubscription) DeepCopy() *MQTTSharedSubscription {
+	if in == nil {
+		return nil
+	}
+	out := new(MQTTSharedSubscription)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NATSAuth) DeepCopyInto(out *NATSAuth) {
 	*out = *in
@@ -1430,6 +2115,11 @@ func (in *NATSEventsSource) DeepCopyInto(out *NATSEventsSource) {
 		*out = new(EventSourceFilter)
 		**out = **in
 	}
+	if in.Transform != nil {
+		in, out := &in.Transform, &out.Transform
+		*out = new(EventSourceTransform)
+		**out = **in
+	}
 	if in.Queue != nil {
 		in, out := &in.Queue, &out.Queue
 		*out = new(string)
@@ -1473,6 +2163,11 @@ func (in *NSQEventSource) DeepCopyInto(out *NSQEventSource) {
 		*out = new(EventSourceFilter)
 		**out = **in
 	}
+	if in.Transform != nil {
+		in, out := &in.Transform, &out.Transform
+		*out = new(EventSourceTransform)
+		**out = **in
+	}
 	return
 }
 
@@ -1527,6 +2222,11 @@ func (in *PubSubEventSource) DeepCopyInto(out *PubSubEventSource) {
 		*out = new(EventSourceFilter)
 		**out = **in
 	}
+	if in.Transform != nil {
+		in, out := &in.Transform, &out.Transform
+		*out = new(EventSourceTransform)
+		**out = **in
+	}
 	return
 }
 
@@ -1580,6 +2280,11 @@ func (in *PulsarEventSource) DeepCopyInto(out *PulsarEventSource) {
 		*out = new(EventSourceFilter)
 		**out = **in
 	}
+	if in.Transform != nil {
+		in, out := &in.Transform, &out.Transform
+		*out = new(EventSourceTransform)
+		**out = **in
+	}
 	if in.AuthAthenzParams != nil {
 		in, out := &in.AuthAthenzParams, &out.AuthAthenzParams
 		*out = make(map[string]string, len(*in))
@@ -1635,6 +2340,11 @@ func (in *RedisEventSource) DeepCopyInto(out *RedisEventSource) {
 		*out = new(EventSourceFilter)
 		**out = **in
 	}
+	if in.Transform != nil {
+		in, out := &in.Transform, &out.Transform
+		*out = new(EventSourceTransform)
+		**out = **in
+	}
 	return
 }
 
@@ -1678,6 +2388,11 @@ func (in *RedisStreamEventSource) DeepCopyInto(out *RedisStreamEventSource) {
 		*out = new(EventSourceFilter)
 		**out = **in
 	}
+	if in.Transform != nil {
+		in, out := &in.Transform, &out.Transform
+		*out = new(EventSourceTransform)
+		**out = **in
+	}
 	return
 }
 
@@ -1788,6 +2503,11 @@ func (in *SFTPEventSource) DeepCopyInto(out *SFTPEventSource) {
 		*out = new(EventSourceFilter)
 		**out = **in
 	}
+	if in.Transform != nil {
+		in, out := &in.Transform, &out.Transform
+		*out = new(EventSourceTransform)
+		**out = **in
+	}
 	return
 }
 
@@ -1831,6 +2551,11 @@ func (in *SNSEventSource) DeepCopyInto(out *SNSEventSource) {
 		*out = new(EventSourceFilter)
 		**out = **in
 	}
+	if in.Transform != nil {
+		in, out := &in.Transform, &out.Transform
+		*out = new(EventSourceTransform)
+		**out = **in
+	}
 	return
 }
 
@@ -1844,6 +2569,49 @@ func (in *SNSEventSource) DeepCopy() *SNSEventSource {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SQLEventSource) DeepCopyInto(out *SQLEventSource) {
+	*out = *in
+	if in.DSN != nil {
+		in, out := &in.DSN, &out.DSN
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Persistence != nil {
+		in, out := &in.Persistence, &out.Persistence
+		*out = new(ConfigMapPersistence)
+		**out = **in
+	}
+	if in.Metadata != nil {
+		in, out := &in.Metadata, &out.Metadata
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Filter != nil {
+		in, out := &in.Filter, &out.Filter
+		*out = new(EventSourceFilter)
+		**out = **in
+	}
+	if in.Transform != nil {
+		in, out := &in.Transform, &out.Transform
+		*out = new(EventSourceTransform)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SQLEventSource.
+func (in *SQLEventSource) DeepCopy() *SQLEventSource {
+	if in == nil {
+		return nil
+	}
+	out := new(SQLEventSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SQSEventSource) DeepCopyInto(out *SQSEventSource) {
 	*out = *in
@@ -1869,6 +2637,11 @@ func (in *SQSEventSource) DeepCopyInto(out *SQSEventSource) {
 		*out = new(EventSourceFilter)
 		**out = **in
 	}
+	if in.Transform != nil {
+		in, out := &in.Transform, &out.Transform
+		*out = new(EventSourceTransform)
+		**out = **in
+	}
 	if in.SessionToken != nil {
 		in, out := &in.SessionToken, &out.SessionToken
 		*out = new(v1.SecretKeySelector)
@@ -1956,6 +2729,11 @@ func (in *SlackEventSource) DeepCopyInto(out *SlackEventSource) {
 		*out = new(EventSourceFilter)
 		**out = **in
 	}
+	if in.Transform != nil {
+		in, out := &in.Transform, &out.Transform
+		*out = new(EventSourceTransform)
+		**out = **in
+	}
 	return
 }
 
@@ -2066,6 +2844,27 @@ func (in *StripeEventSource) DeepCopy() *StripeEventSource {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TCPDependencyProbe) DeepCopyInto(out *TCPDependencyProbe) {
+	*out = *in
+	if in.Addresses != nil {
+		in, out := &in.Addresses, &out.Addresses
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TCPDependencyProbe.
+func (in *TCPDependencyProbe) DeepCopy() *TCPDependencyProbe {
+	if in == nil {
+		return nil
+	}
+	out := new(TCPDependencyProbe)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Template) DeepCopyInto(out *Template) {
 	*out = *in
@@ -2120,6 +2919,11 @@ func (in *Template) DeepCopyInto(out *Template) {
 		*out = new(int32)
 		**out = **in
 	}
+	if in.RuntimeClassName != nil {
+		in, out := &in.RuntimeClassName, &out.RuntimeClassName
+		*out = new(string)
+		**out = **in
+	}
 	return
 }
 
@@ -2179,6 +2983,21 @@ func (in *WebhookContext) DeepCopyInto(out *WebhookContext) {
 		*out = new(int64)
 		**out = **in
 	}
+	if in.Replay != nil {
+		in, out := &in.Replay, &out.Replay
+		*out = new(WebhookReplay)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Enrichment != nil {
+		in, out := &in.Enrichment, &out.Enrichment
+		*out = new(WebhookEnrichment)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Redelivery != nil {
+		in, out := &in.Redelivery, &out.Redelivery
+		*out = new(WebhookRedelivery)
+		**out = **in
+	}
 	return
 }
 
@@ -2192,6 +3011,34 @@ func (in *WebhookContext) DeepCopy() *WebhookContext {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WebhookEnrichment) DeepCopyInto(out *WebhookEnrichment) {
+	*out = *in
+	if in.TrustedProxies != nil {
+		in, out := &in.TrustedProxies, &out.TrustedProxies
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Networks != nil {
+		in, out := &in.Networks, &out.Networks
+		*out = make([]WebhookNetwork, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WebhookEnrichment.
+func (in *WebhookEnrichment) DeepCopy() *WebhookEnrichment {
+	if in == nil {
+		return nil
+	}
+	out := new(WebhookEnrichment)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WebhookEventSource) DeepCopyInto(out *WebhookEventSource) {
 	*out = *in
@@ -2201,6 +3048,11 @@ func (in *WebhookEventSource) DeepCopyInto(out *WebhookEventSource) {
 		*out = new(EventSourceFilter)
 		**out = **in
 	}
+	if in.Transform != nil {
+		in, out := &in.Transform, &out.Transform
+		*out = new(EventSourceTransform)
+		**out = **in
+	}
 	return
 }
 
@@ -2213,3 +3065,78 @@ func (in *WebhookEventSource) DeepCopy() *WebhookEventSource {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WebhookNetwork) DeepCopyInto(out *WebhookNetwork) {
+	*out = *in
+	if in.CIDRs != nil {
+		in, out := &in.CIDRs, &out.CIDRs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WebhookNetwork.
+func (in *WebhookNetwork) DeepCopy() *WebhookNetwork {
+	if in == nil {
+		return nil
+	}
+	out := new(WebhookNetwork)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WebhookRedelivery) DeepCopyInto(out *WebhookRedelivery) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WebhookRedelivery.
+func (in *WebhookRedelivery) DeepCopy() *WebhookRedelivery {
+	if in == nil {
+		return nil
+	}
+	out := new(WebhookRedelivery)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WebhookReplay) DeepCopyInto(out *WebhookReplay) {
+	*out = *in
+	if in.AuthSecret != nil {
+		in, out := &in.AuthSecret, &out.AuthSecret
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WebhookReplay.
+func (in *WebhookReplay) DeepCopy() *WebhookReplay {
+	if in == nil {
+		return nil
+	}
+	out := new(WebhookReplay)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WebhookTokenRotation) DeepCopyInto(out *WebhookTokenRotation) {
+	*out = *in
+	in.NextRotationTime.DeepCopyInto(&out.NextRotationTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WebhookTokenRotation.
+func (in *WebhookTokenRotation) DeepCopy() *WebhookTokenRotation {
+	if in == nil {
+		return nil
+	}
+	out := new(WebhookTokenRotation)
+	in.DeepCopyInto(out)
+	return out
+}
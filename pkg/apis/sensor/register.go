@@ -25,4 +25,9 @@ const (
 	Singular string = "sensor"
 	Plural   string = "sensors"
 	FullName string = Plural + "." + Group
+
+	// ClusterSensor constants
+	ClusterKind     string = "ClusterSensor"
+	ClusterSingular string = "clustersensor"
+	ClusterPlural   string = "clustersensors"
 )
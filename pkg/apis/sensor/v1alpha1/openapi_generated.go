@@ -31,36 +31,65 @@ import (
 func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenAPIDefinition {
 	return map[string]common.OpenAPIDefinition{
 		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.AWSLambdaTrigger":           schema_pkg_apis_sensor_v1alpha1_AWSLambdaTrigger(ref),
+		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.AWSSNSTrigger":              schema_pkg_apis_sensor_v1alpha1_AWSSNSTrigger(ref),
+		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.AWSSQSTrigger":              schema_pkg_apis_sensor_v1alpha1_AWSSQSTrigger(ref),
+		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.ArgoWorkflowArtifact":       schema_pkg_apis_sensor_v1alpha1_ArgoWorkflowArtifact(ref),
+		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.ArgoWorkflowS3Artifact":     schema_pkg_apis_sensor_v1alpha1_ArgoWorkflowS3Artifact(ref),
 		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.ArgoWorkflowTrigger":        schema_pkg_apis_sensor_v1alpha1_ArgoWorkflowTrigger(ref),
 		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.ArtifactLocation":           schema_pkg_apis_sensor_v1alpha1_ArtifactLocation(ref),
 		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.AzureEventHubsTrigger":      schema_pkg_apis_sensor_v1alpha1_AzureEventHubsTrigger(ref),
+		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.AzureQueueStorageTrigger":   schema_pkg_apis_sensor_v1alpha1_AzureQueueStorageTrigger(ref),
 		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.AzureServiceBusTrigger":     schema_pkg_apis_sensor_v1alpha1_AzureServiceBusTrigger(ref),
+		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.CanaryRollout":              schema_pkg_apis_sensor_v1alpha1_CanaryRollout(ref),
+		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.CanaryStatus":               schema_pkg_apis_sensor_v1alpha1_CanaryStatus(ref),
+		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.ClusterSensor":              schema_pkg_apis_sensor_v1alpha1_ClusterSensor(ref),
+		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.ClusterSensorList":          schema_pkg_apis_sensor_v1alpha1_ClusterSensorList(ref),
+		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.ClusterSensorSpec":          schema_pkg_apis_sensor_v1alpha1_ClusterSensorSpec(ref),
 		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.ConditionsResetByTime":      schema_pkg_apis_sensor_v1alpha1_ConditionsResetByTime(ref),
 		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.ConditionsResetCriteria":    schema_pkg_apis_sensor_v1alpha1_ConditionsResetCriteria(ref),
 		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.CustomTrigger":              schema_pkg_apis_sensor_v1alpha1_CustomTrigger(ref),
 		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.DataFilter":                 schema_pkg_apis_sensor_v1alpha1_DataFilter(ref),
+		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.DataSchemaValidation":       schema_pkg_apis_sensor_v1alpha1_DataSchemaValidation(ref),
+		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.DependencyStartPosition":    schema_pkg_apis_sensor_v1alpha1_DependencyStartPosition(ref),
+		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.ElasticsearchTrigger":       schema_pkg_apis_sensor_v1alpha1_ElasticsearchTrigger(ref),
 		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.EmailTrigger":               schema_pkg_apis_sensor_v1alpha1_EmailTrigger(ref),
 		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.Event":                      schema_pkg_apis_sensor_v1alpha1_Event(ref),
+		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.EventCapture":               schema_pkg_apis_sensor_v1alpha1_EventCapture(ref),
 		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.EventContext":               schema_pkg_apis_sensor_v1alpha1_EventContext(ref),
 		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.EventDependency":            schema_pkg_apis_sensor_v1alpha1_EventDependency(ref),
 		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.EventDependencyFilter":      schema_pkg_apis_sensor_v1alpha1_EventDependencyFilter(ref),
 		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.EventDependencyTransformer": schema_pkg_apis_sensor_v1alpha1_EventDependencyTransformer(ref),
 		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.ExprFilter":                 schema_pkg_apis_sensor_v1alpha1_ExprFilter(ref),
+		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.FailingTriggerStatus":       schema_pkg_apis_sensor_v1alpha1_FailingTriggerStatus(ref),
 		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.FileArtifact":               schema_pkg_apis_sensor_v1alpha1_FileArtifact(ref),
 		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.GitArtifact":                schema_pkg_apis_sensor_v1alpha1_GitArtifact(ref),
 		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.GitCreds":                   schema_pkg_apis_sensor_v1alpha1_GitCreds(ref),
 		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.GitRemoteConfig":            schema_pkg_apis_sensor_v1alpha1_GitRemoteConfig(ref),
+		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.GithubAppCreds":             schema_pkg_apis_sensor_v1alpha1_GithubAppCreds(ref),
+		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.GithubWorkflowTrigger":      schema_pkg_apis_sensor_v1alpha1_GithubWorkflowTrigger(ref),
+		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.HTTPPayloadWrapper":         schema_pkg_apis_sensor_v1alpha1_HTTPPayloadWrapper(ref),
 		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.HTTPTrigger":                schema_pkg_apis_sensor_v1alpha1_HTTPTrigger(ref),
+		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.JenkinsTrigger":             schema_pkg_apis_sensor_v1alpha1_JenkinsTrigger(ref),
 		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.K8SResourcePolicy":          schema_pkg_apis_sensor_v1alpha1_K8SResourcePolicy(ref),
 		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.KafkaTrigger":               schema_pkg_apis_sensor_v1alpha1_KafkaTrigger(ref),
 		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.LogTrigger":                 schema_pkg_apis_sensor_v1alpha1_LogTrigger(ref),
+		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.LokiTrigger":                schema_pkg_apis_sensor_v1alpha1_LokiTrigger(ref),
 		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.NATSTrigger":                schema_pkg_apis_sensor_v1alpha1_NATSTrigger(ref),
 		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.OpenWhiskTrigger":           schema_pkg_apis_sensor_v1alpha1_OpenWhiskTrigger(ref),
 		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.PayloadField":               schema_pkg_apis_sensor_v1alpha1_PayloadField(ref),
+		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.PayloadGuards":              schema_pkg_apis_sensor_v1alpha1_PayloadGuards(ref),
+		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.PrometheusDependency":       schema_pkg_apis_sensor_v1alpha1_PrometheusDependency(ref),
+		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.PrometheusMetric":           schema_pkg_apis_sensor_v1alpha1_PrometheusMetric(ref),
+		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.PrometheusPushgateway":      schema_pkg_apis_sensor_v1alpha1_PrometheusPushgateway(ref),
+		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.PrometheusRemoteWrite":      schema_pkg_apis_sensor_v1alpha1_PrometheusRemoteWrite(ref),
+		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.PrometheusTrigger":          schema_pkg_apis_sensor_v1alpha1_PrometheusTrigger(ref),
 		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.PulsarTrigger":              schema_pkg_apis_sensor_v1alpha1_PulsarTrigger(ref),
 		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.RateLimit":                  schema_pkg_apis_sensor_v1alpha1_RateLimit(ref),
 		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.Sensor":                     schema_pkg_apis_sensor_v1alpha1_Sensor(ref),
+		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.SensorDistribution":         schema_pkg_apis_sensor_v1alpha1_SensorDistribution(ref),
+		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.SensorExecutionQuota":       schema_pkg_apis_sensor_v1alpha1_SensorExecutionQuota(ref),
 		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.SensorList":                 schema_pkg_apis_sensor_v1alpha1_SensorList(ref),
+		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.SensorRollout":              schema_pkg_apis_sensor_v1alpha1_SensorRollout(ref),
 		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.SensorSpec":                 schema_pkg_apis_sensor_v1alpha1_SensorSpec(ref),
 		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.SensorStatus":               schema_pkg_apis_sensor_v1alpha1_SensorStatus(ref),
 		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.SlackSender":                schema_pkg_apis_sensor_v1alpha1_SlackSender(ref),
@@ -70,11 +99,24 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.StatusPolicy":               schema_pkg_apis_sensor_v1alpha1_StatusPolicy(ref),
 		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.Template":                   schema_pkg_apis_sensor_v1alpha1_Template(ref),
 		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TimeFilter":                 schema_pkg_apis_sensor_v1alpha1_TimeFilter(ref),
+		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TracingMetadata":            schema_pkg_apis_sensor_v1alpha1_TracingMetadata(ref),
 		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.Trigger":                    schema_pkg_apis_sensor_v1alpha1_Trigger(ref),
+		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerActiveWindow":        schema_pkg_apis_sensor_v1alpha1_TriggerActiveWindow(ref),
+		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerActiveWindows":       schema_pkg_apis_sensor_v1alpha1_TriggerActiveWindows(ref),
+		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerBatch":               schema_pkg_apis_sensor_v1alpha1_TriggerBatch(ref),
+		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerCache":               schema_pkg_apis_sensor_v1alpha1_TriggerCache(ref),
+		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerCircuitBreaker":      schema_pkg_apis_sensor_v1alpha1_TriggerCircuitBreaker(ref),
+		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerDeduplication":       schema_pkg_apis_sensor_v1alpha1_TriggerDeduplication(ref),
+		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerFeatureFlag":         schema_pkg_apis_sensor_v1alpha1_TriggerFeatureFlag(ref),
+		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerOversizeRoute":       schema_pkg_apis_sensor_v1alpha1_TriggerOversizeRoute(ref),
 		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerParameter":           schema_pkg_apis_sensor_v1alpha1_TriggerParameter(ref),
 		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerParameterSource":     schema_pkg_apis_sensor_v1alpha1_TriggerParameterSource(ref),
 		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerPolicy":              schema_pkg_apis_sensor_v1alpha1_TriggerPolicy(ref),
+		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerPool":                schema_pkg_apis_sensor_v1alpha1_TriggerPool(ref),
+		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerPools":               schema_pkg_apis_sensor_v1alpha1_TriggerPools(ref),
+		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerStatusReporting":     schema_pkg_apis_sensor_v1alpha1_TriggerStatusReporting(ref),
 		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerTemplate":            schema_pkg_apis_sensor_v1alpha1_TriggerTemplate(ref),
+		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggersStatus":             schema_pkg_apis_sensor_v1alpha1_TriggersStatus(ref),
 		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.URLArtifact":                schema_pkg_apis_sensor_v1alpha1_URLArtifact(ref),
 	}
 }
@@ -165,29 +207,58 @@ func schema_pkg_apis_sensor_v1alpha1_AWSLambdaTrigger(ref common.ReferenceCallba
 	}
 }
 
-func schema_pkg_apis_sensor_v1alpha1_ArgoWorkflowTrigger(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_sensor_v1alpha1_AWSSNSTrigger(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ArgoWorkflowTrigger is the trigger for the Argo Workflow",
+				Description: "AWSSNSTrigger refers to specification of the trigger to publish messages to an AWS SNS topic",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"source": {
+					"topicArn": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Source of the K8s resource file(s)",
-							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.ArtifactLocation"),
+							Description: "TopicArn is the ARN of the topic to publish the messages to.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"operation": {
+					"region": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Operation refers to the type of operation performed on the argo workflow resource. Default value is Submit.",
+							Description: "Region is AWS region",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"parameters": {
+					"accessKey": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Parameters is the list of parameters to pass to resolved Argo Workflow object",
+							Description: "AccessKey refers K8s secret containing aws access key",
+							Ref:         ref("k8s.io/api/core/v1.SecretKeySelector"),
+						},
+					},
+					"secretKey": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SecretKey refers K8s secret containing aws secret key",
+							Ref:         ref("k8s.io/api/core/v1.SecretKeySelector"),
+						},
+					},
+					"roleARN": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RoleARN is the Amazon Resource Name (ARN) of the role to assume.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"endpoint": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Endpoint configures connection to a specific SNS endpoint instead of Amazons servers",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"payload": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Payload is the list of key-value extracted from an event payload to construct the message.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
@@ -199,125 +270,125 @@ func schema_pkg_apis_sensor_v1alpha1_ArgoWorkflowTrigger(ref common.ReferenceCal
 							},
 						},
 					},
-					"args": {
+					"parameters": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Args is the list of arguments to pass to the argo CLI",
+							Description: "Parameters is the list of key-value extracted from event's payload that are applied to the trigger resource, e.g. to set the message attributes or the message group ID from the event.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerParameter"),
 									},
 								},
 							},
 						},
 					},
-				},
-			},
-		},
-		Dependencies: []string{
-			"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.ArtifactLocation", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerParameter"},
-	}
-}
-
-func schema_pkg_apis_sensor_v1alpha1_ArtifactLocation(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "ArtifactLocation describes the source location for an external artifact",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"s3": {
-						SchemaProps: spec.SchemaProps{
-							Description: "S3 compliant artifact",
-							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/common.S3Artifact"),
-						},
-					},
-					"inline": {
+					"subject": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Inline artifact is embedded in sensor spec as a string",
+							Description: "Subject is the subject of the messages, used by the email subscriptions.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"file": {
-						SchemaProps: spec.SchemaProps{
-							Description: "File artifact is artifact stored in a file",
-							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.FileArtifact"),
-						},
-					},
-					"url": {
-						SchemaProps: spec.SchemaProps{
-							Description: "URL to fetch the artifact from",
-							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.URLArtifact"),
-						},
-					},
-					"configmap": {
+					"messageAttributes": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Configmap that stores the artifact",
-							Ref:         ref("k8s.io/api/core/v1.ConfigMapKeySelector"),
+							Description: "MessageAttributes are the string attributes of the messages.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
-					"git": {
+					"messageGroupId": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Git repository hosting the artifact",
-							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.GitArtifact"),
+							Description: "MessageGroupID is the message group ID of the messages, required by FIFO topics.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"resource": {
+					"messageDeduplicationId": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Resource is generic template for K8s resource",
-							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/common.Resource"),
+							Description: "MessageDeduplicationID is the deduplication ID of the messages published to FIFO topics. It defaults to the idempotency key of the execution, so that the redeliveries of the same events are deduplicated.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
+				Required: []string{"topicArn", "region", "payload"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/argoproj/argo-events/pkg/apis/common.Resource", "github.com/argoproj/argo-events/pkg/apis/common.S3Artifact", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.FileArtifact", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.GitArtifact", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.URLArtifact", "k8s.io/api/core/v1.ConfigMapKeySelector"},
+			"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerParameter", "k8s.io/api/core/v1.SecretKeySelector"},
 	}
 }
 
-func schema_pkg_apis_sensor_v1alpha1_AzureEventHubsTrigger(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_sensor_v1alpha1_AWSSQSTrigger(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "AzureEventHubsTrigger refers to specification of the Azure Event Hubs Trigger",
+				Description: "AWSSQSTrigger refers to specification of the trigger to send messages to an AWS SQS queue",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"fqdn": {
+					"queue": {
 						SchemaProps: spec.SchemaProps{
-							Description: "FQDN refers to the namespace dns of Azure Event Hubs to be used i.e. <namespace>.servicebus.windows.net",
+							Description: "Queue is the name of the queue to send the messages to.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"hubName": {
+					"queueAccountId": {
 						SchemaProps: spec.SchemaProps{
-							Description: "HubName refers to the Azure Event Hub to send events to",
+							Description: "QueueAccountID is the ID of the account that created the queue, if it is not the account of the credentials.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"region": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Region is AWS region",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"sharedAccessKeyName": {
+					"accessKey": {
 						SchemaProps: spec.SchemaProps{
-							Description: "SharedAccessKeyName refers to the name of the Shared Access Key",
+							Description: "AccessKey refers K8s secret containing aws access key",
 							Ref:         ref("k8s.io/api/core/v1.SecretKeySelector"),
 						},
 					},
-					"sharedAccessKey": {
+					"secretKey": {
 						SchemaProps: spec.SchemaProps{
-							Description: "SharedAccessKey refers to a K8s secret containing the primary key for the",
+							Description: "SecretKey refers K8s secret containing aws secret key",
 							Ref:         ref("k8s.io/api/core/v1.SecretKeySelector"),
 						},
 					},
+					"roleARN": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RoleARN is the Amazon Resource Name (ARN) of the role to assume.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"endpoint": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Endpoint configures connection to a specific SQS endpoint instead of Amazons servers",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 					"payload": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Payload is the list of key-value extracted from an event payload to construct the request payload.",
+							Description: "Payload is the list of key-value extracted from an event payload to construct the message body.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
@@ -331,7 +402,7 @@ func schema_pkg_apis_sensor_v1alpha1_AzureEventHubsTrigger(ref common.ReferenceC
 					},
 					"parameters": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Parameters is the list of key-value extracted from event's payload that are applied to the trigger resource.",
+							Description: "Parameters is the list of key-value extracted from event's payload that are applied to the trigger resource, e.g. to set the message attributes or the message group ID from the event.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
@@ -343,294 +414,344 @@ func schema_pkg_apis_sensor_v1alpha1_AzureEventHubsTrigger(ref common.ReferenceC
 							},
 						},
 					},
-				},
-				Required: []string{"fqdn", "hubName", "sharedAccessKeyName", "payload"},
-			},
-		},
-		Dependencies: []string{
-			"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerParameter", "k8s.io/api/core/v1.SecretKeySelector"},
-	}
-}
-
-func schema_pkg_apis_sensor_v1alpha1_AzureServiceBusTrigger(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
-				Properties: map[string]spec.Schema{
-					"connectionString": {
-						SchemaProps: spec.SchemaProps{
-							Description: "ConnectionString is the connection string for the Azure Service Bus",
-							Ref:         ref("k8s.io/api/core/v1.SecretKeySelector"),
-						},
-					},
-					"queueName": {
+					"messageAttributes": {
 						SchemaProps: spec.SchemaProps{
-							Description: "QueueName is the name of the Azure Service Bus Queue",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "MessageAttributes are the string attributes of the messages.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
-					"topicName": {
+					"messageGroupId": {
 						SchemaProps: spec.SchemaProps{
-							Description: "TopicName is the name of the Azure Service Bus Topic",
-							Default:     "",
+							Description: "MessageGroupID is the message group ID of the messages, required by FIFO queues.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"subscriptionName": {
+					"messageDeduplicationId": {
 						SchemaProps: spec.SchemaProps{
-							Description: "SubscriptionName is the name of the Azure Service Bus Topic Subscription",
-							Default:     "",
+							Description: "MessageDeduplicationID is the deduplication ID of the messages sent to FIFO queues. It defaults to the idempotency key of the execution, so that the redeliveries of the same events are deduplicated.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"tls": {
-						SchemaProps: spec.SchemaProps{
-							Description: "TLS configuration for the service bus client",
-							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/common.TLSConfig"),
-						},
-					},
-					"payload": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Payload is the list of key-value extracted from an event payload to construct the request payload.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerParameter"),
-									},
-								},
-							},
-						},
-					},
-					"parameters": {
+					"delaySeconds": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Parameters is the list of key-value extracted from event's payload that are applied to the trigger resource.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerParameter"),
-									},
-								},
-							},
+							Description: "DelaySeconds is the number of seconds the delivery of the messages is delayed, up to 900. It is not supported by FIFO queues.",
+							Type:        []string{"integer"},
+							Format:      "int64",
 						},
 					},
 				},
-				Required: []string{"queueName", "topicName", "subscriptionName", "payload"},
+				Required: []string{"queue", "region", "payload"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/argoproj/argo-events/pkg/apis/common.TLSConfig", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerParameter", "k8s.io/api/core/v1.SecretKeySelector"},
+			"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerParameter", "k8s.io/api/core/v1.SecretKeySelector"},
 	}
 }
 
-func schema_pkg_apis_sensor_v1alpha1_ConditionsResetByTime(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_sensor_v1alpha1_ArgoWorkflowArtifact(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "ArgoWorkflowArtifact is an input artifact of a submitted workflow, materialized from the events either as a raw artifact or as a reference to an S3 object.",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"cron": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Cron is a cron-like expression. For reference, see: https://en.wikipedia.org/wiki/Cron",
+							Description: "Name of the input artifact.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"timezone": {
+					"raw": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "Raw materializes the artifact as a raw artifact, whose content is resolved from the events like the value of a parameter, e.g. a field of the event payload.",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerParameterSource"),
 						},
 					},
-				},
-			},
-		},
-	}
-}
-
-func schema_pkg_apis_sensor_v1alpha1_ConditionsResetCriteria(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
-				Properties: map[string]spec.Schema{
-					"byTime": {
+					"s3": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Schedule is a cron-like expression. For reference, see: https://en.wikipedia.org/wiki/Cron",
-							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.ConditionsResetByTime"),
+							Description: "S3 materializes the artifact as a reference to an S3 object, e.g. the claim-check object of an event whose data was offloaded by the ClaimCheck oversize policy of its EventSource.",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.ArgoWorkflowS3Artifact"),
 						},
 					},
 				},
+				Required: []string{"name"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.ConditionsResetByTime"},
+			"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.ArgoWorkflowS3Artifact", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerParameterSource"},
 	}
 }
 
-func schema_pkg_apis_sensor_v1alpha1_CustomTrigger(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_sensor_v1alpha1_ArgoWorkflowS3Artifact(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "CustomTrigger refers to the specification of the custom trigger.",
+				Description: "ArgoWorkflowS3Artifact is a reference to an S3 object, whose location is resolved from the data of an event.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"serverURL": {
+					"dependencyName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ServerURL is the url of the gRPC server that executes custom trigger",
+							Description: "DependencyName refers to the name of the dependency whose event holds the location of the object.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"secure": {
+					"bucketKey": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Secure refers to type of the connection between sensor to custom trigger gRPC",
-							Default:     false,
+							Description: "BucketKey is the JSON path of the bucket of the object in the event data. Default value: \"claimCheck.bucket\".",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"keyKey": {
+						SchemaProps: spec.SchemaProps{
+							Description: "KeyKey is the JSON path of the key of the object in the event data. Default value: \"claimCheck.key\".",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"endpoint": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Endpoint of the S3 server. Defaults to the \"claimCheck.endpoint\" of the event data.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"region": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Region of the bucket.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"insecure": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Insecure disables the TLS of the connection to the S3 server.",
 							Type:        []string{"boolean"},
 							Format:      "",
 						},
 					},
-					"certSecret": {
+					"accessKeySecret": {
 						SchemaProps: spec.SchemaProps{
-							Description: "CertSecret refers to the secret that contains cert for secure connection between sensor and custom trigger gRPC server.",
+							Description: "AccessKeySecret is the secret selector of the access key, in the namespace of the workflow.",
 							Ref:         ref("k8s.io/api/core/v1.SecretKeySelector"),
 						},
 					},
-					"serverNameOverride": {
+					"secretKeySecret": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ServerNameOverride for the secure connection between sensor and custom trigger gRPC server.",
+							Description: "SecretKeySecret is the secret selector of the secret key, in the namespace of the workflow.",
+							Ref:         ref("k8s.io/api/core/v1.SecretKeySelector"),
+						},
+					},
+				},
+				Required: []string{"dependencyName"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/api/core/v1.SecretKeySelector"},
+	}
+}
+
+func schema_pkg_apis_sensor_v1alpha1_ArgoWorkflowTrigger(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ArgoWorkflowTrigger is the trigger for the Argo Workflow",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"source": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Source of the K8s resource file(s)",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.ArtifactLocation"),
+						},
+					},
+					"operation": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Operation refers to the type of operation performed on the argo workflow resource. Default value is Submit.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"spec": {
+					"parameters": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Spec is the custom trigger resource specification that custom trigger gRPC server knows how to interpret.",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
+							Description: "Parameters is the list of parameters to pass to resolved Argo Workflow object",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerParameter"),
 									},
 								},
 							},
 						},
 					},
-					"parameters": {
+					"args": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Parameters is the list of parameters that is applied to resolved custom trigger trigger object.",
+							Description: "Args is the list of arguments to pass to the argo CLI",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerParameter"),
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
 									},
 								},
 							},
 						},
 					},
-					"payload": {
+					"labelSelector": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Payload is the list of key-value extracted from an event payload to construct the request payload.",
+							Description: "LabelSelector selects the existing workflows to run the operation on, e.g. \"approval-id=1234\", instead of the workflow named in the source. The source is optional when it is set. It can be parameterized with the trigger parameters, using \"argoWorkflow.labelSelector\" as the dest. Not applicable to the submit and submit-from operations.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"artifacts": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Artifacts are the input artifacts of the submitted workflow, materialized from the events and set in the spec.arguments.artifacts of the workflow. Only applicable to the submit operation.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerParameter"),
+										Ref:     ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.ArgoWorkflowArtifact"),
 									},
 								},
 							},
 						},
 					},
 				},
-				Required: []string{"serverURL", "secure", "spec", "payload"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerParameter", "k8s.io/api/core/v1.SecretKeySelector"},
+			"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.ArgoWorkflowArtifact", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.ArtifactLocation", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerParameter"},
 	}
 }
 
-func schema_pkg_apis_sensor_v1alpha1_DataFilter(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_sensor_v1alpha1_ArtifactLocation(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "DataFilter describes constraints and filters for event data Regular Expressions are purposefully not a feature as they are overkill for our uses here See Rob Pike's Post: https://commandcenter.blogspot.com/2011/08/regular-expressions-in-lexing-and.html",
+				Description: "ArtifactLocation describes the source location for an external artifact",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"path": {
+					"s3": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Path is the JSONPath of the event's (JSON decoded) data key Path is a series of keys separated by a dot. A key may contain wildcard characters '*' and '?'. To access an array value use the index as the key. The dot and wildcard characters can be escaped with '\\'. See https://github.com/tidwall/gjson#path-syntax for more information on how to use this.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "S3 compliant artifact",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/common.S3Artifact"),
 						},
 					},
-					"type": {
+					"inline": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Type contains the JSON type of the data",
-							Default:     "",
+							Description: "Inline artifact is embedded in sensor spec as a string",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"value": {
+					"file": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Value is the allowed string values for this key Booleans are passed using strconv.ParseBool() Numbers are parsed using as float64 using strconv.ParseFloat() Strings are taken as is Nils this value is ignored",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Description: "File artifact is artifact stored in a file",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.FileArtifact"),
 						},
 					},
-					"comparator": {
+					"url": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Comparator compares the event data with a user given value. Can be \">=\", \">\", \"=\", \"!=\", \"<\", or \"<=\". Is optional, and if left blank treated as equality \"=\".",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "URL to fetch the artifact from",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.URLArtifact"),
 						},
 					},
-					"template": {
+					"configmap": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Template is a go-template for extracting a string from the event's data. A Template is evaluated with provided path, type and value. The templating follows the standard go-template syntax as well as sprig's extra functions. See https://pkg.go.dev/text/template and https://masterminds.github.io/sprig/",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Configmap that stores the artifact",
+							Ref:         ref("k8s.io/api/core/v1.ConfigMapKeySelector"),
+						},
+					},
+					"git": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Git repository hosting the artifact",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.GitArtifact"),
+						},
+					},
+					"resource": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Resource is generic template for K8s resource",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/common.Resource"),
 						},
 					},
 				},
-				Required: []string{"path", "type", "value"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/argoproj/argo-events/pkg/apis/common.Resource", "github.com/argoproj/argo-events/pkg/apis/common.S3Artifact", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.FileArtifact", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.GitArtifact", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.URLArtifact", "k8s.io/api/core/v1.ConfigMapKeySelector"},
 	}
 }
 
-func schema_pkg_apis_sensor_v1alpha1_EmailTrigger(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_sensor_v1alpha1_AzureEventHubsTrigger(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "EmailTrigger refers to the specification of the email notification trigger.",
+				Description: "AzureEventHubsTrigger refers to specification of the Azure Event Hubs Trigger",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
+					"fqdn": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FQDN refers to the namespace dns of Azure Event Hubs to be used i.e. <namespace>.servicebus.windows.net",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"hubName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "HubName refers to the Azure Event Hub to send events to",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"sharedAccessKeyName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SharedAccessKeyName refers to the name of the Shared Access Key. If neither the name nor the key of the Shared Access Key is provided, it will try to access via Azure AD with DefaultAzureCredential, e.g. with workload identity.",
+							Ref:         ref("k8s.io/api/core/v1.SecretKeySelector"),
+						},
+					},
+					"sharedAccessKey": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SharedAccessKey refers to a K8s secret containing the primary key for the",
+							Ref:         ref("k8s.io/api/core/v1.SecretKeySelector"),
+						},
+					},
+					"payload": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Payload is the list of key-value extracted from an event payload to construct the request payload.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerParameter"),
+									},
+								},
+							},
+						},
+					},
 					"parameters": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Parameters is the list of key-value extracted from event's payload that are applied to the trigger resource.",
@@ -645,70 +766,94 @@ func schema_pkg_apis_sensor_v1alpha1_EmailTrigger(ref common.ReferenceCallback)
 							},
 						},
 					},
-					"username": {
+				},
+				Required: []string{"fqdn", "hubName", "payload"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerParameter", "k8s.io/api/core/v1.SecretKeySelector"},
+	}
+}
+
+func schema_pkg_apis_sensor_v1alpha1_AzureQueueStorageTrigger(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AzureQueueStorageTrigger refers to the specification of the Azure Queue Storage trigger.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"storageAccountName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Username refers to the username used to connect to the smtp server.",
+							Description: "StorageAccountName is the name of the storage account where the queue is. This field is necessary to access via Azure AD (managed identity) and it is ignored if ConnectionString is set.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"smtpPassword": {
+					"connectionString": {
 						SchemaProps: spec.SchemaProps{
-							Description: "SMTPPassword refers to the Kubernetes secret that holds the smtp password used to connect to smtp server.",
+							Description: "ConnectionString is the connection string to access Azure Queue Storage. If this fields is not provided it will try to access via Azure AD with StorageAccountName.",
 							Ref:         ref("k8s.io/api/core/v1.SecretKeySelector"),
 						},
 					},
-					"host": {
+					"queueName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Host refers to the smtp host url to which email is send.",
+							Description: "QueueName is the name of the queue",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"port": {
+					"payload": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Port refers to the smtp server port to which email is send. Defaults to 0.",
-							Type:        []string{"integer"},
-							Format:      "int32",
+							Description: "Payload is the list of key-value extracted from an event payload to construct the message.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerParameter"),
+									},
+								},
+							},
 						},
 					},
-					"to": {
+					"parameters": {
 						SchemaProps: spec.SchemaProps{
-							Description: "To refers to the email addresses to which the emails are send.",
+							Description: "Parameters is the list of key-value extracted from event's payload that are applied to the trigger resource.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerParameter"),
 									},
 								},
 							},
 						},
 					},
-					"from": {
+					"encodeMessage": {
 						SchemaProps: spec.SchemaProps{
-							Description: "From refers to the address from which the email is send from.",
-							Type:        []string{"string"},
+							Description: "EncodeMessage specifies if the messages should be base64 encoded, as expected by the consumers decoding them, e.g. Azure Functions.",
+							Type:        []string{"boolean"},
 							Format:      "",
 						},
 					},
-					"subject": {
+					"visibilityTimeoutInSeconds": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Subject refers to the subject line for the email send.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "VisibilityTimeoutInSeconds is the duration (in seconds) for which the messages are hidden after they are enqueued, to delay their processing. The default value is 0.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"body": {
+					"timeToLiveInSeconds": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Body refers to the body/content of the email send.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "TimeToLiveInSeconds is the duration (in seconds) the messages are kept in the queue, -1 to never expire them. The default value is 7 days.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
 				},
+				Required: []string{"queueName", "payload"},
 			},
 		},
 		Dependencies: []string{
@@ -716,452 +861,399 @@ func schema_pkg_apis_sensor_v1alpha1_EmailTrigger(ref common.ReferenceCallback)
 	}
 }
 
-func schema_pkg_apis_sensor_v1alpha1_Event(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_sensor_v1alpha1_AzureServiceBusTrigger(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Event represents the cloudevent received from an event source.",
-				Type:        []string{"object"},
+				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"context": {
-						SchemaProps: spec.SchemaProps{
-							Ref: ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.EventContext"),
-						},
-					},
-					"data": {
+					"connectionString": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "byte",
+							Description: "ConnectionString is the connection string for the Azure Service Bus. If this fields is not provided it will try to access via Azure AD with DefaultAzureCredential and FullyQualifiedNamespace.",
+							Ref:         ref("k8s.io/api/core/v1.SecretKeySelector"),
 						},
 					},
-				},
-				Required: []string{"data"},
-			},
-		},
-		Dependencies: []string{
-			"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.EventContext"},
-	}
-}
-
-func schema_pkg_apis_sensor_v1alpha1_EventContext(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "EventContext holds the context of the cloudevent received from an event source.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"id": {
+					"queueName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ID of the event; must be non-empty and unique within the scope of the producer.",
+							Description: "QueueName is the name of the Azure Service Bus Queue",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"source": {
+					"topicName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Source - A URI describing the event producer.",
+							Description: "TopicName is the name of the Azure Service Bus Topic",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"specversion": {
+					"subscriptionName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "SpecVersion - The version of the CloudEvents specification used by the event.",
+							Description: "SubscriptionName is the name of the Azure Service Bus Topic Subscription",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"type": {
+					"tls": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Type - The type of the occurrence which has happened.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "TLS configuration for the service bus client",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/common.TLSConfig"),
 						},
 					},
-					"datacontenttype": {
+					"payload": {
 						SchemaProps: spec.SchemaProps{
-							Description: "DataContentType - A MIME (RFC2046) string describing the media type of `data`.",
-							Default:     "",
+							Description: "Payload is the list of key-value extracted from an event payload to construct the request payload.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerParameter"),
+									},
+								},
+							},
+						},
+					},
+					"parameters": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Parameters is the list of key-value extracted from event's payload that are applied to the trigger resource.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerParameter"),
+									},
+								},
+							},
+						},
+					},
+					"fullyQualifiedNamespace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FullyQualifiedNamespace is the Service Bus namespace name (ex: myservicebus.servicebus.windows.net). This field is necessary to access via Azure AD (managed identity) and it is ignored if ConnectionString is set.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"subject": {
+				},
+				Required: []string{"queueName", "topicName", "subscriptionName", "payload"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/argoproj/argo-events/pkg/apis/common.TLSConfig", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerParameter", "k8s.io/api/core/v1.SecretKeySelector"},
+	}
+}
+
+func schema_pkg_apis_sensor_v1alpha1_CanaryRollout(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "CanaryRollout is the configuration of a canary rollout.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"bakeDuration": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Subject - The subject of the event in the context of the event producer",
-							Default:     "",
+							Description: "BakeDuration is how long the canary runs before being evaluated, e.g. \"10m\". Defaults to 5m.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"time": {
+					"maxErrorRate": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Time - A Timestamp when the event happened.",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Description: "MaxErrorRate is the maximum ratio, between 0 and 1, of failed dry-run trigger executions for the canary to be promoted. Defaults to 0.",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/common.Amount"),
+						},
+					},
+					"minExecutions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MinExecutions is the minimum number of dry-run trigger executions for the canary to be promoted. Defaults to 0, which promotes a canary that has not received any event.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
 				},
-				Required: []string{"id", "source", "specversion", "type", "datacontenttype", "subject", "time"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"github.com/argoproj/argo-events/pkg/apis/common.Amount"},
 	}
 }
 
-func schema_pkg_apis_sensor_v1alpha1_EventDependency(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_sensor_v1alpha1_CanaryStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "EventDependency describes a dependency",
+				Description: "CanaryStatus is the status of the last canary rollout.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"name": {
+					"revision": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name is a unique name of this dependency",
+							Description: "Revision is the hash of the Deployment spec rolled out by the canary.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"eventSourceName": {
+					"phase": {
 						SchemaProps: spec.SchemaProps{
-							Description: "EventSourceName is the name of EventSource that Sensor depends on",
+							Description: "Phase of the canary rollout.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"eventName": {
+					"startedAt": {
 						SchemaProps: spec.SchemaProps{
-							Description: "EventName is the name of the event",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "StartedAt is the time the canary started baking.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-					"filters": {
+					"executions": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Filters and rules governing toleration of success and constraints on the context and data of an event",
-							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.EventDependencyFilter"),
+							Description: "Executions is the number of dry-run trigger executions observed when the canary was evaluated.",
+							Type:        []string{"integer"},
+							Format:      "int64",
 						},
 					},
-					"transform": {
+					"failures": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Transform transforms the event data",
-							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.EventDependencyTransformer"),
+							Description: "Failures is the number of failed dry-run trigger executions observed when the canary was evaluated.",
+							Type:        []string{"integer"},
+							Format:      "int64",
 						},
 					},
-					"filtersLogicalOperator": {
+					"message": {
 						SchemaProps: spec.SchemaProps{
-							Description: "FiltersLogicalOperator defines how different filters are evaluated together. Available values: and (&&), or (||) Is optional and if left blank treated as and (&&).",
+							Description: "Message explains the outcome of the canary rollout.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
 				},
-				Required: []string{"name", "eventSourceName", "eventName"},
+				Required: []string{"revision", "phase"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.EventDependencyFilter", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.EventDependencyTransformer"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_pkg_apis_sensor_v1alpha1_EventDependencyFilter(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_sensor_v1alpha1_ClusterSensor(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "EventDependencyFilter defines filters and constraints for a event.",
+				Description: "ClusterSensor is a cluster-scoped Sensor, instantiated by the controller as a Sensor of the same name into each namespace selected by its namespace selector.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"time": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Time filter on the event with escalation",
-							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TimeFilter"),
-						},
-					},
-					"context": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Context filter constraints",
-							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.EventContext"),
-						},
-					},
-					"data": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Data filter constraints with escalation",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.DataFilter"),
-									},
-								},
-							},
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"exprs": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Exprs contains the list of expressions evaluated against the event payload.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.ExprFilter"),
-									},
-								},
-							},
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"dataLogicalOperator": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Description: "DataLogicalOperator defines how multiple Data filters (if defined) are evaluated together. Available values: and (&&), or (||) Is optional and if left blank treated as and (&&).",
-							Type:        []string{"string"},
-							Format:      "",
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
 						},
 					},
-					"exprLogicalOperator": {
+					"spec": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ExprLogicalOperator defines how multiple Exprs filters (if defined) are evaluated together. Available values: and (&&), or (||) Is optional and if left blank treated as and (&&).",
-							Type:        []string{"string"},
-							Format:      "",
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.ClusterSensorSpec"),
 						},
 					},
-					"script": {
+					"status": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Script refers to a Lua script evaluated to determine the validity of an event.",
-							Type:        []string{"string"},
-							Format:      "",
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/argoproj/argo-events/pkg/apis/common.ClusterResourceStatus"),
 						},
 					},
 				},
+				Required: []string{"metadata", "spec"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.DataFilter", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.EventContext", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.ExprFilter", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TimeFilter"},
+			"github.com/argoproj/argo-events/pkg/apis/common.ClusterResourceStatus", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.ClusterSensorSpec", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_pkg_apis_sensor_v1alpha1_EventDependencyTransformer(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_sensor_v1alpha1_ClusterSensorList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "EventDependencyTransformer transforms the event",
+				Description: "ClusterSensorList is the list of ClusterSensor resources",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"jq": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "JQ holds the jq command applied for transformation",
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"script": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Script refers to a Lua script used to transform the event",
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-				},
-			},
-		},
-	}
-}
-
-func schema_pkg_apis_sensor_v1alpha1_ExprFilter(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
-				Properties: map[string]spec.Schema{
-					"expr": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Expr refers to the expression that determines the outcome of the filter.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
 						},
 					},
-					"fields": {
+					"items": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Fields refers to set of keys that refer to the paths within event payload.",
-							Type:        []string{"array"},
+							Type: []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.PayloadField"),
+										Ref:     ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.ClusterSensor"),
 									},
 								},
 							},
 						},
 					},
 				},
-				Required: []string{"expr", "fields"},
+				Required: []string{"metadata", "items"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.PayloadField"},
+			"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.ClusterSensor", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
-func schema_pkg_apis_sensor_v1alpha1_FileArtifact(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_sensor_v1alpha1_ClusterSensorSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "FileArtifact contains information about an artifact in a filesystem",
+				Description: "ClusterSensorSpec describes the Sensors instantiated into the selected namespaces",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"path": {
+					"namespaceSelector": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "NamespaceSelector selects the namespaces the Sensor is instantiated into, an empty selector selects all the namespaces.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
+						},
+					},
+					"sensor": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Sensor is the spec of the instantiated Sensors.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.SensorSpec"),
 						},
 					},
 				},
+				Required: []string{"namespaceSelector", "sensor"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.SensorSpec", "k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
 	}
 }
 
-func schema_pkg_apis_sensor_v1alpha1_GitArtifact(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_sensor_v1alpha1_ConditionsResetByTime(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "GitArtifact contains information about an artifact stored in git",
-				Type:        []string{"object"},
+				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"url": {
+					"cron": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Git URL",
-							Default:     "",
+							Description: "Cron is a cron-like expression. For reference, see: https://en.wikipedia.org/wiki/Cron",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"cloneDirectory": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Directory to clone the repository. We clone complete directory because GitArtifact is not limited to any specific Git service providers. Hence we don't use any specific git provider client.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"creds": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Creds contain reference to git username and password",
-							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.GitCreds"),
-						},
-					},
-					"sshKeySecret": {
-						SchemaProps: spec.SchemaProps{
-							Description: "SSHKeySecret refers to the secret that contains SSH key",
-							Ref:         ref("k8s.io/api/core/v1.SecretKeySelector"),
-						},
-					},
-					"filePath": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Path to file that contains trigger resource definition",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"branch": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Branch to use to pull trigger resource",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"tag": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Tag to use to pull trigger resource",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"ref": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Ref to use to pull trigger resource. Will result in a shallow clone and fetch.",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"remote": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Remote to manage set of tracked repositories. Defaults to \"origin\". Refer https://git-scm.com/docs/git-remote",
-							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.GitRemoteConfig"),
-						},
-					},
-					"insecureIgnoreHostKey": {
+					"timezone": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Whether to ignore host key",
-							Type:        []string{"boolean"},
-							Format:      "",
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
 				},
-				Required: []string{"url", "cloneDirectory", "filePath"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.GitCreds", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.GitRemoteConfig", "k8s.io/api/core/v1.SecretKeySelector"},
 	}
 }
 
-func schema_pkg_apis_sensor_v1alpha1_GitCreds(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_sensor_v1alpha1_ConditionsResetCriteria(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "GitCreds contain reference to git username and password",
-				Type:        []string{"object"},
+				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"username": {
-						SchemaProps: spec.SchemaProps{
-							Ref: ref("k8s.io/api/core/v1.SecretKeySelector"),
-						},
-					},
-					"password": {
+					"byTime": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("k8s.io/api/core/v1.SecretKeySelector"),
+							Description: "Schedule is a cron-like expression. For reference, see: https://en.wikipedia.org/wiki/Cron",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.ConditionsResetByTime"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/api/core/v1.SecretKeySelector"},
+			"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.ConditionsResetByTime"},
 	}
 }
 
-func schema_pkg_apis_sensor_v1alpha1_GitRemoteConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_sensor_v1alpha1_CustomTrigger(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "GitRemoteConfig contains the configuration of a Git remote",
+				Description: "CustomTrigger refers to the specification of the custom trigger.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"name": {
+					"serverURL": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name of the remote to fetch from.",
+							Description: "ServerURL is the url of the gRPC server that executes custom trigger",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"urls": {
+					"secure": {
 						SchemaProps: spec.SchemaProps{
-							Description: "URLs the URLs of a remote repository. It must be non-empty. Fetch will always use the first URL, while push will use all of them.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
+							Description: "Secure refers to type of the connection between sensor to custom trigger gRPC",
+							Default:     false,
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"certSecret": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CertSecret refers to the secret that contains cert for secure connection between sensor and custom trigger gRPC server.",
+							Ref:         ref("k8s.io/api/core/v1.SecretKeySelector"),
+						},
+					},
+					"serverNameOverride": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ServerNameOverride for the secure connection between sensor and custom trigger gRPC server.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Spec is the custom trigger resource specification that custom trigger gRPC server knows how to interpret.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: "",
@@ -1172,31 +1264,10 @@ func schema_pkg_apis_sensor_v1alpha1_GitRemoteConfig(ref common.ReferenceCallbac
 							},
 						},
 					},
-				},
-				Required: []string{"name", "urls"},
-			},
-		},
-	}
-}
-
-func schema_pkg_apis_sensor_v1alpha1_HTTPTrigger(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "HTTPTrigger is the trigger for the HTTP request",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"url": {
-						SchemaProps: spec.SchemaProps{
-							Description: "URL refers to the URL to send HTTP request to.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"payload": {
+					"parameters": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
+							Description: "Parameters is the list of parameters that is applied to resolved custom trigger trigger object.",
+							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
@@ -1207,22 +1278,9 @@ func schema_pkg_apis_sensor_v1alpha1_HTTPTrigger(ref common.ReferenceCallback) c
 							},
 						},
 					},
-					"tls": {
-						SchemaProps: spec.SchemaProps{
-							Description: "TLS configuration for the HTTP client.",
-							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/common.TLSConfig"),
-						},
-					},
-					"method": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Method refers to the type of the HTTP request. Refer https://golang.org/src/net/http/method.go for more info. Default value is POST.",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"parameters": {
+					"payload": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Parameters is the list of key-value extracted from event's payload that are applied to the HTTP trigger resource.",
+							Description: "Payload is the list of key-value extracted from an event payload to construct the request payload.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
@@ -1234,25 +1292,43 @@ func schema_pkg_apis_sensor_v1alpha1_HTTPTrigger(ref common.ReferenceCallback) c
 							},
 						},
 					},
-					"timeout": {
+				},
+				Required: []string{"serverURL", "secure", "spec", "payload"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerParameter", "k8s.io/api/core/v1.SecretKeySelector"},
+	}
+}
+
+func schema_pkg_apis_sensor_v1alpha1_DataFilter(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DataFilter describes constraints and filters for event data Regular Expressions are purposefully not a feature as they are overkill for our uses here See Rob Pike's Post: https://commandcenter.blogspot.com/2011/08/regular-expressions-in-lexing-and.html",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"path": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Timeout refers to the HTTP request timeout in seconds. Default value is 60 seconds.",
-							Type:        []string{"integer"},
-							Format:      "int64",
+							Description: "Path is the JSONPath of the event's (JSON decoded) data key Path is a series of keys separated by a dot. A key may contain wildcard characters '*' and '?'. To access an array value use the index as the key. The dot and wildcard characters can be escaped with '\\'. See https://github.com/tidwall/gjson#path-syntax for more information on how to use this.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"basicAuth": {
+					"type": {
 						SchemaProps: spec.SchemaProps{
-							Description: "BasicAuth configuration for the http request.",
-							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/common.BasicAuth"),
+							Description: "Type contains the JSON type of the data",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"headers": {
+					"value": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Headers for the HTTP request.",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
+							Description: "Value is the allowed string values for this key Booleans are passed using strconv.ParseBool() Numbers are parsed using as float64 using strconv.ParseFloat() Strings are taken as is Nils this value is ignored",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: "",
@@ -1263,41 +1339,39 @@ func schema_pkg_apis_sensor_v1alpha1_HTTPTrigger(ref common.ReferenceCallback) c
 							},
 						},
 					},
-					"secureHeaders": {
+					"comparator": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Secure Headers stored in Kubernetes Secrets for the HTTP requests.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/argoproj/argo-events/pkg/apis/common.SecureHeader"),
-									},
-								},
-							},
+							Description: "Comparator compares the event data with a user given value. Can be \">=\", \">\", \"=\", \"!=\", \"<\", or \"<=\". Is optional, and if left blank treated as equality \"=\".",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"template": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Template is a go-template for extracting a string from the event's data. A Template is evaluated with provided path, type and value. The templating follows the standard go-template syntax as well as sprig's extra functions. See https://pkg.go.dev/text/template and https://masterminds.github.io/sprig/",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
-				Required: []string{"url", "payload"},
+				Required: []string{"path", "type", "value"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/argoproj/argo-events/pkg/apis/common.BasicAuth", "github.com/argoproj/argo-events/pkg/apis/common.SecureHeader", "github.com/argoproj/argo-events/pkg/apis/common.TLSConfig", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerParameter"},
 	}
 }
 
-func schema_pkg_apis_sensor_v1alpha1_K8SResourcePolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_sensor_v1alpha1_DataSchemaValidation(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "K8SResourcePolicy refers to the policy used to check the state of K8s based triggers using labels",
+				Description: "DataSchemaValidation configures the validation of the event data against the JSON Schema referred to by the CloudEvents \"dataschema\" attribute.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"labels": {
+					"allowedURLs": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Labels required to identify whether a resource is in success state",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
+							Description: "AllowedURLs are the prefixes of the schema URLs allowed to be fetched, e.g. \"https://schemas.example.com/\". The events referring to any other schema are discarded.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: "",
@@ -1308,63 +1382,81 @@ func schema_pkg_apis_sensor_v1alpha1_K8SResourcePolicy(ref common.ReferenceCallb
 							},
 						},
 					},
-					"backoff": {
+					"cacheTTL": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Backoff before checking resource state",
-							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/common.Backoff"),
+							Description: "CacheTTL is how long a fetched schema is cached, e.g. \"10m\". Defaults to 1h.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"errorOnBackoffTimeout": {
+					"required": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ErrorOnBackoffTimeout determines whether sensor should transition to error state if the trigger policy is unable to determine the state of the resource",
-							Default:     false,
+							Description: "Required discards the events without a \"dataschema\" attribute.",
 							Type:        []string{"boolean"},
 							Format:      "",
 						},
 					},
 				},
-				Required: []string{"backoff", "errorOnBackoffTimeout"},
+				Required: []string{"allowedURLs"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/argoproj/argo-events/pkg/apis/common.Backoff"},
 	}
 }
 
-func schema_pkg_apis_sensor_v1alpha1_KafkaTrigger(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_sensor_v1alpha1_DependencyStartPosition(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "KafkaTrigger refers to the specification of the Kafka trigger.",
+				Description: "DependencyStartPosition describes the position from which a dependency starts consuming the events.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"url": {
+					"deliverPolicy": {
 						SchemaProps: spec.SchemaProps{
-							Description: "URL of the Kafka broker, multiple URLs separated by comma.",
-							Default:     "",
+							Description: "DeliverPolicy is one of \"Latest\", \"Earliest\" and \"Time\", defaults to \"Latest\".",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"topic": {
+					"time": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name of the topic. More info at https://kafka.apache.org/documentation/#intro_topics",
+							Description: "Time to start with when the deliver policy is \"Time\", in RFC3339 format, e.g. \"2024-01-02T15:04:05Z\".",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_pkg_apis_sensor_v1alpha1_ElasticsearchTrigger(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ElasticsearchTrigger refers to the specification of the trigger indexing records built from the events in an Elasticsearch or OpenSearch index.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"url": {
+						SchemaProps: spec.SchemaProps{
+							Description: "URL of the cluster, e.g. \"https://elasticsearch:9200\".",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"partition": {
+					"index": {
 						SchemaProps: spec.SchemaProps{
-							Description: "DEPRECATED",
-							Default:     0,
-							Type:        []string{"integer"},
-							Format:      "int32",
+							Description: "Index the records are indexed in, or data stream they are appended to.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"parameters": {
+					"payload": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Parameters is the list of parameters that is applied to resolved Kafka trigger object.",
+							Description: "Payload is the list of key-value extracted from an event payload to construct the record.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
@@ -1376,36 +1468,71 @@ func schema_pkg_apis_sensor_v1alpha1_KafkaTrigger(ref common.ReferenceCallback)
 							},
 						},
 					},
-					"requiredAcks": {
+					"parameters": {
 						SchemaProps: spec.SchemaProps{
-							Description: "RequiredAcks used in producer to tell the broker how many replica acknowledgements Defaults to 1 (Only wait for the leader to ack).",
-							Type:        []string{"integer"},
-							Format:      "int32",
+							Description: "Parameters is the list of key-value extracted from event's payload that are applied to the trigger resource.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerParameter"),
+									},
+								},
+							},
 						},
 					},
-					"compress": {
+					"timestampField": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Compress determines whether to compress message or not. Defaults to false. If set to true, compresses message using snappy compression.",
-							Type:        []string{"boolean"},
+							Description: "TimestampField is the field of the record set to the time of the trigger, e.g. \"@timestamp\" for a data stream. The field is not set if empty.",
+							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"flushFrequency": {
+					"basicAuth": {
 						SchemaProps: spec.SchemaProps{
-							Description: "FlushFrequency refers to the frequency in milliseconds to flush batches. Defaults to 500 milliseconds.",
-							Type:        []string{"integer"},
-							Format:      "int32",
+							Description: "BasicAuth configuration for the cluster.",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/common.BasicAuth"),
+						},
+					},
+					"apiKey": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIKey refers to the Kubernetes secret that holds the encoded API key of the cluster.",
+							Ref:         ref("k8s.io/api/core/v1.SecretKeySelector"),
 						},
 					},
 					"tls": {
 						SchemaProps: spec.SchemaProps{
-							Description: "TLS configuration for the Kafka producer.",
+							Description: "TLS configuration for the cluster.",
 							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/common.TLSConfig"),
 						},
 					},
-					"payload": {
+					"timeout": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Payload is the list of key-value extracted from an event payload to construct the request payload.",
+							Description: "Timeout of the requests in seconds, defaults to 10.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+				},
+				Required: []string{"url", "index", "payload"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/argoproj/argo-events/pkg/apis/common.BasicAuth", "github.com/argoproj/argo-events/pkg/apis/common.TLSConfig", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerParameter", "k8s.io/api/core/v1.SecretKeySelector"},
+	}
+}
+
+func schema_pkg_apis_sensor_v1alpha1_EmailTrigger(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "EmailTrigger refers to the specification of the email notification trigger.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"parameters": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Parameters is the list of key-value extracted from event's payload that are applied to the trigger resource.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
@@ -1417,548 +1544,2687 @@ func schema_pkg_apis_sensor_v1alpha1_KafkaTrigger(ref common.ReferenceCallback)
 							},
 						},
 					},
-					"partitioningKey": {
+					"username": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The partitioning key for the messages put on the Kafka topic.",
+							Description: "Username refers to the username used to connect to the smtp server.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"version": {
+					"smtpPassword": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Specify what kafka version is being connected to enables certain features in sarama, defaults to 1.0.0",
+							Description: "SMTPPassword refers to the Kubernetes secret that holds the smtp password used to connect to smtp server.",
+							Ref:         ref("k8s.io/api/core/v1.SecretKeySelector"),
+						},
+					},
+					"host": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Host refers to the smtp host url to which email is send.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"sasl": {
+					"port": {
 						SchemaProps: spec.SchemaProps{
-							Description: "SASL configuration for the kafka client",
-							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/common.SASLConfig"),
+							Description: "Port refers to the smtp server port to which email is send. Defaults to 0.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"schemaRegistry": {
+					"to": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Schema Registry configuration to producer message with avro format",
-							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/common.SchemaRegistryConfig"),
+							Description: "To refers to the email addresses to which the emails are send.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"from": {
+						SchemaProps: spec.SchemaProps{
+							Description: "From refers to the address from which the email is send from.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"subject": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Subject refers to the subject line for the email send.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"body": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Body refers to the body/content of the email send.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
-				Required: []string{"url", "topic", "payload"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/argoproj/argo-events/pkg/apis/common.SASLConfig", "github.com/argoproj/argo-events/pkg/apis/common.SchemaRegistryConfig", "github.com/argoproj/argo-events/pkg/apis/common.TLSConfig", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerParameter"},
+			"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerParameter", "k8s.io/api/core/v1.SecretKeySelector"},
 	}
 }
 
-func schema_pkg_apis_sensor_v1alpha1_LogTrigger(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_sensor_v1alpha1_Event(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "Event represents the cloudevent received from an event source.",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"intervalSeconds": {
+					"context": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Only print messages every interval. Useful to prevent logging too much data for busy events.",
-							Type:        []string{"integer"},
-							Format:      "int64",
+							Ref: ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.EventContext"),
+						},
+					},
+					"data": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "byte",
 						},
 					},
 				},
+				Required: []string{"data"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.EventContext"},
 	}
 }
 
-func schema_pkg_apis_sensor_v1alpha1_NATSTrigger(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_sensor_v1alpha1_EventCapture(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "NATSTrigger refers to the specification of the NATS trigger.",
+				Description: "EventCapture writes the events received by a Sensor, before the transformations and the filters of the dependencies, as JSON lines objects named `<key>/<sensor name>/<time>-<pod name>.jsonl` in an S3 bucket. An object is written at every flush interval, if any event has been received, and the objects older than the retention are deleted.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"url": {
+					"s3": {
 						SchemaProps: spec.SchemaProps{
-							Description: "URL of the NATS cluster.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "S3 is the bucket the events are written to, the objects are written under its key.",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/common.S3Artifact"),
 						},
 					},
-					"subject": {
+					"flushInterval": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name of the subject to put message on.",
-							Default:     "",
+							Description: "FlushInterval is how often the received events are written, e.g. \"30s\". Defaults to 1m.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"payload": {
-						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerParameter"),
-									},
-								},
-							},
-						},
-					},
-					"parameters": {
-						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerParameter"),
-									},
-								},
-							},
-						},
-					},
-					"tls": {
+					"retention": {
 						SchemaProps: spec.SchemaProps{
-							Description: "TLS configuration for the NATS producer.",
-							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/common.TLSConfig"),
+							Description: "Retention is how long the objects are kept, e.g. \"72h\". Defaults to 24h.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
-				Required: []string{"url", "subject", "payload"},
+				Required: []string{"s3"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/argoproj/argo-events/pkg/apis/common.TLSConfig", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerParameter"},
+			"github.com/argoproj/argo-events/pkg/apis/common.S3Artifact"},
 	}
 }
 
-func schema_pkg_apis_sensor_v1alpha1_OpenWhiskTrigger(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_sensor_v1alpha1_EventContext(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "OpenWhiskTrigger refers to the specification of the OpenWhisk trigger.",
+				Description: "EventContext holds the context of the cloudevent received from an event source.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"host": {
+					"id": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Host URL of the OpenWhisk.",
+							Description: "ID of the event; must be non-empty and unique within the scope of the producer.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"version": {
+					"source": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Version for the API. Defaults to v1.",
+							Description: "Source - A URI describing the event producer.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"namespace": {
+					"specversion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Namespace for the action. Defaults to \"_\".",
+							Description: "SpecVersion - The version of the CloudEvents specification used by the event.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"authToken": {
+					"type": {
 						SchemaProps: spec.SchemaProps{
-							Description: "AuthToken for authentication.",
-							Ref:         ref("k8s.io/api/core/v1.SecretKeySelector"),
+							Description: "Type - The type of the occurrence which has happened.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"actionName": {
+					"datacontenttype": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name of the action/function.",
+							Description: "DataContentType - A MIME (RFC2046) string describing the media type of `data`.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"payload": {
+					"subject": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Payload is the list of key-value extracted from an event payload to construct the request payload.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerParameter"),
-									},
-								},
-							},
+							Description: "Subject - The subject of the event in the context of the event producer",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"parameters": {
+					"time": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Parameters is the list of key-value extracted from event's payload that are applied to the trigger resource.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
+							Description: "Time - A Timestamp when the event happened.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"extensions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Extensions - The CloudEvent extensions of the event, e.g. the ones mapped from the headers of the Kafka records.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerParameter"),
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
 									},
 								},
 							},
 						},
 					},
 				},
-				Required: []string{"host", "actionName", "payload"},
+				Required: []string{"id", "source", "specversion", "type", "datacontenttype", "subject", "time"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerParameter", "k8s.io/api/core/v1.SecretKeySelector"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_pkg_apis_sensor_v1alpha1_PayloadField(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_sensor_v1alpha1_EventDependency(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "PayloadField binds a value at path within the event payload against a name.",
+				Description: "EventDependency describes a dependency",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"path": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Path is the JSONPath of the event's (JSON decoded) data key Path is a series of keys separated by a dot. A key may contain wildcard characters '*' and '?'. To access an array value use the index as the key. The dot and wildcard characters can be escaped with '\\'. See https://github.com/tidwall/gjson#path-syntax for more information on how to use this.",
+							Description: "Name is a unique name of this dependency",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"name": {
+					"eventSourceName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name acts as key that holds the value at the path.",
-							Default:     "",
+							Description: "EventSourceName is the name of EventSource that Sensor depends on, not set for a metric dependency.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"eventName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EventName is the name of the event, not set for a metric dependency.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"filters": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Filters and rules governing toleration of success and constraints on the context and data of an event",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.EventDependencyFilter"),
+						},
+					},
+					"transform": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Transform transforms the event data",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.EventDependencyTransformer"),
+						},
+					},
+					"filtersLogicalOperator": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FiltersLogicalOperator defines how different filters are evaluated together. Available values: and (&&), or (||) Is optional and if left blank treated as and (&&).",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"startPosition": {
+						SchemaProps: spec.SchemaProps{
+							Description: "StartPosition is the position in the EventBus from which the dependency starts consuming the events, defaults to the events published after the dependency is deployed. It only applies the first time the dependency is deployed, the dependency resumes from its last position afterwards. Only supported with the JetStream EventBus.",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.DependencyStartPosition"),
+						},
+					},
+					"guards": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Guards reject the events with a pathological payload, before the filters are evaluated.",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.PayloadGuards"),
+						},
+					},
+					"eventBusName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EventBusName is the name of the EventBus the dependency consumes the events from, in the namespace of the Sensor. Defaults to the EventBus of the Sensor. The dependencies of a trigger spanning several EventBuses are joined by the Sensor pod, in memory.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
+					"prometheus": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Prometheus makes it a metric dependency, resolved by the result of a PromQL query rather than by an event of the EventBus. The query is evaluated once the event dependencies of a trigger are resolved, and the metric dependencies of a trigger are always combined with its event dependencies with \"&&\".",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.PrometheusDependency"),
+						},
+					},
 				},
-				Required: []string{"path", "name"},
+				Required: []string{"name"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.DependencyStartPosition", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.EventDependencyFilter", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.EventDependencyTransformer", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.PayloadGuards", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.PrometheusDependency"},
 	}
 }
 
-func schema_pkg_apis_sensor_v1alpha1_PulsarTrigger(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_sensor_v1alpha1_EventDependencyFilter(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "PulsarTrigger refers to the specification of the Pulsar trigger.",
+				Description: "EventDependencyFilter defines filters and constraints for a event.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"url": {
+					"time": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Configure the service URL for the Pulsar service.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Time filter on the event with escalation",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TimeFilter"),
 						},
 					},
-					"topic": {
+					"context": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name of the topic. See https://pulsar.apache.org/docs/en/concepts-messaging/",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Context filter constraints",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.EventContext"),
 						},
 					},
-					"parameters": {
+					"data": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Parameters is the list of parameters that is applied to resolved Kafka trigger object.",
+							Description: "Data filter constraints with escalation",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerParameter"),
+										Ref:     ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.DataFilter"),
 									},
 								},
 							},
 						},
 					},
-					"payload": {
+					"exprs": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Payload is the list of key-value extracted from an event payload to construct the request payload.",
+							Description: "Exprs contains the list of expressions evaluated against the event payload.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerParameter"),
+										Ref:     ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.ExprFilter"),
 									},
 								},
 							},
 						},
 					},
-					"tlsTrustCertsSecret": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Trusted TLS certificate secret.",
-							Ref:         ref("k8s.io/api/core/v1.SecretKeySelector"),
-						},
-					},
-					"tlsAllowInsecureConnection": {
+					"dataLogicalOperator": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Whether the Pulsar client accept untrusted TLS certificate from broker.",
-							Type:        []string{"boolean"},
+							Description: "DataLogicalOperator defines how multiple Data filters (if defined) are evaluated together. Available values: and (&&), or (||) Is optional and if left blank treated as and (&&).",
+							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"tlsValidateHostname": {
+					"exprLogicalOperator": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Whether the Pulsar client verify the validity of the host name from broker.",
-							Type:        []string{"boolean"},
+							Description: "ExprLogicalOperator defines how multiple Exprs filters (if defined) are evaluated together. Available values: and (&&), or (||) Is optional and if left blank treated as and (&&).",
+							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"tls": {
-						SchemaProps: spec.SchemaProps{
-							Description: "TLS configuration for the pulsar client.",
-							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/common.TLSConfig"),
-						},
-					},
-					"authTokenSecret": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Authentication token for the pulsar client. Either token or athenz can be set to use auth.",
-							Ref:         ref("k8s.io/api/core/v1.SecretKeySelector"),
-						},
-					},
-					"connectionBackoff": {
+					"script": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Backoff holds parameters applied to connection.",
-							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/common.Backoff"),
+							Description: "Script refers to a Lua script evaluated to determine the validity of an event.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"authAthenzParams": {
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.DataFilter", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.EventContext", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.ExprFilter", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TimeFilter"},
+	}
+}
+
+func schema_pkg_apis_sensor_v1alpha1_EventDependencyTransformer(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "EventDependencyTransformer transforms the event",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"jq": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Authentication athenz parameters for the pulsar client. Refer https://github.com/apache/pulsar-client-go/blob/master/pulsar/auth/athenz.go Either token or athenz can be set to use auth.",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Description: "JQ holds the jq command applied for transformation",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"authAthenzSecret": {
+					"script": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Authentication athenz privateKey secret for the pulsar client. AuthAthenzSecret must be set if AuthAthenzParams is used.",
-							Ref:         ref("k8s.io/api/core/v1.SecretKeySelector"),
+							Description: "Script refers to a Lua script used to transform the event",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
-				Required: []string{"url", "topic", "payload"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/argoproj/argo-events/pkg/apis/common.Backoff", "github.com/argoproj/argo-events/pkg/apis/common.TLSConfig", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerParameter", "k8s.io/api/core/v1.SecretKeySelector"},
 	}
 }
 
-func schema_pkg_apis_sensor_v1alpha1_RateLimit(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_sensor_v1alpha1_ExprFilter(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"unit": {
+					"expr": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Defaults to Second",
+							Description: "Expr refers to the expression that determines the outcome of the filter.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"requestsPerUnit": {
+					"fields": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"integer"},
-							Format: "int32",
+							Description: "Fields refers to set of keys that refer to the paths within event payload.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.PayloadField"),
+									},
+								},
+							},
 						},
 					},
 				},
+				Required: []string{"expr", "fields"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.PayloadField"},
 	}
 }
 
-func schema_pkg_apis_sensor_v1alpha1_Sensor(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_sensor_v1alpha1_FailingTriggerStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Sensor is the definition of a sensor resource",
+				Description: "FailingTriggerStatus describes a trigger whose last execution failed",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Description: "Name of the trigger.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"apiVersion": {
+					"since": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Since is the time of the first failed execution since the last successful one.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-					"metadata": {
+					"lastExecutionTime": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+							Description: "LastExecutionTime is the time of the last execution.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-					"spec": {
+					"consecutiveFailures": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.SensorSpec"),
+							Description: "ConsecutiveFailures is the number of failed executions since the last successful one.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"status": {
+					"lastError": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.SensorStatus"),
+							Description: "LastError is the error of the last execution, truncated.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
-				Required: []string{"metadata", "spec"},
+				Required: []string{"name", "since", "lastExecutionTime", "consecutiveFailures"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.SensorSpec", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.SensorStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_pkg_apis_sensor_v1alpha1_SensorList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_sensor_v1alpha1_FileArtifact(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "SensorList is the list of Sensor resources",
+				Description: "FileArtifact contains information about an artifact in a filesystem",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"path": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
-							Type:        []string{"string"},
-							Format:      "",
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"apiVersion": {
-						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
-							Type:        []string{"string"},
-							Format:      "",
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_sensor_v1alpha1_GitArtifact(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "GitArtifact contains information about an artifact stored in git",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"url": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Git URL",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"cloneDirectory": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Directory to clone the repository. We clone complete directory because GitArtifact is not limited to any specific Git service providers. Hence we don't use any specific git provider client.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"creds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Creds contain reference to git username and password",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.GitCreds"),
+						},
+					},
+					"sshKeySecret": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SSHKeySecret refers to the secret that contains SSH key",
+							Ref:         ref("k8s.io/api/core/v1.SecretKeySelector"),
+						},
+					},
+					"filePath": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Path to file that contains trigger resource definition",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"branch": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Branch to use to pull trigger resource",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"tag": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Tag to use to pull trigger resource",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"ref": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Ref to use to pull trigger resource. Will result in a shallow clone and fetch.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"remote": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Remote to manage set of tracked repositories. Defaults to \"origin\". Refer https://git-scm.com/docs/git-remote",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.GitRemoteConfig"),
+						},
+					},
+					"insecureIgnoreHostKey": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Whether to ignore host key",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"url", "cloneDirectory", "filePath"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.GitCreds", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.GitRemoteConfig", "k8s.io/api/core/v1.SecretKeySelector"},
+	}
+}
+
+func schema_pkg_apis_sensor_v1alpha1_GitCreds(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "GitCreds contain reference to git username and password",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"username": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/api/core/v1.SecretKeySelector"),
+						},
+					},
+					"password": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/api/core/v1.SecretKeySelector"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/api/core/v1.SecretKeySelector"},
+	}
+}
+
+func schema_pkg_apis_sensor_v1alpha1_GitRemoteConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "GitRemoteConfig contains the configuration of a Git remote",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the remote to fetch from.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"urls": {
+						SchemaProps: spec.SchemaProps{
+							Description: "URLs the URLs of a remote repository. It must be non-empty. Fetch will always use the first URL, while push will use all of them.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"name", "urls"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_sensor_v1alpha1_GithubAppCreds(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "GithubAppCreds holds the credentials of a GitHub App",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"privateKey": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PrivateKey refers to a K8s secret containing the GitHub app private key",
+							Ref:         ref("k8s.io/api/core/v1.SecretKeySelector"),
+						},
+					},
+					"appID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AppID refers to the GitHub App ID for the application you created",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"installationID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "InstallationID refers to the Installation ID of the GitHub app you created and installed",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+				},
+				Required: []string{"privateKey", "appID", "installationID"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/api/core/v1.SecretKeySelector"},
+	}
+}
+
+func schema_pkg_apis_sensor_v1alpha1_GithubWorkflowTrigger(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "GithubWorkflowTrigger refers to the specification of the trigger dispatching a GitHub Actions workflow with the workflow_dispatch event.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"owner": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Owner of the repository.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"repository": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Repository holding the workflow.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"workflow": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Workflow is the file name, e.g. \"release.yaml\", or the ID of the workflow. The workflow must be configured with the workflow_dispatch event.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"ref": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Ref is the branch or the tag the workflow runs on.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"inputs": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Inputs of the workflow, the workflow is dispatched without inputs if empty.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"parameters": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Parameters is the list of key-value extracted from event's payload that are applied to the trigger resource.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerParameter"),
+									},
+								},
+							},
+						},
+					},
+					"apiToken": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIToken refers to a K8s secret containing a GitHub personal access token with the permission to write the actions of the repository.",
+							Ref:         ref("k8s.io/api/core/v1.SecretKeySelector"),
+						},
+					},
+					"githubApp": {
+						SchemaProps: spec.SchemaProps{
+							Description: "GithubApp holds the credentials of a GitHub App installed on the repository.",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.GithubAppCreds"),
+						},
+					},
+					"githubBaseURL": {
+						SchemaProps: spec.SchemaProps{
+							Description: "GitHub base URL (for GitHub Enterprise)",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"owner", "repository", "workflow", "ref"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.GithubAppCreds", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerParameter", "k8s.io/api/core/v1.SecretKeySelector"},
+	}
+}
+
+func schema_pkg_apis_sensor_v1alpha1_HTTPPayloadWrapper(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "HTTPPayloadWrapper configures the JSON object wrapping the payload of the HTTP trigger.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"dataKey": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DataKey is the key of the payload in the wrapper object. Defaults to \"data\".",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"contextKey": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ContextKey is the key of the contexts of the events in the wrapper object, keyed by dependency name. The contexts are omitted if not specified.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"fields": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Fields are static fields added to the wrapper object.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_sensor_v1alpha1_HTTPTrigger(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "HTTPTrigger is the trigger for the HTTP request",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"url": {
+						SchemaProps: spec.SchemaProps{
+							Description: "URL refers to the URL to send HTTP request to.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"payload": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerParameter"),
+									},
+								},
+							},
+						},
+					},
+					"tls": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TLS configuration for the HTTP client.",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/common.TLSConfig"),
+						},
+					},
+					"method": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Method refers to the type of the HTTP request. Refer https://golang.org/src/net/http/method.go for more info. Default value is POST.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"parameters": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Parameters is the list of key-value extracted from event's payload that are applied to the HTTP trigger resource.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerParameter"),
+									},
+								},
+							},
+						},
+					},
+					"timeout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Timeout refers to the HTTP request timeout in seconds. Default value is 60 seconds.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"basicAuth": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BasicAuth configuration for the http request.",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/common.BasicAuth"),
+						},
+					},
+					"headers": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Headers for the HTTP request.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"secureHeaders": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Secure Headers stored in Kubernetes Secrets for the HTTP requests.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/argoproj/argo-events/pkg/apis/common.SecureHeader"),
+									},
+								},
+							},
+						},
+					},
+					"contentMode": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ContentMode is how the event is delivered in the request, either Raw (default), Structured, Binary or Wrapped.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"wrapper": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Wrapper configures the JSON object wrapping the payload with the Wrapped content mode.",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.HTTPPayloadWrapper"),
+						},
+					},
+				},
+				Required: []string{"url", "payload"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/argoproj/argo-events/pkg/apis/common.BasicAuth", "github.com/argoproj/argo-events/pkg/apis/common.SecureHeader", "github.com/argoproj/argo-events/pkg/apis/common.TLSConfig", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.HTTPPayloadWrapper", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerParameter"},
+	}
+}
+
+func schema_pkg_apis_sensor_v1alpha1_JenkinsTrigger(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "JenkinsTrigger refers to the specification of the trigger starting a Jenkins job with the remote access API.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"url": {
+						SchemaProps: spec.SchemaProps{
+							Description: "URL of Jenkins, e.g. \"https://jenkins.example.com\".",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"job": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Job is the full name of the job, e.g. \"folder/job\".",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"buildParameters": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BuildParameters are the parameters of the build, the job is started without parameters if empty.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"parameters": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Parameters is the list of key-value extracted from event's payload that are applied to the trigger resource.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerParameter"),
+									},
+								},
+							},
+						},
+					},
+					"basicAuth": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BasicAuth holds the username and the API token, or the password, of the Jenkins user. A crumb is requested when the crumb issuer of Jenkins is enabled.",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/common.BasicAuth"),
+						},
+					},
+					"token": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Token refers to the K8s secret that holds the authentication token of the job, configured with the \"Trigger builds remotely\" option of the job.",
+							Ref:         ref("k8s.io/api/core/v1.SecretKeySelector"),
+						},
+					},
+					"tls": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TLS configuration for Jenkins.",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/common.TLSConfig"),
+						},
+					},
+					"timeout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Timeout of the requests in seconds, defaults to 10.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+				},
+				Required: []string{"url", "job"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/argoproj/argo-events/pkg/apis/common.BasicAuth", "github.com/argoproj/argo-events/pkg/apis/common.TLSConfig", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerParameter", "k8s.io/api/core/v1.SecretKeySelector"},
+	}
+}
+
+func schema_pkg_apis_sensor_v1alpha1_K8SResourcePolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "K8SResourcePolicy refers to the policy used to check the state of K8s based triggers using labels",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"labels": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Labels required to identify whether a resource is in success state",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"backoff": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Backoff before checking resource state",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/common.Backoff"),
+						},
+					},
+					"errorOnBackoffTimeout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ErrorOnBackoffTimeout determines whether sensor should transition to error state if the trigger policy is unable to determine the state of the resource",
+							Default:     false,
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"backoff", "errorOnBackoffTimeout"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/argoproj/argo-events/pkg/apis/common.Backoff"},
+	}
+}
+
+func schema_pkg_apis_sensor_v1alpha1_KafkaTrigger(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "KafkaTrigger refers to the specification of the Kafka trigger.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"url": {
+						SchemaProps: spec.SchemaProps{
+							Description: "URL of the Kafka broker, multiple URLs separated by comma.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"topic": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the topic. More info at https://kafka.apache.org/documentation/#intro_topics",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"partition": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DEPRECATED",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"parameters": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Parameters is the list of parameters that is applied to resolved Kafka trigger object.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerParameter"),
+									},
+								},
+							},
+						},
+					},
+					"requiredAcks": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RequiredAcks used in producer to tell the broker how many replica acknowledgements Defaults to 1 (Only wait for the leader to ack).",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"compress": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Compress determines whether to compress message or not. Defaults to false. If set to true, compresses message using snappy compression.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"flushFrequency": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FlushFrequency refers to the frequency in milliseconds to flush batches. Defaults to 500 milliseconds.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"tls": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TLS configuration for the Kafka producer.",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/common.TLSConfig"),
+						},
+					},
+					"payload": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Payload is the list of key-value extracted from an event payload to construct the request payload.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerParameter"),
+									},
+								},
+							},
+						},
+					},
+					"partitioningKey": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The partitioning key for the messages put on the Kafka topic.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"version": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Specify what kafka version is being connected to enables certain features in sarama, defaults to 1.0.0",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"sasl": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SASL configuration for the kafka client",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/common.SASLConfig"),
+						},
+					},
+					"schemaRegistry": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Schema Registry configuration to produce messages serialized with an Avro or Protobuf schema",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/common.SchemaRegistryConfig"),
+						},
+					},
+				},
+				Required: []string{"url", "topic", "payload"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/argoproj/argo-events/pkg/apis/common.SASLConfig", "github.com/argoproj/argo-events/pkg/apis/common.SchemaRegistryConfig", "github.com/argoproj/argo-events/pkg/apis/common.TLSConfig", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerParameter"},
+	}
+}
+
+func schema_pkg_apis_sensor_v1alpha1_LogTrigger(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"intervalSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Only print messages every interval. Useful to prevent logging too much data for busy events.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_sensor_v1alpha1_LokiTrigger(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "LokiTrigger refers to the specification of the trigger appending records built from the events to a Loki stream, with the push API.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"url": {
+						SchemaProps: spec.SchemaProps{
+							Description: "URL of Loki, e.g. \"http://loki:3100\".",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"labels": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Labels of the stream the records are appended to.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"payload": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Payload is the list of key-value extracted from an event payload to construct the record, appended as a JSON log line.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerParameter"),
+									},
+								},
+							},
+						},
+					},
+					"parameters": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Parameters is the list of key-value extracted from event's payload that are applied to the trigger resource.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerParameter"),
+									},
+								},
+							},
+						},
+					},
+					"tenantID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TenantID is the tenant of a multi-tenant Loki, sent in the X-Scope-OrgID header.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"basicAuth": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BasicAuth configuration for Loki.",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/common.BasicAuth"),
+						},
+					},
+					"bearerToken": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BearerToken refers to the Kubernetes secret that holds the bearer token for Loki.",
+							Ref:         ref("k8s.io/api/core/v1.SecretKeySelector"),
+						},
+					},
+					"tls": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TLS configuration for Loki.",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/common.TLSConfig"),
+						},
+					},
+					"timeout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Timeout of the requests in seconds, defaults to 10.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+				},
+				Required: []string{"url", "labels", "payload"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/argoproj/argo-events/pkg/apis/common.BasicAuth", "github.com/argoproj/argo-events/pkg/apis/common.TLSConfig", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerParameter", "k8s.io/api/core/v1.SecretKeySelector"},
+	}
+}
+
+func schema_pkg_apis_sensor_v1alpha1_NATSTrigger(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "NATSTrigger refers to the specification of the NATS trigger.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"url": {
+						SchemaProps: spec.SchemaProps{
+							Description: "URL of the NATS cluster.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"subject": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the subject to put message on.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"payload": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerParameter"),
+									},
+								},
+							},
+						},
+					},
+					"parameters": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerParameter"),
+									},
+								},
+							},
+						},
+					},
+					"tls": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TLS configuration for the NATS producer.",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/common.TLSConfig"),
+						},
+					},
+				},
+				Required: []string{"url", "subject", "payload"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/argoproj/argo-events/pkg/apis/common.TLSConfig", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerParameter"},
+	}
+}
+
+func schema_pkg_apis_sensor_v1alpha1_OpenWhiskTrigger(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "OpenWhiskTrigger refers to the specification of the OpenWhisk trigger.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"host": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Host URL of the OpenWhisk.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"version": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Version for the API. Defaults to v1.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"namespace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Namespace for the action. Defaults to \"_\".",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"authToken": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AuthToken for authentication.",
+							Ref:         ref("k8s.io/api/core/v1.SecretKeySelector"),
+						},
+					},
+					"actionName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the action/function.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"payload": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Payload is the list of key-value extracted from an event payload to construct the request payload.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerParameter"),
+									},
+								},
+							},
+						},
+					},
+					"parameters": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Parameters is the list of key-value extracted from event's payload that are applied to the trigger resource.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerParameter"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"host", "actionName", "payload"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerParameter", "k8s.io/api/core/v1.SecretKeySelector"},
+	}
+}
+
+func schema_pkg_apis_sensor_v1alpha1_PayloadField(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PayloadField binds a value at path within the event payload against a name.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"path": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Path is the JSONPath of the event's (JSON decoded) data key Path is a series of keys separated by a dot. A key may contain wildcard characters '*' and '?'. To access an array value use the index as the key. The dot and wildcard characters can be escaped with '\\'. See https://github.com/tidwall/gjson#path-syntax for more information on how to use this.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name acts as key that holds the value at the path.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"path", "name"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_sensor_v1alpha1_PayloadGuards(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PayloadGuards are cheap checks of the size and shape of the event payload, protecting the Sensor from the payloads which would be expensive to filter.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"maxBytes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxBytes is the maximum size of the event data in bytes.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"maxDepth": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxDepth is the maximum nesting depth of the objects and arrays of the JSON event data.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"maxArrayLength": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxArrayLength is the maximum length of the arrays of the JSON event data.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"contentTypes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ContentTypes are the allowed content types of the event data, e.g. \"application/json\", their parameters such as the charset are ignored. Any content type is allowed if empty.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_sensor_v1alpha1_PrometheusDependency(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PrometheusDependency is a dependency on a PromQL query, e.g. \"sum(rabbitmq_queue_messages) > 1000\". Like an alerting rule, the dependency is resolved when the query returns a non-empty result, which becomes the data of the dependency event.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"url": {
+						SchemaProps: spec.SchemaProps{
+							Description: "URL of the Prometheus HTTP API, e.g. \"http://prometheus:9090\".",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"query": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Query is the PromQL instant query.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"interval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Interval evaluates the query on a schedule, e.g. \"30s\", instead of on each evaluation of a trigger, which then uses the latest result.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"basicAuth": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BasicAuth configuration for the Prometheus HTTP API.",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/common.BasicAuth"),
+						},
+					},
+					"bearerToken": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BearerToken refers to the Kubernetes secret that holds the bearer token of the Prometheus HTTP API.",
+							Ref:         ref("k8s.io/api/core/v1.SecretKeySelector"),
+						},
+					},
+					"tls": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TLS configuration for the Prometheus HTTP API.",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/common.TLSConfig"),
+						},
+					},
+					"headers": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Headers are the additional headers of the requests, e.g. \"X-Scope-OrgID\" for a multi-tenant endpoint.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"timeout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Timeout of the query in seconds, defaults to 10.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+				},
+				Required: []string{"url", "query"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/argoproj/argo-events/pkg/apis/common.BasicAuth", "github.com/argoproj/argo-events/pkg/apis/common.TLSConfig", "k8s.io/api/core/v1.SecretKeySelector"},
+	}
+}
+
+func schema_pkg_apis_sensor_v1alpha1_PrometheusMetric(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PrometheusMetric is a metric sample pushed by the Prometheus trigger.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the metric.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"help": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Help of the metric, only used with the Pushgateway.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"value": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Value of the sample, a float number.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"labels": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Labels of the sample.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"name", "value"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_sensor_v1alpha1_PrometheusPushgateway(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PrometheusPushgateway is a Prometheus Pushgateway.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"url": {
+						SchemaProps: spec.SchemaProps{
+							Description: "URL of the Pushgateway, e.g. \"http://pushgateway:9091\".",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"job": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Job is the job label of the pushed group of metrics.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"grouping": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Grouping labels of the pushed group of metrics, in addition to the job.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"basicAuth": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BasicAuth configuration for the Pushgateway.",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/common.BasicAuth"),
+						},
+					},
+					"tls": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TLS configuration for the Pushgateway.",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/common.TLSConfig"),
+						},
+					},
+				},
+				Required: []string{"url", "job"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/argoproj/argo-events/pkg/apis/common.BasicAuth", "github.com/argoproj/argo-events/pkg/apis/common.TLSConfig"},
+	}
+}
+
+func schema_pkg_apis_sensor_v1alpha1_PrometheusRemoteWrite(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PrometheusRemoteWrite is a Prometheus remote-write endpoint.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"url": {
+						SchemaProps: spec.SchemaProps{
+							Description: "URL of the remote-write endpoint, e.g. \"http://prometheus:9090/api/v1/write\".",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"basicAuth": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BasicAuth configuration for the endpoint.",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/common.BasicAuth"),
+						},
+					},
+					"bearerToken": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BearerToken refers to the Kubernetes secret that holds the bearer token of the endpoint.",
+							Ref:         ref("k8s.io/api/core/v1.SecretKeySelector"),
+						},
+					},
+					"tls": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TLS configuration for the endpoint.",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/common.TLSConfig"),
+						},
+					},
+					"headers": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Headers are the additional headers of the requests, e.g. \"X-Scope-OrgID\" for a multi-tenant endpoint.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"url"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/argoproj/argo-events/pkg/apis/common.BasicAuth", "github.com/argoproj/argo-events/pkg/apis/common.TLSConfig", "k8s.io/api/core/v1.SecretKeySelector"},
+	}
+}
+
+func schema_pkg_apis_sensor_v1alpha1_PrometheusTrigger(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PrometheusTrigger refers to the specification of the trigger converting the events into metric samples, pushed with the Prometheus remote-write protocol or to a Prometheus Pushgateway.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"parameters": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Parameters is the list of key-value extracted from event's payload that are applied to the trigger resource.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerParameter"),
+									},
+								},
+							},
+						},
+					},
+					"remoteWrite": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RemoteWrite pushes the samples to a Prometheus remote-write endpoint.",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.PrometheusRemoteWrite"),
+						},
+					},
+					"pushgateway": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Pushgateway pushes the samples to a Prometheus Pushgateway.",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.PrometheusPushgateway"),
+						},
+					},
+					"metrics": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Metrics are the metric samples to push, their values and labels are usually set with the parameters.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.PrometheusMetric"),
+									},
+								},
+							},
+						},
+					},
+					"timeout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Timeout of the push in seconds, defaults to 10.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+				},
+				Required: []string{"metrics"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.PrometheusMetric", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.PrometheusPushgateway", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.PrometheusRemoteWrite", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerParameter"},
+	}
+}
+
+func schema_pkg_apis_sensor_v1alpha1_PulsarTrigger(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PulsarTrigger refers to the specification of the Pulsar trigger.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"url": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Configure the service URL for the Pulsar service.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"topic": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the topic. See https://pulsar.apache.org/docs/en/concepts-messaging/",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"parameters": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Parameters is the list of parameters that is applied to resolved Kafka trigger object.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerParameter"),
+									},
+								},
+							},
+						},
+					},
+					"payload": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Payload is the list of key-value extracted from an event payload to construct the request payload.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerParameter"),
+									},
+								},
+							},
+						},
+					},
+					"tlsTrustCertsSecret": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Trusted TLS certificate secret.",
+							Ref:         ref("k8s.io/api/core/v1.SecretKeySelector"),
+						},
+					},
+					"tlsAllowInsecureConnection": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Whether the Pulsar client accept untrusted TLS certificate from broker.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"tlsValidateHostname": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Whether the Pulsar client verify the validity of the host name from broker.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"tls": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TLS configuration for the pulsar client.",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/common.TLSConfig"),
+						},
+					},
+					"authTokenSecret": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Authentication token for the pulsar client. Either token or athenz can be set to use auth.",
+							Ref:         ref("k8s.io/api/core/v1.SecretKeySelector"),
+						},
+					},
+					"connectionBackoff": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Backoff holds parameters applied to connection.",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/common.Backoff"),
+						},
+					},
+					"authAthenzParams": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Authentication athenz parameters for the pulsar client. Refer https://github.com/apache/pulsar-client-go/blob/master/pulsar/auth/athenz.go Either token or athenz can be set to use auth.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"authAthenzSecret": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Authentication athenz privateKey secret for the pulsar client. AuthAthenzSecret must be set if AuthAthenzParams is used.",
+							Ref:         ref("k8s.io/api/core/v1.SecretKeySelector"),
+						},
+					},
+				},
+				Required: []string{"url", "topic", "payload"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/argoproj/argo-events/pkg/apis/common.Backoff", "github.com/argoproj/argo-events/pkg/apis/common.TLSConfig", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerParameter", "k8s.io/api/core/v1.SecretKeySelector"},
+	}
+}
+
+func schema_pkg_apis_sensor_v1alpha1_RateLimit(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"unit": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Defaults to Second",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"requestsPerUnit": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"integer"},
+							Format: "int32",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_sensor_v1alpha1_Sensor(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Sensor is the definition of a sensor resource",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.SensorSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.SensorStatus"),
+						},
+					},
+				},
+				Required: []string{"metadata", "spec"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.SensorSpec", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.SensorStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_pkg_apis_sensor_v1alpha1_SensorDistribution(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SensorDistribution configures how the trigger load is distributed across the replicas of a Sensor.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"mode": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Mode is either LeaderElection (default), or Shared, where all the replicas run the triggers without a leader election, each one consuming its partition of the dependencies. Shared requires a JetStream or Kafka EventBus. With JetStream, each replica claims one of the spec.replicas partitions, and the dependencies of a trigger are spread across them, unless its conditions require several events or it batches its executions, which keeps them together on a single replica.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_sensor_v1alpha1_SensorExecutionQuota(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SensorExecutionQuota limits the trigger executions of a Sensor over the last hour and the last day, at least one of MaxTriggersPerHour and MaxTriggersPerDay is required. The executions of all the triggers are counted together, by each Sensor pod.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"maxTriggersPerHour": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxTriggersPerHour is the maximum number of trigger executions over the last hour.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"maxTriggersPerDay": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxTriggersPerDay is the maximum number of trigger executions over the last 24 hours.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"overflow": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Overflow is the policy for the executions exceeding the quota, \"Drop\", \"Queue\" or \"Alert\". Defaults to \"Drop\".",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_sensor_v1alpha1_SensorList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SensorList is the list of Sensor resources",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.Sensor"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"metadata", "items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.Sensor", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+	}
+}
+
+func schema_pkg_apis_sensor_v1alpha1_SensorRollout(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SensorRollout configures how the spec changes of a Sensor are rolled out.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"canary": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Canary starts the new revision alongside the current one, consuming the events with a shadow consumer and running the triggers in dry-run mode. After the bake duration, the new revision is promoted if the error rate of the dry-run trigger executions is acceptable, otherwise it is rolled back.",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.CanaryRollout"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.CanaryRollout"},
+	}
+}
+
+func schema_pkg_apis_sensor_v1alpha1_SensorSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SensorSpec represents desired sensor state",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"dependencies": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Dependencies is a list of the events that this sensor is dependent on.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.EventDependency"),
+									},
+								},
+							},
+						},
+					},
+					"triggers": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Triggers is a list of the things that this sensor evokes. These are the outputs from this sensor.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.Trigger"),
+									},
+								},
+							},
+						},
+					},
+					"template": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Template is the pod specification for the sensor",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.Template"),
+						},
+					},
+					"errorOnFailedRound": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ErrorOnFailedRound if set to true, marks sensor state as `error` if the previous trigger round fails. Once sensor state is set to `error`, no further triggers will be processed.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"eventBusName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EventBusName references to a EventBus name. By default the value is \"default\"",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"replicas": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Replicas is the sensor deployment replicas",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"revisionHistoryLimit": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RevisionHistoryLimit specifies how many old deployment revisions to retain",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"loggingFields": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LoggingFields add additional key-value pairs when logging happens",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"remoteEventBus": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RemoteEventBus references to an EventBus in another cluster, it can not be used together with EventBusName.",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/common.RemoteEventBus"),
+						},
+					},
+					"drainTimeout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DrainTimeout is the maximum duration, e.g. \"30s\", for a terminating sensor pod to finish its in-flight trigger executions, while a new pod takes over the EventBus consumer during a rollout. The old pod exits immediately if not specified.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metrics": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Metrics configures the monitoring of the metrics endpoint of the Sensor pods.",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/common.MetricsConfig"),
+						},
+					},
+					"rollout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Rollout configures how the spec changes are rolled out, the Deployment is updated immediately if not specified.",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.SensorRollout"),
+						},
+					},
+					"dataSchemaValidation": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DataSchemaValidation validates the data of the events carrying a CloudEvents \"dataschema\" attribute against the JSON Schema it refers to, before the filters of the dependencies are applied.",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.DataSchemaValidation"),
+						},
+					},
+					"distribution": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Distribution configures how the trigger load is distributed across the replicas of the Sensor. By default, only the elected leader replica runs the triggers.",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.SensorDistribution"),
+						},
+					},
+					"requiresOrdering": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RequiresOrdering declares that the events of each dependency must be delivered in the order they are published. The Sensor is not deployed on an EventBus which can't guarantee it.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"triggerStatus": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TriggerStatus configures the report of the trigger executions by the Sensor pods, they are not reported if not specified.",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerStatusReporting"),
+						},
+					},
+					"quota": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Quota limits the trigger executions of the Sensor per hour and per day, so that a buggy upstream can't make it execute the triggers thousands of times.",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.SensorExecutionQuota"),
+						},
+					},
+					"tracingMetadata": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TracingMetadata configures the labels and annotations added to the resources created by the K8s and Argo Workflow triggers, so that they can be traced back to the events which caused them. The IDs of the events and the trace ID are added as annotations if not specified.",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TracingMetadata"),
+						},
+					},
+					"capture": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Capture records the events received by the Sensor to an S3 bucket, so that they can be replayed offline through a Sensor spec with the sensor-replay command.",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.EventCapture"),
+						},
+					},
+					"triggerPools": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TriggerPools runs the executions of each trigger on its own bounded pool of workers, so that a slow trigger doesn't delay the executions of the other triggers.",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerPools"),
+						},
+					},
+				},
+				Required: []string{"dependencies", "triggers"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/argoproj/argo-events/pkg/apis/common.MetricsConfig", "github.com/argoproj/argo-events/pkg/apis/common.RemoteEventBus", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.DataSchemaValidation", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.EventCapture", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.EventDependency", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.SensorDistribution", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.SensorExecutionQuota", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.SensorRollout", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.Template", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TracingMetadata", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.Trigger", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerPools", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerStatusReporting"},
+	}
+}
+
+func schema_pkg_apis_sensor_v1alpha1_SensorStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SensorStatus contains information about the status of a sensor.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"conditions": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-patch-merge-key": "type",
+								"x-kubernetes-patch-strategy":  "merge",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Conditions are the latest available observations of a resource's current state.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/argoproj/argo-events/pkg/apis/common.Condition"),
+									},
+								},
+							},
+						},
+					},
+					"canary": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Canary is the status of the last canary rollout, if any.",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.CanaryStatus"),
+						},
+					},
+					"triggers": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Triggers is the report of the trigger executions, if enabled.",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggersStatus"),
+						},
+					},
+					"retries": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Retries are the operations being retried by the Sensor pods, e.g. the trigger executions.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/argoproj/argo-events/pkg/apis/common.RetryStatus"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/argoproj/argo-events/pkg/apis/common.Condition", "github.com/argoproj/argo-events/pkg/apis/common.RetryStatus", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.CanaryStatus", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggersStatus"},
+	}
+}
+
+func schema_pkg_apis_sensor_v1alpha1_SlackSender(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"username": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Username is the Slack application's username",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"icon": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Icon is the Slack application's icon, e.g. :robot_face: or https://example.com/image.png",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_sensor_v1alpha1_SlackThread(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"messageAggregationKey": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MessageAggregationKey allows to aggregate the messages to a thread by some key.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"broadcastMessageToChannel": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BroadcastMessageToChannel allows to also broadcast the message from the thread to the channel",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_sensor_v1alpha1_SlackTrigger(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SlackTrigger refers to the specification of the slack notification trigger.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"parameters": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Parameters is the list of key-value extracted from event's payload that are applied to the trigger resource.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerParameter"),
+									},
+								},
+							},
+						},
+					},
+					"slackToken": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SlackToken refers to the Kubernetes secret that holds the slack token required to send messages.",
+							Ref:         ref("k8s.io/api/core/v1.SecretKeySelector"),
+						},
+					},
+					"channel": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Channel refers to which Slack channel to send Slack message.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"metadata": {
+					"message": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+							Description: "Message refers to the message to send to the Slack channel.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"items": {
+					"attachments": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.Sensor"),
-									},
-								},
-							},
+							Description: "Attachments is a JSON format string that represents an array of Slack attachments according to the attachments API: https://api.slack.com/reference/messaging/attachments .",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"blocks": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Blocks is a JSON format string that represents an array of Slack blocks according to the blocks API: https://api.slack.com/reference/block-kit/blocks .",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"thread": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Thread refers to additional options for sending messages to a Slack thread.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.SlackThread"),
+						},
+					},
+					"sender": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Sender refers to additional configuration of the Slack application that sends the message.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.SlackSender"),
 						},
 					},
 				},
-				Required: []string{"metadata", "items"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.Sensor", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+			"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.SlackSender", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.SlackThread", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerParameter", "k8s.io/api/core/v1.SecretKeySelector"},
 	}
 }
 
-func schema_pkg_apis_sensor_v1alpha1_SensorSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_sensor_v1alpha1_StandardK8STrigger(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "SensorSpec represents desired sensor state",
+				Description: "StandardK8STrigger is the standard Kubernetes resource trigger",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"dependencies": {
+					"source": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Dependencies is a list of the events that this sensor is dependent on.",
+							Description: "Source of the K8s resource file(s)",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.ArtifactLocation"),
+						},
+					},
+					"operation": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Operation refers to the type of operation performed on the k8s resource. Default value is Create.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"parameters": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Parameters is the list of parameters that is applied to resolved K8s trigger object.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.EventDependency"),
+										Ref:     ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerParameter"),
 									},
 								},
 							},
 						},
 					},
-					"triggers": {
+					"patchStrategy": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Triggers is a list of the things that this sensor evokes. These are the outputs from this sensor.",
-							Type:        []string{"array"},
+							Description: "PatchStrategy controls the K8s object patching strategy when the trigger operation is specified as patch. possible values: \"application/json-patch+json\" \"application/merge-patch+json\" \"application/strategic-merge-patch+json\" \"application/apply-patch+yaml\". Defaults to \"application/merge-patch+json\"",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"liveObject": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LiveObject specifies whether the resource should be directly fetched from K8s instead of being marshaled from the resource artifact. If set to true, the resource artifact must contain the information required to uniquely identify the resource in the cluster, that is, you must specify \"apiVersion\", \"kind\" as well as \"name\" and \"namespace\" meta data. Only valid for operation type `update`",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.ArtifactLocation", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerParameter"},
+	}
+}
+
+func schema_pkg_apis_sensor_v1alpha1_StatusPolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "StatusPolicy refers to the policy used to check the state of the trigger using response status",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"allow": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.Trigger"),
+										Default: 0,
+										Type:    []string{"integer"},
+										Format:  "int32",
 									},
 								},
 							},
 						},
 					},
-					"template": {
+				},
+				Required: []string{"allow"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_sensor_v1alpha1_Template(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Template holds the information of a sensor deployment template",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Template is the pod specification for the sensor",
-							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.Template"),
+							Description: "Metadata sets the pods's metadata, i.e. annotations and labels",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/common.Metadata"),
 						},
 					},
-					"errorOnFailedRound": {
+					"serviceAccountName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ErrorOnFailedRound if set to true, marks sensor state as `error` if the previous trigger round fails. Once sensor state is set to `error`, no further triggers will be processed.",
-							Type:        []string{"boolean"},
+							Description: "ServiceAccountName is the name of the ServiceAccount to use to run sensor pod. More info: https://kubernetes.io/docs/tasks/configure-pod-container/configure-service-account/",
+							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"eventBusName": {
+					"container": {
 						SchemaProps: spec.SchemaProps{
-							Description: "EventBusName references to a EventBus name. By default the value is \"default\"",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Container is the main container image to run in the sensor pod",
+							Ref:         ref("k8s.io/api/core/v1.Container"),
 						},
 					},
-					"replicas": {
+					"volumes": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-patch-merge-key": "name",
+								"x-kubernetes-patch-strategy":  "merge",
+							},
+						},
 						SchemaProps: spec.SchemaProps{
-							Description: "Replicas is the sensor deployment replicas",
-							Type:        []string{"integer"},
-							Format:      "int32",
+							Description: "Volumes is a list of volumes that can be mounted by containers in a workflow.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/api/core/v1.Volume"),
+									},
+								},
+							},
 						},
 					},
-					"revisionHistoryLimit": {
+					"securityContext": {
 						SchemaProps: spec.SchemaProps{
-							Description: "RevisionHistoryLimit specifies how many old deployment revisions to retain",
-							Type:        []string{"integer"},
-							Format:      "int32",
+							Description: "SecurityContext holds pod-level security attributes and common container settings. Optional: Defaults to empty.  See type description for default values of each field.",
+							Ref:         ref("k8s.io/api/core/v1.PodSecurityContext"),
 						},
 					},
-					"loggingFields": {
+					"nodeSelector": {
 						SchemaProps: spec.SchemaProps{
-							Description: "LoggingFields add additional key-value pairs when logging happens",
+							Description: "NodeSelector is a selector which must be true for the pod to fit on a node. Selector which must match a node's labels for the pod to be scheduled on that node. More info: https://kubernetes.io/docs/concepts/configuration/assign-pod-node/",
 							Type:        []string{"object"},
 							AdditionalProperties: &spec.SchemaOrBool{
 								Allows: true,
@@ -1972,92 +4238,154 @@ func schema_pkg_apis_sensor_v1alpha1_SensorSpec(ref common.ReferenceCallback) co
 							},
 						},
 					},
-				},
-				Required: []string{"dependencies", "triggers"},
-			},
-		},
-		Dependencies: []string{
-			"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.EventDependency", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.Template", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.Trigger"},
-	}
-}
-
-func schema_pkg_apis_sensor_v1alpha1_SensorStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "SensorStatus contains information about the status of a sensor.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"conditions": {
+					"tolerations": {
+						SchemaProps: spec.SchemaProps{
+							Description: "If specified, the pod's tolerations.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/api/core/v1.Toleration"),
+									},
+								},
+							},
+						},
+					},
+					"imagePullSecrets": {
 						VendorExtensible: spec.VendorExtensible{
 							Extensions: spec.Extensions{
-								"x-kubernetes-patch-merge-key": "type",
+								"x-kubernetes-patch-merge-key": "name",
 								"x-kubernetes-patch-strategy":  "merge",
 							},
 						},
 						SchemaProps: spec.SchemaProps{
-							Description: "Conditions are the latest available observations of a resource's current state.",
+							Description: "ImagePullSecrets is an optional list of references to secrets in the same namespace to use for pulling any of the images used by this PodSpec. If specified, these secrets will be passed to individual puller implementations for them to use. For example, in the case of docker, only DockerConfig type secrets are honored. More info: https://kubernetes.io/docs/concepts/containers/images#specifying-imagepullsecrets-on-a-pod",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/argoproj/argo-events/pkg/apis/common.Condition"),
+										Ref:     ref("k8s.io/api/core/v1.LocalObjectReference"),
 									},
 								},
 							},
 						},
 					},
+					"priorityClassName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "If specified, indicates the EventSource pod's priority. \"system-node-critical\" and \"system-cluster-critical\" are two special keywords which indicate the highest priorities with the former being the highest priority. Any other name must be defined by creating a PriorityClass object with that name. If not specified, the pod priority will be default or zero if there is no default. More info: https://kubernetes.io/docs/concepts/configuration/pod-priority-preemption/",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"priority": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The priority value. Various system components use this field to find the priority of the EventSource pod. When Priority Admission Controller is enabled, it prevents users from setting this field. The admission controller populates this field from PriorityClassName. The higher the value, the higher the priority. More info: https://kubernetes.io/docs/concepts/configuration/pod-priority-preemption/",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"affinity": {
+						SchemaProps: spec.SchemaProps{
+							Description: "If specified, the pod's scheduling constraints",
+							Ref:         ref("k8s.io/api/core/v1.Affinity"),
+						},
+					},
+					"runtimeClassName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RuntimeClassName refers to a RuntimeClass object in the node.k8s.io group, which should be used to run the sensor pods. More info: https://kubernetes.io/docs/concepts/containers/runtime-class/",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"generateServiceAccount": {
+						SchemaProps: spec.SchemaProps{
+							Description: "GenerateServiceAccount makes the controller generate the ServiceAccount of the sensor pods, named after the Sensor with a \"-sensor\" suffix, and bind it to a Role granting the operations of the Argo Workflow triggers on the Workflows of the Sensor namespace. The generated objects are recreated if they are deleted or modified. Can't be specified along with ServiceAccountName.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/argoproj/argo-events/pkg/apis/common.Condition"},
+			"github.com/argoproj/argo-events/pkg/apis/common.Metadata", "k8s.io/api/core/v1.Affinity", "k8s.io/api/core/v1.Container", "k8s.io/api/core/v1.LocalObjectReference", "k8s.io/api/core/v1.PodSecurityContext", "k8s.io/api/core/v1.Toleration", "k8s.io/api/core/v1.Volume"},
 	}
 }
 
-func schema_pkg_apis_sensor_v1alpha1_SlackSender(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_sensor_v1alpha1_TimeFilter(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "TimeFilter describes a window in time. It filters out events that occur outside the time limits. In other words, only events that occur after Start and before Stop will pass this filter.",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"username": {
+					"start": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Username is the Slack application's username",
+							Description: "Start is the beginning of a time window in UTC. Before this time, events for this dependency are ignored. Format is hh:mm:ss.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"icon": {
+					"stop": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Icon is the Slack application's icon, e.g. :robot_face: or https://example.com/image.png",
+							Description: "Stop is the end of a time window in UTC. After or equal to this time, events for this dependency are ignored and Format is hh:mm:ss. If it is smaller than Start, it is treated as next day of Start (e.g.: 22:00:00-01:00:00 means 22:00:00-25:00:00).",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
 				},
+				Required: []string{"start", "stop"},
 			},
 		},
 	}
 }
 
-func schema_pkg_apis_sensor_v1alpha1_SlackThread(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_sensor_v1alpha1_TracingMetadata(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "TracingMetadata holds the templates of the labels and annotations added to the resources created by the K8s and Argo Workflow triggers. They are Go templates with the sprig functions, rendered against `.Sensor` and `.Trigger`, the names of the Sensor and of the trigger, `.EventIDs`, the IDs of the events sorted by dependency name and separated by commas, `.TraceID`, the ID of the trace of the execution if the tracing is enabled, and `.Input`, the events by dependency name, with their `context` and `data`. The labels and annotations rendered to an empty string are not added.",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"messageAggregationKey": {
+					"labels": {
 						SchemaProps: spec.SchemaProps{
-							Description: "MessageAggregationKey allows to aggregate the messages to a thread by some key.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Labels are the templates of the labels, by label name, e.g. `app.example.com/order: '{{ .Input.order.data.id }}'`.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
-					"broadcastMessageToChannel": {
+					"annotations": {
 						SchemaProps: spec.SchemaProps{
-							Description: "BroadcastMessageToChannel allows to also broadcast the message from the thread to the channel",
+							Description: "Annotations are the templates of the annotations, by annotation name, added to the default ones.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"disableDefaults": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DisableDefaults disables the default annotations, only the labels and annotations specified are added.",
 							Type:        []string{"boolean"},
 							Format:      "",
 						},
@@ -2068,16 +4396,22 @@ func schema_pkg_apis_sensor_v1alpha1_SlackThread(ref common.ReferenceCallback) c
 	}
 }
 
-func schema_pkg_apis_sensor_v1alpha1_SlackTrigger(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_sensor_v1alpha1_Trigger(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "SlackTrigger refers to the specification of the slack notification trigger.",
+				Description: "Trigger is an action taken, output produced, an event created, a message sent",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
+					"template": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Template describes the trigger specification.",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerTemplate"),
+						},
+					},
 					"parameters": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Parameters is the list of key-value extracted from event's payload that are applied to the trigger resource.",
+							Description: "Parameters is the list of parameters applied to the trigger template definition",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
@@ -2089,322 +4423,311 @@ func schema_pkg_apis_sensor_v1alpha1_SlackTrigger(ref common.ReferenceCallback)
 							},
 						},
 					},
-					"slackToken": {
+					"policy": {
 						SchemaProps: spec.SchemaProps{
-							Description: "SlackToken refers to the Kubernetes secret that holds the slack token required to send messages.",
-							Ref:         ref("k8s.io/api/core/v1.SecretKeySelector"),
+							Description: "Policy to configure backoff and execution criteria for the trigger",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerPolicy"),
 						},
 					},
-					"channel": {
+					"retryStrategy": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Channel refers to which Slack channel to send Slack message.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Retry strategy, defaults to no retry",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/common.Backoff"),
 						},
 					},
-					"message": {
+					"rateLimit": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Message refers to the message to send to the Slack channel.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Rate limit, default unit is Second",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.RateLimit"),
 						},
 					},
-					"attachments": {
+					"atLeastOnce": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Attachments is a JSON format string that represents an array of Slack attachments according to the attachments API: https://api.slack.com/reference/messaging/attachments .",
-							Type:        []string{"string"},
+							Description: "AtLeastOnce determines the trigger execution semantics. Defaults to false. Trigger execution will use at-most-once semantics. If set to true, Trigger execution will switch to at-least-once semantics.",
+							Type:        []string{"boolean"},
 							Format:      "",
 						},
 					},
-					"blocks": {
+					"dlqTrigger": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Blocks is a JSON format string that represents an array of Slack blocks according to the blocks API: https://api.slack.com/reference/block-kit/blocks .",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "If the trigger fails, it will retry up to the configured number of retries. If the maximum retries are reached and the trigger is set to execute atLeastOnce, the dead letter queue (DLQ) trigger will be invoked if specified.  Invoking the dead letter queue trigger helps prevent data loss.",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.Trigger"),
 						},
 					},
-					"thread": {
+					"deduplication": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Thread refers to additional options for sending messages to a Slack thread.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.SlackThread"),
+							Description: "Deduplication skips the trigger execution if it has been executed with the same idempotency key within a time window, to avoid duplicate executions on redelivered events. Only supported with the JetStream EventBus.",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerDeduplication"),
 						},
 					},
-					"sender": {
+					"circuitBreaker": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Sender refers to additional configuration of the Slack application that sends the message.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.SlackSender"),
+							Description: "CircuitBreaker stops executing the trigger after consecutive failures, to stop hammering a failing target.",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerCircuitBreaker"),
 						},
 					},
-				},
-			},
-		},
-		Dependencies: []string{
-			"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.SlackSender", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.SlackThread", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerParameter", "k8s.io/api/core/v1.SecretKeySelector"},
-	}
-}
-
-func schema_pkg_apis_sensor_v1alpha1_StandardK8STrigger(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "StandardK8STrigger is the standard Kubernetes resource trigger",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"source": {
+					"activeWindows": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Source of the K8s resource file(s)",
-							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.ArtifactLocation"),
+							Description: "ActiveWindows restricts the trigger executions to time windows, e.g. the business hours.",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerActiveWindows"),
 						},
 					},
-					"operation": {
+					"batch": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Operation refers to the type of operation performed on the k8s resource. Default value is Create.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Batch accumulates the events satisfying the trigger conditions, and executes the trigger once with all of them. Only supported with the JetStream EventBus.",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerBatch"),
 						},
 					},
-					"parameters": {
+					"cache": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Parameters is the list of parameters that is applied to resolved K8s trigger object.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerParameter"),
-									},
-								},
-							},
+							Description: "Cache skips the trigger execution if an identical request has been executed successfully within the TTL, e.g. for the event sources re-delivering unchanged state snapshots.",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerCache"),
 						},
 					},
-					"patchStrategy": {
+					"featureFlag": {
 						SchemaProps: spec.SchemaProps{
-							Description: "PatchStrategy controls the K8s object patching strategy when the trigger operation is specified as patch. possible values: \"application/json-patch+json\" \"application/merge-patch+json\" \"application/strategic-merge-patch+json\" \"application/apply-patch+yaml\". Defaults to \"application/merge-patch+json\"",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "FeatureFlag gates the trigger executions on a feature flag, to roll out an automation progressively, e.g. per tenant, without editing the Sensor.",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerFeatureFlag"),
 						},
 					},
-					"liveObject": {
+					"oversizeRoute": {
 						SchemaProps: spec.SchemaProps{
-							Description: "LiveObject specifies whether the resource should be directly fetched from K8s instead of being marshaled from the resource artifact. If set to true, the resource artifact must contain the information required to uniquely identify the resource in the cluster, that is, you must specify \"apiVersion\", \"kind\" as well as \"name\" and \"namespace\" meta data. Only valid for operation type `update`",
-							Type:        []string{"boolean"},
-							Format:      "",
+							Description: "OversizeRoute routes the executions whose events are larger than a threshold to an alternate trigger, e.g. a Workflow processing the events from an artifact store, instead of an HTTP trigger failing on oversized bodies.",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerOversizeRoute"),
+						},
+					},
+					"pool": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Pool runs the executions of the trigger on its own bounded pool of workers, overriding the triggerPools of the Sensor.",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerPool"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.ArtifactLocation", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerParameter"},
+			"github.com/argoproj/argo-events/pkg/apis/common.Backoff", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.RateLimit", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.Trigger", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerActiveWindows", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerBatch", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerCache", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerCircuitBreaker", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerDeduplication", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerFeatureFlag", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerOversizeRoute", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerParameter", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerPolicy", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerPool", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerTemplate"},
 	}
 }
 
-func schema_pkg_apis_sensor_v1alpha1_StatusPolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_sensor_v1alpha1_TriggerActiveWindow(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "StatusPolicy refers to the policy used to check the state of the trigger using response status",
+				Description: "TriggerActiveWindow describes a recurring time window.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"allow": {
+					"start": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: 0,
-										Type:    []string{"integer"},
-										Format:  "int32",
-									},
-								},
-							},
+							Description: "Start is a cron expression of the times the window opens, e.g. \"0 9 * * 1-5\".",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"duration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Duration is how long the window stays open, e.g. \"8h\".",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
-				Required: []string{"allow"},
+				Required: []string{"start", "duration"},
 			},
 		},
 	}
 }
 
-func schema_pkg_apis_sensor_v1alpha1_Template(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_sensor_v1alpha1_TriggerActiveWindows(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Template holds the information of a sensor deployment template",
+				Description: "TriggerActiveWindows describes the time windows a trigger is executed in.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"metadata": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Metadata sets the pods's metadata, i.e. annotations and labels",
-							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/common.Metadata"),
-						},
-					},
-					"serviceAccountName": {
-						SchemaProps: spec.SchemaProps{
-							Description: "ServiceAccountName is the name of the ServiceAccount to use to run sensor pod. More info: https://kubernetes.io/docs/tasks/configure-pod-container/configure-service-account/",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"container": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Container is the main container image to run in the sensor pod",
-							Ref:         ref("k8s.io/api/core/v1.Container"),
-						},
-					},
-					"volumes": {
-						VendorExtensible: spec.VendorExtensible{
-							Extensions: spec.Extensions{
-								"x-kubernetes-patch-merge-key": "name",
-								"x-kubernetes-patch-strategy":  "merge",
-							},
-						},
+					"windows": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Volumes is a list of volumes that can be mounted by containers in a workflow.",
+							Description: "Windows are the time windows the trigger is executed in, a trigger is active if any of them is open.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("k8s.io/api/core/v1.Volume"),
+										Ref:     ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerActiveWindow"),
 									},
 								},
 							},
 						},
 					},
-					"securityContext": {
+					"timezone": {
 						SchemaProps: spec.SchemaProps{
-							Description: "SecurityContext holds pod-level security attributes and common container settings. Optional: Defaults to empty.  See type description for default values of each field.",
-							Ref:         ref("k8s.io/api/core/v1.PodSecurityContext"),
+							Description: "Timezone of the windows, e.g. \"America/New_York\". Defaults to UTC.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"nodeSelector": {
+					"outsideWindows": {
 						SchemaProps: spec.SchemaProps{
-							Description: "NodeSelector is a selector which must be true for the pod to fit on a node. Selector which must match a node's labels for the pod to be scheduled on that node. More info: https://kubernetes.io/docs/concepts/configuration/assign-pod-node/",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Description: "OutsideWindows is the policy for the executions outside the windows, \"Drop\" or \"Defer\". Defaults to \"Drop\".",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"tolerations": {
+				},
+				Required: []string{"windows"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerActiveWindow"},
+	}
+}
+
+func schema_pkg_apis_sensor_v1alpha1_TriggerBatch(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "TriggerBatch describes when to flush the batch of a trigger, at least one of MaxEvents and Window is required. The data of each dependency event is the JSON array of the data of the batched events.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"maxEvents": {
 						SchemaProps: spec.SchemaProps{
-							Description: "If specified, the pod's tolerations.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("k8s.io/api/core/v1.Toleration"),
-									},
-								},
-							},
+							Description: "MaxEvents is the number of times the trigger conditions are satisfied flushing the batch.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"imagePullSecrets": {
-						VendorExtensible: spec.VendorExtensible{
-							Extensions: spec.Extensions{
-								"x-kubernetes-patch-merge-key": "name",
-								"x-kubernetes-patch-strategy":  "merge",
-							},
+					"window": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Window is the maximum duration to accumulate the events from the first one of the batch, e.g. \"5m\".",
+							Type:        []string{"string"},
+							Format:      "",
 						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_sensor_v1alpha1_TriggerCache(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "TriggerCache describes the cache of the successful executions of a trigger. The cache is kept in memory by each Sensor pod.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"keyTemplate": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ImagePullSecrets is an optional list of references to secrets in the same namespace to use for pulling any of the images used by this PodSpec. If specified, these secrets will be passed to individual puller implementations for them to use. For example, in the case of docker, only DockerConfig type secrets are honored. More info: https://kubernetes.io/docs/concepts/containers/images#specifying-imagepullsecrets-on-a-pod",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("k8s.io/api/core/v1.LocalObjectReference"),
-									},
-								},
-							},
+							Description: "KeyTemplate is a Go template to render the cache key of an execution. The events are accessible by their dependency names under `.Input`, each with the `context` and the `data`, e.g. `{{ .Input.dep1.data.state }}`. Defaults to the hash of the trigger resource and of its payload, rendered with their parameters.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"priorityClassName": {
+					"ttl": {
 						SchemaProps: spec.SchemaProps{
-							Description: "If specified, indicates the EventSource pod's priority. \"system-node-critical\" and \"system-cluster-critical\" are two special keywords which indicate the highest priorities with the former being the highest priority. Any other name must be defined by creating a PriorityClass object with that name. If not specified, the pod priority will be default or zero if there is no default. More info: https://kubernetes.io/docs/concepts/configuration/pod-priority-preemption/",
+							Description: "TTL is the duration to keep the successful executions, e.g. \"10m\".",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"priority": {
+				},
+				Required: []string{"ttl"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_sensor_v1alpha1_TriggerCircuitBreaker(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "TriggerCircuitBreaker describes the circuit breaker of a trigger. The circuit opens after a number of consecutive failed executions, retries included, and the trigger executions then fail immediately. Once the open duration elapsed, one trial execution is allowed: the circuit is closed if it succeeds, and opened again otherwise.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"consecutiveFailures": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The priority value. Various system components use this field to find the priority of the EventSource pod. When Priority Admission Controller is enabled, it prevents users from setting this field. The admission controller populates this field from PriorityClassName. The higher the value, the higher the priority. More info: https://kubernetes.io/docs/concepts/configuration/pod-priority-preemption/",
+							Description: "ConsecutiveFailures is the number of consecutive failed executions opening the circuit.",
+							Default:     0,
 							Type:        []string{"integer"},
 							Format:      "int32",
 						},
 					},
-					"affinity": {
+					"openDuration": {
 						SchemaProps: spec.SchemaProps{
-							Description: "If specified, the pod's scheduling constraints",
-							Ref:         ref("k8s.io/api/core/v1.Affinity"),
+							Description: "OpenDuration is how long the circuit stays open before a trial execution is allowed, e.g. \"30s\". Defaults to \"1m\".",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
+				Required: []string{"consecutiveFailures"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/argoproj/argo-events/pkg/apis/common.Metadata", "k8s.io/api/core/v1.Affinity", "k8s.io/api/core/v1.Container", "k8s.io/api/core/v1.LocalObjectReference", "k8s.io/api/core/v1.PodSecurityContext", "k8s.io/api/core/v1.Toleration", "k8s.io/api/core/v1.Volume"},
 	}
 }
 
-func schema_pkg_apis_sensor_v1alpha1_TimeFilter(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_sensor_v1alpha1_TriggerDeduplication(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "TimeFilter describes a window in time. It filters out events that occur outside the time limits. In other words, only events that occur after Start and before Stop will pass this filter.",
+				Description: "TriggerDeduplication describes how to deduplicate the trigger executions.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"start": {
+					"keyTemplate": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Start is the beginning of a time window in UTC. Before this time, events for this dependency are ignored. Format is hh:mm:ss.",
-							Default:     "",
+							Description: "KeyTemplate is a Go template to render the idempotency key of an execution. The events are accessible by their dependency names under `.Input`, each with the `context` and the `data`, e.g. `{{ .Input.dep1.data.id }}`. Defaults to the IDs of the events triggering the execution.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"stop": {
+					"window": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Stop is the end of a time window in UTC. After or equal to this time, events for this dependency are ignored and Format is hh:mm:ss. If it is smaller than Start, it is treated as next day of Start (e.g.: 22:00:00-01:00:00 means 22:00:00-25:00:00).",
-							Default:     "",
+							Description: "Window is the duration to keep the idempotency keys, e.g. \"10m\". Defaults to \"1h\".",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
 				},
-				Required: []string{"start", "stop"},
 			},
 		},
 	}
 }
 
-func schema_pkg_apis_sensor_v1alpha1_Trigger(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_sensor_v1alpha1_TriggerFeatureFlag(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Trigger is an action taken, output produced, an event created, a message sent",
+				Description: "TriggerFeatureFlag describes a boolean feature flag gating the trigger executions, evaluated by an OpenFeature provider serving the OpenFeature Remote Evaluation Protocol (OFREP), e.g. flagd or GO Feature Flag.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"template": {
+					"key": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Template describes the trigger specification.",
-							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerTemplate"),
+							Description: "Key of the flag.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"parameters": {
+					"url": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Parameters is the list of parameters applied to the trigger template definition",
+							Description: "URL of the OFREP API of the provider, e.g. \"http://flagd:8016\".",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"targetingKey": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TargetingKey is the targeting key of the evaluation context, extracted from the events, e.g. the tenant.",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerParameterSource"),
+						},
+					},
+					"context": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Context is the list of key-value extracted from the events, added to the evaluation context.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
@@ -2416,42 +4739,68 @@ func schema_pkg_apis_sensor_v1alpha1_Trigger(ref common.ReferenceCallback) commo
 							},
 						},
 					},
-					"policy": {
+					"defaultValue": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Policy to configure backoff and execution criteria for the trigger",
-							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerPolicy"),
+							Description: "DefaultValue is the value of the flag when it can't be evaluated. Defaults to false, the executions are skipped.",
+							Type:        []string{"boolean"},
+							Format:      "",
 						},
 					},
-					"retryStrategy": {
+					"bearerToken": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Retry strategy, defaults to no retry",
-							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/common.Backoff"),
+							Description: "BearerToken refers to the Kubernetes secret that holds the bearer token of the provider.",
+							Ref:         ref("k8s.io/api/core/v1.SecretKeySelector"),
 						},
 					},
-					"rateLimit": {
+					"tls": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Rate limit, default unit is Second",
-							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.RateLimit"),
+							Description: "TLS configuration of the provider.",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/common.TLSConfig"),
 						},
 					},
-					"atLeastOnce": {
+					"timeout": {
 						SchemaProps: spec.SchemaProps{
-							Description: "AtLeastOnce determines the trigger execution semantics. Defaults to false. Trigger execution will use at-most-once semantics. If set to true, Trigger execution will switch to at-least-once semantics.",
-							Type:        []string{"boolean"},
-							Format:      "",
+							Description: "Timeout of the evaluations in seconds, defaults to 5.",
+							Type:        []string{"integer"},
+							Format:      "int64",
 						},
 					},
-					"dlqTrigger": {
+				},
+				Required: []string{"key", "url"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/argoproj/argo-events/pkg/apis/common.TLSConfig", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerParameter", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerParameterSource", "k8s.io/api/core/v1.SecretKeySelector"},
+	}
+}
+
+func schema_pkg_apis_sensor_v1alpha1_TriggerOversizeRoute(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "TriggerOversizeRoute routes the trigger executions whose events are too large to an alternate trigger.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"maxSize": {
 						SchemaProps: spec.SchemaProps{
-							Description: "If the trigger fails, it will retry up to the configured number of retries. If the maximum retries are reached and the trigger is set to execute atLeastOnce, the dead letter queue (DLQ) trigger will be invoked if specified.  Invoking the dead letter queue trigger helps prevent data loss.",
+							Description: "MaxSize is the size in bytes above which the executions are routed to the alternate trigger. The size of an execution is the total size of the data of its events.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"trigger": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Trigger is the alternate trigger, executed with the same events. Its name must differ from the ones of the other triggers, as its circuit breaker, cache and rate limiter are its own.",
 							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.Trigger"),
 						},
 					},
 				},
+				Required: []string{"maxSize", "trigger"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/argoproj/argo-events/pkg/apis/common.Backoff", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.RateLimit", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.Trigger", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerParameter", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerPolicy", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerTemplate"},
+			"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.Trigger"},
 	}
 }
 
@@ -2470,7 +4819,7 @@ func schema_pkg_apis_sensor_v1alpha1_TriggerParameter(ref common.ReferenceCallba
 					},
 					"dest": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Dest is the JSONPath of a resource key. A path is a series of keys separated by a dot. The colon character can be escaped with '.' The -1 key can be used to append a value to an existing array. See https://github.com/tidwall/sjson#path-syntax for more information about how this is used.",
+							Description: "Dest is the JSONPath of a resource key. A path is a series of keys separated by a dot. The colon character can be escaped with '.' The -1 key can be used to append a value to an existing array. See https://github.com/tidwall/sjson#path-syntax for more information about how this is used. With the 'jsonPatch' and 'mergePatch' operations, an empty Dest patches the whole resource.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
@@ -2478,7 +4827,7 @@ func schema_pkg_apis_sensor_v1alpha1_TriggerParameter(ref common.ReferenceCallba
 					},
 					"operation": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Operation is what to do with the existing value at Dest, whether to 'prepend', 'overwrite', or 'append' it.",
+							Description: "Operation is what to do with the existing value at Dest, whether to 'prepend', 'overwrite', or 'append' it, or to patch it with the new value, a JSON Patch (RFC 6902) document with 'jsonPatch', or a JSON Merge Patch (RFC 7386) document with 'mergePatch'.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
@@ -2583,6 +4932,101 @@ func schema_pkg_apis_sensor_v1alpha1_TriggerPolicy(ref common.ReferenceCallback)
 	}
 }
 
+func schema_pkg_apis_sensor_v1alpha1_TriggerPool(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "TriggerPool configures the pool of workers of a trigger, the fields not specified default to the ones of the triggerPools of the Sensor.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"workers": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Workers is the number of workers of the trigger, i.e. how many of its executions run at the same time.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"queueSize": {
+						SchemaProps: spec.SchemaProps{
+							Description: "QueueSize is the number of executions of the trigger waiting for a worker.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_sensor_v1alpha1_TriggerPools(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "TriggerPools configures the pools of workers running the trigger executions. The executions of a trigger wait in its queue for one of its workers, and the trigger stops receiving events while its queue is full, without affecting the other triggers.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"workers": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Workers is the number of workers of each trigger, i.e. how many of its executions run at the same time. Defaults to 4.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"queueSize": {
+						SchemaProps: spec.SchemaProps{
+							Description: "QueueSize is the number of executions of each trigger waiting for a worker. Defaults to 100.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"maxConcurrency": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxConcurrency is the maximum number of executions running at the same time across all the triggers, 0 for no limit. The triggers take turns to start their executions, so that each one gets a fair share.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_sensor_v1alpha1_TriggerStatusReporting(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "TriggerStatusReporting configures the report of the trigger executions by the Sensor pods. The status aggregates the executions of all the triggers, and lists the failing ones only, so that its size does not grow with the number of triggers.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"verbosity": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Verbosity is either Summary (default), or Detailed, which also keeps the recent executions of each trigger in a ConfigMap named in the status. The pods need the permission to get, create and update ConfigMaps for the Detailed verbosity.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"maxFailingTriggers": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxFailingTriggers is the maximum number of failing triggers listed in the status, the others are only counted. Defaults to 10.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"executionTTL": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ExecutionTTL is how long the executions are kept in the ConfigMap with the Detailed verbosity, e.g. \"6h\". Defaults to 24h.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_sensor_v1alpha1_TriggerTemplate(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -2703,12 +5147,123 @@ func schema_pkg_apis_sensor_v1alpha1_TriggerTemplate(ref common.ReferenceCallbac
 							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.EmailTrigger"),
 						},
 					},
+					"prometheus": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Prometheus refers to the trigger designed to push metric samples to Prometheus",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.PrometheusTrigger"),
+						},
+					},
+					"loki": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Loki refers to the trigger designed to append records to Loki",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.LokiTrigger"),
+						},
+					},
+					"elasticsearch": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Elasticsearch refers to the trigger designed to index records in Elasticsearch or OpenSearch",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.ElasticsearchTrigger"),
+						},
+					},
+					"jenkins": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Jenkins refers to the trigger designed to start Jenkins jobs",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.JenkinsTrigger"),
+						},
+					},
+					"githubWorkflow": {
+						SchemaProps: spec.SchemaProps{
+							Description: "GithubWorkflow refers to the trigger designed to dispatch GitHub Actions workflows",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.GithubWorkflowTrigger"),
+						},
+					},
+					"awsSQS": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AWSSQS refers to the trigger designed to send messages to AWS SQS queues",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.AWSSQSTrigger"),
+						},
+					},
+					"awsSNS": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AWSSNS refers to the trigger designed to publish messages to AWS SNS topics",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.AWSSNSTrigger"),
+						},
+					},
+					"azureQueueStorage": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AzureQueueStorage refers to the trigger designed to enqueue messages on Azure Queue Storage",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.AzureQueueStorageTrigger"),
+						},
+					},
 				},
 				Required: []string{"name"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.AWSLambdaTrigger", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.ArgoWorkflowTrigger", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.AzureEventHubsTrigger", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.AzureServiceBusTrigger", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.ConditionsResetCriteria", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.CustomTrigger", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.EmailTrigger", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.HTTPTrigger", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.KafkaTrigger", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.LogTrigger", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.NATSTrigger", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.OpenWhiskTrigger", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.PulsarTrigger", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.SlackTrigger", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.StandardK8STrigger"},
+			"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.AWSLambdaTrigger", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.AWSSNSTrigger", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.AWSSQSTrigger", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.ArgoWorkflowTrigger", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.AzureEventHubsTrigger", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.AzureQueueStorageTrigger", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.AzureServiceBusTrigger", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.ConditionsResetCriteria", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.CustomTrigger", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.ElasticsearchTrigger", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.EmailTrigger", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.GithubWorkflowTrigger", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.HTTPTrigger", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.JenkinsTrigger", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.KafkaTrigger", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.LogTrigger", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.LokiTrigger", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.NATSTrigger", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.OpenWhiskTrigger", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.PrometheusTrigger", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.PulsarTrigger", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.SlackTrigger", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.StandardK8STrigger"},
+	}
+}
+
+func schema_pkg_apis_sensor_v1alpha1_TriggersStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "TriggersStatus aggregates the executions of the triggers of a Sensor",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"executions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Executions is the number of trigger executions.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"failures": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Failures is the number of failed trigger executions.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"lastExecutionTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastExecutionTime is the time of the last trigger execution.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"failing": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Failing lists the triggers whose last execution failed, the longest failing first, up to the maxFailingTriggers of the report.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.FailingTriggerStatus"),
+									},
+								},
+							},
+						},
+					},
+					"omittedFailing": {
+						SchemaProps: spec.SchemaProps{
+							Description: "OmittedFailing is the number of failing triggers not listed.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"executionStore": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ExecutionStore is the name of the ConfigMap holding the recent executions of each trigger, with the Detailed verbosity.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.FailingTriggerStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
@@ -31,6 +31,9 @@ var (
 	// SchemaGroupVersionKind is a group version kind used to attach owner references
 	SchemaGroupVersionKind = SchemeGroupVersion.WithKind(sensor.Kind)
 
+	// ClusterSchemaGroupVersionKind is a group version kind used to attach owner references to the instantiated Sensors
+	ClusterSchemaGroupVersionKind = SchemeGroupVersion.WithKind(sensor.ClusterKind)
+
 	// SchemeBuilder is used to add go types to the GroupVersionKind scheme
 	SchemeBuilder = runtime.NewSchemeBuilder(addKnownTypes)
 
@@ -52,6 +55,8 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 	scheme.AddKnownTypes(SchemeGroupVersion,
 		&Sensor{},
 		&SensorList{},
+		&ClusterSensor{},
+		&ClusterSensorList{},
 	)
 	v1.AddToGroupVersion(scheme, SchemeGroupVersion)
 	return nil
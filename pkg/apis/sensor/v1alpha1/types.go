@@ -91,6 +91,40 @@ type SensorList struct {
 	Items           []Sensor `json:"items" protobuf:"bytes,2,rep,name=items"`
 }
 
+// ClusterSensor is a cluster-scoped Sensor, instantiated by the controller as a Sensor of the same name
+// into each namespace selected by its namespace selector.
+// +genclient
+// +genclient:nonNamespaced
+// +kubebuilder:resource:scope=Cluster,shortName=csn
+// +kubebuilder:subresource:status
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +k8s:openapi-gen=true
+type ClusterSensor struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata" protobuf:"bytes,1,opt,name=metadata"`
+	Spec              ClusterSensorSpec `json:"spec" protobuf:"bytes,2,opt,name=spec"`
+	// +optional
+	Status apicommon.ClusterResourceStatus `json:"status,omitempty" protobuf:"bytes,3,opt,name=status"`
+}
+
+// ClusterSensorList is the list of ClusterSensor resources
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type ClusterSensorList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata" protobuf:"bytes,1,opt,name=metadata"`
+
+	Items []ClusterSensor `json:"items" protobuf:"bytes,2,rep,name=items"`
+}
+
+// ClusterSensorSpec describes the Sensors instantiated into the selected namespaces
+type ClusterSensorSpec struct {
+	// NamespaceSelector selects the namespaces the Sensor is instantiated into, an empty selector selects
+	// all the namespaces.
+	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector" protobuf:"bytes,1,opt,name=namespaceSelector"`
+	// Sensor is the spec of the instantiated Sensors.
+	Sensor SensorSpec `json:"sensor" protobuf:"bytes,2,opt,name=sensor"`
+}
+
 // SensorSpec represents desired sensor state
 type SensorSpec struct {
 	// Dependencies is a list of the events that this sensor is dependent on.
@@ -113,6 +147,155 @@ type SensorSpec struct {
 	// LoggingFields add additional key-value pairs when logging happens
 	// +optional
 	LoggingFields map[string]string `json:"loggingFields" protobuf:"bytes,8,rep,name=loggingFields"`
+	// RemoteEventBus references to an EventBus in another cluster, it can not be used together with EventBusName.
+	// +optional
+	RemoteEventBus *apicommon.RemoteEventBus `json:"remoteEventBus,omitempty" protobuf:"bytes,9,opt,name=remoteEventBus"`
+	// DrainTimeout is the maximum duration, e.g. "30s", for a terminating sensor pod to finish its in-flight trigger executions,
+	// while a new pod takes over the EventBus consumer during a rollout. The old pod exits immediately if not specified.
+	// +optional
+	DrainTimeout string `json:"drainTimeout,omitempty" protobuf:"bytes,10,opt,name=drainTimeout"`
+	// Metrics configures the monitoring of the metrics endpoint of the Sensor pods.
+	// +optional
+	Metrics *apicommon.MetricsConfig `json:"metrics,omitempty" protobuf:"bytes,11,opt,name=metrics"`
+	// Rollout configures how the spec changes are rolled out, the Deployment is updated immediately if not specified.
+	// +optional
+	Rollout *SensorRollout `json:"rollout,omitempty" protobuf:"bytes,12,opt,name=rollout"`
+	// DataSchemaValidation validates the data of the events carrying a CloudEvents "dataschema" attribute
+	// against the JSON Schema it refers to, before the filters of the dependencies are applied.
+	// +optional
+	DataSchemaValidation *DataSchemaValidation `json:"dataSchemaValidation,omitempty" protobuf:"bytes,13,opt,name=dataSchemaValidation"`
+	// Distribution configures how the trigger load is distributed across the replicas of the Sensor.
+	// By default, only the elected leader replica runs the triggers.
+	// +optional
+	Distribution *SensorDistribution `json:"distribution,omitempty" protobuf:"bytes,14,opt,name=distribution"`
+	// RequiresOrdering declares that the events of each dependency must be delivered in the order they are
+	// published. The Sensor is not deployed on an EventBus which can't guarantee it.
+	// +optional
+	RequiresOrdering bool `json:"requiresOrdering,omitempty" protobuf:"varint,15,opt,name=requiresOrdering"`
+	// TriggerStatus configures the report of the trigger executions by the Sensor pods, they are not
+	// reported if not specified.
+	// +optional
+	TriggerStatus *TriggerStatusReporting `json:"triggerStatus,omitempty" protobuf:"bytes,16,opt,name=triggerStatus"`
+	// Quota limits the trigger executions of the Sensor per hour and per day, so that a buggy upstream can't
+	// make it execute the triggers thousands of times.
+	// +optional
+	Quota *SensorExecutionQuota `json:"quota,omitempty" protobuf:"bytes,17,opt,name=quota"`
+	// TracingMetadata configures the labels and annotations added to the resources created by the K8s and Argo
+	// Workflow triggers, so that they can be traced back to the events which caused them. The IDs of the events
+	// and the trace ID are added as annotations if not specified.
+	// +optional
+	TracingMetadata *TracingMetadata `json:"tracingMetadata,omitempty" protobuf:"bytes,18,opt,name=tracingMetadata"`
+	// Capture records the events received by the Sensor to an S3 bucket, so that they can be replayed offline
+	// through a Sensor spec with the sensor-replay command.
+	// +optional
+	Capture *EventCapture `json:"capture,omitempty" protobuf:"bytes,19,opt,name=capture"`
+	// TriggerPools runs the executions of each trigger on its own bounded pool of workers, so that a slow trigger
+	// doesn't delay the executions of the other triggers.
+	// +optional
+	TriggerPools *TriggerPools `json:"triggerPools,omitempty" protobuf:"bytes,20,opt,name=triggerPools"`
+}
+
+// TracingMetadata holds the templates of the labels and annotations added to the resources created by the K8s
+// and Argo Workflow triggers. They are Go templates with the sprig functions, rendered against `.Sensor` and
+// `.Trigger`, the names of the Sensor and of the trigger, `.EventIDs`, the IDs of the events sorted by dependency
+// name and separated by commas, `.TraceID`, the ID of the trace of the execution if the tracing is enabled, and
+// `.Input`, the events by dependency name, with their `context` and `data`. The labels and annotations rendered
+// to an empty string are not added.
+type TracingMetadata struct {
+	// Labels are the templates of the labels, by label name, e.g.
+	// `app.example.com/order: '{{ .Input.order.data.id }}'`.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty" protobuf:"bytes,1,rep,name=labels"`
+	// Annotations are the templates of the annotations, by annotation name, added to the default ones.
+	// +optional
+	Annotations map[string]string `json:"annotations,omitempty" protobuf:"bytes,2,rep,name=annotations"`
+	// DisableDefaults disables the default annotations, only the labels and annotations specified are added.
+	// +optional
+	DisableDefaults bool `json:"disableDefaults,omitempty" protobuf:"varint,3,opt,name=disableDefaults"`
+}
+
+// TriggerPools configures the pools of workers running the trigger executions. The executions of a trigger wait
+// in its queue for one of its workers, and the trigger stops receiving events while its queue is full, without
+// affecting the other triggers.
+type TriggerPools struct {
+	// Workers is the number of workers of each trigger, i.e. how many of its executions run at the same time.
+	// Defaults to 4.
+	// +optional
+	Workers int32 `json:"workers,omitempty" protobuf:"varint,1,opt,name=workers"`
+	// QueueSize is the number of executions of each trigger waiting for a worker. Defaults to 100.
+	// +optional
+	QueueSize int32 `json:"queueSize,omitempty" protobuf:"varint,2,opt,name=queueSize"`
+	// MaxConcurrency is the maximum number of executions running at the same time across all the triggers, 0 for
+	// no limit. The triggers take turns to start their executions, so that each one gets a fair share.
+	// +optional
+	MaxConcurrency int32 `json:"maxConcurrency,omitempty" protobuf:"varint,3,opt,name=maxConcurrency"`
+}
+
+// TriggerPool configures the pool of workers of a trigger, the fields not specified default to the ones of the
+// triggerPools of the Sensor.
+type TriggerPool struct {
+	// Workers is the number of workers of the trigger, i.e. how many of its executions run at the same time.
+	// +optional
+	Workers int32 `json:"workers,omitempty" protobuf:"varint,1,opt,name=workers"`
+	// QueueSize is the number of executions of the trigger waiting for a worker.
+	// +optional
+	QueueSize int32 `json:"queueSize,omitempty" protobuf:"varint,2,opt,name=queueSize"`
+}
+
+// GetPool returns the workers and the queue size of the pool of a trigger, nil if it has no pool.
+func (s SensorSpec) GetPool(trigger Trigger) *TriggerPool {
+	if s.TriggerPools == nil && trigger.Pool == nil {
+		return nil
+	}
+	pool := TriggerPool{Workers: 4, QueueSize: 100}
+	if s.TriggerPools != nil {
+		if s.TriggerPools.Workers > 0 {
+			pool.Workers = s.TriggerPools.Workers
+		}
+		if s.TriggerPools.QueueSize > 0 {
+			pool.QueueSize = s.TriggerPools.QueueSize
+		}
+	}
+	if trigger.Pool != nil {
+		if trigger.Pool.Workers > 0 {
+			pool.Workers = trigger.Pool.Workers
+		}
+		if trigger.Pool.QueueSize > 0 {
+			pool.QueueSize = trigger.Pool.QueueSize
+		}
+	}
+	return &pool
+}
+
+// EventCapture writes the events received by a Sensor, before the transformations and the filters of the
+// dependencies, as JSON lines objects named `<key>/<sensor name>/<time>-<pod name>.jsonl` in an S3 bucket. An
+// object is written at every flush interval, if any event has been received, and the objects older than the
+// retention are deleted.
+type EventCapture struct {
+	// S3 is the bucket the events are written to, the objects are written under its key.
+	S3 *apicommon.S3Artifact `json:"s3" protobuf:"bytes,1,opt,name=s3"`
+	// FlushInterval is how often the received events are written, e.g. "30s". Defaults to 1m.
+	// +optional
+	FlushInterval string `json:"flushInterval,omitempty" protobuf:"bytes,2,opt,name=flushInterval"`
+	// Retention is how long the objects are kept, e.g. "72h". Defaults to 24h.
+	// +optional
+	Retention string `json:"retention,omitempty" protobuf:"bytes,3,opt,name=retention"`
+}
+
+// GetFlushInterval returns how often the received events are written.
+func (c EventCapture) GetFlushInterval() time.Duration {
+	if interval, err := time.ParseDuration(c.FlushInterval); err == nil && interval > 0 {
+		return interval
+	}
+	return time.Minute
+}
+
+// GetRetention returns how long the objects are kept.
+func (c EventCapture) GetRetention() time.Duration {
+	if retention, err := time.ParseDuration(c.Retention); err == nil && retention > 0 {
+		return retention
+	}
+	return 24 * time.Hour
 }
 
 func (s SensorSpec) GetReplicas() int32 {
@@ -126,6 +309,23 @@ func (s SensorSpec) GetReplicas() int32 {
 	return replicas
 }
 
+// IsSharedDistribution returns whether the replicas of the sensor share the trigger load.
+func (s SensorSpec) IsSharedDistribution() bool {
+	return s.Distribution != nil && s.Distribution.Mode == SensorDistributionShared
+}
+
+// GetDrainTimeout returns the drain timeout of the sensor pods, 0 means no draining.
+func (s SensorSpec) GetDrainTimeout() time.Duration {
+	if s.DrainTimeout == "" {
+		return 0
+	}
+	d, err := time.ParseDuration(s.DrainTimeout)
+	if err != nil || d < 0 {
+		return 0
+	}
+	return d
+}
+
 // Template holds the information of a sensor deployment template
 type Template struct {
 	// Metadata sets the pods's metadata, i.e. annotations and labels
@@ -182,6 +382,16 @@ type Template struct {
 	// If specified, the pod's scheduling constraints
 	// +optional
 	Affinity *corev1.Affinity `json:"affinity,omitempty" protobuf:"bytes,11,opt,name=affinity"`
+	// RuntimeClassName refers to a RuntimeClass object in the node.k8s.io group, which should be used to run the sensor pods.
+	// More info: https://kubernetes.io/docs/concepts/containers/runtime-class/
+	// +optional
+	RuntimeClassName *string `json:"runtimeClassName,omitempty" protobuf:"bytes,12,opt,name=runtimeClassName"`
+	// GenerateServiceAccount makes the controller generate the ServiceAccount of the sensor pods, named after the
+	// Sensor with a "-sensor" suffix, and bind it to a Role granting the operations of the Argo Workflow triggers on
+	// the Workflows of the Sensor namespace. The generated objects are recreated if they are deleted or modified.
+	// Can't be specified along with ServiceAccountName.
+	// +optional
+	GenerateServiceAccount bool `json:"generateServiceAccount,omitempty" protobuf:"varint,13,opt,name=generateServiceAccount"`
 }
 
 type LogicalOperator string
@@ -196,10 +406,12 @@ const (
 type EventDependency struct {
 	// Name is a unique name of this dependency
 	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
-	// EventSourceName is the name of EventSource that Sensor depends on
-	EventSourceName string `json:"eventSourceName" protobuf:"bytes,2,name=eventSourceName"`
-	// EventName is the name of the event
-	EventName string `json:"eventName" protobuf:"bytes,3,name=eventName"`
+	// EventSourceName is the name of EventSource that Sensor depends on, not set for a metric dependency.
+	// +optional
+	EventSourceName string `json:"eventSourceName,omitempty" protobuf:"bytes,2,name=eventSourceName"`
+	// EventName is the name of the event, not set for a metric dependency.
+	// +optional
+	EventName string `json:"eventName,omitempty" protobuf:"bytes,3,name=eventName"`
 	// Filters and rules governing toleration of success and constraints on the context and data of an event
 	Filters *EventDependencyFilter `json:"filters,omitempty" protobuf:"bytes,4,opt,name=filters"`
 	// Transform transforms the event data
@@ -208,6 +420,116 @@ type EventDependency struct {
 	// Available values: and (&&), or (||)
 	// Is optional and if left blank treated as and (&&).
 	FiltersLogicalOperator LogicalOperator `json:"filtersLogicalOperator,omitempty" protobuf:"bytes,6,opt,name=filtersLogicalOperator,casttype=LogicalOperator"`
+	// StartPosition is the position in the EventBus from which the dependency starts consuming the events,
+	// defaults to the events published after the dependency is deployed. It only applies the first time the
+	// dependency is deployed, the dependency resumes from its last position afterwards.
+	// Only supported with the JetStream EventBus.
+	// +optional
+	StartPosition *DependencyStartPosition `json:"startPosition,omitempty" protobuf:"bytes,7,opt,name=startPosition" hash:"ignore"`
+	// Guards reject the events with a pathological payload, before the filters are evaluated.
+	// +optional
+	Guards *PayloadGuards `json:"guards,omitempty" protobuf:"bytes,8,opt,name=guards"`
+	// EventBusName is the name of the EventBus the dependency consumes the events from, in the namespace of the
+	// Sensor. Defaults to the EventBus of the Sensor.
+	// The dependencies of a trigger spanning several EventBuses are joined by the Sensor pod, in memory.
+	// +optional
+	EventBusName string `json:"eventBusName,omitempty" protobuf:"bytes,9,opt,name=eventBusName"`
+	// Prometheus makes it a metric dependency, resolved by the result of a PromQL query rather than by an event of
+	// the EventBus. The query is evaluated once the event dependencies of a trigger are resolved, and the metric
+	// dependencies of a trigger are always combined with its event dependencies with "&&".
+	// +optional
+	Prometheus *PrometheusDependency `json:"prometheus,omitempty" protobuf:"bytes,10,opt,name=prometheus"`
+}
+
+// IsMetric returns whether the dependency is resolved by a metric condition rather than by an event.
+func (d EventDependency) IsMetric() bool {
+	return d.Prometheus != nil
+}
+
+// PrometheusDependency is a dependency on a PromQL query, e.g. "sum(rabbitmq_queue_messages) > 1000". Like an
+// alerting rule, the dependency is resolved when the query returns a non-empty result, which becomes the data of
+// the dependency event.
+type PrometheusDependency struct {
+	// URL of the Prometheus HTTP API, e.g. "http://prometheus:9090".
+	URL string `json:"url" protobuf:"bytes,1,opt,name=url"`
+	// Query is the PromQL instant query.
+	Query string `json:"query" protobuf:"bytes,2,opt,name=query"`
+	// Interval evaluates the query on a schedule, e.g. "30s", instead of on each evaluation of a trigger, which
+	// then uses the latest result.
+	// +optional
+	Interval string `json:"interval,omitempty" protobuf:"bytes,3,opt,name=interval"`
+	// BasicAuth configuration for the Prometheus HTTP API.
+	// +optional
+	BasicAuth *apicommon.BasicAuth `json:"basicAuth,omitempty" protobuf:"bytes,4,opt,name=basicAuth"`
+	// BearerToken refers to the Kubernetes secret that holds the bearer token of the Prometheus HTTP API.
+	// +optional
+	BearerToken *corev1.SecretKeySelector `json:"bearerToken,omitempty" protobuf:"bytes,5,opt,name=bearerToken"`
+	// TLS configuration for the Prometheus HTTP API.
+	// +optional
+	TLS *apicommon.TLSConfig `json:"tls,omitempty" protobuf:"bytes,6,opt,name=tls"`
+	// Headers are the additional headers of the requests, e.g. "X-Scope-OrgID" for a multi-tenant endpoint.
+	// +optional
+	Headers map[string]string `json:"headers,omitempty" protobuf:"bytes,7,rep,name=headers"`
+	// Timeout of the query in seconds, defaults to 10.
+	// +optional
+	Timeout int64 `json:"timeout,omitempty" protobuf:"varint,8,opt,name=timeout"`
+}
+
+// GetInterval returns the interval of the scheduled evaluations of the query, 0 if the query is evaluated on
+// each evaluation of a trigger.
+func (p PrometheusDependency) GetInterval() time.Duration {
+	if interval, err := time.ParseDuration(p.Interval); err == nil && interval > 0 {
+		return interval
+	}
+	return 0
+}
+
+// PayloadGuards are cheap checks of the size and shape of the event payload, protecting the Sensor from the
+// payloads which would be expensive to filter.
+type PayloadGuards struct {
+	// MaxBytes is the maximum size of the event data in bytes.
+	// +optional
+	MaxBytes int64 `json:"maxBytes,omitempty" protobuf:"varint,1,opt,name=maxBytes"`
+	// MaxDepth is the maximum nesting depth of the objects and arrays of the JSON event data.
+	// +optional
+	MaxDepth int32 `json:"maxDepth,omitempty" protobuf:"varint,2,opt,name=maxDepth"`
+	// MaxArrayLength is the maximum length of the arrays of the JSON event data.
+	// +optional
+	MaxArrayLength int32 `json:"maxArrayLength,omitempty" protobuf:"varint,3,opt,name=maxArrayLength"`
+	// ContentTypes are the allowed content types of the event data, e.g. "application/json", their parameters
+	// such as the charset are ignored. Any content type is allowed if empty.
+	// +optional
+	ContentTypes []string `json:"contentTypes,omitempty" protobuf:"bytes,4,rep,name=contentTypes"`
+}
+
+// DependencyDeliverPolicy is the policy to start consuming the events of a dependency.
+type DependencyDeliverPolicy string
+
+const (
+	// DeliverPolicyLatest starts with the events published after the dependency is deployed.
+	DeliverPolicyLatest DependencyDeliverPolicy = "Latest"
+	// DeliverPolicyEarliest starts with the earliest event retained in the EventBus.
+	DeliverPolicyEarliest DependencyDeliverPolicy = "Earliest"
+	// DeliverPolicyTime starts with the first event published at or after a time.
+	DeliverPolicyTime DependencyDeliverPolicy = "Time"
+)
+
+// DependencyStartPosition describes the position from which a dependency starts consuming the events.
+type DependencyStartPosition struct {
+	// DeliverPolicy is one of "Latest", "Earliest" and "Time", defaults to "Latest".
+	// +optional
+	DeliverPolicy DependencyDeliverPolicy `json:"deliverPolicy,omitempty" protobuf:"bytes,1,opt,name=deliverPolicy,casttype=DependencyDeliverPolicy"`
+	// Time to start with when the deliver policy is "Time", in RFC3339 format, e.g. "2024-01-02T15:04:05Z".
+	// +optional
+	Time *metav1.Time `json:"time,omitempty" protobuf:"bytes,2,opt,name=time"`
+}
+
+// GetDeliverPolicy returns the deliver policy, defaults to "Latest".
+func (p *DependencyStartPosition) GetDeliverPolicy() DependencyDeliverPolicy {
+	if p == nil || p.DeliverPolicy == "" {
+		return DeliverPolicyLatest
+	}
+	return p.DeliverPolicy
 }
 
 // EventDependencyTransformer transforms the event
@@ -343,6 +665,190 @@ type Trigger struct {
 	// loss.
 	// +optional
 	DlqTrigger *Trigger `json:"dlqTrigger,omitempty" protobuf:"bytes,7,opt,name=dlqTrigger"`
+	// Deduplication skips the trigger execution if it has been executed with the same idempotency key within a time window,
+	// to avoid duplicate executions on redelivered events. Only supported with the JetStream EventBus.
+	// +optional
+	Deduplication *TriggerDeduplication `json:"deduplication,omitempty" protobuf:"bytes,8,opt,name=deduplication"`
+	// CircuitBreaker stops executing the trigger after consecutive failures, to stop hammering a failing target.
+	// +optional
+	CircuitBreaker *TriggerCircuitBreaker `json:"circuitBreaker,omitempty" protobuf:"bytes,9,opt,name=circuitBreaker"`
+	// ActiveWindows restricts the trigger executions to time windows, e.g. the business hours.
+	// +optional
+	ActiveWindows *TriggerActiveWindows `json:"activeWindows,omitempty" protobuf:"bytes,10,opt,name=activeWindows"`
+	// Batch accumulates the events satisfying the trigger conditions, and executes the trigger once with all of them.
+	// Only supported with the JetStream EventBus.
+	// +optional
+	Batch *TriggerBatch `json:"batch,omitempty" protobuf:"bytes,11,opt,name=batch"`
+	// Cache skips the trigger execution if an identical request has been executed successfully within the TTL, e.g.
+	// for the event sources re-delivering unchanged state snapshots.
+	// +optional
+	Cache *TriggerCache `json:"cache,omitempty" protobuf:"bytes,12,opt,name=cache"`
+	// FeatureFlag gates the trigger executions on a feature flag, to roll out an automation progressively, e.g. per
+	// tenant, without editing the Sensor.
+	// +optional
+	FeatureFlag *TriggerFeatureFlag `json:"featureFlag,omitempty" protobuf:"bytes,13,opt,name=featureFlag"`
+	// OversizeRoute routes the executions whose events are larger than a threshold to an alternate trigger, e.g. a
+	// Workflow processing the events from an artifact store, instead of an HTTP trigger failing on oversized bodies.
+	// +optional
+	OversizeRoute *TriggerOversizeRoute `json:"oversizeRoute,omitempty" protobuf:"bytes,14,opt,name=oversizeRoute"`
+	// Pool runs the executions of the trigger on its own bounded pool of workers, overriding the triggerPools of
+	// the Sensor.
+	// +optional
+	Pool *TriggerPool `json:"pool,omitempty" protobuf:"bytes,15,opt,name=pool"`
+}
+
+// TriggerOversizeRoute routes the trigger executions whose events are too large to an alternate trigger.
+type TriggerOversizeRoute struct {
+	// MaxSize is the size in bytes above which the executions are routed to the alternate trigger. The size of an
+	// execution is the total size of the data of its events.
+	MaxSize int64 `json:"maxSize" protobuf:"varint,1,opt,name=maxSize"`
+	// Trigger is the alternate trigger, executed with the same events. Its name must differ from the ones of the
+	// other triggers, as its circuit breaker, cache and rate limiter are its own.
+	Trigger *Trigger `json:"trigger" protobuf:"bytes,2,opt,name=trigger"`
+}
+
+// TriggerFeatureFlag describes a boolean feature flag gating the trigger executions, evaluated by an OpenFeature
+// provider serving the OpenFeature Remote Evaluation Protocol (OFREP), e.g. flagd or GO Feature Flag.
+type TriggerFeatureFlag struct {
+	// Key of the flag.
+	Key string `json:"key" protobuf:"bytes,1,opt,name=key"`
+	// URL of the OFREP API of the provider, e.g. "http://flagd:8016".
+	URL string `json:"url" protobuf:"bytes,2,opt,name=url"`
+	// TargetingKey is the targeting key of the evaluation context, extracted from the events, e.g. the tenant.
+	// +optional
+	TargetingKey *TriggerParameterSource `json:"targetingKey,omitempty" protobuf:"bytes,3,opt,name=targetingKey"`
+	// Context is the list of key-value extracted from the events, added to the evaluation context.
+	// +optional
+	Context []TriggerParameter `json:"context,omitempty" protobuf:"bytes,4,rep,name=context"`
+	// DefaultValue is the value of the flag when it can't be evaluated. Defaults to false, the executions are skipped.
+	// +optional
+	DefaultValue bool `json:"defaultValue,omitempty" protobuf:"varint,5,opt,name=defaultValue"`
+	// BearerToken refers to the Kubernetes secret that holds the bearer token of the provider.
+	// +optional
+	BearerToken *corev1.SecretKeySelector `json:"bearerToken,omitempty" protobuf:"bytes,6,opt,name=bearerToken"`
+	// TLS configuration of the provider.
+	// +optional
+	TLS *apicommon.TLSConfig `json:"tls,omitempty" protobuf:"bytes,7,opt,name=tls"`
+	// Timeout of the evaluations in seconds, defaults to 5.
+	// +optional
+	Timeout int64 `json:"timeout,omitempty" protobuf:"varint,8,opt,name=timeout"`
+}
+
+// TriggerCache describes the cache of the successful executions of a trigger. The cache is kept in memory by each
+// Sensor pod.
+type TriggerCache struct {
+	// KeyTemplate is a Go template to render the cache key of an execution. The events are accessible by their
+	// dependency names under `.Input`, each with the `context` and the `data`, e.g. `{{ .Input.dep1.data.state }}`.
+	// Defaults to the hash of the trigger resource and of its payload, rendered with their parameters.
+	// +optional
+	KeyTemplate string `json:"keyTemplate,omitempty" protobuf:"bytes,1,opt,name=keyTemplate"`
+	// TTL is the duration to keep the successful executions, e.g. "10m".
+	TTL string `json:"ttl" protobuf:"bytes,2,opt,name=ttl"`
+}
+
+// GetTTL returns the duration to keep the successful executions, 0 if the TTL is invalid.
+func (c TriggerCache) GetTTL() time.Duration {
+	if ttl, err := time.ParseDuration(c.TTL); err == nil && ttl > 0 {
+		return ttl
+	}
+	return 0
+}
+
+// TriggerBatch describes when to flush the batch of a trigger, at least one of MaxEvents and Window is required.
+// The data of each dependency event is the JSON array of the data of the batched events.
+type TriggerBatch struct {
+	// MaxEvents is the number of times the trigger conditions are satisfied flushing the batch.
+	// +optional
+	MaxEvents int32 `json:"maxEvents,omitempty" protobuf:"varint,1,opt,name=maxEvents"`
+	// Window is the maximum duration to accumulate the events from the first one of the batch, e.g. "5m".
+	// +optional
+	Window string `json:"window,omitempty" protobuf:"bytes,2,opt,name=window"`
+}
+
+// GetWindow returns the batch window, 0 if the batch is only flushed by its number of events.
+func (b TriggerBatch) GetWindow() time.Duration {
+	if w, err := time.ParseDuration(b.Window); err == nil && w > 0 {
+		return w
+	}
+	return 0
+}
+
+// TriggerOutsideWindowsPolicy is the policy for the trigger executions outside the active windows.
+type TriggerOutsideWindowsPolicy string
+
+const (
+	// TriggerOutsideWindowsDrop drops the executions outside the active windows.
+	TriggerOutsideWindowsDrop TriggerOutsideWindowsPolicy = "Drop"
+	// TriggerOutsideWindowsDefer holds the executions outside the active windows, and releases them when the next
+	// window opens.
+	TriggerOutsideWindowsDefer TriggerOutsideWindowsPolicy = "Defer"
+)
+
+// TriggerActiveWindows describes the time windows a trigger is executed in.
+type TriggerActiveWindows struct {
+	// Windows are the time windows the trigger is executed in, a trigger is active if any of them is open.
+	Windows []TriggerActiveWindow `json:"windows" protobuf:"bytes,1,rep,name=windows"`
+	// Timezone of the windows, e.g. "America/New_York". Defaults to UTC.
+	// +optional
+	Timezone string `json:"timezone,omitempty" protobuf:"bytes,2,opt,name=timezone"`
+	// OutsideWindows is the policy for the executions outside the windows, "Drop" or "Defer". Defaults to "Drop".
+	// +optional
+	OutsideWindows TriggerOutsideWindowsPolicy `json:"outsideWindows,omitempty" protobuf:"bytes,3,opt,name=outsideWindows,casttype=TriggerOutsideWindowsPolicy"`
+}
+
+// TriggerActiveWindow describes a recurring time window.
+type TriggerActiveWindow struct {
+	// Start is a cron expression of the times the window opens, e.g. "0 9 * * 1-5".
+	Start string `json:"start" protobuf:"bytes,1,opt,name=start"`
+	// Duration is how long the window stays open, e.g. "8h".
+	Duration string `json:"duration" protobuf:"bytes,2,opt,name=duration"`
+}
+
+// GetOutsideWindows returns the policy for the executions outside the windows, defaults to Drop.
+func (w TriggerActiveWindows) GetOutsideWindows() TriggerOutsideWindowsPolicy {
+	if w.OutsideWindows == "" {
+		return TriggerOutsideWindowsDrop
+	}
+	return w.OutsideWindows
+}
+
+// TriggerCircuitBreaker describes the circuit breaker of a trigger. The circuit opens after a number of consecutive
+// failed executions, retries included, and the trigger executions then fail immediately. Once the open duration
+// elapsed, one trial execution is allowed: the circuit is closed if it succeeds, and opened again otherwise.
+type TriggerCircuitBreaker struct {
+	// ConsecutiveFailures is the number of consecutive failed executions opening the circuit.
+	ConsecutiveFailures int32 `json:"consecutiveFailures" protobuf:"varint,1,opt,name=consecutiveFailures"`
+	// OpenDuration is how long the circuit stays open before a trial execution is allowed, e.g. "30s". Defaults to "1m".
+	// +optional
+	OpenDuration string `json:"openDuration,omitempty" protobuf:"bytes,2,opt,name=openDuration"`
+}
+
+// GetOpenDuration returns how long the circuit stays open, defaults to 1 minute.
+func (c TriggerCircuitBreaker) GetOpenDuration() time.Duration {
+	if d, err := time.ParseDuration(c.OpenDuration); err == nil && d > 0 {
+		return d
+	}
+	return time.Minute
+}
+
+// TriggerDeduplication describes how to deduplicate the trigger executions.
+type TriggerDeduplication struct {
+	// KeyTemplate is a Go template to render the idempotency key of an execution. The events are accessible by
+	// their dependency names under `.Input`, each with the `context` and the `data`, e.g. `{{ .Input.dep1.data.id }}`.
+	// Defaults to the IDs of the events triggering the execution.
+	// +optional
+	KeyTemplate string `json:"keyTemplate,omitempty" protobuf:"bytes,1,opt,name=keyTemplate"`
+	// Window is the duration to keep the idempotency keys, e.g. "10m". Defaults to "1h".
+	// +optional
+	Window string `json:"window,omitempty" protobuf:"bytes,2,opt,name=window"`
+}
+
+// GetWindow returns the deduplication window, defaults to 1 hour.
+func (d TriggerDeduplication) GetWindow() time.Duration {
+	if w, err := time.ParseDuration(d.Window); err == nil && w > 0 {
+		return w
+	}
+	return time.Hour
 }
 
 type RateLimiteUnit string
@@ -412,6 +918,30 @@ type TriggerTemplate struct {
 	// Email refers to the trigger designed to send an email notification
 	// +optional
 	Email *EmailTrigger `json:"email,omitempty" protobuf:"bytes,17,opt,name=email"`
+	// Prometheus refers to the trigger designed to push metric samples to Prometheus
+	// +optional
+	Prometheus *PrometheusTrigger `json:"prometheus,omitempty" protobuf:"bytes,18,opt,name=prometheus"`
+	// Loki refers to the trigger designed to append records to Loki
+	// +optional
+	Loki *LokiTrigger `json:"loki,omitempty" protobuf:"bytes,19,opt,name=loki"`
+	// Elasticsearch refers to the trigger designed to index records in Elasticsearch or OpenSearch
+	// +optional
+	Elasticsearch *ElasticsearchTrigger `json:"elasticsearch,omitempty" protobuf:"bytes,20,opt,name=elasticsearch"`
+	// Jenkins refers to the trigger designed to start Jenkins jobs
+	// +optional
+	Jenkins *JenkinsTrigger `json:"jenkins,omitempty" protobuf:"bytes,21,opt,name=jenkins"`
+	// GithubWorkflow refers to the trigger designed to dispatch GitHub Actions workflows
+	// +optional
+	GithubWorkflow *GithubWorkflowTrigger `json:"githubWorkflow,omitempty" protobuf:"bytes,22,opt,name=githubWorkflow"`
+	// AWSSQS refers to the trigger designed to send messages to AWS SQS queues
+	// +optional
+	AWSSQS *AWSSQSTrigger `json:"awsSQS,omitempty" protobuf:"bytes,23,opt,name=awsSQS"`
+	// AWSSNS refers to the trigger designed to publish messages to AWS SNS topics
+	// +optional
+	AWSSNS *AWSSNSTrigger `json:"awsSNS,omitempty" protobuf:"bytes,24,opt,name=awsSNS"`
+	// AzureQueueStorage refers to the trigger designed to enqueue messages on Azure Queue Storage
+	// +optional
+	AzureQueueStorage *AzureQueueStorageTrigger `json:"azureQueueStorage,omitempty" protobuf:"bytes,25,opt,name=azureQueueStorage"`
 }
 
 type ConditionsResetCriteria struct {
@@ -467,6 +997,75 @@ type ArgoWorkflowTrigger struct {
 	Parameters []TriggerParameter `json:"parameters,omitempty" protobuf:"bytes,3,rep,name=parameters"`
 	// Args is the list of arguments to pass to the argo CLI
 	Args []string `json:"args,omitempty" protobuf:"bytes,4,rep,name=args"`
+	// LabelSelector selects the existing workflows to run the operation on, e.g. "approval-id=1234", instead of the
+	// workflow named in the source. The source is optional when it is set. It can be parameterized with the trigger
+	// parameters, using "argoWorkflow.labelSelector" as the dest. Not applicable to the submit and submit-from operations.
+	// +optional
+	LabelSelector string `json:"labelSelector,omitempty" protobuf:"bytes,5,opt,name=labelSelector"`
+	// Artifacts are the input artifacts of the submitted workflow, materialized from the events and set in the
+	// spec.arguments.artifacts of the workflow. Only applicable to the submit operation.
+	// +optional
+	Artifacts []ArgoWorkflowArtifact `json:"artifacts,omitempty" protobuf:"bytes,6,rep,name=artifacts"`
+}
+
+// ArgoWorkflowArtifact is an input artifact of a submitted workflow, materialized from the events either as a raw
+// artifact or as a reference to an S3 object.
+type ArgoWorkflowArtifact struct {
+	// Name of the input artifact.
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Raw materializes the artifact as a raw artifact, whose content is resolved from the events like the value of
+	// a parameter, e.g. a field of the event payload.
+	// +optional
+	Raw *TriggerParameterSource `json:"raw,omitempty" protobuf:"bytes,2,opt,name=raw"`
+	// S3 materializes the artifact as a reference to an S3 object, e.g. the claim-check object of an event whose
+	// data was offloaded by the ClaimCheck oversize policy of its EventSource.
+	// +optional
+	S3 *ArgoWorkflowS3Artifact `json:"s3,omitempty" protobuf:"bytes,3,opt,name=s3"`
+}
+
+// ArgoWorkflowS3Artifact is a reference to an S3 object, whose location is resolved from the data of an event.
+type ArgoWorkflowS3Artifact struct {
+	// DependencyName refers to the name of the dependency whose event holds the location of the object.
+	DependencyName string `json:"dependencyName" protobuf:"bytes,1,opt,name=dependencyName"`
+	// BucketKey is the JSON path of the bucket of the object in the event data.
+	// Default value: "claimCheck.bucket".
+	// +optional
+	BucketKey string `json:"bucketKey,omitempty" protobuf:"bytes,2,opt,name=bucketKey"`
+	// KeyKey is the JSON path of the key of the object in the event data.
+	// Default value: "claimCheck.key".
+	// +optional
+	KeyKey string `json:"keyKey,omitempty" protobuf:"bytes,3,opt,name=keyKey"`
+	// Endpoint of the S3 server. Defaults to the "claimCheck.endpoint" of the event data.
+	// +optional
+	Endpoint string `json:"endpoint,omitempty" protobuf:"bytes,4,opt,name=endpoint"`
+	// Region of the bucket.
+	// +optional
+	Region string `json:"region,omitempty" protobuf:"bytes,5,opt,name=region"`
+	// Insecure disables the TLS of the connection to the S3 server.
+	// +optional
+	Insecure bool `json:"insecure,omitempty" protobuf:"varint,6,opt,name=insecure"`
+	// AccessKeySecret is the secret selector of the access key, in the namespace of the workflow.
+	// +optional
+	AccessKeySecret *corev1.SecretKeySelector `json:"accessKeySecret,omitempty" protobuf:"bytes,7,opt,name=accessKeySecret"`
+	// SecretKeySecret is the secret selector of the secret key, in the namespace of the workflow.
+	// +optional
+	SecretKeySecret *corev1.SecretKeySelector `json:"secretKeySecret,omitempty" protobuf:"bytes,8,opt,name=secretKeySecret"`
+}
+
+// GetBucketKey returns the JSON path of the bucket of the object in the event data
+func (a *ArgoWorkflowS3Artifact) GetBucketKey() string {
+	if a.BucketKey == "" {
+		return "claimCheck.bucket"
+	}
+	return a.BucketKey
+}
+
+// GetKeyKey returns the JSON path of the key of the object in the event data
+func (a *ArgoWorkflowS3Artifact) GetKeyKey() string {
+	if a.KeyKey == "" {
+		return "claimCheck.key"
+	}
+	return a.KeyKey
 }
 
 // HTTPTrigger is the trigger for the HTTP request
@@ -500,6 +1099,48 @@ type HTTPTrigger struct {
 	// Secure Headers stored in Kubernetes Secrets for the HTTP requests.
 	// +optional
 	SecureHeaders []*apicommon.SecureHeader `json:"secureHeaders,omitempty" protobuf:"bytes,9,rep,name=secureHeaders"`
+	// ContentMode is how the event is delivered in the request, either Raw (default), Structured, Binary or Wrapped.
+	// +optional
+	ContentMode HTTPContentMode `json:"contentMode,omitempty" protobuf:"bytes,10,opt,name=contentMode,casttype=HTTPContentMode"`
+	// Wrapper configures the JSON object wrapping the payload with the Wrapped content mode.
+	// +optional
+	Wrapper *HTTPPayloadWrapper `json:"wrapper,omitempty" protobuf:"bytes,11,opt,name=wrapper"`
+}
+
+// HTTPContentMode is how the event is delivered in the HTTP request.
+type HTTPContentMode string
+
+const (
+	// HTTPContentModeRaw sends the payload as the request body
+	HTTPContentModeRaw HTTPContentMode = "Raw"
+	// HTTPContentModeStructured sends a structured mode CloudEvent, with the attributes and the data in the JSON body
+	HTTPContentModeStructured HTTPContentMode = "Structured"
+	// HTTPContentModeBinary sends a binary mode CloudEvent, with the attributes in the ce-* headers and the data in the body
+	HTTPContentModeBinary HTTPContentMode = "Binary"
+	// HTTPContentModeWrapped sends the payload wrapped in a JSON object
+	HTTPContentModeWrapped HTTPContentMode = "Wrapped"
+)
+
+// HTTPPayloadWrapper configures the JSON object wrapping the payload of the HTTP trigger.
+type HTTPPayloadWrapper struct {
+	// DataKey is the key of the payload in the wrapper object. Defaults to "data".
+	// +optional
+	DataKey string `json:"dataKey,omitempty" protobuf:"bytes,1,opt,name=dataKey"`
+	// ContextKey is the key of the contexts of the events in the wrapper object, keyed by dependency name.
+	// The contexts are omitted if not specified.
+	// +optional
+	ContextKey string `json:"contextKey,omitempty" protobuf:"bytes,2,opt,name=contextKey"`
+	// Fields are static fields added to the wrapper object.
+	// +optional
+	Fields map[string]string `json:"fields,omitempty" protobuf:"bytes,3,rep,name=fields"`
+}
+
+// GetDataKey returns the key of the payload in the wrapper object
+func (w *HTTPPayloadWrapper) GetDataKey() string {
+	if w == nil || w.DataKey == "" {
+		return "data"
+	}
+	return w.DataKey
 }
 
 // AWSLambdaTrigger refers to specification of the trigger to invoke an AWS Lambda function
@@ -539,15 +1180,101 @@ type AWSLambdaTrigger struct {
 	RoleARN string `json:"roleARN,omitempty" protobuf:"bytes,8,opt,name=roleARN"`
 }
 
+// AWSSQSTrigger refers to specification of the trigger to send messages to an AWS SQS queue
+type AWSSQSTrigger struct {
+	// Queue is the name of the queue to send the messages to.
+	Queue string `json:"queue" protobuf:"bytes,1,opt,name=queue"`
+	// QueueAccountID is the ID of the account that created the queue, if it is not the account of the credentials.
+	// +optional
+	QueueAccountID string `json:"queueAccountId,omitempty" protobuf:"bytes,2,opt,name=queueAccountId"`
+	// Region is AWS region
+	Region string `json:"region" protobuf:"bytes,3,opt,name=region"`
+	// AccessKey refers K8s secret containing aws access key
+	// +optional
+	AccessKey *corev1.SecretKeySelector `json:"accessKey,omitempty" protobuf:"bytes,4,opt,name=accessKey"`
+	// SecretKey refers K8s secret containing aws secret key
+	// +optional
+	SecretKey *corev1.SecretKeySelector `json:"secretKey,omitempty" protobuf:"bytes,5,opt,name=secretKey"`
+	// RoleARN is the Amazon Resource Name (ARN) of the role to assume.
+	// +optional
+	RoleARN string `json:"roleARN,omitempty" protobuf:"bytes,6,opt,name=roleARN"`
+	// Endpoint configures connection to a specific SQS endpoint instead of Amazons servers
+	// +optional
+	Endpoint string `json:"endpoint,omitempty" protobuf:"bytes,7,opt,name=endpoint"`
+	// Payload is the list of key-value extracted from an event payload to construct the message body.
+	Payload []TriggerParameter `json:"payload" protobuf:"bytes,8,rep,name=payload"`
+	// Parameters is the list of key-value extracted from event's payload that are applied to
+	// the trigger resource, e.g. to set the message attributes or the message group ID from the event.
+	// +optional
+	Parameters []TriggerParameter `json:"parameters,omitempty" protobuf:"bytes,9,rep,name=parameters"`
+	// MessageAttributes are the string attributes of the messages.
+	// +optional
+	MessageAttributes map[string]string `json:"messageAttributes,omitempty" protobuf:"bytes,10,rep,name=messageAttributes"`
+	// MessageGroupID is the message group ID of the messages, required by FIFO queues.
+	// +optional
+	MessageGroupID string `json:"messageGroupId,omitempty" protobuf:"bytes,11,opt,name=messageGroupId"`
+	// MessageDeduplicationID is the deduplication ID of the messages sent to FIFO queues. It defaults to the
+	// idempotency key of the execution, so that the redeliveries of the same events are deduplicated.
+	// +optional
+	MessageDeduplicationID string `json:"messageDeduplicationId,omitempty" protobuf:"bytes,12,opt,name=messageDeduplicationId"`
+	// DelaySeconds is the number of seconds the delivery of the messages is delayed, up to 900.
+	// It is not supported by FIFO queues.
+	// +optional
+	DelaySeconds int64 `json:"delaySeconds,omitempty" protobuf:"varint,13,opt,name=delaySeconds"`
+}
+
+// AWSSNSTrigger refers to specification of the trigger to publish messages to an AWS SNS topic
+type AWSSNSTrigger struct {
+	// TopicArn is the ARN of the topic to publish the messages to.
+	TopicArn string `json:"topicArn" protobuf:"bytes,1,opt,name=topicArn"`
+	// Region is AWS region
+	Region string `json:"region" protobuf:"bytes,2,opt,name=region"`
+	// AccessKey refers K8s secret containing aws access key
+	// +optional
+	AccessKey *corev1.SecretKeySelector `json:"accessKey,omitempty" protobuf:"bytes,3,opt,name=accessKey"`
+	// SecretKey refers K8s secret containing aws secret key
+	// +optional
+	SecretKey *corev1.SecretKeySelector `json:"secretKey,omitempty" protobuf:"bytes,4,opt,name=secretKey"`
+	// RoleARN is the Amazon Resource Name (ARN) of the role to assume.
+	// +optional
+	RoleARN string `json:"roleARN,omitempty" protobuf:"bytes,5,opt,name=roleARN"`
+	// Endpoint configures connection to a specific SNS endpoint instead of Amazons servers
+	// +optional
+	Endpoint string `json:"endpoint,omitempty" protobuf:"bytes,6,opt,name=endpoint"`
+	// Payload is the list of key-value extracted from an event payload to construct the message.
+	Payload []TriggerParameter `json:"payload" protobuf:"bytes,7,rep,name=payload"`
+	// Parameters is the list of key-value extracted from event's payload that are applied to
+	// the trigger resource, e.g. to set the message attributes or the message group ID from the event.
+	// +optional
+	Parameters []TriggerParameter `json:"parameters,omitempty" protobuf:"bytes,8,rep,name=parameters"`
+	// Subject is the subject of the messages, used by the email subscriptions.
+	// +optional
+	Subject string `json:"subject,omitempty" protobuf:"bytes,9,opt,name=subject"`
+	// MessageAttributes are the string attributes of the messages.
+	// +optional
+	MessageAttributes map[string]string `json:"messageAttributes,omitempty" protobuf:"bytes,10,rep,name=messageAttributes"`
+	// MessageGroupID is the message group ID of the messages, required by FIFO topics.
+	// +optional
+	MessageGroupID string `json:"messageGroupId,omitempty" protobuf:"bytes,11,opt,name=messageGroupId"`
+	// MessageDeduplicationID is the deduplication ID of the messages published to FIFO topics. It defaults to
+	// the idempotency key of the execution, so that the redeliveries of the same events are deduplicated.
+	// +optional
+	MessageDeduplicationID string `json:"messageDeduplicationId,omitempty" protobuf:"bytes,12,opt,name=messageDeduplicationId"`
+}
+
 // AzureEventHubsTrigger refers to specification of the Azure Event Hubs Trigger
 type AzureEventHubsTrigger struct {
 	// FQDN refers to the namespace dns of Azure Event Hubs to be used i.e. <namespace>.servicebus.windows.net
 	FQDN string `json:"fqdn" protobuf:"bytes,1,opt,name=fqdn"`
 	// HubName refers to the Azure Event Hub to send events to
 	HubName string `json:"hubName" protobuf:"bytes,2,opt,name=hubName"`
-	// SharedAccessKeyName refers to the name of the Shared Access Key
-	SharedAccessKeyName *corev1.SecretKeySelector `json:"sharedAccessKeyName" protobuf:"bytes,3,opt,name=sharedAccessKeyName"`
+	// SharedAccessKeyName refers to the name of the Shared Access Key. If neither the name nor the key of the
+	// Shared Access Key is provided, it will try to access via Azure AD with DefaultAzureCredential, e.g. with
+	// workload identity.
+	// +optional
+	SharedAccessKeyName *corev1.SecretKeySelector `json:"sharedAccessKeyName,omitempty" protobuf:"bytes,3,opt,name=sharedAccessKeyName"`
 	// SharedAccessKey refers to a K8s secret containing the primary key for the
+	// +optional
 	SharedAccessKey *corev1.SecretKeySelector `json:"sharedAccessKey,omitempty" protobuf:"bytes,4,opt,name=sharedAccessKey"`
 	// Payload is the list of key-value extracted from an event payload to construct the request payload.
 	Payload []TriggerParameter `json:"payload" protobuf:"bytes,5,rep,name=payload"`
@@ -558,7 +1285,9 @@ type AzureEventHubsTrigger struct {
 }
 
 type AzureServiceBusTrigger struct {
-	// ConnectionString is the connection string for the Azure Service Bus
+	// ConnectionString is the connection string for the Azure Service Bus. If this fields is not provided
+	// it will try to access via Azure AD with DefaultAzureCredential and FullyQualifiedNamespace.
+	// +optional
 	ConnectionString *corev1.SecretKeySelector `json:"connectionString,omitempty" protobuf:"bytes,1,opt,name=connectionString"`
 	// QueueName is the name of the Azure Service Bus Queue
 	QueueName string `json:"queueName" protobuf:"bytes,2,opt,name=queueName"`
@@ -575,6 +1304,42 @@ type AzureServiceBusTrigger struct {
 	// the trigger resource.
 	// +optional
 	Parameters []TriggerParameter `json:"parameters,omitempty" protobuf:"bytes,7,rep,name=parameters"`
+	// FullyQualifiedNamespace is the Service Bus namespace name (ex: myservicebus.servicebus.windows.net). This field is necessary to
+	// access via Azure AD (managed identity) and it is ignored if ConnectionString is set.
+	// +optional
+	FullyQualifiedNamespace string `json:"fullyQualifiedNamespace,omitempty" protobuf:"bytes,8,opt,name=fullyQualifiedNamespace"`
+}
+
+// AzureQueueStorageTrigger refers to the specification of the Azure Queue Storage trigger.
+type AzureQueueStorageTrigger struct {
+	// StorageAccountName is the name of the storage account where the queue is. This field is necessary to
+	// access via Azure AD (managed identity) and it is ignored if ConnectionString is set.
+	// +optional
+	StorageAccountName string `json:"storageAccountName,omitempty" protobuf:"bytes,1,opt,name=storageAccountName"`
+	// ConnectionString is the connection string to access Azure Queue Storage. If this fields is not provided
+	// it will try to access via Azure AD with StorageAccountName.
+	// +optional
+	ConnectionString *corev1.SecretKeySelector `json:"connectionString,omitempty" protobuf:"bytes,2,opt,name=connectionString"`
+	// QueueName is the name of the queue
+	QueueName string `json:"queueName" protobuf:"bytes,3,opt,name=queueName"`
+	// Payload is the list of key-value extracted from an event payload to construct the message.
+	Payload []TriggerParameter `json:"payload" protobuf:"bytes,4,rep,name=payload"`
+	// Parameters is the list of key-value extracted from event's payload that are applied to
+	// the trigger resource.
+	// +optional
+	Parameters []TriggerParameter `json:"parameters,omitempty" protobuf:"bytes,5,rep,name=parameters"`
+	// EncodeMessage specifies if the messages should be base64 encoded, as expected by the consumers
+	// decoding them, e.g. Azure Functions.
+	// +optional
+	EncodeMessage bool `json:"encodeMessage,omitempty" protobuf:"varint,6,opt,name=encodeMessage"`
+	// VisibilityTimeoutInSeconds is the duration (in seconds) for which the messages are hidden after they
+	// are enqueued, to delay their processing. The default value is 0.
+	// +optional
+	VisibilityTimeoutInSeconds *int32 `json:"visibilityTimeoutInSeconds,omitempty" protobuf:"varint,7,opt,name=visibilityTimeoutInSeconds"`
+	// TimeToLiveInSeconds is the duration (in seconds) the messages are kept in the queue, -1 to never expire them.
+	// The default value is 7 days.
+	// +optional
+	TimeToLiveInSeconds *int32 `json:"timeToLiveInSeconds,omitempty" protobuf:"varint,8,opt,name=timeToLiveInSeconds"`
 }
 
 // KafkaTrigger refers to the specification of the Kafka trigger.
@@ -616,7 +1381,7 @@ type KafkaTrigger struct {
 	// SASL configuration for the kafka client
 	// +optional
 	SASL *apicommon.SASLConfig `json:"sasl,omitempty" protobuf:"bytes,12,opt,name=sasl"`
-	// Schema Registry configuration to producer message with avro format
+	// Schema Registry configuration to produce messages serialized with an Avro or Protobuf schema
 	// +optional
 	SchemaRegistry *apicommon.SchemaRegistryConfig `json:"schemaRegistry,omitempty" protobuf:"bytes,13,opt,name=schemaRegistry"`
 }
@@ -730,6 +1495,207 @@ type EmailTrigger struct {
 	Body string `json:"body,omitempty" protobuf:"bytes,9,opt,name=body"`
 }
 
+// PrometheusTrigger refers to the specification of the trigger converting the events into metric samples,
+// pushed with the Prometheus remote-write protocol or to a Prometheus Pushgateway.
+type PrometheusTrigger struct {
+	// Parameters is the list of key-value extracted from event's payload that are applied to
+	// the trigger resource.
+	// +optional
+	Parameters []TriggerParameter `json:"parameters,omitempty" protobuf:"bytes,1,rep,name=parameters"`
+	// RemoteWrite pushes the samples to a Prometheus remote-write endpoint.
+	// +optional
+	RemoteWrite *PrometheusRemoteWrite `json:"remoteWrite,omitempty" protobuf:"bytes,2,opt,name=remoteWrite"`
+	// Pushgateway pushes the samples to a Prometheus Pushgateway.
+	// +optional
+	Pushgateway *PrometheusPushgateway `json:"pushgateway,omitempty" protobuf:"bytes,3,opt,name=pushgateway"`
+	// Metrics are the metric samples to push, their values and labels are usually set with the parameters.
+	Metrics []PrometheusMetric `json:"metrics" protobuf:"bytes,4,rep,name=metrics"`
+	// Timeout of the push in seconds, defaults to 10.
+	// +optional
+	Timeout int64 `json:"timeout,omitempty" protobuf:"varint,5,opt,name=timeout"`
+}
+
+// PrometheusRemoteWrite is a Prometheus remote-write endpoint.
+type PrometheusRemoteWrite struct {
+	// URL of the remote-write endpoint, e.g. "http://prometheus:9090/api/v1/write".
+	URL string `json:"url" protobuf:"bytes,1,opt,name=url"`
+	// BasicAuth configuration for the endpoint.
+	// +optional
+	BasicAuth *apicommon.BasicAuth `json:"basicAuth,omitempty" protobuf:"bytes,2,opt,name=basicAuth"`
+	// BearerToken refers to the Kubernetes secret that holds the bearer token of the endpoint.
+	// +optional
+	BearerToken *corev1.SecretKeySelector `json:"bearerToken,omitempty" protobuf:"bytes,3,opt,name=bearerToken"`
+	// TLS configuration for the endpoint.
+	// +optional
+	TLS *apicommon.TLSConfig `json:"tls,omitempty" protobuf:"bytes,4,opt,name=tls"`
+	// Headers are the additional headers of the requests, e.g. "X-Scope-OrgID" for a multi-tenant endpoint.
+	// +optional
+	Headers map[string]string `json:"headers,omitempty" protobuf:"bytes,5,rep,name=headers"`
+}
+
+// PrometheusPushgateway is a Prometheus Pushgateway.
+type PrometheusPushgateway struct {
+	// URL of the Pushgateway, e.g. "http://pushgateway:9091".
+	URL string `json:"url" protobuf:"bytes,1,opt,name=url"`
+	// Job is the job label of the pushed group of metrics.
+	Job string `json:"job" protobuf:"bytes,2,opt,name=job"`
+	// Grouping labels of the pushed group of metrics, in addition to the job.
+	// +optional
+	Grouping map[string]string `json:"grouping,omitempty" protobuf:"bytes,3,rep,name=grouping"`
+	// BasicAuth configuration for the Pushgateway.
+	// +optional
+	BasicAuth *apicommon.BasicAuth `json:"basicAuth,omitempty" protobuf:"bytes,4,opt,name=basicAuth"`
+	// TLS configuration for the Pushgateway.
+	// +optional
+	TLS *apicommon.TLSConfig `json:"tls,omitempty" protobuf:"bytes,5,opt,name=tls"`
+}
+
+// PrometheusMetric is a metric sample pushed by the Prometheus trigger.
+type PrometheusMetric struct {
+	// Name of the metric.
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Help of the metric, only used with the Pushgateway.
+	// +optional
+	Help string `json:"help,omitempty" protobuf:"bytes,2,opt,name=help"`
+	// Value of the sample, a float number.
+	Value string `json:"value" protobuf:"bytes,3,opt,name=value"`
+	// Labels of the sample.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty" protobuf:"bytes,4,rep,name=labels"`
+}
+
+// LokiTrigger refers to the specification of the trigger appending records built from the events to a Loki
+// stream, with the push API.
+type LokiTrigger struct {
+	// URL of Loki, e.g. "http://loki:3100".
+	URL string `json:"url" protobuf:"bytes,1,opt,name=url"`
+	// Labels of the stream the records are appended to.
+	Labels map[string]string `json:"labels" protobuf:"bytes,2,rep,name=labels"`
+	// Payload is the list of key-value extracted from an event payload to construct the record, appended as a
+	// JSON log line.
+	Payload []TriggerParameter `json:"payload" protobuf:"bytes,3,rep,name=payload"`
+	// Parameters is the list of key-value extracted from event's payload that are applied to
+	// the trigger resource.
+	// +optional
+	Parameters []TriggerParameter `json:"parameters,omitempty" protobuf:"bytes,4,rep,name=parameters"`
+	// TenantID is the tenant of a multi-tenant Loki, sent in the X-Scope-OrgID header.
+	// +optional
+	TenantID string `json:"tenantID,omitempty" protobuf:"bytes,5,opt,name=tenantID"`
+	// BasicAuth configuration for Loki.
+	// +optional
+	BasicAuth *apicommon.BasicAuth `json:"basicAuth,omitempty" protobuf:"bytes,6,opt,name=basicAuth"`
+	// BearerToken refers to the Kubernetes secret that holds the bearer token for Loki.
+	// +optional
+	BearerToken *corev1.SecretKeySelector `json:"bearerToken,omitempty" protobuf:"bytes,7,opt,name=bearerToken"`
+	// TLS configuration for Loki.
+	// +optional
+	TLS *apicommon.TLSConfig `json:"tls,omitempty" protobuf:"bytes,8,opt,name=tls"`
+	// Timeout of the requests in seconds, defaults to 10.
+	// +optional
+	Timeout int64 `json:"timeout,omitempty" protobuf:"varint,9,opt,name=timeout"`
+}
+
+// ElasticsearchTrigger refers to the specification of the trigger indexing records built from the events in
+// an Elasticsearch or OpenSearch index.
+type ElasticsearchTrigger struct {
+	// URL of the cluster, e.g. "https://elasticsearch:9200".
+	URL string `json:"url" protobuf:"bytes,1,opt,name=url"`
+	// Index the records are indexed in, or data stream they are appended to.
+	Index string `json:"index" protobuf:"bytes,2,opt,name=index"`
+	// Payload is the list of key-value extracted from an event payload to construct the record.
+	Payload []TriggerParameter `json:"payload" protobuf:"bytes,3,rep,name=payload"`
+	// Parameters is the list of key-value extracted from event's payload that are applied to
+	// the trigger resource.
+	// +optional
+	Parameters []TriggerParameter `json:"parameters,omitempty" protobuf:"bytes,4,rep,name=parameters"`
+	// TimestampField is the field of the record set to the time of the trigger, e.g. "@timestamp" for a data
+	// stream. The field is not set if empty.
+	// +optional
+	TimestampField string `json:"timestampField,omitempty" protobuf:"bytes,5,opt,name=timestampField"`
+	// BasicAuth configuration for the cluster.
+	// +optional
+	BasicAuth *apicommon.BasicAuth `json:"basicAuth,omitempty" protobuf:"bytes,6,opt,name=basicAuth"`
+	// APIKey refers to the Kubernetes secret that holds the encoded API key of the cluster.
+	// +optional
+	APIKey *corev1.SecretKeySelector `json:"apiKey,omitempty" protobuf:"bytes,7,opt,name=apiKey"`
+	// TLS configuration for the cluster.
+	// +optional
+	TLS *apicommon.TLSConfig `json:"tls,omitempty" protobuf:"bytes,8,opt,name=tls"`
+	// Timeout of the requests in seconds, defaults to 10.
+	// +optional
+	Timeout int64 `json:"timeout,omitempty" protobuf:"varint,9,opt,name=timeout"`
+}
+
+// JenkinsTrigger refers to the specification of the trigger starting a Jenkins job with the remote access API.
+type JenkinsTrigger struct {
+	// URL of Jenkins, e.g. "https://jenkins.example.com".
+	URL string `json:"url" protobuf:"bytes,1,opt,name=url"`
+	// Job is the full name of the job, e.g. "folder/job".
+	Job string `json:"job" protobuf:"bytes,2,opt,name=job"`
+	// BuildParameters are the parameters of the build, the job is started without parameters if empty.
+	// +optional
+	BuildParameters map[string]string `json:"buildParameters,omitempty" protobuf:"bytes,3,rep,name=buildParameters"`
+	// Parameters is the list of key-value extracted from event's payload that are applied to
+	// the trigger resource.
+	// +optional
+	Parameters []TriggerParameter `json:"parameters,omitempty" protobuf:"bytes,4,rep,name=parameters"`
+	// BasicAuth holds the username and the API token, or the password, of the Jenkins user.
+	// A crumb is requested when the crumb issuer of Jenkins is enabled.
+	// +optional
+	BasicAuth *apicommon.BasicAuth `json:"basicAuth,omitempty" protobuf:"bytes,5,opt,name=basicAuth"`
+	// Token refers to the K8s secret that holds the authentication token of the job, configured with the
+	// "Trigger builds remotely" option of the job.
+	// +optional
+	Token *corev1.SecretKeySelector `json:"token,omitempty" protobuf:"bytes,6,opt,name=token"`
+	// TLS configuration for Jenkins.
+	// +optional
+	TLS *apicommon.TLSConfig `json:"tls,omitempty" protobuf:"bytes,7,opt,name=tls"`
+	// Timeout of the requests in seconds, defaults to 10.
+	// +optional
+	Timeout int64 `json:"timeout,omitempty" protobuf:"varint,8,opt,name=timeout"`
+}
+
+// GithubWorkflowTrigger refers to the specification of the trigger dispatching a GitHub Actions workflow
+// with the workflow_dispatch event.
+type GithubWorkflowTrigger struct {
+	// Owner of the repository.
+	Owner string `json:"owner" protobuf:"bytes,1,opt,name=owner"`
+	// Repository holding the workflow.
+	Repository string `json:"repository" protobuf:"bytes,2,opt,name=repository"`
+	// Workflow is the file name, e.g. "release.yaml", or the ID of the workflow.
+	// The workflow must be configured with the workflow_dispatch event.
+	Workflow string `json:"workflow" protobuf:"bytes,3,opt,name=workflow"`
+	// Ref is the branch or the tag the workflow runs on.
+	Ref string `json:"ref" protobuf:"bytes,4,opt,name=ref"`
+	// Inputs of the workflow, the workflow is dispatched without inputs if empty.
+	// +optional
+	Inputs map[string]string `json:"inputs,omitempty" protobuf:"bytes,5,rep,name=inputs"`
+	// Parameters is the list of key-value extracted from event's payload that are applied to
+	// the trigger resource.
+	// +optional
+	Parameters []TriggerParameter `json:"parameters,omitempty" protobuf:"bytes,6,rep,name=parameters"`
+	// APIToken refers to a K8s secret containing a GitHub personal access token with the permission to
+	// write the actions of the repository.
+	// +optional
+	APIToken *corev1.SecretKeySelector `json:"apiToken,omitempty" protobuf:"bytes,7,opt,name=apiToken"`
+	// GithubApp holds the credentials of a GitHub App installed on the repository.
+	// +optional
+	GithubApp *GithubAppCreds `json:"githubApp,omitempty" protobuf:"bytes,8,opt,name=githubApp"`
+	// GitHub base URL (for GitHub Enterprise)
+	// +optional
+	GithubBaseURL string `json:"githubBaseURL,omitempty" protobuf:"bytes,9,opt,name=githubBaseURL"`
+}
+
+// GithubAppCreds holds the credentials of a GitHub App
+type GithubAppCreds struct {
+	// PrivateKey refers to a K8s secret containing the GitHub app private key
+	PrivateKey *corev1.SecretKeySelector `json:"privateKey" protobuf:"bytes,1,opt,name=privateKey"`
+	// AppID refers to the GitHub App ID for the application you created
+	AppID int64 `json:"appID" protobuf:"varint,2,opt,name=appID"`
+	// InstallationID refers to the Installation ID of the GitHub app you created and installed
+	InstallationID int64 `json:"installationID" protobuf:"varint,3,opt,name=installationID"`
+}
+
 // SlackTrigger refers to the specification of the slack notification trigger.
 type SlackTrigger struct {
 	// Parameters is the list of key-value extracted from event's payload that are applied to
@@ -824,8 +1790,17 @@ const (
 	TriggerParameterOpOverwrite TriggerParameterOperation = "overwrite"
 	// TriggerParameterOpPrepend means prepend the new value to the existing
 	TriggerParameterOpPrepend TriggerParameterOperation = "prepend"
+	// TriggerParameterOpJSONPatch means apply the new value, a JSON Patch (RFC 6902) document, to the existing
+	TriggerParameterOpJSONPatch TriggerParameterOperation = "jsonPatch"
+	// TriggerParameterOpMergePatch means apply the new value, a JSON Merge Patch (RFC 7386) document, to the existing
+	TriggerParameterOpMergePatch TriggerParameterOperation = "mergePatch"
 )
 
+// IsPatch returns true if the operation applies a patch document to the destination.
+func (op TriggerParameterOperation) IsPatch() bool {
+	return op == TriggerParameterOpJSONPatch || op == TriggerParameterOpMergePatch
+}
+
 // TriggerParameter indicates a passed parameter to a service template
 type TriggerParameter struct {
 	// Src contains a source reference to the value of the parameter from a dependency
@@ -834,9 +1809,12 @@ type TriggerParameter struct {
 	// A path is a series of keys separated by a dot. The colon character can be escaped with '.'
 	// The -1 key can be used to append a value to an existing array.
 	// See https://github.com/tidwall/sjson#path-syntax for more information about how this is used.
+	// With the 'jsonPatch' and 'mergePatch' operations, an empty Dest patches the whole resource.
 	Dest string `json:"dest" protobuf:"bytes,2,opt,name=dest"`
 	// Operation is what to do with the existing value at Dest, whether to
-	// 'prepend', 'overwrite', or 'append' it.
+	// 'prepend', 'overwrite', or 'append' it, or to patch it with the new value, a
+	// JSON Patch (RFC 6902) document with 'jsonPatch', or a JSON Merge Patch (RFC 7386)
+	// document with 'mergePatch'.
 	Operation TriggerParameterOperation `json:"operation,omitempty" protobuf:"bytes,3,opt,name=operation,casttype=TriggerParameterOperation"`
 }
 
@@ -912,9 +1890,291 @@ func (in *StatusPolicy) GetAllow() []int {
 	return statuses
 }
 
+// DataSchemaValidation configures the validation of the event data against the JSON Schema referred to
+// by the CloudEvents "dataschema" attribute.
+type DataSchemaValidation struct {
+	// AllowedURLs are the prefixes of the schema URLs allowed to be fetched, e.g. "https://schemas.example.com/".
+	// The events referring to any other schema are discarded.
+	AllowedURLs []string `json:"allowedURLs" protobuf:"bytes,1,rep,name=allowedURLs"`
+	// CacheTTL is how long a fetched schema is cached, e.g. "10m". Defaults to 1h.
+	// +optional
+	CacheTTL string `json:"cacheTTL,omitempty" protobuf:"bytes,2,opt,name=cacheTTL"`
+	// Required discards the events without a "dataschema" attribute.
+	// +optional
+	Required bool `json:"required,omitempty" protobuf:"varint,3,opt,name=required"`
+}
+
+const defaultDataSchemaCacheTTL = time.Hour
+
+// GetCacheTTL returns how long a fetched schema is cached.
+func (v DataSchemaValidation) GetCacheTTL() time.Duration {
+	if v.CacheTTL == "" {
+		return defaultDataSchemaCacheTTL
+	}
+	d, err := time.ParseDuration(v.CacheTTL)
+	if err != nil || d <= 0 {
+		return defaultDataSchemaCacheTTL
+	}
+	return d
+}
+
+// SensorDistributionMode is how the trigger load is distributed across the replicas of a Sensor.
+type SensorDistributionMode string
+
+const (
+	// SensorDistributionLeaderElection runs the triggers on the elected leader replica, the other replicas stand by.
+	SensorDistributionLeaderElection SensorDistributionMode = "LeaderElection"
+	// SensorDistributionShared runs the triggers on all the replicas, each of them consuming a partition of the
+	// dependencies.
+	SensorDistributionShared SensorDistributionMode = "Shared"
+)
+
+// SensorDistribution configures how the trigger load is distributed across the replicas of a Sensor.
+type SensorDistribution struct {
+	// Mode is either LeaderElection (default), or Shared, where all the replicas run the triggers without a
+	// leader election, each one consuming its partition of the dependencies. Shared requires a JetStream or
+	// Kafka EventBus. With JetStream, each replica claims one of the spec.replicas partitions, and the
+	// dependencies of a trigger are spread across them, unless its conditions require several events or it
+	// batches its executions, which keeps them together on a single replica.
+	// +optional
+	Mode SensorDistributionMode `json:"mode,omitempty" protobuf:"bytes,1,opt,name=mode,casttype=SensorDistributionMode"`
+}
+
+// TriggerStatusVerbosity is the verbosity of the trigger executions reported by the Sensor pods.
+type TriggerStatusVerbosity string
+
+const (
+	// TriggerStatusSummary aggregates the executions in the status, with the failing triggers
+	TriggerStatusSummary TriggerStatusVerbosity = "Summary"
+	// TriggerStatusDetailed also keeps the recent executions of each trigger in a ConfigMap
+	TriggerStatusDetailed TriggerStatusVerbosity = "Detailed"
+)
+
+const (
+	// DefaultMaxFailingTriggers is the default number of failing triggers listed in the status
+	DefaultMaxFailingTriggers = 10
+	// DefaultTriggerExecutionTTL is the default time the executions are kept in the execution store
+	DefaultTriggerExecutionTTL = 24 * time.Hour
+)
+
+// TriggerStatusReporting configures the report of the trigger executions by the Sensor pods. The status
+// aggregates the executions of all the triggers, and lists the failing ones only, so that its size does not
+// grow with the number of triggers.
+type TriggerStatusReporting struct {
+	// Verbosity is either Summary (default), or Detailed, which also keeps the recent executions of each
+	// trigger in a ConfigMap named in the status. The pods need the permission to get, create and update
+	// ConfigMaps for the Detailed verbosity.
+	// +optional
+	Verbosity TriggerStatusVerbosity `json:"verbosity,omitempty" protobuf:"bytes,1,opt,name=verbosity,casttype=TriggerStatusVerbosity"`
+	// MaxFailingTriggers is the maximum number of failing triggers listed in the status, the others are only
+	// counted. Defaults to 10.
+	// +optional
+	MaxFailingTriggers int32 `json:"maxFailingTriggers,omitempty" protobuf:"varint,2,opt,name=maxFailingTriggers"`
+	// ExecutionTTL is how long the executions are kept in the ConfigMap with the Detailed verbosity, e.g. "6h".
+	// Defaults to 24h.
+	// +optional
+	ExecutionTTL string `json:"executionTTL,omitempty" protobuf:"bytes,3,opt,name=executionTTL"`
+}
+
+// GetVerbosity returns the verbosity of the report
+func (r *TriggerStatusReporting) GetVerbosity() TriggerStatusVerbosity {
+	if r.Verbosity == "" {
+		return TriggerStatusSummary
+	}
+	return r.Verbosity
+}
+
+// GetMaxFailingTriggers returns the maximum number of failing triggers listed in the status
+func (r *TriggerStatusReporting) GetMaxFailingTriggers() int {
+	if r.MaxFailingTriggers <= 0 {
+		return DefaultMaxFailingTriggers
+	}
+	return int(r.MaxFailingTriggers)
+}
+
+// GetExecutionTTL returns how long the executions are kept in the execution store
+func (r *TriggerStatusReporting) GetExecutionTTL() time.Duration {
+	if d, err := time.ParseDuration(r.ExecutionTTL); err == nil && d > 0 {
+		return d
+	}
+	return DefaultTriggerExecutionTTL
+}
+
+// QuotaOverflowPolicy is the policy for the trigger executions exceeding the quota of a Sensor.
+type QuotaOverflowPolicy string
+
+const (
+	// QuotaOverflowDrop drops the executions exceeding the quota.
+	QuotaOverflowDrop QuotaOverflowPolicy = "Drop"
+	// QuotaOverflowQueue holds the executions exceeding the quota, and releases them as the quota frees up.
+	QuotaOverflowQueue QuotaOverflowPolicy = "Queue"
+	// QuotaOverflowAlert executes the triggers anyway, the exceeded quota is only reported.
+	QuotaOverflowAlert QuotaOverflowPolicy = "Alert"
+)
+
+// SensorExecutionQuota limits the trigger executions of a Sensor over the last hour and the last day, at least
+// one of MaxTriggersPerHour and MaxTriggersPerDay is required. The executions of all the triggers are counted
+// together, by each Sensor pod.
+type SensorExecutionQuota struct {
+	// MaxTriggersPerHour is the maximum number of trigger executions over the last hour.
+	// +optional
+	MaxTriggersPerHour int32 `json:"maxTriggersPerHour,omitempty" protobuf:"varint,1,opt,name=maxTriggersPerHour"`
+	// MaxTriggersPerDay is the maximum number of trigger executions over the last 24 hours.
+	// +optional
+	MaxTriggersPerDay int32 `json:"maxTriggersPerDay,omitempty" protobuf:"varint,2,opt,name=maxTriggersPerDay"`
+	// Overflow is the policy for the executions exceeding the quota, "Drop", "Queue" or "Alert". Defaults to "Drop".
+	// +optional
+	Overflow QuotaOverflowPolicy `json:"overflow,omitempty" protobuf:"bytes,3,opt,name=overflow,casttype=QuotaOverflowPolicy"`
+}
+
+// GetOverflow returns the policy for the executions exceeding the quota, defaults to Drop.
+func (q SensorExecutionQuota) GetOverflow() QuotaOverflowPolicy {
+	if q.Overflow == "" {
+		return QuotaOverflowDrop
+	}
+	return q.Overflow
+}
+
+// SensorRollout configures how the spec changes of a Sensor are rolled out.
+type SensorRollout struct {
+	// Canary starts the new revision alongside the current one, consuming the events with a shadow consumer and
+	// running the triggers in dry-run mode. After the bake duration, the new revision is promoted if the error rate
+	// of the dry-run trigger executions is acceptable, otherwise it is rolled back.
+	// +optional
+	Canary *CanaryRollout `json:"canary,omitempty" protobuf:"bytes,1,opt,name=canary"`
+}
+
+// GetCanary returns the canary rollout configuration, if any.
+func (r *SensorRollout) GetCanary() *CanaryRollout {
+	if r == nil {
+		return nil
+	}
+	return r.Canary
+}
+
+// CanaryRollout is the configuration of a canary rollout.
+type CanaryRollout struct {
+	// BakeDuration is how long the canary runs before being evaluated, e.g. "10m". Defaults to 5m.
+	// +optional
+	BakeDuration string `json:"bakeDuration,omitempty" protobuf:"bytes,1,opt,name=bakeDuration"`
+	// MaxErrorRate is the maximum ratio, between 0 and 1, of failed dry-run trigger executions for the canary to be promoted.
+	// Defaults to 0.
+	// +optional
+	MaxErrorRate *apicommon.Amount `json:"maxErrorRate,omitempty" protobuf:"bytes,2,opt,name=maxErrorRate"`
+	// MinExecutions is the minimum number of dry-run trigger executions for the canary to be promoted.
+	// Defaults to 0, which promotes a canary that has not received any event.
+	// +optional
+	MinExecutions int32 `json:"minExecutions,omitempty" protobuf:"varint,3,opt,name=minExecutions"`
+}
+
+const defaultCanaryBakeDuration = 5 * time.Minute
+
+// GetBakeDuration returns the bake duration of the canary.
+func (c CanaryRollout) GetBakeDuration() time.Duration {
+	if c.BakeDuration == "" {
+		return defaultCanaryBakeDuration
+	}
+	d, err := time.ParseDuration(c.BakeDuration)
+	if err != nil || d <= 0 {
+		return defaultCanaryBakeDuration
+	}
+	return d
+}
+
+// GetMaxErrorRate returns the maximum error rate of the canary.
+func (c CanaryRollout) GetMaxErrorRate() float64 {
+	if c.MaxErrorRate == nil {
+		return 0
+	}
+	rate, err := c.MaxErrorRate.Float64()
+	if err != nil {
+		return 0
+	}
+	return rate
+}
+
+// CanaryPhase is the phase of a canary rollout.
+type CanaryPhase string
+
+const (
+	// CanaryPhaseBaking means the canary is running in dry-run mode.
+	CanaryPhaseBaking CanaryPhase = "Baking"
+	// CanaryPhasePromoted means the canary revision has replaced the previous one.
+	CanaryPhasePromoted CanaryPhase = "Promoted"
+	// CanaryPhaseRolledBack means the canary has been removed and the previous revision kept, until the spec changes again.
+	CanaryPhaseRolledBack CanaryPhase = "RolledBack"
+)
+
+// CanaryStatus is the status of the last canary rollout.
+type CanaryStatus struct {
+	// Revision is the hash of the Deployment spec rolled out by the canary.
+	Revision string `json:"revision" protobuf:"bytes,1,opt,name=revision"`
+	// Phase of the canary rollout.
+	Phase CanaryPhase `json:"phase" protobuf:"bytes,2,opt,name=phase,casttype=CanaryPhase"`
+	// StartedAt is the time the canary started baking.
+	StartedAt metav1.Time `json:"startedAt,omitempty" protobuf:"bytes,3,opt,name=startedAt"`
+	// Executions is the number of dry-run trigger executions observed when the canary was evaluated.
+	// +optional
+	Executions int64 `json:"executions,omitempty" protobuf:"varint,4,opt,name=executions"`
+	// Failures is the number of failed dry-run trigger executions observed when the canary was evaluated.
+	// +optional
+	Failures int64 `json:"failures,omitempty" protobuf:"varint,5,opt,name=failures"`
+	// Message explains the outcome of the canary rollout.
+	// +optional
+	Message string `json:"message,omitempty" protobuf:"bytes,6,opt,name=message"`
+}
+
 // SensorStatus contains information about the status of a sensor.
 type SensorStatus struct {
 	apicommon.Status `json:",inline" protobuf:"bytes,1,opt,name=status"`
+	// Canary is the status of the last canary rollout, if any.
+	// +optional
+	Canary *CanaryStatus `json:"canary,omitempty" protobuf:"bytes,2,opt,name=canary"`
+	// Triggers is the report of the trigger executions, if enabled.
+	// +optional
+	Triggers *TriggersStatus `json:"triggers,omitempty" protobuf:"bytes,3,opt,name=triggers"`
+	// Retries are the operations being retried by the Sensor pods, e.g. the trigger executions.
+	// +optional
+	Retries []apicommon.RetryStatus `json:"retries,omitempty" protobuf:"bytes,4,rep,name=retries"`
+}
+
+// TriggersStatus aggregates the executions of the triggers of a Sensor
+type TriggersStatus struct {
+	// Executions is the number of trigger executions.
+	// +optional
+	Executions int64 `json:"executions,omitempty" protobuf:"varint,1,opt,name=executions"`
+	// Failures is the number of failed trigger executions.
+	// +optional
+	Failures int64 `json:"failures,omitempty" protobuf:"varint,2,opt,name=failures"`
+	// LastExecutionTime is the time of the last trigger execution.
+	// +optional
+	LastExecutionTime *metav1.Time `json:"lastExecutionTime,omitempty" protobuf:"bytes,3,opt,name=lastExecutionTime"`
+	// Failing lists the triggers whose last execution failed, the longest failing first, up to the
+	// maxFailingTriggers of the report.
+	// +optional
+	Failing []FailingTriggerStatus `json:"failing,omitempty" protobuf:"bytes,4,rep,name=failing"`
+	// OmittedFailing is the number of failing triggers not listed.
+	// +optional
+	OmittedFailing int32 `json:"omittedFailing,omitempty" protobuf:"varint,5,opt,name=omittedFailing"`
+	// ExecutionStore is the name of the ConfigMap holding the recent executions of each trigger, with the
+	// Detailed verbosity.
+	// +optional
+	ExecutionStore string `json:"executionStore,omitempty" protobuf:"bytes,6,opt,name=executionStore"`
+}
+
+// FailingTriggerStatus describes a trigger whose last execution failed
+type FailingTriggerStatus struct {
+	// Name of the trigger.
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Since is the time of the first failed execution since the last successful one.
+	Since metav1.Time `json:"since" protobuf:"bytes,2,opt,name=since"`
+	// LastExecutionTime is the time of the last execution.
+	LastExecutionTime metav1.Time `json:"lastExecutionTime" protobuf:"bytes,3,opt,name=lastExecutionTime"`
+	// ConsecutiveFailures is the number of failed executions since the last successful one.
+	ConsecutiveFailures int32 `json:"consecutiveFailures" protobuf:"varint,4,opt,name=consecutiveFailures"`
+	// LastError is the error of the last execution, truncated.
+	// +optional
+	LastError string `json:"lastError,omitempty" protobuf:"bytes,5,opt,name=lastError"`
 }
 
 const (
@@ -927,11 +2187,23 @@ const (
 	// SensorConditionDeployed has the status True when the Sensor
 	// has its Deployment created.
 	SensorConditionDeployed apicommon.ConditionType = "Deployed"
+	// SensorConditionCircuitBreakersClosed has the status True when the
+	// circuit breakers of the triggers are closed. It is only set by the
+	// Sensor pods when a trigger has a circuit breaker.
+	SensorConditionCircuitBreakersClosed apicommon.ConditionType = "CircuitBreakersClosed"
+	// SensorConditionWithinQuota has the status True when the trigger
+	// executions are within the quota of the Sensor. It is only set by
+	// the Sensor pods when the Sensor has a quota.
+	SensorConditionWithinQuota apicommon.ConditionType = "WithinQuota"
+	// SensorConditionDependenciesReady has the status True when the
+	// EventBus of the Sensor is deployed, and the EventSources of its
+	// dependencies are ready.
+	SensorConditionDependenciesReady apicommon.ConditionType = "DependenciesReady"
 )
 
 // InitConditions sets conditions to Unknown state.
 func (s *SensorStatus) InitConditions() {
-	s.InitializeConditions(SensorConditionDepencencyProvided, SensorConditionTriggersProvided, SensorConditionDeployed)
+	s.InitializeConditions(SensorConditionDepencencyProvided, SensorConditionTriggersProvided, SensorConditionDeployed, SensorConditionDependenciesReady)
 }
 
 // MarkDependenciesProvided set the sensor has valid dependencies provided.
@@ -964,6 +2236,36 @@ func (s *SensorStatus) MarkDeployFailed(reason, message string) {
 	s.MarkFalse(SensorConditionDeployed, reason, message)
 }
 
+// MarkDependenciesReady set the eventbus and the eventsources of the sensor are ready.
+func (s *SensorStatus) MarkDependenciesReady() {
+	s.MarkTrue(SensorConditionDependenciesReady)
+}
+
+// MarkWaitingForDependencies set the sensor is waiting for its eventbus or eventsources.
+func (s *SensorStatus) MarkWaitingForDependencies(reason, message string) {
+	s.MarkFalse(SensorConditionDependenciesReady, reason, message)
+}
+
+// MarkCircuitBreakersClosed set the circuit breakers of the triggers are closed.
+func (s *SensorStatus) MarkCircuitBreakersClosed() {
+	s.MarkTrue(SensorConditionCircuitBreakersClosed)
+}
+
+// MarkCircuitBreakersOpen set the circuit breakers of some triggers are open.
+func (s *SensorStatus) MarkCircuitBreakersOpen(reason, message string) {
+	s.MarkFalse(SensorConditionCircuitBreakersClosed, reason, message)
+}
+
+// MarkWithinQuota set the trigger executions are within the quota of the sensor.
+func (s *SensorStatus) MarkWithinQuota() {
+	s.MarkTrue(SensorConditionWithinQuota)
+}
+
+// MarkQuotaExceeded set the trigger executions exceed the quota of the sensor.
+func (s *SensorStatus) MarkQuotaExceeded(reason, message string) {
+	s.MarkFalse(SensorConditionWithinQuota, reason, message)
+}
+
 // ArtifactLocation describes the source location for an external artifact
 type ArtifactLocation struct {
 	// S3 compliant artifact
@@ -1095,6 +2397,9 @@ type EventContext struct {
 	Subject string `json:"subject" protobuf:"bytes,6,opt,name=subject"`
 	// Time - A Timestamp when the event happened.
 	Time metav1.Time `json:"time" protobuf:"bytes,7,opt,name=time"`
+	// Extensions - The CloudEvent extensions of the event, e.g. the ones mapped from the headers of the Kafka records.
+	// +optional
+	Extensions map[string]string `json:"extensions,omitempty" protobuf:"bytes,8,rep,name=extensions"`
 }
 
 func (e EventContext) String() string {
@@ -2,6 +2,7 @@ package v1alpha1
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -21,6 +22,31 @@ func TestGetReplicas(t *testing.T) {
 	assert.Equal(t, sp.GetReplicas(), int32(2))
 }
 
+func TestGetDrainTimeout(t *testing.T) {
+	sp := SensorSpec{}
+	assert.Equal(t, time.Duration(0), sp.GetDrainTimeout())
+	sp.DrainTimeout = "xyz"
+	assert.Equal(t, time.Duration(0), sp.GetDrainTimeout())
+	sp.DrainTimeout = "45s"
+	assert.Equal(t, 45*time.Second, sp.GetDrainTimeout())
+}
+
+func TestTriggerDeduplicationGetWindow(t *testing.T) {
+	d := TriggerDeduplication{}
+	assert.Equal(t, time.Hour, d.GetWindow())
+	d.Window = "10m"
+	assert.Equal(t, 10*time.Minute, d.GetWindow())
+}
+
+func TestGetPool(t *testing.T) {
+	sp := SensorSpec{}
+	assert.Nil(t, sp.GetPool(Trigger{}))
+	assert.Equal(t, &TriggerPool{Workers: 1, QueueSize: 100}, sp.GetPool(Trigger{Pool: &TriggerPool{Workers: 1}}))
+	sp.TriggerPools = &TriggerPools{QueueSize: 10}
+	assert.Equal(t, &TriggerPool{Workers: 4, QueueSize: 10}, sp.GetPool(Trigger{}))
+	assert.Equal(t, &TriggerPool{Workers: 8, QueueSize: 10}, sp.GetPool(Trigger{Pool: &TriggerPool{Workers: 8}}))
+}
+
 func convertInt(t *testing.T, num int) *int32 {
 	t.Helper()
 	r := int32(num)
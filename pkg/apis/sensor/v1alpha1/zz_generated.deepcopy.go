@@ -24,6 +24,7 @@ package v1alpha1
 import (
 	common "github.com/argoproj/argo-events/pkg/apis/common"
 	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -72,6 +73,152 @@ func (in *AWSLambdaTrigger) DeepCopy() *AWSLambdaTrigger {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSSNSTrigger) DeepCopyInto(out *AWSSNSTrigger) {
+	*out = *in
+	if in.AccessKey != nil {
+		in, out := &in.AccessKey, &out.AccessKey
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SecretKey != nil {
+		in, out := &in.SecretKey, &out.SecretKey
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Payload != nil {
+		in, out := &in.Payload, &out.Payload
+		*out = make([]TriggerParameter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = make([]TriggerParameter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.MessageAttributes != nil {
+		in, out := &in.MessageAttributes, &out.MessageAttributes
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSSNSTrigger.
+func (in *AWSSNSTrigger) DeepCopy() *AWSSNSTrigger {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSSNSTrigger)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSSQSTrigger) DeepCopyInto(out *AWSSQSTrigger) {
+	*out = *in
+	if in.AccessKey != nil {
+		in, out := &in.AccessKey, &out.AccessKey
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SecretKey != nil {
+		in, out := &in.SecretKey, &out.SecretKey
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Payload != nil {
+		in, out := &in.Payload, &out.Payload
+		*out = make([]TriggerParameter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = make([]TriggerParameter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.MessageAttributes != nil {
+		in, out := &in.MessageAttributes, &out.MessageAttributes
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSSQSTrigger.
+func (in *AWSSQSTrigger) DeepCopy() *AWSSQSTrigger {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSSQSTrigger)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArgoWorkflowArtifact) DeepCopyInto(out *ArgoWorkflowArtifact) {
+	*out = *in
+	if in.Raw != nil {
+		in, out := &in.Raw, &out.Raw
+		*out = new(TriggerParameterSource)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.S3 != nil {
+		in, out := &in.S3, &out.S3
+		*out = new(ArgoWorkflowS3Artifact)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArgoWorkflowArtifact.
+func (in *ArgoWorkflowArtifact) DeepCopy() *ArgoWorkflowArtifact {
+	if in == nil {
+		return nil
+	}
+	out := new(ArgoWorkflowArtifact)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArgoWorkflowS3Artifact) DeepCopyInto(out *ArgoWorkflowS3Artifact) {
+	*out = *in
+	if in.AccessKeySecret != nil {
+		in, out := &in.AccessKeySecret, &out.AccessKeySecret
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SecretKeySecret != nil {
+		in, out := &in.SecretKeySecret, &out.SecretKeySecret
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArgoWorkflowS3Artifact.
+func (in *ArgoWorkflowS3Artifact) DeepCopy() *ArgoWorkflowS3Artifact {
+	if in == nil {
+		return nil
+	}
+	out := new(ArgoWorkflowS3Artifact)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ArgoWorkflowTrigger) DeepCopyInto(out *ArgoWorkflowTrigger) {
 	*out = *in
@@ -92,6 +239,13 @@ func (in *ArgoWorkflowTrigger) DeepCopyInto(out *ArgoWorkflowTrigger) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.Artifacts != nil {
+		in, out := &in.Artifacts, &out.Artifacts
+		*out = make([]ArgoWorkflowArtifact, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -196,6 +350,51 @@ func (in *AzureEventHubsTrigger) DeepCopy() *AzureEventHubsTrigger {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureQueueStorageTrigger) DeepCopyInto(out *AzureQueueStorageTrigger) {
+	*out = *in
+	if in.ConnectionString != nil {
+		in, out := &in.ConnectionString, &out.ConnectionString
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Payload != nil {
+		in, out := &in.Payload, &out.Payload
+		*out = make([]TriggerParameter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = make([]TriggerParameter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.VisibilityTimeoutInSeconds != nil {
+		in, out := &in.VisibilityTimeoutInSeconds, &out.VisibilityTimeoutInSeconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.TimeToLiveInSeconds != nil {
+		in, out := &in.TimeToLiveInSeconds, &out.TimeToLiveInSeconds
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureQueueStorageTrigger.
+func (in *AzureQueueStorageTrigger) DeepCopy() *AzureQueueStorageTrigger {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureQueueStorageTrigger)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AzureServiceBusTrigger) DeepCopyInto(out *AzureServiceBusTrigger) {
 	*out = *in
@@ -236,6 +435,127 @@ func (in *AzureServiceBusTrigger) DeepCopy() *AzureServiceBusTrigger {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CanaryRollout) DeepCopyInto(out *CanaryRollout) {
+	*out = *in
+	if in.MaxErrorRate != nil {
+		in, out := &in.MaxErrorRate, &out.MaxErrorRate
+		*out = new(common.Amount)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CanaryRollout.
+func (in *CanaryRollout) DeepCopy() *CanaryRollout {
+	if in == nil {
+		return nil
+	}
+	out := new(CanaryRollout)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CanaryStatus) DeepCopyInto(out *CanaryStatus) {
+	*out = *in
+	in.StartedAt.DeepCopyInto(&out.StartedAt)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CanaryStatus.
+func (in *CanaryStatus) DeepCopy() *CanaryStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CanaryStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterSensor) DeepCopyInto(out *ClusterSensor) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterSensor.
+func (in *ClusterSensor) DeepCopy() *ClusterSensor {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterSensor)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterSensor) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterSensorList) DeepCopyInto(out *ClusterSensorList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ClusterSensor, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterSensorList.
+func (in *ClusterSensorList) DeepCopy() *ClusterSensorList {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterSensorList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterSensorList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterSensorSpec) DeepCopyInto(out *ClusterSensorSpec) {
+	*out = *in
+	if in.NamespaceSelector != nil {
+		in, out := &in.NamespaceSelector, &out.NamespaceSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	in.Sensor.DeepCopyInto(&out.Sensor)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterSensorSpec.
+func (in *ClusterSensorSpec) DeepCopy() *ClusterSensorSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterSensorSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ConditionsResetByTime) DeepCopyInto(out *ConditionsResetByTime) {
 	*out = *in
@@ -337,13 +657,99 @@ func (in *DataFilter) DeepCopy() *DataFilter {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *EmailTrigger) DeepCopyInto(out *EmailTrigger) {
+func (in *DataSchemaValidation) DeepCopyInto(out *DataSchemaValidation) {
 	*out = *in
-	if in.Parameters != nil {
-		in, out := &in.Parameters, &out.Parameters
-		*out = make([]TriggerParameter, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
+	if in.AllowedURLs != nil {
+		in, out := &in.AllowedURLs, &out.AllowedURLs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataSchemaValidation.
+func (in *DataSchemaValidation) DeepCopy() *DataSchemaValidation {
+	if in == nil {
+		return nil
+	}
+	out := new(DataSchemaValidation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DependencyStartPosition) DeepCopyInto(out *DependencyStartPosition) {
+	*out = *in
+	if in.Time != nil {
+		in, out := &in.Time, &out.Time
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DependencyStartPosition.
+func (in *DependencyStartPosition) DeepCopy() *DependencyStartPosition {
+	if in == nil {
+		return nil
+	}
+	out := new(DependencyStartPosition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ElasticsearchTrigger) DeepCopyInto(out *ElasticsearchTrigger) {
+	*out = *in
+	if in.Payload != nil {
+		in, out := &in.Payload, &out.Payload
+		*out = make([]TriggerParameter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = make([]TriggerParameter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.BasicAuth != nil {
+		in, out := &in.BasicAuth, &out.BasicAuth
+		*out = new(common.BasicAuth)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.APIKey != nil {
+		in, out := &in.APIKey, &out.APIKey
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(common.TLSConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ElasticsearchTrigger.
+func (in *ElasticsearchTrigger) DeepCopy() *ElasticsearchTrigger {
+	if in == nil {
+		return nil
+	}
+	out := new(ElasticsearchTrigger)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EmailTrigger) DeepCopyInto(out *EmailTrigger) {
+	*out = *in
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = make([]TriggerParameter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 	if in.SMTPPassword != nil {
@@ -395,10 +801,38 @@ func (in *Event) DeepCopy() *Event {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EventCapture) DeepCopyInto(out *EventCapture) {
+	*out = *in
+	if in.S3 != nil {
+		in, out := &in.S3, &out.S3
+		*out = new(common.S3Artifact)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EventCapture.
+func (in *EventCapture) DeepCopy() *EventCapture {
+	if in == nil {
+		return nil
+	}
+	out := new(EventCapture)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EventContext) DeepCopyInto(out *EventContext) {
 	*out = *in
 	in.Time.DeepCopyInto(&out.Time)
+	if in.Extensions != nil {
+		in, out := &in.Extensions, &out.Extensions
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 	return
 }
 
@@ -425,6 +859,21 @@ func (in *EventDependency) DeepCopyInto(out *EventDependency) {
 		*out = new(EventDependencyTransformer)
 		**out = **in
 	}
+	if in.StartPosition != nil {
+		in, out := &in.StartPosition, &out.StartPosition
+		*out = new(DependencyStartPosition)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Guards != nil {
+		in, out := &in.Guards, &out.Guards
+		*out = new(PayloadGuards)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Prometheus != nil {
+		in, out := &in.Prometheus, &out.Prometheus
+		*out = new(PrometheusDependency)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -515,6 +964,24 @@ func (in *ExprFilter) DeepCopy() *ExprFilter {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FailingTriggerStatus) DeepCopyInto(out *FailingTriggerStatus) {
+	*out = *in
+	in.Since.DeepCopyInto(&out.Since)
+	in.LastExecutionTime.DeepCopyInto(&out.LastExecutionTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FailingTriggerStatus.
+func (in *FailingTriggerStatus) DeepCopy() *FailingTriggerStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(FailingTriggerStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *FileArtifact) DeepCopyInto(out *FileArtifact) {
 	*out = *in
@@ -557,85 +1024,521 @@ func (in *GitArtifact) DeepCopy() *GitArtifact {
 	if in == nil {
 		return nil
 	}
-	out := new(GitArtifact)
+	out := new(GitArtifact)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GitCreds) DeepCopyInto(out *GitCreds) {
+	*out = *in
+	if in.Username != nil {
+		in, out := &in.Username, &out.Username
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Password != nil {
+		in, out := &in.Password, &out.Password
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitCreds.
+func (in *GitCreds) DeepCopy() *GitCreds {
+	if in == nil {
+		return nil
+	}
+	out := new(GitCreds)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GitRemoteConfig) DeepCopyInto(out *GitRemoteConfig) {
+	*out = *in
+	if in.URLS != nil {
+		in, out := &in.URLS, &out.URLS
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitRemoteConfig.
+func (in *GitRemoteConfig) DeepCopy() *GitRemoteConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(GitRemoteConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GithubAppCreds) DeepCopyInto(out *GithubAppCreds) {
+	*out = *in
+	if in.PrivateKey != nil {
+		in, out := &in.PrivateKey, &out.PrivateKey
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GithubAppCreds.
+func (in *GithubAppCreds) DeepCopy() *GithubAppCreds {
+	if in == nil {
+		return nil
+	}
+	out := new(GithubAppCreds)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GithubWorkflowTrigger) DeepCopyInto(out *GithubWorkflowTrigger) {
+	*out = *in
+	if in.Inputs != nil {
+		in, out := &in.Inputs, &out.Inputs
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = make([]TriggerParameter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.APIToken != nil {
+		in, out := &in.APIToken, &out.APIToken
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GithubApp != nil {
+		in, out := &in.GithubApp, &out.GithubApp
+		*out = new(GithubAppCreds)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GithubWorkflowTrigger.
+func (in *GithubWorkflowTrigger) DeepCopy() *GithubWorkflowTrigger {
+	if in == nil {
+		return nil
+	}
+	out := new(GithubWorkflowTrigger)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPPayloadWrapper) DeepCopyInto(out *HTTPPayloadWrapper) {
+	*out = *in
+	if in.Fields != nil {
+		in, out := &in.Fields, &out.Fields
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPPayloadWrapper.
+func (in *HTTPPayloadWrapper) DeepCopy() *HTTPPayloadWrapper {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPPayloadWrapper)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPTrigger) DeepCopyInto(out *HTTPTrigger) {
+	*out = *in
+	if in.Payload != nil {
+		in, out := &in.Payload, &out.Payload
+		*out = make([]TriggerParameter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(common.TLSConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = make([]TriggerParameter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.BasicAuth != nil {
+		in, out := &in.BasicAuth, &out.BasicAuth
+		*out = new(common.BasicAuth)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.SecureHeaders != nil {
+		in, out := &in.SecureHeaders, &out.SecureHeaders
+		*out = make([]*common.SecureHeader, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(common.SecureHeader)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.Wrapper != nil {
+		in, out := &in.Wrapper, &out.Wrapper
+		*out = new(HTTPPayloadWrapper)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPTrigger.
+func (in *HTTPTrigger) DeepCopy() *HTTPTrigger {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPTrigger)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JenkinsTrigger) DeepCopyInto(out *JenkinsTrigger) {
+	*out = *in
+	if in.BuildParameters != nil {
+		in, out := &in.BuildParameters, &out.BuildParameters
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = make([]TriggerParameter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.BasicAuth != nil {
+		in, out := &in.BasicAuth, &out.BasicAuth
+		*out = new(common.BasicAuth)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Token != nil {
+		in, out := &in.Token, &out.Token
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(common.TLSConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JenkinsTrigger.
+func (in *JenkinsTrigger) DeepCopy() *JenkinsTrigger {
+	if in == nil {
+		return nil
+	}
+	out := new(JenkinsTrigger)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *K8SResourcePolicy) DeepCopyInto(out *K8SResourcePolicy) {
+	*out = *in
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Backoff != nil {
+		in, out := &in.Backoff, &out.Backoff
+		*out = new(common.Backoff)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new K8SResourcePolicy.
+func (in *K8SResourcePolicy) DeepCopy() *K8SResourcePolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(K8SResourcePolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaTrigger) DeepCopyInto(out *KafkaTrigger) {
+	*out = *in
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = make([]TriggerParameter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(common.TLSConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Payload != nil {
+		in, out := &in.Payload, &out.Payload
+		*out = make([]TriggerParameter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.PartitioningKey != nil {
+		in, out := &in.PartitioningKey, &out.PartitioningKey
+		*out = new(string)
+		**out = **in
+	}
+	if in.SASL != nil {
+		in, out := &in.SASL, &out.SASL
+		*out = new(common.SASLConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SchemaRegistry != nil {
+		in, out := &in.SchemaRegistry, &out.SchemaRegistry
+		*out = new(common.SchemaRegistryConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaTrigger.
+func (in *KafkaTrigger) DeepCopy() *KafkaTrigger {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaTrigger)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LogTrigger) DeepCopyInto(out *LogTrigger) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogTrigger.
+func (in *LogTrigger) DeepCopy() *LogTrigger {
+	if in == nil {
+		return nil
+	}
+	out := new(LogTrigger)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LokiTrigger) DeepCopyInto(out *LokiTrigger) {
+	*out = *in
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Payload != nil {
+		in, out := &in.Payload, &out.Payload
+		*out = make([]TriggerParameter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = make([]TriggerParameter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.BasicAuth != nil {
+		in, out := &in.BasicAuth, &out.BasicAuth
+		*out = new(common.BasicAuth)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BearerToken != nil {
+		in, out := &in.BearerToken, &out.BearerToken
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(common.TLSConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LokiTrigger.
+func (in *LokiTrigger) DeepCopy() *LokiTrigger {
+	if in == nil {
+		return nil
+	}
+	out := new(LokiTrigger)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NATSTrigger) DeepCopyInto(out *NATSTrigger) {
+	*out = *in
+	if in.Payload != nil {
+		in, out := &in.Payload, &out.Payload
+		*out = make([]TriggerParameter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = make([]TriggerParameter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(common.TLSConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NATSTrigger.
+func (in *NATSTrigger) DeepCopy() *NATSTrigger {
+	if in == nil {
+		return nil
+	}
+	out := new(NATSTrigger)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenWhiskTrigger) DeepCopyInto(out *OpenWhiskTrigger) {
+	*out = *in
+	if in.AuthToken != nil {
+		in, out := &in.AuthToken, &out.AuthToken
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Payload != nil {
+		in, out := &in.Payload, &out.Payload
+		*out = make([]TriggerParameter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = make([]TriggerParameter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenWhiskTrigger.
+func (in *OpenWhiskTrigger) DeepCopy() *OpenWhiskTrigger {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenWhiskTrigger)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GitCreds) DeepCopyInto(out *GitCreds) {
+func (in *PayloadField) DeepCopyInto(out *PayloadField) {
 	*out = *in
-	if in.Username != nil {
-		in, out := &in.Username, &out.Username
-		*out = new(v1.SecretKeySelector)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Password != nil {
-		in, out := &in.Password, &out.Password
-		*out = new(v1.SecretKeySelector)
-		(*in).DeepCopyInto(*out)
-	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitCreds.
-func (in *GitCreds) DeepCopy() *GitCreds {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PayloadField.
+func (in *PayloadField) DeepCopy() *PayloadField {
 	if in == nil {
 		return nil
 	}
-	out := new(GitCreds)
+	out := new(PayloadField)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GitRemoteConfig) DeepCopyInto(out *GitRemoteConfig) {
+func (in *PayloadGuards) DeepCopyInto(out *PayloadGuards) {
 	*out = *in
-	if in.URLS != nil {
-		in, out := &in.URLS, &out.URLS
+	if in.ContentTypes != nil {
+		in, out := &in.ContentTypes, &out.ContentTypes
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitRemoteConfig.
-func (in *GitRemoteConfig) DeepCopy() *GitRemoteConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PayloadGuards.
+func (in *PayloadGuards) DeepCopy() *PayloadGuards {
 	if in == nil {
 		return nil
 	}
-	out := new(GitRemoteConfig)
+	out := new(PayloadGuards)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *HTTPTrigger) DeepCopyInto(out *HTTPTrigger) {
+func (in *PrometheusDependency) DeepCopyInto(out *PrometheusDependency) {
 	*out = *in
-	if in.Payload != nil {
-		in, out := &in.Payload, &out.Payload
-		*out = make([]TriggerParameter, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.BasicAuth != nil {
+		in, out := &in.BasicAuth, &out.BasicAuth
+		*out = new(common.BasicAuth)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BearerToken != nil {
+		in, out := &in.BearerToken, &out.BearerToken
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
 	}
 	if in.TLS != nil {
 		in, out := &in.TLS, &out.TLS
 		*out = new(common.TLSConfig)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.Parameters != nil {
-		in, out := &in.Parameters, &out.Parameters
-		*out = make([]TriggerParameter, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-	if in.BasicAuth != nil {
-		in, out := &in.BasicAuth, &out.BasicAuth
-		*out = new(common.BasicAuth)
-		(*in).DeepCopyInto(*out)
-	}
 	if in.Headers != nil {
 		in, out := &in.Headers, &out.Headers
 		*out = make(map[string]string, len(*in))
@@ -643,32 +1546,21 @@ func (in *HTTPTrigger) DeepCopyInto(out *HTTPTrigger) {
 			(*out)[key] = val
 		}
 	}
-	if in.SecureHeaders != nil {
-		in, out := &in.SecureHeaders, &out.SecureHeaders
-		*out = make([]*common.SecureHeader, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(common.SecureHeader)
-				(*in).DeepCopyInto(*out)
-			}
-		}
-	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPTrigger.
-func (in *HTTPTrigger) DeepCopy() *HTTPTrigger {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrometheusDependency.
+func (in *PrometheusDependency) DeepCopy() *PrometheusDependency {
 	if in == nil {
 		return nil
 	}
-	out := new(HTTPTrigger)
+	out := new(PrometheusDependency)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *K8SResourcePolicy) DeepCopyInto(out *K8SResourcePolicy) {
+func (in *PrometheusMetric) DeepCopyInto(out *PrometheusMetric) {
 	*out = *in
 	if in.Labels != nil {
 		in, out := &in.Labels, &out.Labels
@@ -677,143 +1569,113 @@ func (in *K8SResourcePolicy) DeepCopyInto(out *K8SResourcePolicy) {
 			(*out)[key] = val
 		}
 	}
-	if in.Backoff != nil {
-		in, out := &in.Backoff, &out.Backoff
-		*out = new(common.Backoff)
-		(*in).DeepCopyInto(*out)
-	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new K8SResourcePolicy.
-func (in *K8SResourcePolicy) DeepCopy() *K8SResourcePolicy {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrometheusMetric.
+func (in *PrometheusMetric) DeepCopy() *PrometheusMetric {
 	if in == nil {
 		return nil
 	}
-	out := new(K8SResourcePolicy)
+	out := new(PrometheusMetric)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KafkaTrigger) DeepCopyInto(out *KafkaTrigger) {
+func (in *PrometheusPushgateway) DeepCopyInto(out *PrometheusPushgateway) {
 	*out = *in
-	if in.Parameters != nil {
-		in, out := &in.Parameters, &out.Parameters
-		*out = make([]TriggerParameter, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
+	if in.Grouping != nil {
+		in, out := &in.Grouping, &out.Grouping
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
 		}
 	}
+	if in.BasicAuth != nil {
+		in, out := &in.BasicAuth, &out.BasicAuth
+		*out = new(common.BasicAuth)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.TLS != nil {
 		in, out := &in.TLS, &out.TLS
 		*out = new(common.TLSConfig)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.Payload != nil {
-		in, out := &in.Payload, &out.Payload
-		*out = make([]TriggerParameter, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-	if in.PartitioningKey != nil {
-		in, out := &in.PartitioningKey, &out.PartitioningKey
-		*out = new(string)
-		**out = **in
-	}
-	if in.SASL != nil {
-		in, out := &in.SASL, &out.SASL
-		*out = new(common.SASLConfig)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.SchemaRegistry != nil {
-		in, out := &in.SchemaRegistry, &out.SchemaRegistry
-		*out = new(common.SchemaRegistryConfig)
-		(*in).DeepCopyInto(*out)
-	}
-	return
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaTrigger.
-func (in *KafkaTrigger) DeepCopy() *KafkaTrigger {
-	if in == nil {
-		return nil
-	}
-	out := new(KafkaTrigger)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *LogTrigger) DeepCopyInto(out *LogTrigger) {
-	*out = *in
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogTrigger.
-func (in *LogTrigger) DeepCopy() *LogTrigger {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrometheusPushgateway.
+func (in *PrometheusPushgateway) DeepCopy() *PrometheusPushgateway {
 	if in == nil {
 		return nil
 	}
-	out := new(LogTrigger)
+	out := new(PrometheusPushgateway)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NATSTrigger) DeepCopyInto(out *NATSTrigger) {
+func (in *PrometheusRemoteWrite) DeepCopyInto(out *PrometheusRemoteWrite) {
 	*out = *in
-	if in.Payload != nil {
-		in, out := &in.Payload, &out.Payload
-		*out = make([]TriggerParameter, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.BasicAuth != nil {
+		in, out := &in.BasicAuth, &out.BasicAuth
+		*out = new(common.BasicAuth)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.Parameters != nil {
-		in, out := &in.Parameters, &out.Parameters
-		*out = make([]TriggerParameter, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.BearerToken != nil {
+		in, out := &in.BearerToken, &out.BearerToken
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
 	}
 	if in.TLS != nil {
 		in, out := &in.TLS, &out.TLS
 		*out = new(common.TLSConfig)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NATSTrigger.
-func (in *NATSTrigger) DeepCopy() *NATSTrigger {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrometheusRemoteWrite.
+func (in *PrometheusRemoteWrite) DeepCopy() *PrometheusRemoteWrite {
 	if in == nil {
 		return nil
 	}
-	out := new(NATSTrigger)
+	out := new(PrometheusRemoteWrite)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OpenWhiskTrigger) DeepCopyInto(out *OpenWhiskTrigger) {
+func (in *PrometheusTrigger) DeepCopyInto(out *PrometheusTrigger) {
 	*out = *in
-	if in.AuthToken != nil {
-		in, out := &in.AuthToken, &out.AuthToken
-		*out = new(v1.SecretKeySelector)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Payload != nil {
-		in, out := &in.Payload, &out.Payload
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
 		*out = make([]TriggerParameter, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-	if in.Parameters != nil {
-		in, out := &in.Parameters, &out.Parameters
-		*out = make([]TriggerParameter, len(*in))
+	if in.RemoteWrite != nil {
+		in, out := &in.RemoteWrite, &out.RemoteWrite
+		*out = new(PrometheusRemoteWrite)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Pushgateway != nil {
+		in, out := &in.Pushgateway, &out.Pushgateway
+		*out = new(PrometheusPushgateway)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Metrics != nil {
+		in, out := &in.Metrics, &out.Metrics
+		*out = make([]PrometheusMetric, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -821,28 +1683,12 @@ func (in *OpenWhiskTrigger) DeepCopyInto(out *OpenWhiskTrigger) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenWhiskTrigger.
-func (in *OpenWhiskTrigger) DeepCopy() *OpenWhiskTrigger {
-	if in == nil {
-		return nil
-	}
-	out := new(OpenWhiskTrigger)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PayloadField) DeepCopyInto(out *PayloadField) {
-	*out = *in
-	return
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PayloadField.
-func (in *PayloadField) DeepCopy() *PayloadField {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrometheusTrigger.
+func (in *PrometheusTrigger) DeepCopy() *PrometheusTrigger {
 	if in == nil {
 		return nil
 	}
-	out := new(PayloadField)
+	out := new(PrometheusTrigger)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -953,6 +1799,38 @@ func (in *Sensor) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SensorDistribution) DeepCopyInto(out *SensorDistribution) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SensorDistribution.
+func (in *SensorDistribution) DeepCopy() *SensorDistribution {
+	if in == nil {
+		return nil
+	}
+	out := new(SensorDistribution)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SensorExecutionQuota) DeepCopyInto(out *SensorExecutionQuota) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SensorExecutionQuota.
+func (in *SensorExecutionQuota) DeepCopy() *SensorExecutionQuota {
+	if in == nil {
+		return nil
+	}
+	out := new(SensorExecutionQuota)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SensorList) DeepCopyInto(out *SensorList) {
 	*out = *in
@@ -986,6 +1864,27 @@ func (in *SensorList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SensorRollout) DeepCopyInto(out *SensorRollout) {
+	*out = *in
+	if in.Canary != nil {
+		in, out := &in.Canary, &out.Canary
+		*out = new(CanaryRollout)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SensorRollout.
+func (in *SensorRollout) DeepCopy() *SensorRollout {
+	if in == nil {
+		return nil
+	}
+	out := new(SensorRollout)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SensorSpec) DeepCopyInto(out *SensorSpec) {
 	*out = *in
@@ -1013,17 +1912,67 @@ func (in *SensorSpec) DeepCopyInto(out *SensorSpec) {
 		*out = new(int32)
 		**out = **in
 	}
-	if in.RevisionHistoryLimit != nil {
-		in, out := &in.RevisionHistoryLimit, &out.RevisionHistoryLimit
-		*out = new(int32)
+	if in.RevisionHistoryLimit != nil {
+		in, out := &in.RevisionHistoryLimit, &out.RevisionHistoryLimit
+		*out = new(int32)
+		**out = **in
+	}
+	if in.LoggingFields != nil {
+		in, out := &in.LoggingFields, &out.LoggingFields
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.RemoteEventBus != nil {
+		in, out := &in.RemoteEventBus, &out.RemoteEventBus
+		*out = new(common.RemoteEventBus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Metrics != nil {
+		in, out := &in.Metrics, &out.Metrics
+		*out = new(common.MetricsConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Rollout != nil {
+		in, out := &in.Rollout, &out.Rollout
+		*out = new(SensorRollout)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DataSchemaValidation != nil {
+		in, out := &in.DataSchemaValidation, &out.DataSchemaValidation
+		*out = new(DataSchemaValidation)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Distribution != nil {
+		in, out := &in.Distribution, &out.Distribution
+		*out = new(SensorDistribution)
+		**out = **in
+	}
+	if in.TriggerStatus != nil {
+		in, out := &in.TriggerStatus, &out.TriggerStatus
+		*out = new(TriggerStatusReporting)
+		**out = **in
+	}
+	if in.Quota != nil {
+		in, out := &in.Quota, &out.Quota
+		*out = new(SensorExecutionQuota)
 		**out = **in
 	}
-	if in.LoggingFields != nil {
-		in, out := &in.LoggingFields, &out.LoggingFields
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
+	if in.TracingMetadata != nil {
+		in, out := &in.TracingMetadata, &out.TracingMetadata
+		*out = new(TracingMetadata)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Capture != nil {
+		in, out := &in.Capture, &out.Capture
+		*out = new(EventCapture)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TriggerPools != nil {
+		in, out := &in.TriggerPools, &out.TriggerPools
+		*out = new(TriggerPools)
+		**out = **in
 	}
 	return
 }
@@ -1042,6 +1991,23 @@ func (in *SensorSpec) DeepCopy() *SensorSpec {
 func (in *SensorStatus) DeepCopyInto(out *SensorStatus) {
 	*out = *in
 	in.Status.DeepCopyInto(&out.Status)
+	if in.Canary != nil {
+		in, out := &in.Canary, &out.Canary
+		*out = new(CanaryStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Triggers != nil {
+		in, out := &in.Triggers, &out.Triggers
+		*out = new(TriggersStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Retries != nil {
+		in, out := &in.Retries, &out.Retries
+		*out = make([]common.RetryStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -1220,6 +2186,11 @@ func (in *Template) DeepCopyInto(out *Template) {
 		*out = new(v1.Affinity)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.RuntimeClassName != nil {
+		in, out := &in.RuntimeClassName, &out.RuntimeClassName
+		*out = new(string)
+		**out = **in
+	}
 	return
 }
 
@@ -1249,6 +2220,36 @@ func (in *TimeFilter) DeepCopy() *TimeFilter {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TracingMetadata) DeepCopyInto(out *TracingMetadata) {
+	*out = *in
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TracingMetadata.
+func (in *TracingMetadata) DeepCopy() *TracingMetadata {
+	if in == nil {
+		return nil
+	}
+	out := new(TracingMetadata)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Trigger) DeepCopyInto(out *Trigger) {
 	*out = *in
@@ -1284,6 +2285,46 @@ func (in *Trigger) DeepCopyInto(out *Trigger) {
 		*out = new(Trigger)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Deduplication != nil {
+		in, out := &in.Deduplication, &out.Deduplication
+		*out = new(TriggerDeduplication)
+		**out = **in
+	}
+	if in.CircuitBreaker != nil {
+		in, out := &in.CircuitBreaker, &out.CircuitBreaker
+		*out = new(TriggerCircuitBreaker)
+		**out = **in
+	}
+	if in.ActiveWindows != nil {
+		in, out := &in.ActiveWindows, &out.ActiveWindows
+		*out = new(TriggerActiveWindows)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Batch != nil {
+		in, out := &in.Batch, &out.Batch
+		*out = new(TriggerBatch)
+		**out = **in
+	}
+	if in.Cache != nil {
+		in, out := &in.Cache, &out.Cache
+		*out = new(TriggerCache)
+		**out = **in
+	}
+	if in.FeatureFlag != nil {
+		in, out := &in.FeatureFlag, &out.FeatureFlag
+		*out = new(TriggerFeatureFlag)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OversizeRoute != nil {
+		in, out := &in.OversizeRoute, &out.OversizeRoute
+		*out = new(TriggerOversizeRoute)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Pool != nil {
+		in, out := &in.Pool, &out.Pool
+		*out = new(TriggerPool)
+		**out = **in
+	}
 	return
 }
 
@@ -1297,6 +2338,166 @@ func (in *Trigger) DeepCopy() *Trigger {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TriggerActiveWindow) DeepCopyInto(out *TriggerActiveWindow) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TriggerActiveWindow.
+func (in *TriggerActiveWindow) DeepCopy() *TriggerActiveWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(TriggerActiveWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TriggerActiveWindows) DeepCopyInto(out *TriggerActiveWindows) {
+	*out = *in
+	if in.Windows != nil {
+		in, out := &in.Windows, &out.Windows
+		*out = make([]TriggerActiveWindow, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TriggerActiveWindows.
+func (in *TriggerActiveWindows) DeepCopy() *TriggerActiveWindows {
+	if in == nil {
+		return nil
+	}
+	out := new(TriggerActiveWindows)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TriggerBatch) DeepCopyInto(out *TriggerBatch) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TriggerBatch.
+func (in *TriggerBatch) DeepCopy() *TriggerBatch {
+	if in == nil {
+		return nil
+	}
+	out := new(TriggerBatch)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TriggerCache) DeepCopyInto(out *TriggerCache) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TriggerCache.
+func (in *TriggerCache) DeepCopy() *TriggerCache {
+	if in == nil {
+		return nil
+	}
+	out := new(TriggerCache)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TriggerCircuitBreaker) DeepCopyInto(out *TriggerCircuitBreaker) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TriggerCircuitBreaker.
+func (in *TriggerCircuitBreaker) DeepCopy() *TriggerCircuitBreaker {
+	if in == nil {
+		return nil
+	}
+	out := new(TriggerCircuitBreaker)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TriggerDeduplication) DeepCopyInto(out *TriggerDeduplication) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TriggerDeduplication.
+func (in *TriggerDeduplication) DeepCopy() *TriggerDeduplication {
+	if in == nil {
+		return nil
+	}
+	out := new(TriggerDeduplication)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TriggerFeatureFlag) DeepCopyInto(out *TriggerFeatureFlag) {
+	*out = *in
+	if in.TargetingKey != nil {
+		in, out := &in.TargetingKey, &out.TargetingKey
+		*out = new(TriggerParameterSource)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Context != nil {
+		in, out := &in.Context, &out.Context
+		*out = make([]TriggerParameter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.BearerToken != nil {
+		in, out := &in.BearerToken, &out.BearerToken
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(common.TLSConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TriggerFeatureFlag.
+func (in *TriggerFeatureFlag) DeepCopy() *TriggerFeatureFlag {
+	if in == nil {
+		return nil
+	}
+	out := new(TriggerFeatureFlag)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TriggerOversizeRoute) DeepCopyInto(out *TriggerOversizeRoute) {
+	*out = *in
+	if in.Trigger != nil {
+		in, out := &in.Trigger, &out.Trigger
+		*out = new(Trigger)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TriggerOversizeRoute.
+func (in *TriggerOversizeRoute) DeepCopy() *TriggerOversizeRoute {
+	if in == nil {
+		return nil
+	}
+	out := new(TriggerOversizeRoute)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TriggerParameter) DeepCopyInto(out *TriggerParameter) {
 	*out = *in
@@ -1365,6 +2566,54 @@ func (in *TriggerPolicy) DeepCopy() *TriggerPolicy {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TriggerPool) DeepCopyInto(out *TriggerPool) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TriggerPool.
+func (in *TriggerPool) DeepCopy() *TriggerPool {
+	if in == nil {
+		return nil
+	}
+	out := new(TriggerPool)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TriggerPools) DeepCopyInto(out *TriggerPools) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TriggerPools.
+func (in *TriggerPools) DeepCopy() *TriggerPools {
+	if in == nil {
+		return nil
+	}
+	out := new(TriggerPools)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TriggerStatusReporting) DeepCopyInto(out *TriggerStatusReporting) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TriggerStatusReporting.
+func (in *TriggerStatusReporting) DeepCopy() *TriggerStatusReporting {
+	if in == nil {
+		return nil
+	}
+	out := new(TriggerStatusReporting)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TriggerTemplate) DeepCopyInto(out *TriggerTemplate) {
 	*out = *in
@@ -1445,6 +2694,46 @@ func (in *TriggerTemplate) DeepCopyInto(out *TriggerTemplate) {
 		*out = new(EmailTrigger)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Prometheus != nil {
+		in, out := &in.Prometheus, &out.Prometheus
+		*out = new(PrometheusTrigger)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Loki != nil {
+		in, out := &in.Loki, &out.Loki
+		*out = new(LokiTrigger)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Elasticsearch != nil {
+		in, out := &in.Elasticsearch, &out.Elasticsearch
+		*out = new(ElasticsearchTrigger)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Jenkins != nil {
+		in, out := &in.Jenkins, &out.Jenkins
+		*out = new(JenkinsTrigger)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GithubWorkflow != nil {
+		in, out := &in.GithubWorkflow, &out.GithubWorkflow
+		*out = new(GithubWorkflowTrigger)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AWSSQS != nil {
+		in, out := &in.AWSSQS, &out.AWSSQS
+		*out = new(AWSSQSTrigger)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AWSSNS != nil {
+		in, out := &in.AWSSNS, &out.AWSSNS
+		*out = new(AWSSNSTrigger)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AzureQueueStorage != nil {
+		in, out := &in.AzureQueueStorage, &out.AzureQueueStorage
+		*out = new(AzureQueueStorageTrigger)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1458,6 +2747,33 @@ func (in *TriggerTemplate) DeepCopy() *TriggerTemplate {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TriggersStatus) DeepCopyInto(out *TriggersStatus) {
+	*out = *in
+	if in.LastExecutionTime != nil {
+		in, out := &in.LastExecutionTime, &out.LastExecutionTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Failing != nil {
+		in, out := &in.Failing, &out.Failing
+		*out = make([]FailingTriggerStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TriggersStatus.
+func (in *TriggersStatus) DeepCopy() *TriggersStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(TriggersStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *URLArtifact) DeepCopyInto(out *URLArtifact) {
 	*out = *in
@@ -28,6 +28,7 @@ import (
 
 type ArgoprojV1alpha1Interface interface {
 	RESTClient() rest.Interface
+	ClusterEventSourcesGetter
 	EventSourcesGetter
 }
 
@@ -36,6 +37,10 @@ type ArgoprojV1alpha1Client struct {
 	restClient rest.Interface
 }
 
+func (c *ArgoprojV1alpha1Client) ClusterEventSources() ClusterEventSourceInterface {
+	return newClusterEventSources(c)
+}
+
 func (c *ArgoprojV1alpha1Client) EventSources(namespace string) EventSourceInterface {
 	return newEventSources(c, namespace)
 }
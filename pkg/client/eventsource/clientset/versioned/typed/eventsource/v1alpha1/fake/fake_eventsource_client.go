@@ -28,6 +28,10 @@ type FakeArgoprojV1alpha1 struct {
 	*testing.Fake
 }
 
+func (c *FakeArgoprojV1alpha1) ClusterEventSources() v1alpha1.ClusterEventSourceInterface {
+	return &FakeClusterEventSources{c}
+}
+
 func (c *FakeArgoprojV1alpha1) EventSources(namespace string) v1alpha1.EventSourceInterface {
 	return &FakeEventSources{c, namespace}
 }
@@ -24,6 +24,8 @@ import (
 
 // Interface provides access to all the informers in this group version.
 type Interface interface {
+	// ClusterEventSources returns a ClusterEventSourceInformer.
+	ClusterEventSources() ClusterEventSourceInformer
 	// EventSources returns a EventSourceInformer.
 	EventSources() EventSourceInformer
 }
@@ -39,6 +41,11 @@ func New(f internalinterfaces.SharedInformerFactory, namespace string, tweakList
 	return &version{factory: f, namespace: namespace, tweakListOptions: tweakListOptions}
 }
 
+// ClusterEventSources returns a ClusterEventSourceInformer.
+func (v *version) ClusterEventSources() ClusterEventSourceInformer {
+	return &clusterEventSourceInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
 // EventSources returns a EventSourceInformer.
 func (v *version) EventSources() EventSourceInformer {
 	return &eventSourceInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
@@ -28,6 +28,10 @@ type FakeArgoprojV1alpha1 struct {
 	*testing.Fake
 }
 
+func (c *FakeArgoprojV1alpha1) ClusterSensors() v1alpha1.ClusterSensorInterface {
+	return &FakeClusterSensors{c}
+}
+
 func (c *FakeArgoprojV1alpha1) Sensors(namespace string) v1alpha1.SensorInterface {
 	return &FakeSensors{c, namespace}
 }
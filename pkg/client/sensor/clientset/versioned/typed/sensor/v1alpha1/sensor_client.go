@@ -28,6 +28,7 @@ import (
 
 type ArgoprojV1alpha1Interface interface {
 	RESTClient() rest.Interface
+	ClusterSensorsGetter
 	SensorsGetter
 }
 
@@ -36,6 +37,10 @@ type ArgoprojV1alpha1Client struct {
 	restClient rest.Interface
 }
 
+func (c *ArgoprojV1alpha1Client) ClusterSensors() ClusterSensorInterface {
+	return newClusterSensors(c)
+}
+
 func (c *ArgoprojV1alpha1Client) Sensors(namespace string) SensorInterface {
 	return newSensors(c, namespace)
 }
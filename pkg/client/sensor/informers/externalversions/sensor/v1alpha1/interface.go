@@ -24,6 +24,8 @@ import (
 
 // Interface provides access to all the informers in this group version.
 type Interface interface {
+	// ClusterSensors returns a ClusterSensorInformer.
+	ClusterSensors() ClusterSensorInformer
 	// Sensors returns a SensorInformer.
 	Sensors() SensorInformer
 }
@@ -39,6 +41,11 @@ func New(f internalinterfaces.SharedInformerFactory, namespace string, tweakList
 	return &version{factory: f, namespace: namespace, tweakListOptions: tweakListOptions}
 }
 
+// ClusterSensors returns a ClusterSensorInformer.
+func (v *version) ClusterSensors() ClusterSensorInformer {
+	return &clusterSensorInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
 // Sensors returns a SensorInformer.
 func (v *version) Sensors() SensorInformer {
 	return &sensorInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
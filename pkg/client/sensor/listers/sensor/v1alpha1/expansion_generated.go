@@ -18,6 +18,10 @@ limitations under the License.
 
 package v1alpha1
 
+// ClusterSensorListerExpansion allows custom methods to be added to
+// ClusterSensorLister.
+type ClusterSensorListerExpansion interface{}
+
 // SensorListerExpansion allows custom methods to be added to
 // SensorLister.
 type SensorListerExpansion interface{}
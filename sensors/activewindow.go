@@ -0,0 +1,97 @@
+package sensors
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	cronlib "github.com/robfig/cron/v3"
+
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+)
+
+// activeWindow is a recurring time window of a trigger.
+type activeWindow struct {
+	start    cronlib.Schedule
+	duration time.Duration
+}
+
+// activeWindows gates the executions of a trigger to its active windows.
+type activeWindows struct {
+	windows  []activeWindow
+	location *time.Location
+	policy   v1alpha1.TriggerOutsideWindowsPolicy
+}
+
+func newActiveWindows(config v1alpha1.TriggerActiveWindows) (*activeWindows, error) {
+	location := time.UTC
+	if config.Timezone != "" {
+		var err error
+		if location, err = time.LoadLocation(config.Timezone); err != nil {
+			return nil, fmt.Errorf("failed to load timezone %s, %w", config.Timezone, err)
+		}
+	}
+	parser := cronlib.NewParser(cronlib.Minute | cronlib.Hour | cronlib.Dom | cronlib.Month | cronlib.Dow)
+	aw := &activeWindows{location: location, policy: config.GetOutsideWindows()}
+	for _, w := range config.Windows {
+		start, err := parser.Parse(w.Start)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse window start %q, %w", w.Start, err)
+		}
+		duration, err := time.ParseDuration(w.Duration)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse window duration %q, %w", w.Duration, err)
+		}
+		aw.windows = append(aw.windows, activeWindow{start: start, duration: duration})
+	}
+	return aw, nil
+}
+
+// active returns whether any window is open at t.
+func (aw *activeWindows) active(t time.Time) bool {
+	t = t.In(aw.location)
+	for _, w := range aw.windows {
+		// The window is open if it started within its duration before t
+		if start := w.start.Next(t.Add(-w.duration)); !start.IsZero() && !start.After(t) {
+			return true
+		}
+	}
+	return false
+}
+
+// nextOpen returns the time the next window opens after t, zero if none does.
+func (aw *activeWindows) nextOpen(t time.Time) time.Time {
+	t = t.In(aw.location)
+	var next time.Time
+	for _, w := range aw.windows {
+		if start := w.start.Next(t); !start.IsZero() && (next.IsZero() || start.Before(next)) {
+			next = start
+		}
+	}
+	return next
+}
+
+// admit returns whether an execution is allowed now. With the Defer policy, it waits for the next window to
+// open, and returns false only if the context is done first or no window opens anymore.
+func (aw *activeWindows) admit(ctx context.Context) bool {
+	for {
+		now := time.Now()
+		if aw.active(now) {
+			return true
+		}
+		if aw.policy != v1alpha1.TriggerOutsideWindowsDefer {
+			return false
+		}
+		next := aw.nextOpen(now)
+		if next.IsZero() {
+			return false
+		}
+		timer := time.NewTimer(next.Sub(now))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return false
+		case <-timer.C:
+		}
+	}
+}
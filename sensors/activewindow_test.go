@@ -0,0 +1,61 @@
+package sensors
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+)
+
+func TestActiveWindows(t *testing.T) {
+	aw, err := newActiveWindows(v1alpha1.TriggerActiveWindows{
+		Windows:  []v1alpha1.TriggerActiveWindow{{Start: "0 9 * * 1-5", Duration: "8h"}},
+		Timezone: "America/New_York",
+	})
+	assert.NoError(t, err)
+	location, _ := time.LoadLocation("America/New_York")
+
+	// Monday
+	assert.False(t, aw.active(time.Date(2024, 6, 3, 8, 59, 0, 0, location)))
+	assert.True(t, aw.active(time.Date(2024, 6, 3, 9, 0, 0, 0, location)))
+	assert.True(t, aw.active(time.Date(2024, 6, 3, 16, 59, 0, 0, location)))
+	assert.False(t, aw.active(time.Date(2024, 6, 3, 17, 0, 0, 0, location)))
+	// The timezone of the given time does not matter
+	assert.True(t, aw.active(time.Date(2024, 6, 3, 14, 0, 0, 0, time.UTC)))
+	// Saturday
+	assert.False(t, aw.active(time.Date(2024, 6, 8, 10, 0, 0, 0, location)))
+
+	// Friday evening, the next window opens on Monday
+	next := aw.nextOpen(time.Date(2024, 6, 7, 18, 0, 0, 0, location))
+	assert.True(t, next.Equal(time.Date(2024, 6, 10, 9, 0, 0, 0, location)))
+}
+
+func TestActiveWindowsAdmit(t *testing.T) {
+	now := time.Now().UTC()
+	// A window opening in six months
+	later := now.AddDate(0, 6, 0)
+	config := v1alpha1.TriggerActiveWindows{
+		Windows: []v1alpha1.TriggerActiveWindow{{Start: later.Format("4 15 2 1 *"), Duration: "1m"}},
+	}
+
+	aw, err := newActiveWindows(config)
+	assert.NoError(t, err)
+	assert.False(t, aw.admit(context.Background()))
+
+	config.OutsideWindows = v1alpha1.TriggerOutsideWindowsDefer
+	aw, err = newActiveWindows(config)
+	assert.NoError(t, err)
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	assert.False(t, aw.admit(ctx))
+	assert.GreaterOrEqual(t, time.Since(start), 50*time.Millisecond)
+
+	config.Windows = append(config.Windows, v1alpha1.TriggerActiveWindow{Start: "* * * * *", Duration: "1m"})
+	aw, err = newActiveWindows(config)
+	assert.NoError(t, err)
+	assert.True(t, aw.admit(context.Background()))
+}
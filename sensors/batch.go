@@ -0,0 +1,282 @@
+package sensors
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"go.uber.org/zap"
+
+	"github.com/argoproj/argo-events/common"
+	eventbuscommon "github.com/argoproj/argo-events/eventbus/common"
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+)
+
+// batchSizeExtension is the CloudEvent extension holding the number of events aggregated in a batched event.
+const batchSizeExtension = "batchsize"
+
+// batchExecution is an execution accumulated in a batch, persisted under its own key.
+type batchExecution struct {
+	key    string
+	at     time.Time
+	events map[string]cloudevents.Event
+}
+
+// triggerBatch accumulates the executions of a trigger, and flushes them once full or after its window. The executions
+// are persisted one by one as they are added, and deleted once the batch is flushed, so that a batch whose execution
+// failed, or was interrupted by a restart, is flushed again.
+type triggerBatch struct {
+	triggerName string
+	config      v1alpha1.TriggerBatch
+	// store persists the batch, the batch is only kept in memory if nil.
+	store eventbuscommon.BatchStore
+	// flush executes the trigger with the aggregated events of the batch, and calls done with the result.
+	flush func(events map[string]cloudevents.Event, done func(error))
+	// inFlight tracks the scheduled flush, so that the drain of the Sensor waits for it once it has started.
+	inFlight *sync.WaitGroup
+	logger   *zap.SugaredLogger
+
+	lock sync.Mutex
+	// startedAt is the time the window of the batch started.
+	startedAt  time.Time
+	executions []batchExecution
+	timer      *time.Timer
+	// stopped prevents the flush of a failed batch from being scheduled again once the batch is stopped.
+	stopped bool
+}
+
+func newTriggerBatch(triggerName string, config v1alpha1.TriggerBatch, store eventbuscommon.BatchStore, flush func(map[string]cloudevents.Event, func(error)), inFlight *sync.WaitGroup, logger *zap.SugaredLogger) *triggerBatch {
+	return &triggerBatch{triggerName: triggerName, config: config, store: store, flush: flush, inFlight: inFlight, logger: logger}
+}
+
+// restore loads the persisted executions, and flushes them if the batch is full, or schedules the flush otherwise.
+func (b *triggerBatch) restore() error {
+	b.lock.Lock()
+	b.stopped = false
+	b.lock.Unlock()
+	if b.store == nil {
+		return nil
+	}
+	persisted, err := b.store.LoadBatch(b.triggerName)
+	if err != nil || len(persisted) == 0 {
+		return err
+	}
+	executions := make([]batchExecution, 0, len(persisted))
+	for key, data := range persisted {
+		at, err := parseBatchKey(key)
+		if err != nil {
+			b.logger.Warnw("ignoring invalid batch execution key", "key", key, zap.Error(err))
+			continue
+		}
+		events := map[string]cloudevents.Event{}
+		if err := json.Unmarshal(data, &events); err != nil {
+			b.logger.Warnw("ignoring invalid persisted batch execution", "key", key, zap.Error(err))
+			continue
+		}
+		executions = append(executions, batchExecution{key: key, at: at, events: events})
+	}
+	if len(executions) == 0 {
+		return nil
+	}
+	sort.Slice(executions, func(i, j int) bool { return executions[i].at.Before(executions[j].at) })
+	b.logger.Infow("restored the persisted batch", "executions", len(executions))
+	b.lock.Lock()
+	b.startedAt = executions[0].at
+	b.executions = append(executions, b.executions...)
+	if b.full() {
+		executions := b.take()
+		b.lock.Unlock()
+		b.flushExecutions(executions)
+		return nil
+	}
+	b.scheduleFlush()
+	b.lock.Unlock()
+	return nil
+}
+
+// add adds the events of an execution to the batch, and flushes it if it is full.
+func (b *triggerBatch) add(events map[string]cloudevents.Event) error {
+	at := time.Now().UTC()
+	execution := batchExecution{key: fmt.Sprintf("%d-%s", at.UnixNano(), common.RandomString(8)), at: at, events: events}
+	if err := b.persist(execution); err != nil {
+		return err
+	}
+	b.lock.Lock()
+	if len(b.executions) == 0 {
+		b.startedAt = at
+	}
+	b.executions = append(b.executions, execution)
+	if b.full() {
+		executions := b.take()
+		b.lock.Unlock()
+		b.flushExecutions(executions)
+		return nil
+	}
+	b.scheduleFlush()
+	b.lock.Unlock()
+	return nil
+}
+
+// full returns whether the batch reached its maximum number of executions, it must be called with the lock held.
+func (b *triggerBatch) full() bool {
+	return b.config.MaxEvents > 0 && len(b.executions) >= int(b.config.MaxEvents)
+}
+
+// stop stops the scheduled flush, the persisted batch is flushed after a restart.
+func (b *triggerBatch) stop() {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	b.stopped = true
+	b.stopTimer()
+}
+
+// stopTimer stops the scheduled flush, it must be called with the lock held. A flush which has already started is
+// not stopped, it is still tracked as in flight until it finishes.
+func (b *triggerBatch) stopTimer() {
+	if b.timer == nil {
+		return
+	}
+	if b.timer.Stop() && b.inFlight != nil {
+		b.inFlight.Done()
+	}
+	b.timer = nil
+}
+
+// scheduleFlush schedules the flush at the end of the window, it must be called with the lock held.
+func (b *triggerBatch) scheduleFlush() {
+	window := b.config.GetWindow()
+	if window == 0 || b.timer != nil || b.stopped {
+		return
+	}
+	delay := time.Until(b.startedAt.Add(window))
+	if delay < 0 {
+		delay = 0
+	}
+	if b.inFlight != nil {
+		b.inFlight.Add(1)
+	}
+	b.timer = time.AfterFunc(delay, func() {
+		if b.inFlight != nil {
+			defer b.inFlight.Done()
+		}
+		b.lock.Lock()
+		executions := b.take()
+		b.lock.Unlock()
+		b.flushExecutions(executions)
+	})
+}
+
+// take empties the batch and returns its executions, it must be called with the lock held. The executions stay
+// persisted until they are flushed.
+func (b *triggerBatch) take() []batchExecution {
+	executions := b.executions
+	b.executions = nil
+	b.stopTimer()
+	return executions
+}
+
+// requeue puts back the executions of a failed flush at the head of the batch, they are flushed again at the end of
+// a new window, or once the batch is full.
+func (b *triggerBatch) requeue(executions []batchExecution) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	b.executions = append(executions, b.executions...)
+	b.startedAt = time.Now().UTC()
+	b.scheduleFlush()
+}
+
+// persist saves an execution added to the batch.
+func (b *triggerBatch) persist(execution batchExecution) error {
+	if b.store == nil {
+		return nil
+	}
+	data, err := json.Marshal(execution.events)
+	if err != nil {
+		return fmt.Errorf("failed to marshal the batch execution, %w", err)
+	}
+	return b.store.SaveBatchExecution(b.triggerName, execution.key, data)
+}
+
+// clear deletes the persisted executions once they are flushed.
+func (b *triggerBatch) clear(executions []batchExecution) {
+	if b.store == nil {
+		return
+	}
+	keys := make([]string, 0, len(executions))
+	for _, execution := range executions {
+		keys = append(keys, execution.key)
+	}
+	if err := b.store.DeleteBatchExecutions(b.triggerName, keys); err != nil {
+		b.logger.Warnw("failed to delete the flushed executions of the persisted batch", zap.Error(err))
+	}
+}
+
+func (b *triggerBatch) flushExecutions(executions []batchExecution) {
+	if len(executions) == 0 {
+		return
+	}
+	events := make([]map[string]cloudevents.Event, 0, len(executions))
+	for _, execution := range executions {
+		events = append(events, execution.events)
+	}
+	aggregated, err := aggregateEvents(events)
+	if err != nil {
+		b.logger.Errorw("failed to aggregate the events of the batch, dropping it", zap.Error(err))
+		b.clear(executions)
+		return
+	}
+	b.logger.Infow("flushing the batch", "executions", len(executions))
+	b.flush(aggregated, func(err error) {
+		if err != nil {
+			b.logger.Errorw("failed to execute the batch, it is flushed again", "executions", len(executions), zap.Error(err))
+			b.requeue(executions)
+			return
+		}
+		b.clear(executions)
+	})
+}
+
+// parseBatchKey returns the time an execution was added to the batch from its key.
+func parseBatchKey(key string) (time.Time, error) {
+	nanos, _, _ := strings.Cut(key, "-")
+	n, err := strconv.ParseInt(nanos, 10, 64)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return time.Unix(0, n).UTC(), nil
+}
+
+// aggregateEvents returns an event per dependency, the latest one with the JSON array of the data of all
+// the events of the dependency, and their number in the batchsize extension.
+func aggregateEvents(executions []map[string]cloudevents.Event) (map[string]cloudevents.Event, error) {
+	data := make(map[string][]json.RawMessage)
+	result := make(map[string]cloudevents.Event)
+	for _, events := range executions {
+		for depName, event := range events {
+			d := event.Data()
+			switch {
+			case len(d) == 0:
+				d = []byte("null")
+			case !json.Valid(d):
+				// Keep the non JSON data as strings
+				d, _ = json.Marshal(string(d))
+			}
+			data[depName] = append(data[depName], d)
+			result[depName] = event
+		}
+	}
+	for depName, event := range result {
+		aggregated := event.Clone()
+		if err := aggregated.SetData(cloudevents.ApplicationJSON, data[depName]); err != nil {
+			return nil, fmt.Errorf("failed to set the data of dependency %s, %w", depName, err)
+		}
+		aggregated.SetExtension(batchSizeExtension, len(data[depName]))
+		result[depName] = aggregated
+	}
+	return result, nil
+}
@@ -0,0 +1,202 @@
+package sensors
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/argoproj/argo-events/common/logging"
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+)
+
+type fakeBatchStore struct {
+	lock    sync.Mutex
+	batches map[string]map[string][]byte
+	saved   int
+}
+
+func (s *fakeBatchStore) LoadBatch(triggerName string) (map[string][]byte, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	executions := map[string][]byte{}
+	for k, v := range s.batches[triggerName] {
+		executions[k] = v
+	}
+	return executions, nil
+}
+
+func (s *fakeBatchStore) SaveBatchExecution(triggerName, key string, execution []byte) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if s.batches[triggerName] == nil {
+		s.batches[triggerName] = map[string][]byte{}
+	}
+	s.batches[triggerName][key] = execution
+	s.saved++
+	return nil
+}
+
+func (s *fakeBatchStore) DeleteBatchExecutions(triggerName string, keys []string) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	for _, key := range keys {
+		delete(s.batches[triggerName], key)
+	}
+	return nil
+}
+
+func (s *fakeBatchStore) count(triggerName string) int {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return len(s.batches[triggerName])
+}
+
+func newBatchEvent(id, data string) cloudevents.Event {
+	event := cloudevents.NewEvent()
+	event.SetID(id)
+	event.SetSource("fake-source")
+	event.SetType("fake-type")
+	_ = event.SetData(cloudevents.ApplicationJSON, []byte(data))
+	return event
+}
+
+type flushRecorder struct {
+	lock    sync.Mutex
+	flushed []map[string]cloudevents.Event
+	// result is the result of the executions, they don't finish if nil
+	result func() error
+}
+
+func (r *flushRecorder) flush(events map[string]cloudevents.Event, done func(error)) {
+	r.lock.Lock()
+	r.flushed = append(r.flushed, events)
+	result := r.result
+	r.lock.Unlock()
+	if result != nil {
+		done(result())
+	}
+}
+
+func (r *flushRecorder) count() int {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	return len(r.flushed)
+}
+
+func succeeded() error { return nil }
+
+func TestTriggerBatchMaxEvents(t *testing.T) {
+	store := &fakeBatchStore{batches: map[string]map[string][]byte{}}
+	recorder := &flushRecorder{result: succeeded}
+	batch := newTriggerBatch("fake-trigger", v1alpha1.TriggerBatch{MaxEvents: 2}, store, recorder.flush, nil, logging.NewArgoEventsLogger())
+
+	assert.NoError(t, batch.add(map[string]cloudevents.Event{"dep": newBatchEvent("1", `{"key":"a"}`)}))
+	assert.Equal(t, 0, recorder.count())
+	assert.Equal(t, 1, store.count("fake-trigger"))
+
+	assert.NoError(t, batch.add(map[string]cloudevents.Event{"dep": newBatchEvent("2", `"b"`)}))
+	assert.Equal(t, 1, recorder.count())
+	assert.Equal(t, 0, store.count("fake-trigger"))
+	// Each execution is persisted once
+	assert.Equal(t, 2, store.saved)
+
+	event := recorder.flushed[0]["dep"]
+	assert.Equal(t, "2", event.ID())
+	assert.JSONEq(t, `[{"key":"a"},"b"]`, string(event.Data()))
+	assert.Equal(t, int32(2), event.Extensions()[batchSizeExtension])
+}
+
+func TestTriggerBatchWindow(t *testing.T) {
+	store := &fakeBatchStore{batches: map[string]map[string][]byte{}}
+	recorder := &flushRecorder{result: succeeded}
+	config := v1alpha1.TriggerBatch{Window: "100ms"}
+	batch := newTriggerBatch("fake-trigger", config, store, recorder.flush, nil, logging.NewArgoEventsLogger())
+	assert.NoError(t, batch.add(map[string]cloudevents.Event{"dep": newBatchEvent("1", `1`)}))
+	batch.stop()
+
+	// The persisted batch is restored, and flushed at the end of its window
+	restored := newTriggerBatch("fake-trigger", config, store, recorder.flush, nil, logging.NewArgoEventsLogger())
+	assert.NoError(t, restored.restore())
+	assert.NoError(t, restored.add(map[string]cloudevents.Event{"dep": newBatchEvent("2", `2`)}))
+	assert.Eventually(t, func() bool { return recorder.count() == 1 }, time.Second, 10*time.Millisecond)
+	assert.JSONEq(t, `[1,2]`, string(recorder.flushed[0]["dep"].Data()))
+	assert.Equal(t, 0, store.count("fake-trigger"))
+}
+
+func TestTriggerBatchFlushFailure(t *testing.T) {
+	store := &fakeBatchStore{batches: map[string]map[string][]byte{}}
+	failures := 1
+	recorder := &flushRecorder{result: func() error {
+		if failures > 0 {
+			failures--
+			return errors.New("fake error")
+		}
+		return nil
+	}}
+	config := v1alpha1.TriggerBatch{MaxEvents: 2, Window: "50ms"}
+	batch := newTriggerBatch("fake-trigger", config, store, recorder.flush, nil, logging.NewArgoEventsLogger())
+	assert.NoError(t, batch.add(map[string]cloudevents.Event{"dep": newBatchEvent("1", `1`)}))
+	assert.NoError(t, batch.add(map[string]cloudevents.Event{"dep": newBatchEvent("2", `2`)}))
+	assert.Equal(t, 1, recorder.count())
+	// The failed batch is kept, and flushed again
+	assert.Equal(t, 2, store.count("fake-trigger"))
+	assert.Eventually(t, func() bool { return recorder.count() == 2 }, time.Second, 10*time.Millisecond)
+	assert.JSONEq(t, `[1,2]`, string(recorder.flushed[1]["dep"].Data()))
+	assert.Eventually(t, func() bool { return store.count("fake-trigger") == 0 }, time.Second, 10*time.Millisecond)
+}
+
+func TestTriggerBatchInterruptedFlush(t *testing.T) {
+	store := &fakeBatchStore{batches: map[string]map[string][]byte{}}
+	config := v1alpha1.TriggerBatch{MaxEvents: 2}
+	// The pod stops before the execution of the batch finishes
+	interrupted := &flushRecorder{}
+	batch := newTriggerBatch("fake-trigger", config, store, interrupted.flush, nil, logging.NewArgoEventsLogger())
+	assert.NoError(t, batch.add(map[string]cloudevents.Event{"dep": newBatchEvent("1", `1`)}))
+	assert.NoError(t, batch.add(map[string]cloudevents.Event{"dep": newBatchEvent("2", `2`)}))
+	assert.Equal(t, 1, interrupted.count())
+	batch.stop()
+	assert.Equal(t, 2, store.count("fake-trigger"))
+
+	// The full batch is flushed again once restored
+	recorder := &flushRecorder{result: succeeded}
+	restored := newTriggerBatch("fake-trigger", config, store, recorder.flush, nil, logging.NewArgoEventsLogger())
+	assert.NoError(t, restored.restore())
+	assert.Equal(t, 1, recorder.count())
+	assert.JSONEq(t, `[1,2]`, string(recorder.flushed[0]["dep"].Data()))
+	assert.Equal(t, 0, store.count("fake-trigger"))
+}
+
+func TestTriggerBatchInFlight(t *testing.T) {
+	store := &fakeBatchStore{batches: map[string]map[string][]byte{}}
+	recorder := &flushRecorder{result: succeeded}
+	inFlight := &sync.WaitGroup{}
+	config := v1alpha1.TriggerBatch{Window: "50ms"}
+	batch := newTriggerBatch("fake-trigger", config, store, recorder.flush, inFlight, logging.NewArgoEventsLogger())
+
+	// The stopped flush is not in flight anymore
+	assert.NoError(t, batch.add(map[string]cloudevents.Event{"dep": newBatchEvent("1", `1`)}))
+	batch.stop()
+	inFlight.Wait()
+	assert.Equal(t, 0, recorder.count())
+
+	// The scheduled flush is waited for
+	assert.NoError(t, batch.restore())
+	inFlight.Wait()
+	assert.Equal(t, 1, recorder.count())
+}
+
+func TestAggregateEvents(t *testing.T) {
+	events, err := aggregateEvents([]map[string]cloudevents.Event{
+		{"dep1": newBatchEvent("1", `{"a":1}`), "dep2": newBatchEvent("2", `not json`)},
+		{"dep1": newBatchEvent("3", `{"a":2}`)},
+	})
+	assert.NoError(t, err)
+	assert.Len(t, events, 2)
+	assert.JSONEq(t, `[{"a":1},{"a":2}]`, string(events["dep1"].Data()))
+	assert.JSONEq(t, `["not json"]`, string(events["dep2"].Data()))
+	assert.Equal(t, "3", events["dep1"].ID())
+}
@@ -0,0 +1,228 @@
+package sensors
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"path"
+	"strings"
+	"sync"
+	"time"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/minio/minio-go/v7"
+	"go.uber.org/zap"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-events/common/logging"
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+	"github.com/argoproj/argo-events/sensors/artifacts"
+)
+
+const (
+	// CaptureFileSuffix is the suffix of the objects written by the capture.
+	CaptureFileSuffix = ".jsonl"
+	// captureTimeFormat formats the times of the objects written by the capture, so that they sort by name.
+	captureTimeFormat = "20060102T150405.000Z"
+	// maxCaptureSeen is the number of the last captured events remembered to capture them once, an event is
+	// received once per trigger depending on it.
+	maxCaptureSeen = 10000
+	// maxCaptureBufferSize is the size above which the buffered events are dropped if they can't be written.
+	maxCaptureBufferSize = 16 * 1024 * 1024
+)
+
+// CapturedEvent is an event received by a Sensor, as written by the capture.
+type CapturedEvent struct {
+	// ReceivedAt is when the Sensor received the event.
+	ReceivedAt time.Time `json:"receivedAt"`
+	// Context is the context of the event.
+	Context v1alpha1.EventContext `json:"context"`
+	// Data is the data of the event, if it is JSON.
+	Data json.RawMessage `json:"data,omitempty"`
+	// DataBase64 is the data of the event, if it is not JSON.
+	DataBase64 []byte `json:"dataBase64,omitempty"`
+}
+
+// newCapturedEvent returns the captured form of an event received at the given time.
+func newCapturedEvent(event cloudevents.Event, receivedAt time.Time) CapturedEvent {
+	captured := CapturedEvent{
+		ReceivedAt: receivedAt,
+		Context: v1alpha1.EventContext{
+			ID:              event.ID(),
+			Source:          event.Source(),
+			SpecVersion:     event.SpecVersion(),
+			Type:            event.Type(),
+			DataContentType: event.DataContentType(),
+			Subject:         event.Subject(),
+			Time:            metav1.Time{Time: event.Time()},
+		},
+	}
+	if data := event.Data(); json.Valid(data) {
+		captured.Data = data
+	} else {
+		captured.DataBase64 = data
+	}
+	return captured
+}
+
+// captureStore is the object store the captured events are written to.
+type captureStore interface {
+	put(ctx context.Context, key string, data []byte) error
+	// list returns the last modification times of the objects under the prefix, by key.
+	list(ctx context.Context, prefix string) (map[string]time.Time, error)
+	remove(ctx context.Context, key string) error
+}
+
+type s3CaptureStore struct {
+	client *minio.Client
+	bucket string
+}
+
+func (s *s3CaptureStore) put(ctx context.Context, key string, data []byte) error {
+	_, err := s.client.PutObject(ctx, s.bucket, key, bytes.NewReader(data), int64(len(data)), minio.PutObjectOptions{ContentType: "application/x-ndjson"})
+	return err
+}
+
+func (s *s3CaptureStore) list(ctx context.Context, prefix string) (map[string]time.Time, error) {
+	objects := map[string]time.Time{}
+	for obj := range s.client.ListObjects(ctx, s.bucket, minio.ListObjectsOptions{Prefix: prefix}) {
+		if obj.Err != nil {
+			return nil, obj.Err
+		}
+		objects[obj.Key] = obj.LastModified
+	}
+	return objects, nil
+}
+
+func (s *s3CaptureStore) remove(ctx context.Context, key string) error {
+	return s.client.RemoveObject(ctx, s.bucket, key, minio.RemoveObjectOptions{})
+}
+
+// eventCapture buffers the events received by the Sensor, and writes them as an object at every flush interval.
+type eventCapture struct {
+	spec     v1alpha1.EventCapture
+	store    captureStore
+	prefix   string
+	hostname string
+	lock     sync.Mutex
+	buf      bytes.Buffer
+	// seen are the last captured events, by source and ID, and seenOrder the order they were captured in.
+	seen      map[string]bool
+	seenOrder []string
+}
+
+func newEventCapture(spec v1alpha1.EventCapture, sensorName, hostname string) (*eventCapture, error) {
+	creds, err := artifacts.GetCredentials(&v1alpha1.ArtifactLocation{S3: spec.S3})
+	if err != nil {
+		return nil, err
+	}
+	client, err := artifacts.NewMinioClient(spec.S3, *creds)
+	if err != nil {
+		return nil, err
+	}
+	return newEventCaptureWithStore(spec, &s3CaptureStore{client: client, bucket: spec.S3.Bucket.Name}, sensorName, hostname), nil
+}
+
+func newEventCaptureWithStore(spec v1alpha1.EventCapture, store captureStore, sensorName, hostname string) *eventCapture {
+	return &eventCapture{
+		spec:     spec,
+		store:    store,
+		prefix:   path.Join(spec.S3.Bucket.Key, sensorName) + "/",
+		hostname: hostname,
+		seen:     make(map[string]bool),
+	}
+}
+
+// record buffers the event, unless it has already been captured.
+func (c *eventCapture) record(event cloudevents.Event) error {
+	key := event.Source() + "/" + event.ID()
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if c.seen[key] {
+		return nil
+	}
+	b, err := json.Marshal(newCapturedEvent(event, time.Now().UTC()))
+	if err != nil {
+		return err
+	}
+	c.buf.Write(b)
+	c.buf.WriteByte('\n')
+	c.seen[key] = true
+	c.seenOrder = append(c.seenOrder, key)
+	if len(c.seenOrder) > maxCaptureSeen {
+		delete(c.seen, c.seenOrder[0])
+		c.seenOrder = c.seenOrder[1:]
+	}
+	return nil
+}
+
+// flush writes the buffered events as a new object.
+func (c *eventCapture) flush(ctx context.Context, now time.Time) error {
+	c.lock.Lock()
+	if c.buf.Len() == 0 {
+		c.lock.Unlock()
+		return nil
+	}
+	data := make([]byte, c.buf.Len())
+	copy(data, c.buf.Bytes())
+	c.buf.Reset()
+	c.lock.Unlock()
+
+	key := c.prefix + now.UTC().Format(captureTimeFormat) + "-" + c.hostname + CaptureFileSuffix
+	if err := c.store.put(ctx, key, data); err != nil {
+		c.lock.Lock()
+		defer c.lock.Unlock()
+		if len(data)+c.buf.Len() > maxCaptureBufferSize {
+			return fmt.Errorf("failed to write the captured events to %s, dropped %d bytes, %w", key, len(data), err)
+		}
+		// They are written with the next flush, before the events received since
+		pending := append(data, c.buf.Bytes()...)
+		c.buf.Reset()
+		c.buf.Write(pending)
+		return fmt.Errorf("failed to write the captured events to %s, %w", key, err)
+	}
+	return nil
+}
+
+// prune deletes the objects older than the retention.
+func (c *eventCapture) prune(ctx context.Context, now time.Time) error {
+	objects, err := c.store.list(ctx, c.prefix)
+	if err != nil {
+		return fmt.Errorf("failed to list the captured events, %w", err)
+	}
+	for key, lastModified := range objects {
+		if !strings.HasSuffix(key, CaptureFileSuffix) || now.Sub(lastModified) <= c.spec.GetRetention() {
+			continue
+		}
+		if err := c.store.remove(ctx, key); err != nil {
+			return fmt.Errorf("failed to delete the captured events %s, %w", key, err)
+		}
+	}
+	return nil
+}
+
+// run writes the buffered events at every flush interval until the context is done, then writes the last ones.
+func (c *eventCapture) run(ctx context.Context) {
+	log := logging.FromContext(ctx)
+	ticker := time.NewTicker(c.spec.GetFlushInterval())
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			flushCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), 10*time.Second)
+			defer cancel()
+			if err := c.flush(flushCtx, time.Now()); err != nil {
+				log.Warnw("failed to write the last captured events", zap.Error(err))
+			}
+			return
+		case now := <-ticker.C:
+			if err := c.flush(ctx, now); err != nil {
+				log.Warnw("failed to write the captured events", zap.Error(err))
+			}
+			if err := c.prune(ctx, now); err != nil {
+				log.Warnw("failed to delete the expired captured events", zap.Error(err))
+			}
+		}
+	}
+}
@@ -0,0 +1,97 @@
+package sensors
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/stretchr/testify/assert"
+
+	apicommon "github.com/argoproj/argo-events/pkg/apis/common"
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+)
+
+type fakeCaptureStore struct {
+	objects map[string][]byte
+	times   map[string]time.Time
+	failPut bool
+}
+
+func (s *fakeCaptureStore) put(ctx context.Context, key string, data []byte) error {
+	if s.failPut {
+		return fmt.Errorf("unavailable")
+	}
+	s.objects[key] = data
+	s.times[key] = time.Now()
+	return nil
+}
+
+func (s *fakeCaptureStore) list(ctx context.Context, prefix string) (map[string]time.Time, error) {
+	return s.times, nil
+}
+
+func (s *fakeCaptureStore) remove(ctx context.Context, key string) error {
+	delete(s.objects, key)
+	delete(s.times, key)
+	return nil
+}
+
+func newCaptureEvent(id string, data []byte) cloudevents.Event {
+	event := cloudevents.NewEvent()
+	event.SetID(id)
+	event.SetSource("webhook")
+	event.SetSubject("push")
+	event.SetType("webhook")
+	_ = event.SetData(cloudevents.ApplicationJSON, data)
+	return event
+}
+
+func TestEventCapture(t *testing.T) {
+	spec := v1alpha1.EventCapture{S3: &apicommon.S3Artifact{Bucket: &apicommon.S3Bucket{Name: "captures", Key: "sensors"}}}
+	store := &fakeCaptureStore{objects: map[string][]byte{}, times: map[string]time.Time{}}
+	capture := newEventCaptureWithStore(spec, store, "test-sensor", "test-pod")
+	ctx := context.Background()
+	now := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	assert.NoError(t, capture.record(newCaptureEvent("1", []byte(`{"a":1}`))))
+	// Received once per trigger
+	assert.NoError(t, capture.record(newCaptureEvent("1", []byte(`{"a":1}`))))
+	assert.NoError(t, capture.record(newCaptureEvent("2", []byte(`not json`))))
+	assert.NoError(t, capture.flush(ctx, now))
+	data, ok := store.objects["sensors/test-sensor/20260102T030405.000Z-test-pod.jsonl"]
+	assert.True(t, ok)
+	lines := bytes.Split(bytes.TrimSpace(data), []byte("\n"))
+	assert.Len(t, lines, 2)
+	var first, second CapturedEvent
+	assert.NoError(t, json.Unmarshal(lines[0], &first))
+	assert.NoError(t, json.Unmarshal(lines[1], &second))
+	assert.Equal(t, "1", first.Context.ID)
+	assert.JSONEq(t, `{"a":1}`, string(first.Data))
+	assert.Equal(t, []byte("not json"), second.DataBase64)
+
+	// Nothing to write
+	assert.NoError(t, capture.flush(ctx, now.Add(time.Minute)))
+	assert.Len(t, store.objects, 1)
+
+	t.Run("test failed write", func(t *testing.T) {
+		store.failPut = true
+		assert.NoError(t, capture.record(newCaptureEvent("3", []byte(`{}`))))
+		assert.Error(t, capture.flush(ctx, now.Add(2*time.Minute)))
+		store.failPut = false
+		assert.NoError(t, capture.record(newCaptureEvent("4", []byte(`{}`))))
+		assert.NoError(t, capture.flush(ctx, now.Add(3*time.Minute)))
+		data := store.objects["sensors/test-sensor/20260102T030705.000Z-test-pod.jsonl"]
+		assert.Len(t, bytes.Split(bytes.TrimSpace(data), []byte("\n")), 2)
+	})
+
+	t.Run("test prune", func(t *testing.T) {
+		store.times["sensors/test-sensor/20260102T030405.000Z-test-pod.jsonl"] = time.Now().Add(-25 * time.Hour)
+		assert.NoError(t, capture.prune(ctx, time.Now()))
+		assert.Len(t, store.objects, 1)
+		assert.Contains(t, store.objects, "sensors/test-sensor/20260102T030705.000Z-test-pod.jsonl")
+	})
+}
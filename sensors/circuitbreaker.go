@@ -0,0 +1,141 @@
+package sensors
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/util/retry"
+
+	"github.com/argoproj/argo-events/pkg/apis/sensor"
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+)
+
+// errCircuitOpen is returned when a trigger execution is rejected by an open circuit.
+var errCircuitOpen = fmt.Errorf("circuit breaker is open")
+
+// circuitBreaker implements the circuit breaker of a trigger.
+type circuitBreaker struct {
+	config v1alpha1.TriggerCircuitBreaker
+
+	lock     sync.Mutex
+	failures int32
+	// openedAt is zero when the circuit is closed.
+	openedAt time.Time
+	// trial is true when a trial execution is in progress.
+	trial bool
+}
+
+func newCircuitBreaker(config v1alpha1.TriggerCircuitBreaker) *circuitBreaker {
+	return &circuitBreaker{config: config}
+}
+
+// allow returns whether an execution is allowed. Once the open duration elapsed, one trial execution is allowed.
+func (cb *circuitBreaker) allow() bool {
+	cb.lock.Lock()
+	defer cb.lock.Unlock()
+	if cb.openedAt.IsZero() {
+		return true
+	}
+	if cb.trial || time.Since(cb.openedAt) < cb.config.GetOpenDuration() {
+		return false
+	}
+	cb.trial = true
+	return true
+}
+
+// record records the result of an allowed execution, and returns whether the circuit opened or closed.
+func (cb *circuitBreaker) record(success bool) bool {
+	cb.lock.Lock()
+	defer cb.lock.Unlock()
+	wasOpen := !cb.openedAt.IsZero()
+	cb.trial = false
+	if success {
+		cb.failures = 0
+		cb.openedAt = time.Time{}
+		return wasOpen
+	}
+	cb.failures++
+	if wasOpen || cb.failures >= cb.config.ConsecutiveFailures {
+		cb.openedAt = time.Now()
+		return !wasOpen
+	}
+	return false
+}
+
+func (cb *circuitBreaker) isOpen() bool {
+	cb.lock.Lock()
+	defer cb.lock.Unlock()
+	return !cb.openedAt.IsZero()
+}
+
+// recordCircuitBreaker records the result of an execution allowed by a circuit breaker, and updates the
+// CircuitBreakersClosed condition once the circuit opened or closed.
+func (sensorCtx *SensorContext) recordCircuitBreaker(ctx context.Context, cb *circuitBreaker, err error, log *zap.SugaredLogger) {
+	if !cb.record(err == nil) {
+		return
+	}
+	if err != nil {
+		log.Warnw("circuit breaker is open", "openDuration", cb.config.GetOpenDuration().String())
+	} else {
+		log.Info("circuit breaker is closed")
+	}
+	// The condition belongs to the live Sensor, the dry-run pods don't update it
+	if sensorCtx.dryRun {
+		return
+	}
+	if err := sensorCtx.updateCircuitBreakersCondition(ctx); err != nil {
+		log.Warnw("failed to update the circuit breakers condition", zap.Error(err))
+	}
+}
+
+// updateCircuitBreakersCondition sets the CircuitBreakersClosed condition of the Sensor according to the
+// circuit breakers of this pod.
+func (sensorCtx *SensorContext) updateCircuitBreakersCondition(ctx context.Context) error {
+	if len(sensorCtx.circuitBreakers) == 0 || sensorCtx.dynamicClient == nil {
+		return nil
+	}
+	var open []string
+	for name, cb := range sensorCtx.circuitBreakers {
+		if cb.isOpen() {
+			open = append(open, name)
+		}
+	}
+	sort.Strings(open)
+	return sensorCtx.updateStatus(ctx, func(status *v1alpha1.SensorStatus) {
+		if len(open) == 0 {
+			status.MarkCircuitBreakersClosed()
+		} else {
+			status.MarkCircuitBreakersOpen("CircuitOpen", fmt.Sprintf("The circuit breakers of the triggers %s are open.", strings.Join(open, ", ")))
+		}
+	})
+}
+
+// updateStatus applies the given mutation to the status of the Sensor, retrying on conflicts.
+func (sensorCtx *SensorContext) updateStatus(ctx context.Context, mutate func(status *v1alpha1.SensorStatus)) error {
+	client := sensorCtx.dynamicClient.Resource(v1alpha1.SchemeGroupVersion.WithResource(sensor.Plural)).Namespace(sensorCtx.sensor.Namespace)
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		obj, err := client.Get(ctx, sensorCtx.sensor.Name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		s := &v1alpha1.Sensor{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, s); err != nil {
+			return err
+		}
+		mutate(&s.Status)
+		un, err := runtime.DefaultUnstructuredConverter.ToUnstructured(s)
+		if err != nil {
+			return err
+		}
+		_, err = client.UpdateStatus(ctx, &unstructured.Unstructured{Object: un}, metav1.UpdateOptions{})
+		return err
+	})
+}
@@ -0,0 +1,163 @@
+package sensors
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+
+	"github.com/argoproj/argo-events/common/logging"
+	"github.com/argoproj/argo-events/metrics"
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+)
+
+func TestCircuitBreaker(t *testing.T) {
+	cb := newCircuitBreaker(v1alpha1.TriggerCircuitBreaker{ConsecutiveFailures: 2, OpenDuration: "50ms"})
+
+	assert.True(t, cb.allow())
+	assert.False(t, cb.record(false))
+	assert.True(t, cb.allow())
+	assert.False(t, cb.record(true))
+	// The failures must be consecutive
+	assert.True(t, cb.allow())
+	assert.False(t, cb.record(false))
+	assert.True(t, cb.allow())
+	assert.True(t, cb.record(false))
+	assert.True(t, cb.isOpen())
+	assert.False(t, cb.allow())
+
+	// One trial execution is allowed once the open duration elapsed
+	time.Sleep(60 * time.Millisecond)
+	assert.True(t, cb.allow())
+	assert.False(t, cb.allow())
+	assert.False(t, cb.record(false))
+	assert.True(t, cb.isOpen())
+	assert.False(t, cb.allow())
+
+	time.Sleep(60 * time.Millisecond)
+	assert.True(t, cb.allow())
+	assert.True(t, cb.record(true))
+	assert.False(t, cb.isOpen())
+	assert.True(t, cb.allow())
+}
+
+func TestUpdateCircuitBreakersCondition(t *testing.T) {
+	obj := sensorObj.DeepCopy()
+	obj.Spec.Triggers[0].CircuitBreaker = &v1alpha1.TriggerCircuitBreaker{ConsecutiveFailures: 1}
+	scheme := runtime.NewScheme()
+	assert.NoError(t, v1alpha1.AddToScheme(scheme))
+	dynamicClient := dynamicfake.NewSimpleDynamicClient(scheme, &v1alpha1.Sensor{
+		TypeMeta:   metav1.TypeMeta{APIVersion: v1alpha1.SchemeGroupVersion.String(), Kind: "Sensor"},
+		ObjectMeta: obj.ObjectMeta,
+	})
+	sensorCtx := NewSensorContext(nil, dynamicClient, obj, nil, "", "", nil)
+	assert.Len(t, sensorCtx.circuitBreakers, 1)
+
+	getCondition := func() *corev1.ConditionStatus {
+		u, err := dynamicClient.Resource(v1alpha1.SchemeGroupVersion.WithResource("sensors")).Namespace(obj.Namespace).Get(context.Background(), obj.Name, metav1.GetOptions{})
+		assert.NoError(t, err)
+		s := &v1alpha1.Sensor{}
+		assert.NoError(t, runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, s))
+		if c := s.Status.GetCondition(v1alpha1.SensorConditionCircuitBreakersClosed); c != nil {
+			return &c.Status
+		}
+		return nil
+	}
+
+	cb := sensorCtx.circuitBreakers[obj.Spec.Triggers[0].Template.Name]
+	assert.True(t, cb.allow())
+	assert.True(t, cb.record(false))
+	err := sensorCtx.updateCircuitBreakersCondition(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, corev1.ConditionFalse, *getCondition())
+
+	cb.openedAt = time.Now().Add(-2 * time.Minute)
+	assert.True(t, cb.allow())
+	assert.True(t, cb.record(true))
+	err = sensorCtx.updateCircuitBreakersCondition(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, corev1.ConditionTrue, *getCondition())
+}
+
+func TestExecuteTriggerCircuitBreaker(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	url := server.URL
+	server.Close()
+
+	// The trigger is not atLeastOnce, its executions fail once they finished asynchronously
+	trigger := v1alpha1.Trigger{
+		Template: &v1alpha1.TriggerTemplate{
+			Name: "http-trigger",
+			HTTP: &v1alpha1.HTTPTrigger{
+				URL:    url,
+				Method: http.MethodPost,
+			},
+		},
+		CircuitBreaker: &v1alpha1.TriggerCircuitBreaker{ConsecutiveFailures: 2},
+	}
+	obj := sensorObj.DeepCopy()
+	obj.Spec.Triggers = []v1alpha1.Trigger{trigger}
+	scheme := runtime.NewScheme()
+	assert.NoError(t, v1alpha1.AddToScheme(scheme))
+	dynamicClient := dynamicfake.NewSimpleDynamicClient(scheme, &v1alpha1.Sensor{
+		TypeMeta:   metav1.TypeMeta{APIVersion: v1alpha1.SchemeGroupVersion.String(), Kind: "Sensor"},
+		ObjectMeta: obj.ObjectMeta,
+	})
+	getCondition := func() corev1.ConditionStatus {
+		u, err := dynamicClient.Resource(v1alpha1.SchemeGroupVersion.WithResource("sensors")).Namespace(obj.Namespace).Get(context.Background(), obj.Name, metav1.GetOptions{})
+		assert.NoError(t, err)
+		s := &v1alpha1.Sensor{}
+		assert.NoError(t, runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, s))
+		return s.Status.GetCondition(v1alpha1.SensorConditionCircuitBreakersClosed).Status
+	}
+	logger := logging.NewArgoEventsLogger()
+
+	execute := func(sensorCtx *SensorContext) error {
+		results := make(chan error, 1)
+		err := sensorCtx.executeTrigger(context.Background(), context.Background(), obj, map[string]cloudevents.Event{}, trigger, func(err error) {
+			results <- err
+		}, logger)
+		if err != nil {
+			return err
+		}
+		select {
+		case err := <-results:
+			return err
+		case <-time.After(10 * time.Second):
+			t.Fatal("the result of the execution is not reported")
+			return nil
+		}
+	}
+
+	t.Run("test open the circuit", func(t *testing.T) {
+		sensorCtx := NewSensorContext(nil, dynamicClient, obj, nil, "", "", metrics.NewMetrics(obj.Namespace))
+		assert.Error(t, execute(sensorCtx))
+		assert.False(t, sensorCtx.circuitBreakers[trigger.Template.Name].isOpen())
+		assert.Error(t, execute(sensorCtx))
+		assert.True(t, sensorCtx.circuitBreakers[trigger.Template.Name].isOpen())
+		assert.Equal(t, corev1.ConditionFalse, getCondition())
+		assert.ErrorIs(t, execute(sensorCtx), errCircuitOpen)
+	})
+
+	t.Run("test dry run does not update the condition", func(t *testing.T) {
+		sensorCtx := NewSensorContext(nil, dynamicClient, obj, nil, "", "", metrics.NewMetrics(obj.Namespace))
+		assert.NoError(t, sensorCtx.updateCircuitBreakersCondition(context.Background()))
+		assert.Equal(t, corev1.ConditionTrue, getCondition())
+		sensorCtx.dryRun = true
+		cb := sensorCtx.circuitBreakers[trigger.Template.Name]
+		for i := 0; i < 2; i++ {
+			assert.True(t, cb.allow())
+			sensorCtx.recordCircuitBreaker(context.Background(), cb, errCircuitOpen, logger)
+		}
+		assert.True(t, cb.isOpen())
+		assert.Equal(t, corev1.ConditionTrue, getCondition())
+	})
+}
@@ -1,11 +1,14 @@
 package cmd
 
 import (
+	"context"
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"net/http"
 	"os"
 
+	"go.opentelemetry.io/otel/attribute"
 	"go.uber.org/zap"
 	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
@@ -14,6 +17,7 @@ import (
 	argoevents "github.com/argoproj/argo-events"
 	"github.com/argoproj/argo-events/common"
 	"github.com/argoproj/argo-events/common/logging"
+	"github.com/argoproj/argo-events/common/tracing"
 	"github.com/argoproj/argo-events/metrics"
 	eventbusv1alpha1 "github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1"
 	v1alpha1 "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
@@ -52,6 +56,16 @@ func Start() {
 			logger.Fatalw("failed to unmarshal bus config object", zap.Error(err))
 		}
 	}
+	additionalBusConfigs := map[string]eventbusv1alpha1.BusConfig{}
+	if encoded := os.Getenv(common.EnvVarAdditionalEventBusConfigs); len(encoded) > 0 {
+		configs, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			logger.Fatalw("failed to decode additional bus configs string", zap.Error(err))
+		}
+		if err = json.Unmarshal(configs, &additionalBusConfigs); err != nil {
+			logger.Fatalw("failed to unmarshal additional bus configs object", zap.Error(err))
+		}
+	}
 	if busConfig.NATS != nil {
 		for _, trigger := range sensor.Spec.Triggers {
 			if trigger.AtLeastOnce {
@@ -79,10 +93,38 @@ func Start() {
 
 	ctx := logging.WithLogger(signals.SetupSignalHandler(), logger)
 	m := metrics.NewMetrics(sensor.Namespace)
+	if sensor.Spec.Metrics != nil {
+		m.SetLabelLimits(sensor.Spec.Metrics.LabelLimits)
+	}
 	go m.Run(ctx, fmt.Sprintf(":%d", common.SensorMetricsPort))
 
+	shutdownTracing, err := tracing.Init(ctx, "argo-events-sensor",
+		attribute.String("argo_events.sensor.name", sensor.Name),
+		attribute.String("k8s.namespace.name", sensor.Namespace),
+		attribute.String("k8s.pod.name", hostname),
+	)
+	if err != nil {
+		logger.Fatalw("failed to initialize tracing", zap.Error(err))
+	}
+	defer func() {
+		if err := shutdownTracing(context.Background()); err != nil {
+			logger.Warnw("failed to flush the traces", zap.Error(err))
+		}
+	}()
+
 	logger.Infow("starting sensor server", "version", argoevents.GetVersion())
 	sensorExecutionCtx := sensors.NewSensorContext(kubeClient, dynamicClient, sensor, busConfig, ebSubject, hostname, m)
+	if os.Getenv(common.EnvVarSensorDryRun) == "true" {
+		logger.Info("dry run mode is enabled, triggers will not be executed")
+		sensorExecutionCtx.EnableDryRun()
+	}
+	if len(additionalBusConfigs) > 0 {
+		sensorExecutionCtx.SetAdditionalEventBuses(additionalBusConfigs)
+	}
+	if token := os.Getenv(common.EnvVarDebugToken); token != "" {
+		// The debug endpoint is served by the metrics server
+		http.Handle(common.DebugConfigPath, common.DebugConfigHandler(token, sensorExecutionCtx.DebugConfig))
+	}
 	if err := sensorExecutionCtx.Start(ctx); err != nil {
 		logger.Fatalw("failed to listen to events", zap.Error(err))
 	}
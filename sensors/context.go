@@ -18,14 +18,18 @@ limitations under the License.
 
 import (
 	"net/http"
+	"sync"
 	"time"
 
 	eventhubs "github.com/Azure/azure-event-hubs-go/v3"
 	servicebus "github.com/Azure/azure-sdk-for-go/sdk/messaging/azservicebus"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azqueue"
 	"github.com/IBM/sarama"
 	"github.com/apache/openwhisk-client-go/whisk"
 	"github.com/apache/pulsar-client-go/pulsar"
 	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/aws/aws-sdk-go/service/sns/snsiface"
+	"github.com/aws/aws-sdk-go/service/sqs/sqsiface"
 	natslib "github.com/nats-io/nats.go"
 	"google.golang.org/grpc"
 	"k8s.io/client-go/dynamic"
@@ -35,6 +39,7 @@ import (
 	sensormetrics "github.com/argoproj/argo-events/metrics"
 	eventbusv1alpha1 "github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1"
 	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+	sensordependencies "github.com/argoproj/argo-events/sensors/dependencies"
 )
 
 // SensorContext contains execution context for Sensor
@@ -47,6 +52,9 @@ type SensorContext struct {
 	sensor *v1alpha1.Sensor
 	// EventBus config
 	eventBusConfig *eventbusv1alpha1.BusConfig
+	// additionalEventBusConfigs are the configs of the additional EventBuses the dependencies consume from, keyed by
+	// EventBus name
+	additionalEventBusConfigs map[string]eventbusv1alpha1.BusConfig
 	// EventBus subject
 	eventBusSubject string
 	hostname        string
@@ -65,18 +73,44 @@ type SensorContext struct {
 	natsConnections common.StringKeyedMap[*natslib.Conn]
 	// awsLambdaClients holds the references to active AWS Lambda clients.
 	awsLambdaClients common.StringKeyedMap[*lambda.Lambda]
+	// awsSQSClients holds the references to active AWS SQS clients.
+	awsSQSClients common.StringKeyedMap[sqsiface.SQSAPI]
+	// awsSNSClients holds the references to active AWS SNS clients.
+	awsSNSClients common.StringKeyedMap[snsiface.SNSAPI]
 	// openwhiskClients holds the references to active OpenWhisk clients.
 	openwhiskClients common.StringKeyedMap[*whisk.Client]
 	// azureEventHubsClients holds the references to active Azure Event Hub clients.
 	azureEventHubsClients common.StringKeyedMap[*eventhubs.Hub]
 	// azureServiceBusClients holds the references to active Azure Service Bus clients.
 	azureServiceBusClients common.StringKeyedMap[*servicebus.Sender]
-	metrics                *sensormetrics.Metrics
+	// azureQueueStorageClients holds the references to active Azure Queue Storage clients.
+	azureQueueStorageClients common.StringKeyedMap[*azqueue.QueueClient]
+	metrics                  *sensormetrics.Metrics
+	// inFlight tracks the trigger executions in progress, used to drain them on termination.
+	inFlight sync.WaitGroup
+	// dryRun resolves the triggers without executing them.
+	dryRun bool
+	// dataSchemaValidator validates the event data against their dataschema, if enabled.
+	dataSchemaValidator *sensordependencies.DataSchemaValidator
+	// circuitBreakers holds the circuit breakers of the triggers, keyed by trigger name.
+	circuitBreakers map[string]*circuitBreaker
+	// triggerCaches holds the caches of the successful trigger executions, keyed by trigger name.
+	triggerCaches map[string]*triggerCache
+	// metricDependencies holds the dependencies resolved by a PromQL query, keyed by dependency name.
+	metricDependencies map[string]*metricDependency
+	// triggerStatus reports the trigger executions in the status, if enabled.
+	triggerStatus *triggerStatusReporter
+	// quota limits the trigger executions of the Sensor, if set.
+	quota *executionQuota
+	// pools runs the trigger executions on bounded pools of workers, if set.
+	pools *triggerPools
+	// subscriptions tracks the connections of the triggers to the EventBus, served by the debug endpoint.
+	subscriptions *subscriptionTracker
 }
 
 // NewSensorContext returns a new sensor execution context.
 func NewSensorContext(kubeClient kubernetes.Interface, dynamicClient dynamic.Interface, sensor *v1alpha1.Sensor, eventBusConfig *eventbusv1alpha1.BusConfig, eventBusSubject, hostname string, metrics *sensormetrics.Metrics) *SensorContext {
-	return &SensorContext{
+	sensorCtx := &SensorContext{
 		kubeClient:           kubeClient,
 		dynamicClient:        dynamicClient,
 		sensor:               sensor,
@@ -88,13 +122,61 @@ func NewSensorContext(kubeClient kubernetes.Interface, dynamicClient dynamic.Int
 		slackHTTPClient: &http.Client{
 			Timeout: time.Minute * 5,
 		},
-		kafkaProducers:         common.NewStringKeyedMap[sarama.AsyncProducer](),
-		pulsarProducers:        common.NewStringKeyedMap[pulsar.Producer](),
-		natsConnections:        common.NewStringKeyedMap[*natslib.Conn](),
-		awsLambdaClients:       common.NewStringKeyedMap[*lambda.Lambda](),
-		openwhiskClients:       common.NewStringKeyedMap[*whisk.Client](),
-		azureEventHubsClients:  common.NewStringKeyedMap[*eventhubs.Hub](),
-		azureServiceBusClients: common.NewStringKeyedMap[*servicebus.Sender](),
-		metrics:                metrics,
+		kafkaProducers:           common.NewStringKeyedMap[sarama.AsyncProducer](),
+		pulsarProducers:          common.NewStringKeyedMap[pulsar.Producer](),
+		natsConnections:          common.NewStringKeyedMap[*natslib.Conn](),
+		awsLambdaClients:         common.NewStringKeyedMap[*lambda.Lambda](),
+		awsSQSClients:            common.NewStringKeyedMap[sqsiface.SQSAPI](),
+		awsSNSClients:            common.NewStringKeyedMap[snsiface.SNSAPI](),
+		openwhiskClients:         common.NewStringKeyedMap[*whisk.Client](),
+		azureEventHubsClients:    common.NewStringKeyedMap[*eventhubs.Hub](),
+		azureServiceBusClients:   common.NewStringKeyedMap[*servicebus.Sender](),
+		azureQueueStorageClients: common.NewStringKeyedMap[*azqueue.QueueClient](),
+		metrics:                  metrics,
+		circuitBreakers:          make(map[string]*circuitBreaker),
+		triggerCaches:            make(map[string]*triggerCache),
+		metricDependencies:       make(map[string]*metricDependency),
+		subscriptions:            newSubscriptionTracker(),
 	}
+	for _, dep := range sensor.Spec.Dependencies {
+		if dep.IsMetric() {
+			sensorCtx.metricDependencies[dep.Name] = newMetricDependency(dep)
+		}
+	}
+	for _, trigger := range withAlternateTriggers(sensor.Spec.Triggers) {
+		if trigger.CircuitBreaker != nil && trigger.Template != nil {
+			sensorCtx.circuitBreakers[trigger.Template.Name] = newCircuitBreaker(*trigger.CircuitBreaker)
+		}
+		if trigger.Cache != nil && trigger.Template != nil {
+			sensorCtx.triggerCaches[trigger.Template.Name] = newTriggerCache(*trigger.Cache)
+		}
+	}
+	if sensor.Spec.DataSchemaValidation != nil {
+		sensorCtx.dataSchemaValidator = sensordependencies.NewDataSchemaValidator(sensor.Spec.DataSchemaValidation)
+	}
+	if sensor.Spec.TriggerStatus != nil {
+		sensorCtx.triggerStatus = newTriggerStatusReporter(*sensor.Spec.TriggerStatus)
+	}
+	if sensor.Spec.Quota != nil {
+		sensorCtx.quota = newExecutionQuota(*sensor.Spec.Quota)
+	}
+	for _, trigger := range sensor.Spec.Triggers {
+		if sensor.Spec.TriggerPools != nil || trigger.Pool != nil {
+			sensorCtx.pools = newTriggerPools(sensor, metrics)
+			break
+		}
+	}
+	return sensorCtx
+}
+
+// SetAdditionalEventBuses sets the configs of the additional EventBuses the dependencies consume from, keyed by
+// EventBus name.
+func (sensorCtx *SensorContext) SetAdditionalEventBuses(configs map[string]eventbusv1alpha1.BusConfig) {
+	sensorCtx.additionalEventBusConfigs = configs
+}
+
+// EnableDryRun makes the sensor resolve the triggers, including the template and resource parameters,
+// without executing them.
+func (sensorCtx *SensorContext) EnableDryRun() {
+	sensorCtx.dryRun = true
 }
@@ -0,0 +1,111 @@
+package sensors
+
+import (
+	"sync"
+	"sync/atomic"
+
+	argoevents "github.com/argoproj/argo-events"
+	"github.com/argoproj/argo-events/common"
+	eventbuscommon "github.com/argoproj/argo-events/eventbus/common"
+)
+
+// DebugConfig is the configuration of a Sensor pod, served by its debug endpoint to troubleshoot the triggers which
+// are not executed.
+type DebugConfig struct {
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+	Hostname  string `json:"hostname"`
+	Version   string `json:"version"`
+	DryRun    bool   `json:"dryRun"`
+	// Spec is the resolved spec of the Sensor, with the secret values redacted.
+	Spec interface{} `json:"spec"`
+	// EventBus is the config of the EventBus, with the secret values redacted.
+	EventBus interface{} `json:"eventBus"`
+	// AdditionalEventBuses are the configs of the additional EventBuses the dependencies consume from.
+	AdditionalEventBuses interface{} `json:"additionalEventBuses,omitempty"`
+	// Subscriptions are the subscriptions of the triggers to the EventBus, in the order of the triggers.
+	Subscriptions []TriggerSubscription `json:"subscriptions"`
+}
+
+// TriggerSubscription is the state of the subscription of a trigger to the EventBus.
+type TriggerSubscription struct {
+	Trigger      string   `json:"trigger"`
+	Dependencies []string `json:"dependencies,omitempty"`
+	// Connection describes the connection of the trigger, e.g. its client or its consumers, empty until it connects.
+	Connection string `json:"connection,omitempty"`
+	Connected  bool   `json:"connected"`
+	// Subscribed is true while the trigger receives the events of its dependencies.
+	Subscribed bool `json:"subscribed"`
+}
+
+// subscriptionTracker holds the connections of the triggers to the EventBus, keyed by trigger name.
+type subscriptionTracker struct {
+	lock          sync.Mutex
+	subscriptions map[string]*trackedSubscription
+}
+
+type trackedSubscription struct {
+	dependencies []string
+	conn         eventbuscommon.TriggerConnection
+	subLock      *uint32
+}
+
+func newSubscriptionTracker() *subscriptionTracker {
+	return &subscriptionTracker{subscriptions: make(map[string]*trackedSubscription)}
+}
+
+// track records the connection of a trigger, and the lock held while it is subscribed, on each (re)connection.
+func (t *subscriptionTracker) track(triggerName string, dependencies []string, conn eventbuscommon.TriggerConnection, subLock *uint32) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	t.subscriptions[triggerName] = &trackedSubscription{dependencies: dependencies, conn: conn, subLock: subLock}
+}
+
+// untrack removes the connection of a trigger once it is closed.
+func (t *subscriptionTracker) untrack(triggerName string) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	delete(t.subscriptions, triggerName)
+}
+
+func (t *subscriptionTracker) get(triggerName string) TriggerSubscription {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	result := TriggerSubscription{Trigger: triggerName}
+	s, ok := t.subscriptions[triggerName]
+	if !ok {
+		return result
+	}
+	result.Dependencies = s.dependencies
+	if s.conn != nil {
+		result.Connection = s.conn.String()
+		result.Connected = !s.conn.IsClosed()
+	}
+	result.Subscribed = s.subLock != nil && atomic.LoadUint32(s.subLock) == 1
+	return result
+}
+
+// DebugConfig returns the configuration of the Sensor pod, with the states of the subscriptions of its triggers.
+func (sensorCtx *SensorContext) DebugConfig() interface{} {
+	sensor := sensorCtx.sensor
+	config := &DebugConfig{
+		Namespace:     sensor.Namespace,
+		Name:          sensor.Name,
+		Hostname:      sensorCtx.hostname,
+		Version:       argoevents.GetVersion().Version,
+		DryRun:        sensorCtx.dryRun,
+		Spec:          common.Redact(sensor.Spec),
+		EventBus:      common.Redact(sensorCtx.eventBusConfig),
+		Subscriptions: make([]TriggerSubscription, 0, len(sensor.Spec.Triggers)),
+	}
+	if len(sensorCtx.additionalEventBusConfigs) > 0 {
+		config.AdditionalEventBuses = common.Redact(sensorCtx.additionalEventBusConfigs)
+	}
+	for _, trigger := range sensor.Spec.Triggers {
+		if trigger.Template == nil {
+			continue
+		}
+		config.Subscriptions = append(config.Subscriptions, sensorCtx.subscriptions.get(trigger.Template.Name))
+	}
+	return config
+}
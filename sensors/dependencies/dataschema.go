@@ -0,0 +1,137 @@
+package dependencies
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/santhosh-tekuri/jsonschema/v5"
+
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+)
+
+// maxDataSchemaSize is the maximum size of a fetched schema document.
+const maxDataSchemaSize = 1 << 20
+
+// SchemaViolationError is returned when the event data does not conform to its schema.
+type SchemaViolationError struct {
+	DataSchema string
+	Err        error
+}
+
+func (e *SchemaViolationError) Error() string {
+	return fmt.Sprintf("event data does not conform to the schema %s, %v", e.DataSchema, e.Err)
+}
+
+func (e *SchemaViolationError) Unwrap() error {
+	return e.Err
+}
+
+type cachedSchema struct {
+	schema    *jsonschema.Schema
+	expiresAt time.Time
+}
+
+// DataSchemaValidator validates the event data against the JSON Schema referred to by the "dataschema"
+// attribute of the event. The schemas are only fetched from the allowed URLs, and are cached.
+type DataSchemaValidator struct {
+	config     *v1alpha1.DataSchemaValidation
+	httpClient *http.Client
+	lock       sync.Mutex
+	schemas    map[string]*cachedSchema
+}
+
+// NewDataSchemaValidator returns a new DataSchemaValidator.
+func NewDataSchemaValidator(config *v1alpha1.DataSchemaValidation) *DataSchemaValidator {
+	return &DataSchemaValidator{
+		config:     config,
+		httpClient: &http.Client{Timeout: 10 * time.Second},
+		schemas:    make(map[string]*cachedSchema),
+	}
+}
+
+// Validate validates the data of the event. A *SchemaViolationError is returned if the data does not conform
+// to the schema, any other error means the schema could not be used.
+func (v *DataSchemaValidator) Validate(event *cloudevents.Event) error {
+	dataSchema := event.DataSchema()
+	if dataSchema == "" {
+		if v.config.Required {
+			return &SchemaViolationError{Err: fmt.Errorf("dataschema attribute is required")}
+		}
+		return nil
+	}
+	schema, err := v.getSchema(dataSchema)
+	if err != nil {
+		return err
+	}
+	var data interface{}
+	decoder := json.NewDecoder(bytes.NewReader(event.Data()))
+	decoder.UseNumber()
+	if err := decoder.Decode(&data); err != nil {
+		return &SchemaViolationError{DataSchema: dataSchema, Err: fmt.Errorf("event data is not valid JSON, %w", err)}
+	}
+	if err := schema.Validate(data); err != nil {
+		var validationErr *jsonschema.ValidationError
+		if errors.As(err, &validationErr) {
+			return &SchemaViolationError{DataSchema: dataSchema, Err: err}
+		}
+		return err
+	}
+	return nil
+}
+
+func (v *DataSchemaValidator) getSchema(url string) (*jsonschema.Schema, error) {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+	if cached, ok := v.schemas[url]; ok && time.Now().Before(cached.expiresAt) {
+		return cached.schema, nil
+	}
+	compiler := jsonschema.NewCompiler()
+	compiler.LoadURL = v.loadURL
+	schema, err := compiler.Compile(url)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compile the schema %s, %w", url, err)
+	}
+	v.schemas[url] = &cachedSchema{schema: schema, expiresAt: time.Now().Add(v.config.GetCacheTTL())}
+	return schema, nil
+}
+
+// loadURL fetches the schema documents, including the referenced ones, from the allowed URLs.
+func (v *DataSchemaValidator) loadURL(url string) (io.ReadCloser, error) {
+	allowed := false
+	for _, prefix := range v.config.AllowedURLs {
+		if strings.HasPrefix(url, prefix) {
+			allowed = true
+			break
+		}
+	}
+	if !allowed {
+		return nil, fmt.Errorf("schema url %s is not allowed", url)
+	}
+	resp, err := v.httpClient.Get(url)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to fetch %s, status code %d", url, resp.StatusCode)
+	}
+	b, err := io.ReadAll(io.LimitReader(resp.Body, maxDataSchemaSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(b) > maxDataSchemaSize {
+		return nil, fmt.Errorf("schema %s exceeds the maximum size of %d bytes", url, maxDataSchemaSize)
+	}
+	if !json.Valid(b) {
+		return nil, fmt.Errorf("schema %s is not valid JSON", url)
+	}
+	return io.NopCloser(bytes.NewReader(b)), nil
+}
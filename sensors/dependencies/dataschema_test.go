@@ -0,0 +1,98 @@
+package dependencies
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+)
+
+const testDataSchema = `{
+  "type": "object",
+  "properties": {
+    "body": {"$ref": "definitions.json#/definitions/body"}
+  },
+  "required": ["body"]
+}`
+
+const testDataSchemaDefinitions = `{
+  "definitions": {
+    "body": {
+      "type": "object",
+      "properties": {"count": {"type": "integer"}},
+      "required": ["count"]
+    }
+  }
+}`
+
+func TestDataSchemaValidator(t *testing.T) {
+	var fetches int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&fetches, 1)
+		switch r.URL.Path {
+		case "/schemas/event.json":
+			_, _ = w.Write([]byte(testDataSchema))
+		case "/schemas/definitions.json":
+			_, _ = w.Write([]byte(testDataSchemaDefinitions))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	newEvent := func(dataSchema, data string) *cloudevents.Event {
+		e := cloudevents.NewEvent()
+		e.SetID("1")
+		e.SetSource("webhook")
+		e.SetType("webhook")
+		if dataSchema != "" {
+			e.SetDataSchema(dataSchema)
+		}
+		_ = e.SetData(cloudevents.ApplicationJSON, []byte(data))
+		return &e
+	}
+	validator := NewDataSchemaValidator(&v1alpha1.DataSchemaValidation{AllowedURLs: []string{server.URL + "/schemas/"}})
+
+	t.Run("test valid data", func(t *testing.T) {
+		err := validator.Validate(newEvent(server.URL+"/schemas/event.json", `{"body": {"count": 3}}`))
+		assert.NoError(t, err)
+		assert.Equal(t, int32(2), atomic.LoadInt32(&fetches))
+	})
+
+	t.Run("test invalid data", func(t *testing.T) {
+		err := validator.Validate(newEvent(server.URL+"/schemas/event.json", `{"body": {"count": "three"}}`))
+		var violation *SchemaViolationError
+		assert.True(t, errors.As(err, &violation))
+		// The schema is cached
+		assert.Equal(t, int32(2), atomic.LoadInt32(&fetches))
+	})
+
+	t.Run("test without dataschema", func(t *testing.T) {
+		err := validator.Validate(newEvent("", `{}`))
+		assert.NoError(t, err)
+		err = NewDataSchemaValidator(&v1alpha1.DataSchemaValidation{AllowedURLs: []string{server.URL}, Required: true}).Validate(newEvent("", `{}`))
+		var violation *SchemaViolationError
+		assert.True(t, errors.As(err, &violation))
+	})
+
+	t.Run("test url not allowed", func(t *testing.T) {
+		err := validator.Validate(newEvent(server.URL+"/other/event.json", `{}`))
+		assert.Error(t, err)
+		var violation *SchemaViolationError
+		assert.False(t, errors.As(err, &violation))
+		assert.Contains(t, err.Error(), "not allowed")
+	})
+
+	t.Run("test schema not found", func(t *testing.T) {
+		err := validator.Validate(newEvent(server.URL+"/schemas/missing.json", `{}`))
+		assert.Error(t, err)
+		var violation *SchemaViolationError
+		assert.False(t, errors.As(err, &violation))
+	})
+}
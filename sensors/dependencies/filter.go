@@ -443,6 +443,10 @@ func filterContext(expected *v1alpha1.EventContext, actual *v1alpha1.EventContex
 	if expected.DataContentType != "" {
 		res = res && expected.DataContentType == actual.DataContentType
 	}
+	for name, value := range expected.Extensions {
+		actualValue, ok := actual.Extensions[name]
+		res = res && ok && value == actualValue
+	}
 	return res
 }
 
@@ -70,6 +70,38 @@ func TestFilterContext(t *testing.T) {
 			},
 			result: true,
 		},
+		{
+			name: "extensions are a subset",
+			expectedContext: &v1alpha1.EventContext{
+				Type:       "kafka",
+				Extensions: map[string]string{"tenant": "acme"},
+			},
+			actualContext: &v1alpha1.EventContext{
+				Type:       "kafka",
+				Extensions: map[string]string{"tenant": "acme", "region": "eu"},
+			},
+			result: true,
+		},
+		{
+			name: "different extensions",
+			expectedContext: &v1alpha1.EventContext{
+				Extensions: map[string]string{"tenant": "acme", "region": "us"},
+			},
+			actualContext: &v1alpha1.EventContext{
+				Extensions: map[string]string{"tenant": "acme", "region": "eu"},
+			},
+			result: false,
+		},
+		{
+			name: "missing extension",
+			expectedContext: &v1alpha1.EventContext{
+				Extensions: map[string]string{"tenant": "acme"},
+			},
+			actualContext: &v1alpha1.EventContext{
+				Type: "kafka",
+			},
+			result: false,
+		},
 		{
 			name:            "actual event context is nil",
 			expectedContext: &v1alpha1.EventContext{},
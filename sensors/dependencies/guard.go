@@ -0,0 +1,80 @@
+package dependencies
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"mime"
+	"strings"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+)
+
+// CheckGuards checks the payload of the event against the guards of a dependency, and returns the violation
+// if any. The JSON data is scanned without being decoded, and the scan stops at the first violation.
+func CheckGuards(guards *v1alpha1.PayloadGuards, event *cloudevents.Event) error {
+	if guards == nil {
+		return nil
+	}
+	data := event.Data()
+	if guards.MaxBytes > 0 && int64(len(data)) > guards.MaxBytes {
+		return fmt.Errorf("event data size %d exceeds the maximum of %d bytes", len(data), guards.MaxBytes)
+	}
+	if len(guards.ContentTypes) > 0 {
+		contentType := event.DataContentType()
+		if mediaType, _, err := mime.ParseMediaType(contentType); err == nil {
+			contentType = mediaType
+		}
+		allowed := false
+		for _, ct := range guards.ContentTypes {
+			if strings.EqualFold(ct, contentType) {
+				allowed = true
+				break
+			}
+		}
+		if !allowed {
+			return fmt.Errorf("event data content type %q is not allowed", contentType)
+		}
+	}
+	if guards.MaxDepth > 0 || guards.MaxArrayLength > 0 {
+		return checkJSONShape(data, int(guards.MaxDepth), int(guards.MaxArrayLength))
+	}
+	return nil
+}
+
+// checkJSONShape checks the nesting depth and the array lengths of the JSON data, the data which is not
+// valid JSON passes the check.
+func checkJSONShape(data []byte, maxDepth, maxArrayLength int) error {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	// The lengths of the open arrays, -1 for the open objects
+	var lengths []int
+	for {
+		token, err := decoder.Token()
+		if err != nil {
+			// The end of the data, or data which is not valid JSON
+			return nil
+		}
+		if delim, ok := token.(json.Delim); ok && (delim == '}' || delim == ']') {
+			lengths = lengths[:len(lengths)-1]
+			continue
+		}
+		if n := len(lengths); n > 0 && lengths[n-1] >= 0 {
+			lengths[n-1]++
+			if maxArrayLength > 0 && lengths[n-1] > maxArrayLength {
+				return fmt.Errorf("event data has an array longer than %d", maxArrayLength)
+			}
+		}
+		if delim, ok := token.(json.Delim); ok {
+			if delim == '[' {
+				lengths = append(lengths, 0)
+			} else {
+				lengths = append(lengths, -1)
+			}
+			if maxDepth > 0 && len(lengths) > maxDepth {
+				return fmt.Errorf("event data is nested deeper than %d", maxDepth)
+			}
+		}
+	}
+}
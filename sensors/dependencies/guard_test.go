@@ -0,0 +1,51 @@
+package dependencies
+
+import (
+	"testing"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+)
+
+func newGuardEvent(contentType, data string) *cloudevents.Event {
+	event := cloudevents.NewEvent()
+	event.SetID("1")
+	event.SetSource("fake-source")
+	event.SetType("fake-type")
+	_ = event.SetData(contentType, []byte(data))
+	return &event
+}
+
+func TestCheckGuards(t *testing.T) {
+	event := newGuardEvent("application/json; charset=utf-8", `{"a":[1,2,{"b":[3]}],"c":{"d":{}}}`)
+	assert.NoError(t, CheckGuards(nil, event))
+	assert.NoError(t, CheckGuards(&v1alpha1.PayloadGuards{MaxBytes: 100, MaxDepth: 4, MaxArrayLength: 3, ContentTypes: []string{"application/json"}}, event))
+
+	err := CheckGuards(&v1alpha1.PayloadGuards{MaxBytes: 10}, event)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "exceeds the maximum")
+
+	err = CheckGuards(&v1alpha1.PayloadGuards{MaxDepth: 3}, event)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "nested deeper than 3")
+
+	err = CheckGuards(&v1alpha1.PayloadGuards{MaxArrayLength: 2}, event)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "array longer than 2")
+
+	err = CheckGuards(&v1alpha1.PayloadGuards{ContentTypes: []string{"text/plain"}}, event)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "is not allowed")
+}
+
+func TestCheckJSONShape(t *testing.T) {
+	assert.NoError(t, checkJSONShape([]byte(`[[1,2],[3,4]]`), 2, 2))
+	assert.Error(t, checkJSONShape([]byte(`[[1,2],[3,4],[5]]`), 2, 2))
+	assert.Error(t, checkJSONShape([]byte(`[[[1]]]`), 2, 0))
+	// The object keys and values are not counted as array items
+	assert.NoError(t, checkJSONShape([]byte(`[{"a":1,"b":2,"c":3}]`), 0, 1))
+	// The data which is not JSON passes
+	assert.NoError(t, checkJSONShape([]byte(`not json`), 1, 1))
+}
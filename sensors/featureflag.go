@@ -0,0 +1,149 @@
+package sensors
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+
+	"github.com/argoproj/argo-events/common"
+	apicommon "github.com/argoproj/argo-events/pkg/apis/common"
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+	sensortriggers "github.com/argoproj/argo-events/sensors/triggers"
+)
+
+// ofrepRequest is the body of a flag evaluation request of the OpenFeature Remote Evaluation Protocol.
+type ofrepRequest struct {
+	Context map[string]interface{} `json:"context"`
+}
+
+// ofrepResponse is the body of a flag evaluation response, either the value or the error.
+type ofrepResponse struct {
+	Key          string      `json:"key"`
+	Value        interface{} `json:"value"`
+	Reason       string      `json:"reason,omitempty"`
+	Variant      string      `json:"variant,omitempty"`
+	ErrorCode    string      `json:"errorCode,omitempty"`
+	ErrorDetails string      `json:"errorDetails,omitempty"`
+}
+
+// evaluateFeatureFlag evaluates the feature flag gating a trigger, with the evaluation context extracted from
+// the events.
+func (sensorCtx *SensorContext) evaluateFeatureFlag(ctx context.Context, flag *v1alpha1.TriggerFeatureFlag, events map[string]*v1alpha1.Event) (bool, error) {
+	evalContext := map[string]interface{}{}
+	if len(flag.Context) > 0 {
+		payload, err := sensortriggers.ConstructPayload(events, flag.Context)
+		if err != nil {
+			return false, fmt.Errorf("failed to construct the evaluation context, %w", err)
+		}
+		if err := json.Unmarshal(payload, &evalContext); err != nil {
+			return false, fmt.Errorf("failed to construct the evaluation context, %w", err)
+		}
+	}
+	if flag.TargetingKey != nil {
+		value, _, err := sensortriggers.ResolveParamValue(flag.TargetingKey, events)
+		if err != nil {
+			return false, fmt.Errorf("failed to resolve the targeting key, %w", err)
+		}
+		if value != nil {
+			evalContext["targetingKey"] = *value
+		}
+	}
+	body, err := json.Marshal(ofrepRequest{Context: evalContext})
+	if err != nil {
+		return false, err
+	}
+
+	client, err := sensorCtx.featureFlagClient(flag)
+	if err != nil {
+		return false, err
+	}
+	endpoint := strings.TrimSuffix(flag.URL, "/") + "/ofrep/v1/evaluate/flags/" + url.PathEscape(flag.Key)
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(body))
+	if err != nil {
+		return false, fmt.Errorf("failed to construct request for %s, %w", endpoint, err)
+	}
+	request.Header.Set("Content-Type", "application/json")
+	if flag.BearerToken != nil {
+		token, err := common.GetSecretFromVolume(flag.BearerToken)
+		if err != nil {
+			return false, fmt.Errorf("failed to retrieve the bearer token, %w", err)
+		}
+		request.Header.Set("Authorization", "Bearer "+token)
+	}
+	response, err := client.Do(request)
+	if err != nil {
+		return false, fmt.Errorf("failed to evaluate the flag %q, %w", flag.Key, err)
+	}
+	defer response.Body.Close()
+	result := ofrepResponse{}
+	if err := json.NewDecoder(io.LimitReader(response.Body, 64*1024)).Decode(&result); err != nil {
+		return false, fmt.Errorf("failed to evaluate the flag %q, status %d", flag.Key, response.StatusCode)
+	}
+	if response.StatusCode != http.StatusOK || result.ErrorCode != "" {
+		return false, fmt.Errorf("failed to evaluate the flag %q, status %d: %s %s", flag.Key, response.StatusCode, result.ErrorCode, result.ErrorDetails)
+	}
+	value, ok := result.Value.(bool)
+	if !ok {
+		return false, fmt.Errorf("flag %q is not a boolean flag", flag.Key)
+	}
+	return value, nil
+}
+
+// featureFlagClient returns the HTTP client of the provider, shared with the HTTP triggers with the same TLS
+// configuration and timeout.
+func (sensorCtx *SensorContext) featureFlagClient(flag *v1alpha1.TriggerFeatureFlag) (*http.Client, error) {
+	timeout := 5 * time.Second
+	if flag.Timeout > 0 {
+		timeout = time.Duration(flag.Timeout) * time.Second
+	}
+	clientKey := common.MustHash(struct {
+		TLS     *apicommon.TLSConfig
+		Timeout int64
+	}{flag.TLS, int64(timeout / time.Second)})
+	client, ok := sensorCtx.httpClients.Load(clientKey)
+	if !ok {
+		client = &http.Client{Timeout: timeout}
+		if flag.TLS != nil {
+			tlsConfig, err := common.GetTLSConfig(flag.TLS)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get the tls configuration, %w", err)
+			}
+			client.Transport = &http.Transport{
+				TLSClientConfig: tlsConfig,
+			}
+		}
+		client, _ = sensorCtx.httpClients.LoadOrStore(clientKey, client)
+	}
+	return client, nil
+}
+
+// featureFlagOn returns whether the feature flag of a trigger lets the execution happen, its default value if the
+// flag can't be evaluated.
+func (sensorCtx *SensorContext) featureFlagOn(ctx context.Context, trigger v1alpha1.Trigger, events map[string]cloudevents.Event, log *zap.SugaredLogger) bool {
+	flag := trigger.FeatureFlag
+	eventsMapping := make(map[string]*v1alpha1.Event, len(events))
+	for depName, event := range events {
+		eventsMapping[depName] = convertEvent(event)
+	}
+	on, err := sensorCtx.evaluateFeatureFlag(ctx, flag, eventsMapping)
+	if err != nil {
+		log.Warnw("failed to evaluate the feature flag, using its default value", "flag", flag.Key, "defaultValue", flag.DefaultValue, zap.Error(err))
+		on = flag.DefaultValue
+	}
+	if !on {
+		log.Infow("skipping trigger execution, the feature flag is off", "flag", flag.Key)
+		trace.SpanFromContext(ctx).AddEvent("trigger.gated", trace.WithAttributes(attribute.String("flag", flag.Key)))
+	}
+	return on
+}
@@ -0,0 +1,72 @@
+package sensors
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/argoproj/argo-events/common/logging"
+	"github.com/argoproj/argo-events/metrics"
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+)
+
+func TestFeatureFlagOn(t *testing.T) {
+	var evalContext map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body := ofrepRequest{}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		evalContext = body.Context
+		switch r.URL.Path {
+		case "/ofrep/v1/evaluate/flags/rollout":
+			_ = json.NewEncoder(w).Encode(ofrepResponse{Key: "rollout", Value: body.Context["targetingKey"] == "tenant-a", Reason: "TARGETING_MATCH"})
+		case "/ofrep/v1/evaluate/flags/variant":
+			_ = json.NewEncoder(w).Encode(ofrepResponse{Key: "variant", Value: "blue"})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_ = json.NewEncoder(w).Encode(ofrepResponse{ErrorCode: "FLAG_NOT_FOUND", ErrorDetails: "flag not found"})
+		}
+	}))
+	defer server.Close()
+
+	newEvents := func(tenant string) map[string]cloudevents.Event {
+		e := cloudevents.NewEvent()
+		e.SetID("1")
+		e.SetSource("webhook")
+		e.SetType("webhook")
+		_ = e.SetData(cloudevents.ApplicationJSON, []byte(`{"tenant":"`+tenant+`","region":"eu"}`))
+		return map[string]cloudevents.Event{"dep": e}
+	}
+	trigger := v1alpha1.Trigger{
+		Template: &v1alpha1.TriggerTemplate{Name: "trigger"},
+		FeatureFlag: &v1alpha1.TriggerFeatureFlag{
+			Key:          "rollout",
+			URL:          server.URL,
+			TargetingKey: &v1alpha1.TriggerParameterSource{DependencyName: "dep", DataKey: "tenant"},
+			Context: []v1alpha1.TriggerParameter{
+				{Src: &v1alpha1.TriggerParameterSource{DependencyName: "dep", DataKey: "region"}, Dest: "region"},
+			},
+		},
+	}
+	obj := sensorObj.DeepCopy()
+	sensorCtx := NewSensorContext(nil, nil, obj, nil, "", "", metrics.NewMetrics(obj.Namespace))
+	log := logging.NewArgoEventsLogger()
+	ctx := context.Background()
+
+	assert.True(t, sensorCtx.featureFlagOn(ctx, trigger, newEvents("tenant-a"), log))
+	assert.Equal(t, map[string]interface{}{"targetingKey": "tenant-a", "region": "eu"}, evalContext)
+	assert.False(t, sensorCtx.featureFlagOn(ctx, trigger, newEvents("tenant-b"), log))
+
+	// The default value is used when the flag can't be evaluated
+	trigger.FeatureFlag.Key = "missing"
+	assert.False(t, sensorCtx.featureFlagOn(ctx, trigger, newEvents("tenant-a"), log))
+	trigger.FeatureFlag.DefaultValue = true
+	assert.True(t, sensorCtx.featureFlagOn(ctx, trigger, newEvents("tenant-a"), log))
+
+	_, err := sensorCtx.evaluateFeatureFlag(ctx, &v1alpha1.TriggerFeatureFlag{Key: "variant", URL: server.URL}, nil)
+	assert.ErrorContains(t, err, "not a boolean flag")
+}
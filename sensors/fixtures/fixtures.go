@@ -0,0 +1,260 @@
+/*
+Copyright 2020 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package fixtures runs sample events through the dependencies and triggers of a Sensor without
+// an EventBus or any trigger destination, so that the filter outcomes and the rendered trigger
+// payloads can be compared with golden files.
+package fixtures
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/Knetic/govaluate"
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+	"github.com/argoproj/argo-events/sensors"
+	sensordependencies "github.com/argoproj/argo-events/sensors/dependencies"
+	sensortriggers "github.com/argoproj/argo-events/sensors/triggers"
+)
+
+// Event is a sample event. The source and subject of the context are matched against
+// the eventSourceName and eventName of the Sensor dependencies.
+type Event struct {
+	Context v1alpha1.EventContext `json:"context"`
+	Data    json.RawMessage       `json:"data,omitempty"`
+}
+
+// Result is the outcome of running a set of sample events through a Sensor.
+type Result struct {
+	// Dependencies are the outcomes of the dependencies matching any of the events, keyed by dependency name.
+	Dependencies map[string]DependencyResult `json:"dependencies"`
+	// Triggers are the outcomes of the triggers, keyed by trigger name.
+	Triggers map[string]TriggerResult `json:"triggers"`
+}
+
+// DependencyResult is the outcome of a dependency.
+type DependencyResult struct {
+	// Passed is true if the event passed the transformation and the filters of the dependency.
+	Passed bool `json:"passed"`
+	// Error is the transformation or filtering error, if any.
+	Error string `json:"error,omitempty"`
+}
+
+// TriggerResult is the outcome of a trigger.
+type TriggerResult struct {
+	// Fired is true if the conditions of the trigger are satisfied by the passed dependencies.
+	Fired bool `json:"fired"`
+	// Payload is the rendered payload, or the rendered resource for the K8s and Argo Workflow triggers.
+	Payload json.RawMessage `json:"payload,omitempty"`
+	// Error is the rendering error, if any.
+	Error string `json:"error,omitempty"`
+}
+
+// Evaluate runs the events through the dependencies of the Sensor, and renders the triggers whose
+// conditions are satisfied. If several events match the same dependency, the last one passing wins.
+// Events without an ID get their position in the list as ID, so that the results are reproducible.
+func Evaluate(sensor *v1alpha1.Sensor, events []Event) (*Result, error) {
+	result := &Result{
+		Dependencies: make(map[string]DependencyResult),
+		Triggers:     make(map[string]TriggerResult),
+	}
+	passed := make(map[string]*v1alpha1.Event)
+	for i, event := range events {
+		if event.Context.ID == "" {
+			event.Context.ID = fmt.Sprintf("%d", i+1)
+		}
+		for _, dep := range sensor.Spec.Dependencies {
+			if dep.EventSourceName != event.Context.Source || dep.EventName != event.Context.Subject {
+				continue
+			}
+			argoEvent, err := applyDependency(dep, event)
+			depResult := DependencyResult{Passed: argoEvent != nil}
+			if err != nil {
+				depResult.Error = err.Error()
+			}
+			if prev, ok := result.Dependencies[dep.Name]; ok && prev.Passed && !depResult.Passed {
+				continue
+			}
+			result.Dependencies[dep.Name] = depResult
+			if argoEvent != nil {
+				passed[dep.Name] = argoEvent
+			}
+		}
+	}
+
+	for _, trigger := range sensor.Spec.Triggers {
+		if trigger.Template == nil {
+			return nil, fmt.Errorf("trigger template is not specified")
+		}
+		fired, err := evaluateConditions(sensor, trigger, passed)
+		if err != nil {
+			return nil, err
+		}
+		triggerResult := TriggerResult{Fired: fired}
+		if fired {
+			payload, err := renderTrigger(trigger, passed)
+			if err != nil {
+				triggerResult.Error = err.Error()
+			} else {
+				triggerResult.Payload = payload
+			}
+		}
+		result.Triggers[trigger.Template.Name] = triggerResult
+	}
+	return result, nil
+}
+
+// applyDependency applies the transformation and the filters of the dependency to the event,
+// it returns nil if the event doesn't pass.
+func applyDependency(dep v1alpha1.EventDependency, event Event) (*v1alpha1.Event, error) {
+	ce := cloudevents.NewEvent()
+	ce.SetID(event.Context.ID)
+	ce.SetSource(event.Context.Source)
+	ce.SetSubject(event.Context.Subject)
+	ce.SetType(event.Context.Type)
+	ce.SetTime(event.Context.Time.Time)
+	contentType := event.Context.DataContentType
+	if contentType == "" {
+		contentType = cloudevents.ApplicationJSON
+	}
+	if err := ce.SetData(contentType, []byte(event.Data)); err != nil {
+		return nil, err
+	}
+	transformed, err := sensordependencies.ApplyTransform(&ce, dep.Transform)
+	if err != nil {
+		return nil, fmt.Errorf("failed to apply transformation, %w", err)
+	}
+	argoEvent := &v1alpha1.Event{
+		Context: &v1alpha1.EventContext{
+			DataContentType: transformed.DataContentType(),
+			Source:          transformed.Source(),
+			SpecVersion:     transformed.SpecVersion(),
+			Type:            transformed.Type(),
+			Time:            event.Context.Time,
+			ID:              transformed.ID(),
+			Subject:         transformed.Subject(),
+		},
+		Data: transformed.Data(),
+	}
+	if dep.Filters == nil {
+		return argoEvent, nil
+	}
+	ok, err := sensordependencies.Filter(argoEvent, dep.Filters, dep.FiltersLogicalOperator)
+	if !ok {
+		return nil, err
+	}
+	return argoEvent, err
+}
+
+func evaluateConditions(sensor *v1alpha1.Sensor, trigger v1alpha1.Trigger, passed map[string]*v1alpha1.Event) (bool, error) {
+	depExpression, err := sensors.GetDependencyExpression(sensor, trigger)
+	if err != nil {
+		return false, fmt.Errorf("failed to get dependency expression of trigger %s, %w", trigger.Template.Name, err)
+	}
+	expr, err := govaluate.NewEvaluableExpression(strings.ReplaceAll(depExpression, "-", "\\-"))
+	if err != nil {
+		return false, fmt.Errorf("failed to parse dependency expression of trigger %s, %w", trigger.Template.Name, err)
+	}
+	parameters := make(map[string]interface{})
+	for _, dep := range sensor.Spec.Dependencies {
+		_, ok := passed[dep.Name]
+		parameters[dep.Name] = ok
+	}
+	result, err := expr.Evaluate(parameters)
+	if err != nil {
+		return false, fmt.Errorf("failed to evaluate dependency expression of trigger %s, %w", trigger.Template.Name, err)
+	}
+	return result == true, nil
+}
+
+// renderTrigger renders the payload of the trigger, the same way the trigger implementations do.
+func renderTrigger(trigger v1alpha1.Trigger, events map[string]*v1alpha1.Event) (json.RawMessage, error) {
+	if err := sensortriggers.ApplyTemplateParameters(events, &trigger); err != nil {
+		return nil, fmt.Errorf("failed to apply template parameters, %w", err)
+	}
+	template := trigger.Template
+	switch {
+	case template.K8s != nil:
+		return renderResource(template.K8s.Source, template.K8s.Parameters, events)
+	case template.ArgoWorkflow != nil:
+		return renderResource(template.ArgoWorkflow.Source, template.ArgoWorkflow.Parameters, events)
+	case template.HTTP != nil:
+		return sensortriggers.ConstructPayload(events, template.HTTP.Payload)
+	case template.AWSLambda != nil:
+		return sensortriggers.ConstructPayload(events, template.AWSLambda.Payload)
+	case template.AWSSQS != nil:
+		return sensortriggers.ConstructPayload(events, template.AWSSQS.Payload)
+	case template.AWSSNS != nil:
+		return sensortriggers.ConstructPayload(events, template.AWSSNS.Payload)
+	case template.CustomTrigger != nil:
+		return sensortriggers.ConstructPayload(events, template.CustomTrigger.Payload)
+	case template.Kafka != nil:
+		return sensortriggers.ConstructPayload(events, template.Kafka.Payload)
+	case template.NATS != nil:
+		return sensortriggers.ConstructPayload(events, template.NATS.Payload)
+	case template.OpenWhisk != nil:
+		return sensortriggers.ConstructPayload(events, template.OpenWhisk.Payload)
+	case template.AzureEventHubs != nil:
+		return sensortriggers.ConstructPayload(events, template.AzureEventHubs.Payload)
+	case template.Pulsar != nil:
+		return sensortriggers.ConstructPayload(events, template.Pulsar.Payload)
+	case template.AzureServiceBus != nil:
+		return sensortriggers.ConstructPayload(events, template.AzureServiceBus.Payload)
+	case template.AzureQueueStorage != nil:
+		return sensortriggers.ConstructPayload(events, template.AzureQueueStorage.Payload)
+	case template.Slack != nil:
+		return renderSpec(template.Slack, template.Slack.Parameters, events)
+	case template.Email != nil:
+		return renderSpec(template.Email, template.Email.Parameters, events)
+	case template.Prometheus != nil:
+		return renderSpec(template.Prometheus, template.Prometheus.Parameters, events)
+	case template.Loki != nil:
+		return sensortriggers.ConstructPayload(events, template.Loki.Payload)
+	case template.Elasticsearch != nil:
+		return sensortriggers.ConstructPayload(events, template.Elasticsearch.Payload)
+	case template.Jenkins != nil:
+		return renderSpec(template.Jenkins, template.Jenkins.Parameters, events)
+	case template.GithubWorkflow != nil:
+		return renderSpec(template.GithubWorkflow, template.GithubWorkflow.Parameters, events)
+	default:
+		return nil, nil
+	}
+}
+
+func renderResource(source *v1alpha1.ArtifactLocation, parameters []v1alpha1.TriggerParameter, events map[string]*v1alpha1.Event) (json.RawMessage, error) {
+	if source == nil || (source.Resource == nil && source.Inline == nil) {
+		return nil, fmt.Errorf("only inline resources can be rendered")
+	}
+	obj, err := sensortriggers.FetchKubernetesResource(source)
+	if err != nil {
+		return nil, err
+	}
+	if err := sensortriggers.ApplyResourceParameters(events, parameters, obj); err != nil {
+		return nil, err
+	}
+	return obj.MarshalJSON()
+}
+
+func renderSpec(spec interface{}, parameters []v1alpha1.TriggerParameter, events map[string]*v1alpha1.Event) (json.RawMessage, error) {
+	b, err := json.Marshal(spec)
+	if err != nil {
+		return nil, err
+	}
+	return sensortriggers.ApplyParams(b, parameters, events)
+}
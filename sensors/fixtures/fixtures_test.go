@@ -0,0 +1,73 @@
+package fixtures
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+)
+
+func TestEvaluate(t *testing.T) {
+	sensor := &v1alpha1.Sensor{
+		Spec: v1alpha1.SensorSpec{
+			Dependencies: []v1alpha1.EventDependency{
+				{Name: "dep-a", EventSourceName: "webhook", EventName: "a"},
+				{Name: "dep-b", EventSourceName: "webhook", EventName: "b"},
+			},
+			Triggers: []v1alpha1.Trigger{
+				{Template: &v1alpha1.TriggerTemplate{Name: "both", Log: &v1alpha1.LogTrigger{}}},
+				{Template: &v1alpha1.TriggerTemplate{Name: "any", Conditions: "dep-a || dep-b", Log: &v1alpha1.LogTrigger{}}},
+			},
+		},
+	}
+	eventA := Event{Context: v1alpha1.EventContext{Source: "webhook", Subject: "a"}, Data: json.RawMessage(`{}`)}
+	eventB := Event{Context: v1alpha1.EventContext{Source: "webhook", Subject: "b"}, Data: json.RawMessage(`{}`)}
+
+	result, err := Evaluate(sensor, []Event{eventA})
+	assert.NoError(t, err)
+	assert.True(t, result.Dependencies["dep-a"].Passed)
+	assert.NotContains(t, result.Dependencies, "dep-b")
+	assert.False(t, result.Triggers["both"].Fired)
+	assert.True(t, result.Triggers["any"].Fired)
+
+	result, err = Evaluate(sensor, []Event{eventA, eventB})
+	assert.NoError(t, err)
+	assert.True(t, result.Triggers["both"].Fired)
+	assert.True(t, result.Triggers["any"].Fired)
+}
+
+func TestRun(t *testing.T) {
+	sensor, err := LoadSensor("testdata/sensor.yaml")
+	assert.NoError(t, err)
+
+	t.Run("test golden files match", func(t *testing.T) {
+		mismatches, err := Run(sensor, "testdata/events", "testdata/events", false)
+		assert.NoError(t, err)
+		assert.Empty(t, mismatches)
+	})
+
+	t.Run("test golden files mismatch", func(t *testing.T) {
+		goldenDir := t.TempDir()
+		mismatches, err := Run(sensor, "testdata/events", goldenDir, false)
+		assert.NoError(t, err)
+		assert.Len(t, mismatches, 2)
+
+		_, err = Run(sensor, "testdata/events", goldenDir, true)
+		assert.NoError(t, err)
+		assert.FileExists(t, filepath.Join(goldenDir, "push"+GoldenFileSuffix))
+		mismatches, err = Run(sensor, "testdata/events", goldenDir, false)
+		assert.NoError(t, err)
+		assert.Empty(t, mismatches)
+
+		err = os.WriteFile(filepath.Join(goldenDir, "push"+GoldenFileSuffix), []byte("{}\n"), 0o644)
+		assert.NoError(t, err)
+		mismatches, err = Run(sensor, "testdata/events", goldenDir, false)
+		assert.NoError(t, err)
+		assert.Len(t, mismatches, 1)
+		assert.Equal(t, "{}\n", mismatches[0].Expected)
+	})
+}
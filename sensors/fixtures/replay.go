@@ -0,0 +1,166 @@
+package fixtures
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Knetic/govaluate"
+
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+	"github.com/argoproj/argo-events/sensors"
+)
+
+// Decision is a trigger execution reproduced by a replay.
+type Decision struct {
+	// Time is when the Sensor received the event satisfying the conditions of the trigger.
+	Time time.Time `json:"time"`
+	// Trigger is the name of the trigger.
+	Trigger string `json:"trigger"`
+	// Events are the IDs of the events the trigger is executed with, by dependency name.
+	Events map[string]string `json:"events"`
+	// Payload is the rendered payload, or the rendered resource for the K8s and Argo Workflow triggers.
+	Payload json.RawMessage `json:"payload,omitempty"`
+	// Error is the rendering error, if any.
+	Error string `json:"error,omitempty"`
+}
+
+// LoadCapture reads the events written by the capture of a Sensor, from a file or from the *.jsonl files of a
+// directory and its subdirectories, e.g. a copy of the bucket. The events are sorted by the time they were
+// received.
+func LoadCapture(path string) ([]sensors.CapturedEvent, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	files := []string{path}
+	if info.IsDir() {
+		files = nil
+		err = filepath.WalkDir(path, func(p string, d fs.DirEntry, err error) error {
+			if err == nil && !d.IsDir() && strings.HasSuffix(p, sensors.CaptureFileSuffix) {
+				files = append(files, p)
+			}
+			return err
+		})
+		if err != nil {
+			return nil, err
+		}
+		if len(files) == 0 {
+			return nil, fmt.Errorf("no capture file found in %s", path)
+		}
+	}
+	var events []sensors.CapturedEvent
+	for _, file := range files {
+		fileEvents, err := loadCaptureFile(file)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, fileEvents...)
+	}
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].ReceivedAt.Before(events[j].ReceivedAt)
+	})
+	return events, nil
+}
+
+func loadCaptureFile(path string) ([]sensors.CapturedEvent, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	var events []sensors.CapturedEvent
+	decoder := json.NewDecoder(f)
+	for {
+		event := sensors.CapturedEvent{}
+		if err := decoder.Decode(&event); err != nil {
+			if errors.Is(err, io.EOF) {
+				return events, nil
+			}
+			return nil, fmt.Errorf("failed to parse capture file %s, %w", path, err)
+		}
+		events = append(events, event)
+	}
+}
+
+// Replay runs the captured events through the Sensor in the order they were received, the way the Sensor
+// processes them: the events passing a dependency of a trigger are kept, the last one of each dependency, until
+// the conditions of the trigger are satisfied, then the trigger is executed with them and they are cleared.
+// The rate limits, the conditions resets and the other time based behaviors of the triggers are not reproduced.
+func Replay(sensor *v1alpha1.Sensor, events []sensors.CapturedEvent) ([]Decision, error) {
+	triggerDeps := make(map[string]map[string]bool)
+	for _, trigger := range sensor.Spec.Triggers {
+		if trigger.Template == nil {
+			return nil, fmt.Errorf("trigger template is not specified")
+		}
+		depExpression, err := sensors.GetDependencyExpression(sensor, trigger)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get dependency expression of trigger %s, %w", trigger.Template.Name, err)
+		}
+		expr, err := govaluate.NewEvaluableExpression(strings.ReplaceAll(depExpression, "-", "\\-"))
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse dependency expression of trigger %s, %w", trigger.Template.Name, err)
+		}
+		deps := make(map[string]bool)
+		for _, v := range expr.Vars() {
+			deps[v] = true
+		}
+		triggerDeps[trigger.Template.Name] = deps
+	}
+
+	pending := make(map[string]map[string]*v1alpha1.Event)
+	var decisions []Decision
+	for _, captured := range events {
+		event := Event{Context: captured.Context, Data: captured.Data}
+		if len(captured.Data) == 0 {
+			event.Data = json.RawMessage(captured.DataBase64)
+		}
+		for _, trigger := range sensor.Spec.Triggers {
+			name := trigger.Template.Name
+			updated := false
+			for _, dep := range sensor.Spec.Dependencies {
+				if !triggerDeps[name][dep.Name] || dep.EventSourceName != event.Context.Source || dep.EventName != event.Context.Subject {
+					continue
+				}
+				argoEvent, _ := applyDependency(dep, event)
+				if argoEvent == nil {
+					continue
+				}
+				if pending[name] == nil {
+					pending[name] = make(map[string]*v1alpha1.Event)
+				}
+				pending[name][dep.Name] = argoEvent
+				updated = true
+			}
+			if !updated {
+				continue
+			}
+			fired, err := evaluateConditions(sensor, trigger, pending[name])
+			if err != nil {
+				return nil, err
+			}
+			if !fired {
+				continue
+			}
+			decision := Decision{Time: captured.ReceivedAt, Trigger: name, Events: make(map[string]string)}
+			for depName, e := range pending[name] {
+				decision.Events[depName] = e.Context.ID
+			}
+			if payload, err := renderTrigger(trigger, pending[name]); err != nil {
+				decision.Error = err.Error()
+			} else {
+				decision.Payload = payload
+			}
+			decisions = append(decisions, decision)
+			delete(pending, name)
+		}
+	}
+	return decisions, nil
+}
@@ -0,0 +1,85 @@
+package fixtures
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+	"github.com/argoproj/argo-events/sensors"
+)
+
+func TestReplay(t *testing.T) {
+	sensor := &v1alpha1.Sensor{
+		Spec: v1alpha1.SensorSpec{
+			Dependencies: []v1alpha1.EventDependency{
+				{Name: "dep-a", EventSourceName: "webhook", EventName: "a", Filters: &v1alpha1.EventDependencyFilter{
+					Data: []v1alpha1.DataFilter{{Path: "ok", Type: v1alpha1.JSONTypeBool, Value: []string{"true"}}},
+				}},
+				{Name: "dep-b", EventSourceName: "webhook", EventName: "b"},
+			},
+			Triggers: []v1alpha1.Trigger{
+				{Template: &v1alpha1.TriggerTemplate{Name: "both", Log: &v1alpha1.LogTrigger{}}},
+				{Template: &v1alpha1.TriggerTemplate{Name: "a", Conditions: "dep-a", Log: &v1alpha1.LogTrigger{}}},
+			},
+		},
+	}
+	start := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	captured := func(i int, subject, data string) sensors.CapturedEvent {
+		return sensors.CapturedEvent{
+			ReceivedAt: start.Add(time.Duration(i) * time.Second),
+			Context:    v1alpha1.EventContext{ID: subject + "-" + string(rune('0'+i)), Source: "webhook", Subject: subject},
+			Data:       json.RawMessage(data),
+		}
+	}
+	events := []sensors.CapturedEvent{
+		captured(1, "a", `{"ok":false}`),
+		captured(2, "b", `{}`),
+		captured(3, "a", `{"ok":true}`),
+		captured(4, "b", `{}`),
+		captured(5, "a", `{"ok":true}`),
+	}
+
+	decisions, err := Replay(sensor, events)
+	assert.NoError(t, err)
+	assert.Len(t, decisions, 4)
+	assert.Equal(t, Decision{Time: start.Add(3 * time.Second), Trigger: "both", Events: map[string]string{"dep-a": "a-3", "dep-b": "b-2"}}, decisions[0])
+	assert.Equal(t, "a", decisions[1].Trigger)
+	assert.Equal(t, map[string]string{"dep-a": "a-3"}, decisions[1].Events)
+	// The events of the first executions are cleared
+	assert.Equal(t, "both", decisions[2].Trigger)
+	assert.Equal(t, map[string]string{"dep-a": "a-5", "dep-b": "b-4"}, decisions[2].Events)
+	assert.Equal(t, "a", decisions[3].Trigger)
+	assert.Equal(t, map[string]string{"dep-a": "a-5"}, decisions[3].Events)
+}
+
+func TestLoadCapture(t *testing.T) {
+	dir := t.TempDir()
+	assert.NoError(t, os.MkdirAll(filepath.Join(dir, "sensors", "test-sensor"), 0o755))
+	write := func(name, content string) {
+		assert.NoError(t, os.WriteFile(filepath.Join(dir, "sensors", "test-sensor", name), []byte(content), 0o644))
+	}
+	write("20260102T030500.000Z-pod-1.jsonl", `{"receivedAt":"2026-01-02T03:04:07Z","context":{"id":"2"},"data":{}}
+{"receivedAt":"2026-01-02T03:04:09Z","context":{"id":"4"},"data":{}}
+`)
+	write("20260102T030500.000Z-pod-2.jsonl", `{"receivedAt":"2026-01-02T03:04:08Z","context":{"id":"3"},"dataBase64":"dGV4dA=="}
+{"receivedAt":"2026-01-02T03:04:06Z","context":{"id":"1"},"data":{}}
+`)
+	write("README.md", "not a capture")
+
+	events, err := LoadCapture(dir)
+	assert.NoError(t, err)
+	var ids []string
+	for _, e := range events {
+		ids = append(ids, e.Context.ID)
+	}
+	assert.Equal(t, []string{"1", "2", "3", "4"}, ids)
+	assert.Equal(t, []byte("text"), events[2].DataBase64)
+
+	_, err = LoadCapture(t.TempDir())
+	assert.ErrorContains(t, err, "no capture file found")
+}
@@ -0,0 +1,105 @@
+package fixtures
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"sigs.k8s.io/yaml"
+
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+)
+
+// GoldenFileSuffix is the suffix of a golden file, appended to the name of the events file without extension.
+const GoldenFileSuffix = ".golden"
+
+// Mismatch is an events file whose result differs from its golden file.
+type Mismatch struct {
+	// EventsFile is the path of the events file.
+	EventsFile string
+	// Expected is the content of the golden file, empty if it doesn't exist.
+	Expected string
+	// Actual is the result of the events file.
+	Actual string
+}
+
+// LoadSensor reads a Sensor manifest in YAML or JSON.
+func LoadSensor(path string) (*v1alpha1.Sensor, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	sensor := &v1alpha1.Sensor{}
+	if err := yaml.UnmarshalStrict(b, sensor); err != nil {
+		return nil, fmt.Errorf("failed to parse sensor manifest %s, %w", path, err)
+	}
+	return sensor, nil
+}
+
+// LoadEvents reads an events file, which contains either one sample event or a list of them.
+func LoadEvents(path string) ([]Event, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var events []Event
+	if trimmed := bytes.TrimSpace(b); len(trimmed) > 0 && trimmed[0] == '[' {
+		err = json.Unmarshal(trimmed, &events)
+	} else {
+		event := Event{}
+		err = json.Unmarshal(trimmed, &event)
+		events = append(events, event)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse events file %s, %w", path, err)
+	}
+	return events, nil
+}
+
+// Run evaluates every *.json events file in eventsDir against the Sensor, and compares the results with
+// the golden files in goldenDir. If update is true, the golden files are written instead of compared.
+func Run(sensor *v1alpha1.Sensor, eventsDir, goldenDir string, update bool) ([]Mismatch, error) {
+	files, err := filepath.Glob(filepath.Join(eventsDir, "*.json"))
+	if err != nil {
+		return nil, err
+	}
+	if len(files) == 0 {
+		return nil, fmt.Errorf("no events file found in %s", eventsDir)
+	}
+	sort.Strings(files)
+	var mismatches []Mismatch
+	for _, file := range files {
+		events, err := LoadEvents(file)
+		if err != nil {
+			return nil, err
+		}
+		result, err := Evaluate(sensor, events)
+		if err != nil {
+			return nil, fmt.Errorf("failed to evaluate events file %s, %w", file, err)
+		}
+		actual, err := json.MarshalIndent(result, "", "  ")
+		if err != nil {
+			return nil, err
+		}
+		actual = append(actual, '\n')
+		goldenFile := filepath.Join(goldenDir, strings.TrimSuffix(filepath.Base(file), ".json")+GoldenFileSuffix)
+		if update {
+			if err := os.WriteFile(goldenFile, actual, 0o644); err != nil {
+				return nil, fmt.Errorf("failed to write golden file %s, %w", goldenFile, err)
+			}
+			continue
+		}
+		expected, err := os.ReadFile(goldenFile)
+		if err != nil && !os.IsNotExist(err) {
+			return nil, fmt.Errorf("failed to read golden file %s, %w", goldenFile, err)
+		}
+		if !bytes.Equal(expected, actual) {
+			mismatches = append(mismatches, Mismatch{EventsFile: file, Expected: string(expected), Actual: string(actual)})
+		}
+	}
+	return mismatches, nil
+}
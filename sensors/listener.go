@@ -18,7 +18,9 @@ package sensors
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"sort"
 	"strings"
 	"sync"
 	"sync/atomic"
@@ -36,7 +38,10 @@ import (
 	sensordependencies "github.com/argoproj/argo-events/sensors/dependencies"
 	sensortriggers "github.com/argoproj/argo-events/sensors/triggers"
 	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/cloudevents/sdk-go/v2/types"
 	cronlib "github.com/robfig/cron/v3"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/ratelimit"
 	"go.uber.org/zap"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -60,8 +65,9 @@ func (sensorCtx *SensorContext) Start(ctx context.Context) error {
 	leasename := fmt.Sprintf("sensor-%s", sensorCtx.sensor.Name)
 
 	// sensor for kafka eventbus can be scaled horizontally,
-	// therefore does not require an elector
-	if sensorCtx.eventBusConfig.Kafka != nil {
+	// therefore does not require an elector, neither does a sensor
+	// whose replicas partition the dependencies
+	if sensorCtx.eventBusConfig.Kafka != nil || sensorCtx.sensor.Spec.IsSharedDistribution() {
 		return sensorCtx.listenEvents(ctx)
 	}
 
@@ -71,20 +77,61 @@ func (sensorCtx *SensorContext) Start(ctx context.Context) error {
 		return err
 	}
 
+	drainTimeout := sensorCtx.sensor.Spec.GetDrainTimeout()
+	// The electors start leading in a goroutine, which may only be scheduled after RunOrDie returns, the lock orders
+	// its Add before the Wait, or prevents it from listening once the pod stops.
+	var leadingLock sync.Mutex
+	leading := &sync.WaitGroup{}
+	stopped := false
 	elector.RunOrDie(ctx, leaderelection.LeaderCallbacks{
 		OnStartedLeading: func(ctx context.Context) {
+			leadingLock.Lock()
+			if stopped {
+				leadingLock.Unlock()
+				return
+			}
+			leading.Add(1)
+			leadingLock.Unlock()
+			defer leading.Done()
 			if err := sensorCtx.listenEvents(ctx); err != nil {
 				log.Fatalw("failed to start", zap.Error(err))
 			}
 		},
 		OnStoppedLeading: func() {
+			if drainTimeout > 0 && ctx.Err() != nil {
+				// Terminating, the leadership is handed over to the new pod while the in-flight executions drain.
+				log.Infof("stopped leading: %s, draining in-flight trigger executions", sensorCtx.hostname)
+				return
+			}
 			log.Fatalf("leader lost: %s", sensorCtx.hostname)
 		},
 	})
 
+	leadingLock.Lock()
+	stopped = true
+	leadingLock.Unlock()
+	if drainTimeout > 0 {
+		leading.Wait()
+	}
 	return nil
 }
 
+// waitForInFlight waits for the in-flight trigger executions to finish, up to the given timeout.
+func (sensorCtx *SensorContext) waitForInFlight(ctx context.Context, timeout time.Duration) {
+	log := logging.FromContext(ctx)
+	done := make(chan struct{})
+	go func() {
+		sensorCtx.inFlight.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		log.Info("in-flight trigger executions are drained")
+	case <-time.After(timeout):
+		log.Warnf("timed out after %v waiting for in-flight trigger executions to drain", timeout)
+	}
+}
+
 func initRateLimiter(trigger v1alpha1.Trigger) {
 	duration := time.Second
 	if trigger.RateLimit != nil {
@@ -112,25 +159,139 @@ func (sensorCtx *SensorContext) listenEvents(ctx context.Context) error {
 
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
+	// The operations retried by the dry-run pods are not reported
+	if !sensorCtx.dryRun {
+		retryStatusDone := make(chan struct{})
+		defer func() {
+			cancel()
+			<-retryStatusDone
+		}()
+		go func() {
+			defer close(retryStatusDone)
+			sensorCtx.reportRetryStatus(ctx)
+		}()
+	}
+	// Trigger executions use a separate context, so that they are able to finish while draining.
+	execCtx := ctx
+	drainTimeout := sensor.Spec.GetDrainTimeout()
+	if drainTimeout > 0 {
+		var execCancel context.CancelFunc
+		execCtx, execCancel = context.WithCancel(context.WithoutCancel(ctx))
+		defer execCancel()
+	}
 	ebDriver, err := eventbus.GetSensorDriver(logging.WithLogger(ctx, logger), *sensorCtx.eventBusConfig, sensorCtx.sensor, sensorCtx.hostname)
 	if err != nil {
 		return err
 	}
-	err = common.DoWithRetry(&common.DefaultBackoff, func() error {
-		return ebDriver.Initialize()
-	})
-	if err != nil {
+	// The triggers connect through a driver joining the EventBuses, when dependencies consume from additional ones
+	var triggerDriver eventbuscommon.SensorDriver = ebDriver
+	if len(sensorCtx.additionalEventBusConfigs) > 0 {
+		if triggerDriver, err = sensorCtx.multiBusDriver(logging.WithLogger(ctx, logger), ebDriver); err != nil {
+			return err
+		}
+	}
+	if err := retryUntilAvailable(ctx, logger, "eventbus.initialize", sensor.Name, "initialize the eventbus", triggerDriver.Initialize); err != nil {
 		return err
 	}
+	if err := retryUntilAvailable(ctx, logger, "eventbus.subjects", sensor.Name, "find the subjects of the dependencies", func() error {
+		return checkSubjects(triggerDriver, sensor.Spec.Dependencies)
+	}); err != nil {
+		return err
+	}
+	// Only the drivers having a Key/Value store support trigger deduplication
+	deduplicator, _ := ebDriver.(eventbuscommon.Deduplicator)
+	// The replicas of a shared Sensor only consume the dependencies of their partition
+	var partition *sensorPartition
+	if sensor.Spec.IsSharedDistribution() {
+		if partition, err = sensorCtx.claimPartition(ctx, ebDriver, logger); err != nil {
+			return err
+		}
+	}
+
+	// The condition belongs to the live Sensor, the dry-run pods don't update it
+	if len(sensorCtx.circuitBreakers) > 0 && !sensorCtx.dryRun {
+		// Reset the condition possibly left over by the previous pods
+		go func() {
+			if err := sensorCtx.updateCircuitBreakersCondition(ctx); err != nil {
+				logger.Warnw("failed to update the circuit breakers condition", zap.Error(err))
+			}
+		}()
+	}
+
+	for _, m := range sensorCtx.metricDependencies {
+		if m.dep.Prometheus.GetInterval() > 0 {
+			go m.run(ctx, sensorCtx, logger)
+		}
+	}
+
+	var capture *eventCapture
+	if sensor.Spec.Capture != nil {
+		if capture, err = newEventCapture(*sensor.Spec.Capture, sensor.Name, sensorCtx.hostname); err != nil {
+			// The capture is a debugging aid, the Sensor runs without it
+			logger.Errorw("failed to create the capture, the received events are not captured", zap.Error(err))
+			capture = nil
+		} else {
+			captureCtx, captureCancel := context.WithCancel(ctx)
+			captureWg := &sync.WaitGroup{}
+			defer func() {
+				captureCancel()
+				captureWg.Wait()
+			}()
+			captureWg.Add(1)
+			go func() {
+				defer captureWg.Done()
+				capture.run(captureCtx)
+			}()
+		}
+	}
+
+	if sensorCtx.quota != nil && !sensorCtx.dryRun {
+		// Only the drivers having a Key/Value store keep the executions across the restarts of the pod
+		if store, ok := ebDriver.(eventbuscommon.QuotaStore); ok {
+			if err := sensorCtx.quota.persist(store, logger); err != nil {
+				logger.Warnw("failed to load the persisted executions of the quota, the quota starts over", zap.Error(err))
+			}
+		}
+		// Reset the condition possibly left over by the previous pods
+		go func() {
+			if err := sensorCtx.updateQuotaCondition(ctx); err != nil {
+				logger.Warnw("failed to update the quota condition", zap.Error(err))
+			}
+		}()
+	}
+
+	// The executions of the dry-run pods are not reported
+	reportWg := &sync.WaitGroup{}
+	if sensorCtx.triggerStatus != nil && !sensorCtx.dryRun {
+		reportCtx, reportCancel := context.WithCancel(context.WithoutCancel(ctx))
+		defer func() {
+			reportCancel()
+			reportWg.Wait()
+		}()
+		reportWg.Add(1)
+		go func() {
+			defer reportWg.Done()
+			sensorCtx.reportTriggerStatus(reportCtx)
+		}()
+	}
 
 	wg := &sync.WaitGroup{}
-	for _, t := range sensor.Spec.Triggers {
+	// subscribing tracks the triggers until their subscriptions stop, drained is closed once the in-flight executions
+	// are drained, the triggers keep their connections open until then so that the executions can be acknowledged.
+	subscribing := &sync.WaitGroup{}
+	drained := make(chan struct{})
+	for _, t := range withAlternateTriggers(sensor.Spec.Triggers) {
 		initRateLimiter(t)
+	}
+	for _, t := range sensor.Spec.Triggers {
 		wg.Add(1)
+		subscribing.Add(1)
 		go func(trigger v1alpha1.Trigger) {
 			triggerLogger := logger.With(logging.LabelTriggerName, trigger.Template.Name)
 
 			defer wg.Done()
+			stopSubscribing := sync.OnceFunc(subscribing.Done)
+			defer stopSubscribing()
 			depExpression, err := sensorCtx.getDependencyExpression(ctx, trigger)
 			if err != nil {
 				triggerLogger.Errorw("failed to get dependency expression", zap.Error(err))
@@ -144,6 +305,11 @@ func (sensorCtx *SensorContext) listenEvents(ctx context.Context) error {
 				return
 			}
 			depNames := unique(expr.Vars())
+			metricDepNames, err := metricDependencyNames(sensor, trigger)
+			if err != nil {
+				triggerLogger.Errorw("failed to get the metric dependencies", zap.Error(err))
+				return
+			}
 			deps := []eventbuscommon.Dependency{}
 			for _, depName := range depNames {
 				dep, ok := depMapping[depName]
@@ -155,24 +321,50 @@ func (sensorCtx *SensorContext) listenEvents(ctx context.Context) error {
 					Name:            dep.Name,
 					EventSourceName: dep.EventSourceName,
 					EventName:       dep.EventName,
+					StartPosition:   dep.StartPosition,
 				}
 				deps = append(deps, d)
 			}
+			if partition != nil {
+				if deps = partition.dependencies(trigger, depExpression, deps); len(deps) == 0 {
+					triggerLogger.Info("none of the dependencies of the trigger is in the partition of the replica")
+					return
+				}
+				depNames = make([]string, 0, len(deps))
+				for _, dep := range deps {
+					depNames = append(depNames, dep.Name)
+				}
+			}
 
 			var conn eventbuscommon.TriggerConnection
-			err = common.DoWithRetry(&common.DefaultBackoff, func() error {
+			err = retryUntilAvailable(ctx, triggerLogger, "eventbus.connect", sensor.Name+"/"+trigger.Template.Name, "connect to the eventbus", func() error {
 				var err error
-				conn, err = ebDriver.Connect(ctx, trigger.Template.Name, depExpression, deps, trigger.AtLeastOnce)
+				conn, err = triggerDriver.Connect(ctx, trigger.Template.Name, depExpression, deps, trigger.AtLeastOnce)
 				triggerLogger.Debugf("just created connection %v, %+v", &conn, conn)
 				return err
 			})
 			if err != nil {
-				triggerLogger.Fatalw("failed to connect to event bus", zap.Error(err))
+				triggerLogger.Errorw("failed to connect to event bus", zap.Error(err))
 				return
 			}
 			defer conn.Close()
 
+			var windows *activeWindows
+			if trigger.ActiveWindows != nil {
+				if windows, err = newActiveWindows(*trigger.ActiveWindows); err != nil {
+					triggerLogger.Errorw("failed to parse the active windows", zap.Error(err))
+					return
+				}
+			}
+
+			tracer := newDecisionTracer(sensor.Name, trigger.Template.Name)
+
 			transformFunc := func(depName string, event cloudevents.Event) (*cloudevents.Event, error) {
+				if capture != nil {
+					if err := capture.record(event); err != nil {
+						triggerLogger.Warnw("failed to capture the event", zap.Error(err))
+					}
+				}
 				dep, ok := depMapping[depName]
 				if !ok {
 					return nil, fmt.Errorf("dependency %s not found", dep.Name)
@@ -180,7 +372,11 @@ func (sensorCtx *SensorContext) listenEvents(ctx context.Context) error {
 				if dep.Transform == nil {
 					return &event, nil
 				}
-				return sensordependencies.ApplyTransform(&event, dep.Transform)
+				transformed, err := sensordependencies.ApplyTransform(&event, dep.Transform)
+				if err != nil {
+					tracer.filtered(depName, event, fmt.Sprintf("transform failed: %v", err))
+				}
+				return transformed, err
 			}
 
 			filterFunc := func(depName string, cloudEvent cloudevents.Event) bool {
@@ -188,7 +384,31 @@ func (sensorCtx *SensorContext) listenEvents(ctx context.Context) error {
 				if !ok {
 					return false
 				}
+				sensorCtx.metrics.DependencyEventReceived(sensor.Name, trigger.Template.Name, dep.EventSourceName, dep.Name)
+				// The guards are checked first, they are cheap and protect the expensive checks
+				if err := sensordependencies.CheckGuards(dep.Guards, &cloudEvent); err != nil {
+					// The data is not logged, it may be huge
+					triggerLogger.Warnf("Event [ID '%s', Source '%s'] discarded by the payload guards: %s", cloudEvent.ID(), cloudEvent.Source(), err.Error())
+					sensorCtx.metrics.DependencyEventGuardRejected(sensor.Name, trigger.Template.Name, dep.EventSourceName, dep.Name)
+					tracer.filtered(depName, cloudEvent, fmt.Sprintf("payload guards: %v", err))
+					return false
+				}
+				if sensorCtx.dataSchemaValidator != nil {
+					if err := sensorCtx.dataSchemaValidator.Validate(&cloudEvent); err != nil {
+						var violation *sensordependencies.SchemaViolationError
+						if errors.As(err, &violation) {
+							triggerLogger.Warnf("Event [%s] discarded due to schema violation: %s", eventToString(convertEvent(cloudEvent)), err.Error())
+							sensorCtx.metrics.DependencyEventSchemaInvalid(sensor.Name, trigger.Template.Name, dep.EventSourceName, dep.Name)
+							tracer.filtered(depName, cloudEvent, fmt.Sprintf("schema violation: %v", err))
+						} else {
+							triggerLogger.Errorf("Event [%s] discarded, failed to validate its data schema: %s", eventToString(convertEvent(cloudEvent)), err.Error())
+							tracer.filtered(depName, cloudEvent, fmt.Sprintf("schema validation failed: %v", err))
+						}
+						return false
+					}
+				}
 				if dep.Filters == nil {
+					tracer.matched(depName, cloudEvent)
 					return true
 				}
 				argoEvent := convertEvent(cloudEvent)
@@ -207,40 +427,142 @@ func (sensorCtx *SensorContext) listenEvents(ctx context.Context) error {
 						triggerLogger.Debugf("Event [%s] discarded due to filtering", eventToString(argoEvent))
 					}
 				}
+				if !result {
+					sensorCtx.metrics.DependencyEventFiltered(sensor.Name, trigger.Template.Name, dep.EventSourceName, dep.Name)
+					reason := "filters not met"
+					if err != nil {
+						reason = fmt.Sprintf("filtering error: %v", err)
+					}
+					tracer.filtered(depName, cloudEvent, reason)
+				} else {
+					tracer.matched(depName, cloudEvent)
+				}
 				return result
 			}
 
-			actionFunc := func(events map[string]cloudevents.Event) {
-				retryStrategy := trigger.RetryStrategy
-				if retryStrategy == nil {
-					retryStrategy = &apicommon.Backoff{Steps: 1}
+			// executeFunc executes the trigger with the events satisfying its conditions, done is called once with the
+			// result of the execution, which is nil if the trigger is not executed, or the dead letter trigger succeeded.
+			executeFunc := func(traceCtx context.Context, endTrace func(error), events map[string]cloudevents.Event, done func(error)) {
+				if len(metricDepNames) > 0 && !sensorCtx.metricDependenciesResolved(traceCtx, trigger.Template.Name, metricDepNames, events, triggerLogger) {
+					trace.SpanFromContext(traceCtx).End()
+					done(nil)
+					return
 				}
-				err := common.DoWithRetry(retryStrategy, func() error {
-					return sensorCtx.triggerActions(ctx, sensor, events, trigger)
-				})
+				if trigger.FeatureFlag != nil && !sensorCtx.featureFlagOn(traceCtx, trigger, events, triggerLogger) {
+					sensorCtx.metrics.ActionGated(sensor.Name, trigger.Template.Name)
+					trace.SpanFromContext(traceCtx).End()
+					done(nil)
+					return
+				}
+				idempotencyKey, admitted := sensorCtx.admitTriggerExecution(ctx, traceCtx, deduplicator, &trigger, events, triggerLogger)
+				if !admitted {
+					trace.SpanFromContext(traceCtx).End()
+					done(nil)
+					return
+				}
+				// The oversized executions are routed to the alternate trigger
+				execTrigger := trigger
+				if trigger.OversizeRoute != nil {
+					execTrigger = sensorCtx.routeOversized(traceCtx, sensor.Name, trigger, events, triggerLogger)
+				}
+				// The key is released once the execution has failed, so that the redeliveries are not dropped as
+				// duplicates. The executions which are not atLeastOnce only finish after triggerActions returns.
+				releaseKey := func(err error) {
+					if err == nil {
+						return
+					}
+					releaseIdempotencyKey(deduplicator, &trigger, idempotencyKey, triggerLogger)
+				}
+				report := func(err error) {
+					releaseKey(err)
+					done(err)
+				}
+				err := sensorCtx.executeTrigger(ctx, traceCtx, sensor, events, execTrigger, report, triggerLogger)
+				endTrace(err)
+				if sensorCtx.triggerStatus != nil && !sensorCtx.dryRun {
+					sensorCtx.triggerStatus.record(trigger.Template.Name, time.Now(), err)
+				}
+				deadLettered := false
 				if err != nil {
 					triggerLogger.Warnf("failed to trigger actions, %v", err)
-					sensorCtx.metrics.ActionRetriesFailed(sensor.Name, trigger.Template.Name)
-					if trigger.DlqTrigger != nil {
-						dlqRetryStrategy := trigger.DlqTrigger.RetryStrategy
+					sensorCtx.metrics.ActionRetriesFailed(sensor.Name, execTrigger.Template.Name)
+					if execTrigger.DlqTrigger != nil {
+						dlqRetryStrategy := execTrigger.DlqTrigger.RetryStrategy
 						if dlqRetryStrategy == nil {
 							dlqRetryStrategy = &apicommon.Backoff{Steps: 1}
 						}
 
 						triggerLogger.Debugf("invoking dlqTrigger")
-						dlqErr := common.DoWithRetry(dlqRetryStrategy, func() error {
-							return sensorCtx.triggerActions(ctx, sensor, events, *trigger.DlqTrigger)
+						dlqErr := common.DoWithTrackedRetry("trigger.deadletter", sensor.Name+"/"+execTrigger.DlqTrigger.Template.Name, dlqRetryStrategy, func() error {
+							return sensorCtx.triggerActions(execCtx, sensor, events, *execTrigger.DlqTrigger)
 						})
 
 						if dlqErr != nil {
 							triggerLogger.Errorf("failed to trigger dlqTrigger, %v", dlqErr)
-							sensorCtx.metrics.ActionRetriesFailed(sensor.Name, trigger.DlqTrigger.Template.Name)
+							sensorCtx.metrics.ActionRetriesFailed(sensor.Name, execTrigger.DlqTrigger.Template.Name)
+						} else {
+							deadLettered = true
 						}
 					}
 				}
+				// The asynchronous executions report their result themselves, err is only set if they did not start
+				if execTrigger.AtLeastOnce || err != nil {
+					releaseKey(err)
+					if deadLettered {
+						done(nil)
+					} else {
+						done(err)
+					}
+				}
+			}
+
+			var batch *triggerBatch
+			if trigger.Batch != nil {
+				// Only the drivers having a Key/Value store persist the batches
+				store, _ := ebDriver.(eventbuscommon.BatchStore)
+				batch = newTriggerBatch(trigger.Template.Name, *trigger.Batch, store, func(events map[string]cloudevents.Event, done func(error)) {
+					traceCtx, endTrace := tracer.startExecution(execCtx, events)
+					executeFunc(traceCtx, endTrace, events, done)
+				}, &sensorCtx.inFlight, triggerLogger)
+				if err := batch.restore(); err != nil {
+					triggerLogger.Errorw("failed to restore the persisted batch", zap.Error(err))
+				}
+				defer batch.stop()
+			}
+
+			actionFunc := func(events map[string]cloudevents.Event) {
+				traceCtx, endTrace := tracer.startExecution(execCtx, events)
+				if windows != nil && !windows.active(time.Now()) {
+					span := trace.SpanFromContext(traceCtx)
+					if windows.policy == v1alpha1.TriggerOutsideWindowsDefer {
+						triggerLogger.Infow("deferring trigger execution to the next active window", "opensAt", windows.nextOpen(time.Now()))
+						span.AddEvent("trigger.deferred")
+					}
+					// The events are not acknowledged while the execution is deferred
+					if !windows.admit(ctx) {
+						triggerLogger.Info("dropping trigger execution outside the active windows")
+						sensorCtx.metrics.ActionOutsideWindows(sensor.Name, trigger.Template.Name)
+						span.AddEvent("trigger.dropped")
+						span.End()
+						return
+					}
+				}
+				if batch != nil {
+					span := trace.SpanFromContext(traceCtx)
+					span.AddEvent("trigger.batched")
+					span.End()
+					if err := batch.add(events); err != nil {
+						triggerLogger.Errorw("failed to add the events to the batch", zap.Error(err))
+						sensorCtx.metrics.ActionFailed(sensor.Name, trigger.Template.Name)
+					}
+					return
+				}
+				executeFunc(traceCtx, endTrace, events, func(error) {})
 			}
 
 			var subLock uint32
+			sensorCtx.subscriptions.track(trigger.Template.Name, depNames, conn, &subLock)
+			defer sensorCtx.subscriptions.untrack(trigger.Template.Name)
 			wg1 := &sync.WaitGroup{}
 			closeSubCh := make(chan struct{})
 
@@ -303,6 +625,9 @@ func (sensorCtx *SensorContext) listenEvents(ctx context.Context) error {
 					triggerLogger.Infof("started subscribing to events for trigger %s with client connection %s", trigger.Template.Name, conn)
 
 					subject := &sensorCtx.eventBusSubject
+					if observer, ok := conn.(eventbuscommon.ConditionsObserver); ok {
+						observer.ObserveConditions(tracer.conditionsEvaluated)
+					}
 					err = conn.Subscribe(ctx, closeSubCh, resetConditionsCh, lastResetTime, transformFunc, filterFunc, actionFunc, subject)
 					if err != nil {
 						triggerLogger.Errorw("failed to subscribe to eventbus", zap.Any("connection", conn), zap.Error(err))
@@ -322,16 +647,26 @@ func (sensorCtx *SensorContext) listenEvents(ctx context.Context) error {
 				case <-ctx.Done():
 					triggerLogger.Infof("exiting eventbus connection daemon for client %s...", conn)
 					wg1.Wait()
+					if drainTimeout > 0 {
+						if batch != nil {
+							// The persisted batch is flushed by the next pod, only a flush already started is drained
+							batch.stop()
+						}
+						// Keep the connection open until the in-flight executions finish, so they can still be acknowledged.
+						stopSubscribing()
+						<-drained
+					}
 					return
 				case <-ticker.C:
 					if conn == nil || conn.IsClosed() {
 						triggerLogger.Info("EventBus connection lost, reconnecting...")
-						conn, err = ebDriver.Connect(ctx, trigger.Template.Name, depExpression, deps, trigger.AtLeastOnce)
+						conn, err = triggerDriver.Connect(ctx, trigger.Template.Name, depExpression, deps, trigger.AtLeastOnce)
 						if err != nil {
 							triggerLogger.Errorw("failed to reconnect to eventbus", zap.Any("connection", conn), zap.Error(err))
 							continue
 						}
 						triggerLogger.Infow("reconnected to EventBus.", zap.Any("connection", conn))
+						sensorCtx.subscriptions.track(trigger.Template.Name, depNames, conn, &subLock)
 
 						if atomic.LoadUint32(&subLock) == 1 {
 							triggerLogger.Debug("acquired sublock, instructing trigger to shutdown subscription")
@@ -355,10 +690,62 @@ func (sensorCtx *SensorContext) listenEvents(ctx context.Context) error {
 	<-ctx.Done()
 	logger.Info("Shutting down...")
 	cancel()
+	if drainTimeout > 0 {
+		// No execution is started once all the subscriptions are stopped
+		subscribing.Wait()
+		sensorCtx.waitForInFlight(logging.WithLogger(ctx, logger), drainTimeout)
+		close(drained)
+	}
 	wg.Wait()
 	return nil
 }
 
+type executionResultKey struct{}
+
+// withExecutionResult returns a context reporting the result of the executions which are not atLeastOnce, once they
+// finish asynchronously.
+func withExecutionResult(ctx context.Context, report func(error)) context.Context {
+	return context.WithValue(ctx, executionResultKey{}, report)
+}
+
+// reportExecutionResult reports the result of an asynchronous execution, if the context expects it.
+func reportExecutionResult(ctx context.Context, err error) {
+	if report, ok := ctx.Value(executionResultKey{}).(func(error)); ok {
+		report(err)
+	}
+}
+
+// executeTrigger executes a trigger with its retry strategy, unless its circuit breaker rejects the execution. The
+// executions which are not atLeastOnce finish asynchronously, their result is recorded by the circuit breaker and
+// passed to report once they finish.
+func (sensorCtx *SensorContext) executeTrigger(ctx, traceCtx context.Context, sensor *v1alpha1.Sensor, events map[string]cloudevents.Event, trigger v1alpha1.Trigger, report func(error), log *zap.SugaredLogger) error {
+	breaker := sensorCtx.circuitBreakers[trigger.Template.Name]
+	if breaker != nil && !breaker.allow() {
+		return errCircuitOpen
+	}
+	execTraceCtx := traceCtx
+	if !trigger.AtLeastOnce {
+		execTraceCtx = withExecutionResult(traceCtx, func(err error) {
+			if breaker != nil {
+				sensorCtx.recordCircuitBreaker(ctx, breaker, err, log)
+			}
+			report(err)
+		})
+	}
+	retryStrategy := trigger.RetryStrategy
+	if retryStrategy == nil {
+		retryStrategy = &apicommon.Backoff{Steps: 1}
+	}
+	err := common.DoWithTrackedRetry("trigger.execute", sensor.Name+"/"+trigger.Template.Name, retryStrategy, func() error {
+		return sensorCtx.triggerActions(execTraceCtx, sensor, events, trigger)
+	})
+	// The asynchronous executions are recorded once they finish, unless they did not start
+	if breaker != nil && (trigger.AtLeastOnce || err != nil) {
+		sensorCtx.recordCircuitBreaker(ctx, breaker, err, log)
+	}
+	return err
+}
+
 func (sensorCtx *SensorContext) triggerActions(ctx context.Context, sensor *v1alpha1.Sensor, events map[string]cloudevents.Event, trigger v1alpha1.Trigger) error {
 	eventsMapping := make(map[string]*v1alpha1.Event)
 	depNames := make([]string, 0, len(events))
@@ -368,23 +755,63 @@ func (sensorCtx *SensorContext) triggerActions(ctx context.Context, sensor *v1al
 		depNames = append(depNames, k)
 		eventIDs = append(eventIDs, v.ID())
 	}
+	sensorCtx.inFlight.Add(1)
+	if pool := sensorCtx.pools.get(trigger); pool != nil {
+		return sensorCtx.triggerOnPool(ctx, pool, sensor, trigger, eventsMapping, depNames, eventIDs)
+	}
 	if trigger.AtLeastOnce {
+		defer sensorCtx.inFlight.Done()
 		// By making this a blocking call, wait to Ack the message
 		// until this trigger is executed.
 		return sensorCtx.triggerWithRateLimit(ctx, sensor, trigger, eventsMapping, depNames, eventIDs)
 	} else {
 		go func() {
+			defer sensorCtx.inFlight.Done()
 			err := sensorCtx.triggerWithRateLimit(ctx, sensor, trigger, eventsMapping, depNames, eventIDs)
 			if err != nil {
 				// Log the error, and let it continue
 				logger := logging.FromContext(ctx)
 				logger.Errorw("Failed to execute a trigger", zap.Error(err), zap.String(logging.LabelTriggerName, trigger.Template.Name))
 			}
+			reportExecutionResult(ctx, err)
 		}()
 		return nil
 	}
 }
 
+// triggerOnPool executes the trigger on its pool of workers. Like without a pool, it waits for the execution if the
+// trigger is atLeastOnce, and only for room in the queue of the pool otherwise.
+func (sensorCtx *SensorContext) triggerOnPool(ctx context.Context, pool *triggerPool, sensor *v1alpha1.Sensor, trigger v1alpha1.Trigger, eventsMapping map[string]*v1alpha1.Event, depNames, eventIDs []string) error {
+	if trigger.AtLeastOnce {
+		defer sensorCtx.inFlight.Done()
+		done := make(chan error, 1)
+		if err := pool.submit(ctx, func(err error) {
+			if err == nil {
+				err = sensorCtx.triggerWithRateLimit(ctx, sensor, trigger, eventsMapping, depNames, eventIDs)
+			}
+			done <- err
+		}); err != nil {
+			return err
+		}
+		return <-done
+	}
+	err := pool.submit(ctx, func(err error) {
+		defer sensorCtx.inFlight.Done()
+		if err == nil {
+			err = sensorCtx.triggerWithRateLimit(ctx, sensor, trigger, eventsMapping, depNames, eventIDs)
+		}
+		if err != nil {
+			logger := logging.FromContext(ctx)
+			logger.Errorw("Failed to execute a trigger", zap.Error(err), zap.String(logging.LabelTriggerName, trigger.Template.Name))
+		}
+		reportExecutionResult(ctx, err)
+	})
+	if err != nil {
+		sensorCtx.inFlight.Done()
+	}
+	return err
+}
+
 func (sensorCtx *SensorContext) triggerWithRateLimit(ctx context.Context, sensor *v1alpha1.Sensor, trigger v1alpha1.Trigger, eventsMapping map[string]*v1alpha1.Event, depNames, eventIDs []string) error {
 	if rl, ok := rateLimiters[trigger.Template.Name]; ok {
 		rl.Take()
@@ -392,6 +819,10 @@ func (sensorCtx *SensorContext) triggerWithRateLimit(ctx context.Context, sensor
 
 	log := logging.FromContext(ctx)
 	if err := sensorCtx.triggerOne(ctx, sensor, trigger, eventsMapping, depNames, eventIDs, log); err != nil {
+		if errors.Is(err, errResultCached) {
+			sensorCtx.metrics.ActionCached(sensor.Name, trigger.Template.Name)
+			return nil
+		}
 		// Log the error, and let it continue
 		log.Errorw("Failed to execute a trigger", zap.Error(err), zap.String(logging.LabelTriggerName, trigger.Template.Name),
 			zap.Any("triggeredBy", depNames), zap.Any("triggeredByEvents", eventIDs))
@@ -436,15 +867,47 @@ func (sensorCtx *SensorContext) triggerOne(ctx context.Context, sensor *v1alpha1
 		return err
 	}
 
+	if sensorCtx.dryRun {
+		logger.Infow(fmt.Sprintf("Dry run, skipped the execution of trigger '%s'", trigger.Template.Name),
+			zap.Any("triggeredBy", depNames), zap.Any("triggeredByEvents", eventIDs))
+		return nil
+	}
+
+	var cacheKey string
+	cache := sensorCtx.triggerCaches[trigger.Template.Name]
+	if cache != nil {
+		var key string
+		payload, err := sensortriggers.ConstructPayload(eventsMapping, triggerPayload(trigger.Template))
+		if err == nil {
+			key, err = cache.key(eventsMapping, updatedObj, payload)
+		}
+		if err != nil {
+			logger.Warnw("failed to get the cache key, skipping the cache", zap.Error(err))
+		} else if !cache.claim(key) {
+			logger.Infow("skipping trigger execution, an identical request was executed recently", "cacheKey", key,
+				zap.Any("triggeredBy", depNames), zap.Any("triggeredByEvents", eventIDs))
+			trace.SpanFromContext(ctx).AddEvent("trigger.cached", trace.WithAttributes(attribute.String("cacheKey", key)))
+			return errResultCached
+		} else {
+			cacheKey = key
+		}
+	}
+
 	logger.Debug("executing the trigger resource")
 	newObj, err := triggerImpl.Execute(ctx, eventsMapping, updatedObj)
 	if err != nil {
+		if cacheKey != "" {
+			cache.release(cacheKey)
+		}
 		return fmt.Errorf("failed to execute trigger, %w", err)
 	}
 	logger.Debug("trigger resource successfully executed")
 
 	logger.Debug("applying trigger policy")
 	if err := triggerImpl.ApplyPolicy(ctx, newObj); err != nil {
+		if cacheKey != "" {
+			cache.release(cacheKey)
+		}
 		return err
 	}
 	logger.Infow(fmt.Sprintf("Successfully processed trigger '%s'", trigger.Template.Name),
@@ -454,7 +917,17 @@ func (sensorCtx *SensorContext) triggerOne(ctx context.Context, sensor *v1alpha1
 
 func (sensorCtx *SensorContext) getDependencyExpression(ctx context.Context, trigger v1alpha1.Trigger) (string, error) {
 	logger := logging.FromContext(ctx)
+	depExpression, err := GetDependencyExpression(sensorCtx.sensor, trigger)
+	if err != nil {
+		logger.Errorw("Failed to parse original dependency expression", zap.Error(err))
+		return "", err
+	}
+	logger.Infof("Dependency expression for trigger %s: %s", trigger.Template.Name, depExpression)
+	return depExpression, nil
+}
 
+// GetDependencyExpression returns the expression of the trigger conditions, which only contains dependency names.
+func GetDependencyExpression(sensor *v1alpha1.Sensor, trigger v1alpha1.Trigger) (string, error) {
 	// Translate original expression which might contain group names
 	// to an expression only contains dependency names
 	translate := func(originalExpr string, parameters map[string]string) (string, error) {
@@ -466,13 +939,11 @@ func (sensorCtx *SensorContext) getDependencyExpression(ctx context.Context, tri
 
 		program, err := expr.Compile(originalExpr, expr.Env(parameters))
 		if err != nil {
-			logger.Errorw("Failed to compile original dependency expression", zap.Error(err))
-			return "", err
+			return "", fmt.Errorf("failed to compile original dependency expression, %w", err)
 		}
 		result, err := expr.Run(program, parameters)
 		if err != nil {
-			logger.Errorw("Failed to parse original dependency expression", zap.Error(err))
-			return "", err
+			return "", fmt.Errorf("failed to parse original dependency expression, %w", err)
 		}
 		newExpr := fmt.Sprintf("%v", result)
 		newExpr = strings.ReplaceAll(newExpr, "\"(\"", "(")
@@ -480,9 +951,6 @@ func (sensorCtx *SensorContext) getDependencyExpression(ctx context.Context, tri
 		return newExpr, nil
 	}
 
-	sensor := sensorCtx.sensor
-	var depExpression string
-	var err error
 	switch {
 	case trigger.Template.Conditions != "":
 		conditions := trigger.Template.Conditions
@@ -491,20 +959,101 @@ func (sensorCtx *SensorContext) getDependencyExpression(ctx context.Context, tri
 		for _, dep := range sensor.Spec.Dependencies {
 			key := strings.ReplaceAll(dep.Name, "-", "_")
 			depGroupMapping[key] = dep.Name
+			// The metric dependencies are resolved by the Sensor after the event dependencies
+			if dep.IsMetric() {
+				depGroupMapping[key] = "true"
+			}
 		}
-		depExpression, err = translate(conditions, depGroupMapping)
-		if err != nil {
-			return "", err
-		}
+		return translate(conditions, depGroupMapping)
 	default:
 		deps := []string{}
 		for _, dep := range sensor.Spec.Dependencies {
-			deps = append(deps, dep.Name)
+			if !dep.IsMetric() {
+				deps = append(deps, dep.Name)
+			}
 		}
-		depExpression = strings.Join(deps, "&&")
+		return strings.Join(deps, "&&"), nil
+	}
+}
+
+// metricDependencyNames returns the names of the metric dependencies of a trigger, the ones in its conditions or
+// all of them without conditions.
+func metricDependencyNames(sensor *v1alpha1.Sensor, trigger v1alpha1.Trigger) ([]string, error) {
+	referenced := map[string]bool{}
+	if trigger.Template.Conditions != "" {
+		expr, err := govaluate.NewEvaluableExpression(strings.ReplaceAll(trigger.Template.Conditions, "-", "\\-"))
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse the trigger conditions, %w", err)
+		}
+		for _, name := range expr.Vars() {
+			referenced[name] = true
+		}
+	}
+	var names []string
+	for _, dep := range sensor.Spec.Dependencies {
+		if dep.IsMetric() && (trigger.Template.Conditions == "" || referenced[dep.Name]) {
+			names = append(names, dep.Name)
+		}
+	}
+	return names, nil
+}
+
+// getIdempotencyKey renders the idempotency key of a trigger execution, defaults to the sorted event IDs.
+func getIdempotencyKey(events map[string]cloudevents.Event, dedup *v1alpha1.TriggerDeduplication) (string, error) {
+	if dedup.KeyTemplate == "" {
+		ids := make([]string, 0, len(events))
+		for _, event := range events {
+			ids = append(ids, event.ID())
+		}
+		sort.Strings(ids)
+		return strings.Join(ids, ","), nil
+	}
+	eventsMapping := make(map[string]*v1alpha1.Event, len(events))
+	for depName, event := range events {
+		eventsMapping[depName] = convertEvent(event)
+	}
+	return sensortriggers.RenderTemplate(eventsMapping, dedup.KeyTemplate)
+}
+
+// admitTriggerExecution claims the idempotency key of a trigger execution, and applies the quota of the Sensor to it.
+// It returns the claimed key, if any, and false if the execution is a duplicate or is dropped by the quota. The key of
+// a dropped execution is released, so that its redelivery is not discarded as a duplicate. The dry-run pods neither
+// claim the keys nor count against the quota, so that they don't hold back the executions of the live Sensor.
+func (sensorCtx *SensorContext) admitTriggerExecution(ctx, traceCtx context.Context, deduplicator eventbuscommon.Deduplicator, trigger *v1alpha1.Trigger, events map[string]cloudevents.Event, log *zap.SugaredLogger) (string, bool) {
+	if sensorCtx.dryRun {
+		return "", true
+	}
+	var idempotencyKey string
+	if trigger.Deduplication != nil && deduplicator != nil {
+		key, err := getIdempotencyKey(events, trigger.Deduplication)
+		if err != nil {
+			log.Warnw("failed to get idempotency key, skipping deduplication", zap.Error(err))
+		} else if claimed, err := deduplicator.ClaimKey(trigger.Template.Name, key, trigger.Deduplication.GetWindow()); err != nil {
+			log.Warnw("failed to claim idempotency key, skipping deduplication", zap.Error(err))
+		} else if !claimed {
+			log.Infow("skipping duplicate trigger execution", "idempotencyKey", key)
+			sensorCtx.metrics.ActionDeduplicated(sensorCtx.sensor.Name, trigger.Template.Name)
+			trace.SpanFromContext(traceCtx).AddEvent("trigger.deduplicated", trace.WithAttributes(attribute.String("idempotencyKey", key)))
+			return "", false
+		} else {
+			idempotencyKey = key
+		}
+	}
+	if sensorCtx.quota != nil && !sensorCtx.admitExecution(ctx, traceCtx, trigger.Template.Name, log) {
+		releaseIdempotencyKey(deduplicator, trigger, idempotencyKey, log)
+		return "", false
+	}
+	return idempotencyKey, true
+}
+
+// releaseIdempotencyKey releases the claimed idempotency key of an execution which did not go through.
+func releaseIdempotencyKey(deduplicator eventbuscommon.Deduplicator, trigger *v1alpha1.Trigger, key string, log *zap.SugaredLogger) {
+	if key == "" {
+		return
+	}
+	if err := deduplicator.ReleaseKey(trigger.Template.Name, key, trigger.Deduplication.GetWindow()); err != nil {
+		log.Warnw("failed to release idempotency key", zap.Error(err))
 	}
-	logger.Infof("Dependency expression for trigger %s: %s", trigger.Template.Name, depExpression)
-	return depExpression, nil
 }
 
 func eventToString(event *v1alpha1.Event) string {
@@ -513,6 +1062,17 @@ func eventToString(event *v1alpha1.Event) string {
 }
 
 func convertEvent(event cloudevents.Event) *v1alpha1.Event {
+	var extensions map[string]string
+	for name, value := range event.Extensions() {
+		if extensions == nil {
+			extensions = make(map[string]string)
+		}
+		if s, err := types.Format(value); err == nil {
+			extensions[name] = s
+		} else {
+			extensions[name] = fmt.Sprintf("%v", value)
+		}
+	}
 	return &v1alpha1.Event{
 		Context: &v1alpha1.EventContext{
 			DataContentType: event.Context.GetDataContentType(),
@@ -522,6 +1082,7 @@ func convertEvent(event cloudevents.Event) *v1alpha1.Event {
 			Time:            metav1.Time{Time: event.Context.GetTime()},
 			ID:              event.Context.GetID(),
 			Subject:         event.Context.GetSubject(),
+			Extensions:      extensions,
 		},
 		Data: event.Data(),
 	}
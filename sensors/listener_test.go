@@ -18,11 +18,18 @@ package sensors
 
 import (
 	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
 	"testing"
+	"time"
 
+	cloudevents "github.com/cloudevents/sdk-go/v2"
 	"github.com/stretchr/testify/assert"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	"github.com/argoproj/argo-events/common/logging"
+	"github.com/argoproj/argo-events/metrics"
 	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
 )
 
@@ -145,4 +152,144 @@ func TestGetDependencyExpression(t *testing.T) {
 		_, err := sensorCtx.getDependencyExpression(context.Background(), *trig)
 		assert.NoError(t, err)
 	})
+
+	t.Run("get expression with metric dependencies", func(t *testing.T) {
+		obj := sensorObj.DeepCopy()
+		obj.Spec.Dependencies = []v1alpha1.EventDependency{
+			{
+				Name:            "dep-1",
+				EventSourceName: "webhook",
+				EventName:       "example-1",
+			},
+			{
+				Name:       "queue-high",
+				Prometheus: &v1alpha1.PrometheusDependency{URL: "http://prometheus:9090", Query: "sum(queue_depth) > 100"},
+			},
+		}
+		sensorCtx := &SensorContext{
+			sensor: obj,
+		}
+		expr, err := sensorCtx.getDependencyExpression(context.Background(), *fakeTrigger)
+		assert.NoError(t, err)
+		assert.Equal(t, "dep-1", expr)
+		names, err := metricDependencyNames(obj, *fakeTrigger)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"queue-high"}, names)
+
+		trig := fakeTrigger.DeepCopy()
+		trig.Template.Conditions = "dep-1 && queue-high"
+		expr, err = sensorCtx.getDependencyExpression(context.Background(), *trig)
+		assert.NoError(t, err)
+		assert.Equal(t, "dep-1&&true", expr)
+		trig.Template.Conditions = "dep-1"
+		names, err = metricDependencyNames(obj, *trig)
+		assert.NoError(t, err)
+		assert.Empty(t, names)
+	})
+}
+
+func TestGetIdempotencyKey(t *testing.T) {
+	newEvent := func(id, data string) cloudevents.Event {
+		e := cloudevents.NewEvent()
+		e.SetID(id)
+		e.SetSource("webhook")
+		e.SetType("webhook")
+		_ = e.SetData(cloudevents.ApplicationJSON, []byte(data))
+		return e
+	}
+	events := map[string]cloudevents.Event{
+		"dep-b": newEvent("2", `{"order": "b"}`),
+		"dep-a": newEvent("1", `{"order": "a"}`),
+	}
+
+	t.Run("test default key", func(t *testing.T) {
+		key, err := getIdempotencyKey(events, &v1alpha1.TriggerDeduplication{})
+		assert.NoError(t, err)
+		assert.Equal(t, "1,2", key)
+	})
+
+	t.Run("test key template", func(t *testing.T) {
+		key, err := getIdempotencyKey(events, &v1alpha1.TriggerDeduplication{
+			KeyTemplate: `{{ (index .Input "dep-a").data.order }}-{{ (index .Input "dep-b").data.order }}`,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, "a-b", key)
+	})
+}
+
+func TestTriggerOneDryRun(t *testing.T) {
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+	}))
+	defer server.Close()
+
+	trigger := v1alpha1.Trigger{
+		Template: &v1alpha1.TriggerTemplate{
+			Name: "http-trigger",
+			HTTP: &v1alpha1.HTTPTrigger{
+				URL:    server.URL,
+				Method: http.MethodPost,
+			},
+		},
+	}
+	obj := sensorObj.DeepCopy()
+	obj.Spec.Triggers = []v1alpha1.Trigger{trigger}
+	sensorCtx := NewSensorContext(nil, nil, obj, nil, "", "", metrics.NewMetrics(obj.Namespace))
+	log := logging.NewArgoEventsLogger()
+
+	sensorCtx.EnableDryRun()
+	err := sensorCtx.triggerOne(context.Background(), obj, trigger, map[string]*v1alpha1.Event{}, nil, nil, log)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(0), atomic.LoadInt32(&requests))
+
+	sensorCtx.dryRun = false
+	err = sensorCtx.triggerOne(context.Background(), obj, trigger, map[string]*v1alpha1.Event{}, nil, nil, log)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&requests))
+}
+
+func TestConvertEventExtensions(t *testing.T) {
+	event := cloudevents.NewEvent()
+	event.SetID("1")
+	event.SetType("kafka")
+	event.SetSource("kafka-source")
+	event.SetExtension("tenant", "acme")
+	event.SetExtension("attempt", 3)
+	converted := convertEvent(event)
+	assert.Equal(t, map[string]string{"tenant": "acme", "attempt": "3"}, converted.Context.Extensions)
+	assert.Nil(t, convertEvent(cloudevents.NewEvent()).Context.Extensions)
+}
+
+func TestTriggerActionsReportsAsyncResult(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	url := server.URL
+	server.Close()
+
+	trigger := v1alpha1.Trigger{
+		Template: &v1alpha1.TriggerTemplate{
+			Name: "http-trigger",
+			HTTP: &v1alpha1.HTTPTrigger{
+				URL:    url,
+				Method: http.MethodPost,
+			},
+		},
+	}
+	obj := sensorObj.DeepCopy()
+	obj.Spec.Triggers = []v1alpha1.Trigger{trigger}
+	sensorCtx := NewSensorContext(nil, nil, obj, nil, "", "", metrics.NewMetrics(obj.Namespace))
+
+	results := make(chan error, 1)
+	ctx := withExecutionResult(context.Background(), func(err error) {
+		results <- err
+	})
+	// The execution is not atLeastOnce, its failure is only known once it finishes
+	err := sensorCtx.triggerActions(ctx, obj, map[string]cloudevents.Event{}, trigger)
+	assert.NoError(t, err)
+	select {
+	case err := <-results:
+		assert.Error(t, err)
+	case <-time.After(10 * time.Second):
+		t.Fatal("the result of the execution is not reported")
+	}
 }
@@ -0,0 +1,205 @@
+package sensors
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+
+	"github.com/argoproj/argo-events/common"
+	apicommon "github.com/argoproj/argo-events/pkg/apis/common"
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+)
+
+// prometheusResponse is the body of a response of the Prometheus HTTP API.
+type prometheusResponse struct {
+	Status string `json:"status"`
+	Data   struct {
+		ResultType string          `json:"resultType"`
+		Result     json.RawMessage `json:"result"`
+	} `json:"data"`
+	ErrorType string `json:"errorType,omitempty"`
+	Error     string `json:"error,omitempty"`
+}
+
+// metricResult is a result of the query of a metric dependency.
+type metricResult struct {
+	// resolved is whether the query returned a non-empty result.
+	resolved bool
+	// data is the result of the query, in the format of the Prometheus HTTP API.
+	data json.RawMessage
+	time time.Time
+}
+
+// metricDependency is a dependency resolved by a PromQL query, holding the latest result of the query when it's
+// evaluated on a schedule.
+type metricDependency struct {
+	dep  v1alpha1.EventDependency
+	lock sync.RWMutex
+	// result is the latest result of the scheduled query, nil until the query has been evaluated.
+	result *metricResult
+	err    error
+}
+
+func newMetricDependency(dep v1alpha1.EventDependency) *metricDependency {
+	return &metricDependency{dep: dep}
+}
+
+// latest returns the latest result of the scheduled query, nil if the query has not been evaluated yet.
+func (m *metricDependency) latest() (*metricResult, error) {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+	return m.result, m.err
+}
+
+// run evaluates the query on its schedule, until the context is done.
+func (m *metricDependency) run(ctx context.Context, sensorCtx *SensorContext, log *zap.SugaredLogger) {
+	ticker := time.NewTicker(m.dep.Prometheus.GetInterval())
+	defer ticker.Stop()
+	for {
+		result, err := sensorCtx.queryPrometheus(ctx, m.dep.Prometheus)
+		if err != nil && ctx.Err() == nil {
+			log.Warnw("failed to evaluate the query of the metric dependency", "dependency", m.dep.Name, zap.Error(err))
+		}
+		m.lock.Lock()
+		m.result, m.err = result, err
+		m.lock.Unlock()
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// queryPrometheus evaluates the instant query of a metric dependency.
+func (sensorCtx *SensorContext) queryPrometheus(ctx context.Context, p *v1alpha1.PrometheusDependency) (*metricResult, error) {
+	client, err := sensorCtx.prometheusClient(p)
+	if err != nil {
+		return nil, err
+	}
+	endpoint := strings.TrimSuffix(p.URL, "/") + "/api/v1/query"
+	form := url.Values{"query": {p.Query}}
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to construct request for %s, %w", endpoint, err)
+	}
+	for name, value := range p.Headers {
+		request.Header.Set(name, value)
+	}
+	request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	if p.BasicAuth != nil {
+		username, password, err := common.GetBasicAuthFromVolume(p.BasicAuth)
+		if err != nil {
+			return nil, err
+		}
+		request.SetBasicAuth(username, password)
+	}
+	if p.BearerToken != nil {
+		token, err := common.GetSecretFromVolume(p.BearerToken)
+		if err != nil {
+			return nil, fmt.Errorf("failed to retrieve the bearer token, %w", err)
+		}
+		request.Header.Set("Authorization", "Bearer "+token)
+	}
+	now := time.Now()
+	response, err := client.Do(request)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query %s, %w", endpoint, err)
+	}
+	defer response.Body.Close()
+	body := prometheusResponse{}
+	if err := json.NewDecoder(io.LimitReader(response.Body, 4*1024*1024)).Decode(&body); err != nil {
+		return nil, fmt.Errorf("failed to query %s, status %d", endpoint, response.StatusCode)
+	}
+	if response.StatusCode != http.StatusOK || body.Status != "success" {
+		return nil, fmt.Errorf("failed to query %s, status %d: %s %s", endpoint, response.StatusCode, body.ErrorType, body.Error)
+	}
+	switch body.Data.ResultType {
+	case "vector", "matrix":
+	default:
+		return nil, fmt.Errorf("the query returns a %s, it should return a vector", body.Data.ResultType)
+	}
+	var series []json.RawMessage
+	if err := json.Unmarshal(body.Data.Result, &series); err != nil {
+		return nil, fmt.Errorf("failed to decode the result of the query, %w", err)
+	}
+	return &metricResult{resolved: len(series) > 0, data: body.Data.Result, time: now}, nil
+}
+
+// prometheusClient returns the HTTP client of the Prometheus HTTP API, shared with the HTTP triggers with the same
+// TLS configuration and timeout.
+func (sensorCtx *SensorContext) prometheusClient(p *v1alpha1.PrometheusDependency) (*http.Client, error) {
+	timeout := 10 * time.Second
+	if p.Timeout > 0 {
+		timeout = time.Duration(p.Timeout) * time.Second
+	}
+	clientKey := common.MustHash(struct {
+		TLS     *apicommon.TLSConfig
+		Timeout int64
+	}{p.TLS, int64(timeout / time.Second)})
+	client, ok := sensorCtx.httpClients.Load(clientKey)
+	if !ok {
+		client = &http.Client{Timeout: timeout}
+		if p.TLS != nil {
+			tlsConfig, err := common.GetTLSConfig(p.TLS)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get the tls configuration, %w", err)
+			}
+			client.Transport = &http.Transport{
+				TLSClientConfig: tlsConfig,
+			}
+		}
+		client, _ = sensorCtx.httpClients.LoadOrStore(clientKey, client)
+	}
+	return client, nil
+}
+
+// metricDependenciesResolved returns whether the metric dependencies of a trigger are resolved, and adds their
+// events to the events of the trigger execution. A dependency whose query fails is not resolved.
+func (sensorCtx *SensorContext) metricDependenciesResolved(ctx context.Context, triggerName string, depNames []string, events map[string]cloudevents.Event, log *zap.SugaredLogger) bool {
+	for _, depName := range depNames {
+		m := sensorCtx.metricDependencies[depName]
+		var result *metricResult
+		var err error
+		if m.dep.Prometheus.GetInterval() > 0 {
+			result, err = m.latest()
+		}
+		if result == nil && err == nil {
+			result, err = sensorCtx.queryPrometheus(ctx, m.dep.Prometheus)
+		}
+		if err != nil {
+			log.Warnw("failed to evaluate the query of the metric dependency, skipping trigger execution", "dependency", depName, zap.Error(err))
+		}
+		if err != nil || !result.resolved {
+			if err == nil {
+				log.Infow("skipping trigger execution, the metric dependency is not resolved", "dependency", depName)
+			}
+			sensorCtx.metrics.DependencyMetricUnresolved(sensorCtx.sensor.Name, triggerName, depName)
+			trace.SpanFromContext(ctx).AddEvent("trigger.unresolved", trace.WithAttributes(attribute.String("dependency", depName)))
+			return false
+		}
+		event := cloudevents.NewEvent()
+		event.SetID(uuid.New().String())
+		event.SetSource("prometheus")
+		event.SetType("prometheus")
+		event.SetSubject(depName)
+		event.SetTime(result.time)
+		if err := event.SetData(cloudevents.ApplicationJSON, []byte(result.data)); err != nil {
+			log.Warnw("failed to set the data of the metric dependency event", "dependency", depName, zap.Error(err))
+		}
+		events[depName] = event
+	}
+	return true
+}
@@ -0,0 +1,96 @@
+package sensors
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/argoproj/argo-events/common/logging"
+	"github.com/argoproj/argo-events/metrics"
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+)
+
+func TestMetricDependenciesResolved(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/query" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_ = r.ParseForm()
+		switch r.Form.Get("query") {
+		case "sum(queue_depth) > 100":
+			_, _ = w.Write([]byte(`{"status":"success","data":{"resultType":"vector","result":[{"metric":{},"value":[1700000000,"150"]}]}}`))
+		case "sum(queue_depth) > 1000":
+			_, _ = w.Write([]byte(`{"status":"success","data":{"resultType":"vector","result":[]}}`))
+		case "1":
+			_, _ = w.Write([]byte(`{"status":"success","data":{"resultType":"scalar","result":[1700000000,"1"]}}`))
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"status":"error","errorType":"bad_data","error":"parse error"}`))
+		}
+	}))
+	defer server.Close()
+
+	obj := sensorObj.DeepCopy()
+	obj.Spec.Dependencies = append(obj.Spec.Dependencies,
+		v1alpha1.EventDependency{Name: "queue-high", Prometheus: &v1alpha1.PrometheusDependency{URL: server.URL, Query: "sum(queue_depth) > 100"}},
+		v1alpha1.EventDependency{Name: "queue-huge", Prometheus: &v1alpha1.PrometheusDependency{URL: server.URL, Query: "sum(queue_depth) > 1000", Interval: "1h"}},
+		v1alpha1.EventDependency{Name: "invalid", Prometheus: &v1alpha1.PrometheusDependency{URL: server.URL, Query: "sum("}},
+	)
+	sensorCtx := NewSensorContext(nil, nil, obj, nil, "", "", metrics.NewMetrics(obj.Namespace))
+	log := logging.NewArgoEventsLogger()
+	ctx := context.Background()
+
+	events := map[string]cloudevents.Event{}
+	assert.True(t, sensorCtx.metricDependenciesResolved(ctx, "trigger", []string{"queue-high"}, events, log))
+	assert.Equal(t, "prometheus", events["queue-high"].Source())
+	assert.JSONEq(t, `[{"metric":{},"value":[1700000000,"150"]}]`, string(events["queue-high"].Data()))
+	assert.False(t, sensorCtx.metricDependenciesResolved(ctx, "trigger", []string{"queue-high", "queue-huge"}, map[string]cloudevents.Event{}, log))
+	assert.False(t, sensorCtx.metricDependenciesResolved(ctx, "trigger", []string{"invalid"}, map[string]cloudevents.Event{}, log))
+
+	_, err := sensorCtx.queryPrometheus(ctx, &v1alpha1.PrometheusDependency{URL: server.URL, Query: "1"})
+	assert.ErrorContains(t, err, "it should return a vector")
+	_, err = sensorCtx.queryPrometheus(ctx, &v1alpha1.PrometheusDependency{URL: server.URL, Query: "sum("})
+	assert.ErrorContains(t, err, "bad_data parse error")
+}
+
+func TestMetricDependencyRun(t *testing.T) {
+	queries := make(chan struct{}, 10)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		queries <- struct{}{}
+		_, _ = w.Write([]byte(`{"status":"success","data":{"resultType":"vector","result":[{"metric":{},"value":[1700000000,"150"]}]}}`))
+	}))
+	defer server.Close()
+
+	dep := v1alpha1.EventDependency{Name: "queue-high", Prometheus: &v1alpha1.PrometheusDependency{URL: server.URL, Query: "sum(queue_depth) > 100", Interval: "1h"}}
+	obj := sensorObj.DeepCopy()
+	obj.Spec.Dependencies = append(obj.Spec.Dependencies, dep)
+	sensorCtx := NewSensorContext(nil, nil, obj, nil, "", "", metrics.NewMetrics(obj.Namespace))
+	m := sensorCtx.metricDependencies["queue-high"]
+	result, err := m.latest()
+	assert.Nil(t, result)
+	assert.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		m.run(ctx, sensorCtx, logging.NewArgoEventsLogger())
+		close(done)
+	}()
+	<-queries
+	assert.Eventually(t, func() bool {
+		result, _ := m.latest()
+		return result != nil && result.resolved
+	}, 5*time.Second, 10*time.Millisecond)
+	cancel()
+	<-done
+
+	// The latest result is used, the query isn't evaluated again
+	assert.True(t, sensorCtx.metricDependenciesResolved(context.Background(), "trigger", []string{"queue-high"}, map[string]cloudevents.Event{}, logging.NewArgoEventsLogger()))
+	assert.Empty(t, queries)
+}
@@ -0,0 +1,51 @@
+package sensors
+
+import (
+	"context"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+)
+
+// withAlternateTriggers returns the triggers along with the alternate triggers of their oversize routes, which have
+// their own state, e.g. circuit breaker, cache and rate limiter, keyed by their names.
+func withAlternateTriggers(triggers []v1alpha1.Trigger) []v1alpha1.Trigger {
+	result := make([]v1alpha1.Trigger, 0, len(triggers))
+	for _, trigger := range triggers {
+		result = append(result, trigger)
+		if trigger.OversizeRoute != nil && trigger.OversizeRoute.Trigger != nil {
+			result = append(result, *trigger.OversizeRoute.Trigger)
+		}
+	}
+	return result
+}
+
+// eventsSize returns the total size of the data of the events.
+func eventsSize(events map[string]cloudevents.Event) int64 {
+	var size int64
+	for _, event := range events {
+		size += int64(len(event.Data()))
+	}
+	return size
+}
+
+// routeOversized returns the trigger to execute with the events, the alternate trigger of the oversize route when
+// the events are larger than its max size.
+func (sensorCtx *SensorContext) routeOversized(ctx context.Context, sensorName string, trigger v1alpha1.Trigger, events map[string]cloudevents.Event, log *zap.SugaredLogger) v1alpha1.Trigger {
+	route := trigger.OversizeRoute
+	size := eventsSize(events)
+	if size <= route.MaxSize {
+		return trigger
+	}
+	log.Infow("routing the oversized trigger execution to the alternate trigger", "size", size, "maxSize", route.MaxSize, "alternateTrigger", route.Trigger.Template.Name)
+	sensorCtx.metrics.ActionOversizeRouted(sensorName, trigger.Template.Name)
+	trace.SpanFromContext(ctx).AddEvent("trigger.rerouted", trace.WithAttributes(
+		attribute.Int64("size", size),
+		attribute.String("trigger", route.Trigger.Template.Name),
+	))
+	return *route.Trigger
+}
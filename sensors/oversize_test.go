@@ -0,0 +1,62 @@
+package sensors
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/argoproj/argo-events/common/logging"
+	"github.com/argoproj/argo-events/metrics"
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+)
+
+func TestRouteOversized(t *testing.T) {
+	obj := sensorObj.DeepCopy()
+	trigger := obj.Spec.Triggers[0]
+	alternate := trigger.DeepCopy()
+	alternate.Template.Name = "fake-trigger-from-s3"
+	trigger.OversizeRoute = &v1alpha1.TriggerOversizeRoute{MaxSize: 1024, Trigger: alternate}
+	sensorCtx := NewSensorContext(nil, nil, obj, nil, "", "", metrics.NewMetrics(obj.Namespace))
+	log := logging.NewArgoEventsLogger()
+
+	newEvent := func(data string) cloudevents.Event {
+		event := cloudevents.NewEvent()
+		_ = event.SetData(cloudevents.ApplicationJSON, []byte(data))
+		return event
+	}
+	small := map[string]cloudevents.Event{"dep-1": newEvent(`{"size":"small"}`), "dep-2": newEvent(`{"size":"small"}`)}
+	assert.Equal(t, int64(32), eventsSize(small))
+	routed := sensorCtx.routeOversized(context.Background(), obj.Name, trigger, small, log)
+	assert.Equal(t, "fake-trigger", routed.Template.Name)
+
+	// The size of the execution is the total size of its events
+	large := map[string]cloudevents.Event{
+		"dep-1": newEvent(`"` + strings.Repeat("a", 600) + `"`),
+		"dep-2": newEvent(`"` + strings.Repeat("b", 600) + `"`),
+	}
+	routed = sensorCtx.routeOversized(context.Background(), obj.Name, trigger, large, log)
+	assert.Equal(t, "fake-trigger-from-s3", routed.Template.Name)
+}
+
+func TestAlternateTriggerState(t *testing.T) {
+	obj := sensorObj.DeepCopy()
+	alternate := obj.Spec.Triggers[0].DeepCopy()
+	alternate.Template.Name = "fake-trigger-from-s3"
+	alternate.CircuitBreaker = &v1alpha1.TriggerCircuitBreaker{ConsecutiveFailures: 3}
+	alternate.Cache = &v1alpha1.TriggerCache{}
+	obj.Spec.Triggers[0].OversizeRoute = &v1alpha1.TriggerOversizeRoute{MaxSize: 1024, Trigger: alternate}
+	sensorCtx := NewSensorContext(nil, nil, obj, nil, "", "", metrics.NewMetrics(obj.Namespace))
+
+	// The alternate trigger has its own circuit breaker and cache
+	assert.NotNil(t, sensorCtx.circuitBreakers["fake-trigger-from-s3"])
+	assert.NotNil(t, sensorCtx.triggerCaches["fake-trigger-from-s3"])
+	assert.Nil(t, sensorCtx.circuitBreakers["fake-trigger"])
+	names := []string{}
+	for _, trigger := range withAlternateTriggers(obj.Spec.Triggers) {
+		names = append(names, trigger.Template.Name)
+	}
+	assert.Equal(t, []string{"fake-trigger", "fake-trigger-from-s3"}, names)
+}
@@ -0,0 +1,69 @@
+package sensors
+
+import (
+	"context"
+	"hash/fnv"
+	"strings"
+
+	"go.uber.org/zap"
+
+	eventbuscommon "github.com/argoproj/argo-events/eventbus/common"
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+)
+
+// sensorPartition is the partition of the dependencies consumed by the replica of a Sensor whose replicas share the
+// trigger load.
+type sensorPartition struct {
+	partition  int
+	partitions int
+}
+
+// claimPartition claims a partition of the dependencies for the replica, with one partition per replica. It returns
+// nil if the driver doesn't partition the dependencies, e.g. the Kafka consumer groups assign the partitions of the
+// topics themselves. The pod exits once it loses its partition, another replica may consume it already.
+func (sensorCtx *SensorContext) claimPartition(ctx context.Context, driver eventbuscommon.SensorDriver, logger *zap.SugaredLogger) (*sensorPartition, error) {
+	partitioner, ok := driver.(eventbuscommon.Partitioner)
+	if !ok {
+		return nil, nil
+	}
+	partitions := int(sensorCtx.sensor.Spec.GetReplicas())
+	logger.Infof("claiming one of the %d partitions of the dependencies", partitions)
+	partition, lost, err := partitioner.ClaimPartition(ctx, sensorCtx.hostname, partitions)
+	if err != nil {
+		return nil, err
+	}
+	logger.Infof("claimed partition %d of the dependencies", partition)
+	go func() {
+		select {
+		case <-lost:
+			logger.Fatalf("partition %d lost: %s", partition, sensorCtx.hostname)
+		case <-ctx.Done():
+		}
+	}()
+	return &sensorPartition{partition: partition, partitions: partitions}, nil
+}
+
+// dependencies returns the dependencies of the trigger in the partition. Each dependency of a trigger is a partition
+// key on its own, unless the trigger keeps a state across its dependencies: the conditions requiring several events,
+// or the batch. Their dependencies are kept together, so that a single replica receives all the events of the trigger.
+func (p *sensorPartition) dependencies(trigger v1alpha1.Trigger, depExpression string, deps []eventbuscommon.Dependency) []eventbuscommon.Dependency {
+	if strings.Contains(depExpression, "&") || trigger.Batch != nil {
+		if p.owns(trigger.Template.Name) {
+			return deps
+		}
+		return nil
+	}
+	result := []eventbuscommon.Dependency{}
+	for _, dep := range deps {
+		if p.owns(trigger.Template.Name + "/" + dep.Name) {
+			result = append(result, dep)
+		}
+	}
+	return result
+}
+
+func (p *sensorPartition) owns(key string) bool {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(key))
+	return int(h.Sum32()%uint32(p.partitions)) == p.partition
+}
@@ -0,0 +1,97 @@
+package sensors
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/argoproj/argo-events/common/logging"
+	eventbuscommon "github.com/argoproj/argo-events/eventbus/common"
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+)
+
+type fakePartitioner struct {
+	eventbuscommon.SensorDriver
+	holder     string
+	partitions int
+}
+
+func (p *fakePartitioner) ClaimPartition(ctx context.Context, holder string, partitions int) (int, <-chan struct{}, error) {
+	p.holder = holder
+	p.partitions = partitions
+	return 1, make(chan struct{}), nil
+}
+
+func TestClaimPartition(t *testing.T) {
+	obj := sensorObj.DeepCopy()
+	replicas := int32(3)
+	obj.Spec.Replicas = &replicas
+	sensorCtx := NewSensorContext(nil, nil, obj, nil, "", "pod-a", nil)
+	logger := logging.NewArgoEventsLogger()
+
+	t.Run("test driver without partitions", func(t *testing.T) {
+		partition, err := sensorCtx.claimPartition(context.Background(), &fakeSubjectsDriver{}, logger)
+		assert.NoError(t, err)
+		assert.Nil(t, partition)
+	})
+
+	t.Run("test one partition per replica", func(t *testing.T) {
+		driver := &fakePartitioner{}
+		partition, err := sensorCtx.claimPartition(context.Background(), driver, logger)
+		assert.NoError(t, err)
+		assert.Equal(t, &sensorPartition{partition: 1, partitions: 3}, partition)
+		assert.Equal(t, "pod-a", driver.holder)
+		assert.Equal(t, 3, driver.partitions)
+	})
+}
+
+func TestPartitionDependencies(t *testing.T) {
+	deps := []eventbuscommon.Dependency{}
+	for i := 0; i < 20; i++ {
+		deps = append(deps, eventbuscommon.Dependency{Name: fmt.Sprintf("dep-%d", i), EventSourceName: "es", EventName: fmt.Sprintf("e%d", i)})
+	}
+	partitions := make([]*sensorPartition, 3)
+	for i := range partitions {
+		partitions[i] = &sensorPartition{partition: i, partitions: 3}
+	}
+	trigger := v1alpha1.Trigger{Template: &v1alpha1.TriggerTemplate{Name: "trigger"}}
+
+	t.Run("test each dependency in a single partition", func(t *testing.T) {
+		seen := map[string]int{}
+		for _, p := range partitions {
+			owned := p.dependencies(trigger, "dep-0 || dep-1", deps)
+			// The dependencies are spread across the partitions
+			assert.NotEmpty(t, owned)
+			for _, dep := range owned {
+				seen[dep.Name]++
+			}
+		}
+		assert.Len(t, seen, len(deps))
+		for _, count := range seen {
+			assert.Equal(t, 1, count)
+		}
+	})
+
+	t.Run("test dependencies kept together", func(t *testing.T) {
+		batched := trigger
+		batched.Batch = &v1alpha1.TriggerBatch{MaxEvents: 10}
+		for _, tc := range []struct {
+			trigger       v1alpha1.Trigger
+			depExpression string
+		}{
+			{trigger, "dep-0 && dep-1"},
+			{batched, "dep-0 || dep-1"},
+		} {
+			owners := 0
+			for _, p := range partitions {
+				if owned := p.dependencies(tc.trigger, tc.depExpression, deps); len(owned) > 0 {
+					assert.Equal(t, deps, owned)
+					owners++
+				}
+			}
+			assert.Equal(t, 1, owners)
+		}
+	})
+}
@@ -0,0 +1,217 @@
+package sensors
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+
+	eventbuscommon "github.com/argoproj/argo-events/eventbus/common"
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+)
+
+// quotaWindow is a sliding window of an execution quota.
+type quotaWindow struct {
+	duration time.Duration
+	max      int
+}
+
+// executionQuota implements the execution quota of a Sensor over sliding windows.
+type executionQuota struct {
+	config  v1alpha1.SensorExecutionQuota
+	windows []quotaWindow
+
+	lock sync.Mutex
+	// executions are the times of the executions within the longest window, oldest first.
+	executions []time.Time
+	// exceeded is true from an execution exceeding the quota to the next one within it.
+	exceeded bool
+
+	// store persists the executions, so that the restarts of the pod don't reset the windows, if set.
+	store eventbuscommon.QuotaStore
+	log   *zap.SugaredLogger
+}
+
+func newExecutionQuota(config v1alpha1.SensorExecutionQuota) *executionQuota {
+	q := &executionQuota{config: config}
+	if config.MaxTriggersPerHour > 0 {
+		q.windows = append(q.windows, quotaWindow{duration: time.Hour, max: int(config.MaxTriggersPerHour)})
+	}
+	if config.MaxTriggersPerDay > 0 {
+		q.windows = append(q.windows, quotaWindow{duration: 24 * time.Hour, max: int(config.MaxTriggersPerDay)})
+	}
+	return q
+}
+
+// persist loads the executions persisted in the store by the previous pods of the Sensor, and persists the next
+// executions in it.
+func (q *executionQuota) persist(store eventbuscommon.QuotaStore, log *zap.SugaredLogger) error {
+	executions, err := store.LoadExecutions(q.longestWindow())
+	if err != nil {
+		return err
+	}
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	q.executions = append(executions, q.executions...)
+	sort.Slice(q.executions, func(i, j int) bool { return q.executions[i].Before(q.executions[j]) })
+	q.store, q.log = store, log
+	return nil
+}
+
+// take records an execution at now if it is within the quota, or if the overflow policy is Alert. It returns the
+// zero time if the execution is within the quota, otherwise the time the quota frees up.
+func (q *executionQuota) take(now time.Time) time.Time {
+	q.lock.Lock()
+	q.prune(now)
+	freesAt := q.freesAt(now)
+	recorded := freesAt.IsZero() || q.config.GetOverflow() == v1alpha1.QuotaOverflowAlert
+	if recorded {
+		q.executions = append(q.executions, now)
+	}
+	store := q.store
+	q.lock.Unlock()
+	if recorded && store != nil {
+		if err := store.SaveExecution(now, q.longestWindow()); err != nil {
+			q.log.Warnw("failed to persist the execution, it is only counted by this pod", zap.Error(err))
+		}
+	}
+	return freesAt
+}
+
+// longestWindow returns the duration of the longest window of the quota.
+func (q *executionQuota) longestWindow() time.Duration {
+	var longest time.Duration
+	for _, w := range q.windows {
+		if w.duration > longest {
+			longest = w.duration
+		}
+	}
+	return longest
+}
+
+// prune forgets the executions which left all the windows.
+func (q *executionQuota) prune(now time.Time) {
+	longest := q.longestWindow()
+	i := sort.Search(len(q.executions), func(i int) bool { return q.executions[i].After(now.Add(-longest)) })
+	q.executions = q.executions[i:]
+}
+
+// freesAt returns the time enough executions leave the full windows for one more to be within the quota, zero
+// if it already is.
+func (q *executionQuota) freesAt(now time.Time) time.Time {
+	var at time.Time
+	for _, w := range q.windows {
+		start := sort.Search(len(q.executions), func(i int) bool { return q.executions[i].After(now.Add(-w.duration)) })
+		if n := len(q.executions) - start; n >= w.max {
+			if t := q.executions[start+n-w.max].Add(w.duration); t.After(at) {
+				at = t
+			}
+		}
+	}
+	return at
+}
+
+// wait waits for the quota to free up and records an execution, it returns false if the context is done first.
+func (q *executionQuota) wait(ctx context.Context) bool {
+	for {
+		now := time.Now()
+		freesAt := q.take(now)
+		if freesAt.IsZero() {
+			return true
+		}
+		timer := time.NewTimer(freesAt.Sub(now))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return false
+		case <-timer.C:
+		}
+	}
+}
+
+// setExceeded sets whether the quota is exceeded, and returns whether it changed.
+func (q *executionQuota) setExceeded(exceeded bool) bool {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	changed := q.exceeded != exceeded
+	q.exceeded = exceeded
+	return changed
+}
+
+func (q *executionQuota) isExceeded() bool {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	return q.exceeded
+}
+
+// String describes the limits of the quota, e.g. "100 per hour, 1000 per day".
+func (q *executionQuota) String() string {
+	var limits []string
+	if q.config.MaxTriggersPerHour > 0 {
+		limits = append(limits, fmt.Sprintf("%d per hour", q.config.MaxTriggersPerHour))
+	}
+	if q.config.MaxTriggersPerDay > 0 {
+		limits = append(limits, fmt.Sprintf("%d per day", q.config.MaxTriggersPerDay))
+	}
+	return strings.Join(limits, ", ")
+}
+
+// admitExecution applies the quota of the Sensor to an execution of the trigger, and returns whether the execution
+// goes on. With the Queue policy, it waits for the quota to free up, and returns false only if the context is done
+// first.
+func (sensorCtx *SensorContext) admitExecution(ctx, traceCtx context.Context, triggerName string, log *zap.SugaredLogger) bool {
+	quota := sensorCtx.quota
+	freesAt := quota.take(time.Now())
+	if quota.setExceeded(!freesAt.IsZero()) {
+		if freesAt.IsZero() {
+			log.Info("trigger executions are within the quota of the sensor again")
+		} else {
+			log.Warnw("trigger executions exceed the quota of the sensor", "quota", quota.String(), "overflow", quota.config.GetOverflow())
+		}
+		if err := sensorCtx.updateQuotaCondition(ctx); err != nil {
+			log.Warnw("failed to update the quota condition", zap.Error(err))
+		}
+	}
+	if freesAt.IsZero() {
+		return true
+	}
+	sensorCtx.metrics.ActionQuotaExceeded(sensorCtx.sensor.Name, triggerName)
+	span := trace.SpanFromContext(traceCtx)
+	switch quota.config.GetOverflow() {
+	case v1alpha1.QuotaOverflowAlert:
+		span.AddEvent("trigger.overQuota")
+		return true
+	case v1alpha1.QuotaOverflowQueue:
+		log.Infow("queuing trigger execution until the quota of the sensor frees up", "freesAt", freesAt)
+		span.AddEvent("trigger.queued", trace.WithAttributes(attribute.String("freesAt", freesAt.Format(time.RFC3339))))
+		// The events are not acknowledged while the execution is queued
+		if quota.wait(ctx) {
+			return true
+		}
+	}
+	log.Info("dropping trigger execution exceeding the quota of the sensor")
+	span.AddEvent("trigger.dropped")
+	return false
+}
+
+// updateQuotaCondition sets the WithinQuota condition of the Sensor according to the quota of this pod.
+func (sensorCtx *SensorContext) updateQuotaCondition(ctx context.Context) error {
+	if sensorCtx.quota == nil || sensorCtx.dynamicClient == nil {
+		return nil
+	}
+	quota := sensorCtx.quota
+	exceeded := quota.isExceeded()
+	return sensorCtx.updateStatus(ctx, func(status *v1alpha1.SensorStatus) {
+		if !exceeded {
+			status.MarkWithinQuota()
+		} else {
+			status.MarkQuotaExceeded("QuotaExceeded", fmt.Sprintf("The trigger executions exceed the quota of %s, the overflow policy is %s.", quota.String(), quota.config.GetOverflow()))
+		}
+	})
+}
@@ -0,0 +1,199 @@
+package sensors
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+
+	"github.com/argoproj/argo-events/metrics"
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+)
+
+func TestExecutionQuota(t *testing.T) {
+	q := newExecutionQuota(v1alpha1.SensorExecutionQuota{MaxTriggersPerHour: 2, MaxTriggersPerDay: 3})
+	assert.Equal(t, "2 per hour, 3 per day", q.String())
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	assert.True(t, q.take(now).IsZero())
+	assert.True(t, q.take(now.Add(10*time.Minute)).IsZero())
+	// The hourly quota frees up an hour after the first execution
+	assert.Equal(t, now.Add(time.Hour), q.take(now.Add(20*time.Minute)))
+	assert.True(t, q.take(now.Add(time.Hour+time.Minute)).IsZero())
+	// The daily quota frees up a day after the first execution
+	assert.Equal(t, now.Add(24*time.Hour), q.take(now.Add(3*time.Hour)))
+	assert.True(t, q.take(now.Add(24*time.Hour+time.Minute)).IsZero())
+	assert.Len(t, q.executions, 3)
+}
+
+func TestExecutionQuotaAlert(t *testing.T) {
+	q := newExecutionQuota(v1alpha1.SensorExecutionQuota{MaxTriggersPerHour: 1, Overflow: v1alpha1.QuotaOverflowAlert})
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	assert.True(t, q.take(now).IsZero())
+	// The executions exceeding the quota are recorded anyway
+	assert.Equal(t, now.Add(time.Hour), q.take(now.Add(10*time.Minute)))
+	assert.Equal(t, now.Add(70*time.Minute), q.take(now.Add(20*time.Minute)))
+	assert.Len(t, q.executions, 3)
+}
+
+// fakeQuotaStore keeps the executions in memory
+type fakeQuotaStore struct {
+	executions []time.Time
+}
+
+func (s *fakeQuotaStore) LoadExecutions(window time.Duration) ([]time.Time, error) {
+	return s.executions, nil
+}
+
+func (s *fakeQuotaStore) SaveExecution(at time.Time, window time.Duration) error {
+	s.executions = append(s.executions, at)
+	return nil
+}
+
+func TestExecutionQuotaPersist(t *testing.T) {
+	now := time.Now()
+	store := &fakeQuotaStore{}
+	q := newExecutionQuota(v1alpha1.SensorExecutionQuota{MaxTriggersPerDay: 2})
+	assert.NoError(t, q.persist(store, zap.NewNop().Sugar()))
+	assert.True(t, q.take(now.Add(-2*time.Hour)).IsZero())
+	assert.True(t, q.take(now.Add(-time.Hour)).IsZero())
+	assert.Len(t, store.executions, 2)
+
+	// The quota of the next pod starts with the executions of the previous one
+	q = newExecutionQuota(v1alpha1.SensorExecutionQuota{MaxTriggersPerDay: 2})
+	assert.NoError(t, q.persist(store, zap.NewNop().Sugar()))
+	assert.Equal(t, now.Add(22*time.Hour), q.take(now))
+	assert.Len(t, store.executions, 2)
+}
+
+func TestExecutionQuotaWait(t *testing.T) {
+	q := newExecutionQuota(v1alpha1.SensorExecutionQuota{MaxTriggersPerHour: 1})
+	assert.True(t, q.take(time.Now().Add(-time.Hour+50*time.Millisecond)).IsZero())
+	assert.True(t, q.wait(context.Background()))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	assert.False(t, q.wait(ctx))
+}
+
+func TestAdmitExecution(t *testing.T) {
+	obj := sensorObj.DeepCopy()
+	obj.Spec.Quota = &v1alpha1.SensorExecutionQuota{MaxTriggersPerHour: 1}
+	scheme := runtime.NewScheme()
+	assert.NoError(t, v1alpha1.AddToScheme(scheme))
+	dynamicClient := dynamicfake.NewSimpleDynamicClient(scheme, &v1alpha1.Sensor{
+		TypeMeta:   metav1.TypeMeta{APIVersion: v1alpha1.SchemeGroupVersion.String(), Kind: "Sensor"},
+		ObjectMeta: obj.ObjectMeta,
+	})
+	sensorCtx := NewSensorContext(nil, dynamicClient, obj, nil, "", "", metrics.NewMetrics(obj.Namespace))
+	assert.NotNil(t, sensorCtx.quota)
+
+	getCondition := func() *corev1.ConditionStatus {
+		u, err := dynamicClient.Resource(v1alpha1.SchemeGroupVersion.WithResource("sensors")).Namespace(obj.Namespace).Get(context.Background(), obj.Name, metav1.GetOptions{})
+		assert.NoError(t, err)
+		s := &v1alpha1.Sensor{}
+		assert.NoError(t, runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, s))
+		if c := s.Status.GetCondition(v1alpha1.SensorConditionWithinQuota); c != nil {
+			return &c.Status
+		}
+		return nil
+	}
+
+	ctx := context.Background()
+	log := zap.NewNop().Sugar()
+	assert.True(t, sensorCtx.admitExecution(ctx, ctx, "trigger", log))
+	assert.Nil(t, getCondition())
+	assert.False(t, sensorCtx.admitExecution(ctx, ctx, "trigger", log))
+	assert.Equal(t, corev1.ConditionFalse, *getCondition())
+
+	sensorCtx.quota.executions[0] = time.Now().Add(-2 * time.Hour)
+	assert.True(t, sensorCtx.admitExecution(ctx, ctx, "trigger", log))
+	assert.Equal(t, corev1.ConditionTrue, *getCondition())
+}
+
+type fakeDeduplicator struct {
+	keys map[string]bool
+}
+
+func (d *fakeDeduplicator) ClaimKey(triggerName, key string, window time.Duration) (bool, error) {
+	if d.keys[triggerName+"/"+key] {
+		return false, nil
+	}
+	d.keys[triggerName+"/"+key] = true
+	return true, nil
+}
+
+func (d *fakeDeduplicator) ReleaseKey(triggerName, key string, window time.Duration) error {
+	delete(d.keys, triggerName+"/"+key)
+	return nil
+}
+
+func TestAdmitTriggerExecutionReleasesDroppedKey(t *testing.T) {
+	obj := sensorObj.DeepCopy()
+	obj.Spec.Quota = &v1alpha1.SensorExecutionQuota{MaxTriggersPerHour: 1}
+	sensorCtx := NewSensorContext(nil, nil, obj, nil, "", "", metrics.NewMetrics(obj.Namespace))
+	dedup := &fakeDeduplicator{keys: map[string]bool{}}
+	trigger := &v1alpha1.Trigger{
+		Template:      &v1alpha1.TriggerTemplate{Name: "trigger"},
+		Deduplication: &v1alpha1.TriggerDeduplication{},
+	}
+	newEvents := func(id string) map[string]cloudevents.Event {
+		event := cloudevents.NewEvent()
+		event.SetID(id)
+		return map[string]cloudevents.Event{"dep": event}
+	}
+
+	ctx := context.Background()
+	log := zap.NewNop().Sugar()
+	key, admitted := sensorCtx.admitTriggerExecution(ctx, ctx, dedup, trigger, newEvents("1"), log)
+	assert.True(t, admitted)
+	assert.Equal(t, "1", key)
+	// A duplicate is discarded
+	_, admitted = sensorCtx.admitTriggerExecution(ctx, ctx, dedup, trigger, newEvents("1"), log)
+	assert.False(t, admitted)
+
+	// The execution is dropped by the quota, its key is released
+	_, admitted = sensorCtx.admitTriggerExecution(ctx, ctx, dedup, trigger, newEvents("2"), log)
+	assert.False(t, admitted)
+	assert.False(t, dedup.keys["trigger/2"])
+
+	// The redelivered event is executed once the quota frees up
+	sensorCtx.quota.executions[0] = time.Now().Add(-2 * time.Hour)
+	key, admitted = sensorCtx.admitTriggerExecution(ctx, ctx, dedup, trigger, newEvents("2"), log)
+	assert.True(t, admitted)
+	assert.Equal(t, "2", key)
+}
+
+func TestAdmitTriggerExecutionDryRun(t *testing.T) {
+	obj := sensorObj.DeepCopy()
+	obj.Spec.Quota = &v1alpha1.SensorExecutionQuota{MaxTriggersPerHour: 1}
+	sensorCtx := NewSensorContext(nil, nil, obj, nil, "", "", metrics.NewMetrics(obj.Namespace))
+	sensorCtx.dryRun = true
+	dedup := &fakeDeduplicator{keys: map[string]bool{}}
+	trigger := &v1alpha1.Trigger{
+		Template:      &v1alpha1.TriggerTemplate{Name: "trigger"},
+		Deduplication: &v1alpha1.TriggerDeduplication{},
+	}
+	event := cloudevents.NewEvent()
+	event.SetID("1")
+	events := map[string]cloudevents.Event{"dep": event}
+
+	ctx := context.Background()
+	log := zap.NewNop().Sugar()
+	// The dry-run executions leave the key unclaimed, and don't count against the quota
+	for i := 0; i < 2; i++ {
+		key, admitted := sensorCtx.admitTriggerExecution(ctx, ctx, dedup, trigger, events, log)
+		assert.True(t, admitted)
+		assert.Empty(t, key)
+	}
+	assert.Empty(t, dedup.keys)
+	assert.Empty(t, sensorCtx.quota.executions)
+}
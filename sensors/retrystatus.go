@@ -0,0 +1,51 @@
+package sensors
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/util/retry"
+
+	"github.com/argoproj/argo-events/common"
+	"github.com/argoproj/argo-events/common/logging"
+	apicommon "github.com/argoproj/argo-events/pkg/apis/common"
+	"github.com/argoproj/argo-events/pkg/apis/sensor"
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+)
+
+// retryStatusInterval is the interval the operations being retried are reported at.
+const retryStatusInterval = 30 * time.Second
+
+// reportRetryStatus reports the operations retried by the pod in the status of the Sensor, until the context is done.
+func (sensorCtx *SensorContext) reportRetryStatus(ctx context.Context) {
+	if sensorCtx.dynamicClient == nil {
+		return
+	}
+	log := logging.FromContext(ctx)
+	client := sensorCtx.dynamicClient.Resource(v1alpha1.SchemeGroupVersion.WithResource(sensor.Plural)).Namespace(sensorCtx.sensor.Namespace)
+	common.ReportRetryStatuses(ctx, sensorCtx.hostname, retryStatusInterval, func(ctx context.Context, statuses []apicommon.RetryStatus) error {
+		return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+			obj, err := client.Get(ctx, sensorCtx.sensor.Name, metav1.GetOptions{})
+			if err != nil {
+				return err
+			}
+			s := &v1alpha1.Sensor{}
+			if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, s); err != nil {
+				return err
+			}
+			s.Status.Retries = common.MergeRetryStatuses(s.Status.Retries, sensorCtx.hostname, statuses)
+			un, err := runtime.DefaultUnstructuredConverter.ToUnstructured(s)
+			if err != nil {
+				return err
+			}
+			_, err = client.UpdateStatus(ctx, &unstructured.Unstructured{Object: un}, metav1.UpdateOptions{})
+			return err
+		})
+	}, func(err error) {
+		log.Warnw("failed to report the operations being retried in the status", zap.Error(err))
+	})
+}
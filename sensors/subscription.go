@@ -0,0 +1,113 @@
+package sensors
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	"github.com/argoproj/argo-events/common"
+	"github.com/argoproj/argo-events/common/logging"
+	"github.com/argoproj/argo-events/eventbus"
+	eventbuscommon "github.com/argoproj/argo-events/eventbus/common"
+	multisensor "github.com/argoproj/argo-events/eventbus/multi/sensor"
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+)
+
+var (
+	// subscriptionRetryInterval is the first interval between the attempts to set up the subscriptions, it is
+	// doubled after each attempt up to subscriptionMaxRetryInterval.
+	subscriptionRetryInterval    = time.Second
+	subscriptionMaxRetryInterval = time.Minute
+)
+
+// retryUntilAvailable calls f until it succeeds or the context is done, with an exponential backoff. The Sensor can
+// be created before its EventBus or the EventSources of its dependencies, so the subscriptions are retried until they
+// are available, instead of restarting the pod. The retries are tracked under the operation and the target.
+func retryUntilAvailable(ctx context.Context, logger *zap.SugaredLogger, operation, target, action string, f func() error) error {
+	run := common.TrackRetries(operation, target)
+	interval := subscriptionRetryInterval
+	for attempt := 1; ; attempt++ {
+		err := f()
+		if err == nil {
+			run.Done(nil)
+			return nil
+		}
+		run.AttemptFailed(err)
+		logger.Warnw(fmt.Sprintf("failed to %s, retrying", action), zap.Int("attempt", attempt), zap.Duration("interval", interval), zap.Error(err))
+		select {
+		case <-ctx.Done():
+			// The retries are stopped, not exhausted
+			run.Done(ctx.Err())
+			return fmt.Errorf("failed to %s, %w", action, err)
+		case <-time.After(wait.Jitter(interval, 0.1)):
+		}
+		if interval *= 2; interval > subscriptionMaxRetryInterval {
+			interval = subscriptionMaxRetryInterval
+		}
+	}
+}
+
+// checkSubjects returns an error if the subjects of the dependencies do not exist on the EventBus yet, when the
+// driver is able to check them.
+func checkSubjects(driver eventbuscommon.SensorDriver, dependencies []v1alpha1.EventDependency) error {
+	checker, ok := driver.(eventbuscommon.SubjectsChecker)
+	if !ok {
+		return nil
+	}
+	deps := make([]eventbuscommon.Dependency, 0, len(dependencies))
+	for _, dep := range dependencies {
+		if dep.IsMetric() {
+			continue
+		}
+		deps = append(deps, eventbuscommon.Dependency{Name: dep.Name, EventSourceName: dep.EventSourceName, EventName: dep.EventName})
+	}
+	missing, err := checker.MissingSubjects(deps)
+	if err != nil {
+		return err
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("waiting for the subjects %s", strings.Join(missing, ", "))
+	}
+	return nil
+}
+
+// remoteEventBusKey is the key of the remote EventBus of the Sensor among the EventBuses of the dependencies.
+const remoteEventBusKey = "remote/"
+
+// multiBusDriver returns the driver joining the EventBus of the Sensor and the additional EventBuses the
+// dependencies consume from.
+func (sensorCtx *SensorContext) multiBusDriver(ctx context.Context, mainDriver eventbuscommon.SensorDriver) (eventbuscommon.SensorDriver, error) {
+	mainName := sensorCtx.sensor.Spec.EventBusName
+	switch {
+	case sensorCtx.sensor.Spec.RemoteEventBus != nil:
+		// Not a valid EventBus name, so that it never collides with the name of an additional EventBus
+		mainName = remoteEventBusKey
+	case mainName == "":
+		mainName = common.DefaultEventBusName
+	}
+	drivers := map[string]eventbuscommon.SensorDriver{mainName: mainDriver}
+	sharedConsumers := map[string]bool{mainName: sensorCtx.eventBusConfig.Kafka != nil}
+	for name, config := range sensorCtx.additionalEventBusConfigs {
+		if name == mainName {
+			continue
+		}
+		driver, err := eventbus.GetAdditionalSensorDriver(ctx, name, config, sensorCtx.sensor, sensorCtx.hostname)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get the driver of the eventbus %s, %w", name, err)
+		}
+		drivers[name] = driver
+		sharedConsumers[name] = config.Kafka != nil
+	}
+	dependencyBuses := make(map[string]string, len(sensorCtx.sensor.Spec.Dependencies))
+	for _, dep := range sensorCtx.sensor.Spec.Dependencies {
+		dependencyBuses[dep.Name] = mainName
+		if _, ok := sensorCtx.additionalEventBusConfigs[dep.EventBusName]; ok {
+			dependencyBuses[dep.Name] = dep.EventBusName
+		}
+	}
+	return multisensor.NewSensorMulti(drivers, dependencyBuses, sharedConsumers, logging.FromContext(ctx)), nil
+}
@@ -0,0 +1,51 @@
+package sensors
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap/zaptest"
+
+	eventbuscommon "github.com/argoproj/argo-events/eventbus/common"
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+)
+
+type fakeSubjectsDriver struct {
+	eventbuscommon.SensorDriver
+	missing []string
+}
+
+func (d *fakeSubjectsDriver) MissingSubjects(deps []eventbuscommon.Dependency) ([]string, error) {
+	return d.missing, nil
+}
+
+func TestRetryUntilAvailable(t *testing.T) {
+	subscriptionRetryInterval = time.Millisecond
+	logger := zaptest.NewLogger(t).Sugar()
+	attempts := 0
+	err := retryUntilAvailable(context.Background(), logger, "test.operation", "sensor", "test", func() error {
+		if attempts++; attempts < 3 {
+			return fmt.Errorf("not available")
+		}
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 3, attempts)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	err = retryUntilAvailable(ctx, logger, "test.operation", "sensor", "test", func() error {
+		return fmt.Errorf("not available")
+	})
+	assert.ErrorContains(t, err, "failed to test, not available")
+}
+
+func TestCheckSubjects(t *testing.T) {
+	deps := []v1alpha1.EventDependency{{Name: "dep", EventSourceName: "webhook", EventName: "example"}}
+	assert.NoError(t, checkSubjects(&fakeSubjectsDriver{}, deps))
+	err := checkSubjects(&fakeSubjectsDriver{missing: []string{"default.webhook.example"}}, deps)
+	assert.ErrorContains(t, err, "default.webhook.example")
+}
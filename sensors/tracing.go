@@ -0,0 +1,195 @@
+package sensors
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	tracerName = "github.com/argoproj/argo-events/sensors"
+
+	// pendingSpanTimeout is how long a trigger span waits for the evaluation of the trigger conditions,
+	// in case the EventBus does not report it.
+	pendingSpanTimeout = time.Minute
+)
+
+// decisionTracer traces the dependency resolution decisions of a trigger. Each dependency event delivered to the
+// trigger starts a trigger span, on which the decisions are recorded as span events: the dependency match or the
+// filter rejection, the evaluation of the trigger conditions, and the trigger execution.
+type decisionTracer struct {
+	tracer      trace.Tracer
+	sensorName  string
+	triggerName string
+
+	lock sync.Mutex
+	// spans are the trigger spans waiting for the evaluation of the trigger conditions or the trigger execution,
+	// keyed by dependency name and event ID.
+	spans map[string]*pendingSpan
+}
+
+type pendingSpan struct {
+	span      trace.Span
+	startedAt time.Time
+	// evaluated is true once the trigger conditions are evaluated
+	evaluated bool
+}
+
+func newDecisionTracer(sensorName, triggerName string) *decisionTracer {
+	return &decisionTracer{
+		tracer:      otel.Tracer(tracerName),
+		sensorName:  sensorName,
+		triggerName: triggerName,
+		spans:       make(map[string]*pendingSpan),
+	}
+}
+
+func spanKey(depName string, event cloudevents.Event) string {
+	return depName + "/" + event.ID()
+}
+
+func eventAttributes(depName string, event cloudevents.Event) []attribute.KeyValue {
+	return []attribute.KeyValue{
+		attribute.String("argo_events.dependency.name", depName),
+		attribute.String("cloudevents.event_id", event.ID()),
+		attribute.String("cloudevents.event_source", event.Source()),
+		attribute.String("cloudevents.event_subject", event.Subject()),
+		attribute.String("cloudevents.event_type", event.Type()),
+	}
+}
+
+func (t *decisionTracer) startSpan(ctx context.Context, attrs ...attribute.KeyValue) trace.Span {
+	_, span := t.tracer.Start(ctx, "trigger "+t.triggerName, trace.WithAttributes(append([]attribute.KeyValue{
+		attribute.String("argo_events.sensor.name", t.sensorName),
+		attribute.String("argo_events.trigger.name", t.triggerName),
+	}, attrs...)...))
+	return span
+}
+
+// filtered records a dependency event rejected by the filters, the data schema validation or the transform.
+func (t *decisionTracer) filtered(depName string, event cloudevents.Event, reason string) {
+	span := t.startSpan(context.Background(), eventAttributes(depName, event)...)
+	span.AddEvent("dependency.filtered", trace.WithAttributes(attribute.String("reason", reason)))
+	span.End()
+}
+
+// matched records a dependency event which passed the filters. The trigger span is ended once the trigger conditions
+// are evaluated, or after the trigger execution if they are satisfied.
+func (t *decisionTracer) matched(depName string, event cloudevents.Event) {
+	span := t.startSpan(context.Background(), eventAttributes(depName, event)...)
+	span.AddEvent("dependency.matched")
+	if !span.IsRecording() {
+		span.End()
+		return
+	}
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	t.endExpiredLocked()
+	key := spanKey(depName, event)
+	if previous, ok := t.spans[key]; ok {
+		// Redelivered event
+		previous.span.End()
+	}
+	t.spans[key] = &pendingSpan{span: span, startedAt: time.Now()}
+}
+
+// conditionsEvaluated records an evaluation of the trigger conditions, it implements eventbuscommon.ConditionsObserveFunc.
+func (t *decisionTracer) conditionsEvaluated(depName string, event cloudevents.Event, received map[string]bool, satisfied bool, err error) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	key := spanKey(depName, event)
+	pending, ok := t.spans[key]
+	if !ok {
+		return
+	}
+	var receivedDeps, missingDeps []string
+	for name, ok := range received {
+		if ok {
+			receivedDeps = append(receivedDeps, name)
+		} else {
+			missingDeps = append(missingDeps, name)
+		}
+	}
+	sort.Strings(receivedDeps)
+	sort.Strings(missingDeps)
+	attrs := []attribute.KeyValue{
+		attribute.Bool("satisfied", satisfied),
+		attribute.StringSlice("dependencies.received", receivedDeps),
+		attribute.StringSlice("dependencies.missing", missingDeps),
+	}
+	if err != nil {
+		attrs = append(attrs, attribute.String("error", err.Error()))
+		pending.span.SetStatus(codes.Error, err.Error())
+	}
+	pending.span.AddEvent("conditions.evaluated", trace.WithAttributes(attrs...))
+	pending.evaluated = true
+	if !satisfied || err != nil {
+		pending.span.End()
+		delete(t.spans, key)
+	}
+}
+
+// startExecution returns the context of a trigger execution, which runs under the trigger span of the last event
+// resolving the conditions, and a function to call with the result of the execution.
+func (t *decisionTracer) startExecution(ctx context.Context, events map[string]cloudevents.Event) (context.Context, func(error)) {
+	t.lock.Lock()
+	var pending *pendingSpan
+	for depName, event := range events {
+		key := spanKey(depName, event)
+		p, ok := t.spans[key]
+		if !ok {
+			continue
+		}
+		delete(t.spans, key)
+		if pending == nil || p.startedAt.After(pending.startedAt) {
+			if pending != nil {
+				pending.span.End()
+			}
+			pending = p
+		} else {
+			p.span.End()
+		}
+	}
+	t.lock.Unlock()
+
+	var span trace.Span
+	if pending != nil {
+		span = pending.span
+	} else {
+		// The EventBus resolved the conditions without reporting it, e.g. on another Sensor pod
+		span = t.startSpan(ctx)
+		for depName, event := range events {
+			span.AddEvent("dependency.matched", trace.WithAttributes(eventAttributes(depName, event)...))
+		}
+	}
+	if pending == nil || !pending.evaluated {
+		span.AddEvent("conditions.evaluated", trace.WithAttributes(attribute.Bool("satisfied", true)))
+	}
+	span.AddEvent("trigger.started")
+	return trace.ContextWithSpan(ctx, span), func(err error) {
+		if err != nil {
+			span.AddEvent("trigger.failed", trace.WithAttributes(attribute.String("error", err.Error())))
+			span.SetStatus(codes.Error, err.Error())
+		} else {
+			span.AddEvent("trigger.succeeded")
+		}
+		span.End()
+	}
+}
+
+// endExpiredLocked ends the trigger spans whose trigger conditions evaluation was never reported.
+func (t *decisionTracer) endExpiredLocked() {
+	for key, pending := range t.spans {
+		if time.Since(pending.startedAt) > pendingSpanTimeout {
+			pending.span.End()
+			delete(t.spans, key)
+		}
+	}
+}
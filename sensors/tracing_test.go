@@ -0,0 +1,89 @@
+package sensors
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func newTestDecisionTracer() (*decisionTracer, *tracetest.SpanRecorder) {
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	tracer := newDecisionTracer("test-sensor", "test-trigger")
+	tracer.tracer = provider.Tracer(tracerName)
+	return tracer, recorder
+}
+
+func newTestEvent(id string) cloudevents.Event {
+	event := cloudevents.NewEvent()
+	event.SetID(id)
+	event.SetSource("webhook")
+	event.SetType("webhook")
+	return event
+}
+
+func spanEventNames(span sdktrace.ReadOnlySpan) []string {
+	var names []string
+	for _, e := range span.Events() {
+		names = append(names, e.Name)
+	}
+	return names
+}
+
+func TestDecisionTracer(t *testing.T) {
+	t.Run("filtered", func(t *testing.T) {
+		tracer, recorder := newTestDecisionTracer()
+		tracer.filtered("dep-1", newTestEvent("1"), "filters not met")
+		spans := recorder.Ended()
+		assert.Len(t, spans, 1)
+		assert.Equal(t, "trigger test-trigger", spans[0].Name())
+		assert.Equal(t, []string{"dependency.filtered"}, spanEventNames(spans[0]))
+		assert.Equal(t, "filters not met", spans[0].Events()[0].Attributes[0].Value.AsString())
+	})
+
+	t.Run("conditions not satisfied", func(t *testing.T) {
+		tracer, recorder := newTestDecisionTracer()
+		tracer.matched("dep-1", newTestEvent("1"))
+		assert.Len(t, recorder.Ended(), 0)
+		tracer.conditionsEvaluated("dep-1", newTestEvent("1"), map[string]bool{"dep-1": true, "dep-2": false}, false, nil)
+		spans := recorder.Ended()
+		assert.Len(t, spans, 1)
+		assert.Equal(t, []string{"dependency.matched", "conditions.evaluated"}, spanEventNames(spans[0]))
+		assert.Empty(t, tracer.spans)
+	})
+
+	t.Run("trigger executed", func(t *testing.T) {
+		tracer, recorder := newTestDecisionTracer()
+		tracer.matched("dep-1", newTestEvent("1"))
+		tracer.conditionsEvaluated("dep-1", newTestEvent("1"), map[string]bool{"dep-1": true, "dep-2": false}, false, nil)
+		tracer.matched("dep-2", newTestEvent("2"))
+		tracer.conditionsEvaluated("dep-2", newTestEvent("2"), map[string]bool{"dep-1": true, "dep-2": true}, true, nil)
+		assert.Len(t, recorder.Ended(), 1)
+		_, finish := tracer.startExecution(context.Background(), map[string]cloudevents.Event{
+			"dep-1": newTestEvent("1"),
+			"dep-2": newTestEvent("2"),
+		})
+		finish(nil)
+		spans := recorder.Ended()
+		assert.Len(t, spans, 2)
+		assert.Equal(t, []string{"dependency.matched", "conditions.evaluated", "trigger.started", "trigger.succeeded"}, spanEventNames(spans[1]))
+		assert.Empty(t, tracer.spans)
+	})
+
+	t.Run("trigger failed without reported evaluation", func(t *testing.T) {
+		tracer, recorder := newTestDecisionTracer()
+		ctx, finish := tracer.startExecution(context.Background(), map[string]cloudevents.Event{"dep-1": newTestEvent("1")})
+		assert.NotNil(t, ctx)
+		finish(fmt.Errorf("boom"))
+		spans := recorder.Ended()
+		assert.Len(t, spans, 1)
+		assert.Equal(t, []string{"dependency.matched", "conditions.evaluated", "trigger.started", "trigger.failed"}, spanEventNames(spans[0]))
+		assert.Equal(t, codes.Error, spans[0].Status().Code)
+	})
+}
@@ -26,14 +26,22 @@ import (
 	openwhisk "github.com/argoproj/argo-events/sensors/triggers/apache-openwhisk"
 	argoworkflow "github.com/argoproj/argo-events/sensors/triggers/argo-workflow"
 	awslambda "github.com/argoproj/argo-events/sensors/triggers/aws-lambda"
+	awssns "github.com/argoproj/argo-events/sensors/triggers/aws-sns"
+	awssqs "github.com/argoproj/argo-events/sensors/triggers/aws-sqs"
 	eventhubs "github.com/argoproj/argo-events/sensors/triggers/azure-event-hubs"
+	queuestorage "github.com/argoproj/argo-events/sensors/triggers/azure-queue-storage"
 	servicebus "github.com/argoproj/argo-events/sensors/triggers/azure-service-bus"
 	customtrigger "github.com/argoproj/argo-events/sensors/triggers/custom-trigger"
+	"github.com/argoproj/argo-events/sensors/triggers/elasticsearch"
 	"github.com/argoproj/argo-events/sensors/triggers/email"
+	githubworkflow "github.com/argoproj/argo-events/sensors/triggers/github-workflow"
 	"github.com/argoproj/argo-events/sensors/triggers/http"
+	"github.com/argoproj/argo-events/sensors/triggers/jenkins"
 	"github.com/argoproj/argo-events/sensors/triggers/kafka"
 	logtrigger "github.com/argoproj/argo-events/sensors/triggers/log"
+	"github.com/argoproj/argo-events/sensors/triggers/loki"
 	"github.com/argoproj/argo-events/sensors/triggers/nats"
+	"github.com/argoproj/argo-events/sensors/triggers/prometheus"
 	"github.com/argoproj/argo-events/sensors/triggers/pulsar"
 	"github.com/argoproj/argo-events/sensors/triggers/slack"
 	standardk8s "github.com/argoproj/argo-events/sensors/triggers/standard-k8s"
@@ -81,6 +89,24 @@ func (sensorCtx *SensorContext) GetTrigger(ctx context.Context, trigger *v1alpha
 		return result
 	}
 
+	if trigger.Template.AWSSQS != nil {
+		result, err := awssqs.NewAWSSQSTrigger(sensorCtx.awsSQSClients, sensorCtx.sensor, trigger, log)
+		if err != nil {
+			log.Errorw("failed to new a SQS trigger", zap.Error(err))
+			return nil
+		}
+		return result
+	}
+
+	if trigger.Template.AWSSNS != nil {
+		result, err := awssns.NewAWSSNSTrigger(sensorCtx.awsSNSClients, sensorCtx.sensor, trigger, log)
+		if err != nil {
+			log.Errorw("failed to new a SNS trigger", zap.Error(err))
+			return nil
+		}
+		return result
+	}
+
 	if trigger.Template.AzureEventHubs != nil {
 		result, err := eventhubs.NewAzureEventHubsTrigger(sensorCtx.sensor, trigger, sensorCtx.azureEventHubsClients, log)
 		if err != nil {
@@ -99,6 +125,15 @@ func (sensorCtx *SensorContext) GetTrigger(ctx context.Context, trigger *v1alpha
 		return result
 	}
 
+	if trigger.Template.AzureQueueStorage != nil {
+		result, err := queuestorage.NewAzureQueueStorageTrigger(sensorCtx.sensor, trigger, sensorCtx.azureQueueStorageClients, log)
+		if err != nil {
+			log.Errorw("failed to new an Azure Queue Storage trigger", zap.Error(err))
+			return nil
+		}
+		return result
+	}
+
 	if trigger.Template.Kafka != nil {
 		result, err := kafka.NewKafkaTrigger(sensorCtx.sensor, trigger, sensorCtx.kafkaProducers, log)
 		if err != nil {
@@ -170,5 +205,50 @@ func (sensorCtx *SensorContext) GetTrigger(ctx context.Context, trigger *v1alpha
 		}
 		return result
 	}
+
+	if trigger.Template.Prometheus != nil {
+		result, err := prometheus.NewPrometheusTrigger(sensorCtx.httpClients, sensorCtx.sensor, trigger, log)
+		if err != nil {
+			log.Errorw("failed to new a Prometheus trigger", zap.Error(err))
+			return nil
+		}
+		return result
+	}
+
+	if trigger.Template.Loki != nil {
+		result, err := loki.NewLokiTrigger(sensorCtx.httpClients, sensorCtx.sensor, trigger, log)
+		if err != nil {
+			log.Errorw("failed to new a Loki trigger", zap.Error(err))
+			return nil
+		}
+		return result
+	}
+
+	if trigger.Template.Elasticsearch != nil {
+		result, err := elasticsearch.NewElasticsearchTrigger(sensorCtx.httpClients, sensorCtx.sensor, trigger, log)
+		if err != nil {
+			log.Errorw("failed to new an Elasticsearch trigger", zap.Error(err))
+			return nil
+		}
+		return result
+	}
+
+	if trigger.Template.Jenkins != nil {
+		result, err := jenkins.NewJenkinsTrigger(sensorCtx.sensor, trigger, log)
+		if err != nil {
+			log.Errorw("failed to new a Jenkins trigger", zap.Error(err))
+			return nil
+		}
+		return result
+	}
+
+	if trigger.Template.GithubWorkflow != nil {
+		result, err := githubworkflow.NewGithubWorkflowTrigger(sensorCtx.sensor, trigger, log)
+		if err != nil {
+			log.Errorw("failed to new a GitHub workflow trigger", zap.Error(err))
+			return nil
+		}
+		return result
+	}
 	return nil
 }
@@ -0,0 +1,107 @@
+package sensors
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+	sensortriggers "github.com/argoproj/argo-events/sensors/triggers"
+)
+
+// errResultCached is returned when a trigger execution is skipped because an identical request has been executed
+// successfully within the TTL of the trigger cache.
+var errResultCached = fmt.Errorf("trigger result is cached")
+
+// triggerCache keeps the keys of the successful executions of a trigger until their TTL expires.
+type triggerCache struct {
+	config v1alpha1.TriggerCache
+
+	lock sync.Mutex
+	// expirations holds the expiration time of each key.
+	expirations map[string]time.Time
+}
+
+func newTriggerCache(config v1alpha1.TriggerCache) *triggerCache {
+	return &triggerCache{config: config, expirations: make(map[string]time.Time)}
+}
+
+// key returns the cache key of an execution, the rendered key template, or the hash of the rendered trigger resource
+// and of the payload rendered from the events, which the triggers sending a message or a request only construct
+// when they are executed.
+func (c *triggerCache) key(events map[string]*v1alpha1.Event, resource interface{}, payload []byte) (string, error) {
+	if c.config.KeyTemplate != "" {
+		return sensortriggers.RenderTemplate(events, c.config.KeyTemplate)
+	}
+	b, err := json.Marshal(resource)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal the trigger resource, %w", err)
+	}
+	hash := sha256.New()
+	hash.Write(b)
+	hash.Write(payload)
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// claim records the key for the TTL and returns true, unless it has already been recorded within the TTL. The hit
+// check and the record are atomic, so that only one of concurrent identical executions goes on.
+func (c *triggerCache) claim(key string) bool {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	now := time.Now()
+	if expiration, ok := c.expirations[key]; ok && now.Before(expiration) {
+		return false
+	}
+	for k, expiration := range c.expirations {
+		if !now.Before(expiration) {
+			delete(c.expirations, k)
+		}
+	}
+	c.expirations[key] = now.Add(c.config.GetTTL())
+	return true
+}
+
+// release forgets the key of an execution which failed, so that an identical request is executed again.
+func (c *triggerCache) release(key string) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	delete(c.expirations, key)
+}
+
+// triggerPayload returns the parameters of the payload of the triggers sending a message or a request, if any.
+func triggerPayload(template *v1alpha1.TriggerTemplate) []v1alpha1.TriggerParameter {
+	switch {
+	case template.HTTP != nil:
+		return template.HTTP.Payload
+	case template.AWSLambda != nil:
+		return template.AWSLambda.Payload
+	case template.CustomTrigger != nil:
+		return template.CustomTrigger.Payload
+	case template.Kafka != nil:
+		return template.Kafka.Payload
+	case template.NATS != nil:
+		return template.NATS.Payload
+	case template.OpenWhisk != nil:
+		return template.OpenWhisk.Payload
+	case template.AzureEventHubs != nil:
+		return template.AzureEventHubs.Payload
+	case template.Pulsar != nil:
+		return template.Pulsar.Payload
+	case template.AzureServiceBus != nil:
+		return template.AzureServiceBus.Payload
+	case template.Loki != nil:
+		return template.Loki.Payload
+	case template.Elasticsearch != nil:
+		return template.Elasticsearch.Payload
+	case template.AWSSQS != nil:
+		return template.AWSSQS.Payload
+	case template.AWSSNS != nil:
+		return template.AWSSNS.Payload
+	case template.AzureQueueStorage != nil:
+		return template.AzureQueueStorage.Payload
+	}
+	return nil
+}
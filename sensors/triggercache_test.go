@@ -0,0 +1,99 @@
+package sensors
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/argoproj/argo-events/common/logging"
+	"github.com/argoproj/argo-events/metrics"
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+)
+
+func TestTriggerCache(t *testing.T) {
+	cache := newTriggerCache(v1alpha1.TriggerCache{TTL: "50ms"})
+
+	a, err := cache.key(nil, map[string]string{"url": "http://a"}, nil)
+	assert.NoError(t, err)
+	b, err := cache.key(nil, map[string]string{"url": "http://b"}, nil)
+	assert.NoError(t, err)
+	assert.NotEqual(t, a, b)
+	// The payload rendered from the events is part of the key
+	c, err := cache.key(nil, map[string]string{"url": "http://a"}, []byte(`{"id":"1"}`))
+	assert.NoError(t, err)
+	assert.NotEqual(t, a, c)
+
+	assert.True(t, cache.claim(a))
+	assert.False(t, cache.claim(a))
+	assert.True(t, cache.claim(b))
+	cache.release(b)
+	assert.True(t, cache.claim(b))
+
+	time.Sleep(60 * time.Millisecond)
+	assert.True(t, cache.claim(a))
+	assert.Len(t, cache.expirations, 1)
+}
+
+func TestTriggerCacheKeyTemplate(t *testing.T) {
+	cache := newTriggerCache(v1alpha1.TriggerCache{KeyTemplate: "{{ .Input.dep.data.version }}", TTL: "1m"})
+	key, err := cache.key(map[string]*v1alpha1.Event{
+		"dep": {Context: &v1alpha1.EventContext{ID: "1", DataContentType: "application/json"}, Data: []byte(`{"version":"v2"}`)},
+	}, nil, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "v2", key)
+}
+
+func TestTriggerOneCached(t *testing.T) {
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+	}))
+	defer server.Close()
+
+	trigger := v1alpha1.Trigger{
+		Template: &v1alpha1.TriggerTemplate{
+			Name: "http-trigger",
+			HTTP: &v1alpha1.HTTPTrigger{
+				URL:    server.URL,
+				Method: http.MethodPost,
+			},
+		},
+		Cache: &v1alpha1.TriggerCache{TTL: "1m"},
+	}
+	obj := sensorObj.DeepCopy()
+	obj.Spec.Triggers = []v1alpha1.Trigger{trigger}
+	sensorCtx := NewSensorContext(nil, nil, obj, nil, "", "", metrics.NewMetrics(obj.Namespace))
+	log := logging.NewArgoEventsLogger()
+
+	err := sensorCtx.triggerOne(context.Background(), obj, trigger, map[string]*v1alpha1.Event{}, nil, nil, log)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&requests))
+
+	// The identical request is not sent again within the TTL
+	err = sensorCtx.triggerOne(context.Background(), obj, trigger, map[string]*v1alpha1.Event{}, nil, nil, log)
+	assert.ErrorIs(t, err, errResultCached)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&requests))
+	assert.NoError(t, sensorCtx.triggerWithRateLimit(context.Background(), obj, trigger, map[string]*v1alpha1.Event{}, nil, nil))
+	assert.Equal(t, int32(1), atomic.LoadInt32(&requests))
+
+	// The requests with different payloads are sent
+	trigger.Template.HTTP.Payload = []v1alpha1.TriggerParameter{
+		{Src: &v1alpha1.TriggerParameterSource{DependencyName: "dep", DataKey: "id"}, Dest: "id"},
+	}
+	newEvents := func(id string) map[string]*v1alpha1.Event {
+		return map[string]*v1alpha1.Event{
+			"dep": {Context: &v1alpha1.EventContext{ID: id, DataContentType: "application/json"}, Data: []byte(`{"id":"` + id + `"}`)},
+		}
+	}
+	assert.NoError(t, sensorCtx.triggerOne(context.Background(), obj, trigger, newEvents("1"), nil, nil, log))
+	assert.NoError(t, sensorCtx.triggerOne(context.Background(), obj, trigger, newEvents("2"), nil, nil, log))
+	assert.Equal(t, int32(3), atomic.LoadInt32(&requests))
+	err = sensorCtx.triggerOne(context.Background(), obj, trigger, newEvents("2"), nil, nil, log)
+	assert.ErrorIs(t, err, errResultCached)
+	assert.Equal(t, int32(3), atomic.LoadInt32(&requests))
+}
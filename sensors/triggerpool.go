@@ -0,0 +1,193 @@
+package sensors
+
+import (
+	"context"
+	"sync"
+
+	sensormetrics "github.com/argoproj/argo-events/metrics"
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+)
+
+// poolTask is an execution queued in a trigger pool, it is called with nil once a worker runs it, or with the
+// error of the context if it is done before the execution gets a slot.
+type poolTask func(err error)
+
+// triggerPools runs the trigger executions on bounded pools of workers, one per trigger, created on their first
+// execution.
+type triggerPools struct {
+	sensorName string
+	spec       v1alpha1.SensorSpec
+	metrics    *sensormetrics.Metrics
+	// slots limits the executions running at the same time across the triggers, nil if there is no limit.
+	slots *fairSlots
+	lock  sync.Mutex
+	pools map[string]*triggerPool
+}
+
+func newTriggerPools(sensor *v1alpha1.Sensor, metrics *sensormetrics.Metrics) *triggerPools {
+	p := &triggerPools{
+		sensorName: sensor.Name,
+		spec:       sensor.Spec,
+		metrics:    metrics,
+		pools:      make(map[string]*triggerPool),
+	}
+	if sensor.Spec.TriggerPools != nil && sensor.Spec.TriggerPools.MaxConcurrency > 0 {
+		p.slots = newFairSlots(int(sensor.Spec.TriggerPools.MaxConcurrency))
+	}
+	return p
+}
+
+// get returns the pool of the trigger, nil if its executions don't run on a pool.
+func (p *triggerPools) get(trigger v1alpha1.Trigger) *triggerPool {
+	if p == nil {
+		return nil
+	}
+	spec := p.spec.GetPool(trigger)
+	if spec == nil {
+		return nil
+	}
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	name := trigger.Template.Name
+	pool, ok := p.pools[name]
+	if !ok {
+		pool = &triggerPool{
+			pools: p,
+			name:  name,
+			queue: make(chan queuedTask, spec.QueueSize),
+		}
+		for i := 0; i < int(spec.Workers); i++ {
+			go pool.work()
+		}
+		p.pools[name] = pool
+	}
+	return pool
+}
+
+type queuedTask struct {
+	ctx  context.Context
+	task poolTask
+}
+
+// triggerPool is the bounded pool of workers of a trigger.
+type triggerPool struct {
+	pools *triggerPools
+	name  string
+	queue chan queuedTask
+}
+
+// submit queues the task, it waits for room in the queue if it is full, until the context is done.
+func (p *triggerPool) submit(ctx context.Context, task poolTask) error {
+	metrics := p.pools.metrics
+	select {
+	case p.queue <- queuedTask{ctx: ctx, task: task}:
+	default:
+		metrics.TriggerPoolSaturated(p.pools.sensorName, p.name)
+		select {
+		case p.queue <- queuedTask{ctx: ctx, task: task}:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	metrics.TriggerPoolQueued(p.pools.sensorName, p.name, len(p.queue))
+	return nil
+}
+
+func (p *triggerPool) work() {
+	metrics := p.pools.metrics
+	slots := p.pools.slots
+	for t := range p.queue {
+		metrics.TriggerPoolQueued(p.pools.sensorName, p.name, len(p.queue))
+		if slots != nil {
+			if err := slots.acquire(t.ctx, p.name); err != nil {
+				t.task(err)
+				continue
+			}
+		}
+		metrics.IncTriggerPoolBusy(p.pools.sensorName, p.name)
+		t.task(nil)
+		metrics.DecTriggerPoolBusy(p.pools.sensorName, p.name)
+		if slots != nil {
+			slots.release()
+		}
+	}
+}
+
+// fairSlots limits the executions running at the same time across the triggers. A freed slot is given to the
+// triggers waiting for one in turn, so that a trigger with many executions waiting doesn't starve the others.
+type fairSlots struct {
+	lock sync.Mutex
+	free int
+	// waiters are the executions waiting for a slot, by trigger name, in the order they started waiting.
+	waiters map[string][]chan struct{}
+	waiting int
+	// order is the order the triggers take turns in, and next the index of the trigger whose turn is next.
+	order []string
+	next  int
+}
+
+func newFairSlots(size int) *fairSlots {
+	return &fairSlots{free: size, waiters: make(map[string][]chan struct{})}
+}
+
+// acquire waits for a slot for an execution of the trigger, until the context is done.
+func (s *fairSlots) acquire(ctx context.Context, name string) error {
+	s.lock.Lock()
+	if s.free > 0 && s.waiting == 0 {
+		s.free--
+		s.lock.Unlock()
+		return nil
+	}
+	granted := make(chan struct{})
+	if _, ok := s.waiters[name]; !ok {
+		s.order = append(s.order, name)
+	}
+	s.waiters[name] = append(s.waiters[name], granted)
+	s.waiting++
+	s.lock.Unlock()
+
+	select {
+	case <-granted:
+		return nil
+	case <-ctx.Done():
+		s.lock.Lock()
+		defer s.lock.Unlock()
+		select {
+		case <-granted:
+			// The slot was given meanwhile
+			s.releaseLocked()
+		default:
+			waiters := s.waiters[name]
+			for i, w := range waiters {
+				if w == granted {
+					s.waiters[name] = append(waiters[:i:i], waiters[i+1:]...)
+					s.waiting--
+					break
+				}
+			}
+		}
+		return ctx.Err()
+	}
+}
+
+// release frees a slot, or gives it to the next trigger waiting for one.
+func (s *fairSlots) release() {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.releaseLocked()
+}
+
+func (s *fairSlots) releaseLocked() {
+	for i := 0; i < len(s.order); i++ {
+		idx := (s.next + i) % len(s.order)
+		name := s.order[idx]
+		if waiters := s.waiters[name]; len(waiters) > 0 {
+			s.waiters[name] = waiters[1:]
+			s.waiting--
+			s.next = idx + 1
+			close(waiters[0])
+			return
+		}
+	}
+	s.free++
+}
@@ -0,0 +1,124 @@
+package sensors
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	sensormetrics "github.com/argoproj/argo-events/metrics"
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+)
+
+func newPoolTestSensor(pools *v1alpha1.TriggerPools, triggers ...v1alpha1.Trigger) *v1alpha1.Sensor {
+	sensor := sensorObj.DeepCopy()
+	sensor.Spec.TriggerPools = pools
+	sensor.Spec.Triggers = triggers
+	return sensor
+}
+
+func TestTriggerPools(t *testing.T) {
+	slow := v1alpha1.Trigger{Template: &v1alpha1.TriggerTemplate{Name: "slow"}, Pool: &v1alpha1.TriggerPool{Workers: 2, QueueSize: 1}}
+	other := v1alpha1.Trigger{Template: &v1alpha1.TriggerTemplate{Name: "other"}}
+	sensor := newPoolTestSensor(nil, slow, other)
+	pools := newTriggerPools(sensor, sensormetrics.NewMetrics("argo-events"))
+
+	assert.Nil(t, pools.get(other))
+	pool := pools.get(slow)
+	assert.NotNil(t, pool)
+	assert.Same(t, pool, pools.get(slow))
+
+	t.Run("test bounded workers", func(t *testing.T) {
+		release := make(chan struct{})
+		var running, maxRunning int32
+		var wg sync.WaitGroup
+		for i := 0; i < 3; i++ {
+			wg.Add(1)
+			assert.NoError(t, pool.submit(context.Background(), func(err error) {
+				defer wg.Done()
+				n := atomic.AddInt32(&running, 1)
+				for {
+					m := atomic.LoadInt32(&maxRunning)
+					if n <= m || atomic.CompareAndSwapInt32(&maxRunning, m, n) {
+						break
+					}
+				}
+				<-release
+				atomic.AddInt32(&running, -1)
+			}))
+		}
+		// The 2 workers are busy and the queue is full
+		assert.Eventually(t, func() bool { return atomic.LoadInt32(&running) == 2 }, time.Second, 10*time.Millisecond)
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+		assert.ErrorIs(t, pool.submit(ctx, func(err error) {}), context.DeadlineExceeded)
+		close(release)
+		wg.Wait()
+		assert.Equal(t, int32(2), maxRunning)
+	})
+}
+
+func TestTriggerPoolsDefaults(t *testing.T) {
+	trigger := v1alpha1.Trigger{Template: &v1alpha1.TriggerTemplate{Name: "default"}}
+	sensor := newPoolTestSensor(&v1alpha1.TriggerPools{MaxConcurrency: 1}, trigger)
+	pools := newTriggerPools(sensor, sensormetrics.NewMetrics("argo-events"))
+	assert.NotNil(t, pools.slots)
+	pool := pools.get(trigger)
+	assert.NotNil(t, pool)
+	assert.Equal(t, 100, cap(pool.queue))
+
+	var nilPools *triggerPools
+	assert.Nil(t, nilPools.get(trigger))
+}
+
+func TestFairSlots(t *testing.T) {
+	slots := newFairSlots(1)
+	ctx := context.Background()
+	assert.NoError(t, slots.acquire(ctx, "busy"))
+
+	var lock sync.Mutex
+	var order []string
+	var wg sync.WaitGroup
+	wait := func(name string) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			assert.NoError(t, slots.acquire(ctx, name))
+			lock.Lock()
+			order = append(order, name)
+			lock.Unlock()
+			slots.release()
+		}()
+	}
+	waiting := func(n int) {
+		assert.Eventually(t, func() bool {
+			slots.lock.Lock()
+			defer slots.lock.Unlock()
+			return slots.waiting == n
+		}, time.Second, time.Millisecond)
+	}
+	// Many executions of "busy" wait before the one of "quiet"
+	for i := 1; i <= 3; i++ {
+		wait("busy")
+		waiting(i)
+	}
+	wait("quiet")
+	waiting(4)
+	slots.release()
+	wg.Wait()
+	assert.Equal(t, []string{"busy", "quiet", "busy", "busy"}, order)
+	assert.Equal(t, 1, slots.free)
+
+	t.Run("test cancel", func(t *testing.T) {
+		assert.NoError(t, slots.acquire(ctx, "busy"))
+		cctx, cancel := context.WithCancel(ctx)
+		cancel()
+		assert.ErrorIs(t, slots.acquire(cctx, "busy"), context.Canceled)
+		assert.Equal(t, 0, slots.waiting)
+		slots.release()
+		assert.Equal(t, 1, slots.free)
+	})
+}
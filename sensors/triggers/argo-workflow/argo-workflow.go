@@ -79,6 +79,10 @@ func (t *ArgoWorkflowTrigger) GetTriggerType() apicommon.TriggerType {
 // FetchResource fetches the trigger resource from external source
 func (t *ArgoWorkflowTrigger) FetchResource(ctx context.Context) (interface{}, error) {
 	trigger := t.Trigger
+	if trigger.Template.ArgoWorkflow.Source == nil && trigger.Template.ArgoWorkflow.LabelSelector != "" {
+		// The workflows are selected by labels
+		return &unstructured.Unstructured{Object: map[string]interface{}{}}, nil
+	}
 	return triggers.FetchKubernetesResource(trigger.Template.ArgoWorkflow.Source)
 }
 
@@ -91,6 +95,9 @@ func (t *ArgoWorkflowTrigger) ApplyResourceParameters(events map[string]*v1alpha
 	if err := triggers.ApplyResourceParameters(events, t.Trigger.Template.ArgoWorkflow.Parameters, obj); err != nil {
 		return nil, err
 	}
+	if err := applyArtifacts(events, t.Trigger.Template.ArgoWorkflow.Artifacts, obj); err != nil {
+		return nil, err
+	}
 	return obj, nil
 }
 
@@ -109,7 +116,7 @@ func (t *ArgoWorkflowTrigger) Execute(ctx context.Context, events map[string]*v1
 	}
 
 	name := obj.GetName()
-	if name == "" {
+	if name == "" && trigger.Template.ArgoWorkflow.LabelSelector == "" {
 		if op != v1alpha1.Submit {
 			return nil, fmt.Errorf("failed to execute the workflow %v operation, no name is given", op)
 		}
@@ -149,6 +156,9 @@ func (t *ArgoWorkflowTrigger) Execute(ctx context.Context, events map[string]*v1
 			labels[k] = v
 		}
 		obj.SetLabels(labels)
+		if err := triggers.ApplyTracingMetadata(ctx, obj, t.Sensor, trigger.Template.Name, events); err != nil {
+			return nil, err
+		}
 
 		jObj, err := obj.MarshalJSON()
 		if err != nil {
@@ -171,34 +181,17 @@ func (t *ArgoWorkflowTrigger) Execute(ctx context.Context, events map[string]*v1
 		}
 		fromArg := fmt.Sprintf("%s/%s", kind, name)
 		cmd = exec.Command("argo", "-n", namespace, "submit", "--from", fromArg)
-	case v1alpha1.Resubmit:
-		cmd = exec.Command("argo", "-n", namespace, "resubmit", name)
-	case v1alpha1.Resume:
-		cmd = exec.Command("argo", "-n", namespace, "resume", name)
-	case v1alpha1.Retry:
-		cmd = exec.Command("argo", "-n", namespace, "retry", name)
-	case v1alpha1.Suspend:
-		cmd = exec.Command("argo", "-n", namespace, "suspend", name)
-	case v1alpha1.Terminate:
-		cmd = exec.Command("argo", "-n", namespace, "terminate", name)
-	case v1alpha1.Stop:
-		cmd = exec.Command("argo", "-n", namespace, "stop", name)
+	case v1alpha1.Resubmit, v1alpha1.Resume, v1alpha1.Retry, v1alpha1.Suspend, v1alpha1.Terminate, v1alpha1.Stop:
+		return t.executeOnWorkflows(ctx, op, namespace, name)
 	default:
 		return nil, fmt.Errorf("unknown operation type %s", string(op))
 	}
 
-	cmd.Stdout = os.Stdout
-	cmd.Stderr = os.Stderr
-	cmd.Args = append(cmd.Args, trigger.Template.ArgoWorkflow.Args...)
-	if err := t.cmdRunner(cmd); err != nil {
-		return nil, fmt.Errorf("failed to execute %s command for workflow %s, %w", string(op), name, err)
+	if err := t.runCommand(cmd, op, name); err != nil {
+		return nil, err
 	}
 
-	t.namespableDynamicClient = t.DynamicClient.Resource(schema.GroupVersionResource{
-		Group:    "argoproj.io",
-		Version:  "v1alpha1",
-		Resource: "workflows",
-	})
+	t.namespableDynamicClient = t.workflowClient()
 
 	if op != v1alpha1.Submit {
 		return t.namespableDynamicClient.Namespace(namespace).Get(ctx, name, metav1.GetOptions{})
@@ -213,6 +206,52 @@ func (t *ArgoWorkflowTrigger) Execute(ctx context.Context, events map[string]*v1
 	return l.Items[0], nil
 }
 
+// executeOnWorkflows runs the operation on the named workflow, or on the workflows matching the label selector of the
+// trigger, and returns the last workflow the operation ran on.
+func (t *ArgoWorkflowTrigger) executeOnWorkflows(ctx context.Context, op v1alpha1.ArgoWorkflowOperation, namespace, name string) (interface{}, error) {
+	t.namespableDynamicClient = t.workflowClient()
+
+	names := []string{name}
+	if selector := t.Trigger.Template.ArgoWorkflow.LabelSelector; selector != "" {
+		l, err := t.namespableDynamicClient.Namespace(namespace).List(ctx, metav1.ListOptions{LabelSelector: selector})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list the workflows matching the label selector %q, %w", selector, err)
+		}
+		if len(l.Items) == 0 {
+			return nil, fmt.Errorf("no workflow matches the label selector %q", selector)
+		}
+		names = names[:0]
+		for _, wf := range l.Items {
+			names = append(names, wf.GetName())
+		}
+	}
+
+	for _, name := range names {
+		if err := t.runCommand(exec.Command("argo", "-n", namespace, string(op), name), op, name); err != nil {
+			return nil, err
+		}
+	}
+	return t.namespableDynamicClient.Namespace(namespace).Get(ctx, names[len(names)-1], metav1.GetOptions{})
+}
+
+func (t *ArgoWorkflowTrigger) runCommand(cmd *exec.Cmd, op v1alpha1.ArgoWorkflowOperation, name string) error {
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	cmd.Args = append(cmd.Args, t.Trigger.Template.ArgoWorkflow.Args...)
+	if err := t.cmdRunner(cmd); err != nil {
+		return fmt.Errorf("failed to execute %s command for workflow %s, %w", string(op), name, err)
+	}
+	return nil
+}
+
+func (t *ArgoWorkflowTrigger) workflowClient() dynamic.NamespaceableResourceInterface {
+	return t.DynamicClient.Resource(schema.GroupVersionResource{
+		Group:    "argoproj.io",
+		Version:  "v1alpha1",
+		Resource: "workflows",
+	})
+}
+
 // ApplyPolicy applies the policy on the trigger
 func (t *ArgoWorkflowTrigger) ApplyPolicy(ctx context.Context, resource interface{}) error {
 	trigger := t.Trigger
@@ -74,7 +74,9 @@ func newUnstructured(apiVersion, kind, namespace, name string) *unstructured.Uns
 
 func getFakeWfTrigger(operation v1alpha1.ArgoWorkflowOperation) *ArgoWorkflowTrigger {
 	runtimeScheme := runtime.NewScheme()
-	client := dynamicFake.NewSimpleDynamicClient(runtimeScheme)
+	client := dynamicFake.NewSimpleDynamicClientWithCustomListKinds(runtimeScheme, map[schema.GroupVersionResource]string{
+		{Group: "argoproj.io", Version: "v1alpha1", Resource: "workflows"}: "WorkflowList",
+	})
 	artifact := apicommon.NewResource(un)
 	trigger := &v1alpha1.Trigger{
 		Template: &v1alpha1.TriggerTemplate{
@@ -122,6 +124,35 @@ func TestExecute(t *testing.T) {
 		expected := fmt.Sprintf("argo -n %s resume test %s %s", un.GetNamespace(), firstArg, secondArg)
 		assert.Contains(t, actual, expected)
 	})
+
+	t.Run("runs the operation on the workflows matching the label selector", func(t *testing.T) {
+		ctx := context.Background()
+		var actual []string
+		trigger := getFakeWfTrigger("resume")
+		trigger.cmdRunner = func(cmd *exec.Cmd) error {
+			actual = append(actual, cmd.String())
+			return nil
+		}
+		trigger.Trigger.Template.ArgoWorkflow.Source = nil
+		trigger.Trigger.Template.ArgoWorkflow.LabelSelector = "name in (approval-1, approval-2)"
+
+		for _, name := range []string{"approval-1", "approval-2", "other"} {
+			_, err := namespacedClientFrom(trigger).Namespace("fake").Create(ctx, newUnstructured("argoproj.io/v1alpha1", "Workflow", "fake", name), metav1.CreateOptions{})
+			assert.Nil(t, err)
+		}
+
+		resource, err := trigger.FetchResource(ctx)
+		assert.Nil(t, err)
+		_, err = trigger.Execute(ctx, nil, resource)
+		assert.Nil(t, err)
+		assert.Len(t, actual, 2)
+		assert.Contains(t, actual[0], "argo -n fake resume approval-1")
+		assert.Contains(t, actual[1], "argo -n fake resume approval-2")
+
+		trigger.Trigger.Template.ArgoWorkflow.LabelSelector = "name=unknown"
+		_, err = trigger.Execute(ctx, nil, resource)
+		assert.ErrorContains(t, err, "no workflow matches the label selector")
+	})
 }
 
 func storingCmdTrigger(cmdStr *string, wfArgs ...string) *ArgoWorkflowTrigger {
@@ -0,0 +1,104 @@
+package argo_workflow
+
+import (
+	"fmt"
+
+	"github.com/tidwall/gjson"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+	"github.com/argoproj/argo-events/sensors/triggers"
+)
+
+// applyArtifacts sets the input artifacts materialized from the events in the spec.arguments.artifacts of the
+// workflow, replacing the artifacts of the same names.
+func applyArtifacts(events map[string]*v1alpha1.Event, artifacts []v1alpha1.ArgoWorkflowArtifact, obj *unstructured.Unstructured) error {
+	if len(artifacts) == 0 {
+		return nil
+	}
+	existing, _, err := unstructured.NestedSlice(obj.Object, "spec", "arguments", "artifacts")
+	if err != nil {
+		return fmt.Errorf("failed to get the artifacts of the workflow arguments, %w", err)
+	}
+	for _, artifact := range artifacts {
+		value, err := materializeArtifact(events, artifact)
+		if err != nil {
+			return fmt.Errorf("failed to materialize the artifact %s, %w", artifact.Name, err)
+		}
+		replaced := false
+		for i, e := range existing {
+			if m, ok := e.(map[string]interface{}); ok && m["name"] == artifact.Name {
+				existing[i] = value
+				replaced = true
+				break
+			}
+		}
+		if !replaced {
+			existing = append(existing, value)
+		}
+	}
+	return unstructured.SetNestedSlice(obj.Object, existing, "spec", "arguments", "artifacts")
+}
+
+// materializeArtifact returns the workflow artifact of an input artifact.
+func materializeArtifact(events map[string]*v1alpha1.Event, artifact v1alpha1.ArgoWorkflowArtifact) (map[string]interface{}, error) {
+	switch {
+	case artifact.Raw != nil:
+		value, _, err := triggers.ResolveParamValue(artifact.Raw, events)
+		if err != nil {
+			return nil, err
+		}
+		if value == nil {
+			return nil, fmt.Errorf("no event of the dependency %s", artifact.Raw.DependencyName)
+		}
+		return map[string]interface{}{
+			"name": artifact.Name,
+			"raw":  map[string]interface{}{"data": *value},
+		}, nil
+	case artifact.S3 != nil:
+		s3, err := s3Artifact(events, artifact.S3)
+		if err != nil {
+			return nil, err
+		}
+		return map[string]interface{}{"name": artifact.Name, "s3": s3}, nil
+	default:
+		return nil, fmt.Errorf("either raw or s3 must be specified")
+	}
+}
+
+// s3Artifact returns the S3 location of a workflow artifact, resolved from the data of the event.
+func s3Artifact(events map[string]*v1alpha1.Event, s3 *v1alpha1.ArgoWorkflowS3Artifact) (map[string]interface{}, error) {
+	event, ok := events[s3.DependencyName]
+	if !ok {
+		return nil, fmt.Errorf("no event of the dependency %s", s3.DependencyName)
+	}
+	bucket := gjson.GetBytes(event.Data, s3.GetBucketKey()).String()
+	if bucket == "" {
+		return nil, fmt.Errorf("no bucket found at %s in the event data", s3.GetBucketKey())
+	}
+	key := gjson.GetBytes(event.Data, s3.GetKeyKey()).String()
+	if key == "" {
+		return nil, fmt.Errorf("no key found at %s in the event data", s3.GetKeyKey())
+	}
+	result := map[string]interface{}{"bucket": bucket, "key": key}
+	endpoint := s3.Endpoint
+	if endpoint == "" {
+		endpoint = gjson.GetBytes(event.Data, "claimCheck.endpoint").String()
+	}
+	if endpoint != "" {
+		result["endpoint"] = endpoint
+	}
+	if s3.Region != "" {
+		result["region"] = s3.Region
+	}
+	if s3.Insecure {
+		result["insecure"] = true
+	}
+	if s3.AccessKeySecret != nil {
+		result["accessKeySecret"] = map[string]interface{}{"name": s3.AccessKeySecret.Name, "key": s3.AccessKeySecret.Key}
+	}
+	if s3.SecretKeySecret != nil {
+		result["secretKeySecret"] = map[string]interface{}{"name": s3.SecretKeySecret.Name, "key": s3.SecretKeySecret.Key}
+	}
+	return result, nil
+}
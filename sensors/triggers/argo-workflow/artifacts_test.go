@@ -0,0 +1,62 @@
+package argo_workflow
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/argoproj/argo-events/common"
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+)
+
+func TestApplyArtifacts(t *testing.T) {
+	events := map[string]*v1alpha1.Event{
+		"order": {
+			Context: &v1alpha1.EventContext{DataContentType: common.MediaTypeJSON},
+			Data:    []byte(`{"body":{"items":[{"sku":"a-1"}]}}`),
+		},
+		"upload": {
+			Context: &v1alpha1.EventContext{DataContentType: common.MediaTypeJSON},
+			Data:    []byte(`{"claimCheck":{"endpoint":"minio.argo-events:9000","bucket":"events","key":"webhook/example/1","size":2097152}}`),
+		},
+	}
+	obj := un.DeepCopy()
+	assert.NoError(t, unstructured.SetNestedSlice(obj.Object, []interface{}{
+		map[string]interface{}{"name": "items", "raw": map[string]interface{}{"data": "[]"}},
+		map[string]interface{}{"name": "config", "raw": map[string]interface{}{"data": "{}"}},
+	}, "spec", "arguments", "artifacts"))
+
+	err := applyArtifacts(events, []v1alpha1.ArgoWorkflowArtifact{
+		{Name: "items", Raw: &v1alpha1.TriggerParameterSource{DependencyName: "order", DataKey: "body.items"}},
+		{Name: "payload", S3: &v1alpha1.ArgoWorkflowS3Artifact{
+			DependencyName:  "upload",
+			AccessKeySecret: &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "minio"}, Key: "accesskey"},
+			SecretKeySecret: &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "minio"}, Key: "secretkey"},
+		}},
+	}, obj)
+	assert.NoError(t, err)
+	artifacts, _, err := unstructured.NestedSlice(obj.Object, "spec", "arguments", "artifacts")
+	assert.NoError(t, err)
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{"name": "items", "raw": map[string]interface{}{"data": `[{"sku":"a-1"}]`}},
+		map[string]interface{}{"name": "config", "raw": map[string]interface{}{"data": "{}"}},
+		map[string]interface{}{"name": "payload", "s3": map[string]interface{}{
+			"endpoint":        "minio.argo-events:9000",
+			"bucket":          "events",
+			"key":             "webhook/example/1",
+			"accessKeySecret": map[string]interface{}{"name": "minio", "key": "accesskey"},
+			"secretKeySecret": map[string]interface{}{"name": "minio", "key": "secretkey"},
+		}},
+	}, artifacts)
+
+	err = applyArtifacts(events, []v1alpha1.ArgoWorkflowArtifact{
+		{Name: "payload", S3: &v1alpha1.ArgoWorkflowS3Artifact{DependencyName: "order"}},
+	}, obj)
+	assert.ErrorContains(t, err, "no bucket found at claimCheck.bucket")
+	err = applyArtifacts(events, []v1alpha1.ArgoWorkflowArtifact{
+		{Name: "payload", Raw: &v1alpha1.TriggerParameterSource{DependencyName: "missing"}},
+	}, obj)
+	assert.ErrorContains(t, err, "no event of the dependency missing")
+}
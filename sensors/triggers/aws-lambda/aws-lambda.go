@@ -17,6 +17,7 @@ package aws_lambda
 
 import (
 	"context"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
 
@@ -114,10 +115,16 @@ func (t *AWSLambdaTrigger) Execute(ctx context.Context, events map[string]*v1alp
 		return nil, err
 	}
 
+	clientContext, err := idempotencyClientContext(triggers.IdempotencyKey(events, t.Trigger.Template.Name))
+	if err != nil {
+		return nil, err
+	}
+
 	response, err := t.LambdaClient.Invoke(&lambda.InvokeInput{
 		FunctionName:   &trigger.FunctionName,
 		Payload:        payload,
 		InvocationType: trigger.InvocationType,
+		ClientContext:  &clientContext,
 	})
 	if err != nil {
 		return nil, err
@@ -126,6 +133,20 @@ func (t *AWSLambdaTrigger) Execute(ctx context.Context, events map[string]*v1alp
 	return response, nil
 }
 
+// idempotencyClientContext returns the base64 encoded client context carrying the idempotency key,
+// which is exposed to the function as a custom client context attribute.
+func idempotencyClientContext(key string) (string, error) {
+	body, err := json.Marshal(map[string]interface{}{
+		"custom": map[string]string{
+			triggers.IdempotencyKeyHeader: key,
+		},
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal the lambda client context, %w", err)
+	}
+	return base64.StdEncoding.EncodeToString(body), nil
+}
+
 // ApplyPolicy applies the policy on the trigger execution response
 func (t *AWSLambdaTrigger) ApplyPolicy(ctx context.Context, resource interface{}) error {
 	if t.Trigger.Policy == nil || t.Trigger.Policy.Status == nil || t.Trigger.Policy.Status.Allow == nil {
@@ -17,6 +17,8 @@ package aws_lambda
 
 import (
 	"context"
+	"encoding/base64"
+	"encoding/json"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/service/lambda"
@@ -186,3 +188,15 @@ func TestAWSLambdaTrigger_ApplyPolicy(t *testing.T) {
 		assert.Nil(t, err)
 	}
 }
+
+func TestIdempotencyClientContext(t *testing.T) {
+	clientContext, err := idempotencyClientContext("key")
+	assert.NoError(t, err)
+	body, err := base64.StdEncoding.DecodeString(clientContext)
+	assert.NoError(t, err)
+	var decoded struct {
+		Custom map[string]string `json:"custom"`
+	}
+	assert.NoError(t, json.Unmarshal(body, &decoded))
+	assert.Equal(t, "key", decoded.Custom["Idempotency-Key"])
+}
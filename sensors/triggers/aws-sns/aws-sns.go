@@ -0,0 +1,159 @@
+/*
+Copyright 2020 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package aws_sns
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sns"
+	"github.com/aws/aws-sdk-go/service/sns/snsiface"
+	"go.uber.org/zap"
+
+	"github.com/argoproj/argo-events/common"
+	"github.com/argoproj/argo-events/common/logging"
+	commonaws "github.com/argoproj/argo-events/eventsources/common/aws"
+	apicommon "github.com/argoproj/argo-events/pkg/apis/common"
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+	"github.com/argoproj/argo-events/sensors/triggers"
+)
+
+// AWSSNSTrigger refers to trigger that publishes messages to AWS SNS topics
+type AWSSNSTrigger struct {
+	// SNSClient is AWS SNS client
+	SNSClient snsiface.SNSAPI
+	// Sensor object
+	Sensor *v1alpha1.Sensor
+	// Trigger definition
+	Trigger *v1alpha1.Trigger
+	// logger to log stuff
+	Logger *zap.SugaredLogger
+}
+
+// NewAWSSNSTrigger returns a new AWS SNS trigger
+func NewAWSSNSTrigger(snsClients common.StringKeyedMap[snsiface.SNSAPI], sensor *v1alpha1.Sensor, trigger *v1alpha1.Trigger, logger *zap.SugaredLogger) (*AWSSNSTrigger, error) {
+	snsTrigger := trigger.Template.AWSSNS
+
+	snsClient, ok := snsClients.Load(trigger.Template.Name)
+	if !ok {
+		awsSession, err := commonaws.CreateAWSSessionWithCredsInVolume(snsTrigger.Region, snsTrigger.RoleARN, snsTrigger.AccessKey, snsTrigger.SecretKey, nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create a AWS session, %w", err)
+		}
+		config := &aws.Config{Region: &snsTrigger.Region}
+		if snsTrigger.Endpoint != "" {
+			config.Endpoint = &snsTrigger.Endpoint
+		}
+		snsClient = sns.New(awsSession, config)
+		snsClients.Store(trigger.Template.Name, snsClient)
+	}
+
+	return &AWSSNSTrigger{
+		SNSClient: snsClient,
+		Sensor:    sensor,
+		Trigger:   trigger,
+		Logger:    logger.With(logging.LabelTriggerType, apicommon.AWSSNSTrigger),
+	}, nil
+}
+
+// GetTriggerType returns the type of the trigger
+func (t *AWSSNSTrigger) GetTriggerType() apicommon.TriggerType {
+	return apicommon.AWSSNSTrigger
+}
+
+// FetchResource fetches the trigger resource
+func (t *AWSSNSTrigger) FetchResource(ctx context.Context) (interface{}, error) {
+	return t.Trigger.Template.AWSSNS, nil
+}
+
+// ApplyResourceParameters applies parameters to the trigger resource
+func (t *AWSSNSTrigger) ApplyResourceParameters(events map[string]*v1alpha1.Event, resource interface{}) (interface{}, error) {
+	resourceBytes, err := json.Marshal(resource)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal the aws sns trigger resource, %w", err)
+	}
+	parameters := t.Trigger.Template.AWSSNS.Parameters
+	if parameters != nil {
+		updatedResourceBytes, err := triggers.ApplyParams(resourceBytes, parameters, events)
+		if err != nil {
+			return nil, err
+		}
+		var st *v1alpha1.AWSSNSTrigger
+		if err := json.Unmarshal(updatedResourceBytes, &st); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal the updated aws sns trigger resource after applying resource parameters, %w", err)
+		}
+		return st, nil
+	}
+	return resource, nil
+}
+
+// Execute executes the trigger
+func (t *AWSSNSTrigger) Execute(ctx context.Context, events map[string]*v1alpha1.Event, resource interface{}) (interface{}, error) {
+	trigger, ok := resource.(*v1alpha1.AWSSNSTrigger)
+	if !ok {
+		return nil, fmt.Errorf("failed to interpret the trigger resource")
+	}
+
+	if trigger.Payload == nil {
+		return nil, fmt.Errorf("payload parameters are not specified")
+	}
+
+	payload, err := triggers.ConstructPayload(events, trigger.Payload)
+	if err != nil {
+		return nil, err
+	}
+
+	idempotencyKey := triggers.IdempotencyKey(events, t.Trigger.Template.Name)
+	input := &sns.PublishInput{
+		TopicArn:          &trigger.TopicArn,
+		Message:           aws.String(string(payload)),
+		MessageAttributes: messageAttributes(trigger.MessageAttributes, idempotencyKey),
+	}
+	if trigger.Subject != "" {
+		input.Subject = &trigger.Subject
+	}
+	if trigger.MessageGroupID != "" {
+		input.MessageGroupId = &trigger.MessageGroupID
+		input.MessageDeduplicationId = aws.String(idempotencyKey)
+		if trigger.MessageDeduplicationID != "" {
+			input.MessageDeduplicationId = &trigger.MessageDeduplicationID
+		}
+	}
+
+	response, err := t.SNSClient.PublishWithContext(ctx, input)
+	if err != nil {
+		return nil, fmt.Errorf("failed to publish the message to the topic %s, %w", trigger.TopicArn, err)
+	}
+	t.Logger.Infow("message published to the topic", zap.String("topicArn", trigger.TopicArn), zap.String("messageId", aws.StringValue(response.MessageId)))
+	return response, nil
+}
+
+// messageAttributes returns the string attributes of the message, with the idempotency key of the execution.
+func messageAttributes(attributes map[string]string, idempotencyKey string) map[string]*sns.MessageAttributeValue {
+	result := make(map[string]*sns.MessageAttributeValue, len(attributes)+1)
+	for name, value := range attributes {
+		result[name] = &sns.MessageAttributeValue{DataType: aws.String("String"), StringValue: aws.String(value)}
+	}
+	result[triggers.IdempotencyKeyHeader] = &sns.MessageAttributeValue{DataType: aws.String("String"), StringValue: aws.String(idempotencyKey)}
+	return result
+}
+
+// ApplyPolicy applies the policy on the trigger execution response
+func (t *AWSSNSTrigger) ApplyPolicy(ctx context.Context, resource interface{}) error {
+	return nil
+}
@@ -0,0 +1,124 @@
+/*
+Copyright 2020 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package aws_sns
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/sns"
+	"github.com/aws/aws-sdk-go/service/sns/snsiface"
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-events/common/logging"
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+	"github.com/argoproj/argo-events/sensors/triggers"
+)
+
+type fakeSNSClient struct {
+	snsiface.SNSAPI
+	published *sns.PublishInput
+}
+
+func (f *fakeSNSClient) PublishWithContext(ctx aws.Context, input *sns.PublishInput, opts ...request.Option) (*sns.PublishOutput, error) {
+	f.published = input
+	return &sns.PublishOutput{MessageId: aws.String("1")}, nil
+}
+
+var sensorObj = &v1alpha1.Sensor{
+	ObjectMeta: metav1.ObjectMeta{
+		Name:      "fake-sensor",
+		Namespace: "fake",
+	},
+	Spec: v1alpha1.SensorSpec{
+		Triggers: []v1alpha1.Trigger{
+			{
+				Template: &v1alpha1.TriggerTemplate{
+					Name: "fake-trigger",
+					AWSSNS: &v1alpha1.AWSSNSTrigger{
+						TopicArn: "arn:aws:sns:us-east-1:123456789012:orders",
+						Region:   "us-east-1",
+						Subject:  "order",
+						Payload: []v1alpha1.TriggerParameter{
+							{
+								Src:  &v1alpha1.TriggerParameterSource{DependencyName: "fake-dependency", DataKey: "order"},
+								Dest: "order",
+							},
+						},
+						Parameters: []v1alpha1.TriggerParameter{
+							{
+								Src:  &v1alpha1.TriggerParameterSource{DependencyName: "fake-dependency", DataKey: "region"},
+								Dest: "messageAttributes.region",
+							},
+						},
+					},
+				},
+			},
+		},
+	},
+}
+
+var testEvents = map[string]*v1alpha1.Event{
+	"fake-dependency": {
+		Context: &v1alpha1.EventContext{
+			ID:              "1",
+			Type:            "webhook",
+			Source:          "webhook-gateway",
+			DataContentType: "application/json",
+			SpecVersion:     cloudevents.VersionV1,
+			Subject:         "example-1",
+		},
+		Data: []byte(`{"order": "o-1", "region": "eu"}`),
+	},
+}
+
+func TestAWSSNSTrigger_Execute(t *testing.T) {
+	client := &fakeSNSClient{}
+	trigger := &AWSSNSTrigger{
+		SNSClient: client,
+		Sensor:    sensorObj.DeepCopy(),
+		Trigger:   sensorObj.Spec.Triggers[0].DeepCopy(),
+		Logger:    logging.NewArgoEventsLogger(),
+	}
+	resource, err := trigger.FetchResource(context.TODO())
+	assert.NoError(t, err)
+	resource, err = trigger.ApplyResourceParameters(testEvents, resource)
+	assert.NoError(t, err)
+	_, err = trigger.Execute(context.TODO(), testEvents, resource)
+	assert.NoError(t, err)
+
+	assert.Equal(t, "arn:aws:sns:us-east-1:123456789012:orders", *client.published.TopicArn)
+	assert.Equal(t, `{"order":"o-1"}`, *client.published.Message)
+	assert.Equal(t, "order", *client.published.Subject)
+	assert.Nil(t, client.published.MessageGroupId)
+	assert.Nil(t, client.published.MessageDeduplicationId)
+	assert.Equal(t, "eu", *client.published.MessageAttributes["region"].StringValue)
+	assert.Equal(t, triggers.IdempotencyKey(testEvents, "fake-trigger"), *client.published.MessageAttributes[triggers.IdempotencyKeyHeader].StringValue)
+
+	t.Run("fifo topic", func(t *testing.T) {
+		fifo := resource.(*v1alpha1.AWSSNSTrigger).DeepCopy()
+		fifo.MessageGroupID = "orders"
+		fifo.MessageDeduplicationID = "o-1"
+		_, err = trigger.Execute(context.TODO(), testEvents, fifo)
+		assert.NoError(t, err)
+		assert.Equal(t, "orders", *client.published.MessageGroupId)
+		assert.Equal(t, "o-1", *client.published.MessageDeduplicationId)
+	})
+}
@@ -0,0 +1,170 @@
+/*
+Copyright 2020 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package aws_sqs
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sqs"
+	"github.com/aws/aws-sdk-go/service/sqs/sqsiface"
+	"go.uber.org/zap"
+
+	"github.com/argoproj/argo-events/common"
+	"github.com/argoproj/argo-events/common/logging"
+	commonaws "github.com/argoproj/argo-events/eventsources/common/aws"
+	apicommon "github.com/argoproj/argo-events/pkg/apis/common"
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+	"github.com/argoproj/argo-events/sensors/triggers"
+)
+
+// AWSSQSTrigger refers to trigger that sends messages to AWS SQS queues
+type AWSSQSTrigger struct {
+	// SQSClient is AWS SQS client
+	SQSClient sqsiface.SQSAPI
+	// Sensor object
+	Sensor *v1alpha1.Sensor
+	// Trigger definition
+	Trigger *v1alpha1.Trigger
+	// logger to log stuff
+	Logger *zap.SugaredLogger
+}
+
+// NewAWSSQSTrigger returns a new AWS SQS trigger
+func NewAWSSQSTrigger(sqsClients common.StringKeyedMap[sqsiface.SQSAPI], sensor *v1alpha1.Sensor, trigger *v1alpha1.Trigger, logger *zap.SugaredLogger) (*AWSSQSTrigger, error) {
+	sqsTrigger := trigger.Template.AWSSQS
+
+	sqsClient, ok := sqsClients.Load(trigger.Template.Name)
+	if !ok {
+		awsSession, err := commonaws.CreateAWSSessionWithCredsInVolume(sqsTrigger.Region, sqsTrigger.RoleARN, sqsTrigger.AccessKey, sqsTrigger.SecretKey, nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create a AWS session, %w", err)
+		}
+		config := &aws.Config{Region: &sqsTrigger.Region}
+		if sqsTrigger.Endpoint != "" {
+			config.Endpoint = &sqsTrigger.Endpoint
+		}
+		sqsClient = sqs.New(awsSession, config)
+		sqsClients.Store(trigger.Template.Name, sqsClient)
+	}
+
+	return &AWSSQSTrigger{
+		SQSClient: sqsClient,
+		Sensor:    sensor,
+		Trigger:   trigger,
+		Logger:    logger.With(logging.LabelTriggerType, apicommon.AWSSQSTrigger),
+	}, nil
+}
+
+// GetTriggerType returns the type of the trigger
+func (t *AWSSQSTrigger) GetTriggerType() apicommon.TriggerType {
+	return apicommon.AWSSQSTrigger
+}
+
+// FetchResource fetches the trigger resource
+func (t *AWSSQSTrigger) FetchResource(ctx context.Context) (interface{}, error) {
+	return t.Trigger.Template.AWSSQS, nil
+}
+
+// ApplyResourceParameters applies parameters to the trigger resource
+func (t *AWSSQSTrigger) ApplyResourceParameters(events map[string]*v1alpha1.Event, resource interface{}) (interface{}, error) {
+	resourceBytes, err := json.Marshal(resource)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal the aws sqs trigger resource, %w", err)
+	}
+	parameters := t.Trigger.Template.AWSSQS.Parameters
+	if parameters != nil {
+		updatedResourceBytes, err := triggers.ApplyParams(resourceBytes, parameters, events)
+		if err != nil {
+			return nil, err
+		}
+		var st *v1alpha1.AWSSQSTrigger
+		if err := json.Unmarshal(updatedResourceBytes, &st); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal the updated aws sqs trigger resource after applying resource parameters, %w", err)
+		}
+		return st, nil
+	}
+	return resource, nil
+}
+
+// Execute executes the trigger
+func (t *AWSSQSTrigger) Execute(ctx context.Context, events map[string]*v1alpha1.Event, resource interface{}) (interface{}, error) {
+	trigger, ok := resource.(*v1alpha1.AWSSQSTrigger)
+	if !ok {
+		return nil, fmt.Errorf("failed to interpret the trigger resource")
+	}
+
+	if trigger.Payload == nil {
+		return nil, fmt.Errorf("payload parameters are not specified")
+	}
+
+	payload, err := triggers.ConstructPayload(events, trigger.Payload)
+	if err != nil {
+		return nil, err
+	}
+
+	getQueueURLInput := &sqs.GetQueueUrlInput{
+		QueueName: &trigger.Queue,
+	}
+	if trigger.QueueAccountID != "" {
+		getQueueURLInput = getQueueURLInput.SetQueueOwnerAWSAccountId(trigger.QueueAccountID)
+	}
+	queueURL, err := t.SQSClient.GetQueueUrlWithContext(ctx, getQueueURLInput)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the queue url for %s, %w", trigger.Queue, err)
+	}
+
+	idempotencyKey := triggers.IdempotencyKey(events, t.Trigger.Template.Name)
+	input := &sqs.SendMessageInput{
+		QueueUrl:          queueURL.QueueUrl,
+		MessageBody:       aws.String(string(payload)),
+		MessageAttributes: messageAttributes(trigger.MessageAttributes, idempotencyKey),
+	}
+	if trigger.MessageGroupID != "" {
+		input.MessageGroupId = &trigger.MessageGroupID
+		input.MessageDeduplicationId = aws.String(idempotencyKey)
+		if trigger.MessageDeduplicationID != "" {
+			input.MessageDeduplicationId = &trigger.MessageDeduplicationID
+		}
+	}
+	if trigger.DelaySeconds > 0 {
+		input.DelaySeconds = &trigger.DelaySeconds
+	}
+
+	response, err := t.SQSClient.SendMessageWithContext(ctx, input)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send the message to the queue %s, %w", trigger.Queue, err)
+	}
+	t.Logger.Infow("message sent to the queue", zap.String("queue", trigger.Queue), zap.String("messageId", aws.StringValue(response.MessageId)))
+	return response, nil
+}
+
+// messageAttributes returns the string attributes of the message, with the idempotency key of the execution.
+func messageAttributes(attributes map[string]string, idempotencyKey string) map[string]*sqs.MessageAttributeValue {
+	result := make(map[string]*sqs.MessageAttributeValue, len(attributes)+1)
+	for name, value := range attributes {
+		result[name] = &sqs.MessageAttributeValue{DataType: aws.String("String"), StringValue: aws.String(value)}
+	}
+	result[triggers.IdempotencyKeyHeader] = &sqs.MessageAttributeValue{DataType: aws.String("String"), StringValue: aws.String(idempotencyKey)}
+	return result
+}
+
+// ApplyPolicy applies the policy on the trigger execution response
+func (t *AWSSQSTrigger) ApplyPolicy(ctx context.Context, resource interface{}) error {
+	return nil
+}
@@ -0,0 +1,128 @@
+/*
+Copyright 2020 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package aws_sqs
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/sqs"
+	"github.com/aws/aws-sdk-go/service/sqs/sqsiface"
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-events/common/logging"
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+	"github.com/argoproj/argo-events/sensors/triggers"
+)
+
+type fakeSQSClient struct {
+	sqsiface.SQSAPI
+	queueURLInput *sqs.GetQueueUrlInput
+	sent          *sqs.SendMessageInput
+}
+
+func (f *fakeSQSClient) GetQueueUrlWithContext(ctx aws.Context, input *sqs.GetQueueUrlInput, opts ...request.Option) (*sqs.GetQueueUrlOutput, error) {
+	f.queueURLInput = input
+	return &sqs.GetQueueUrlOutput{QueueUrl: aws.String("https://sqs.us-east-1.amazonaws.com/123456789012/" + *input.QueueName)}, nil
+}
+
+func (f *fakeSQSClient) SendMessageWithContext(ctx aws.Context, input *sqs.SendMessageInput, opts ...request.Option) (*sqs.SendMessageOutput, error) {
+	f.sent = input
+	return &sqs.SendMessageOutput{MessageId: aws.String("1")}, nil
+}
+
+var sensorObj = &v1alpha1.Sensor{
+	ObjectMeta: metav1.ObjectMeta{
+		Name:      "fake-sensor",
+		Namespace: "fake",
+	},
+	Spec: v1alpha1.SensorSpec{
+		Triggers: []v1alpha1.Trigger{
+			{
+				Template: &v1alpha1.TriggerTemplate{
+					Name: "fake-trigger",
+					AWSSQS: &v1alpha1.AWSSQSTrigger{
+						Queue:  "fake-queue",
+						Region: "us-east-1",
+						Payload: []v1alpha1.TriggerParameter{
+							{
+								Src:  &v1alpha1.TriggerParameterSource{DependencyName: "fake-dependency", DataKey: "order"},
+								Dest: "order",
+							},
+						},
+						Parameters: []v1alpha1.TriggerParameter{
+							{
+								Src:  &v1alpha1.TriggerParameterSource{DependencyName: "fake-dependency", DataKey: "customer"},
+								Dest: "messageGroupId",
+							},
+							{
+								Src:  &v1alpha1.TriggerParameterSource{DependencyName: "fake-dependency", DataKey: "region"},
+								Dest: "messageAttributes.region",
+							},
+						},
+						MessageAttributes: map[string]string{"source": "argo-events"},
+					},
+				},
+			},
+		},
+	},
+}
+
+var testEvents = map[string]*v1alpha1.Event{
+	"fake-dependency": {
+		Context: &v1alpha1.EventContext{
+			ID:              "1",
+			Type:            "webhook",
+			Source:          "webhook-gateway",
+			DataContentType: "application/json",
+			SpecVersion:     cloudevents.VersionV1,
+			Subject:         "example-1",
+		},
+		Data: []byte(`{"order": "o-1", "customer": "c-1", "region": "eu"}`),
+	},
+}
+
+func TestAWSSQSTrigger_Execute(t *testing.T) {
+	client := &fakeSQSClient{}
+	trigger := &AWSSQSTrigger{
+		SQSClient: client,
+		Sensor:    sensorObj.DeepCopy(),
+		Trigger:   sensorObj.Spec.Triggers[0].DeepCopy(),
+		Logger:    logging.NewArgoEventsLogger(),
+	}
+	resource, err := trigger.FetchResource(context.TODO())
+	assert.NoError(t, err)
+	resource, err = trigger.ApplyResourceParameters(testEvents, resource)
+	assert.NoError(t, err)
+	_, err = trigger.Execute(context.TODO(), testEvents, resource)
+	assert.NoError(t, err)
+
+	assert.Equal(t, "fake-queue", *client.queueURLInput.QueueName)
+	assert.Nil(t, client.queueURLInput.QueueOwnerAWSAccountId)
+	assert.Equal(t, "https://sqs.us-east-1.amazonaws.com/123456789012/fake-queue", *client.sent.QueueUrl)
+	assert.Equal(t, `{"order":"o-1"}`, *client.sent.MessageBody)
+	assert.Equal(t, "c-1", *client.sent.MessageGroupId)
+	key := triggers.IdempotencyKey(testEvents, "fake-trigger")
+	assert.Equal(t, key, *client.sent.MessageDeduplicationId)
+	assert.Equal(t, "eu", *client.sent.MessageAttributes["region"].StringValue)
+	assert.Equal(t, "argo-events", *client.sent.MessageAttributes["source"].StringValue)
+	assert.Equal(t, key, *client.sent.MessageAttributes[triggers.IdempotencyKeyHeader].StringValue)
+	assert.Nil(t, client.sent.DelaySeconds)
+}
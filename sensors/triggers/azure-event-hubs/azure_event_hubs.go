@@ -19,8 +19,14 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strconv"
+	"strings"
 
+	"github.com/Azure/azure-amqp-common-go/v4/auth"
 	eventhub "github.com/Azure/azure-event-hubs-go/v3"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
 	"go.uber.org/zap"
 
 	"github.com/argoproj/argo-events/common"
@@ -42,6 +48,22 @@ type AzureEventHubsTrigger struct {
 	Logger *zap.SugaredLogger
 }
 
+// aadTokenProvider provides the Azure AD tokens of an azidentity credential to the Event Hubs client.
+type aadTokenProvider struct {
+	credential azcore.TokenCredential
+}
+
+// GetToken implements auth.TokenProvider
+func (p *aadTokenProvider) GetToken(uri string) (*auth.Token, error) {
+	token, err := p.credential.GetToken(context.Background(), policy.TokenRequestOptions{
+		Scopes: []string{"https://eventhubs.azure.net/.default"},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get an Azure AD token, %w", err)
+	}
+	return auth.NewToken(auth.CBSTokenTypeJWT, token.Token, strconv.FormatInt(token.ExpiresOn.Unix(), 10)), nil
+}
+
 // NewAzureEventHubsTrigger returns a new azure event hubs context.
 func NewAzureEventHubsTrigger(sensor *v1alpha1.Sensor, trigger *v1alpha1.Trigger, azureEventHubsClient common.StringKeyedMap[*eventhub.Hub], logger *zap.SugaredLogger) (*AzureEventHubsTrigger, error) {
 	azureEventHubsTrigger := trigger.Template.AzureEventHubs
@@ -49,27 +71,41 @@ func NewAzureEventHubsTrigger(sensor *v1alpha1.Sensor, trigger *v1alpha1.Trigger
 	hub, ok := azureEventHubsClient.Load(trigger.Template.Name)
 
 	if !ok {
-		// form event hubs connection string in the ff format:
-		// Endpoint=sb://namespace.servicebus.windows.net/;SharedAccessKeyName=RootManageSharedAccessKey;SharedAccessKey=superSecret1234=;EntityPath=hubName
 		fqdn := azureEventHubsTrigger.FQDN
 		hubName := azureEventHubsTrigger.HubName
 
-		sharedAccessKeyName, err := common.GetSecretFromVolume(azureEventHubsTrigger.SharedAccessKeyName)
-		if err != nil {
-			return nil, err
-		}
-		sharedAccessKey, err := common.GetSecretFromVolume(azureEventHubsTrigger.SharedAccessKey)
-		if err != nil {
-			return nil, err
-		}
-
-		logger.Debug("generating connection string")
-		connStr := fmt.Sprintf("Endpoint=sb://%s/;SharedAccessKeyName=%s;SharedAccessKey=%s;EntityPath=%s", fqdn, sharedAccessKeyName, sharedAccessKey, hubName)
-		logger.Debug("connection string: ", connStr)
-
-		hub, err = eventhub.NewHubFromConnectionString(connStr)
-		if err != nil {
-			return nil, err
+		if azureEventHubsTrigger.SharedAccessKeyName == nil && azureEventHubsTrigger.SharedAccessKey == nil {
+			logger.Info("connecting to the event hub with AAD credentials...")
+			cred, err := azidentity.NewDefaultAzureCredential(nil)
+			if err != nil {
+				return nil, err
+			}
+			// the namespace name is the first label of <namespace>.servicebus.windows.net
+			namespace, _, _ := strings.Cut(fqdn, ".")
+			hub, err = eventhub.NewHub(namespace, hubName, &aadTokenProvider{credential: cred})
+			if err != nil {
+				return nil, err
+			}
+		} else {
+			// form event hubs connection string in the ff format:
+			// Endpoint=sb://namespace.servicebus.windows.net/;SharedAccessKeyName=RootManageSharedAccessKey;SharedAccessKey=superSecret1234=;EntityPath=hubName
+			sharedAccessKeyName, err := common.GetSecretFromVolume(azureEventHubsTrigger.SharedAccessKeyName)
+			if err != nil {
+				return nil, err
+			}
+			sharedAccessKey, err := common.GetSecretFromVolume(azureEventHubsTrigger.SharedAccessKey)
+			if err != nil {
+				return nil, err
+			}
+
+			logger.Debug("generating connection string")
+			connStr := fmt.Sprintf("Endpoint=sb://%s/;SharedAccessKeyName=%s;SharedAccessKey=%s;EntityPath=%s", fqdn, sharedAccessKeyName, sharedAccessKey, hubName)
+			logger.Debug("connection string: ", connStr)
+
+			hub, err = eventhub.NewHubFromConnectionString(connStr)
+			if err != nil {
+				return nil, err
+			}
 		}
 
 		azureEventHubsClient.Store(trigger.Template.Name, hub)
@@ -0,0 +1,172 @@
+/*
+Copyright 2020 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package azurequeuestorage
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azqueue"
+	"go.uber.org/zap"
+
+	"github.com/argoproj/argo-events/common"
+	"github.com/argoproj/argo-events/common/logging"
+	apicommon "github.com/argoproj/argo-events/pkg/apis/common"
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+	"github.com/argoproj/argo-events/sensors/triggers"
+)
+
+// Queue is the subset of the operations of an Azure Queue Storage queue client used by the trigger
+type Queue interface {
+	EnqueueMessage(ctx context.Context, content string, o *azqueue.EnqueueMessageOptions) (azqueue.EnqueueMessagesResponse, error)
+}
+
+// AzureQueueStorageTrigger describes the trigger to enqueue messages on an Azure Queue Storage queue
+type AzureQueueStorageTrigger struct {
+	// Sensor object
+	Sensor *v1alpha1.Sensor
+	// Trigger reference
+	Trigger *v1alpha1.Trigger
+	// Queue refers to the client of the queue
+	Queue Queue
+	// Logger to log stuff
+	Logger *zap.SugaredLogger
+}
+
+// NewAzureQueueStorageTrigger returns a new Azure Queue Storage trigger
+func NewAzureQueueStorageTrigger(sensor *v1alpha1.Sensor, trigger *v1alpha1.Trigger, azureQueueStorageClients common.StringKeyedMap[*azqueue.QueueClient], logger *zap.SugaredLogger) (*AzureQueueStorageTrigger, error) {
+	triggerLogger := logger.With(logging.LabelTriggerType, apicommon.AzureQueueStorageTrigger)
+	queueStorageTrigger := trigger.Template.AzureQueueStorage
+
+	queueClient, ok := azureQueueStorageClients.Load(trigger.Template.Name)
+	if !ok {
+		var client *azqueue.ServiceClient
+		if queueStorageTrigger.ConnectionString != nil {
+			connStr, err := common.GetSecretFromVolume(queueStorageTrigger.ConnectionString)
+			if err != nil {
+				triggerLogger.With("connection-string", queueStorageTrigger.ConnectionString.Name).Errorw("failed to retrieve connection string from secret", zap.Error(err))
+				return nil, err
+			}
+			triggerLogger.Info("connecting to azure queue storage with connection string...")
+			client, err = azqueue.NewServiceClientFromConnectionString(connStr, nil)
+			if err != nil {
+				triggerLogger.Errorw("failed to create a service client", zap.Error(err))
+				return nil, err
+			}
+		} else {
+			triggerLogger.Info("connecting to azure queue storage with AAD credentials...")
+			cred, err := azidentity.NewDefaultAzureCredential(nil)
+			if err != nil {
+				triggerLogger.Errorw("failed to create DefaultAzureCredential", zap.Error(err))
+				return nil, err
+			}
+			serviceURL := fmt.Sprintf("https://%s.queue.core.windows.net/", queueStorageTrigger.StorageAccountName)
+			client, err = azqueue.NewServiceClient(serviceURL, cred, nil)
+			if err != nil {
+				triggerLogger.Errorw("failed to create a service client", zap.Error(err))
+				return nil, err
+			}
+		}
+		queueClient = client.NewQueueClient(queueStorageTrigger.QueueName)
+		azureQueueStorageClients.Store(trigger.Template.Name, queueClient)
+	}
+
+	return &AzureQueueStorageTrigger{
+		Sensor:  sensor,
+		Trigger: trigger,
+		Queue:   queueClient,
+		Logger:  triggerLogger,
+	}, nil
+}
+
+// GetTriggerType returns the type of the trigger
+func (t *AzureQueueStorageTrigger) GetTriggerType() apicommon.TriggerType {
+	return apicommon.AzureQueueStorageTrigger
+}
+
+// FetchResource fetches the trigger resource
+func (t *AzureQueueStorageTrigger) FetchResource(ctx context.Context) (interface{}, error) {
+	return t.Trigger.Template.AzureQueueStorage, nil
+}
+
+// ApplyResourceParameters applies parameters to the trigger resource
+func (t *AzureQueueStorageTrigger) ApplyResourceParameters(events map[string]*v1alpha1.Event, resource interface{}) (interface{}, error) {
+	fetchedResource, ok := resource.(*v1alpha1.AzureQueueStorageTrigger)
+	if !ok {
+		return nil, fmt.Errorf("failed to interpret the fetched trigger resource")
+	}
+
+	resourceBytes, err := json.Marshal(fetchedResource)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal the azure queue storage trigger resource, %w", err)
+	}
+
+	parameters := fetchedResource.Parameters
+	if parameters != nil {
+		updatedResourceBytes, err := triggers.ApplyParams(resourceBytes, parameters, events)
+		if err != nil {
+			return nil, err
+		}
+		var queueTrigger *v1alpha1.AzureQueueStorageTrigger
+		if err := json.Unmarshal(updatedResourceBytes, &queueTrigger); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal the updated azure queue storage trigger resource after applying resource parameters, %w", err)
+		}
+		return queueTrigger, nil
+	}
+
+	return resource, nil
+}
+
+// Execute executes the trigger
+func (t *AzureQueueStorageTrigger) Execute(ctx context.Context, events map[string]*v1alpha1.Event, resource interface{}) (interface{}, error) {
+	trigger, ok := resource.(*v1alpha1.AzureQueueStorageTrigger)
+	if !ok {
+		return nil, fmt.Errorf("failed to interpret the trigger resource")
+	}
+
+	if trigger.Payload == nil {
+		return nil, fmt.Errorf("payload parameters are not specified")
+	}
+
+	payload, err := triggers.ConstructPayload(events, trigger.Payload)
+	if err != nil {
+		return nil, err
+	}
+
+	content := string(payload)
+	if trigger.EncodeMessage {
+		content = base64.StdEncoding.EncodeToString(payload)
+	}
+
+	response, err := t.Queue.EnqueueMessage(ctx, content, &azqueue.EnqueueMessageOptions{
+		TimeToLive:        trigger.TimeToLiveInSeconds,
+		VisibilityTimeout: trigger.VisibilityTimeoutInSeconds,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to enqueue a message on the azure queue storage queue, %w", err)
+	}
+	t.Logger.Info("successfully enqueued message on the azure queue storage queue")
+
+	return response, nil
+}
+
+// ApplyPolicy applies the trigger policy
+func (t *AzureQueueStorageTrigger) ApplyPolicy(ctx context.Context, resource interface{}) error {
+	return nil
+}
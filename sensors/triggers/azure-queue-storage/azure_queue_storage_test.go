@@ -0,0 +1,139 @@
+/*
+Copyright 2020 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package azurequeuestorage
+
+import (
+	"context"
+	"encoding/base64"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azqueue"
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-events/common/logging"
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+)
+
+var sensorObj = &v1alpha1.Sensor{
+	ObjectMeta: metav1.ObjectMeta{
+		Name:      "fake-sensor",
+		Namespace: "fake",
+	},
+	Spec: v1alpha1.SensorSpec{
+		Triggers: []v1alpha1.Trigger{
+			{
+				Template: &v1alpha1.TriggerTemplate{
+					Name: "fake-trigger",
+					AzureQueueStorage: &v1alpha1.AzureQueueStorageTrigger{
+						StorageAccountName: "fake-account",
+						QueueName:          "fake-queue",
+						Payload: []v1alpha1.TriggerParameter{
+							{
+								Src: &v1alpha1.TriggerParameterSource{
+									DependencyName: "fake-dependency",
+									DataKey:        "message",
+								},
+								Dest: "message",
+							},
+						},
+					},
+				},
+			},
+		},
+	},
+}
+
+var testEvents = map[string]*v1alpha1.Event{
+	"fake-dependency": {
+		Context: &v1alpha1.EventContext{
+			ID:              "1",
+			Type:            "webhook",
+			Source:          "webhook-gateway",
+			DataContentType: "application/json",
+			SpecVersion:     cloudevents.VersionV1,
+			Subject:         "example-1",
+		},
+		Data: []byte(`{"message": "hello", "queue": "real-queue"}`),
+	},
+}
+
+type fakeQueue struct {
+	content string
+	options *azqueue.EnqueueMessageOptions
+}
+
+func (q *fakeQueue) EnqueueMessage(ctx context.Context, content string, o *azqueue.EnqueueMessageOptions) (azqueue.EnqueueMessagesResponse, error) {
+	q.content = content
+	q.options = o
+	return azqueue.EnqueueMessagesResponse{}, nil
+}
+
+func getFakeAzureQueueStorageTrigger() (*AzureQueueStorageTrigger, *fakeQueue) {
+	queue := &fakeQueue{}
+	sensor := sensorObj.DeepCopy()
+	return &AzureQueueStorageTrigger{
+		Sensor:  sensor,
+		Trigger: &sensor.Spec.Triggers[0],
+		Queue:   queue,
+		Logger:  logging.NewArgoEventsLogger(),
+	}, queue
+}
+
+func TestAzureQueueStorageTrigger_ApplyResourceParameters(t *testing.T) {
+	trigger, _ := getFakeAzureQueueStorageTrigger()
+	trigger.Trigger.Template.AzureQueueStorage.Parameters = []v1alpha1.TriggerParameter{
+		{
+			Src: &v1alpha1.TriggerParameterSource{
+				DependencyName: "fake-dependency",
+				DataKey:        "queue",
+			},
+			Dest: "queueName",
+		},
+	}
+
+	resource, err := trigger.FetchResource(context.TODO())
+	assert.NoError(t, err)
+	response, err := trigger.ApplyResourceParameters(testEvents, resource)
+	assert.NoError(t, err)
+	updatedTrigger, ok := response.(*v1alpha1.AzureQueueStorageTrigger)
+	assert.True(t, ok)
+	assert.Equal(t, "real-queue", updatedTrigger.QueueName)
+}
+
+func TestAzureQueueStorageTrigger_Execute(t *testing.T) {
+	t.Run("plain message", func(t *testing.T) {
+		trigger, queue := getFakeAzureQueueStorageTrigger()
+		trigger.Trigger.Template.AzureQueueStorage.TimeToLiveInSeconds = to.Ptr(int32(-1))
+		_, err := trigger.Execute(context.TODO(), testEvents, trigger.Trigger.Template.AzureQueueStorage)
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"message": "hello"}`, queue.content)
+		assert.Equal(t, int32(-1), *queue.options.TimeToLive)
+		assert.Nil(t, queue.options.VisibilityTimeout)
+	})
+
+	t.Run("encoded message", func(t *testing.T) {
+		trigger, queue := getFakeAzureQueueStorageTrigger()
+		trigger.Trigger.Template.AzureQueueStorage.EncodeMessage = true
+		_, err := trigger.Execute(context.TODO(), testEvents, trigger.Trigger.Template.AzureQueueStorage)
+		assert.NoError(t, err)
+		decoded, err := base64.StdEncoding.DecodeString(queue.content)
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"message": "hello"}`, string(decoded))
+	})
+}
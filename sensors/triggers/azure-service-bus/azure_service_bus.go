@@ -20,6 +20,7 @@ import (
 	"encoding/json"
 	"fmt"
 
+	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
 	servicebus "github.com/Azure/azure-sdk-for-go/sdk/messaging/azservicebus"
 	"go.uber.org/zap"
 
@@ -49,13 +50,6 @@ func NewAzureServiceBusTrigger(sensor *v1alpha1.Sensor, trigger *v1alpha1.Trigge
 	sender, ok := azureServiceBusClients.Load(trigger.Template.Name)
 
 	if !ok {
-		connStr, err := common.GetSecretFromVolume(azureServiceBusTrigger.ConnectionString)
-		if err != nil {
-			triggerLogger.With("connection-string", azureServiceBusTrigger.ConnectionString.Name).Errorw("failed to retrieve connection string from secret", zap.Error(err))
-			return nil, err
-		}
-
-		triggerLogger.Info("connecting to the service bus...")
 		clientOptions := servicebus.ClientOptions{}
 		if azureServiceBusTrigger.TLS != nil {
 			tlsConfig, err := common.GetTLSConfig(azureServiceBusTrigger.TLS)
@@ -66,10 +60,31 @@ func NewAzureServiceBusTrigger(sensor *v1alpha1.Sensor, trigger *v1alpha1.Trigge
 			clientOptions.TLSConfig = tlsConfig
 		}
 
-		client, err := servicebus.NewClientFromConnectionString(connStr, &clientOptions)
-		if err != nil {
-			triggerLogger.Errorw("failed to create a service bus client", zap.Error(err))
-			return nil, err
+		var client *servicebus.Client
+		if azureServiceBusTrigger.ConnectionString != nil {
+			connStr, err := common.GetSecretFromVolume(azureServiceBusTrigger.ConnectionString)
+			if err != nil {
+				triggerLogger.With("connection-string", azureServiceBusTrigger.ConnectionString.Name).Errorw("failed to retrieve connection string from secret", zap.Error(err))
+				return nil, err
+			}
+			triggerLogger.Info("connecting to the service bus using connection string...")
+			client, err = servicebus.NewClientFromConnectionString(connStr, &clientOptions)
+			if err != nil {
+				triggerLogger.Errorw("failed to create a service bus client", zap.Error(err))
+				return nil, err
+			}
+		} else {
+			triggerLogger.Info("connecting to the service bus with AAD credentials...")
+			cred, err := azidentity.NewDefaultAzureCredential(nil)
+			if err != nil {
+				triggerLogger.Errorw("failed to create DefaultAzureCredential", zap.Error(err))
+				return nil, err
+			}
+			client, err = servicebus.NewClient(azureServiceBusTrigger.FullyQualifiedNamespace, cred, &clientOptions)
+			if err != nil {
+				triggerLogger.Errorw("failed to create a service bus client", zap.Error(err))
+				return nil, err
+			}
 		}
 
 		// Set queueOrTopicName to be azureServiceBusTrigger.QueueName or azureServiceBusTrigger.TopicName
@@ -85,6 +100,7 @@ func NewAzureServiceBusTrigger(sensor *v1alpha1.Sensor, trigger *v1alpha1.Trigge
 
 		logger.With("queueOrTopicName", queueOrTopicName).Info("creating a new sender...")
 
+		var err error
 		sender, err = client.NewSender(queueOrTopicName, &servicebus.NewSenderOptions{})
 		if err != nil {
 			triggerLogger.Errorw("failed to create a service bus sender", zap.Error(err))
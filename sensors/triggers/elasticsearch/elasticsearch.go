@@ -0,0 +1,187 @@
+/*
+Copyright 2020 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package elasticsearch
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	neturl "net/url"
+	"strings"
+	"time"
+
+	"github.com/tidwall/sjson"
+	"go.uber.org/zap"
+
+	"github.com/argoproj/argo-events/common"
+	"github.com/argoproj/argo-events/common/logging"
+	apicommon "github.com/argoproj/argo-events/pkg/apis/common"
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+	"github.com/argoproj/argo-events/sensors/triggers"
+)
+
+// defaultTimeout is the timeout of the requests when the trigger does not set one
+const defaultTimeout = 10 * time.Second
+
+// ElasticsearchTrigger describes the trigger to index records in Elasticsearch or OpenSearch
+type ElasticsearchTrigger struct {
+	// Client is http client.
+	Client *http.Client
+	// Sensor object
+	Sensor *v1alpha1.Sensor
+	// Trigger reference
+	Trigger *v1alpha1.Trigger
+	// Logger to log stuff
+	Logger *zap.SugaredLogger
+}
+
+// NewElasticsearchTrigger returns a new Elasticsearch trigger
+func NewElasticsearchTrigger(httpClients common.StringKeyedMap[*http.Client], sensor *v1alpha1.Sensor, trigger *v1alpha1.Trigger, logger *zap.SugaredLogger) (*ElasticsearchTrigger, error) {
+	esTrigger := trigger.Template.Elasticsearch
+
+	timeout := defaultTimeout
+	if esTrigger.Timeout > 0 {
+		timeout = time.Duration(esTrigger.Timeout) * time.Second
+	}
+	// The clients are shared with the HTTP triggers with the same TLS configuration and timeout
+	clientKey := common.MustHash(struct {
+		TLS     *apicommon.TLSConfig
+		Timeout int64
+	}{esTrigger.TLS, int64(timeout / time.Second)})
+	client, ok := httpClients.Load(clientKey)
+	if !ok {
+		client = &http.Client{Timeout: timeout}
+		if esTrigger.TLS != nil {
+			tlsConfig, err := common.GetTLSConfig(esTrigger.TLS)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get the tls configuration, %w", err)
+			}
+			client.Transport = &http.Transport{
+				TLSClientConfig: tlsConfig,
+			}
+		}
+		client, _ = httpClients.LoadOrStore(clientKey, client)
+	}
+
+	return &ElasticsearchTrigger{
+		Client:  client,
+		Sensor:  sensor,
+		Trigger: trigger,
+		Logger:  logger.With(logging.LabelTriggerType, apicommon.ElasticsearchTrigger),
+	}, nil
+}
+
+// GetTriggerType returns the type of the trigger
+func (t *ElasticsearchTrigger) GetTriggerType() apicommon.TriggerType {
+	return apicommon.ElasticsearchTrigger
+}
+
+// FetchResource fetches the trigger resource
+func (t *ElasticsearchTrigger) FetchResource(ctx context.Context) (interface{}, error) {
+	return t.Trigger.Template.Elasticsearch, nil
+}
+
+// ApplyResourceParameters applies parameters to the trigger resource
+func (t *ElasticsearchTrigger) ApplyResourceParameters(events map[string]*v1alpha1.Event, resource interface{}) (interface{}, error) {
+	fetchedResource, ok := resource.(*v1alpha1.ElasticsearchTrigger)
+	if !ok {
+		return nil, fmt.Errorf("failed to interpret the fetched trigger resource")
+	}
+
+	resourceBytes, err := json.Marshal(fetchedResource)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal the elasticsearch trigger resource, %w", err)
+	}
+	parameters := fetchedResource.Parameters
+	if parameters != nil {
+		updatedResourceBytes, err := triggers.ApplyParams(resourceBytes, parameters, events)
+		if err != nil {
+			return nil, err
+		}
+		var et *v1alpha1.ElasticsearchTrigger
+		if err := json.Unmarshal(updatedResourceBytes, &et); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal the updated elasticsearch trigger resource after applying resource parameters, %w", err)
+		}
+		return et, nil
+	}
+	return resource, nil
+}
+
+// Execute executes the trigger
+func (t *ElasticsearchTrigger) Execute(ctx context.Context, events map[string]*v1alpha1.Event, resource interface{}) (interface{}, error) {
+	trigger, ok := resource.(*v1alpha1.ElasticsearchTrigger)
+	if !ok {
+		return nil, fmt.Errorf("failed to interpret the trigger resource")
+	}
+
+	record, err := triggers.ConstructPayload(events, trigger.Payload)
+	if err != nil {
+		return nil, err
+	}
+	if trigger.TimestampField != "" {
+		path := trigger.TimestampField
+		if strings.HasPrefix(path, "@") {
+			// A leading "@" is a modifier in the paths, e.g. "@timestamp" has to be escaped
+			path = `\` + path
+		}
+		record, err = sjson.SetBytes(record, path, time.Now().UTC().Format(time.RFC3339Nano))
+		if err != nil {
+			return nil, fmt.Errorf("failed to set the timestamp field %s, %w", trigger.TimestampField, err)
+		}
+	}
+
+	// The records are always created, so that they can be appended to the data streams as well
+	url := fmt.Sprintf("%s/%s/_doc?op_type=create", strings.TrimSuffix(trigger.URL, "/"), neturl.PathEscape(trigger.Index))
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(record))
+	if err != nil {
+		return nil, fmt.Errorf("failed to construct request for %s, %w", url, err)
+	}
+	request.Header.Set("Content-Type", "application/json")
+	if trigger.BasicAuth != nil {
+		username, password, err := common.GetBasicAuthFromVolume(trigger.BasicAuth)
+		if err != nil {
+			return nil, err
+		}
+		request.SetBasicAuth(username, password)
+	}
+	if trigger.APIKey != nil {
+		apiKey, err := common.GetSecretFromVolume(trigger.APIKey)
+		if err != nil {
+			return nil, fmt.Errorf("failed to retrieve the api key, %w", err)
+		}
+		request.Header.Set("Authorization", "ApiKey "+apiKey)
+	}
+
+	response, err := t.Client.Do(request)
+	if err != nil {
+		return nil, fmt.Errorf("failed to index the record in %s, %w", trigger.Index, err)
+	}
+	defer response.Body.Close()
+	if response.StatusCode/100 != 2 {
+		message, _ := io.ReadAll(io.LimitReader(response.Body, 1024))
+		return nil, fmt.Errorf("failed to index the record in %s, status %d: %s", trigger.Index, response.StatusCode, bytes.TrimSpace(message))
+	}
+	t.Logger.Infow("indexed the record", zap.String("index", trigger.Index))
+	return nil, nil
+}
+
+// ApplyPolicy applies the policy on the trigger, the records have no policy
+func (t *ElasticsearchTrigger) ApplyPolicy(ctx context.Context, resource interface{}) error {
+	return nil
+}
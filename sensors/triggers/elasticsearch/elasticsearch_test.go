@@ -0,0 +1,99 @@
+/*
+Copyright 2020 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package elasticsearch
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-events/common"
+	"github.com/argoproj/argo-events/common/logging"
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+)
+
+var sensorObj = &v1alpha1.Sensor{
+	ObjectMeta: metav1.ObjectMeta{
+		Name:      "fake-sensor",
+		Namespace: "fake",
+	},
+	Spec: v1alpha1.SensorSpec{
+		Triggers: []v1alpha1.Trigger{
+			{
+				Template: &v1alpha1.TriggerTemplate{
+					Name: "fake-trigger",
+					Elasticsearch: &v1alpha1.ElasticsearchTrigger{
+						URL:            "https://elasticsearch:9200",
+						Index:          "audit",
+						TimestampField: "@timestamp",
+						Payload: []v1alpha1.TriggerParameter{
+							{
+								Src:  &v1alpha1.TriggerParameterSource{DependencyName: "dep", DataKey: "user"},
+								Dest: "user.name",
+							},
+						},
+					},
+				},
+			},
+		},
+	},
+}
+
+func TestElasticsearchTrigger(t *testing.T) {
+	var path, opType string
+	var record map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path, opType = r.URL.Path, r.URL.Query().Get("op_type")
+		body, _ := io.ReadAll(r.Body)
+		assert.NoError(t, json.Unmarshal(body, &record))
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer server.Close()
+
+	trigger, err := NewElasticsearchTrigger(common.NewStringKeyedMap[*http.Client](), sensorObj.DeepCopy(), sensorObj.Spec.Triggers[0].DeepCopy(), logging.NewArgoEventsLogger())
+	require.NoError(t, err)
+	resource := trigger.Trigger.Template.Elasticsearch.DeepCopy()
+	resource.URL = server.URL
+	events := map[string]*v1alpha1.Event{
+		"dep": {
+			Context: &v1alpha1.EventContext{
+				ID:              "1",
+				Type:            "webhook",
+				Source:          "webhook-gateway",
+				DataContentType: "application/json",
+				SpecVersion:     "1.0",
+				Subject:         "example-1",
+			},
+			Data: []byte(`{"user": "admin"}`),
+		},
+	}
+	_, err = trigger.Execute(context.TODO(), events, resource)
+	require.NoError(t, err)
+
+	assert.Equal(t, "/audit/_doc", path)
+	assert.Equal(t, "create", opType)
+	assert.Equal(t, map[string]interface{}{"name": "admin"}, record["user"])
+	_, err = time.Parse(time.RFC3339Nano, record["@timestamp"].(string))
+	assert.NoError(t, err)
+}
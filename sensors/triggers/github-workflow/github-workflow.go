@@ -0,0 +1,198 @@
+/*
+Copyright 2020 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package github_workflow
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/bradleyfalzon/ghinstallation/v2"
+	gh "github.com/google/go-github/v50/github"
+	"go.uber.org/zap"
+
+	"github.com/argoproj/argo-events/common"
+	"github.com/argoproj/argo-events/common/logging"
+	apicommon "github.com/argoproj/argo-events/pkg/apis/common"
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+	"github.com/argoproj/argo-events/sensors/triggers"
+)
+
+// GithubWorkflowTrigger describes the trigger to dispatch GitHub Actions workflows
+type GithubWorkflowTrigger struct {
+	// Client is the GitHub client.
+	Client *gh.Client
+	// Sensor object
+	Sensor *v1alpha1.Sensor
+	// Trigger reference
+	Trigger *v1alpha1.Trigger
+	// Logger to log stuff
+	Logger *zap.SugaredLogger
+}
+
+// NewGithubWorkflowTrigger returns a new GitHub workflow trigger
+func NewGithubWorkflowTrigger(sensor *v1alpha1.Sensor, trigger *v1alpha1.Trigger, logger *zap.SugaredLogger) (*GithubWorkflowTrigger, error) {
+	workflowTrigger := trigger.Template.GithubWorkflow
+
+	var transport http.RoundTripper
+	switch {
+	case workflowTrigger.APIToken != nil:
+		token, err := common.GetSecretFromVolume(workflowTrigger.APIToken)
+		if err != nil {
+			return nil, fmt.Errorf("failed to retrieve the api token, %w", err)
+		}
+		transport = &tokenTransport{token: token}
+	case workflowTrigger.GithubApp != nil:
+		privateKey, err := common.GetSecretFromVolume(workflowTrigger.GithubApp.PrivateKey)
+		if err != nil {
+			return nil, fmt.Errorf("failed to retrieve the github app private key, %w", err)
+		}
+		appTransport, err := ghinstallation.New(http.DefaultTransport, workflowTrigger.GithubApp.AppID, workflowTrigger.GithubApp.InstallationID, []byte(privateKey))
+		if err != nil {
+			return nil, fmt.Errorf("failed to create the github app transport, %w", err)
+		}
+		if workflowTrigger.GithubBaseURL != "" {
+			appTransport.BaseURL = strings.TrimSuffix(enterpriseAPIURL(workflowTrigger.GithubBaseURL), "/")
+		}
+		transport = appTransport
+	default:
+		return nil, fmt.Errorf("none of the supported auth options were provided")
+	}
+
+	client, err := newClient(workflowTrigger.GithubBaseURL, &http.Client{Transport: transport})
+	if err != nil {
+		return nil, err
+	}
+
+	return &GithubWorkflowTrigger{
+		Client:  client,
+		Sensor:  sensor,
+		Trigger: trigger,
+		Logger:  logger.With(logging.LabelTriggerType, apicommon.GithubWorkflowTrigger),
+	}, nil
+}
+
+// newClient returns a GitHub client, for GitHub Enterprise when the base URL is set
+func newClient(baseURL string, httpClient *http.Client) (*gh.Client, error) {
+	if baseURL == "" {
+		return gh.NewClient(httpClient), nil
+	}
+	client, err := gh.NewEnterpriseClient(baseURL, baseURL, httpClient)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create the github enterprise client, %w", err)
+	}
+	return client, nil
+}
+
+// enterpriseAPIURL returns the URL of the REST API of GitHub Enterprise, the same way the enterprise
+// client does.
+func enterpriseAPIURL(baseURL string) string {
+	if !strings.HasSuffix(baseURL, "/") {
+		baseURL += "/"
+	}
+	if !strings.HasSuffix(baseURL, "/api/v3/") {
+		baseURL += "api/v3/"
+	}
+	return baseURL
+}
+
+// tokenTransport authenticates the requests with a personal access token
+type tokenTransport struct {
+	token string
+}
+
+func (t *tokenTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	// The request must not be modified, the headers are copied
+	req2 := req.Clone(req.Context())
+	req2.Header.Set("Authorization", "Bearer "+t.token)
+	return http.DefaultTransport.RoundTrip(req2)
+}
+
+// GetTriggerType returns the type of the trigger
+func (t *GithubWorkflowTrigger) GetTriggerType() apicommon.TriggerType {
+	return apicommon.GithubWorkflowTrigger
+}
+
+// FetchResource fetches the trigger resource
+func (t *GithubWorkflowTrigger) FetchResource(ctx context.Context) (interface{}, error) {
+	return t.Trigger.Template.GithubWorkflow, nil
+}
+
+// ApplyResourceParameters applies parameters to the trigger resource
+func (t *GithubWorkflowTrigger) ApplyResourceParameters(events map[string]*v1alpha1.Event, resource interface{}) (interface{}, error) {
+	fetchedResource, ok := resource.(*v1alpha1.GithubWorkflowTrigger)
+	if !ok {
+		return nil, fmt.Errorf("failed to interpret the fetched trigger resource")
+	}
+
+	resourceBytes, err := json.Marshal(fetchedResource)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal the github workflow trigger resource, %w", err)
+	}
+	parameters := fetchedResource.Parameters
+	if parameters != nil {
+		updatedResourceBytes, err := triggers.ApplyParams(resourceBytes, parameters, events)
+		if err != nil {
+			return nil, err
+		}
+		var wt *v1alpha1.GithubWorkflowTrigger
+		if err := json.Unmarshal(updatedResourceBytes, &wt); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal the updated github workflow trigger resource after applying resource parameters, %w", err)
+		}
+		return wt, nil
+	}
+	return resource, nil
+}
+
+// Execute executes the trigger
+func (t *GithubWorkflowTrigger) Execute(ctx context.Context, events map[string]*v1alpha1.Event, resource interface{}) (interface{}, error) {
+	trigger, ok := resource.(*v1alpha1.GithubWorkflowTrigger)
+	if !ok {
+		return nil, fmt.Errorf("failed to interpret the trigger resource")
+	}
+
+	event := gh.CreateWorkflowDispatchEventRequest{
+		Ref: trigger.Ref,
+	}
+	if len(trigger.Inputs) > 0 {
+		event.Inputs = make(map[string]interface{}, len(trigger.Inputs))
+		for name, value := range trigger.Inputs {
+			event.Inputs[name] = value
+		}
+	}
+
+	var err error
+	// The workflows are referred to by ID or by file name
+	if workflowID, parseErr := strconv.ParseInt(trigger.Workflow, 10, 64); parseErr == nil {
+		_, err = t.Client.Actions.CreateWorkflowDispatchEventByID(ctx, trigger.Owner, trigger.Repository, workflowID, event)
+	} else {
+		_, err = t.Client.Actions.CreateWorkflowDispatchEventByFileName(ctx, trigger.Owner, trigger.Repository, trigger.Workflow, event)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to dispatch the workflow %s of %s/%s, %w", trigger.Workflow, trigger.Owner, trigger.Repository, err)
+	}
+	t.Logger.Infow("dispatched the workflow", zap.String("repository", trigger.Owner+"/"+trigger.Repository),
+		zap.String("workflow", trigger.Workflow), zap.String("ref", trigger.Ref))
+	return nil, nil
+}
+
+// ApplyPolicy applies the policy on the trigger, the dispatches have no policy
+func (t *GithubWorkflowTrigger) ApplyPolicy(ctx context.Context, resource interface{}) error {
+	return nil
+}
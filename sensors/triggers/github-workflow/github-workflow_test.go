@@ -0,0 +1,150 @@
+/*
+Copyright 2020 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package github_workflow
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-events/common/logging"
+	apicommon "github.com/argoproj/argo-events/pkg/apis/common"
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+)
+
+var sensorObj = &v1alpha1.Sensor{
+	ObjectMeta: metav1.ObjectMeta{
+		Name:      "fake-sensor",
+		Namespace: "fake",
+	},
+	Spec: v1alpha1.SensorSpec{
+		Triggers: []v1alpha1.Trigger{
+			{
+				Template: &v1alpha1.TriggerTemplate{
+					Name: "fake-trigger",
+					GithubWorkflow: &v1alpha1.GithubWorkflowTrigger{
+						Owner:      "argoproj",
+						Repository: "argo-events",
+						Workflow:   "release.yaml",
+						Ref:        "main",
+						Inputs:     map[string]string{"version": ""},
+						Parameters: []v1alpha1.TriggerParameter{
+							{
+								Src:  &v1alpha1.TriggerParameterSource{DependencyName: "dep", DataKey: "ref"},
+								Dest: "ref",
+							},
+							{
+								Src:  &v1alpha1.TriggerParameterSource{DependencyName: "dep", DataKey: "version"},
+								Dest: "inputs.version",
+							},
+						},
+					},
+				},
+			},
+		},
+	},
+}
+
+func getFakeGithubWorkflowTrigger(t *testing.T, baseURL string) *GithubWorkflowTrigger {
+	t.Helper()
+	client, err := newClient(baseURL, &http.Client{Transport: &tokenTransport{token: "fake-token"}})
+	require.NoError(t, err)
+	return &GithubWorkflowTrigger{
+		Client:  client,
+		Sensor:  sensorObj.DeepCopy(),
+		Trigger: sensorObj.Spec.Triggers[0].DeepCopy(),
+		Logger:  logging.NewArgoEventsLogger(),
+	}
+}
+
+func TestNewGithubWorkflowTrigger(t *testing.T) {
+	_, err := NewGithubWorkflowTrigger(sensorObj.DeepCopy(), sensorObj.Spec.Triggers[0].DeepCopy(), logging.NewArgoEventsLogger())
+	assert.ErrorContains(t, err, "none of the supported auth options were provided")
+}
+
+func TestEnterpriseAPIURL(t *testing.T) {
+	assert.Equal(t, "https://github.example.com/api/v3/", enterpriseAPIURL("https://github.example.com"))
+	assert.Equal(t, "https://github.example.com/api/v3/", enterpriseAPIURL("https://github.example.com/api/v3"))
+}
+
+func TestGithubWorkflowTrigger_ApplyResourceParameters(t *testing.T) {
+	trigger := getFakeGithubWorkflowTrigger(t, "")
+	events := map[string]*v1alpha1.Event{
+		"dep": {
+			Context: &v1alpha1.EventContext{
+				ID:              "1",
+				Type:            "webhook",
+				Source:          "webhook-gateway",
+				DataContentType: "application/json",
+				SpecVersion:     "1.0",
+				Subject:         "example-1",
+			},
+			Data: []byte(`{"ref": "v1.9.0", "version": "1.9.0"}`),
+		},
+	}
+	resource, err := trigger.FetchResource(context.TODO())
+	require.NoError(t, err)
+	updated, err := trigger.ApplyResourceParameters(events, resource)
+	require.NoError(t, err)
+	workflowTrigger, ok := updated.(*v1alpha1.GithubWorkflowTrigger)
+	require.True(t, ok)
+	assert.Equal(t, "v1.9.0", workflowTrigger.Ref)
+	assert.Equal(t, "1.9.0", workflowTrigger.Inputs["version"])
+	assert.Equal(t, apicommon.GithubWorkflowTrigger, trigger.GetTriggerType())
+}
+
+func TestGithubWorkflowTrigger_Execute(t *testing.T) {
+	var authorization, path string
+	var body map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authorization, path = r.Header.Get("Authorization"), r.URL.Path
+		body = nil
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		if r.URL.Path == "/api/v3/repos/argoproj/argo-events/actions/workflows/missing.yaml/dispatches" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	trigger := getFakeGithubWorkflowTrigger(t, server.URL)
+	resource := trigger.Trigger.Template.GithubWorkflow.DeepCopy()
+	resource.Inputs["version"] = "1.9.0"
+	_, err := trigger.Execute(context.TODO(), nil, resource)
+	require.NoError(t, err)
+	assert.Equal(t, "Bearer fake-token", authorization)
+	assert.Equal(t, "/api/v3/repos/argoproj/argo-events/actions/workflows/release.yaml/dispatches", path)
+	assert.Equal(t, map[string]interface{}{"ref": "main", "inputs": map[string]interface{}{"version": "1.9.0"}}, body)
+
+	// The workflows can be referred to by ID
+	resource.Workflow = "1234"
+	resource.Inputs = nil
+	_, err = trigger.Execute(context.TODO(), nil, resource)
+	require.NoError(t, err)
+	assert.Equal(t, "/api/v3/repos/argoproj/argo-events/actions/workflows/1234/dispatches", path)
+	assert.Equal(t, map[string]interface{}{"ref": "main"}, body)
+
+	resource.Workflow = "missing.yaml"
+	_, err = trigger.Execute(context.TODO(), nil, resource)
+	assert.ErrorContains(t, err, "404")
+}
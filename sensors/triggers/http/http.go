@@ -23,6 +23,10 @@ import (
 	"net/http"
 	"time"
 
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/cloudevents/sdk-go/v2/binding"
+	cehttp "github.com/cloudevents/sdk-go/v2/protocol/http"
+	"github.com/google/uuid"
 	"go.uber.org/zap"
 
 	"github.com/argoproj/argo-events/common"
@@ -49,7 +53,12 @@ type HTTPTrigger struct {
 func NewHTTPTrigger(httpClients common.StringKeyedMap[*http.Client], sensor *v1alpha1.Sensor, trigger *v1alpha1.Trigger, logger *zap.SugaredLogger) (*HTTPTrigger, error) {
 	httptrigger := trigger.Template.HTTP
 
-	client, ok := httpClients.Load(trigger.Template.Name)
+	// The triggers with the same TLS configuration and timeout share their client, and thus its connections
+	clientKey := common.MustHash(struct {
+		TLS     *apicommon.TLSConfig
+		Timeout int64
+	}{httptrigger.TLS, httptrigger.Timeout})
+	client, ok := httpClients.Load(clientKey)
 	if !ok {
 		client = &http.Client{}
 
@@ -69,7 +78,7 @@ func NewHTTPTrigger(httpClients common.StringKeyedMap[*http.Client], sensor *v1a
 		}
 		client.Timeout = timeout
 
-		httpClients.Store(trigger.Template.Name, client)
+		client, _ = httpClients.LoadOrStore(clientKey, client)
 	}
 
 	return &HTTPTrigger{
@@ -130,7 +139,9 @@ func (t *HTTPTrigger) Execute(ctx context.Context, events map[string]*v1alpha1.E
 		return nil, fmt.Errorf("failed to interpret the trigger resource")
 	}
 
-	if (trigger.Method == http.MethodPost || trigger.Method == http.MethodPatch || trigger.Method == http.MethodPut) && trigger.Payload == nil {
+	cloudEvent := trigger.ContentMode == v1alpha1.HTTPContentModeStructured || trigger.ContentMode == v1alpha1.HTTPContentModeBinary
+	// The CloudEvent of a single event carries its data if the payload is not specified
+	if (trigger.Method == http.MethodPost || trigger.Method == http.MethodPatch || trigger.Method == http.MethodPut) && trigger.Payload == nil && (!cloudEvent || len(events) != 1) {
 		t.Logger.Warnw("payload parameters are not specified. request payload will be an empty string", zap.Any("url", trigger.URL))
 	}
 
@@ -141,11 +152,13 @@ func (t *HTTPTrigger) Execute(ctx context.Context, events map[string]*v1alpha1.E
 		}
 	}
 
-	request, err := http.NewRequest(trigger.Method, trigger.URL, bytes.NewReader(payload))
+	request, err := t.newRequest(ctx, trigger, events, payload)
 	if err != nil {
-		return nil, fmt.Errorf("failed to construct request for %s, %w", trigger.URL, err)
+		return nil, err
 	}
 
+	request.Header.Set(triggers.IdempotencyKeyHeader, triggers.IdempotencyKey(events, t.Trigger.Template.Name))
+
 	if trigger.Headers != nil {
 		for name, value := range trigger.Headers {
 			request.Header[name] = []string{value}
@@ -196,6 +209,110 @@ func (t *HTTPTrigger) Execute(ctx context.Context, events map[string]*v1alpha1.E
 	return t.Client.Do(request)
 }
 
+// newRequest constructs the request, with the body written according to the content mode of the trigger
+func (t *HTTPTrigger) newRequest(ctx context.Context, trigger *v1alpha1.HTTPTrigger, events map[string]*v1alpha1.Event, payload []byte) (*http.Request, error) {
+	var contentType string
+	switch trigger.ContentMode {
+	case v1alpha1.HTTPContentModeStructured, v1alpha1.HTTPContentModeBinary:
+		event, err := t.newCloudEvent(events, payload, trigger.Payload != nil)
+		if err != nil {
+			return nil, err
+		}
+		request, err := http.NewRequest(trigger.Method, trigger.URL, nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to construct request for %s, %w", trigger.URL, err)
+		}
+		if trigger.ContentMode == v1alpha1.HTTPContentModeStructured {
+			ctx = binding.WithForceStructured(ctx)
+		} else {
+			ctx = binding.WithForceBinary(ctx)
+		}
+		if err := cehttp.WriteRequest(ctx, binding.ToMessage(event), request); err != nil {
+			return nil, fmt.Errorf("failed to write the cloudevent to the request, %w", err)
+		}
+		return request, nil
+	case v1alpha1.HTTPContentModeWrapped:
+		wrapper := make(map[string]interface{})
+		if trigger.Wrapper != nil {
+			for k, v := range trigger.Wrapper.Fields {
+				wrapper[k] = v
+			}
+			if trigger.Wrapper.ContextKey != "" {
+				contexts := make(map[string]*v1alpha1.EventContext, len(events))
+				for name, event := range events {
+					contexts[name] = event.Context
+				}
+				wrapper[trigger.Wrapper.ContextKey] = contexts
+			}
+		}
+		switch {
+		case len(payload) == 0:
+			wrapper[trigger.Wrapper.GetDataKey()] = nil
+		case json.Valid(payload):
+			wrapper[trigger.Wrapper.GetDataKey()] = json.RawMessage(payload)
+		default:
+			wrapper[trigger.Wrapper.GetDataKey()] = string(payload)
+		}
+		body, err := json.Marshal(wrapper)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal the wrapped payload, %w", err)
+		}
+		payload = body
+		contentType = cloudevents.ApplicationJSON
+	}
+	request, err := http.NewRequest(trigger.Method, trigger.URL, bytes.NewReader(payload))
+	if err != nil {
+		return nil, fmt.Errorf("failed to construct request for %s, %w", trigger.URL, err)
+	}
+	if contentType != "" {
+		request.Header.Set("Content-Type", contentType)
+	}
+	return request, nil
+}
+
+// newCloudEvent returns the CloudEvent delivering the events. The CloudEvent of a single event keeps its
+// attributes, and carries its data unless the payload is specified. Otherwise, a new CloudEvent is
+// created, with the sensor name as source and the trigger name as type.
+func (t *HTTPTrigger) newCloudEvent(events map[string]*v1alpha1.Event, payload []byte, hasPayload bool) (*cloudevents.Event, error) {
+	event := cloudevents.NewEvent()
+	var single *v1alpha1.Event
+	if len(events) == 1 {
+		for _, e := range events {
+			single = e
+		}
+	}
+	if single != nil && single.Context != nil {
+		event.SetID(single.Context.ID)
+		event.SetSource(single.Context.Source)
+		event.SetType(single.Context.Type)
+		event.SetSubject(single.Context.Subject)
+		event.SetTime(single.Context.Time.Time)
+	} else {
+		event.SetID(uuid.New().String())
+		event.SetSource(t.Sensor.Name)
+		event.SetType(t.Trigger.Template.Name)
+		event.SetTime(time.Now())
+	}
+	var err error
+	switch {
+	case hasPayload:
+		err = event.SetData(cloudevents.ApplicationJSON, payload)
+	case single != nil:
+		contentType := cloudevents.ApplicationJSON
+		if single.Context != nil && single.Context.DataContentType != "" {
+			contentType = single.Context.DataContentType
+		}
+		err = event.SetData(contentType, single.Data)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to set the cloudevent data, %w", err)
+	}
+	if err := event.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid cloudevent, %w", err)
+	}
+	return &event, nil
+}
+
 // ApplyPolicy applies policy on the trigger
 func (t *HTTPTrigger) ApplyPolicy(ctx context.Context, resource interface{}) error {
 	if t.Trigger.Policy == nil || t.Trigger.Policy.Status == nil || t.Trigger.Policy.Status.Allow == nil {
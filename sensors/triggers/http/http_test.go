@@ -17,12 +17,18 @@ package http
 
 import (
 	"context"
+	"encoding/json"
+	"io"
 	"net/http"
+	"net/http/httptest"
 	"testing"
+	"time"
 
+	"github.com/argoproj/argo-events/common"
 	"github.com/argoproj/argo-events/common/logging"
-	"github.com/argoproj/argo-events/pkg/apis/common"
+	apicommon "github.com/argoproj/argo-events/pkg/apis/common"
 	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+	"github.com/argoproj/argo-events/sensors/triggers"
 	cloudevents "github.com/cloudevents/sdk-go/v2"
 	"github.com/stretchr/testify/assert"
 	corev1 "k8s.io/api/core/v1"
@@ -59,6 +65,27 @@ func getFakeHTTPTrigger() *HTTPTrigger {
 	}
 }
 
+func TestNewHTTPTrigger(t *testing.T) {
+	clients := common.NewStringKeyedMap[*http.Client]()
+	trigger1 := sensorObj.Spec.Triggers[0].DeepCopy()
+	trigger2 := sensorObj.Spec.Triggers[0].DeepCopy()
+	trigger2.Template.Name = "fake-trigger-2"
+	trigger2.Template.HTTP.URL = "http://fake.com:12001"
+	trigger3 := sensorObj.Spec.Triggers[0].DeepCopy()
+	trigger3.Template.Name = "fake-trigger-3"
+	trigger3.Template.HTTP.Timeout = 20
+
+	t1, err := NewHTTPTrigger(clients, sensorObj, trigger1, logging.NewArgoEventsLogger())
+	assert.NoError(t, err)
+	t2, err := NewHTTPTrigger(clients, sensorObj, trigger2, logging.NewArgoEventsLogger())
+	assert.NoError(t, err)
+	t3, err := NewHTTPTrigger(clients, sensorObj, trigger3, logging.NewArgoEventsLogger())
+	assert.NoError(t, err)
+	// The triggers with the same client configuration share their client
+	assert.Same(t, t1.Client, t2.Client)
+	assert.NotSame(t, t1.Client, t3.Client)
+}
+
 func TestHTTPTrigger_FetchResource(t *testing.T) {
 	trigger := getFakeHTTPTrigger()
 	obj, err := trigger.FetchResource(context.TODO())
@@ -87,7 +114,7 @@ func TestHTTPTrigger_ApplyResourceParameters(t *testing.T) {
 	}
 
 	defaultValue := "http://default.com"
-	secureHeader := &common.SecureHeader{Name: "test", ValueFrom: &common.ValueFromSource{
+	secureHeader := &apicommon.SecureHeader{Name: "test", ValueFrom: &apicommon.ValueFromSource{
 		SecretKeyRef: &corev1.SecretKeySelector{
 			LocalObjectReference: corev1.LocalObjectReference{
 				Name: "tokens",
@@ -95,7 +122,7 @@ func TestHTTPTrigger_ApplyResourceParameters(t *testing.T) {
 			Key: "serviceToken"}},
 	}
 
-	secureHeaders := []*common.SecureHeader{}
+	secureHeaders := []*apicommon.SecureHeader{}
 	secureHeaders = append(secureHeaders, secureHeader)
 	trigger.Trigger.Template.HTTP.SecureHeaders = secureHeaders
 	trigger.Trigger.Template.HTTP.Parameters = []v1alpha1.TriggerParameter{
@@ -146,3 +173,81 @@ func TestHTTPTrigger_ApplyPolicy(t *testing.T) {
 	err = trigger.ApplyPolicy(context.TODO(), response)
 	assert.NotNil(t, err)
 }
+
+func TestHTTPTrigger_ContentMode(t *testing.T) {
+	var header http.Header
+	var body []byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		header = r.Header
+		body, _ = io.ReadAll(r.Body)
+	}))
+	defer server.Close()
+
+	events := map[string]*v1alpha1.Event{
+		"fake-dependency": {
+			Context: &v1alpha1.EventContext{
+				ID:              "1",
+				Source:          "webhook",
+				SpecVersion:     cloudevents.VersionV1,
+				Type:            "webhook",
+				DataContentType: cloudevents.ApplicationJSON,
+				Subject:         "example",
+				Time:            metav1.Time{Time: time.Now()},
+			},
+			Data: []byte(`{"name":"argo"}`),
+		},
+	}
+	execute := func(mode v1alpha1.HTTPContentMode, wrapper *v1alpha1.HTTPPayloadWrapper, payload []v1alpha1.TriggerParameter) {
+		trigger := getFakeHTTPTrigger()
+		trigger.Client = server.Client()
+		resource := trigger.Trigger.Template.HTTP
+		resource.URL = server.URL
+		resource.ContentMode = mode
+		resource.Wrapper = wrapper
+		resource.Payload = payload
+		_, err := trigger.Execute(context.TODO(), events, resource)
+		assert.NoError(t, err)
+	}
+	payload := []v1alpha1.TriggerParameter{
+		{Src: &v1alpha1.TriggerParameterSource{DependencyName: "fake-dependency", DataKey: "name"}, Dest: "user"},
+	}
+
+	t.Run("raw", func(t *testing.T) {
+		execute("", nil, payload)
+		assert.Equal(t, `{"user":"argo"}`, string(body))
+		assert.Empty(t, header.Get("Ce-Id"))
+		assert.Equal(t, triggers.IdempotencyKey(events, "fake-trigger"), header.Get(triggers.IdempotencyKeyHeader))
+	})
+
+	t.Run("binary", func(t *testing.T) {
+		execute(v1alpha1.HTTPContentModeBinary, nil, nil)
+		assert.Equal(t, `{"name":"argo"}`, string(body))
+		assert.Equal(t, "1", header.Get("Ce-Id"))
+		assert.Equal(t, "webhook", header.Get("Ce-Source"))
+		assert.Equal(t, "example", header.Get("Ce-Subject"))
+		assert.Equal(t, cloudevents.ApplicationJSON, header.Get("Content-Type"))
+	})
+
+	t.Run("structured", func(t *testing.T) {
+		execute(v1alpha1.HTTPContentModeStructured, nil, payload)
+		assert.Contains(t, header.Get("Content-Type"), cloudevents.ApplicationCloudEventsJSON)
+		event := cloudevents.NewEvent()
+		assert.NoError(t, json.Unmarshal(body, &event))
+		assert.Equal(t, "1", event.ID())
+		assert.Equal(t, `{"user":"argo"}`, string(event.Data()))
+	})
+
+	t.Run("wrapped", func(t *testing.T) {
+		execute(v1alpha1.HTTPContentModeWrapped, &v1alpha1.HTTPPayloadWrapper{DataKey: "event", ContextKey: "context", Fields: map[string]string{"kind": "argo"}}, payload)
+		assert.Equal(t, cloudevents.ApplicationJSON, header.Get("Content-Type"))
+		var wrapped struct {
+			Kind    string                            `json:"kind"`
+			Event   map[string]string                 `json:"event"`
+			Context map[string]*v1alpha1.EventContext `json:"context"`
+		}
+		assert.NoError(t, json.Unmarshal(body, &wrapped))
+		assert.Equal(t, "argo", wrapped.Kind)
+		assert.Equal(t, "argo", wrapped.Event["user"])
+		assert.Equal(t, "1", wrapped.Context["fake-dependency"].ID)
+	})
+}
@@ -0,0 +1,50 @@
+/*
+Copyright 2020 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package triggers
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"sort"
+
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+)
+
+// IdempotencyKeyHeader is the header or attribute name used to deliver the idempotency key to downstream systems.
+const IdempotencyKeyHeader = "Idempotency-Key"
+
+// IdempotencyKey returns a deterministic key for an execution of the named trigger with the given events.
+// Redeliveries of the same events to the same trigger produce the same key, so downstream systems can
+// use it to dedupe at-least-once deliveries.
+func IdempotencyKey(events map[string]*v1alpha1.Event, triggerName string) string {
+	ids := make([]string, 0, len(events))
+	for _, event := range events {
+		if event == nil || event.Context == nil {
+			continue
+		}
+		ids = append(ids, event.Context.ID)
+	}
+	sort.Strings(ids)
+
+	h := sha256.New()
+	for _, id := range ids {
+		h.Write([]byte(id))
+		h.Write([]byte{0})
+	}
+	h.Write([]byte(triggerName))
+	return hex.EncodeToString(h.Sum(nil))
+}
@@ -0,0 +1,44 @@
+/*
+Copyright 2020 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package triggers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+)
+
+func TestIdempotencyKey(t *testing.T) {
+	events := map[string]*v1alpha1.Event{
+		"dep-1": {Context: &v1alpha1.EventContext{ID: "a"}},
+		"dep-2": {Context: &v1alpha1.EventContext{ID: "b"}},
+	}
+	key := IdempotencyKey(events, "trigger")
+	assert.Len(t, key, 64)
+
+	swapped := map[string]*v1alpha1.Event{
+		"dep-1": {Context: &v1alpha1.EventContext{ID: "b"}},
+		"dep-2": {Context: &v1alpha1.EventContext{ID: "a"}},
+	}
+	assert.Equal(t, key, IdempotencyKey(swapped, "trigger"))
+	assert.NotEqual(t, key, IdempotencyKey(events, "other-trigger"))
+
+	events["dep-2"].Context.ID = "c"
+	assert.NotEqual(t, key, IdempotencyKey(events, "trigger"))
+}
@@ -0,0 +1,230 @@
+/*
+Copyright 2020 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package jenkins
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/cookiejar"
+	neturl "net/url"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/argoproj/argo-events/common"
+	"github.com/argoproj/argo-events/common/logging"
+	apicommon "github.com/argoproj/argo-events/pkg/apis/common"
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+	"github.com/argoproj/argo-events/sensors/triggers"
+)
+
+// defaultTimeout is the timeout of the requests when the trigger does not set one
+const defaultTimeout = 10 * time.Second
+
+// JenkinsTrigger describes the trigger to start Jenkins jobs
+type JenkinsTrigger struct {
+	// Client is http client.
+	Client *http.Client
+	// Sensor object
+	Sensor *v1alpha1.Sensor
+	// Trigger reference
+	Trigger *v1alpha1.Trigger
+	// Logger to log stuff
+	Logger *zap.SugaredLogger
+}
+
+// NewJenkinsTrigger returns a new Jenkins trigger
+func NewJenkinsTrigger(sensor *v1alpha1.Sensor, trigger *v1alpha1.Trigger, logger *zap.SugaredLogger) (*JenkinsTrigger, error) {
+	jenkinsTrigger := trigger.Template.Jenkins
+
+	// The client is not shared, the crumbs are bound to the session cookie
+	jar, err := cookiejar.New(nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create the cookie jar, %w", err)
+	}
+	client := &http.Client{Jar: jar, Timeout: defaultTimeout}
+	if jenkinsTrigger.Timeout > 0 {
+		client.Timeout = time.Duration(jenkinsTrigger.Timeout) * time.Second
+	}
+	if jenkinsTrigger.TLS != nil {
+		tlsConfig, err := common.GetTLSConfig(jenkinsTrigger.TLS)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get the tls configuration, %w", err)
+		}
+		client.Transport = &http.Transport{
+			TLSClientConfig: tlsConfig,
+		}
+	}
+
+	return &JenkinsTrigger{
+		Client:  client,
+		Sensor:  sensor,
+		Trigger: trigger,
+		Logger:  logger.With(logging.LabelTriggerType, apicommon.JenkinsTrigger),
+	}, nil
+}
+
+// GetTriggerType returns the type of the trigger
+func (t *JenkinsTrigger) GetTriggerType() apicommon.TriggerType {
+	return apicommon.JenkinsTrigger
+}
+
+// FetchResource fetches the trigger resource
+func (t *JenkinsTrigger) FetchResource(ctx context.Context) (interface{}, error) {
+	return t.Trigger.Template.Jenkins, nil
+}
+
+// ApplyResourceParameters applies parameters to the trigger resource
+func (t *JenkinsTrigger) ApplyResourceParameters(events map[string]*v1alpha1.Event, resource interface{}) (interface{}, error) {
+	fetchedResource, ok := resource.(*v1alpha1.JenkinsTrigger)
+	if !ok {
+		return nil, fmt.Errorf("failed to interpret the fetched trigger resource")
+	}
+
+	resourceBytes, err := json.Marshal(fetchedResource)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal the jenkins trigger resource, %w", err)
+	}
+	parameters := fetchedResource.Parameters
+	if parameters != nil {
+		updatedResourceBytes, err := triggers.ApplyParams(resourceBytes, parameters, events)
+		if err != nil {
+			return nil, err
+		}
+		var jt *v1alpha1.JenkinsTrigger
+		if err := json.Unmarshal(updatedResourceBytes, &jt); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal the updated jenkins trigger resource after applying resource parameters, %w", err)
+		}
+		return jt, nil
+	}
+	return resource, nil
+}
+
+// Execute executes the trigger, and returns the URL of the queue item of the build
+func (t *JenkinsTrigger) Execute(ctx context.Context, events map[string]*v1alpha1.Event, resource interface{}) (interface{}, error) {
+	trigger, ok := resource.(*v1alpha1.JenkinsTrigger)
+	if !ok {
+		return nil, fmt.Errorf("failed to interpret the trigger resource")
+	}
+	baseURL := strings.TrimSuffix(trigger.URL, "/")
+
+	var username, password string
+	if trigger.BasicAuth != nil {
+		var err error
+		if username, password, err = common.GetBasicAuthFromVolume(trigger.BasicAuth); err != nil {
+			return nil, err
+		}
+	}
+	form := neturl.Values{}
+	for name, value := range trigger.BuildParameters {
+		form.Set(name, value)
+	}
+	query := neturl.Values{}
+	if trigger.Token != nil {
+		token, err := common.GetSecretFromVolume(trigger.Token)
+		if err != nil {
+			return nil, fmt.Errorf("failed to retrieve the job token, %w", err)
+		}
+		query.Set("token", token)
+	}
+
+	action := "build"
+	if len(trigger.BuildParameters) > 0 {
+		action = "buildWithParameters"
+	}
+	url := fmt.Sprintf("%s%s/%s", baseURL, jobPath(trigger.Job), action)
+	if len(query) > 0 {
+		url += "?" + query.Encode()
+	}
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, url, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to construct the build request of %s, %w", trigger.Job, err)
+	}
+	request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	if trigger.BasicAuth != nil {
+		request.SetBasicAuth(username, password)
+		crumbField, crumb, err := t.crumb(ctx, baseURL, username, password)
+		if err != nil {
+			return nil, err
+		}
+		if crumb != "" {
+			request.Header.Set(crumbField, crumb)
+		}
+	}
+
+	response, err := t.Client.Do(request)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start the job %s, %w", trigger.Job, err)
+	}
+	defer response.Body.Close()
+	if response.StatusCode/100 != 2 {
+		message, _ := io.ReadAll(io.LimitReader(response.Body, 1024))
+		return nil, fmt.Errorf("failed to start the job %s, status %d: %s", trigger.Job, response.StatusCode, bytes.TrimSpace(message))
+	}
+	queueItem := response.Header.Get("Location")
+	t.Logger.Infow("started the job", zap.String("job", trigger.Job), zap.String("queueItem", queueItem))
+	return queueItem, nil
+}
+
+// crumb requests a crumb from the crumb issuer of Jenkins, and returns the header and the value of the crumb,
+// or an empty crumb if the crumb issuer is disabled.
+func (t *JenkinsTrigger) crumb(ctx context.Context, baseURL, username, password string) (string, string, error) {
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, baseURL+"/crumbIssuer/api/json", nil)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to construct the crumb request, %w", err)
+	}
+	request.SetBasicAuth(username, password)
+	response, err := t.Client.Do(request)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to request a crumb, %w", err)
+	}
+	defer response.Body.Close()
+	if response.StatusCode == http.StatusNotFound {
+		// The crumb issuer is disabled
+		return "", "", nil
+	}
+	if response.StatusCode/100 != 2 {
+		return "", "", fmt.Errorf("failed to request a crumb, status %d", response.StatusCode)
+	}
+	var crumb struct {
+		Crumb             string `json:"crumb"`
+		CrumbRequestField string `json:"crumbRequestField"`
+	}
+	if err := json.NewDecoder(response.Body).Decode(&crumb); err != nil {
+		return "", "", fmt.Errorf("failed to decode the crumb, %w", err)
+	}
+	return crumb.CrumbRequestField, crumb.Crumb, nil
+}
+
+// jobPath returns the path of a job from its full name, e.g. "/job/folder/job/name" for "folder/name"
+func jobPath(fullName string) string {
+	var path strings.Builder
+	for _, name := range strings.Split(strings.Trim(fullName, "/"), "/") {
+		path.WriteString("/job/")
+		path.WriteString(neturl.PathEscape(name))
+	}
+	return path.String()
+}
+
+// ApplyPolicy applies the policy on the trigger, the builds have no policy
+func (t *JenkinsTrigger) ApplyPolicy(ctx context.Context, resource interface{}) error {
+	return nil
+}
@@ -0,0 +1,155 @@
+/*
+Copyright 2020 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package jenkins
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	neturl "net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-events/common/logging"
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+)
+
+var sensorObj = &v1alpha1.Sensor{
+	ObjectMeta: metav1.ObjectMeta{
+		Name:      "fake-sensor",
+		Namespace: "fake",
+	},
+	Spec: v1alpha1.SensorSpec{
+		Triggers: []v1alpha1.Trigger{
+			{
+				Template: &v1alpha1.TriggerTemplate{
+					Name: "fake-trigger",
+					Jenkins: &v1alpha1.JenkinsTrigger{
+						URL:             "http://jenkins:8080",
+						Job:             "release/argo-events",
+						BuildParameters: map[string]string{"REVISION": "main"},
+						Parameters: []v1alpha1.TriggerParameter{
+							{
+								Src:  &v1alpha1.TriggerParameterSource{DependencyName: "dep", DataKey: "revision"},
+								Dest: "buildParameters.REVISION",
+							},
+						},
+					},
+				},
+			},
+		},
+	},
+}
+
+func TestJobPath(t *testing.T) {
+	assert.Equal(t, "/job/argo-events", jobPath("argo-events"))
+	assert.Equal(t, "/job/release/job/argo%20events", jobPath("/release/argo events"))
+}
+
+func TestJenkinsTrigger_ApplyResourceParameters(t *testing.T) {
+	trigger, err := NewJenkinsTrigger(sensorObj.DeepCopy(), sensorObj.Spec.Triggers[0].DeepCopy(), logging.NewArgoEventsLogger())
+	require.NoError(t, err)
+	events := map[string]*v1alpha1.Event{
+		"dep": {
+			Context: &v1alpha1.EventContext{
+				ID:              "1",
+				Type:            "webhook",
+				Source:          "webhook-gateway",
+				DataContentType: "application/json",
+				SpecVersion:     "1.0",
+				Subject:         "example-1",
+			},
+			Data: []byte(`{"revision": "v1.9.0"}`),
+		},
+	}
+	resource, err := trigger.FetchResource(context.TODO())
+	require.NoError(t, err)
+	updated, err := trigger.ApplyResourceParameters(events, resource)
+	require.NoError(t, err)
+	assert.Equal(t, "v1.9.0", updated.(*v1alpha1.JenkinsTrigger).BuildParameters["REVISION"])
+}
+
+func TestJenkinsTrigger_Execute(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/job/release/job/argo-events/buildWithParameters":
+			assert.NoError(t, r.ParseForm())
+			assert.Equal(t, "v1.9.0", r.PostForm.Get("REVISION"))
+			w.Header().Set("Location", "http://jenkins:8080/queue/item/42/")
+			w.WriteHeader(http.StatusCreated)
+		case "/job/release/job/argo-events/build":
+			w.WriteHeader(http.StatusCreated)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	trigger, err := NewJenkinsTrigger(sensorObj.DeepCopy(), sensorObj.Spec.Triggers[0].DeepCopy(), logging.NewArgoEventsLogger())
+	require.NoError(t, err)
+	resource := trigger.Trigger.Template.Jenkins.DeepCopy()
+	resource.URL = server.URL
+	resource.BuildParameters["REVISION"] = "v1.9.0"
+	result, err := trigger.Execute(context.TODO(), nil, resource)
+	require.NoError(t, err)
+	assert.Equal(t, "http://jenkins:8080/queue/item/42/", result)
+
+	// The jobs without parameters are started with the build action
+	resource.BuildParameters = nil
+	_, err = trigger.Execute(context.TODO(), nil, resource)
+	require.NoError(t, err)
+
+	resource.Job = "missing"
+	_, err = trigger.Execute(context.TODO(), nil, resource)
+	assert.ErrorContains(t, err, "status 404")
+}
+
+func TestJenkinsTrigger_Crumb(t *testing.T) {
+	crumbIssuer := true
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		username, password, ok := r.BasicAuth()
+		assert.True(t, ok)
+		assert.Equal(t, "admin", username)
+		assert.Equal(t, "api-token", password)
+		if !crumbIssuer {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		http.SetCookie(w, &http.Cookie{Name: "JSESSIONID", Value: "session", Path: "/"})
+		_ = json.NewEncoder(w).Encode(map[string]string{"crumb": "abc", "crumbRequestField": "Jenkins-Crumb"})
+	}))
+	defer server.Close()
+
+	trigger, err := NewJenkinsTrigger(sensorObj.DeepCopy(), sensorObj.Spec.Triggers[0].DeepCopy(), logging.NewArgoEventsLogger())
+	require.NoError(t, err)
+	field, crumb, err := trigger.crumb(context.TODO(), server.URL, "admin", "api-token")
+	require.NoError(t, err)
+	assert.Equal(t, "Jenkins-Crumb", field)
+	assert.Equal(t, "abc", crumb)
+	// The crumb is bound to the session cookie, kept by the client
+	serverURL, err := neturl.Parse(server.URL)
+	require.NoError(t, err)
+	require.Len(t, trigger.Client.Jar.Cookies(serverURL), 1)
+
+	crumbIssuer = false
+	_, crumb, err = trigger.crumb(context.TODO(), server.URL, "admin", "api-token")
+	require.NoError(t, err)
+	assert.Empty(t, crumb)
+}
@@ -25,6 +25,7 @@ import (
 
 	"github.com/hamba/avro"
 	"github.com/riferrei/srclient"
+	"google.golang.org/protobuf/reflect/protoreflect"
 
 	"github.com/IBM/sarama"
 	"go.uber.org/zap"
@@ -46,8 +47,24 @@ type KafkaTrigger struct {
 	Producer sarama.AsyncProducer
 	// Logger to log stuff
 	Logger *zap.SugaredLogger
-	// Avro schema of message
+	// Schema of message
 	schema *srclient.Schema
+	// Protobuf message of the schema
+	protoMessage protoreflect.MessageDescriptor
+}
+
+// getProducerKey returns the key of the producer of a trigger. The triggers with the same connection and
+// producer configuration share their producer.
+func getProducerKey(kafkatrigger *v1alpha1.KafkaTrigger) string {
+	return common.MustHash(struct {
+		URL            string
+		Version        string
+		SASL           *apicommon.SASLConfig
+		TLS            *apicommon.TLSConfig
+		Compress       bool
+		FlushFrequency int32
+		RequiredAcks   int32
+	}{kafkatrigger.URL, kafkatrigger.Version, kafkatrigger.SASL, kafkatrigger.TLS, kafkatrigger.Compress, kafkatrigger.FlushFrequency, kafkatrigger.RequiredAcks})
 }
 
 // NewKafkaTrigger returns a new kafka trigger context.
@@ -55,8 +72,10 @@ func NewKafkaTrigger(sensor *v1alpha1.Sensor, trigger *v1alpha1.Trigger, kafkaPr
 	kafkatrigger := trigger.Template.Kafka
 	triggerLogger := logger.With(logging.LabelTriggerType, apicommon.KafkaTrigger)
 
-	producer, ok := kafkaProducers.Load(trigger.Template.Name)
+	producerKey := getProducerKey(kafkatrigger)
+	producer, ok := kafkaProducers.Load(producerKey)
 	var schema *srclient.Schema
+	var protoMessage protoreflect.MessageDescriptor
 
 	if !ok {
 		var err error
@@ -133,23 +152,40 @@ func NewKafkaTrigger(sensor *v1alpha1.Sensor, trigger *v1alpha1.Trigger, kafkaPr
 			}
 		}()
 
-		kafkaProducers.Store(trigger.Template.Name, producer)
+		if existing, loaded := kafkaProducers.LoadOrStore(producerKey, producer); loaded {
+			// Another trigger created the shared producer meanwhile
+			_ = producer.Close()
+			producer = existing
+		}
 	}
 
 	if kafkatrigger.SchemaRegistry != nil {
 		var err error
-		schema, err = getSchemaFromRegistry(kafkatrigger.SchemaRegistry)
+		schemaRegistryClient := getSchemaRegistryClient(kafkatrigger.SchemaRegistry)
+		schema, err = getSchemaFromRegistry(schemaRegistryClient, kafkatrigger.SchemaRegistry)
 		if err != nil {
 			return nil, err
 		}
+		switch getSchemaType(schema) {
+		case srclient.Avro:
+			// The avro schema is parsed when serializing the payload
+		case srclient.Protobuf:
+			protoMessage, err = getProtobufMessage(schemaRegistryClient, schema, kafkatrigger.SchemaRegistry.MessageType)
+			if err != nil {
+				return nil, err
+			}
+		default:
+			return nil, fmt.Errorf("unsupported schema type %s of the schema with id '%d'", getSchemaType(schema), schema.ID())
+		}
 	}
 
 	return &KafkaTrigger{
-		Sensor:   sensor,
-		Trigger:  trigger,
-		Producer: producer,
-		Logger:   triggerLogger,
-		schema:   schema,
+		Sensor:       sensor,
+		Trigger:      trigger,
+		Producer:     producer,
+		Logger:       triggerLogger,
+		schema:       schema,
+		protoMessage: protoMessage,
 	}, nil
 }
 
@@ -206,9 +242,13 @@ func (t *KafkaTrigger) Execute(ctx context.Context, events map[string]*v1alpha1.
 		return nil, err
 	}
 
-	// Producer with avro schema
+	// Producer with avro or protobuf schema
 	if t.schema != nil {
-		payload, err = avroParser(t.schema.Schema(), t.schema.ID(), payload)
+		if t.protoMessage != nil {
+			payload, err = protobufParser(t.protoMessage, t.schema.ID(), payload)
+		} else {
+			payload, err = avroParser(t.schema.Schema(), t.schema.ID(), payload)
+		}
 		if err != nil {
 			return nil, err
 		}
@@ -224,6 +264,14 @@ func (t *KafkaTrigger) Execute(ctx context.Context, events map[string]*v1alpha1.
 		msg.Key = sarama.StringEncoder(*trigger.PartitioningKey)
 	}
 
+	// record headers are only supported from Kafka 0.11 onwards
+	if supportsHeaders(trigger.Version) {
+		msg.Headers = []sarama.RecordHeader{{
+			Key:   []byte(triggers.IdempotencyKeyHeader),
+			Value: []byte(triggers.IdempotencyKey(events, t.Trigger.Template.Name)),
+		}}
+	}
+
 	t.Producer.Input() <- msg
 
 	t.Logger.Infow("successfully produced a message", zap.Any("topic", trigger.Topic))
@@ -236,6 +284,18 @@ func (t *KafkaTrigger) ApplyPolicy(ctx context.Context, resource interface{}) er
 	return nil
 }
 
+// supportsHeaders returns whether the configured Kafka version supports record headers.
+func supportsHeaders(version string) bool {
+	if version == "" {
+		return true
+	}
+	v, err := sarama.ParseKafkaVersion(version)
+	if err != nil {
+		return false
+	}
+	return v.IsAtLeast(sarama.V0_11_0_0)
+}
+
 func avroParser(schema string, schemaID int, payload []byte) ([]byte, error) {
 	var recordValue []byte
 	var payloadNative map[string]interface{}
@@ -251,7 +311,7 @@ func avroParser(schema string, schemaID int, payload []byte) ([]byte, error) {
 	}
 	avroNative, err := avro.Marshal(schemaAvro, payloadNative)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("payload does not conform to the avro schema with id '%d', %w", schemaID, err)
 	}
 
 	schemaIDBytes := make([]byte, 4)
@@ -263,17 +323,47 @@ func avroParser(schema string, schemaID int, payload []byte) ([]byte, error) {
 	return recordValue, nil
 }
 
-// getSchemaFromRegistry returns a schema from registry.
-func getSchemaFromRegistry(sr *apicommon.SchemaRegistryConfig) (*srclient.Schema, error) {
+// getSchemaRegistryClient returns a schema registry client.
+func getSchemaRegistryClient(sr *apicommon.SchemaRegistryConfig) srclient.ISchemaRegistryClient {
 	schemaRegistryClient := srclient.CreateSchemaRegistryClient(sr.URL)
 	if sr.Auth.Username != nil && sr.Auth.Password != nil {
 		user, _ := common.GetSecretFromVolume(sr.Auth.Username)
 		password, _ := common.GetSecretFromVolume(sr.Auth.Password)
 		schemaRegistryClient.SetCredentials(user, password)
 	}
-	schema, err := schemaRegistryClient.GetSchema(int(sr.SchemaID))
-	if err != nil {
-		return nil, fmt.Errorf("error getting the schema with id '%d' %s", sr.SchemaID, err)
+	return schemaRegistryClient
+}
+
+// getSchemaFromRegistry returns a schema from registry, by ID or by subject.
+func getSchemaFromRegistry(schemaRegistryClient srclient.ISchemaRegistryClient, sr *apicommon.SchemaRegistryConfig) (*srclient.Schema, error) {
+	switch {
+	case sr.SchemaID != 0:
+		schema, err := schemaRegistryClient.GetSchema(int(sr.SchemaID))
+		if err != nil {
+			return nil, fmt.Errorf("error getting the schema with id '%d' %s", sr.SchemaID, err)
+		}
+		return schema, nil
+	case sr.Subject != "" && sr.Version > 0:
+		schema, err := schemaRegistryClient.GetSchemaByVersion(sr.Subject, int(sr.Version))
+		if err != nil {
+			return nil, fmt.Errorf("error getting the schema of subject '%s' version '%d' %s", sr.Subject, sr.Version, err)
+		}
+		return schema, nil
+	case sr.Subject != "":
+		schema, err := schemaRegistryClient.GetLatestSchema(sr.Subject)
+		if err != nil {
+			return nil, fmt.Errorf("error getting the latest schema of subject '%s' %s", sr.Subject, err)
+		}
+		return schema, nil
+	default:
+		return nil, fmt.Errorf("either schema id or subject must be specified")
+	}
+}
+
+// getSchemaType returns the type of the schema, the registry omits it for Avro schemas.
+func getSchemaType(schema *srclient.Schema) srclient.SchemaType {
+	if schema.SchemaType() == nil {
+		return srclient.Avro
 	}
-	return schema, nil
+	return *schema.SchemaType()
 }
@@ -22,6 +22,7 @@ import (
 	"github.com/IBM/sarama"
 	"github.com/IBM/sarama/mocks"
 	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/riferrei/srclient"
 	"github.com/stretchr/testify/assert"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
@@ -68,7 +69,7 @@ func getFakeKafkaTrigger(producers common.StringKeyedMap[sarama.AsyncProducer])
 func TestNewKafkaTrigger(t *testing.T) {
 	producer := mocks.NewAsyncProducer(t, nil)
 	producers := common.NewStringKeyedMap[sarama.AsyncProducer]()
-	producers.Store("fake-trigger", producer)
+	producers.Store(getProducerKey(sensorObj.Spec.Triggers[0].Template.Kafka), producer)
 	trigger, err := NewKafkaTrigger(sensorObj.DeepCopy(), sensorObj.Spec.Triggers[0].DeepCopy(), producers, logging.NewArgoEventsLogger())
 
 	assert.Nil(t, err)
@@ -76,10 +77,26 @@ func TestNewKafkaTrigger(t *testing.T) {
 	assert.Equal(t, trigger.Trigger.Template.Kafka.SASL.Mechanism, "PLAIN")
 }
 
+func TestNewKafkaTrigger_SharedProducer(t *testing.T) {
+	producer := mocks.NewAsyncProducer(t, nil)
+	producers := common.NewStringKeyedMap[sarama.AsyncProducer]()
+	producers.Store(getProducerKey(sensorObj.Spec.Triggers[0].Template.Kafka), producer)
+	// Another trigger publishing to another topic with the same configuration
+	trigger := sensorObj.Spec.Triggers[0].DeepCopy()
+	trigger.Template.Name = "fake-trigger-2"
+	trigger.Template.Kafka.Topic = "fake-topic-2"
+	kafkaTrigger, err := NewKafkaTrigger(sensorObj.DeepCopy(), trigger, producers, logging.NewArgoEventsLogger())
+	assert.Nil(t, err)
+	assert.Equal(t, producer, kafkaTrigger.Producer)
+
+	trigger.Template.Kafka.Compress = !trigger.Template.Kafka.Compress
+	assert.NotEqual(t, getProducerKey(sensorObj.Spec.Triggers[0].Template.Kafka), getProducerKey(trigger.Template.Kafka))
+}
+
 func TestKafkaTrigger_FetchResource(t *testing.T) {
 	producer := mocks.NewAsyncProducer(t, nil)
 	producers := common.NewStringKeyedMap[sarama.AsyncProducer]()
-	producers.Store("fake-trigger", producer)
+	producers.Store(getProducerKey(sensorObj.Spec.Triggers[0].Template.Kafka), producer)
 	trigger, err := getFakeKafkaTrigger(producers)
 	assert.Nil(t, err)
 	obj, err := trigger.FetchResource(context.TODO())
@@ -93,7 +110,7 @@ func TestKafkaTrigger_FetchResource(t *testing.T) {
 func TestKafkaTrigger_ApplyResourceParameters(t *testing.T) {
 	producer := mocks.NewAsyncProducer(t, nil)
 	producers := common.NewStringKeyedMap[sarama.AsyncProducer]()
-	producers.Store("fake-trigger", producer)
+	producers.Store(getProducerKey(sensorObj.Spec.Triggers[0].Template.Kafka), producer)
 	trigger, err := getFakeKafkaTrigger(producers)
 	assert.Nil(t, err)
 
@@ -137,7 +154,7 @@ func TestKafkaTrigger_ApplyResourceParameters(t *testing.T) {
 func TestKafkaTrigger_Execute(t *testing.T) {
 	producer := mocks.NewAsyncProducer(t, nil)
 	producers := common.NewStringKeyedMap[sarama.AsyncProducer]()
-	producers.Store("fake-trigger", producer)
+	producers.Store(getProducerKey(sensorObj.Spec.Triggers[0].Template.Kafka), producer)
 	trigger, err := getFakeKafkaTrigger(producers)
 	assert.Nil(t, err)
 	testEvents := map[string]*v1alpha1.Event{
@@ -173,3 +190,83 @@ func TestKafkaTrigger_Execute(t *testing.T) {
 	assert.Nil(t, err)
 	assert.Nil(t, result)
 }
+
+func TestGetSchemaFromRegistry(t *testing.T) {
+	client := srclient.CreateMockSchemaRegistryClient("mock://testingUrl")
+	v1, err := client.CreateSchema("test-value", `{"type":"record","name":"test","fields":[{"name":"a","type":"string"}]}`, srclient.Avro)
+	assert.NoError(t, err)
+	v2, err := client.CreateSchema("test-value", `{"type":"record","name":"test","fields":[{"name":"b","type":"string"}]}`, srclient.Avro)
+	assert.NoError(t, err)
+
+	schema, err := getSchemaFromRegistry(client, &apicommon.SchemaRegistryConfig{SchemaID: int32(v1.ID())})
+	assert.NoError(t, err)
+	assert.Equal(t, v1.ID(), schema.ID())
+
+	schema, err = getSchemaFromRegistry(client, &apicommon.SchemaRegistryConfig{Subject: "test-value"})
+	assert.NoError(t, err)
+	assert.Equal(t, v2.ID(), schema.ID())
+
+	schema, err = getSchemaFromRegistry(client, &apicommon.SchemaRegistryConfig{Subject: "test-value", Version: 1})
+	assert.NoError(t, err)
+	assert.Equal(t, v1.ID(), schema.ID())
+
+	_, err = getSchemaFromRegistry(client, &apicommon.SchemaRegistryConfig{})
+	assert.Error(t, err)
+}
+
+func TestAvroParser(t *testing.T) {
+	schema := `{"type":"record","name":"test","fields":[{"name":"a","type":"string"}]}`
+	value, err := avroParser(schema, 3, []byte(`{"a":"b"}`))
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{0, 0, 0, 0, 3, 2, 'b'}, value)
+
+	_, err = avroParser(schema, 3, []byte(`{"c":"b"}`))
+	assert.Error(t, err)
+}
+
+func TestProtobufParser(t *testing.T) {
+	client := srclient.CreateMockSchemaRegistryClient("mock://testingUrl")
+	schema, err := client.CreateSchema("test-value", `
+syntax = "proto3";
+package test;
+
+message Outer {
+  message Inner {
+    string name = 1;
+  }
+  string id = 1;
+}
+
+message Other {
+  int32 count = 1;
+}
+`, srclient.Protobuf)
+	assert.NoError(t, err)
+
+	md, err := getProtobufMessage(client, schema, "")
+	assert.NoError(t, err)
+	assert.Equal(t, "test.Outer", string(md.FullName()))
+	value, err := protobufParser(md, schema.ID(), []byte(`{"id":"x"}`))
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{0, 0, 0, 0, byte(schema.ID()), 0, 0x0a, 1, 'x'}, value)
+
+	_, err = protobufParser(md, schema.ID(), []byte(`{"unknown":"x"}`))
+	assert.Error(t, err)
+
+	md, err = getProtobufMessage(client, schema, "test.Outer.Inner")
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{4, 0, 0}, messageIndexes(md))
+
+	md, err = getProtobufMessage(client, schema, "test.Other")
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{2, 2}, messageIndexes(md))
+
+	_, err = getProtobufMessage(client, schema, "test.Missing")
+	assert.Error(t, err)
+}
+
+func TestSupportsHeaders(t *testing.T) {
+	assert.True(t, supportsHeaders(""))
+	assert.True(t, supportsHeaders("2.5.0"))
+	assert.False(t, supportsHeaders("0.10.2.0"))
+}
@@ -0,0 +1,120 @@
+/*
+Copyright 2020 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package kafka
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+
+	"github.com/bufbuild/protocompile"
+	"github.com/riferrei/srclient"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+// schemaFileName is the name given to the registered schema when it is compiled.
+const schemaFileName = "schema.proto"
+
+// getProtobufMessage compiles the Protobuf schema, along with the schemas it references, and returns the
+// descriptor of the message to serialize payloads into.
+func getProtobufMessage(client srclient.ISchemaRegistryClient, schema *srclient.Schema, messageType string) (protoreflect.MessageDescriptor, error) {
+	sources := map[string]string{schemaFileName: schema.Schema()}
+	if err := addReferencedSchemas(client, schema.References(), sources); err != nil {
+		return nil, err
+	}
+	compiler := protocompile.Compiler{
+		Resolver: protocompile.WithStandardImports(&protocompile.SourceResolver{
+			Accessor: protocompile.SourceAccessorFromMap(sources),
+		}),
+	}
+	files, err := compiler.Compile(context.Background(), schemaFileName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compile the protobuf schema with id '%d', %w", schema.ID(), err)
+	}
+	file := files[0]
+	if messageType == "" {
+		if file.Messages().Len() == 0 {
+			return nil, fmt.Errorf("no message found in the protobuf schema with id '%d'", schema.ID())
+		}
+		return file.Messages().Get(0), nil
+	}
+	md, ok := file.FindDescriptorByName(protoreflect.FullName(messageType)).(protoreflect.MessageDescriptor)
+	if !ok {
+		return nil, fmt.Errorf("message %q not found in the protobuf schema with id '%d'", messageType, schema.ID())
+	}
+	return md, nil
+}
+
+func addReferencedSchemas(client srclient.ISchemaRegistryClient, references []srclient.Reference, sources map[string]string) error {
+	for _, ref := range references {
+		if _, ok := sources[ref.Name]; ok {
+			continue
+		}
+		schema, err := client.GetSchemaByVersion(ref.Subject, ref.Version)
+		if err != nil {
+			return fmt.Errorf("failed to get the referenced schema %q of subject %q, %w", ref.Name, ref.Subject, err)
+		}
+		sources[ref.Name] = schema.Schema()
+		if err := addReferencedSchemas(client, schema.References(), sources); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// protobufParser serializes the JSON payload into the message, using the Confluent wire format:
+// magic byte, schema ID, message indexes and the serialized message.
+func protobufParser(md protoreflect.MessageDescriptor, schemaID int, payload []byte) ([]byte, error) {
+	msg := dynamicpb.NewMessage(md)
+	if err := protojson.Unmarshal(payload, msg); err != nil {
+		return nil, fmt.Errorf("payload does not conform to the protobuf message %s, %w", md.FullName(), err)
+	}
+	data, err := proto.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	recordValue := []byte{0}
+	recordValue = binary.BigEndian.AppendUint32(recordValue, uint32(schemaID))
+	recordValue = append(recordValue, messageIndexes(md)...)
+	recordValue = append(recordValue, data...)
+	return recordValue, nil
+}
+
+// messageIndexes encodes the path of the message in the schema, the path of the first message is
+// encoded as a single 0.
+func messageIndexes(md protoreflect.MessageDescriptor) []byte {
+	var indexes []int
+	var d protoreflect.Descriptor = md
+	for {
+		if _, ok := d.(protoreflect.FileDescriptor); ok {
+			break
+		}
+		indexes = append([]int{d.Index()}, indexes...)
+		d = d.Parent()
+	}
+	if len(indexes) == 1 && indexes[0] == 0 {
+		return []byte{0}
+	}
+	b := binary.AppendVarint(nil, int64(len(indexes)))
+	for _, i := range indexes {
+		b = binary.AppendVarint(b, int64(i))
+	}
+	return b
+}
@@ -0,0 +1,199 @@
+/*
+Copyright 2020 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package loki
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/argoproj/argo-events/common"
+	"github.com/argoproj/argo-events/common/logging"
+	apicommon "github.com/argoproj/argo-events/pkg/apis/common"
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+	"github.com/argoproj/argo-events/sensors/triggers"
+)
+
+// defaultTimeout is the timeout of the requests when the trigger does not set one
+const defaultTimeout = 10 * time.Second
+
+// LokiTrigger describes the trigger to append records to Loki
+type LokiTrigger struct {
+	// Client is http client.
+	Client *http.Client
+	// Sensor object
+	Sensor *v1alpha1.Sensor
+	// Trigger reference
+	Trigger *v1alpha1.Trigger
+	// Logger to log stuff
+	Logger *zap.SugaredLogger
+}
+
+// NewLokiTrigger returns a new Loki trigger
+func NewLokiTrigger(httpClients common.StringKeyedMap[*http.Client], sensor *v1alpha1.Sensor, trigger *v1alpha1.Trigger, logger *zap.SugaredLogger) (*LokiTrigger, error) {
+	lokiTrigger := trigger.Template.Loki
+
+	timeout := defaultTimeout
+	if lokiTrigger.Timeout > 0 {
+		timeout = time.Duration(lokiTrigger.Timeout) * time.Second
+	}
+	// The clients are shared with the HTTP triggers with the same TLS configuration and timeout
+	clientKey := common.MustHash(struct {
+		TLS     *apicommon.TLSConfig
+		Timeout int64
+	}{lokiTrigger.TLS, int64(timeout / time.Second)})
+	client, ok := httpClients.Load(clientKey)
+	if !ok {
+		client = &http.Client{Timeout: timeout}
+		if lokiTrigger.TLS != nil {
+			tlsConfig, err := common.GetTLSConfig(lokiTrigger.TLS)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get the tls configuration, %w", err)
+			}
+			client.Transport = &http.Transport{
+				TLSClientConfig: tlsConfig,
+			}
+		}
+		client, _ = httpClients.LoadOrStore(clientKey, client)
+	}
+
+	return &LokiTrigger{
+		Client:  client,
+		Sensor:  sensor,
+		Trigger: trigger,
+		Logger:  logger.With(logging.LabelTriggerType, apicommon.LokiTrigger),
+	}, nil
+}
+
+// GetTriggerType returns the type of the trigger
+func (t *LokiTrigger) GetTriggerType() apicommon.TriggerType {
+	return apicommon.LokiTrigger
+}
+
+// FetchResource fetches the trigger resource
+func (t *LokiTrigger) FetchResource(ctx context.Context) (interface{}, error) {
+	return t.Trigger.Template.Loki, nil
+}
+
+// ApplyResourceParameters applies parameters to the trigger resource
+func (t *LokiTrigger) ApplyResourceParameters(events map[string]*v1alpha1.Event, resource interface{}) (interface{}, error) {
+	fetchedResource, ok := resource.(*v1alpha1.LokiTrigger)
+	if !ok {
+		return nil, fmt.Errorf("failed to interpret the fetched trigger resource")
+	}
+
+	resourceBytes, err := json.Marshal(fetchedResource)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal the loki trigger resource, %w", err)
+	}
+	parameters := fetchedResource.Parameters
+	if parameters != nil {
+		updatedResourceBytes, err := triggers.ApplyParams(resourceBytes, parameters, events)
+		if err != nil {
+			return nil, err
+		}
+		var lt *v1alpha1.LokiTrigger
+		if err := json.Unmarshal(updatedResourceBytes, &lt); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal the updated loki trigger resource after applying resource parameters, %w", err)
+		}
+		return lt, nil
+	}
+	return resource, nil
+}
+
+// pushRequest is the body of a request to the push API of Loki
+type pushRequest struct {
+	Streams []stream `json:"streams"`
+}
+
+type stream struct {
+	Stream map[string]string `json:"stream"`
+	// Values are the pairs of the timestamp in nanoseconds and the log line
+	Values [][2]string `json:"values"`
+}
+
+// Execute executes the trigger
+func (t *LokiTrigger) Execute(ctx context.Context, events map[string]*v1alpha1.Event, resource interface{}) (interface{}, error) {
+	trigger, ok := resource.(*v1alpha1.LokiTrigger)
+	if !ok {
+		return nil, fmt.Errorf("failed to interpret the trigger resource")
+	}
+
+	record, err := triggers.ConstructPayload(events, trigger.Payload)
+	if err != nil {
+		return nil, err
+	}
+	body, err := json.Marshal(pushRequest{
+		Streams: []stream{
+			{
+				Stream: trigger.Labels,
+				Values: [][2]string{{strconv.FormatInt(time.Now().UnixNano(), 10), string(record)}},
+			},
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal the push request, %w", err)
+	}
+
+	url := strings.TrimSuffix(trigger.URL, "/") + "/loki/api/v1/push"
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to construct request for %s, %w", url, err)
+	}
+	request.Header.Set("Content-Type", "application/json")
+	if trigger.TenantID != "" {
+		request.Header.Set("X-Scope-OrgID", trigger.TenantID)
+	}
+	if trigger.BasicAuth != nil {
+		username, password, err := common.GetBasicAuthFromVolume(trigger.BasicAuth)
+		if err != nil {
+			return nil, err
+		}
+		request.SetBasicAuth(username, password)
+	}
+	if trigger.BearerToken != nil {
+		token, err := common.GetSecretFromVolume(trigger.BearerToken)
+		if err != nil {
+			return nil, fmt.Errorf("failed to retrieve the bearer token, %w", err)
+		}
+		request.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	response, err := t.Client.Do(request)
+	if err != nil {
+		return nil, fmt.Errorf("failed to push the record to %s, %w", url, err)
+	}
+	defer response.Body.Close()
+	if response.StatusCode/100 != 2 {
+		message, _ := io.ReadAll(io.LimitReader(response.Body, 1024))
+		return nil, fmt.Errorf("failed to push the record to %s, status %d: %s", url, response.StatusCode, bytes.TrimSpace(message))
+	}
+	t.Logger.Infow("appended the record to loki", zap.Any("labels", trigger.Labels))
+	return nil, nil
+}
+
+// ApplyPolicy applies the policy on the trigger, the records have no policy
+func (t *LokiTrigger) ApplyPolicy(ctx context.Context, resource interface{}) error {
+	return nil
+}
@@ -0,0 +1,124 @@
+/*
+Copyright 2020 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package loki
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-events/common"
+	"github.com/argoproj/argo-events/common/logging"
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+)
+
+var sensorObj = &v1alpha1.Sensor{
+	ObjectMeta: metav1.ObjectMeta{
+		Name:      "fake-sensor",
+		Namespace: "fake",
+	},
+	Spec: v1alpha1.SensorSpec{
+		Triggers: []v1alpha1.Trigger{
+			{
+				Template: &v1alpha1.TriggerTemplate{
+					Name: "fake-trigger",
+					Loki: &v1alpha1.LokiTrigger{
+						URL:      "http://loki:3100",
+						Labels:   map[string]string{"app": "audit", "repo": ""},
+						TenantID: "tenant",
+						Payload: []v1alpha1.TriggerParameter{
+							{
+								Src:  &v1alpha1.TriggerParameterSource{DependencyName: "dep", DataKey: "user"},
+								Dest: "user",
+							},
+						},
+						Parameters: []v1alpha1.TriggerParameter{
+							{
+								Src:  &v1alpha1.TriggerParameterSource{DependencyName: "dep", DataKey: "repo"},
+								Dest: "labels.repo",
+							},
+						},
+					},
+				},
+			},
+		},
+	},
+}
+
+var events = map[string]*v1alpha1.Event{
+	"dep": {
+		Context: &v1alpha1.EventContext{
+			ID:              "1",
+			Type:            "webhook",
+			Source:          "webhook-gateway",
+			DataContentType: "application/json",
+			SpecVersion:     "1.0",
+			Subject:         "example-1",
+		},
+		Data: []byte(`{"user": "admin", "repo": "argo-events"}`),
+	},
+}
+
+func TestLokiTrigger(t *testing.T) {
+	var tenant string
+	var request pushRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		tenant = r.Header.Get("X-Scope-OrgID")
+		assert.Equal(t, "/loki/api/v1/push", r.URL.Path)
+		body, _ := io.ReadAll(r.Body)
+		assert.NoError(t, json.Unmarshal(body, &request))
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	trigger, err := NewLokiTrigger(common.NewStringKeyedMap[*http.Client](), sensorObj.DeepCopy(), sensorObj.Spec.Triggers[0].DeepCopy(), logging.NewArgoEventsLogger())
+	require.NoError(t, err)
+	trigger.Trigger.Template.Loki.URL = server.URL
+	resource, err := trigger.FetchResource(context.TODO())
+	require.NoError(t, err)
+	resource, err = trigger.ApplyResourceParameters(events, resource)
+	require.NoError(t, err)
+	_, err = trigger.Execute(context.TODO(), events, resource)
+	require.NoError(t, err)
+
+	assert.Equal(t, "tenant", tenant)
+	require.Len(t, request.Streams, 1)
+	assert.Equal(t, map[string]string{"app": "audit", "repo": "argo-events"}, request.Streams[0].Stream)
+	require.Len(t, request.Streams[0].Values, 1)
+	assert.NotEmpty(t, request.Streams[0].Values[0][0])
+	assert.JSONEq(t, `{"user": "admin"}`, request.Streams[0].Values[0][1])
+}
+
+func TestLokiTrigger_Error(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "entry too far behind", http.StatusBadRequest)
+	}))
+	defer server.Close()
+
+	trigger, err := NewLokiTrigger(common.NewStringKeyedMap[*http.Client](), sensorObj.DeepCopy(), sensorObj.Spec.Triggers[0].DeepCopy(), logging.NewArgoEventsLogger())
+	require.NoError(t, err)
+	resource := trigger.Trigger.Template.Loki.DeepCopy()
+	resource.URL = server.URL
+	_, err = trigger.Execute(context.TODO(), events, resource)
+	assert.ErrorContains(t, err, "status 400: entry too far behind")
+}
@@ -131,7 +131,13 @@ func (t *NATSTrigger) Execute(ctx context.Context, events map[string]*v1alpha1.E
 		return nil, err
 	}
 
-	if err := t.Conn.Publish(t.Trigger.Template.NATS.Subject, payload); err != nil {
+	msg := natslib.NewMsg(t.Trigger.Template.NATS.Subject)
+	msg.Data = payload
+	// headers require a NATS server 2.2 or newer
+	if t.Conn.HeadersSupported() {
+		msg.Header.Set(triggers.IdempotencyKeyHeader, triggers.IdempotencyKey(events, t.Trigger.Template.Name))
+	}
+	if err := t.Conn.PublishMsg(msg); err != nil {
 		return nil, err
 	}
 
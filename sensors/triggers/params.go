@@ -23,6 +23,7 @@ import (
 	"text/template"
 
 	sprig "github.com/Masterminds/sprig/v3"
+	jsonpatch "github.com/evanphx/json-patch/v5"
 	"github.com/ghodss/yaml"
 	"github.com/tidwall/gjson"
 	"github.com/tidwall/sjson"
@@ -46,6 +47,14 @@ func ConstructPayload(events map[string]*v1alpha1.Event, parameters []v1alpha1.T
 		if err != nil {
 			return nil, err
 		}
+		if parameter.Operation.IsPatch() {
+			tmp, err := patchJSON(payload, parameter.Dest, parameter.Operation, []byte(*value))
+			if err != nil {
+				return nil, err
+			}
+			payload = tmp
+			continue
+		}
 		if typ != stringType && parameter.Src.UseRawData {
 			tmp, err := sjson.SetRawBytes(payload, parameter.Dest, []byte(*value))
 			if err != nil {
@@ -113,6 +122,14 @@ func ApplyParams(jsonObj []byte, params []v1alpha1.TriggerParameter, events map[
 		if value == nil {
 			continue
 		}
+		if param.Operation.IsPatch() {
+			tmp, err := patchJSON(jsonObj, param.Dest, param.Operation, []byte(*value))
+			if err != nil {
+				return nil, err
+			}
+			jsonObj = tmp
+			continue
+		}
 
 		switch op := param.Operation; op {
 		case v1alpha1.TriggerParameterOpAppend, v1alpha1.TriggerParameterOpPrepend:
@@ -150,6 +167,40 @@ func ApplyParams(jsonObj []byte, params []v1alpha1.TriggerParameter, events map[
 	return jsonObj, nil
 }
 
+// patchJSON applies a JSON Patch or a JSON Merge Patch document to the value at the dest path of the JSON object,
+// or to the whole object if dest is empty. A missing value is patched as an empty object.
+func patchJSON(jsonObj []byte, dest string, op v1alpha1.TriggerParameterOperation, patch []byte) ([]byte, error) {
+	doc := jsonObj
+	if dest != "" {
+		doc = []byte(gjson.GetBytes(jsonObj, dest).Raw)
+	}
+	if len(doc) == 0 {
+		doc = []byte("{}")
+	}
+	var patched []byte
+	switch op {
+	case v1alpha1.TriggerParameterOpJSONPatch:
+		p, err := jsonpatch.DecodePatch(patch)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON patch, %w", err)
+		}
+		if patched, err = p.Apply(doc); err != nil {
+			return nil, fmt.Errorf("failed to apply the JSON patch to %q, %w", dest, err)
+		}
+	case v1alpha1.TriggerParameterOpMergePatch:
+		var err error
+		if patched, err = jsonpatch.MergePatch(doc, patch); err != nil {
+			return nil, fmt.Errorf("failed to apply the JSON merge patch to %q, %w", dest, err)
+		}
+	default:
+		return nil, fmt.Errorf("unsupported trigger parameter patch operation: %+v", op)
+	}
+	if dest == "" {
+		return patched, nil
+	}
+	return sjson.SetRawBytes(jsonObj, dest, patched)
+}
+
 func isJSON(b []byte) bool {
 	var js json.RawMessage
 	return json.Unmarshal(b, &js) == nil
@@ -292,6 +343,53 @@ func getValueWithTemplate(value []byte, templString string) (string, error) {
 	return out, nil
 }
 
+// RenderTemplate executes the template against the events, each of them is accessible by its dependency name
+// under `.Input`, with the `context` and the `data` of the event.
+func RenderTemplate(events map[string]*v1alpha1.Event, templString string) (string, error) {
+	input, err := templateInput(events)
+	if err != nil {
+		return "", err
+	}
+	tpl, err := template.New("template").Funcs(sprig.FuncMap()).Parse(templString)
+	if err != nil {
+		return "", err
+	}
+	var buf bytes.Buffer
+	if err := tpl.Execute(&buf, map[string]interface{}{
+		"Input": input,
+	}); err != nil {
+		return "", err
+	}
+	out := buf.String()
+	if out == "" || out == "<no value>" {
+		return "", fmt.Errorf("template evaluated to empty string or no value: %s", templString)
+	}
+	return out, nil
+}
+
+// templateInput returns the events by dependency name, with the `context` and the `data` of each of them, as
+// accessible by the templates under `.Input`.
+func templateInput(events map[string]*v1alpha1.Event) (map[string]interface{}, error) {
+	input := make(map[string]interface{}, len(events))
+	for depName, event := range events {
+		if event == nil || event.Context == nil {
+			continue
+		}
+		contextBytes, err := json.Marshal(event.Context)
+		if err != nil {
+			return nil, err
+		}
+		item := map[string]interface{}{
+			"context": gjson.ParseBytes(contextBytes).Value(),
+		}
+		if dataBytes, err := renderEventDataAsJSON(event); err == nil {
+			item["data"] = gjson.ParseBytes(dataBytes).Value()
+		}
+		input[depName] = item
+	}
+	return input, nil
+}
+
 // getValueByKey will return the value as raw json or a string and value's type at the provided key,
 // Value type (jsonType or stringType or empty string). JSON represent a block while String represent a single value.
 // or an error if it does not exist.
@@ -549,6 +549,125 @@ func TestApplyParams(t *testing.T) {
 	}
 }
 
+func TestApplyPatchParams(t *testing.T) {
+	event := &v1alpha1.Event{
+		Context: &v1alpha1.EventContext{
+			DataContentType: common.MediaTypeJSON,
+			Subject:         "example-1",
+			Source:          "webhook-gateway",
+			Type:            "webhook",
+			ID:              "1",
+			Time:            metav1.Time{Time: time.Now().UTC()},
+		},
+		Data: []byte(`{"ref": "main", "labels": {"team": "platform"}, "envs": [{"name": "A", "value": "1"}]}`),
+	}
+	events := map[string]*v1alpha1.Event{
+		"fake-dependency": event,
+	}
+	resource := []byte(`{"metadata": {"name": "job", "labels": {"app": "ci"}}, "spec": {"args": ["build"], "env": [{"name": "X", "value": "0"}]}}`)
+
+	tests := []struct {
+		name   string
+		param  v1alpha1.TriggerParameter
+		result string
+		err    string
+	}{
+		{
+			name: "json patch of the whole resource",
+			param: v1alpha1.TriggerParameter{
+				Src: &v1alpha1.TriggerParameterSource{
+					DependencyName: "fake-dependency",
+					DataTemplate:   `[{"op": "add", "path": "/spec/args/-", "value": "{{ .Input.ref }}"}, {"op": "remove", "path": "/spec/env/0"}]`,
+				},
+				Operation: v1alpha1.TriggerParameterOpJSONPatch,
+			},
+			result: `{"metadata": {"name": "job", "labels": {"app": "ci"}}, "spec": {"args": ["build", "main"], "env": []}}`,
+		},
+		{
+			name: "json patch at the destination",
+			param: v1alpha1.TriggerParameter{
+				Src: &v1alpha1.TriggerParameterSource{
+					DependencyName: "fake-dependency",
+					DataTemplate:   `[{"op": "replace", "path": "/0/value", "value": "{{ .Input.ref }}"}, {"op": "add", "path": "/-", "value": {"name": "Y", "value": "2"}}]`,
+				},
+				Dest:      "spec.env",
+				Operation: v1alpha1.TriggerParameterOpJSONPatch,
+			},
+			result: `{"metadata": {"name": "job", "labels": {"app": "ci"}}, "spec": {"args": ["build"], "env": [{"name": "X", "value": "main"}, {"name": "Y", "value": "2"}]}}`,
+		},
+		{
+			name: "merge patch from the raw data",
+			param: v1alpha1.TriggerParameter{
+				Src: &v1alpha1.TriggerParameterSource{
+					DependencyName: "fake-dependency",
+					DataKey:        "labels",
+					UseRawData:     true,
+				},
+				Dest:      "metadata.labels",
+				Operation: v1alpha1.TriggerParameterOpMergePatch,
+			},
+			result: `{"metadata": {"name": "job", "labels": {"app": "ci", "team": "platform"}}, "spec": {"args": ["build"], "env": [{"name": "X", "value": "0"}]}}`,
+		},
+		{
+			name: "merge patch of a missing destination",
+			param: v1alpha1.TriggerParameter{
+				Src: &v1alpha1.TriggerParameterSource{
+					DependencyName: "fake-dependency",
+					DataKey:        "labels",
+					UseRawData:     true,
+				},
+				Dest:      "metadata.annotations",
+				Operation: v1alpha1.TriggerParameterOpMergePatch,
+			},
+			result: `{"metadata": {"name": "job", "labels": {"app": "ci"}, "annotations": {"team": "platform"}}, "spec": {"args": ["build"], "env": [{"name": "X", "value": "0"}]}}`,
+		},
+		{
+			name: "invalid json patch",
+			param: v1alpha1.TriggerParameter{
+				Src: &v1alpha1.TriggerParameterSource{
+					DependencyName: "fake-dependency",
+					DataKey:        "ref",
+				},
+				Operation: v1alpha1.TriggerParameterOpJSONPatch,
+			},
+			err: "invalid JSON patch",
+		},
+		{
+			name: "json patch failing to apply",
+			param: v1alpha1.TriggerParameter{
+				Src: &v1alpha1.TriggerParameterSource{
+					DependencyName: "fake-dependency",
+					DataTemplate:   `[{"op": "remove", "path": "/spec/missing"}]`,
+				},
+				Operation: v1alpha1.TriggerParameterOpJSONPatch,
+			},
+			err: "failed to apply the JSON patch",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result, err := ApplyParams(resource, []v1alpha1.TriggerParameter{test.param}, events)
+			if test.err != "" {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), test.err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.JSONEq(t, test.result, string(result))
+		})
+	}
+
+	t.Run("patch of the payload", func(t *testing.T) {
+		payload, err := ConstructPayload(events, []v1alpha1.TriggerParameter{
+			{Src: &v1alpha1.TriggerParameterSource{DependencyName: "fake-dependency", DataKey: "ref"}, Dest: "ref"},
+			{Src: &v1alpha1.TriggerParameterSource{DependencyName: "fake-dependency", DataKey: "labels", UseRawData: true}, Operation: v1alpha1.TriggerParameterOpMergePatch},
+		})
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"ref": "main", "team": "platform"}`, string(payload))
+	})
+}
+
 func TestApplyResourceParameters(t *testing.T) {
 	obj := sensorObj.DeepCopy()
 	deployment := newUnstructured("apps/v1", "Deployment", "fake-deployment", "fake")
@@ -619,3 +738,31 @@ func TestApplyTemplateParameters(t *testing.T) {
 	err := ApplyTemplateParameters(testEvents, &obj.Spec.Triggers[0])
 	assert.Nil(t, err)
 }
+
+func TestRenderTemplate(t *testing.T) {
+	event := &v1alpha1.Event{
+		Context: &v1alpha1.EventContext{
+			DataContentType: common.MediaTypeJSON,
+			Subject:         "example-1",
+			Source:          "webhook-gateway",
+			Type:            "webhook",
+			ID:              "1",
+			Time:            metav1.Time{Time: time.Now().UTC()},
+		},
+		Data: []byte("{\"order\": {\"id\": \"abc\"} }"),
+	}
+	testEvents := map[string]*v1alpha1.Event{
+		"fake-dependency": event,
+	}
+
+	result, err := RenderTemplate(testEvents, `{{ .Input.fake-dependency.data.order.id }}`)
+	assert.Error(t, err)
+	assert.Empty(t, result)
+
+	result, err = RenderTemplate(testEvents, `{{ (index .Input "fake-dependency").data.order.id }}-{{ (index .Input "fake-dependency").context.id }}`)
+	assert.NoError(t, err)
+	assert.Equal(t, "abc-1", result)
+
+	_, err = RenderTemplate(testEvents, `{{ .Input.missing }}`)
+	assert.Error(t, err)
+}
@@ -0,0 +1,323 @@
+/*
+Copyright 2020 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package prometheus
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/golang/snappy"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/push"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	"github.com/argoproj/argo-events/common"
+	"github.com/argoproj/argo-events/common/logging"
+	apicommon "github.com/argoproj/argo-events/pkg/apis/common"
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+	"github.com/argoproj/argo-events/sensors/triggers"
+)
+
+const (
+	// defaultTimeout is the timeout of the pushes when the trigger does not set one
+	defaultTimeout = 10 * time.Second
+	// nameLabel is the label holding the name of a metric
+	nameLabel = "__name__"
+)
+
+// PrometheusTrigger describes the trigger to push metric samples to Prometheus
+type PrometheusTrigger struct {
+	// Client is http client.
+	Client *http.Client
+	// Sensor object
+	Sensor *v1alpha1.Sensor
+	// Trigger reference
+	Trigger *v1alpha1.Trigger
+	// Logger to log stuff
+	Logger *zap.SugaredLogger
+}
+
+// NewPrometheusTrigger returns a new Prometheus trigger
+func NewPrometheusTrigger(httpClients common.StringKeyedMap[*http.Client], sensor *v1alpha1.Sensor, trigger *v1alpha1.Trigger, logger *zap.SugaredLogger) (*PrometheusTrigger, error) {
+	promTrigger := trigger.Template.Prometheus
+
+	var tlsConfig *apicommon.TLSConfig
+	switch {
+	case promTrigger.RemoteWrite != nil:
+		tlsConfig = promTrigger.RemoteWrite.TLS
+	case promTrigger.Pushgateway != nil:
+		tlsConfig = promTrigger.Pushgateway.TLS
+	default:
+		return nil, fmt.Errorf("either remoteWrite or pushgateway must be specified")
+	}
+	timeout := defaultTimeout
+	if promTrigger.Timeout > 0 {
+		timeout = time.Duration(promTrigger.Timeout) * time.Second
+	}
+
+	// The clients are shared with the HTTP triggers with the same TLS configuration and timeout
+	clientKey := common.MustHash(struct {
+		TLS     *apicommon.TLSConfig
+		Timeout int64
+	}{tlsConfig, int64(timeout / time.Second)})
+	client, ok := httpClients.Load(clientKey)
+	if !ok {
+		client = &http.Client{Timeout: timeout}
+		if tlsConfig != nil {
+			c, err := common.GetTLSConfig(tlsConfig)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get the tls configuration, %w", err)
+			}
+			client.Transport = &http.Transport{
+				TLSClientConfig: c,
+			}
+		}
+		client, _ = httpClients.LoadOrStore(clientKey, client)
+	}
+
+	return &PrometheusTrigger{
+		Client:  client,
+		Sensor:  sensor,
+		Trigger: trigger,
+		Logger:  logger.With(logging.LabelTriggerType, apicommon.PrometheusTrigger),
+	}, nil
+}
+
+// GetTriggerType returns the type of the trigger
+func (t *PrometheusTrigger) GetTriggerType() apicommon.TriggerType {
+	return apicommon.PrometheusTrigger
+}
+
+// FetchResource fetches the trigger resource
+func (t *PrometheusTrigger) FetchResource(ctx context.Context) (interface{}, error) {
+	return t.Trigger.Template.Prometheus, nil
+}
+
+// ApplyResourceParameters applies parameters to the trigger resource
+func (t *PrometheusTrigger) ApplyResourceParameters(events map[string]*v1alpha1.Event, resource interface{}) (interface{}, error) {
+	fetchedResource, ok := resource.(*v1alpha1.PrometheusTrigger)
+	if !ok {
+		return nil, fmt.Errorf("failed to interpret the fetched trigger resource")
+	}
+
+	resourceBytes, err := json.Marshal(fetchedResource)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal the prometheus trigger resource, %w", err)
+	}
+	parameters := fetchedResource.Parameters
+	if parameters != nil {
+		updatedResourceBytes, err := triggers.ApplyParams(resourceBytes, parameters, events)
+		if err != nil {
+			return nil, err
+		}
+		var pt *v1alpha1.PrometheusTrigger
+		if err := json.Unmarshal(updatedResourceBytes, &pt); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal the updated prometheus trigger resource after applying resource parameters, %w", err)
+		}
+		return pt, nil
+	}
+	return resource, nil
+}
+
+// sample is a metric sample to push
+type sample struct {
+	metric v1alpha1.PrometheusMetric
+	value  float64
+}
+
+// Execute executes the trigger
+func (t *PrometheusTrigger) Execute(ctx context.Context, events map[string]*v1alpha1.Event, resource interface{}) (interface{}, error) {
+	trigger, ok := resource.(*v1alpha1.PrometheusTrigger)
+	if !ok {
+		return nil, fmt.Errorf("failed to interpret the trigger resource")
+	}
+
+	samples := make([]sample, 0, len(trigger.Metrics))
+	for _, metric := range trigger.Metrics {
+		value, err := strconv.ParseFloat(metric.Value, 64)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse the value %q of the metric %s, %w", metric.Value, metric.Name, err)
+		}
+		samples = append(samples, sample{metric: metric, value: value})
+	}
+
+	if trigger.RemoteWrite != nil {
+		if err := t.remoteWrite(ctx, trigger.RemoteWrite, samples, time.Now()); err != nil {
+			return nil, err
+		}
+		t.Logger.Infow("pushed the samples to the remote-write endpoint", zap.Int("samples", len(samples)))
+		return nil, nil
+	}
+	if err := t.pushgateway(ctx, trigger.Pushgateway, samples); err != nil {
+		return nil, err
+	}
+	t.Logger.Infow("pushed the samples to the pushgateway", zap.Int("samples", len(samples)))
+	return nil, nil
+}
+
+// remoteWrite sends the samples to a remote-write endpoint, with the 1.0 version of the protocol.
+func (t *PrometheusTrigger) remoteWrite(ctx context.Context, remoteWrite *v1alpha1.PrometheusRemoteWrite, samples []sample, now time.Time) error {
+	body := snappy.Encode(nil, encodeWriteRequest(samples, now.UnixMilli()))
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, remoteWrite.URL, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to construct request for %s, %w", remoteWrite.URL, err)
+	}
+	for name, value := range remoteWrite.Headers {
+		request.Header.Set(name, value)
+	}
+	request.Header.Set("Content-Encoding", "snappy")
+	request.Header.Set("Content-Type", "application/x-protobuf")
+	request.Header.Set("X-Prometheus-Remote-Write-Version", "0.1.0")
+
+	if remoteWrite.BasicAuth != nil {
+		username, password, err := common.GetBasicAuthFromVolume(remoteWrite.BasicAuth)
+		if err != nil {
+			return err
+		}
+		request.SetBasicAuth(username, password)
+	}
+	if remoteWrite.BearerToken != nil {
+		token, err := common.GetSecretFromVolume(remoteWrite.BearerToken)
+		if err != nil {
+			return fmt.Errorf("failed to retrieve the bearer token, %w", err)
+		}
+		request.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	response, err := t.Client.Do(request)
+	if err != nil {
+		return fmt.Errorf("failed to push the samples to %s, %w", remoteWrite.URL, err)
+	}
+	defer response.Body.Close()
+	if response.StatusCode/100 != 2 {
+		message, _ := io.ReadAll(io.LimitReader(response.Body, 1024))
+		return fmt.Errorf("failed to push the samples to %s, status %d: %s", remoteWrite.URL, response.StatusCode, bytes.TrimSpace(message))
+	}
+	return nil
+}
+
+// encodeWriteRequest encodes the samples as a prometheus.WriteRequest protobuf message, one time series
+// per sample.
+func encodeWriteRequest(samples []sample, timestamp int64) []byte {
+	var request []byte
+	for _, s := range samples {
+		names := make([]string, 0, len(s.metric.Labels))
+		for name := range s.metric.Labels {
+			if name != nameLabel {
+				names = append(names, name)
+			}
+		}
+		// The labels of a time series are sorted by name
+		names = append(names, nameLabel)
+		sort.Strings(names)
+
+		var series []byte
+		for _, name := range names {
+			value := s.metric.Labels[name]
+			if name == nameLabel {
+				value = s.metric.Name
+			}
+			var label []byte
+			label = protowire.AppendTag(label, 1, protowire.BytesType)
+			label = protowire.AppendString(label, name)
+			label = protowire.AppendTag(label, 2, protowire.BytesType)
+			label = protowire.AppendString(label, value)
+			series = protowire.AppendTag(series, 1, protowire.BytesType)
+			series = protowire.AppendBytes(series, label)
+		}
+		var point []byte
+		point = protowire.AppendTag(point, 1, protowire.Fixed64Type)
+		point = protowire.AppendFixed64(point, math.Float64bits(s.value))
+		point = protowire.AppendTag(point, 2, protowire.VarintType)
+		point = protowire.AppendVarint(point, uint64(timestamp))
+		series = protowire.AppendTag(series, 2, protowire.BytesType)
+		series = protowire.AppendBytes(series, point)
+
+		request = protowire.AppendTag(request, 1, protowire.BytesType)
+		request = protowire.AppendBytes(request, series)
+	}
+	return request
+}
+
+// pushgateway adds the samples to the group of the Pushgateway, as gauges.
+func (t *PrometheusTrigger) pushgateway(ctx context.Context, pushgateway *v1alpha1.PrometheusPushgateway, samples []sample) error {
+	pusher := push.New(pushgateway.URL, pushgateway.Job).Client(t.Client).Collector(&sampleCollector{samples: samples})
+	names := make([]string, 0, len(pushgateway.Grouping))
+	for name := range pushgateway.Grouping {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		pusher = pusher.Grouping(name, pushgateway.Grouping[name])
+	}
+	if pushgateway.BasicAuth != nil {
+		username, password, err := common.GetBasicAuthFromVolume(pushgateway.BasicAuth)
+		if err != nil {
+			return err
+		}
+		pusher = pusher.BasicAuth(username, password)
+	}
+	if err := pusher.AddContext(ctx); err != nil {
+		return fmt.Errorf("failed to push the samples to %s, %w", pushgateway.URL, err)
+	}
+	return nil
+}
+
+// sampleCollector collects the samples as constant gauges. It is an unchecked collector, as the labels of
+// the samples are only known once the parameters are applied.
+type sampleCollector struct {
+	samples []sample
+}
+
+func (c *sampleCollector) Describe(chan<- *prometheus.Desc) {}
+
+func (c *sampleCollector) Collect(ch chan<- prometheus.Metric) {
+	for _, s := range c.samples {
+		names := make([]string, 0, len(s.metric.Labels))
+		for name := range s.metric.Labels {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		values := make([]string, 0, len(names))
+		for _, name := range names {
+			values = append(values, s.metric.Labels[name])
+		}
+		help := s.metric.Help
+		if help == "" {
+			help = s.metric.Name
+		}
+		desc := prometheus.NewDesc(s.metric.Name, help, names, nil)
+		metric, err := prometheus.NewConstMetric(desc, prometheus.GaugeValue, s.value, values...)
+		if err != nil {
+			metric = prometheus.NewInvalidMetric(desc, err)
+		}
+		ch <- metric
+	}
+}
+
+// ApplyPolicy applies the policy on the trigger, the pushes have no policy
+func (t *PrometheusTrigger) ApplyPolicy(ctx context.Context, resource interface{}) error {
+	return nil
+}
@@ -0,0 +1,196 @@
+/*
+Copyright 2020 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package prometheus
+
+import (
+	"context"
+	"io"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/snappy"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-events/common"
+	"github.com/argoproj/argo-events/common/logging"
+	apicommon "github.com/argoproj/argo-events/pkg/apis/common"
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+)
+
+var sensorObj = &v1alpha1.Sensor{
+	ObjectMeta: metav1.ObjectMeta{
+		Name:      "fake-sensor",
+		Namespace: "fake",
+	},
+	Spec: v1alpha1.SensorSpec{
+		Triggers: []v1alpha1.Trigger{
+			{
+				Template: &v1alpha1.TriggerTemplate{
+					Name: "fake-trigger",
+					Prometheus: &v1alpha1.PrometheusTrigger{
+						RemoteWrite: &v1alpha1.PrometheusRemoteWrite{
+							URL:     "http://prometheus:9090/api/v1/write",
+							Headers: map[string]string{"X-Scope-OrgID": "tenant"},
+						},
+						Metrics: []v1alpha1.PrometheusMetric{
+							{
+								Name:   "build_duration_seconds",
+								Value:  "0",
+								Labels: map[string]string{"repo": "", "branch": "main"},
+							},
+						},
+						Parameters: []v1alpha1.TriggerParameter{
+							{
+								Src:  &v1alpha1.TriggerParameterSource{DependencyName: "dep", DataKey: "duration"},
+								Dest: "metrics.0.value",
+							},
+							{
+								Src:  &v1alpha1.TriggerParameterSource{DependencyName: "dep", DataKey: "repo"},
+								Dest: "metrics.0.labels.repo",
+							},
+						},
+					},
+				},
+			},
+		},
+	},
+}
+
+func getFakePrometheusTrigger(t *testing.T) *PrometheusTrigger {
+	t.Helper()
+	trigger, err := NewPrometheusTrigger(common.NewStringKeyedMap[*http.Client](), sensorObj.DeepCopy(), sensorObj.Spec.Triggers[0].DeepCopy(), logging.NewArgoEventsLogger())
+	require.NoError(t, err)
+	return trigger
+}
+
+func TestPrometheusTrigger_ApplyResourceParameters(t *testing.T) {
+	trigger := getFakePrometheusTrigger(t)
+	events := map[string]*v1alpha1.Event{
+		"dep": {
+			Context: &v1alpha1.EventContext{
+				ID:              "1",
+				Type:            "webhook",
+				Source:          "webhook-gateway",
+				DataContentType: "application/json",
+				SpecVersion:     "1.0",
+				Subject:         "example-1",
+			},
+			Data: []byte(`{"duration": 42.5, "repo": "argo-events"}`),
+		},
+	}
+	resource, err := trigger.FetchResource(context.TODO())
+	require.NoError(t, err)
+	updated, err := trigger.ApplyResourceParameters(events, resource)
+	require.NoError(t, err)
+	promTrigger, ok := updated.(*v1alpha1.PrometheusTrigger)
+	require.True(t, ok)
+	assert.Equal(t, "42.5", promTrigger.Metrics[0].Value)
+	assert.Equal(t, "argo-events", promTrigger.Metrics[0].Labels["repo"])
+	assert.Equal(t, apicommon.PrometheusTrigger, trigger.GetTriggerType())
+}
+
+func TestPrometheusTrigger_RemoteWrite(t *testing.T) {
+	var header http.Header
+	var body []byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		header = r.Header
+		compressed, _ := io.ReadAll(r.Body)
+		body, _ = snappy.Decode(nil, compressed)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	trigger := getFakePrometheusTrigger(t)
+	resource := trigger.Trigger.Template.Prometheus.DeepCopy()
+	resource.RemoteWrite.URL = server.URL
+	resource.Metrics[0].Value = "42.5"
+	resource.Metrics[0].Labels["repo"] = "argo-events"
+	_, err := trigger.Execute(context.TODO(), nil, resource)
+	require.NoError(t, err)
+
+	assert.Equal(t, "snappy", header.Get("Content-Encoding"))
+	assert.Equal(t, "application/x-protobuf", header.Get("Content-Type"))
+	assert.Equal(t, "tenant", header.Get("X-Scope-OrgID"))
+
+	// The write request holds one time series, with the labels sorted by name
+	series := decodeFields(t, body)[1]
+	require.Len(t, series, 1)
+	fields := decodeFields(t, series[0])
+	var labels [][2]string
+	for _, label := range fields[1] {
+		kv := decodeFields(t, label)
+		labels = append(labels, [2]string{string(kv[1][0]), string(kv[2][0])})
+	}
+	assert.Equal(t, [][2]string{{"__name__", "build_duration_seconds"}, {"branch", "main"}, {"repo", "argo-events"}}, labels)
+	require.Len(t, fields[2], 1)
+	point := decodeFields(t, fields[2][0])
+	bits, _ := protowire.ConsumeFixed64(point[1][0])
+	assert.Equal(t, 42.5, math.Float64frombits(bits))
+	timestamp, _ := protowire.ConsumeVarint(point[2][0])
+	assert.NotZero(t, timestamp)
+
+	resource.Metrics[0].Value = "not-a-number"
+	_, err = trigger.Execute(context.TODO(), nil, resource)
+	assert.ErrorContains(t, err, "failed to parse the value")
+}
+
+func TestPrometheusTrigger_Pushgateway(t *testing.T) {
+	var method, path string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		method, path = r.Method, r.URL.Path
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	trigger := getFakePrometheusTrigger(t)
+	resource := trigger.Trigger.Template.Prometheus.DeepCopy()
+	resource.RemoteWrite = nil
+	resource.Pushgateway = &v1alpha1.PrometheusPushgateway{
+		URL:      server.URL,
+		Job:      "builds",
+		Grouping: map[string]string{"instance": "ci"},
+	}
+	_, err := trigger.Execute(context.TODO(), nil, resource)
+	require.NoError(t, err)
+	assert.Equal(t, http.MethodPost, method)
+	assert.Equal(t, "/metrics/job/builds/instance/ci", path)
+}
+
+// decodeFields decodes the fields of a protobuf message, keyed by field number. The values of the
+// length-delimited fields are their content, the others are their raw encoding.
+func decodeFields(t *testing.T, b []byte) map[protowire.Number][][]byte {
+	t.Helper()
+	fields := map[protowire.Number][][]byte{}
+	for len(b) > 0 {
+		num, typ, n := protowire.ConsumeTag(b)
+		require.GreaterOrEqual(t, n, 0)
+		b = b[n:]
+		n = protowire.ConsumeFieldValue(num, typ, b)
+		require.GreaterOrEqual(t, n, 0)
+		value := b[:n]
+		if typ == protowire.BytesType {
+			value, _ = protowire.ConsumeBytes(value)
+		}
+		fields[num] = append(fields[num], value)
+		b = b[n:]
+	}
+	return fields
+}
@@ -109,7 +109,7 @@ func NewPulsarTrigger(sensor *v1alpha1.Sensor, trigger *v1alpha1.Trigger, pulsar
 
 		var client pulsar.Client
 
-		if err := common.DoWithRetry(pulsarTrigger.ConnectionBackoff, func() error {
+		if err := common.DoWithTrackedRetry("trigger.connect", sensor.Name+"/"+trigger.Template.Name, pulsarTrigger.ConnectionBackoff, func() error {
 			var err error
 			if client, err = pulsar.NewClient(clientOpt); err != nil {
 				return err
@@ -193,6 +193,9 @@ func (t *PulsarTrigger) Execute(ctx context.Context, events map[string]*v1alpha1
 
 	_, err = t.Producer.Send(ctx, &pulsar.ProducerMessage{
 		Payload: payload,
+		Properties: map[string]string{
+			triggers.IdempotencyKeyHeader: triggers.IdempotencyKey(events, t.Trigger.Template.Name),
+		},
 	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to send message to pulsar, %w", err)
@@ -165,6 +165,9 @@ func (k8sTrigger *StandardK8sTrigger) Execute(ctx context.Context, events map[st
 		labels["events.argoproj.io/trigger"] = trigger.Template.Name
 		labels["events.argoproj.io/action-timestamp"] = strconv.Itoa(int(time.Now().UnixNano() / int64(time.Millisecond)))
 		obj.SetLabels(labels)
+		if err := triggers.ApplyTracingMetadata(ctx, obj, k8sTrigger.Sensor, trigger.Template.Name, events); err != nil {
+			return nil, err
+		}
 		return k8sTrigger.namespableDynamicClient.Namespace(namespace).Create(ctx, obj, metav1.CreateOptions{})
 
 	case v1alpha1.Update:
@@ -173,6 +176,9 @@ func (k8sTrigger *StandardK8sTrigger) Execute(ctx context.Context, events map[st
 		oldObj, err := k8sTrigger.namespableDynamicClient.Namespace(namespace).Get(ctx, obj.GetName(), metav1.GetOptions{})
 		if err != nil && apierrors.IsNotFound(err) {
 			k8sTrigger.Logger.Info("object not found, creating the object...")
+			if err := triggers.ApplyTracingMetadata(ctx, obj, k8sTrigger.Sensor, trigger.Template.Name, events); err != nil {
+				return nil, err
+			}
 			return k8sTrigger.namespableDynamicClient.Namespace(namespace).Create(ctx, obj, metav1.CreateOptions{})
 		} else if err != nil {
 			return nil, fmt.Errorf("failed to retrieve existing object. err: %w", err)
@@ -190,6 +196,9 @@ func (k8sTrigger *StandardK8sTrigger) Execute(ctx context.Context, events map[st
 		_, err := k8sTrigger.namespableDynamicClient.Namespace(namespace).Get(ctx, obj.GetName(), metav1.GetOptions{})
 		if err != nil && apierrors.IsNotFound(err) {
 			k8sTrigger.Logger.Info("object not found, creating the object...")
+			if err := triggers.ApplyTracingMetadata(ctx, obj, k8sTrigger.Sensor, trigger.Template.Name, events); err != nil {
+				return nil, err
+			}
 			return k8sTrigger.namespableDynamicClient.Namespace(namespace).Create(ctx, obj, metav1.CreateOptions{})
 		} else if err != nil {
 			return nil, fmt.Errorf("failed to retrieve existing object. err: %w", err)
@@ -0,0 +1,133 @@
+package triggers
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"text/template"
+
+	sprig "github.com/Masterminds/sprig/v3"
+	"go.opentelemetry.io/otel/trace"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/validation"
+
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+)
+
+const (
+	// AnnotationEventIDs is the annotation of the resources created by the triggers holding the IDs of the events
+	AnnotationEventIDs = "events.argoproj.io/event-ids"
+	// AnnotationTraceID is the annotation of the resources created by the triggers holding the trace ID of the execution
+	AnnotationTraceID = "events.argoproj.io/trace-id"
+)
+
+// defaultTracingAnnotations are the annotations added to the created resources unless they are disabled.
+var defaultTracingAnnotations = map[string]string{
+	AnnotationEventIDs: "{{ .EventIDs }}",
+	AnnotationTraceID:  "{{ .TraceID }}",
+}
+
+// ApplyTracingMetadata adds the labels and annotations correlating a resource created by a trigger to the events
+// which caused it, and to the trace of the execution.
+func ApplyTracingMetadata(ctx context.Context, obj *unstructured.Unstructured, sensor *v1alpha1.Sensor, triggerName string, events map[string]*v1alpha1.Event) error {
+	spec := sensor.Spec.TracingMetadata
+	if spec == nil {
+		spec = &v1alpha1.TracingMetadata{}
+	}
+	annotationTemplates := map[string]string{}
+	if !spec.DisableDefaults {
+		for k, v := range defaultTracingAnnotations {
+			annotationTemplates[k] = v
+		}
+	}
+	for k, v := range spec.Annotations {
+		annotationTemplates[k] = v
+	}
+	if len(annotationTemplates) == 0 && len(spec.Labels) == 0 {
+		return nil
+	}
+	data, err := tracingTemplateData(ctx, sensor.Name, triggerName, events)
+	if err != nil {
+		return err
+	}
+	labels, err := renderTracingTemplates(spec.Labels, data)
+	if err != nil {
+		return fmt.Errorf("failed to render the tracing labels, %w", err)
+	}
+	for k, v := range labels {
+		if errs := validation.IsValidLabelValue(v); len(errs) > 0 {
+			return fmt.Errorf("invalid value %q of the tracing label %s, %s", v, k, strings.Join(errs, ", "))
+		}
+	}
+	annotations, err := renderTracingTemplates(annotationTemplates, data)
+	if err != nil {
+		return fmt.Errorf("failed to render the tracing annotations, %w", err)
+	}
+	obj.SetLabels(mergeStringMaps(obj.GetLabels(), labels))
+	obj.SetAnnotations(mergeStringMaps(obj.GetAnnotations(), annotations))
+	return nil
+}
+
+// tracingTemplateData returns the data the tracing templates are rendered against.
+func tracingTemplateData(ctx context.Context, sensorName, triggerName string, events map[string]*v1alpha1.Event) (map[string]interface{}, error) {
+	input, err := templateInput(events)
+	if err != nil {
+		return nil, err
+	}
+	depNames := make([]string, 0, len(events))
+	for depName, event := range events {
+		if event != nil && event.Context != nil {
+			depNames = append(depNames, depName)
+		}
+	}
+	sort.Strings(depNames)
+	eventIDs := make([]string, 0, len(depNames))
+	for _, depName := range depNames {
+		eventIDs = append(eventIDs, events[depName].Context.ID)
+	}
+	traceID := ""
+	if sc := trace.SpanContextFromContext(ctx); sc.HasTraceID() {
+		traceID = sc.TraceID().String()
+	}
+	return map[string]interface{}{
+		"Sensor":   sensorName,
+		"Trigger":  triggerName,
+		"EventIDs": strings.Join(eventIDs, ","),
+		"TraceID":  traceID,
+		"Input":    input,
+	}, nil
+}
+
+// renderTracingTemplates renders the templates of the labels or annotations, leaving out the empty ones.
+func renderTracingTemplates(templates map[string]string, data map[string]interface{}) (map[string]string, error) {
+	result := make(map[string]string, len(templates))
+	for k, templString := range templates {
+		tpl, err := template.New(k).Funcs(sprig.FuncMap()).Parse(templString)
+		if err != nil {
+			return nil, fmt.Errorf("invalid template of %s, %w", k, err)
+		}
+		var buf bytes.Buffer
+		if err := tpl.Execute(&buf, data); err != nil {
+			return nil, fmt.Errorf("failed to render the template of %s, %w", k, err)
+		}
+		if v := strings.TrimSpace(buf.String()); v != "" && v != "<no value>" {
+			result[k] = v
+		}
+	}
+	return result, nil
+}
+
+func mergeStringMaps(dst, src map[string]string) map[string]string {
+	if len(src) == 0 {
+		return dst
+	}
+	if dst == nil {
+		dst = make(map[string]string, len(src))
+	}
+	for k, v := range src {
+		dst[k] = v
+	}
+	return dst
+}
@@ -0,0 +1,57 @@
+package triggers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/trace"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+)
+
+func TestApplyTracingMetadata(t *testing.T) {
+	sensor := &v1alpha1.Sensor{ObjectMeta: metav1.ObjectMeta{Name: "orders"}}
+	events := map[string]*v1alpha1.Event{
+		"payment": {Context: &v1alpha1.EventContext{ID: "id-2", DataContentType: "application/json"}, Data: []byte(`{"amount":10}`)},
+		"order":   {Context: &v1alpha1.EventContext{ID: "id-1", DataContentType: "application/json"}, Data: []byte(`{"id":"o-42"}`)},
+	}
+	traceID, _ := trace.TraceIDFromHex("4bf92f3577b34da6a3ce929d0e0e4736")
+	spanID, _ := trace.SpanIDFromHex("00f067aa0ba902b7")
+	ctx := trace.ContextWithSpanContext(context.Background(), trace.NewSpanContext(trace.SpanContextConfig{TraceID: traceID, SpanID: spanID}))
+
+	t.Run("test default annotations", func(t *testing.T) {
+		obj := &unstructured.Unstructured{}
+		obj.SetAnnotations(map[string]string{"existing": "kept"})
+		assert.NoError(t, ApplyTracingMetadata(ctx, obj, sensor, "create-job", events))
+		assert.Equal(t, map[string]string{
+			"existing":         "kept",
+			AnnotationEventIDs: "id-1,id-2",
+			AnnotationTraceID:  "4bf92f3577b34da6a3ce929d0e0e4736",
+		}, obj.GetAnnotations())
+		assert.Empty(t, obj.GetLabels())
+
+		// No trace ID without tracing
+		obj = &unstructured.Unstructured{}
+		assert.NoError(t, ApplyTracingMetadata(context.Background(), obj, sensor, "create-job", events))
+		assert.Equal(t, map[string]string{AnnotationEventIDs: "id-1,id-2"}, obj.GetAnnotations())
+	})
+
+	t.Run("test templates", func(t *testing.T) {
+		s := sensor.DeepCopy()
+		s.Spec.TracingMetadata = &v1alpha1.TracingMetadata{
+			Labels:          map[string]string{"example.com/order": "{{ .Input.order.data.id }}", "example.com/missing": "{{ .Input.missing }}"},
+			Annotations:     map[string]string{"example.com/source": "{{ .Sensor }}/{{ .Trigger }}"},
+			DisableDefaults: true,
+		}
+		obj := &unstructured.Unstructured{}
+		assert.NoError(t, ApplyTracingMetadata(ctx, obj, s, "create-job", events))
+		assert.Equal(t, map[string]string{"example.com/order": "o-42"}, obj.GetLabels())
+		assert.Equal(t, map[string]string{"example.com/source": "orders/create-job"}, obj.GetAnnotations())
+
+		s.Spec.TracingMetadata.Labels = map[string]string{"example.com/source": "{{ .Sensor }}/{{ .Trigger }}"}
+		assert.ErrorContains(t, ApplyTracingMetadata(ctx, obj, s, "create-job", events), "invalid value")
+	})
+}
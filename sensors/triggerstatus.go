@@ -0,0 +1,362 @@
+package sensors
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
+
+	"github.com/argoproj/argo-events/common"
+	"github.com/argoproj/argo-events/common/logging"
+	"github.com/argoproj/argo-events/pkg/apis/sensor"
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+)
+
+const (
+	// triggerStatusInterval is the interval the trigger executions are reported at.
+	triggerStatusInterval = 30 * time.Second
+	// maxTriggerErrorLength is the maximum length of the errors listed in the status.
+	maxTriggerErrorLength = 512
+	// maxStoredExecutions is the maximum number of executions kept for each trigger in the execution store.
+	maxStoredExecutions = 100
+)
+
+// triggerExecution is an execution kept in the execution store.
+type triggerExecution struct {
+	Time  time.Time `json:"time"`
+	Error string    `json:"error,omitempty"`
+}
+
+// triggerState is the state of a trigger known by this pod.
+type triggerState struct {
+	lastExecutionTime time.Time
+	// failingSince is zero when the last execution succeeded.
+	failingSince        time.Time
+	consecutiveFailures int32
+	lastError           string
+}
+
+// triggerReport holds the executions to report since the previous report.
+type triggerReport struct {
+	executions int64
+	failures   int64
+	last       time.Time
+	triggers   map[string]triggerState
+	// pending holds the executions to add to the execution store, keyed by trigger name.
+	pending map[string][]triggerExecution
+}
+
+// triggerStatusReporter aggregates the trigger executions of this pod, and reports them in the status.
+type triggerStatusReporter struct {
+	config v1alpha1.TriggerStatusReporting
+
+	lock   sync.Mutex
+	report triggerReport
+}
+
+func newTriggerStatusReporter(config v1alpha1.TriggerStatusReporting) *triggerStatusReporter {
+	return &triggerStatusReporter{
+		config: config,
+		report: triggerReport{
+			triggers: make(map[string]triggerState),
+			pending:  make(map[string][]triggerExecution),
+		},
+	}
+}
+
+// record records an execution of a trigger.
+func (r *triggerStatusReporter) record(trigger string, at time.Time, err error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.report.executions++
+	if at.After(r.report.last) {
+		r.report.last = at
+	}
+	state := r.report.triggers[trigger]
+	state.lastExecutionTime = at
+	execution := triggerExecution{Time: at}
+	if err != nil {
+		r.report.failures++
+		if state.failingSince.IsZero() {
+			state.failingSince = at
+		}
+		state.consecutiveFailures++
+		state.lastError = truncateError(err.Error())
+		execution.Error = state.lastError
+	} else {
+		state.failingSince = time.Time{}
+		state.consecutiveFailures = 0
+		state.lastError = ""
+	}
+	r.report.triggers[trigger] = state
+	if r.config.GetVerbosity() == v1alpha1.TriggerStatusDetailed {
+		r.report.pending[trigger] = append(r.report.pending[trigger], execution)
+	}
+}
+
+// take returns the executions to report, and resets the counters. It returns nil if there is nothing to report.
+func (r *triggerStatusReporter) take() *triggerReport {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if r.report.executions == 0 && len(r.report.pending) == 0 {
+		return nil
+	}
+	report := r.report
+	report.triggers = make(map[string]triggerState, len(r.report.triggers))
+	for name, state := range r.report.triggers {
+		report.triggers[name] = state
+	}
+	r.report.executions = 0
+	r.report.failures = 0
+	r.report.pending = make(map[string][]triggerExecution)
+	return &report
+}
+
+// restore adds back the executions of a report which failed to be reported.
+func (r *triggerStatusReporter) restore(report *triggerReport) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.report.executions += report.executions
+	r.report.failures += report.failures
+	for name, executions := range report.pending {
+		r.report.pending[name] = append(executions, r.report.pending[name]...)
+	}
+}
+
+// apply merges a report into the status of the triggers.
+func (report *triggerReport) apply(status *v1alpha1.TriggersStatus, maxFailing int) {
+	status.Executions += report.executions
+	status.Failures += report.failures
+	if status.LastExecutionTime == nil || report.last.After(status.LastExecutionTime.Time) {
+		status.LastExecutionTime = &metav1.Time{Time: report.last}
+	}
+	failing := make(map[string]v1alpha1.FailingTriggerStatus, len(status.Failing))
+	for _, f := range status.Failing {
+		failing[f.Name] = f
+	}
+	for name, state := range report.triggers {
+		// Keep the status reported by another pod after this one
+		if f, ok := failing[name]; ok && f.LastExecutionTime.After(state.lastExecutionTime) {
+			continue
+		}
+		if state.failingSince.IsZero() {
+			delete(failing, name)
+			continue
+		}
+		failing[name] = v1alpha1.FailingTriggerStatus{
+			Name:                name,
+			Since:               metav1.Time{Time: state.failingSince},
+			LastExecutionTime:   metav1.Time{Time: state.lastExecutionTime},
+			ConsecutiveFailures: state.consecutiveFailures,
+			LastError:           state.lastError,
+		}
+	}
+	list := make([]v1alpha1.FailingTriggerStatus, 0, len(failing))
+	for _, f := range failing {
+		list = append(list, f)
+	}
+	// The triggers failing for the longest time are listed first
+	sort.Slice(list, func(i, j int) bool {
+		if !list[i].Since.Equal(&list[j].Since) {
+			return list[i].Since.Before(&list[j].Since)
+		}
+		return list[i].Name < list[j].Name
+	})
+	status.OmittedFailing = 0
+	if len(list) > maxFailing {
+		status.OmittedFailing = int32(len(list) - maxFailing)
+		list = list[:maxFailing]
+	}
+	status.Failing = list
+}
+
+// reportTriggerStatus reports the trigger executions periodically, until the context is done.
+func (sensorCtx *SensorContext) reportTriggerStatus(ctx context.Context) {
+	log := logging.FromContext(ctx)
+	ticker := time.NewTicker(triggerStatusInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			// Report the last executions before exiting
+			flushCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), 5*time.Second)
+			if err := sensorCtx.flushTriggerStatus(flushCtx); err != nil {
+				log.Warnw("failed to report the trigger executions", zap.Error(err))
+			}
+			cancel()
+			return
+		case <-ticker.C:
+			if err := sensorCtx.flushTriggerStatus(ctx); err != nil {
+				log.Warnw("failed to report the trigger executions", zap.Error(err))
+			}
+		}
+	}
+}
+
+// flushTriggerStatus reports the trigger executions since the previous report in the status, and in the
+// execution store with the Detailed verbosity.
+func (sensorCtx *SensorContext) flushTriggerStatus(ctx context.Context) error {
+	reporter := sensorCtx.triggerStatus
+	if reporter == nil || sensorCtx.dynamicClient == nil {
+		return nil
+	}
+	report := reporter.take()
+	if report == nil {
+		return nil
+	}
+	detailed := reporter.config.GetVerbosity() == v1alpha1.TriggerStatusDetailed
+	var uid types.UID
+	client := sensorCtx.dynamicClient.Resource(v1alpha1.SchemeGroupVersion.WithResource(sensor.Plural)).Namespace(sensorCtx.sensor.Namespace)
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		obj, err := client.Get(ctx, sensorCtx.sensor.Name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		s := &v1alpha1.Sensor{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, s); err != nil {
+			return err
+		}
+		uid = s.UID
+		if s.Status.Triggers == nil {
+			s.Status.Triggers = &v1alpha1.TriggersStatus{}
+		}
+		report.apply(s.Status.Triggers, reporter.config.GetMaxFailingTriggers())
+		s.Status.Triggers.ExecutionStore = ""
+		if detailed {
+			s.Status.Triggers.ExecutionStore = executionStoreName(sensorCtx.sensor.Name)
+		}
+		un, err := runtime.DefaultUnstructuredConverter.ToUnstructured(s)
+		if err != nil {
+			return err
+		}
+		_, err = client.UpdateStatus(ctx, &unstructured.Unstructured{Object: un}, metav1.UpdateOptions{})
+		return err
+	})
+	if err != nil {
+		reporter.restore(report)
+		return err
+	}
+	if !detailed || len(report.pending) == 0 {
+		return nil
+	}
+	if err := sensorCtx.storeTriggerExecutions(ctx, uid, report.pending, time.Now().Add(-reporter.config.GetExecutionTTL())); err != nil {
+		// Only the executions are stored again with the next report
+		reporter.restore(&triggerReport{pending: report.pending})
+		return err
+	}
+	return nil
+}
+
+// storeTriggerExecutions adds the executions to the execution store, and removes the ones older than expiry.
+func (sensorCtx *SensorContext) storeTriggerExecutions(ctx context.Context, uid types.UID, pending map[string][]triggerExecution, expiry time.Time) error {
+	if sensorCtx.kubeClient == nil {
+		return nil
+	}
+	client := sensorCtx.kubeClient.CoreV1().ConfigMaps(sensorCtx.sensor.Namespace)
+	name := executionStoreName(sensorCtx.sensor.Name)
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		cm, err := client.Get(ctx, name, metav1.GetOptions{})
+		create := false
+		if err != nil {
+			if !apierrors.IsNotFound(err) {
+				return err
+			}
+			create = true
+			cm = &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      name,
+					Namespace: sensorCtx.sensor.Namespace,
+					Labels: map[string]string{
+						common.LabelSensorName: sensorCtx.sensor.Name,
+					},
+					OwnerReferences: []metav1.OwnerReference{
+						*metav1.NewControllerRef(&metav1.ObjectMeta{Name: sensorCtx.sensor.Name, UID: uid}, v1alpha1.SchemaGroupVersionKind),
+					},
+				},
+			}
+		}
+		if cm.Data == nil {
+			cm.Data = make(map[string]string)
+		}
+		keys := make(map[string][]triggerExecution)
+		for name, executions := range pending {
+			key := executionStoreKey(name)
+			keys[key] = append(keys[key], executions...)
+		}
+		for key := range cm.Data {
+			if _, ok := keys[key]; !ok {
+				keys[key] = nil
+			}
+		}
+		for key, executions := range keys {
+			var stored []triggerExecution
+			if data, ok := cm.Data[key]; ok {
+				if err := json.Unmarshal([]byte(data), &stored); err != nil {
+					stored = nil
+				}
+			}
+			stored = append(stored, executions...)
+			kept := stored[:0]
+			for _, e := range stored {
+				if e.Time.After(expiry) {
+					kept = append(kept, e)
+				}
+			}
+			if len(kept) > maxStoredExecutions {
+				kept = kept[len(kept)-maxStoredExecutions:]
+			}
+			if len(kept) == 0 {
+				delete(cm.Data, key)
+				continue
+			}
+			data, err := json.Marshal(kept)
+			if err != nil {
+				return err
+			}
+			cm.Data[key] = string(data)
+		}
+		if create {
+			_, err = client.Create(ctx, cm, metav1.CreateOptions{})
+			if apierrors.IsAlreadyExists(err) {
+				// Created by another pod meanwhile, retry with it
+				return apierrors.NewConflict(corev1.Resource("configmaps"), name, err)
+			}
+			return err
+		}
+		_, err = client.Update(ctx, cm, metav1.UpdateOptions{})
+		return err
+	})
+}
+
+// executionStoreName returns the name of the ConfigMap keeping the executions of the triggers of a Sensor.
+func executionStoreName(sensorName string) string {
+	return sensorName + "-sensor-executions"
+}
+
+// executionStoreKey returns the ConfigMap key of the executions of a trigger.
+func executionStoreKey(trigger string) string {
+	return strings.Map(func(r rune) rune {
+		if r == '-' || r == '.' || r == '_' || (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') {
+			return r
+		}
+		return '_'
+	}, trigger)
+}
+
+func truncateError(msg string) string {
+	if len(msg) <= maxTriggerErrorLength {
+		return msg
+	}
+	return msg[:maxTriggerErrorLength] + "..."
+}
@@ -0,0 +1,119 @@
+package sensors
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+)
+
+func TestTriggerReportApply(t *testing.T) {
+	now := time.Now().Truncate(time.Second)
+	r := newTriggerStatusReporter(v1alpha1.TriggerStatusReporting{MaxFailingTriggers: 2})
+	r.record("a", now.Add(-3*time.Minute), fmt.Errorf("failed"))
+	r.record("b", now.Add(-2*time.Minute), fmt.Errorf("failed"))
+	r.record("c", now.Add(-1*time.Minute), fmt.Errorf("failed"))
+	r.record("d", now, nil)
+
+	status := &v1alpha1.TriggersStatus{
+		Executions: 10,
+		Failing: []v1alpha1.FailingTriggerStatus{
+			{Name: "d", Since: metav1.Time{Time: now.Add(-time.Hour)}, LastExecutionTime: metav1.Time{Time: now.Add(-time.Hour)}},
+		},
+	}
+	report := r.take()
+	assert.NotNil(t, report)
+	report.apply(status, r.config.GetMaxFailingTriggers())
+	assert.Equal(t, int64(14), status.Executions)
+	assert.Equal(t, int64(3), status.Failures)
+	assert.Equal(t, now, status.LastExecutionTime.Time)
+	assert.Len(t, status.Failing, 2)
+	assert.Equal(t, "a", status.Failing[0].Name)
+	assert.Equal(t, "b", status.Failing[1].Name)
+	assert.Equal(t, int32(1), status.OmittedFailing)
+	assert.Nil(t, r.take())
+
+	// A trigger succeeding is not failing anymore
+	r.record("a", now.Add(time.Second), nil)
+	r.take().apply(status, r.config.GetMaxFailingTriggers())
+	assert.Len(t, status.Failing, 2)
+	assert.Equal(t, "b", status.Failing[0].Name)
+	assert.Equal(t, "c", status.Failing[1].Name)
+	assert.Equal(t, int32(0), status.OmittedFailing)
+}
+
+func TestTruncateError(t *testing.T) {
+	assert.Equal(t, "failed", truncateError("failed"))
+	msg := truncateError(string(make([]byte, 2*maxTriggerErrorLength)))
+	assert.Len(t, msg, maxTriggerErrorLength+3)
+}
+
+func TestExecutionStoreKey(t *testing.T) {
+	assert.Equal(t, "my-trigger_1.a", executionStoreKey("my-trigger_1.a"))
+	assert.Equal(t, "my_trigger", executionStoreKey("my/trigger"))
+}
+
+func TestFlushTriggerStatus(t *testing.T) {
+	obj := sensorObj.DeepCopy()
+	obj.UID = "uid"
+	obj.Spec.TriggerStatus = &v1alpha1.TriggerStatusReporting{Verbosity: v1alpha1.TriggerStatusDetailed, ExecutionTTL: "1h"}
+	scheme := runtime.NewScheme()
+	assert.NoError(t, v1alpha1.AddToScheme(scheme))
+	dynamicClient := dynamicfake.NewSimpleDynamicClient(scheme, &v1alpha1.Sensor{
+		TypeMeta:   metav1.TypeMeta{APIVersion: v1alpha1.SchemeGroupVersion.String(), Kind: "Sensor"},
+		ObjectMeta: obj.ObjectMeta,
+	})
+	kubeClient := k8sfake.NewSimpleClientset()
+	sensorCtx := NewSensorContext(kubeClient, dynamicClient, obj, nil, "", "", nil)
+	assert.NotNil(t, sensorCtx.triggerStatus)
+
+	getStatus := func() *v1alpha1.TriggersStatus {
+		u, err := dynamicClient.Resource(v1alpha1.SchemeGroupVersion.WithResource("sensors")).Namespace(obj.Namespace).Get(context.Background(), obj.Name, metav1.GetOptions{})
+		assert.NoError(t, err)
+		s := &v1alpha1.Sensor{}
+		assert.NoError(t, runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, s))
+		return s.Status.Triggers
+	}
+
+	// Nothing is reported without executions
+	assert.NoError(t, sensorCtx.flushTriggerStatus(context.Background()))
+	assert.Nil(t, getStatus())
+
+	sensorCtx.triggerStatus.record("fake-trigger", time.Now(), fmt.Errorf("failed"))
+	sensorCtx.triggerStatus.record("fake-trigger", time.Now(), nil)
+	assert.NoError(t, sensorCtx.flushTriggerStatus(context.Background()))
+	status := getStatus()
+	assert.NotNil(t, status)
+	assert.Equal(t, int64(2), status.Executions)
+	assert.Equal(t, int64(1), status.Failures)
+	assert.Empty(t, status.Failing)
+	assert.Equal(t, "fake-sensor-sensor-executions", status.ExecutionStore)
+
+	cm, err := kubeClient.CoreV1().ConfigMaps(obj.Namespace).Get(context.Background(), status.ExecutionStore, metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, "uid", string(cm.OwnerReferences[0].UID))
+	var executions []triggerExecution
+	assert.NoError(t, json.Unmarshal([]byte(cm.Data["fake-trigger"]), &executions))
+	assert.Len(t, executions, 2)
+	assert.Equal(t, "failed", executions[0].Error)
+
+	// The expired executions are removed
+	sensorCtx.triggerStatus.record("fake-trigger", time.Now(), nil)
+	sensorCtx.triggerStatus.record("other-trigger", time.Now().Add(-2*time.Hour), nil)
+	assert.NoError(t, sensorCtx.flushTriggerStatus(context.Background()))
+	cm, err = kubeClient.CoreV1().ConfigMaps(obj.Namespace).Get(context.Background(), status.ExecutionStore, metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.NoError(t, json.Unmarshal([]byte(cm.Data["fake-trigger"]), &executions))
+	assert.Len(t, executions, 3)
+	assert.NotContains(t, cm.Data, "other-trigger")
+	assert.Equal(t, int64(4), getStatus().Executions)
+}
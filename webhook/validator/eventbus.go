@@ -83,6 +83,13 @@ func (eb *eventbus) ValidateUpdate(ctx context.Context) *admissionv1.AdmissionRe
 		if eb.oldeb.Spec.JetStreamExotic == nil {
 			return DeniedResponse("Can not change event bus implementation")
 		}
+	case eb.neweb.Spec.Shared != nil:
+		if eb.oldeb.Spec.Shared == nil {
+			return DeniedResponse("Can not change event bus implementation")
+		}
+		if eb.oldeb.Spec.Shared.Namespace != eb.neweb.Spec.Shared.Namespace || eb.oldeb.Spec.Shared.GetName() != eb.neweb.Spec.Shared.GetName() {
+			return DeniedResponse("\"spec.shared\" is immutable, can not be updated")
+		}
 	}
 
 	return AllowedResponse()
@@ -2,6 +2,7 @@ package validator
 
 import (
 	"context"
+	"fmt"
 
 	admissionv1 "k8s.io/api/admission/v1"
 	"k8s.io/client-go/kubernetes"
@@ -30,7 +31,12 @@ func NewEventSourceValidator(client kubernetes.Interface, ebClient eventbusclien
 }
 
 func (es *eventsource) ValidateCreate(ctx context.Context) *admissionv1.AdmissionResponse {
-	if err := eventsourcecontroller.ValidateEventSource(es.newes); err != nil {
+	// The included specs are resolved the same way the controller does, an EventSource may only be complete with them
+	resolved, err := eventsourcecontroller.ResolveIncludesWithClientset(ctx, es.client, es.newes)
+	if err != nil {
+		return DeniedResponse(fmt.Sprintf("failed to resolve includes, %v", err))
+	}
+	if err := eventsourcecontroller.ValidateEventSource(resolved); err != nil {
 		return DeniedResponse(err.Error())
 	}
 	return AllowedResponse()
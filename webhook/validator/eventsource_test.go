@@ -7,6 +7,9 @@ import (
 
 	"github.com/ghodss/yaml"
 	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	fakeClient "k8s.io/client-go/kubernetes/fake"
 
 	"github.com/argoproj/argo-events/pkg/apis/eventsource/v1alpha1"
 )
@@ -34,3 +37,34 @@ func TestValidateEventSource(t *testing.T) {
 		assert.True(t, r.Allowed)
 	}
 }
+
+func TestValidateEventSourceIncludes(t *testing.T) {
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Namespace: testNamespace, Name: "webhook-defaults"},
+		Data: map[string]string{"spec": `
+webhook:
+  example:
+    endpoint: /example
+    port: "12000"
+    method: POST
+`},
+	}
+	k8sClient := fakeClient.NewSimpleClientset(cm)
+	es := &v1alpha1.EventSource{
+		ObjectMeta: metav1.ObjectMeta{Namespace: testNamespace, Name: "include-only"},
+		Spec: v1alpha1.EventSourceSpec{
+			Includes: []v1alpha1.EventSourceInclude{{ConfigMap: &corev1.ConfigMapKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: "webhook-defaults"},
+				Key:                  "spec",
+			}}},
+		},
+	}
+	v := NewEventSourceValidator(k8sClient, fakeEventBusClient, fakeEventSourceClient, fakeSensorClient, nil, es)
+	r := v.ValidateCreate(contextWithLogger(t))
+	assert.True(t, r.Allowed)
+
+	v = NewEventSourceValidator(fakeK8sClient, fakeEventBusClient, fakeEventSourceClient, fakeSensorClient, nil, es)
+	r = v.ValidateCreate(contextWithLogger(t))
+	assert.False(t, r.Allowed)
+	assert.Contains(t, r.Result.Message, "failed to resolve includes")
+}
@@ -10,6 +10,7 @@ import (
 	"k8s.io/client-go/kubernetes"
 
 	sensorcontroller "github.com/argoproj/argo-events/controllers/sensor"
+	eventbusv1alpha1 "github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1"
 	sensorv1alpha1 "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
 	eventbusclient "github.com/argoproj/argo-events/pkg/client/eventbus/clientset/versioned"
 	eventsourceclient "github.com/argoproj/argo-events/pkg/client/eventsource/clientset/versioned"
@@ -42,7 +43,24 @@ func (s *sensor) ValidateCreate(ctx context.Context) *admissionv1.AdmissionRespo
 		return DeniedResponse(fmt.Sprintf("failed to get EventBus eventBusName=%s; err=%v", eventBusName, err))
 	}
 
-	if err := sensorcontroller.ValidateSensor(s.newSensor, eventBus); err != nil {
+	// The dependencies are validated against the EventBuses they consume from
+	additionalEventBuses := make(map[string]*eventbusv1alpha1.EventBus)
+	for _, dep := range s.newSensor.Spec.Dependencies {
+		name := dep.EventBusName
+		if name == "" || name == eventBusName {
+			continue
+		}
+		if _, ok := additionalEventBuses[name]; ok {
+			continue
+		}
+		b, err := s.eventBusClient.ArgoprojV1alpha1().EventBus(s.newSensor.Namespace).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return DeniedResponse(fmt.Sprintf("failed to get EventBus %s of the dependency %s; err=%v", name, dep.Name, err))
+		}
+		additionalEventBuses[name] = b
+	}
+
+	if err := sensorcontroller.ValidateSensorWithEventBuses(s.newSensor, eventBus, additionalEventBuses); err != nil {
 		return DeniedResponse(err.Error())
 	}
 	return AllowedResponse()